@@ -126,6 +126,7 @@ import (
 	"github.com/spf13/cast"
 
 	"github.com/Stride-Labs/stride/v24/utils"
+	"github.com/Stride-Labs/stride/v24/wasmbinding"
 	airdrop "github.com/Stride-Labs/stride/v24/x/airdrop"
 	airdropkeeper "github.com/Stride-Labs/stride/v24/x/airdrop/keeper"
 	airdroptypes "github.com/Stride-Labs/stride/v24/x/airdrop/types"
@@ -253,6 +254,7 @@ var (
 		stakeibcmoduletypes.ModuleName:                {authtypes.Minter, authtypes.Burner, authtypes.Staking},
 		claimtypes.ModuleName:                         nil,
 		interchainquerytypes.ModuleName:               nil,
+		interchainquerytypes.RelayerFeePoolName:       nil,
 		icatypes.ModuleName:                           nil,
 		stakeibcmoduletypes.RewardCollectorName:       nil,
 		staketiatypes.ModuleName:                      {authtypes.Minter, authtypes.Burner},
@@ -336,18 +338,20 @@ type StrideApp struct {
 
 	StakeibcKeeper stakeibcmodulekeeper.Keeper
 
-	EpochsKeeper          epochsmodulekeeper.Keeper
-	InterchainqueryKeeper interchainquerykeeper.Keeper
-	ScopedRecordsKeeper   capabilitykeeper.ScopedKeeper
-	RecordsKeeper         recordsmodulekeeper.Keeper
-	IcacallbacksKeeper    icacallbacksmodulekeeper.Keeper
-	ScopedratelimitKeeper capabilitykeeper.ScopedKeeper
-	RatelimitKeeper       ratelimitkeeper.Keeper
-	ClaimKeeper           claimkeeper.Keeper
-	ICAOracleKeeper       icaoraclekeeper.Keeper
-	StaketiaKeeper        staketiakeeper.Keeper
-	StakedymKeeper        stakedymkeeper.Keeper
-	AirdropKeeper         airdropkeeper.Keeper
+	EpochsKeeper                epochsmodulekeeper.Keeper
+	InterchainqueryKeeper       interchainquerykeeper.Keeper
+	ScopedInterchainqueryKeeper capabilitykeeper.ScopedKeeper
+	ScopedRecordsKeeper         capabilitykeeper.ScopedKeeper
+	RecordsKeeper               recordsmodulekeeper.Keeper
+	IcacallbacksKeeper          icacallbacksmodulekeeper.Keeper
+	ScopedratelimitKeeper       capabilitykeeper.ScopedKeeper
+	RatelimitKeeper             ratelimitkeeper.Keeper
+	ClaimKeeper                 claimkeeper.Keeper
+	ICAOracleKeeper             icaoraclekeeper.Keeper
+	ScopedICAOracleKeeper       capabilitykeeper.ScopedKeeper
+	StaketiaKeeper              staketiakeeper.Keeper
+	StakedymKeeper              stakedymkeeper.Keeper
+	AirdropKeeper               airdropkeeper.Keeper
 
 	mm           *module.Manager
 	sm           *module.SimulationManager
@@ -451,9 +455,15 @@ func NewStrideApp(
 	app.StakingKeeper = *stakingkeeper.NewKeeper(
 		appCodec, keys[stakingtypes.StoreKey], app.AccountKeeper, app.BankKeeper, authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
-	epochsKeeper := epochsmodulekeeper.NewKeeper(appCodec, keys[epochsmoduletypes.StoreKey])
+	epochsKeeper := epochsmodulekeeper.NewKeeper(
+		appCodec,
+		keys[epochsmoduletypes.StoreKey],
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+	)
 	app.MintKeeper = mintkeeper.NewKeeper(
-		appCodec, keys[minttypes.StoreKey], app.GetSubspace(minttypes.ModuleName), app.AccountKeeper, app.BankKeeper, app.DistrKeeper, app.EpochsKeeper, authtypes.FeeCollectorName,
+		appCodec, keys[minttypes.StoreKey], app.GetSubspace(minttypes.ModuleName), app.AccountKeeper, app.BankKeeper, app.DistrKeeper, epochsKeeper,
+		&app.WasmKeeper, // wasm keeper initialized below
+		authtypes.FeeCollectorName,
 	)
 	app.DistrKeeper = distrkeeper.NewKeeper(
 		appCodec, keys[distrtypes.StoreKey], app.AccountKeeper, app.BankKeeper,
@@ -475,20 +485,24 @@ func NewStrideApp(
 	app.FeeGrantKeeper = feegrantkeeper.NewKeeper(appCodec, keys[feegrant.StoreKey], app.AccountKeeper)
 	app.UpgradeKeeper = upgradekeeper.NewKeeper(skipUpgradeHeights, keys[upgradetypes.StoreKey], appCodec, homePath, app.BaseApp, authtypes.NewModuleAddress(govtypes.ModuleName).String())
 
-	// register the staking hooks
-	// NOTE: stakingKeeper above is passed by reference, so that it will contain these hooks
-	app.StakingKeeper.SetHooks(
-		stakingtypes.NewMultiStakingHooks(app.DistrKeeper.Hooks(), app.ClaimKeeper.Hooks()),
-	)
-
-	// Add airdrop keeper
+	// The stakeibc keeper is passed by reference since it's instantiated after the airdrop keeper
+	// Note: Must be above the staking and stakeibc hooks
 	app.AirdropKeeper = airdropkeeper.NewKeeper(
 		appCodec,
 		keys[airdroptypes.StoreKey],
+		app.AccountKeeper,
 		app.BankKeeper,
+		app.DistrKeeper,
+		&app.StakeibcKeeper,
 	)
 	airdropModule := airdrop.NewAppModule(appCodec, app.AirdropKeeper)
 
+	// register the staking hooks
+	// NOTE: stakingKeeper above is passed by reference, so that it will contain these hooks
+	app.StakingKeeper.SetHooks(
+		stakingtypes.NewMultiStakingHooks(app.DistrKeeper.Hooks(), app.ClaimKeeper.Hooks(), app.AirdropKeeper.Hooks()),
+	)
+
 	// Add ICS Consumer Keeper
 	app.ConsumerKeeper = ccvconsumerkeeper.NewNonZeroKeeper(
 		appCodec,
@@ -565,7 +579,7 @@ func NewStrideApp(
 
 	// Add wasm keeper and wasm client keeper (must be after IBCKeeper and TransferKeeper)
 	wasmContractMemoryLimit := uint32(32)
-	wasmCapabilities := "iterator,staking,stargate,cosmwasm_1_1,cosmwasm_1_2,cosmwasm_1_3,cosmwasm_1_4"
+	wasmCapabilities := "iterator,staking,stargate,cosmwasm_1_1,cosmwasm_1_2,cosmwasm_1_3,cosmwasm_1_4," + wasmbinding.StrideCapability
 	wasmDir := filepath.Join(homePath, "wasm")
 	wasmVmDir := filepath.Join(homePath, "wasm", "wasm")
 	wasmConfig, err := wasm.ReadWasmConfig(appOpts)
@@ -585,6 +599,20 @@ func NewStrideApp(
 	}
 	wasmOpts = append(wasmOpts, wasmkeeper.WithWasmEngine(wasmer))
 
+	// Register the custom stride bindings
+	// The keepers are passed by reference since they're instantiated after the wasm keeper
+	wasmOpts = append(wasmOpts, wasmbinding.RegisterCustomPlugins(
+		&app.StakeibcKeeper,
+		&app.RecordsKeeper,
+		&app.StaketiaKeeper,
+		&app.StakedymKeeper,
+		&app.EpochsKeeper,
+		&app.AutopilotKeeper,
+	)...)
+
+	// Restrict contract stargate queries to the deterministic whitelist
+	wasmOpts = append(wasmOpts, wasmbinding.RegisterStargateQueries(app.GRPCQueryRouter(), appCodec)...)
+
 	scopedWasmKeeper := app.CapabilityKeeper.ScopeToModule(wasmtypes.ModuleName)
 	app.WasmKeeper = wasmkeeper.NewKeeper(
 		appCodec,
@@ -663,8 +691,18 @@ func NewStrideApp(
 		*app.IBCKeeper,
 	)
 
-	app.InterchainqueryKeeper = interchainquerykeeper.NewKeeper(appCodec, keys[interchainquerytypes.StoreKey], app.IBCKeeper)
+	scopedInterchainqueryKeeper := app.CapabilityKeeper.ScopeToModule(interchainquerytypes.ModuleName)
+	app.ScopedInterchainqueryKeeper = scopedInterchainqueryKeeper
+	app.InterchainqueryKeeper = interchainquerykeeper.NewKeeper(
+		appCodec,
+		keys[interchainquerytypes.StoreKey],
+		app.IBCKeeper,
+		scopedInterchainqueryKeeper,
+		app.BankKeeper,
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+	)
 	interchainQueryModule := interchainquery.NewAppModule(appCodec, app.InterchainqueryKeeper)
+	asyncICQIBCModule := interchainquery.NewIBCModule(app.InterchainqueryKeeper)
 
 	app.RecordsKeeper = *recordsmodulekeeper.NewKeeper(
 		appCodec,
@@ -679,19 +717,24 @@ func NewStrideApp(
 	recordsModule := recordsmodule.NewAppModule(appCodec, app.RecordsKeeper, app.AccountKeeper, app.BankKeeper)
 
 	// Note: Must be above stakeibc keeper
+	scopedICAOracleKeeper := app.CapabilityKeeper.ScopeToModule(icaoracletypes.ModuleName)
+	app.ScopedICAOracleKeeper = scopedICAOracleKeeper
 	app.ICAOracleKeeper = *icaoraclekeeper.NewKeeper(
 		appCodec,
 		keys[icaoracletypes.StoreKey],
 		app.GetSubspace(icaoracletypes.ModuleName),
 		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+		scopedICAOracleKeeper,
 		app.IBCKeeper.ChannelKeeper, // ICS4Wrapper - Note: this technically should be ICAController but it doesn't implement ICS4
 		app.IBCKeeper.ClientKeeper,
 		app.IBCKeeper.ConnectionKeeper,
 		app.IBCKeeper.ChannelKeeper,
+		&app.IBCKeeper.PortKeeper,
 		app.ICAControllerKeeper,
 		app.IcacallbacksKeeper,
 	)
 	icaoracleModule := icaoracle.NewAppModule(appCodec, app.ICAOracleKeeper)
+	oracleIBCModule := icaoracle.NewIBCModule(app.ICAOracleKeeper)
 
 	stakeibcKeeper := stakeibcmodulekeeper.NewKeeper(
 		appCodec,
@@ -712,7 +755,7 @@ func NewStrideApp(
 		app.ConsumerKeeper,
 	)
 	app.StakeibcKeeper = *stakeibcKeeper.SetHooks(
-		stakeibcmoduletypes.NewMultiStakeIBCHooks(app.ClaimKeeper.Hooks()),
+		stakeibcmoduletypes.NewMultiStakeIBCHooks(app.ClaimKeeper.Hooks(), app.AirdropKeeper.Hooks()),
 	)
 
 	stakeibcModule := stakeibcmodule.NewAppModule(appCodec, app.StakeibcKeeper, app.AccountKeeper, app.BankKeeper)
@@ -721,10 +764,13 @@ func NewStrideApp(
 		appCodec,
 		keys[autopilottypes.StoreKey],
 		app.GetSubspace(autopilottypes.ModuleName),
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 		app.BankKeeper,
 		app.StakeibcKeeper,
 		app.ClaimKeeper,
 		app.TransferKeeper,
+		app.ContractKeeper,
+		app.IcacallbacksKeeper,
 	)
 	autopilotModule := autopilot.NewAppModule(appCodec, app.AutopilotKeeper)
 
@@ -737,6 +783,8 @@ func NewStrideApp(
 		app.ICAOracleKeeper,
 		app.RatelimitKeeper,
 		app.TransferKeeper,
+		&app.InterchainqueryKeeper,
+		&app.EpochsKeeper,
 	)
 	stakeTiaModule := staketia.NewAppModule(appCodec, app.StaketiaKeeper)
 
@@ -749,6 +797,8 @@ func NewStrideApp(
 		app.ICAOracleKeeper,
 		app.RatelimitKeeper,
 		app.TransferKeeper,
+		&app.InterchainqueryKeeper,
+		&app.EpochsKeeper,
 	)
 	stakeDymModule := stakedym.NewAppModule(appCodec, app.StakedymKeeper)
 
@@ -778,6 +828,14 @@ func NewStrideApp(
 	if err != nil {
 		return nil
 	}
+	err = app.InterchainqueryKeeper.SetCallbackHandler(staketiatypes.ModuleName, app.StaketiaKeeper.ICQCallbackHandler())
+	if err != nil {
+		return nil
+	}
+	err = app.InterchainqueryKeeper.SetCallbackHandler(stakedymtypes.ModuleName, app.StakedymKeeper.ICQCallbackHandler())
+	if err != nil {
+		return nil
+	}
 
 	app.EpochsKeeper = *epochsKeeper.SetHooks(
 		epochsmoduletypes.NewMultiEpochHooks(
@@ -798,10 +856,19 @@ func NewStrideApp(
 		app.StakeibcKeeper.Callbacks(),
 		app.RecordsKeeper.Callbacks(),
 		app.ICAOracleKeeper.Callbacks(),
+		app.AutopilotKeeper.Callbacks(),
 	); err != nil {
 		return nil
 	}
 
+	// Register oracle metric producers
+	metricProducers := app.StakeibcKeeper.OracleMetricProducers()
+	metricProducers = append(metricProducers, app.StaketiaKeeper.OracleMetricProducers()...)
+	metricProducers = append(metricProducers, app.StakedymKeeper.OracleMetricProducers()...)
+	if err := app.ICAOracleKeeper.RegisterMetricProducers(metricProducers...); err != nil {
+		return nil
+	}
+
 	// create IBC middleware stacks by combining middleware with base application
 	app.ICAHostKeeper = icahostkeeper.NewKeeper(
 		appCodec,
@@ -876,7 +943,11 @@ func NewStrideApp(
 		// Transfer stack
 		AddRoute(ibctransfertypes.ModuleName, transferStack).
 		// Consumer stack
-		AddRoute(ccvconsumertypes.ModuleName, consumerModule)
+		AddRoute(ccvconsumertypes.ModuleName, consumerModule).
+		// Async ICQ stack
+		AddRoute(interchainquerytypes.PortID, asyncICQIBCModule).
+		// IBC oracle stack
+		AddRoute(icaoracletypes.PortID, oracleIBCModule)
 
 	app.IBCKeeper.SetRouter(ibcRouter)
 
@@ -1240,7 +1311,8 @@ func (app *StrideApp) BlacklistedModuleAccountAddrs() map[string]bool {
 			acc == staketiatypes.ModuleName ||
 			acc == staketiatypes.FeeAddress ||
 			acc == stakedymtypes.ModuleName ||
-			acc == stakedymtypes.FeeAddress {
+			acc == stakedymtypes.FeeAddress ||
+			acc == interchainquerytypes.RelayerFeePoolName {
 			continue
 		}
 		modAccAddrs[authtypes.NewModuleAddress(acc).String()] = true
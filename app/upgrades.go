@@ -34,6 +34,7 @@ import (
 	v22 "github.com/Stride-Labs/stride/v24/app/upgrades/v22"
 	v23 "github.com/Stride-Labs/stride/v24/app/upgrades/v23"
 	v24 "github.com/Stride-Labs/stride/v24/app/upgrades/v24"
+	v25 "github.com/Stride-Labs/stride/v24/app/upgrades/v25"
 	v3 "github.com/Stride-Labs/stride/v24/app/upgrades/v3"
 	v4 "github.com/Stride-Labs/stride/v24/app/upgrades/v4"
 	v5 "github.com/Stride-Labs/stride/v24/app/upgrades/v5"
@@ -324,6 +325,22 @@ func (app *StrideApp) setupUpgradeHandlers(appOpts servertypes.AppOptions) {
 		),
 	)
 
+	// v25 upgrade handler
+	app.UpgradeKeeper.SetUpgradeHandler(
+		v25.UpgradeName,
+		v25.CreateUpgradeHandler(
+			app.mm,
+			app.configurator,
+			app.AirdropKeeper,
+			app.AutopilotKeeper,
+			app.BankKeeper,
+			app.ClaimKeeper,
+			app.ICAOracleKeeper,
+			app.InterchainqueryKeeper,
+			app.MintKeeper,
+		),
+	)
+
 	upgradeInfo, err := app.UpgradeKeeper.ReadUpgradeInfoFromDisk()
 	if err != nil {
 		panic(fmt.Errorf("Failed to read upgrade info from disk: %w", err))
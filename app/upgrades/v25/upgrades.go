@@ -0,0 +1,256 @@
+package v25
+
+import (
+	"fmt"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+
+	airdropkeeper "github.com/Stride-Labs/stride/v24/x/airdrop/keeper"
+	airdroptypes "github.com/Stride-Labs/stride/v24/x/airdrop/types"
+	autopilotkeeper "github.com/Stride-Labs/stride/v24/x/autopilot/keeper"
+	autopilottypes "github.com/Stride-Labs/stride/v24/x/autopilot/types"
+	claimkeeper "github.com/Stride-Labs/stride/v24/x/claim/keeper"
+	claimtypes "github.com/Stride-Labs/stride/v24/x/claim/types"
+	icaoraclekeeper "github.com/Stride-Labs/stride/v24/x/icaoracle/keeper"
+	icqkeeper "github.com/Stride-Labs/stride/v24/x/interchainquery/keeper"
+	mintkeeper "github.com/Stride-Labs/stride/v24/x/mint/keeper"
+	minttypes "github.com/Stride-Labs/stride/v24/x/mint/types"
+)
+
+var (
+	UpgradeName = "v25"
+
+	// Each legacy claim airdrop is split into one x/airdrop airdrop per action,
+	// with the action's share of the remaining rewards
+	ActionMigrations = []ActionMigration{
+		{
+			AirdropIdSuffix: "free",
+			Action:          claimtypes.ACTION_FREE,
+			Percentage:      claimtypes.PercentageForFree,
+			ClaimCondition:  airdroptypes.CLAIM_CONDITION_NONE,
+		},
+		{
+			AirdropIdSuffix: "liquid-stake",
+			Action:          claimtypes.ACTION_LIQUID_STAKE,
+			Percentage:      claimtypes.PercentageForLiquidStake,
+			ClaimCondition:  airdroptypes.CLAIM_CONDITION_LIQUID_STAKE,
+		},
+		{
+			AirdropIdSuffix: "delegate-stake",
+			Action:          claimtypes.ACTION_DELEGATE_STAKE,
+			Percentage:      claimtypes.PercentageForStake,
+			ClaimCondition:  airdroptypes.CLAIM_CONDITION_DELEGATE_STAKE,
+		},
+	}
+)
+
+// Mapping from a legacy claim action to the x/airdrop airdrop that replaces it
+type ActionMigration struct {
+	AirdropIdSuffix string
+	Action          claimtypes.Action
+	Percentage      sdk.Dec
+	ClaimCondition  airdroptypes.ClaimCondition
+}
+
+// CreateUpgradeHandler creates an SDK upgrade handler for v25
+func CreateUpgradeHandler(
+	mm *module.Manager,
+	configurator module.Configurator,
+	airdropKeeper airdropkeeper.Keeper,
+	autopilotKeeper autopilotkeeper.Keeper,
+	bankKeeper bankkeeper.Keeper,
+	claimKeeper claimkeeper.Keeper,
+	icaoracleKeeper icaoraclekeeper.Keeper,
+	icqKeeper icqkeeper.Keeper,
+	mintKeeper mintkeeper.Keeper,
+) upgradetypes.UpgradeHandler {
+	return func(ctx sdk.Context, _ upgradetypes.Plan, vm module.VersionMap) (module.VersionMap, error) {
+		ctx.Logger().Info("Starting upgrade v25...")
+
+		if err := MigrateClaimAirdrops(ctx, airdropKeeper, bankKeeper, claimKeeper); err != nil {
+			return vm, errorsmod.Wrapf(err, "unable to migrate claim airdrops")
+		}
+
+		// Add the new inflation schedule param, keeping the existing step reduction
+		ctx.Logger().Info("Adding mint inflation schedule...")
+		mintKeeper.SetInflationSchedule(ctx, minttypes.DefaultInflationSchedule())
+
+		// Replace the fixed distribution proportions with the equivalent weighted recipients
+		ctx.Logger().Info("Migrating mint distribution proportions to recipients...")
+		distributionProportions := mintKeeper.GetDistributionProportions(ctx)
+		mintKeeper.SetDistributionRecipients(ctx, minttypes.RecipientsFromProportions(distributionProportions))
+
+		// Add the gas limit param for contract transfer callbacks
+		ctx.Logger().Info("Adding autopilot contract callback gas limit...")
+		autopilotKeeper.SetContractCallbackGasLimit(ctx, autopilottypes.DefaultContractCallbackGasLimit)
+
+		// Bind the icaoracle port so that IBC oracle channels can be opened
+		ctx.Logger().Info("Binding icaoracle port...")
+		if err := icaoracleKeeper.BindPort(ctx); err != nil {
+			return vm, errorsmod.Wrapf(err, "unable to bind icaoracle port")
+		}
+
+		// Bind the icqcontroller port so that async-icq channels can be opened
+		ctx.Logger().Info("Binding icqcontroller port...")
+		if err := icqKeeper.BindPort(ctx); err != nil {
+			return vm, errorsmod.Wrapf(err, "unable to bind icqcontroller port")
+		}
+
+		ctx.Logger().Info("Running module migrations...")
+		return mm.RunMigrations(ctx, configurator, vm)
+	}
+}
+
+// Migrates each live x/claim airdrop into x/airdrop and removes the legacy airdrop
+// Each legacy airdrop is split into an airdrop per action (see ActionMigrations), and each
+// claim record is converted into a user allocation with the user's weighted share of the
+// airdrop's rewards for each action they have not yet completed
+// Legacy airdrops that end before the first migrated period would finish are left in x/claim
+// to expire on their own
+// Existing vesting accounts from legacy claims are left as is, since x/airdrop uses the same
+// vesting account type
+func MigrateClaimAirdrops(
+	ctx sdk.Context,
+	airdropKeeper airdropkeeper.Keeper,
+	bankKeeper bankkeeper.Keeper,
+	claimKeeper claimkeeper.Keeper,
+) error {
+	ctx.Logger().Info("Migrating claim airdrops...")
+
+	params, err := claimKeeper.GetParams(ctx)
+	if err != nil {
+		return errorsmod.Wrapf(err, "unable to get claim params")
+	}
+
+	// The legacy airdrops are only removed after every airdrop has been migrated, since the
+	// claim records are keyed by airdrop ID prefix
+	migratedAirdropIds := []string{}
+	for _, legacyAirdrop := range params.Airdrops {
+		migrated, err := MigrateClaimAirdrop(ctx, airdropKeeper, bankKeeper, claimKeeper, *legacyAirdrop)
+		if err != nil {
+			return errorsmod.Wrapf(err, "unable to migrate airdrop %s", legacyAirdrop.AirdropIdentifier)
+		}
+		if migrated {
+			migratedAirdropIds = append(migratedAirdropIds, legacyAirdrop.AirdropIdentifier)
+		}
+	}
+
+	for _, legacyAirdropId := range migratedAirdropIds {
+		if err := claimKeeper.EndAirdrop(ctx, legacyAirdropId); err != nil {
+			return errorsmod.Wrapf(err, "unable to remove legacy airdrop %s", legacyAirdropId)
+		}
+	}
+
+	return nil
+}
+
+// Creates the x/airdrop airdrops and user allocations for a single legacy claim airdrop
+// Each user's allocation matches what x/claim would have paid out for each uncompleted action,
+// where the reward pool is the distributor's remaining balance plus what was already claimed
+// The legacy airdrops had no decay - the full amount could be claimed at any point until the
+// airdrop's end date - so the rewards are allocated to the first period of the new airdrop,
+// and can be claimed (per the claim condition) until the legacy airdrop's end date
+// Returns false if the airdrop was not migrated
+func MigrateClaimAirdrop(
+	ctx sdk.Context,
+	airdropKeeper airdropkeeper.Keeper,
+	bankKeeper bankkeeper.Keeper,
+	claimKeeper claimkeeper.Keeper,
+	legacyAirdrop claimtypes.Airdrop,
+) (migrated bool, err error) {
+	legacyAirdropId := legacyAirdrop.AirdropIdentifier
+
+	distributionStartDate := ctx.BlockTime()
+	if legacyAirdrop.AirdropStartTime.After(distributionStartDate) {
+		distributionStartDate = legacyAirdrop.AirdropStartTime
+	}
+	periodLength := time.Duration(airdropKeeper.GetParams(ctx).PeriodLengthSeconds) * time.Second
+	distributionEndDate := distributionStartDate.Add(periodLength)
+	claimTypeDeadlineDate := distributionEndDate.Add(-1 * time.Second)
+	clawbackDate := legacyAirdrop.AirdropStartTime.Add(legacyAirdrop.AirdropDuration)
+
+	if !clawbackDate.After(distributionEndDate) {
+		ctx.Logger().Info(fmt.Sprintf("Skipping claim airdrop %s since it ends at %s", legacyAirdropId, clawbackDate))
+		return false, nil
+	}
+
+	totalWeight, err := claimKeeper.GetTotalWeight(ctx, legacyAirdropId)
+	if err != nil {
+		return false, err
+	}
+	if !totalWeight.IsPositive() {
+		ctx.Logger().Info(fmt.Sprintf("Skipping claim airdrop %s since it has no claim records", legacyAirdropId))
+		return false, nil
+	}
+
+	distributorAddress, err := sdk.AccAddressFromBech32(legacyAirdrop.DistributorAddress)
+	if err != nil {
+		return false, errorsmod.Wrapf(err, "invalid distributor address")
+	}
+	remainingRewards := bankKeeper.GetBalance(ctx, distributorAddress, legacyAirdrop.ClaimDenom).Amount
+	totalRewards := remainingRewards.Add(legacyAirdrop.ClaimedSoFar)
+	claimRecords := claimKeeper.GetClaimRecords(ctx, legacyAirdropId)
+
+	for _, actionMigration := range ActionMigrations {
+		airdropId := fmt.Sprintf("%s-%s", legacyAirdropId, actionMigration.AirdropIdSuffix)
+		if _, found := airdropKeeper.GetAirdrop(ctx, airdropId); found {
+			return false, airdroptypes.ErrAirdropAlreadyExists.Wrapf("airdrop %s", airdropId)
+		}
+
+		// The distributor is kept the same so that the rewards don't need to move, and since the
+		// legacy airdrops had no admins, the distributor is also used as the allocator and linker
+		airdropKeeper.SetAirdrop(ctx, airdroptypes.Airdrop{
+			Id:                    airdropId,
+			RewardDenom:           legacyAirdrop.ClaimDenom,
+			DistributionStartDate: &distributionStartDate,
+			DistributionEndDate:   &distributionEndDate,
+			ClawbackDate:          &clawbackDate,
+			ClaimTypeDeadlineDate: &claimTypeDeadlineDate,
+			EarlyClaimPenalty:     sdk.ZeroDec(),
+			DistributorAddress:    legacyAirdrop.DistributorAddress,
+			AllocatorAddress:      legacyAirdrop.DistributorAddress,
+			LinkerAddress:         legacyAirdrop.DistributorAddress,
+			ClaimAndStakeBonus:    sdk.ZeroDec(),
+			ClaimCondition:        actionMigration.ClaimCondition,
+		})
+
+		actionRewards := sdk.NewDecFromInt(totalRewards).Mul(actionMigration.Percentage)
+		for _, claimRecord := range claimRecords {
+			// Since the claim records are keyed by an airdrop ID prefix, records from other
+			// airdrops with overlapping IDs must be filtered out
+			if claimRecord.AirdropIdentifier != legacyAirdropId {
+				continue
+			}
+
+			// Actions that were already completed were paid out from x/claim
+			actionIndex := int(actionMigration.Action)
+			if actionIndex < len(claimRecord.ActionCompleted) && claimRecord.ActionCompleted[actionIndex] {
+				continue
+			}
+
+			userRewards := actionRewards.Mul(claimRecord.Weight).Quo(totalWeight).TruncateInt()
+			if userRewards.IsZero() {
+				continue
+			}
+
+			airdropKeeper.SetUserAllocation(ctx, airdroptypes.UserAllocation{
+				AirdropId:    airdropId,
+				Address:      claimRecord.Address,
+				Claimed:      sdkmath.ZeroInt(),
+				Forfeited:    sdkmath.ZeroInt(),
+				Staked:       sdkmath.ZeroInt(),
+				StakingBonus: sdkmath.ZeroInt(),
+				Vested:       sdkmath.ZeroInt(),
+				Allocations:  []sdkmath.Int{userRewards, sdkmath.ZeroInt()},
+			})
+		}
+	}
+
+	return true, nil
+}
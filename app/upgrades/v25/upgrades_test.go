@@ -0,0 +1,282 @@
+package v25_test
+
+import (
+	"testing"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	capabilitykeeper "github.com/cosmos/cosmos-sdk/x/capability/keeper"
+	host "github.com/cosmos/ibc-go/v7/modules/core/24-host"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/Stride-Labs/stride/v24/app/apptesting"
+	v25 "github.com/Stride-Labs/stride/v24/app/upgrades/v25"
+	airdroptypes "github.com/Stride-Labs/stride/v24/x/airdrop/types"
+	autopilottypes "github.com/Stride-Labs/stride/v24/x/autopilot/types"
+	claimtypes "github.com/Stride-Labs/stride/v24/x/claim/types"
+	epochstypes "github.com/Stride-Labs/stride/v24/x/epochs/types"
+	icaoracletypes "github.com/Stride-Labs/stride/v24/x/icaoracle/types"
+	icqtypes "github.com/Stride-Labs/stride/v24/x/interchainquery/types"
+	minttypes "github.com/Stride-Labs/stride/v24/x/mint/types"
+)
+
+type UpgradeTestSuite struct {
+	apptesting.AppTestHelper
+}
+
+func (s *UpgradeTestSuite) SetupTest() {
+	s.Setup()
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(UpgradeTestSuite))
+}
+
+// Releases a port that was bound at genesis, to mock a live chain where the port
+// was never bound
+func (s *UpgradeTestSuite) unbindPort(scopedModuleKeeper capabilitykeeper.ScopedKeeper, portId string) {
+	portPath := host.PortPath(portId)
+	capability, found := scopedModuleKeeper.GetCapability(s.Ctx, portPath)
+	s.Require().True(found, "port %s should be bound at genesis", portId)
+
+	err := scopedModuleKeeper.ReleaseCapability(s.Ctx, capability)
+	s.Require().NoError(err, "no error expected when releasing module's %s port capability", portId)
+	err = s.App.ScopedIBCKeeper.ReleaseCapability(s.Ctx, capability)
+	s.Require().NoError(err, "no error expected when releasing IBC's %s port capability", portId)
+}
+
+func (s *UpgradeTestSuite) TestUpgrade() {
+	upgradeHeight := int64(4)
+	upgradeTime := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+	s.Ctx = s.Ctx.WithBlockTime(upgradeTime)
+
+	// Create one airdrop that should be migrated and one that's about to end
+	liveAirdropId := "live"
+	endingAirdropId := "ending"
+
+	liveStartTime := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	liveDuration := time.Hour * 24 * 365
+	endingStartTime := time.Date(2023, 6, 1, 0, 0, 0, 0, time.UTC)
+	endingDuration := upgradeTime.Sub(endingStartTime) + time.Hour
+
+	liveDistributor := s.TestAccs[0]
+	endingDistributor := s.TestAccs[1]
+	s.FundAccount(liveDistributor, sdk.NewInt64Coin("ustrd", 10000))
+
+	err := s.App.ClaimKeeper.SetParams(s.Ctx, claimtypes.Params{
+		Airdrops: []*claimtypes.Airdrop{
+			{
+				AirdropIdentifier:  liveAirdropId,
+				ChainId:            "chain-0",
+				AirdropStartTime:   liveStartTime,
+				AirdropDuration:    liveDuration,
+				ClaimDenom:         "ustrd",
+				DistributorAddress: liveDistributor.String(),
+				ClaimedSoFar:       sdkmath.ZeroInt(),
+			},
+			{
+				AirdropIdentifier:  endingAirdropId,
+				ChainId:            "chain-1",
+				AirdropStartTime:   endingStartTime,
+				AirdropDuration:    endingDuration,
+				ClaimDenom:         "ustrd",
+				DistributorAddress: endingDistributor.String(),
+				ClaimedSoFar:       sdkmath.ZeroInt(),
+			},
+		},
+	})
+	s.Require().NoError(err, "no error expected when setting claim params")
+
+	for _, airdropId := range []string{liveAirdropId, endingAirdropId} {
+		s.App.EpochsKeeper.SetEpochInfo(s.Ctx, epochstypes.EpochInfo{Identifier: "airdrop-" + airdropId})
+	}
+
+	// Add claim records with weights for each airdrop
+	users := apptesting.CreateRandomAccounts(3)
+	weights := []sdk.Dec{sdk.MustNewDecFromStr("0.5"), sdk.MustNewDecFromStr("0.25"), sdk.MustNewDecFromStr("0.25")}
+	claimRecords := []claimtypes.ClaimRecord{}
+	for _, airdropId := range []string{liveAirdropId, endingAirdropId} {
+		for i, user := range users {
+			claimRecords = append(claimRecords, claimtypes.ClaimRecord{
+				AirdropIdentifier: airdropId,
+				Address:           user.String(),
+				Weight:            weights[i],
+				ActionCompleted:   []bool{false, false, false},
+			})
+		}
+	}
+	err = s.App.ClaimKeeper.SetClaimRecordsWithWeights(s.Ctx, claimRecords)
+	s.Require().NoError(err, "no error expected when setting claim records")
+
+	// Unbind the icaoracle and icqcontroller ports, which were added after the chain launched
+	s.unbindPort(s.App.ScopedICAOracleKeeper, icaoracletypes.PortID)
+	s.unbindPort(s.App.ScopedInterchainqueryKeeper, icqtypes.PortID)
+	s.Require().False(s.App.ICAOracleKeeper.IsBound(s.Ctx), "icaoracle port should not be bound before the upgrade")
+	s.Require().False(s.App.InterchainqueryKeeper.IsBound(s.Ctx), "icqcontroller port should not be bound before the upgrade")
+
+	// Overwrite the autopilot callback gas limit, since the param is already set at genesis
+	s.App.AutopilotKeeper.SetContractCallbackGasLimit(s.Ctx, 1)
+
+	// Run the upgrade
+	s.ConfirmUpgradeSucceededs(v25.UpgradeName, upgradeHeight)
+
+	// Confirm an airdrop was created for each action
+	// The live distributor has 10000 remaining rewards, which is split 20/60/20 across the
+	// free, liquid stake, and delegate stake airdrops
+	expectedAirdrops := []struct {
+		airdropId          string
+		condition          airdroptypes.ClaimCondition
+		expectedUserReward []int64
+	}{
+		{airdropId: "live-free", condition: airdroptypes.CLAIM_CONDITION_NONE, expectedUserReward: []int64{1000, 500, 500}},
+		{airdropId: "live-liquid-stake", condition: airdroptypes.CLAIM_CONDITION_LIQUID_STAKE, expectedUserReward: []int64{3000, 1500, 1500}},
+		{airdropId: "live-delegate-stake", condition: airdroptypes.CLAIM_CONDITION_DELEGATE_STAKE, expectedUserReward: []int64{1000, 500, 500}},
+	}
+	for _, expected := range expectedAirdrops {
+		airdrop, found := s.App.AirdropKeeper.GetAirdrop(s.Ctx, expected.airdropId)
+		s.Require().True(found, "airdrop %s should have been created", expected.airdropId)
+
+		s.Require().Equal(expected.condition, airdrop.ClaimCondition, "%s claim condition", expected.airdropId)
+		s.Require().Equal("ustrd", airdrop.RewardDenom, "%s reward denom", expected.airdropId)
+		s.Require().Equal(liveDistributor.String(), airdrop.DistributorAddress, "%s distributor", expected.airdropId)
+		s.Require().Equal(upgradeTime, *airdrop.DistributionStartDate, "%s distribution start", expected.airdropId)
+		s.Require().Equal(liveStartTime.Add(liveDuration), *airdrop.ClawbackDate, "%s clawback date", expected.airdropId)
+
+		for i, user := range users {
+			userAllocation, found := s.App.AirdropKeeper.GetUserAllocation(s.Ctx, expected.airdropId, user.String())
+			s.Require().True(found, "user %d allocation should have been created for %s", i, expected.airdropId)
+
+			expectedAllocations := []sdkmath.Int{sdkmath.NewInt(expected.expectedUserReward[i]), sdkmath.ZeroInt()}
+			s.Require().Equal(expectedAllocations, userAllocation.Allocations, "user %d allocations for %s", i, expected.airdropId)
+		}
+	}
+
+	// Confirm the live legacy airdrop was removed along with its epoch and claim records
+	s.Require().Nil(s.App.ClaimKeeper.GetAirdropByIdentifier(s.Ctx, liveAirdropId), "live legacy airdrop should be removed")
+	s.Require().Empty(s.App.ClaimKeeper.GetClaimRecords(s.Ctx, liveAirdropId), "live legacy claim records should be removed")
+	_, found := s.App.EpochsKeeper.GetEpochInfo(s.Ctx, "airdrop-"+liveAirdropId)
+	s.Require().False(found, "live legacy epoch should be removed")
+
+	// Confirm the airdrop that was about to end was left in x/claim
+	s.Require().NotNil(s.App.ClaimKeeper.GetAirdropByIdentifier(s.Ctx, endingAirdropId), "ending legacy airdrop should remain")
+	s.Require().Len(s.App.ClaimKeeper.GetClaimRecords(s.Ctx, endingAirdropId), 3, "ending legacy claim records should remain")
+	_, found = s.App.AirdropKeeper.GetAirdrop(s.Ctx, endingAirdropId+"-free")
+	s.Require().False(found, "ending airdrop should not be migrated")
+
+	// Confirm the mint inflation schedule was added with the step reduction
+	mintParams := s.App.MintKeeper.GetParams(s.Ctx)
+	s.Require().Equal(minttypes.DefaultInflationSchedule(), mintParams.InflationSchedule, "mint inflation schedule")
+
+	// Confirm the mint distribution proportions were migrated to recipients
+	expectedRecipients := minttypes.RecipientsFromProportions(mintParams.DistributionProportions)
+	s.Require().Equal(expectedRecipients, mintParams.DistributionRecipients, "mint distribution recipients")
+
+	// Confirm the autopilot contract callback gas limit was added
+	autopilotParams := s.App.AutopilotKeeper.GetParams(s.Ctx)
+	s.Require().Equal(autopilottypes.DefaultContractCallbackGasLimit, autopilotParams.ContractCallbackGasLimit, "autopilot callback gas limit")
+
+	// Confirm the icaoracle and icqcontroller ports were bound
+	s.Require().True(s.App.ICAOracleKeeper.IsBound(s.Ctx), "icaoracle port should be bound")
+	s.Require().True(s.App.InterchainqueryKeeper.IsBound(s.Ctx), "icqcontroller port should be bound")
+}
+
+func (s *UpgradeTestSuite) TestMigrateClaimAirdrops_AirdropAlreadyExists() {
+	s.Ctx = s.Ctx.WithBlockTime(time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC))
+
+	distributor := s.TestAccs[0]
+	err := s.App.ClaimKeeper.SetParams(s.Ctx, claimtypes.Params{
+		Airdrops: []*claimtypes.Airdrop{{
+			AirdropIdentifier:  "live",
+			AirdropStartTime:   time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+			AirdropDuration:    time.Hour * 24 * 365,
+			ClaimDenom:         "ustrd",
+			DistributorAddress: distributor.String(),
+			ClaimedSoFar:       sdkmath.ZeroInt(),
+		}},
+	})
+	s.Require().NoError(err, "no error expected when setting claim params")
+	s.App.ClaimKeeper.SetTotalWeight(s.Ctx, sdk.OneDec(), "live")
+
+	// Create an x/airdrop airdrop with a conflicting ID
+	s.App.AirdropKeeper.SetAirdrop(s.Ctx, airdroptypes.Airdrop{Id: "live-liquid-stake"})
+
+	err = v25.MigrateClaimAirdrops(s.Ctx, s.App.AirdropKeeper, s.App.BankKeeper, s.App.ClaimKeeper)
+	s.Require().ErrorContains(err, "airdrop already exists")
+}
+
+func (s *UpgradeTestSuite) TestMigrateClaimAirdrop_PartiallyClaimed() {
+	s.Ctx = s.Ctx.WithBlockTime(time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC))
+
+	// The airdrop started with 10000 rewards, 3000 of which have already been claimed
+	// User 0 claimed the free action (1000), and user 1 claimed the free (500) and liquid stake (1500) actions
+	airdropId := "partial"
+	distributor := s.TestAccs[0]
+	s.FundAccount(distributor, sdk.NewInt64Coin("ustrd", 7000))
+
+	legacyAirdrop := claimtypes.Airdrop{
+		AirdropIdentifier:  airdropId,
+		ChainId:            "chain-0",
+		AirdropStartTime:   time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+		AirdropDuration:    time.Hour * 24 * 365,
+		ClaimDenom:         "ustrd",
+		DistributorAddress: distributor.String(),
+		ClaimedSoFar:       sdkmath.NewInt(3000),
+	}
+	err := s.App.ClaimKeeper.SetParams(s.Ctx, claimtypes.Params{Airdrops: []*claimtypes.Airdrop{&legacyAirdrop}})
+	s.Require().NoError(err, "no error expected when setting claim params")
+
+	users := apptesting.CreateRandomAccounts(3)
+	weights := []sdk.Dec{sdk.MustNewDecFromStr("0.5"), sdk.MustNewDecFromStr("0.25"), sdk.MustNewDecFromStr("0.25")}
+	actionsCompleted := [][]bool{{true, false, false}, {true, true, false}, {false, false, false}}
+	claimRecords := []claimtypes.ClaimRecord{}
+	for i, user := range users {
+		claimRecords = append(claimRecords, claimtypes.ClaimRecord{
+			AirdropIdentifier: airdropId,
+			Address:           user.String(),
+			Weight:            weights[i],
+			ActionCompleted:   actionsCompleted[i],
+		})
+	}
+	err = s.App.ClaimKeeper.SetClaimRecordsWithWeights(s.Ctx, claimRecords)
+	s.Require().NoError(err, "no error expected when setting claim records")
+
+	// Snapshot the amounts that would have been claimable from x/claim
+	legacyClaimable := map[string]sdkmath.Int{}
+	for _, actionMigration := range v25.ActionMigrations {
+		for _, user := range users {
+			claimable, err := s.App.ClaimKeeper.GetClaimableAmountForAction(s.Ctx, user, actionMigration.Action, airdropId, false)
+			s.Require().NoError(err, "no error expected when getting legacy claimable amount")
+			legacyClaimable[actionMigration.AirdropIdSuffix+user.String()] = claimable.AmountOf("ustrd")
+		}
+	}
+
+	migrated, err := v25.MigrateClaimAirdrop(s.Ctx, s.App.AirdropKeeper, s.App.BankKeeper, s.App.ClaimKeeper, legacyAirdrop)
+	s.Require().NoError(err, "no error expected when migrating airdrop")
+	s.Require().True(migrated, "airdrop should have been migrated")
+
+	// Completed actions should not receive an allocation, and uncompleted actions should
+	// receive their share of the full 10000 rewards
+	expectedUserRewards := map[string][]int64{
+		"free":           {0, 0, 500},
+		"liquid-stake":   {3000, 0, 1500},
+		"delegate-stake": {1000, 500, 500},
+	}
+	for suffix, expectedRewards := range expectedUserRewards {
+		newAirdropId := airdropId + "-" + suffix
+		for i, user := range users {
+			userAllocation, found := s.App.AirdropKeeper.GetUserAllocation(s.Ctx, newAirdropId, user.String())
+			s.Require().Equal(legacyClaimable[suffix+user.String()].Int64(), expectedRewards[i],
+				"user %d legacy claimable amount for %s", i, newAirdropId)
+
+			if expectedRewards[i] == 0 {
+				s.Require().False(found, "user %d should not have an allocation for %s", i, newAirdropId)
+				continue
+			}
+
+			s.Require().True(found, "user %d allocation should have been created for %s", i, newAirdropId)
+			expectedAllocations := []sdkmath.Int{sdkmath.NewInt(expectedRewards[i]), sdkmath.ZeroInt()}
+			s.Require().Equal(expectedAllocations, userAllocation.Allocations, "user %d allocations for %s", i, newAirdropId)
+		}
+	}
+}
@@ -0,0 +1,43 @@
+package bindings
+
+import (
+	sdkmath "cosmossdk.io/math"
+)
+
+// StrideMsg contains the custom messages that can be dispatched by a contract
+// The contract is always the sender of the message
+// Exactly one of the fields should be set
+type StrideMsg struct {
+	LiquidStake            *LiquidStake            `json:"liquid_stake,omitempty"`
+	RedeemStake            *RedeemStake            `json:"redeem_stake,omitempty"`
+	ClaimUndelegatedTokens *ClaimUndelegatedTokens `json:"claim_undelegated_tokens,omitempty"`
+	LSMLiquidStake         *LSMLiquidStake         `json:"lsm_liquid_stake,omitempty"`
+}
+
+// Liquid stakes native tokens from the contract
+// The stTokens are minted to the contract, unless an IBC receiver is specified, in which case
+// they are transferred to the receiver and the contract is notified (via sudo) when the
+// transfer is acknowledged or times out
+type LiquidStake struct {
+	HostDenom       string      `json:"host_denom"`
+	Amount          sdkmath.Int `json:"amount"`
+	IbcReceiver     string      `json:"ibc_receiver,omitempty"`
+	TransferChannel string      `json:"transfer_channel,omitempty"`
+}
+
+type RedeemStake struct {
+	HostZone string      `json:"host_zone"`
+	Amount   sdkmath.Int `json:"amount"`
+	Receiver string      `json:"receiver"`
+}
+
+type ClaimUndelegatedTokens struct {
+	HostZoneId string `json:"host_zone_id"`
+	Epoch      uint64 `json:"epoch"`
+	Receiver   string `json:"receiver"`
+}
+
+type LSMLiquidStake struct {
+	Amount           sdkmath.Int `json:"amount"`
+	LsmTokenIbcDenom string      `json:"lsm_token_ibc_denom"`
+}
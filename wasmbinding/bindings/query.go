@@ -0,0 +1,77 @@
+package bindings
+
+import (
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// StrideQuery contains the custom queries that can be submitted by a contract
+// Exactly one of the fields should be set
+type StrideQuery struct {
+	// Returns a summary of the stakeibc host zone
+	HostZone *HostZoneQuery `json:"host_zone,omitempty"`
+	// Returns the redemption rate of a host zone (from stakeibc, staketia, or stakedym)
+	RedemptionRate *RedemptionRateQuery `json:"redemption_rate,omitempty"`
+	// Returns the user's redemption records for a host zone
+	UserRedemptionRecords *UserRedemptionRecordsQuery `json:"user_redemption_records,omitempty"`
+	// Returns the info for an epoch
+	EpochInfo *EpochInfoQuery `json:"epoch_info,omitempty"`
+}
+
+type HostZoneQuery struct {
+	ChainId string `json:"chain_id"`
+}
+
+type HostZoneResponse struct {
+	ChainId           string      `json:"chain_id"`
+	HostDenom         string      `json:"host_denom"`
+	IbcDenom          string      `json:"ibc_denom"`
+	TransferChannelId string      `json:"transfer_channel_id"`
+	RedemptionRate    sdk.Dec     `json:"redemption_rate"`
+	TotalDelegations  sdkmath.Int `json:"total_delegations"`
+	UnbondingPeriod   uint64      `json:"unbonding_period"`
+	Halted            bool        `json:"halted"`
+}
+
+type RedemptionRateQuery struct {
+	ChainId string `json:"chain_id"`
+}
+
+type RedemptionRateResponse struct {
+	ChainId        string  `json:"chain_id"`
+	Module         string  `json:"module"`
+	RedemptionRate sdk.Dec `json:"redemption_rate"`
+	Halted         bool    `json:"halted"`
+}
+
+type UserRedemptionRecordsQuery struct {
+	ChainId string `json:"chain_id"`
+	Address string `json:"address"`
+}
+
+// UserRedemptionRecord is a module agnostic view of a redemption
+// For stakeibc, RecordId is the epoch number of the redemption,
+// For staketia and stakedym, RecordId is the unbonding record ID
+type UserRedemptionRecord struct {
+	Module         string      `json:"module"`
+	RecordId       uint64      `json:"record_id"`
+	NativeAmount   sdkmath.Int `json:"native_amount"`
+	StTokenAmount  sdkmath.Int `json:"st_token_amount"`
+	ClaimIsPending bool        `json:"claim_is_pending"`
+}
+
+type UserRedemptionRecordsResponse struct {
+	Records []UserRedemptionRecord `json:"records"`
+}
+
+type EpochInfoQuery struct {
+	Identifier string `json:"identifier"`
+}
+
+type EpochInfoResponse struct {
+	Identifier              string `json:"identifier"`
+	CurrentEpoch            int64  `json:"current_epoch"`
+	CurrentEpochStartTime   int64  `json:"current_epoch_start_time"`
+	CurrentEpochStartHeight int64  `json:"current_epoch_start_height"`
+	DurationSeconds         int64  `json:"duration_seconds"`
+}
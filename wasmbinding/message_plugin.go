@@ -0,0 +1,142 @@
+package wasmbinding
+
+import (
+	"encoding/json"
+
+	errorsmod "cosmossdk.io/errors"
+	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
+	wasmvmtypes "github.com/CosmWasm/wasmvm/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/gogoproto/proto"
+	transfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
+
+	"github.com/Stride-Labs/stride/v24/wasmbinding/bindings"
+	autopilotkeeper "github.com/Stride-Labs/stride/v24/x/autopilot/keeper"
+	autopilottypes "github.com/Stride-Labs/stride/v24/x/autopilot/types"
+	stakeibckeeper "github.com/Stride-Labs/stride/v24/x/stakeibc/keeper"
+	stakeibctypes "github.com/Stride-Labs/stride/v24/x/stakeibc/types"
+)
+
+// CustomMessageDecorator wraps the default wasm messenger so that custom stride messages
+// are handled here, while all other messages are passed through to the wrapped messenger
+func CustomMessageDecorator(
+	stakeibcKeeper *stakeibckeeper.Keeper,
+	autopilotKeeper *autopilotkeeper.Keeper,
+) func(wasmkeeper.Messenger) wasmkeeper.Messenger {
+	return func(old wasmkeeper.Messenger) wasmkeeper.Messenger {
+		return &CustomMessenger{
+			wrapped:         old,
+			stakeibcKeeper:  stakeibcKeeper,
+			autopilotKeeper: autopilotKeeper,
+		}
+	}
+}
+
+type CustomMessenger struct {
+	wrapped         wasmkeeper.Messenger
+	stakeibcKeeper  *stakeibckeeper.Keeper
+	autopilotKeeper *autopilotkeeper.Keeper
+}
+
+var _ wasmkeeper.Messenger = (*CustomMessenger)(nil)
+
+// DispatchMsg executes a custom stride message with the contract as the sender
+func (m *CustomMessenger) DispatchMsg(
+	ctx sdk.Context,
+	contractAddr sdk.AccAddress,
+	contractIBCPortID string,
+	msg wasmvmtypes.CosmosMsg,
+) (events []sdk.Event, data [][]byte, err error) {
+	if msg.Custom == nil {
+		return m.wrapped.DispatchMsg(ctx, contractAddr, contractIBCPortID, msg)
+	}
+
+	var strideMsg bindings.StrideMsg
+	if err := json.Unmarshal(msg.Custom, &strideMsg); err != nil {
+		return nil, nil, errorsmod.Wrap(err, "invalid stride msg")
+	}
+
+	// Events are collected on a fresh event manager so they can be returned to wasmd
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	goCtx := sdk.WrapSDKContext(ctx)
+	msgServer := stakeibckeeper.NewMsgServerImpl(*m.stakeibcKeeper)
+	sender := contractAddr.String()
+
+	var response proto.Message
+	switch {
+	case strideMsg.LiquidStake != nil:
+		stake := strideMsg.LiquidStake
+		liquidStakeMsg := stakeibctypes.NewMsgLiquidStake(sender, stake.Amount, stake.HostDenom)
+		if err := liquidStakeMsg.ValidateBasic(); err != nil {
+			return nil, nil, err
+		}
+		response, err = m.liquidStake(ctx, msgServer, liquidStakeMsg, stake)
+
+	case strideMsg.RedeemStake != nil:
+		redeem := strideMsg.RedeemStake
+		redeemMsg := stakeibctypes.NewMsgRedeemStake(sender, redeem.Amount, redeem.HostZone, redeem.Receiver)
+		if err := redeemMsg.ValidateBasic(); err != nil {
+			return nil, nil, err
+		}
+		response, err = msgServer.RedeemStake(goCtx, redeemMsg)
+
+	case strideMsg.ClaimUndelegatedTokens != nil:
+		claim := strideMsg.ClaimUndelegatedTokens
+		claimMsg := stakeibctypes.NewMsgClaimUndelegatedTokens(sender, claim.HostZoneId, claim.Epoch, claim.Receiver)
+		if err := claimMsg.ValidateBasic(); err != nil {
+			return nil, nil, err
+		}
+		response, err = msgServer.ClaimUndelegatedTokens(goCtx, claimMsg)
+
+	case strideMsg.LSMLiquidStake != nil:
+		lsm := strideMsg.LSMLiquidStake
+		lsmMsg := stakeibctypes.NewMsgLSMLiquidStake(sender, lsm.Amount, lsm.LsmTokenIbcDenom)
+		if err := lsmMsg.ValidateBasic(); err != nil {
+			return nil, nil, err
+		}
+		response, err = msgServer.LSMLiquidStake(goCtx, lsmMsg)
+
+	default:
+		return nil, nil, wasmvmtypes.UnsupportedRequest{Kind: "unknown stride msg variant"}
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+
+	responseBz, err := proto.Marshal(response)
+	if err != nil {
+		return nil, nil, errorsmod.Wrap(err, "unable to serialize stride msg response")
+	}
+
+	return ctx.EventManager().Events(), [][]byte{responseBz}, nil
+}
+
+// Liquid stakes from the contract, and if an IBC receiver is specified, forwards the stTokens
+// with a callback to the contract
+func (m *CustomMessenger) liquidStake(
+	ctx sdk.Context,
+	msgServer stakeibctypes.MsgServer,
+	liquidStakeMsg *stakeibctypes.MsgLiquidStake,
+	stake *bindings.LiquidStake,
+) (*stakeibctypes.MsgLiquidStakeResponse, error) {
+	response, err := msgServer.LiquidStake(sdk.WrapSDKContext(ctx), liquidStakeMsg)
+	if err != nil || stake.IbcReceiver == "" {
+		return response, err
+	}
+
+	transferMetadata := transfertypes.FungibleTokenPacketData{
+		Denom:    stake.HostDenom,
+		Receiver: liquidStakeMsg.Creator,
+	}
+	autopilotMetadata := autopilottypes.StakeibcPacketMetadata{
+		StrideAddress:   liquidStakeMsg.Creator,
+		IbcReceiver:     stake.IbcReceiver,
+		TransferChannel: stake.TransferChannel,
+		ContractAddress: liquidStakeMsg.Creator,
+	}
+	if err := m.autopilotKeeper.IBCTransferStToken(ctx, response.StToken, transferMetadata, autopilotMetadata); err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
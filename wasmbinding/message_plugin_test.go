@@ -0,0 +1,154 @@
+package wasmbinding_test
+
+import (
+	"encoding/json"
+
+	sdkmath "cosmossdk.io/math"
+	wasmvmtypes "github.com/CosmWasm/wasmvm/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	transfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
+	ibctesting "github.com/cosmos/ibc-go/v7/testing"
+
+	"github.com/Stride-Labs/stride/v24/wasmbinding"
+	"github.com/Stride-Labs/stride/v24/wasmbinding/bindings"
+	autopilotkeeper "github.com/Stride-Labs/stride/v24/x/autopilot/keeper"
+	epochtypes "github.com/Stride-Labs/stride/v24/x/epochs/types"
+	icacallbackstypes "github.com/Stride-Labs/stride/v24/x/icacallbacks/types"
+	recordstypes "github.com/Stride-Labs/stride/v24/x/records/types"
+	stakeibctypes "github.com/Stride-Labs/stride/v24/x/stakeibc/types"
+)
+
+// Mock messenger that records whether a message was passed through
+type mockMessenger struct {
+	called bool
+}
+
+func (m *mockMessenger) DispatchMsg(
+	ctx sdk.Context,
+	contractAddr sdk.AccAddress,
+	contractIBCPortID string,
+	msg wasmvmtypes.CosmosMsg,
+) ([]sdk.Event, [][]byte, error) {
+	m.called = true
+	return nil, nil, nil
+}
+
+// Helper function to serialize a custom message and dispatch it through the decorated messenger
+func (s *WasmBindingTestSuite) dispatchCustom(
+	messenger *mockMessenger,
+	contract sdk.AccAddress,
+	msg bindings.StrideMsg,
+) ([]sdk.Event, [][]byte, error) {
+	msgBz, err := json.Marshal(msg)
+	s.Require().NoError(err, "no error expected when serializing msg")
+
+	decorated := wasmbinding.CustomMessageDecorator(&s.App.StakeibcKeeper, &s.App.AutopilotKeeper)(messenger)
+	return decorated.DispatchMsg(s.Ctx, contract, "", wasmvmtypes.CosmosMsg{Custom: msgBz})
+}
+
+func (s *WasmBindingTestSuite) SetupLiquidStake() (contract sdk.AccAddress) {
+	contract = s.TestAccs[0]
+	depositAddress := stakeibctypes.NewHostZoneDepositAddress(HostChainId)
+
+	s.App.StakeibcKeeper.SetHostZone(s.Ctx, stakeibctypes.HostZone{
+		ChainId:        HostChainId,
+		HostDenom:      Atom,
+		IbcDenom:       IbcAtom,
+		RedemptionRate: sdk.OneDec(),
+		DepositAddress: depositAddress.String(),
+	})
+	s.App.StakeibcKeeper.SetEpochTracker(s.Ctx, stakeibctypes.EpochTracker{
+		EpochIdentifier: epochtypes.STRIDE_EPOCH,
+		EpochNumber:     1,
+	})
+	s.App.RecordsKeeper.SetDepositRecord(s.Ctx, recordstypes.DepositRecord{
+		Id:                 1,
+		DepositEpochNumber: 1,
+		HostZoneId:         HostChainId,
+		Amount:             sdkmath.ZeroInt(),
+		Status:             recordstypes.DepositRecord_TRANSFER_QUEUE,
+	})
+
+	s.FundAccount(contract, sdk.NewInt64Coin(IbcAtom, 1000))
+	return contract
+}
+
+func (s *WasmBindingTestSuite) TestDispatchLiquidStake() {
+	contract := s.SetupLiquidStake()
+	messenger := &mockMessenger{}
+
+	msg := bindings.StrideMsg{LiquidStake: &bindings.LiquidStake{HostDenom: Atom, Amount: sdkmath.NewInt(400)}}
+	events, data, err := s.dispatchCustom(messenger, contract, msg)
+	s.Require().NoError(err, "no error expected when dispatching liquid stake")
+	s.Require().False(messenger.called, "custom message should not be passed to the wrapped messenger")
+
+	// Confirm the contract was debited the native tokens and received stTokens
+	s.Require().Equal(int64(600), s.App.BankKeeper.GetBalance(s.Ctx, contract, IbcAtom).Amount.Int64(), "contract native balance")
+	s.Require().Equal(int64(400), s.App.BankKeeper.GetBalance(s.Ctx, contract, StAtom).Amount.Int64(), "contract stToken balance")
+
+	// Confirm the liquid stake response and events were returned
+	s.Require().Len(data, 1, "response data")
+	var response stakeibctypes.MsgLiquidStakeResponse
+	s.Require().NoError(response.Unmarshal(data[0]), "no error expected when unmarshalling response")
+	s.Require().Equal(sdk.NewInt64Coin(StAtom, 400), response.StToken, "response stToken")
+	s.Require().NotEmpty(events, "events returned")
+}
+
+func (s *WasmBindingTestSuite) TestDispatchLiquidStake_Forward() {
+	s.CreateTransferChannel(HostChainId)
+	contract := s.SetupLiquidStake()
+	messenger := &mockMessenger{}
+
+	msg := bindings.StrideMsg{LiquidStake: &bindings.LiquidStake{
+		HostDenom:       Atom,
+		Amount:          sdkmath.NewInt(400),
+		IbcReceiver:     "cosmosXXX",
+		TransferChannel: ibctesting.FirstChannelID,
+	}}
+	expectedSequence := s.MustGetNextSequenceNumber(transfertypes.PortID, ibctesting.FirstChannelID)
+
+	_, _, err := s.dispatchCustom(messenger, contract, msg)
+	s.Require().NoError(err, "no error expected when dispatching liquid stake with forwarding")
+
+	// Confirm the stTokens were transferred out of the contract
+	s.Require().Zero(s.App.BankKeeper.GetBalance(s.Ctx, contract, StAtom).Amount.Int64(), "contract stToken balance")
+
+	// Confirm the contract callback was registered for the transfer
+	callbackKey := icacallbackstypes.PacketID(transfertypes.PortID, ibctesting.FirstChannelID, expectedSequence)
+	callbackData, found := s.App.IcacallbacksKeeper.GetCallbackData(s.Ctx, callbackKey)
+	s.Require().True(found, "callback data should have been stored")
+	s.Require().Equal(autopilotkeeper.IBCCallbacksID_ContractTransfer, callbackData.CallbackId, "callback ID")
+}
+
+func (s *WasmBindingTestSuite) TestDispatchLiquidStake_Failure() {
+	contract := s.SetupLiquidStake()
+	messenger := &mockMessenger{}
+
+	// Invalid amount - fails validate basic
+	msg := bindings.StrideMsg{LiquidStake: &bindings.LiquidStake{HostDenom: Atom, Amount: sdkmath.ZeroInt()}}
+	_, _, err := s.dispatchCustom(messenger, contract, msg)
+	s.Require().ErrorContains(err, "amount liquid staked must be positive")
+
+	// Insufficient balance - fails in the msg server
+	msg = bindings.StrideMsg{LiquidStake: &bindings.LiquidStake{HostDenom: Atom, Amount: sdkmath.NewInt(2000)}}
+	_, _, err = s.dispatchCustom(messenger, contract, msg)
+	s.Require().ErrorContains(err, "balance is lower than staking amount")
+}
+
+func (s *WasmBindingTestSuite) TestDispatchInvalidMsg() {
+	messenger := &mockMessenger{}
+
+	_, _, err := s.dispatchCustom(messenger, s.TestAccs[0], bindings.StrideMsg{})
+	s.Require().ErrorContains(err, "unknown stride msg variant")
+	s.Require().False(messenger.called, "wrapped messenger should not be called")
+}
+
+func (s *WasmBindingTestSuite) TestDispatchNonCustomMsg() {
+	messenger := &mockMessenger{}
+	decorated := wasmbinding.CustomMessageDecorator(&s.App.StakeibcKeeper, &s.App.AutopilotKeeper)(messenger)
+
+	bankMsg := wasmvmtypes.CosmosMsg{Bank: &wasmvmtypes.BankMsg{}}
+	_, _, err := decorated.DispatchMsg(s.Ctx, s.TestAccs[0], "", bankMsg)
+	s.Require().NoError(err, "no error expected when dispatching non-custom msg")
+	s.Require().True(messenger.called, "non-custom messages should be passed to the wrapped messenger")
+}
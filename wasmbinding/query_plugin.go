@@ -0,0 +1,217 @@
+package wasmbinding
+
+import (
+	"encoding/json"
+
+	errorsmod "cosmossdk.io/errors"
+	wasmvmtypes "github.com/CosmWasm/wasmvm/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/Stride-Labs/stride/v24/wasmbinding/bindings"
+	epochskeeper "github.com/Stride-Labs/stride/v24/x/epochs/keeper"
+	recordskeeper "github.com/Stride-Labs/stride/v24/x/records/keeper"
+	recordstypes "github.com/Stride-Labs/stride/v24/x/records/types"
+	stakedymkeeper "github.com/Stride-Labs/stride/v24/x/stakedym/keeper"
+	stakedymtypes "github.com/Stride-Labs/stride/v24/x/stakedym/types"
+	stakeibckeeper "github.com/Stride-Labs/stride/v24/x/stakeibc/keeper"
+	stakeibctypes "github.com/Stride-Labs/stride/v24/x/stakeibc/types"
+	staketiakeeper "github.com/Stride-Labs/stride/v24/x/staketia/keeper"
+	staketiatypes "github.com/Stride-Labs/stride/v24/x/staketia/types"
+)
+
+// Max number of user redemption records returned from a single query
+const MaxUserRedemptionRecordsPerQuery = 50
+
+// QueryPlugin answers the custom stride queries from contracts
+// The keepers are passed by reference since the wasm keeper is constructed
+// before the stride module keepers in app.go
+// Each query reads directly from the keepers using the context passed from wasmd,
+// which is already wrapped with the contract's query gas meter
+type QueryPlugin struct {
+	stakeibcKeeper *stakeibckeeper.Keeper
+	recordsKeeper  *recordskeeper.Keeper
+	staketiaKeeper *staketiakeeper.Keeper
+	stakedymKeeper *stakedymkeeper.Keeper
+	epochsKeeper   *epochskeeper.Keeper
+}
+
+func NewQueryPlugin(
+	stakeibcKeeper *stakeibckeeper.Keeper,
+	recordsKeeper *recordskeeper.Keeper,
+	staketiaKeeper *staketiakeeper.Keeper,
+	stakedymKeeper *stakedymkeeper.Keeper,
+	epochsKeeper *epochskeeper.Keeper,
+) *QueryPlugin {
+	return &QueryPlugin{
+		stakeibcKeeper: stakeibcKeeper,
+		recordsKeeper:  recordsKeeper,
+		staketiaKeeper: staketiaKeeper,
+		stakedymKeeper: stakedymKeeper,
+		epochsKeeper:   epochsKeeper,
+	}
+}
+
+// CustomQuerier dispatches a StrideQuery to the relevant handler
+// and returns the JSON serialized response
+func CustomQuerier(qp *QueryPlugin) func(ctx sdk.Context, request json.RawMessage) ([]byte, error) {
+	return func(ctx sdk.Context, request json.RawMessage) ([]byte, error) {
+		var query bindings.StrideQuery
+		if err := json.Unmarshal(request, &query); err != nil {
+			return nil, errorsmod.Wrap(err, "invalid stride query")
+		}
+
+		var response interface{}
+		var err error
+		switch {
+		case query.HostZone != nil:
+			response, err = qp.HostZone(ctx, query.HostZone.ChainId)
+		case query.RedemptionRate != nil:
+			response, err = qp.RedemptionRate(ctx, query.RedemptionRate.ChainId)
+		case query.UserRedemptionRecords != nil:
+			response, err = qp.UserRedemptionRecords(ctx, query.UserRedemptionRecords.ChainId, query.UserRedemptionRecords.Address)
+		case query.EpochInfo != nil:
+			response, err = qp.EpochInfo(ctx, query.EpochInfo.Identifier)
+		default:
+			return nil, wasmvmtypes.UnsupportedRequest{Kind: "unknown stride query variant"}
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		bz, err := json.Marshal(response)
+		if err != nil {
+			return nil, errorsmod.Wrap(err, "unable to serialize stride query response")
+		}
+		return bz, nil
+	}
+}
+
+// Returns a summary of a stakeibc host zone
+func (qp *QueryPlugin) HostZone(ctx sdk.Context, chainId string) (*bindings.HostZoneResponse, error) {
+	hostZone, found := qp.stakeibcKeeper.GetHostZone(ctx, chainId)
+	if !found {
+		return nil, errorsmod.Wrapf(stakeibctypes.ErrHostZoneNotFound, "host zone %s", chainId)
+	}
+
+	return &bindings.HostZoneResponse{
+		ChainId:           hostZone.ChainId,
+		HostDenom:         hostZone.HostDenom,
+		IbcDenom:          hostZone.IbcDenom,
+		TransferChannelId: hostZone.TransferChannelId,
+		RedemptionRate:    hostZone.RedemptionRate,
+		TotalDelegations:  hostZone.TotalDelegations,
+		UnbondingPeriod:   hostZone.UnbondingPeriod,
+		Halted:            hostZone.Halted,
+	}, nil
+}
+
+// Returns the redemption rate for a chain, checking stakeibc first, then staketia and stakedym
+func (qp *QueryPlugin) RedemptionRate(ctx sdk.Context, chainId string) (*bindings.RedemptionRateResponse, error) {
+	if hostZone, found := qp.stakeibcKeeper.GetHostZone(ctx, chainId); found {
+		return &bindings.RedemptionRateResponse{
+			ChainId:        hostZone.ChainId,
+			Module:         stakeibctypes.ModuleName,
+			RedemptionRate: hostZone.RedemptionRate,
+			Halted:         hostZone.Halted,
+		}, nil
+	}
+
+	if hostZone, err := qp.staketiaKeeper.GetHostZone(ctx); err == nil && hostZone.ChainId == chainId {
+		return &bindings.RedemptionRateResponse{
+			ChainId:        hostZone.ChainId,
+			Module:         staketiatypes.ModuleName,
+			RedemptionRate: hostZone.RedemptionRate,
+			Halted:         hostZone.Halted,
+		}, nil
+	}
+
+	if hostZone, err := qp.stakedymKeeper.GetHostZone(ctx); err == nil && hostZone.ChainId == chainId {
+		return &bindings.RedemptionRateResponse{
+			ChainId:        hostZone.ChainId,
+			Module:         stakedymtypes.ModuleName,
+			RedemptionRate: hostZone.RedemptionRate,
+			Halted:         hostZone.Halted,
+		}, nil
+	}
+
+	return nil, errorsmod.Wrapf(stakeibctypes.ErrHostZoneNotFound, "host zone %s", chainId)
+}
+
+// Returns the redemption records for a user on a given host zone
+// For staketia and stakedym, the records are looked up by the address index
+// For stakeibc, the records are keyed by {chainId}.{epoch}.{receiver}, so the chain's records are scanned
+// The response is capped at MaxUserRedemptionRecordsPerQuery records
+func (qp *QueryPlugin) UserRedemptionRecords(ctx sdk.Context, chainId, address string) (*bindings.UserRedemptionRecordsResponse, error) {
+	if _, err := sdk.AccAddressFromBech32(address); err != nil {
+		return nil, errorsmod.Wrapf(err, "invalid address %s", address)
+	}
+
+	records := []bindings.UserRedemptionRecord{}
+
+	if hostZone, err := qp.staketiaKeeper.GetHostZone(ctx); err == nil && hostZone.ChainId == chainId {
+		for _, record := range qp.staketiaKeeper.GetRedemptionRecordsFromAddress(ctx, address) {
+			if len(records) >= MaxUserRedemptionRecordsPerQuery {
+				break
+			}
+			records = append(records, bindings.UserRedemptionRecord{
+				Module:        staketiatypes.ModuleName,
+				RecordId:      record.UnbondingRecordId,
+				NativeAmount:  record.NativeAmount,
+				StTokenAmount: record.StTokenAmount,
+			})
+		}
+		return &bindings.UserRedemptionRecordsResponse{Records: records}, nil
+	}
+
+	if hostZone, err := qp.stakedymKeeper.GetHostZone(ctx); err == nil && hostZone.ChainId == chainId {
+		for _, record := range qp.stakedymKeeper.GetRedemptionRecordsFromAddress(ctx, address) {
+			if len(records) >= MaxUserRedemptionRecordsPerQuery {
+				break
+			}
+			records = append(records, bindings.UserRedemptionRecord{
+				Module:        stakedymtypes.ModuleName,
+				RecordId:      record.UnbondingRecordId,
+				NativeAmount:  record.NativeAmount,
+				StTokenAmount: record.StTokenAmount,
+			})
+		}
+		return &bindings.UserRedemptionRecordsResponse{Records: records}, nil
+	}
+
+	if _, found := qp.stakeibcKeeper.GetHostZone(ctx, chainId); !found {
+		return nil, errorsmod.Wrapf(stakeibctypes.ErrHostZoneNotFound, "host zone %s", chainId)
+	}
+
+	qp.recordsKeeper.IterateUserRedemptionRecordsForHostZone(ctx, chainId, func(_ int64, record recordstypes.UserRedemptionRecord) (stop bool) {
+		if record.Receiver != address {
+			return false
+		}
+		records = append(records, bindings.UserRedemptionRecord{
+			Module:         stakeibctypes.ModuleName,
+			RecordId:       record.EpochNumber,
+			NativeAmount:   record.NativeTokenAmount,
+			StTokenAmount:  record.StTokenAmount,
+			ClaimIsPending: record.ClaimIsPending,
+		})
+		return len(records) >= MaxUserRedemptionRecordsPerQuery
+	})
+
+	return &bindings.UserRedemptionRecordsResponse{Records: records}, nil
+}
+
+// Returns the current state of an epoch
+func (qp *QueryPlugin) EpochInfo(ctx sdk.Context, identifier string) (*bindings.EpochInfoResponse, error) {
+	epochInfo, found := qp.epochsKeeper.GetEpochInfo(ctx, identifier)
+	if !found {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrNotFound, "epoch %s", identifier)
+	}
+
+	return &bindings.EpochInfoResponse{
+		Identifier:              epochInfo.Identifier,
+		CurrentEpoch:            epochInfo.CurrentEpoch,
+		CurrentEpochStartTime:   epochInfo.CurrentEpochStartTime.Unix(),
+		CurrentEpochStartHeight: epochInfo.CurrentEpochStartHeight,
+		DurationSeconds:         int64(epochInfo.Duration.Seconds()),
+	}, nil
+}
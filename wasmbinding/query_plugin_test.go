@@ -0,0 +1,237 @@
+package wasmbinding_test
+
+import (
+	"encoding/json"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Stride-Labs/stride/v24/wasmbinding"
+	"github.com/Stride-Labs/stride/v24/wasmbinding/bindings"
+	epochstypes "github.com/Stride-Labs/stride/v24/x/epochs/types"
+	recordstypes "github.com/Stride-Labs/stride/v24/x/records/types"
+	stakeibctypes "github.com/Stride-Labs/stride/v24/x/stakeibc/types"
+	staketiatypes "github.com/Stride-Labs/stride/v24/x/staketia/types"
+)
+
+// Helper function to serialize a query, run it through the custom querier, and deserialize the response
+func (s *WasmBindingTestSuite) queryCustom(query bindings.StrideQuery, response interface{}) error {
+	queryBz, err := json.Marshal(query)
+	s.Require().NoError(err, "no error expected when serializing query")
+
+	responseBz, err := wasmbinding.CustomQuerier(s.QueryPlugin)(s.Ctx, queryBz)
+	if err != nil {
+		return err
+	}
+
+	s.Require().NoError(json.Unmarshal(responseBz, response), "no error expected when deserializing response")
+	return nil
+}
+
+func (s *WasmBindingTestSuite) TestQueryHostZone() {
+	hostZone := stakeibctypes.HostZone{
+		ChainId:           HostChainId,
+		HostDenom:         Atom,
+		IbcDenom:          IbcAtom,
+		TransferChannelId: "channel-0",
+		RedemptionRate:    sdk.MustNewDecFromStr("1.2"),
+		TotalDelegations:  sdkmath.NewInt(1000),
+		UnbondingPeriod:   21,
+	}
+	s.App.StakeibcKeeper.SetHostZone(s.Ctx, hostZone)
+
+	// Query a valid host zone
+	var response bindings.HostZoneResponse
+	err := s.queryCustom(bindings.StrideQuery{HostZone: &bindings.HostZoneQuery{ChainId: HostChainId}}, &response)
+	s.Require().NoError(err, "no error expected when querying host zone")
+
+	s.Require().Equal(HostChainId, response.ChainId, "chain id")
+	s.Require().Equal(Atom, response.HostDenom, "host denom")
+	s.Require().Equal(IbcAtom, response.IbcDenom, "ibc denom")
+	s.Require().Equal("channel-0", response.TransferChannelId, "transfer channel")
+	s.Require().Equal(hostZone.RedemptionRate, response.RedemptionRate, "redemption rate")
+	s.Require().Equal(int64(1000), response.TotalDelegations.Int64(), "total delegations")
+	s.Require().Equal(uint64(21), response.UnbondingPeriod, "unbonding period")
+
+	// Query a host zone that does not exist
+	err = s.queryCustom(bindings.StrideQuery{HostZone: &bindings.HostZoneQuery{ChainId: "fake"}}, &response)
+	s.Require().ErrorContains(err, "host zone not found")
+}
+
+func (s *WasmBindingTestSuite) TestQueryRedemptionRate() {
+	// Register a stakeibc host zone and a staketia host zone
+	s.App.StakeibcKeeper.SetHostZone(s.Ctx, stakeibctypes.HostZone{
+		ChainId:        HostChainId,
+		RedemptionRate: sdk.MustNewDecFromStr("1.1"),
+	})
+	s.App.StaketiaKeeper.SetHostZone(s.Ctx, staketiatypes.HostZone{
+		ChainId:        "celestia",
+		RedemptionRate: sdk.MustNewDecFromStr("1.5"),
+		Halted:         true,
+	})
+
+	// Query the stakeibc host zone
+	var response bindings.RedemptionRateResponse
+	err := s.queryCustom(bindings.StrideQuery{RedemptionRate: &bindings.RedemptionRateQuery{ChainId: HostChainId}}, &response)
+	s.Require().NoError(err, "no error expected when querying stakeibc redemption rate")
+	s.Require().Equal(stakeibctypes.ModuleName, response.Module, "stakeibc module")
+	s.Require().Equal(sdk.MustNewDecFromStr("1.1"), response.RedemptionRate, "stakeibc redemption rate")
+	s.Require().False(response.Halted, "stakeibc halted")
+
+	// Query the staketia host zone
+	err = s.queryCustom(bindings.StrideQuery{RedemptionRate: &bindings.RedemptionRateQuery{ChainId: "celestia"}}, &response)
+	s.Require().NoError(err, "no error expected when querying staketia redemption rate")
+	s.Require().Equal(staketiatypes.ModuleName, response.Module, "staketia module")
+	s.Require().Equal(sdk.MustNewDecFromStr("1.5"), response.RedemptionRate, "staketia redemption rate")
+	s.Require().True(response.Halted, "staketia halted")
+
+	// Query a chain that's not registered in any module
+	err = s.queryCustom(bindings.StrideQuery{RedemptionRate: &bindings.RedemptionRateQuery{ChainId: "fake"}}, &response)
+	s.Require().ErrorContains(err, "host zone not found")
+}
+
+func (s *WasmBindingTestSuite) TestQueryUserRedemptionRecords() {
+	user := s.TestAccs[0].String()
+	otherUser := s.TestAccs[1].String()
+
+	s.App.StakeibcKeeper.SetHostZone(s.Ctx, stakeibctypes.HostZone{ChainId: HostChainId})
+	s.App.StakeibcKeeper.SetHostZone(s.Ctx, stakeibctypes.HostZone{ChainId: "other-chain"})
+
+	// Store records across different epochs, users, and host zones
+	// Only the records for the user and host zone should be returned
+	for _, tc := range []struct {
+		chainId  string
+		receiver string
+		epoch    uint64
+	}{
+		{chainId: HostChainId, receiver: user, epoch: 1},
+		{chainId: HostChainId, receiver: user, epoch: 2},
+		{chainId: HostChainId, receiver: otherUser, epoch: 2},
+		{chainId: "other-chain", receiver: user, epoch: 1},
+	} {
+		s.App.RecordsKeeper.SetUserRedemptionRecord(s.Ctx, recordstypes.UserRedemptionRecord{
+			Id:                recordstypes.UserRedemptionRecordKeyFormatter(tc.chainId, tc.epoch, tc.receiver),
+			Receiver:          tc.receiver,
+			HostZoneId:        tc.chainId,
+			EpochNumber:       tc.epoch,
+			NativeTokenAmount: sdkmath.NewInt(int64(tc.epoch * 100)),
+			StTokenAmount:     sdkmath.NewInt(int64(tc.epoch * 10)),
+			ClaimIsPending:    tc.epoch == 2,
+		})
+	}
+
+	var response bindings.UserRedemptionRecordsResponse
+	query := bindings.StrideQuery{UserRedemptionRecords: &bindings.UserRedemptionRecordsQuery{ChainId: HostChainId, Address: user}}
+	err := s.queryCustom(query, &response)
+	s.Require().NoError(err, "no error expected when querying stakeibc records")
+
+	s.Require().Len(response.Records, 2, "number of stakeibc records")
+	for i, record := range response.Records {
+		epoch := uint64(i + 1)
+		s.Require().Equal(stakeibctypes.ModuleName, record.Module, "record %d module", i)
+		s.Require().Equal(epoch, record.RecordId, "record %d id", i)
+		s.Require().Equal(int64(epoch*100), record.NativeAmount.Int64(), "record %d native amount", i)
+		s.Require().Equal(int64(epoch*10), record.StTokenAmount.Int64(), "record %d st amount", i)
+		s.Require().Equal(epoch == 2, record.ClaimIsPending, "record %d claim is pending", i)
+	}
+
+	// Store staketia records and confirm they're returned when querying the staketia chain
+	s.App.StaketiaKeeper.SetHostZone(s.Ctx, staketiatypes.HostZone{ChainId: "celestia"})
+	s.App.StaketiaKeeper.SetRedemptionRecord(s.Ctx, staketiatypes.RedemptionRecord{
+		UnbondingRecordId: 5,
+		Redeemer:          user,
+		NativeAmount:      sdkmath.NewInt(500),
+		StTokenAmount:     sdkmath.NewInt(400),
+	})
+
+	query = bindings.StrideQuery{UserRedemptionRecords: &bindings.UserRedemptionRecordsQuery{ChainId: "celestia", Address: user}}
+	err = s.queryCustom(query, &response)
+	s.Require().NoError(err, "no error expected when querying staketia records")
+	s.Require().Equal([]bindings.UserRedemptionRecord{{
+		Module:        staketiatypes.ModuleName,
+		RecordId:      5,
+		NativeAmount:  sdkmath.NewInt(500),
+		StTokenAmount: sdkmath.NewInt(400),
+	}}, response.Records, "staketia records")
+
+	// Query with an invalid address
+	query = bindings.StrideQuery{UserRedemptionRecords: &bindings.UserRedemptionRecordsQuery{ChainId: HostChainId, Address: "invalid"}}
+	err = s.queryCustom(query, &response)
+	s.Require().ErrorContains(err, "invalid address")
+
+	// Query a host zone that does not exist
+	query = bindings.StrideQuery{UserRedemptionRecords: &bindings.UserRedemptionRecordsQuery{ChainId: "fake", Address: user}}
+	err = s.queryCustom(query, &response)
+	s.Require().ErrorContains(err, "host zone not found")
+}
+
+func (s *WasmBindingTestSuite) TestQueryUserRedemptionRecords_Capped() {
+	user := s.TestAccs[0].String()
+	s.App.StakeibcKeeper.SetHostZone(s.Ctx, stakeibctypes.HostZone{ChainId: HostChainId})
+
+	for epoch := uint64(1); epoch <= wasmbinding.MaxUserRedemptionRecordsPerQuery+10; epoch++ {
+		s.App.RecordsKeeper.SetUserRedemptionRecord(s.Ctx, recordstypes.UserRedemptionRecord{
+			Id:                recordstypes.UserRedemptionRecordKeyFormatter(HostChainId, epoch, user),
+			Receiver:          user,
+			HostZoneId:        HostChainId,
+			EpochNumber:       epoch,
+			NativeTokenAmount: sdkmath.ZeroInt(),
+			StTokenAmount:     sdkmath.ZeroInt(),
+		})
+	}
+
+	var response bindings.UserRedemptionRecordsResponse
+	query := bindings.StrideQuery{UserRedemptionRecords: &bindings.UserRedemptionRecordsQuery{ChainId: HostChainId, Address: user}}
+	err := s.queryCustom(query, &response)
+	s.Require().NoError(err, "no error expected when querying records")
+	s.Require().Len(response.Records, wasmbinding.MaxUserRedemptionRecordsPerQuery, "number of records")
+}
+
+func (s *WasmBindingTestSuite) TestQueryEpochInfo() {
+	startTime := time.Unix(1_700_000_000, 0).UTC()
+	s.App.EpochsKeeper.SetEpochInfo(s.Ctx, epochstypes.EpochInfo{
+		Identifier:              "test-epoch",
+		StartTime:               startTime,
+		Duration:                time.Hour,
+		CurrentEpoch:            7,
+		CurrentEpochStartTime:   startTime,
+		CurrentEpochStartHeight: 100,
+		EpochCountingStarted:    true,
+	})
+
+	var response bindings.EpochInfoResponse
+	err := s.queryCustom(bindings.StrideQuery{EpochInfo: &bindings.EpochInfoQuery{Identifier: "test-epoch"}}, &response)
+	s.Require().NoError(err, "no error expected when querying epoch info")
+	s.Require().Equal(bindings.EpochInfoResponse{
+		Identifier:              "test-epoch",
+		CurrentEpoch:            7,
+		CurrentEpochStartTime:   startTime.Unix(),
+		CurrentEpochStartHeight: 100,
+		DurationSeconds:         3600,
+	}, response, "epoch info response")
+
+	err = s.queryCustom(bindings.StrideQuery{EpochInfo: &bindings.EpochInfoQuery{Identifier: "fake"}}, &response)
+	s.Require().ErrorContains(err, "epoch fake")
+}
+
+func (s *WasmBindingTestSuite) TestQueryInvalid() {
+	_, err := wasmbinding.CustomQuerier(s.QueryPlugin)(s.Ctx, []byte(`{}`))
+	s.Require().ErrorContains(err, "unknown stride query variant")
+
+	_, err = wasmbinding.CustomQuerier(s.QueryPlugin)(s.Ctx, []byte(`not json`))
+	s.Require().ErrorContains(err, "invalid stride query")
+}
+
+func (s *WasmBindingTestSuite) TestQueryGasConsumed() {
+	s.App.StakeibcKeeper.SetHostZone(s.Ctx, stakeibctypes.HostZone{ChainId: HostChainId})
+
+	// Confirm the query reads are charged to the context's gas meter
+	ctx := s.Ctx.WithGasMeter(sdk.NewGasMeter(1_000_000))
+	queryBz, err := json.Marshal(bindings.StrideQuery{HostZone: &bindings.HostZoneQuery{ChainId: HostChainId}})
+	s.Require().NoError(err)
+
+	_, err = wasmbinding.CustomQuerier(s.QueryPlugin)(ctx, queryBz)
+	s.Require().NoError(err, "no error expected when querying host zone")
+	s.Require().Greater(ctx.GasMeter().GasConsumed(), uint64(0), "gas consumed")
+}
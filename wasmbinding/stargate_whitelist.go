@@ -0,0 +1,84 @@
+package wasmbinding
+
+import (
+	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec"
+
+	airdroptypes "github.com/Stride-Labs/stride/v24/x/airdrop/types"
+	epochstypes "github.com/Stride-Labs/stride/v24/x/epochs/types"
+	icaoracletypes "github.com/Stride-Labs/stride/v24/x/icaoracle/types"
+	recordstypes "github.com/Stride-Labs/stride/v24/x/records/types"
+	stakedymtypes "github.com/Stride-Labs/stride/v24/x/stakedym/types"
+	stakeibctypes "github.com/Stride-Labs/stride/v24/x/stakeibc/types"
+	staketiatypes "github.com/Stride-Labs/stride/v24/x/staketia/types"
+)
+
+// GetStargateWhitelistedPaths returns the stargate queries that contracts are permitted to make,
+// keyed by query path, with the response type that's used to JSON serialize the result
+// Only queries that are deterministic and have bounded (or paginated) responses should be added here,
+// since the results are consumed during contract execution
+// The response types are validated in stargate_whitelist_test.go
+func GetStargateWhitelistedPaths() wasmkeeper.AcceptedStargateQueries {
+	return wasmkeeper.AcceptedStargateQueries{
+		// stakeibc
+		"/stride.stakeibc.Query/Params":                       &stakeibctypes.QueryParamsResponse{},
+		"/stride.stakeibc.Query/Validators":                   &stakeibctypes.QueryGetValidatorsResponse{},
+		"/stride.stakeibc.Query/HostZone":                     &stakeibctypes.QueryGetHostZoneResponse{},
+		"/stride.stakeibc.Query/HostZoneAll":                  &stakeibctypes.QueryAllHostZoneResponse{},
+		"/stride.stakeibc.Query/ModuleAddress":                &stakeibctypes.QueryModuleAddressResponse{},
+		"/stride.stakeibc.Query/InterchainAccountFromAddress": &stakeibctypes.QueryInterchainAccountFromAddressResponse{},
+		"/stride.stakeibc.Query/EpochTracker":                 &stakeibctypes.QueryGetEpochTrackerResponse{},
+		"/stride.stakeibc.Query/EpochTrackerAll":              &stakeibctypes.QueryAllEpochTrackerResponse{},
+		"/stride.stakeibc.Query/NextPacketSequence":           &stakeibctypes.QueryGetNextPacketSequenceResponse{},
+		"/stride.stakeibc.Query/AllTradeRoutes":               &stakeibctypes.QueryAllTradeRoutesResponse{},
+
+		// staketia
+		"/stride.staketia.Query/HostZone":          &staketiatypes.QueryHostZoneResponse{},
+		"/stride.staketia.Query/RedemptionRecord":  &staketiatypes.QueryRedemptionRecordResponse{},
+		"/stride.staketia.Query/RedemptionRecords": &staketiatypes.QueryRedemptionRecordsResponse{},
+
+		// stakedym
+		"/stride.stakedym.Query/HostZone":          &stakedymtypes.QueryHostZoneResponse{},
+		"/stride.stakedym.Query/RedemptionRecord":  &stakedymtypes.QueryRedemptionRecordResponse{},
+		"/stride.stakedym.Query/RedemptionRecords": &stakedymtypes.QueryRedemptionRecordsResponse{},
+
+		// records
+		"/stride.records.Query/Params":                      &recordstypes.QueryParamsResponse{},
+		"/stride.records.Query/UserRedemptionRecord":        &recordstypes.QueryGetUserRedemptionRecordResponse{},
+		"/stride.records.Query/UserRedemptionRecordForUser": &recordstypes.QueryAllUserRedemptionRecordForUserResponse{},
+		"/stride.records.Query/EpochUnbondingRecord":        &recordstypes.QueryGetEpochUnbondingRecordResponse{},
+		"/stride.records.Query/DepositRecord":               &recordstypes.QueryGetDepositRecordResponse{},
+		"/stride.records.Query/DepositRecordByHost":         &recordstypes.QueryDepositRecordByHostResponse{},
+		"/stride.records.Query/LSMDeposit":                  &recordstypes.QueryLSMDepositResponse{},
+		"/stride.records.Query/LSMDeposits":                 &recordstypes.QueryLSMDepositsResponse{},
+
+		// epochs
+		"/stride.epochs.Query/EpochInfos":   &epochstypes.QueryEpochsInfoResponse{},
+		"/stride.epochs.Query/CurrentEpoch": &epochstypes.QueryCurrentEpochResponse{},
+		"/stride.epochs.Query/EpochInfo":    &epochstypes.QueryEpochInfoResponse{},
+
+		// icaoracle
+		"/stride.icaoracle.Query/Oracle":        &icaoracletypes.QueryOracleResponse{},
+		"/stride.icaoracle.Query/AllOracles":    &icaoracletypes.QueryAllOraclesResponse{},
+		"/stride.icaoracle.Query/ActiveOracles": &icaoracletypes.QueryActiveOraclesResponse{},
+		"/stride.icaoracle.Query/Metrics":       &icaoracletypes.QueryMetricsResponse{},
+
+		// airdrop
+		"/stride.airdrop.Query/Airdrop":         &airdroptypes.QueryAirdropResponse{},
+		"/stride.airdrop.Query/AllAirdrops":     &airdroptypes.QueryAllAirdropsResponse{},
+		"/stride.airdrop.Query/UserAllocation":  &airdroptypes.QueryUserAllocationResponse{},
+		"/stride.airdrop.Query/UserAllocations": &airdroptypes.QueryUserAllocationsResponse{},
+		"/stride.airdrop.Query/UserSummary":     &airdroptypes.QueryUserSummaryResponse{},
+	}
+}
+
+// RegisterStargateQueries returns the wasm keeper option that restricts contract stargate queries
+// to the whitelist above
+func RegisterStargateQueries(queryRouter *baseapp.GRPCQueryRouter, cdc codec.Codec) []wasmkeeper.Option {
+	return []wasmkeeper.Option{
+		wasmkeeper.WithQueryPlugins(&wasmkeeper.QueryPlugins{
+			Stargate: wasmkeeper.AcceptListStargateQuerier(GetStargateWhitelistedPaths(), queryRouter, cdc),
+		}),
+	}
+}
@@ -0,0 +1,110 @@
+package wasmbinding_test
+
+import (
+	"strings"
+
+	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
+	wasmvmtypes "github.com/CosmWasm/wasmvm/types"
+	gogoproto "github.com/cosmos/gogoproto/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"github.com/Stride-Labs/stride/v24/wasmbinding"
+	stakeibctypes "github.com/Stride-Labs/stride/v24/x/stakeibc/types"
+)
+
+// Splits a query path of the form "/{package}.{Service}/{Method}" into the service and method names
+func (s *WasmBindingTestSuite) parseQueryPath(path string) (service, method string) {
+	parts := strings.Split(strings.TrimPrefix(path, "/"), "/")
+	s.Require().Len(parts, 2, "query path %s should be of the form /{service}/{method}", path)
+	return parts[0], parts[1]
+}
+
+// Recursively checks that a message does not contain any fields that could cause non-deterministic
+// serialization or non-deterministic results across validators (maps and floating point numbers)
+func (s *WasmBindingTestSuite) checkMessageIsDeterministic(
+	path string,
+	message protoreflect.MessageDescriptor,
+	visited map[protoreflect.FullName]bool,
+) {
+	if visited[message.FullName()] {
+		return
+	}
+	visited[message.FullName()] = true
+
+	fields := message.Fields()
+	for i := 0; i < fields.Len(); i++ {
+		field := fields.Get(i)
+		fieldName := field.FullName()
+
+		s.Require().False(field.IsMap(), "%s: field %s is a map", path, fieldName)
+		s.Require().NotEqual(protoreflect.FloatKind, field.Kind(), "%s: field %s is a float", path, fieldName)
+		s.Require().NotEqual(protoreflect.DoubleKind, field.Kind(), "%s: field %s is a double", path, fieldName)
+
+		if field.Message() != nil {
+			s.checkMessageIsDeterministic(path, field.Message(), visited)
+		}
+	}
+}
+
+func (s *WasmBindingTestSuite) TestStargateWhitelist_Deterministic() {
+	whitelist := wasmbinding.GetStargateWhitelistedPaths()
+	s.Require().NotEmpty(whitelist, "whitelist should not be empty")
+
+	for path, response := range whitelist {
+		serviceName, methodName := s.parseQueryPath(path)
+
+		// Confirm the query is routable
+		s.Require().NotNil(s.App.GRPCQueryRouter().Route(path), "%s: query should be registered with the router", path)
+
+		// Confirm the whitelisted response type matches the response type of the rpc
+		serviceDescriptor, err := gogoproto.HybridResolver.FindDescriptorByName(protoreflect.FullName(serviceName))
+		s.Require().NoError(err, "%s: service should be registered", path)
+
+		methodDescriptor := serviceDescriptor.(protoreflect.ServiceDescriptor).Methods().ByName(protoreflect.Name(methodName))
+		s.Require().NotNil(methodDescriptor, "%s: method should be registered", path)
+
+		responseName := gogoproto.MessageName(response)
+		s.Require().Equal(string(methodDescriptor.Output().FullName()), responseName, "%s: response type", path)
+		s.Require().NotNil(gogoproto.MessageType(responseName), "%s: response type should be registered", path)
+
+		// Confirm the response type is safe to return to contracts
+		s.checkMessageIsDeterministic(path, methodDescriptor.Output(), map[protoreflect.FullName]bool{})
+
+		// Confirm the response can be serialized to JSON (as is done in the stargate querier)
+		_, err = s.App.AppCodec().MarshalJSON(response)
+		s.Require().NoError(err, "%s: response should be JSON serializable", path)
+	}
+}
+
+func (s *WasmBindingTestSuite) TestStargateWhitelist_Query() {
+	s.App.StakeibcKeeper.SetHostZone(s.Ctx, stakeibctypes.HostZone{ChainId: HostChainId, HostDenom: Atom})
+
+	querier := wasmkeeper.AcceptListStargateQuerier(
+		wasmbinding.GetStargateWhitelistedPaths(),
+		s.App.GRPCQueryRouter(),
+		s.App.AppCodec(),
+	)
+
+	// Query a whitelisted path
+	request := stakeibctypes.QueryGetHostZoneRequest{ChainId: HostChainId}
+	requestBz, err := request.Marshal()
+	s.Require().NoError(err, "no error expected when serializing request")
+
+	responseBz, err := querier(s.Ctx, &wasmvmtypes.StargateQuery{
+		Path: "/stride.stakeibc.Query/HostZone",
+		Data: requestBz,
+	})
+	s.Require().NoError(err, "no error expected when querying whitelisted path")
+
+	var response stakeibctypes.QueryGetHostZoneResponse
+	s.Require().NoError(s.App.AppCodec().UnmarshalJSON(responseBz, &response), "no error expected when deserializing response")
+	s.Require().Equal(HostChainId, response.HostZone.ChainId, "host zone chain id")
+	s.Require().Equal(Atom, response.HostZone.HostDenom, "host zone denom")
+
+	// Query a path that's not whitelisted
+	_, err = querier(s.Ctx, &wasmvmtypes.StargateQuery{
+		Path: "/stride.stakeibc.Query/AddressUnbondings",
+		Data: []byte{},
+	})
+	s.Require().ErrorContains(err, "path is not allowed from the contract")
+}
@@ -0,0 +1,39 @@
+package wasmbinding
+
+import (
+	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
+
+	autopilotkeeper "github.com/Stride-Labs/stride/v24/x/autopilot/keeper"
+	epochskeeper "github.com/Stride-Labs/stride/v24/x/epochs/keeper"
+	recordskeeper "github.com/Stride-Labs/stride/v24/x/records/keeper"
+	stakedymkeeper "github.com/Stride-Labs/stride/v24/x/stakedym/keeper"
+	stakeibckeeper "github.com/Stride-Labs/stride/v24/x/stakeibc/keeper"
+	staketiakeeper "github.com/Stride-Labs/stride/v24/x/staketia/keeper"
+)
+
+// Capability that contracts must declare (with `requires_stride`) to use the custom bindings
+const StrideCapability = "stride"
+
+// RegisterCustomPlugins returns the wasm keeper options for the custom stride query and message bindings
+func RegisterCustomPlugins(
+	stakeibcKeeper *stakeibckeeper.Keeper,
+	recordsKeeper *recordskeeper.Keeper,
+	staketiaKeeper *staketiakeeper.Keeper,
+	stakedymKeeper *stakedymkeeper.Keeper,
+	epochsKeeper *epochskeeper.Keeper,
+	autopilotKeeper *autopilotkeeper.Keeper,
+) []wasmkeeper.Option {
+	queryPlugin := NewQueryPlugin(stakeibcKeeper, recordsKeeper, staketiaKeeper, stakedymKeeper, epochsKeeper)
+
+	queryPluginOpt := wasmkeeper.WithQueryPlugins(&wasmkeeper.QueryPlugins{
+		Custom: CustomQuerier(queryPlugin),
+	})
+	messengerDecoratorOpt := wasmkeeper.WithMessageHandlerDecorator(
+		CustomMessageDecorator(stakeibcKeeper, autopilotKeeper),
+	)
+
+	return []wasmkeeper.Option{
+		queryPluginOpt,
+		messengerDecoratorOpt,
+	}
+}
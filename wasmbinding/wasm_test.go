@@ -0,0 +1,37 @@
+package wasmbinding_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/Stride-Labs/stride/v24/app/apptesting"
+	"github.com/Stride-Labs/stride/v24/wasmbinding"
+)
+
+const (
+	HostChainId = "chain-0"
+	Atom        = "uatom"
+	StAtom      = "stuatom"
+	IbcAtom     = "ibc/uatom"
+)
+
+type WasmBindingTestSuite struct {
+	apptesting.AppTestHelper
+	QueryPlugin *wasmbinding.QueryPlugin
+}
+
+func (s *WasmBindingTestSuite) SetupTest() {
+	s.Setup()
+	s.QueryPlugin = wasmbinding.NewQueryPlugin(
+		&s.App.StakeibcKeeper,
+		&s.App.RecordsKeeper,
+		&s.App.StaketiaKeeper,
+		&s.App.StakedymKeeper,
+		&s.App.EpochsKeeper,
+	)
+}
+
+func TestWasmBindingTestSuite(t *testing.T) {
+	suite.Run(t, new(WasmBindingTestSuite))
+}
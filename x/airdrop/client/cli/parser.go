@@ -2,6 +2,7 @@ package cli
 
 import (
 	"encoding/csv"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"os"
@@ -56,3 +57,53 @@ func ParseUserAllocations(fileName string) ([]types.RawAllocation, error) {
 
 	return allAllocations, nil
 }
+
+// File generated by the generate-allocation-proofs command, containing the merkle root
+// and each user's allocation proof
+type AllocationProofsFile struct {
+	MerkleRoot string                           `json:"merkle_root"`
+	Proofs     map[string]types.AllocationProof `json:"proofs"`
+}
+
+// Builds the merkle root and proofs for each user in an allocations CSV file
+func GenerateAllocationProofs(allocationsFileName string) (AllocationProofsFile, error) {
+	allocations, err := ParseUserAllocations(allocationsFileName)
+	if err != nil {
+		return AllocationProofsFile{}, err
+	}
+
+	merkleRoot, proofs, err := types.BuildAllocationProofs(allocations)
+	if err != nil {
+		return AllocationProofsFile{}, err
+	}
+
+	proofsFile := AllocationProofsFile{
+		MerkleRoot: merkleRoot,
+		Proofs:     map[string]types.AllocationProof{},
+	}
+	for i, allocation := range allocations {
+		proofsFile.Proofs[allocation.UserAddress] = proofs[i]
+	}
+
+	return proofsFile, nil
+}
+
+// Reads a user's allocation proof from a file generated by the generate-allocation-proofs command
+func ParseAllocationProof(proofsFileName, address string) (*types.AllocationProof, error) {
+	proofsBz, err := os.ReadFile(proofsFileName)
+	if err != nil {
+		return nil, err
+	}
+
+	var proofsFile AllocationProofsFile
+	if err := json.Unmarshal(proofsBz, &proofsFile); err != nil {
+		return nil, err
+	}
+
+	proof, ok := proofsFile.Proofs[address]
+	if !ok {
+		return nil, fmt.Errorf("no allocation proof found for %s", address)
+	}
+
+	return &proof, nil
+}
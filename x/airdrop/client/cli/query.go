@@ -29,6 +29,8 @@ func GetQueryCmd() *cobra.Command {
 		CmdQueryUserAllocations(),
 		CmdQueryAllAllocations(),
 		CmdQueryUserSummary(),
+		CmdQueryClawbackRecord(),
+		CmdQueryAllClawbackRecords(),
 	)
 
 	return cmd
@@ -217,3 +219,62 @@ func CmdQueryUserSummary() *cobra.Command {
 
 	return cmd
 }
+
+// Queries the clawback record for a finalized airdrop
+func CmdQueryClawbackRecord() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "clawback-record [airdrop-id]",
+		Short: "Queries the clawback record for a finalized airdrop",
+		Long: strings.TrimSpace(
+			`Queries the total allocated, claimed, forfeited, and clawed back rewards for an airdrop after the clawback`,
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			airdropId := args[0]
+
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryClawbackRecordRequest{
+				AirdropId: airdropId,
+			}
+			res, err := queryClient.ClawbackRecord(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	return cmd
+}
+
+// Queries the clawback records across all finalized airdrops
+func CmdQueryAllClawbackRecords() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "clawback-records",
+		Short: "Queries the clawback records across all finalized airdrops",
+		Args:  cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryAllClawbackRecordsRequest{}
+			res, err := queryClient.AllClawbackRecords(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	return cmd
+}
@@ -1,8 +1,10 @@
 package cli
 
 import (
+	"encoding/json"
 	"errors"
 	"fmt"
+	"os"
 	"strings"
 	"time"
 
@@ -23,9 +25,12 @@ const (
 	FlagClawbackDate          = "clawback-date"
 	FlagClaimTypeDeadlineDate = "claim-type-deadline-date"
 	FlagEarlyClaimPenalty     = "early-claim-penalty"
+	FlagClaimAndStakeBonus    = "claim-and-stake-bonus"
+	FlagClaimCondition        = "claim-condition"
 	FlagDistributorAddress    = "distributor-address"
 	FlagAllocatorAddress      = "allocator-address"
 	FlagLinkerAddress         = "linker-address"
+	FlagProofFile             = "proof-file"
 
 	FlagRewardDenom    = "reward-denom"
 	DefaultRewardDenom = "ustrd"
@@ -46,11 +51,15 @@ func GetTxCmd() *cobra.Command {
 	cmd.AddCommand(
 		CmdClaimDaily(),
 		CmdClaimEarly(),
+		CmdClaimAndStake(),
+		CmdClaimAndVest(),
 		CmdCreateAirdrop(),
 		CmdUpdateAirdrop(),
 		CmdAddAllocations(),
 		CmdUpdateUserAllocation(),
 		CmdLinkAddresses(),
+		CmdSetAllocationsRoot(),
+		CmdGenerateAllocationProofs(),
 	)
 
 	return cmd
@@ -67,6 +76,10 @@ This option is only available if the user has not already elected to claim early
 
 Example:
   $ %[1]s tx %[2]s claim-daily airdrop-1 --from user
+
+If the airdrop uses an allocations merkle root, the first claim must include the
+user's allocation proof (from the generate-allocation-proofs command):
+  $ %[1]s tx %[2]s claim-daily airdrop-1 --proof-file proofs.json --from user
 `, version.AppName, types.ModuleName),
 		),
 		Args: cobra.ExactArgs(1),
@@ -83,6 +96,17 @@ Example:
 				airdropId,
 			)
 
+			proofFileName, err := cmd.Flags().GetString(FlagProofFile)
+			if err != nil {
+				return err
+			}
+			if proofFileName != "" {
+				msg.AllocationProof, err = ParseAllocationProof(proofFileName, msg.Claimer)
+				if err != nil {
+					return errorsmod.Wrapf(err, "unable to parse allocation proof")
+				}
+			}
+
 			if err := msg.ValidateBasic(); err != nil {
 				return err
 			}
@@ -91,6 +115,7 @@ Example:
 		},
 	}
 
+	cmd.Flags().String(FlagProofFile, "", "File with the user's allocation proof, required on the first claim of a merkle root airdrop")
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
@@ -107,6 +132,10 @@ claim penalty causing a portion of the total to be clawed back.
 
 Example:
   $ %[1]s tx %[2]s claim-early airdrop-1 --from user
+
+If the airdrop uses an allocations merkle root, the first claim must include the
+user's allocation proof (from the generate-allocation-proofs command):
+  $ %[1]s tx %[2]s claim-early airdrop-1 --proof-file proofs.json --from user
 `, version.AppName, types.ModuleName),
 		),
 		Args: cobra.ExactArgs(1),
@@ -123,6 +152,17 @@ Example:
 				airdropId,
 			)
 
+			proofFileName, err := cmd.Flags().GetString(FlagProofFile)
+			if err != nil {
+				return err
+			}
+			if proofFileName != "" {
+				msg.AllocationProof, err = ParseAllocationProof(proofFileName, msg.Claimer)
+				if err != nil {
+					return errorsmod.Wrapf(err, "unable to parse allocation proof")
+				}
+			}
+
 			if err := msg.ValidateBasic(); err != nil {
 				return err
 			}
@@ -131,6 +171,122 @@ Example:
 		},
 	}
 
+	cmd.Flags().String(FlagProofFile, "", "File with the user's allocation proof, required on the first claim of a merkle root airdrop")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// User transaction to claim all the pending airdrop rewards up to the current day and liquid stake them
+func CmdClaimAndStake() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "claim-and-stake [airdrop-id]",
+		Short: "Claims all the pending airdrop rewards up to the current day and liquid stakes them",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Claims all pending airdrop rewards up to the current day and liquid stakes them,
+along with the airdrop's claim and stake bonus. The stTokens are sent to the user.
+This option is only available if the user has not already elected to claim early
+
+Example:
+  $ %[1]s tx %[2]s claim-and-stake airdrop-1 --from user
+
+If the airdrop uses an allocations merkle root, the first claim must include the
+user's allocation proof (from the generate-allocation-proofs command):
+  $ %[1]s tx %[2]s claim-and-stake airdrop-1 --proof-file proofs.json --from user
+`, version.AppName, types.ModuleName),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			airdropId := args[0]
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgClaimAndStake(
+				clientCtx.GetFromAddress().String(),
+				airdropId,
+			)
+
+			proofFileName, err := cmd.Flags().GetString(FlagProofFile)
+			if err != nil {
+				return err
+			}
+			if proofFileName != "" {
+				msg.AllocationProof, err = ParseAllocationProof(proofFileName, msg.Claimer)
+				if err != nil {
+					return errorsmod.Wrapf(err, "unable to parse allocation proof")
+				}
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(FlagProofFile, "", "File with the user's allocation proof, required on the first claim of a merkle root airdrop")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// User transaction to claim all the remaining airdrop rewards into a vesting account
+func CmdClaimAndVest() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "claim-and-vest [airdrop-id]",
+		Short: "Claims all the remaining airdrop rewards into a vesting account",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Claims all remaining airdrop rewards without a penalty, but locks them in a vesting
+account. Each day's allocation unlocks over the course of that day, and allocations from
+past days are unlocked immediately.
+This option is only available before the airdrop's claim type deadline
+
+Example:
+  $ %[1]s tx %[2]s claim-and-vest airdrop-1 --from user
+
+If the airdrop uses an allocations merkle root, the first claim must include the
+user's allocation proof (from the generate-allocation-proofs command):
+  $ %[1]s tx %[2]s claim-and-vest airdrop-1 --proof-file proofs.json --from user
+`, version.AppName, types.ModuleName),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			airdropId := args[0]
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgClaimAndVest(
+				clientCtx.GetFromAddress().String(),
+				airdropId,
+			)
+
+			proofFileName, err := cmd.Flags().GetString(FlagProofFile)
+			if err != nil {
+				return err
+			}
+			if proofFileName != "" {
+				msg.AllocationProof, err = ParseAllocationProof(proofFileName, msg.Claimer)
+				if err != nil {
+					return errorsmod.Wrapf(err, "unable to parse allocation proof")
+				}
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(FlagProofFile, "", "File with the user's allocation proof, required on the first claim of a merkle root airdrop")
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
@@ -151,6 +307,8 @@ Example:
 	--clawback-date            2024-07-01T00:00:00 \
 	--claim-type-deadline-date 2024-02-01T00:00:00 \
 	--early-claim-penalty      0.5 \
+	--claim-and-stake-bonus    0.1 \
+	--claim-condition          CLAIM_CONDITION_NONE \
 	--distributor-address     strideXXX \
 	--allocator-address       strideYYY \
 	--linker-address          strideZZZ \
@@ -187,6 +345,14 @@ Example:
 			if err != nil {
 				return errorsmod.Wrapf(err, "unable to parse early claim penalty address")
 			}
+			claimAndStakeBonusString, err := cmd.Flags().GetString(FlagClaimAndStakeBonus)
+			if err != nil {
+				return errorsmod.Wrapf(err, "unable to parse claim and stake bonus")
+			}
+			claimConditionString, err := cmd.Flags().GetString(FlagClaimCondition)
+			if err != nil {
+				return errorsmod.Wrapf(err, "unable to parse claim condition")
+			}
 			distributorAddress, err := cmd.Flags().GetString(FlagDistributorAddress)
 			if err != nil {
 				return errorsmod.Wrapf(err, "unable to parse distribution address")
@@ -221,6 +387,14 @@ Example:
 			if err != nil {
 				return errorsmod.Wrapf(err, "unable to parse early penalty")
 			}
+			claimAndStakeBonus, err := sdk.NewDecFromStr(claimAndStakeBonusString)
+			if err != nil {
+				return errorsmod.Wrapf(err, "unable to parse claim and stake bonus")
+			}
+			claimCondition, ok := types.ClaimCondition_value[strings.ToUpper(claimConditionString)]
+			if !ok {
+				return fmt.Errorf("invalid claim condition %s", claimConditionString)
+			}
 
 			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
@@ -236,6 +410,8 @@ Example:
 				&clawbackDate,
 				&deadlineDate,
 				earlyClaimPenalty,
+				claimAndStakeBonus,
+				types.ClaimCondition(claimCondition),
 				distributorAddress,
 				allocatorAddress,
 				linkerAddress,
@@ -255,6 +431,8 @@ Example:
 	cmd.Flags().String(FlagClawbackDate, "", "Date when rewards are clawed back (after distribution end date)")
 	cmd.Flags().String(FlagClaimTypeDeadlineDate, "", "Deadline to decide on the claim type")
 	cmd.Flags().String(FlagEarlyClaimPenalty, "", "Decimal (0 to 1) representing the penalty for claiming early")
+	cmd.Flags().String(FlagClaimAndStakeBonus, "0", "Decimal (0 to 1) representing the bonus for claiming and liquid staking")
+	cmd.Flags().String(FlagClaimCondition, types.CLAIM_CONDITION_NONE.String(), "Action required to claim rewards (CLAIM_CONDITION_NONE, CLAIM_CONDITION_LIQUID_STAKE, or CLAIM_CONDITION_DELEGATE_STAKE)")
 	cmd.Flags().String(FlagDistributorAddress, "", "Address of the distributor account")
 	cmd.Flags().String(FlagAllocatorAddress, "", "Address of the allocator account")
 	cmd.Flags().String(FlagLinkerAddress, "", "Address of the linker account")
@@ -295,6 +473,8 @@ Example:
 	--clawback-date            2024-07-01T00:00:00 \
 	--claim-type-deadline-date 2024-02-01T00:00:00 \
 	--early-claim-penalty      0.5 \
+	--claim-and-stake-bonus    0.1 \
+	--claim-condition          CLAIM_CONDITION_NONE \
 	--distributor-address     strideXXX \
 	--allocator-address       strideYYY \
 	--linker-address          strideZZZ \
@@ -331,6 +511,14 @@ Example:
 			if err != nil {
 				return errorsmod.Wrapf(err, "unable to parse early claim penalty address")
 			}
+			claimAndStakeBonusString, err := cmd.Flags().GetString(FlagClaimAndStakeBonus)
+			if err != nil {
+				return errorsmod.Wrapf(err, "unable to parse claim and stake bonus")
+			}
+			claimConditionString, err := cmd.Flags().GetString(FlagClaimCondition)
+			if err != nil {
+				return errorsmod.Wrapf(err, "unable to parse claim condition")
+			}
 			distributorAddress, err := cmd.Flags().GetString(FlagDistributorAddress)
 			if err != nil {
 				return errorsmod.Wrapf(err, "unable to parse distribution address")
@@ -365,6 +553,14 @@ Example:
 			if err != nil {
 				return errorsmod.Wrapf(err, "unable to parse early penalty")
 			}
+			claimAndStakeBonus, err := sdk.NewDecFromStr(claimAndStakeBonusString)
+			if err != nil {
+				return errorsmod.Wrapf(err, "unable to parse claim and stake bonus")
+			}
+			claimCondition, ok := types.ClaimCondition_value[strings.ToUpper(claimConditionString)]
+			if !ok {
+				return fmt.Errorf("invalid claim condition %s", claimConditionString)
+			}
 
 			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
@@ -380,6 +576,8 @@ Example:
 				&clawbackDate,
 				&deadlineDate,
 				earlyClaimPenalty,
+				claimAndStakeBonus,
+				types.ClaimCondition(claimCondition),
 				distributorAddress,
 				allocatorAddress,
 				linkerAddress,
@@ -400,6 +598,8 @@ Example:
 	cmd.Flags().String(FlagClawbackDate, "", "Date when rewards are clawed back (after distribution end date)")
 	cmd.Flags().String(FlagClaimTypeDeadlineDate, "", "Deadline to decide on the claim type")
 	cmd.Flags().String(FlagEarlyClaimPenalty, "", "Decimal (0 to 1) representing the penalty for claiming early")
+	cmd.Flags().String(FlagClaimAndStakeBonus, "0", "Decimal (0 to 1) representing the bonus for claiming and liquid staking")
+	cmd.Flags().String(FlagClaimCondition, types.CLAIM_CONDITION_NONE.String(), "Action required to claim rewards (CLAIM_CONDITION_NONE, CLAIM_CONDITION_LIQUID_STAKE, or CLAIM_CONDITION_DELEGATE_STAKE)")
 	cmd.Flags().String(FlagDistributorAddress, "", "Address of the distributor account")
 	cmd.Flags().String(FlagAllocatorAddress, "", "Address of the allocator account")
 	cmd.Flags().String(FlagLinkerAddress, "", "Address of the linker account")
@@ -539,6 +739,10 @@ func CmdLinkAddresses() *cobra.Command {
 
 Example Command:
   $ %[1]s tx %[2]s link-addresses airdrop-1 strideXXX dymXXX --from admin
+
+If the airdrop uses an allocations merkle root and the host address's allocation has
+not yet been stored, include the host address's allocation proof:
+  $ %[1]s tx %[2]s link-addresses airdrop-1 strideXXX dymXXX --proof-file proofs.json --from admin
 `, version.AppName, types.ModuleName),
 		),
 		Args: cobra.ExactArgs(3),
@@ -559,6 +763,62 @@ Example Command:
 				hostAddress,
 			)
 
+			proofFileName, err := cmd.Flags().GetString(FlagProofFile)
+			if err != nil {
+				return err
+			}
+			if proofFileName != "" {
+				msg.AllocationProof, err = ParseAllocationProof(proofFileName, msg.HostAddress)
+				if err != nil {
+					return errorsmod.Wrapf(err, "unable to parse allocation proof")
+				}
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(FlagProofFile, "", "File with the host address's allocation proof, required if its merkle root allocation has not been stored")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// Admin transaction to commit to the user allocations of an airdrop with a merkle root
+func CmdSetAllocationsRoot() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-allocations-root [airdrop-id] [merkle-root]",
+		Short: "Commits to the user allocations of an airdrop with a merkle root",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Commits to the user allocations of an airdrop with a merkle root, instead of
+adding each allocation on-chain. Each user's allocation is stored the first time they
+claim with their allocation proof. The merkle root can be generated from an allocations
+CSV file with the generate-allocation-proofs command.
+
+Example Command:
+  $ %[1]s tx %[2]s set-allocations-root airdrop-1 [merkle-root-hex] --from admin
+`, version.AppName, types.ModuleName),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			airdropId := args[0]
+			merkleRoot := args[1]
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSetAllocationsRoot(
+				clientCtx.GetFromAddress().String(),
+				airdropId,
+				merkleRoot,
+			)
+
 			if err := msg.ValidateBasic(); err != nil {
 				return err
 			}
@@ -571,3 +831,48 @@ Example Command:
 
 	return cmd
 }
+
+// Offline command to generate the merkle root and each user's allocation proof from an allocations CSV
+func CmdGenerateAllocationProofs() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "generate-allocation-proofs [allocations-csv-file] [output-file]",
+		Short: "Generates the allocations merkle root and each user's proof from an allocations CSV",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Generates the allocations merkle root and each user's allocation proof from an
+allocations CSV file. The output file contains the merkle root (to be submitted with
+set-allocations-root) and a proof for each address (to be used with --proof-file when claiming).
+Note: the addresses must be stride addresses since allocations are only stored when the user claims
+
+Example CSV:
+ strideXXX,0,10,10,20,30,40,...
+ strideYYY,0,10,10,20,30,40,...
+
+Example Command:
+  $ %[1]s tx %[2]s generate-allocation-proofs allocations.csv proofs.json
+`, version.AppName, types.ModuleName),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			allocationsFileName := args[0]
+			outputFileName := args[1]
+
+			proofsFile, err := GenerateAllocationProofs(allocationsFileName)
+			if err != nil {
+				return errorsmod.Wrapf(err, "unable to generate allocation proofs")
+			}
+
+			proofsBz, err := json.MarshalIndent(proofsFile, "", "  ")
+			if err != nil {
+				return err
+			}
+			if err := os.WriteFile(outputFileName, proofsBz, 0o600); err != nil {
+				return err
+			}
+
+			cmd.Printf("Merkle root: %s\n", proofsFile.MerkleRoot)
+			return nil
+		},
+	}
+
+	return cmd
+}
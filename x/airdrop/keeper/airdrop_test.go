@@ -11,8 +11,9 @@ import (
 func (s *KeeperTestSuite) addAirdrops() (airdrops []types.Airdrop) {
 	for i := 0; i <= 4; i++ {
 		airdrop := types.Airdrop{
-			Id:                fmt.Sprintf("airdrop-%d", i),
-			EarlyClaimPenalty: sdk.ZeroDec(),
+			Id:                 fmt.Sprintf("airdrop-%d", i),
+			EarlyClaimPenalty:  sdk.ZeroDec(),
+			ClaimAndStakeBonus: sdk.ZeroDec(),
 		}
 		airdrops = append(airdrops, airdrop)
 		s.App.AirdropKeeper.SetAirdrop(s.Ctx, airdrop)
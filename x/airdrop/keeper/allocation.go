@@ -81,3 +81,42 @@ func (k Keeper) GetUserAllocationsForAddress(ctx sdk.Context, address string) (u
 	}
 	return userAllocations
 }
+
+// Records that a user's merkle leaf has been stored on-chain
+func (k Keeper) SetMaterializedAllocation(ctx sdk.Context, materializedAllocation types.MaterializedAllocation) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.MaterializedAllocationKeyPrefix)
+	key := types.UserAllocationKey(materializedAllocation.AirdropId, materializedAllocation.Address)
+	store.Set(key, k.cdc.MustMarshal(&materializedAllocation))
+}
+
+// Checks whether a user's merkle leaf has already been stored on-chain
+func (k Keeper) IsAllocationMaterialized(ctx sdk.Context, airdropId, address string) bool {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.MaterializedAllocationKeyPrefix)
+	return store.Has(types.UserAllocationKey(airdropId, address))
+}
+
+// Checks whether any merkle leaf has been stored on-chain for an airdrop
+func (k Keeper) HasMaterializedAllocations(ctx sdk.Context, airdropId string) bool {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.MaterializedAllocationKeyPrefix)
+
+	iterator := sdk.KVStorePrefixIterator(store, types.AirdropAddressesKeyPrefix(airdropId))
+	defer iterator.Close()
+
+	return iterator.Valid()
+}
+
+// Retrieves all materialized allocation records across all airdrops
+func (k Keeper) GetAllMaterializedAllocations(ctx sdk.Context) (materializedAllocations []types.MaterializedAllocation) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.MaterializedAllocationKeyPrefix)
+
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		materializedAllocation := types.MaterializedAllocation{}
+		k.cdc.MustUnmarshal(iterator.Value(), &materializedAllocation)
+		materializedAllocations = append(materializedAllocations, materializedAllocation)
+	}
+
+	return materializedAllocations
+}
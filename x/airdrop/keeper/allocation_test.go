@@ -12,10 +12,13 @@ import (
 // for pointers
 func newUserAllocation(airdropId, address string) types.UserAllocation {
 	return types.UserAllocation{
-		AirdropId: airdropId,
-		Address:   address,
-		Claimed:   sdkmath.ZeroInt(),
-		Forfeited: sdkmath.ZeroInt(),
+		AirdropId:    airdropId,
+		Address:      address,
+		Claimed:      sdkmath.ZeroInt(),
+		Forfeited:    sdkmath.ZeroInt(),
+		Staked:       sdkmath.ZeroInt(),
+		StakingBonus: sdkmath.ZeroInt(),
+		Vested:       sdkmath.ZeroInt(),
 	}
 }
 
@@ -4,12 +4,89 @@ import (
 	errorsmod "cosmossdk.io/errors"
 	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 
+	"github.com/Stride-Labs/stride/v24/utils"
 	"github.com/Stride-Labs/stride/v24/x/airdrop/types"
+	vestingtypes "github.com/Stride-Labs/stride/v24/x/claim/vesting/types"
+	stakeibckeeper "github.com/Stride-Labs/stride/v24/x/stakeibc/keeper"
+	stakeibctypes "github.com/Stride-Labs/stride/v24/x/stakeibc/types"
 )
 
+// For airdrops that commit to allocations with a merkle root, stores the user's
+// allocation the first time they claim (or are linked), after verifying their proof
+// If the user's leaf has already been stored, the proof is ignored
+// If the address already has an allocation (from being linked to another address),
+// the leaf's allocations are added to it
+func (k Keeper) MaterializeAllocationFromProof(ctx sdk.Context, airdropId, claimer string, proof types.AllocationProof) error {
+	airdrop, airdropFound := k.GetAirdrop(ctx, airdropId)
+	if !airdropFound {
+		return types.ErrAirdropNotFound.Wrapf("airdrop %s", airdropId)
+	}
+	if airdrop.AllocationsMerkleRoot == "" {
+		return types.ErrInvalidMerkleProof.Wrapf("airdrop %s does not use an allocations merkle root", airdropId)
+	}
+	if airdrop.Finalized {
+		return types.ErrAirdropFinalized.Wrapf("airdrop %s", airdropId)
+	}
+	if k.IsAllocationMaterialized(ctx, airdropId, claimer) {
+		return nil
+	}
+
+	periodLengthSeconds := k.GetParams(ctx).PeriodLengthSeconds
+	expectedDays := airdrop.GetAirdropPeriods(periodLengthSeconds)
+	if len(proof.Allocations) != int(expectedDays) {
+		return types.ErrInvalidAllocationListLength.Wrapf("expected %d, provided %d", expectedDays, len(proof.Allocations))
+	}
+
+	if err := proof.Verify(airdrop.AllocationsMerkleRoot, claimer); err != nil {
+		return err
+	}
+
+	userAllocation, userFound := k.GetUserAllocation(ctx, airdropId, claimer)
+	if userFound {
+		if len(userAllocation.Allocations) != len(proof.Allocations) {
+			return types.ErrInvalidAllocationListLength.Wrapf("current allocations length: %d, provided length: %d",
+				len(userAllocation.Allocations), len(proof.Allocations))
+		}
+		for i, reward := range proof.Allocations {
+			userAllocation.Allocations[i] = userAllocation.Allocations[i].Add(reward)
+		}
+	} else {
+		userAllocation = types.UserAllocation{
+			AirdropId:    airdropId,
+			Address:      claimer,
+			Claimed:      sdkmath.ZeroInt(),
+			Forfeited:    sdkmath.ZeroInt(),
+			Staked:       sdkmath.ZeroInt(),
+			StakingBonus: sdkmath.ZeroInt(),
+			Vested:       sdkmath.ZeroInt(),
+			Allocations:  proof.Allocations,
+		}
+	}
+	k.SetUserAllocation(ctx, userAllocation)
+	k.SetMaterializedAllocation(ctx, types.MaterializedAllocation{AirdropId: airdropId, Address: claimer})
+
+	return nil
+}
+
 // User transaction to claim all the pending airdrop rewards up to the current day
+// Airdrops that are gated by a claim condition cannot be claimed directly
 func (k Keeper) ClaimDaily(ctx sdk.Context, airdropId, claimer string) error {
+	airdrop, airdropFound := k.GetAirdrop(ctx, airdropId)
+	if !airdropFound {
+		return types.ErrAirdropNotFound.Wrapf("airdrop %s", airdropId)
+	}
+	if airdrop.ClaimCondition != types.CLAIM_CONDITION_NONE {
+		return types.ErrClaimConditionNotMet.Wrapf("airdrop %s is claimed with %s", airdropId, airdrop.ClaimCondition)
+	}
+
+	return k.claimAccruedRewards(ctx, airdropId, claimer)
+}
+
+// Claims all the pending airdrop rewards up to the current day
+// Used for both daily claims and claims triggered by the completion of a claim condition
+func (k Keeper) claimAccruedRewards(ctx sdk.Context, airdropId, claimer string) error {
 	// Fetch the airdrop and user's allocations
 	airdrop, airdropFound := k.GetAirdrop(ctx, airdropId)
 	if !airdropFound {
@@ -78,6 +155,9 @@ func (k Keeper) ClaimEarly(ctx sdk.Context, airdropId, claimer string) error {
 	if !airdropFound {
 		return types.ErrAirdropNotFound.Wrapf("airdrop %s", airdropId)
 	}
+	if airdrop.ClaimCondition != types.CLAIM_CONDITION_NONE {
+		return types.ErrClaimConditionNotMet.Wrapf("airdrop %s is claimed with %s", airdropId, airdrop.ClaimCondition)
+	}
 	userAllocation, userFound := k.GetUserAllocation(ctx, airdropId, claimer)
 	if !userFound {
 		return types.ErrUserAllocationNotFound.Wrapf("user %s for airdrop %s", claimer, airdropId)
@@ -134,6 +214,225 @@ func (k Keeper) ClaimEarly(ctx sdk.Context, airdropId, claimer string) error {
 	return nil
 }
 
+// User transaction to claim all the pending airdrop rewards up to the current day (same as ClaimDaily)
+// and liquid stake them, along with the airdrop's staking bonus which is paid from the distributor
+// The reward denom must be the IBC denom of a stakeibc host zone
+// Since the rewards are liquid staked, this also satisfies the liquid stake claim condition
+// Returns the stTokens that were minted to the user
+func (k Keeper) ClaimAndStake(ctx sdk.Context, airdropId, claimer string) (stToken sdk.Coin, err error) {
+	// Fetch the airdrop and user's allocations
+	airdrop, airdropFound := k.GetAirdrop(ctx, airdropId)
+	if !airdropFound {
+		return stToken, types.ErrAirdropNotFound.Wrapf("airdrop %s", airdropId)
+	}
+	if airdrop.ClaimCondition != types.CLAIM_CONDITION_NONE && airdrop.ClaimCondition != types.CLAIM_CONDITION_LIQUID_STAKE {
+		return stToken, types.ErrClaimConditionNotMet.Wrapf("airdrop %s is claimed with %s", airdropId, airdrop.ClaimCondition)
+	}
+	userAllocation, userFound := k.GetUserAllocation(ctx, airdropId, claimer)
+	if !userFound {
+		return stToken, types.ErrUserAllocationNotFound.Wrapf("user %s for airdrop %s", claimer, airdropId)
+	}
+
+	// Confirm the airdrop started
+	currentTime := ctx.BlockTime().Unix()
+	if currentTime < airdrop.DistributionStartDate.Unix() {
+		return stToken, types.ErrAirdropNotStarted
+	}
+
+	// Confirm we're not passed the clawback date
+	if currentTime >= airdrop.ClawbackDate.Unix() {
+		return stToken, types.ErrAirdropEnded
+	}
+
+	// Confirm the rewards can be liquid staked
+	hostZone, err := k.stakeibcKeeper.GetHostZoneFromIBCDenom(ctx, airdrop.RewardDenom)
+	if err != nil {
+		return stToken, types.ErrRewardDenomNotStakeable.Wrapf("no host zone found for %s", airdrop.RewardDenom)
+	}
+
+	// Get the index in the allocations array from the current date
+	periodLengthSeconds := k.GetParams(ctx).PeriodLengthSeconds
+	todaysIndex, err := airdrop.GetCurrentDateIndex(ctx, periodLengthSeconds)
+	if err != nil {
+		return stToken, err
+	}
+
+	// Sum the rewards up to that date and 0 them out in the process
+	todaysRewards := sdkmath.ZeroInt()
+	for i := 0; i <= todaysIndex; i++ {
+		rewardsOnDate := userAllocation.Allocations[i]
+		todaysRewards = todaysRewards.Add(rewardsOnDate)
+		userAllocation.Allocations[i] = sdkmath.ZeroInt()
+	}
+
+	// If there are no rewards, alert the user with an error
+	if todaysRewards.IsZero() {
+		return stToken, types.ErrNoUnclaimedRewards
+	}
+
+	// Calculate the staking bonus (airdrops created before the bonus was introduced have a nil rate)
+	bonusRate := sdk.ZeroDec()
+	if !airdrop.ClaimAndStakeBonus.IsNil() {
+		bonusRate = airdrop.ClaimAndStakeBonus
+	}
+	stakingBonus := sdk.NewDecFromInt(todaysRewards).Mul(bonusRate).TruncateInt()
+
+	// Update the claimed and staked amounts on the allocation record
+	// The bonus is tracked separately so that the claimed amount still reconciles with the allocations
+	if userAllocation.Staked.IsNil() {
+		userAllocation.Staked = sdkmath.ZeroInt()
+	}
+	if userAllocation.StakingBonus.IsNil() {
+		userAllocation.StakingBonus = sdkmath.ZeroInt()
+	}
+	userAllocation.Claimed = userAllocation.Claimed.Add(todaysRewards)
+	userAllocation.Staked = userAllocation.Staked.Add(todaysRewards)
+	userAllocation.StakingBonus = userAllocation.StakingBonus.Add(stakingBonus)
+
+	// Update the reward record for to mark the progress
+	k.SetUserAllocation(ctx, userAllocation)
+
+	// Distribute the rewards and bonus from the distributor
+	distributorAccount := sdk.MustAccAddressFromBech32(airdrop.DistributorAddress)
+	claimerAccount := sdk.MustAccAddressFromBech32(userAllocation.Address)
+	stakeAmount := todaysRewards.Add(stakingBonus)
+	rewardsCoin := sdk.NewCoin(airdrop.RewardDenom, stakeAmount)
+
+	if err := k.bankKeeper.SendCoins(ctx, distributorAccount, claimerAccount, sdk.NewCoins(rewardsCoin)); err != nil {
+		return stToken, errorsmod.Wrapf(err, "unable to distribute rewards")
+	}
+
+	// Liquid stake the rewards on behalf of the user
+	liquidStakeMsg := &stakeibctypes.MsgLiquidStake{
+		Creator:   claimer,
+		Amount:    stakeAmount,
+		HostDenom: hostZone.HostDenom,
+	}
+	msgServer := stakeibckeeper.NewMsgServerImpl(*k.stakeibcKeeper)
+	liquidStakeResponse, err := msgServer.LiquidStake(sdk.WrapSDKContext(ctx), liquidStakeMsg)
+	if err != nil {
+		return stToken, errorsmod.Wrapf(err, "unable to liquid stake rewards")
+	}
+
+	return liquidStakeResponse.StToken, nil
+}
+
+// User transaction to claim all their remaining rewards up front without a penalty, but into
+// a vesting account that mirrors their allocations
+// Each period's allocation unlocks linearly over the course of that period. Allocations from
+// periods that have already passed are unlocked immediately, and the current period unlocks over
+// its remaining time (this ensures vesting periods never start before the current block time)
+func (k Keeper) ClaimAndVest(ctx sdk.Context, airdropId, claimer string) error {
+	// Fetch the airdrop and user's allocations
+	airdrop, airdropFound := k.GetAirdrop(ctx, airdropId)
+	if !airdropFound {
+		return types.ErrAirdropNotFound.Wrapf("airdrop %s", airdropId)
+	}
+	if airdrop.ClaimCondition != types.CLAIM_CONDITION_NONE {
+		return types.ErrClaimConditionNotMet.Wrapf("airdrop %s is claimed with %s", airdropId, airdrop.ClaimCondition)
+	}
+	userAllocation, userFound := k.GetUserAllocation(ctx, airdropId, claimer)
+	if !userFound {
+		return types.ErrUserAllocationNotFound.Wrapf("user %s for airdrop %s", claimer, airdropId)
+	}
+
+	// Confirm the airdrop started
+	currentTime := ctx.BlockTime().Unix()
+	if currentTime < airdrop.DistributionStartDate.Unix() {
+		return types.ErrAirdropNotStarted
+	}
+
+	// Confirm we're not past the decision date
+	if currentTime > airdrop.ClaimTypeDeadlineDate.Unix() {
+		return types.ErrAfterDecisionDeadline
+	}
+
+	// Build a vesting period for each remaining allocation and 0 them out in the process
+	periodLengthSeconds := k.GetParams(ctx).PeriodLengthSeconds
+	totalAccruedRewards := sdkmath.ZeroInt()
+	vestingPeriods := vestingtypes.Periods{}
+	for i, rewardsOnDate := range userAllocation.Allocations {
+		if rewardsOnDate.IsZero() {
+			continue
+		}
+		totalAccruedRewards = totalAccruedRewards.Add(rewardsOnDate)
+		userAllocation.Allocations[i] = sdkmath.ZeroInt()
+
+		// If the period has already passed, the rewards are not locked
+		periodStartTime := airdrop.DistributionStartDate.Unix() + (int64(i) * periodLengthSeconds)
+		periodEndTime := periodStartTime + periodLengthSeconds
+		if periodEndTime <= currentTime {
+			continue
+		}
+
+		vestingStartTime := utils.Max64(periodStartTime, currentTime)
+		vestingPeriods = append(vestingPeriods, vestingtypes.Period{
+			StartTime: vestingStartTime,
+			Length:    periodEndTime - vestingStartTime,
+			Amount:    sdk.NewCoins(sdk.NewCoin(airdrop.RewardDenom, rewardsOnDate)),
+		})
+	}
+
+	// If there are no rewards, alert the user with an error
+	if totalAccruedRewards.IsZero() {
+		return types.ErrNoUnclaimedRewards
+	}
+
+	// Update the claimed and vested amounts on the allocation record
+	if userAllocation.Vested.IsNil() {
+		userAllocation.Vested = sdkmath.ZeroInt()
+	}
+	userAllocation.Claimed = userAllocation.Claimed.Add(totalAccruedRewards)
+	userAllocation.Vested = userAllocation.Vested.Add(totalAccruedRewards)
+
+	// Update the reward record for to mark the progress
+	k.SetUserAllocation(ctx, userAllocation)
+
+	// Lock the rewards in the claimer's vesting account before they're sent
+	claimerAccount := sdk.MustAccAddressFromBech32(userAllocation.Address)
+	if len(vestingPeriods) > 0 {
+		if err := k.GrantVestingPeriods(ctx, claimerAccount, vestingPeriods); err != nil {
+			return err
+		}
+	}
+
+	// Distribute rewards from the distributor
+	distributorAccount := sdk.MustAccAddressFromBech32(airdrop.DistributorAddress)
+	rewardsCoin := sdk.NewCoin(airdrop.RewardDenom, totalAccruedRewards)
+
+	if err := k.bankKeeper.SendCoins(ctx, distributorAccount, claimerAccount, sdk.NewCoins(rewardsCoin)); err != nil {
+		return errorsmod.Wrapf(err, "unable to distribute rewards")
+	}
+
+	return nil
+}
+
+// Adds vesting periods to an account, converting it into a StridePeriodicVestingAccount if necessary
+// Only base accounts (or new accounts) and existing stride vesting accounts are supported
+func (k Keeper) GrantVestingPeriods(ctx sdk.Context, address sdk.AccAddress, periods vestingtypes.Periods) error {
+	account := k.accountKeeper.GetAccount(ctx, address)
+	if account == nil {
+		account = k.accountKeeper.NewAccountWithAddress(ctx, address)
+	}
+
+	switch typedAccount := account.(type) {
+	case *vestingtypes.StridePeriodicVestingAccount:
+		for _, period := range periods {
+			typedAccount.AddNewGrant(period)
+		}
+		k.accountKeeper.SetAccount(ctx, typedAccount)
+
+	case *authtypes.BaseAccount:
+		vestingAccount := vestingtypes.NewStridePeriodicVestingAccount(typedAccount, periods.TotalAmount(), periods)
+		k.accountKeeper.SetAccount(ctx, vestingAccount)
+
+	default:
+		return types.ErrInvalidVestingAccount.Wrapf("account %s has type %T", address, account)
+	}
+
+	return nil
+}
+
 // Admin transaction to merge allocations between a stride and non-stride address
 // If the stride address does not yet have an allocation, the host allocation will be overwritten
 // with the stride address
@@ -5,8 +5,14 @@ import (
 
 	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 
+	"github.com/Stride-Labs/stride/v24/app/apptesting"
 	"github.com/Stride-Labs/stride/v24/x/airdrop/types"
+	vestingtypes "github.com/Stride-Labs/stride/v24/x/claim/vesting/types"
+	epochtypes "github.com/Stride-Labs/stride/v24/x/epochs/types"
+	recordstypes "github.com/Stride-Labs/stride/v24/x/records/types"
+	stakeibctypes "github.com/Stride-Labs/stride/v24/x/stakeibc/types"
 )
 
 // Helper function to cast an array of allocations as int64's into sdkmath.Ints
@@ -25,6 +31,96 @@ func allocationsToInt64(allocationsSdkInt []sdkmath.Int) (allocationsInt64 []int
 	return allocationsInt64
 }
 
+func (s *KeeperTestSuite) TestMaterializeAllocationFromProof() {
+	claimer := "claimer"
+	allocations := allocationsToSdkInt([]int64{10, 20, 30})
+
+	// Create a 3 day airdrop that commits to the claimer's allocations with a merkle root
+	startDate := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	endDate := time.Date(2024, 1, 3, 0, 0, 0, 0, time.UTC)
+
+	merkleRoot, proofs, err := types.BuildAllocationProofs([]types.RawAllocation{
+		{UserAddress: claimer, Allocations: allocations},
+		{UserAddress: "other", Allocations: allocations},
+	})
+	s.Require().NoError(err, "no error expected when building proofs")
+
+	airdrop := types.Airdrop{
+		Id:                    AirdropId,
+		DistributionStartDate: &startDate,
+		DistributionEndDate:   &endDate,
+	}
+	s.App.AirdropKeeper.SetAirdrop(s.Ctx, airdrop)
+
+	// Attempt to materialize before the root is set, it should fail
+	err = s.App.AirdropKeeper.MaterializeAllocationFromProof(s.Ctx, AirdropId, claimer, proofs[0])
+	s.Require().ErrorContains(err, "does not use an allocations merkle root")
+
+	// Set the root and materialize the allocation
+	airdrop.AllocationsMerkleRoot = merkleRoot
+	s.App.AirdropKeeper.SetAirdrop(s.Ctx, airdrop)
+
+	err = s.App.AirdropKeeper.MaterializeAllocationFromProof(s.Ctx, AirdropId, claimer, proofs[0])
+	s.Require().NoError(err, "no error expected when materializing allocation")
+
+	expectedUserAllocation := types.UserAllocation{
+		AirdropId:    AirdropId,
+		Address:      claimer,
+		Claimed:      sdkmath.ZeroInt(),
+		Forfeited:    sdkmath.ZeroInt(),
+		Staked:       sdkmath.ZeroInt(),
+		StakingBonus: sdkmath.ZeroInt(),
+		Vested:       sdkmath.ZeroInt(),
+		Allocations:  allocations,
+	}
+	s.Require().Equal(expectedUserAllocation, s.MustGetUserAllocation(AirdropId, claimer), "user allocation")
+
+	// Update the stored allocation and call materialize again, it should be a no-op
+	updatedUserAllocation := expectedUserAllocation
+	updatedUserAllocation.Claimed = sdkmath.NewInt(10)
+	s.App.AirdropKeeper.SetUserAllocation(s.Ctx, updatedUserAllocation)
+
+	err = s.App.AirdropKeeper.MaterializeAllocationFromProof(s.Ctx, AirdropId, claimer, proofs[0])
+	s.Require().NoError(err, "no error expected when materializing an existing allocation")
+	s.Require().Equal(updatedUserAllocation, s.MustGetUserAllocation(AirdropId, claimer), "user allocation after no-op")
+
+	// Attempt to materialize with another user's proof, it should fail
+	err = s.App.AirdropKeeper.MaterializeAllocationFromProof(s.Ctx, AirdropId, "different", proofs[1])
+	s.Require().ErrorIs(err, types.ErrInvalidMerkleProof)
+
+	// Store an allocation for the other user (e.g. from being linked to a host address)
+	// When their leaf is materialized, it should be added to the existing allocation
+	linkedUserAllocation := expectedUserAllocation
+	linkedUserAllocation.Address = "other"
+	linkedUserAllocation.Allocations = allocationsToSdkInt([]int64{1, 2, 3})
+	s.App.AirdropKeeper.SetUserAllocation(s.Ctx, linkedUserAllocation)
+	s.Require().False(s.App.AirdropKeeper.IsAllocationMaterialized(s.Ctx, AirdropId, "other"), "other not yet materialized")
+
+	err = s.App.AirdropKeeper.MaterializeAllocationFromProof(s.Ctx, AirdropId, "other", proofs[1])
+	s.Require().NoError(err, "no error expected when materializing on top of a linked allocation")
+
+	mergedUserAllocation := s.MustGetUserAllocation(AirdropId, "other")
+	s.Require().Equal([]int64{11, 22, 33}, allocationsToInt64(mergedUserAllocation.Allocations), "merged allocations")
+	s.Require().True(s.App.AirdropKeeper.IsAllocationMaterialized(s.Ctx, AirdropId, "other"), "other materialized")
+
+	// Materializing the same leaf again should not add it a second time
+	err = s.App.AirdropKeeper.MaterializeAllocationFromProof(s.Ctx, AirdropId, "other", proofs[1])
+	s.Require().NoError(err, "no error expected when re-materializing")
+	mergedUserAllocation = s.MustGetUserAllocation(AirdropId, "other")
+	s.Require().Equal([]int64{11, 22, 33}, allocationsToInt64(mergedUserAllocation.Allocations), "allocations after re-materializing")
+
+	// Finalize the airdrop and try to materialize a new user, it should fail
+	airdrop.Finalized = true
+	s.App.AirdropKeeper.SetAirdrop(s.Ctx, airdrop)
+
+	err = s.App.AirdropKeeper.MaterializeAllocationFromProof(s.Ctx, AirdropId, "different", proofs[1])
+	s.Require().ErrorIs(err, types.ErrAirdropFinalized)
+
+	// Attempt to materialize for an airdrop that doesn't exist, it should fail
+	err = s.App.AirdropKeeper.MaterializeAllocationFromProof(s.Ctx, "fake-airdrop", claimer, proofs[0])
+	s.Require().ErrorIs(err, types.ErrAirdropNotFound)
+}
+
 func (s *KeeperTestSuite) TestClaimDaily() {
 	testCases := []struct {
 		name                string
@@ -177,11 +273,14 @@ func (s *KeeperTestSuite) TestClaimDaily() {
 
 			// Create the initial user and allocations
 			s.App.AirdropKeeper.SetUserAllocation(s.Ctx, types.UserAllocation{
-				AirdropId:   AirdropId,
-				Address:     claimer.String(),
-				Claimed:     sdkmath.NewInt(tc.initialClaimed),
-				Forfeited:   sdkmath.NewInt(tc.initialForfeited),
-				Allocations: allocationsToSdkInt(tc.initialAllocations),
+				AirdropId:    AirdropId,
+				Address:      claimer.String(),
+				Claimed:      sdkmath.NewInt(tc.initialClaimed),
+				Forfeited:    sdkmath.NewInt(tc.initialForfeited),
+				Staked:       sdkmath.ZeroInt(),
+				StakingBonus: sdkmath.ZeroInt(),
+				Vested:       sdkmath.ZeroInt(),
+				Allocations:  allocationsToSdkInt(tc.initialAllocations),
 			})
 
 			// Call claim daily
@@ -324,11 +423,14 @@ func (s *KeeperTestSuite) TestClaimEarly() {
 
 			// Create the initial user and allocations
 			s.App.AirdropKeeper.SetUserAllocation(s.Ctx, types.UserAllocation{
-				AirdropId:   AirdropId,
-				Address:     claimer.String(),
-				Claimed:     sdkmath.NewInt(tc.initialClaimed),
-				Forfeited:   sdkmath.ZeroInt(),
-				Allocations: allocationsToSdkInt(tc.initialAllocations),
+				AirdropId:    AirdropId,
+				Address:      claimer.String(),
+				Claimed:      sdkmath.NewInt(tc.initialClaimed),
+				Forfeited:    sdkmath.ZeroInt(),
+				Staked:       sdkmath.ZeroInt(),
+				StakingBonus: sdkmath.ZeroInt(),
+				Vested:       sdkmath.ZeroInt(),
+				Allocations:  allocationsToSdkInt(tc.initialAllocations),
 			})
 
 			// Call claim daily
@@ -359,6 +461,292 @@ func (s *KeeperTestSuite) TestClaimEarly() {
 	}
 }
 
+// Helper function to register a host zone whose IBC denom can be liquid staked
+func (s *KeeperTestSuite) setupClaimAndStakeHostZone(redemptionRate sdk.Dec) (ibcDenom string) {
+	hostDenom := "uatom"
+	ibcDenom = "ibc/atom"
+	depositAddress := s.TestAccs[2]
+
+	s.App.StakeibcKeeper.SetEpochTracker(s.Ctx, stakeibctypes.EpochTracker{
+		EpochIdentifier: epochtypes.STRIDE_EPOCH,
+		EpochNumber:     1,
+	})
+	s.App.RecordsKeeper.SetDepositRecord(s.Ctx, recordstypes.DepositRecord{
+		Id:                 1,
+		DepositEpochNumber: 1,
+		Amount:             sdkmath.ZeroInt(),
+		HostZoneId:         "chain-0",
+		Status:             recordstypes.DepositRecord_TRANSFER_QUEUE,
+	})
+	s.App.StakeibcKeeper.SetHostZone(s.Ctx, stakeibctypes.HostZone{
+		ChainId:        "chain-0",
+		HostDenom:      hostDenom,
+		IbcDenom:       ibcDenom,
+		RedemptionRate: redemptionRate,
+		DepositAddress: depositAddress.String(),
+	})
+
+	return ibcDenom
+}
+
+func (s *KeeperTestSuite) TestClaimAndStake() {
+	claimer := s.TestAccs[0]
+	distributor := s.TestAccs[1]
+	depositAddress := s.TestAccs[2]
+
+	// Register the host zone with a redemption rate of 1.1
+	rewardDenom := s.setupClaimAndStakeHostZone(sdk.MustNewDecFromStr("1.1"))
+	stDenom := "stuatom"
+
+	// Fund the distributor
+	initialDistributorBalance := sdkmath.NewInt(10000)
+	s.FundAccount(distributor, sdk.NewCoin(rewardDenom, initialDistributorBalance))
+
+	// Create a 3 day airdrop with a 10% claim and stake bonus
+	startDate := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	endDate := time.Date(2024, 1, 3, 0, 0, 0, 0, time.UTC)
+	clawbackDate := time.Date(2024, 1, 10, 0, 0, 0, 0, time.UTC)
+
+	s.App.AirdropKeeper.SetAirdrop(s.Ctx, types.Airdrop{
+		Id:                    AirdropId,
+		RewardDenom:           rewardDenom,
+		DistributorAddress:    distributor.String(),
+		DistributionStartDate: &startDate,
+		DistributionEndDate:   &endDate,
+		ClawbackDate:          &clawbackDate,
+		ClaimAndStakeBonus:    sdk.MustNewDecFromStr("0.1"),
+	})
+	s.App.AirdropKeeper.SetUserAllocation(s.Ctx, types.UserAllocation{
+		AirdropId:    AirdropId,
+		Address:      claimer.String(),
+		Claimed:      sdkmath.ZeroInt(),
+		Forfeited:    sdkmath.ZeroInt(),
+		Staked:       sdkmath.ZeroInt(),
+		StakingBonus: sdkmath.ZeroInt(),
+		Vested:       sdkmath.ZeroInt(),
+		Allocations:  allocationsToSdkInt([]int64{100, 200, 300}),
+	})
+
+	// Attempt to claim before the airdrop starts, it should fail
+	s.Ctx = s.Ctx.WithBlockTime(startDate.Add(-1 * time.Hour))
+	_, err := s.App.AirdropKeeper.ClaimAndStake(s.Ctx, AirdropId, claimer.String())
+	s.Require().ErrorIs(err, types.ErrAirdropNotStarted)
+
+	// Claim and stake on the second day
+	// Rewards: 100 + 200 = 300, Bonus: 30, stTokens: 330 / 1.1 = 300
+	s.Ctx = s.Ctx.WithBlockTime(startDate.Add(24 * time.Hour).Add(time.Hour))
+	stToken, err := s.App.AirdropKeeper.ClaimAndStake(s.Ctx, AirdropId, claimer.String())
+	s.Require().NoError(err, "no error expected when claiming and staking")
+	s.Require().Equal(sdk.NewInt64Coin(stDenom, 300), stToken, "st token")
+
+	userAllocation := s.MustGetUserAllocation(AirdropId, claimer.String())
+	s.Require().Equal([]int64{0, 0, 300}, allocationsToInt64(userAllocation.Allocations), "allocations")
+	s.Require().Equal(int64(300), userAllocation.Claimed.Int64(), "claimed")
+	s.Require().Equal(int64(300), userAllocation.Staked.Int64(), "staked")
+	s.Require().Equal(int64(30), userAllocation.StakingBonus.Int64(), "staking bonus")
+
+	s.Require().Equal(int64(300), s.App.BankKeeper.GetBalance(s.Ctx, claimer, stDenom).Amount.Int64(), "claimer st balance")
+	s.Require().Zero(s.App.BankKeeper.GetBalance(s.Ctx, claimer, rewardDenom).Amount.Int64(), "claimer native balance")
+	s.Require().Equal(int64(330), s.App.BankKeeper.GetBalance(s.Ctx, depositAddress, rewardDenom).Amount.Int64(), "deposit balance")
+	s.Require().Equal(int64(9670), s.App.BankKeeper.GetBalance(s.Ctx, distributor, rewardDenom).Amount.Int64(), "distributor balance")
+
+	// Attempt to claim again on the same day, there should be no rewards left
+	_, err = s.App.AirdropKeeper.ClaimAndStake(s.Ctx, AirdropId, claimer.String())
+	s.Require().ErrorIs(err, types.ErrNoUnclaimedRewards)
+
+	// Claim daily (without staking) on the third day, the staked amount should not change
+	s.Ctx = s.Ctx.WithBlockTime(s.Ctx.BlockTime().Add(24 * time.Hour))
+	err = s.App.AirdropKeeper.ClaimDaily(s.Ctx, AirdropId, claimer.String())
+	s.Require().NoError(err, "no error expected when claiming daily")
+
+	userAllocation = s.MustGetUserAllocation(AirdropId, claimer.String())
+	s.Require().Equal(int64(600), userAllocation.Claimed.Int64(), "claimed after daily claim")
+	s.Require().Equal(int64(300), userAllocation.Staked.Int64(), "staked after daily claim")
+	s.Require().Equal(int64(30), userAllocation.StakingBonus.Int64(), "staking bonus after daily claim")
+
+	// Check that the user summary reflects the staked claims
+	summary, err := s.App.AirdropKeeper.UserSummary(sdk.WrapSDKContext(s.Ctx), &types.QueryUserSummaryRequest{
+		AirdropId: AirdropId,
+		Address:   claimer.String(),
+	})
+	s.Require().NoError(err, "no error expected when querying user summary")
+	s.Require().Equal(types.CLAIM_AND_STAKE.String(), summary.ClaimType, "claim type")
+	s.Require().Equal(int64(300), summary.Staked.Int64(), "summary staked")
+	s.Require().Equal(int64(30), summary.StakingBonus.Int64(), "summary staking bonus")
+
+	// Attempt to claim after the clawback date, it should fail
+	s.Ctx = s.Ctx.WithBlockTime(clawbackDate)
+	_, err = s.App.AirdropKeeper.ClaimAndStake(s.Ctx, AirdropId, claimer.String())
+	s.Require().ErrorIs(err, types.ErrAirdropEnded)
+}
+
+func (s *KeeperTestSuite) TestClaimAndStake_RewardDenomNotStakeable() {
+	claimer := s.TestAccs[0]
+	distributor := s.TestAccs[1]
+
+	// Create an airdrop with a reward denom that has no host zone
+	s.App.AirdropKeeper.SetAirdrop(s.Ctx, types.Airdrop{
+		Id:                    AirdropId,
+		RewardDenom:           RewardDenom,
+		DistributorAddress:    distributor.String(),
+		DistributionStartDate: &DistributionStartDate,
+		DistributionEndDate:   &DistributionEndDate,
+		ClawbackDate:          &ClawbackDate,
+	})
+	s.App.AirdropKeeper.SetUserAllocation(s.Ctx, types.UserAllocation{
+		AirdropId:    AirdropId,
+		Address:      claimer.String(),
+		Claimed:      sdkmath.ZeroInt(),
+		Forfeited:    sdkmath.ZeroInt(),
+		Staked:       sdkmath.ZeroInt(),
+		StakingBonus: sdkmath.ZeroInt(),
+		Vested:       sdkmath.ZeroInt(),
+		Allocations:  allocationsToSdkInt([]int64{10, 10, 10}),
+	})
+
+	s.Ctx = s.Ctx.WithBlockTime(DistributionStartDate.Add(time.Hour))
+	_, err := s.App.AirdropKeeper.ClaimAndStake(s.Ctx, AirdropId, claimer.String())
+	s.Require().ErrorIs(err, types.ErrRewardDenomNotStakeable)
+
+	// Confirm the allocation was not touched
+	userAllocation := s.MustGetUserAllocation(AirdropId, claimer.String())
+	s.Require().Equal(int64(0), userAllocation.Claimed.Int64(), "claimed")
+}
+
+func (s *KeeperTestSuite) TestClaimAndVest() {
+	claimer := s.TestAccs[0]
+	distributor := s.TestAccs[1]
+
+	// Fund the distributor
+	initialDistributorBalance := sdkmath.NewInt(10000)
+	s.FundAccount(distributor, sdk.NewCoin(RewardDenom, initialDistributorBalance))
+
+	// Create a 3 day airdrop
+	startDate := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	endDate := time.Date(2024, 1, 3, 0, 0, 0, 0, time.UTC)
+	deadlineDate := time.Date(2024, 1, 2, 12, 0, 0, 0, time.UTC)
+	clawbackDate := time.Date(2024, 1, 10, 0, 0, 0, 0, time.UTC)
+
+	s.App.AirdropKeeper.SetAirdrop(s.Ctx, types.Airdrop{
+		Id:                    AirdropId,
+		RewardDenom:           RewardDenom,
+		DistributorAddress:    distributor.String(),
+		DistributionStartDate: &startDate,
+		DistributionEndDate:   &endDate,
+		ClaimTypeDeadlineDate: &deadlineDate,
+		ClawbackDate:          &clawbackDate,
+	})
+	s.App.AirdropKeeper.SetUserAllocation(s.Ctx, types.UserAllocation{
+		AirdropId:    AirdropId,
+		Address:      claimer.String(),
+		Claimed:      sdkmath.ZeroInt(),
+		Forfeited:    sdkmath.ZeroInt(),
+		Staked:       sdkmath.ZeroInt(),
+		StakingBonus: sdkmath.ZeroInt(),
+		Vested:       sdkmath.ZeroInt(),
+		Allocations:  allocationsToSdkInt([]int64{100, 200, 300}),
+	})
+
+	// Attempt to claim before the airdrop starts, it should fail
+	s.Ctx = s.Ctx.WithBlockTime(startDate.Add(-1 * time.Hour))
+	err := s.App.AirdropKeeper.ClaimAndVest(s.Ctx, AirdropId, claimer.String())
+	s.Require().ErrorIs(err, types.ErrAirdropNotStarted)
+
+	// Claim and vest one hour into the second day
+	// The first day's rewards should be unlocked immediately, the second day's rewards should
+	// vest over the remainder of the day, and the third day's rewards should vest over the third day
+	claimTime := startDate.Add(24 * time.Hour).Add(time.Hour)
+	s.Ctx = s.Ctx.WithBlockTime(claimTime)
+	err = s.App.AirdropKeeper.ClaimAndVest(s.Ctx, AirdropId, claimer.String())
+	s.Require().NoError(err, "no error expected when claiming and vesting")
+
+	userAllocation := s.MustGetUserAllocation(AirdropId, claimer.String())
+	s.Require().Equal([]int64{0, 0, 0}, allocationsToInt64(userAllocation.Allocations), "allocations")
+	s.Require().Equal(int64(600), userAllocation.Claimed.Int64(), "claimed")
+	s.Require().Equal(int64(600), userAllocation.Vested.Int64(), "vested")
+
+	// Confirm the funds were sent to the user, but only the first day's rewards are spendable
+	s.Require().Equal(int64(600), s.App.BankKeeper.GetBalance(s.Ctx, claimer, RewardDenom).Amount.Int64(), "claimer balance")
+	s.Require().Equal(int64(100), s.App.BankKeeper.SpendableCoins(s.Ctx, claimer).AmountOf(RewardDenom).Int64(), "claimer spendable")
+	s.Require().Equal(int64(9400), s.App.BankKeeper.GetBalance(s.Ctx, distributor, RewardDenom).Amount.Int64(), "distributor balance")
+
+	// Confirm the account was converted to a vesting account with the remaining periods
+	account := s.App.AccountKeeper.GetAccount(s.Ctx, claimer)
+	vestingAccount, ok := account.(*vestingtypes.StridePeriodicVestingAccount)
+	s.Require().True(ok, "account should be a vesting account")
+	s.Require().Equal([]vestingtypes.Period{
+		{
+			StartTime: claimTime.Unix(),
+			Length:    int64((23 * time.Hour).Seconds()),
+			Amount:    sdk.NewCoins(sdk.NewInt64Coin(RewardDenom, 200)),
+		},
+		{
+			StartTime: startDate.Add(48 * time.Hour).Unix(),
+			Length:    int64((24 * time.Hour).Seconds()),
+			Amount:    sdk.NewCoins(sdk.NewInt64Coin(RewardDenom, 300)),
+		},
+	}, vestingAccount.VestingPeriods, "vesting periods")
+
+	// At the end of the second day, the second day's rewards should be unlocked
+	s.Ctx = s.Ctx.WithBlockTime(startDate.Add(48 * time.Hour))
+	s.Require().Equal(int64(300), s.App.BankKeeper.SpendableCoins(s.Ctx, claimer).AmountOf(RewardDenom).Int64(), "claimer spendable day 2")
+
+	// Attempt to claim again, there should be no rewards left
+	s.Ctx = s.Ctx.WithBlockTime(claimTime)
+	err = s.App.AirdropKeeper.ClaimAndVest(s.Ctx, AirdropId, claimer.String())
+	s.Require().ErrorIs(err, types.ErrNoUnclaimedRewards)
+
+	// Check that the user summary reflects the vested claim
+	summary, err := s.App.AirdropKeeper.UserSummary(sdk.WrapSDKContext(s.Ctx), &types.QueryUserSummaryRequest{
+		AirdropId: AirdropId,
+		Address:   claimer.String(),
+	})
+	s.Require().NoError(err, "no error expected when querying user summary")
+	s.Require().Equal(types.CLAIM_AND_VEST.String(), summary.ClaimType, "claim type")
+	s.Require().Equal(int64(600), summary.Vested.Int64(), "summary vested")
+
+	// Attempt to claim after the deadline, it should fail
+	s.Ctx = s.Ctx.WithBlockTime(deadlineDate.Add(time.Hour))
+	err = s.App.AirdropKeeper.ClaimAndVest(s.Ctx, AirdropId, claimer.String())
+	s.Require().ErrorIs(err, types.ErrAfterDecisionDeadline)
+}
+
+func (s *KeeperTestSuite) TestGrantVestingPeriods() {
+	blockTime := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	s.Ctx = s.Ctx.WithBlockTime(blockTime)
+
+	period := vestingtypes.Period{
+		StartTime: blockTime.Unix(),
+		Length:    100,
+		Amount:    sdk.NewCoins(sdk.NewInt64Coin(RewardDenom, 1000)),
+	}
+
+	// Grant to an account that doesn't exist yet, it should be created as a vesting account
+	newAddress := apptesting.CreateRandomAccounts(1)[0]
+	err := s.App.AirdropKeeper.GrantVestingPeriods(s.Ctx, newAddress, vestingtypes.Periods{period})
+	s.Require().NoError(err, "no error expected when granting to a new account")
+
+	vestingAccount, ok := s.App.AccountKeeper.GetAccount(s.Ctx, newAddress).(*vestingtypes.StridePeriodicVestingAccount)
+	s.Require().True(ok, "new account should be a vesting account")
+	s.Require().Equal([]vestingtypes.Period{period}, vestingAccount.VestingPeriods, "new account periods")
+
+	// Grant again to the same account, the period should be appended
+	laterPeriod := period
+	laterPeriod.StartTime += 50
+	err = s.App.AirdropKeeper.GrantVestingPeriods(s.Ctx, newAddress, vestingtypes.Periods{laterPeriod})
+	s.Require().NoError(err, "no error expected when granting to an existing vesting account")
+
+	vestingAccount, ok = s.App.AccountKeeper.GetAccount(s.Ctx, newAddress).(*vestingtypes.StridePeriodicVestingAccount)
+	s.Require().True(ok, "existing account should still be a vesting account")
+	s.Require().Len(vestingAccount.VestingPeriods, 2, "existing account periods")
+	s.Require().Equal(int64(2000), vestingAccount.OriginalVesting.AmountOf(RewardDenom).Int64(), "original vesting")
+
+	// Grant to a module account, it should fail
+	moduleAccount := s.App.AccountKeeper.GetModuleAccount(s.Ctx, authtypes.FeeCollectorName)
+	err = s.App.AirdropKeeper.GrantVestingPeriods(s.Ctx, moduleAccount.GetAddress(), vestingtypes.Periods{period})
+	s.Require().ErrorIs(err, types.ErrInvalidVestingAccount)
+}
+
 func (s *KeeperTestSuite) TestLinkAddresses() {
 	testCases := []struct {
 		name                string
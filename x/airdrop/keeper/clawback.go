@@ -0,0 +1,173 @@
+package keeper
+
+import (
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+
+	"github.com/Stride-Labs/stride/v24/utils"
+	"github.com/Stride-Labs/stride/v24/x/airdrop/types"
+)
+
+// Writes a clawback record to the store
+func (k Keeper) SetClawbackRecord(ctx sdk.Context, clawbackRecord types.ClawbackRecord) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ClawbackRecordKeyPrefix)
+
+	key := types.KeyPrefix(clawbackRecord.AirdropId)
+	value := k.cdc.MustMarshal(&clawbackRecord)
+
+	store.Set(key, value)
+}
+
+// Retrieves the clawback record for an airdrop
+func (k Keeper) GetClawbackRecord(ctx sdk.Context, airdropId string) (clawbackRecord types.ClawbackRecord, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ClawbackRecordKeyPrefix)
+
+	key := types.KeyPrefix(airdropId)
+	clawbackRecordBz := store.Get(key)
+
+	if len(clawbackRecordBz) == 0 {
+		return clawbackRecord, false
+	}
+
+	k.cdc.MustUnmarshal(clawbackRecordBz, &clawbackRecord)
+	return clawbackRecord, true
+}
+
+// Retrieves all clawback records from the store
+func (k Keeper) GetAllClawbackRecords(ctx sdk.Context) (clawbackRecords []types.ClawbackRecord) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ClawbackRecordKeyPrefix)
+
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		clawbackRecord := types.ClawbackRecord{}
+		k.cdc.MustUnmarshal(iterator.Value(), &clawbackRecord)
+		clawbackRecords = append(clawbackRecords, clawbackRecord)
+	}
+
+	return clawbackRecords
+}
+
+// Sums the allocated, claimed, and forfeited rewards across all users in an airdrop
+// The allocated amount includes the rewards that have already been claimed or forfeited
+func (k Keeper) GetAirdropTotals(ctx sdk.Context, airdropId string) (allocated, claimed, forfeited sdkmath.Int) {
+	allocated, claimed, forfeited = sdkmath.ZeroInt(), sdkmath.ZeroInt(), sdkmath.ZeroInt()
+	for _, userAllocation := range k.GetUserAllocationsForAirdrop(ctx, airdropId) {
+		claimed = claimed.Add(userAllocation.Claimed)
+		forfeited = forfeited.Add(userAllocation.Forfeited)
+
+		allocated = allocated.Add(userAllocation.Claimed).Add(userAllocation.Forfeited)
+		for _, rewardsOnDate := range userAllocation.Allocations {
+			allocated = allocated.Add(rewardsOnDate)
+		}
+	}
+	return allocated, claimed, forfeited
+}
+
+// Checks whether another active airdrop distributes the same reward denom from the same distributor
+func (k Keeper) isDistributorShared(ctx sdk.Context, airdrop types.Airdrop) bool {
+	for _, otherAirdrop := range k.GetAllAirdrops(ctx) {
+		if otherAirdrop.Id == airdrop.Id || otherAirdrop.Finalized {
+			continue
+		}
+		if otherAirdrop.DistributorAddress == airdrop.DistributorAddress && otherAirdrop.RewardDenom == airdrop.RewardDenom {
+			return true
+		}
+	}
+	return false
+}
+
+// Sweeps the remaining rewards for an airdrop from the distributor to the clawback recipient
+// (or the community pool if a recipient is not configured), marks the airdrop as finalized,
+// and stores a record of the airdrop totals
+// Typically the full distributor balance is swept; however, if the distributor is shared with
+// another active airdrop, only this airdrop's unclaimed rewards (including forfeited rewards)
+// are swept so that the other airdrop's balance is untouched
+func (k Keeper) ClawbackAirdrop(ctx sdk.Context, airdropId string) error {
+	airdrop, found := k.GetAirdrop(ctx, airdropId)
+	if !found {
+		return types.ErrAirdropNotFound.Wrapf("airdrop %s", airdropId)
+	}
+	if airdrop.Finalized {
+		return types.ErrAirdropFinalized.Wrapf("airdrop %s", airdropId)
+	}
+
+	allocated, claimed, forfeited := k.GetAirdropTotals(ctx, airdropId)
+
+	distributorAddress, err := sdk.AccAddressFromBech32(airdrop.DistributorAddress)
+	if err != nil {
+		return errorsmod.Wrapf(err, "invalid distributor address")
+	}
+	clawbackAmount := k.bankKeeper.GetBalance(ctx, distributorAddress, airdrop.RewardDenom).Amount
+	if k.isDistributorShared(ctx, airdrop) {
+		unclaimed := allocated.Sub(claimed)
+		clawbackAmount = sdkmath.MinInt(clawbackAmount, unclaimed)
+	}
+
+	// Send the rewards to the configured recipient, or the community pool if no recipient is set
+	recipient := k.GetParams(ctx).ClawbackRecipient
+	if clawbackAmount.IsPositive() {
+		clawbackCoins := sdk.NewCoins(sdk.NewCoin(airdrop.RewardDenom, clawbackAmount))
+		if recipient == "" {
+			if err := k.distributionKeeper.FundCommunityPool(ctx, clawbackCoins, distributorAddress); err != nil {
+				return errorsmod.Wrapf(err, "unable to fund community pool")
+			}
+		} else {
+			recipientAddress, err := sdk.AccAddressFromBech32(recipient)
+			if err != nil {
+				return errorsmod.Wrapf(err, "invalid clawback recipient")
+			}
+			if err := k.bankKeeper.SendCoins(ctx, distributorAddress, recipientAddress, clawbackCoins); err != nil {
+				return errorsmod.Wrapf(err, "unable to send clawback to recipient")
+			}
+		}
+	}
+	if recipient == "" {
+		recipient = authtypes.NewModuleAddress(distrtypes.ModuleName).String()
+	}
+
+	blockTime := ctx.BlockTime()
+	k.SetClawbackRecord(ctx, types.ClawbackRecord{
+		AirdropId:    airdropId,
+		Recipient:    recipient,
+		Allocated:    allocated,
+		Claimed:      claimed,
+		Forfeited:    forfeited,
+		ClawedBack:   clawbackAmount,
+		ClawbackTime: &blockTime,
+	})
+
+	airdrop.Finalized = true
+	k.SetAirdrop(ctx, airdrop)
+
+	return nil
+}
+
+// Claws back the rewards from each airdrop that has passed its clawback date
+// If the clawback fails, the error is logged and the clawback is retried in the next block
+func (k Keeper) EndBlocker(ctx sdk.Context) {
+	currentTime := ctx.BlockTime().Unix()
+	for _, airdrop := range k.GetAllAirdrops(ctx) {
+		if airdrop.Finalized || airdrop.ClawbackDate == nil || currentTime < airdrop.ClawbackDate.Unix() {
+			continue
+		}
+
+		airdropId := airdrop.Id
+		err := utils.ApplyFuncIfNoError(ctx, func(ctx sdk.Context) error {
+			return k.ClawbackAirdrop(ctx, airdropId)
+		})
+		if err != nil {
+			k.Logger(ctx).Error(fmt.Sprintf("Unable to clawback airdrop %s: %s", airdropId, err.Error()))
+			continue
+		}
+
+		k.Logger(ctx).Info(fmt.Sprintf("Clawed back airdrop %s", airdropId))
+	}
+}
@@ -0,0 +1,216 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+
+	"github.com/Stride-Labs/stride/v24/x/airdrop/types"
+)
+
+// Creates an airdrop with two user allocations and funds the distributor
+//
+//	User 1: claimed 100, forfeited 50, remaining 10
+//	User 2: claimed 0, forfeited 0, remaining 60
+//	Totals: allocated 220, claimed 100, forfeited 50
+func (s *KeeperTestSuite) SetupClawback(distributorBalance int64) (distributor sdk.AccAddress) {
+	distributor = s.TestAccs[0]
+	s.FundAccount(distributor, sdk.NewInt64Coin(RewardDenom, distributorBalance))
+
+	s.App.AirdropKeeper.SetAirdrop(s.Ctx, types.Airdrop{
+		Id:                    AirdropId,
+		RewardDenom:           RewardDenom,
+		DistributionStartDate: &DistributionStartDate,
+		DistributionEndDate:   &DistributionEndDate,
+		ClawbackDate:          &ClawbackDate,
+		ClaimTypeDeadlineDate: &DeadlineDate,
+		DistributorAddress:    distributor.String(),
+	})
+
+	s.App.AirdropKeeper.SetUserAllocation(s.Ctx, types.UserAllocation{
+		AirdropId:    AirdropId,
+		Address:      "user-1",
+		Claimed:      sdkmath.NewInt(100),
+		Forfeited:    sdkmath.NewInt(50),
+		Staked:       sdkmath.ZeroInt(),
+		StakingBonus: sdkmath.ZeroInt(),
+		Vested:       sdkmath.ZeroInt(),
+		Allocations:  allocationsToSdkInt([]int64{0, 0, 10}),
+	})
+	s.App.AirdropKeeper.SetUserAllocation(s.Ctx, types.UserAllocation{
+		AirdropId:    AirdropId,
+		Address:      "user-2",
+		Claimed:      sdkmath.ZeroInt(),
+		Forfeited:    sdkmath.ZeroInt(),
+		Staked:       sdkmath.ZeroInt(),
+		StakingBonus: sdkmath.ZeroInt(),
+		Vested:       sdkmath.ZeroInt(),
+		Allocations:  allocationsToSdkInt([]int64{20, 20, 20}),
+	})
+
+	return distributor
+}
+
+// Helper function to confirm the clawback record and finalized status after a clawback
+func (s *KeeperTestSuite) CheckClawbackRecord(recipient string, expectedClawback int64) {
+	s.Require().True(s.MustGetAirdrop(AirdropId).Finalized, "airdrop should be finalized")
+
+	clawbackRecord, found := s.App.AirdropKeeper.GetClawbackRecord(s.Ctx, AirdropId)
+	s.Require().True(found, "clawback record should have been created")
+	s.Require().Equal(AirdropId, clawbackRecord.AirdropId, "airdrop id")
+	s.Require().Equal(recipient, clawbackRecord.Recipient, "recipient")
+	s.Require().Equal(int64(220), clawbackRecord.Allocated.Int64(), "allocated")
+	s.Require().Equal(int64(100), clawbackRecord.Claimed.Int64(), "claimed")
+	s.Require().Equal(int64(50), clawbackRecord.Forfeited.Int64(), "forfeited")
+	s.Require().Equal(expectedClawback, clawbackRecord.ClawedBack.Int64(), "clawed back")
+	s.Require().Equal(s.Ctx.BlockTime().Unix(), clawbackRecord.ClawbackTime.Unix(), "clawback time")
+}
+
+func (s *KeeperTestSuite) TestGetAirdropTotals() {
+	s.SetupClawback(0)
+
+	// Add an allocation for a different airdrop that should be ignored
+	s.App.AirdropKeeper.SetUserAllocation(s.Ctx, types.UserAllocation{
+		AirdropId:    "different-airdrop",
+		Address:      "user-1",
+		Claimed:      sdkmath.NewInt(1000),
+		Forfeited:    sdkmath.NewInt(1000),
+		Staked:       sdkmath.ZeroInt(),
+		StakingBonus: sdkmath.ZeroInt(),
+		Vested:       sdkmath.ZeroInt(),
+		Allocations:  allocationsToSdkInt([]int64{1000}),
+	})
+
+	allocated, claimed, forfeited := s.App.AirdropKeeper.GetAirdropTotals(s.Ctx, AirdropId)
+	s.Require().Equal(int64(220), allocated.Int64(), "allocated")
+	s.Require().Equal(int64(100), claimed.Int64(), "claimed")
+	s.Require().Equal(int64(50), forfeited.Int64(), "forfeited")
+}
+
+func (s *KeeperTestSuite) TestClawbackAirdrop_CommunityPool() {
+	distributor := s.SetupClawback(1000)
+	s.Ctx = s.Ctx.WithBlockTime(ClawbackDate)
+
+	initialCommunityPool := s.App.DistrKeeper.GetFeePoolCommunityCoins(s.Ctx).AmountOf(RewardDenom)
+
+	err := s.App.AirdropKeeper.ClawbackAirdrop(s.Ctx, AirdropId)
+	s.Require().NoError(err, "no error expected during clawback")
+
+	// The full distributor balance should have been sent to the community pool
+	distributorBalance := s.App.BankKeeper.GetBalance(s.Ctx, distributor, RewardDenom)
+	s.Require().Zero(distributorBalance.Amount.Int64(), "distributor balance")
+
+	finalCommunityPool := s.App.DistrKeeper.GetFeePoolCommunityCoins(s.Ctx).AmountOf(RewardDenom)
+	s.Require().Equal(int64(1000), finalCommunityPool.Sub(initialCommunityPool).TruncateInt64(), "community pool increase")
+
+	communityPoolAddress := authtypes.NewModuleAddress(distrtypes.ModuleName).String()
+	s.CheckClawbackRecord(communityPoolAddress, 1000)
+
+	// Attempting to clawback again should fail
+	err = s.App.AirdropKeeper.ClawbackAirdrop(s.Ctx, AirdropId)
+	s.Require().ErrorIs(err, types.ErrAirdropFinalized)
+}
+
+func (s *KeeperTestSuite) TestClawbackAirdrop_ConfiguredRecipient() {
+	distributor := s.SetupClawback(1000)
+	s.Ctx = s.Ctx.WithBlockTime(ClawbackDate)
+
+	recipient := s.TestAccs[1]
+	params := s.App.AirdropKeeper.GetParams(s.Ctx)
+	params.ClawbackRecipient = recipient.String()
+	s.App.AirdropKeeper.SetParams(s.Ctx, params)
+
+	err := s.App.AirdropKeeper.ClawbackAirdrop(s.Ctx, AirdropId)
+	s.Require().NoError(err, "no error expected during clawback")
+
+	distributorBalance := s.App.BankKeeper.GetBalance(s.Ctx, distributor, RewardDenom)
+	s.Require().Zero(distributorBalance.Amount.Int64(), "distributor balance")
+
+	recipientBalance := s.App.BankKeeper.GetBalance(s.Ctx, recipient, RewardDenom)
+	s.Require().Equal(int64(1000), recipientBalance.Amount.Int64(), "recipient balance")
+
+	s.CheckClawbackRecord(recipient.String(), 1000)
+}
+
+func (s *KeeperTestSuite) TestClawbackAirdrop_SharedDistributor() {
+	distributor := s.SetupClawback(1000)
+	s.Ctx = s.Ctx.WithBlockTime(ClawbackDate)
+
+	recipient := s.TestAccs[1]
+	params := s.App.AirdropKeeper.GetParams(s.Ctx)
+	params.ClawbackRecipient = recipient.String()
+	s.App.AirdropKeeper.SetParams(s.Ctx, params)
+
+	// Create another active airdrop that uses the same distributor
+	s.App.AirdropKeeper.SetAirdrop(s.Ctx, types.Airdrop{
+		Id:                 "other-airdrop",
+		RewardDenom:        RewardDenom,
+		DistributorAddress: distributor.String(),
+	})
+
+	err := s.App.AirdropKeeper.ClawbackAirdrop(s.Ctx, AirdropId)
+	s.Require().NoError(err, "no error expected during clawback")
+
+	// Only the unclaimed rewards (allocated - claimed) should be swept
+	distributorBalance := s.App.BankKeeper.GetBalance(s.Ctx, distributor, RewardDenom)
+	s.Require().Equal(int64(880), distributorBalance.Amount.Int64(), "distributor balance")
+
+	recipientBalance := s.App.BankKeeper.GetBalance(s.Ctx, recipient, RewardDenom)
+	s.Require().Equal(int64(120), recipientBalance.Amount.Int64(), "recipient balance")
+
+	s.CheckClawbackRecord(recipient.String(), 120)
+
+	// The other airdrop should not be finalized
+	s.Require().False(s.MustGetAirdrop("other-airdrop").Finalized, "other airdrop should not be finalized")
+}
+
+func (s *KeeperTestSuite) TestClawbackAirdrop_AirdropNotFound() {
+	err := s.App.AirdropKeeper.ClawbackAirdrop(s.Ctx, "fake-airdrop")
+	s.Require().ErrorIs(err, types.ErrAirdropNotFound)
+}
+
+func (s *KeeperTestSuite) TestEndBlocker_Clawback() {
+	distributor := s.SetupClawback(1000)
+
+	// Create a second airdrop with an invalid distributor so that the clawback fails
+	s.App.AirdropKeeper.SetAirdrop(s.Ctx, types.Airdrop{
+		Id:                 "invalid-airdrop",
+		RewardDenom:        "other-denom",
+		ClawbackDate:       &ClawbackDate,
+		DistributorAddress: "invalid",
+	})
+
+	// Before the clawback date, nothing should happen
+	s.Ctx = s.Ctx.WithBlockTime(ClawbackDate.Add(-1 * time.Second))
+	s.App.AirdropKeeper.EndBlocker(s.Ctx)
+
+	s.Require().False(s.MustGetAirdrop(AirdropId).Finalized, "airdrop should not be finalized before clawback date")
+	_, found := s.App.AirdropKeeper.GetClawbackRecord(s.Ctx, AirdropId)
+	s.Require().False(found, "clawback record should not exist before clawback date")
+
+	distributorBalance := s.App.BankKeeper.GetBalance(s.Ctx, distributor, RewardDenom)
+	s.Require().Equal(int64(1000), distributorBalance.Amount.Int64(), "distributor balance before clawback date")
+
+	// Once the clawback date passes, the valid airdrop should be clawed back
+	s.Ctx = s.Ctx.WithBlockTime(ClawbackDate)
+	s.App.AirdropKeeper.EndBlocker(s.Ctx)
+
+	communityPoolAddress := authtypes.NewModuleAddress(distrtypes.ModuleName).String()
+	s.CheckClawbackRecord(communityPoolAddress, 1000)
+
+	// The failed airdrop should not be finalized and should not have a record
+	s.Require().False(s.MustGetAirdrop("invalid-airdrop").Finalized, "failed airdrop should not be finalized")
+	_, found = s.App.AirdropKeeper.GetClawbackRecord(s.Ctx, "invalid-airdrop")
+	s.Require().False(found, "failed airdrop should not have a clawback record")
+
+	// Calling the EndBlocker again should not change the finalized airdrop's record
+	s.Ctx = s.Ctx.WithBlockTime(ClawbackDate.Add(time.Hour))
+	s.App.AirdropKeeper.EndBlocker(s.Ctx)
+
+	clawbackRecord, found := s.App.AirdropKeeper.GetClawbackRecord(s.Ctx, AirdropId)
+	s.Require().True(found, "clawback record should still exist")
+	s.Require().Equal(ClawbackDate.Unix(), clawbackRecord.ClawbackTime.Unix(), "clawback time should not change")
+}
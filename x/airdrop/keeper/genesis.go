@@ -15,6 +15,12 @@ func (k Keeper) InitGenesis(ctx sdk.Context, genState types.GenesisState) {
 	for _, allocation := range genState.UserAllocations {
 		k.SetUserAllocation(ctx, allocation)
 	}
+	for _, clawbackRecord := range genState.ClawbackRecords {
+		k.SetClawbackRecord(ctx, clawbackRecord)
+	}
+	for _, materializedAllocation := range genState.MaterializedAllocations {
+		k.SetMaterializedAllocation(ctx, materializedAllocation)
+	}
 }
 
 // Export's module state into genesis file
@@ -22,6 +28,8 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 	genesis := types.DefaultGenesis()
 	genesis.Airdrops = k.GetAllAirdrops(ctx)
 	genesis.UserAllocations = k.GetAllUserAllocations(ctx)
+	genesis.ClawbackRecords = k.GetAllClawbackRecords(ctx)
+	genesis.MaterializedAllocations = k.GetAllMaterializedAllocations(ctx)
 	genesis.Params = k.GetParams(ctx)
 	return genesis
 }
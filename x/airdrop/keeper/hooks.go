@@ -0,0 +1,103 @@
+package keeper
+
+import (
+	"errors"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/Stride-Labs/stride/v24/utils"
+	"github.com/Stride-Labs/stride/v24/x/airdrop/types"
+	stakeibctypes "github.com/Stride-Labs/stride/v24/x/stakeibc/types"
+)
+
+// Claims the accrued rewards from each airdrop that is gated by the given condition,
+// after the user has completed the associated action
+// Failed claims are logged and skipped so that they never block the underlying action
+// Note: for merkle root airdrops, the user must first materialize their allocation with a proof
+func (k Keeper) ClaimForCondition(ctx sdk.Context, claimer sdk.AccAddress, condition types.ClaimCondition) {
+	for _, airdrop := range k.GetAllAirdrops(ctx) {
+		if airdrop.ClaimCondition != condition || airdrop.Finalized {
+			continue
+		}
+		if _, found := k.GetUserAllocation(ctx, airdrop.Id, claimer.String()); !found {
+			continue
+		}
+
+		airdropId := airdrop.Id
+		err := utils.ApplyFuncIfNoError(ctx, func(ctx sdk.Context) error {
+			return k.claimAccruedRewards(ctx, airdropId, claimer.String())
+		})
+		if err != nil && !errors.Is(err, types.ErrNoUnclaimedRewards) {
+			k.Logger(ctx).Error(fmt.Sprintf("Unable to claim airdrop %s for %s after %s: %s",
+				airdropId, claimer.String(), condition.String(), err.Error()))
+		}
+	}
+}
+
+// Claims the rewards from airdrops that are gated by a liquid stake
+func (k Keeper) AfterLiquidStake(ctx sdk.Context, addr sdk.AccAddress) {
+	k.ClaimForCondition(ctx, addr, types.CLAIM_CONDITION_LIQUID_STAKE)
+}
+
+// Claims the rewards from airdrops that are gated by a delegation
+func (k Keeper) AfterDelegationModified(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) error {
+	k.ClaimForCondition(ctx, delAddr, types.CLAIM_CONDITION_DELEGATE_STAKE)
+	return nil
+}
+
+// ________________________________________________________________________________________
+
+// Hooks wrapper struct for airdrop keeper
+type Hooks struct {
+	k Keeper
+}
+
+var _ stakingtypes.StakingHooks = Hooks{}
+var _ stakeibctypes.StakeIBCHooks = Hooks{}
+
+// Return the wrapper struct
+func (k Keeper) Hooks() Hooks {
+	return Hooks{k}
+}
+
+// stakeibc hooks
+func (h Hooks) AfterLiquidStake(ctx sdk.Context, addr sdk.AccAddress) {
+	h.k.AfterLiquidStake(ctx, addr)
+}
+
+// staking hooks
+func (h Hooks) AfterDelegationModified(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) error {
+	return h.k.AfterDelegationModified(ctx, delAddr, valAddr)
+}
+func (h Hooks) AfterUnbondingInitiated(ctx sdk.Context, id uint64) error {
+	return nil
+}
+func (h Hooks) AfterValidatorCreated(ctx sdk.Context, valAddr sdk.ValAddress) error {
+	return nil
+}
+func (h Hooks) BeforeValidatorModified(ctx sdk.Context, valAddr sdk.ValAddress) error {
+	return nil
+}
+func (h Hooks) AfterValidatorRemoved(ctx sdk.Context, consAddr sdk.ConsAddress, valAddr sdk.ValAddress) error {
+	return nil
+}
+func (h Hooks) AfterValidatorBonded(ctx sdk.Context, consAddr sdk.ConsAddress, valAddr sdk.ValAddress) error {
+	return nil
+}
+func (h Hooks) AfterValidatorBeginUnbonding(ctx sdk.Context, consAddr sdk.ConsAddress, valAddr sdk.ValAddress) error {
+	return nil
+}
+func (h Hooks) BeforeDelegationCreated(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) error {
+	return nil
+}
+func (h Hooks) BeforeDelegationSharesModified(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) error {
+	return nil
+}
+func (h Hooks) BeforeDelegationRemoved(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) error {
+	return nil
+}
+func (h Hooks) BeforeValidatorSlashed(ctx sdk.Context, valAddr sdk.ValAddress, fraction sdk.Dec) error {
+	return nil
+}
@@ -0,0 +1,116 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Stride-Labs/stride/v24/x/airdrop/types"
+)
+
+func (s *KeeperTestSuite) TestClaimForCondition() {
+	claimer := s.TestAccs[0]
+	distributor := s.TestAccs[1]
+	unfundedDistributor := s.TestAccs[2]
+
+	noConditionAirdropId := "no-condition"
+	liquidStakeAirdropId := "liquid-stake"
+	delegateStakeAirdropId := "delegate-stake"
+	unfundedAirdropId := "unfunded"
+
+	// Fund the distributor
+	s.FundAccount(distributor, sdk.NewInt64Coin(RewardDenom, 1000))
+
+	// Create an airdrop for each condition, as well as an airdrop that can't be paid out
+	airdrops := []struct {
+		airdropId   string
+		distributor sdk.AccAddress
+		condition   types.ClaimCondition
+	}{
+		{airdropId: noConditionAirdropId, distributor: distributor, condition: types.CLAIM_CONDITION_NONE},
+		{airdropId: liquidStakeAirdropId, distributor: distributor, condition: types.CLAIM_CONDITION_LIQUID_STAKE},
+		{airdropId: delegateStakeAirdropId, distributor: distributor, condition: types.CLAIM_CONDITION_DELEGATE_STAKE},
+		{airdropId: unfundedAirdropId, distributor: unfundedDistributor, condition: types.CLAIM_CONDITION_LIQUID_STAKE},
+	}
+	for _, airdrop := range airdrops {
+		s.App.AirdropKeeper.SetAirdrop(s.Ctx, types.Airdrop{
+			Id:                    airdrop.airdropId,
+			RewardDenom:           RewardDenom,
+			DistributorAddress:    airdrop.distributor.String(),
+			DistributionStartDate: &DistributionStartDate,
+			DistributionEndDate:   &DistributionEndDate,
+			ClaimTypeDeadlineDate: &DeadlineDate,
+			ClawbackDate:          &ClawbackDate,
+			EarlyClaimPenalty:     sdk.ZeroDec(),
+			ClaimCondition:        airdrop.condition,
+		})
+		s.App.AirdropKeeper.SetUserAllocation(s.Ctx, types.UserAllocation{
+			AirdropId:    airdrop.airdropId,
+			Address:      claimer.String(),
+			Claimed:      sdkmath.ZeroInt(),
+			Forfeited:    sdkmath.ZeroInt(),
+			Staked:       sdkmath.ZeroInt(),
+			StakingBonus: sdkmath.ZeroInt(),
+			Vested:       sdkmath.ZeroInt(),
+			Allocations:  allocationsToSdkInt([]int64{10, 20, 30}),
+		})
+	}
+
+	// Set the block time to the second day
+	s.Ctx = s.Ctx.WithBlockTime(DistributionStartDate.Add(24 * time.Hour).Add(time.Hour))
+
+	// Confirm the conditional airdrops cannot be claimed directly
+	err := s.App.AirdropKeeper.ClaimDaily(s.Ctx, liquidStakeAirdropId, claimer.String())
+	s.Require().ErrorIs(err, types.ErrClaimConditionNotMet, "claim daily with condition")
+
+	err = s.App.AirdropKeeper.ClaimEarly(s.Ctx, delegateStakeAirdropId, claimer.String())
+	s.Require().ErrorIs(err, types.ErrClaimConditionNotMet, "claim early with condition")
+
+	err = s.App.AirdropKeeper.ClaimAndVest(s.Ctx, delegateStakeAirdropId, claimer.String())
+	s.Require().ErrorIs(err, types.ErrClaimConditionNotMet, "claim and vest with condition")
+
+	_, err = s.App.AirdropKeeper.ClaimAndStake(s.Ctx, delegateStakeAirdropId, claimer.String())
+	s.Require().ErrorIs(err, types.ErrClaimConditionNotMet, "claim and stake with delegation condition")
+
+	// Call the liquid stake hook, only the funded liquid stake airdrop should be claimed
+	s.App.AirdropKeeper.Hooks().AfterLiquidStake(s.Ctx, claimer)
+
+	expectedClaimed := map[string]int64{
+		noConditionAirdropId:   0,
+		liquidStakeAirdropId:   30,
+		delegateStakeAirdropId: 0,
+		unfundedAirdropId:      0,
+	}
+	for airdropId, expected := range expectedClaimed {
+		userAllocation := s.MustGetUserAllocation(airdropId, claimer.String())
+		s.Require().Equal(expected, userAllocation.Claimed.Int64(), "claimed after liquid stake - %s", airdropId)
+	}
+	s.Require().Equal(int64(30), s.App.BankKeeper.GetBalance(s.Ctx, claimer, RewardDenom).Amount.Int64(), "balance after liquid stake")
+
+	// Confirm the failed claim did not modify the allocations
+	unfundedAllocation := s.MustGetUserAllocation(unfundedAirdropId, claimer.String())
+	s.Require().Equal([]int64{10, 20, 30}, allocationsToInt64(unfundedAllocation.Allocations), "unfunded allocations")
+
+	// Calling the hook again on the same day should be a no-op
+	s.App.AirdropKeeper.Hooks().AfterLiquidStake(s.Ctx, claimer)
+	s.Require().Equal(int64(30), s.App.BankKeeper.GetBalance(s.Ctx, claimer, RewardDenom).Amount.Int64(), "balance after second liquid stake")
+
+	// Call the delegation hook, the delegate stake airdrop should now be claimed
+	err = s.App.AirdropKeeper.Hooks().AfterDelegationModified(s.Ctx, claimer, sdk.ValAddress(claimer))
+	s.Require().NoError(err, "no error expected from delegation hook")
+
+	delegateAllocation := s.MustGetUserAllocation(delegateStakeAirdropId, claimer.String())
+	s.Require().Equal(int64(30), delegateAllocation.Claimed.Int64(), "claimed after delegation")
+	s.Require().Equal([]int64{0, 0, 30}, allocationsToInt64(delegateAllocation.Allocations), "allocations after delegation")
+	s.Require().Equal(int64(60), s.App.BankKeeper.GetBalance(s.Ctx, claimer, RewardDenom).Amount.Int64(), "balance after delegation")
+
+	// The airdrop without a condition should still be claimable directly
+	err = s.App.AirdropKeeper.ClaimDaily(s.Ctx, noConditionAirdropId, claimer.String())
+	s.Require().NoError(err, "no error expected when claiming an airdrop without a condition")
+
+	// The hooks should be a no-op for users without an allocation
+	otherUser := s.TestAccs[3]
+	s.App.AirdropKeeper.Hooks().AfterLiquidStake(s.Ctx, otherUser)
+	s.Require().Zero(s.App.BankKeeper.GetBalance(s.Ctx, otherUser, RewardDenom).Amount.Int64(), "other user balance")
+}
@@ -9,25 +9,35 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/Stride-Labs/stride/v24/x/airdrop/types"
+	stakeibckeeper "github.com/Stride-Labs/stride/v24/x/stakeibc/keeper"
 )
 
 type (
 	Keeper struct {
-		cdc        codec.BinaryCodec
-		storeKey   storetypes.StoreKey
-		bankKeeper types.BankKeeper
+		cdc                codec.BinaryCodec
+		storeKey           storetypes.StoreKey
+		accountKeeper      types.AccountKeeper
+		bankKeeper         types.BankKeeper
+		distributionKeeper types.DistributionKeeper
+		stakeibcKeeper     *stakeibckeeper.Keeper
 	}
 )
 
 func NewKeeper(
 	cdc codec.BinaryCodec,
 	storeKey storetypes.StoreKey,
+	accountKeeper types.AccountKeeper,
 	bankKeeper types.BankKeeper,
+	distributionKeeper types.DistributionKeeper,
+	stakeibcKeeper *stakeibckeeper.Keeper,
 ) Keeper {
 	return Keeper{
-		cdc:        cdc,
-		storeKey:   storeKey,
-		bankKeeper: bankKeeper,
+		cdc:                cdc,
+		storeKey:           storeKey,
+		accountKeeper:      accountKeeper,
+		bankKeeper:         bankKeeper,
+		distributionKeeper: distributionKeeper,
+		stakeibcKeeper:     stakeibcKeeper,
 	}
 }
 
@@ -25,6 +25,13 @@ var _ types.MsgServer = msgServer{}
 func (ms msgServer) ClaimDaily(goCtx context.Context, msg *types.MsgClaimDaily) (*types.MsgClaimDailyResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
+	if msg.AllocationProof != nil {
+		err := ms.Keeper.MaterializeAllocationFromProof(ctx, msg.AirdropId, msg.Claimer, *msg.AllocationProof)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	err := ms.Keeper.ClaimDaily(ctx, msg.AirdropId, msg.Claimer)
 	if err != nil {
 		return nil, err
@@ -37,6 +44,13 @@ func (ms msgServer) ClaimDaily(goCtx context.Context, msg *types.MsgClaimDaily)
 func (ms msgServer) ClaimEarly(goCtx context.Context, msg *types.MsgClaimEarly) (*types.MsgClaimEarlyResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
+	if msg.AllocationProof != nil {
+		err := ms.Keeper.MaterializeAllocationFromProof(ctx, msg.AirdropId, msg.Claimer, *msg.AllocationProof)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	err := ms.Keeper.ClaimEarly(ctx, msg.AirdropId, msg.Claimer)
 	if err != nil {
 		return nil, err
@@ -45,6 +59,44 @@ func (ms msgServer) ClaimEarly(goCtx context.Context, msg *types.MsgClaimEarly)
 	return &types.MsgClaimEarlyResponse{}, nil
 }
 
+// User transaction to claim all the pending daily airdrop rewards and liquid stake them
+func (ms msgServer) ClaimAndStake(goCtx context.Context, msg *types.MsgClaimAndStake) (*types.MsgClaimAndStakeResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if msg.AllocationProof != nil {
+		err := ms.Keeper.MaterializeAllocationFromProof(ctx, msg.AirdropId, msg.Claimer, *msg.AllocationProof)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	stToken, err := ms.Keeper.ClaimAndStake(ctx, msg.AirdropId, msg.Claimer)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgClaimAndStakeResponse{StToken: stToken}, nil
+}
+
+// User transaction to claim all their remaining rewards without a penalty into a vesting account
+func (ms msgServer) ClaimAndVest(goCtx context.Context, msg *types.MsgClaimAndVest) (*types.MsgClaimAndVestResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if msg.AllocationProof != nil {
+		err := ms.Keeper.MaterializeAllocationFromProof(ctx, msg.AirdropId, msg.Claimer, *msg.AllocationProof)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	err := ms.Keeper.ClaimAndVest(ctx, msg.AirdropId, msg.Claimer)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgClaimAndVestResponse{}, nil
+}
+
 // Admin transaction to create a new airdrop
 func (ms msgServer) CreateAirdrop(goCtx context.Context, msg *types.MsgCreateAirdrop) (*types.MsgCreateAirdropResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
@@ -64,6 +116,8 @@ func (ms msgServer) CreateAirdrop(goCtx context.Context, msg *types.MsgCreateAir
 		DistributorAddress:    msg.DistributorAddress,
 		AllocatorAddress:      msg.AllocatorAddress,
 		LinkerAddress:         msg.LinkerAddress,
+		ClaimAndStakeBonus:    msg.ClaimAndStakeBonus,
+		ClaimCondition:        msg.ClaimCondition,
 	}
 	ms.Keeper.SetAirdrop(ctx, airdrop)
 
@@ -74,9 +128,13 @@ func (ms msgServer) CreateAirdrop(goCtx context.Context, msg *types.MsgCreateAir
 func (ms msgServer) UpdateAirdrop(goCtx context.Context, msg *types.MsgUpdateAirdrop) (*types.MsgUpdateAirdropResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
-	if _, found := ms.Keeper.GetAirdrop(ctx, msg.AirdropId); !found {
+	existingAirdrop, found := ms.Keeper.GetAirdrop(ctx, msg.AirdropId)
+	if !found {
 		return nil, types.ErrAirdropNotFound.Wrapf("airdrop %s", msg.AirdropId)
 	}
+	if existingAirdrop.Finalized {
+		return nil, types.ErrAirdropFinalized.Wrapf("airdrop %s", msg.AirdropId)
+	}
 
 	airdrop := types.Airdrop{
 		Id:                    msg.AirdropId,
@@ -89,6 +147,9 @@ func (ms msgServer) UpdateAirdrop(goCtx context.Context, msg *types.MsgUpdateAir
 		DistributorAddress:    msg.DistributorAddress,
 		AllocatorAddress:      msg.AllocatorAddress,
 		LinkerAddress:         msg.LinkerAddress,
+		AllocationsMerkleRoot: existingAirdrop.AllocationsMerkleRoot,
+		ClaimAndStakeBonus:    msg.ClaimAndStakeBonus,
+		ClaimCondition:        msg.ClaimCondition,
 	}
 	ms.Keeper.SetAirdrop(ctx, airdrop)
 
@@ -106,6 +167,12 @@ func (ms msgServer) AddAllocations(goCtx context.Context, msg *types.MsgAddAlloc
 	if msg.Admin != airdrop.AllocatorAddress {
 		return nil, types.ErrInvalidAdminAddress.Wrapf("user allocations can only be added by the allocator admin")
 	}
+	if airdrop.Finalized {
+		return nil, types.ErrAirdropFinalized.Wrapf("airdrop %s", msg.AirdropId)
+	}
+	if airdrop.AllocationsMerkleRoot != "" {
+		return nil, types.ErrAllocationsMerkleRootSet.Wrapf("airdrop %s", msg.AirdropId)
+	}
 
 	periodLengthSeconds := ms.Keeper.GetParams(ctx).PeriodLengthSeconds
 	expectedDays := airdrop.GetAirdropPeriods(periodLengthSeconds)
@@ -121,11 +188,14 @@ func (ms msgServer) AddAllocations(goCtx context.Context, msg *types.MsgAddAlloc
 		}
 
 		userAllocation := types.UserAllocation{
-			AirdropId:   msg.AirdropId,
-			Address:     rawAllocation.UserAddress,
-			Claimed:     sdkmath.ZeroInt(),
-			Forfeited:   sdkmath.ZeroInt(),
-			Allocations: rawAllocation.Allocations,
+			AirdropId:    msg.AirdropId,
+			Address:      rawAllocation.UserAddress,
+			Claimed:      sdkmath.ZeroInt(),
+			Forfeited:    sdkmath.ZeroInt(),
+			Staked:       sdkmath.ZeroInt(),
+			StakingBonus: sdkmath.ZeroInt(),
+			Vested:       sdkmath.ZeroInt(),
+			Allocations:  rawAllocation.Allocations,
 		}
 		ms.Keeper.SetUserAllocation(ctx, userAllocation)
 	}
@@ -144,6 +214,9 @@ func (ms msgServer) UpdateUserAllocation(goCtx context.Context, msg *types.MsgUp
 	if msg.Admin != airdrop.AllocatorAddress {
 		return nil, types.ErrInvalidAdminAddress.Wrapf("user allocation updates can only be performed by the allocator admin")
 	}
+	if airdrop.Finalized {
+		return nil, types.ErrAirdropFinalized.Wrapf("airdrop %s", msg.AirdropId)
+	}
 
 	userAllocation, found := ms.Keeper.GetUserAllocation(ctx, msg.AirdropId, msg.UserAddress)
 	if !found {
@@ -173,9 +246,45 @@ func (ms msgServer) LinkAddresses(goCtx context.Context, msg *types.MsgLinkAddre
 		return nil, types.ErrInvalidAdminAddress.Wrapf("linking can only be performed by the linkor admin")
 	}
 
+	// For merkle root airdrops, the host allocation may need to be stored from its proof first
+	if msg.AllocationProof != nil {
+		err := ms.Keeper.MaterializeAllocationFromProof(ctx, msg.AirdropId, msg.HostAddress, *msg.AllocationProof)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	if err := ms.Keeper.LinkAddresses(ctx, msg.AirdropId, msg.StrideAddress, msg.HostAddress); err != nil {
 		return nil, err
 	}
 
 	return &types.MsgLinkAddressesResponse{}, nil
 }
+
+// Admin transaction to commit to the user allocations of an airdrop with a merkle root
+// Each user's allocation is then stored the first time they claim with a proof
+func (ms msgServer) SetAllocationsRoot(goCtx context.Context, msg *types.MsgSetAllocationsRoot) (*types.MsgSetAllocationsRootResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	airdrop, found := ms.Keeper.GetAirdrop(ctx, msg.AirdropId)
+	if !found {
+		return nil, types.ErrAirdropNotFound.Wrapf("airdrop %s", msg.AirdropId)
+	}
+	if msg.Admin != airdrop.AllocatorAddress {
+		return nil, types.ErrInvalidAdminAddress.Wrapf("the allocations root can only be set by the allocator admin")
+	}
+	if airdrop.Finalized {
+		return nil, types.ErrAirdropFinalized.Wrapf("airdrop %s", msg.AirdropId)
+	}
+
+	// Once allocations have been stored from the root, changing the root could allow a
+	// user to store a second leaf on top of the first
+	if ms.Keeper.HasMaterializedAllocations(ctx, msg.AirdropId) {
+		return nil, types.ErrAllocationsMaterialized.Wrapf("airdrop %s", msg.AirdropId)
+	}
+
+	airdrop.AllocationsMerkleRoot = msg.MerkleRoot
+	ms.Keeper.SetAirdrop(ctx, airdrop)
+
+	return &types.MsgSetAllocationsRootResponse{}, nil
+}
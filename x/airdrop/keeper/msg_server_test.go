@@ -1,6 +1,7 @@
 package keeper_test
 
 import (
+	"strings"
 	"time"
 
 	sdkmath "cosmossdk.io/math"
@@ -75,6 +76,12 @@ func (s *KeeperTestSuite) TestUpdateAirdrop() {
 	s.Require().Equal(msg.AllocatorAddress, airdrop.AllocatorAddress, "allocator address")
 	s.Require().Equal(msg.LinkerAddress, airdrop.LinkerAddress, "linker address")
 
+	// Finalize the airdrop and try it again, it should error since the airdrop has been clawed back
+	airdrop.Finalized = true
+	s.App.AirdropKeeper.SetAirdrop(s.Ctx, airdrop)
+	_, err = s.GetMsgServer().UpdateAirdrop(sdk.UnwrapSDKContext(s.Ctx), &msg)
+	s.Require().ErrorIs(err, types.ErrAirdropFinalized)
+
 	// Remove the airdrop and try it again, it should error saying the airdrop doesn't exist
 	s.App.AirdropKeeper.RemoveAirdrop(s.Ctx, AirdropId)
 	_, err = s.GetMsgServer().UpdateAirdrop(sdk.UnwrapSDKContext(s.Ctx), &msg)
@@ -157,6 +164,29 @@ func (s *KeeperTestSuite) TestAddAllocations() {
 	_, err = s.GetMsgServer().AddAllocations(sdk.UnwrapSDKContext(s.Ctx), &invalidMsg)
 	s.Require().ErrorIs(err, types.ErrInvalidAdminAddress)
 
+	// Set an allocations merkle root and try to add a new allocation, it should fail
+	newUserMsg := types.MsgAddAllocations{
+		AirdropId: AirdropId,
+		Allocations: []types.RawAllocation{
+			{UserAddress: "user-3", Allocations: msg.Allocations[0].Allocations},
+		},
+	}
+
+	airdrop := s.MustGetAirdrop(AirdropId)
+	airdrop.AllocationsMerkleRoot = "root"
+	s.App.AirdropKeeper.SetAirdrop(s.Ctx, airdrop)
+
+	_, err = s.GetMsgServer().AddAllocations(sdk.UnwrapSDKContext(s.Ctx), &newUserMsg)
+	s.Require().ErrorIs(err, types.ErrAllocationsMerkleRootSet)
+
+	// Finalize the airdrop and try to add a new allocation, it should fail
+	airdrop.AllocationsMerkleRoot = ""
+	airdrop.Finalized = true
+	s.App.AirdropKeeper.SetAirdrop(s.Ctx, airdrop)
+
+	_, err = s.GetMsgServer().AddAllocations(sdk.UnwrapSDKContext(s.Ctx), &newUserMsg)
+	s.Require().ErrorIs(err, types.ErrAirdropFinalized)
+
 	// Remove the airdrop and try it again, it should error saying the airdrop doesn't exist
 	s.App.AirdropKeeper.RemoveAirdrop(s.Ctx, AirdropId)
 	_, err = s.GetMsgServer().AddAllocations(sdk.UnwrapSDKContext(s.Ctx), &msg)
@@ -207,6 +237,13 @@ func (s *KeeperTestSuite) TestUpdateUserAllocation() {
 	_, err = s.GetMsgServer().UpdateUserAllocation(sdk.UnwrapSDKContext(s.Ctx), &invalidMsg)
 	s.Require().ErrorIs(err, types.ErrInvalidAdminAddress)
 
+	// Finalize the airdrop and try again, it should fail
+	airdrop := s.MustGetAirdrop(AirdropId)
+	airdrop.Finalized = true
+	s.App.AirdropKeeper.SetAirdrop(s.Ctx, airdrop)
+	_, err = s.GetMsgServer().UpdateUserAllocation(sdk.UnwrapSDKContext(s.Ctx), &msg)
+	s.Require().ErrorIs(err, types.ErrAirdropFinalized)
+
 	// Remove the airdrop and try again, it should also error
 	s.App.AirdropKeeper.RemoveAirdrop(s.Ctx, AirdropId)
 	_, err = s.GetMsgServer().UpdateUserAllocation(sdk.UnwrapSDKContext(s.Ctx), &msg)
@@ -228,28 +265,37 @@ func (s *KeeperTestSuite) TestMsgLinkAddresses() {
 
 	// Create the stride and host allocations
 	strideUserAllocation := types.UserAllocation{
-		AirdropId:   AirdropId,
-		Address:     strideAddress,
-		Allocations: []sdkmath.Int{sdkmath.NewInt(10), sdkmath.NewInt(10), sdkmath.NewInt(10)},
-		Forfeited:   sdkmath.ZeroInt(),
-		Claimed:     sdkmath.NewInt(10),
+		AirdropId:    AirdropId,
+		Address:      strideAddress,
+		Allocations:  []sdkmath.Int{sdkmath.NewInt(10), sdkmath.NewInt(10), sdkmath.NewInt(10)},
+		Forfeited:    sdkmath.ZeroInt(),
+		Staked:       sdkmath.ZeroInt(),
+		StakingBonus: sdkmath.ZeroInt(),
+		Vested:       sdkmath.ZeroInt(),
+		Claimed:      sdkmath.NewInt(10),
 	}
 	hostUserAllocation := types.UserAllocation{
-		AirdropId:   AirdropId,
-		Address:     hostAddress,
-		Allocations: []sdkmath.Int{sdkmath.NewInt(10), sdkmath.NewInt(10), sdkmath.NewInt(10)},
-		Forfeited:   sdkmath.ZeroInt(),
-		Claimed:     sdkmath.ZeroInt(),
+		AirdropId:    AirdropId,
+		Address:      hostAddress,
+		Allocations:  []sdkmath.Int{sdkmath.NewInt(10), sdkmath.NewInt(10), sdkmath.NewInt(10)},
+		Forfeited:    sdkmath.ZeroInt(),
+		Staked:       sdkmath.ZeroInt(),
+		StakingBonus: sdkmath.ZeroInt(),
+		Vested:       sdkmath.ZeroInt(),
+		Claimed:      sdkmath.ZeroInt(),
 	}
 	s.App.AirdropKeeper.SetUserAllocation(s.Ctx, strideUserAllocation)
 	s.App.AirdropKeeper.SetUserAllocation(s.Ctx, hostUserAllocation)
 
 	expectedUpdatedUserAllocation := types.UserAllocation{
-		AirdropId:   AirdropId,
-		Address:     strideAddress,
-		Claimed:     sdkmath.NewInt(10),
-		Forfeited:   sdkmath.ZeroInt(),
-		Allocations: []sdkmath.Int{sdkmath.NewInt(20), sdkmath.NewInt(20), sdkmath.NewInt(20)},
+		AirdropId:    AirdropId,
+		Address:      strideAddress,
+		Claimed:      sdkmath.NewInt(10),
+		Forfeited:    sdkmath.ZeroInt(),
+		Staked:       sdkmath.ZeroInt(),
+		StakingBonus: sdkmath.ZeroInt(),
+		Vested:       sdkmath.ZeroInt(),
+		Allocations:  []sdkmath.Int{sdkmath.NewInt(20), sdkmath.NewInt(20), sdkmath.NewInt(20)},
 	}
 
 	// Call link
@@ -281,3 +327,219 @@ func (s *KeeperTestSuite) TestMsgLinkAddresses() {
 	_, err = s.GetMsgServer().LinkAddresses(sdk.UnwrapSDKContext(s.Ctx), &invalidMsg)
 	s.Require().ErrorIs(err, types.ErrAirdropNotFound)
 }
+
+func (s *KeeperTestSuite) TestMsgLinkAddresses_WithAllocationProof() {
+	strideAddress := "stride"
+	hostAddress := "host"
+	linkerAddress := "linker"
+
+	// Build a merkle airdrop with allocations for both the stride and host address
+	airdrop := types.Airdrop{
+		Id:                    AirdropId,
+		LinkerAddress:         linkerAddress,
+		DistributionStartDate: &DistributionStartDate,
+		DistributionEndDate:   &DistributionEndDate,
+	}
+	numPeriods := airdrop.GetAirdropPeriods(s.App.AirdropKeeper.GetParams(s.Ctx).PeriodLengthSeconds)
+	allocations := []sdkmath.Int{}
+	for i := int64(0); i < numPeriods; i++ {
+		allocations = append(allocations, sdkmath.NewInt(10))
+	}
+
+	merkleRoot, proofs, err := types.BuildAllocationProofs([]types.RawAllocation{
+		{UserAddress: strideAddress, Allocations: allocations},
+		{UserAddress: hostAddress, Allocations: allocations},
+	})
+	s.Require().NoError(err, "no error expected when building proofs")
+
+	airdrop.AllocationsMerkleRoot = merkleRoot
+	s.App.AirdropKeeper.SetAirdrop(s.Ctx, airdrop)
+
+	// Attempt to link without a proof, it should fail since the host allocation hasn't been stored
+	msg := types.MsgLinkAddresses{
+		Admin:         linkerAddress,
+		AirdropId:     AirdropId,
+		StrideAddress: strideAddress,
+		HostAddress:   hostAddress,
+	}
+	_, err = s.GetMsgServer().LinkAddresses(sdk.UnwrapSDKContext(s.Ctx), &msg)
+	s.Require().ErrorIs(err, types.ErrUserAllocationNotFound)
+
+	// Attempt to link with the stride address's proof, it should fail verification
+	invalidMsg := msg
+	invalidMsg.AllocationProof = &proofs[0]
+	_, err = s.GetMsgServer().LinkAddresses(sdk.UnwrapSDKContext(s.Ctx), &invalidMsg)
+	s.Require().ErrorIs(err, types.ErrInvalidMerkleProof)
+
+	// Link with the host proof, the host allocation should be moved to the stride address
+	msg.AllocationProof = &proofs[1]
+	_, err = s.GetMsgServer().LinkAddresses(sdk.UnwrapSDKContext(s.Ctx), &msg)
+	s.Require().NoError(err, "no error expected when linking with proof")
+
+	strideAllocation := s.MustGetUserAllocation(AirdropId, strideAddress)
+	s.Require().Equal(allocations, strideAllocation.Allocations, "stride allocations after link")
+
+	_, hostFound := s.App.AirdropKeeper.GetUserAllocation(s.Ctx, AirdropId, hostAddress)
+	s.Require().False(hostFound, "host user allocation should have been removed")
+
+	// Attempt to link again with the same proof, the leaf should not be stored a second time
+	_, err = s.GetMsgServer().LinkAddresses(sdk.UnwrapSDKContext(s.Ctx), &msg)
+	s.Require().ErrorIs(err, types.ErrUserAllocationNotFound)
+
+	// Store the stride address's own leaf, it should be added to the linked allocation
+	err = s.App.AirdropKeeper.MaterializeAllocationFromProof(s.Ctx, AirdropId, strideAddress, proofs[0])
+	s.Require().NoError(err, "no error expected when materializing stride allocation")
+
+	strideAllocation = s.MustGetUserAllocation(AirdropId, strideAddress)
+	for i, allocation := range strideAllocation.Allocations {
+		s.Require().Equal(int64(20), allocation.Int64(), "stride allocation on day %d", i)
+	}
+}
+
+func (s *KeeperTestSuite) TestSetAllocationsRoot() {
+	allocatorAddress := "allocator"
+	merkleRoot := strings.Repeat("ab", 32)
+
+	// Create the initial airdrop
+	s.App.AirdropKeeper.SetAirdrop(s.Ctx, types.Airdrop{
+		Id:               AirdropId,
+		AllocatorAddress: allocatorAddress,
+	})
+
+	// Set the allocations root
+	msg := types.MsgSetAllocationsRoot{
+		Admin:      allocatorAddress,
+		AirdropId:  AirdropId,
+		MerkleRoot: merkleRoot,
+	}
+	_, err := s.GetMsgServer().SetAllocationsRoot(sdk.UnwrapSDKContext(s.Ctx), &msg)
+	s.Require().NoError(err, "no error expected when setting allocations root")
+
+	// Confirm the root was stored
+	airdrop := s.MustGetAirdrop(AirdropId)
+	s.Require().Equal(merkleRoot, airdrop.AllocationsMerkleRoot, "allocations merkle root")
+
+	// Attempt to call it again with a non-admin address, it should fail
+	invalidMsg := msg
+	invalidMsg.Admin = "different"
+	_, err = s.GetMsgServer().SetAllocationsRoot(sdk.UnwrapSDKContext(s.Ctx), &invalidMsg)
+	s.Require().ErrorIs(err, types.ErrInvalidAdminAddress)
+
+	// Store an allocation from the root and try to update it, it should fail
+	s.App.AirdropKeeper.SetMaterializedAllocation(s.Ctx, types.MaterializedAllocation{
+		AirdropId: AirdropId,
+		Address:   "user",
+	})
+	_, err = s.GetMsgServer().SetAllocationsRoot(sdk.UnwrapSDKContext(s.Ctx), &msg)
+	s.Require().ErrorIs(err, types.ErrAllocationsMaterialized)
+
+	// The root for a different airdrop should not be locked
+	s.Require().False(s.App.AirdropKeeper.HasMaterializedAllocations(s.Ctx, AirdropId+"-other"),
+		"other airdrop should not have materialized allocations")
+
+	// Finalize the airdrop and try again, it should fail
+	airdrop.Finalized = true
+	s.App.AirdropKeeper.SetAirdrop(s.Ctx, airdrop)
+	_, err = s.GetMsgServer().SetAllocationsRoot(sdk.UnwrapSDKContext(s.Ctx), &msg)
+	s.Require().ErrorIs(err, types.ErrAirdropFinalized)
+
+	// Remove the airdrop and try it again, it should error saying the airdrop doesn't exist
+	s.App.AirdropKeeper.RemoveAirdrop(s.Ctx, AirdropId)
+	_, err = s.GetMsgServer().SetAllocationsRoot(sdk.UnwrapSDKContext(s.Ctx), &msg)
+	s.Require().ErrorIs(err, types.ErrAirdropNotFound)
+}
+
+func (s *KeeperTestSuite) TestMsgClaimWithAllocationProof() {
+	claimer := s.TestAccs[0]
+	otherUser := s.TestAccs[1]
+	distributor := s.TestAccs[2]
+
+	// Fund the distributor
+	s.FundAccount(distributor, sdk.NewCoin(RewardDenom, sdkmath.NewInt(1000)))
+
+	// Build the merkle tree with an allocation for each day of the airdrop
+	airdrop := types.Airdrop{
+		Id:                    AirdropId,
+		RewardDenom:           RewardDenom,
+		DistributorAddress:    distributor.String(),
+		DistributionStartDate: &DistributionStartDate,
+		DistributionEndDate:   &DistributionEndDate,
+		ClawbackDate:          &ClawbackDate,
+		ClaimTypeDeadlineDate: &DeadlineDate,
+		EarlyClaimPenalty:     sdk.ZeroDec(),
+	}
+	numPeriods := airdrop.GetAirdropPeriods(s.App.AirdropKeeper.GetParams(s.Ctx).PeriodLengthSeconds)
+	allocations := []sdkmath.Int{}
+	for i := int64(0); i < numPeriods; i++ {
+		allocations = append(allocations, sdkmath.NewInt(10))
+	}
+
+	merkleRoot, proofs, err := types.BuildAllocationProofs([]types.RawAllocation{
+		{UserAddress: claimer.String(), Allocations: allocations},
+		{UserAddress: otherUser.String(), Allocations: allocations},
+	})
+	s.Require().NoError(err, "no error expected when building proofs")
+
+	airdrop.AllocationsMerkleRoot = merkleRoot
+	s.App.AirdropKeeper.SetAirdrop(s.Ctx, airdrop)
+
+	// Set the block time to the second day of the airdrop
+	s.Ctx = s.Ctx.WithBlockTime(DistributionStartDate.Add(24 * time.Hour).Add(time.Hour))
+
+	// Attempt to claim with an allocation list of the wrong length, it should fail
+	invalidMsg := types.MsgClaimDaily{
+		Claimer:   claimer.String(),
+		AirdropId: AirdropId,
+		AllocationProof: &types.AllocationProof{
+			Allocations: allocations[1:],
+			Proof:       proofs[0].Proof,
+		},
+	}
+	_, err = s.GetMsgServer().ClaimDaily(sdk.UnwrapSDKContext(s.Ctx), &invalidMsg)
+	s.Require().ErrorIs(err, types.ErrInvalidAllocationListLength)
+
+	// Attempt to claim with inflated allocations, it should fail
+	invalidMsg.AllocationProof.Allocations = append([]sdkmath.Int{sdkmath.NewInt(1000)}, allocations[1:]...)
+	_, err = s.GetMsgServer().ClaimDaily(sdk.UnwrapSDKContext(s.Ctx), &invalidMsg)
+	s.Require().ErrorIs(err, types.ErrInvalidMerkleProof)
+
+	// Claim without a proof before the allocation has been stored, it should fail
+	_, err = s.GetMsgServer().ClaimDaily(sdk.UnwrapSDKContext(s.Ctx), &types.MsgClaimDaily{
+		Claimer:   claimer.String(),
+		AirdropId: AirdropId,
+	})
+	s.Require().ErrorIs(err, types.ErrUserAllocationNotFound)
+
+	// Claim with a valid proof, the allocation should be stored and the first two days claimed
+	validMsg := types.MsgClaimDaily{
+		Claimer:         claimer.String(),
+		AirdropId:       AirdropId,
+		AllocationProof: &proofs[0],
+	}
+	_, err = s.GetMsgServer().ClaimDaily(sdk.UnwrapSDKContext(s.Ctx), &validMsg)
+	s.Require().NoError(err, "no error expected when claiming with proof")
+
+	userAllocation := s.MustGetUserAllocation(AirdropId, claimer.String())
+	s.Require().Equal(int64(20), userAllocation.Claimed.Int64(), "claimed")
+	s.Require().Equal(int64(20), s.App.BankKeeper.GetBalance(s.Ctx, claimer, RewardDenom).Amount.Int64(), "claimer balance")
+
+	// Claim again with the same proof on the next day, the proof should be ignored
+	// and only the new day's rewards should be claimed
+	s.Ctx = s.Ctx.WithBlockTime(s.Ctx.BlockTime().Add(24 * time.Hour))
+	_, err = s.GetMsgServer().ClaimDaily(sdk.UnwrapSDKContext(s.Ctx), &validMsg)
+	s.Require().NoError(err, "no error expected when claiming again with proof")
+
+	userAllocation = s.MustGetUserAllocation(AirdropId, claimer.String())
+	s.Require().Equal(int64(30), userAllocation.Claimed.Int64(), "claimed after second claim")
+
+	// The other user claims early with their proof
+	_, err = s.GetMsgServer().ClaimEarly(sdk.UnwrapSDKContext(s.Ctx), &types.MsgClaimEarly{
+		Claimer:         otherUser.String(),
+		AirdropId:       AirdropId,
+		AllocationProof: &proofs[1],
+	})
+	s.Require().NoError(err, "no error expected when claiming early with proof")
+
+	otherUserAllocation := s.MustGetUserAllocation(AirdropId, otherUser.String())
+	s.Require().Equal(sdkmath.NewInt(10).MulRaw(numPeriods), otherUserAllocation.Claimed, "other user claimed")
+}
@@ -48,6 +48,10 @@ func (k Keeper) Airdrop(goCtx context.Context, req *types.QueryAirdropRequest) (
 		LinkerAddress:         airdrop.LinkerAddress,
 		CurrentDateIndex:      int64(currentDateIndex),
 		AirdropLength:         airdrop.GetAirdropPeriods(periodLengthSeconds),
+		Finalized:             airdrop.Finalized,
+		AllocationsMerkleRoot: airdrop.AllocationsMerkleRoot,
+		ClaimAndStakeBonus:    airdrop.ClaimAndStakeBonus,
+		ClaimCondition:        airdrop.ClaimCondition,
 	}
 
 	return &airdropResponse, nil
@@ -122,7 +126,7 @@ func (k Keeper) AllAllocations(goCtx context.Context, req *types.QueryAllAllocat
 	}, nil
 }
 
-// Queries the state of an address for an airdrop (daily claim or claim early)
+// Queries the state of an address for an airdrop (daily claim, claim early, claim and stake, or claim and vest)
 // and the amount claimed and remaining
 func (k Keeper) UserSummary(goCtx context.Context, req *types.QueryUserSummaryRequest) (*types.QueryUserSummaryResponse, error) {
 	if req == nil {
@@ -151,18 +155,63 @@ func (k Keeper) UserSummary(goCtx context.Context, req *types.QueryUserSummaryRe
 		return nil, status.Error(codes.FailedPrecondition, err.Error())
 	}
 
+	// Allocations stored before staked claims were tracked will have nil staked amounts
+	staked, stakingBonus, vested := sdkmath.ZeroInt(), sdkmath.ZeroInt(), sdkmath.ZeroInt()
+	if !allocation.Staked.IsNil() {
+		staked = allocation.Staked
+	}
+	if !allocation.StakingBonus.IsNil() {
+		stakingBonus = allocation.StakingBonus
+	}
+	if !allocation.Vested.IsNil() {
+		vested = allocation.Vested
+	}
+
 	claimType := types.CLAIM_DAILY
 	if allocation.Forfeited.GT(sdkmath.ZeroInt()) {
 		claimType = types.CLAIM_EARLY
+	} else if vested.GT(sdkmath.ZeroInt()) {
+		claimType = types.CLAIM_AND_VEST
+	} else if staked.GT(sdkmath.ZeroInt()) {
+		claimType = types.CLAIM_AND_STAKE
 	}
 
 	summary := &types.QueryUserSummaryResponse{
-		ClaimType: claimType.String(),
-		Claimed:   allocation.Claimed,
-		Claimable: claimable,
-		Forfeited: allocation.Forfeited,
-		Remaining: allocation.GetRemainingAllocations(),
+		ClaimType:    claimType.String(),
+		Claimed:      allocation.Claimed,
+		Claimable:    claimable,
+		Forfeited:    allocation.Forfeited,
+		Remaining:    allocation.GetRemainingAllocations(),
+		Staked:       staked,
+		StakingBonus: stakingBonus,
+		Vested:       vested,
 	}
 
 	return summary, nil
 }
+
+// Queries the clawback record for a finalized airdrop
+func (k Keeper) ClawbackRecord(goCtx context.Context, req *types.QueryClawbackRecordRequest) (*types.QueryClawbackRecordResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	clawbackRecord, found := k.GetClawbackRecord(ctx, req.AirdropId)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "clawback record not found for airdrop %s", req.AirdropId)
+	}
+
+	return &types.QueryClawbackRecordResponse{ClawbackRecord: clawbackRecord}, nil
+}
+
+// Queries the clawback records across all finalized airdrops
+func (k Keeper) AllClawbackRecords(goCtx context.Context, req *types.QueryAllClawbackRecordsRequest) (*types.QueryAllClawbackRecordsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	clawbackRecords := k.GetAllClawbackRecords(ctx)
+	return &types.QueryAllClawbackRecordsResponse{ClawbackRecords: clawbackRecords}, nil
+}
@@ -55,6 +55,7 @@ func (s *KeeperTestSuite) TestQueryAirdrop() {
 
 	s.Require().Equal(expectedDateIndex, respAirdrop.CurrentDateIndex, "airdrop date index")
 	s.Require().Equal(expectedAirdropLength, respAirdrop.AirdropLength, "airdrop length")
+	s.Require().False(respAirdrop.Finalized, "airdrop finalized")
 
 	// Update the block time so the airdrop hasn't started yet
 	// Confirm the current date index is -1
@@ -183,10 +184,13 @@ func (s *KeeperTestSuite) TestQueryUserSummary() {
 	claimable := sdkmath.NewInt(1 + 5)
 
 	userAllocation := types.UserAllocation{
-		AirdropId: AirdropId,
-		Address:   UserAddress,
-		Claimed:   claimed,
-		Forfeited: forfeited,
+		AirdropId:    AirdropId,
+		Address:      UserAddress,
+		Claimed:      claimed,
+		Forfeited:    forfeited,
+		Staked:       sdkmath.ZeroInt(),
+		StakingBonus: sdkmath.ZeroInt(),
+		Vested:       sdkmath.ZeroInt(),
 		Allocations: []sdkmath.Int{
 			sdkmath.ZeroInt(),
 			sdkmath.NewInt(1),
@@ -242,3 +246,36 @@ func (s *KeeperTestSuite) TestQueryUserSummary() {
 	s.Require().NoError(err, "no error expected when querying user summary after airdrop")
 	s.Require().Equal(int64(0), resp.Claimable.Int64(), "claimable after airdrop")
 }
+
+func (s *KeeperTestSuite) TestQueryClawbackRecord() {
+	// Create clawback records for multiple airdrops
+	expectedRecords := []types.ClawbackRecord{}
+	for i := 1; i <= 3; i++ {
+		clawbackRecord := types.ClawbackRecord{
+			AirdropId:  fmt.Sprintf("airdrop-%d", i),
+			Recipient:  "recipient",
+			Allocated:  sdkmath.NewInt(int64(i * 100)),
+			Claimed:    sdkmath.NewInt(int64(i * 50)),
+			Forfeited:  sdkmath.NewInt(int64(i * 10)),
+			ClawedBack: sdkmath.NewInt(int64(i * 40)),
+		}
+		s.App.AirdropKeeper.SetClawbackRecord(s.Ctx, clawbackRecord)
+		expectedRecords = append(expectedRecords, clawbackRecord)
+	}
+
+	// Query for a single record
+	req := &types.QueryClawbackRecordRequest{AirdropId: "airdrop-2"}
+	resp, err := s.App.AirdropKeeper.ClawbackRecord(sdk.WrapSDKContext(s.Ctx), req)
+	s.Require().NoError(err, "no error expected when querying a clawback record")
+	s.Require().Equal(expectedRecords[1], resp.ClawbackRecord, "clawback record")
+
+	// Query for a record that doesn't exist
+	req = &types.QueryClawbackRecordRequest{AirdropId: "fake-airdrop"}
+	_, err = s.App.AirdropKeeper.ClawbackRecord(sdk.WrapSDKContext(s.Ctx), req)
+	s.Require().ErrorContains(err, "clawback record not found for airdrop fake-airdrop")
+
+	// Query for all records
+	allResp, err := s.App.AirdropKeeper.AllClawbackRecords(sdk.WrapSDKContext(s.Ctx), &types.QueryAllClawbackRecordsRequest{})
+	s.Require().NoError(err, "no error expected when querying all clawback records")
+	s.Require().Equal(expectedRecords, allResp.ClawbackRecords, "all clawback records")
+}
@@ -43,16 +43,28 @@ const (
 	// CLAIM_EARLY indicates that the airdrop rewards have been claimed early,
 	// with half going to the user and half being clawed back
 	CLAIM_EARLY ClaimType = 1
+	// CLAIM_AND_STAKE indicates that the airdrop rewards are accumulated daily
+	// (same as CLAIM_DAILY), but each claim is liquid staked, with the airdrop's
+	// staking bonus added on top
+	CLAIM_AND_STAKE ClaimType = 2
+	// CLAIM_AND_VEST indicates that the full remaining airdrop rewards have been
+	// claimed up front without a penalty, but are locked in a vesting account
+	// that unlocks each period's allocation over the course of that period
+	CLAIM_AND_VEST ClaimType = 3
 )
 
 var ClaimType_name = map[int32]string{
 	0: "CLAIM_DAILY",
 	1: "CLAIM_EARLY",
+	2: "CLAIM_AND_STAKE",
+	3: "CLAIM_AND_VEST",
 }
 
 var ClaimType_value = map[string]int32{
-	"CLAIM_DAILY": 0,
-	"CLAIM_EARLY": 1,
+	"CLAIM_DAILY":     0,
+	"CLAIM_EARLY":     1,
+	"CLAIM_AND_STAKE": 2,
+	"CLAIM_AND_VEST":  3,
 }
 
 func (x ClaimType) String() string {
@@ -63,11 +75,51 @@ func (ClaimType) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_49e89994d4a2aee3, []int{0}
 }
 
+// ClaimCondition enum represents an action that a user must complete in order
+// to claim their rewards from an airdrop
+type ClaimCondition int32
+
+const (
+	// CLAIM_CONDITION_NONE indicates that rewards can be claimed at any time
+	// with a claim message
+	CLAIM_CONDITION_NONE ClaimCondition = 0
+	// CLAIM_CONDITION_LIQUID_STAKE indicates that the accrued rewards are claimed
+	// automatically each time the user liquid stakes (or with MsgClaimAndStake)
+	CLAIM_CONDITION_LIQUID_STAKE ClaimCondition = 1
+	// CLAIM_CONDITION_DELEGATE_STAKE indicates that the accrued rewards are
+	// claimed automatically each time the user modifies a delegation
+	CLAIM_CONDITION_DELEGATE_STAKE ClaimCondition = 2
+)
+
+var ClaimCondition_name = map[int32]string{
+	0: "CLAIM_CONDITION_NONE",
+	1: "CLAIM_CONDITION_LIQUID_STAKE",
+	2: "CLAIM_CONDITION_DELEGATE_STAKE",
+}
+
+var ClaimCondition_value = map[string]int32{
+	"CLAIM_CONDITION_NONE":           0,
+	"CLAIM_CONDITION_LIQUID_STAKE":   1,
+	"CLAIM_CONDITION_DELEGATE_STAKE": 2,
+}
+
+func (x ClaimCondition) String() string {
+	return proto.EnumName(ClaimCondition_name, int32(x))
+}
+
+func (ClaimCondition) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_49e89994d4a2aee3, []int{1}
+}
+
 // Airdrop module parameters
 type Params struct {
 	// The number of seconds between each element in the allocations array
 	// In practice this is always 24 hours, but it's customizable for testing
 	PeriodLengthSeconds int64 `protobuf:"varint,1,opt,name=period_length_seconds,json=periodLengthSeconds,proto3" json:"period_length_seconds,omitempty"`
+	// Address that receives the unclaimed rewards once an airdrop's clawback
+	// date has passed
+	// If empty, the rewards are sent to the community pool
+	ClawbackRecipient string `protobuf:"bytes,2,opt,name=clawback_recipient,json=clawbackRecipient,proto3" json:"clawback_recipient,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -110,6 +162,13 @@ func (m *Params) GetPeriodLengthSeconds() int64 {
 	return 0
 }
 
+func (m *Params) GetClawbackRecipient() string {
+	if m != nil {
+		return m.ClawbackRecipient
+	}
+	return ""
+}
+
 // UserAllocation tracks the status of an allocation for a user on a specific
 // airdrop
 type UserAllocation struct {
@@ -138,6 +197,15 @@ type UserAllocation struct {
 	//   *MsgClaimEarly*
 	//   Day 1: {claimed:15, forfeited:15, allocations:[0,0,0]}
 	Allocations []cosmossdk_io_math.Int `protobuf:"bytes,5,rep,name=allocations,proto3,customtype=cosmossdk.io/math.Int" json:"allocations"`
+	// The portion of the claimed amount that was liquid staked with
+	// MsgClaimAndStake (the unstaked portion is claimed - staked)
+	Staked cosmossdk_io_math.Int `protobuf:"bytes,6,opt,name=staked,proto3,customtype=cosmossdk.io/math.Int" json:"staked"`
+	// The total bonus paid out by the distributor for claiming and staking
+	// This is not included in the claimed amount
+	StakingBonus cosmossdk_io_math.Int `protobuf:"bytes,7,opt,name=staking_bonus,json=stakingBonus,proto3,customtype=cosmossdk.io/math.Int" json:"staking_bonus"`
+	// The portion of the claimed amount that was sent to a vesting account with
+	// MsgClaimAndVest
+	Vested cosmossdk_io_math.Int `protobuf:"bytes,8,opt,name=vested,proto3,customtype=cosmossdk.io/math.Int" json:"vested"`
 }
 
 func (m *UserAllocation) Reset()         { *m = UserAllocation{} }
@@ -213,6 +281,22 @@ type Airdrop struct {
 	AllocatorAddress string `protobuf:"bytes,9,opt,name=allocator_address,json=allocatorAddress,proto3" json:"allocator_address,omitempty"`
 	// Admin account with permissions to link addresseses
 	LinkerAddress string `protobuf:"bytes,10,opt,name=linker_address,json=linkerAddress,proto3" json:"linker_address,omitempty"`
+	// Indicates that the clawback date has passed and the unclaimed rewards have
+	// been clawed back
+	Finalized bool `protobuf:"varint,11,opt,name=finalized,proto3" json:"finalized,omitempty"`
+	// Optional hex-encoded merkle root committing to each user's allocations
+	// If set, allocations are not added on-chain up front, and instead, each
+	// user's allocation is stored the first time they claim with a merkle proof
+	AllocationsMerkleRoot string `protobuf:"bytes,12,opt,name=allocations_merkle_root,json=allocationsMerkleRoot,proto3" json:"allocations_merkle_root,omitempty"`
+	// Bonus paid from the distributor when rewards are claimed and liquid staked
+	// with MsgClaimAndStake - e.g. 0.1 means a staker receives an extra 10% of
+	// their claimed rewards
+	// The reward denom must be the IBC denom of a stakeibc host zone
+	ClaimAndStakeBonus cosmossdk_io_math.LegacyDec `protobuf:"bytes,13,opt,name=claim_and_stake_bonus,json=claimAndStakeBonus,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"claim_and_stake_bonus"`
+	// Action that the user must complete to claim their rewards
+	// If set, rewards cannot be claimed directly with a claim message, and are
+	// instead claimed automatically after the user completes the action
+	ClaimCondition ClaimCondition `protobuf:"varint,14,opt,name=claim_condition,json=claimCondition,proto3,enum=stride.airdrop.ClaimCondition" json:"claim_condition,omitempty"`
 }
 
 func (m *Airdrop) Reset()         { *m = Airdrop{} }
@@ -311,58 +395,234 @@ func (m *Airdrop) GetLinkerAddress() string {
 	return ""
 }
 
+func (m *Airdrop) GetFinalized() bool {
+	if m != nil {
+		return m.Finalized
+	}
+	return false
+}
+
+func (m *Airdrop) GetAllocationsMerkleRoot() string {
+	if m != nil {
+		return m.AllocationsMerkleRoot
+	}
+	return ""
+}
+
+func (m *Airdrop) GetClaimCondition() ClaimCondition {
+	if m != nil {
+		return m.ClaimCondition
+	}
+	return CLAIM_CONDITION_NONE
+}
+
+// ClawbackRecord tracks the outcome of an airdrop after its unclaimed rewards
+// have been clawed back
+type ClawbackRecord struct {
+	// ID of the airdrop
+	AirdropId string `protobuf:"bytes,1,opt,name=airdrop_id,json=airdropId,proto3" json:"airdrop_id,omitempty"`
+	// Address that received the clawed back rewards
+	Recipient string `protobuf:"bytes,2,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	// The total amount of rewards allocated across all users
+	Allocated cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=allocated,proto3,customtype=cosmossdk.io/math.Int" json:"allocated"`
+	// The total amount of rewards claimed across all users
+	Claimed cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=claimed,proto3,customtype=cosmossdk.io/math.Int" json:"claimed"`
+	// The total amount of rewards forfeited from claiming early
+	Forfeited cosmossdk_io_math.Int `protobuf:"bytes,5,opt,name=forfeited,proto3,customtype=cosmossdk.io/math.Int" json:"forfeited"`
+	// The amount of rewards swept from the distributor to the recipient
+	ClawedBack cosmossdk_io_math.Int `protobuf:"bytes,6,opt,name=clawed_back,json=clawedBack,proto3,customtype=cosmossdk.io/math.Int" json:"clawed_back"`
+	// Time at which the clawback was executed
+	ClawbackTime *time.Time `protobuf:"bytes,7,opt,name=clawback_time,json=clawbackTime,proto3,stdtime" json:"clawback_time,omitempty"`
+}
+
+func (m *ClawbackRecord) Reset()         { *m = ClawbackRecord{} }
+func (m *ClawbackRecord) String() string { return proto.CompactTextString(m) }
+func (*ClawbackRecord) ProtoMessage()    {}
+func (*ClawbackRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_49e89994d4a2aee3, []int{3}
+}
+func (m *ClawbackRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ClawbackRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ClawbackRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ClawbackRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClawbackRecord.Merge(m, src)
+}
+func (m *ClawbackRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *ClawbackRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClawbackRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ClawbackRecord proto.InternalMessageInfo
+
+func (m *ClawbackRecord) GetAirdropId() string {
+	if m != nil {
+		return m.AirdropId
+	}
+	return ""
+}
+
+func (m *ClawbackRecord) GetRecipient() string {
+	if m != nil {
+		return m.Recipient
+	}
+	return ""
+}
+
+func (m *ClawbackRecord) GetClawbackTime() *time.Time {
+	if m != nil {
+		return m.ClawbackTime
+	}
+	return nil
+}
+
+// MaterializedAllocation records that a user's leaf of an airdrop's allocations
+// merkle root has been stored on-chain, so that the same leaf cannot be stored
+// twice (e.g. after the allocation was moved to another address when linking)
+type MaterializedAllocation struct {
+	// Airdrop ID
+	AirdropId string `protobuf:"bytes,1,opt,name=airdrop_id,json=airdropId,proto3" json:"airdrop_id,omitempty"`
+	// Address of the merkle leaf
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *MaterializedAllocation) Reset()         { *m = MaterializedAllocation{} }
+func (m *MaterializedAllocation) String() string { return proto.CompactTextString(m) }
+func (*MaterializedAllocation) ProtoMessage()    {}
+func (*MaterializedAllocation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_49e89994d4a2aee3, []int{4}
+}
+func (m *MaterializedAllocation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MaterializedAllocation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MaterializedAllocation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MaterializedAllocation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MaterializedAllocation.Merge(m, src)
+}
+func (m *MaterializedAllocation) XXX_Size() int {
+	return m.Size()
+}
+func (m *MaterializedAllocation) XXX_DiscardUnknown() {
+	xxx_messageInfo_MaterializedAllocation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MaterializedAllocation proto.InternalMessageInfo
+
+func (m *MaterializedAllocation) GetAirdropId() string {
+	if m != nil {
+		return m.AirdropId
+	}
+	return ""
+}
+
+func (m *MaterializedAllocation) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterEnum("stride.airdrop.ClaimType", ClaimType_name, ClaimType_value)
+	proto.RegisterEnum("stride.airdrop.ClaimCondition", ClaimCondition_name, ClaimCondition_value)
 	proto.RegisterType((*Params)(nil), "stride.airdrop.Params")
 	proto.RegisterType((*UserAllocation)(nil), "stride.airdrop.UserAllocation")
 	proto.RegisterType((*Airdrop)(nil), "stride.airdrop.Airdrop")
+	proto.RegisterType((*ClawbackRecord)(nil), "stride.airdrop.ClawbackRecord")
+	proto.RegisterType((*MaterializedAllocation)(nil), "stride.airdrop.MaterializedAllocation")
 }
 
 func init() { proto.RegisterFile("stride/airdrop/airdrop.proto", fileDescriptor_49e89994d4a2aee3) }
 
 var fileDescriptor_49e89994d4a2aee3 = []byte{
-	// 655 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x94, 0xc1, 0x4e, 0xdb, 0x4a,
-	0x14, 0x86, 0xe3, 0x10, 0xc8, 0xcd, 0x04, 0x72, 0x61, 0x00, 0x5d, 0xdf, 0xdc, 0x8b, 0x43, 0xe9,
-	0xa6, 0xaa, 0x84, 0xad, 0x86, 0x4a, 0x5d, 0xb4, 0x12, 0x0a, 0x24, 0x8b, 0xa8, 0xa9, 0x84, 0x1c,
-	0x2a, 0x95, 0x6e, 0x46, 0x13, 0xcf, 0x60, 0x46, 0xd8, 0x9e, 0x68, 0x66, 0x28, 0xcd, 0x1b, 0x74,
-	0xc9, 0xba, 0xdb, 0xbe, 0x42, 0xd5, 0x67, 0x60, 0x89, 0xba, 0xaa, 0xba, 0xa0, 0x15, 0xbc, 0x48,
-	0xe5, 0x19, 0x3b, 0x49, 0xd5, 0x45, 0xb2, 0x4a, 0x7c, 0xfe, 0xf3, 0x7f, 0xf6, 0x9c, 0xff, 0x68,
-	0xc0, 0xff, 0x52, 0x09, 0x46, 0xa8, 0x87, 0x99, 0x20, 0x82, 0x0f, 0xf3, 0x5f, 0x77, 0x28, 0xb8,
-	0xe2, 0xb0, 0x66, 0x54, 0x37, 0xab, 0xd6, 0xff, 0x0d, 0xb8, 0x8c, 0xb9, 0x44, 0x5a, 0xf5, 0xcc,
-	0x83, 0x69, 0xad, 0x6f, 0x84, 0x3c, 0xe4, 0xa6, 0x9e, 0xfe, 0xcb, 0xaa, 0x8d, 0x90, 0xf3, 0x30,
-	0xa2, 0x9e, 0x7e, 0x1a, 0x5c, 0x9c, 0x7a, 0x8a, 0xc5, 0x54, 0x2a, 0x1c, 0x67, 0x6f, 0xd8, 0x79,
-	0x01, 0x96, 0x8e, 0xb0, 0xc0, 0xb1, 0x84, 0x4d, 0xb0, 0x39, 0xa4, 0x82, 0x71, 0x82, 0x22, 0x9a,
-	0x84, 0xea, 0x0c, 0x49, 0x1a, 0xf0, 0x84, 0x48, 0xdb, 0xda, 0xb6, 0x1e, 0x2d, 0xf8, 0xeb, 0x46,
-	0xec, 0x69, 0xad, 0x6f, 0xa4, 0x9d, 0x8f, 0x45, 0x50, 0x7b, 0x2d, 0xa9, 0x68, 0x45, 0x11, 0x0f,
-	0xb0, 0x62, 0x3c, 0x81, 0x5b, 0x00, 0x64, 0x5f, 0x8b, 0x18, 0xd1, 0xde, 0x8a, 0x5f, 0xc9, 0x2a,
-	0x5d, 0x02, 0x9b, 0xa0, 0x8c, 0x09, 0x11, 0x54, 0x4a, 0xbb, 0x98, 0x6a, 0x07, 0xf6, 0xd7, 0xcf,
-	0xbb, 0x1b, 0xd9, 0x49, 0x5a, 0x46, 0xe9, 0x2b, 0xc1, 0x92, 0xd0, 0xcf, 0x1b, 0xe1, 0x33, 0x50,
-	0x0e, 0x22, 0xcc, 0x62, 0x4a, 0xec, 0x05, 0xed, 0xd9, 0xba, 0xbe, 0x6d, 0x14, 0xbe, 0xdf, 0x36,
-	0x36, 0x8d, 0x4f, 0x92, 0x73, 0x97, 0x71, 0x2f, 0xc6, 0xea, 0xcc, 0xed, 0x26, 0xca, 0xcf, 0xbb,
-	0xe1, 0x73, 0x50, 0x39, 0xe5, 0xe2, 0x94, 0x32, 0x45, 0x89, 0x5d, 0x9a, 0xc7, 0x3a, 0xe9, 0x87,
-	0xfb, 0xa0, 0x8a, 0xc7, 0xc7, 0x92, 0xf6, 0xe2, 0xf6, 0xc2, 0x6c, 0xfb, 0xb4, 0x63, 0xe7, 0xcb,
-	0x22, 0x28, 0xb7, 0xcc, 0xc1, 0x61, 0x0d, 0x14, 0xc7, 0xd3, 0x28, 0x32, 0x02, 0x1f, 0x80, 0x65,
-	0x41, 0x2f, 0xb1, 0x20, 0x88, 0xd0, 0x84, 0xc7, 0x66, 0x16, 0x7e, 0xd5, 0xd4, 0xda, 0x69, 0x09,
-	0xbe, 0x01, 0xff, 0x10, 0x96, 0xe6, 0x3f, 0xb8, 0x48, 0x79, 0x48, 0x2a, 0x2c, 0x14, 0x22, 0x58,
-	0x51, 0x3d, 0x85, 0x6a, 0xb3, 0xee, 0x9a, 0x70, 0xdd, 0x3c, 0x5c, 0xf7, 0x38, 0x0f, 0xf7, 0xa0,
-	0x74, 0xf5, 0xa3, 0x61, 0xf9, 0x9b, 0xd3, 0x80, 0x7e, 0xea, 0x6f, 0x63, 0x45, 0xe1, 0x31, 0xf8,
-	0x4d, 0x40, 0x34, 0x21, 0x86, 0x5b, 0x9a, 0x93, 0xbb, 0x3e, 0x6d, 0xef, 0x24, 0x44, 0x53, 0x3b,
-	0x60, 0x25, 0x88, 0xf0, 0xe5, 0x00, 0x07, 0xe7, 0x86, 0xb6, 0x38, 0x27, 0x6d, 0x39, 0xb7, 0x69,
-	0xcc, 0x09, 0xb0, 0x75, 0x7c, 0x48, 0x8d, 0x86, 0x14, 0x11, 0x8a, 0x49, 0xc4, 0x12, 0x6a, 0x88,
-	0x4b, 0xf3, 0x9e, 0x5b, 0x13, 0x8e, 0x47, 0x43, 0xda, 0xce, 0xfc, 0x1a, 0xdd, 0x07, 0xeb, 0x14,
-	0x8b, 0x68, 0x84, 0xcc, 0x0b, 0x86, 0x34, 0xc1, 0x91, 0x1a, 0xd9, 0x65, 0xbd, 0x18, 0x0f, 0xb3,
-	0x64, 0xff, 0xfb, 0x33, 0xd9, 0x1e, 0x0d, 0x71, 0x30, 0x6a, 0xd3, 0xc0, 0x5f, 0xd3, 0xfe, 0xc3,
-	0xd4, 0x7e, 0x64, 0xdc, 0xb0, 0x0b, 0x26, 0xd3, 0xe0, 0x02, 0xe5, 0xcb, 0xfd, 0xd7, 0x8c, 0xe5,
-	0x86, 0x53, 0xa6, 0x4c, 0x81, 0x1d, 0xb0, 0x96, 0xed, 0xcf, 0x14, 0xa8, 0x32, 0x03, 0xb4, 0x3a,
-	0xb6, 0xe4, 0x98, 0x7d, 0x50, 0x8b, 0x58, 0x72, 0x4e, 0x27, 0x0c, 0x30, 0x83, 0xb1, 0x62, 0xfa,
-	0xb3, 0xe2, 0xe3, 0x3d, 0x50, 0x39, 0xcc, 0x07, 0x08, 0xff, 0x06, 0xd5, 0xc3, 0x5e, 0xab, 0xfb,
-	0x0a, 0xb5, 0x5b, 0xdd, 0xde, 0xc9, 0x6a, 0x61, 0x52, 0xe8, 0xb4, 0xfc, 0xde, 0xc9, 0xaa, 0x55,
-	0x2f, 0x7d, 0xf8, 0xe4, 0x14, 0x0e, 0x5e, 0x5e, 0xdf, 0x39, 0xd6, 0xcd, 0x9d, 0x63, 0xfd, 0xbc,
-	0x73, 0xac, 0xab, 0x7b, 0xa7, 0x70, 0x73, 0xef, 0x14, 0xbe, 0xdd, 0x3b, 0x85, 0xb7, 0x4f, 0x42,
-	0xa6, 0xce, 0x2e, 0x06, 0x6e, 0xc0, 0x63, 0xaf, 0xaf, 0xef, 0xb3, 0xdd, 0x1e, 0x1e, 0x48, 0x2f,
-	0xbb, 0xf9, 0xde, 0x35, 0x9f, 0x7a, 0xef, 0xc7, 0xf7, 0x5f, 0x9a, 0xba, 0x1c, 0x2c, 0xe9, 0x68,
-	0xf7, 0x7e, 0x05, 0x00, 0x00, 0xff, 0xff, 0x9f, 0x99, 0xae, 0xf9, 0x1e, 0x05, 0x00, 0x00,
+	// 1000 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x56, 0xcf, 0x6e, 0x1b, 0xb7,
+	0x13, 0xd6, 0x5a, 0xb6, 0x65, 0x8d, 0x6c, 0x59, 0xa6, 0xed, 0x5f, 0xf6, 0xe7, 0x3a, 0xb2, 0xaa,
+	0x5e, 0x8c, 0x00, 0x91, 0x50, 0xb7, 0x4d, 0x0f, 0x05, 0x1a, 0xe8, 0x1f, 0x0c, 0x21, 0xb2, 0x9d,
+	0xac, 0x94, 0xa0, 0x2e, 0x0a, 0x10, 0xd4, 0x92, 0x96, 0x09, 0xad, 0x96, 0x02, 0x97, 0x76, 0xaa,
+	0xde, 0x0b, 0xf4, 0x98, 0x57, 0x28, 0x7a, 0xee, 0xad, 0x0f, 0x91, 0x43, 0x0f, 0x41, 0x4f, 0x45,
+	0x0f, 0x69, 0x61, 0xbf, 0x48, 0xb1, 0xe4, 0xae, 0x24, 0xbb, 0x07, 0x6d, 0x7b, 0x92, 0x38, 0x33,
+	0xdf, 0x37, 0x24, 0x67, 0xe6, 0xe3, 0xc2, 0x7e, 0xa0, 0x24, 0xa7, 0xac, 0x4a, 0xb8, 0xa4, 0x52,
+	0x8c, 0xe3, 0xdf, 0xca, 0x58, 0x0a, 0x25, 0x50, 0xde, 0x78, 0x2b, 0x91, 0x75, 0xef, 0xff, 0xae,
+	0x08, 0x46, 0x22, 0xc0, 0xda, 0x5b, 0x35, 0x0b, 0x13, 0xba, 0xb7, 0x33, 0x10, 0x03, 0x61, 0xec,
+	0xe1, 0xbf, 0xc8, 0x7a, 0x30, 0x10, 0x62, 0xe0, 0xb1, 0xaa, 0x5e, 0xf5, 0xaf, 0x2e, 0xaa, 0x8a,
+	0x8f, 0x58, 0xa0, 0xc8, 0x28, 0xca, 0x50, 0xfe, 0xde, 0x82, 0xd5, 0xe7, 0x44, 0x92, 0x51, 0x80,
+	0x8e, 0x60, 0x77, 0xcc, 0x24, 0x17, 0x14, 0x7b, 0xcc, 0x1f, 0xa8, 0x4b, 0x1c, 0x30, 0x57, 0xf8,
+	0x34, 0xb0, 0xad, 0x92, 0x75, 0x98, 0x76, 0xb6, 0x8d, 0xb3, 0xa3, 0x7d, 0x5d, 0xe3, 0x42, 0xc7,
+	0x80, 0x5c, 0x8f, 0xbc, 0xee, 0x13, 0x77, 0x88, 0x25, 0x73, 0xf9, 0x98, 0x33, 0x5f, 0xd9, 0x4b,
+	0x25, 0xeb, 0x30, 0x5b, 0xb7, 0x7f, 0xfb, 0xe5, 0xf1, 0x4e, 0xb4, 0xc7, 0x1a, 0xa5, 0x92, 0x05,
+	0x41, 0x57, 0x49, 0xee, 0x0f, 0x9c, 0xad, 0x18, 0xe3, 0xc4, 0x90, 0xf2, 0xaf, 0x69, 0xc8, 0xbf,
+	0x0c, 0x98, 0xac, 0x79, 0x9e, 0x70, 0x89, 0xe2, 0xc2, 0x47, 0x0f, 0x01, 0xa2, 0x73, 0x63, 0x4e,
+	0xf5, 0x26, 0xb2, 0x4e, 0x36, 0xb2, 0xb4, 0x29, 0x3a, 0x82, 0x0c, 0x31, 0xac, 0x0b, 0xf3, 0xc5,
+	0x81, 0xe8, 0x73, 0xc8, 0xb8, 0x1e, 0xe1, 0x23, 0x46, 0xed, 0xb4, 0xc6, 0x3c, 0x7c, 0xfb, 0xfe,
+	0x20, 0xf5, 0xc7, 0xfb, 0x83, 0x5d, 0x83, 0x0b, 0xe8, 0xb0, 0xc2, 0x45, 0x75, 0x44, 0xd4, 0x65,
+	0xa5, 0xed, 0x2b, 0x27, 0x8e, 0x46, 0x5f, 0x40, 0xf6, 0x42, 0xc8, 0x0b, 0xc6, 0x15, 0xa3, 0xf6,
+	0x72, 0x12, 0xe8, 0x2c, 0x1e, 0x3d, 0x85, 0x1c, 0x99, 0x1e, 0x2b, 0xb0, 0x57, 0x4a, 0xe9, 0xc5,
+	0xf0, 0x79, 0x04, 0xfa, 0x0c, 0x56, 0x03, 0x45, 0x86, 0x8c, 0xda, 0xab, 0x49, 0x52, 0x47, 0xc1,
+	0xa8, 0x0e, 0x1b, 0xe1, 0x3f, 0xee, 0x0f, 0x70, 0x5f, 0xf8, 0x57, 0x81, 0x9d, 0x49, 0x82, 0x5e,
+	0x8f, 0x30, 0xf5, 0x10, 0x12, 0xa6, 0xbe, 0x66, 0x41, 0x78, 0xea, 0xb5, 0x44, 0xa9, 0x4d, 0x70,
+	0xf9, 0xe7, 0x0c, 0x64, 0x6a, 0xa6, 0x54, 0x28, 0x0f, 0x4b, 0xd3, 0xfa, 0x2d, 0x71, 0x8a, 0x3e,
+	0x84, 0x75, 0xc9, 0x5e, 0x13, 0x49, 0x31, 0x65, 0xbe, 0x18, 0x99, 0xea, 0x39, 0x39, 0x63, 0x6b,
+	0x86, 0x26, 0xf4, 0x15, 0x3c, 0xa0, 0x3c, 0xec, 0xfd, 0xfe, 0x55, 0x78, 0x03, 0x38, 0x50, 0x44,
+	0x2a, 0x4c, 0x89, 0x62, 0xba, 0x6e, 0xb9, 0xa3, 0xbd, 0x8a, 0x69, 0xec, 0x4a, 0xdc, 0xd8, 0x95,
+	0x5e, 0xdc, 0xd8, 0xf5, 0xe5, 0x37, 0x7f, 0x1e, 0x58, 0xce, 0xee, 0x3c, 0x41, 0x37, 0xc4, 0x37,
+	0x89, 0x62, 0xa8, 0x07, 0x77, 0x1c, 0x98, 0xf9, 0xd4, 0xf0, 0x2e, 0x27, 0xe4, 0xdd, 0x9e, 0x87,
+	0xb7, 0x7c, 0xaa, 0x59, 0x5b, 0xb0, 0x31, 0x1d, 0x03, 0xcd, 0xb6, 0x92, 0x90, 0x6d, 0x3d, 0x86,
+	0x69, 0x9a, 0x73, 0xb0, 0x75, 0xc3, 0x61, 0x35, 0x19, 0x33, 0x4c, 0x19, 0xa1, 0x1e, 0xf7, 0x99,
+	0x61, 0x5c, 0x4d, 0x7a, 0x6e, 0xcd, 0xd0, 0x9b, 0x8c, 0x59, 0x33, 0xc2, 0x6b, 0xea, 0x2e, 0x6c,
+	0x33, 0x22, 0xbd, 0x09, 0x36, 0x09, 0xc6, 0xcc, 0x27, 0x9e, 0x9a, 0x44, 0x1d, 0xf1, 0x51, 0x54,
+	0xd4, 0x0f, 0xfe, 0x59, 0xd4, 0x0e, 0x1b, 0x10, 0x77, 0xd2, 0x64, 0xae, 0xb3, 0xa5, 0xf1, 0x8d,
+	0x10, 0xfe, 0xdc, 0xa0, 0x51, 0x1b, 0x66, 0xb7, 0x21, 0x24, 0x8e, 0xc7, 0x71, 0x6d, 0xc1, 0x38,
+	0xa2, 0x39, 0x50, 0xe4, 0x41, 0x2d, 0xd8, 0x8a, 0x3a, 0x7e, 0x8e, 0x28, 0xbb, 0x80, 0xa8, 0x30,
+	0x85, 0xc4, 0x34, 0x4f, 0x21, 0xef, 0x71, 0x7f, 0xc8, 0x66, 0x1c, 0xb0, 0x80, 0x63, 0xc3, 0xc4,
+	0xc7, 0x04, 0xfb, 0x90, 0xbd, 0xe0, 0x3e, 0xf1, 0xf8, 0x77, 0x8c, 0xda, 0xb9, 0x92, 0x75, 0xb8,
+	0xe6, 0xcc, 0x0c, 0xe8, 0x09, 0x3c, 0x98, 0x9b, 0x4b, 0x3c, 0x62, 0x72, 0xe8, 0x31, 0x2c, 0x85,
+	0x50, 0xf6, 0xba, 0xee, 0xe2, 0xdd, 0x39, 0xf7, 0x89, 0xf6, 0x3a, 0x42, 0x28, 0xf4, 0x0a, 0x4c,
+	0x59, 0x30, 0xf1, 0x29, 0xd6, 0xd3, 0x19, 0x4d, 0xe4, 0x46, 0xf2, 0xfb, 0x47, 0x9a, 0xa1, 0xe6,
+	0xd3, 0x6e, 0x88, 0x37, 0xd3, 0x79, 0x0c, 0x9b, 0x86, 0x37, 0x54, 0x63, 0x1e, 0x66, 0xb5, 0xf3,
+	0x25, 0xeb, 0x30, 0x7f, 0x54, 0xac, 0xdc, 0x7d, 0x39, 0x2a, 0xba, 0x6e, 0x8d, 0x38, 0xca, 0xc9,
+	0xbb, 0x77, 0xd6, 0xe5, 0x1f, 0xd3, 0x90, 0x6f, 0xcc, 0x44, 0x59, 0x48, 0xba, 0x48, 0x7e, 0x9f,
+	0x40, 0x36, 0xb9, 0xe0, 0xcf, 0x42, 0x43, 0x25, 0x8d, 0xee, 0x28, 0xa9, 0x08, 0xcf, 0xe2, 0xe7,
+	0xf5, 0x7b, 0xf9, 0xbf, 0xeb, 0xf7, 0xca, 0xbf, 0xd4, 0xef, 0x2f, 0x21, 0x17, 0x8e, 0x29, 0xa3,
+	0x38, 0xbc, 0x9e, 0x64, 0x1a, 0x0c, 0x06, 0x51, 0x27, 0xee, 0xf0, 0x8e, 0x3a, 0x84, 0xef, 0xaf,
+	0x9d, 0x49, 0x38, 0xcb, 0x53, 0x75, 0x08, 0x1d, 0xe5, 0x17, 0xf0, 0xbf, 0x13, 0xa2, 0x98, 0xe4,
+	0xa6, 0x19, 0x93, 0xbf, 0x94, 0xf6, 0xbd, 0x97, 0x72, 0xfa, 0x1e, 0x3e, 0xfa, 0x06, 0xb2, 0x8d,
+	0x58, 0x2e, 0xd0, 0x26, 0xe4, 0x1a, 0x9d, 0x5a, 0xfb, 0x04, 0x37, 0x6b, 0xed, 0xce, 0x79, 0x21,
+	0x35, 0x33, 0xb4, 0x6a, 0x4e, 0xe7, 0xbc, 0x60, 0xa1, 0x6d, 0xd8, 0x34, 0x86, 0xda, 0x69, 0x13,
+	0x77, 0x7b, 0xb5, 0x67, 0xad, 0xc2, 0x12, 0x42, 0x90, 0x9f, 0x19, 0x5f, 0xb5, 0xba, 0xbd, 0x42,
+	0x7a, 0x6f, 0xf9, 0x87, 0x9f, 0x8a, 0xa9, 0x47, 0xd7, 0xba, 0xa7, 0xe6, 0xda, 0x0c, 0xd9, 0xb0,
+	0x63, 0x62, 0x1b, 0x67, 0xa7, 0xcd, 0x76, 0xaf, 0x7d, 0x76, 0x8a, 0x4f, 0xcf, 0x4e, 0x5b, 0x85,
+	0x14, 0x2a, 0xc1, 0xfe, 0x7d, 0x4f, 0xa7, 0xfd, 0xe2, 0x65, 0x3b, 0xce, 0x63, 0xa1, 0x32, 0x14,
+	0xef, 0x47, 0x34, 0x5b, 0x9d, 0xd6, 0x71, 0xad, 0xd7, 0x8a, 0xf7, 0x62, 0xf2, 0xd6, 0x9f, 0xbd,
+	0xbd, 0x29, 0x5a, 0xef, 0x6e, 0x8a, 0xd6, 0x5f, 0x37, 0x45, 0xeb, 0xcd, 0x6d, 0x31, 0xf5, 0xee,
+	0xb6, 0x98, 0xfa, 0xfd, 0xb6, 0x98, 0xfa, 0xfa, 0xe3, 0x01, 0x57, 0x97, 0x57, 0xfd, 0x8a, 0x2b,
+	0x46, 0xd5, 0xae, 0x1e, 0x90, 0xc7, 0x1d, 0xd2, 0x0f, 0xaa, 0xd1, 0x47, 0xd8, 0xf5, 0xd1, 0xa7,
+	0xd5, 0x6f, 0xa7, 0x9f, 0x62, 0xa1, 0x08, 0x07, 0xfd, 0x55, 0x5d, 0x9d, 0x4f, 0xfe, 0x1e, 0x00,
+	0x01, 0xdc, 0xe7, 0x5c, 0xa9, 0x09, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -385,6 +645,13 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ClawbackRecipient) > 0 {
+		i -= len(m.ClawbackRecipient)
+		copy(dAtA[i:], m.ClawbackRecipient)
+		i = encodeVarintAirdrop(dAtA, i, uint64(len(m.ClawbackRecipient)))
+		i--
+		dAtA[i] = 0x12
+	}
 	if m.PeriodLengthSeconds != 0 {
 		i = encodeVarintAirdrop(dAtA, i, uint64(m.PeriodLengthSeconds))
 		i--
@@ -413,6 +680,36 @@ func (m *UserAllocation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.Vested.Size()
+		i -= size
+		if _, err := m.Vested.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintAirdrop(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	{
+		size := m.StakingBonus.Size()
+		i -= size
+		if _, err := m.StakingBonus.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintAirdrop(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.Staked.Size()
+		i -= size
+		if _, err := m.Staked.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintAirdrop(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
 	if len(m.Allocations) > 0 {
 		for iNdEx := len(m.Allocations) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -484,6 +781,38 @@ func (m *Airdrop) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ClaimCondition != 0 {
+		i = encodeVarintAirdrop(dAtA, i, uint64(m.ClaimCondition))
+		i--
+		dAtA[i] = 0x70
+	}
+	{
+		size := m.ClaimAndStakeBonus.Size()
+		i -= size
+		if _, err := m.ClaimAndStakeBonus.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintAirdrop(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x6a
+	if len(m.AllocationsMerkleRoot) > 0 {
+		i -= len(m.AllocationsMerkleRoot)
+		copy(dAtA[i:], m.AllocationsMerkleRoot)
+		i = encodeVarintAirdrop(dAtA, i, uint64(len(m.AllocationsMerkleRoot)))
+		i--
+		dAtA[i] = 0x62
+	}
+	if m.Finalized {
+		i--
+		if m.Finalized {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x58
+	}
 	if len(m.LinkerAddress) > 0 {
 		i -= len(m.LinkerAddress)
 		copy(dAtA[i:], m.LinkerAddress)
@@ -572,6 +901,130 @@ func (m *Airdrop) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ClawbackRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ClawbackRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ClawbackRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ClawbackTime != nil {
+		n5, err5 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.ClawbackTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ClawbackTime):])
+		if err5 != nil {
+			return 0, err5
+		}
+		i -= n5
+		i = encodeVarintAirdrop(dAtA, i, uint64(n5))
+		i--
+		dAtA[i] = 0x3a
+	}
+	{
+		size := m.ClawedBack.Size()
+		i -= size
+		if _, err := m.ClawedBack.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintAirdrop(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.Forfeited.Size()
+		i -= size
+		if _, err := m.Forfeited.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintAirdrop(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.Claimed.Size()
+		i -= size
+		if _, err := m.Claimed.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintAirdrop(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.Allocated.Size()
+		i -= size
+		if _, err := m.Allocated.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintAirdrop(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintAirdrop(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.AirdropId) > 0 {
+		i -= len(m.AirdropId)
+		copy(dAtA[i:], m.AirdropId)
+		i = encodeVarintAirdrop(dAtA, i, uint64(len(m.AirdropId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MaterializedAllocation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MaterializedAllocation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MaterializedAllocation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintAirdrop(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.AirdropId) > 0 {
+		i -= len(m.AirdropId)
+		copy(dAtA[i:], m.AirdropId)
+		i = encodeVarintAirdrop(dAtA, i, uint64(len(m.AirdropId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintAirdrop(dAtA []byte, offset int, v uint64) int {
 	offset -= sovAirdrop(v)
 	base := offset
@@ -592,6 +1045,10 @@ func (m *Params) Size() (n int) {
 	if m.PeriodLengthSeconds != 0 {
 		n += 1 + sovAirdrop(uint64(m.PeriodLengthSeconds))
 	}
+	l = len(m.ClawbackRecipient)
+	if l > 0 {
+		n += 1 + l + sovAirdrop(uint64(l))
+	}
 	return n
 }
 
@@ -619,6 +1076,12 @@ func (m *UserAllocation) Size() (n int) {
 			n += 1 + l + sovAirdrop(uint64(l))
 		}
 	}
+	l = m.Staked.Size()
+	n += 1 + l + sovAirdrop(uint64(l))
+	l = m.StakingBonus.Size()
+	n += 1 + l + sovAirdrop(uint64(l))
+	l = m.Vested.Size()
+	n += 1 + l + sovAirdrop(uint64(l))
 	return n
 }
 
@@ -666,9 +1129,67 @@ func (m *Airdrop) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovAirdrop(uint64(l))
 	}
-	return n
-}
-
+	if m.Finalized {
+		n += 2
+	}
+	l = len(m.AllocationsMerkleRoot)
+	if l > 0 {
+		n += 1 + l + sovAirdrop(uint64(l))
+	}
+	l = m.ClaimAndStakeBonus.Size()
+	n += 1 + l + sovAirdrop(uint64(l))
+	if m.ClaimCondition != 0 {
+		n += 1 + sovAirdrop(uint64(m.ClaimCondition))
+	}
+	return n
+}
+
+func (m *ClawbackRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.AirdropId)
+	if l > 0 {
+		n += 1 + l + sovAirdrop(uint64(l))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovAirdrop(uint64(l))
+	}
+	l = m.Allocated.Size()
+	n += 1 + l + sovAirdrop(uint64(l))
+	l = m.Claimed.Size()
+	n += 1 + l + sovAirdrop(uint64(l))
+	l = m.Forfeited.Size()
+	n += 1 + l + sovAirdrop(uint64(l))
+	l = m.ClawedBack.Size()
+	n += 1 + l + sovAirdrop(uint64(l))
+	if m.ClawbackTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ClawbackTime)
+		n += 1 + l + sovAirdrop(uint64(l))
+	}
+	return n
+}
+
+func (m *MaterializedAllocation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.AirdropId)
+	if l > 0 {
+		n += 1 + l + sovAirdrop(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovAirdrop(uint64(l))
+	}
+	return n
+}
+
 func sovAirdrop(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -723,6 +1244,38 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClawbackRecipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAirdrop
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAirdrop
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAirdrop
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClawbackRecipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAirdrop(dAtA[iNdEx:])
@@ -941,6 +1494,108 @@ func (m *UserAllocation) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Staked", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAirdrop
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAirdrop
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAirdrop
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Staked.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingBonus", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAirdrop
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAirdrop
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAirdrop
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.StakingBonus.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Vested", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAirdrop
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAirdrop
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAirdrop
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Vested.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAirdrop(dAtA[iNdEx:])
@@ -1329,6 +1984,511 @@ func (m *Airdrop) Unmarshal(dAtA []byte) error {
 			}
 			m.LinkerAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Finalized", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAirdrop
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Finalized = bool(v != 0)
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllocationsMerkleRoot", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAirdrop
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAirdrop
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAirdrop
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllocationsMerkleRoot = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClaimAndStakeBonus", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAirdrop
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAirdrop
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAirdrop
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ClaimAndStakeBonus.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClaimCondition", wireType)
+			}
+			m.ClaimCondition = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAirdrop
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ClaimCondition |= ClaimCondition(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAirdrop(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAirdrop
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ClawbackRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAirdrop
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ClawbackRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ClawbackRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AirdropId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAirdrop
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAirdrop
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAirdrop
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AirdropId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAirdrop
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAirdrop
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAirdrop
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Allocated", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAirdrop
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAirdrop
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAirdrop
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Allocated.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Claimed", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAirdrop
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAirdrop
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAirdrop
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Claimed.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Forfeited", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAirdrop
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAirdrop
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAirdrop
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Forfeited.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClawedBack", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAirdrop
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAirdrop
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAirdrop
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ClawedBack.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClawbackTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAirdrop
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAirdrop
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAirdrop
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ClawbackTime == nil {
+				m.ClawbackTime = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.ClawbackTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAirdrop(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAirdrop
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MaterializedAllocation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAirdrop
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MaterializedAllocation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MaterializedAllocation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AirdropId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAirdrop
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAirdrop
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAirdrop
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AirdropId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAirdrop
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAirdrop
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAirdrop
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAirdrop(dAtA[iNdEx:])
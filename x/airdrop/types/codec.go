@@ -12,22 +12,28 @@ import (
 func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	legacy.RegisterAminoMsg(cdc, &MsgClaimDaily{}, "airdrop/MsgClaimDaily")
 	legacy.RegisterAminoMsg(cdc, &MsgClaimEarly{}, "airdrop/MsgClaimEarly")
+	legacy.RegisterAminoMsg(cdc, &MsgClaimAndStake{}, "airdrop/MsgClaimAndStake")
+	legacy.RegisterAminoMsg(cdc, &MsgClaimAndVest{}, "airdrop/MsgClaimAndVest")
 	legacy.RegisterAminoMsg(cdc, &MsgCreateAirdrop{}, "airdrop/MsgCreateAirdrop")
 	legacy.RegisterAminoMsg(cdc, &MsgUpdateAirdrop{}, "airdrop/MsgUpdateAirdrop")
 	legacy.RegisterAminoMsg(cdc, &MsgAddAllocations{}, "airdrop/MsgAddAllocations")
 	legacy.RegisterAminoMsg(cdc, &MsgUpdateUserAllocation{}, "airdrop/MsgUpdateUserAllocation")
 	legacy.RegisterAminoMsg(cdc, &MsgLinkAddresses{}, "airdrop/MsgLinkAddresses")
+	legacy.RegisterAminoMsg(cdc, &MsgSetAllocationsRoot{}, "airdrop/MsgSetAllocationsRoot")
 }
 
 func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgClaimDaily{},
 		&MsgClaimEarly{},
+		&MsgClaimAndStake{},
+		&MsgClaimAndVest{},
 		&MsgCreateAirdrop{},
 		&MsgUpdateAirdrop{},
 		&MsgAddAllocations{},
 		&MsgUpdateUserAllocation{},
 		&MsgLinkAddresses{},
+		&MsgSetAllocationsRoot{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
@@ -20,4 +20,11 @@ var (
 	ErrFailedToLinkAddresses       = sdkerrors.Register(ModuleName, 2010, "unable to link addresses")
 	ErrInvalidAllocationListLength = sdkerrors.Register(ModuleName, 2011, "invalid allocations list length")
 	ErrInvalidAdminAddress         = sdkerrors.Register(ModuleName, 2012, "invalid admin address")
+	ErrAirdropFinalized            = sdkerrors.Register(ModuleName, 2013, "airdrop has been finalized")
+	ErrInvalidMerkleProof          = sdkerrors.Register(ModuleName, 2014, "invalid merkle proof")
+	ErrAllocationsMerkleRootSet    = sdkerrors.Register(ModuleName, 2015, "airdrop allocations are committed with a merkle root")
+	ErrRewardDenomNotStakeable     = sdkerrors.Register(ModuleName, 2016, "airdrop reward denom cannot be liquid staked")
+	ErrInvalidVestingAccount       = sdkerrors.Register(ModuleName, 2017, "account cannot receive vested rewards")
+	ErrClaimConditionNotMet        = sdkerrors.Register(ModuleName, 2018, "airdrop rewards are gated by a claim condition")
+	ErrAllocationsMaterialized     = sdkerrors.Register(ModuleName, 2019, "airdrop allocations have already been stored from the merkle root")
 )
@@ -1,12 +1,18 @@
 package types
 
-import "errors"
+import (
+	"errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
 
 // DefaultGenesis returns the default genesis state
 func DefaultGenesis() *GenesisState {
 	return &GenesisState{
-		Airdrops:        []Airdrop{},
-		UserAllocations: []UserAllocation{},
+		Airdrops:                []Airdrop{},
+		UserAllocations:         []UserAllocation{},
+		ClawbackRecords:         []ClawbackRecord{},
+		MaterializedAllocations: []MaterializedAllocation{},
 		Params: Params{
 			PeriodLengthSeconds: 24 * 60 * 60, // 1 day
 		},
@@ -19,5 +25,10 @@ func (gs GenesisState) Validate() error {
 	if gs.Params.PeriodLengthSeconds == 0 {
 		return errors.New("allocation window seconds must be set as a module param")
 	}
+	if gs.Params.ClawbackRecipient != "" {
+		if _, err := sdk.AccAddressFromBech32(gs.Params.ClawbackRecipient); err != nil {
+			return errors.New("invalid clawback recipient address")
+		}
+	}
 	return nil
 }
@@ -31,6 +31,10 @@ type GenesisState struct {
 	Airdrops []Airdrop `protobuf:"bytes,2,rep,name=airdrops,proto3" json:"airdrops" yaml:"airdrops"`
 	// All allocation records across all airdrops
 	UserAllocations []UserAllocation `protobuf:"bytes,3,rep,name=user_allocations,json=userAllocations,proto3" json:"user_allocations" yaml:"user_allocations"`
+	// Clawback records for all finalized airdrops
+	ClawbackRecords []ClawbackRecord `protobuf:"bytes,4,rep,name=clawback_records,json=clawbackRecords,proto3" json:"clawback_records" yaml:"clawback_records"`
+	// Merkle leaves that have been stored on-chain across all airdrops
+	MaterializedAllocations []MaterializedAllocation `protobuf:"bytes,5,rep,name=materialized_allocations,json=materializedAllocations,proto3" json:"materialized_allocations" yaml:"materialized_allocations"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -87,6 +91,20 @@ func (m *GenesisState) GetUserAllocations() []UserAllocation {
 	return nil
 }
 
+func (m *GenesisState) GetClawbackRecords() []ClawbackRecord {
+	if m != nil {
+		return m.ClawbackRecords
+	}
+	return nil
+}
+
+func (m *GenesisState) GetMaterializedAllocations() []MaterializedAllocation {
+	if m != nil {
+		return m.MaterializedAllocations
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "stride.airdrop.GenesisState")
 }
@@ -94,26 +112,31 @@ func init() {
 func init() { proto.RegisterFile("stride/airdrop/genesis.proto", fileDescriptor_bd8a2f92a6e82560) }
 
 var fileDescriptor_bd8a2f92a6e82560 = []byte{
-	// 295 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x29, 0x2e, 0x29, 0xca,
-	0x4c, 0x49, 0xd5, 0x4f, 0xcc, 0x2c, 0x4a, 0x29, 0xca, 0x2f, 0xd0, 0x4f, 0x4f, 0xcd, 0x4b, 0x2d,
-	0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0xe2, 0x83, 0xc8, 0xea, 0x41, 0x65, 0xa5,
-	0x44, 0xd2, 0xf3, 0xd3, 0xf3, 0xc1, 0x52, 0xfa, 0x20, 0x16, 0x44, 0x95, 0x14, 0xba, 0x19, 0x50,
-	0x1a, 0x22, 0xab, 0xd4, 0xcf, 0xc4, 0xc5, 0xe3, 0x0e, 0x31, 0x35, 0xb8, 0x24, 0xb1, 0x24, 0x55,
-	0xc8, 0x95, 0x8b, 0xad, 0x20, 0xb1, 0x28, 0x31, 0xb7, 0x58, 0x82, 0x51, 0x81, 0x51, 0x83, 0xdb,
-	0x48, 0x4c, 0x0f, 0xd5, 0x16, 0xbd, 0x00, 0xb0, 0xac, 0x93, 0xe8, 0x89, 0x7b, 0xf2, 0x0c, 0x9f,
-	0xee, 0xc9, 0xf3, 0x56, 0x26, 0xe6, 0xe6, 0x58, 0x29, 0x41, 0xf4, 0x28, 0x05, 0x41, 0x35, 0x0b,
-	0xf9, 0x70, 0x71, 0x40, 0x35, 0x14, 0x4b, 0x30, 0x29, 0x30, 0x6b, 0x70, 0x1b, 0x89, 0xa3, 0x1b,
-	0xe4, 0x08, 0xa1, 0x9d, 0xc4, 0xa1, 0x26, 0xf1, 0x43, 0x4c, 0x82, 0x69, 0x53, 0x0a, 0x82, 0x9b,
-	0x20, 0x94, 0xc5, 0x25, 0x50, 0x5a, 0x9c, 0x5a, 0x14, 0x9f, 0x98, 0x93, 0x93, 0x9f, 0x9c, 0x58,
-	0x92, 0x99, 0x9f, 0x57, 0x2c, 0xc1, 0x0c, 0x36, 0x55, 0x0e, 0xdd, 0xd4, 0xd0, 0xe2, 0xd4, 0x22,
-	0x47, 0xb8, 0x32, 0x27, 0x79, 0xa8, 0xe1, 0xe2, 0x10, 0xc3, 0xd1, 0x4d, 0x51, 0x0a, 0xe2, 0x2f,
-	0x45, 0xd1, 0x50, 0xec, 0xe4, 0x7d, 0xe2, 0x91, 0x1c, 0xe3, 0x85, 0x47, 0x72, 0x8c, 0x0f, 0x1e,
-	0xc9, 0x31, 0x4e, 0x78, 0x2c, 0xc7, 0x70, 0xe1, 0xb1, 0x1c, 0xc3, 0x8d, 0xc7, 0x72, 0x0c, 0x51,
-	0x86, 0xe9, 0x99, 0x25, 0x19, 0xa5, 0x49, 0x7a, 0xc9, 0xf9, 0xb9, 0xfa, 0xc1, 0x60, 0x5b, 0x75,
-	0x7d, 0x12, 0x93, 0x8a, 0xf5, 0xa1, 0x01, 0x5c, 0x66, 0x64, 0xa2, 0x5f, 0x01, 0x0f, 0xe6, 0x92,
-	0xca, 0x82, 0xd4, 0xe2, 0x24, 0x36, 0x70, 0x28, 0x1b, 0x03, 0x02, 0x00, 0x00, 0xff, 0xff, 0x96,
-	0xda, 0x9d, 0xfb, 0xc9, 0x01, 0x00, 0x00,
+	// 374 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x92, 0xc1, 0x4a, 0xeb, 0x40,
+	0x18, 0x85, 0x93, 0xdb, 0xde, 0x72, 0x49, 0xef, 0xbd, 0x95, 0xa0, 0x26, 0x14, 0x49, 0x4a, 0x16,
+	0xda, 0x8d, 0x09, 0x56, 0x57, 0xee, 0x1a, 0x11, 0x17, 0x56, 0x90, 0x14, 0x37, 0x6e, 0xca, 0x24,
+	0x19, 0x62, 0x34, 0xe9, 0x84, 0x99, 0xa9, 0x5a, 0x9f, 0x40, 0x5c, 0xf9, 0x58, 0x5d, 0x76, 0xe9,
+	0xaa, 0x48, 0xfb, 0x06, 0x3e, 0x81, 0x38, 0x33, 0x96, 0x66, 0xa8, 0xab, 0x7f, 0xe0, 0x9c, 0xf3,
+	0x9d, 0xf9, 0xe1, 0xd7, 0x76, 0x08, 0xc5, 0x69, 0x0c, 0x3d, 0x90, 0xe2, 0x18, 0xa3, 0xc2, 0x4b,
+	0xe0, 0x10, 0x92, 0x94, 0xb8, 0x05, 0x46, 0x14, 0xe9, 0xff, 0xb9, 0xea, 0x0a, 0xb5, 0xb9, 0x99,
+	0xa0, 0x04, 0x31, 0xc9, 0xfb, 0x7a, 0x71, 0x57, 0x53, 0x66, 0x88, 0xc9, 0x55, 0xe7, 0xb9, 0xaa,
+	0xfd, 0x3d, 0xe3, 0xd4, 0x3e, 0x05, 0x14, 0xea, 0xa7, 0x5a, 0xad, 0x00, 0x18, 0xe4, 0xc4, 0x54,
+	0x5b, 0x6a, 0xbb, 0xde, 0xd9, 0x76, 0xcb, 0x2d, 0xee, 0x25, 0x53, 0xfd, 0xad, 0xc9, 0xcc, 0x56,
+	0x3e, 0x66, 0xf6, 0xbf, 0x31, 0xc8, 0xb3, 0x63, 0x87, 0x67, 0x9c, 0x40, 0x84, 0xf5, 0x9e, 0xf6,
+	0x47, 0x04, 0x88, 0xf9, 0xab, 0x55, 0x69, 0xd7, 0x3b, 0x86, 0x0c, 0xea, 0xf2, 0xe9, 0x1b, 0x82,
+	0xd4, 0xe0, 0xa4, 0xef, 0x98, 0x13, 0x2c, 0x09, 0xfa, 0xad, 0xb6, 0x31, 0x22, 0x10, 0x0f, 0x40,
+	0x96, 0xa1, 0x08, 0xd0, 0x14, 0x0d, 0x89, 0x59, 0x61, 0x54, 0x4b, 0xa6, 0x5e, 0x11, 0x88, 0xbb,
+	0x4b, 0x9b, 0x6f, 0x0b, 0xb8, 0xc1, 0xe1, 0x32, 0xc5, 0x09, 0x1a, 0xa3, 0x52, 0x80, 0x75, 0x45,
+	0x19, 0x78, 0x08, 0x41, 0x74, 0x37, 0xc0, 0x30, 0x42, 0x38, 0x26, 0x66, 0x75, 0x7d, 0xd7, 0x89,
+	0xf0, 0x05, 0xcc, 0x26, 0x77, 0xc9, 0x14, 0x27, 0x68, 0x44, 0xa5, 0x00, 0xd1, 0x5f, 0x54, 0xcd,
+	0xcc, 0x01, 0x85, 0x38, 0x05, 0x59, 0xfa, 0x04, 0xe3, 0xd2, 0x82, 0xbf, 0x59, 0xe9, 0xae, 0x5c,
+	0x7a, 0xb1, 0xe2, 0x5f, 0x59, 0x74, 0x4f, 0x94, 0xdb, 0xbc, 0xfc, 0x27, 0xaa, 0x13, 0x18, 0xf9,
+	0x5a, 0x00, 0xf1, 0xcf, 0x27, 0x73, 0x4b, 0x9d, 0xce, 0x2d, 0xf5, 0x7d, 0x6e, 0xa9, 0xaf, 0x0b,
+	0x4b, 0x99, 0x2e, 0x2c, 0xe5, 0x6d, 0x61, 0x29, 0xd7, 0x07, 0x49, 0x4a, 0x6f, 0x46, 0xa1, 0x1b,
+	0xa1, 0xdc, 0xeb, 0xb3, 0xdf, 0xec, 0xf7, 0x40, 0x48, 0x3c, 0x71, 0x59, 0xf7, 0x9d, 0x23, 0xef,
+	0x71, 0x79, 0x5f, 0x74, 0x5c, 0x40, 0x12, 0xd6, 0xd8, 0x79, 0x1d, 0x7e, 0x0e, 0x00, 0xbd, 0x4b,
+	0x6f, 0xa1, 0xc2, 0x02, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -136,6 +159,34 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.MaterializedAllocations) > 0 {
+		for iNdEx := len(m.MaterializedAllocations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MaterializedAllocations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.ClawbackRecords) > 0 {
+		for iNdEx := len(m.ClawbackRecords) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ClawbackRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	if len(m.UserAllocations) > 0 {
 		for iNdEx := len(m.UserAllocations) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -208,6 +259,18 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.ClawbackRecords) > 0 {
+		for _, e := range m.ClawbackRecords {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.MaterializedAllocations) > 0 {
+		for _, e := range m.MaterializedAllocations {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -347,6 +410,74 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClawbackRecords", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClawbackRecords = append(m.ClawbackRecords, ClawbackRecord{})
+			if err := m.ClawbackRecords[len(m.ClawbackRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaterializedAllocations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MaterializedAllocations = append(m.MaterializedAllocations, MaterializedAllocation{})
+			if err := m.MaterializedAllocations[len(m.MaterializedAllocations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -19,6 +19,9 @@ var (
 	ParamsPrefix            = KeyPrefix("params")
 	AirdropKeyPrefix        = KeyPrefix("airdrops")
 	UserAllocationKeyPrefix = KeyPrefix("user-allocations")
+	ClawbackRecordKeyPrefix = KeyPrefix("clawback-records")
+
+	MaterializedAllocationKeyPrefix = KeyPrefix("materialized-allocations")
 )
 
 // Generates a key byte prefix from a string
@@ -29,3 +32,8 @@ func KeyPrefix(p string) []byte {
 func UserAllocationKey(airdropId string, userAddress string) []byte {
 	return KeyPrefix(fmt.Sprintf("%s/%s", airdropId, userAddress))
 }
+
+// Prefix for all keys of an airdrop that are stored with UserAllocationKey
+func AirdropAddressesKeyPrefix(airdropId string) []byte {
+	return KeyPrefix(fmt.Sprintf("%s/", airdropId))
+}
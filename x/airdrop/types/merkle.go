@@ -0,0 +1,169 @@
+package types
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+)
+
+// Prefixes used to domain separate leaves from inner nodes, so that an inner node
+// can never be passed off as a leaf
+var (
+	merkleLeafPrefix = []byte{0x00}
+	merkleNodePrefix = []byte{0x01}
+)
+
+// Hashes a user's allocations into a merkle leaf
+// The leaf preimage is the same format as a row in the allocations CSV
+//
+// Ex: sha256(0x00 || "strideXXX,10,10,20")
+func HashAllocationLeaf(address string, allocations []sdkmath.Int) []byte {
+	row := make([]string, 0, len(allocations)+1)
+	row = append(row, address)
+	for _, allocation := range allocations {
+		row = append(row, allocation.String())
+	}
+
+	hash := sha256.New()
+	hash.Write(merkleLeafPrefix)
+	hash.Write([]byte(strings.Join(row, ",")))
+	return hash.Sum(nil)
+}
+
+// Hashes two sibling nodes into their parent
+// The siblings are sorted before hashing so that proofs do not need to specify
+// whether each sibling is on the left or the right
+func hashMerkleNodes(a, b []byte) []byte {
+	if bytes.Compare(a, b) > 0 {
+		a, b = b, a
+	}
+
+	hash := sha256.New()
+	hash.Write(merkleNodePrefix)
+	hash.Write(a)
+	hash.Write(b)
+	return hash.Sum(nil)
+}
+
+// Builds a merkle tree from the list of leaves, and returns the root as well as
+// the proof for each leaf (in the same order as the leaves)
+// If a level has an odd number of nodes, the last node is promoted to the next level
+func BuildMerkleTree(leaves [][]byte) (root []byte, proofs [][][]byte) {
+	if len(leaves) == 0 {
+		return nil, nil
+	}
+
+	// Track the index of each leaf's ancestor at the current level
+	proofs = make([][][]byte, len(leaves))
+	positions := make([]int, len(leaves))
+	for i := range leaves {
+		positions[i] = i
+	}
+
+	level := leaves
+	for len(level) > 1 {
+		for leafIndex, position := range positions {
+			siblingPosition := position ^ 1
+			if siblingPosition < len(level) {
+				proofs[leafIndex] = append(proofs[leafIndex], level[siblingPosition])
+			}
+			positions[leafIndex] = position / 2
+		}
+
+		nextLevel := make([][]byte, 0, (len(level)+1)/2)
+		for i := 0; i < len(level); i += 2 {
+			if i+1 < len(level) {
+				nextLevel = append(nextLevel, hashMerkleNodes(level[i], level[i+1]))
+			} else {
+				nextLevel = append(nextLevel, level[i])
+			}
+		}
+		level = nextLevel
+	}
+
+	return level[0], proofs
+}
+
+// Checks whether a leaf is included in the tree with the given root
+func VerifyMerkleProof(root, leaf []byte, proof [][]byte) bool {
+	computedHash := leaf
+	for _, sibling := range proof {
+		computedHash = hashMerkleNodes(computedHash, sibling)
+	}
+	return bytes.Equal(computedHash, root)
+}
+
+// Decodes and validates a hex-encoded merkle root
+func DecodeMerkleRoot(merkleRoot string) ([]byte, error) {
+	root, err := hex.DecodeString(merkleRoot)
+	if err != nil {
+		return nil, errorsmod.Wrapf(ErrInvalidMerkleProof, "merkle root is not valid hex: %s", err.Error())
+	}
+	if len(root) != sha256.Size {
+		return nil, errorsmod.Wrapf(ErrInvalidMerkleProof, "merkle root must be %d bytes", sha256.Size)
+	}
+	return root, nil
+}
+
+// Verifies a user's allocation proof against the hex-encoded merkle root of an airdrop
+func (p AllocationProof) Verify(merkleRoot, address string) error {
+	root, err := DecodeMerkleRoot(merkleRoot)
+	if err != nil {
+		return err
+	}
+
+	proof := make([][]byte, len(p.Proof))
+	for i, siblingHex := range p.Proof {
+		sibling, err := hex.DecodeString(siblingHex)
+		if err != nil {
+			return errorsmod.Wrapf(ErrInvalidMerkleProof, "proof element %d is not valid hex", i)
+		}
+		proof[i] = sibling
+	}
+
+	leaf := HashAllocationLeaf(address, p.Allocations)
+	if !VerifyMerkleProof(root, leaf, proof) {
+		return errorsmod.Wrapf(ErrInvalidMerkleProof, "allocations for %s are not included in merkle root %s", address, merkleRoot)
+	}
+
+	return nil
+}
+
+// Builds the merkle root and allocation proofs for a list of raw allocations
+// The returned proofs are in the same order as the allocations
+func BuildAllocationProofs(allocations []RawAllocation) (merkleRoot string, proofs []AllocationProof, err error) {
+	if len(allocations) == 0 {
+		return "", nil, fmt.Errorf("at least one allocation must be provided")
+	}
+
+	addresses := map[string]bool{}
+	leaves := make([][]byte, len(allocations))
+	for i, allocation := range allocations {
+		if addresses[allocation.UserAddress] {
+			return "", nil, fmt.Errorf("duplicate allocation for address %s", allocation.UserAddress)
+		}
+		addresses[allocation.UserAddress] = true
+		leaves[i] = HashAllocationLeaf(allocation.UserAddress, allocation.Allocations)
+	}
+
+	root, rawProofs := BuildMerkleTree(leaves)
+
+	proofs = make([]AllocationProof, len(allocations))
+	for i, allocation := range allocations {
+		proofHex := make([]string, len(rawProofs[i]))
+		for j, sibling := range rawProofs[i] {
+			proofHex[j] = hex.EncodeToString(sibling)
+		}
+		proofs[i] = AllocationProof{
+			Allocations: allocation.Allocations,
+			Proof:       proofHex,
+		}
+	}
+
+	return hex.EncodeToString(root), proofs, nil
+}
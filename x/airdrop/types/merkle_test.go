@@ -0,0 +1,96 @@
+package types_test
+
+import (
+	"encoding/hex"
+	"fmt"
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Stride-Labs/stride/v24/x/airdrop/types"
+)
+
+// Helper function to build a list of raw allocations with unique addresses
+func buildRawAllocations(numUsers int) []types.RawAllocation {
+	allocations := []types.RawAllocation{}
+	for i := 0; i < numUsers; i++ {
+		allocations = append(allocations, types.RawAllocation{
+			UserAddress: fmt.Sprintf("user-%d", i),
+			Allocations: []sdkmath.Int{sdkmath.NewInt(int64(i)), sdkmath.NewInt(10), sdkmath.NewInt(20)},
+		})
+	}
+	return allocations
+}
+
+func TestBuildAndVerifyAllocationProofs(t *testing.T) {
+	// Test trees of different sizes, including uneven levels
+	for numUsers := 1; numUsers <= 9; numUsers++ {
+		t.Run(fmt.Sprintf("%d users", numUsers), func(t *testing.T) {
+			allocations := buildRawAllocations(numUsers)
+
+			merkleRoot, proofs, err := types.BuildAllocationProofs(allocations)
+			require.NoError(t, err, "no error expected when building proofs")
+			require.Len(t, proofs, numUsers, "number of proofs")
+
+			_, err = types.DecodeMerkleRoot(merkleRoot)
+			require.NoError(t, err, "merkle root should be valid")
+
+			for i, allocation := range allocations {
+				require.NoError(t, proofs[i].Verify(merkleRoot, allocation.UserAddress), "proof for %s should be valid", allocation.UserAddress)
+			}
+		})
+	}
+}
+
+func TestVerifyAllocationProof_Invalid(t *testing.T) {
+	allocations := buildRawAllocations(5)
+	merkleRoot, proofs, err := types.BuildAllocationProofs(allocations)
+	require.NoError(t, err, "no error expected when building proofs")
+
+	validProof := proofs[2]
+	validAddress := allocations[2].UserAddress
+
+	// Proof used with a different address
+	err = validProof.Verify(merkleRoot, allocations[3].UserAddress)
+	require.ErrorIs(t, err, types.ErrInvalidMerkleProof, "different address")
+
+	// Proof with tampered allocations
+	tamperedAllocations := validProof
+	tamperedAllocations.Allocations = []sdkmath.Int{sdkmath.NewInt(1000), sdkmath.NewInt(10), sdkmath.NewInt(20)}
+	err = tamperedAllocations.Verify(merkleRoot, validAddress)
+	require.ErrorIs(t, err, types.ErrInvalidMerkleProof, "tampered allocations")
+
+	// Proof with a tampered sibling
+	tamperedSibling := types.AllocationProof{Allocations: validProof.Allocations, Proof: append([]string{}, validProof.Proof...)}
+	tamperedSibling.Proof[0] = hex.EncodeToString(make([]byte, 32))
+	err = tamperedSibling.Verify(merkleRoot, validAddress)
+	require.ErrorIs(t, err, types.ErrInvalidMerkleProof, "tampered sibling")
+
+	// Proof with an invalid hex sibling
+	invalidHexSibling := types.AllocationProof{Allocations: validProof.Allocations, Proof: []string{"XX"}}
+	err = invalidHexSibling.Verify(merkleRoot, validAddress)
+	require.ErrorContains(t, err, "proof element 0 is not valid hex")
+
+	// Proof verified against a different root
+	otherRoot, _, err := types.BuildAllocationProofs(buildRawAllocations(6))
+	require.NoError(t, err, "no error expected when building other proofs")
+	err = validProof.Verify(otherRoot, validAddress)
+	require.ErrorIs(t, err, types.ErrInvalidMerkleProof, "different root")
+
+	// Invalid roots
+	err = validProof.Verify("XX", validAddress)
+	require.ErrorContains(t, err, "merkle root is not valid hex")
+
+	err = validProof.Verify("abcd", validAddress)
+	require.ErrorContains(t, err, "merkle root must be 32 bytes")
+}
+
+func TestBuildAllocationProofs_Invalid(t *testing.T) {
+	_, _, err := types.BuildAllocationProofs([]types.RawAllocation{})
+	require.ErrorContains(t, err, "at least one allocation must be provided")
+
+	duplicateAllocations := append(buildRawAllocations(2), buildRawAllocations(1)...)
+	_, _, err = types.BuildAllocationProofs(duplicateAllocations)
+	require.ErrorContains(t, err, "duplicate allocation for address user-0")
+}
@@ -16,35 +16,44 @@ import (
 )
 
 const (
-	TypeMsgClaimDaily = "claim_daily"
-	TypeMsgClaimEarly = "claim_early"
+	TypeMsgClaimDaily    = "claim_daily"
+	TypeMsgClaimEarly    = "claim_early"
+	TypeMsgClaimAndStake = "claim_and_stake"
+	TypeMsgClaimAndVest  = "claim_and_vest"
 
 	TypeMsgCreateAirdrop        = "create_airdrop"
 	TypeMsgUpdateAirdrop        = "update_airdrop"
 	TypeMsgAddAllocations       = "add_allocations"
 	TypeMsgUpdateUserAllocation = "update_user_allocation"
 	TypeMsgLinkAddresses        = "link_addresses"
+	TypeMsgSetAllocationsRoot   = "set_allocations_root"
 )
 
 var (
 	_ sdk.Msg = &MsgClaimDaily{}
 	_ sdk.Msg = &MsgClaimEarly{}
+	_ sdk.Msg = &MsgClaimAndStake{}
+	_ sdk.Msg = &MsgClaimAndVest{}
 
 	_ sdk.Msg = &MsgCreateAirdrop{}
 	_ sdk.Msg = &MsgUpdateAirdrop{}
 	_ sdk.Msg = &MsgAddAllocations{}
 	_ sdk.Msg = &MsgUpdateUserAllocation{}
 	_ sdk.Msg = &MsgLinkAddresses{}
+	_ sdk.Msg = &MsgSetAllocationsRoot{}
 
 	// Implement legacy interface for ledger support
 	_ legacytx.LegacyMsg = &MsgClaimDaily{}
 	_ legacytx.LegacyMsg = &MsgClaimEarly{}
+	_ legacytx.LegacyMsg = &MsgClaimAndStake{}
+	_ legacytx.LegacyMsg = &MsgClaimAndVest{}
 
 	_ legacytx.LegacyMsg = &MsgCreateAirdrop{}
 	_ legacytx.LegacyMsg = &MsgUpdateAirdrop{}
 	_ legacytx.LegacyMsg = &MsgAddAllocations{}
 	_ legacytx.LegacyMsg = &MsgUpdateUserAllocation{}
 	_ legacytx.LegacyMsg = &MsgLinkAddresses{}
+	_ legacytx.LegacyMsg = &MsgSetAllocationsRoot{}
 )
 
 // ----------------------------------------------
@@ -86,6 +95,11 @@ func (msg *MsgClaimDaily) ValidateBasic() error {
 	if msg.AirdropId == "" {
 		return errors.New("airdrop-id must be specified")
 	}
+	if msg.AllocationProof != nil {
+		if err := msg.AllocationProof.ValidateBasic(); err != nil {
+			return err
+		}
+	}
 
 	return nil
 }
@@ -129,6 +143,107 @@ func (msg *MsgClaimEarly) ValidateBasic() error {
 	if msg.AirdropId == "" {
 		return errors.New("airdrop-id must be specified")
 	}
+	if msg.AllocationProof != nil {
+		if err := msg.AllocationProof.ValidateBasic(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ----------------------------------------------
+//               MsgClaimAndStake
+// ----------------------------------------------
+
+func NewMsgClaimAndStake(claimer, airdropId string) *MsgClaimAndStake {
+	return &MsgClaimAndStake{
+		Claimer:   claimer,
+		AirdropId: airdropId,
+	}
+}
+
+func (msg MsgClaimAndStake) Type() string {
+	return TypeMsgClaimAndStake
+}
+
+func (msg MsgClaimAndStake) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgClaimAndStake) GetSigners() []sdk.AccAddress {
+	claimer, err := sdk.AccAddressFromBech32(msg.Claimer)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{claimer}
+}
+
+func (msg *MsgClaimAndStake) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgClaimAndStake) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Claimer); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid address (%s)", err)
+	}
+	if msg.AirdropId == "" {
+		return errors.New("airdrop-id must be specified")
+	}
+	if msg.AllocationProof != nil {
+		if err := msg.AllocationProof.ValidateBasic(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ----------------------------------------------
+//               MsgClaimAndVest
+// ----------------------------------------------
+
+func NewMsgClaimAndVest(claimer, airdropId string) *MsgClaimAndVest {
+	return &MsgClaimAndVest{
+		Claimer:   claimer,
+		AirdropId: airdropId,
+	}
+}
+
+func (msg MsgClaimAndVest) Type() string {
+	return TypeMsgClaimAndVest
+}
+
+func (msg MsgClaimAndVest) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgClaimAndVest) GetSigners() []sdk.AccAddress {
+	claimer, err := sdk.AccAddressFromBech32(msg.Claimer)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{claimer}
+}
+
+func (msg *MsgClaimAndVest) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgClaimAndVest) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Claimer); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid address (%s)", err)
+	}
+	if msg.AirdropId == "" {
+		return errors.New("airdrop-id must be specified")
+	}
+	if msg.AllocationProof != nil {
+		if err := msg.AllocationProof.ValidateBasic(); err != nil {
+			return err
+		}
+	}
 
 	return nil
 }
@@ -146,6 +261,8 @@ func NewMsgCreateAirdrop(
 	clawbackDate *time.Time,
 	claimDeadlineDate *time.Time,
 	earlyClaimPenalty sdk.Dec,
+	claimAndStakeBonus sdk.Dec,
+	claimCondition ClaimCondition,
 	distributorAddress string,
 	allocatorAddress string,
 	linkerAddress string,
@@ -159,6 +276,8 @@ func NewMsgCreateAirdrop(
 		ClawbackDate:          clawbackDate,
 		ClaimTypeDeadlineDate: claimDeadlineDate,
 		EarlyClaimPenalty:     earlyClaimPenalty,
+		ClaimAndStakeBonus:    claimAndStakeBonus,
+		ClaimCondition:        claimCondition,
 		DistributorAddress:    distributorAddress,
 		AllocatorAddress:      allocatorAddress,
 		LinkerAddress:         linkerAddress,
@@ -202,6 +321,8 @@ func (msg *MsgCreateAirdrop) ValidateBasic() error {
 		msg.ClawbackDate,
 		msg.ClaimTypeDeadlineDate,
 		msg.EarlyClaimPenalty,
+		msg.ClaimAndStakeBonus,
+		msg.ClaimCondition,
 		msg.DistributorAddress,
 		msg.AllocatorAddress,
 		msg.LinkerAddress,
@@ -221,6 +342,8 @@ func NewMsgUpdateAirdrop(
 	clawbackDate *time.Time,
 	claimDeadlineDate *time.Time,
 	earlyClaimPenalty sdk.Dec,
+	claimAndStakeBonus sdk.Dec,
+	claimCondition ClaimCondition,
 	distributorAddress string,
 	allocatorAddress string,
 	linkerAddress string,
@@ -234,6 +357,8 @@ func NewMsgUpdateAirdrop(
 		ClawbackDate:          clawbackDate,
 		ClaimTypeDeadlineDate: claimDeadlineDate,
 		EarlyClaimPenalty:     earlyClaimPenalty,
+		ClaimAndStakeBonus:    claimAndStakeBonus,
+		ClaimCondition:        claimCondition,
 		DistributorAddress:    distributorAddress,
 		AllocatorAddress:      allocatorAddress,
 		LinkerAddress:         linkerAddress,
@@ -277,6 +402,8 @@ func (msg *MsgUpdateAirdrop) ValidateBasic() error {
 		msg.ClawbackDate,
 		msg.ClaimTypeDeadlineDate,
 		msg.EarlyClaimPenalty,
+		msg.ClaimAndStakeBonus,
+		msg.ClaimCondition,
 		msg.DistributorAddress,
 		msg.AllocatorAddress,
 		msg.LinkerAddress,
@@ -461,6 +588,74 @@ func (msg *MsgLinkAddresses) ValidateBasic() error {
 	if strings.HasPrefix(msg.HostAddress, "stride") {
 		return errors.New("linked address cannot be a stride address")
 	}
+	if msg.AllocationProof != nil {
+		if err := msg.AllocationProof.ValidateBasic(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
 
+// ----------------------------------------------
+//             MsgSetAllocationsRoot
+// ----------------------------------------------
+
+func NewMsgSetAllocationsRoot(admin, airdropId, merkleRoot string) *MsgSetAllocationsRoot {
+	return &MsgSetAllocationsRoot{
+		Admin:      admin,
+		AirdropId:  airdropId,
+		MerkleRoot: merkleRoot,
+	}
+}
+
+func (msg MsgSetAllocationsRoot) Type() string {
+	return TypeMsgSetAllocationsRoot
+}
+
+func (msg MsgSetAllocationsRoot) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgSetAllocationsRoot) GetSigners() []sdk.AccAddress {
+	admin, err := sdk.AccAddressFromBech32(msg.Admin)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{admin}
+}
+
+func (msg *MsgSetAllocationsRoot) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgSetAllocationsRoot) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Admin); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid address (%s)", err)
+	}
+	if msg.AirdropId == "" {
+		return errors.New("airdrop-id must be specified")
+	}
+	if _, err := DecodeMerkleRoot(msg.MerkleRoot); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ----------------------------------------------
+//               AllocationProof
+// ----------------------------------------------
+
+func (p *AllocationProof) ValidateBasic() error {
+	if len(p.Allocations) == 0 {
+		return errors.New("allocation proof must include allocations")
+	}
+	for i, allocation := range p.Allocations {
+		if allocation.IsNil() || allocation.IsNegative() {
+			return fmt.Errorf("allocation at index %d cannot be negative", i)
+		}
+	}
 	return nil
 }
@@ -52,6 +52,37 @@ func TestMsgClaimDaily_ValidateBasic(t *testing.T) {
 			},
 			expectedError: "airdrop-id must be specified",
 		},
+		{
+			name: "valid allocation proof",
+			msg: types.MsgClaimDaily{
+				Claimer:   validAddress,
+				AirdropId: validAirdropId,
+				AllocationProof: &types.AllocationProof{
+					Allocations: []sdkmath.Int{sdkmath.NewInt(0), sdkmath.NewInt(10)},
+					Proof:       []string{"ab"},
+				},
+			},
+		},
+		{
+			name: "allocation proof without allocations",
+			msg: types.MsgClaimDaily{
+				Claimer:         validAddress,
+				AirdropId:       validAirdropId,
+				AllocationProof: &types.AllocationProof{},
+			},
+			expectedError: "allocation proof must include allocations",
+		},
+		{
+			name: "allocation proof with negative allocation",
+			msg: types.MsgClaimDaily{
+				Claimer:   validAddress,
+				AirdropId: validAirdropId,
+				AllocationProof: &types.AllocationProof{
+					Allocations: []sdkmath.Int{sdkmath.NewInt(10), sdkmath.NewInt(-1)},
+				},
+			},
+			expectedError: "allocation at index 1 cannot be negative",
+		},
 	}
 	for _, tc := range tests {
 		t.Run(tc.name, func(t *testing.T) {
@@ -111,6 +142,37 @@ func TestMsgClaimEarly_ValidateBasic(t *testing.T) {
 			},
 			expectedError: "airdrop-id must be specified",
 		},
+		{
+			name: "valid allocation proof",
+			msg: types.MsgClaimEarly{
+				Claimer:   validAddress,
+				AirdropId: validAirdropId,
+				AllocationProof: &types.AllocationProof{
+					Allocations: []sdkmath.Int{sdkmath.NewInt(0), sdkmath.NewInt(10)},
+					Proof:       []string{"ab"},
+				},
+			},
+		},
+		{
+			name: "allocation proof without allocations",
+			msg: types.MsgClaimEarly{
+				Claimer:         validAddress,
+				AirdropId:       validAirdropId,
+				AllocationProof: &types.AllocationProof{},
+			},
+			expectedError: "allocation proof must include allocations",
+		},
+		{
+			name: "allocation proof with negative allocation",
+			msg: types.MsgClaimEarly{
+				Claimer:   validAddress,
+				AirdropId: validAirdropId,
+				AllocationProof: &types.AllocationProof{
+					Allocations: []sdkmath.Int{sdkmath.NewInt(10), sdkmath.NewInt(-1)},
+				},
+			},
+			expectedError: "allocation at index 1 cannot be negative",
+		},
 	}
 	for _, tc := range tests {
 		t.Run(tc.name, func(t *testing.T) {
@@ -134,6 +196,142 @@ func TestMsgClaimEarly_GetSignBytes(t *testing.T) {
 	require.Equal(t, expected, string(res))
 }
 
+// ----------------------------------------------
+//               MsgClaimAndStake
+// ----------------------------------------------
+
+func TestMsgClaimAndStake_ValidateBasic(t *testing.T) {
+	validAddress, invalidAddress := apptesting.GenerateTestAddrs()
+	validAirdropId := "airdrop-1"
+
+	tests := []struct {
+		name          string
+		msg           types.MsgClaimAndStake
+		expectedError string
+	}{
+		{
+			name: "valid message",
+			msg: types.MsgClaimAndStake{
+				Claimer:   validAddress,
+				AirdropId: validAirdropId,
+			},
+		},
+		{
+			name: "invalid address",
+			msg: types.MsgClaimAndStake{
+				Claimer:   invalidAddress,
+				AirdropId: validAirdropId,
+			},
+			expectedError: "invalid address",
+		},
+		{
+			name: "invalid airdrop id",
+			msg: types.MsgClaimAndStake{
+				Claimer:   validAddress,
+				AirdropId: "",
+			},
+			expectedError: "airdrop-id must be specified",
+		},
+		{
+			name: "allocation proof without allocations",
+			msg: types.MsgClaimAndStake{
+				Claimer:         validAddress,
+				AirdropId:       validAirdropId,
+				AllocationProof: &types.AllocationProof{},
+			},
+			expectedError: "allocation proof must include allocations",
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			actualError := tc.msg.ValidateBasic()
+			if tc.expectedError != "" {
+				require.ErrorContains(t, actualError, tc.expectedError)
+				return
+			}
+			require.NoError(t, actualError)
+		})
+	}
+}
+
+func TestMsgClaimAndStake_GetSignBytes(t *testing.T) {
+	addr := "strideXXX"
+	airdropId := "airdrop"
+	msg := types.NewMsgClaimAndStake(addr, airdropId)
+	res := msg.GetSignBytes()
+
+	expected := `{"type":"airdrop/MsgClaimAndStake","value":{"airdrop_id":"airdrop","claimer":"strideXXX"}}`
+	require.Equal(t, expected, string(res))
+}
+
+// ----------------------------------------------
+//               MsgClaimAndVest
+// ----------------------------------------------
+
+func TestMsgClaimAndVest_ValidateBasic(t *testing.T) {
+	validAddress, invalidAddress := apptesting.GenerateTestAddrs()
+	validAirdropId := "airdrop-1"
+
+	tests := []struct {
+		name          string
+		msg           types.MsgClaimAndVest
+		expectedError string
+	}{
+		{
+			name: "valid message",
+			msg: types.MsgClaimAndVest{
+				Claimer:   validAddress,
+				AirdropId: validAirdropId,
+			},
+		},
+		{
+			name: "invalid address",
+			msg: types.MsgClaimAndVest{
+				Claimer:   invalidAddress,
+				AirdropId: validAirdropId,
+			},
+			expectedError: "invalid address",
+		},
+		{
+			name: "invalid airdrop id",
+			msg: types.MsgClaimAndVest{
+				Claimer:   validAddress,
+				AirdropId: "",
+			},
+			expectedError: "airdrop-id must be specified",
+		},
+		{
+			name: "allocation proof without allocations",
+			msg: types.MsgClaimAndVest{
+				Claimer:         validAddress,
+				AirdropId:       validAirdropId,
+				AllocationProof: &types.AllocationProof{},
+			},
+			expectedError: "allocation proof must include allocations",
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			actualError := tc.msg.ValidateBasic()
+			if tc.expectedError != "" {
+				require.ErrorContains(t, actualError, tc.expectedError)
+				return
+			}
+			require.NoError(t, actualError)
+		})
+	}
+}
+
+func TestMsgClaimAndVest_GetSignBytes(t *testing.T) {
+	addr := "strideXXX"
+	airdropId := "airdrop"
+	msg := types.NewMsgClaimAndVest(addr, airdropId)
+	res := msg.GetSignBytes()
+
+	expected := `{"type":"airdrop/MsgClaimAndVest","value":{"airdrop_id":"airdrop","claimer":"strideXXX"}}`
+	require.Equal(t, expected, string(res))
+}
+
 // ----------------------------------------------
 //               MsgCreateAirdrop
 // ----------------------------------------------
@@ -256,6 +454,7 @@ func TestMsgCreateAirdrop_GetSignBytes(t *testing.T) {
 	deadlineDate := time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC)
 
 	earlyClaimPenalty := sdk.MustNewDecFromStr("0.5")
+	claimAndStakeBonus := sdk.MustNewDecFromStr("0.1")
 
 	msg := types.NewMsgCreateAirdrop(
 		admin,
@@ -266,6 +465,8 @@ func TestMsgCreateAirdrop_GetSignBytes(t *testing.T) {
 		&clawbackDate,
 		&deadlineDate,
 		earlyClaimPenalty,
+		claimAndStakeBonus,
+		types.CLAIM_CONDITION_LIQUID_STAKE,
 		distributorAddress,
 		allocatorAddress,
 		linkerAddress,
@@ -277,6 +478,8 @@ func TestMsgCreateAirdrop_GetSignBytes(t *testing.T) {
 		"value":{"admin":"admin",
 		"airdrop_id":"airdrop",
 		"allocator_address":"allocator",
+		"claim_and_stake_bonus":"0.100000000000000000",
+		"claim_condition":1,
 		"claim_type_deadline_date":"2024-02-01T00:00:00Z",
 		"clawback_date":"2024-07-01T00:00:00Z",
 		"distribution_end_date":"2024-06-01T00:00:00Z",
@@ -413,6 +616,7 @@ func TestMsgUpdateAirdrop_GetSignBytes(t *testing.T) {
 	deadlineDate := time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC)
 
 	earlyClaimPenalty := sdk.MustNewDecFromStr("0.5")
+	claimAndStakeBonus := sdk.MustNewDecFromStr("0.1")
 
 	msg := types.NewMsgUpdateAirdrop(
 		admin,
@@ -423,6 +627,8 @@ func TestMsgUpdateAirdrop_GetSignBytes(t *testing.T) {
 		&clawbackDate,
 		&deadlineDate,
 		earlyClaimPenalty,
+		claimAndStakeBonus,
+		types.CLAIM_CONDITION_LIQUID_STAKE,
 		distributorAddress,
 		allocatorAddress,
 		linkerAddress,
@@ -434,6 +640,8 @@ func TestMsgUpdateAirdrop_GetSignBytes(t *testing.T) {
 		"value":{"admin":"admin",
 		"airdrop_id":"airdrop",
 		"allocator_address":"allocator",
+		"claim_and_stake_bonus":"0.100000000000000000",
+		"claim_condition":1,
 		"claim_type_deadline_date":"2024-02-01T00:00:00Z",
 		"clawback_date":"2024-07-01T00:00:00Z",
 		"distribution_end_date":"2024-06-01T00:00:00Z",
@@ -823,6 +1031,30 @@ func TestMsgLinkAddresses_ValidateBasic(t *testing.T) {
 			},
 			expectedError: "linked address cannot be a stride address",
 		},
+		{
+			name: "valid allocation proof",
+			msg: types.MsgLinkAddresses{
+				Admin:         adminAddress,
+				AirdropId:     validAirdropId,
+				StrideAddress: validStrideAddress,
+				HostAddress:   validHostAddress,
+				AllocationProof: &types.AllocationProof{
+					Allocations: []sdkmath.Int{sdkmath.NewInt(0), sdkmath.NewInt(10)},
+					Proof:       []string{"ab"},
+				},
+			},
+		},
+		{
+			name: "allocation proof without allocations",
+			msg: types.MsgLinkAddresses{
+				Admin:           adminAddress,
+				AirdropId:       validAirdropId,
+				StrideAddress:   validStrideAddress,
+				HostAddress:     validHostAddress,
+				AllocationProof: &types.AllocationProof{},
+			},
+			expectedError: "allocation proof must include allocations",
+		},
 	}
 	for _, tc := range tests {
 		t.Run(tc.name, func(t *testing.T) {
@@ -856,3 +1088,95 @@ func TestMsgLinkAddresses_GetSignBytes(t *testing.T) {
 	expected = re.ReplaceAllString(expected, "")
 	require.Equal(t, expected, string(res))
 }
+
+// ----------------------------------------------
+//             MsgSetAllocationsRoot
+// ----------------------------------------------
+
+func TestMsgSetAllocationsRoot_ValidateBasic(t *testing.T) {
+	apptesting.SetupConfig()
+
+	validAddress, invalidAddress := apptesting.GenerateTestAddrs()
+	validAirdropId := "airdrop-1"
+	validMerkleRoot := strings.Repeat("ab", 32)
+
+	tests := []struct {
+		name          string
+		msg           types.MsgSetAllocationsRoot
+		expectedError string
+	}{
+		{
+			name: "valid message",
+			msg: types.MsgSetAllocationsRoot{
+				Admin:      validAddress,
+				AirdropId:  validAirdropId,
+				MerkleRoot: validMerkleRoot,
+			},
+		},
+		{
+			name: "invalid address",
+			msg: types.MsgSetAllocationsRoot{
+				Admin:      invalidAddress,
+				AirdropId:  validAirdropId,
+				MerkleRoot: validMerkleRoot,
+			},
+			expectedError: "invalid address",
+		},
+		{
+			name: "invalid airdrop id",
+			msg: types.MsgSetAllocationsRoot{
+				Admin:      validAddress,
+				AirdropId:  "",
+				MerkleRoot: validMerkleRoot,
+			},
+			expectedError: "airdrop-id must be specified",
+		},
+		{
+			name: "merkle root not hex",
+			msg: types.MsgSetAllocationsRoot{
+				Admin:      validAddress,
+				AirdropId:  validAirdropId,
+				MerkleRoot: "XX",
+			},
+			expectedError: "merkle root is not valid hex",
+		},
+		{
+			name: "merkle root wrong length",
+			msg: types.MsgSetAllocationsRoot{
+				Admin:      validAddress,
+				AirdropId:  validAirdropId,
+				MerkleRoot: "abcd",
+			},
+			expectedError: "merkle root must be 32 bytes",
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			actualError := tc.msg.ValidateBasic()
+			if tc.expectedError != "" {
+				require.ErrorContains(t, actualError, tc.expectedError)
+				return
+			}
+			require.NoError(t, actualError)
+		})
+	}
+}
+
+func TestMsgSetAllocationsRoot_GetSignBytes(t *testing.T) {
+	admin := "admin"
+	airdropId := "airdrop"
+	merkleRoot := "root"
+
+	msg := types.NewMsgSetAllocationsRoot(admin, airdropId, merkleRoot)
+	res := msg.GetSignBytes()
+
+	expected := strings.TrimSpace(`
+		{"type":"airdrop/MsgSetAllocationsRoot",
+		"value":{"admin":"admin",
+		"airdrop_id":"airdrop",
+		"merkle_root":"root"}}`)
+
+	re := regexp.MustCompile(`\s+`)
+	expected = re.ReplaceAllString(expected, "")
+	require.Equal(t, expected, string(res))
+}
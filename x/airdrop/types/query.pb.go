@@ -111,6 +111,14 @@ type QueryAirdropResponse struct {
 	CurrentDateIndex int64 `protobuf:"varint,11,opt,name=current_date_index,json=currentDateIndex,proto3" json:"current_date_index,omitempty"`
 	// The length of the airdrop (i.e. number of periods in the airdrop array)
 	AirdropLength int64 `protobuf:"varint,12,opt,name=airdrop_length,json=airdropLength,proto3" json:"airdrop_length,omitempty"`
+	// Indicates whether the unclaimed rewards have been clawed back
+	Finalized bool `protobuf:"varint,13,opt,name=finalized,proto3" json:"finalized,omitempty"`
+	// Hex-encoded merkle root of the user allocations, if the airdrop uses one
+	AllocationsMerkleRoot string `protobuf:"bytes,14,opt,name=allocations_merkle_root,json=allocationsMerkleRoot,proto3" json:"allocations_merkle_root,omitempty"`
+	// Bonus paid from the distributor when rewards are claimed and liquid staked
+	ClaimAndStakeBonus cosmossdk_io_math.LegacyDec `protobuf:"bytes,15,opt,name=claim_and_stake_bonus,json=claimAndStakeBonus,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"claim_and_stake_bonus"`
+	// Action that the user must complete to claim their rewards
+	ClaimCondition ClaimCondition `protobuf:"varint,16,opt,name=claim_condition,json=claimCondition,proto3,enum=stride.airdrop.ClaimCondition" json:"claim_condition,omitempty"`
 }
 
 func (m *QueryAirdropResponse) Reset()         { *m = QueryAirdropResponse{} }
@@ -223,6 +231,27 @@ func (m *QueryAirdropResponse) GetAirdropLength() int64 {
 	return 0
 }
 
+func (m *QueryAirdropResponse) GetFinalized() bool {
+	if m != nil {
+		return m.Finalized
+	}
+	return false
+}
+
+func (m *QueryAirdropResponse) GetAllocationsMerkleRoot() string {
+	if m != nil {
+		return m.AllocationsMerkleRoot
+	}
+	return ""
+}
+
+func (m *QueryAirdropResponse) GetClaimCondition() ClaimCondition {
+	if m != nil {
+		return m.ClaimCondition
+	}
+	return CLAIM_CONDITION_NONE
+}
+
 // Airdrops
 type QueryAllAirdropsRequest struct {
 }
@@ -649,7 +678,8 @@ func (m *QueryUserSummaryRequest) GetAddress() string {
 }
 
 type QueryUserSummaryResponse struct {
-	// The claim type (claim daily or claim early)
+	// The claim type (claim daily, claim early, claim and stake, or claim and
+	// vest)
 	ClaimType string `protobuf:"bytes,1,opt,name=claim_type,json=claimType,proto3" json:"claim_type,omitempty"`
 	// The total rewards claimed so far
 	Claimed github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=claimed,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"claimed"`
@@ -659,6 +689,12 @@ type QueryUserSummaryResponse struct {
 	Remaining github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=remaining,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"remaining"`
 	// The total rewards that can be claimed right now
 	Claimable github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,5,opt,name=claimable,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"claimable"`
+	// The portion of the claimed rewards that were liquid staked
+	Staked github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,6,opt,name=staked,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"staked"`
+	// The total bonus received for claiming and staking
+	StakingBonus github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,7,opt,name=staking_bonus,json=stakingBonus,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"staking_bonus"`
+	// The portion of the claimed rewards that were sent to a vesting account
+	Vested github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,8,opt,name=vested,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"vested"`
 }
 
 func (m *QueryUserSummaryResponse) Reset()         { *m = QueryUserSummaryResponse{} }
@@ -701,6 +737,176 @@ func (m *QueryUserSummaryResponse) GetClaimType() string {
 	return ""
 }
 
+// ClawbackRecord
+type QueryClawbackRecordRequest struct {
+	AirdropId string `protobuf:"bytes,1,opt,name=airdrop_id,json=airdropId,proto3" json:"airdrop_id,omitempty"`
+}
+
+func (m *QueryClawbackRecordRequest) Reset()         { *m = QueryClawbackRecordRequest{} }
+func (m *QueryClawbackRecordRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryClawbackRecordRequest) ProtoMessage()    {}
+func (*QueryClawbackRecordRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_28cd033986bfea74, []int{12}
+}
+func (m *QueryClawbackRecordRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryClawbackRecordRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryClawbackRecordRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryClawbackRecordRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryClawbackRecordRequest.Merge(m, src)
+}
+func (m *QueryClawbackRecordRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryClawbackRecordRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryClawbackRecordRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryClawbackRecordRequest proto.InternalMessageInfo
+
+func (m *QueryClawbackRecordRequest) GetAirdropId() string {
+	if m != nil {
+		return m.AirdropId
+	}
+	return ""
+}
+
+type QueryClawbackRecordResponse struct {
+	ClawbackRecord ClawbackRecord `protobuf:"bytes,1,opt,name=clawback_record,json=clawbackRecord,proto3" json:"clawback_record"`
+}
+
+func (m *QueryClawbackRecordResponse) Reset()         { *m = QueryClawbackRecordResponse{} }
+func (m *QueryClawbackRecordResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryClawbackRecordResponse) ProtoMessage()    {}
+func (*QueryClawbackRecordResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_28cd033986bfea74, []int{13}
+}
+func (m *QueryClawbackRecordResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryClawbackRecordResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryClawbackRecordResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryClawbackRecordResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryClawbackRecordResponse.Merge(m, src)
+}
+func (m *QueryClawbackRecordResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryClawbackRecordResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryClawbackRecordResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryClawbackRecordResponse proto.InternalMessageInfo
+
+func (m *QueryClawbackRecordResponse) GetClawbackRecord() ClawbackRecord {
+	if m != nil {
+		return m.ClawbackRecord
+	}
+	return ClawbackRecord{}
+}
+
+// AllClawbackRecords
+type QueryAllClawbackRecordsRequest struct {
+}
+
+func (m *QueryAllClawbackRecordsRequest) Reset()         { *m = QueryAllClawbackRecordsRequest{} }
+func (m *QueryAllClawbackRecordsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAllClawbackRecordsRequest) ProtoMessage()    {}
+func (*QueryAllClawbackRecordsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_28cd033986bfea74, []int{14}
+}
+func (m *QueryAllClawbackRecordsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllClawbackRecordsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllClawbackRecordsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllClawbackRecordsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllClawbackRecordsRequest.Merge(m, src)
+}
+func (m *QueryAllClawbackRecordsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllClawbackRecordsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllClawbackRecordsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllClawbackRecordsRequest proto.InternalMessageInfo
+
+type QueryAllClawbackRecordsResponse struct {
+	ClawbackRecords []ClawbackRecord `protobuf:"bytes,1,rep,name=clawback_records,json=clawbackRecords,proto3" json:"clawback_records"`
+}
+
+func (m *QueryAllClawbackRecordsResponse) Reset()         { *m = QueryAllClawbackRecordsResponse{} }
+func (m *QueryAllClawbackRecordsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAllClawbackRecordsResponse) ProtoMessage()    {}
+func (*QueryAllClawbackRecordsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_28cd033986bfea74, []int{15}
+}
+func (m *QueryAllClawbackRecordsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllClawbackRecordsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllClawbackRecordsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllClawbackRecordsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllClawbackRecordsResponse.Merge(m, src)
+}
+func (m *QueryAllClawbackRecordsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllClawbackRecordsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllClawbackRecordsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllClawbackRecordsResponse proto.InternalMessageInfo
+
+func (m *QueryAllClawbackRecordsResponse) GetClawbackRecords() []ClawbackRecord {
+	if m != nil {
+		return m.ClawbackRecords
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryAirdropRequest)(nil), "stride.airdrop.QueryAirdropRequest")
 	proto.RegisterType((*QueryAirdropResponse)(nil), "stride.airdrop.QueryAirdropResponse")
@@ -714,84 +920,104 @@ func init() {
 	proto.RegisterType((*QueryAllAllocationsResponse)(nil), "stride.airdrop.QueryAllAllocationsResponse")
 	proto.RegisterType((*QueryUserSummaryRequest)(nil), "stride.airdrop.QueryUserSummaryRequest")
 	proto.RegisterType((*QueryUserSummaryResponse)(nil), "stride.airdrop.QueryUserSummaryResponse")
+	proto.RegisterType((*QueryClawbackRecordRequest)(nil), "stride.airdrop.QueryClawbackRecordRequest")
+	proto.RegisterType((*QueryClawbackRecordResponse)(nil), "stride.airdrop.QueryClawbackRecordResponse")
+	proto.RegisterType((*QueryAllClawbackRecordsRequest)(nil), "stride.airdrop.QueryAllClawbackRecordsRequest")
+	proto.RegisterType((*QueryAllClawbackRecordsResponse)(nil), "stride.airdrop.QueryAllClawbackRecordsResponse")
 }
 
 func init() { proto.RegisterFile("stride/airdrop/query.proto", fileDescriptor_28cd033986bfea74) }
 
 var fileDescriptor_28cd033986bfea74 = []byte{
-	// 1154 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0x41, 0x4f, 0x1b, 0x47,
-	0x14, 0x66, 0x0d, 0x81, 0x30, 0x06, 0x43, 0x06, 0x2a, 0x36, 0x0e, 0x31, 0x74, 0x93, 0x10, 0x8b,
-	0xc2, 0x6e, 0x71, 0xab, 0x2a, 0xed, 0xa1, 0x29, 0x14, 0x42, 0x69, 0x91, 0x9a, 0x18, 0x22, 0xb5,
-	0xbd, 0xac, 0xc6, 0xde, 0x61, 0x19, 0xb1, 0xde, 0x71, 0x76, 0xd6, 0x49, 0x2c, 0xc4, 0xa5, 0x95,
-	0x7a, 0xac, 0x22, 0xf5, 0xd2, 0xbf, 0x50, 0xb5, 0xea, 0xa9, 0xfd, 0x0f, 0x39, 0x46, 0xed, 0xa5,
-	0xea, 0x21, 0xad, 0xa0, 0xd7, 0xfe, 0x87, 0x6a, 0x67, 0xde, 0xda, 0x6b, 0xb3, 0x60, 0x07, 0x29,
-	0x27, 0x7b, 0xe6, 0xbd, 0xf7, 0xbd, 0x6f, 0xde, 0xbc, 0xf7, 0xcd, 0xa2, 0xbc, 0x08, 0x03, 0xe6,
-	0x50, 0x8b, 0xb0, 0xc0, 0x09, 0x78, 0xdd, 0x7a, 0xd4, 0xa0, 0x41, 0xd3, 0xac, 0x07, 0x3c, 0xe4,
-	0x38, 0xa7, 0x6c, 0x26, 0xd8, 0xf2, 0xb3, 0x5d, 0xbe, 0xf0, 0xab, 0xbc, 0xf3, 0xd3, 0x2e, 0x77,
-	0xb9, 0xfc, 0x6b, 0x45, 0xff, 0x60, 0x77, 0xd6, 0xe5, 0xdc, 0xf5, 0xa8, 0x45, 0xea, 0xcc, 0x22,
-	0xbe, 0xcf, 0x43, 0x12, 0x32, 0xee, 0x0b, 0xb0, 0x2e, 0x56, 0xb9, 0xa8, 0x71, 0x61, 0x55, 0x88,
-	0xa0, 0x2a, 0xb5, 0xf5, 0x78, 0xa5, 0x42, 0x43, 0xb2, 0x62, 0xd5, 0x89, 0xcb, 0x7c, 0xe9, 0x0c,
-	0xbe, 0x57, 0x95, 0xaf, 0xad, 0x52, 0xa8, 0x05, 0x98, 0xe6, 0x20, 0x89, 0x5c, 0x55, 0x1a, 0x7b,
-	0x56, 0xc8, 0x6a, 0x54, 0x84, 0xa4, 0x06, 0xdc, 0x8c, 0x5b, 0x68, 0xea, 0x41, 0x84, 0xbe, 0xaa,
-	0x18, 0x97, 0xe9, 0xa3, 0x06, 0x15, 0x21, 0xce, 0xa1, 0x0c, 0x73, 0x74, 0x6d, 0x5e, 0x2b, 0x8e,
-	0x96, 0x33, 0xcc, 0x31, 0x7e, 0x18, 0x46, 0xd3, 0x9d, 0x7e, 0xa2, 0xce, 0x7d, 0x41, 0xbb, 0x1d,
-	0xf1, 0x9b, 0x68, 0x2c, 0xa0, 0x4f, 0x48, 0xe0, 0xd8, 0x0e, 0xf5, 0x79, 0x4d, 0xcf, 0x48, 0x4b,
-	0x56, 0xed, 0xad, 0x47, 0x5b, 0xf8, 0x0b, 0x34, 0xe3, 0xb0, 0xa8, 0x60, 0x95, 0x46, 0x74, 0x08,
-	0x5b, 0x84, 0x24, 0x08, 0x6d, 0x87, 0x84, 0x54, 0x1f, 0x9c, 0xd7, 0x8a, 0xd9, 0x52, 0xde, 0x54,
-	0xac, 0xcd, 0x98, 0xb5, 0xb9, 0x1b, 0xb3, 0x5e, 0x1b, 0x7a, 0xf6, 0xf7, 0x9c, 0x56, 0x7e, 0x23,
-	0x09, 0xb0, 0x13, 0xc5, 0xaf, 0x93, 0x90, 0xe2, 0x5d, 0xd4, 0x61, 0xb0, 0xa9, 0xef, 0x28, 0xdc,
-	0xa1, 0x3e, 0x71, 0xa7, 0x92, 0xe1, 0x1b, 0xbe, 0x23, 0x51, 0x37, 0xd0, 0x78, 0xd5, 0x23, 0x4f,
-	0x2a, 0xa4, 0x7a, 0xa0, 0xd0, 0x2e, 0xf5, 0x89, 0x36, 0x16, 0x87, 0x49, 0x98, 0x2f, 0x91, 0x5e,
-	0xf5, 0x08, 0xab, 0xd9, 0x61, 0xb3, 0x4e, 0x6d, 0x87, 0x12, 0xc7, 0x63, 0x3e, 0x55, 0x88, 0xc3,
-	0xfd, 0x9e, 0x5b, 0x22, 0xec, 0x36, 0xeb, 0x74, 0x1d, 0xe2, 0x25, 0xf4, 0x0e, 0x9a, 0xa2, 0x24,
-	0xf0, 0x9a, 0xb6, 0x4a, 0x50, 0xa7, 0x3e, 0xf1, 0xc2, 0xa6, 0x3e, 0x12, 0xd5, 0x7e, 0xed, 0xc6,
-	0xf3, 0x97, 0x73, 0x03, 0x7f, 0xbd, 0x9c, 0xbb, 0xa6, 0x1a, 0x43, 0x38, 0x07, 0x26, 0xe3, 0x56,
-	0x8d, 0x84, 0xfb, 0xe6, 0x36, 0x75, 0x49, 0xb5, 0xb9, 0x4e, 0xab, 0xe5, 0x2b, 0x32, 0xfe, 0xe3,
-	0x28, 0xfc, 0xbe, 0x8a, 0xc6, 0x5b, 0xa8, 0x5d, 0x0d, 0x1e, 0xd8, 0xc4, 0x71, 0x02, 0x2a, 0x84,
-	0x7e, 0x59, 0x82, 0xea, 0xbf, 0xff, 0xba, 0x3c, 0x0d, 0x9d, 0xb6, 0xaa, 0x2c, 0x3b, 0x61, 0xc0,
-	0x7c, 0xb7, 0x8c, 0x13, 0x41, 0x60, 0xc1, 0x1b, 0xe8, 0x0a, 0xf1, 0x3c, 0x5e, 0x25, 0x49, 0xa0,
-	0xd1, 0x1e, 0x40, 0x93, 0xad, 0x90, 0x18, 0xe6, 0x2e, 0xca, 0x79, 0xcc, 0x3f, 0xa0, 0x6d, 0x0c,
-	0xd4, 0x03, 0x63, 0x5c, 0xf9, 0xc7, 0x00, 0x4b, 0x08, 0x57, 0x1b, 0x41, 0x40, 0x7d, 0xd5, 0x6e,
-	0x36, 0xf3, 0x1d, 0xfa, 0x54, 0xcf, 0xce, 0x6b, 0xc5, 0xc1, 0xf2, 0x24, 0x58, 0xa2, 0x82, 0x6e,
-	0x45, 0xfb, 0xf8, 0x16, 0xca, 0xc1, 0x1c, 0xdb, 0x1e, 0xf5, 0xdd, 0x70, 0x5f, 0x1f, 0x93, 0x9e,
-	0xe3, 0xb0, 0xbb, 0x2d, 0x37, 0x8d, 0xab, 0x68, 0x46, 0x4d, 0x86, 0xe7, 0xc1, 0x70, 0x08, 0x98,
-	0x22, 0xe3, 0x21, 0xd2, 0x4f, 0x9b, 0x60, 0x70, 0xde, 0x47, 0x97, 0x01, 0x47, 0xe8, 0xda, 0xfc,
-	0x60, 0x31, 0x5b, 0x9a, 0x31, 0x3b, 0x55, 0xc5, 0x84, 0x98, 0xb5, 0xa1, 0xe8, 0x06, 0xcb, 0x2d,
-	0x77, 0x83, 0xa3, 0xbc, 0x84, 0x7d, 0x28, 0x68, 0xb0, 0xaa, 0x8a, 0xc4, 0xb8, 0x1f, 0x8f, 0xee,
-	0x75, 0x84, 0x62, 0xda, 0xad, 0xc9, 0x1c, 0x85, 0x9d, 0x2d, 0x07, 0x97, 0xd0, 0x48, 0x5c, 0xbd,
-	0x4c, 0x8f, 0xea, 0xc5, 0x8e, 0xc6, 0x1e, 0xba, 0x96, 0x9a, 0x10, 0x8e, 0xb2, 0x89, 0x26, 0x1a,
-	0x22, 0xba, 0x95, 0x96, 0x49, 0xa6, 0xcd, 0x96, 0x0a, 0xdd, 0x27, 0xea, 0x02, 0xc8, 0x35, 0x3a,
-	0xd6, 0xc6, 0x83, 0xd4, 0x3c, 0x71, 0x39, 0x93, 0xd4, 0xb5, 0x7e, 0xa9, 0x73, 0x34, 0x9b, 0x0e,
-	0x09, 0xdc, 0x3f, 0x47, 0x93, 0x5d, 0xdc, 0xe3, 0xeb, 0xe8, 0x41, 0x1e, 0x6e, 0x65, 0xa2, 0xf3,
-	0x08, 0xc2, 0xf8, 0x46, 0x83, 0xdb, 0x89, 0x2e, 0xfd, 0xf4, 0x19, 0x7a, 0xdc, 0xce, 0x3d, 0x84,
-	0xda, 0xf2, 0x2e, 0x2f, 0x28, 0x5b, 0x5a, 0x30, 0xe1, 0x88, 0xd1, 0x5b, 0x60, 0xaa, 0x67, 0x08,
-	0xde, 0x02, 0xf3, 0x3e, 0x71, 0x29, 0x40, 0x97, 0x13, 0x91, 0xc6, 0x2f, 0x1a, 0x94, 0xb2, 0x9b,
-	0x05, 0x1c, 0xfb, 0x1e, 0xca, 0x5e, 0xf4, 0xc4, 0xc9, 0x40, 0xbc, 0x99, 0xc2, 0xf7, 0x76, 0x4f,
-	0xbe, 0x8a, 0x44, 0x07, 0x61, 0x0f, 0xa6, 0x28, 0x4a, 0xb9, 0xd3, 0xa8, 0xd5, 0x48, 0xd0, 0x7c,
-	0x8d, 0x0d, 0xfd, 0x5f, 0x06, 0x26, 0xb3, 0x23, 0x1d, 0xd4, 0xe6, 0x3a, 0x42, 0x6d, 0xa1, 0x8e,
-	0xf3, 0xb5, 0x84, 0x17, 0x7f, 0x82, 0x46, 0xe4, 0x82, 0x3a, 0x90, 0xcf, 0x04, 0x81, 0x5d, 0x70,
-	0x59, 0xb8, 0xdf, 0xa8, 0x98, 0x55, 0x5e, 0x83, 0x47, 0x18, 0x7e, 0x96, 0x85, 0x73, 0x60, 0x45,
-	0x60, 0xc2, 0xdc, 0xf2, 0xc3, 0x72, 0x1c, 0x8e, 0xb7, 0xd1, 0xe8, 0x1e, 0x0f, 0xf6, 0x28, 0x0b,
-	0xa9, 0x23, 0x9f, 0xbe, 0x57, 0xc7, 0x6a, 0x03, 0x44, 0x68, 0x01, 0xad, 0x11, 0xe6, 0x33, 0xdf,
-	0x95, 0x0f, 0xde, 0x05, 0xd0, 0x5a, 0x00, 0x11, 0x9a, 0xa4, 0x49, 0x2a, 0x9e, 0x7a, 0xf0, 0x2e,
-	0x80, 0xd6, 0x02, 0x28, 0x1d, 0x8f, 0xa0, 0x4b, 0xb2, 0xde, 0xf8, 0x5b, 0x0d, 0x8d, 0x80, 0xae,
-	0xe1, 0x1b, 0xdd, 0xfd, 0x96, 0xf2, 0x25, 0x92, 0xbf, 0x79, 0xbe, 0x93, 0xba, 0x33, 0xe3, 0xed,
-	0xaf, 0xff, 0xf8, 0xf7, 0xfb, 0xcc, 0x22, 0x2e, 0x5a, 0x3b, 0xd2, 0x7b, 0x79, 0x9b, 0x54, 0x84,
-	0x95, 0xfe, 0x55, 0x66, 0x1d, 0x32, 0xe7, 0x08, 0x7f, 0xa7, 0xa1, 0x6c, 0x42, 0x97, 0xf1, 0xed,
-	0xf4, 0x3c, 0xa7, 0x44, 0x3d, 0x5f, 0xec, 0xed, 0x08, 0xa4, 0x96, 0x24, 0xa9, 0x05, 0x7c, 0xb3,
-	0x0f, 0x52, 0x02, 0xff, 0xa6, 0xa1, 0x5c, 0xe7, 0xc0, 0xe1, 0xc5, 0xd4, 0x54, 0xa9, 0xb2, 0x9f,
-	0x7f, 0xab, 0x2f, 0x5f, 0x60, 0xf6, 0xa9, 0x64, 0xb6, 0x8e, 0xd7, 0xce, 0x63, 0xd6, 0xa5, 0x8b,
-	0xd6, 0x61, 0x7b, 0x0a, 0x8f, 0xac, 0x43, 0x18, 0xa5, 0x23, 0xfc, 0xb3, 0x86, 0x26, 0xba, 0xd4,
-	0x15, 0xf7, 0x43, 0xa6, 0x55, 0xd0, 0xa5, 0xfe, 0x9c, 0x81, 0xfa, 0x87, 0x92, 0xfa, 0x1d, 0xfc,
-	0xde, 0x2b, 0x50, 0x17, 0x09, 0xba, 0x3f, 0x69, 0x28, 0xd7, 0x29, 0x8a, 0x67, 0x94, 0x39, 0x55,
-	0xbf, 0xcf, 0x28, 0x73, 0xba, 0xca, 0x1a, 0x1f, 0x49, 0xae, 0x1f, 0xe0, 0x3b, 0xe7, 0x36, 0x80,
-	0xe7, 0x75, 0x51, 0x6d, 0x97, 0x19, 0xff, 0xa8, 0xa1, 0x6c, 0x42, 0xa3, 0xce, 0xe8, 0xd2, 0xd3,
-	0xa2, 0x79, 0x46, 0x97, 0xa6, 0xc8, 0x9d, 0xb1, 0x29, 0x49, 0xae, 0xe2, 0xbb, 0x3d, 0x0b, 0x2a,
-	0x54, 0xe4, 0x19, 0x8d, 0xb0, 0xf6, 0xd9, 0xf3, 0xe3, 0x82, 0xf6, 0xe2, 0xb8, 0xa0, 0xfd, 0x73,
-	0x5c, 0xd0, 0x9e, 0x9d, 0x14, 0x06, 0x5e, 0x9c, 0x14, 0x06, 0xfe, 0x3c, 0x29, 0x0c, 0x7c, 0xb5,
-	0x92, 0x50, 0x8c, 0x94, 0x24, 0x8f, 0x4b, 0xef, 0x5a, 0x4f, 0x5b, 0xa9, 0xa4, 0x80, 0x54, 0x86,
-	0xe5, 0x37, 0xf0, 0x3b, 0xff, 0x07, 0x00, 0x00, 0xff, 0xff, 0xcd, 0xe5, 0x73, 0x81, 0x86, 0x0d,
+	// 1410 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x57, 0xcf, 0x6f, 0x13, 0xc7,
+	0x17, 0xcf, 0x26, 0x21, 0x3f, 0x9e, 0x13, 0x27, 0x0c, 0xa0, 0x2c, 0x4e, 0x70, 0xf2, 0x35, 0xbf,
+	0x2c, 0x08, 0xbb, 0x5f, 0x5c, 0x84, 0x68, 0x2b, 0x95, 0x26, 0x84, 0xa4, 0x69, 0x83, 0x0a, 0x36,
+	0x54, 0x6d, 0x2f, 0xab, 0xf1, 0xce, 0xc4, 0xac, 0xb2, 0xde, 0x31, 0x3b, 0x6b, 0xc0, 0x45, 0x5c,
+	0x5a, 0xa9, 0xc7, 0x16, 0xa9, 0x7f, 0x45, 0x45, 0xd5, 0x53, 0x7b, 0xed, 0x99, 0x23, 0xa2, 0x97,
+	0xaa, 0x07, 0x5a, 0x41, 0xff, 0x90, 0x6a, 0x67, 0x66, 0x6d, 0xaf, 0xbd, 0xc6, 0x8b, 0xa5, 0x9e,
+	0xbc, 0x3b, 0xef, 0xf3, 0x3e, 0xef, 0x33, 0x6f, 0xde, 0xbc, 0xb7, 0x86, 0x1c, 0x0f, 0x7c, 0x87,
+	0x50, 0x13, 0x3b, 0x3e, 0xf1, 0x59, 0xc3, 0xbc, 0xd7, 0xa4, 0x7e, 0xcb, 0x68, 0xf8, 0x2c, 0x60,
+	0x28, 0x2b, 0x6d, 0x86, 0xb2, 0xe5, 0x56, 0x7a, 0xb0, 0xea, 0x57, 0xa2, 0x73, 0x47, 0x6b, 0xac,
+	0xc6, 0xc4, 0xa3, 0x19, 0x3e, 0xa9, 0xd5, 0x95, 0x1a, 0x63, 0x35, 0x97, 0x9a, 0xb8, 0xe1, 0x98,
+	0xd8, 0xf3, 0x58, 0x80, 0x03, 0x87, 0x79, 0x5c, 0x59, 0xcf, 0xd9, 0x8c, 0xd7, 0x19, 0x37, 0xab,
+	0x98, 0x53, 0x19, 0xda, 0xbc, 0x7f, 0xb1, 0x4a, 0x03, 0x7c, 0xd1, 0x6c, 0xe0, 0x9a, 0xe3, 0x09,
+	0xb0, 0xc2, 0x1e, 0x97, 0x58, 0x4b, 0x86, 0x90, 0x2f, 0xca, 0xb4, 0xaa, 0x82, 0x88, 0xb7, 0x6a,
+	0x73, 0xdf, 0x0c, 0x9c, 0x3a, 0xe5, 0x01, 0xae, 0x2b, 0x6d, 0x85, 0xd3, 0x70, 0xe4, 0x56, 0xc8,
+	0xbe, 0x21, 0x15, 0x97, 0xe9, 0xbd, 0x26, 0xe5, 0x01, 0xca, 0xc2, 0xb8, 0x43, 0x74, 0x6d, 0x4d,
+	0x2b, 0xce, 0x96, 0xc7, 0x1d, 0x52, 0xf8, 0x7e, 0x06, 0x8e, 0xc6, 0x71, 0xbc, 0xc1, 0x3c, 0x4e,
+	0x7b, 0x81, 0xe8, 0x7f, 0x30, 0xe7, 0xd3, 0x07, 0xd8, 0x27, 0x16, 0xa1, 0x1e, 0xab, 0xeb, 0xe3,
+	0xc2, 0x92, 0x91, 0x6b, 0x5b, 0xe1, 0x12, 0xfa, 0x1c, 0x96, 0x88, 0x13, 0x26, 0xac, 0xda, 0x0c,
+	0x37, 0x61, 0xf1, 0x00, 0xfb, 0x81, 0x45, 0x70, 0x40, 0xf5, 0x89, 0x35, 0xad, 0x98, 0x29, 0xe5,
+	0x0c, 0xa9, 0xda, 0x88, 0x54, 0x1b, 0xb7, 0x23, 0xd5, 0x9b, 0x93, 0x4f, 0xfe, 0x5a, 0xd5, 0xca,
+	0xc7, 0xba, 0x09, 0x2a, 0xa1, 0xff, 0x16, 0x0e, 0x28, 0xba, 0x0d, 0x31, 0x83, 0x45, 0x3d, 0x22,
+	0x79, 0x27, 0x53, 0xf2, 0x1e, 0xe9, 0x76, 0xbf, 0xee, 0x11, 0xc1, 0x7a, 0x1d, 0xe6, 0x6d, 0x17,
+	0x3f, 0xa8, 0x62, 0xfb, 0x40, 0xb2, 0x1d, 0x4a, 0xc9, 0x36, 0x17, 0xb9, 0x09, 0x9a, 0x2f, 0x40,
+	0xb7, 0x5d, 0xec, 0xd4, 0xad, 0xa0, 0xd5, 0xa0, 0x16, 0xa1, 0x98, 0xb8, 0x8e, 0x47, 0x25, 0xe3,
+	0x54, 0xda, 0x7d, 0x0b, 0x86, 0xdb, 0xad, 0x06, 0xdd, 0x52, 0xfe, 0x82, 0xba, 0x02, 0x47, 0x28,
+	0xf6, 0xdd, 0x96, 0x25, 0x03, 0x34, 0xa8, 0x87, 0xdd, 0xa0, 0xa5, 0x4f, 0x87, 0xb9, 0xdf, 0x3c,
+	0xf9, 0xec, 0xe5, 0xea, 0xd8, 0x9f, 0x2f, 0x57, 0x97, 0x65, 0x61, 0x70, 0x72, 0x60, 0x38, 0xcc,
+	0xac, 0xe3, 0xe0, 0xae, 0xb1, 0x47, 0x6b, 0xd8, 0x6e, 0x6d, 0x51, 0xbb, 0x7c, 0x58, 0xf8, 0x5f,
+	0x0b, 0xdd, 0x6f, 0x4a, 0x6f, 0xb4, 0x0b, 0x9d, 0x6c, 0x30, 0xdf, 0xc2, 0x84, 0xf8, 0x94, 0x73,
+	0x7d, 0x46, 0x90, 0xea, 0x2f, 0x7e, 0xb9, 0x70, 0x54, 0x55, 0xda, 0x86, 0xb4, 0x54, 0x02, 0xdf,
+	0xf1, 0x6a, 0x65, 0xd4, 0xe5, 0xa4, 0x2c, 0xe8, 0x3a, 0x1c, 0xc6, 0xae, 0xcb, 0x6c, 0xdc, 0x4d,
+	0x34, 0x3b, 0x84, 0x68, 0xb1, 0xed, 0x12, 0xd1, 0x5c, 0x85, 0xac, 0xeb, 0x78, 0x07, 0xb4, 0xc3,
+	0x01, 0x43, 0x38, 0xe6, 0x25, 0x3e, 0x22, 0x58, 0x07, 0x64, 0x37, 0x7d, 0x9f, 0x7a, 0xb2, 0xdc,
+	0x2c, 0xc7, 0x23, 0xf4, 0xa1, 0x9e, 0x59, 0xd3, 0x8a, 0x13, 0xe5, 0x45, 0x65, 0x09, 0x13, 0xba,
+	0x1b, 0xae, 0xa3, 0xd3, 0x90, 0x55, 0xf7, 0xd8, 0x72, 0xa9, 0x57, 0x0b, 0xee, 0xea, 0x73, 0x02,
+	0x39, 0xaf, 0x56, 0xf7, 0xc4, 0x22, 0x5a, 0x81, 0xd9, 0x7d, 0xc7, 0xc3, 0xae, 0xf3, 0x15, 0x25,
+	0xfa, 0xfc, 0x9a, 0x56, 0x9c, 0x29, 0x77, 0x16, 0xd0, 0x65, 0x58, 0x52, 0xfb, 0x08, 0x2f, 0xb7,
+	0x55, 0xa7, 0xfe, 0x81, 0x4b, 0x2d, 0x9f, 0xb1, 0x40, 0xcf, 0x8a, 0xab, 0x71, 0xac, 0xcb, 0x7c,
+	0x43, 0x58, 0xcb, 0x8c, 0x05, 0xe8, 0x33, 0x90, 0x67, 0x6d, 0x61, 0x8f, 0x84, 0x37, 0xe4, 0x80,
+	0x5a, 0x55, 0xe6, 0x35, 0xb9, 0xbe, 0x90, 0xfe, 0x50, 0x91, 0x60, 0xd8, 0xf0, 0x48, 0x25, 0xf4,
+	0xdf, 0x0c, 0xdd, 0xd1, 0x0e, 0x2c, 0x48, 0x5e, 0x9b, 0x79, 0xc4, 0x09, 0xa3, 0xea, 0x8b, 0x6b,
+	0x5a, 0x31, 0x5b, 0xca, 0x1b, 0xf1, 0x9e, 0x66, 0x88, 0x62, 0xb8, 0x16, 0xa1, 0xca, 0x59, 0x3b,
+	0xf6, 0x5e, 0x38, 0x0e, 0x4b, 0xb2, 0x21, 0xb8, 0xae, 0xea, 0x09, 0x5c, 0x35, 0x8f, 0xc2, 0x1d,
+	0xd0, 0xfb, 0x4d, 0xaa, 0x5f, 0xbc, 0x0b, 0x33, 0x2a, 0x00, 0xd7, 0xb5, 0xb5, 0x89, 0x62, 0xa6,
+	0xb4, 0xd4, 0x1b, 0x58, 0xf9, 0x6c, 0x4e, 0x86, 0x7b, 0x2c, 0xb7, 0xe1, 0x05, 0x06, 0x39, 0x41,
+	0x7b, 0x87, 0x53, 0x7f, 0xa3, 0x9d, 0xb4, 0xa8, 0x63, 0x9d, 0x00, 0x88, 0x4e, 0xab, 0xdd, 0x90,
+	0x66, 0xd5, 0xca, 0x2e, 0x41, 0x25, 0x98, 0x8e, 0x8a, 0x66, 0x7c, 0x48, 0xd1, 0x44, 0xc0, 0xc2,
+	0x3e, 0x2c, 0x27, 0x06, 0x54, 0x5b, 0xd9, 0x81, 0x85, 0x26, 0x0f, 0x8b, 0xb1, 0x6d, 0x12, 0x61,
+	0x33, 0xfd, 0xa9, 0xec, 0x21, 0xc8, 0x36, 0x63, 0xef, 0x85, 0x5b, 0x89, 0x71, 0xa2, 0x74, 0x76,
+	0x4b, 0xd7, 0xd2, 0x4a, 0x67, 0xb0, 0x92, 0x4c, 0xa9, 0xb4, 0x7f, 0x0a, 0x8b, 0x3d, 0xda, 0xa3,
+	0xe3, 0x18, 0x22, 0x5e, 0x9d, 0xca, 0x42, 0x7c, 0x0b, 0xbc, 0xf0, 0x8d, 0x06, 0xb9, 0xf6, 0xa1,
+	0xf7, 0xef, 0x61, 0xc8, 0xe9, 0x6c, 0x03, 0x74, 0xa6, 0x9a, 0x38, 0xa0, 0x4c, 0xe9, 0x8c, 0xa1,
+	0xb6, 0x18, 0x8e, 0x40, 0x43, 0x4e, 0x5f, 0x35, 0x02, 0x8d, 0x9b, 0xb8, 0x46, 0x15, 0x75, 0xb9,
+	0xcb, 0xb3, 0xf0, 0xb3, 0x06, 0xcb, 0x89, 0x2a, 0xd4, 0xb6, 0xb7, 0x21, 0x33, 0xea, 0x8e, 0xbb,
+	0x1d, 0xd1, 0x4e, 0x82, 0xde, 0xb3, 0x43, 0xf5, 0x4a, 0x11, 0x31, 0xc1, 0xae, 0xba, 0x45, 0x61,
+	0xc8, 0x4a, 0xb3, 0x5e, 0xc7, 0x7e, 0xeb, 0x3f, 0x2c, 0xe8, 0x17, 0x93, 0xa0, 0xf7, 0x87, 0x53,
+	0xb9, 0x39, 0x01, 0xd0, 0x99, 0x4f, 0x51, 0xbc, 0xf6, 0xbc, 0x41, 0x1f, 0xc1, 0xb4, 0x78, 0xa1,
+	0x44, 0xc5, 0x33, 0x54, 0x0b, 0x3a, 0x53, 0x73, 0x82, 0xbb, 0xcd, 0xaa, 0x61, 0xb3, 0xba, 0xfa,
+	0xf6, 0x50, 0x3f, 0x17, 0x38, 0x39, 0x30, 0x43, 0x32, 0x6e, 0xec, 0x7a, 0x41, 0x39, 0x72, 0x47,
+	0x7b, 0x30, 0xbb, 0xcf, 0xfc, 0x7d, 0xea, 0x04, 0x94, 0xe8, 0x13, 0x23, 0x71, 0x75, 0x08, 0x42,
+	0x36, 0x9f, 0xd6, 0xb1, 0xe3, 0x39, 0x5e, 0x4d, 0x9f, 0x1c, 0x8d, 0xad, 0x4d, 0x10, 0xb2, 0x09,
+	0x99, 0xb8, 0xea, 0xca, 0x39, 0x3f, 0x02, 0x5b, 0x9b, 0x00, 0x6d, 0xc3, 0x94, 0x68, 0xdd, 0x44,
+	0x9f, 0x1a, 0x89, 0x4a, 0x79, 0xa3, 0x0a, 0xcc, 0x87, 0x4f, 0x8e, 0x57, 0x53, 0x43, 0x60, 0x7a,
+	0x24, 0xba, 0x39, 0x45, 0x22, 0x27, 0xc1, 0x36, 0x4c, 0xdd, 0xa7, 0x3c, 0x3c, 0x83, 0x99, 0xd1,
+	0xc4, 0x49, 0xef, 0xc2, 0xfb, 0xea, 0xe2, 0x5f, 0x53, 0x1f, 0x3b, 0x65, 0x6a, 0x33, 0x9f, 0xa4,
+	0xab, 0xe2, 0x82, 0x0b, 0xcb, 0x89, 0xce, 0xaa, 0x26, 0x6f, 0x88, 0x69, 0x25, 0x2c, 0x96, 0x2f,
+	0x4c, 0x83, 0x5a, 0x6c, 0x9c, 0x40, 0xdd, 0xd9, 0xac, 0x1d, 0x5b, 0x2d, 0xac, 0x41, 0x3e, 0xea,
+	0x0e, 0x71, 0x7c, 0x7b, 0x74, 0xf9, 0xb0, 0x3a, 0x10, 0xd1, 0x69, 0x9d, 0x3d, 0x9a, 0x06, 0x36,
+	0x92, 0x44, 0x51, 0x0b, 0x71, 0x51, 0xbc, 0xf4, 0x1b, 0xc0, 0x21, 0x11, 0x14, 0x7d, 0xab, 0xc1,
+	0xb4, 0x9a, 0x7e, 0xe8, 0x64, 0x2f, 0x59, 0xc2, 0x67, 0x7a, 0xee, 0xd4, 0x9b, 0x41, 0x52, 0x71,
+	0xe1, 0xff, 0x5f, 0xff, 0xfe, 0xcf, 0x0f, 0xe3, 0xe7, 0x50, 0xd1, 0xac, 0x08, 0xf4, 0x85, 0x3d,
+	0x5c, 0xe5, 0x66, 0xf2, 0x5f, 0x16, 0xf3, 0x91, 0x43, 0x1e, 0xa3, 0xef, 0x34, 0xc8, 0x74, 0x4d,
+	0x6f, 0x74, 0x36, 0x39, 0x4e, 0xdf, 0xe8, 0xcf, 0x15, 0x87, 0x03, 0x95, 0xa8, 0x75, 0x21, 0xea,
+	0x0c, 0x3a, 0x95, 0x42, 0x14, 0x47, 0xbf, 0x6a, 0x90, 0x8d, 0xb7, 0x65, 0x74, 0x2e, 0x31, 0x54,
+	0xe2, 0xc7, 0x41, 0xee, 0x7c, 0x2a, 0xac, 0x52, 0xf6, 0xb1, 0x50, 0xb6, 0x85, 0x36, 0xdf, 0xa4,
+	0xac, 0x67, 0x7a, 0x9a, 0x8f, 0x3a, 0x55, 0xfe, 0xd8, 0x7c, 0xa4, 0x1a, 0xee, 0x63, 0xf4, 0x93,
+	0x06, 0x0b, 0xf1, 0x30, 0x1c, 0xa5, 0x11, 0xd3, 0x4e, 0xe8, 0x7a, 0x3a, 0xb0, 0x92, 0xfe, 0x81,
+	0x90, 0x7e, 0x05, 0x5d, 0x7e, 0x0b, 0xe9, 0xbc, 0x4b, 0xee, 0x53, 0x0d, 0xb2, 0xf1, 0xd1, 0x39,
+	0x20, 0xcd, 0x89, 0x53, 0x3e, 0x77, 0x3e, 0x15, 0x56, 0x69, 0xfd, 0x50, 0x68, 0x7d, 0x0f, 0x5d,
+	0x79, 0x63, 0x01, 0xb8, 0x6e, 0x8f, 0xd4, 0x4e, 0x9a, 0xd1, 0x8f, 0x1a, 0x64, 0xba, 0x26, 0xd9,
+	0x80, 0x2a, 0xed, 0x1f, 0xad, 0xb9, 0xe2, 0x70, 0xa0, 0x12, 0xb9, 0x23, 0x44, 0x6e, 0xa0, 0xab,
+	0x43, 0x13, 0xca, 0xa5, 0xe7, 0xa0, 0x42, 0x08, 0x33, 0x1b, 0x6f, 0x07, 0x03, 0x32, 0x9b, 0xd8,
+	0x46, 0x73, 0xe7, 0x53, 0x61, 0xdf, 0x26, 0xb3, 0x3d, 0x3d, 0x2c, 0x9e, 0xd9, 0xa7, 0x1a, 0xa0,
+	0xfe, 0x16, 0x88, 0x8c, 0x41, 0xe7, 0x9b, 0xdc, 0x4d, 0x73, 0x66, 0x6a, 0xbc, 0x52, 0x7e, 0x49,
+	0x28, 0x37, 0xd0, 0xfa, 0x5b, 0x28, 0xe7, 0x9b, 0x9f, 0x3c, 0x7b, 0x95, 0xd7, 0x9e, 0xbf, 0xca,
+	0x6b, 0x7f, 0xbf, 0xca, 0x6b, 0x4f, 0x5e, 0xe7, 0xc7, 0x9e, 0xbf, 0xce, 0x8f, 0xfd, 0xf1, 0x3a,
+	0x3f, 0xf6, 0xe5, 0xc5, 0xae, 0x59, 0x96, 0xc0, 0x78, 0xbf, 0x74, 0xc9, 0x7c, 0xd8, 0xe6, 0x15,
+	0xa3, 0xad, 0x3a, 0x25, 0xfe, 0x7c, 0xbf, 0xf3, 0xef, 0x00, 0x0a, 0x34, 0x73, 0x8c, 0xff, 0x11,
 	0x00, 0x00,
 }
 
@@ -820,6 +1046,11 @@ type QueryClient interface {
 	// Queries the state of an address for an airdrop including the claim type,
 	// amount claimed so far, and unclaimed amount
 	UserSummary(ctx context.Context, in *QueryUserSummaryRequest, opts ...grpc.CallOption) (*QueryUserSummaryResponse, error)
+	// Queries the clawback record (allocated, claimed, forfeited, and clawed
+	// back totals) for a finalized airdrop
+	ClawbackRecord(ctx context.Context, in *QueryClawbackRecordRequest, opts ...grpc.CallOption) (*QueryClawbackRecordResponse, error)
+	// Queries the clawback records across all finalized airdrops
+	AllClawbackRecords(ctx context.Context, in *QueryAllClawbackRecordsRequest, opts ...grpc.CallOption) (*QueryAllClawbackRecordsResponse, error)
 }
 
 type queryClient struct {
@@ -884,6 +1115,24 @@ func (c *queryClient) UserSummary(ctx context.Context, in *QueryUserSummaryReque
 	return out, nil
 }
 
+func (c *queryClient) ClawbackRecord(ctx context.Context, in *QueryClawbackRecordRequest, opts ...grpc.CallOption) (*QueryClawbackRecordResponse, error) {
+	out := new(QueryClawbackRecordResponse)
+	err := c.cc.Invoke(ctx, "/stride.airdrop.Query/ClawbackRecord", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AllClawbackRecords(ctx context.Context, in *QueryAllClawbackRecordsRequest, opts ...grpc.CallOption) (*QueryAllClawbackRecordsResponse, error) {
+	out := new(QueryAllClawbackRecordsResponse)
+	err := c.cc.Invoke(ctx, "/stride.airdrop.Query/AllClawbackRecords", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Queries the configuration for a given airdrop
@@ -899,6 +1148,11 @@ type QueryServer interface {
 	// Queries the state of an address for an airdrop including the claim type,
 	// amount claimed so far, and unclaimed amount
 	UserSummary(context.Context, *QueryUserSummaryRequest) (*QueryUserSummaryResponse, error)
+	// Queries the clawback record (allocated, claimed, forfeited, and clawed
+	// back totals) for a finalized airdrop
+	ClawbackRecord(context.Context, *QueryClawbackRecordRequest) (*QueryClawbackRecordResponse, error)
+	// Queries the clawback records across all finalized airdrops
+	AllClawbackRecords(context.Context, *QueryAllClawbackRecordsRequest) (*QueryAllClawbackRecordsResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -923,6 +1177,12 @@ func (*UnimplementedQueryServer) AllAllocations(ctx context.Context, req *QueryA
 func (*UnimplementedQueryServer) UserSummary(ctx context.Context, req *QueryUserSummaryRequest) (*QueryUserSummaryResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UserSummary not implemented")
 }
+func (*UnimplementedQueryServer) ClawbackRecord(ctx context.Context, req *QueryClawbackRecordRequest) (*QueryClawbackRecordResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClawbackRecord not implemented")
+}
+func (*UnimplementedQueryServer) AllClawbackRecords(ctx context.Context, req *QueryAllClawbackRecordsRequest) (*QueryAllClawbackRecordsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AllClawbackRecords not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -1036,6 +1296,42 @@ func _Query_UserSummary_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_ClawbackRecord_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryClawbackRecordRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ClawbackRecord(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stride.airdrop.Query/ClawbackRecord",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ClawbackRecord(ctx, req.(*QueryClawbackRecordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AllClawbackRecords_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllClawbackRecordsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AllClawbackRecords(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stride.airdrop.Query/AllClawbackRecords",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AllClawbackRecords(ctx, req.(*QueryAllClawbackRecordsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "stride.airdrop.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -1064,6 +1360,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "UserSummary",
 			Handler:    _Query_UserSummary_Handler,
 		},
+		{
+			MethodName: "ClawbackRecord",
+			Handler:    _Query_ClawbackRecord_Handler,
+		},
+		{
+			MethodName: "AllClawbackRecords",
+			Handler:    _Query_AllClawbackRecords_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "stride/airdrop/query.proto",
@@ -1119,6 +1423,40 @@ func (m *QueryAirdropResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ClaimCondition != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ClaimCondition))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
+	{
+		size := m.ClaimAndStakeBonus.Size()
+		i -= size
+		if _, err := m.ClaimAndStakeBonus.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x7a
+	if len(m.AllocationsMerkleRoot) > 0 {
+		i -= len(m.AllocationsMerkleRoot)
+		copy(dAtA[i:], m.AllocationsMerkleRoot)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.AllocationsMerkleRoot)))
+		i--
+		dAtA[i] = 0x72
+	}
+	if m.Finalized {
+		i--
+		if m.Finalized {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x68
+	}
 	if m.AirdropLength != 0 {
 		i = encodeVarintQuery(dAtA, i, uint64(m.AirdropLength))
 		i--
@@ -1564,6 +1902,36 @@ func (m *QueryUserSummaryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.Vested.Size()
+		i -= size
+		if _, err := m.Vested.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	{
+		size := m.StakingBonus.Size()
+		i -= size
+		if _, err := m.StakingBonus.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.Staked.Size()
+		i -= size
+		if _, err := m.Staked.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
 	{
 		size := m.Claimable.Size()
 		i -= size
@@ -1614,43 +1982,166 @@ func (m *QueryUserSummaryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryClawbackRecordRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueryAirdropRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *QueryClawbackRecordRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryClawbackRecordRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Id)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.AirdropId) > 0 {
+		i -= len(m.AirdropId)
+		copy(dAtA[i:], m.AirdropId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.AirdropId)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryAirdropResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryClawbackRecordResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryClawbackRecordResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryClawbackRecordResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Id)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.RewardDenom)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	{
+		size, err := m.ClawbackRecord.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllClawbackRecordsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllClawbackRecordsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllClawbackRecordsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllClawbackRecordsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllClawbackRecordsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllClawbackRecordsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ClawbackRecords) > 0 {
+		for iNdEx := len(m.ClawbackRecords) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ClawbackRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryAirdropRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAirdropResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.RewardDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
 	}
 	if m.DistributionStartDate != nil {
 		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.DistributionStartDate)
@@ -1688,6 +2179,18 @@ func (m *QueryAirdropResponse) Size() (n int) {
 	if m.AirdropLength != 0 {
 		n += 1 + sovQuery(uint64(m.AirdropLength))
 	}
+	if m.Finalized {
+		n += 2
+	}
+	l = len(m.AllocationsMerkleRoot)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.ClaimAndStakeBonus.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.ClaimCondition != 0 {
+		n += 2 + sovQuery(uint64(m.ClaimCondition))
+	}
 	return n
 }
 
@@ -1844,6 +2347,60 @@ func (m *QueryUserSummaryResponse) Size() (n int) {
 	n += 1 + l + sovQuery(uint64(l))
 	l = m.Claimable.Size()
 	n += 1 + l + sovQuery(uint64(l))
+	l = m.Staked.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.StakingBonus.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Vested.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryClawbackRecordRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.AirdropId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryClawbackRecordResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ClawbackRecord.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllClawbackRecordsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryAllClawbackRecordsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ClawbackRecords) > 0 {
+		for _, e := range m.ClawbackRecords {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -2340,6 +2897,111 @@ func (m *QueryAirdropResponse) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Finalized", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Finalized = bool(v != 0)
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllocationsMerkleRoot", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllocationsMerkleRoot = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClaimAndStakeBonus", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ClaimAndStakeBonus.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClaimCondition", wireType)
+			}
+			m.ClaimCondition = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ClaimCondition |= ClaimCondition(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3410,6 +4072,407 @@ func (m *QueryUserSummaryResponse) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Staked", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Staked.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingBonus", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.StakingBonus.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Vested", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Vested.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryClawbackRecordRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryClawbackRecordRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryClawbackRecordRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AirdropId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AirdropId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryClawbackRecordResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryClawbackRecordResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryClawbackRecordResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClawbackRecord", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ClawbackRecord.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllClawbackRecordsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllClawbackRecordsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllClawbackRecordsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllClawbackRecordsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllClawbackRecordsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllClawbackRecordsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClawbackRecords", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClawbackRecords = append(m.ClawbackRecords, ClawbackRecord{})
+			if err := m.ClawbackRecords[len(m.ClawbackRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -8,6 +8,7 @@ import (
 	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
@@ -42,6 +43,9 @@ type MsgClaimDaily struct {
 	Claimer string `protobuf:"bytes,1,opt,name=claimer,proto3" json:"claimer,omitempty"`
 	// Airdrop ID
 	AirdropId string `protobuf:"bytes,2,opt,name=airdrop_id,json=airdropId,proto3" json:"airdrop_id,omitempty"`
+	// Merkle proof of the claimer's allocations, required on the first claim of
+	// an airdrop that uses an allocations merkle root
+	AllocationProof *AllocationProof `protobuf:"bytes,3,opt,name=allocation_proof,json=allocationProof,proto3" json:"allocation_proof,omitempty"`
 }
 
 func (m *MsgClaimDaily) Reset()         { *m = MsgClaimDaily{} }
@@ -91,6 +95,13 @@ func (m *MsgClaimDaily) GetAirdropId() string {
 	return ""
 }
 
+func (m *MsgClaimDaily) GetAllocationProof() *AllocationProof {
+	if m != nil {
+		return m.AllocationProof
+	}
+	return nil
+}
+
 type MsgClaimDailyResponse struct {
 }
 
@@ -133,6 +144,9 @@ type MsgClaimEarly struct {
 	Claimer string `protobuf:"bytes,1,opt,name=claimer,proto3" json:"claimer,omitempty"`
 	// Airdrop ID
 	AirdropId string `protobuf:"bytes,2,opt,name=airdrop_id,json=airdropId,proto3" json:"airdrop_id,omitempty"`
+	// Merkle proof of the claimer's allocations, required on the first claim of
+	// an airdrop that uses an allocations merkle root
+	AllocationProof *AllocationProof `protobuf:"bytes,3,opt,name=allocation_proof,json=allocationProof,proto3" json:"allocation_proof,omitempty"`
 }
 
 func (m *MsgClaimEarly) Reset()         { *m = MsgClaimEarly{} }
@@ -182,6 +196,13 @@ func (m *MsgClaimEarly) GetAirdropId() string {
 	return ""
 }
 
+func (m *MsgClaimEarly) GetAllocationProof() *AllocationProof {
+	if m != nil {
+		return m.AllocationProof
+	}
+	return nil
+}
+
 type MsgClaimEarlyResponse struct {
 }
 
@@ -218,6 +239,217 @@ func (m *MsgClaimEarlyResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgClaimEarlyResponse proto.InternalMessageInfo
 
+// ClaimAndStake
+type MsgClaimAndStake struct {
+	// Address of the claimer
+	Claimer string `protobuf:"bytes,1,opt,name=claimer,proto3" json:"claimer,omitempty"`
+	// Airdrop ID
+	AirdropId string `protobuf:"bytes,2,opt,name=airdrop_id,json=airdropId,proto3" json:"airdrop_id,omitempty"`
+	// Merkle proof of the claimer's allocations, required on the first claim of
+	// an airdrop that uses an allocations merkle root
+	AllocationProof *AllocationProof `protobuf:"bytes,3,opt,name=allocation_proof,json=allocationProof,proto3" json:"allocation_proof,omitempty"`
+}
+
+func (m *MsgClaimAndStake) Reset()         { *m = MsgClaimAndStake{} }
+func (m *MsgClaimAndStake) String() string { return proto.CompactTextString(m) }
+func (*MsgClaimAndStake) ProtoMessage()    {}
+func (*MsgClaimAndStake) Descriptor() ([]byte, []int) {
+	return fileDescriptor_40a6837f542f43b8, []int{4}
+}
+func (m *MsgClaimAndStake) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgClaimAndStake) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgClaimAndStake.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgClaimAndStake) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgClaimAndStake.Merge(m, src)
+}
+func (m *MsgClaimAndStake) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgClaimAndStake) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgClaimAndStake.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgClaimAndStake proto.InternalMessageInfo
+
+func (m *MsgClaimAndStake) GetClaimer() string {
+	if m != nil {
+		return m.Claimer
+	}
+	return ""
+}
+
+func (m *MsgClaimAndStake) GetAirdropId() string {
+	if m != nil {
+		return m.AirdropId
+	}
+	return ""
+}
+
+func (m *MsgClaimAndStake) GetAllocationProof() *AllocationProof {
+	if m != nil {
+		return m.AllocationProof
+	}
+	return nil
+}
+
+type MsgClaimAndStakeResponse struct {
+	// The stTokens received from liquid staking the rewards and bonus
+	StToken types.Coin `protobuf:"bytes,1,opt,name=st_token,json=stToken,proto3" json:"st_token"`
+}
+
+func (m *MsgClaimAndStakeResponse) Reset()         { *m = MsgClaimAndStakeResponse{} }
+func (m *MsgClaimAndStakeResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgClaimAndStakeResponse) ProtoMessage()    {}
+func (*MsgClaimAndStakeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_40a6837f542f43b8, []int{5}
+}
+func (m *MsgClaimAndStakeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgClaimAndStakeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgClaimAndStakeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgClaimAndStakeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgClaimAndStakeResponse.Merge(m, src)
+}
+func (m *MsgClaimAndStakeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgClaimAndStakeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgClaimAndStakeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgClaimAndStakeResponse proto.InternalMessageInfo
+
+func (m *MsgClaimAndStakeResponse) GetStToken() types.Coin {
+	if m != nil {
+		return m.StToken
+	}
+	return types.Coin{}
+}
+
+// ClaimAndVest
+type MsgClaimAndVest struct {
+	// Address of the claimer
+	Claimer string `protobuf:"bytes,1,opt,name=claimer,proto3" json:"claimer,omitempty"`
+	// Airdrop ID
+	AirdropId string `protobuf:"bytes,2,opt,name=airdrop_id,json=airdropId,proto3" json:"airdrop_id,omitempty"`
+	// Merkle proof of the claimer's allocations, required on the first claim of
+	// an airdrop that uses an allocations merkle root
+	AllocationProof *AllocationProof `protobuf:"bytes,3,opt,name=allocation_proof,json=allocationProof,proto3" json:"allocation_proof,omitempty"`
+}
+
+func (m *MsgClaimAndVest) Reset()         { *m = MsgClaimAndVest{} }
+func (m *MsgClaimAndVest) String() string { return proto.CompactTextString(m) }
+func (*MsgClaimAndVest) ProtoMessage()    {}
+func (*MsgClaimAndVest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_40a6837f542f43b8, []int{6}
+}
+func (m *MsgClaimAndVest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgClaimAndVest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgClaimAndVest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgClaimAndVest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgClaimAndVest.Merge(m, src)
+}
+func (m *MsgClaimAndVest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgClaimAndVest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgClaimAndVest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgClaimAndVest proto.InternalMessageInfo
+
+func (m *MsgClaimAndVest) GetClaimer() string {
+	if m != nil {
+		return m.Claimer
+	}
+	return ""
+}
+
+func (m *MsgClaimAndVest) GetAirdropId() string {
+	if m != nil {
+		return m.AirdropId
+	}
+	return ""
+}
+
+func (m *MsgClaimAndVest) GetAllocationProof() *AllocationProof {
+	if m != nil {
+		return m.AllocationProof
+	}
+	return nil
+}
+
+type MsgClaimAndVestResponse struct {
+}
+
+func (m *MsgClaimAndVestResponse) Reset()         { *m = MsgClaimAndVestResponse{} }
+func (m *MsgClaimAndVestResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgClaimAndVestResponse) ProtoMessage()    {}
+func (*MsgClaimAndVestResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_40a6837f542f43b8, []int{7}
+}
+func (m *MsgClaimAndVestResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgClaimAndVestResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgClaimAndVestResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgClaimAndVestResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgClaimAndVestResponse.Merge(m, src)
+}
+func (m *MsgClaimAndVestResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgClaimAndVestResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgClaimAndVestResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgClaimAndVestResponse proto.InternalMessageInfo
+
 // CreateAirdrop
 type MsgCreateAirdrop struct {
 	// Airdrop admin address
@@ -246,13 +478,18 @@ type MsgCreateAirdrop struct {
 	AllocatorAddress string `protobuf:"bytes,10,opt,name=allocator_address,json=allocatorAddress,proto3" json:"allocator_address,omitempty"`
 	// Admin account with permissions to link addresseses
 	LinkerAddress string `protobuf:"bytes,11,opt,name=linker_address,json=linkerAddress,proto3" json:"linker_address,omitempty"`
+	// Bonus paid from the distributor when rewards are claimed and liquid staked
+	// - e.g. 0.1 means a staker receives an extra 10% of their claimed rewards
+	ClaimAndStakeBonus cosmossdk_io_math.LegacyDec `protobuf:"bytes,12,opt,name=claim_and_stake_bonus,json=claimAndStakeBonus,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"claim_and_stake_bonus"`
+	// Action that the user must complete to claim their rewards
+	ClaimCondition ClaimCondition `protobuf:"varint,13,opt,name=claim_condition,json=claimCondition,proto3,enum=stride.airdrop.ClaimCondition" json:"claim_condition,omitempty"`
 }
 
 func (m *MsgCreateAirdrop) Reset()         { *m = MsgCreateAirdrop{} }
 func (m *MsgCreateAirdrop) String() string { return proto.CompactTextString(m) }
 func (*MsgCreateAirdrop) ProtoMessage()    {}
 func (*MsgCreateAirdrop) Descriptor() ([]byte, []int) {
-	return fileDescriptor_40a6837f542f43b8, []int{4}
+	return fileDescriptor_40a6837f542f43b8, []int{8}
 }
 func (m *MsgCreateAirdrop) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -351,6 +588,13 @@ func (m *MsgCreateAirdrop) GetLinkerAddress() string {
 	return ""
 }
 
+func (m *MsgCreateAirdrop) GetClaimCondition() ClaimCondition {
+	if m != nil {
+		return m.ClaimCondition
+	}
+	return CLAIM_CONDITION_NONE
+}
+
 type MsgCreateAirdropResponse struct {
 }
 
@@ -358,7 +602,7 @@ func (m *MsgCreateAirdropResponse) Reset()         { *m = MsgCreateAirdropRespon
 func (m *MsgCreateAirdropResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgCreateAirdropResponse) ProtoMessage()    {}
 func (*MsgCreateAirdropResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_40a6837f542f43b8, []int{5}
+	return fileDescriptor_40a6837f542f43b8, []int{9}
 }
 func (m *MsgCreateAirdropResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -415,13 +659,18 @@ type MsgUpdateAirdrop struct {
 	AllocatorAddress string `protobuf:"bytes,10,opt,name=allocator_address,json=allocatorAddress,proto3" json:"allocator_address,omitempty"`
 	// Admin account with permissions to link addresseses
 	LinkerAddress string `protobuf:"bytes,11,opt,name=linker_address,json=linkerAddress,proto3" json:"linker_address,omitempty"`
+	// Bonus paid from the distributor when rewards are claimed and liquid staked
+	// - e.g. 0.1 means a staker receives an extra 10% of their claimed rewards
+	ClaimAndStakeBonus cosmossdk_io_math.LegacyDec `protobuf:"bytes,12,opt,name=claim_and_stake_bonus,json=claimAndStakeBonus,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"claim_and_stake_bonus"`
+	// Action that the user must complete to claim their rewards
+	ClaimCondition ClaimCondition `protobuf:"varint,13,opt,name=claim_condition,json=claimCondition,proto3,enum=stride.airdrop.ClaimCondition" json:"claim_condition,omitempty"`
 }
 
 func (m *MsgUpdateAirdrop) Reset()         { *m = MsgUpdateAirdrop{} }
 func (m *MsgUpdateAirdrop) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateAirdrop) ProtoMessage()    {}
 func (*MsgUpdateAirdrop) Descriptor() ([]byte, []int) {
-	return fileDescriptor_40a6837f542f43b8, []int{6}
+	return fileDescriptor_40a6837f542f43b8, []int{10}
 }
 func (m *MsgUpdateAirdrop) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -520,6 +769,13 @@ func (m *MsgUpdateAirdrop) GetLinkerAddress() string {
 	return ""
 }
 
+func (m *MsgUpdateAirdrop) GetClaimCondition() ClaimCondition {
+	if m != nil {
+		return m.ClaimCondition
+	}
+	return CLAIM_CONDITION_NONE
+}
+
 type MsgUpdateAirdropResponse struct {
 }
 
@@ -527,7 +783,7 @@ func (m *MsgUpdateAirdropResponse) Reset()         { *m = MsgUpdateAirdropRespon
 func (m *MsgUpdateAirdropResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateAirdropResponse) ProtoMessage()    {}
 func (*MsgUpdateAirdropResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_40a6837f542f43b8, []int{7}
+	return fileDescriptor_40a6837f542f43b8, []int{11}
 }
 func (m *MsgUpdateAirdropResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -566,7 +822,7 @@ func (m *RawAllocation) Reset()         { *m = RawAllocation{} }
 func (m *RawAllocation) String() string { return proto.CompactTextString(m) }
 func (*RawAllocation) ProtoMessage()    {}
 func (*RawAllocation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_40a6837f542f43b8, []int{8}
+	return fileDescriptor_40a6837f542f43b8, []int{12}
 }
 func (m *RawAllocation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -616,7 +872,7 @@ func (m *MsgAddAllocations) Reset()         { *m = MsgAddAllocations{} }
 func (m *MsgAddAllocations) String() string { return proto.CompactTextString(m) }
 func (*MsgAddAllocations) ProtoMessage()    {}
 func (*MsgAddAllocations) Descriptor() ([]byte, []int) {
-	return fileDescriptor_40a6837f542f43b8, []int{9}
+	return fileDescriptor_40a6837f542f43b8, []int{13}
 }
 func (m *MsgAddAllocations) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -673,7 +929,7 @@ func (m *MsgAddAllocationsResponse) Reset()         { *m = MsgAddAllocationsResp
 func (m *MsgAddAllocationsResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgAddAllocationsResponse) ProtoMessage()    {}
 func (*MsgAddAllocationsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_40a6837f542f43b8, []int{10}
+	return fileDescriptor_40a6837f542f43b8, []int{14}
 }
 func (m *MsgAddAllocationsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -719,7 +975,7 @@ func (m *MsgUpdateUserAllocation) Reset()         { *m = MsgUpdateUserAllocation
 func (m *MsgUpdateUserAllocation) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateUserAllocation) ProtoMessage()    {}
 func (*MsgUpdateUserAllocation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_40a6837f542f43b8, []int{11}
+	return fileDescriptor_40a6837f542f43b8, []int{15}
 }
 func (m *MsgUpdateUserAllocation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -776,7 +1032,7 @@ func (m *MsgUpdateUserAllocationResponse) Reset()         { *m = MsgUpdateUserAl
 func (m *MsgUpdateUserAllocationResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateUserAllocationResponse) ProtoMessage()    {}
 func (*MsgUpdateUserAllocationResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_40a6837f542f43b8, []int{12}
+	return fileDescriptor_40a6837f542f43b8, []int{16}
 }
 func (m *MsgUpdateUserAllocationResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -813,15 +1069,20 @@ type MsgLinkAddresses struct {
 	AirdropId string `protobuf:"bytes,2,opt,name=airdrop_id,json=airdropId,proto3" json:"airdrop_id,omitempty"`
 	// Stride address - this address may or may not exist in allocations yet
 	StrideAddress string `protobuf:"bytes,3,opt,name=stride_address,json=strideAddress,proto3" json:"stride_address,omitempty"`
-	// Host address - this address must exist
+	// Host address - this address must exist, unless the airdrop uses an
+	// allocations merkle root and the host address's proof is provided
 	HostAddress string `protobuf:"bytes,4,opt,name=host_address,json=hostAddress,proto3" json:"host_address,omitempty"`
+	// Merkle proof of the host address's allocations, required to link a host
+	// address whose allocation has not yet been stored on an airdrop that uses an
+	// allocations merkle root
+	AllocationProof *AllocationProof `protobuf:"bytes,5,opt,name=allocation_proof,json=allocationProof,proto3" json:"allocation_proof,omitempty"`
 }
 
 func (m *MsgLinkAddresses) Reset()         { *m = MsgLinkAddresses{} }
 func (m *MsgLinkAddresses) String() string { return proto.CompactTextString(m) }
 func (*MsgLinkAddresses) ProtoMessage()    {}
 func (*MsgLinkAddresses) Descriptor() ([]byte, []int) {
-	return fileDescriptor_40a6837f542f43b8, []int{13}
+	return fileDescriptor_40a6837f542f43b8, []int{17}
 }
 func (m *MsgLinkAddresses) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -878,6 +1139,13 @@ func (m *MsgLinkAddresses) GetHostAddress() string {
 	return ""
 }
 
+func (m *MsgLinkAddresses) GetAllocationProof() *AllocationProof {
+	if m != nil {
+		return m.AllocationProof
+	}
+	return nil
+}
+
 type MsgLinkAddressesResponse struct {
 }
 
@@ -885,7 +1153,7 @@ func (m *MsgLinkAddressesResponse) Reset()         { *m = MsgLinkAddressesRespon
 func (m *MsgLinkAddressesResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgLinkAddressesResponse) ProtoMessage()    {}
 func (*MsgLinkAddressesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_40a6837f542f43b8, []int{14}
+	return fileDescriptor_40a6837f542f43b8, []int{18}
 }
 func (m *MsgLinkAddressesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -914,143 +1182,346 @@ func (m *MsgLinkAddressesResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgLinkAddressesResponse proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*MsgClaimDaily)(nil), "stride.airdrop.MsgClaimDaily")
-	proto.RegisterType((*MsgClaimDailyResponse)(nil), "stride.airdrop.MsgClaimDailyResponse")
-	proto.RegisterType((*MsgClaimEarly)(nil), "stride.airdrop.MsgClaimEarly")
-	proto.RegisterType((*MsgClaimEarlyResponse)(nil), "stride.airdrop.MsgClaimEarlyResponse")
-	proto.RegisterType((*MsgCreateAirdrop)(nil), "stride.airdrop.MsgCreateAirdrop")
-	proto.RegisterType((*MsgCreateAirdropResponse)(nil), "stride.airdrop.MsgCreateAirdropResponse")
-	proto.RegisterType((*MsgUpdateAirdrop)(nil), "stride.airdrop.MsgUpdateAirdrop")
-	proto.RegisterType((*MsgUpdateAirdropResponse)(nil), "stride.airdrop.MsgUpdateAirdropResponse")
-	proto.RegisterType((*RawAllocation)(nil), "stride.airdrop.RawAllocation")
-	proto.RegisterType((*MsgAddAllocations)(nil), "stride.airdrop.MsgAddAllocations")
-	proto.RegisterType((*MsgAddAllocationsResponse)(nil), "stride.airdrop.MsgAddAllocationsResponse")
-	proto.RegisterType((*MsgUpdateUserAllocation)(nil), "stride.airdrop.MsgUpdateUserAllocation")
-	proto.RegisterType((*MsgUpdateUserAllocationResponse)(nil), "stride.airdrop.MsgUpdateUserAllocationResponse")
-	proto.RegisterType((*MsgLinkAddresses)(nil), "stride.airdrop.MsgLinkAddresses")
-	proto.RegisterType((*MsgLinkAddressesResponse)(nil), "stride.airdrop.MsgLinkAddressesResponse")
+// Proof of a user's allocations against an airdrop's allocations merkle root
+type AllocationProof struct {
+	// The user's allocations (i.e. the leaf of the merkle tree)
+	Allocations []cosmossdk_io_math.Int `protobuf:"bytes,1,rep,name=allocations,proto3,customtype=cosmossdk.io/math.Int" json:"allocations"`
+	// Hex-encoded sibling hashes from the leaf up to the root
+	Proof []string `protobuf:"bytes,2,rep,name=proof,proto3" json:"proof,omitempty"`
 }
 
-func init() { proto.RegisterFile("stride/airdrop/tx.proto", fileDescriptor_40a6837f542f43b8) }
-
-var fileDescriptor_40a6837f542f43b8 = []byte{
-	// 973 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x57, 0xbf, 0x6f, 0xdb, 0xc6,
-	0x17, 0x37, 0x23, 0xd9, 0xf9, 0xfa, 0x64, 0x19, 0x31, 0x63, 0x43, 0xb4, 0x02, 0x4b, 0xb2, 0xbe,
-	0x48, 0xa3, 0x06, 0x08, 0x89, 0xa8, 0x9d, 0x9c, 0xa1, 0xb0, 0x2a, 0x0d, 0x46, 0x6d, 0xa0, 0xa0,
-	0x1d, 0xa0, 0x3f, 0x80, 0x12, 0x27, 0xdd, 0x95, 0x3e, 0x98, 0xe4, 0x11, 0xbc, 0x73, 0x1c, 0xad,
-	0x1d, 0x33, 0x65, 0xca, 0xbf, 0xd0, 0x35, 0x43, 0xff, 0x88, 0x8c, 0x41, 0xbb, 0x14, 0x1d, 0xd2,
-	0xc2, 0x1e, 0xb2, 0xf5, 0x6f, 0x28, 0x78, 0x47, 0x52, 0x3c, 0x9b, 0x2e, 0x35, 0x18, 0xe8, 0xe2,
-	0xc5, 0x36, 0xdf, 0xfb, 0xbc, 0xcf, 0xbd, 0x7b, 0xef, 0x7d, 0xe8, 0x47, 0xd0, 0x60, 0x3c, 0x22,
-	0x08, 0x5b, 0x90, 0x44, 0x28, 0xa2, 0xa1, 0xc5, 0x5f, 0x9a, 0x61, 0x44, 0x39, 0xd5, 0x57, 0xa5,
-	0xc3, 0x4c, 0x1c, 0xcd, 0xc6, 0x84, 0x32, 0x9f, 0x32, 0xcb, 0x67, 0xae, 0xf5, 0xe2, 0x69, 0xfc,
-	0x4b, 0x02, 0x9b, 0x6b, 0xd0, 0x27, 0x01, 0xb5, 0xc4, 0xcf, 0xc4, 0xb4, 0x29, 0xb1, 0x8e, 0x78,
-	0xb2, 0xe4, 0x43, 0xe2, 0x5a, 0x77, 0xa9, 0x4b, 0xa5, 0x3d, 0xfe, 0x2b, 0xb1, 0xb6, 0x5d, 0x4a,
-	0x5d, 0x0f, 0x5b, 0xe2, 0x69, 0x7c, 0xfa, 0xa3, 0xc5, 0x89, 0x8f, 0x19, 0x87, 0x7e, 0x28, 0x01,
-	0xdd, 0x57, 0x1a, 0xa8, 0x1f, 0x30, 0xf7, 0x4b, 0x0f, 0x12, 0x7f, 0x08, 0x89, 0x37, 0xd5, 0xfb,
-	0xe0, 0xee, 0x24, 0x7e, 0xc2, 0x91, 0xa1, 0x75, 0xb4, 0xde, 0xf2, 0xc0, 0xf8, 0xf5, 0x97, 0x27,
-	0xeb, 0xc9, 0x59, 0xbb, 0x08, 0x45, 0x98, 0xb1, 0x43, 0x1e, 0x91, 0xc0, 0xb5, 0x53, 0xa0, 0xbe,
-	0x05, 0x40, 0x72, 0x1d, 0x87, 0x20, 0xe3, 0x4e, 0x1c, 0x66, 0x2f, 0x27, 0x96, 0x3d, 0xb4, 0xf3,
-	0xc9, 0x4f, 0x1f, 0xdf, 0x3e, 0x4e, 0xc1, 0xaf, 0x3e, 0xbe, 0x7d, 0xbc, 0x91, 0x56, 0x45, 0x39,
-	0xba, 0xdb, 0x00, 0x1b, 0x8a, 0xc1, 0xc6, 0x2c, 0xa4, 0x01, 0xc3, 0x4a, 0x96, 0x23, 0x18, 0xfd,
-	0x57, 0x59, 0x8a, 0xa3, 0xf3, 0x59, 0x0a, 0x43, 0x96, 0xe5, 0xcf, 0x4b, 0xe0, 0x5e, 0xec, 0x89,
-	0x30, 0xe4, 0x78, 0x57, 0xc6, 0xea, 0x26, 0x58, 0x84, 0xc8, 0x27, 0x41, 0x69, 0x9a, 0x12, 0x56,
-	0x92, 0xa4, 0xbe, 0x0d, 0x56, 0x22, 0x7c, 0x06, 0x23, 0xe4, 0x20, 0x1c, 0x50, 0xdf, 0xa8, 0x08,
-	0x40, 0x4d, 0xda, 0x86, 0xb1, 0x49, 0xff, 0x06, 0x34, 0x10, 0x89, 0x87, 0x6c, 0x7c, 0xca, 0x09,
-	0x0d, 0x1c, 0xc6, 0x61, 0xc4, 0x1d, 0x04, 0x39, 0x36, 0xaa, 0x1d, 0xad, 0x57, 0xeb, 0x37, 0x4d,
-	0x39, 0x15, 0x66, 0x3a, 0x15, 0xe6, 0x51, 0x3a, 0x15, 0x83, 0xea, 0xeb, 0x3f, 0xdb, 0x9a, 0xbd,
-	0x91, 0x27, 0x38, 0x8c, 0xe3, 0x87, 0x90, 0x63, 0xfd, 0x08, 0x28, 0x0e, 0x07, 0x07, 0x48, 0xf2,
-	0x2e, 0xce, 0xc9, 0x7b, 0x3f, 0x1f, 0x3e, 0x0a, 0x90, 0x60, 0x1d, 0x81, 0xfa, 0xc4, 0x83, 0x67,
-	0x63, 0x38, 0x39, 0x91, 0x6c, 0x4b, 0x73, 0xb2, 0xad, 0xa4, 0x61, 0x82, 0xe6, 0x5b, 0x60, 0x88,
-	0xde, 0x39, 0x7c, 0x1a, 0x62, 0x07, 0x61, 0x88, 0x3c, 0x12, 0x60, 0xc9, 0x78, 0x77, 0xde, 0x7b,
-	0x0b, 0x86, 0xa3, 0x69, 0x88, 0x87, 0x49, 0xbc, 0xa0, 0x3e, 0x04, 0xf7, 0x71, 0xdc, 0x69, 0x47,
-	0x1e, 0x10, 0xe2, 0x00, 0x7a, 0x7c, 0x6a, 0xfc, 0x4f, 0x74, 0xf4, 0xff, 0xef, 0x3e, 0xb4, 0x17,
-	0xfe, 0xf8, 0xd0, 0x7e, 0x20, 0xbb, 0xca, 0xd0, 0x89, 0x49, 0xa8, 0xe5, 0x43, 0x7e, 0x6c, 0xee,
-	0x63, 0x17, 0x4e, 0xa6, 0x43, 0x3c, 0xb1, 0xd7, 0x44, 0xbc, 0x98, 0x99, 0xaf, 0x65, 0xb4, 0xbe,
-	0x07, 0x66, 0xd5, 0xa0, 0x91, 0x03, 0xe5, 0x30, 0x18, 0xcb, 0x25, 0x63, 0xa2, 0xe7, 0x82, 0x12,
-	0x8f, 0x3e, 0x02, 0x6b, 0xd0, 0xf3, 0xe8, 0x04, 0xe6, 0x89, 0x40, 0x09, 0xd1, 0xbd, 0x2c, 0x24,
-	0xa5, 0xf9, 0x02, 0xac, 0x7a, 0x24, 0x38, 0xc1, 0x33, 0x8e, 0x5a, 0x09, 0x47, 0x5d, 0xe2, 0x13,
-	0xe3, 0xce, 0xa3, 0x58, 0x41, 0x72, 0x8e, 0x63, 0xfd, 0x18, 0x79, 0xfd, 0xe4, 0x45, 0xd1, 0x6d,
-	0x02, 0xe3, 0xb2, 0xed, 0xb2, 0x8a, 0x9e, 0x87, 0xe8, 0x56, 0x45, 0xb7, 0x2a, 0xba, 0x55, 0x51,
-	0x5e, 0x45, 0x8a, 0x28, 0x12, 0x15, 0x29, 0xb6, 0x4c, 0x45, 0x0c, 0xd4, 0x6d, 0x78, 0xb6, 0x2b,
-	0x93, 0x23, 0x34, 0x88, 0x47, 0xfe, 0x94, 0xe5, 0x92, 0xd2, 0xe4, 0xc8, 0xc7, 0xb6, 0x59, 0xe6,
-	0x35, 0x98, 0x05, 0x30, 0xa3, 0xda, 0xa9, 0xf4, 0x96, 0x07, 0x5b, 0x49, 0x63, 0x36, 0xae, 0x36,
-	0x66, 0x2f, 0xe0, 0x76, 0x3e, 0xa2, 0xfb, 0x9b, 0x06, 0xd6, 0x0e, 0x98, 0xbb, 0x8b, 0xd0, 0xec,
-	0x60, 0x76, 0xd3, 0xda, 0x1d, 0xa9, 0x59, 0x56, 0x3a, 0x95, 0x5e, 0xad, 0xbf, 0x65, 0xaa, 0x5b,
-	0x95, 0xa9, 0x5c, 0x7e, 0x50, 0x8d, 0x2f, 0xa1, 0xe4, 0xba, 0xd3, 0x53, 0xab, 0xbc, 0x99, 0xab,
-	0xb2, 0x9a, 0x7f, 0xf7, 0x01, 0xd8, 0xbc, 0x62, 0xcc, 0xea, 0xfc, 0xe6, 0x0e, 0x68, 0x64, 0x4d,
-	0x78, 0x1e, 0x17, 0x73, 0x56, 0xf2, 0x1b, 0xbe, 0xf8, 0xb3, 0x4b, 0x1d, 0xac, 0x94, 0xb0, 0xde,
-	0x68, 0x6f, 0x77, 0x4c, 0xb5, 0x5e, 0xed, 0x2b, 0x53, 0xa9, 0x5e, 0xbe, 0xbb, 0x0d, 0xda, 0xd7,
-	0xb8, 0xb2, 0xda, 0xfd, 0xad, 0x89, 0x37, 0xfd, 0x3e, 0x09, 0x4e, 0x92, 0x34, 0xf1, 0x8d, 0x4f,
-	0xcb, 0x43, 0x90, 0xec, 0xdb, 0x6a, 0xd9, 0xec, 0xba, 0xb4, 0xa6, 0xe5, 0x79, 0x06, 0x56, 0x8e,
-	0x29, 0xe3, 0x19, 0xa8, 0x5a, 0x56, 0xdb, 0x18, 0x3d, 0x87, 0x60, 0x95, 0xbb, 0x25, 0x82, 0x55,
-	0x6c, 0x69, 0x31, 0xfa, 0x6f, 0x16, 0x41, 0xe5, 0x80, 0xb9, 0xba, 0x0d, 0x40, 0x6e, 0x19, 0xbf,
-	0x32, 0xd7, 0xca, 0x7e, 0xdc, 0x7c, 0xf8, 0xaf, 0xee, 0x94, 0x3b, 0xe3, 0x94, 0xab, 0xf3, 0xb5,
-	0x9c, 0xc2, 0x7d, 0x3d, 0xa7, 0xb2, 0xec, 0xea, 0xdf, 0x83, 0xba, 0xba, 0xe8, 0x76, 0x8a, 0xe2,
-	0xf2, 0x88, 0x66, 0xaf, 0x0c, 0x91, 0x27, 0x57, 0xff, 0xff, 0x17, 0x91, 0x2b, 0x88, 0x42, 0xf2,
-	0xc2, 0x57, 0xa3, 0xfe, 0x03, 0x58, 0xbd, 0xf4, 0x86, 0xda, 0x2e, 0x88, 0x55, 0x21, 0xcd, 0x4f,
-	0x4b, 0x21, 0x19, 0x7f, 0x08, 0xd6, 0x0b, 0x5f, 0x07, 0x8f, 0xae, 0xcd, 0x50, 0x05, 0x36, 0xad,
-	0x39, 0x81, 0xf9, 0x72, 0xa9, 0x22, 0x2a, 0x2a, 0x97, 0x82, 0x28, 0x2c, 0x57, 0xe1, 0x60, 0x0e,
-	0xbe, 0x7a, 0x77, 0xde, 0xd2, 0xde, 0x9f, 0xb7, 0xb4, 0xbf, 0xce, 0x5b, 0xda, 0xeb, 0x8b, 0xd6,
-	0xc2, 0xfb, 0x8b, 0xd6, 0xc2, 0xef, 0x17, 0xad, 0x85, 0xef, 0x9e, 0xba, 0x84, 0x1f, 0x9f, 0x8e,
-	0xcd, 0x09, 0xf5, 0xad, 0x43, 0xc1, 0xf6, 0x64, 0x1f, 0x8e, 0x99, 0x95, 0x7c, 0xf9, 0xbe, 0xe8,
-	0x7f, 0x6e, 0xbd, 0x9c, 0x7d, 0xff, 0x4e, 0x43, 0xcc, 0xc6, 0x4b, 0x62, 0x69, 0xf8, 0xec, 0x9f,
-	0x00, 0x00, 0x00, 0xff, 0xff, 0x36, 0xaf, 0xbd, 0x14, 0x1e, 0x0f, 0x00, 0x00,
+func (m *AllocationProof) Reset()         { *m = AllocationProof{} }
+func (m *AllocationProof) String() string { return proto.CompactTextString(m) }
+func (*AllocationProof) ProtoMessage()    {}
+func (*AllocationProof) Descriptor() ([]byte, []int) {
+	return fileDescriptor_40a6837f542f43b8, []int{19}
 }
-
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// MsgClient is the client API for Msg service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type MsgClient interface {
-	// User transaction to claim all the pending daily airdrop rewards
-	ClaimDaily(ctx context.Context, in *MsgClaimDaily, opts ...grpc.CallOption) (*MsgClaimDailyResponse, error)
-	// User transaction to claim a portion of their total amount now, and forfeit
-	// the remainder to be clawed back
-	ClaimEarly(ctx context.Context, in *MsgClaimEarly, opts ...grpc.CallOption) (*MsgClaimEarlyResponse, error)
-	// Admin transaction to create a new airdrop
-	CreateAirdrop(ctx context.Context, in *MsgCreateAirdrop, opts ...grpc.CallOption) (*MsgCreateAirdropResponse, error)
-	// Admin transaction to update an existing airdrop
-	UpdateAirdrop(ctx context.Context, in *MsgUpdateAirdrop, opts ...grpc.CallOption) (*MsgUpdateAirdropResponse, error)
-	// Admin transaction to add multiple user allocations for a given airdrop
-	AddAllocations(ctx context.Context, in *MsgAddAllocations, opts ...grpc.CallOption) (*MsgAddAllocationsResponse, error)
-	// Admin transaction to update a user's allocation to an airdrop
-	UpdateUserAllocation(ctx context.Context, in *MsgUpdateUserAllocation, opts ...grpc.CallOption) (*MsgUpdateUserAllocationResponse, error)
-	// Admin address to link a stride and non-stride address, merging their
-	// allocations
-	LinkAddresses(ctx context.Context, in *MsgLinkAddresses, opts ...grpc.CallOption) (*MsgLinkAddressesResponse, error)
+func (m *AllocationProof) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-type msgClient struct {
-	cc grpc1.ClientConn
+func (m *AllocationProof) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AllocationProof.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-
-func NewMsgClient(cc grpc1.ClientConn) MsgClient {
-	return &msgClient{cc}
+func (m *AllocationProof) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AllocationProof.Merge(m, src)
+}
+func (m *AllocationProof) XXX_Size() int {
+	return m.Size()
+}
+func (m *AllocationProof) XXX_DiscardUnknown() {
+	xxx_messageInfo_AllocationProof.DiscardUnknown(m)
 }
 
-func (c *msgClient) ClaimDaily(ctx context.Context, in *MsgClaimDaily, opts ...grpc.CallOption) (*MsgClaimDailyResponse, error) {
-	out := new(MsgClaimDailyResponse)
-	err := c.cc.Invoke(ctx, "/stride.airdrop.Msg/ClaimDaily", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_AllocationProof proto.InternalMessageInfo
+
+func (m *AllocationProof) GetProof() []string {
+	if m != nil {
+		return m.Proof
 	}
-	return out, nil
+	return nil
 }
 
-func (c *msgClient) ClaimEarly(ctx context.Context, in *MsgClaimEarly, opts ...grpc.CallOption) (*MsgClaimEarlyResponse, error) {
-	out := new(MsgClaimEarlyResponse)
-	err := c.cc.Invoke(ctx, "/stride.airdrop.Msg/ClaimEarly", in, out, opts...)
-	if err != nil {
-		return nil, err
+// SetAllocationsRoot
+type MsgSetAllocationsRoot struct {
+	// Airdrop admin address
+	Admin string `protobuf:"bytes,1,opt,name=admin,proto3" json:"admin,omitempty"`
+	// Airdrop ID
+	AirdropId string `protobuf:"bytes,2,opt,name=airdrop_id,json=airdropId,proto3" json:"airdrop_id,omitempty"`
+	// Hex-encoded merkle root of the user allocations
+	MerkleRoot string `protobuf:"bytes,3,opt,name=merkle_root,json=merkleRoot,proto3" json:"merkle_root,omitempty"`
+}
+
+func (m *MsgSetAllocationsRoot) Reset()         { *m = MsgSetAllocationsRoot{} }
+func (m *MsgSetAllocationsRoot) String() string { return proto.CompactTextString(m) }
+func (*MsgSetAllocationsRoot) ProtoMessage()    {}
+func (*MsgSetAllocationsRoot) Descriptor() ([]byte, []int) {
+	return fileDescriptor_40a6837f542f43b8, []int{20}
+}
+func (m *MsgSetAllocationsRoot) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetAllocationsRoot) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetAllocationsRoot.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetAllocationsRoot) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetAllocationsRoot.Merge(m, src)
+}
+func (m *MsgSetAllocationsRoot) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetAllocationsRoot) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetAllocationsRoot.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetAllocationsRoot proto.InternalMessageInfo
+
+func (m *MsgSetAllocationsRoot) GetAdmin() string {
+	if m != nil {
+		return m.Admin
+	}
+	return ""
+}
+
+func (m *MsgSetAllocationsRoot) GetAirdropId() string {
+	if m != nil {
+		return m.AirdropId
+	}
+	return ""
+}
+
+func (m *MsgSetAllocationsRoot) GetMerkleRoot() string {
+	if m != nil {
+		return m.MerkleRoot
+	}
+	return ""
+}
+
+type MsgSetAllocationsRootResponse struct {
+}
+
+func (m *MsgSetAllocationsRootResponse) Reset()         { *m = MsgSetAllocationsRootResponse{} }
+func (m *MsgSetAllocationsRootResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetAllocationsRootResponse) ProtoMessage()    {}
+func (*MsgSetAllocationsRootResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_40a6837f542f43b8, []int{21}
+}
+func (m *MsgSetAllocationsRootResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetAllocationsRootResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetAllocationsRootResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetAllocationsRootResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetAllocationsRootResponse.Merge(m, src)
+}
+func (m *MsgSetAllocationsRootResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetAllocationsRootResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetAllocationsRootResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetAllocationsRootResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*MsgClaimDaily)(nil), "stride.airdrop.MsgClaimDaily")
+	proto.RegisterType((*MsgClaimDailyResponse)(nil), "stride.airdrop.MsgClaimDailyResponse")
+	proto.RegisterType((*MsgClaimEarly)(nil), "stride.airdrop.MsgClaimEarly")
+	proto.RegisterType((*MsgClaimEarlyResponse)(nil), "stride.airdrop.MsgClaimEarlyResponse")
+	proto.RegisterType((*MsgClaimAndStake)(nil), "stride.airdrop.MsgClaimAndStake")
+	proto.RegisterType((*MsgClaimAndStakeResponse)(nil), "stride.airdrop.MsgClaimAndStakeResponse")
+	proto.RegisterType((*MsgClaimAndVest)(nil), "stride.airdrop.MsgClaimAndVest")
+	proto.RegisterType((*MsgClaimAndVestResponse)(nil), "stride.airdrop.MsgClaimAndVestResponse")
+	proto.RegisterType((*MsgCreateAirdrop)(nil), "stride.airdrop.MsgCreateAirdrop")
+	proto.RegisterType((*MsgCreateAirdropResponse)(nil), "stride.airdrop.MsgCreateAirdropResponse")
+	proto.RegisterType((*MsgUpdateAirdrop)(nil), "stride.airdrop.MsgUpdateAirdrop")
+	proto.RegisterType((*MsgUpdateAirdropResponse)(nil), "stride.airdrop.MsgUpdateAirdropResponse")
+	proto.RegisterType((*RawAllocation)(nil), "stride.airdrop.RawAllocation")
+	proto.RegisterType((*MsgAddAllocations)(nil), "stride.airdrop.MsgAddAllocations")
+	proto.RegisterType((*MsgAddAllocationsResponse)(nil), "stride.airdrop.MsgAddAllocationsResponse")
+	proto.RegisterType((*MsgUpdateUserAllocation)(nil), "stride.airdrop.MsgUpdateUserAllocation")
+	proto.RegisterType((*MsgUpdateUserAllocationResponse)(nil), "stride.airdrop.MsgUpdateUserAllocationResponse")
+	proto.RegisterType((*MsgLinkAddresses)(nil), "stride.airdrop.MsgLinkAddresses")
+	proto.RegisterType((*MsgLinkAddressesResponse)(nil), "stride.airdrop.MsgLinkAddressesResponse")
+	proto.RegisterType((*AllocationProof)(nil), "stride.airdrop.AllocationProof")
+	proto.RegisterType((*MsgSetAllocationsRoot)(nil), "stride.airdrop.MsgSetAllocationsRoot")
+	proto.RegisterType((*MsgSetAllocationsRootResponse)(nil), "stride.airdrop.MsgSetAllocationsRootResponse")
+}
+
+func init() { proto.RegisterFile("stride/airdrop/tx.proto", fileDescriptor_40a6837f542f43b8) }
+
+var fileDescriptor_40a6837f542f43b8 = []byte{
+	// 1297 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x58, 0x41, 0x6f, 0xdb, 0x36,
+	0x1b, 0x8e, 0xe2, 0xa4, 0x69, 0xe8, 0x38, 0x69, 0xd4, 0x04, 0x56, 0xd4, 0x2f, 0xb6, 0xeb, 0x0f,
+	0x5d, 0xdd, 0x62, 0x95, 0x10, 0x6f, 0xa7, 0xf4, 0x50, 0xc4, 0x4d, 0x30, 0x74, 0x6b, 0x81, 0x42,
+	0x4e, 0x8b, 0x6e, 0x03, 0x26, 0xd0, 0x12, 0xeb, 0x68, 0xb6, 0x45, 0x43, 0xa4, 0xdb, 0xfa, 0xba,
+	0xe3, 0x4e, 0x3d, 0xed, 0x77, 0xf4, 0xb0, 0x1d, 0xb6, 0x5f, 0x90, 0x63, 0xb1, 0x01, 0xc3, 0x50,
+	0x0c, 0xdd, 0x90, 0x1c, 0xfa, 0x37, 0x06, 0x8a, 0x94, 0x2c, 0xca, 0x4e, 0xe4, 0x0d, 0x39, 0x74,
+	0x43, 0x2e, 0x71, 0xf4, 0xbe, 0xcf, 0xfb, 0x88, 0xcf, 0x43, 0xbe, 0x14, 0x25, 0x50, 0x24, 0x34,
+	0xf0, 0x5c, 0x64, 0x42, 0x2f, 0x70, 0x03, 0xdc, 0x37, 0xe9, 0x0b, 0xa3, 0x1f, 0x60, 0x8a, 0xd5,
+	0x65, 0x9e, 0x30, 0x44, 0x42, 0x2f, 0x3a, 0x98, 0xf4, 0x30, 0x31, 0x7b, 0xa4, 0x6d, 0x3e, 0xdb,
+	0x62, 0x3f, 0x1c, 0xa8, 0xaf, 0xc2, 0x9e, 0xe7, 0x63, 0x33, 0xfc, 0x2b, 0x42, 0x1b, 0x1c, 0x6b,
+	0x87, 0x57, 0x26, 0xbf, 0x10, 0xa9, 0x92, 0xa0, 0x69, 0x41, 0x82, 0xcc, 0x67, 0x5b, 0x2d, 0x44,
+	0xe1, 0x96, 0xe9, 0x60, 0xcf, 0x17, 0xf9, 0xb5, 0x36, 0x6e, 0x63, 0x5e, 0xc7, 0xfe, 0x13, 0xd1,
+	0x72, 0x1b, 0xe3, 0x76, 0x17, 0x99, 0xe1, 0x55, 0x6b, 0xf0, 0xd4, 0xa4, 0x5e, 0x0f, 0x11, 0x0a,
+	0x7b, 0x7d, 0x01, 0xf8, 0x5f, 0x4a, 0x86, 0xf8, 0xe5, 0xd9, 0xea, 0xaf, 0x0a, 0x28, 0x3c, 0x20,
+	0xed, 0xbb, 0x5d, 0xe8, 0xf5, 0x76, 0xa1, 0xd7, 0x1d, 0xaa, 0x75, 0xb0, 0xe0, 0xb0, 0x2b, 0x14,
+	0x68, 0x4a, 0x45, 0xa9, 0x2d, 0x36, 0xb4, 0x9f, 0xbf, 0xbf, 0xb5, 0x26, 0x46, 0xba, 0xe3, 0xba,
+	0x01, 0x22, 0xa4, 0x49, 0x03, 0xcf, 0x6f, 0x5b, 0x11, 0x50, 0xdd, 0x04, 0x40, 0xd0, 0xda, 0x9e,
+	0xab, 0xcd, 0xb2, 0x32, 0x6b, 0x51, 0x44, 0xee, 0xb9, 0xea, 0xa7, 0xe0, 0x12, 0xec, 0x76, 0xb1,
+	0x03, 0xa9, 0x87, 0x7d, 0x26, 0x1d, 0x3f, 0xd5, 0x72, 0x15, 0xa5, 0x96, 0xaf, 0x97, 0x0d, 0xd9,
+	0x4b, 0x63, 0x27, 0xc6, 0x3d, 0x64, 0x30, 0x6b, 0x05, 0xca, 0x81, 0xed, 0x0f, 0xbe, 0x79, 0xf7,
+	0xea, 0x66, 0x74, 0xe3, 0x6f, 0xdf, 0xbd, 0xba, 0xb9, 0x1e, 0x09, 0x93, 0x64, 0x54, 0x8b, 0x60,
+	0x5d, 0x0a, 0x58, 0x88, 0xf4, 0xb1, 0x4f, 0x90, 0xa4, 0x78, 0x0f, 0x06, 0xff, 0x05, 0xc5, 0xa1,
+	0x8c, 0xa4, 0xe2, 0x30, 0x10, 0x2b, 0xfe, 0x5d, 0x01, 0x97, 0xa2, 0xcc, 0x8e, 0xef, 0x36, 0x29,
+	0xec, 0xa0, 0xf7, 0x5d, 0xf4, 0x8d, 0xb4, 0x68, 0x2d, 0x2d, 0x3a, 0x52, 0x52, 0x7d, 0x0c, 0xb4,
+	0x74, 0x2c, 0x92, 0xae, 0x6e, 0x83, 0x8b, 0x84, 0xda, 0x14, 0x77, 0x90, 0x1f, 0xca, 0xcc, 0xd7,
+	0x37, 0x0c, 0xa1, 0x91, 0xb5, 0x99, 0x21, 0xda, 0xcc, 0xb8, 0x8b, 0x3d, 0xbf, 0x31, 0x77, 0xf8,
+	0xb6, 0x3c, 0x63, 0x2d, 0x10, 0xba, 0xcf, 0xf0, 0xd5, 0x37, 0x0a, 0x58, 0x49, 0x10, 0x3f, 0x46,
+	0x84, 0xbe, 0xef, 0xae, 0xd5, 0xd2, 0xae, 0x15, 0x27, 0xb8, 0xc6, 0x84, 0x54, 0x37, 0x40, 0x31,
+	0x15, 0x8a, 0x97, 0xcb, 0xe1, 0x02, 0x5f, 0x2e, 0x01, 0x82, 0x14, 0xed, 0xf0, 0x7a, 0xd5, 0x00,
+	0xf3, 0xd0, 0xed, 0x79, 0x7e, 0xa6, 0x6c, 0x0e, 0xcb, 0x12, 0x7d, 0x15, 0x2c, 0x05, 0xe8, 0x39,
+	0x0c, 0x5c, 0xdb, 0x45, 0x3e, 0xee, 0x85, 0x82, 0x17, 0xad, 0x3c, 0x8f, 0xed, 0xb2, 0x90, 0xfa,
+	0x04, 0x14, 0x5d, 0x8f, 0x19, 0xd0, 0x1a, 0x84, 0xce, 0x10, 0x0a, 0x03, 0x6a, 0xbb, 0x90, 0x22,
+	0x6d, 0x2e, 0xb4, 0x47, 0x37, 0xf8, 0xd6, 0x67, 0x44, 0x5b, 0x9f, 0xb1, 0x1f, 0x6d, 0x7d, 0x8d,
+	0xb9, 0x97, 0x7f, 0x94, 0x15, 0x6b, 0x3d, 0x49, 0xd0, 0x64, 0xf5, 0xbb, 0x90, 0x22, 0x75, 0x1f,
+	0x48, 0x09, 0x1b, 0xf9, 0x2e, 0xe7, 0x9d, 0x9f, 0x92, 0xf7, 0x72, 0xb2, 0x7c, 0xcf, 0x77, 0x43,
+	0xd6, 0x3d, 0x50, 0x70, 0xba, 0xf0, 0x79, 0x0b, 0x3a, 0x1d, 0xce, 0x76, 0x61, 0x4a, 0xb6, 0xa5,
+	0xa8, 0x2c, 0xa4, 0xf9, 0x1c, 0x68, 0xe1, 0xfc, 0xd9, 0x74, 0xd8, 0x47, 0xb6, 0x8b, 0xa0, 0xdb,
+	0xf5, 0x7c, 0xc4, 0x19, 0x17, 0xa6, 0xd5, 0x1d, 0x32, 0xec, 0x0f, 0xfb, 0x68, 0x57, 0xd4, 0x87,
+	0xd4, 0x4d, 0x70, 0x19, 0xb1, 0x8d, 0xc1, 0xe6, 0x37, 0xe8, 0x23, 0x1f, 0x76, 0xe9, 0x50, 0xbb,
+	0x18, 0xce, 0xe8, 0xff, 0xd9, 0xe2, 0x7f, 0xf3, 0xb6, 0x7c, 0x85, 0xcf, 0x2a, 0x71, 0x3b, 0x86,
+	0x87, 0xcd, 0x1e, 0xa4, 0x07, 0xc6, 0x7d, 0xd4, 0x86, 0xce, 0x70, 0x17, 0x39, 0xd6, 0x6a, 0x58,
+	0x1f, 0xae, 0x9a, 0x87, 0xbc, 0x5a, 0xbd, 0x07, 0x46, 0x6e, 0xe0, 0xc0, 0x86, 0x7c, 0x31, 0x68,
+	0x8b, 0x19, 0xcb, 0x44, 0x4d, 0x14, 0x89, 0x8c, 0xba, 0x07, 0x56, 0xc5, 0x82, 0x4e, 0x10, 0x81,
+	0x0c, 0xa2, 0x4b, 0x71, 0x49, 0x44, 0x73, 0x07, 0x2c, 0x77, 0x3d, 0xbf, 0x83, 0x46, 0x1c, 0xf9,
+	0x0c, 0x8e, 0x02, 0xc7, 0x47, 0x04, 0x8f, 0x01, 0x37, 0xd0, 0x86, 0xbe, 0xcb, 0x96, 0x5d, 0x07,
+	0xd9, 0x2d, 0xec, 0x0f, 0x88, 0xb6, 0x34, 0xbd, 0x53, 0xaa, 0x93, 0xdc, 0x8f, 0x1a, 0xac, 0x5c,
+	0xfd, 0x04, 0xac, 0x70, 0x5e, 0x07, 0xfb, 0xae, 0xc7, 0xd6, 0x8e, 0x56, 0xa8, 0x28, 0xb5, 0xe5,
+	0x7a, 0x29, 0xdd, 0xe8, 0xa1, 0xc3, 0x77, 0x23, 0x94, 0xb5, 0xec, 0x48, 0xd7, 0xdb, 0xd7, 0x59,
+	0x9b, 0xf3, 0x46, 0x1b, 0xdb, 0x1a, 0x93, 0x5d, 0x5b, 0xd5, 0x81, 0x96, 0x8e, 0xa5, 0xdb, 0xfc,
+	0x51, 0xdf, 0x3d, 0x6f, 0xf3, 0xf3, 0x36, 0x3f, 0x6f, 0xf3, 0x7f, 0x55, 0x9b, 0x4b, 0x5d, 0x2b,
+	0xda, 0x5c, 0x8a, 0xc5, 0x6d, 0x4e, 0x40, 0xc1, 0x82, 0xcf, 0x47, 0x27, 0x07, 0xd6, 0x93, 0x03,
+	0x92, 0x70, 0x4d, 0xe1, 0x3d, 0x39, 0x20, 0x23, 0x67, 0xee, 0x80, 0xfc, 0xe8, 0x64, 0x41, 0xb4,
+	0xb9, 0x4a, 0xae, 0xb6, 0xd8, 0xd8, 0x14, 0x7e, 0xac, 0x8f, 0xfb, 0x71, 0xcf, 0xa7, 0x56, 0xb2,
+	0xa2, 0xfa, 0x8b, 0x02, 0x56, 0x1f, 0x90, 0xf6, 0x8e, 0xeb, 0x8e, 0x6e, 0x4c, 0xce, 0x7a, 0x73,
+	0xd9, 0x93, 0x47, 0x99, 0xab, 0xe4, 0x6a, 0xf9, 0xfa, 0x66, 0xda, 0x63, 0x49, 0xbc, 0x38, 0xe2,
+	0x25, 0xeb, 0xf8, 0x99, 0x69, 0xe4, 0xf2, 0x46, 0xc2, 0x65, 0x79, 0xfc, 0xd5, 0x2b, 0x60, 0x63,
+	0x2c, 0x18, 0xfb, 0xfc, 0xdd, 0x2c, 0x28, 0xc6, 0x93, 0xf0, 0x88, 0x99, 0x39, 0xb2, 0xfc, 0x8c,
+	0x85, 0xdf, 0x4e, 0xcd, 0x60, 0x2e, 0x83, 0xf5, 0x4c, 0xe7, 0x76, 0xdb, 0x90, 0xfd, 0x2a, 0x8f,
+	0xad, 0x4a, 0x59, 0x7c, 0xf5, 0x2a, 0x28, 0x9f, 0x90, 0x8a, 0xbd, 0xfb, 0x69, 0x36, 0x7c, 0x14,
+	0xdd, 0xf7, 0xfc, 0x8e, 0x18, 0x26, 0x3a, 0xf3, 0xd5, 0x72, 0x0d, 0x88, 0xd7, 0x76, 0xd9, 0x36,
+	0xab, 0xc0, 0xa3, 0x91, 0x3d, 0xb7, 0xc1, 0xd2, 0x01, 0x26, 0x34, 0x06, 0xcd, 0x65, 0x79, 0xcb,
+	0xd0, 0x51, 0xf1, 0xa4, 0xa3, 0xfc, 0xfc, 0x3f, 0x3c, 0xca, 0x9f, 0xd2, 0xfc, 0x92, 0x4f, 0xa2,
+	0xf9, 0xa5, 0x58, 0x6c, 0xec, 0x01, 0x58, 0x49, 0xdd, 0x28, 0x3d, 0xff, 0xca, 0xdf, 0x9d, 0x7f,
+	0x75, 0x0d, 0xcc, 0x73, 0x65, 0xb3, 0xac, 0xd4, 0xe2, 0x17, 0xd5, 0x1f, 0x94, 0xf0, 0xed, 0xb3,
+	0x89, 0x68, 0xb2, 0x39, 0x30, 0xa6, 0x67, 0x3d, 0x8f, 0x65, 0x90, 0xef, 0xa1, 0xa0, 0xd3, 0x45,
+	0x76, 0x80, 0x31, 0x15, 0x93, 0x08, 0x78, 0x88, 0xdd, 0x6f, 0xfb, 0x43, 0xd9, 0xb8, 0xcd, 0x84,
+	0x71, 0xe3, 0xa3, 0xab, 0x96, 0xc1, 0xe6, 0xc4, 0x44, 0x64, 0x61, 0xfd, 0xc7, 0x05, 0x90, 0x7b,
+	0x40, 0xda, 0xaa, 0x05, 0x40, 0xe2, 0x23, 0xc9, 0xd8, 0x36, 0x23, 0x7d, 0x6b, 0xd0, 0xaf, 0x9d,
+	0x9a, 0x8e, 0xdf, 0x4e, 0x23, 0x4e, 0xfe, 0x19, 0xe2, 0x44, 0xce, 0x30, 0xad, 0x5f, 0x3b, 0x35,
+	0x1d, 0x73, 0x7e, 0x09, 0x0a, 0xf2, 0x8b, 0x7e, 0xe5, 0xa4, 0xba, 0x08, 0xa1, 0xd7, 0xb2, 0x10,
+	0x31, 0xf9, 0x13, 0xb0, 0x24, 0xbd, 0x0e, 0x97, 0x4f, 0xa9, 0x64, 0x00, 0xfd, 0x7a, 0x06, 0x40,
+	0x1a, 0xb6, 0xf4, 0xc2, 0x39, 0x71, 0xd8, 0x49, 0x84, 0x5e, 0xcb, 0x42, 0x24, 0xc9, 0xe5, 0x63,
+	0xee, 0x24, 0x72, 0x09, 0xa1, 0xd7, 0xb2, 0x10, 0x31, 0xf9, 0x57, 0x60, 0x39, 0xf5, 0x9c, 0xbb,
+	0x3a, 0xa1, 0x56, 0x86, 0xe8, 0x37, 0x32, 0x21, 0x31, 0x7f, 0x1f, 0xac, 0x4d, 0x7c, 0xa8, 0x5c,
+	0x3f, 0x71, 0x84, 0x32, 0x50, 0x37, 0xa7, 0x04, 0x26, 0xed, 0x92, 0xb7, 0xe2, 0x49, 0x76, 0x49,
+	0x08, 0xbd, 0x96, 0x85, 0x88, 0xc9, 0xbf, 0x06, 0xea, 0x84, 0x4d, 0x62, 0xd2, 0xe2, 0x1e, 0x87,
+	0xe9, 0xb7, 0xa6, 0x82, 0x45, 0xf7, 0x6a, 0x7c, 0x76, 0x78, 0x54, 0x52, 0x5e, 0x1f, 0x95, 0x94,
+	0x3f, 0x8f, 0x4a, 0xca, 0xcb, 0xe3, 0xd2, 0xcc, 0xeb, 0xe3, 0xd2, 0xcc, 0x6f, 0xc7, 0xa5, 0x99,
+	0x2f, 0xb6, 0xda, 0x1e, 0x3d, 0x18, 0xb4, 0x0c, 0x07, 0xf7, 0xcc, 0x66, 0x48, 0x79, 0xeb, 0x3e,
+	0x6c, 0x11, 0x53, 0x7c, 0x2b, 0x7d, 0x56, 0xff, 0xd8, 0x7c, 0x31, 0xfa, 0xf0, 0x3b, 0xec, 0x23,
+	0xd2, 0xba, 0x10, 0x9e, 0xc3, 0x3f, 0xfa, 0x6b, 0x00, 0x19, 0x87, 0x41, 0x0d, 0x17, 0x16, 0x00,
+	0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// User transaction to claim all the pending daily airdrop rewards
+	ClaimDaily(ctx context.Context, in *MsgClaimDaily, opts ...grpc.CallOption) (*MsgClaimDailyResponse, error)
+	// User transaction to claim a portion of their total amount now, and forfeit
+	// the remainder to be clawed back
+	ClaimEarly(ctx context.Context, in *MsgClaimEarly, opts ...grpc.CallOption) (*MsgClaimEarlyResponse, error)
+	// User transaction to claim all the pending daily airdrop rewards and liquid
+	// stake them (along with the airdrop's staking bonus)
+	ClaimAndStake(ctx context.Context, in *MsgClaimAndStake, opts ...grpc.CallOption) (*MsgClaimAndStakeResponse, error)
+	// User transaction to claim their full remaining rewards without a penalty,
+	// into a vesting account that unlocks in line with their daily allocations
+	ClaimAndVest(ctx context.Context, in *MsgClaimAndVest, opts ...grpc.CallOption) (*MsgClaimAndVestResponse, error)
+	// Admin transaction to create a new airdrop
+	CreateAirdrop(ctx context.Context, in *MsgCreateAirdrop, opts ...grpc.CallOption) (*MsgCreateAirdropResponse, error)
+	// Admin transaction to update an existing airdrop
+	UpdateAirdrop(ctx context.Context, in *MsgUpdateAirdrop, opts ...grpc.CallOption) (*MsgUpdateAirdropResponse, error)
+	// Admin transaction to add multiple user allocations for a given airdrop
+	AddAllocations(ctx context.Context, in *MsgAddAllocations, opts ...grpc.CallOption) (*MsgAddAllocationsResponse, error)
+	// Admin transaction to update a user's allocation to an airdrop
+	UpdateUserAllocation(ctx context.Context, in *MsgUpdateUserAllocation, opts ...grpc.CallOption) (*MsgUpdateUserAllocationResponse, error)
+	// Admin address to link a stride and non-stride address, merging their
+	// allocations
+	LinkAddresses(ctx context.Context, in *MsgLinkAddresses, opts ...grpc.CallOption) (*MsgLinkAddressesResponse, error)
+	// Admin transaction to commit to the user allocations of an airdrop with a
+	// merkle root, instead of adding each allocation on-chain
+	SetAllocationsRoot(ctx context.Context, in *MsgSetAllocationsRoot, opts ...grpc.CallOption) (*MsgSetAllocationsRootResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) ClaimDaily(ctx context.Context, in *MsgClaimDaily, opts ...grpc.CallOption) (*MsgClaimDailyResponse, error) {
+	out := new(MsgClaimDailyResponse)
+	err := c.cc.Invoke(ctx, "/stride.airdrop.Msg/ClaimDaily", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ClaimEarly(ctx context.Context, in *MsgClaimEarly, opts ...grpc.CallOption) (*MsgClaimEarlyResponse, error) {
+	out := new(MsgClaimEarlyResponse)
+	err := c.cc.Invoke(ctx, "/stride.airdrop.Msg/ClaimEarly", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ClaimAndStake(ctx context.Context, in *MsgClaimAndStake, opts ...grpc.CallOption) (*MsgClaimAndStakeResponse, error) {
+	out := new(MsgClaimAndStakeResponse)
+	err := c.cc.Invoke(ctx, "/stride.airdrop.Msg/ClaimAndStake", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ClaimAndVest(ctx context.Context, in *MsgClaimAndVest, opts ...grpc.CallOption) (*MsgClaimAndVestResponse, error) {
+	out := new(MsgClaimAndVestResponse)
+	err := c.cc.Invoke(ctx, "/stride.airdrop.Msg/ClaimAndVest", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
 	return out, nil
 }
@@ -1100,6 +1571,15 @@ func (c *msgClient) LinkAddresses(ctx context.Context, in *MsgLinkAddresses, opt
 	return out, nil
 }
 
+func (c *msgClient) SetAllocationsRoot(ctx context.Context, in *MsgSetAllocationsRoot, opts ...grpc.CallOption) (*MsgSetAllocationsRootResponse, error) {
+	out := new(MsgSetAllocationsRootResponse)
+	err := c.cc.Invoke(ctx, "/stride.airdrop.Msg/SetAllocationsRoot", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// User transaction to claim all the pending daily airdrop rewards
@@ -1107,6 +1587,12 @@ type MsgServer interface {
 	// User transaction to claim a portion of their total amount now, and forfeit
 	// the remainder to be clawed back
 	ClaimEarly(context.Context, *MsgClaimEarly) (*MsgClaimEarlyResponse, error)
+	// User transaction to claim all the pending daily airdrop rewards and liquid
+	// stake them (along with the airdrop's staking bonus)
+	ClaimAndStake(context.Context, *MsgClaimAndStake) (*MsgClaimAndStakeResponse, error)
+	// User transaction to claim their full remaining rewards without a penalty,
+	// into a vesting account that unlocks in line with their daily allocations
+	ClaimAndVest(context.Context, *MsgClaimAndVest) (*MsgClaimAndVestResponse, error)
 	// Admin transaction to create a new airdrop
 	CreateAirdrop(context.Context, *MsgCreateAirdrop) (*MsgCreateAirdropResponse, error)
 	// Admin transaction to update an existing airdrop
@@ -1118,6 +1604,9 @@ type MsgServer interface {
 	// Admin address to link a stride and non-stride address, merging their
 	// allocations
 	LinkAddresses(context.Context, *MsgLinkAddresses) (*MsgLinkAddressesResponse, error)
+	// Admin transaction to commit to the user allocations of an airdrop with a
+	// merkle root, instead of adding each allocation on-chain
+	SetAllocationsRoot(context.Context, *MsgSetAllocationsRoot) (*MsgSetAllocationsRootResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -1130,6 +1619,12 @@ func (*UnimplementedMsgServer) ClaimDaily(ctx context.Context, req *MsgClaimDail
 func (*UnimplementedMsgServer) ClaimEarly(ctx context.Context, req *MsgClaimEarly) (*MsgClaimEarlyResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ClaimEarly not implemented")
 }
+func (*UnimplementedMsgServer) ClaimAndStake(ctx context.Context, req *MsgClaimAndStake) (*MsgClaimAndStakeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClaimAndStake not implemented")
+}
+func (*UnimplementedMsgServer) ClaimAndVest(ctx context.Context, req *MsgClaimAndVest) (*MsgClaimAndVestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClaimAndVest not implemented")
+}
 func (*UnimplementedMsgServer) CreateAirdrop(ctx context.Context, req *MsgCreateAirdrop) (*MsgCreateAirdropResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateAirdrop not implemented")
 }
@@ -1145,6 +1640,9 @@ func (*UnimplementedMsgServer) UpdateUserAllocation(ctx context.Context, req *Ms
 func (*UnimplementedMsgServer) LinkAddresses(ctx context.Context, req *MsgLinkAddresses) (*MsgLinkAddressesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method LinkAddresses not implemented")
 }
+func (*UnimplementedMsgServer) SetAllocationsRoot(ctx context.Context, req *MsgSetAllocationsRoot) (*MsgSetAllocationsRootResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetAllocationsRoot not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -1186,6 +1684,42 @@ func _Msg_ClaimEarly_Handler(srv interface{}, ctx context.Context, dec func(inte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_ClaimAndStake_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgClaimAndStake)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ClaimAndStake(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stride.airdrop.Msg/ClaimAndStake",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ClaimAndStake(ctx, req.(*MsgClaimAndStake))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ClaimAndVest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgClaimAndVest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ClaimAndVest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stride.airdrop.Msg/ClaimAndVest",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ClaimAndVest(ctx, req.(*MsgClaimAndVest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_CreateAirdrop_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgCreateAirdrop)
 	if err := dec(in); err != nil {
@@ -1276,6 +1810,24 @@ func _Msg_LinkAddresses_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_SetAllocationsRoot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetAllocationsRoot)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetAllocationsRoot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stride.airdrop.Msg/SetAllocationsRoot",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetAllocationsRoot(ctx, req.(*MsgSetAllocationsRoot))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "stride.airdrop.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -1288,6 +1840,14 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ClaimEarly",
 			Handler:    _Msg_ClaimEarly_Handler,
 		},
+		{
+			MethodName: "ClaimAndStake",
+			Handler:    _Msg_ClaimAndStake_Handler,
+		},
+		{
+			MethodName: "ClaimAndVest",
+			Handler:    _Msg_ClaimAndVest_Handler,
+		},
 		{
 			MethodName: "CreateAirdrop",
 			Handler:    _Msg_CreateAirdrop_Handler,
@@ -1308,6 +1868,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "LinkAddresses",
 			Handler:    _Msg_LinkAddresses_Handler,
 		},
+		{
+			MethodName: "SetAllocationsRoot",
+			Handler:    _Msg_SetAllocationsRoot_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "stride/airdrop/tx.proto",
@@ -1333,6 +1897,18 @@ func (m *MsgClaimDaily) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.AllocationProof != nil {
+		{
+			size, err := m.AllocationProof.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
 	if len(m.AirdropId) > 0 {
 		i -= len(m.AirdropId)
 		copy(dAtA[i:], m.AirdropId)
@@ -1393,6 +1969,18 @@ func (m *MsgClaimEarly) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.AllocationProof != nil {
+		{
+			size, err := m.AllocationProof.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
 	if len(m.AirdropId) > 0 {
 		i -= len(m.AirdropId)
 		copy(dAtA[i:], m.AirdropId)
@@ -1433,7 +2021,7 @@ func (m *MsgClaimEarlyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgCreateAirdrop) Marshal() (dAtA []byte, err error) {
+func (m *MsgClaimAndStake) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1443,84 +2031,253 @@ func (m *MsgCreateAirdrop) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgCreateAirdrop) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgClaimAndStake) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgCreateAirdrop) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgClaimAndStake) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.LinkerAddress) > 0 {
-		i -= len(m.LinkerAddress)
-		copy(dAtA[i:], m.LinkerAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.LinkerAddress)))
+	if m.AllocationProof != nil {
+		{
+			size, err := m.AllocationProof.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0x5a
+		dAtA[i] = 0x1a
 	}
-	if len(m.AllocatorAddress) > 0 {
-		i -= len(m.AllocatorAddress)
-		copy(dAtA[i:], m.AllocatorAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.AllocatorAddress)))
+	if len(m.AirdropId) > 0 {
+		i -= len(m.AirdropId)
+		copy(dAtA[i:], m.AirdropId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.AirdropId)))
 		i--
-		dAtA[i] = 0x52
+		dAtA[i] = 0x12
 	}
-	if len(m.DistributorAddress) > 0 {
-		i -= len(m.DistributorAddress)
-		copy(dAtA[i:], m.DistributorAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.DistributorAddress)))
+	if len(m.Claimer) > 0 {
+		i -= len(m.Claimer)
+		copy(dAtA[i:], m.Claimer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Claimer)))
 		i--
-		dAtA[i] = 0x4a
+		dAtA[i] = 0xa
 	}
-	{
-		size := m.EarlyClaimPenalty.Size()
-		i -= size
-		if _, err := m.EarlyClaimPenalty.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgClaimAndStakeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClaimAndStakeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClaimAndStakeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.StToken.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgClaimAndVest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClaimAndVest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClaimAndVest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.AllocationProof != nil {
+		{
+			size, err := m.AllocationProof.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.AirdropId) > 0 {
+		i -= len(m.AirdropId)
+		copy(dAtA[i:], m.AirdropId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.AirdropId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Claimer) > 0 {
+		i -= len(m.Claimer)
+		copy(dAtA[i:], m.Claimer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Claimer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgClaimAndVestResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClaimAndVestResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClaimAndVestResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateAirdrop) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateAirdrop) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateAirdrop) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ClaimCondition != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ClaimCondition))
+		i--
+		dAtA[i] = 0x68
+	}
+	{
+		size := m.ClaimAndStakeBonus.Size()
+		i -= size
+		if _, err := m.ClaimAndStakeBonus.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x62
+	if len(m.LinkerAddress) > 0 {
+		i -= len(m.LinkerAddress)
+		copy(dAtA[i:], m.LinkerAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.LinkerAddress)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if len(m.AllocatorAddress) > 0 {
+		i -= len(m.AllocatorAddress)
+		copy(dAtA[i:], m.AllocatorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.AllocatorAddress)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if len(m.DistributorAddress) > 0 {
+		i -= len(m.DistributorAddress)
+		copy(dAtA[i:], m.DistributorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.DistributorAddress)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	{
+		size := m.EarlyClaimPenalty.Size()
+		i -= size
+		if _, err := m.EarlyClaimPenalty.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
 		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
 	i--
 	dAtA[i] = 0x42
 	if m.ClaimTypeDeadlineDate != nil {
-		n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.ClaimTypeDeadlineDate, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ClaimTypeDeadlineDate):])
-		if err1 != nil {
-			return 0, err1
+		n6, err6 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.ClaimTypeDeadlineDate, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ClaimTypeDeadlineDate):])
+		if err6 != nil {
+			return 0, err6
 		}
-		i -= n1
-		i = encodeVarintTx(dAtA, i, uint64(n1))
+		i -= n6
+		i = encodeVarintTx(dAtA, i, uint64(n6))
 		i--
 		dAtA[i] = 0x3a
 	}
 	if m.ClawbackDate != nil {
-		n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.ClawbackDate, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ClawbackDate):])
-		if err2 != nil {
-			return 0, err2
+		n7, err7 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.ClawbackDate, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ClawbackDate):])
+		if err7 != nil {
+			return 0, err7
 		}
-		i -= n2
-		i = encodeVarintTx(dAtA, i, uint64(n2))
+		i -= n7
+		i = encodeVarintTx(dAtA, i, uint64(n7))
 		i--
 		dAtA[i] = 0x32
 	}
 	if m.DistributionEndDate != nil {
-		n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.DistributionEndDate, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.DistributionEndDate):])
-		if err3 != nil {
-			return 0, err3
+		n8, err8 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.DistributionEndDate, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.DistributionEndDate):])
+		if err8 != nil {
+			return 0, err8
 		}
-		i -= n3
-		i = encodeVarintTx(dAtA, i, uint64(n3))
+		i -= n8
+		i = encodeVarintTx(dAtA, i, uint64(n8))
 		i--
 		dAtA[i] = 0x2a
 	}
 	if m.DistributionStartDate != nil {
-		n4, err4 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.DistributionStartDate, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.DistributionStartDate):])
-		if err4 != nil {
-			return 0, err4
+		n9, err9 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.DistributionStartDate, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.DistributionStartDate):])
+		if err9 != nil {
+			return 0, err9
 		}
-		i -= n4
-		i = encodeVarintTx(dAtA, i, uint64(n4))
+		i -= n9
+		i = encodeVarintTx(dAtA, i, uint64(n9))
 		i--
 		dAtA[i] = 0x22
 	}
@@ -1591,6 +2348,21 @@ func (m *MsgUpdateAirdrop) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ClaimCondition != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ClaimCondition))
+		i--
+		dAtA[i] = 0x68
+	}
+	{
+		size := m.ClaimAndStakeBonus.Size()
+		i -= size
+		if _, err := m.ClaimAndStakeBonus.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x62
 	if len(m.LinkerAddress) > 0 {
 		i -= len(m.LinkerAddress)
 		copy(dAtA[i:], m.LinkerAddress)
@@ -1623,42 +2395,42 @@ func (m *MsgUpdateAirdrop) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i--
 	dAtA[i] = 0x42
 	if m.ClaimTypeDeadlineDate != nil {
-		n5, err5 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.ClaimTypeDeadlineDate, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ClaimTypeDeadlineDate):])
-		if err5 != nil {
-			return 0, err5
+		n10, err10 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.ClaimTypeDeadlineDate, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ClaimTypeDeadlineDate):])
+		if err10 != nil {
+			return 0, err10
 		}
-		i -= n5
-		i = encodeVarintTx(dAtA, i, uint64(n5))
+		i -= n10
+		i = encodeVarintTx(dAtA, i, uint64(n10))
 		i--
 		dAtA[i] = 0x3a
 	}
 	if m.ClawbackDate != nil {
-		n6, err6 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.ClawbackDate, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ClawbackDate):])
-		if err6 != nil {
-			return 0, err6
+		n11, err11 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.ClawbackDate, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ClawbackDate):])
+		if err11 != nil {
+			return 0, err11
 		}
-		i -= n6
-		i = encodeVarintTx(dAtA, i, uint64(n6))
+		i -= n11
+		i = encodeVarintTx(dAtA, i, uint64(n11))
 		i--
 		dAtA[i] = 0x32
 	}
 	if m.DistributionEndDate != nil {
-		n7, err7 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.DistributionEndDate, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.DistributionEndDate):])
-		if err7 != nil {
-			return 0, err7
+		n12, err12 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.DistributionEndDate, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.DistributionEndDate):])
+		if err12 != nil {
+			return 0, err12
 		}
-		i -= n7
-		i = encodeVarintTx(dAtA, i, uint64(n7))
+		i -= n12
+		i = encodeVarintTx(dAtA, i, uint64(n12))
 		i--
 		dAtA[i] = 0x2a
 	}
 	if m.DistributionStartDate != nil {
-		n8, err8 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.DistributionStartDate, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.DistributionStartDate):])
-		if err8 != nil {
-			return 0, err8
+		n13, err13 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.DistributionStartDate, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.DistributionStartDate):])
+		if err13 != nil {
+			return 0, err13
 		}
-		i -= n8
-		i = encodeVarintTx(dAtA, i, uint64(n8))
+		i -= n13
+		i = encodeVarintTx(dAtA, i, uint64(n13))
 		i--
 		dAtA[i] = 0x22
 	}
@@ -1928,6 +2700,18 @@ func (m *MsgLinkAddresses) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.AllocationProof != nil {
+		{
+			size, err := m.AllocationProof.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
 	if len(m.HostAddress) > 0 {
 		i -= len(m.HostAddress)
 		copy(dAtA[i:], m.HostAddress)
@@ -1982,61 +2766,244 @@ func (m *MsgLinkAddressesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *MsgClaimDaily) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Claimer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.AirdropId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (m *AllocationProof) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgClaimDailyResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+func (m *AllocationProof) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgClaimEarly) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *AllocationProof) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Claimer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Proof) > 0 {
+		for iNdEx := len(m.Proof) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Proof[iNdEx])
+			copy(dAtA[i:], m.Proof[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Proof[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
 	}
-	l = len(m.AirdropId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Allocations) > 0 {
+		for iNdEx := len(m.Allocations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.Allocations[iNdEx].Size()
+				i -= size
+				if _, err := m.Allocations[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgClaimEarlyResponse) Size() (n int) {
+func (m *MsgSetAllocationsRoot) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetAllocationsRoot) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetAllocationsRoot) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MerkleRoot) > 0 {
+		i -= len(m.MerkleRoot)
+		copy(dAtA[i:], m.MerkleRoot)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.MerkleRoot)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.AirdropId) > 0 {
+		i -= len(m.AirdropId)
+		copy(dAtA[i:], m.AirdropId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.AirdropId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Admin) > 0 {
+		i -= len(m.Admin)
+		copy(dAtA[i:], m.Admin)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Admin)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetAllocationsRootResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetAllocationsRootResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetAllocationsRootResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgClaimDaily) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Claimer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.AirdropId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.AllocationProof != nil {
+		l = m.AllocationProof.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgClaimDailyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgClaimEarly) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Claimer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.AirdropId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.AllocationProof != nil {
+		l = m.AllocationProof.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgClaimEarlyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgClaimAndStake) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Claimer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.AirdropId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.AllocationProof != nil {
+		l = m.AllocationProof.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgClaimAndStakeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.StToken.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgClaimAndVest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Claimer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.AirdropId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.AllocationProof != nil {
+		l = m.AllocationProof.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgClaimAndVestResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -2093,6 +3060,11 @@ func (m *MsgCreateAirdrop) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
+	l = m.ClaimAndStakeBonus.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.ClaimCondition != 0 {
+		n += 1 + sovTx(uint64(m.ClaimCondition))
+	}
 	return n
 }
 
@@ -2153,6 +3125,11 @@ func (m *MsgUpdateAirdrop) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
+	l = m.ClaimAndStakeBonus.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.ClaimCondition != 0 {
+		n += 1 + sovTx(uint64(m.ClaimCondition))
+	}
 	return n
 }
 
@@ -2240,59 +3217,514 @@ func (m *MsgUpdateUserAllocation) Size() (n int) {
 			n += 1 + l + sovTx(uint64(l))
 		}
 	}
-	return n
-}
+	return n
+}
+
+func (m *MsgUpdateUserAllocationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgLinkAddresses) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Admin)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.AirdropId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.StrideAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.HostAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.AllocationProof != nil {
+		l = m.AllocationProof.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgLinkAddressesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *AllocationProof) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Allocations) > 0 {
+		for _, e := range m.Allocations {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.Proof) > 0 {
+		for _, s := range m.Proof {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgSetAllocationsRoot) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Admin)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.AirdropId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.MerkleRoot)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSetAllocationsRootResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgClaimDaily) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgClaimDaily: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgClaimDaily: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Claimer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Claimer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AirdropId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AirdropId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllocationProof", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.AllocationProof == nil {
+				m.AllocationProof = &AllocationProof{}
+			}
+			if err := m.AllocationProof.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgClaimDailyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgClaimDailyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgClaimDailyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgClaimEarly) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgClaimEarly: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgClaimEarly: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Claimer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Claimer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AirdropId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AirdropId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllocationProof", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.AllocationProof == nil {
+				m.AllocationProof = &AllocationProof{}
+			}
+			if err := m.AllocationProof.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *MsgUpdateUserAllocationResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
+	return nil
 }
-
-func (m *MsgLinkAddresses) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Admin)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.AirdropId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.StrideAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.HostAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgClaimEarlyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgClaimEarlyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgClaimEarlyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *MsgLinkAddressesResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *MsgClaimDaily) Unmarshal(dAtA []byte) error {
+func (m *MsgClaimAndStake) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2315,10 +3747,10 @@ func (m *MsgClaimDaily) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgClaimDaily: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgClaimAndStake: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgClaimDaily: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgClaimAndStake: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2385,6 +3817,42 @@ func (m *MsgClaimDaily) Unmarshal(dAtA []byte) error {
 			}
 			m.AirdropId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllocationProof", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.AllocationProof == nil {
+				m.AllocationProof = &AllocationProof{}
+			}
+			if err := m.AllocationProof.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2406,7 +3874,7 @@ func (m *MsgClaimDaily) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgClaimDailyResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgClaimAndStakeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2429,12 +3897,45 @@ func (m *MsgClaimDailyResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgClaimDailyResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgClaimAndStakeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgClaimDailyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgClaimAndStakeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StToken", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.StToken.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2456,7 +3957,7 @@ func (m *MsgClaimDailyResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgClaimEarly) Unmarshal(dAtA []byte) error {
+func (m *MsgClaimAndVest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2479,10 +3980,10 @@ func (m *MsgClaimEarly) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgClaimEarly: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgClaimAndVest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgClaimEarly: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgClaimAndVest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2549,6 +4050,42 @@ func (m *MsgClaimEarly) Unmarshal(dAtA []byte) error {
 			}
 			m.AirdropId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllocationProof", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.AllocationProof == nil {
+				m.AllocationProof = &AllocationProof{}
+			}
+			if err := m.AllocationProof.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2570,7 +4107,7 @@ func (m *MsgClaimEarly) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgClaimEarlyResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgClaimAndVestResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2593,10 +4130,10 @@ func (m *MsgClaimEarlyResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgClaimEarlyResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgClaimAndVestResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgClaimEarlyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgClaimAndVestResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2891,7 +4428,41 @@ func (m *MsgCreateAirdrop) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EarlyClaimPenalty", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EarlyClaimPenalty", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.EarlyClaimPenalty.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DistributorAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2919,13 +4490,11 @@ func (m *MsgCreateAirdrop) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.EarlyClaimPenalty.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.DistributorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 9:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DistributorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AllocatorAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2953,11 +4522,11 @@ func (m *MsgCreateAirdrop) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DistributorAddress = string(dAtA[iNdEx:postIndex])
+			m.AllocatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 10:
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AllocatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LinkerAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2985,11 +4554,11 @@ func (m *MsgCreateAirdrop) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AllocatorAddress = string(dAtA[iNdEx:postIndex])
+			m.LinkerAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 11:
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LinkerAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ClaimAndStakeBonus", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3017,8 +4586,29 @@ func (m *MsgCreateAirdrop) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LinkerAddress = string(dAtA[iNdEx:postIndex])
+			if err := m.ClaimAndStakeBonus.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClaimCondition", wireType)
+			}
+			m.ClaimCondition = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ClaimCondition |= ClaimCondition(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -3489,6 +5079,59 @@ func (m *MsgUpdateAirdrop) Unmarshal(dAtA []byte) error {
 			}
 			m.LinkerAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClaimAndStakeBonus", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ClaimAndStakeBonus.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClaimCondition", wireType)
+			}
+			m.ClaimCondition = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ClaimCondition |= ClaimCondition(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -3644,19 +5287,217 @@ func (m *RawAllocation) Unmarshal(dAtA []byte) error {
 			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.Int
+			m.Allocations = append(m.Allocations, v)
+			if err := m.Allocations[len(m.Allocations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAddAllocations) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddAllocations: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddAllocations: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Admin = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AirdropId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AirdropId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Allocations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Allocations = append(m.Allocations, RawAllocation{})
+			if err := m.Allocations[len(m.Allocations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAddAllocationsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
 			}
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			var v cosmossdk_io_math.Int
-			m.Allocations = append(m.Allocations, v)
-			if err := m.Allocations[len(m.Allocations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddAllocationsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddAllocationsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -3678,7 +5519,7 @@ func (m *RawAllocation) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgAddAllocations) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateUserAllocation) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3701,10 +5542,10 @@ func (m *MsgAddAllocations) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAddAllocations: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateUserAllocation: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAddAllocations: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateUserAllocation: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3772,10 +5613,42 @@ func (m *MsgAddAllocations) Unmarshal(dAtA []byte) error {
 			m.AirdropId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UserAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UserAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Allocations", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3785,22 +5658,24 @@ func (m *MsgAddAllocations) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Allocations = append(m.Allocations, RawAllocation{})
+			var v cosmossdk_io_math.Int
+			m.Allocations = append(m.Allocations, v)
 			if err := m.Allocations[len(m.Allocations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
@@ -3826,7 +5701,7 @@ func (m *MsgAddAllocations) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgAddAllocationsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateUserAllocationResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3849,10 +5724,10 @@ func (m *MsgAddAllocationsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAddAllocationsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateUserAllocationResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAddAllocationsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateUserAllocationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -3876,7 +5751,7 @@ func (m *MsgAddAllocationsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateUserAllocation) Unmarshal(dAtA []byte) error {
+func (m *MsgLinkAddresses) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3899,10 +5774,10 @@ func (m *MsgUpdateUserAllocation) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateUserAllocation: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgLinkAddresses: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateUserAllocation: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgLinkAddresses: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3971,7 +5846,7 @@ func (m *MsgUpdateUserAllocation) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UserAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StrideAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3999,11 +5874,11 @@ func (m *MsgUpdateUserAllocation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.UserAddress = string(dAtA[iNdEx:postIndex])
+			m.StrideAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Allocations", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HostAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4031,9 +5906,41 @@ func (m *MsgUpdateUserAllocation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v cosmossdk_io_math.Int
-			m.Allocations = append(m.Allocations, v)
-			if err := m.Allocations[len(m.Allocations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.HostAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllocationProof", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.AllocationProof == nil {
+				m.AllocationProof = &AllocationProof{}
+			}
+			if err := m.AllocationProof.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4058,7 +5965,7 @@ func (m *MsgUpdateUserAllocation) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateUserAllocationResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgLinkAddressesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4081,10 +5988,10 @@ func (m *MsgUpdateUserAllocationResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateUserAllocationResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgLinkAddressesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateUserAllocationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgLinkAddressesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -4108,7 +6015,7 @@ func (m *MsgUpdateUserAllocationResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgLinkAddresses) Unmarshal(dAtA []byte) error {
+func (m *AllocationProof) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4131,15 +6038,15 @@ func (m *MsgLinkAddresses) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgLinkAddresses: wiretype end group for non-group")
+			return fmt.Errorf("proto: AllocationProof: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgLinkAddresses: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AllocationProof: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Allocations", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4167,11 +6074,15 @@ func (m *MsgLinkAddresses) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Admin = string(dAtA[iNdEx:postIndex])
+			var v cosmossdk_io_math.Int
+			m.Allocations = append(m.Allocations, v)
+			if err := m.Allocations[len(m.Allocations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AirdropId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4199,11 +6110,61 @@ func (m *MsgLinkAddresses) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AirdropId = string(dAtA[iNdEx:postIndex])
+			m.Proof = append(m.Proof, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 3:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetAllocationsRoot) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetAllocationsRoot: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetAllocationsRoot: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StrideAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4231,11 +6192,11 @@ func (m *MsgLinkAddresses) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.StrideAddress = string(dAtA[iNdEx:postIndex])
+			m.Admin = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HostAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AirdropId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4263,7 +6224,39 @@ func (m *MsgLinkAddresses) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.HostAddress = string(dAtA[iNdEx:postIndex])
+			m.AirdropId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MerkleRoot", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MerkleRoot = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4286,7 +6279,7 @@ func (m *MsgLinkAddresses) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgLinkAddressesResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetAllocationsRootResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4309,10 +6302,10 @@ func (m *MsgLinkAddressesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgLinkAddressesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetAllocationsRootResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgLinkAddressesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetAllocationsRootResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -18,6 +18,8 @@ func AirdropConfigValidateBasic(
 	clawbackDate *time.Time,
 	claimTypeDeadlineDate *time.Time,
 	earlyClaimPenalty sdk.Dec,
+	claimAndStakeBonus sdk.Dec,
+	claimCondition ClaimCondition,
 	distributorAddress string,
 	allocatorAddress string,
 	linkerAddress string,
@@ -62,6 +64,15 @@ func AirdropConfigValidateBasic(
 		return errors.New("early claim penalty must be between 0 and 1")
 	}
 
+	// The claim and stake bonus is optional
+	if !claimAndStakeBonus.IsNil() && (claimAndStakeBonus.LT(sdk.ZeroDec()) || claimAndStakeBonus.GT(sdk.OneDec())) {
+		return errors.New("claim and stake bonus must be between 0 and 1")
+	}
+
+	if _, ok := ClaimCondition_name[int32(claimCondition)]; !ok {
+		return errors.New("invalid claim condition")
+	}
+
 	if _, err := sdk.AccAddressFromBech32(distributorAddress); err != nil {
 		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid distributor address (%s)", err)
 	}
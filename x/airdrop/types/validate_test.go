@@ -30,6 +30,7 @@ func TestAirdropConfigValidateBasic(t *testing.T) {
 	endDateMinusDelta := validDistributionEndDate.Add(-1 * time.Hour)
 
 	validEarlyClaimPenalty := sdk.MustNewDecFromStr("0.5")
+	validClaimAndStakeBonus := sdk.MustNewDecFromStr("0.1")
 
 	testCases := []struct {
 		name                  string
@@ -40,6 +41,8 @@ func TestAirdropConfigValidateBasic(t *testing.T) {
 		clawbackDate          *time.Time
 		claimTypeDeadlineDate *time.Time
 		earlyClaimPenalty     sdk.Dec
+		claimAndStakeBonus    sdk.Dec
+		claimCondition        types.ClaimCondition
 		distributorAddress    string
 		allocatorAddress      string
 		linkerAddress         string
@@ -54,10 +57,83 @@ func TestAirdropConfigValidateBasic(t *testing.T) {
 			clawbackDate:          &validClawbackDate,
 			claimTypeDeadlineDate: &validDeadlineDate,
 			earlyClaimPenalty:     validEarlyClaimPenalty,
+			claimAndStakeBonus:    validClaimAndStakeBonus,
 			distributorAddress:    validDistributorAddress,
 			allocatorAddress:      validAllocatorAddress,
 			linkerAddress:         validLinkerAddress,
 		},
+		{
+			name:                  "valid message without claim and stake bonus",
+			airdropId:             validAirdropId,
+			rewardDenom:           validRewardDenom,
+			distributionStartDate: &validDistributionStartDate,
+			distributionEndDate:   &validDistributionEndDate,
+			clawbackDate:          &validClawbackDate,
+			claimTypeDeadlineDate: &validDeadlineDate,
+			earlyClaimPenalty:     validEarlyClaimPenalty,
+			distributorAddress:    validDistributorAddress,
+			allocatorAddress:      validAllocatorAddress,
+			linkerAddress:         validLinkerAddress,
+		},
+		{
+			name:                  "negative claim and stake bonus",
+			airdropId:             validAirdropId,
+			rewardDenom:           validRewardDenom,
+			distributionStartDate: &validDistributionStartDate,
+			distributionEndDate:   &validDistributionEndDate,
+			clawbackDate:          &validClawbackDate,
+			claimTypeDeadlineDate: &validDeadlineDate,
+			earlyClaimPenalty:     validEarlyClaimPenalty,
+			claimAndStakeBonus:    sdk.MustNewDecFromStr("-0.1"),
+			distributorAddress:    validDistributorAddress,
+			allocatorAddress:      validAllocatorAddress,
+			linkerAddress:         validLinkerAddress,
+			expectedError:         "claim and stake bonus must be between 0 and 1",
+		},
+		{
+			name:                  "claim and stake bonus greater than 1",
+			airdropId:             validAirdropId,
+			rewardDenom:           validRewardDenom,
+			distributionStartDate: &validDistributionStartDate,
+			distributionEndDate:   &validDistributionEndDate,
+			clawbackDate:          &validClawbackDate,
+			claimTypeDeadlineDate: &validDeadlineDate,
+			earlyClaimPenalty:     validEarlyClaimPenalty,
+			claimAndStakeBonus:    sdk.MustNewDecFromStr("1.1"),
+			distributorAddress:    validDistributorAddress,
+			allocatorAddress:      validAllocatorAddress,
+			linkerAddress:         validLinkerAddress,
+			expectedError:         "claim and stake bonus must be between 0 and 1",
+		},
+		{
+			name:                  "valid message with claim condition",
+			airdropId:             validAirdropId,
+			rewardDenom:           validRewardDenom,
+			distributionStartDate: &validDistributionStartDate,
+			distributionEndDate:   &validDistributionEndDate,
+			clawbackDate:          &validClawbackDate,
+			claimTypeDeadlineDate: &validDeadlineDate,
+			earlyClaimPenalty:     validEarlyClaimPenalty,
+			claimCondition:        types.CLAIM_CONDITION_DELEGATE_STAKE,
+			distributorAddress:    validDistributorAddress,
+			allocatorAddress:      validAllocatorAddress,
+			linkerAddress:         validLinkerAddress,
+		},
+		{
+			name:                  "invalid claim condition",
+			airdropId:             validAirdropId,
+			rewardDenom:           validRewardDenom,
+			distributionStartDate: &validDistributionStartDate,
+			distributionEndDate:   &validDistributionEndDate,
+			clawbackDate:          &validClawbackDate,
+			claimTypeDeadlineDate: &validDeadlineDate,
+			earlyClaimPenalty:     validEarlyClaimPenalty,
+			claimCondition:        types.ClaimCondition(99),
+			distributorAddress:    validDistributorAddress,
+			allocatorAddress:      validAllocatorAddress,
+			linkerAddress:         validLinkerAddress,
+			expectedError:         "invalid claim condition",
+		},
 		{
 			name:                  "invalid airdrop id",
 			airdropId:             "",
@@ -402,6 +478,8 @@ func TestAirdropConfigValidateBasic(t *testing.T) {
 				tc.clawbackDate,
 				tc.claimTypeDeadlineDate,
 				tc.earlyClaimPenalty,
+				tc.claimAndStakeBonus,
+				tc.claimCondition,
 				tc.distributorAddress,
 				tc.allocatorAddress,
 				tc.linkerAddress,
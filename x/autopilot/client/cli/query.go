@@ -23,7 +23,13 @@ func GetQueryCmd(queryRoute string) *cobra.Command {
 		RunE:                       client.ValidateCmd,
 	}
 
-	cmd.AddCommand(CmdQueryParams())
+	cmd.AddCommand(
+		CmdQueryParams(),
+		CmdQueryChannelPolicy(),
+		CmdQueryAllChannelPolicies(),
+		CmdQuerySenderQuota(),
+		CmdQueryAllSwapRoutes(),
+	)
 	return cmd
 }
 
@@ -50,3 +56,105 @@ func CmdQueryParams() *cobra.Command {
 
 	return cmd
 }
+
+func CmdQueryChannelPolicy() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "channel-policy [channel-id]",
+		Short: "shows the autopilot policy for a channel",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.ChannelPolicy(context.Background(), &types.QueryChannelPolicyRequest{
+				ChannelId: args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdQueryAllChannelPolicies() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "channel-policies",
+		Short: "shows all autopilot channel policies",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.AllChannelPolicies(context.Background(), &types.QueryAllChannelPoliciesRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdQuerySenderQuota() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "sender-quota [channel-id] [sender] [denom]",
+		Short: "shows the autopilot quota usage of a sender on a channel for a denom",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.SenderQuota(context.Background(), &types.QuerySenderQuotaRequest{
+				ChannelId: args[0],
+				Sender:    args[1],
+				Denom:     args[2],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdQueryAllSwapRoutes() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "swap-routes",
+		Short: "shows all swap routes that can be used to swap and liquid stake",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.AllSwapRoutes(context.Background(), &types.QueryAllSwapRoutesRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
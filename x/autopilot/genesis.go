@@ -11,11 +11,19 @@ import (
 // state.
 func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState) {
 	k.SetParams(ctx, genState.Params)
+	for _, policy := range genState.ChannelPolicies {
+		k.SetChannelPolicy(ctx, policy)
+	}
+	for _, swapRoute := range genState.SwapRoutes {
+		k.SetSwapRoute(ctx, swapRoute)
+	}
 }
 
 // ExportGenesis returns the capability module's exported genesis.
 func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 	genesis := types.DefaultGenesis()
 	genesis.Params = k.GetParams(ctx)
+	genesis.ChannelPolicies = k.GetAllChannelPolicies(ctx)
+	genesis.SwapRoutes = k.GetAllSwapRoutes(ctx)
 	return genesis
 }
@@ -3,6 +3,7 @@ package autopilot_test
 import (
 	"testing"
 
+	sdkmath "cosmossdk.io/math"
 	"github.com/stretchr/testify/require"
 
 	"github.com/Stride-Labs/stride/v24/app/apptesting"
@@ -13,8 +14,21 @@ import (
 func TestGenesis(t *testing.T) {
 	expectedGenesisState := types.GenesisState{
 		Params: types.Params{
-			StakeibcActive: true,
-			ClaimActive:    true,
+			StakeibcActive:           true,
+			ClaimActive:              true,
+			ContractCallbackGasLimit: types.DefaultContractCallbackGasLimit,
+		},
+		ChannelPolicies: []types.ChannelPolicy{
+			{
+				ChannelId:      "channel-0",
+				AllowedActions: []string{types.LiquidStake},
+				DenomLimits: []types.DenomLimit{{
+					Denom:              "ibc/uatom",
+					MaxAmountPerPacket: sdkmath.NewInt(100),
+					SenderQuota:        sdkmath.NewInt(1000),
+				}},
+				QuotaWindowSeconds: 60,
+			},
 		},
 	}
 
@@ -24,4 +38,5 @@ func TestGenesis(t *testing.T) {
 	actualGenesisState := autopilot.ExportGenesis(s.Ctx, s.App.AutopilotKeeper)
 	require.NotNil(t, actualGenesisState)
 	require.Equal(t, expectedGenesisState.Params, actualGenesisState.Params)
+	require.Equal(t, expectedGenesisState.ChannelPolicies, actualGenesisState.ChannelPolicies)
 }
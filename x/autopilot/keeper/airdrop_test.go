@@ -298,7 +298,8 @@ func (s *KeeperTestSuite) TestAirdropOnRecvPacket() {
 
 			// Update the autopilot active flag
 			s.App.AutopilotKeeper.SetParams(s.Ctx, types.Params{
-				ClaimActive: tc.autopilotClaimActive,
+				ClaimActive:              tc.autopilotClaimActive,
+				ContractCallbackGasLimit: types.DefaultContractCallbackGasLimit,
 			})
 
 			// Set evmos airdrop
@@ -0,0 +1,133 @@
+package keeper
+
+import (
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/gogoproto/proto"
+	transfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+
+	"github.com/Stride-Labs/stride/v24/utils"
+	"github.com/Stride-Labs/stride/v24/x/autopilot/types"
+	icacallbackstypes "github.com/Stride-Labs/stride/v24/x/icacallbacks/types"
+)
+
+const IBCCallbacksID_ContractTransfer = "contract-transfer"
+
+func (k Keeper) Callbacks() icacallbackstypes.ModuleCallbacks {
+	return []icacallbackstypes.ICACallback{
+		{CallbackId: IBCCallbacksID_ContractTransfer, CallbackFunc: icacallbackstypes.ICACallbackFunction(k.ContractTransferCallback)},
+	}
+}
+
+// Submits an ICS-20 transfer on behalf of a contract, and stores callback data so that
+// the contract is notified (via sudo) when the transfer is acknowledged or times out
+// The contract must be the sender of the transfer
+// Returns the sequence number of the transfer packet
+func (k Keeper) IBCTransferWithContractCallback(
+	ctx sdk.Context,
+	contractAddress string,
+	transferMsg *transfertypes.MsgTransfer,
+) (sequence uint64, err error) {
+	if contractAddress != transferMsg.Sender {
+		return 0, errorsmod.Wrapf(types.ErrInvalidContractTransfer,
+			"contract %s is not the sender of the transfer (%s)", contractAddress, transferMsg.Sender)
+	}
+
+	transferResponse, err := k.transferKeeper.Transfer(sdk.WrapSDKContext(ctx), transferMsg)
+	if err != nil {
+		return 0, errorsmod.Wrapf(err, "failed to submit contract transfer")
+	}
+
+	callbackArgs := types.ContractTransferCallback{
+		ContractAddress: contractAddress,
+	}
+	callbackArgsBz, err := proto.Marshal(&callbackArgs)
+	if err != nil {
+		return 0, errorsmod.Wrapf(err, "unable to marshal contract transfer callback data for %+v", callbackArgs)
+	}
+
+	k.icaCallbacksKeeper.AddCallbackData(ctx, icacallbackstypes.CallbackData{
+		CallbackKey:  icacallbackstypes.PacketID(transferMsg.SourcePort, transferMsg.SourceChannel, transferResponse.Sequence),
+		PortId:       transferMsg.SourcePort,
+		ChannelId:    transferMsg.SourceChannel,
+		Sequence:     transferResponse.Sequence,
+		CallbackId:   IBCCallbacksID_ContractTransfer,
+		CallbackArgs: callbackArgsBz,
+	})
+
+	return transferResponse.Sequence, nil
+}
+
+// Callback after a contract initiated transfer is acknowledged or times out
+// Notifies the contract with a sudo message describing the outcome
+// A failure in the contract (including running out of gas) is logged but does not block the
+// ack from being processed, since otherwise, a misbehaving contract could prevent refunds
+// from the transfer module
+func (k Keeper) ContractTransferCallback(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	ackResponse *icacallbackstypes.AcknowledgementResponse,
+	args []byte,
+) error {
+	var callbackArgs types.ContractTransferCallback
+	if err := proto.Unmarshal(args, &callbackArgs); err != nil {
+		return errorsmod.Wrapf(err, "unable to unmarshal contract transfer callback args")
+	}
+
+	contractAddress, err := sdk.AccAddressFromBech32(callbackArgs.ContractAddress)
+	if err != nil {
+		return errorsmod.Wrapf(err, "invalid contract address in callback args")
+	}
+
+	var sudoMsg []byte
+	switch ackResponse.Status {
+	case icacallbackstypes.AckResponseStatus_TIMEOUT:
+		sudoMsg, err = types.NewContractTimeoutSudoMsg(packet.SourceChannel, packet.Sequence)
+	case icacallbackstypes.AckResponseStatus_FAILURE:
+		sudoMsg, err = types.NewContractAckSudoMsg(packet.SourceChannel, packet.Sequence, false, ackResponse.Error)
+	default:
+		sudoMsg, err = types.NewContractAckSudoMsg(packet.SourceChannel, packet.Sequence, true, "")
+	}
+	if err != nil {
+		return errorsmod.Wrapf(err, "unable to build contract callback sudo message")
+	}
+
+	if err := k.SudoContractWithGasLimit(ctx, contractAddress, sudoMsg); err != nil {
+		k.Logger(ctx).Error(fmt.Sprintf("Contract transfer callback to %s failed for packet %d on %s: %s",
+			callbackArgs.ContractAddress, packet.Sequence, packet.SourceChannel, err.Error()))
+		EmitContractCallbackEvent(ctx, callbackArgs.ContractAddress, packet, false)
+		return nil
+	}
+
+	EmitContractCallbackEvent(ctx, callbackArgs.ContractAddress, packet, true)
+	return nil
+}
+
+// Calls sudo on a contract in a cache context with a gas meter that's bounded by the contract
+// callback gas limit param, so that the contract cannot consume the relayer's gas
+// If the contract runs out of gas, the panic is recovered and returned as an error so that
+// it's treated as a failed callback rather than a failed ack
+// The gas used by the contract is charged to the parent context
+func (k Keeper) SudoContractWithGasLimit(ctx sdk.Context, contractAddress sdk.AccAddress, sudoMsg []byte) (err error) {
+	gasLimit := k.GetParams(ctx).ContractCallbackGasLimit
+	limitedCtx := ctx.WithGasMeter(sdk.NewGasMeter(gasLimit))
+
+	defer func() {
+		if recoveryError := recover(); recoveryError != nil {
+			isOutOfGas, descriptor := utils.IsOutOfGasError(recoveryError)
+			if !isOutOfGas {
+				panic(recoveryError)
+			}
+			err = errorsmod.Wrapf(types.ErrContractCallbackOutOfGas, "%s (gas limit: %d)", descriptor, gasLimit)
+		}
+		ctx.GasMeter().ConsumeGas(limitedCtx.GasMeter().GasConsumedToLimit(), "autopilot contract callback")
+	}()
+
+	return utils.ApplyFuncIfNoError(limitedCtx, func(ctx sdk.Context) error {
+		_, err := k.contractKeeper.Sudo(ctx, contractAddress, sudoMsg)
+		return err
+	})
+}
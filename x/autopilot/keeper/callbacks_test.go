@@ -0,0 +1,117 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/gogoproto/proto"
+	transfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+	ibctesting "github.com/cosmos/ibc-go/v7/testing"
+
+	"github.com/Stride-Labs/stride/v24/x/autopilot/keeper"
+	"github.com/Stride-Labs/stride/v24/x/autopilot/types"
+	icacallbackstypes "github.com/Stride-Labs/stride/v24/x/icacallbacks/types"
+)
+
+func (s *KeeperTestSuite) TestIBCTransferWithContractCallback() {
+	s.CreateTransferChannel(HostChainId)
+
+	contractAddress := s.TestAccs[0]
+	s.FundAccount(contractAddress, sdk.NewCoin(Strd, sdkmath.NewInt(1000)))
+
+	transferMsg := &transfertypes.MsgTransfer{
+		SourcePort:       transfertypes.PortID,
+		SourceChannel:    ibctesting.FirstChannelID,
+		Token:            sdk.NewCoin(Strd, sdkmath.NewInt(1000)),
+		Sender:           contractAddress.String(),
+		Receiver:         HostAddress,
+		TimeoutTimestamp: uint64(s.Ctx.BlockTime().Add(time.Hour).UnixNano()),
+	}
+	expectedSequence := s.MustGetNextSequenceNumber(transfertypes.PortID, ibctesting.FirstChannelID)
+
+	sequence, err := s.App.AutopilotKeeper.IBCTransferWithContractCallback(s.Ctx, contractAddress.String(), transferMsg)
+	s.Require().NoError(err, "no error expected when transferring")
+	s.Require().Equal(expectedSequence, sequence, "sequence number")
+
+	// Confirm the callback data was stored
+	callbackKey := icacallbackstypes.PacketID(transfertypes.PortID, ibctesting.FirstChannelID, sequence)
+	callbackData, found := s.App.IcacallbacksKeeper.GetCallbackData(s.Ctx, callbackKey)
+	s.Require().True(found, "callback data should have been stored")
+	s.Require().Equal(keeper.IBCCallbacksID_ContractTransfer, callbackData.CallbackId, "callback ID")
+
+	var callbackArgs types.ContractTransferCallback
+	s.Require().NoError(proto.Unmarshal(callbackData.CallbackArgs, &callbackArgs), "no error expected when unmarshalling")
+	s.Require().Equal(contractAddress.String(), callbackArgs.ContractAddress, "callback contract address")
+}
+
+func (s *KeeperTestSuite) TestIBCTransferWithContractCallback_SenderMismatch() {
+	transferMsg := &transfertypes.MsgTransfer{
+		SourcePort:    transfertypes.PortID,
+		SourceChannel: ibctesting.FirstChannelID,
+		Token:         sdk.NewCoin(Strd, sdkmath.NewInt(1000)),
+		Sender:        s.TestAccs[1].String(),
+		Receiver:      HostAddress,
+	}
+
+	_, err := s.App.AutopilotKeeper.IBCTransferWithContractCallback(s.Ctx, s.TestAccs[0].String(), transferMsg)
+	s.Require().ErrorContains(err, "is not the sender of the transfer")
+}
+
+func (s *KeeperTestSuite) TestContractTransferCallback() {
+	packet := channeltypes.Packet{SourceChannel: "channel-0", Sequence: 1}
+	ackResponse := &icacallbackstypes.AcknowledgementResponse{Status: icacallbackstypes.AckResponseStatus_SUCCESS}
+
+	// Invalid callback args should error
+	err := s.App.AutopilotKeeper.ContractTransferCallback(s.Ctx, packet, ackResponse, []byte{1, 2, 3})
+	s.Require().ErrorContains(err, "unable to unmarshal contract transfer callback args")
+
+	// If the contract's sudo fails (in this case, because there's no contract at the address)
+	// the callback should still succeed, but the failure should be recorded in the event
+	callbackArgsBz, err := proto.Marshal(&types.ContractTransferCallback{ContractAddress: s.TestAccs[0].String()})
+	s.Require().NoError(err, "no error expected when marshalling callback args")
+
+	err = s.App.AutopilotKeeper.ContractTransferCallback(s.Ctx, packet, ackResponse, callbackArgsBz)
+	s.Require().NoError(err, "no error expected when contract sudo fails")
+	s.CheckEventValueEmitted(types.EventTypeContractCallback, types.AttributeKeyCallbackSuccess, "false")
+}
+
+func (s *KeeperTestSuite) TestSudoContractWithGasLimit() {
+	contractAddress := s.TestAccs[0]
+	sudoMsg := []byte(`{}`)
+
+	// With the default gas limit, the sudo should fail because there's no contract at the address
+	err := s.App.AutopilotKeeper.SudoContractWithGasLimit(s.Ctx, contractAddress, sudoMsg)
+	s.Require().Error(err, "error expected since there's no contract")
+	s.Require().NotErrorIs(err, types.ErrContractCallbackOutOfGas, "contract should not run out of gas")
+
+	// With a gas limit of 1, the sudo should run out of gas when reading the contract from the store
+	// The out of gas panic should be returned as an error, and the gas limit should be charged
+	// to the parent context
+	params := s.App.AutopilotKeeper.GetParams(s.Ctx)
+	params.ContractCallbackGasLimit = 1
+	s.App.AutopilotKeeper.SetParams(s.Ctx, params)
+
+	ctx := s.Ctx.WithGasMeter(sdk.NewGasMeter(1_000_000))
+	err = s.App.AutopilotKeeper.SudoContractWithGasLimit(ctx, contractAddress, sudoMsg)
+	s.Require().ErrorIs(err, types.ErrContractCallbackOutOfGas, "contract should run out of gas")
+	s.Require().Less(ctx.GasMeter().GasConsumed(), uint64(1_000_000), "parent context should not run out of gas")
+}
+
+func (s *KeeperTestSuite) TestContractTransferCallback_OutOfGas() {
+	packet := channeltypes.Packet{SourceChannel: "channel-0", Sequence: 1}
+	ackResponse := &icacallbackstypes.AcknowledgementResponse{Status: icacallbackstypes.AckResponseStatus_SUCCESS}
+
+	params := s.App.AutopilotKeeper.GetParams(s.Ctx)
+	params.ContractCallbackGasLimit = 1
+	s.App.AutopilotKeeper.SetParams(s.Ctx, params)
+
+	callbackArgsBz, err := proto.Marshal(&types.ContractTransferCallback{ContractAddress: s.TestAccs[0].String()})
+	s.Require().NoError(err, "no error expected when marshalling callback args")
+
+	// Running out of gas should be treated as a failed callback, rather than panicking
+	err = s.App.AutopilotKeeper.ContractTransferCallback(s.Ctx, packet, ackResponse, callbackArgsBz)
+	s.Require().NoError(err, "no error expected when contract runs out of gas")
+	s.CheckEventValueEmitted(types.EventTypeContractCallback, types.AttributeKeyCallbackSuccess, "false")
+}
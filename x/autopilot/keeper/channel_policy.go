@@ -0,0 +1,155 @@
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Stride-Labs/stride/v24/x/autopilot/types"
+)
+
+// Stores the autopilot policy for a channel
+func (k Keeper) SetChannelPolicy(ctx sdk.Context, policy types.ChannelPolicy) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ChannelPolicyPrefix)
+	policyBz := k.Cdc.MustMarshal(&policy)
+	store.Set([]byte(policy.ChannelId), policyBz)
+}
+
+// Returns the autopilot policy for a channel
+// If no policy has been registered, returns false
+func (k Keeper) GetChannelPolicy(ctx sdk.Context, channelId string) (policy types.ChannelPolicy, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ChannelPolicyPrefix)
+
+	policyBz := store.Get([]byte(channelId))
+	if len(policyBz) == 0 {
+		return policy, false
+	}
+
+	k.Cdc.MustUnmarshal(policyBz, &policy)
+	return policy, true
+}
+
+// Returns all registered channel policies
+func (k Keeper) GetAllChannelPolicies(ctx sdk.Context) []types.ChannelPolicy {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ChannelPolicyPrefix)
+
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	policies := []types.ChannelPolicy{}
+	for ; iterator.Valid(); iterator.Next() {
+		var policy types.ChannelPolicy
+		k.Cdc.MustUnmarshal(iterator.Value(), &policy)
+		policies = append(policies, policy)
+	}
+
+	return policies
+}
+
+// Removes the autopilot policy for a channel
+func (k Keeper) RemoveChannelPolicy(ctx sdk.Context, channelId string) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ChannelPolicyPrefix)
+	store.Delete([]byte(channelId))
+}
+
+// Stores the quota usage of a sender on a channel for a denom
+func (k Keeper) SetSenderQuota(ctx sdk.Context, senderQuota types.SenderQuota) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.SenderQuotaPrefix)
+	key := types.GetSenderQuotaKey(senderQuota.ChannelId, senderQuota.Sender, senderQuota.Denom)
+	senderQuotaBz := k.Cdc.MustMarshal(&senderQuota)
+	store.Set(key, senderQuotaBz)
+}
+
+// Returns the quota usage of a sender on a channel for a denom
+// If the sender has not yet used autopilot with the denom on the channel, returns false
+func (k Keeper) GetSenderQuota(ctx sdk.Context, channelId, sender, denom string) (senderQuota types.SenderQuota, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.SenderQuotaPrefix)
+
+	senderQuotaBz := store.Get(types.GetSenderQuotaKey(channelId, sender, denom))
+	if len(senderQuotaBz) == 0 {
+		return senderQuota, false
+	}
+
+	k.Cdc.MustUnmarshal(senderQuotaBz, &senderQuota)
+	return senderQuota, true
+}
+
+// Removes all sender quotas for a channel
+// This is used when the channel's policy is removed
+func (k Keeper) RemoveSenderQuotasForChannel(ctx sdk.Context, channelId string) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.SenderQuotaPrefix)
+
+	iterator := sdk.KVStorePrefixIterator(store, []byte(channelId+"/"))
+	defer iterator.Close()
+
+	keys := [][]byte{}
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+// Checks an inbound autopilot packet against the policy of the channel it arrived on
+// If there is no policy for the channel, the packet is allowed through
+// Otherwise, the action must be allowlisted and, if the policy has limits for the packet's denom,
+// the amount must be under the per-packet max and the sender must have remaining quota for
+// that denom in the current window (which is then decremented)
+// Limits are key'd by denom so that amounts of different tokens are never compared or summed
+func (k Keeper) CheckAndUpdateChannelPolicy(
+	ctx sdk.Context,
+	channelId string,
+	sender string,
+	action string,
+	denom string,
+	amount sdkmath.Int,
+) error {
+	policy, found := k.GetChannelPolicy(ctx, channelId)
+	if !found {
+		return nil
+	}
+
+	if !policy.IsActionAllowed(action) {
+		return errorsmod.Wrapf(types.ErrActionNotAllowed, "action %s, channel %s", action, channelId)
+	}
+
+	denomLimit, found := policy.GetDenomLimit(denom)
+	if !found {
+		return nil
+	}
+
+	if denomLimit.MaxAmountPerPacket.IsPositive() && amount.GT(denomLimit.MaxAmountPerPacket) {
+		return errorsmod.Wrapf(types.ErrMaxPacketAmountExceeded, "amount: %v%s, max: %v%s",
+			amount, denom, denomLimit.MaxAmountPerPacket, denom)
+	}
+
+	if !denomLimit.SenderQuota.IsPositive() {
+		return nil
+	}
+
+	// If this is the sender's first packet, or the previous window has elapsed, start a new window
+	currentTime := uint64(ctx.BlockTime().Unix())
+	senderQuota, found := k.GetSenderQuota(ctx, channelId, sender, denom)
+	if !found || currentTime >= senderQuota.WindowStartTime+policy.QuotaWindowSeconds {
+		senderQuota = types.SenderQuota{
+			ChannelId:       channelId,
+			Sender:          sender,
+			Denom:           denom,
+			AmountUsed:      sdkmath.ZeroInt(),
+			WindowStartTime: currentTime,
+		}
+	}
+
+	updatedAmountUsed := senderQuota.AmountUsed.Add(amount)
+	if updatedAmountUsed.GT(denomLimit.SenderQuota) {
+		return errorsmod.Wrapf(types.ErrSenderQuotaExceeded, "sender %s, denom %s, amount used: %v, amount: %v, quota: %v",
+			sender, denom, senderQuota.AmountUsed, amount, denomLimit.SenderQuota)
+	}
+
+	senderQuota.AmountUsed = updatedAmountUsed
+	k.SetSenderQuota(ctx, senderQuota)
+
+	return nil
+}
@@ -0,0 +1,156 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+
+	"github.com/Stride-Labs/stride/v24/x/autopilot/keeper"
+	"github.com/Stride-Labs/stride/v24/x/autopilot/types"
+)
+
+// Tests Get/Set/RemoveChannelPolicy
+func (s *KeeperTestSuite) TestChannelPolicy() {
+	policies := []types.ChannelPolicy{
+		{ChannelId: "channel-0", AllowedActions: []string{types.LiquidStake}},
+		{ChannelId: "channel-1", AllowedActions: []string{types.Claim}},
+		{ChannelId: "channel-2", AllowedActions: []string{types.RedeemStake}},
+	}
+	for i := range policies {
+		policies[i].DenomLimits = []types.DenomLimit{
+			{Denom: "denom", MaxAmountPerPacket: sdkmath.NewInt(int64(i + 1)), SenderQuota: sdkmath.ZeroInt()},
+		}
+		s.App.AutopilotKeeper.SetChannelPolicy(s.Ctx, policies[i])
+	}
+
+	// Confirm each policy can be retrieved
+	for _, expected := range policies {
+		actual, found := s.App.AutopilotKeeper.GetChannelPolicy(s.Ctx, expected.ChannelId)
+		s.Require().True(found, "policy should have been found for %s", expected.ChannelId)
+		s.Require().Equal(expected.AllowedActions, actual.AllowedActions, "allowed actions for %s", expected.ChannelId)
+		s.Require().Equal(expected.DenomLimits, actual.DenomLimits, "denom limits for %s", expected.ChannelId)
+	}
+	s.Require().Len(s.App.AutopilotKeeper.GetAllChannelPolicies(s.Ctx), len(policies), "number of policies")
+
+	// Remove one and confirm it's no longer found
+	s.App.AutopilotKeeper.RemoveChannelPolicy(s.Ctx, "channel-1")
+	_, found := s.App.AutopilotKeeper.GetChannelPolicy(s.Ctx, "channel-1")
+	s.Require().False(found, "policy should have been removed")
+	s.Require().Len(s.App.AutopilotKeeper.GetAllChannelPolicies(s.Ctx), len(policies)-1, "number of policies after removal")
+}
+
+func (s *KeeperTestSuite) TestCheckAndUpdateChannelPolicy() {
+	channelId := "channel-0"
+	sender := "cosmosXXX"
+	atomDenom := "ibc/uatom"
+	osmoDenom := "ibc/uosmo"
+	window := uint64(60 * 60)
+
+	s.App.AutopilotKeeper.SetChannelPolicy(s.Ctx, types.ChannelPolicy{
+		ChannelId:      channelId,
+		AllowedActions: []string{types.LiquidStake},
+		DenomLimits: []types.DenomLimit{
+			{Denom: atomDenom, MaxAmountPerPacket: sdkmath.NewInt(100), SenderQuota: sdkmath.NewInt(150)},
+			{Denom: osmoDenom, MaxAmountPerPacket: sdkmath.NewInt(1000), SenderQuota: sdkmath.NewInt(1000)},
+		},
+		QuotaWindowSeconds: window,
+	})
+
+	// Channels without a policy should allow everything
+	err := s.App.AutopilotKeeper.CheckAndUpdateChannelPolicy(s.Ctx, "channel-1", sender, types.RedeemStake, atomDenom, sdkmath.NewInt(1000))
+	s.Require().NoError(err, "no error expected for channel without a policy")
+
+	// Actions that aren't allowlisted should fail
+	err = s.App.AutopilotKeeper.CheckAndUpdateChannelPolicy(s.Ctx, channelId, sender, types.RedeemStake, atomDenom, sdkmath.NewInt(10))
+	s.Require().ErrorIs(err, types.ErrActionNotAllowed)
+
+	// Packets above the denom's max amount should fail
+	err = s.App.AutopilotKeeper.CheckAndUpdateChannelPolicy(s.Ctx, channelId, sender, types.LiquidStake, atomDenom, sdkmath.NewInt(101))
+	s.Require().ErrorIs(err, types.ErrMaxPacketAmountExceeded)
+
+	// A valid packet should decrement the quota
+	err = s.App.AutopilotKeeper.CheckAndUpdateChannelPolicy(s.Ctx, channelId, sender, types.LiquidStake, atomDenom, sdkmath.NewInt(100))
+	s.Require().NoError(err, "no error expected for first packet")
+
+	senderQuota, found := s.App.AutopilotKeeper.GetSenderQuota(s.Ctx, channelId, sender, atomDenom)
+	s.Require().True(found, "sender quota should have been created")
+	s.Require().Equal(atomDenom, senderQuota.Denom, "sender quota denom")
+	s.Require().Equal(int64(100), senderQuota.AmountUsed.Int64(), "amount used after first packet")
+	s.Require().Equal(uint64(s.Ctx.BlockTime().Unix()), senderQuota.WindowStartTime, "window start time")
+
+	// A second packet that exceeds the remaining quota should fail
+	err = s.App.AutopilotKeeper.CheckAndUpdateChannelPolicy(s.Ctx, channelId, sender, types.LiquidStake, atomDenom, sdkmath.NewInt(51))
+	s.Require().ErrorIs(err, types.ErrSenderQuotaExceeded)
+
+	// But a different sender should have their own quota
+	err = s.App.AutopilotKeeper.CheckAndUpdateChannelPolicy(s.Ctx, channelId, "cosmosYYY", types.LiquidStake, atomDenom, sdkmath.NewInt(100))
+	s.Require().NoError(err, "no error expected for different sender")
+
+	// And a different denom should be checked against its own limits and quota,
+	// so a packet above the atom limits should still be accepted
+	err = s.App.AutopilotKeeper.CheckAndUpdateChannelPolicy(s.Ctx, channelId, sender, types.LiquidStake, osmoDenom, sdkmath.NewInt(500))
+	s.Require().NoError(err, "no error expected for different denom")
+
+	senderQuota, found = s.App.AutopilotKeeper.GetSenderQuota(s.Ctx, channelId, sender, osmoDenom)
+	s.Require().True(found, "osmo sender quota should have been created")
+	s.Require().Equal(int64(500), senderQuota.AmountUsed.Int64(), "osmo amount used")
+
+	senderQuota, found = s.App.AutopilotKeeper.GetSenderQuota(s.Ctx, channelId, sender, atomDenom)
+	s.Require().True(found, "atom sender quota should have been found")
+	s.Require().Equal(int64(100), senderQuota.AmountUsed.Int64(), "atom amount used should be unchanged")
+
+	// Denoms without limits on the channel should not be restricted by amount
+	err = s.App.AutopilotKeeper.CheckAndUpdateChannelPolicy(s.Ctx, channelId, sender, types.LiquidStake, "ibc/other", sdkmath.NewInt(1_000_000))
+	s.Require().NoError(err, "no error expected for denom without limits")
+
+	_, found = s.App.AutopilotKeeper.GetSenderQuota(s.Ctx, channelId, sender, "ibc/other")
+	s.Require().False(found, "sender quota should not be tracked for denom without limits")
+
+	// Once the window has elapsed, the quota should reset
+	s.Ctx = s.Ctx.WithBlockTime(s.Ctx.BlockTime().Add(time.Duration(window) * time.Second))
+	err = s.App.AutopilotKeeper.CheckAndUpdateChannelPolicy(s.Ctx, channelId, sender, types.LiquidStake, atomDenom, sdkmath.NewInt(100))
+	s.Require().NoError(err, "no error expected after window reset")
+
+	senderQuota, found = s.App.AutopilotKeeper.GetSenderQuota(s.Ctx, channelId, sender, atomDenom)
+	s.Require().True(found, "sender quota should have been found")
+	s.Require().Equal(int64(100), senderQuota.AmountUsed.Int64(), "amount used after reset")
+}
+
+func (s *KeeperTestSuite) TestSetAndRemoveChannelPolicyMsgs() {
+	msgServer := keeper.NewMsgServerImpl(s.App.AutopilotKeeper)
+	authority := s.App.AutopilotKeeper.GetAuthority()
+	channelId := "channel-0"
+
+	policy := types.ChannelPolicy{
+		ChannelId:      channelId,
+		AllowedActions: []string{types.LiquidStake},
+		DenomLimits: []types.DenomLimit{
+			{Denom: "denom", MaxAmountPerPacket: sdkmath.ZeroInt(), SenderQuota: sdkmath.NewInt(10)},
+		},
+		QuotaWindowSeconds: 10,
+	}
+
+	// Setting a policy from a non-authority address should fail
+	_, err := msgServer.SetChannelPolicy(s.Ctx, types.NewMsgSetChannelPolicy("invalid", policy))
+	s.Require().ErrorContains(err, "invalid authority")
+
+	// Set the policy and use up some of the sender's quota
+	_, err = msgServer.SetChannelPolicy(s.Ctx, types.NewMsgSetChannelPolicy(authority, policy))
+	s.Require().NoError(err, "no error expected when setting policy")
+
+	err = s.App.AutopilotKeeper.CheckAndUpdateChannelPolicy(s.Ctx, channelId, "sender", types.LiquidStake, "denom", sdkmath.NewInt(5))
+	s.Require().NoError(err, "no error expected when checking policy")
+
+	// Remove the policy and confirm the quota was removed as well
+	_, err = msgServer.RemoveChannelPolicy(s.Ctx, types.NewMsgRemoveChannelPolicy(authority, channelId))
+	s.Require().NoError(err, "no error expected when removing policy")
+
+	_, found := s.App.AutopilotKeeper.GetChannelPolicy(s.Ctx, channelId)
+	s.Require().False(found, "policy should have been removed")
+	_, found = s.App.AutopilotKeeper.GetSenderQuota(s.Ctx, channelId, "sender", "denom")
+	s.Require().False(found, "sender quota should have been removed")
+
+	// Removing it again should fail
+	_, err = msgServer.RemoveChannelPolicy(s.Ctx, types.NewMsgRemoveChannelPolicy(authority, channelId))
+	s.Require().ErrorIs(err, types.ErrChannelPolicyNotFound)
+}
@@ -0,0 +1,24 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+
+	"github.com/Stride-Labs/stride/v24/x/autopilot/types"
+)
+
+// Emits an event after a contract has been notified of a transfer's outcome
+func EmitContractCallbackEvent(ctx sdk.Context, contractAddress string, packet channeltypes.Packet, success bool) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeContractCallback,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeKeyContractAddress, contractAddress),
+			sdk.NewAttribute(types.AttributeKeyChannelId, packet.SourceChannel),
+			sdk.NewAttribute(types.AttributeKeySequence, fmt.Sprintf("%d", packet.Sequence)),
+			sdk.NewAttribute(types.AttributeKeyCallbackSuccess, fmt.Sprintf("%t", success)),
+		),
+	)
+}
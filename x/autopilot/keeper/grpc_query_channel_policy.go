@@ -0,0 +1,58 @@
+package keeper
+
+import (
+	"context"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/Stride-Labs/stride/v24/x/autopilot/types"
+)
+
+// Queries the autopilot policy for a specific channel
+func (k Keeper) ChannelPolicy(c context.Context, req *types.QueryChannelPolicyRequest) (*types.QueryChannelPolicyResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	policy, found := k.GetChannelPolicy(ctx, req.ChannelId)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "channel policy not found for %s", req.ChannelId)
+	}
+
+	return &types.QueryChannelPolicyResponse{Policy: policy}, nil
+}
+
+// Queries all autopilot channel policies
+func (k Keeper) AllChannelPolicies(c context.Context, req *types.QueryAllChannelPoliciesRequest) (*types.QueryAllChannelPoliciesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryAllChannelPoliciesResponse{Policies: k.GetAllChannelPolicies(ctx)}, nil
+}
+
+// Queries the current quota usage of a sender on a channel for a denom
+// If the sender has not used autopilot with the denom on the channel, an empty quota is returned
+func (k Keeper) SenderQuota(c context.Context, req *types.QuerySenderQuotaRequest) (*types.QuerySenderQuotaResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	senderQuota, found := k.GetSenderQuota(ctx, req.ChannelId, req.Sender, req.Denom)
+	if !found {
+		senderQuota = types.SenderQuota{
+			ChannelId:  req.ChannelId,
+			Sender:     req.Sender,
+			Denom:      req.Denom,
+			AmountUsed: sdkmath.ZeroInt(),
+		}
+	}
+
+	return &types.QuerySenderQuotaResponse{SenderQuota: senderQuota}, nil
+}
@@ -9,8 +9,9 @@ import (
 func (s *KeeperTestSuite) TestParamsQuery() {
 	// Test with stakeibc enabled and claim disabled
 	s.App.AutopilotKeeper.SetParams(s.Ctx, types.Params{
-		StakeibcActive: true,
-		ClaimActive:    false,
+		StakeibcActive:           true,
+		ClaimActive:              false,
+		ContractCallbackGasLimit: types.DefaultContractCallbackGasLimit,
 	})
 	queryResponse, err := s.QueryClient.Params(context.Background(), &types.QueryParamsRequest{})
 	s.Require().NoError(err)
@@ -19,8 +20,9 @@ func (s *KeeperTestSuite) TestParamsQuery() {
 
 	// Test with claim enabled and stakeibc disabled
 	s.App.AutopilotKeeper.SetParams(s.Ctx, types.Params{
-		StakeibcActive: false,
-		ClaimActive:    true,
+		StakeibcActive:           false,
+		ClaimActive:              true,
+		ContractCallbackGasLimit: types.DefaultContractCallbackGasLimit,
 	})
 	queryResponse, err = s.QueryClient.Params(context.Background(), &types.QueryParamsRequest{})
 	s.Require().NoError(err)
@@ -0,0 +1,21 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/Stride-Labs/stride/v24/x/autopilot/types"
+)
+
+// Queries all swap routes that can be used with SwapAndLiquidStake
+func (k Keeper) AllSwapRoutes(c context.Context, req *types.QueryAllSwapRoutesRequest) (*types.QueryAllSwapRoutesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryAllSwapRoutesResponse{SwapRoutes: k.GetAllSwapRoutes(ctx)}, nil
+}
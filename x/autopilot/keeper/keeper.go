@@ -17,13 +17,16 @@ import (
 
 type (
 	Keeper struct {
-		Cdc            codec.BinaryCodec
-		storeKey       storetypes.StoreKey
-		paramstore     paramtypes.Subspace
-		bankKeeper     types.BankKeeper
-		stakeibcKeeper stakeibckeeper.Keeper
-		claimKeeper    claimkeeper.Keeper
-		transferKeeper types.IbcTransferKeeper
+		Cdc                codec.BinaryCodec
+		storeKey           storetypes.StoreKey
+		paramstore         paramtypes.Subspace
+		authority          string
+		bankKeeper         types.BankKeeper
+		stakeibcKeeper     stakeibckeeper.Keeper
+		claimKeeper        claimkeeper.Keeper
+		transferKeeper     types.IbcTransferKeeper
+		contractKeeper     types.ContractKeeper
+		icaCallbacksKeeper types.ICACallbacksKeeper
 	}
 )
 
@@ -31,10 +34,13 @@ func NewKeeper(
 	Cdc codec.BinaryCodec,
 	storeKey storetypes.StoreKey,
 	ps paramtypes.Subspace,
+	authority string,
 	bankKeeper types.BankKeeper,
 	stakeibcKeeper stakeibckeeper.Keeper,
 	claimKeeper claimkeeper.Keeper,
 	transferKeeper types.IbcTransferKeeper,
+	contractKeeper types.ContractKeeper,
+	icaCallbacksKeeper types.ICACallbacksKeeper,
 ) *Keeper {
 	// set KeyTable if it has not already been set
 	if !ps.HasKeyTable() {
@@ -42,16 +48,24 @@ func NewKeeper(
 	}
 
 	return &Keeper{
-		Cdc:            Cdc,
-		storeKey:       storeKey,
-		paramstore:     ps,
-		bankKeeper:     bankKeeper,
-		stakeibcKeeper: stakeibcKeeper,
-		claimKeeper:    claimKeeper,
-		transferKeeper: transferKeeper,
+		Cdc:                Cdc,
+		storeKey:           storeKey,
+		paramstore:         ps,
+		authority:          authority,
+		bankKeeper:         bankKeeper,
+		stakeibcKeeper:     stakeibcKeeper,
+		claimKeeper:        claimKeeper,
+		transferKeeper:     transferKeeper,
+		contractKeeper:     contractKeeper,
+		icaCallbacksKeeper: icaCallbacksKeeper,
 	}
 }
 
 func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
 }
+
+// GetAuthority returns the x/autopilot module's authority.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
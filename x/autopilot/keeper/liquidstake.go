@@ -106,7 +106,8 @@ func (k Keeper) RunLiquidStake(
 }
 
 // Submits an IBC transfer of the stToken to a non-stride zone (either back to the host zone or to a different zone)
-// The sender of the transfer is the hashed receiver of the original autopilot inbound transfer
+// The sender of the transfer is the hashed receiver of the original autopilot inbound transfer,
+// or the contract if the liquid stake was initiated by a contract
 func (k Keeper) IBCTransferStToken(
 	ctx sdk.Context,
 	stToken sdk.Coin,
@@ -138,6 +139,13 @@ func (k Keeper) IBCTransferStToken(
 		Memo:             "autopilot-liquid-stake-and-forward",
 	}
 
+	// If the liquid stake was initiated by a contract, the contract is notified when the transfer
+	// completes, and since the contract is the sender, any refund is returned directly to the contract
+	if autopilotMetadata.ContractAddress != "" {
+		_, err := k.IBCTransferWithContractCallback(ctx, autopilotMetadata.ContractAddress, transferMsg)
+		return err
+	}
+
 	transferResponse, err := k.transferKeeper.Transfer(sdk.WrapSDKContext(ctx), transferMsg)
 	if err != nil {
 		return errorsmod.Wrapf(err, "failed to submit transfer during autopilot liquid stake and forward")
@@ -0,0 +1,93 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/Stride-Labs/stride/v24/x/autopilot/types"
+)
+
+type msgServer struct {
+	Keeper
+}
+
+// NewMsgServerImpl returns an implementation of the MsgServer interface
+// for the provided Keeper.
+func NewMsgServerImpl(keeper Keeper) types.MsgServer {
+	return &msgServer{Keeper: keeper}
+}
+
+var _ types.MsgServer = msgServer{}
+
+// Adds or updates the autopilot policy for a channel
+func (ms msgServer) SetChannelPolicy(goCtx context.Context, msg *types.MsgSetChannelPolicy) (*types.MsgSetChannelPolicyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if ms.authority != msg.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", ms.authority, msg.Authority)
+	}
+
+	if err := msg.Policy.Validate(); err != nil {
+		return nil, err
+	}
+
+	ms.Keeper.SetChannelPolicy(ctx, msg.Policy)
+
+	return &types.MsgSetChannelPolicyResponse{}, nil
+}
+
+// Removes the autopilot policy for a channel, along with the quota usage of each sender
+func (ms msgServer) RemoveChannelPolicy(goCtx context.Context, msg *types.MsgRemoveChannelPolicy) (*types.MsgRemoveChannelPolicyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if ms.authority != msg.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", ms.authority, msg.Authority)
+	}
+
+	if _, found := ms.Keeper.GetChannelPolicy(ctx, msg.ChannelId); !found {
+		return nil, errorsmod.Wrapf(types.ErrChannelPolicyNotFound, "channel %s", msg.ChannelId)
+	}
+
+	ms.Keeper.RemoveChannelPolicy(ctx, msg.ChannelId)
+	ms.Keeper.RemoveSenderQuotasForChannel(ctx, msg.ChannelId)
+
+	return &types.MsgRemoveChannelPolicyResponse{}, nil
+}
+
+// Adds or updates a swap route used by SwapAndLiquidStake
+// The output denom of the route must belong to a registered host zone
+func (ms msgServer) SetSwapRoute(goCtx context.Context, msg *types.MsgSetSwapRoute) (*types.MsgSetSwapRouteResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if ms.authority != msg.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", ms.authority, msg.Authority)
+	}
+
+	if err := msg.SwapRoute.Validate(); err != nil {
+		return nil, err
+	}
+
+	if _, err := ms.Keeper.stakeibcKeeper.GetHostZoneFromHostDenom(ctx, msg.SwapRoute.HostDenom); err != nil {
+		return nil, errorsmod.Wrapf(types.ErrInvalidSwapRoute, "no host zone found for %s", msg.SwapRoute.HostDenom)
+	}
+
+	ms.Keeper.SetSwapRoute(ctx, msg.SwapRoute)
+
+	return &types.MsgSetSwapRouteResponse{}, nil
+}
+
+// Removes a swap route
+func (ms msgServer) RemoveSwapRoute(goCtx context.Context, msg *types.MsgRemoveSwapRoute) (*types.MsgRemoveSwapRouteResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if ms.authority != msg.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", ms.authority, msg.Authority)
+	}
+
+	if _, found := ms.Keeper.GetSwapRoute(ctx, msg.InputDenom, msg.HostDenom); !found {
+		return nil, errorsmod.Wrapf(types.ErrSwapRouteNotFound, "from %s to %s", msg.InputDenom, msg.HostDenom)
+	}
+
+	ms.Keeper.RemoveSwapRoute(ctx, msg.InputDenom, msg.HostDenom)
+
+	return &types.MsgRemoveSwapRouteResponse{}, nil
+}
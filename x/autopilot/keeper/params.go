@@ -16,3 +16,9 @@ func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
 func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
 	k.paramstore.SetParamSet(ctx, &params)
 }
+
+// Sets the contract callback gas limit param, without overwriting the other params
+// (used to add the param during an upgrade, before it's present in the store)
+func (k Keeper) SetContractCallbackGasLimit(ctx sdk.Context, gasLimit uint64) {
+	k.paramstore.Set(ctx, types.KeyContractCallbackGasLimit, gasLimit)
+}
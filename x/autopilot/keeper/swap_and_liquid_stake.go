@@ -0,0 +1,149 @@
+package keeper
+
+import (
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	transfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+
+	"github.com/Stride-Labs/stride/v24/x/autopilot/types"
+)
+
+// Stores a swap route
+func (k Keeper) SetSwapRoute(ctx sdk.Context, swapRoute types.SwapRoute) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.SwapRoutePrefix)
+	key := types.GetSwapRouteKey(swapRoute.InputDenom, swapRoute.HostDenom)
+	swapRouteBz := k.Cdc.MustMarshal(&swapRoute)
+	store.Set(key, swapRouteBz)
+}
+
+// Returns the swap route between an inbound denom and a host zone's native denom
+// If the route has not been whitelisted, returns false
+func (k Keeper) GetSwapRoute(ctx sdk.Context, inputDenom, hostDenom string) (swapRoute types.SwapRoute, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.SwapRoutePrefix)
+
+	swapRouteBz := store.Get(types.GetSwapRouteKey(inputDenom, hostDenom))
+	if len(swapRouteBz) == 0 {
+		return swapRoute, false
+	}
+
+	k.Cdc.MustUnmarshal(swapRouteBz, &swapRoute)
+	return swapRoute, true
+}
+
+// Returns all whitelisted swap routes
+func (k Keeper) GetAllSwapRoutes(ctx sdk.Context) []types.SwapRoute {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.SwapRoutePrefix)
+
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	swapRoutes := []types.SwapRoute{}
+	for ; iterator.Valid(); iterator.Next() {
+		var swapRoute types.SwapRoute
+		k.Cdc.MustUnmarshal(iterator.Value(), &swapRoute)
+		swapRoutes = append(swapRoutes, swapRoute)
+	}
+
+	return swapRoutes
+}
+
+// Removes a swap route
+func (k Keeper) RemoveSwapRoute(ctx sdk.Context, inputDenom, hostDenom string) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.SwapRoutePrefix)
+	store.Delete(types.GetSwapRouteKey(inputDenom, hostDenom))
+}
+
+// Attempts to do an autopilot swap and liquid stake (and optional forward)
+// The inbound tokens are swapped to the host zone's native token through the whitelisted
+// DEX contract, and the swap output is then liquid staked from the transfer receiver
+func (k Keeper) TrySwapAndLiquidStake(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	transferMetadata transfertypes.FungibleTokenPacketData,
+	autopilotMetadata types.StakeibcPacketMetadata,
+) error {
+	params := k.GetParams(ctx)
+	if !params.StakeibcActive {
+		return errorsmod.Wrapf(types.ErrPacketForwardingInactive, "autopilot stakeibc routing is inactive")
+	}
+
+	amount, ok := sdk.NewIntFromString(transferMetadata.Amount)
+	if !ok {
+		return fmt.Errorf("not a parsable amount field")
+	}
+	minSwapAmountOut, ok := sdk.NewIntFromString(autopilotMetadata.MinSwapAmountOut)
+	if !ok {
+		return fmt.Errorf("not a parsable min swap amount out field")
+	}
+
+	// Determine the denom of the inbound tokens on stride
+	inputDenom := types.GetDenomOnStride(packet, transferMetadata.Denom)
+
+	hostZone, err := k.stakeibcKeeper.GetHostZoneFromHostDenom(ctx, autopilotMetadata.HostDenom)
+	if err != nil {
+		return err
+	}
+
+	swapRoute, found := k.GetSwapRoute(ctx, inputDenom, hostZone.HostDenom)
+	if !found {
+		return errorsmod.Wrapf(types.ErrSwapRouteNotFound, "from %s to %s", inputDenom, hostZone.HostDenom)
+	}
+
+	receiver, err := sdk.AccAddressFromBech32(transferMetadata.Receiver)
+	if err != nil {
+		return err
+	}
+	swapAmountOut, err := k.Swap(ctx, swapRoute, receiver, sdk.NewCoin(inputDenom, amount), hostZone.IbcDenom, minSwapAmountOut)
+	if err != nil {
+		return err
+	}
+
+	// The liquid stake and forward use the host denom from the transfer metadata,
+	// so it's updated to reflect the output of the swap
+	stakeTransferMetadata := transferMetadata
+	stakeTransferMetadata.Denom = hostZone.HostDenom
+	stakeTransferMetadata.Amount = swapAmountOut.String()
+
+	return k.RunLiquidStake(ctx, swapAmountOut, stakeTransferMetadata, autopilotMetadata)
+}
+
+// Executes a swap against the route's DEX contract on behalf of the swapper,
+// and returns the amount of the output denom that was received
+// The output amount is determined from the swapper's balance so that it does
+// not rely on the contract's response format
+func (k Keeper) Swap(
+	ctx sdk.Context,
+	swapRoute types.SwapRoute,
+	swapper sdk.AccAddress,
+	tokenIn sdk.Coin,
+	outputDenom string,
+	minAmountOut sdkmath.Int,
+) (amountOut sdkmath.Int, err error) {
+	contractAddress, err := sdk.AccAddressFromBech32(swapRoute.ContractAddress)
+	if err != nil {
+		return amountOut, errorsmod.Wrapf(types.ErrInvalidSwapRoute, "invalid contract address: %s", err.Error())
+	}
+
+	swapMsg, err := types.NewSwapContractMsg(outputDenom, minAmountOut)
+	if err != nil {
+		return amountOut, errorsmod.Wrapf(err, "unable to serialize swap message")
+	}
+
+	balanceBefore := k.bankKeeper.GetBalance(ctx, swapper, outputDenom).Amount
+	if _, err := k.contractKeeper.Execute(ctx, contractAddress, swapper, swapMsg, sdk.NewCoins(tokenIn)); err != nil {
+		return amountOut, errorsmod.Wrapf(types.ErrSwapFailed, err.Error())
+	}
+	balanceAfter := k.bankKeeper.GetBalance(ctx, swapper, outputDenom).Amount
+
+	amountOut = balanceAfter.Sub(balanceBefore)
+	if amountOut.LT(minAmountOut) {
+		return amountOut, errorsmod.Wrapf(types.ErrSwapFailed, "swap output %v is less than the min amount out %v", amountOut, minAmountOut)
+	}
+
+	return amountOut, nil
+}
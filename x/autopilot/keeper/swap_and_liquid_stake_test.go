@@ -0,0 +1,386 @@
+package keeper_test
+
+import (
+	"encoding/json"
+	"fmt"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/ibc-go/v7/modules/apps/transfer"
+	transfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+	ibctesting "github.com/cosmos/ibc-go/v7/testing"
+
+	"github.com/Stride-Labs/stride/v24/app/apptesting"
+	"github.com/Stride-Labs/stride/v24/x/autopilot"
+	"github.com/Stride-Labs/stride/v24/x/autopilot/keeper"
+	"github.com/Stride-Labs/stride/v24/x/autopilot/types"
+	recordsmodule "github.com/Stride-Labs/stride/v24/x/records"
+	stakeibctypes "github.com/Stride-Labs/stride/v24/x/stakeibc/types"
+)
+
+// Mocks out a DEX contract for the swap step of SwapAndLiquidStake
+// When executed, the contract takes the caller's input tokens and sends back a fixed amount
+// of the output denom from the contract's balance
+type MockSwapContractKeeper struct {
+	bankKeeper   types.BankKeeper
+	amountOut    sdkmath.Int
+	executedMsgs [][]byte
+}
+
+func (m *MockSwapContractKeeper) Execute(
+	ctx sdk.Context,
+	contractAddress sdk.AccAddress,
+	caller sdk.AccAddress,
+	msg []byte,
+	coins sdk.Coins,
+) ([]byte, error) {
+	m.executedMsgs = append(m.executedMsgs, msg)
+
+	var swapMsg types.SwapContractMsg
+	if err := json.Unmarshal(msg, &swapMsg); err != nil {
+		return nil, err
+	}
+
+	if err := m.bankKeeper.SendCoins(ctx, caller, contractAddress, coins); err != nil {
+		return nil, err
+	}
+	tokenOut := sdk.NewCoins(sdk.NewCoin(swapMsg.Swap.OutputDenom, m.amountOut))
+	if err := m.bankKeeper.SendCoins(ctx, contractAddress, caller, tokenOut); err != nil {
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+func (m *MockSwapContractKeeper) Sudo(ctx sdk.Context, contractAddress sdk.AccAddress, msg []byte) ([]byte, error) {
+	return nil, nil
+}
+
+// Helper function to create the autopilot JSON payload for a swap and liquid stake
+func getSwapAndLiquidStakePacketMetadata(receiver, hostDenom, minSwapAmountOut string) string {
+	return fmt.Sprintf(`
+		{
+			"autopilot": {
+				"receiver": "%[1]s",
+				"stakeibc": { "action": "SwapAndLiquidStake", "host_denom": "%[2]s", "min_swap_amount_out": "%[3]s" }
+			}
+		}`, receiver, hostDenom, minSwapAmountOut)
+}
+
+// Helper function to mock out the state needed to test autopilot swap and liquid stake
+// In addition to the liquid stake setup, a swap route is registered from USDC (received on channel-0)
+// to the host denom, and the DEX contract is funded with the host zone's IBC denom so that it can
+// pay out the swap
+//
+// Returns an autopilot keeper that uses the mocked DEX contract, as well as the IBC denoms of
+// the swap input and the host zone's native token
+func (s *KeeperTestSuite) SetupAutopilotSwapAndLiquidStake(
+	depositAddress sdk.AccAddress,
+	liquidStaker sdk.AccAddress,
+	contractAddress sdk.AccAddress,
+	mockContractKeeper *MockSwapContractKeeper,
+) (autopilotKeeper keeper.Keeper, usdcIbcDenom, nativeTokenIBCDenom string) {
+	nativeTokenIBCDenom = s.SetupAutopilotLiquidStake(true, ibctesting.FirstChannelID, depositAddress, liquidStaker)
+
+	usdcIbcDenom = transfertypes.ParseDenomTrace(
+		transfertypes.GetPrefixedDenom(transfertypes.PortID, ibctesting.FirstChannelID, "uusdc")).IBCDenom()
+	s.App.AutopilotKeeper.SetSwapRoute(s.Ctx, types.SwapRoute{
+		InputDenom:      usdcIbcDenom,
+		HostDenom:       HostDenom,
+		ContractAddress: contractAddress.String(),
+	})
+	s.FundAccount(contractAddress, sdk.NewCoin(nativeTokenIBCDenom, sdkmath.NewInt(1_000_000)))
+
+	mockContractKeeper.bankKeeper = s.App.BankKeeper
+	autopilotKeeper = *keeper.NewKeeper(
+		s.App.AppCodec(),
+		s.App.GetKey(types.StoreKey),
+		s.App.GetSubspace(types.ModuleName),
+		s.App.AutopilotKeeper.GetAuthority(),
+		s.App.BankKeeper,
+		s.App.StakeibcKeeper,
+		s.App.ClaimKeeper,
+		s.App.TransferKeeper,
+		mockContractKeeper,
+		s.App.IcacallbacksKeeper,
+	)
+
+	return autopilotKeeper, usdcIbcDenom, nativeTokenIBCDenom
+}
+
+// Tests Get/Set/RemoveSwapRoute
+func (s *KeeperTestSuite) TestSwapRoutes() {
+	contractAddress := s.TestAccs[0].String()
+	swapRoutes := []types.SwapRoute{
+		{InputDenom: "ibc/usdc", HostDenom: Atom, ContractAddress: contractAddress},
+		{InputDenom: "ibc/usdc", HostDenom: Osmo, ContractAddress: contractAddress},
+		{InputDenom: "ibc/usdt", HostDenom: Atom, ContractAddress: contractAddress},
+	}
+	for _, swapRoute := range swapRoutes {
+		s.App.AutopilotKeeper.SetSwapRoute(s.Ctx, swapRoute)
+	}
+
+	for _, expected := range swapRoutes {
+		actual, found := s.App.AutopilotKeeper.GetSwapRoute(s.Ctx, expected.InputDenom, expected.HostDenom)
+		s.Require().True(found, "swap route from %s to %s should have been found", expected.InputDenom, expected.HostDenom)
+		s.Require().Equal(expected, actual, "swap route from %s to %s", expected.InputDenom, expected.HostDenom)
+	}
+	s.Require().ElementsMatch(swapRoutes, s.App.AutopilotKeeper.GetAllSwapRoutes(s.Ctx), "all swap routes")
+
+	s.App.AutopilotKeeper.RemoveSwapRoute(s.Ctx, "ibc/usdc", Osmo)
+	_, found := s.App.AutopilotKeeper.GetSwapRoute(s.Ctx, "ibc/usdc", Osmo)
+	s.Require().False(found, "swap route should have been removed")
+	s.Require().Len(s.App.AutopilotKeeper.GetAllSwapRoutes(s.Ctx), 2, "number of swap routes after removal")
+}
+
+func (s *KeeperTestSuite) TestSetSwapRouteMsg() {
+	msgServer := keeper.NewMsgServerImpl(s.App.AutopilotKeeper)
+	authority := s.App.AutopilotKeeper.GetAuthority()
+
+	s.App.StakeibcKeeper.SetHostZone(s.Ctx, stakeibctypes.HostZone{
+		ChainId:   HostChainId,
+		HostDenom: Atom,
+	})
+
+	swapRoute := types.SwapRoute{
+		InputDenom:      "ibc/usdc",
+		HostDenom:       Atom,
+		ContractAddress: s.TestAccs[0].String(),
+	}
+
+	// Invalid authority
+	_, err := msgServer.SetSwapRoute(s.Ctx, types.NewMsgSetSwapRoute("invalid", swapRoute))
+	s.Require().ErrorContains(err, "invalid authority")
+
+	// Host denom without a host zone
+	invalidSwapRoute := swapRoute
+	invalidSwapRoute.HostDenom = Osmo
+	_, err = msgServer.SetSwapRoute(s.Ctx, types.NewMsgSetSwapRoute(authority, invalidSwapRoute))
+	s.Require().ErrorContains(err, "no host zone found")
+
+	// Successful set and remove
+	_, err = msgServer.SetSwapRoute(s.Ctx, types.NewMsgSetSwapRoute(authority, swapRoute))
+	s.Require().NoError(err, "no error expected when setting swap route")
+
+	_, found := s.App.AutopilotKeeper.GetSwapRoute(s.Ctx, swapRoute.InputDenom, swapRoute.HostDenom)
+	s.Require().True(found, "swap route should have been set")
+
+	_, err = msgServer.RemoveSwapRoute(s.Ctx, types.NewMsgRemoveSwapRoute(authority, swapRoute.InputDenom, swapRoute.HostDenom))
+	s.Require().NoError(err, "no error expected when removing swap route")
+
+	_, err = msgServer.RemoveSwapRoute(s.Ctx, types.NewMsgRemoveSwapRoute(authority, swapRoute.InputDenom, swapRoute.HostDenom))
+	s.Require().ErrorIs(err, types.ErrSwapRouteNotFound)
+}
+
+func (s *KeeperTestSuite) TestTrySwapAndLiquidStake_Failures() {
+	receiver := s.TestAccs[0].String()
+	packet := channeltypes.Packet{
+		SourcePort:         transfertypes.PortID,
+		SourceChannel:      "channel-1000",
+		DestinationPort:    transfertypes.PortID,
+		DestinationChannel: "channel-0",
+	}
+	transferMetadata := transfertypes.FungibleTokenPacketData{
+		Denom:    "uusdc",
+		Amount:   "1000",
+		Receiver: receiver,
+	}
+	autopilotMetadata := types.StakeibcPacketMetadata{
+		Action:           types.SwapAndLiquidStake,
+		StrideAddress:    receiver,
+		HostDenom:        Atom,
+		MinSwapAmountOut: "900",
+	}
+
+	// Autopilot disabled
+	s.App.AutopilotKeeper.SetParams(s.Ctx, types.Params{StakeibcActive: false, ContractCallbackGasLimit: types.DefaultContractCallbackGasLimit})
+	err := s.App.AutopilotKeeper.TrySwapAndLiquidStake(s.Ctx, packet, transferMetadata, autopilotMetadata)
+	s.Require().ErrorIs(err, types.ErrPacketForwardingInactive)
+	s.App.AutopilotKeeper.SetParams(s.Ctx, types.Params{StakeibcActive: true, ContractCallbackGasLimit: types.DefaultContractCallbackGasLimit})
+
+	// No host zone
+	err = s.App.AutopilotKeeper.TrySwapAndLiquidStake(s.Ctx, packet, transferMetadata, autopilotMetadata)
+	s.Require().ErrorContains(err, "No HostZone for uatom denom found")
+
+	// No swap route
+	s.App.StakeibcKeeper.SetHostZone(s.Ctx, stakeibctypes.HostZone{
+		ChainId:   HostChainId,
+		HostDenom: Atom,
+		IbcDenom:  "ibc/atom",
+	})
+	err = s.App.AutopilotKeeper.TrySwapAndLiquidStake(s.Ctx, packet, transferMetadata, autopilotMetadata)
+	s.Require().ErrorIs(err, types.ErrSwapRouteNotFound)
+
+	// Swap route to an address that's not a contract
+	usdcIbcDenom := transfertypes.ParseDenomTrace(
+		transfertypes.GetPrefixedDenom(transfertypes.PortID, "channel-0", "uusdc")).IBCDenom()
+	s.App.AutopilotKeeper.SetSwapRoute(s.Ctx, types.SwapRoute{
+		InputDenom:      usdcIbcDenom,
+		HostDenom:       Atom,
+		ContractAddress: apptesting.CreateRandomAccounts(1)[0].String(),
+	})
+	s.FundAccount(s.TestAccs[0], sdk.NewCoin(usdcIbcDenom, sdkmath.NewInt(1000)))
+	err = s.App.AutopilotKeeper.TrySwapAndLiquidStake(s.Ctx, packet, transferMetadata, autopilotMetadata)
+	s.Require().ErrorIs(err, types.ErrSwapFailed)
+}
+
+func (s *KeeperTestSuite) TestTrySwapAndLiquidStake_Successful() {
+	liquidStaker := s.TestAccs[0]
+	depositAddress := s.TestAccs[1]
+	contractAddress := s.TestAccs[2]
+
+	swapAmountIn := sdkmath.NewInt(1000)
+	swapAmountOut := sdkmath.NewInt(950)
+	minSwapAmountOut := sdkmath.NewInt(900)
+
+	mockContractKeeper := &MockSwapContractKeeper{amountOut: swapAmountOut}
+	autopilotKeeper, usdcIbcDenom, nativeTokenIBCDenom := s.SetupAutopilotSwapAndLiquidStake(
+		depositAddress, liquidStaker, contractAddress, mockContractKeeper)
+
+	// Fund the staker with the inbound tokens (mimicking the transfer that's processed
+	// before the autopilot action)
+	s.FundAccount(liquidStaker, sdk.NewCoin(usdcIbcDenom, swapAmountIn))
+
+	packet := channeltypes.Packet{
+		SourcePort:         transfertypes.PortID,
+		SourceChannel:      SourceChannelOnHost,
+		DestinationPort:    transfertypes.PortID,
+		DestinationChannel: ibctesting.FirstChannelID,
+	}
+	transferMetadata := transfertypes.FungibleTokenPacketData{
+		Denom:    "uusdc",
+		Amount:   swapAmountIn.String(),
+		Receiver: liquidStaker.String(),
+	}
+	autopilotMetadata := types.StakeibcPacketMetadata{
+		Action:           types.SwapAndLiquidStake,
+		StrideAddress:    liquidStaker.String(),
+		HostDenom:        HostDenom,
+		MinSwapAmountOut: minSwapAmountOut.String(),
+	}
+
+	// If the swap output is less than the min amount out, it should fail
+	// (this is run on a cached context so it doesn't affect the successful case below)
+	cacheCtx, _ := s.Ctx.CacheContext()
+	mockContractKeeper.amountOut = minSwapAmountOut.Sub(sdkmath.OneInt())
+	err := autopilotKeeper.TrySwapAndLiquidStake(cacheCtx, packet, transferMetadata, autopilotMetadata)
+	s.Require().ErrorIs(err, types.ErrSwapFailed)
+	s.Require().ErrorContains(err, "less than the min amount out")
+
+	// Then execute the swap and liquid stake with an output above the min amount out
+	mockContractKeeper.amountOut = swapAmountOut
+	mockContractKeeper.executedMsgs = [][]byte{}
+	err = autopilotKeeper.TrySwapAndLiquidStake(s.Ctx, packet, transferMetadata, autopilotMetadata)
+	s.Require().NoError(err, "no error expected when swapping and liquid staking")
+
+	// Confirm the contract was called with the host zone's IBC denom and the min amount out
+	expectedSwapMsg, err := types.NewSwapContractMsg(nativeTokenIBCDenom, minSwapAmountOut)
+	s.Require().NoError(err, "no error expected when building swap msg")
+	s.Require().Equal([][]byte{expectedSwapMsg}, mockContractKeeper.executedMsgs, "swap contract msgs")
+
+	// Confirm the inbound tokens were sent to the contract
+	stakerUsdcBalance := s.App.BankKeeper.GetBalance(s.Ctx, liquidStaker, usdcIbcDenom)
+	s.Require().Zero(stakerUsdcBalance.Amount.Int64(), "staker usdc balance")
+	contractUsdcBalance := s.App.BankKeeper.GetBalance(s.Ctx, contractAddress, usdcIbcDenom)
+	s.Require().Equal(swapAmountIn.Int64(), contractUsdcBalance.Amount.Int64(), "contract usdc balance")
+
+	// Confirm the swap output (not the inbound amount) was liquid staked with the host denom
+	s.CheckLiquidStakeSucceeded(swapAmountOut, liquidStaker, depositAddress, nativeTokenIBCDenom, "")
+}
+
+// Tests the full OnRecvPacket callback, with swap and liquid stake specific test cases
+func (s *KeeperTestSuite) TestOnRecvPacket_SwapAndLiquidStake() {
+	liquidStaker := s.TestAccs[0]
+	depositAddress := s.TestAccs[1]
+	contractAddress := s.TestAccs[2]
+
+	swapAmountIn := sdkmath.NewInt(1000)
+	swapAmountOut := sdkmath.NewInt(950)
+
+	testCases := []struct {
+		name              string
+		transferDenom     string
+		minSwapAmountOut  string
+		removeSwapRoute   bool
+		expectedSuccess   bool
+		expectedSwapCount int
+	}{
+		{
+			name:              "successful swap and liquid stake",
+			transferDenom:     "uusdc",
+			minSwapAmountOut:  "900",
+			expectedSuccess:   true,
+			expectedSwapCount: 1,
+		},
+		{
+			name:              "swap output below min amount out",
+			transferDenom:     "uusdc",
+			minSwapAmountOut:  "951",
+			expectedSuccess:   false,
+			expectedSwapCount: 1,
+		},
+		{
+			name:              "no swap route",
+			transferDenom:     "uusdc",
+			minSwapAmountOut:  "900",
+			removeSwapRoute:   true,
+			expectedSuccess:   false,
+			expectedSwapCount: 0,
+		},
+		{
+			name:              "swap route for a different denom",
+			transferDenom:     "uusdt",
+			minSwapAmountOut:  "900",
+			expectedSuccess:   false,
+			expectedSwapCount: 0,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest() // reset
+
+			mockContractKeeper := &MockSwapContractKeeper{amountOut: swapAmountOut}
+			autopilotKeeper, usdcIbcDenom, nativeTokenIBCDenom := s.SetupAutopilotSwapAndLiquidStake(
+				depositAddress, liquidStaker, contractAddress, mockContractKeeper)
+
+			if tc.removeSwapRoute {
+				s.App.AutopilotKeeper.RemoveSwapRoute(s.Ctx, usdcIbcDenom, HostDenom)
+			}
+
+			transferMetadata := transfertypes.FungibleTokenPacketData{
+				Sender:   HostAddress,
+				Receiver: liquidStaker.String(),
+				Denom:    tc.transferDenom,
+				Amount:   swapAmountIn.String(),
+				Memo:     getSwapAndLiquidStakePacketMetadata(liquidStaker.String(), HostDenom, tc.minSwapAmountOut),
+			}
+			packet := channeltypes.Packet{
+				SourcePort:         transfertypes.PortID,
+				SourceChannel:      SourceChannelOnHost,
+				DestinationPort:    transfertypes.PortID,
+				DestinationChannel: ibctesting.FirstChannelID,
+				Data:               transfertypes.ModuleCdc.MustMarshalJSON(&transferMetadata),
+			}
+
+			transferIBCModule := transfer.NewIBCModule(s.App.TransferKeeper)
+			recordsStack := recordsmodule.NewIBCModule(s.App.RecordsKeeper, transferIBCModule)
+			routerIBCModule := autopilot.NewIBCModule(autopilotKeeper, recordsStack)
+			ack := routerIBCModule.OnRecvPacket(
+				s.Ctx,
+				packet,
+				s.TestAccs[3], // arbitrary relayer address - not actually used
+			)
+
+			s.Require().Len(mockContractKeeper.executedMsgs, tc.expectedSwapCount, "number of swaps")
+
+			if tc.expectedSuccess {
+				s.Require().True(ack.Success(), "ack should be successful - ack: %+v", string(ack.Acknowledgement()))
+				s.CheckLiquidStakeSucceeded(swapAmountOut, liquidStaker, depositAddress, nativeTokenIBCDenom, "")
+			} else {
+				s.Require().False(ack.Success(), "ack should have failed - ack: %+v", string(ack.Acknowledgement()))
+			}
+		})
+	}
+}
@@ -177,12 +177,27 @@ func (im IBCModule) OnRecvPacket(
 			tokenPacketData.Receiver, autopilotMetadata.Receiver))
 	}
 
-	// For autopilot liquid stake and forward, we'll override the receiver with a hashed address
+	// If the channel has an autopilot policy, confirm the action is allowed and within
+	// the channel's limits for the packet's denom (as it appears on Stride) before routing the packet
+	// If the packet is rejected, the quota update is reverted along with the rest of the packet
+	amount, ok := sdk.NewIntFromString(tokenPacketData.Amount)
+	if !ok {
+		return channeltypes.NewErrorAcknowledgement(errorsmod.Wrapf(types.ErrInvalidPacketMetadata, "invalid amount %s", tokenPacketData.Amount))
+	}
+	action := types.GetRoutingAction(autopilotMetadata.RoutingInfo)
+	denom := types.GetDenomOnStride(packet, tokenPacketData.Denom)
+	if err := im.keeper.CheckAndUpdateChannelPolicy(ctx, packet.DestinationChannel, tokenPacketData.Sender, action, denom, amount); err != nil {
+		im.keeper.Logger(ctx).Error(fmt.Sprintf("Autopilot packet from %s rejected by channel policy: %s", tokenPacketData.Sender, err.Error()))
+		return channeltypes.NewErrorAcknowledgement(err)
+	}
+
+	// For autopilot liquid stake (or swap and liquid stake) and forward, we'll override the receiver with a hashed address
 	// The hashed address will also be the sender of the outbound transfer
 	// This is to prevent impersonation at downstream zones
 	// We can identify the forwarding step by whether there's a non-empty IBC receiver field
 	if routingInfo, ok := autopilotMetadata.RoutingInfo.(types.StakeibcPacketMetadata); ok &&
-		routingInfo.Action == types.LiquidStake && routingInfo.IbcReceiver != "" {
+		(routingInfo.Action == types.LiquidStake || routingInfo.Action == types.SwapAndLiquidStake) &&
+		routingInfo.IbcReceiver != "" {
 
 		var err error
 		hashedReceiver, err := types.GenerateHashedAddress(packet.DestinationChannel, tokenPacketData.Sender)
@@ -227,6 +242,12 @@ func (im IBCModule) OnRecvPacket(
 				im.keeper.Logger(ctx).Error(fmt.Sprintf("Error liquid staking packet from autopilot for %s: %s", sender, err.Error()))
 				return channeltypes.NewErrorAcknowledgement(err)
 			}
+		case types.SwapAndLiquidStake:
+			// Try to swap and then liquid stake - return an ack error if it fails, otherwise return the ack generated from the earlier packet propogation
+			if err := im.keeper.TrySwapAndLiquidStake(ctx, packet, tokenPacketData, routingInfo); err != nil {
+				im.keeper.Logger(ctx).Error(fmt.Sprintf("Error swapping and liquid staking packet from autopilot for %s: %s", sender, err.Error()))
+				return channeltypes.NewErrorAcknowledgement(err)
+			}
 		case types.RedeemStake:
 			// Try to redeem stake - return an ack error if it fails, otherwise return the ack generated from the earlier packet propogation
 			if err := im.keeper.TryRedeemStake(ctx, packet, tokenPacketData, routingInfo); err != nil {
@@ -0,0 +1,1409 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: stride/autopilot/autopilot.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// ChannelPolicy restricts the autopilot actions that can be triggered by
+// packets arriving on a given channel
+// If no policy is registered for a channel, all actions are allowed
+type ChannelPolicy struct {
+	// Channel ID on Stride that the inbound packets are received on
+	ChannelId string `protobuf:"bytes,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	// Autopilot actions that can be triggered from this channel
+	// (e.g. LiquidStake, RedeemStake, Claim)
+	AllowedActions []string `protobuf:"bytes,2,rep,name=allowed_actions,json=allowedActions,proto3" json:"allowed_actions,omitempty"`
+	// Amount limits for each denom that can be sent over this channel
+	// Denoms without a limit are not restricted by amount
+	DenomLimits []DenomLimit `protobuf:"bytes,3,rep,name=denom_limits,json=denomLimits,proto3" json:"denom_limits"`
+	// Length of the sender quota window in seconds
+	QuotaWindowSeconds uint64 `protobuf:"varint,4,opt,name=quota_window_seconds,json=quotaWindowSeconds,proto3" json:"quota_window_seconds,omitempty"`
+}
+
+func (m *ChannelPolicy) Reset()         { *m = ChannelPolicy{} }
+func (m *ChannelPolicy) String() string { return proto.CompactTextString(m) }
+func (*ChannelPolicy) ProtoMessage()    {}
+func (*ChannelPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cf12981bf14863a6, []int{0}
+}
+func (m *ChannelPolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ChannelPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ChannelPolicy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ChannelPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChannelPolicy.Merge(m, src)
+}
+func (m *ChannelPolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *ChannelPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChannelPolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChannelPolicy proto.InternalMessageInfo
+
+func (m *ChannelPolicy) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+func (m *ChannelPolicy) GetAllowedActions() []string {
+	if m != nil {
+		return m.AllowedActions
+	}
+	return nil
+}
+
+func (m *ChannelPolicy) GetDenomLimits() []DenomLimit {
+	if m != nil {
+		return m.DenomLimits
+	}
+	return nil
+}
+
+func (m *ChannelPolicy) GetQuotaWindowSeconds() uint64 {
+	if m != nil {
+		return m.QuotaWindowSeconds
+	}
+	return 0
+}
+
+// DenomLimit caps the amount of a single denom that can be routed through
+// autopilot on a channel
+type DenomLimit struct {
+	// Denom of the inbound token on Stride (e.g. ibc/{hash(transfer/channel-X/uatom)})
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// Max amount that can be included in a single autopilot packet
+	// A value of zero indicates there is no limit
+	MaxAmountPerPacket github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=max_amount_per_packet,json=maxAmountPerPacket,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"max_amount_per_packet"`
+	// Max cumulative amount each sender can route through autopilot on this
+	// channel within a single quota window
+	// A value of zero indicates there is no quota
+	SenderQuota github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=sender_quota,json=senderQuota,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"sender_quota"`
+}
+
+func (m *DenomLimit) Reset()         { *m = DenomLimit{} }
+func (m *DenomLimit) String() string { return proto.CompactTextString(m) }
+func (*DenomLimit) ProtoMessage()    {}
+func (*DenomLimit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cf12981bf14863a6, []int{1}
+}
+func (m *DenomLimit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DenomLimit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DenomLimit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DenomLimit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DenomLimit.Merge(m, src)
+}
+func (m *DenomLimit) XXX_Size() int {
+	return m.Size()
+}
+func (m *DenomLimit) XXX_DiscardUnknown() {
+	xxx_messageInfo_DenomLimit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DenomLimit proto.InternalMessageInfo
+
+func (m *DenomLimit) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+// SenderQuota tracks the amount that a sender has routed through autopilot
+// for a given channel and denom during the current quota window
+type SenderQuota struct {
+	ChannelId  string                                 `protobuf:"bytes,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	Sender     string                                 `protobuf:"bytes,2,opt,name=sender,proto3" json:"sender,omitempty"`
+	Denom      string                                 `protobuf:"bytes,3,opt,name=denom,proto3" json:"denom,omitempty"`
+	AmountUsed github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=amount_used,json=amountUsed,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount_used"`
+	// Unix time (in seconds) of the start of the current window
+	WindowStartTime uint64 `protobuf:"varint,5,opt,name=window_start_time,json=windowStartTime,proto3" json:"window_start_time,omitempty"`
+}
+
+func (m *SenderQuota) Reset()         { *m = SenderQuota{} }
+func (m *SenderQuota) String() string { return proto.CompactTextString(m) }
+func (*SenderQuota) ProtoMessage()    {}
+func (*SenderQuota) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cf12981bf14863a6, []int{2}
+}
+func (m *SenderQuota) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SenderQuota) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SenderQuota.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SenderQuota) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SenderQuota.Merge(m, src)
+}
+func (m *SenderQuota) XXX_Size() int {
+	return m.Size()
+}
+func (m *SenderQuota) XXX_DiscardUnknown() {
+	xxx_messageInfo_SenderQuota.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SenderQuota proto.InternalMessageInfo
+
+func (m *SenderQuota) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+func (m *SenderQuota) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *SenderQuota) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *SenderQuota) GetWindowStartTime() uint64 {
+	if m != nil {
+		return m.WindowStartTime
+	}
+	return 0
+}
+
+// SwapRoute whitelists an inbound denom that can be swapped into a host zone's
+// native token (and then liquid staked) with the SwapAndLiquidStake action
+// The swap is executed against a CosmWasm DEX contract on Stride
+type SwapRoute struct {
+	// Denom of the inbound token on Stride (e.g. ibc/{hash(transfer/channel-X/uusdc)})
+	InputDenom string `protobuf:"bytes,1,opt,name=input_denom,json=inputDenom,proto3" json:"input_denom,omitempty"`
+	// Native denom of the host zone that the tokens should be liquid staked to
+	HostDenom string `protobuf:"bytes,2,opt,name=host_denom,json=hostDenom,proto3" json:"host_denom,omitempty"`
+	// Address of the DEX contract that executes the swap
+	ContractAddress string `protobuf:"bytes,3,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+}
+
+func (m *SwapRoute) Reset()         { *m = SwapRoute{} }
+func (m *SwapRoute) String() string { return proto.CompactTextString(m) }
+func (*SwapRoute) ProtoMessage()    {}
+func (*SwapRoute) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cf12981bf14863a6, []int{3}
+}
+func (m *SwapRoute) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SwapRoute) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SwapRoute.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SwapRoute) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SwapRoute.Merge(m, src)
+}
+func (m *SwapRoute) XXX_Size() int {
+	return m.Size()
+}
+func (m *SwapRoute) XXX_DiscardUnknown() {
+	xxx_messageInfo_SwapRoute.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SwapRoute proto.InternalMessageInfo
+
+func (m *SwapRoute) GetInputDenom() string {
+	if m != nil {
+		return m.InputDenom
+	}
+	return ""
+}
+
+func (m *SwapRoute) GetHostDenom() string {
+	if m != nil {
+		return m.HostDenom
+	}
+	return ""
+}
+
+func (m *SwapRoute) GetContractAddress() string {
+	if m != nil {
+		return m.ContractAddress
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*ChannelPolicy)(nil), "stride.autopilot.ChannelPolicy")
+	proto.RegisterType((*DenomLimit)(nil), "stride.autopilot.DenomLimit")
+	proto.RegisterType((*SenderQuota)(nil), "stride.autopilot.SenderQuota")
+	proto.RegisterType((*SwapRoute)(nil), "stride.autopilot.SwapRoute")
+}
+
+func init() { proto.RegisterFile("stride/autopilot/autopilot.proto", fileDescriptor_cf12981bf14863a6) }
+
+var fileDescriptor_cf12981bf14863a6 = []byte{
+	// 540 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x53, 0xc1, 0x6e, 0xd3, 0x4c,
+	0x10, 0x8e, 0x9b, 0xb4, 0x52, 0xc6, 0xfd, 0xff, 0x96, 0x55, 0x41, 0xa6, 0x02, 0x27, 0xca, 0x01,
+	0x02, 0x52, 0x1c, 0xd4, 0x72, 0xe4, 0x92, 0x00, 0x87, 0x48, 0x45, 0x0a, 0x0e, 0x08, 0x09, 0x09,
+	0xad, 0x36, 0xde, 0x55, 0xb2, 0xaa, 0xbd, 0x6b, 0xbc, 0xeb, 0x26, 0x7d, 0x0b, 0x1e, 0x86, 0x87,
+	0xe8, 0x31, 0xe2, 0x84, 0x38, 0x54, 0x28, 0x11, 0x6f, 0xc0, 0x03, 0x20, 0xef, 0x3a, 0x4d, 0xc4,
+	0x85, 0x4b, 0x4f, 0xde, 0xf9, 0xbe, 0xf1, 0xb7, 0x33, 0xdf, 0xec, 0x40, 0x53, 0xe9, 0x8c, 0x53,
+	0xd6, 0x25, 0xb9, 0x96, 0x29, 0x8f, 0xa5, 0xde, 0x9c, 0x82, 0x34, 0x93, 0x5a, 0xa2, 0x43, 0x9b,
+	0x11, 0xdc, 0xe0, 0xc7, 0x47, 0x13, 0x39, 0x91, 0x86, 0xec, 0x16, 0x27, 0x9b, 0x77, 0x7c, 0x3f,
+	0x92, 0x2a, 0x91, 0x0a, 0x5b, 0xc2, 0x06, 0x96, 0x6a, 0x2d, 0x1c, 0xf8, 0xef, 0xe5, 0x94, 0x08,
+	0xc1, 0xe2, 0xa1, 0x8c, 0x79, 0x74, 0x89, 0x1e, 0x02, 0x44, 0x16, 0xc0, 0x9c, 0x7a, 0x4e, 0xd3,
+	0x69, 0xd7, 0xc3, 0x7a, 0x89, 0x0c, 0x28, 0x7a, 0x0c, 0x07, 0x24, 0x8e, 0xe5, 0x8c, 0x51, 0x4c,
+	0x22, 0xcd, 0xa5, 0x50, 0xde, 0x4e, 0xb3, 0xda, 0xae, 0x87, 0xff, 0x97, 0x70, 0xcf, 0xa2, 0xe8,
+	0x35, 0xec, 0x53, 0x26, 0x64, 0x82, 0x63, 0x9e, 0x70, 0xad, 0xbc, 0x6a, 0xb3, 0xda, 0x76, 0x4f,
+	0x1e, 0x04, 0x7f, 0xd7, 0x1c, 0xbc, 0x2a, 0xb2, 0xce, 0x8a, 0xa4, 0x7e, 0xed, 0xea, 0xba, 0x51,
+	0x09, 0x5d, 0x7a, 0x83, 0x28, 0xf4, 0x0c, 0x8e, 0x3e, 0xe7, 0x52, 0x13, 0x3c, 0xe3, 0x82, 0xca,
+	0x19, 0x56, 0x2c, 0x92, 0x82, 0x2a, 0xaf, 0xd6, 0x74, 0xda, 0xb5, 0x10, 0x19, 0xee, 0x83, 0xa1,
+	0x46, 0x96, 0x69, 0xfd, 0x76, 0x00, 0x36, 0x9a, 0xe8, 0x08, 0x76, 0x8d, 0x5e, 0xd9, 0x8a, 0x0d,
+	0x90, 0x84, 0xbb, 0x09, 0x99, 0x63, 0x92, 0xc8, 0x5c, 0x68, 0x9c, 0xb2, 0x0c, 0xa7, 0x24, 0x3a,
+	0x67, 0xda, 0xdb, 0x29, 0xb2, 0xfa, 0x2f, 0x8a, 0x42, 0x7e, 0x5c, 0x37, 0x1e, 0x4d, 0xb8, 0x9e,
+	0xe6, 0xe3, 0x20, 0x92, 0x49, 0xe9, 0x5b, 0xf9, 0xe9, 0x28, 0x7a, 0xde, 0xd5, 0x97, 0x29, 0x53,
+	0xc1, 0x40, 0xe8, 0x6f, 0x5f, 0x3b, 0x50, 0xda, 0x3a, 0x10, 0x3a, 0x44, 0x09, 0x99, 0xf7, 0x8c,
+	0xf2, 0x90, 0x65, 0x43, 0xa3, 0x8b, 0x30, 0xec, 0x2b, 0x26, 0x28, 0xcb, 0xb0, 0x29, 0xd9, 0xab,
+	0xde, 0xc2, 0x3d, 0xae, 0x55, 0x7c, 0x5b, 0x08, 0xb6, 0x7e, 0x39, 0xe0, 0x8e, 0x36, 0xf1, 0xbf,
+	0xe6, 0x78, 0x0f, 0xf6, 0xec, 0xdf, 0xb6, 0xe3, 0xb0, 0x8c, 0x36, 0x76, 0x55, 0xb7, 0xed, 0xfa,
+	0x04, 0x6e, 0x69, 0x55, 0xae, 0x18, 0xf5, 0x6a, 0xb7, 0x50, 0x3c, 0x58, 0xc1, 0xf7, 0x8a, 0x51,
+	0xf4, 0x14, 0xee, 0xac, 0xc7, 0xab, 0x49, 0xa6, 0xb1, 0xe6, 0x09, 0xf3, 0x76, 0xcd, 0x84, 0x0f,
+	0x2c, 0x31, 0x2a, 0xf0, 0x77, 0x3c, 0x61, 0xad, 0x0b, 0xa8, 0x8f, 0x66, 0x24, 0x0d, 0x65, 0xae,
+	0x19, 0x6a, 0x80, 0xcb, 0x45, 0x9a, 0x6b, 0xbc, 0x3d, 0x62, 0x30, 0x90, 0x79, 0x02, 0x85, 0x0b,
+	0x53, 0xa9, 0xd6, 0xbc, 0x6d, 0xb5, 0x5e, 0x20, 0x96, 0x7e, 0x02, 0x87, 0x91, 0x14, 0x3a, 0x23,
+	0x91, 0xc6, 0x84, 0xd2, 0x8c, 0x29, 0x55, 0x36, 0x7e, 0xb0, 0xc6, 0x7b, 0x16, 0xee, 0xbf, 0xb9,
+	0x5a, 0xfa, 0xce, 0x62, 0xe9, 0x3b, 0x3f, 0x97, 0xbe, 0xf3, 0x65, 0xe5, 0x57, 0x16, 0x2b, 0xbf,
+	0xf2, 0x7d, 0xe5, 0x57, 0x3e, 0x9e, 0x6e, 0xf5, 0x3f, 0x32, 0xaf, 0xbb, 0x73, 0x46, 0xc6, 0xaa,
+	0x5b, 0xee, 0xef, 0xc5, 0xc9, 0xf3, 0xee, 0x7c, 0x6b, 0x8b, 0x8d, 0x21, 0xe3, 0x3d, 0xb3, 0x7f,
+	0xa7, 0x7f, 0x06, 0x00, 0x8e, 0xf2, 0x56, 0x32, 0xe6, 0x03, 0x00, 0x00,
+}
+
+func (m *ChannelPolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ChannelPolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ChannelPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.QuotaWindowSeconds != 0 {
+		i = encodeVarintAutopilot(dAtA, i, uint64(m.QuotaWindowSeconds))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.DenomLimits) > 0 {
+		for iNdEx := len(m.DenomLimits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DenomLimits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAutopilot(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.AllowedActions) > 0 {
+		for iNdEx := len(m.AllowedActions) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedActions[iNdEx])
+			copy(dAtA[i:], m.AllowedActions[iNdEx])
+			i = encodeVarintAutopilot(dAtA, i, uint64(len(m.AllowedActions[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintAutopilot(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DenomLimit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DenomLimit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DenomLimit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.SenderQuota.Size()
+		i -= size
+		if _, err := m.SenderQuota.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintAutopilot(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.MaxAmountPerPacket.Size()
+		i -= size
+		if _, err := m.MaxAmountPerPacket.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintAutopilot(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintAutopilot(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SenderQuota) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SenderQuota) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SenderQuota) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.WindowStartTime != 0 {
+		i = encodeVarintAutopilot(dAtA, i, uint64(m.WindowStartTime))
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size := m.AmountUsed.Size()
+		i -= size
+		if _, err := m.AmountUsed.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintAutopilot(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintAutopilot(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintAutopilot(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintAutopilot(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SwapRoute) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SwapRoute) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SwapRoute) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintAutopilot(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.HostDenom) > 0 {
+		i -= len(m.HostDenom)
+		copy(dAtA[i:], m.HostDenom)
+		i = encodeVarintAutopilot(dAtA, i, uint64(len(m.HostDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.InputDenom) > 0 {
+		i -= len(m.InputDenom)
+		copy(dAtA[i:], m.InputDenom)
+		i = encodeVarintAutopilot(dAtA, i, uint64(len(m.InputDenom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintAutopilot(dAtA []byte, offset int, v uint64) int {
+	offset -= sovAutopilot(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ChannelPolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovAutopilot(uint64(l))
+	}
+	if len(m.AllowedActions) > 0 {
+		for _, s := range m.AllowedActions {
+			l = len(s)
+			n += 1 + l + sovAutopilot(uint64(l))
+		}
+	}
+	if len(m.DenomLimits) > 0 {
+		for _, e := range m.DenomLimits {
+			l = e.Size()
+			n += 1 + l + sovAutopilot(uint64(l))
+		}
+	}
+	if m.QuotaWindowSeconds != 0 {
+		n += 1 + sovAutopilot(uint64(m.QuotaWindowSeconds))
+	}
+	return n
+}
+
+func (m *DenomLimit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovAutopilot(uint64(l))
+	}
+	l = m.MaxAmountPerPacket.Size()
+	n += 1 + l + sovAutopilot(uint64(l))
+	l = m.SenderQuota.Size()
+	n += 1 + l + sovAutopilot(uint64(l))
+	return n
+}
+
+func (m *SenderQuota) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovAutopilot(uint64(l))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovAutopilot(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovAutopilot(uint64(l))
+	}
+	l = m.AmountUsed.Size()
+	n += 1 + l + sovAutopilot(uint64(l))
+	if m.WindowStartTime != 0 {
+		n += 1 + sovAutopilot(uint64(m.WindowStartTime))
+	}
+	return n
+}
+
+func (m *SwapRoute) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.InputDenom)
+	if l > 0 {
+		n += 1 + l + sovAutopilot(uint64(l))
+	}
+	l = len(m.HostDenom)
+	if l > 0 {
+		n += 1 + l + sovAutopilot(uint64(l))
+	}
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovAutopilot(uint64(l))
+	}
+	return n
+}
+
+func sovAutopilot(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozAutopilot(x uint64) (n int) {
+	return sovAutopilot(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ChannelPolicy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAutopilot
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ChannelPolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ChannelPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAutopilot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAutopilot
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAutopilot
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedActions", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAutopilot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAutopilot
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAutopilot
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedActions = append(m.AllowedActions, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomLimits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAutopilot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAutopilot
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAutopilot
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DenomLimits = append(m.DenomLimits, DenomLimit{})
+			if err := m.DenomLimits[len(m.DenomLimits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuotaWindowSeconds", wireType)
+			}
+			m.QuotaWindowSeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAutopilot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.QuotaWindowSeconds |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAutopilot(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAutopilot
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DenomLimit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAutopilot
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DenomLimit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DenomLimit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAutopilot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAutopilot
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAutopilot
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxAmountPerPacket", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAutopilot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAutopilot
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAutopilot
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxAmountPerPacket.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SenderQuota", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAutopilot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAutopilot
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAutopilot
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SenderQuota.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAutopilot(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAutopilot
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SenderQuota) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAutopilot
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SenderQuota: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SenderQuota: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAutopilot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAutopilot
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAutopilot
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAutopilot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAutopilot
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAutopilot
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAutopilot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAutopilot
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAutopilot
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AmountUsed", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAutopilot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAutopilot
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAutopilot
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AmountUsed.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WindowStartTime", wireType)
+			}
+			m.WindowStartTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAutopilot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WindowStartTime |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAutopilot(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAutopilot
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SwapRoute) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAutopilot
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SwapRoute: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SwapRoute: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InputDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAutopilot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAutopilot
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAutopilot
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InputDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HostDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAutopilot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAutopilot
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAutopilot
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HostDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAutopilot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAutopilot
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAutopilot
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAutopilot(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAutopilot
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipAutopilot(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowAutopilot
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAutopilot
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAutopilot
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthAutopilot
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupAutopilot
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthAutopilot
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthAutopilot        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowAutopilot          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupAutopilot = fmt.Errorf("proto: unexpected end of group")
+)
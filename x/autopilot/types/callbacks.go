@@ -0,0 +1,53 @@
+package types
+
+import (
+	"encoding/json"
+)
+
+// Sudo message sent to a contract once an ICS-20 transfer that it initiated
+// has been acknowledged or timed out
+// The message mirrors the lifecycle callbacks from ibc-hooks so that contracts
+// can use the same entry point regardless of how the transfer was initiated
+type ContractCallbackSudoMsg struct {
+	IBCLifecycleComplete IBCLifecycleComplete `json:"ibc_lifecycle_complete"`
+}
+type IBCLifecycleComplete struct {
+	IBCAck     *IBCAck     `json:"ibc_ack,omitempty"`
+	IBCTimeout *IBCTimeout `json:"ibc_timeout,omitempty"`
+}
+type IBCAck struct {
+	Channel  string `json:"channel"`
+	Sequence uint64 `json:"sequence"`
+	Success  bool   `json:"success"`
+	Error    string `json:"error,omitempty"`
+}
+type IBCTimeout struct {
+	Channel  string `json:"channel"`
+	Sequence uint64 `json:"sequence"`
+}
+
+// Serializes the sudo message for an acknowledged transfer
+func NewContractAckSudoMsg(channelId string, sequence uint64, success bool, ackError string) ([]byte, error) {
+	return json.Marshal(ContractCallbackSudoMsg{
+		IBCLifecycleComplete: IBCLifecycleComplete{
+			IBCAck: &IBCAck{
+				Channel:  channelId,
+				Sequence: sequence,
+				Success:  success,
+				Error:    ackError,
+			},
+		},
+	})
+}
+
+// Serializes the sudo message for a timed out transfer
+func NewContractTimeoutSudoMsg(channelId string, sequence uint64) ([]byte, error) {
+	return json.Marshal(ContractCallbackSudoMsg{
+		IBCLifecycleComplete: IBCLifecycleComplete{
+			IBCTimeout: &IBCTimeout{
+				Channel:  channelId,
+				Sequence: sequence,
+			},
+		},
+	})
+}
@@ -0,0 +1,29 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/Stride-Labs/stride/v24/x/autopilot/types"
+)
+
+func TestContractCallbackSudoMsgs(t *testing.T) {
+	successMsg, err := types.NewContractAckSudoMsg("channel-0", 10, true, "")
+	require.NoError(t, err)
+	require.Equal(t,
+		`{"ibc_lifecycle_complete":{"ibc_ack":{"channel":"channel-0","sequence":10,"success":true}}}`,
+		string(successMsg))
+
+	failureMsg, err := types.NewContractAckSudoMsg("channel-0", 10, false, "insufficient funds")
+	require.NoError(t, err)
+	require.Equal(t,
+		`{"ibc_lifecycle_complete":{"ibc_ack":{"channel":"channel-0","sequence":10,"success":false,"error":"insufficient funds"}}}`,
+		string(failureMsg))
+
+	timeoutMsg, err := types.NewContractTimeoutSudoMsg("channel-0", 10)
+	require.NoError(t, err)
+	require.Equal(t,
+		`{"ibc_lifecycle_complete":{"ibc_timeout":{"channel":"channel-0","sequence":10}}}`,
+		string(timeoutMsg))
+}
@@ -0,0 +1,100 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	transfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+)
+
+// Action name used in channel policies for claim routing
+// (stakeibc actions are referenced by their packet action name)
+const Claim = "Claim"
+
+// Returns the name of the autopilot action that a packet is routed to,
+// used to check the packet against the channel's allowed actions
+func GetRoutingAction(routingInfo ModuleRoutingInfo) string {
+	switch routingInfo := routingInfo.(type) {
+	case StakeibcPacketMetadata:
+		return routingInfo.Action
+	case ClaimPacketMetadata:
+		return Claim
+	default:
+		return ""
+	}
+}
+
+// Validates the fields of a channel policy
+func (p ChannelPolicy) Validate() error {
+	if !channeltypes.IsValidChannelID(p.ChannelId) {
+		return errorsmod.Wrapf(ErrInvalidChannelPolicy, "invalid channel-id %s", p.ChannelId)
+	}
+
+	seen := map[string]bool{}
+	for _, action := range p.AllowedActions {
+		switch action {
+		case LiquidStake, RedeemStake, SwapAndLiquidStake, Claim:
+		default:
+			return errorsmod.Wrapf(ErrInvalidChannelPolicy, "unsupported action %s", action)
+		}
+		if seen[action] {
+			return errorsmod.Wrapf(ErrInvalidChannelPolicy, "duplicate action %s", action)
+		}
+		seen[action] = true
+	}
+
+	seenDenoms := map[string]bool{}
+	for _, limit := range p.DenomLimits {
+		if limit.Denom == "" {
+			return errorsmod.Wrap(ErrInvalidChannelPolicy, "denom limit must specify a denom")
+		}
+		if seenDenoms[limit.Denom] {
+			return errorsmod.Wrapf(ErrInvalidChannelPolicy, "duplicate denom limit %s", limit.Denom)
+		}
+		seenDenoms[limit.Denom] = true
+
+		if limit.MaxAmountPerPacket.IsNil() || limit.MaxAmountPerPacket.IsNegative() {
+			return errorsmod.Wrapf(ErrInvalidChannelPolicy, "max amount per packet for %s must be non-negative", limit.Denom)
+		}
+		if limit.SenderQuota.IsNil() || limit.SenderQuota.IsNegative() {
+			return errorsmod.Wrapf(ErrInvalidChannelPolicy, "sender quota for %s must be non-negative", limit.Denom)
+		}
+		if limit.SenderQuota.IsPositive() && p.QuotaWindowSeconds == 0 {
+			return errorsmod.Wrap(ErrInvalidChannelPolicy, "quota window must be specified when a sender quota is set")
+		}
+	}
+
+	return nil
+}
+
+// Checks whether the given action is included in the policy's allowed actions
+func (p ChannelPolicy) IsActionAllowed(action string) bool {
+	for _, allowedAction := range p.AllowedActions {
+		if allowedAction == action {
+			return true
+		}
+	}
+	return false
+}
+
+// Returns the amount limits for the given denom
+// If the denom has no limits on this channel, returns false
+func (p ChannelPolicy) GetDenomLimit(denom string) (limit DenomLimit, found bool) {
+	for _, denomLimit := range p.DenomLimits {
+		if denomLimit.Denom == denom {
+			return denomLimit, true
+		}
+	}
+	return limit, false
+}
+
+// Returns the denom of an inbound transfer packet's tokens as they appear on Stride
+// If the token originated from stride, the denom is the unwound base denom,
+// otherwise, it's the IBC hash built from the destination port and channel
+func GetDenomOnStride(packet channeltypes.Packet, packetDenom string) string {
+	if transfertypes.ReceiverChainIsSource(packet.GetSourcePort(), packet.GetSourceChannel(), packetDenom) {
+		voucherPrefix := transfertypes.GetDenomPrefix(packet.GetSourcePort(), packet.GetSourceChannel())
+		return transfertypes.ParseDenomTrace(packetDenom[len(voucherPrefix):]).IBCDenom()
+	}
+	prefixedDenom := transfertypes.GetPrefixedDenom(packet.GetDestPort(), packet.GetDestChannel(), packetDenom)
+	return transfertypes.ParseDenomTrace(prefixedDenom).IBCDenom()
+}
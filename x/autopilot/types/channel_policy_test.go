@@ -0,0 +1,135 @@
+package types_test
+
+import (
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	transfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Stride-Labs/stride/v24/x/autopilot/types"
+)
+
+func TestChannelPolicyValidate(t *testing.T) {
+	validPolicy := func() types.ChannelPolicy {
+		return types.ChannelPolicy{
+			ChannelId:      "channel-0",
+			AllowedActions: []string{types.LiquidStake, types.RedeemStake, types.Claim},
+			DenomLimits: []types.DenomLimit{
+				{Denom: "ibc/uatom", MaxAmountPerPacket: sdkmath.NewInt(100), SenderQuota: sdkmath.NewInt(1000)},
+				{Denom: "stuatom", MaxAmountPerPacket: sdkmath.NewInt(10), SenderQuota: sdkmath.ZeroInt()},
+			},
+			QuotaWindowSeconds: 60,
+		}
+	}
+
+	testCases := []struct {
+		name          string
+		modify        func(p *types.ChannelPolicy)
+		expectedError string
+	}{
+		{
+			name:   "valid policy",
+			modify: func(p *types.ChannelPolicy) {},
+		},
+		{
+			name: "valid policy without limits",
+			modify: func(p *types.ChannelPolicy) {
+				p.DenomLimits = nil
+				p.QuotaWindowSeconds = 0
+			},
+		},
+		{
+			name:          "invalid channel id",
+			modify:        func(p *types.ChannelPolicy) { p.ChannelId = "channel" },
+			expectedError: "invalid channel-id",
+		},
+		{
+			name:          "unsupported action",
+			modify:        func(p *types.ChannelPolicy) { p.AllowedActions = []string{"Swap"} },
+			expectedError: "unsupported action",
+		},
+		{
+			name:          "duplicate action",
+			modify:        func(p *types.ChannelPolicy) { p.AllowedActions = []string{types.Claim, types.Claim} },
+			expectedError: "duplicate action",
+		},
+		{
+			name:          "empty limit denom",
+			modify:        func(p *types.ChannelPolicy) { p.DenomLimits[0].Denom = "" },
+			expectedError: "denom limit must specify a denom",
+		},
+		{
+			name:          "duplicate limit denom",
+			modify:        func(p *types.ChannelPolicy) { p.DenomLimits[1].Denom = "ibc/uatom" },
+			expectedError: "duplicate denom limit ibc/uatom",
+		},
+		{
+			name:          "negative max amount",
+			modify:        func(p *types.ChannelPolicy) { p.DenomLimits[0].MaxAmountPerPacket = sdkmath.NewInt(-1) },
+			expectedError: "max amount per packet for ibc/uatom must be non-negative",
+		},
+		{
+			name:          "nil sender quota",
+			modify:        func(p *types.ChannelPolicy) { p.DenomLimits[1].SenderQuota = sdkmath.Int{} },
+			expectedError: "sender quota for stuatom must be non-negative",
+		},
+		{
+			name:          "quota without window",
+			modify:        func(p *types.ChannelPolicy) { p.QuotaWindowSeconds = 0 },
+			expectedError: "quota window must be specified",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			policy := validPolicy()
+			tc.modify(&policy)
+
+			err := policy.Validate()
+			if tc.expectedError == "" {
+				require.NoError(t, err)
+			} else {
+				require.ErrorContains(t, err, tc.expectedError)
+			}
+		})
+	}
+}
+
+func TestGetDenomOnStride(t *testing.T) {
+	packet := channeltypes.Packet{
+		SourcePort:         "transfer",
+		SourceChannel:      "channel-100",
+		DestinationPort:    "transfer",
+		DestinationChannel: "channel-0",
+	}
+
+	testCases := []struct {
+		name          string
+		packetDenom   string
+		expectedDenom string
+	}{
+		{
+			name:          "token native to the sender",
+			packetDenom:   "uatom",
+			expectedDenom: transfertypes.ParseDenomTrace("transfer/channel-0/uatom").IBCDenom(),
+		},
+		{
+			name:          "token native to stride",
+			packetDenom:   "transfer/channel-100/stuatom",
+			expectedDenom: "stuatom",
+		},
+		{
+			name:          "token returning to stride from another chain",
+			packetDenom:   "transfer/channel-100/transfer/channel-5/uosmo",
+			expectedDenom: transfertypes.ParseDenomTrace("transfer/channel-5/uosmo").IBCDenom(),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expectedDenom, types.GetDenomOnStride(packet, tc.packetDenom))
+		})
+	}
+}
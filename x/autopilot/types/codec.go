@@ -2,21 +2,43 @@ package types
 
 import (
 	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/codec/legacy"
 	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
-	// this line is used by starport scaffolding # 1
+	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/msgservice"
+	govcodec "github.com/cosmos/cosmos-sdk/x/gov/codec"
 )
 
 func RegisterCodec(cdc *codec.LegacyAmino) {
-	// this line is used by starport scaffolding # 2
+	legacy.RegisterAminoMsg(cdc, &MsgSetChannelPolicy{}, "autopilot/MsgSetChannelPolicy")
+	legacy.RegisterAminoMsg(cdc, &MsgRemoveChannelPolicy{}, "autopilot/MsgRemoveChannelPolicy")
+	legacy.RegisterAminoMsg(cdc, &MsgSetSwapRoute{}, "autopilot/MsgSetSwapRoute")
+	legacy.RegisterAminoMsg(cdc, &MsgRemoveSwapRoute{}, "autopilot/MsgRemoveSwapRoute")
 }
 
 func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
-	// this line is used by starport scaffolding # 3
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgSetChannelPolicy{},
+		&MsgRemoveChannelPolicy{},
+		&MsgSetSwapRoute{},
+		&MsgRemoveSwapRoute{},
+	)
 
-	// msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
+	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
 }
 
 var (
 	Amino     = codec.NewLegacyAmino()
-	ModuleCdc = codec.NewProtoCodec(cdctypes.NewInterfaceRegistry())
+	ModuleCdc = codec.NewAminoCodec(Amino)
 )
+
+func init() {
+	RegisterCodec(Amino)
+	cryptocodec.RegisterCrypto(Amino)
+	sdk.RegisterLegacyAminoCodec(Amino)
+
+	// Register all Amino interfaces and concrete types on the gov Amino codec so that this can later be
+	// used to properly serialize MsgSubmitProposal instances
+	RegisterCodec(govcodec.Amino)
+}
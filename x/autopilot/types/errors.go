@@ -15,4 +15,14 @@ var (
 	ErrPacketForwardingInactive  = errorsmod.Register(ModuleName, 1507, "autopilot packet forwarding is disabled")
 	ErrInvalidMemoLength         = errorsmod.Register(ModuleName, 1508, "the memo field exceeded the max allowable size")
 	ErrInvalidReceiverLength     = errorsmod.Register(ModuleName, 1509, "the receiver field exceeded the max allowable size")
+	ErrInvalidChannelPolicy      = errorsmod.Register(ModuleName, 1510, "invalid channel policy")
+	ErrChannelPolicyNotFound     = errorsmod.Register(ModuleName, 1511, "channel policy not found")
+	ErrActionNotAllowed          = errorsmod.Register(ModuleName, 1512, "autopilot action is not allowed on this channel")
+	ErrMaxPacketAmountExceeded   = errorsmod.Register(ModuleName, 1513, "autopilot packet amount exceeds the channel's max amount per packet")
+	ErrSenderQuotaExceeded       = errorsmod.Register(ModuleName, 1514, "autopilot sender quota exceeded")
+	ErrInvalidSwapRoute          = errorsmod.Register(ModuleName, 1515, "invalid swap route")
+	ErrSwapRouteNotFound         = errorsmod.Register(ModuleName, 1516, "swap route not found")
+	ErrSwapFailed                = errorsmod.Register(ModuleName, 1517, "autopilot swap failed")
+	ErrInvalidContractTransfer   = errorsmod.Register(ModuleName, 1518, "invalid contract transfer")
+	ErrContractCallbackOutOfGas  = errorsmod.Register(ModuleName, 1519, "contract callback out of gas")
 )
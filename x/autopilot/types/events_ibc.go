@@ -2,10 +2,16 @@ package types
 
 // IBC events
 const (
-	EventTypeTimeout = "timeout"
+	EventTypeTimeout          = "timeout"
+	EventTypeContractCallback = "contract_callback"
 	// this line is used by starport scaffolding # ibc/packet/event
 
 	AttributeKeyAckSuccess = "success"
 	AttributeKeyAck        = "acknowledgement"
 	AttributeKeyAckError   = "error"
+
+	AttributeKeyContractAddress = "contract_address"
+	AttributeKeyChannelId       = "channel_id"
+	AttributeKeySequence        = "sequence"
+	AttributeKeyCallbackSuccess = "callback_success"
 )
@@ -1,5 +1,9 @@
 package types
 
+import (
+	errorsmod "cosmossdk.io/errors"
+)
+
 // TODO: fix this file
 
 // DefaultIndex is the default capability global index
@@ -8,7 +12,9 @@ const DefaultIndex uint64 = 1
 // DefaultGenesis returns the default Capability genesis state
 func DefaultGenesis() *GenesisState {
 	return &GenesisState{
-		Params: DefaultParams(),
+		Params:          DefaultParams(),
+		ChannelPolicies: []ChannelPolicy{},
+		SwapRoutes:      []SwapRoute{},
 		// this line is used by starport scaffolding # genesis/types/default
 	}
 }
@@ -16,5 +22,32 @@ func DefaultGenesis() *GenesisState {
 // Validate performs basic genesis state validation returning an error upon any
 // failure.
 func (gs GenesisState) Validate() error {
-	return gs.Params.Validate()
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+
+	channelIds := map[string]bool{}
+	for _, policy := range gs.ChannelPolicies {
+		if err := policy.Validate(); err != nil {
+			return err
+		}
+		if channelIds[policy.ChannelId] {
+			return errorsmod.Wrapf(ErrInvalidChannelPolicy, "duplicate policy for channel %s", policy.ChannelId)
+		}
+		channelIds[policy.ChannelId] = true
+	}
+
+	swapRouteKeys := map[string]bool{}
+	for _, swapRoute := range gs.SwapRoutes {
+		if err := swapRoute.Validate(); err != nil {
+			return err
+		}
+		swapRouteKey := string(GetSwapRouteKey(swapRoute.InputDenom, swapRoute.HostDenom))
+		if swapRouteKeys[swapRouteKey] {
+			return errorsmod.Wrapf(ErrInvalidSwapRoute, "duplicate swap route from %s to %s", swapRoute.InputDenom, swapRoute.HostDenom)
+		}
+		swapRouteKeys[swapRouteKey] = true
+	}
+
+	return nil
 }
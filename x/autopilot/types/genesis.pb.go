@@ -26,7 +26,9 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 // GenesisState defines the claim module's genesis state.
 type GenesisState struct {
 	// params defines all the parameters of the module.
-	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params" yaml:"params"`
+	Params          Params          `protobuf:"bytes,1,opt,name=params,proto3" json:"params" yaml:"params"`
+	ChannelPolicies []ChannelPolicy `protobuf:"bytes,2,rep,name=channel_policies,json=channelPolicies,proto3" json:"channel_policies" yaml:"channel_policies"`
+	SwapRoutes      []SwapRoute     `protobuf:"bytes,3,rep,name=swap_routes,json=swapRoutes,proto3" json:"swap_routes" yaml:"swap_routes"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -69,6 +71,20 @@ func (m *GenesisState) GetParams() Params {
 	return Params{}
 }
 
+func (m *GenesisState) GetChannelPolicies() []ChannelPolicy {
+	if m != nil {
+		return m.ChannelPolicies
+	}
+	return nil
+}
+
+func (m *GenesisState) GetSwapRoutes() []SwapRoute {
+	if m != nil {
+		return m.SwapRoutes
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "stride.autopilot.GenesisState")
 }
@@ -76,21 +92,27 @@ func init() {
 func init() { proto.RegisterFile("stride/autopilot/genesis.proto", fileDescriptor_a7e087b21fd12e65) }
 
 var fileDescriptor_a7e087b21fd12e65 = []byte{
-	// 212 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x2b, 0x2e, 0x29, 0xca,
-	0x4c, 0x49, 0xd5, 0x4f, 0x2c, 0x2d, 0xc9, 0x2f, 0xc8, 0xcc, 0xc9, 0x2f, 0xd1, 0x4f, 0x4f, 0xcd,
-	0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x80, 0xc8, 0xeb, 0xc1,
-	0xe5, 0xa5, 0x44, 0xd2, 0xf3, 0xd3, 0xf3, 0xc1, 0x92, 0xfa, 0x20, 0x16, 0x44, 0x9d, 0x94, 0x2c,
-	0x86, 0x39, 0x05, 0x89, 0x45, 0x89, 0xb9, 0x50, 0x63, 0x94, 0xc2, 0xb9, 0x78, 0xdc, 0x21, 0xe6,
-	0x06, 0x97, 0x24, 0x96, 0xa4, 0x0a, 0xb9, 0x73, 0xb1, 0x41, 0xe4, 0x25, 0x18, 0x15, 0x18, 0x35,
-	0xb8, 0x8d, 0x24, 0xf4, 0xd0, 0xed, 0xd1, 0x0b, 0x00, 0xcb, 0x3b, 0x89, 0x9e, 0xb8, 0x27, 0xcf,
-	0xf0, 0xe9, 0x9e, 0x3c, 0x6f, 0x65, 0x62, 0x6e, 0x8e, 0x95, 0x12, 0x44, 0x97, 0x52, 0x10, 0x54,
-	0xbb, 0x93, 0xef, 0x89, 0x47, 0x72, 0x8c, 0x17, 0x1e, 0xc9, 0x31, 0x3e, 0x78, 0x24, 0xc7, 0x38,
-	0xe1, 0xb1, 0x1c, 0xc3, 0x85, 0xc7, 0x72, 0x0c, 0x37, 0x1e, 0xcb, 0x31, 0x44, 0x19, 0xa7, 0x67,
-	0x96, 0x64, 0x94, 0x26, 0xe9, 0x25, 0xe7, 0xe7, 0xea, 0x07, 0x83, 0x0d, 0xd7, 0xf5, 0x49, 0x4c,
-	0x2a, 0xd6, 0x87, 0x3a, 0xb4, 0xcc, 0xc8, 0x44, 0xbf, 0x02, 0xc9, 0xb9, 0x25, 0x95, 0x05, 0xa9,
-	0xc5, 0x49, 0x6c, 0x60, 0xe7, 0x1a, 0x03, 0x02, 0x00, 0x00, 0xff, 0xff, 0x96, 0x69, 0x38, 0xa5,
-	0x17, 0x01, 0x00, 0x00,
+	// 318 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0x90, 0x31, 0x6a, 0xf3, 0x30,
+	0x1c, 0xc5, 0xed, 0x04, 0x32, 0x28, 0xdf, 0x47, 0x83, 0x69, 0xa9, 0x71, 0xa9, 0x1c, 0x3c, 0x65,
+	0xa9, 0x05, 0x49, 0xa7, 0x8e, 0xee, 0x90, 0xa5, 0x85, 0xe0, 0x2c, 0xa5, 0x4b, 0x50, 0x5c, 0xe1,
+	0x88, 0xda, 0x96, 0xb0, 0xe4, 0xa6, 0xbe, 0x45, 0x4f, 0xd0, 0xf3, 0x64, 0xcc, 0xd8, 0x29, 0x14,
+	0xfb, 0x06, 0x3d, 0x41, 0xb1, 0xe5, 0x06, 0x13, 0x6f, 0x82, 0xf7, 0xfb, 0xff, 0x9e, 0x78, 0x00,
+	0x0a, 0x99, 0xd2, 0x17, 0x82, 0x70, 0x26, 0x19, 0xa7, 0x11, 0x93, 0x28, 0x24, 0x09, 0x11, 0x54,
+	0xb8, 0x3c, 0x65, 0x92, 0x19, 0x23, 0x95, 0xbb, 0xc7, 0xdc, 0x3a, 0x0f, 0x59, 0xc8, 0xea, 0x10,
+	0x55, 0x2f, 0xc5, 0x59, 0xd7, 0x1d, 0x0f, 0xc7, 0x29, 0x8e, 0x1b, 0x8d, 0x35, 0xee, 0xc4, 0xc7,
+	0x97, 0x22, 0x9c, 0xcf, 0x1e, 0xf8, 0x37, 0x57, 0xd5, 0x4b, 0x89, 0x25, 0x31, 0xe6, 0x60, 0xa0,
+	0x14, 0xa6, 0x3e, 0xd6, 0x27, 0xc3, 0xa9, 0xe9, 0x9e, 0x7e, 0xc5, 0x5d, 0xd4, 0xb9, 0x77, 0xb1,
+	0x3b, 0xd8, 0xda, 0xcf, 0xc1, 0xfe, 0x9f, 0xe3, 0x38, 0xba, 0x73, 0xd4, 0x95, 0xe3, 0x37, 0xe7,
+	0xc6, 0x2b, 0x18, 0x05, 0x1b, 0x9c, 0x24, 0x24, 0x5a, 0x71, 0x16, 0xd1, 0x80, 0x12, 0x61, 0xf6,
+	0xc6, 0xfd, 0xc9, 0x70, 0x6a, 0x77, 0x95, 0xf7, 0x8a, 0x5c, 0x54, 0x60, 0xee, 0xd9, 0x8d, 0xf9,
+	0x52, 0x99, 0x4f, 0x35, 0x8e, 0x7f, 0x16, 0xb4, 0x78, 0x4a, 0x84, 0xf1, 0x04, 0x86, 0x62, 0x8b,
+	0xf9, 0x2a, 0x65, 0x99, 0x24, 0xc2, 0xec, 0xd7, 0x3d, 0x57, 0xdd, 0x9e, 0xe5, 0x16, 0x73, 0xbf,
+	0x62, 0x3c, 0xab, 0xe9, 0x30, 0x54, 0x47, 0xeb, 0xda, 0xf1, 0x81, 0xf8, 0xc3, 0x84, 0xf7, 0xb8,
+	0x2b, 0xa0, 0xbe, 0x2f, 0xa0, 0xfe, 0x5d, 0x40, 0xfd, 0xa3, 0x84, 0xda, 0xbe, 0x84, 0xda, 0x57,
+	0x09, 0xb5, 0xe7, 0x59, 0x48, 0xe5, 0x26, 0x5b, 0xbb, 0x01, 0x8b, 0xd1, 0xb2, 0x2e, 0xba, 0x79,
+	0xc0, 0x6b, 0x81, 0x9a, 0xcd, 0xdf, 0xa6, 0xb7, 0xe8, 0xbd, 0xb5, 0xbc, 0xcc, 0x39, 0x11, 0xeb,
+	0x41, 0x3d, 0xfb, 0xec, 0x77, 0x00, 0xd7, 0x87, 0x45, 0xb1, 0x01, 0x02, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -113,6 +135,34 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.SwapRoutes) > 0 {
+		for iNdEx := len(m.SwapRoutes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SwapRoutes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.ChannelPolicies) > 0 {
+		for iNdEx := len(m.ChannelPolicies) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ChannelPolicies[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
 	{
 		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -145,6 +195,18 @@ func (m *GenesisState) Size() (n int) {
 	_ = l
 	l = m.Params.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.ChannelPolicies) > 0 {
+		for _, e := range m.ChannelPolicies {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.SwapRoutes) > 0 {
+		for _, e := range m.SwapRoutes {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -216,6 +278,74 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelPolicies", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelPolicies = append(m.ChannelPolicies, ChannelPolicy{})
+			if err := m.ChannelPolicies[len(m.ChannelPolicies)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SwapRoutes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SwapRoutes = append(m.SwapRoutes, SwapRoute{})
+			if err := m.SwapRoutes[len(m.SwapRoutes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -18,6 +18,9 @@ const (
 
 var (
 	TransferFallbackAddressPrefix = []byte("fallback")
+	ChannelPolicyPrefix           = []byte("channel-policy")
+	SenderQuotaPrefix             = []byte("sender-quota")
+	SwapRoutePrefix               = []byte("swap-route")
 
 	FallbackAddressChannelPrefixLength int = 16
 )
@@ -33,3 +36,13 @@ func GetTransferFallbackAddressKey(channelId string, sequenceNumber uint64) []by
 
 	return append(channelIdBz, sequenceNumberBz...)
 }
+
+// Builds the store key for a sender quota, key'd by channel ID, sender, and denom
+func GetSenderQuotaKey(channelId, sender, denom string) []byte {
+	return append([]byte(channelId+"/"+sender+"/"), []byte(denom)...)
+}
+
+// Builds the store key for a swap route, key'd by input denom and host denom
+func GetSwapRouteKey(inputDenom, hostDenom string) []byte {
+	return append([]byte(inputDenom+"/"), []byte(hostDenom)...)
+}
@@ -0,0 +1,52 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/migrations/legacytx"
+)
+
+const TypeMsgRemoveChannelPolicy = "remove_channel_policy"
+
+var (
+	_ sdk.Msg            = &MsgRemoveChannelPolicy{}
+	_ legacytx.LegacyMsg = &MsgRemoveChannelPolicy{}
+)
+
+func NewMsgRemoveChannelPolicy(authority string, channelId string) *MsgRemoveChannelPolicy {
+	return &MsgRemoveChannelPolicy{
+		Authority: authority,
+		ChannelId: channelId,
+	}
+}
+
+func (msg MsgRemoveChannelPolicy) Type() string {
+	return TypeMsgRemoveChannelPolicy
+}
+
+func (msg MsgRemoveChannelPolicy) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgRemoveChannelPolicy) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgRemoveChannelPolicy) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(msg.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+func (msg *MsgRemoveChannelPolicy) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return errorsmod.Wrap(err, "invalid authority address")
+	}
+
+	if msg.ChannelId == "" {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "channel-id is required")
+	}
+
+	return nil
+}
@@ -0,0 +1,56 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/migrations/legacytx"
+)
+
+const TypeMsgRemoveSwapRoute = "remove_swap_route"
+
+var (
+	_ sdk.Msg            = &MsgRemoveSwapRoute{}
+	_ legacytx.LegacyMsg = &MsgRemoveSwapRoute{}
+)
+
+func NewMsgRemoveSwapRoute(authority, inputDenom, hostDenom string) *MsgRemoveSwapRoute {
+	return &MsgRemoveSwapRoute{
+		Authority:  authority,
+		InputDenom: inputDenom,
+		HostDenom:  hostDenom,
+	}
+}
+
+func (msg MsgRemoveSwapRoute) Type() string {
+	return TypeMsgRemoveSwapRoute
+}
+
+func (msg MsgRemoveSwapRoute) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgRemoveSwapRoute) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgRemoveSwapRoute) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(msg.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+func (msg *MsgRemoveSwapRoute) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return errorsmod.Wrap(err, "invalid authority address")
+	}
+
+	if msg.InputDenom == "" {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "input-denom is required")
+	}
+	if msg.HostDenom == "" {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "host-denom is required")
+	}
+
+	return nil
+}
@@ -0,0 +1,47 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/migrations/legacytx"
+)
+
+const TypeMsgSetChannelPolicy = "set_channel_policy"
+
+var (
+	_ sdk.Msg            = &MsgSetChannelPolicy{}
+	_ legacytx.LegacyMsg = &MsgSetChannelPolicy{}
+)
+
+func NewMsgSetChannelPolicy(authority string, policy ChannelPolicy) *MsgSetChannelPolicy {
+	return &MsgSetChannelPolicy{
+		Authority: authority,
+		Policy:    policy,
+	}
+}
+
+func (msg MsgSetChannelPolicy) Type() string {
+	return TypeMsgSetChannelPolicy
+}
+
+func (msg MsgSetChannelPolicy) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgSetChannelPolicy) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgSetChannelPolicy) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(msg.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+func (msg *MsgSetChannelPolicy) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return errorsmod.Wrap(err, "invalid authority address")
+	}
+
+	return msg.Policy.Validate()
+}
@@ -0,0 +1,47 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/migrations/legacytx"
+)
+
+const TypeMsgSetSwapRoute = "set_swap_route"
+
+var (
+	_ sdk.Msg            = &MsgSetSwapRoute{}
+	_ legacytx.LegacyMsg = &MsgSetSwapRoute{}
+)
+
+func NewMsgSetSwapRoute(authority string, swapRoute SwapRoute) *MsgSetSwapRoute {
+	return &MsgSetSwapRoute{
+		Authority: authority,
+		SwapRoute: swapRoute,
+	}
+}
+
+func (msg MsgSetSwapRoute) Type() string {
+	return TypeMsgSetSwapRoute
+}
+
+func (msg MsgSetSwapRoute) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgSetSwapRoute) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgSetSwapRoute) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(msg.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+func (msg *MsgSetSwapRoute) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return errorsmod.Wrap(err, "invalid authority address")
+	}
+
+	return msg.SwapRoute.Validate()
+}
@@ -11,11 +11,15 @@ const (
 	// Default active value for each autopilot supported module
 	DefaultStakeibcActive = true
 	DefaultClaimActive    = true
+
+	// Default gas limit for contract transfer callbacks
+	DefaultContractCallbackGasLimit = uint64(1_000_000)
 )
 
 // KeyActive is the store key for Params
 var KeyStakeibcActive = []byte("StakeibcActive")
 var KeyClaimActive = []byte("ClaimActive")
+var KeyContractCallbackGasLimit = []byte("ContractCallbackGasLimit")
 
 var _ paramtypes.ParamSet = (*Params)(nil)
 
@@ -25,16 +29,17 @@ func ParamKeyTable() paramtypes.KeyTable {
 }
 
 // NewParams creates a new Params instance
-func NewParams(stakeibcActive, claimActive bool) Params {
+func NewParams(stakeibcActive, claimActive bool, contractCallbackGasLimit uint64) Params {
 	return Params{
-		StakeibcActive: stakeibcActive,
-		ClaimActive:    claimActive,
+		StakeibcActive:           stakeibcActive,
+		ClaimActive:              claimActive,
+		ContractCallbackGasLimit: contractCallbackGasLimit,
 	}
 }
 
 // DefaultParams returns a default set of parameters
 func DefaultParams() Params {
-	return NewParams(DefaultStakeibcActive, DefaultClaimActive)
+	return NewParams(DefaultStakeibcActive, DefaultClaimActive, DefaultContractCallbackGasLimit)
 }
 
 // ParamSetPairs get the params.ParamSet
@@ -42,6 +47,7 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 	return paramtypes.ParamSetPairs{
 		paramtypes.NewParamSetPair(KeyStakeibcActive, &p.StakeibcActive, validateBool),
 		paramtypes.NewParamSetPair(KeyClaimActive, &p.ClaimActive, validateBool),
+		paramtypes.NewParamSetPair(KeyContractCallbackGasLimit, &p.ContractCallbackGasLimit, validateGasLimit),
 	}
 }
 
@@ -53,6 +59,9 @@ func (p Params) Validate() error {
 	if err := validateBool(p.ClaimActive); err != nil {
 		return err
 	}
+	if err := validateGasLimit(p.ContractCallbackGasLimit); err != nil {
+		return err
+	}
 
 	return nil
 }
@@ -71,3 +80,15 @@ func validateBool(i interface{}) error {
 
 	return nil
 }
+
+func validateGasLimit(i interface{}) error {
+	gasLimit, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if gasLimit == 0 {
+		return fmt.Errorf("gas limit must be positive")
+	}
+
+	return nil
+}
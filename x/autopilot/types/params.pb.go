@@ -29,6 +29,9 @@ type Params struct {
 	// optionally, turn off each module
 	StakeibcActive bool `protobuf:"varint,1,opt,name=stakeibc_active,json=stakeibcActive,proto3" json:"stakeibc_active,omitempty"`
 	ClaimActive    bool `protobuf:"varint,2,opt,name=claim_active,json=claimActive,proto3" json:"claim_active,omitempty"`
+	// The max gas that a contract can consume when it's notified of the outcome
+	// of a transfer it initiated
+	ContractCallbackGasLimit uint64 `protobuf:"varint,3,opt,name=contract_callback_gas_limit,json=contractCallbackGasLimit,proto3" json:"contract_callback_gas_limit,omitempty"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -77,6 +80,13 @@ func (m *Params) GetClaimActive() bool {
 	return false
 }
 
+func (m *Params) GetContractCallbackGasLimit() uint64 {
+	if m != nil {
+		return m.ContractCallbackGasLimit
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "stride.autopilot.Params")
 }
@@ -84,20 +94,23 @@ func init() {
 func init() { proto.RegisterFile("stride/autopilot/params.proto", fileDescriptor_b0b993e9f5195319) }
 
 var fileDescriptor_b0b993e9f5195319 = []byte{
-	// 208 bytes of a gzipped FileDescriptorProto
+	// 255 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x2d, 0x2e, 0x29, 0xca,
 	0x4c, 0x49, 0xd5, 0x4f, 0x2c, 0x2d, 0xc9, 0x2f, 0xc8, 0xcc, 0xc9, 0x2f, 0xd1, 0x2f, 0x48, 0x2c,
 	0x4a, 0xcc, 0x2d, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x80, 0x48, 0xeb, 0xc1, 0xa5,
-	0xa5, 0x44, 0xd2, 0xf3, 0xd3, 0xf3, 0xc1, 0x92, 0xfa, 0x20, 0x16, 0x44, 0x9d, 0x52, 0x14, 0x17,
-	0x5b, 0x00, 0x58, 0x9f, 0x90, 0x3a, 0x17, 0x7f, 0x71, 0x49, 0x62, 0x76, 0x6a, 0x66, 0x52, 0x72,
-	0x7c, 0x62, 0x72, 0x49, 0x66, 0x59, 0xaa, 0x04, 0xa3, 0x02, 0xa3, 0x06, 0x47, 0x10, 0x1f, 0x4c,
-	0xd8, 0x11, 0x2c, 0x2a, 0xa4, 0xc8, 0xc5, 0x93, 0x9c, 0x93, 0x98, 0x99, 0x0b, 0x53, 0xc5, 0x04,
-	0x56, 0xc5, 0x0d, 0x16, 0x83, 0x28, 0xb1, 0x62, 0x99, 0xb1, 0x40, 0x9e, 0xc1, 0xc9, 0xf7, 0xc4,
-	0x23, 0x39, 0xc6, 0x0b, 0x8f, 0xe4, 0x18, 0x1f, 0x3c, 0x92, 0x63, 0x9c, 0xf0, 0x58, 0x8e, 0xe1,
-	0xc2, 0x63, 0x39, 0x86, 0x1b, 0x8f, 0xe5, 0x18, 0xa2, 0x8c, 0xd3, 0x33, 0x4b, 0x32, 0x4a, 0x93,
-	0xf4, 0x92, 0xf3, 0x73, 0xf5, 0x83, 0xc1, 0x0e, 0xd5, 0xf5, 0x49, 0x4c, 0x2a, 0xd6, 0x87, 0xfa,
-	0xa9, 0xcc, 0xc8, 0x44, 0xbf, 0x02, 0xc9, 0x67, 0x25, 0x95, 0x05, 0xa9, 0xc5, 0x49, 0x6c, 0x60,
-	0x17, 0x1b, 0x03, 0x02, 0x00, 0x00, 0xff, 0xff, 0x52, 0x03, 0x8b, 0x20, 0xfa, 0x00, 0x00, 0x00,
+	0xa5, 0x44, 0xd2, 0xf3, 0xd3, 0xf3, 0xc1, 0x92, 0xfa, 0x20, 0x16, 0x44, 0x9d, 0xd2, 0x4c, 0x46,
+	0x2e, 0xb6, 0x00, 0xb0, 0x46, 0x21, 0x75, 0x2e, 0xfe, 0xe2, 0x92, 0xc4, 0xec, 0xd4, 0xcc, 0xa4,
+	0xe4, 0xf8, 0xc4, 0xe4, 0x92, 0xcc, 0xb2, 0x54, 0x09, 0x46, 0x05, 0x46, 0x0d, 0x8e, 0x20, 0x3e,
+	0x98, 0xb0, 0x23, 0x58, 0x54, 0x48, 0x91, 0x8b, 0x27, 0x39, 0x27, 0x31, 0x33, 0x17, 0xa6, 0x8a,
+	0x09, 0xac, 0x8a, 0x1b, 0x2c, 0x06, 0x55, 0x62, 0xcb, 0x25, 0x9d, 0x9c, 0x9f, 0x57, 0x52, 0x94,
+	0x98, 0x5c, 0x12, 0x9f, 0x9c, 0x98, 0x93, 0x93, 0x94, 0x98, 0x9c, 0x1d, 0x9f, 0x9e, 0x58, 0x1c,
+	0x9f, 0x93, 0x99, 0x9b, 0x59, 0x22, 0xc1, 0xac, 0xc0, 0xa8, 0xc1, 0x12, 0x24, 0x01, 0x53, 0xe2,
+	0x0c, 0x55, 0xe1, 0x9e, 0x58, 0xec, 0x03, 0x92, 0xb7, 0x62, 0x99, 0xb1, 0x40, 0x9e, 0xc1, 0xc9,
+	0xf7, 0xc4, 0x23, 0x39, 0xc6, 0x0b, 0x8f, 0xe4, 0x18, 0x1f, 0x3c, 0x92, 0x63, 0x9c, 0xf0, 0x58,
+	0x8e, 0xe1, 0xc2, 0x63, 0x39, 0x86, 0x1b, 0x8f, 0xe5, 0x18, 0xa2, 0x8c, 0xd3, 0x33, 0x4b, 0x32,
+	0x4a, 0x93, 0xf4, 0x92, 0xf3, 0x73, 0xf5, 0x83, 0xc1, 0x1e, 0xd5, 0xf5, 0x49, 0x4c, 0x2a, 0xd6,
+	0x87, 0x86, 0x49, 0x99, 0x91, 0x89, 0x7e, 0x05, 0x52, 0xc8, 0x94, 0x54, 0x16, 0xa4, 0x16, 0x27,
+	0xb1, 0x81, 0x7d, 0x6c, 0x0c, 0x18, 0x00, 0x3b, 0x29, 0xd2, 0x18, 0x3a, 0x01, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -120,6 +133,11 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ContractCallbackGasLimit != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.ContractCallbackGasLimit))
+		i--
+		dAtA[i] = 0x18
+	}
 	if m.ClaimActive {
 		i--
 		if m.ClaimActive {
@@ -166,6 +184,9 @@ func (m *Params) Size() (n int) {
 	if m.ClaimActive {
 		n += 2
 	}
+	if m.ContractCallbackGasLimit != 0 {
+		n += 1 + sovParams(uint64(m.ContractCallbackGasLimit))
+	}
 	return n
 }
 
@@ -244,6 +265,25 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.ClaimActive = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractCallbackGasLimit", wireType)
+			}
+			m.ContractCallbackGasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ContractCallbackGasLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
@@ -4,11 +4,13 @@ import (
 	"encoding/json"
 
 	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 const LiquidStake = "LiquidStake"
 const RedeemStake = "RedeemStake"
+const SwapAndLiquidStake = "SwapAndLiquidStake"
 
 // Packet metadata info specific to Stakeibc (e.g. 1-click liquid staking)
 type StakeibcPacketMetadata struct {
@@ -17,6 +19,15 @@ type StakeibcPacketMetadata struct {
 	StrideAddress   string
 	IbcReceiver     string `json:"ibc_receiver,omitempty"`
 	TransferChannel string `json:"transfer_channel,omitempty"`
+	// ContractAddress is only set when the liquid stake was initiated by a contract through
+	// the wasm bindings, in which case the contract is notified when the forward transfer completes
+	// It cannot be set from a packet memo
+	ContractAddress string `json:"-"`
+	// Fields specific to SwapAndLiquidStake
+	// HostDenom is the native denom of the host zone that the inbound tokens should be swapped to
+	// MinSwapAmountOut is the minimum amount of HostDenom that must be returned from the swap
+	HostDenom        string `json:"host_denom,omitempty"`
+	MinSwapAmountOut string `json:"min_swap_amount_out,omitempty"`
 }
 
 // Packet metadata info specific to Claim (e.g. airdrops for non-118 coins)
@@ -35,6 +46,14 @@ func (m StakeibcPacketMetadata) Validate() error {
 	switch m.Action {
 	case LiquidStake:
 	case RedeemStake:
+	case SwapAndLiquidStake:
+		if m.HostDenom == "" {
+			return errorsmod.Wrapf(ErrInvalidPacketMetadata, "host denom must be specified for %s", m.Action)
+		}
+		minSwapAmountOut, ok := sdkmath.NewIntFromString(m.MinSwapAmountOut)
+		if !ok || !minSwapAmountOut.IsPositive() {
+			return errorsmod.Wrapf(ErrInvalidPacketMetadata, "min swap amount out must be a positive integer, got '%s'", m.MinSwapAmountOut)
+		}
 	default:
 		return errorsmod.Wrapf(ErrUnsupportedStakeibcAction, "action %s is not supported", m.Action)
 	}
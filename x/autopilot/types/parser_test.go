@@ -258,6 +258,43 @@ func TestValidateStakeibcPacketMetadata(t *testing.T) {
 			},
 			expectedErr: "unsupported stakeibc action",
 		},
+		{
+			name: "valid swap and liquid stake",
+			metadata: &types.StakeibcPacketMetadata{
+				StrideAddress:    validAddress,
+				Action:           types.SwapAndLiquidStake,
+				HostDenom:        "uatom",
+				MinSwapAmountOut: "100",
+			},
+		},
+		{
+			name: "swap and liquid stake missing host denom",
+			metadata: &types.StakeibcPacketMetadata{
+				StrideAddress:    validAddress,
+				Action:           types.SwapAndLiquidStake,
+				MinSwapAmountOut: "100",
+			},
+			expectedErr: "host denom must be specified",
+		},
+		{
+			name: "swap and liquid stake missing min amount out",
+			metadata: &types.StakeibcPacketMetadata{
+				StrideAddress: validAddress,
+				Action:        types.SwapAndLiquidStake,
+				HostDenom:     "uatom",
+			},
+			expectedErr: "min swap amount out must be a positive integer",
+		},
+		{
+			name: "swap and liquid stake zero min amount out",
+			metadata: &types.StakeibcPacketMetadata{
+				StrideAddress:    validAddress,
+				Action:           types.SwapAndLiquidStake,
+				HostDenom:        "uatom",
+				MinSwapAmountOut: "0",
+			},
+			expectedErr: "min swap amount out must be a positive integer",
+		},
 	}
 
 	for _, tc := range testCases {
@@ -112,33 +112,419 @@ func (m *QueryParamsResponse) GetParams() Params {
 	return Params{}
 }
 
+// Queries the autopilot policy for a specific channel
+type QueryChannelPolicyRequest struct {
+	ChannelId string `protobuf:"bytes,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+}
+
+func (m *QueryChannelPolicyRequest) Reset()         { *m = QueryChannelPolicyRequest{} }
+func (m *QueryChannelPolicyRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryChannelPolicyRequest) ProtoMessage()    {}
+func (*QueryChannelPolicyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1dd160550c308365, []int{2}
+}
+func (m *QueryChannelPolicyRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryChannelPolicyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryChannelPolicyRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryChannelPolicyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryChannelPolicyRequest.Merge(m, src)
+}
+func (m *QueryChannelPolicyRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryChannelPolicyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryChannelPolicyRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryChannelPolicyRequest proto.InternalMessageInfo
+
+func (m *QueryChannelPolicyRequest) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+type QueryChannelPolicyResponse struct {
+	Policy ChannelPolicy `protobuf:"bytes,1,opt,name=policy,proto3" json:"policy"`
+}
+
+func (m *QueryChannelPolicyResponse) Reset()         { *m = QueryChannelPolicyResponse{} }
+func (m *QueryChannelPolicyResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryChannelPolicyResponse) ProtoMessage()    {}
+func (*QueryChannelPolicyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1dd160550c308365, []int{3}
+}
+func (m *QueryChannelPolicyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryChannelPolicyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryChannelPolicyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryChannelPolicyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryChannelPolicyResponse.Merge(m, src)
+}
+func (m *QueryChannelPolicyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryChannelPolicyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryChannelPolicyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryChannelPolicyResponse proto.InternalMessageInfo
+
+func (m *QueryChannelPolicyResponse) GetPolicy() ChannelPolicy {
+	if m != nil {
+		return m.Policy
+	}
+	return ChannelPolicy{}
+}
+
+// Queries all autopilot channel policies
+type QueryAllChannelPoliciesRequest struct {
+}
+
+func (m *QueryAllChannelPoliciesRequest) Reset()         { *m = QueryAllChannelPoliciesRequest{} }
+func (m *QueryAllChannelPoliciesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAllChannelPoliciesRequest) ProtoMessage()    {}
+func (*QueryAllChannelPoliciesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1dd160550c308365, []int{4}
+}
+func (m *QueryAllChannelPoliciesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllChannelPoliciesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllChannelPoliciesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllChannelPoliciesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllChannelPoliciesRequest.Merge(m, src)
+}
+func (m *QueryAllChannelPoliciesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllChannelPoliciesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllChannelPoliciesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllChannelPoliciesRequest proto.InternalMessageInfo
+
+type QueryAllChannelPoliciesResponse struct {
+	Policies []ChannelPolicy `protobuf:"bytes,1,rep,name=policies,proto3" json:"policies"`
+}
+
+func (m *QueryAllChannelPoliciesResponse) Reset()         { *m = QueryAllChannelPoliciesResponse{} }
+func (m *QueryAllChannelPoliciesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAllChannelPoliciesResponse) ProtoMessage()    {}
+func (*QueryAllChannelPoliciesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1dd160550c308365, []int{5}
+}
+func (m *QueryAllChannelPoliciesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllChannelPoliciesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllChannelPoliciesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllChannelPoliciesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllChannelPoliciesResponse.Merge(m, src)
+}
+func (m *QueryAllChannelPoliciesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllChannelPoliciesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllChannelPoliciesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllChannelPoliciesResponse proto.InternalMessageInfo
+
+func (m *QueryAllChannelPoliciesResponse) GetPolicies() []ChannelPolicy {
+	if m != nil {
+		return m.Policies
+	}
+	return nil
+}
+
+// Queries the current quota usage of a sender on a channel for a given denom
+type QuerySenderQuotaRequest struct {
+	ChannelId string `protobuf:"bytes,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	Sender    string `protobuf:"bytes,2,opt,name=sender,proto3" json:"sender,omitempty"`
+	Denom     string `protobuf:"bytes,3,opt,name=denom,proto3" json:"denom,omitempty"`
+}
+
+func (m *QuerySenderQuotaRequest) Reset()         { *m = QuerySenderQuotaRequest{} }
+func (m *QuerySenderQuotaRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySenderQuotaRequest) ProtoMessage()    {}
+func (*QuerySenderQuotaRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1dd160550c308365, []int{6}
+}
+func (m *QuerySenderQuotaRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySenderQuotaRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySenderQuotaRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySenderQuotaRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySenderQuotaRequest.Merge(m, src)
+}
+func (m *QuerySenderQuotaRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySenderQuotaRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySenderQuotaRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySenderQuotaRequest proto.InternalMessageInfo
+
+func (m *QuerySenderQuotaRequest) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+func (m *QuerySenderQuotaRequest) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *QuerySenderQuotaRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+type QuerySenderQuotaResponse struct {
+	SenderQuota SenderQuota `protobuf:"bytes,1,opt,name=sender_quota,json=senderQuota,proto3" json:"sender_quota"`
+}
+
+func (m *QuerySenderQuotaResponse) Reset()         { *m = QuerySenderQuotaResponse{} }
+func (m *QuerySenderQuotaResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySenderQuotaResponse) ProtoMessage()    {}
+func (*QuerySenderQuotaResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1dd160550c308365, []int{7}
+}
+func (m *QuerySenderQuotaResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySenderQuotaResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySenderQuotaResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySenderQuotaResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySenderQuotaResponse.Merge(m, src)
+}
+func (m *QuerySenderQuotaResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySenderQuotaResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySenderQuotaResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySenderQuotaResponse proto.InternalMessageInfo
+
+func (m *QuerySenderQuotaResponse) GetSenderQuota() SenderQuota {
+	if m != nil {
+		return m.SenderQuota
+	}
+	return SenderQuota{}
+}
+
+// Queries all swap routes that can be used with SwapAndLiquidStake
+type QueryAllSwapRoutesRequest struct {
+}
+
+func (m *QueryAllSwapRoutesRequest) Reset()         { *m = QueryAllSwapRoutesRequest{} }
+func (m *QueryAllSwapRoutesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAllSwapRoutesRequest) ProtoMessage()    {}
+func (*QueryAllSwapRoutesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1dd160550c308365, []int{8}
+}
+func (m *QueryAllSwapRoutesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllSwapRoutesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllSwapRoutesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllSwapRoutesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllSwapRoutesRequest.Merge(m, src)
+}
+func (m *QueryAllSwapRoutesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllSwapRoutesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllSwapRoutesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllSwapRoutesRequest proto.InternalMessageInfo
+
+type QueryAllSwapRoutesResponse struct {
+	SwapRoutes []SwapRoute `protobuf:"bytes,1,rep,name=swap_routes,json=swapRoutes,proto3" json:"swap_routes"`
+}
+
+func (m *QueryAllSwapRoutesResponse) Reset()         { *m = QueryAllSwapRoutesResponse{} }
+func (m *QueryAllSwapRoutesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAllSwapRoutesResponse) ProtoMessage()    {}
+func (*QueryAllSwapRoutesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1dd160550c308365, []int{9}
+}
+func (m *QueryAllSwapRoutesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllSwapRoutesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllSwapRoutesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllSwapRoutesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllSwapRoutesResponse.Merge(m, src)
+}
+func (m *QueryAllSwapRoutesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllSwapRoutesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllSwapRoutesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllSwapRoutesResponse proto.InternalMessageInfo
+
+func (m *QueryAllSwapRoutesResponse) GetSwapRoutes() []SwapRoute {
+	if m != nil {
+		return m.SwapRoutes
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "stride.autopilot.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "stride.autopilot.QueryParamsResponse")
+	proto.RegisterType((*QueryChannelPolicyRequest)(nil), "stride.autopilot.QueryChannelPolicyRequest")
+	proto.RegisterType((*QueryChannelPolicyResponse)(nil), "stride.autopilot.QueryChannelPolicyResponse")
+	proto.RegisterType((*QueryAllChannelPoliciesRequest)(nil), "stride.autopilot.QueryAllChannelPoliciesRequest")
+	proto.RegisterType((*QueryAllChannelPoliciesResponse)(nil), "stride.autopilot.QueryAllChannelPoliciesResponse")
+	proto.RegisterType((*QuerySenderQuotaRequest)(nil), "stride.autopilot.QuerySenderQuotaRequest")
+	proto.RegisterType((*QuerySenderQuotaResponse)(nil), "stride.autopilot.QuerySenderQuotaResponse")
+	proto.RegisterType((*QueryAllSwapRoutesRequest)(nil), "stride.autopilot.QueryAllSwapRoutesRequest")
+	proto.RegisterType((*QueryAllSwapRoutesResponse)(nil), "stride.autopilot.QueryAllSwapRoutesResponse")
 }
 
 func init() { proto.RegisterFile("stride/autopilot/query.proto", fileDescriptor_1dd160550c308365) }
 
 var fileDescriptor_1dd160550c308365 = []byte{
-	// 278 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x29, 0x2e, 0x29, 0xca,
-	0x4c, 0x49, 0xd5, 0x4f, 0x2c, 0x2d, 0xc9, 0x2f, 0xc8, 0xcc, 0xc9, 0x2f, 0xd1, 0x2f, 0x2c, 0x4d,
-	0x2d, 0xaa, 0xd4, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x80, 0xc8, 0xea, 0xc1, 0x65, 0xa5,
-	0x44, 0xd2, 0xf3, 0xd3, 0xf3, 0xc1, 0x92, 0xfa, 0x20, 0x16, 0x44, 0x9d, 0x94, 0x4c, 0x7a, 0x7e,
-	0x7e, 0x7a, 0x4e, 0xaa, 0x7e, 0x62, 0x41, 0xa6, 0x7e, 0x62, 0x5e, 0x5e, 0x7e, 0x49, 0x62, 0x49,
-	0x66, 0x7e, 0x5e, 0x31, 0x54, 0x56, 0x16, 0xc3, 0x8e, 0x82, 0xc4, 0xa2, 0xc4, 0x5c, 0xa8, 0xb4,
-	0x92, 0x08, 0x97, 0x50, 0x20, 0xc8, 0xce, 0x00, 0xb0, 0x60, 0x50, 0x6a, 0x61, 0x69, 0x6a, 0x71,
-	0x89, 0x92, 0x2f, 0x97, 0x30, 0x8a, 0x68, 0x71, 0x41, 0x7e, 0x5e, 0x71, 0xaa, 0x90, 0x19, 0x17,
-	0x1b, 0x44, 0xb3, 0x04, 0xa3, 0x02, 0xa3, 0x06, 0xb7, 0x91, 0x84, 0x1e, 0xba, 0x13, 0xf5, 0x20,
-	0x3a, 0x9c, 0x58, 0x4e, 0xdc, 0x93, 0x67, 0x08, 0x82, 0xaa, 0x36, 0xea, 0x65, 0xe4, 0x62, 0x05,
-	0x9b, 0x27, 0xd4, 0xcc, 0xc8, 0xc5, 0x06, 0x51, 0x22, 0xa4, 0x82, 0xa9, 0x19, 0xd3, 0x25, 0x52,
-	0xaa, 0x04, 0x54, 0x41, 0x5c, 0xa6, 0xa4, 0xd3, 0x74, 0xf9, 0xc9, 0x64, 0x26, 0x35, 0x21, 0x15,
-	0xfd, 0x60, 0xb0, 0x72, 0x5d, 0x9f, 0xc4, 0xa4, 0x62, 0x7d, 0x1c, 0x5e, 0x77, 0xf2, 0x3d, 0xf1,
-	0x48, 0x8e, 0xf1, 0xc2, 0x23, 0x39, 0xc6, 0x07, 0x8f, 0xe4, 0x18, 0x27, 0x3c, 0x96, 0x63, 0xb8,
-	0xf0, 0x58, 0x8e, 0xe1, 0xc6, 0x63, 0x39, 0x86, 0x28, 0xe3, 0xf4, 0xcc, 0x92, 0x8c, 0xd2, 0x24,
-	0xbd, 0xe4, 0xfc, 0x5c, 0x6c, 0x26, 0x95, 0x19, 0x99, 0xe8, 0x57, 0x20, 0x99, 0x57, 0x52, 0x59,
-	0x90, 0x5a, 0x9c, 0xc4, 0x06, 0x0e, 0x4a, 0x63, 0x40, 0x00, 0x00, 0x00, 0xff, 0xff, 0x06, 0xcb,
-	0xc9, 0x4b, 0xcf, 0x01, 0x00, 0x00,
+	// 636 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x95, 0x51, 0x6b, 0xd3, 0x50,
+	0x14, 0xc7, 0x9b, 0xcd, 0x05, 0x77, 0xea, 0x40, 0xae, 0x43, 0x63, 0xb6, 0x65, 0x25, 0x4c, 0x71,
+	0xae, 0x26, 0x5b, 0x27, 0x03, 0x15, 0xc1, 0x56, 0x10, 0x05, 0x07, 0x5b, 0xfb, 0xa6, 0x0f, 0x35,
+	0x6d, 0xae, 0x5d, 0x20, 0xcd, 0x4d, 0x93, 0x1b, 0x67, 0x19, 0x7b, 0xd1, 0x2f, 0x20, 0xf8, 0xec,
+	0x47, 0x50, 0xf0, 0x2b, 0xf8, 0xb4, 0xc7, 0x81, 0x2f, 0x3e, 0x89, 0xb4, 0x7e, 0x10, 0xe9, 0xbd,
+	0xb7, 0x69, 0x6b, 0x92, 0xad, 0x7b, 0xcb, 0x3d, 0xe7, 0xfc, 0xcf, 0xf9, 0xdd, 0x73, 0xcf, 0x21,
+	0xb0, 0x1c, 0xd2, 0xc0, 0xb1, 0xb1, 0x69, 0x45, 0x94, 0xf8, 0x8e, 0x4b, 0xa8, 0xd9, 0x89, 0x70,
+	0xd0, 0x35, 0xfc, 0x80, 0x50, 0x82, 0xae, 0x72, 0xaf, 0x11, 0x7b, 0xd5, 0xc5, 0x16, 0x69, 0x11,
+	0xe6, 0x34, 0x07, 0x5f, 0x3c, 0x4e, 0x5d, 0x6e, 0x11, 0xd2, 0x72, 0xb1, 0x69, 0xf9, 0x8e, 0x69,
+	0x79, 0x1e, 0xa1, 0x16, 0x75, 0x88, 0x17, 0x0a, 0xef, 0x4a, 0xa2, 0x86, 0x6f, 0x05, 0x56, 0x7b,
+	0xe8, 0x2e, 0x24, 0xdc, 0xf1, 0x17, 0x8f, 0xd0, 0x17, 0x01, 0xed, 0x0f, 0xa8, 0xf6, 0x98, 0xac,
+	0x8a, 0x3b, 0x11, 0x0e, 0xa9, 0xbe, 0x0b, 0xd7, 0x26, 0xac, 0xa1, 0x4f, 0xbc, 0x10, 0xa3, 0x1d,
+	0x90, 0x79, 0x7a, 0x45, 0x2a, 0x48, 0x77, 0xf2, 0x25, 0xc5, 0xf8, 0xff, 0x12, 0x06, 0x57, 0x54,
+	0x2e, 0x9d, 0xfc, 0x5e, 0xcd, 0x55, 0x45, 0xb4, 0xfe, 0x10, 0x6e, 0xb2, 0x74, 0x4f, 0x0f, 0x2c,
+	0xcf, 0xc3, 0xee, 0x1e, 0x71, 0x9d, 0x66, 0x57, 0xd4, 0x42, 0x2b, 0x00, 0x4d, 0x6e, 0xaf, 0x3b,
+	0x36, 0x4b, 0x3c, 0x5f, 0x9d, 0x17, 0x96, 0x17, 0xb6, 0xfe, 0x1a, 0xd4, 0x34, 0xad, 0x20, 0x7a,
+	0x0c, 0xb2, 0xcf, 0x2c, 0x82, 0x68, 0x35, 0x49, 0x34, 0x21, 0x8c, 0xc1, 0xd8, 0x49, 0x2f, 0x80,
+	0xc6, 0x92, 0x97, 0x5d, 0x77, 0x3c, 0xcc, 0xc1, 0x71, 0x27, 0x6c, 0x58, 0xcd, 0x8c, 0x10, 0x0c,
+	0x65, 0xb8, 0xec, 0x0b, 0x9b, 0x22, 0x15, 0x66, 0xa7, 0xa7, 0x88, 0x65, 0xfa, 0x5b, 0xb8, 0xc1,
+	0xaa, 0xd4, 0xb0, 0x67, 0xe3, 0x60, 0x3f, 0x22, 0xd4, 0x9a, 0xae, 0x3d, 0xe8, 0x3a, 0xc8, 0x21,
+	0x13, 0x29, 0x33, 0xcc, 0x25, 0x4e, 0x68, 0x11, 0xe6, 0x6c, 0xec, 0x91, 0xb6, 0x32, 0xcb, 0xcc,
+	0xfc, 0xa0, 0x37, 0x40, 0x49, 0xd6, 0x11, 0xd7, 0x78, 0x06, 0x57, 0xb8, 0xb6, 0xde, 0x19, 0xd8,
+	0x45, 0x43, 0x57, 0x92, 0x57, 0x19, 0x13, 0x8b, 0x8b, 0xe4, 0xc3, 0x91, 0x49, 0x5f, 0x12, 0x8f,
+	0x5d, 0x76, 0xdd, 0xda, 0xa1, 0xe5, 0x57, 0x49, 0x44, 0x47, 0xed, 0x7c, 0x03, 0x6a, 0x9a, 0x53,
+	0x20, 0x54, 0x20, 0x1f, 0x1e, 0x5a, 0x7e, 0x3d, 0x60, 0x66, 0xd1, 0xcc, 0xa5, 0x14, 0x82, 0xa1,
+	0x54, 0xd4, 0x87, 0x30, 0xce, 0x55, 0xfa, 0x21, 0xc3, 0x1c, 0x2b, 0x81, 0x3e, 0x4a, 0x20, 0xf3,
+	0x71, 0x44, 0x6b, 0xc9, 0x1c, 0xc9, 0xa9, 0x57, 0x6f, 0x9d, 0x13, 0xc5, 0x29, 0xf5, 0xe2, 0x87,
+	0x9f, 0x7f, 0x3f, 0xcf, 0xdc, 0x46, 0x6b, 0x66, 0x8d, 0x85, 0xdf, 0x7b, 0x69, 0x35, 0x42, 0x33,
+	0x63, 0x11, 0xd1, 0x57, 0x09, 0x16, 0x26, 0x1e, 0x1f, 0x6d, 0x64, 0x94, 0x49, 0xdb, 0x0e, 0xb5,
+	0x38, 0x5d, 0xb0, 0x40, 0x2b, 0x33, 0xb4, 0x47, 0xe8, 0xc1, 0xd9, 0x68, 0xc3, 0x81, 0xe2, 0x5b,
+	0x60, 0x1e, 0x8d, 0x06, 0xec, 0x18, 0x7d, 0x97, 0x00, 0x25, 0x87, 0x1d, 0x6d, 0x66, 0x70, 0x64,
+	0x6e, 0x8e, 0xba, 0x75, 0x01, 0x85, 0xc0, 0xdf, 0x61, 0xf8, 0x9b, 0xc8, 0xb8, 0x00, 0xfe, 0x00,
+	0xee, 0x9b, 0x04, 0xf9, 0xb1, 0xa9, 0x44, 0xeb, 0x19, 0xa5, 0x93, 0xeb, 0xa5, 0xde, 0x9d, 0x26,
+	0x54, 0xe0, 0x3d, 0x67, 0x78, 0x15, 0xf4, 0xe4, 0x6c, 0xbc, 0xf1, 0x2d, 0x9a, 0xe8, 0xad, 0x79,
+	0xc4, 0x5d, 0xc7, 0xe8, 0x8b, 0x04, 0x0b, 0x13, 0x2b, 0x90, 0x39, 0x14, 0x69, 0x5b, 0xa4, 0x16,
+	0xa7, 0x0b, 0x16, 0xd8, 0x5b, 0x0c, 0x7b, 0x03, 0xad, 0x9f, 0x83, 0x3d, 0xda, 0xbc, 0xca, 0xee,
+	0x49, 0x4f, 0x93, 0x4e, 0x7b, 0x9a, 0xf4, 0xa7, 0xa7, 0x49, 0x9f, 0xfa, 0x5a, 0xee, 0xb4, 0xaf,
+	0xe5, 0x7e, 0xf5, 0xb5, 0xdc, 0xab, 0xed, 0x96, 0x43, 0x0f, 0xa2, 0x86, 0xd1, 0x24, 0xed, 0xb4,
+	0x74, 0xef, 0x4a, 0xf7, 0xcd, 0xf7, 0x63, 0x49, 0x69, 0xd7, 0xc7, 0x61, 0x43, 0x66, 0xff, 0x9a,
+	0xed, 0x7f, 0x03, 0x00, 0x05, 0x5e, 0x59, 0x0d, 0x12, 0x07, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -155,6 +541,15 @@ const _ = grpc.SupportPackageIsVersion4
 type QueryClient interface {
 	// Parameters queries the parameters of the module.
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// Queries the autopilot policy for a specific channel
+	ChannelPolicy(ctx context.Context, in *QueryChannelPolicyRequest, opts ...grpc.CallOption) (*QueryChannelPolicyResponse, error)
+	// Queries all autopilot channel policies
+	AllChannelPolicies(ctx context.Context, in *QueryAllChannelPoliciesRequest, opts ...grpc.CallOption) (*QueryAllChannelPoliciesResponse, error)
+	// Queries the current quota usage of a sender on a channel for a given denom
+	// (the denom is passed as a query parameter since IBC denoms contain a "/")
+	SenderQuota(ctx context.Context, in *QuerySenderQuotaRequest, opts ...grpc.CallOption) (*QuerySenderQuotaResponse, error)
+	// Queries all swap routes that can be used with SwapAndLiquidStake
+	AllSwapRoutes(ctx context.Context, in *QueryAllSwapRoutesRequest, opts ...grpc.CallOption) (*QueryAllSwapRoutesResponse, error)
 }
 
 type queryClient struct {
@@ -174,10 +569,55 @@ func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts .
 	return out, nil
 }
 
+func (c *queryClient) ChannelPolicy(ctx context.Context, in *QueryChannelPolicyRequest, opts ...grpc.CallOption) (*QueryChannelPolicyResponse, error) {
+	out := new(QueryChannelPolicyResponse)
+	err := c.cc.Invoke(ctx, "/stride.autopilot.Query/ChannelPolicy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AllChannelPolicies(ctx context.Context, in *QueryAllChannelPoliciesRequest, opts ...grpc.CallOption) (*QueryAllChannelPoliciesResponse, error) {
+	out := new(QueryAllChannelPoliciesResponse)
+	err := c.cc.Invoke(ctx, "/stride.autopilot.Query/AllChannelPolicies", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SenderQuota(ctx context.Context, in *QuerySenderQuotaRequest, opts ...grpc.CallOption) (*QuerySenderQuotaResponse, error) {
+	out := new(QuerySenderQuotaResponse)
+	err := c.cc.Invoke(ctx, "/stride.autopilot.Query/SenderQuota", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AllSwapRoutes(ctx context.Context, in *QueryAllSwapRoutesRequest, opts ...grpc.CallOption) (*QueryAllSwapRoutesResponse, error) {
+	out := new(QueryAllSwapRoutesResponse)
+	err := c.cc.Invoke(ctx, "/stride.autopilot.Query/AllSwapRoutes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Parameters queries the parameters of the module.
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// Queries the autopilot policy for a specific channel
+	ChannelPolicy(context.Context, *QueryChannelPolicyRequest) (*QueryChannelPolicyResponse, error)
+	// Queries all autopilot channel policies
+	AllChannelPolicies(context.Context, *QueryAllChannelPoliciesRequest) (*QueryAllChannelPoliciesResponse, error)
+	// Queries the current quota usage of a sender on a channel for a given denom
+	// (the denom is passed as a query parameter since IBC denoms contain a "/")
+	SenderQuota(context.Context, *QuerySenderQuotaRequest) (*QuerySenderQuotaResponse, error)
+	// Queries all swap routes that can be used with SwapAndLiquidStake
+	AllSwapRoutes(context.Context, *QueryAllSwapRoutesRequest) (*QueryAllSwapRoutesResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -187,6 +627,18 @@ type UnimplementedQueryServer struct {
 func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
+func (*UnimplementedQueryServer) ChannelPolicy(ctx context.Context, req *QueryChannelPolicyRequest) (*QueryChannelPolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChannelPolicy not implemented")
+}
+func (*UnimplementedQueryServer) AllChannelPolicies(ctx context.Context, req *QueryAllChannelPoliciesRequest) (*QueryAllChannelPoliciesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AllChannelPolicies not implemented")
+}
+func (*UnimplementedQueryServer) SenderQuota(ctx context.Context, req *QuerySenderQuotaRequest) (*QuerySenderQuotaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SenderQuota not implemented")
+}
+func (*UnimplementedQueryServer) AllSwapRoutes(ctx context.Context, req *QueryAllSwapRoutesRequest) (*QueryAllSwapRoutesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AllSwapRoutes not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -210,33 +662,121 @@ func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "stride.autopilot.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "stride/autopilot/query.proto",
-}
-
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_ChannelPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryChannelPolicyRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
+	if interceptor == nil {
+		return srv.(QueryServer).ChannelPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stride.autopilot.Query/ChannelPolicy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ChannelPolicy(ctx, req.(*QueryChannelPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AllChannelPolicies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllChannelPoliciesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AllChannelPolicies(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stride.autopilot.Query/AllChannelPolicies",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AllChannelPolicies(ctx, req.(*QueryAllChannelPoliciesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SenderQuota_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySenderQuotaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SenderQuota(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stride.autopilot.Query/SenderQuota",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SenderQuota(ctx, req.(*QuerySenderQuotaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AllSwapRoutes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllSwapRoutesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AllSwapRoutes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stride.autopilot.Query/AllSwapRoutes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AllSwapRoutes(ctx, req.(*QueryAllSwapRoutesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "stride.autopilot.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "ChannelPolicy",
+			Handler:    _Query_ChannelPolicy_Handler,
+		},
+		{
+			MethodName: "AllChannelPolicies",
+			Handler:    _Query_AllChannelPolicies_Handler,
+		},
+		{
+			MethodName: "SenderQuota",
+			Handler:    _Query_SenderQuota_Handler,
+		},
+		{
+			MethodName: "AllSwapRoutes",
+			Handler:    _Query_AllSwapRoutes_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "stride/autopilot/query.proto",
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
 
 func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
@@ -253,70 +793,1095 @@ func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryChannelPolicyRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryChannelPolicyRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryChannelPolicyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryChannelPolicyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryChannelPolicyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryChannelPolicyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Policy.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllChannelPoliciesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllChannelPoliciesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllChannelPoliciesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllChannelPoliciesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllChannelPoliciesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllChannelPoliciesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Policies) > 0 {
+		for iNdEx := len(m.Policies) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Policies[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySenderQuotaRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySenderQuotaRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySenderQuotaRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySenderQuotaResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySenderQuotaResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySenderQuotaResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.SenderQuota.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllSwapRoutesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllSwapRoutesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllSwapRoutesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllSwapRoutesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllSwapRoutesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllSwapRoutesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SwapRoutes) > 0 {
+		for iNdEx := len(m.SwapRoutes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SwapRoutes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryChannelPolicyRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryChannelPolicyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Policy.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllChannelPoliciesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryAllChannelPoliciesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Policies) > 0 {
+		for _, e := range m.Policies {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QuerySenderQuotaRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySenderQuotaResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.SenderQuota.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllSwapRoutesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryAllSwapRoutesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.SwapRoutes) > 0 {
+		for _, e := range m.SwapRoutes {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryChannelPolicyRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryChannelPolicyRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryChannelPolicyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryChannelPolicyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryChannelPolicyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryChannelPolicyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Policy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Policy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllChannelPoliciesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllChannelPoliciesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllChannelPoliciesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllChannelPoliciesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllChannelPoliciesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllChannelPoliciesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Policies", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Policies = append(m.Policies, ChannelPolicy{})
+			if err := m.Policies[len(m.Policies)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+func (m *QuerySenderQuotaRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySenderQuotaRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySenderQuotaRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
-}
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return nil
 }
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QuerySenderQuotaResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySenderQuotaResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySenderQuotaResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SenderQuota", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SenderQuota.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	var l int
-	_ = l
-	return n
-}
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
-}
-
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAllSwapRoutesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -339,10 +1904,10 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllSwapRoutesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllSwapRoutesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -366,7 +1931,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAllSwapRoutesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -389,15 +1954,15 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllSwapRoutesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllSwapRoutesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SwapRoutes", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -424,7 +1989,8 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.SwapRoutes = append(m.SwapRoutes, SwapRoute{})
+			if err := m.SwapRoutes[len(m.SwapRoutes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
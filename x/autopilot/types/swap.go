@@ -0,0 +1,48 @@
+package types
+
+import (
+	"encoding/json"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Execute message sent to the DEX contract during a SwapAndLiquidStake
+// The contract is expected to swap the attached funds into the output denom,
+// fail if the output is less than the min output amount, and send the output
+// back to the caller
+type SwapContractMsg struct {
+	Swap SwapContractMsgSwap `json:"swap"`
+}
+type SwapContractMsgSwap struct {
+	OutputDenom     string `json:"output_denom"`
+	MinOutputAmount string `json:"min_output_amount"`
+}
+
+// Serializes the execute message for a swap
+func NewSwapContractMsg(outputDenom string, minOutputAmount sdkmath.Int) ([]byte, error) {
+	return json.Marshal(SwapContractMsg{
+		Swap: SwapContractMsgSwap{
+			OutputDenom:     outputDenom,
+			MinOutputAmount: minOutputAmount.String(),
+		},
+	})
+}
+
+// Validates the fields of a swap route
+func (r SwapRoute) Validate() error {
+	if err := sdk.ValidateDenom(r.InputDenom); err != nil {
+		return errorsmod.Wrapf(ErrInvalidSwapRoute, "invalid input denom: %s", err.Error())
+	}
+	if err := sdk.ValidateDenom(r.HostDenom); err != nil {
+		return errorsmod.Wrapf(ErrInvalidSwapRoute, "invalid host denom: %s", err.Error())
+	}
+	if r.InputDenom == r.HostDenom {
+		return errorsmod.Wrap(ErrInvalidSwapRoute, "input denom and host denom cannot be the same")
+	}
+	if _, err := sdk.AccAddressFromBech32(r.ContractAddress); err != nil {
+		return errorsmod.Wrapf(ErrInvalidSwapRoute, "invalid contract address: %s", err.Error())
+	}
+	return nil
+}
@@ -0,0 +1,1802 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: stride/autopilot/tx.proto
+
+package types
+
+import (
+	context "context"
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	grpc1 "github.com/cosmos/gogoproto/grpc"
+	proto "github.com/cosmos/gogoproto/proto"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Adds or updates the autopilot policy for a channel
+type MsgSetChannelPolicy struct {
+	// authority is the address that controls the module (defaults to x/gov unless
+	// overwritten).
+	Authority string        `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	Policy    ChannelPolicy `protobuf:"bytes,2,opt,name=policy,proto3" json:"policy"`
+}
+
+func (m *MsgSetChannelPolicy) Reset()         { *m = MsgSetChannelPolicy{} }
+func (m *MsgSetChannelPolicy) String() string { return proto.CompactTextString(m) }
+func (*MsgSetChannelPolicy) ProtoMessage()    {}
+func (*MsgSetChannelPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_408ffe6cf26dd8be, []int{0}
+}
+func (m *MsgSetChannelPolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetChannelPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetChannelPolicy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetChannelPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetChannelPolicy.Merge(m, src)
+}
+func (m *MsgSetChannelPolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetChannelPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetChannelPolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetChannelPolicy proto.InternalMessageInfo
+
+func (m *MsgSetChannelPolicy) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgSetChannelPolicy) GetPolicy() ChannelPolicy {
+	if m != nil {
+		return m.Policy
+	}
+	return ChannelPolicy{}
+}
+
+type MsgSetChannelPolicyResponse struct {
+}
+
+func (m *MsgSetChannelPolicyResponse) Reset()         { *m = MsgSetChannelPolicyResponse{} }
+func (m *MsgSetChannelPolicyResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetChannelPolicyResponse) ProtoMessage()    {}
+func (*MsgSetChannelPolicyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_408ffe6cf26dd8be, []int{1}
+}
+func (m *MsgSetChannelPolicyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetChannelPolicyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetChannelPolicyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetChannelPolicyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetChannelPolicyResponse.Merge(m, src)
+}
+func (m *MsgSetChannelPolicyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetChannelPolicyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetChannelPolicyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetChannelPolicyResponse proto.InternalMessageInfo
+
+// Removes the autopilot policy for a channel
+type MsgRemoveChannelPolicy struct {
+	// authority is the address that controls the module (defaults to x/gov unless
+	// overwritten).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	ChannelId string `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+}
+
+func (m *MsgRemoveChannelPolicy) Reset()         { *m = MsgRemoveChannelPolicy{} }
+func (m *MsgRemoveChannelPolicy) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveChannelPolicy) ProtoMessage()    {}
+func (*MsgRemoveChannelPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_408ffe6cf26dd8be, []int{2}
+}
+func (m *MsgRemoveChannelPolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveChannelPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveChannelPolicy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRemoveChannelPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveChannelPolicy.Merge(m, src)
+}
+func (m *MsgRemoveChannelPolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveChannelPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveChannelPolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRemoveChannelPolicy proto.InternalMessageInfo
+
+func (m *MsgRemoveChannelPolicy) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgRemoveChannelPolicy) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+type MsgRemoveChannelPolicyResponse struct {
+}
+
+func (m *MsgRemoveChannelPolicyResponse) Reset()         { *m = MsgRemoveChannelPolicyResponse{} }
+func (m *MsgRemoveChannelPolicyResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveChannelPolicyResponse) ProtoMessage()    {}
+func (*MsgRemoveChannelPolicyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_408ffe6cf26dd8be, []int{3}
+}
+func (m *MsgRemoveChannelPolicyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveChannelPolicyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveChannelPolicyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRemoveChannelPolicyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveChannelPolicyResponse.Merge(m, src)
+}
+func (m *MsgRemoveChannelPolicyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveChannelPolicyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveChannelPolicyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRemoveChannelPolicyResponse proto.InternalMessageInfo
+
+// Adds or updates a swap route used by SwapAndLiquidStake
+type MsgSetSwapRoute struct {
+	// authority is the address that controls the module (defaults to x/gov unless
+	// overwritten).
+	Authority string    `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	SwapRoute SwapRoute `protobuf:"bytes,2,opt,name=swap_route,json=swapRoute,proto3" json:"swap_route"`
+}
+
+func (m *MsgSetSwapRoute) Reset()         { *m = MsgSetSwapRoute{} }
+func (m *MsgSetSwapRoute) String() string { return proto.CompactTextString(m) }
+func (*MsgSetSwapRoute) ProtoMessage()    {}
+func (*MsgSetSwapRoute) Descriptor() ([]byte, []int) {
+	return fileDescriptor_408ffe6cf26dd8be, []int{4}
+}
+func (m *MsgSetSwapRoute) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetSwapRoute) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetSwapRoute.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetSwapRoute) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetSwapRoute.Merge(m, src)
+}
+func (m *MsgSetSwapRoute) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetSwapRoute) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetSwapRoute.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetSwapRoute proto.InternalMessageInfo
+
+func (m *MsgSetSwapRoute) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgSetSwapRoute) GetSwapRoute() SwapRoute {
+	if m != nil {
+		return m.SwapRoute
+	}
+	return SwapRoute{}
+}
+
+type MsgSetSwapRouteResponse struct {
+}
+
+func (m *MsgSetSwapRouteResponse) Reset()         { *m = MsgSetSwapRouteResponse{} }
+func (m *MsgSetSwapRouteResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetSwapRouteResponse) ProtoMessage()    {}
+func (*MsgSetSwapRouteResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_408ffe6cf26dd8be, []int{5}
+}
+func (m *MsgSetSwapRouteResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetSwapRouteResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetSwapRouteResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetSwapRouteResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetSwapRouteResponse.Merge(m, src)
+}
+func (m *MsgSetSwapRouteResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetSwapRouteResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetSwapRouteResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetSwapRouteResponse proto.InternalMessageInfo
+
+// Removes a swap route
+type MsgRemoveSwapRoute struct {
+	// authority is the address that controls the module (defaults to x/gov unless
+	// overwritten).
+	Authority  string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	InputDenom string `protobuf:"bytes,2,opt,name=input_denom,json=inputDenom,proto3" json:"input_denom,omitempty"`
+	HostDenom  string `protobuf:"bytes,3,opt,name=host_denom,json=hostDenom,proto3" json:"host_denom,omitempty"`
+}
+
+func (m *MsgRemoveSwapRoute) Reset()         { *m = MsgRemoveSwapRoute{} }
+func (m *MsgRemoveSwapRoute) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveSwapRoute) ProtoMessage()    {}
+func (*MsgRemoveSwapRoute) Descriptor() ([]byte, []int) {
+	return fileDescriptor_408ffe6cf26dd8be, []int{6}
+}
+func (m *MsgRemoveSwapRoute) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveSwapRoute) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveSwapRoute.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRemoveSwapRoute) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveSwapRoute.Merge(m, src)
+}
+func (m *MsgRemoveSwapRoute) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveSwapRoute) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveSwapRoute.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRemoveSwapRoute proto.InternalMessageInfo
+
+func (m *MsgRemoveSwapRoute) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgRemoveSwapRoute) GetInputDenom() string {
+	if m != nil {
+		return m.InputDenom
+	}
+	return ""
+}
+
+func (m *MsgRemoveSwapRoute) GetHostDenom() string {
+	if m != nil {
+		return m.HostDenom
+	}
+	return ""
+}
+
+type MsgRemoveSwapRouteResponse struct {
+}
+
+func (m *MsgRemoveSwapRouteResponse) Reset()         { *m = MsgRemoveSwapRouteResponse{} }
+func (m *MsgRemoveSwapRouteResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveSwapRouteResponse) ProtoMessage()    {}
+func (*MsgRemoveSwapRouteResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_408ffe6cf26dd8be, []int{7}
+}
+func (m *MsgRemoveSwapRouteResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveSwapRouteResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveSwapRouteResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRemoveSwapRouteResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveSwapRouteResponse.Merge(m, src)
+}
+func (m *MsgRemoveSwapRouteResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveSwapRouteResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveSwapRouteResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRemoveSwapRouteResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*MsgSetChannelPolicy)(nil), "stride.autopilot.MsgSetChannelPolicy")
+	proto.RegisterType((*MsgSetChannelPolicyResponse)(nil), "stride.autopilot.MsgSetChannelPolicyResponse")
+	proto.RegisterType((*MsgRemoveChannelPolicy)(nil), "stride.autopilot.MsgRemoveChannelPolicy")
+	proto.RegisterType((*MsgRemoveChannelPolicyResponse)(nil), "stride.autopilot.MsgRemoveChannelPolicyResponse")
+	proto.RegisterType((*MsgSetSwapRoute)(nil), "stride.autopilot.MsgSetSwapRoute")
+	proto.RegisterType((*MsgSetSwapRouteResponse)(nil), "stride.autopilot.MsgSetSwapRouteResponse")
+	proto.RegisterType((*MsgRemoveSwapRoute)(nil), "stride.autopilot.MsgRemoveSwapRoute")
+	proto.RegisterType((*MsgRemoveSwapRouteResponse)(nil), "stride.autopilot.MsgRemoveSwapRouteResponse")
+}
+
+func init() { proto.RegisterFile("stride/autopilot/tx.proto", fileDescriptor_408ffe6cf26dd8be) }
+
+var fileDescriptor_408ffe6cf26dd8be = []byte{
+	// 567 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0x31, 0x6f, 0xd3, 0x40,
+	0x14, 0xce, 0x51, 0x54, 0x29, 0xaf, 0x48, 0x2d, 0x6e, 0x45, 0x13, 0x97, 0x3a, 0xc1, 0xa2, 0x28,
+	0xad, 0x48, 0x0c, 0x29, 0x20, 0x88, 0x40, 0x82, 0xc0, 0x82, 0x44, 0x24, 0xe4, 0x6c, 0x08, 0x29,
+	0x72, 0xe2, 0x93, 0x63, 0x29, 0xf6, 0x19, 0xdf, 0x39, 0x6d, 0x56, 0x46, 0x26, 0xfe, 0x05, 0x13,
+	0x52, 0x07, 0x7e, 0x01, 0x03, 0x74, 0xac, 0x98, 0x98, 0x10, 0x4a, 0x86, 0xfe, 0x0d, 0x64, 0xfb,
+	0x7c, 0x21, 0xb1, 0x5b, 0x22, 0x60, 0x49, 0x7c, 0xdf, 0xfb, 0xf2, 0xbd, 0xf7, 0xe5, 0x7b, 0x67,
+	0x28, 0x52, 0xe6, 0xdb, 0x26, 0xd6, 0x8c, 0x80, 0x11, 0xcf, 0x1e, 0x10, 0xa6, 0xb1, 0xc3, 0x9a,
+	0xe7, 0x13, 0x46, 0xa4, 0xb5, 0xb8, 0x54, 0x13, 0x25, 0xb9, 0xd8, 0x23, 0xd4, 0x21, 0xb4, 0x13,
+	0xd5, 0xb5, 0xf8, 0x10, 0x93, 0xe5, 0xcd, 0xf8, 0xa4, 0x39, 0xd4, 0xd2, 0x86, 0xb7, 0xc3, 0x2f,
+	0x5e, 0xb8, 0x6c, 0x38, 0xb6, 0x4b, 0xb4, 0xe8, 0x93, 0x43, 0x1b, 0x16, 0xb1, 0x48, 0xac, 0x11,
+	0x3e, 0x71, 0xb4, 0x9c, 0x9a, 0x44, 0x3c, 0xc5, 0x0c, 0xf5, 0x2b, 0x82, 0xf5, 0x16, 0xb5, 0xda,
+	0x98, 0x3d, 0xed, 0x1b, 0xae, 0x8b, 0x07, 0x2f, 0xc9, 0xc0, 0xee, 0x8d, 0xa4, 0x7b, 0x90, 0x37,
+	0x02, 0xd6, 0x27, 0xbe, 0xcd, 0x46, 0x05, 0x54, 0x46, 0x95, 0x7c, 0xb3, 0xf0, 0xed, 0x53, 0x75,
+	0x83, 0x0f, 0xf8, 0xc4, 0x34, 0x7d, 0x4c, 0x69, 0x9b, 0xf9, 0xb6, 0x6b, 0xe9, 0x53, 0xaa, 0xf4,
+	0x08, 0x96, 0xbd, 0x48, 0xa1, 0x70, 0xa1, 0x8c, 0x2a, 0x2b, 0xf5, 0x52, 0x6d, 0xde, 0x71, 0x6d,
+	0xa6, 0x51, 0xf3, 0xe2, 0xf1, 0x8f, 0x52, 0x4e, 0xe7, 0x3f, 0x6a, 0x3c, 0x78, 0x7b, 0x7a, 0xb4,
+	0x37, 0x95, 0x7b, 0x77, 0x7a, 0xb4, 0x77, 0x83, 0x7b, 0x38, 0xfc, 0xcd, 0x45, 0xc6, 0xc4, 0xea,
+	0x36, 0x6c, 0x65, 0xc0, 0x3a, 0xa6, 0x1e, 0x71, 0x29, 0x56, 0x3f, 0x22, 0xb8, 0xd2, 0xa2, 0x96,
+	0x8e, 0x1d, 0x32, 0xc4, 0xff, 0xc7, 0xeb, 0x36, 0x40, 0x2f, 0x16, 0xea, 0xd8, 0x66, 0xe4, 0x37,
+	0xaf, 0xe7, 0x39, 0xf2, 0xdc, 0x6c, 0x3c, 0x4c, 0x7b, 0xd9, 0xcd, 0xf6, 0x92, 0x31, 0x94, 0x5a,
+	0x06, 0x25, 0xbb, 0x22, 0x1c, 0x7d, 0x46, 0xb0, 0x1a, 0x3b, 0x6e, 0x1f, 0x18, 0x9e, 0x4e, 0x02,
+	0x86, 0xff, 0xda, 0xca, 0x63, 0x00, 0x7a, 0x60, 0x78, 0x1d, 0x3f, 0x54, 0xe1, 0xd1, 0x6d, 0xa5,
+	0xa3, 0x13, 0x8d, 0x78, 0x6c, 0x79, 0x9a, 0x00, 0x8d, 0xbb, 0x69, 0xb7, 0xea, 0x99, 0xc9, 0x09,
+	0x1d, 0xb5, 0x08, 0x9b, 0x73, 0x90, 0xf0, 0xf7, 0x05, 0x81, 0x24, 0xfe, 0x82, 0x7f, 0xb7, 0x58,
+	0x82, 0x15, 0xdb, 0xf5, 0x02, 0xd6, 0x31, 0xb1, 0x4b, 0x1c, 0x1e, 0x17, 0x44, 0xd0, 0xb3, 0x10,
+	0x09, 0xe3, 0xec, 0x13, 0x9a, 0xd4, 0x97, 0xe2, 0x38, 0x43, 0x24, 0x2a, 0x37, 0xee, 0xa7, 0x0d,
+	0xee, 0x9c, 0x17, 0xe7, 0xd4, 0xe3, 0x55, 0x90, 0xd3, 0x68, 0x62, 0xb3, 0xfe, 0x61, 0x09, 0x96,
+	0x5a, 0xd4, 0x92, 0xfa, 0xb0, 0x96, 0xba, 0x85, 0x3b, 0xe9, 0x08, 0x32, 0x76, 0x5c, 0xae, 0x2e,
+	0x44, 0x4b, 0x3a, 0x4a, 0x6f, 0x60, 0x3d, 0xeb, 0x1a, 0x54, 0x32, 0x55, 0x32, 0x98, 0xf2, 0xad,
+	0x45, 0x99, 0xa2, 0xe5, 0x6b, 0xb8, 0x34, 0xb3, 0xa7, 0xd7, 0xce, 0x9a, 0x58, 0x50, 0xe4, 0xdd,
+	0x3f, 0x52, 0x84, 0x3a, 0x86, 0xd5, 0xf9, 0x2d, 0xb9, 0x7e, 0xce, 0x88, 0xd3, 0x1e, 0x37, 0x17,
+	0x61, 0x25, 0x6d, 0x9a, 0xad, 0xe3, 0xb1, 0x82, 0x4e, 0xc6, 0x0a, 0xfa, 0x39, 0x56, 0xd0, 0xfb,
+	0x89, 0x92, 0x3b, 0x99, 0x28, 0xb9, 0xef, 0x13, 0x25, 0xf7, 0x6a, 0xdf, 0xb2, 0x59, 0x3f, 0xe8,
+	0xd6, 0x7a, 0xc4, 0xd1, 0xda, 0x91, 0x62, 0xf5, 0x85, 0xd1, 0xa5, 0x1a, 0xdf, 0x8f, 0x61, 0xfd,
+	0xce, 0xcc, 0x8e, 0xb0, 0x91, 0x87, 0x69, 0x77, 0x39, 0x7a, 0x03, 0xef, 0xff, 0x1a, 0x00, 0xf0,
+	0xbe, 0x4c, 0xd8, 0x2f, 0x06, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// Adds or updates the autopilot policy for a channel
+	SetChannelPolicy(ctx context.Context, in *MsgSetChannelPolicy, opts ...grpc.CallOption) (*MsgSetChannelPolicyResponse, error)
+	// Removes the autopilot policy for a channel
+	RemoveChannelPolicy(ctx context.Context, in *MsgRemoveChannelPolicy, opts ...grpc.CallOption) (*MsgRemoveChannelPolicyResponse, error)
+	// Adds or updates a swap route used by SwapAndLiquidStake
+	SetSwapRoute(ctx context.Context, in *MsgSetSwapRoute, opts ...grpc.CallOption) (*MsgSetSwapRouteResponse, error)
+	// Removes a swap route
+	RemoveSwapRoute(ctx context.Context, in *MsgRemoveSwapRoute, opts ...grpc.CallOption) (*MsgRemoveSwapRouteResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) SetChannelPolicy(ctx context.Context, in *MsgSetChannelPolicy, opts ...grpc.CallOption) (*MsgSetChannelPolicyResponse, error) {
+	out := new(MsgSetChannelPolicyResponse)
+	err := c.cc.Invoke(ctx, "/stride.autopilot.Msg/SetChannelPolicy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RemoveChannelPolicy(ctx context.Context, in *MsgRemoveChannelPolicy, opts ...grpc.CallOption) (*MsgRemoveChannelPolicyResponse, error) {
+	out := new(MsgRemoveChannelPolicyResponse)
+	err := c.cc.Invoke(ctx, "/stride.autopilot.Msg/RemoveChannelPolicy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetSwapRoute(ctx context.Context, in *MsgSetSwapRoute, opts ...grpc.CallOption) (*MsgSetSwapRouteResponse, error) {
+	out := new(MsgSetSwapRouteResponse)
+	err := c.cc.Invoke(ctx, "/stride.autopilot.Msg/SetSwapRoute", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RemoveSwapRoute(ctx context.Context, in *MsgRemoveSwapRoute, opts ...grpc.CallOption) (*MsgRemoveSwapRouteResponse, error) {
+	out := new(MsgRemoveSwapRouteResponse)
+	err := c.cc.Invoke(ctx, "/stride.autopilot.Msg/RemoveSwapRoute", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// Adds or updates the autopilot policy for a channel
+	SetChannelPolicy(context.Context, *MsgSetChannelPolicy) (*MsgSetChannelPolicyResponse, error)
+	// Removes the autopilot policy for a channel
+	RemoveChannelPolicy(context.Context, *MsgRemoveChannelPolicy) (*MsgRemoveChannelPolicyResponse, error)
+	// Adds or updates a swap route used by SwapAndLiquidStake
+	SetSwapRoute(context.Context, *MsgSetSwapRoute) (*MsgSetSwapRouteResponse, error)
+	// Removes a swap route
+	RemoveSwapRoute(context.Context, *MsgRemoveSwapRoute) (*MsgRemoveSwapRouteResponse, error)
+}
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (*UnimplementedMsgServer) SetChannelPolicy(ctx context.Context, req *MsgSetChannelPolicy) (*MsgSetChannelPolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetChannelPolicy not implemented")
+}
+func (*UnimplementedMsgServer) RemoveChannelPolicy(ctx context.Context, req *MsgRemoveChannelPolicy) (*MsgRemoveChannelPolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveChannelPolicy not implemented")
+}
+func (*UnimplementedMsgServer) SetSwapRoute(ctx context.Context, req *MsgSetSwapRoute) (*MsgSetSwapRouteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetSwapRoute not implemented")
+}
+func (*UnimplementedMsgServer) RemoveSwapRoute(ctx context.Context, req *MsgRemoveSwapRoute) (*MsgRemoveSwapRouteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveSwapRoute not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_SetChannelPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetChannelPolicy)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetChannelPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stride.autopilot.Msg/SetChannelPolicy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetChannelPolicy(ctx, req.(*MsgSetChannelPolicy))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RemoveChannelPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveChannelPolicy)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveChannelPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stride.autopilot.Msg/RemoveChannelPolicy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveChannelPolicy(ctx, req.(*MsgRemoveChannelPolicy))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetSwapRoute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetSwapRoute)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetSwapRoute(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stride.autopilot.Msg/SetSwapRoute",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetSwapRoute(ctx, req.(*MsgSetSwapRoute))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RemoveSwapRoute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveSwapRoute)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveSwapRoute(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stride.autopilot.Msg/RemoveSwapRoute",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveSwapRoute(ctx, req.(*MsgRemoveSwapRoute))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "stride.autopilot.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "SetChannelPolicy",
+			Handler:    _Msg_SetChannelPolicy_Handler,
+		},
+		{
+			MethodName: "RemoveChannelPolicy",
+			Handler:    _Msg_RemoveChannelPolicy_Handler,
+		},
+		{
+			MethodName: "SetSwapRoute",
+			Handler:    _Msg_SetSwapRoute_Handler,
+		},
+		{
+			MethodName: "RemoveSwapRoute",
+			Handler:    _Msg_RemoveSwapRoute_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "stride/autopilot/tx.proto",
+}
+
+func (m *MsgSetChannelPolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetChannelPolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetChannelPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Policy.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetChannelPolicyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetChannelPolicyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetChannelPolicyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveChannelPolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveChannelPolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveChannelPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveChannelPolicyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveChannelPolicyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveChannelPolicyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetSwapRoute) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetSwapRoute) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetSwapRoute) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.SwapRoute.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetSwapRouteResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetSwapRouteResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetSwapRouteResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveSwapRoute) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveSwapRoute) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveSwapRoute) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.HostDenom) > 0 {
+		i -= len(m.HostDenom)
+		copy(dAtA[i:], m.HostDenom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.HostDenom)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.InputDenom) > 0 {
+		i -= len(m.InputDenom)
+		copy(dAtA[i:], m.InputDenom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.InputDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveSwapRouteResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveSwapRouteResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveSwapRouteResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgSetChannelPolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Policy.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgSetChannelPolicyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRemoveChannelPolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRemoveChannelPolicyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetSwapRoute) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.SwapRoute.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgSetSwapRouteResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRemoveSwapRoute) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.InputDenom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.HostDenom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRemoveSwapRouteResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgSetChannelPolicy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetChannelPolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetChannelPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Policy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Policy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetChannelPolicyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetChannelPolicyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetChannelPolicyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRemoveChannelPolicy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRemoveChannelPolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRemoveChannelPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRemoveChannelPolicyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRemoveChannelPolicyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRemoveChannelPolicyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetSwapRoute) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetSwapRoute: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetSwapRoute: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SwapRoute", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SwapRoute.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetSwapRouteResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetSwapRouteResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetSwapRouteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRemoveSwapRoute) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRemoveSwapRoute: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRemoveSwapRoute: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InputDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InputDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HostDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HostDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRemoveSwapRouteResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRemoveSwapRouteResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRemoveSwapRouteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipTx(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthTx
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupTx
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthTx
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthTx        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowTx          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupTx = fmt.Errorf("proto: unexpected end of group")
+)
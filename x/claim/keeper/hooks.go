@@ -99,6 +99,10 @@ func (h Hooks) AfterEpochEnd(ctx sdk.Context, epochInfo epochstypes.EpochInfo) {
 	h.k.AfterEpochEnd(ctx, epochInfo)
 }
 
+func (h Hooks) GetModuleName() string {
+	return types.ModuleName
+}
+
 func (h Hooks) AfterUnbondingInitiated(ctx sdk.Context, id uint64) error {
 	return nil
 }
@@ -30,6 +30,7 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdEpochsInfos(),
 		GetCmdCurrentEpoch(),
 		GetCmdSecondsRemaining(),
+		GetCmdExecutionReport(),
 	)
 
 	return cmd
@@ -157,3 +158,38 @@ func GetCmdSecondsRemaining() *cobra.Command {
 
 	return cmd
 }
+
+// GetCmdExecutionReport provides the hook execution report from the last time the specified epoch started
+func GetCmdExecutionReport() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "execution-report",
+		Short: "Query the gas, duration and outcome of each hook from the last time the specified epoch started",
+		Example: strings.TrimSpace(
+			fmt.Sprintf(`$ %s query epochs execution-report day`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.EpochExecutionReport(cmd.Context(), &types.QueryEpochExecutionReportRequest{
+				Identifier: args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
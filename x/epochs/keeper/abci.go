@@ -31,7 +31,8 @@ func (k Keeper) BeginBlocker(ctx sdk.Context) {
 			epochInfo = startInitialEpoch(epochInfo)
 			logger.Info(fmt.Sprintf("initial %s epoch", epochInfo.Identifier))
 		case shouldEpochStart:
-			epochInfo = endEpoch(epochInfo)
+			var skippedEpochs int64
+			epochInfo, skippedEpochs = endEpoch(epochInfo, ctx.BlockTime())
 
 			// Capitalize the epoch identifier for the logs
 			epochAlias := strings.ToUpper(strings.ReplaceAll(epochInfo.Identifier, "_epoch", ""))
@@ -44,6 +45,23 @@ func (k Keeper) BeginBlocker(ctx sdk.Context) {
 					sdk.NewAttribute(types.AttributeEpochNumber, strconv.FormatInt(epochInfo.CurrentEpoch, 10)),
 				),
 			)
+
+			if epochInfo.MissedEpochs > 0 {
+				logger.Info(fmt.Sprintf("Caught up %s epoch after %d missed epochs (policy: %s)",
+					epochInfo.Identifier, epochInfo.MissedEpochs, epochInfo.CatchUpPolicy))
+			}
+			if skippedEpochs > 0 {
+				logger.Info(fmt.Sprintf("Skipped %d %s epochs", skippedEpochs, epochInfo.Identifier))
+				ctx.EventManager().EmitEvent(
+					sdk.NewEvent(
+						types.EventTypeEpochsSkipped,
+						sdk.NewAttribute(types.AttributeEpochIdentifier, epochInfo.Identifier),
+						sdk.NewAttribute(types.AttributeEpochNumber, strconv.FormatInt(epochInfo.CurrentEpoch, 10)),
+						sdk.NewAttribute(types.AttributeSkippedEpochs, strconv.FormatInt(skippedEpochs, 10)),
+					),
+				)
+			}
+
 			k.AfterEpochEnd(ctx, epochInfo)
 		default:
 			// continue
@@ -73,8 +91,38 @@ func startInitialEpoch(epochInfo types.EpochInfo) types.EpochInfo {
 	return epochInfo
 }
 
-func endEpoch(epochInfo types.EpochInfo) types.EpochInfo {
-	epochInfo.CurrentEpoch++
-	epochInfo.CurrentEpochStartTime = epochInfo.CurrentEpochStartTime.Add(epochInfo.Duration)
-	return epochInfo
+// Advances the epoch number and start time
+// If multiple epochs have elapsed since the current epoch started (e.g. after a chain halt),
+// the catch up policy determines whether the epoch advances one at a time (firing again on
+// each subsequent block until it catches up), or jumps straight to the latest epoch
+// When jumping, the fire-once policy records the jumped epochs in MissedEpochs so that hooks
+// can account for them, while the skip policy hides them from the hooks and only returns
+// the number of skipped epochs (for the epochs_skipped event)
+func endEpoch(epochInfo types.EpochInfo, blockTime time.Time) (updatedEpochInfo types.EpochInfo, skippedEpochs int64) {
+	elapsedEpochs := int64(1)
+	if epochInfo.CatchUpPolicy != types.CATCH_UP_FIRE_EVERY_EPOCH {
+		elapsedEpochs = getElapsedEpochs(epochInfo, blockTime)
+	}
+
+	epochInfo.CurrentEpoch += elapsedEpochs
+	epochInfo.CurrentEpochStartTime = epochInfo.CurrentEpochStartTime.Add(time.Duration(elapsedEpochs) * epochInfo.Duration)
+	epochInfo.MissedEpochs = 0
+
+	if epochInfo.CatchUpPolicy == types.CATCH_UP_SKIP_MISSED {
+		return epochInfo, elapsedEpochs - 1
+	}
+	epochInfo.MissedEpochs = elapsedEpochs - 1
+	return epochInfo, 0
+}
+
+// Returns the number of epochs that have ended since the current epoch started
+// Since an epoch ends at the first block strictly after its end time, an epoch whose
+// end time is exactly the block time has not yet ended
+func getElapsedEpochs(epochInfo types.EpochInfo, blockTime time.Time) int64 {
+	elapsed := blockTime.Sub(epochInfo.CurrentEpochStartTime)
+	elapsedEpochs := int64((elapsed - 1) / epochInfo.Duration)
+	if elapsedEpochs < 1 {
+		return 1
+	}
+	return elapsedEpochs
 }
@@ -4,7 +4,10 @@ import (
 	"fmt"
 	"time"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
 	"github.com/Stride-Labs/stride/v24/x/epochs"
+	"github.com/Stride-Labs/stride/v24/x/epochs/keeper"
 	"github.com/Stride-Labs/stride/v24/x/epochs/types"
 )
 
@@ -190,3 +193,197 @@ func (suite *KeeperTestSuite) TestEpochStartingOneMonthAfterInitGenesis() {
 	suite.Require().Equal(epochInfo.CurrentEpochStartTime.UTC().String(), now.Add(month).UTC().String())
 	suite.Require().Equal(epochInfo.EpochCountingStarted, true)
 }
+
+func (suite *KeeperTestSuite) TestEpochCatchUpPolicies() {
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	epochId := "catch_up"
+
+	testCases := []struct {
+		name                     string
+		policy                   types.CatchUpPolicy
+		haltDuration             time.Duration
+		expCurrentEpoch          int64
+		expMissedEpochs          int64
+		expSkippedEpochs         int64
+		expCurrentEpochStartTime time.Time
+	}{
+		{
+			// Only advances one epoch per block
+			name:                     "fire every epoch",
+			policy:                   types.CATCH_UP_FIRE_EVERY_EPOCH,
+			haltDuration:             time.Hour*5 + time.Minute*30,
+			expCurrentEpoch:          2,
+			expMissedEpochs:          0,
+			expCurrentEpochStartTime: now.Add(time.Hour),
+		},
+		{
+			// Jumps from epoch 1 to epoch 6, skipping epochs 2 through 5
+			// The skipped epochs are only reported in the event
+			name:                     "skip missed epochs",
+			policy:                   types.CATCH_UP_SKIP_MISSED,
+			haltDuration:             time.Hour*5 + time.Minute*30,
+			expCurrentEpoch:          6,
+			expMissedEpochs:          0,
+			expSkippedEpochs:         4,
+			expCurrentEpochStartTime: now.Add(time.Hour * 5),
+		},
+		{
+			// Jumps from epoch 1 to epoch 6, recording the 4 missed epochs
+			name:                     "fire once",
+			policy:                   types.CATCH_UP_FIRE_ONCE,
+			haltDuration:             time.Hour*5 + time.Minute*30,
+			expCurrentEpoch:          6,
+			expMissedEpochs:          4,
+			expCurrentEpochStartTime: now.Add(time.Hour * 5),
+		},
+		{
+			// The epoch ending exactly at the block time has not ended yet
+			name:                     "fire once, block time on epoch boundary",
+			policy:                   types.CATCH_UP_FIRE_ONCE,
+			haltDuration:             time.Hour * 3,
+			expCurrentEpoch:          3,
+			expMissedEpochs:          1,
+			expCurrentEpochStartTime: now.Add(time.Hour * 2),
+		},
+		{
+			// No epochs were missed, so the policy has no effect
+			name:                     "skip missed epochs, no halt",
+			policy:                   types.CATCH_UP_SKIP_MISSED,
+			haltDuration:             time.Hour + time.Second,
+			expCurrentEpoch:          2,
+			expMissedEpochs:          0,
+			expCurrentEpochStartTime: now.Add(time.Hour),
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			// Remove the default epochs so that only the test epoch fires
+			for _, epochInfo := range suite.App.EpochsKeeper.AllEpochInfos(suite.Ctx) {
+				suite.App.EpochsKeeper.DeleteEpochInfo(suite.Ctx, epochInfo.Identifier)
+			}
+			suite.App.EpochsKeeper.SetEpochInfo(suite.Ctx, types.EpochInfo{
+				Identifier:    epochId,
+				StartTime:     now,
+				Duration:      time.Hour,
+				CatchUpPolicy: tc.policy,
+			})
+
+			// Start the first epoch
+			suite.Ctx = suite.Ctx.WithBlockHeight(1).WithBlockTime(now)
+			suite.App.EpochsKeeper.BeginBlocker(suite.Ctx)
+
+			// Process the first block after the halt
+			suite.Ctx = suite.Ctx.WithBlockHeight(2).WithBlockTime(now.Add(tc.haltDuration))
+			suite.App.EpochsKeeper.BeginBlocker(suite.Ctx)
+
+			epochInfo, found := suite.App.EpochsKeeper.GetEpochInfo(suite.Ctx, epochId)
+			suite.Require().True(found, "epoch should exist")
+			suite.Require().Equal(tc.expCurrentEpoch, epochInfo.CurrentEpoch, "current epoch")
+			suite.Require().Equal(tc.expMissedEpochs, epochInfo.MissedEpochs, "missed epochs")
+			suite.Require().Equal(tc.expCurrentEpochStartTime, epochInfo.CurrentEpochStartTime.UTC(), "current epoch start time")
+
+			skippedEvents := []sdk.Event{}
+			for _, event := range suite.Ctx.EventManager().Events() {
+				if event.Type == types.EventTypeEpochsSkipped {
+					skippedEvents = append(skippedEvents, event)
+				}
+			}
+			if tc.expSkippedEpochs > 0 {
+				suite.Require().Len(skippedEvents, 1, "skipped event should have been emitted")
+				skippedAttribute, found := skippedEvents[0].GetAttribute(types.AttributeSkippedEpochs)
+				suite.Require().True(found, "skipped epochs attribute")
+				suite.Require().Equal(fmt.Sprint(tc.expSkippedEpochs), skippedAttribute.Value, "skipped epochs")
+			} else {
+				suite.Require().Empty(skippedEvents, "skipped event should not have been emitted")
+			}
+
+			// The next regular epoch should reset the missed epoch count
+			nextEpochTime := epochInfo.CurrentEpochStartTime.Add(time.Hour + time.Second)
+			suite.Ctx = suite.Ctx.WithBlockHeight(3).WithBlockTime(nextEpochTime)
+			suite.App.EpochsKeeper.BeginBlocker(suite.Ctx)
+
+			epochInfo, _ = suite.App.EpochsKeeper.GetEpochInfo(suite.Ctx, epochId)
+			suite.Require().Equal(tc.expCurrentEpoch+1, epochInfo.CurrentEpoch, "next epoch")
+			suite.Require().Zero(epochInfo.MissedEpochs, "missed epochs after next epoch")
+		})
+	}
+}
+
+// Mock hook that records the epoch info passed to each BeforeEpochStart call
+type recordingEpochHooks struct {
+	epochInfos *[]types.EpochInfo
+}
+
+var _ types.EpochHooks = recordingEpochHooks{}
+
+func (h recordingEpochHooks) AfterEpochEnd(ctx sdk.Context, epochInfo types.EpochInfo) {}
+
+func (h recordingEpochHooks) BeforeEpochStart(ctx sdk.Context, epochInfo types.EpochInfo) {
+	*h.epochInfos = append(*h.epochInfos, epochInfo)
+}
+
+func (h recordingEpochHooks) GetModuleName() string {
+	return "recording"
+}
+
+func (suite *KeeperTestSuite) TestEpochCatchUpPolicies_Hooks() {
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	epochId := "catch_up"
+
+	// The chain halts from epoch 1 until epoch 6, which jumps over epoch 4
+	// A hook that runs every 4 epochs should only run if the missed epochs are passed to the hook
+	testCases := []struct {
+		name               string
+		policy             types.CatchUpPolicy
+		expMissedEpochs    int64
+		expIntervalReached bool
+	}{
+		{
+			name:               "skip missed epochs",
+			policy:             types.CATCH_UP_SKIP_MISSED,
+			expMissedEpochs:    0,
+			expIntervalReached: false,
+		},
+		{
+			name:               "fire once",
+			policy:             types.CATCH_UP_FIRE_ONCE,
+			expMissedEpochs:    4,
+			expIntervalReached: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			epochInfos := &[]types.EpochInfo{}
+			epochsKeeper := keeper.NewKeeper(suite.App.AppCodec(), suite.App.GetKey(types.StoreKey), suite.App.EpochsKeeper.GetAuthority())
+			epochsKeeper.SetHooks(types.NewMultiEpochHooks(recordingEpochHooks{epochInfos: epochInfos}))
+
+			for _, epochInfo := range epochsKeeper.AllEpochInfos(suite.Ctx) {
+				epochsKeeper.DeleteEpochInfo(suite.Ctx, epochInfo.Identifier)
+			}
+			epochsKeeper.SetEpochInfo(suite.Ctx, types.EpochInfo{
+				Identifier:    epochId,
+				StartTime:     now,
+				Duration:      time.Hour,
+				CatchUpPolicy: tc.policy,
+			})
+
+			// Start the first epoch, and then process the first block after the halt
+			suite.Ctx = suite.Ctx.WithBlockHeight(1).WithBlockTime(now)
+			epochsKeeper.BeginBlocker(suite.Ctx)
+			suite.Ctx = suite.Ctx.WithBlockHeight(2).WithBlockTime(now.Add(time.Hour*5 + time.Minute*30))
+			epochsKeeper.BeginBlocker(suite.Ctx)
+
+			suite.Require().Len(*epochInfos, 2, "hook should fire once for the first epoch and once after the halt")
+			hookEpochInfo := (*epochInfos)[1]
+			suite.Require().Equal(int64(6), hookEpochInfo.CurrentEpoch, "hook current epoch")
+			suite.Require().Equal(tc.expMissedEpochs, hookEpochInfo.MissedEpochs, "hook missed epochs")
+			suite.Require().Equal(tc.expIntervalReached, hookEpochInfo.IsIntervalBoundary(4), "hook interval boundary")
+		})
+	}
+}
@@ -1,6 +1,9 @@
 package keeper
 
 import (
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
 	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
@@ -64,3 +67,24 @@ func (k Keeper) AllEpochInfos(ctx sdk.Context) []types.EpochInfo {
 	})
 	return epochs
 }
+
+// Confirms that adding an epoch with the given identifier and duration would preserve
+// the relationship between the stride and day epochs
+func (k Keeper) ValidateStrideAndDayEpochs(ctx sdk.Context, identifier string, duration time.Duration) error {
+	if identifier != types.STRIDE_EPOCH && identifier != types.DAY_EPOCH {
+		return nil
+	}
+
+	strideEpoch, _ := k.GetEpochInfo(ctx, types.STRIDE_EPOCH)
+	dayEpoch, _ := k.GetEpochInfo(ctx, types.DAY_EPOCH)
+	if identifier == types.STRIDE_EPOCH {
+		strideEpoch.Duration = duration
+	} else {
+		dayEpoch.Duration = duration
+	}
+
+	if err := types.ValidateStrideAndDayEpochDurations(strideEpoch.Duration, dayEpoch.Duration); err != nil {
+		return errorsmod.Wrap(types.ErrProtectedEpoch, err.Error())
+	}
+	return nil
+}
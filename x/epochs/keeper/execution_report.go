@@ -0,0 +1,53 @@
+package keeper
+
+import (
+	"sync"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Stride-Labs/stride/v24/x/epochs/types"
+)
+
+// Node-local store of the most recent execution report for each epoch
+// The reports include wall-clock durations, which differ between nodes, so they are
+// kept in memory rather than in state and are cleared when the node restarts
+type executionReportCache struct {
+	mu      sync.RWMutex
+	reports map[string]types.EpochExecutionReport
+}
+
+func newExecutionReportCache() *executionReportCache {
+	return &executionReportCache{
+		reports: map[string]types.EpochExecutionReport{},
+	}
+}
+
+// Returns the execution report from the last time the epoch started
+func (k Keeper) GetEpochExecutionReport(identifier string) (report types.EpochExecutionReport, found bool) {
+	k.executionReports.mu.RLock()
+	defer k.executionReports.mu.RUnlock()
+
+	report, found = k.executionReports.reports[identifier]
+	return report, found
+}
+
+// Adds the hook executions to the epoch's execution report
+// Since both the AfterEpochEnd and BeforeEpochStart hooks are run in the same block, if the
+// existing report is from the current block, the executions are appended to that report;
+// otherwise, the report is replaced
+func (k Keeper) recordHookExecutions(ctx sdk.Context, epochInfo types.EpochInfo, executions []types.EpochHookExecution) {
+	k.executionReports.mu.Lock()
+	defer k.executionReports.mu.Unlock()
+
+	report, found := k.executionReports.reports[epochInfo.Identifier]
+	if !found || report.BlockHeight != ctx.BlockHeight() || report.EpochNumber != epochInfo.CurrentEpoch {
+		report = types.EpochExecutionReport{
+			EpochIdentifier: epochInfo.Identifier,
+			EpochNumber:     epochInfo.CurrentEpoch,
+			BlockHeight:     ctx.BlockHeight(),
+		}
+	}
+	report.Hooks = append(report.Hooks, executions...)
+
+	k.executionReports.reports[epochInfo.Identifier] = report
+}
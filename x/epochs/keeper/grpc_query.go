@@ -75,3 +75,22 @@ func (k Keeper) EpochInfo(c context.Context, req *types.QueryEpochInfoRequest) (
 		Epoch: info,
 	}, nil
 }
+
+// Queries the hook execution report from the last time the epoch started
+func (k Keeper) EpochExecutionReport(
+	c context.Context,
+	req *types.QueryEpochExecutionReportRequest,
+) (*types.QueryEpochExecutionReportResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	report, found := k.GetEpochExecutionReport(req.Identifier)
+	if !found {
+		return nil, status.Error(codes.NotFound, "epoch execution report not found")
+	}
+
+	return &types.QueryEpochExecutionReportResponse{
+		Report: report,
+	}, nil
+}
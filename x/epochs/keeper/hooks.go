@@ -1,17 +1,110 @@
 package keeper
 
 import (
+	"fmt"
+	"time"
+
+	"github.com/armon/go-metrics"
+	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	"github.com/Stride-Labs/stride/v24/utils"
 	"github.com/Stride-Labs/stride/v24/x/epochs/types"
 )
 
 // AfterEpochEnd executes the indicated hook after epochs ends
 func (k Keeper) AfterEpochEnd(ctx sdk.Context, epochInfo types.EpochInfo) {
-	k.hooks.AfterEpochEnd(ctx, epochInfo)
+	k.executeHooks(ctx, epochInfo, types.HOOK_AFTER_EPOCH_END)
 }
 
 // BeforeEpochStart executes the indicated hook before the epochs
 func (k Keeper) BeforeEpochStart(ctx sdk.Context, epochInfo types.EpochInfo) {
-	k.hooks.BeforeEpochStart(ctx, epochInfo)
+	k.executeHooks(ctx, epochInfo, types.HOOK_BEFORE_EPOCH_START)
+}
+
+// Runs each module's hook in its own cached context, so that a panic in one module's hook
+// discards only that module's state changes and does not prevent the other hooks from running
+// The gas, duration and outcome of each hook (and any steps run within it) are recorded in the
+// epoch's execution report and emitted as telemetry
+func (k Keeper) executeHooks(ctx sdk.Context, epochInfo types.EpochInfo, hookType types.HookType) {
+	if k.hooks == nil {
+		return
+	}
+
+	hooks := []types.EpochHooks{k.hooks}
+	if multiHooks, ok := k.hooks.(types.MultiEpochHooks); ok {
+		hooks = multiHooks
+	}
+
+	executions := []types.EpochHookExecution{}
+	for _, hook := range hooks {
+		execution := k.executeHook(ctx, epochInfo, hookType, hook)
+		EmitHookExecutionTelemetry(epochInfo.Identifier, execution)
+		executions = append(executions, execution)
+	}
+
+	k.recordHookExecutions(ctx, epochInfo, executions)
+}
+
+// Runs a single module's hook in a cached context, recording any steps run by the hook
+func (k Keeper) executeHook(
+	ctx sdk.Context,
+	epochInfo types.EpochInfo,
+	hookType types.HookType,
+	hook types.EpochHooks,
+) types.EpochHookExecution {
+	recorder := &types.StepRecorder{}
+	hookCtx := types.WithStepRecorder(ctx, recorder)
+
+	start := time.Now()
+	gasBefore := ctx.GasMeter().GasConsumed()
+
+	err := utils.ApplyFuncIfNoError(hookCtx, func(ctx sdk.Context) error {
+		if hookType == types.HOOK_AFTER_EPOCH_END {
+			hook.AfterEpochEnd(ctx, epochInfo)
+		} else {
+			hook.BeforeEpochStart(ctx, epochInfo)
+		}
+		return nil
+	})
+
+	execution := types.EpochHookExecution{
+		ModuleName: hook.GetModuleName(),
+		HookType:   hookType,
+		GasUsed:    ctx.GasMeter().GasConsumed() - gasBefore,
+		Duration:   time.Since(start),
+		Steps:      recorder.Steps,
+	}
+	if err != nil {
+		k.Logger(ctx).Error(fmt.Sprintf("Epoch hook for %s failed during %s epoch %d: %s",
+			execution.ModuleName, epochInfo.Identifier, epochInfo.CurrentEpoch, err.Error()))
+		execution.Failed = true
+		execution.Error = err.Error()
+	}
+
+	return execution
+}
+
+// Emits gauges with the gas used and duration of the hook and each of its steps, as well
+// as a counter for any failures, all labeled by the epoch, module, and step
+func EmitHookExecutionTelemetry(epochIdentifier string, execution types.EpochHookExecution) {
+	labels := []metrics.Label{
+		telemetry.NewLabel("epoch", epochIdentifier),
+		telemetry.NewLabel("module", execution.ModuleName),
+		telemetry.NewLabel("hook", execution.HookType.String()),
+	}
+	emitExecutionTelemetry("hook", labels, execution.GasUsed, execution.Duration, execution.Failed)
+
+	for _, step := range execution.Steps {
+		stepLabels := append(labels, telemetry.NewLabel("step", step.Name))
+		emitExecutionTelemetry("step", stepLabels, step.GasUsed, step.Duration, step.Failed)
+	}
+}
+
+func emitExecutionTelemetry(prefix string, labels []metrics.Label, gasUsed uint64, duration time.Duration, failed bool) {
+	telemetry.SetGaugeWithLabels([]string{types.ModuleName, prefix + "_gas_used"}, float32(gasUsed), labels)
+	telemetry.SetGaugeWithLabels([]string{types.ModuleName, prefix + "_duration_seconds"}, float32(duration.Seconds()), labels)
+	if failed {
+		telemetry.IncrCounterWithLabels([]string{types.ModuleName, prefix + "_failures"}, 1, labels)
+	}
 }
@@ -0,0 +1,217 @@
+package keeper_test
+
+import (
+	"errors"
+	"time"
+
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Stride-Labs/stride/v24/x/epochs/keeper"
+	"github.com/Stride-Labs/stride/v24/x/epochs/types"
+)
+
+type mockEpochStep struct {
+	name   string
+	err    error
+	panics bool
+}
+
+// Mock hook that writes a key to the store for the hook and each of its steps,
+// so that we can confirm which state changes were discarded
+type mockEpochHooks struct {
+	moduleName string
+	storeKey   storetypes.StoreKey
+	steps      []mockEpochStep
+	panics     bool
+}
+
+var _ types.EpochHooks = mockEpochHooks{}
+
+func (h mockEpochHooks) AfterEpochEnd(ctx sdk.Context, epochInfo types.EpochInfo) {}
+
+func (h mockEpochHooks) BeforeEpochStart(ctx sdk.Context, epochInfo types.EpochInfo) {
+	ctx.KVStore(h.storeKey).Set([]byte(h.moduleName), []byte{1})
+
+	for _, step := range h.steps {
+		step := step
+		_ = types.RunEpochStep(ctx, step.name, func(ctx sdk.Context) error {
+			ctx.KVStore(h.storeKey).Set([]byte(h.moduleName+"/"+step.name), []byte{1})
+			if step.panics {
+				panic("step panic")
+			}
+			return step.err
+		})
+	}
+
+	if h.panics {
+		panic("hook panic")
+	}
+}
+
+func (h mockEpochHooks) GetModuleName() string {
+	return h.moduleName
+}
+
+func (suite *KeeperTestSuite) TestExecuteHooks() {
+	suite.SetupTest()
+
+	storeKey := suite.App.GetKey(types.StoreKey)
+	epochsKeeper := keeper.NewKeeper(suite.App.AppCodec(), storeKey, suite.App.EpochsKeeper.GetAuthority())
+	epochsKeeper.SetHooks(types.NewMultiEpochHooks(
+		mockEpochHooks{
+			moduleName: "module-a",
+			storeKey:   storeKey,
+			steps: []mockEpochStep{
+				{name: "step-1"},
+				{name: "step-2", err: errors.New("step failed")},
+				{name: "step-3", panics: true},
+				{name: "step-4"},
+			},
+		},
+		mockEpochHooks{
+			moduleName: "module-b",
+			storeKey:   storeKey,
+			steps:      []mockEpochStep{{name: "step-1"}},
+			panics:     true,
+		},
+		mockEpochHooks{
+			moduleName: "module-c",
+			storeKey:   storeKey,
+		},
+	))
+
+	// Call both hooks for the same epoch in the same block
+	epochInfo := types.EpochInfo{Identifier: types.DAY_EPOCH, CurrentEpoch: 2}
+	suite.Ctx = suite.Ctx.WithBlockHeight(10)
+	epochsKeeper.AfterEpochEnd(suite.Ctx, epochInfo)
+	epochsKeeper.BeforeEpochStart(suite.Ctx, epochInfo)
+
+	// Only the failed steps and the panicked hook should have had their state changes discarded
+	store := suite.Ctx.KVStore(storeKey)
+	expectedKeys := map[string]bool{
+		"module-a":        true,
+		"module-a/step-1": true,
+		"module-a/step-2": false,
+		"module-a/step-3": false,
+		"module-a/step-4": true,
+		"module-b":        false,
+		"module-b/step-1": false,
+		"module-c":        true,
+	}
+	for key, expected := range expectedKeys {
+		suite.Require().Equal(expected, store.Has([]byte(key)), "store key %s", key)
+	}
+
+	// Check the execution report includes both the AfterEpochEnd and BeforeEpochStart hooks
+	report, found := epochsKeeper.GetEpochExecutionReport(types.DAY_EPOCH)
+	suite.Require().True(found, "execution report should have been found")
+	suite.Require().Equal(types.DAY_EPOCH, report.EpochIdentifier, "report identifier")
+	suite.Require().Equal(int64(2), report.EpochNumber, "report epoch number")
+	suite.Require().Equal(int64(10), report.BlockHeight, "report block height")
+	suite.Require().Len(report.Hooks, 6, "number of hook executions")
+
+	for i, hook := range report.Hooks[:3] {
+		suite.Require().Equal(types.HOOK_AFTER_EPOCH_END, hook.HookType, "hook type %d", i)
+		suite.Require().False(hook.Failed, "after epoch end hook %d failed", i)
+		suite.Require().Empty(hook.Steps, "after epoch end hook %d steps", i)
+	}
+
+	moduleA, moduleB, moduleC := report.Hooks[3], report.Hooks[4], report.Hooks[5]
+
+	suite.Require().Equal("module-a", moduleA.ModuleName, "module a name")
+	suite.Require().Equal(types.HOOK_BEFORE_EPOCH_START, moduleA.HookType, "module a hook type")
+	suite.Require().False(moduleA.Failed, "module a should not have failed")
+	suite.Require().Positive(moduleA.GasUsed, "module a gas used")
+	suite.Require().Len(moduleA.Steps, 4, "module a steps")
+
+	expectedSteps := []struct {
+		name   string
+		failed bool
+		err    string
+	}{
+		{name: "step-1", failed: false},
+		{name: "step-2", failed: true, err: "step failed"},
+		{name: "step-3", failed: true, err: "panic occurred during execution"},
+		{name: "step-4", failed: false},
+	}
+	for i, expected := range expectedSteps {
+		step := moduleA.Steps[i]
+		suite.Require().Equal(expected.name, step.Name, "step %d name", i)
+		suite.Require().Equal(expected.failed, step.Failed, "step %d failed", i)
+		suite.Require().Equal(expected.err, step.Error, "step %d error", i)
+		suite.Require().Positive(step.GasUsed, "step %d gas used", i)
+	}
+
+	suite.Require().Equal("module-b", moduleB.ModuleName, "module b name")
+	suite.Require().True(moduleB.Failed, "module b should have failed")
+	suite.Require().Equal("panic occurred during execution", moduleB.Error, "module b error")
+	suite.Require().Len(moduleB.Steps, 1, "module b steps")
+
+	suite.Require().Equal("module-c", moduleC.ModuleName, "module c name")
+	suite.Require().False(moduleC.Failed, "module c should not have failed")
+
+	// Check the report is returned from the query
+	response, err := epochsKeeper.EpochExecutionReport(suite.Ctx, &types.QueryEpochExecutionReportRequest{
+		Identifier: types.DAY_EPOCH,
+	})
+	suite.Require().NoError(err, "no error expected when querying the execution report")
+	suite.Require().Equal(report, response.Report, "queried report")
+
+	// Once the epoch starts again in a later block, the report should be replaced
+	epochInfo.CurrentEpoch = 3
+	suite.Ctx = suite.Ctx.WithBlockHeight(20)
+	epochsKeeper.BeforeEpochStart(suite.Ctx, epochInfo)
+
+	report, found = epochsKeeper.GetEpochExecutionReport(types.DAY_EPOCH)
+	suite.Require().True(found, "execution report should have been found after the next epoch")
+	suite.Require().Equal(int64(3), report.EpochNumber, "report epoch number after the next epoch")
+	suite.Require().Equal(int64(20), report.BlockHeight, "report block height after the next epoch")
+	suite.Require().Len(report.Hooks, 3, "number of hook executions after the next epoch")
+
+	// There should not be a report for an epoch that hasn't started
+	_, err = epochsKeeper.EpochExecutionReport(suite.Ctx, &types.QueryEpochExecutionReportRequest{
+		Identifier: types.WEEK_EPOCH,
+	})
+	suite.Require().ErrorContains(err, "epoch execution report not found")
+}
+
+func (suite *KeeperTestSuite) TestExecuteHooks_StakeibcSteps() {
+	suite.SetupTest()
+
+	// Start the stride epoch with the app's registered hooks
+	epochInfo, found := suite.App.EpochsKeeper.GetEpochInfo(suite.Ctx, types.STRIDE_EPOCH)
+	suite.Require().True(found, "stride epoch should exist")
+	epochInfo.CurrentEpoch = 4
+	epochInfo.CurrentEpochStartTime = time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	suite.App.EpochsKeeper.BeforeEpochStart(suite.Ctx, epochInfo)
+
+	// Query the report and confirm each of the stakeibc steps were recorded
+	response, err := suite.queryClient.EpochExecutionReport(suite.Ctx, &types.QueryEpochExecutionReportRequest{
+		Identifier: types.STRIDE_EPOCH,
+	})
+	suite.Require().NoError(err, "no error expected when querying the execution report")
+
+	stepNames := map[string][]string{}
+	for _, hook := range response.Report.Hooks {
+		suite.Require().False(hook.Failed, "%s hook should not have failed", hook.ModuleName)
+		for _, step := range hook.Steps {
+			suite.Require().False(step.Failed, "%s step %s should not have failed", hook.ModuleName, step.Name)
+			stepNames[hook.ModuleName] = append(stepNames[hook.ModuleName], step.Name)
+		}
+	}
+
+	suite.Require().Equal([]string{
+		"claim_staking_rewards",
+		"create_deposit_records",
+		"set_withdrawal_addresses",
+		"update_redemption_rates",
+		"transfer_deposits",
+		"delegate_deposits",
+		"reinvest_rewards",
+		"rebalance",
+		"sweep_unbonded_tokens",
+		"community_pool_transfers",
+		"trade_route_transfers",
+	}, stepNames["stakeibc"], "stakeibc steps")
+}
@@ -13,19 +13,30 @@ import (
 
 // Keeper of this module maintains collections of epochs and hooks.
 type Keeper struct {
-	cdc      codec.Codec
-	storeKey storetypes.StoreKey
-	hooks    types.EpochHooks
+	cdc       codec.Codec
+	storeKey  storetypes.StoreKey
+	authority string
+	hooks     types.EpochHooks
+
+	executionReports *executionReportCache
 }
 
 // NewKeeper returns a new instance of epochs Keeper
-func NewKeeper(cdc codec.Codec, storeKey storetypes.StoreKey) *Keeper {
+func NewKeeper(cdc codec.Codec, storeKey storetypes.StoreKey, authority string) *Keeper {
 	return &Keeper{
-		cdc:      cdc,
-		storeKey: storeKey,
+		cdc:       cdc,
+		storeKey:  storeKey,
+		authority: authority,
+
+		executionReports: newExecutionReportCache(),
 	}
 }
 
+// GetAuthority returns the x/epochs module's authority.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
 // SetHooks set the epoch hooks
 func (k *Keeper) SetHooks(eh types.EpochHooks) *Keeper {
 	if k.hooks != nil {
@@ -0,0 +1,132 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/Stride-Labs/stride/v24/x/epochs/types"
+)
+
+type msgServer struct {
+	Keeper
+}
+
+// NewMsgServerImpl returns an implementation of the MsgServer interface
+// for the provided Keeper.
+func NewMsgServerImpl(keeper Keeper) types.MsgServer {
+	return &msgServer{Keeper: keeper}
+}
+
+var _ types.MsgServer = msgServer{}
+
+// Proposal handler for adding a new epoch
+// The epoch starts counting at the start time, or at the current block time if no start time is provided
+func (ms msgServer) CreateEpoch(goCtx context.Context, msg *types.MsgCreateEpoch) (*types.MsgCreateEpochResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if ms.authority != msg.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", ms.authority, msg.Authority)
+	}
+
+	if _, found := ms.Keeper.GetEpochInfo(ctx, msg.Identifier); found {
+		return nil, errorsmod.Wrapf(types.ErrEpochAlreadyExists, "epoch %s", msg.Identifier)
+	}
+
+	// Since epochs advance at most once per block, an epoch that starts in the past would
+	// fire on consecutive blocks until it caught up
+	startTime := msg.StartTime
+	if startTime.IsZero() {
+		startTime = ctx.BlockTime()
+	}
+	if startTime.Before(ctx.BlockTime()) {
+		return nil, errorsmod.Wrapf(types.ErrInvalidStartTime, "start time %s is before the current block time %s",
+			startTime, ctx.BlockTime())
+	}
+
+	if err := ms.Keeper.ValidateStrideAndDayEpochs(ctx, msg.Identifier, msg.Duration); err != nil {
+		return nil, err
+	}
+
+	ms.Keeper.SetEpochInfo(ctx, types.EpochInfo{
+		Identifier:              msg.Identifier,
+		StartTime:               startTime,
+		Duration:                msg.Duration,
+		CurrentEpoch:            0,
+		CurrentEpochStartHeight: ctx.BlockHeight(),
+		EpochCountingStarted:    false,
+		CatchUpPolicy:           msg.CatchUpPolicy,
+	})
+
+	return &types.MsgCreateEpochResponse{}, nil
+}
+
+// Proposal handler for updating the duration of an epoch
+// The new duration applies to the current epoch, meaning the current epoch will end
+// at the current epoch's start time plus the new duration
+func (ms msgServer) UpdateEpochDuration(goCtx context.Context, msg *types.MsgUpdateEpochDuration) (*types.MsgUpdateEpochDurationResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if ms.authority != msg.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", ms.authority, msg.Authority)
+	}
+
+	epochInfo, found := ms.Keeper.GetEpochInfo(ctx, msg.Identifier)
+	if !found {
+		return nil, errorsmod.Wrapf(types.ErrEpochNotFound, "epoch %s", msg.Identifier)
+	}
+
+	// stakeibc tracks the stride and day epoch durations separately, and updates each tracker
+	// at the start of the respective epoch, so changing either duration would break the
+	// relationship between the two until both epochs restart
+	if msg.Identifier == types.STRIDE_EPOCH || msg.Identifier == types.DAY_EPOCH {
+		return nil, errorsmod.Wrapf(types.ErrProtectedEpoch, "the %s and %s epoch durations cannot be updated",
+			types.STRIDE_EPOCH, types.DAY_EPOCH)
+	}
+
+	epochInfo.Duration = msg.Duration
+	ms.Keeper.SetEpochInfo(ctx, epochInfo)
+
+	return &types.MsgUpdateEpochDurationResponse{}, nil
+}
+
+// Proposal handler for removing an epoch
+func (ms msgServer) DeleteEpoch(goCtx context.Context, msg *types.MsgDeleteEpoch) (*types.MsgDeleteEpochResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if ms.authority != msg.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", ms.authority, msg.Authority)
+	}
+
+	if _, found := ms.Keeper.GetEpochInfo(ctx, msg.Identifier); !found {
+		return nil, errorsmod.Wrapf(types.ErrEpochNotFound, "epoch %s", msg.Identifier)
+	}
+
+	if types.ProtectedEpochs[msg.Identifier] {
+		return nil, errorsmod.Wrapf(types.ErrProtectedEpoch, "epoch %s is required by other modules and cannot be deleted", msg.Identifier)
+	}
+
+	ms.Keeper.DeleteEpochInfo(ctx, msg.Identifier)
+
+	return &types.MsgDeleteEpochResponse{}, nil
+}
+
+// Proposal handler for updating how an epoch catches up after a chain halt
+func (ms msgServer) UpdateEpochCatchUpPolicy(
+	goCtx context.Context,
+	msg *types.MsgUpdateEpochCatchUpPolicy,
+) (*types.MsgUpdateEpochCatchUpPolicyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if ms.authority != msg.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", ms.authority, msg.Authority)
+	}
+
+	epochInfo, found := ms.Keeper.GetEpochInfo(ctx, msg.Identifier)
+	if !found {
+		return nil, errorsmod.Wrapf(types.ErrEpochNotFound, "epoch %s", msg.Identifier)
+	}
+
+	epochInfo.CatchUpPolicy = msg.CatchUpPolicy
+	ms.Keeper.SetEpochInfo(ctx, epochInfo)
+
+	return &types.MsgUpdateEpochCatchUpPolicyResponse{}, nil
+}
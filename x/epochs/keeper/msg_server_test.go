@@ -0,0 +1,157 @@
+package keeper_test
+
+import (
+	"time"
+
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/Stride-Labs/stride/v24/x/epochs/keeper"
+	"github.com/Stride-Labs/stride/v24/x/epochs/types"
+)
+
+func (suite *KeeperTestSuite) TestCreateEpoch() {
+	suite.SetupTest()
+	msgServer := keeper.NewMsgServerImpl(suite.App.EpochsKeeper)
+	authority := suite.App.EpochsKeeper.GetAuthority()
+
+	blockTime := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	suite.Ctx = suite.Ctx.WithBlockTime(blockTime).WithBlockHeight(10)
+
+	// Create an epoch without a start time, it should start at the block time
+	_, err := msgServer.CreateEpoch(suite.Ctx, types.NewMsgCreateEpoch(authority, "rebalance", time.Hour, time.Time{}, types.CATCH_UP_FIRE_EVERY_EPOCH))
+	suite.Require().NoError(err, "no error expected when creating an epoch")
+
+	epochInfo, found := suite.App.EpochsKeeper.GetEpochInfo(suite.Ctx, "rebalance")
+	suite.Require().True(found, "epoch should have been created")
+	suite.Require().Equal(types.EpochInfo{
+		Identifier:              "rebalance",
+		StartTime:               blockTime,
+		Duration:                time.Hour,
+		CurrentEpochStartHeight: 10,
+	}, epochInfo, "created epoch")
+
+	// Create an epoch with a future start time and a catch up policy
+	startTime := blockTime.Add(time.Hour)
+	_, err = msgServer.CreateEpoch(suite.Ctx, types.NewMsgCreateEpoch(authority, "future", time.Minute, startTime, types.CATCH_UP_FIRE_ONCE))
+	suite.Require().NoError(err, "no error expected when creating an epoch with a start time")
+
+	epochInfo, found = suite.App.EpochsKeeper.GetEpochInfo(suite.Ctx, "future")
+	suite.Require().True(found, "future epoch should have been created")
+	suite.Require().Equal(startTime, epochInfo.StartTime, "future epoch start time")
+	suite.Require().Equal(types.CATCH_UP_FIRE_ONCE, epochInfo.CatchUpPolicy, "future epoch catch up policy")
+
+	// Confirm the epoch starts counting after the start time
+	suite.Ctx = suite.Ctx.WithBlockTime(startTime)
+	suite.App.EpochsKeeper.BeginBlocker(suite.Ctx)
+	epochInfo, _ = suite.App.EpochsKeeper.GetEpochInfo(suite.Ctx, "future")
+	suite.Require().True(epochInfo.EpochCountingStarted, "future epoch should have started")
+	suite.Require().Equal(int64(1), epochInfo.CurrentEpoch, "future epoch number")
+
+	// Attempt to create an epoch that already exists
+	_, err = msgServer.CreateEpoch(suite.Ctx, types.NewMsgCreateEpoch(authority, "rebalance", time.Hour, time.Time{}, types.CATCH_UP_FIRE_EVERY_EPOCH))
+	suite.Require().ErrorIs(err, types.ErrEpochAlreadyExists, "epoch already exists")
+
+	// Attempt to create an epoch that starts in the past
+	_, err = msgServer.CreateEpoch(suite.Ctx, types.NewMsgCreateEpoch(authority, "past", time.Hour, blockTime, types.CATCH_UP_FIRE_EVERY_EPOCH))
+	suite.Require().ErrorIs(err, types.ErrInvalidStartTime, "start time in the past")
+
+	// Attempt to create an epoch with the wrong authority
+	_, err = msgServer.CreateEpoch(suite.Ctx, types.NewMsgCreateEpoch("invalid", "other", time.Hour, time.Time{}, types.CATCH_UP_FIRE_EVERY_EPOCH))
+	suite.Require().ErrorIs(err, govtypes.ErrInvalidSigner, "invalid authority")
+}
+
+func (suite *KeeperTestSuite) TestCreateEpoch_StrideAndDayEpochRelationship() {
+	suite.SetupTest()
+	msgServer := keeper.NewMsgServerImpl(suite.App.EpochsKeeper)
+	authority := suite.App.EpochsKeeper.GetAuthority()
+
+	// Remove the default stride epoch and confirm it can only be re-created as 1/4th of the day epoch
+	dayEpoch, found := suite.App.EpochsKeeper.GetEpochInfo(suite.Ctx, types.DAY_EPOCH)
+	suite.Require().True(found, "day epoch should exist")
+	suite.App.EpochsKeeper.DeleteEpochInfo(suite.Ctx, types.STRIDE_EPOCH)
+
+	_, err := msgServer.CreateEpoch(suite.Ctx, types.NewMsgCreateEpoch(authority, types.STRIDE_EPOCH, dayEpoch.Duration/2, time.Time{}, types.CATCH_UP_FIRE_EVERY_EPOCH))
+	suite.Require().ErrorContains(err, "the day epoch must be 4 times the length of the stride_epoch epoch")
+
+	_, err = msgServer.CreateEpoch(suite.Ctx, types.NewMsgCreateEpoch(authority, types.STRIDE_EPOCH, dayEpoch.Duration/4, time.Time{}, types.CATCH_UP_FIRE_EVERY_EPOCH))
+	suite.Require().NoError(err, "no error expected when creating the stride epoch with a valid duration")
+}
+
+func (suite *KeeperTestSuite) TestUpdateEpochDuration() {
+	suite.SetupTest()
+	msgServer := keeper.NewMsgServerImpl(suite.App.EpochsKeeper)
+	authority := suite.App.EpochsKeeper.GetAuthority()
+
+	// Update the duration of the mint epoch
+	_, err := msgServer.UpdateEpochDuration(suite.Ctx, types.NewMsgUpdateEpochDuration(authority, types.MINT_EPOCH, time.Minute))
+	suite.Require().NoError(err, "no error expected when updating the epoch duration")
+
+	epochInfo, found := suite.App.EpochsKeeper.GetEpochInfo(suite.Ctx, types.MINT_EPOCH)
+	suite.Require().True(found, "mint epoch should exist")
+	suite.Require().Equal(time.Minute, epochInfo.Duration, "updated duration")
+
+	// The stride and day epochs cannot be updated
+	for _, epochId := range []string{types.STRIDE_EPOCH, types.DAY_EPOCH} {
+		_, err = msgServer.UpdateEpochDuration(suite.Ctx, types.NewMsgUpdateEpochDuration(authority, epochId, time.Minute))
+		suite.Require().ErrorIs(err, types.ErrProtectedEpoch, "%s epoch should be protected", epochId)
+	}
+
+	// Attempt to update an epoch that doesn't exist
+	_, err = msgServer.UpdateEpochDuration(suite.Ctx, types.NewMsgUpdateEpochDuration(authority, "fake", time.Minute))
+	suite.Require().ErrorIs(err, types.ErrEpochNotFound, "epoch not found")
+
+	// Attempt to update with the wrong authority
+	_, err = msgServer.UpdateEpochDuration(suite.Ctx, types.NewMsgUpdateEpochDuration("invalid", types.MINT_EPOCH, time.Minute))
+	suite.Require().ErrorIs(err, govtypes.ErrInvalidSigner, "invalid authority")
+}
+
+func (suite *KeeperTestSuite) TestDeleteEpoch() {
+	suite.SetupTest()
+	msgServer := keeper.NewMsgServerImpl(suite.App.EpochsKeeper)
+	authority := suite.App.EpochsKeeper.GetAuthority()
+
+	suite.App.EpochsKeeper.SetEpochInfo(suite.Ctx, types.EpochInfo{Identifier: "rebalance", Duration: time.Hour})
+
+	// Delete the new epoch
+	_, err := msgServer.DeleteEpoch(suite.Ctx, types.NewMsgDeleteEpoch(authority, "rebalance"))
+	suite.Require().NoError(err, "no error expected when deleting an epoch")
+
+	_, found := suite.App.EpochsKeeper.GetEpochInfo(suite.Ctx, "rebalance")
+	suite.Require().False(found, "epoch should have been deleted")
+
+	// Attempt to delete it again
+	_, err = msgServer.DeleteEpoch(suite.Ctx, types.NewMsgDeleteEpoch(authority, "rebalance"))
+	suite.Require().ErrorIs(err, types.ErrEpochNotFound, "epoch not found")
+
+	// Attempt to delete each protected epoch
+	for epochId := range types.ProtectedEpochs {
+		_, err = msgServer.DeleteEpoch(suite.Ctx, types.NewMsgDeleteEpoch(authority, epochId))
+		suite.Require().ErrorIs(err, types.ErrProtectedEpoch, "%s epoch should be protected", epochId)
+	}
+
+	// Attempt to delete with the wrong authority
+	_, err = msgServer.DeleteEpoch(suite.Ctx, types.NewMsgDeleteEpoch("invalid", types.MINT_EPOCH))
+	suite.Require().ErrorIs(err, govtypes.ErrInvalidSigner, "invalid authority")
+}
+
+func (suite *KeeperTestSuite) TestUpdateEpochCatchUpPolicy() {
+	suite.SetupTest()
+	msgServer := keeper.NewMsgServerImpl(suite.App.EpochsKeeper)
+	authority := suite.App.EpochsKeeper.GetAuthority()
+
+	// Update the catch up policy of the day epoch
+	_, err := msgServer.UpdateEpochCatchUpPolicy(suite.Ctx, types.NewMsgUpdateEpochCatchUpPolicy(authority, types.DAY_EPOCH, types.CATCH_UP_SKIP_MISSED))
+	suite.Require().NoError(err, "no error expected when updating the catch up policy")
+
+	epochInfo, found := suite.App.EpochsKeeper.GetEpochInfo(suite.Ctx, types.DAY_EPOCH)
+	suite.Require().True(found, "day epoch should exist")
+	suite.Require().Equal(types.CATCH_UP_SKIP_MISSED, epochInfo.CatchUpPolicy, "updated catch up policy")
+
+	// Attempt to update an epoch that doesn't exist
+	_, err = msgServer.UpdateEpochCatchUpPolicy(suite.Ctx, types.NewMsgUpdateEpochCatchUpPolicy(authority, "fake", types.CATCH_UP_SKIP_MISSED))
+	suite.Require().ErrorIs(err, types.ErrEpochNotFound, "epoch not found")
+
+	// Attempt to update with the wrong authority
+	_, err = msgServer.UpdateEpochCatchUpPolicy(suite.Ctx, types.NewMsgUpdateEpochCatchUpPolicy("invalid", types.DAY_EPOCH, types.CATCH_UP_SKIP_MISSED))
+	suite.Require().ErrorIs(err, govtypes.ErrInvalidSigner, "invalid authority")
+}
@@ -0,0 +1,38 @@
+package types
+
+import (
+	"fmt"
+)
+
+// Confirms the catch up policy is one of the supported enum values
+func ValidateCatchUpPolicy(policy CatchUpPolicy) error {
+	if _, ok := CatchUpPolicy_name[int32(policy)]; !ok {
+		return fmt.Errorf("invalid catch up policy: %d", policy)
+	}
+	return nil
+}
+
+// Returns true if any epoch covered by the most recent epoch transition is a multiple of the
+// interval. The transition covers the current epoch as well as any epochs that were missed
+// while catching up, so that interval-based processes still run when the epoch number jumps
+func (e EpochInfo) IsIntervalBoundary(interval uint64) bool {
+	return IsEpochIntervalBoundary(uint64(e.CurrentEpoch), uint64(e.MissedEpochs), interval)
+}
+
+// Returns true if any epoch in the range [epochNumber - missedEpochs, epochNumber] is a
+// multiple of the interval
+func IsEpochIntervalBoundary(epochNumber, missedEpochs, interval uint64) bool {
+	if interval == 0 {
+		return false
+	}
+	if missedEpochs == 0 {
+		return epochNumber%interval == 0
+	}
+
+	// Epoch numbers start at 1, so the missed range cannot extend below that
+	firstEpoch := uint64(1)
+	if missedEpochs < epochNumber {
+		firstEpoch = epochNumber - missedEpochs
+	}
+	return epochNumber/interval > (firstEpoch-1)/interval
+}
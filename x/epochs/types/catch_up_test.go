@@ -0,0 +1,40 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/Stride-Labs/stride/v24/x/epochs/types"
+)
+
+func TestIsEpochIntervalBoundary(t *testing.T) {
+	testCases := []struct {
+		name         string
+		epochNumber  uint64
+		missedEpochs uint64
+		interval     uint64
+		expected     bool
+	}{
+		{name: "no missed epochs, multiple", epochNumber: 8, missedEpochs: 0, interval: 4, expected: true},
+		{name: "no missed epochs, not multiple", epochNumber: 9, missedEpochs: 0, interval: 4, expected: false},
+		{name: "missed epochs include multiple", epochNumber: 9, missedEpochs: 1, interval: 4, expected: true},
+		{name: "missed epochs include multiple at start", epochNumber: 11, missedEpochs: 3, interval: 4, expected: true},
+		{name: "missed epochs exclude multiple", epochNumber: 11, missedEpochs: 2, interval: 4, expected: false},
+		{name: "missed epochs span multiple intervals", epochNumber: 20, missedEpochs: 15, interval: 4, expected: true},
+		{name: "missed epochs exceed epoch number", epochNumber: 3, missedEpochs: 5, interval: 2, expected: true},
+		{name: "missed epochs exceed epoch number, no multiple", epochNumber: 3, missedEpochs: 5, interval: 4, expected: false},
+		{name: "interval of one", epochNumber: 7, missedEpochs: 0, interval: 1, expected: true},
+		{name: "zero interval", epochNumber: 8, missedEpochs: 2, interval: 0, expected: false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			actual := types.IsEpochIntervalBoundary(tc.epochNumber, tc.missedEpochs, tc.interval)
+			require.Equal(t, tc.expected, actual)
+
+			epochInfo := types.EpochInfo{CurrentEpoch: int64(tc.epochNumber), MissedEpochs: int64(tc.missedEpochs)}
+			require.Equal(t, tc.expected, epochInfo.IsIntervalBoundary(tc.interval), "epoch info method")
+		})
+	}
+}
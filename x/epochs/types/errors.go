@@ -0,0 +1,12 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+)
+
+var (
+	ErrEpochNotFound      = errorsmod.Register(ModuleName, 1, "epoch not found")
+	ErrEpochAlreadyExists = errorsmod.Register(ModuleName, 2, "epoch already exists")
+	ErrProtectedEpoch     = errorsmod.Register(ModuleName, 3, "epoch cannot be modified")
+	ErrInvalidStartTime   = errorsmod.Register(ModuleName, 4, "invalid epoch start time")
+)
@@ -1,9 +1,12 @@
 package types
 
 const (
-	EventTypeEpochEnd   = "epoch_end"
-	EventTypeEpochStart = "epoch_start"
+	EventTypeEpochEnd      = "epoch_end"
+	EventTypeEpochStart    = "epoch_start"
+	EventTypeEpochsSkipped = "epochs_skipped"
 
-	AttributeEpochNumber    = "epoch_number"
-	AttributeEpochStartTime = "start_time"
+	AttributeEpochNumber     = "epoch_number"
+	AttributeEpochStartTime  = "start_time"
+	AttributeEpochIdentifier = "epoch_identifier"
+	AttributeSkippedEpochs   = "skipped_epochs"
 )
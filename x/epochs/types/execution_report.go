@@ -0,0 +1,53 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Stride-Labs/stride/v24/utils"
+)
+
+type stepRecorderKey struct{}
+
+// Collects the results of the named steps that are run within a single epoch hook
+type StepRecorder struct {
+	Steps []EpochStepExecution
+}
+
+// Attaches a step recorder to the context so that any steps run by the hook
+// are included in the hook's execution report
+func WithStepRecorder(ctx sdk.Context, recorder *StepRecorder) sdk.Context {
+	return ctx.WithValue(stepRecorderKey{}, recorder)
+}
+
+// Runs a named step of an epoch hook in a cached context, so that an error or panic
+// in the step only discards that step's state changes and does not prevent the rest
+// of the hook from running
+// If the hook was called by the epochs module, the step's gas, duration and outcome
+// are recorded in the epoch's execution report
+func RunEpochStep(ctx sdk.Context, name string, step func(ctx sdk.Context) error) error {
+	start := time.Now()
+	gasBefore := ctx.GasMeter().GasConsumed()
+
+	err := utils.ApplyFuncIfNoError(ctx, step)
+	if err != nil {
+		ctx.Logger().Error(fmt.Sprintf("Epoch step %s failed: %s", name, err.Error()))
+	}
+
+	if recorder, ok := ctx.Value(stepRecorderKey{}).(*StepRecorder); ok {
+		execution := EpochStepExecution{
+			Name:     name,
+			GasUsed:  ctx.GasMeter().GasConsumed() - gasBefore,
+			Duration: time.Since(start),
+		}
+		if err != nil {
+			execution.Failed = true
+			execution.Error = err.Error()
+		}
+		recorder.Steps = append(recorder.Steps, execution)
+	}
+
+	return err
+}
@@ -0,0 +1,1314 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: stride/epochs/execution_report.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	_ "github.com/cosmos/gogoproto/types"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// The epoch hook that was executed
+type HookType int32
+
+const (
+	HOOK_AFTER_EPOCH_END    HookType = 0
+	HOOK_BEFORE_EPOCH_START HookType = 1
+)
+
+var HookType_name = map[int32]string{
+	0: "HOOK_AFTER_EPOCH_END",
+	1: "HOOK_BEFORE_EPOCH_START",
+}
+
+var HookType_value = map[string]int32{
+	"HOOK_AFTER_EPOCH_END":    0,
+	"HOOK_BEFORE_EPOCH_START": 1,
+}
+
+func (x HookType) String() string {
+	return proto.EnumName(HookType_name, int32(x))
+}
+
+func (HookType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_e4c95ffd1515f178, []int{0}
+}
+
+// Result of a named step that was run within an epoch hook
+type EpochStepExecution struct {
+	// name of the step
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// gas consumed by the step
+	GasUsed uint64 `protobuf:"varint,2,opt,name=gas_used,json=gasUsed,proto3" json:"gas_used,omitempty"`
+	// wall-clock time spent executing the step
+	Duration time.Duration `protobuf:"bytes,3,opt,name=duration,proto3,stdduration" json:"duration"`
+	// whether the step returned an error or panicked, in which case its state
+	// changes were discarded
+	Failed bool `protobuf:"varint,4,opt,name=failed,proto3" json:"failed,omitempty"`
+	// error from the failed step
+	Error string `protobuf:"bytes,5,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (m *EpochStepExecution) Reset()         { *m = EpochStepExecution{} }
+func (m *EpochStepExecution) String() string { return proto.CompactTextString(m) }
+func (*EpochStepExecution) ProtoMessage()    {}
+func (*EpochStepExecution) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e4c95ffd1515f178, []int{0}
+}
+func (m *EpochStepExecution) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EpochStepExecution) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EpochStepExecution.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EpochStepExecution) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EpochStepExecution.Merge(m, src)
+}
+func (m *EpochStepExecution) XXX_Size() int {
+	return m.Size()
+}
+func (m *EpochStepExecution) XXX_DiscardUnknown() {
+	xxx_messageInfo_EpochStepExecution.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EpochStepExecution proto.InternalMessageInfo
+
+func (m *EpochStepExecution) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *EpochStepExecution) GetGasUsed() uint64 {
+	if m != nil {
+		return m.GasUsed
+	}
+	return 0
+}
+
+func (m *EpochStepExecution) GetDuration() time.Duration {
+	if m != nil {
+		return m.Duration
+	}
+	return 0
+}
+
+func (m *EpochStepExecution) GetFailed() bool {
+	if m != nil {
+		return m.Failed
+	}
+	return false
+}
+
+func (m *EpochStepExecution) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+// Result of a single module's epoch hook
+type EpochHookExecution struct {
+	// name of the module that registered the hook
+	ModuleName string `protobuf:"bytes,1,opt,name=module_name,json=moduleName,proto3" json:"module_name,omitempty"`
+	// which hook was called
+	HookType HookType `protobuf:"varint,2,opt,name=hook_type,json=hookType,proto3,enum=stride.epochs.HookType" json:"hook_type,omitempty"`
+	// gas consumed by the hook, including all steps
+	GasUsed uint64 `protobuf:"varint,3,opt,name=gas_used,json=gasUsed,proto3" json:"gas_used,omitempty"`
+	// wall-clock time spent executing the hook, including all steps
+	Duration time.Duration `protobuf:"bytes,4,opt,name=duration,proto3,stdduration" json:"duration"`
+	// whether the hook panicked, in which case its state changes were discarded
+	Failed bool `protobuf:"varint,5,opt,name=failed,proto3" json:"failed,omitempty"`
+	// error from the failed hook
+	Error string `protobuf:"bytes,6,opt,name=error,proto3" json:"error,omitempty"`
+	// named steps that were run within the hook
+	Steps []EpochStepExecution `protobuf:"bytes,7,rep,name=steps,proto3" json:"steps"`
+}
+
+func (m *EpochHookExecution) Reset()         { *m = EpochHookExecution{} }
+func (m *EpochHookExecution) String() string { return proto.CompactTextString(m) }
+func (*EpochHookExecution) ProtoMessage()    {}
+func (*EpochHookExecution) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e4c95ffd1515f178, []int{1}
+}
+func (m *EpochHookExecution) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EpochHookExecution) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EpochHookExecution.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EpochHookExecution) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EpochHookExecution.Merge(m, src)
+}
+func (m *EpochHookExecution) XXX_Size() int {
+	return m.Size()
+}
+func (m *EpochHookExecution) XXX_DiscardUnknown() {
+	xxx_messageInfo_EpochHookExecution.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EpochHookExecution proto.InternalMessageInfo
+
+func (m *EpochHookExecution) GetModuleName() string {
+	if m != nil {
+		return m.ModuleName
+	}
+	return ""
+}
+
+func (m *EpochHookExecution) GetHookType() HookType {
+	if m != nil {
+		return m.HookType
+	}
+	return HOOK_AFTER_EPOCH_END
+}
+
+func (m *EpochHookExecution) GetGasUsed() uint64 {
+	if m != nil {
+		return m.GasUsed
+	}
+	return 0
+}
+
+func (m *EpochHookExecution) GetDuration() time.Duration {
+	if m != nil {
+		return m.Duration
+	}
+	return 0
+}
+
+func (m *EpochHookExecution) GetFailed() bool {
+	if m != nil {
+		return m.Failed
+	}
+	return false
+}
+
+func (m *EpochHookExecution) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+func (m *EpochHookExecution) GetSteps() []EpochStepExecution {
+	if m != nil {
+		return m.Steps
+	}
+	return nil
+}
+
+// Summary of the hooks that ran the last time an epoch started
+type EpochExecutionReport struct {
+	EpochIdentifier string               `protobuf:"bytes,1,opt,name=epoch_identifier,json=epochIdentifier,proto3" json:"epoch_identifier,omitempty"`
+	EpochNumber     int64                `protobuf:"varint,2,opt,name=epoch_number,json=epochNumber,proto3" json:"epoch_number,omitempty"`
+	BlockHeight     int64                `protobuf:"varint,3,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	Hooks           []EpochHookExecution `protobuf:"bytes,4,rep,name=hooks,proto3" json:"hooks"`
+}
+
+func (m *EpochExecutionReport) Reset()         { *m = EpochExecutionReport{} }
+func (m *EpochExecutionReport) String() string { return proto.CompactTextString(m) }
+func (*EpochExecutionReport) ProtoMessage()    {}
+func (*EpochExecutionReport) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e4c95ffd1515f178, []int{2}
+}
+func (m *EpochExecutionReport) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EpochExecutionReport) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EpochExecutionReport.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EpochExecutionReport) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EpochExecutionReport.Merge(m, src)
+}
+func (m *EpochExecutionReport) XXX_Size() int {
+	return m.Size()
+}
+func (m *EpochExecutionReport) XXX_DiscardUnknown() {
+	xxx_messageInfo_EpochExecutionReport.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EpochExecutionReport proto.InternalMessageInfo
+
+func (m *EpochExecutionReport) GetEpochIdentifier() string {
+	if m != nil {
+		return m.EpochIdentifier
+	}
+	return ""
+}
+
+func (m *EpochExecutionReport) GetEpochNumber() int64 {
+	if m != nil {
+		return m.EpochNumber
+	}
+	return 0
+}
+
+func (m *EpochExecutionReport) GetBlockHeight() int64 {
+	if m != nil {
+		return m.BlockHeight
+	}
+	return 0
+}
+
+func (m *EpochExecutionReport) GetHooks() []EpochHookExecution {
+	if m != nil {
+		return m.Hooks
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterEnum("stride.epochs.HookType", HookType_name, HookType_value)
+	proto.RegisterType((*EpochStepExecution)(nil), "stride.epochs.EpochStepExecution")
+	proto.RegisterType((*EpochHookExecution)(nil), "stride.epochs.EpochHookExecution")
+	proto.RegisterType((*EpochExecutionReport)(nil), "stride.epochs.EpochExecutionReport")
+}
+
+func init() {
+	proto.RegisterFile("stride/epochs/execution_report.proto", fileDescriptor_e4c95ffd1515f178)
+}
+
+var fileDescriptor_e4c95ffd1515f178 = []byte{
+	// 545 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x53, 0xcd, 0x6e, 0xd3, 0x4c,
+	0x14, 0xf5, 0x34, 0x4e, 0xea, 0x4e, 0xbe, 0x0f, 0xa2, 0x51, 0x44, 0xdd, 0x20, 0x39, 0x69, 0xc4,
+	0x22, 0x20, 0x61, 0xa3, 0xd0, 0x2d, 0x42, 0x0d, 0x75, 0x09, 0x3f, 0x4a, 0xd0, 0x24, 0x6c, 0xd8,
+	0x58, 0x76, 0x7c, 0x63, 0x5b, 0xf9, 0x19, 0xcb, 0x63, 0xa3, 0xf6, 0x0d, 0x58, 0xb2, 0x64, 0xcf,
+	0x1b, 0x20, 0x9e, 0x01, 0x75, 0xd9, 0x25, 0x2b, 0x40, 0xc9, 0x8b, 0x20, 0x8f, 0xed, 0xd0, 0x00,
+	0x5d, 0xb1, 0x9b, 0x39, 0xf7, 0xdc, 0x7b, 0xcf, 0xb9, 0x77, 0x06, 0xdf, 0xe1, 0x71, 0x14, 0xb8,
+	0x60, 0x40, 0xc8, 0x26, 0x3e, 0x37, 0xe0, 0x0c, 0x26, 0x49, 0x1c, 0xb0, 0xa5, 0x15, 0x41, 0xc8,
+	0xa2, 0x58, 0x0f, 0x23, 0x16, 0x33, 0xf2, 0x7f, 0xc6, 0xd2, 0x33, 0x56, 0xa3, 0xee, 0x31, 0x8f,
+	0x89, 0x88, 0x91, 0x9e, 0x32, 0x52, 0x43, 0xf3, 0x18, 0xf3, 0xe6, 0x60, 0x88, 0x9b, 0x93, 0x4c,
+	0x0d, 0x37, 0x89, 0xec, 0xb4, 0x56, 0x16, 0x6f, 0x7f, 0x42, 0x98, 0x98, 0x69, 0x81, 0x51, 0x0c,
+	0xa1, 0x59, 0x34, 0x22, 0x04, 0xcb, 0x4b, 0x7b, 0x01, 0x2a, 0x6a, 0xa1, 0xce, 0x1e, 0x15, 0x67,
+	0x72, 0x80, 0x15, 0xcf, 0xe6, 0x56, 0xc2, 0xc1, 0x55, 0x77, 0x5a, 0xa8, 0x23, 0xd3, 0x5d, 0xcf,
+	0xe6, 0xaf, 0x39, 0xb8, 0xe4, 0x31, 0x56, 0x8a, 0xba, 0x6a, 0xa9, 0x85, 0x3a, 0xd5, 0xee, 0x81,
+	0x9e, 0x35, 0xd6, 0x8b, 0xc6, 0xfa, 0x49, 0x4e, 0xe8, 0x29, 0x17, 0xdf, 0x9a, 0xd2, 0x87, 0xef,
+	0x4d, 0x44, 0x37, 0x49, 0xe4, 0x16, 0xae, 0x4c, 0xed, 0x60, 0x0e, 0xae, 0x2a, 0xb7, 0x50, 0x47,
+	0xa1, 0xf9, 0x8d, 0xd4, 0x71, 0x19, 0xa2, 0x88, 0x45, 0x6a, 0x59, 0x08, 0xc9, 0x2e, 0xed, 0xcf,
+	0x3b, 0xb9, 0xe8, 0x3e, 0x63, 0xb3, 0x5f, 0xa2, 0x9b, 0xb8, 0xba, 0x60, 0x6e, 0x32, 0x07, 0xeb,
+	0x8a, 0x76, 0x9c, 0x41, 0x83, 0xd4, 0xc1, 0x11, 0xde, 0xf3, 0x19, 0x9b, 0x59, 0xf1, 0x79, 0x08,
+	0xc2, 0xc2, 0x8d, 0xee, 0xbe, 0xbe, 0x35, 0x45, 0x3d, 0xad, 0x38, 0x3e, 0x0f, 0x81, 0x2a, 0x7e,
+	0x7e, 0xda, 0xf2, 0x5d, 0xba, 0xde, 0xb7, 0xfc, 0x6f, 0xbe, 0xcb, 0x7f, 0xf7, 0x5d, 0xb9, 0xe2,
+	0x9b, 0x3c, 0xc2, 0x65, 0x1e, 0x43, 0xc8, 0xd5, 0xdd, 0x56, 0xa9, 0x53, 0xed, 0x1e, 0xfe, 0xa6,
+	0xfd, 0xcf, 0x3d, 0xf6, 0xe4, 0xb4, 0x27, 0xcd, 0xb2, 0xda, 0x5f, 0x10, 0xae, 0x0b, 0xce, 0x26,
+	0x4e, 0xc5, 0x7b, 0x22, 0x77, 0x71, 0x4d, 0x94, 0xb0, 0x02, 0x17, 0x96, 0x71, 0x30, 0x0d, 0x20,
+	0xca, 0xa7, 0x77, 0x53, 0xe0, 0xcf, 0x36, 0x30, 0x39, 0xc4, 0xff, 0x65, 0xd4, 0x65, 0xb2, 0x70,
+	0x20, 0x12, 0x53, 0x2c, 0xd1, 0xaa, 0xc0, 0x06, 0x02, 0x4a, 0x29, 0xce, 0x9c, 0x4d, 0x66, 0x96,
+	0x0f, 0x81, 0xe7, 0xc7, 0x62, 0x66, 0x25, 0x5a, 0x15, 0x58, 0x5f, 0x40, 0xa9, 0x91, 0x74, 0xbc,
+	0x5c, 0x95, 0xaf, 0x37, 0xb2, 0xb5, 0xdb, 0xc2, 0x88, 0xc8, 0xba, 0xf7, 0x14, 0x2b, 0xc5, 0x9e,
+	0x88, 0x8a, 0xeb, 0xfd, 0xe1, 0xf0, 0x85, 0x75, 0x7c, 0x3a, 0x36, 0xa9, 0x65, 0xbe, 0x1a, 0x3e,
+	0xe9, 0x5b, 0xe6, 0xe0, 0xa4, 0x26, 0x91, 0xdb, 0x78, 0x5f, 0x44, 0x7a, 0xe6, 0xe9, 0x90, 0x9a,
+	0x79, 0x68, 0x34, 0x3e, 0xa6, 0xe3, 0x1a, 0x6a, 0xc8, 0xef, 0x3e, 0x6a, 0x52, 0xef, 0xf9, 0xc5,
+	0x4a, 0x43, 0x97, 0x2b, 0x0d, 0xfd, 0x58, 0x69, 0xe8, 0xfd, 0x5a, 0x93, 0x2e, 0xd7, 0x9a, 0xf4,
+	0x75, 0xad, 0x49, 0x6f, 0x1e, 0x78, 0x41, 0xec, 0x27, 0x8e, 0x3e, 0x61, 0x0b, 0x63, 0x24, 0xc4,
+	0xdd, 0x7f, 0x69, 0x3b, 0xdc, 0xc8, 0x7f, 0xe6, 0xdb, 0xee, 0x91, 0x71, 0x56, 0xfc, 0xcf, 0xf4,
+	0x39, 0x71, 0xa7, 0x22, 0x36, 0xfe, 0xf0, 0xe7, 0x00, 0x9c, 0x1a, 0x82, 0xac, 0xbd, 0x03, 0x00,
+	0x00,
+}
+
+func (m *EpochStepExecution) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EpochStepExecution) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EpochStepExecution) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintExecutionReport(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Failed {
+		i--
+		if m.Failed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	n1, err1 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.Duration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Duration):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintExecutionReport(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x1a
+	if m.GasUsed != 0 {
+		i = encodeVarintExecutionReport(dAtA, i, uint64(m.GasUsed))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintExecutionReport(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EpochHookExecution) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EpochHookExecution) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EpochHookExecution) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Steps) > 0 {
+		for iNdEx := len(m.Steps) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Steps[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintExecutionReport(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintExecutionReport(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Failed {
+		i--
+		if m.Failed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	n2, err2 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.Duration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Duration):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintExecutionReport(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x22
+	if m.GasUsed != 0 {
+		i = encodeVarintExecutionReport(dAtA, i, uint64(m.GasUsed))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.HookType != 0 {
+		i = encodeVarintExecutionReport(dAtA, i, uint64(m.HookType))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ModuleName) > 0 {
+		i -= len(m.ModuleName)
+		copy(dAtA[i:], m.ModuleName)
+		i = encodeVarintExecutionReport(dAtA, i, uint64(len(m.ModuleName)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EpochExecutionReport) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EpochExecutionReport) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EpochExecutionReport) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Hooks) > 0 {
+		for iNdEx := len(m.Hooks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Hooks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintExecutionReport(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.BlockHeight != 0 {
+		i = encodeVarintExecutionReport(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.EpochNumber != 0 {
+		i = encodeVarintExecutionReport(dAtA, i, uint64(m.EpochNumber))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.EpochIdentifier) > 0 {
+		i -= len(m.EpochIdentifier)
+		copy(dAtA[i:], m.EpochIdentifier)
+		i = encodeVarintExecutionReport(dAtA, i, uint64(len(m.EpochIdentifier)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintExecutionReport(dAtA []byte, offset int, v uint64) int {
+	offset -= sovExecutionReport(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *EpochStepExecution) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovExecutionReport(uint64(l))
+	}
+	if m.GasUsed != 0 {
+		n += 1 + sovExecutionReport(uint64(m.GasUsed))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Duration)
+	n += 1 + l + sovExecutionReport(uint64(l))
+	if m.Failed {
+		n += 2
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovExecutionReport(uint64(l))
+	}
+	return n
+}
+
+func (m *EpochHookExecution) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ModuleName)
+	if l > 0 {
+		n += 1 + l + sovExecutionReport(uint64(l))
+	}
+	if m.HookType != 0 {
+		n += 1 + sovExecutionReport(uint64(m.HookType))
+	}
+	if m.GasUsed != 0 {
+		n += 1 + sovExecutionReport(uint64(m.GasUsed))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Duration)
+	n += 1 + l + sovExecutionReport(uint64(l))
+	if m.Failed {
+		n += 2
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovExecutionReport(uint64(l))
+	}
+	if len(m.Steps) > 0 {
+		for _, e := range m.Steps {
+			l = e.Size()
+			n += 1 + l + sovExecutionReport(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EpochExecutionReport) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.EpochIdentifier)
+	if l > 0 {
+		n += 1 + l + sovExecutionReport(uint64(l))
+	}
+	if m.EpochNumber != 0 {
+		n += 1 + sovExecutionReport(uint64(m.EpochNumber))
+	}
+	if m.BlockHeight != 0 {
+		n += 1 + sovExecutionReport(uint64(m.BlockHeight))
+	}
+	if len(m.Hooks) > 0 {
+		for _, e := range m.Hooks {
+			l = e.Size()
+			n += 1 + l + sovExecutionReport(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovExecutionReport(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozExecutionReport(x uint64) (n int) {
+	return sovExecutionReport(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *EpochStepExecution) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowExecutionReport
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EpochStepExecution: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EpochStepExecution: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecutionReport
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExecutionReport
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExecutionReport
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasUsed", wireType)
+			}
+			m.GasUsed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecutionReport
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasUsed |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecutionReport
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthExecutionReport
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthExecutionReport
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.Duration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Failed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecutionReport
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Failed = bool(v != 0)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecutionReport
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExecutionReport
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExecutionReport
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipExecutionReport(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthExecutionReport
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EpochHookExecution) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowExecutionReport
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EpochHookExecution: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EpochHookExecution: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ModuleName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecutionReport
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExecutionReport
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExecutionReport
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ModuleName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HookType", wireType)
+			}
+			m.HookType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecutionReport
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HookType |= HookType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasUsed", wireType)
+			}
+			m.GasUsed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecutionReport
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasUsed |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecutionReport
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthExecutionReport
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthExecutionReport
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.Duration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Failed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecutionReport
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Failed = bool(v != 0)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecutionReport
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExecutionReport
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExecutionReport
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Steps", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecutionReport
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthExecutionReport
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthExecutionReport
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Steps = append(m.Steps, EpochStepExecution{})
+			if err := m.Steps[len(m.Steps)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipExecutionReport(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthExecutionReport
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EpochExecutionReport) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowExecutionReport
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EpochExecutionReport: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EpochExecutionReport: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochIdentifier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecutionReport
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExecutionReport
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExecutionReport
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EpochIdentifier = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochNumber", wireType)
+			}
+			m.EpochNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecutionReport
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochNumber |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecutionReport
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hooks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecutionReport
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthExecutionReport
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthExecutionReport
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hooks = append(m.Hooks, EpochHookExecution{})
+			if err := m.Hooks[len(m.Hooks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipExecutionReport(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthExecutionReport
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipExecutionReport(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowExecutionReport
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowExecutionReport
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowExecutionReport
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthExecutionReport
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupExecutionReport
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthExecutionReport
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthExecutionReport        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowExecutionReport          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupExecutionReport = fmt.Errorf("proto: unexpected end of group")
+)
@@ -2,6 +2,7 @@ package types
 
 import (
 	"errors"
+	"fmt"
 	"time"
 )
 
@@ -17,9 +18,35 @@ var (
 	MINT_EPOCH   = "mint"
 )
 
+// The day epoch must be exactly this many stride epochs (see stakeibc's AssertStrideAndDayEpochRelationship)
+const StrideEpochsPerDayEpoch = 4
+
+// Epochs that other modules depend on, which cannot be deleted with MsgDeleteEpoch
+var ProtectedEpochs = map[string]bool{
+	HOUR_EPOCH:   true,
+	DAY_EPOCH:    true,
+	WEEK_EPOCH:   true,
+	STRIDE_EPOCH: true,
+	MINT_EPOCH:   true,
+}
+
+// Confirms the day epoch is a fixed multiple of the stride epoch, using the same
+// check as stakeibc's invariant
+func ValidateStrideAndDayEpochDurations(strideEpochDuration, dayEpochDuration time.Duration) error {
+	if strideEpochDuration <= 0 || dayEpochDuration <= 0 {
+		return nil
+	}
+	if dayEpochDuration/strideEpochDuration != StrideEpochsPerDayEpoch {
+		return fmt.Errorf("the %s epoch must be %d times the length of the %s epoch",
+			DAY_EPOCH, StrideEpochsPerDayEpoch, STRIDE_EPOCH)
+	}
+	return nil
+}
+
 // DefaultGenesis returns the default Capability genesis state
 // The hour epoch was not included in the mainnet genesis config,
-//  but has been included here for local testing
+//
+//	but has been included here for local testing
 func DefaultGenesis() *GenesisState {
 	epochs := []EpochInfo{
 		{
@@ -85,6 +112,9 @@ func (gs GenesisState) Validate() error {
 		if epoch.Duration == 0 {
 			return errors.New("epoch duration should NOT be 0")
 		}
+		if err := ValidateCatchUpPolicy(epoch.CatchUpPolicy); err != nil {
+			return err
+		}
 		// enforce EpochCountingStarted is false for all epochs
 		if epoch.EpochCountingStarted {
 			return errors.New("epoch counting should NOT be started at genesis")
@@ -27,6 +27,41 @@ var _ = time.Kitchen
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// Determines how an epoch catches up after the chain halts for longer than the
+// epoch's duration
+type CatchUpPolicy int32
+
+const (
+	// Fire each missed epoch individually, one per block, until caught up
+	CATCH_UP_FIRE_EVERY_EPOCH CatchUpPolicy = 0
+	// Jump straight to the current epoch, firing hooks once and emitting a single
+	// event with the number of skipped epochs
+	CATCH_UP_SKIP_MISSED CatchUpPolicy = 1
+	// Jump straight to the current epoch, firing hooks once with the number of
+	// missed epochs recorded in the epoch info
+	CATCH_UP_FIRE_ONCE CatchUpPolicy = 2
+)
+
+var CatchUpPolicy_name = map[int32]string{
+	0: "CATCH_UP_FIRE_EVERY_EPOCH",
+	1: "CATCH_UP_SKIP_MISSED",
+	2: "CATCH_UP_FIRE_ONCE",
+}
+
+var CatchUpPolicy_value = map[string]int32{
+	"CATCH_UP_FIRE_EVERY_EPOCH": 0,
+	"CATCH_UP_SKIP_MISSED":      1,
+	"CATCH_UP_FIRE_ONCE":        2,
+}
+
+func (x CatchUpPolicy) String() string {
+	return proto.EnumName(CatchUpPolicy_name, int32(x))
+}
+
+func (CatchUpPolicy) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_92af8154b2eb736d, []int{0}
+}
+
 type EpochInfo struct {
 	Identifier              string        `protobuf:"bytes,1,opt,name=identifier,proto3" json:"identifier,omitempty"`
 	StartTime               time.Time     `protobuf:"bytes,2,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time" yaml:"start_time"`
@@ -35,6 +70,11 @@ type EpochInfo struct {
 	CurrentEpochStartTime   time.Time     `protobuf:"bytes,5,opt,name=current_epoch_start_time,json=currentEpochStartTime,proto3,stdtime" json:"current_epoch_start_time" yaml:"current_epoch_start_time"`
 	EpochCountingStarted    bool          `protobuf:"varint,6,opt,name=epoch_counting_started,json=epochCountingStarted,proto3" json:"epoch_counting_started,omitempty"`
 	CurrentEpochStartHeight int64         `protobuf:"varint,7,opt,name=current_epoch_start_height,json=currentEpochStartHeight,proto3" json:"current_epoch_start_height,omitempty"`
+	// How the epoch advances if multiple epochs have elapsed since the last block
+	CatchUpPolicy CatchUpPolicy `protobuf:"varint,8,opt,name=catch_up_policy,json=catchUpPolicy,proto3,enum=stride.epochs.CatchUpPolicy" json:"catch_up_policy,omitempty"`
+	// Number of epochs that elapsed without firing hooks before the current epoch
+	// started (only set with the fire-once policy)
+	MissedEpochs int64 `protobuf:"varint,9,opt,name=missed_epochs,json=missedEpochs,proto3" json:"missed_epochs,omitempty"`
 }
 
 func (m *EpochInfo) Reset()         { *m = EpochInfo{} }
@@ -119,6 +159,20 @@ func (m *EpochInfo) GetCurrentEpochStartHeight() int64 {
 	return 0
 }
 
+func (m *EpochInfo) GetCatchUpPolicy() CatchUpPolicy {
+	if m != nil {
+		return m.CatchUpPolicy
+	}
+	return CATCH_UP_FIRE_EVERY_EPOCH
+}
+
+func (m *EpochInfo) GetMissedEpochs() int64 {
+	if m != nil {
+		return m.MissedEpochs
+	}
+	return 0
+}
+
 // GenesisState defines the epochs module's genesis state.
 type GenesisState struct {
 	Epochs []EpochInfo `protobuf:"bytes,1,rep,name=epochs,proto3" json:"epochs"`
@@ -165,6 +219,7 @@ func (m *GenesisState) GetEpochs() []EpochInfo {
 }
 
 func init() {
+	proto.RegisterEnum("stride.epochs.CatchUpPolicy", CatchUpPolicy_name, CatchUpPolicy_value)
 	proto.RegisterType((*EpochInfo)(nil), "stride.epochs.EpochInfo")
 	proto.RegisterType((*GenesisState)(nil), "stride.epochs.GenesisState")
 }
@@ -172,37 +227,44 @@ func init() {
 func init() { proto.RegisterFile("stride/epochs/genesis.proto", fileDescriptor_92af8154b2eb736d) }
 
 var fileDescriptor_92af8154b2eb736d = []byte{
-	// 467 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x93, 0xbf, 0x8f, 0xd3, 0x30,
-	0x14, 0xc7, 0x6b, 0x5a, 0xca, 0xd5, 0x77, 0x27, 0x84, 0x75, 0x80, 0x29, 0x22, 0x89, 0xc2, 0x12,
-	0x09, 0x48, 0xa0, 0x9c, 0x18, 0x60, 0x2b, 0xbf, 0x11, 0x53, 0xca, 0x80, 0x58, 0xaa, 0xb4, 0x75,
-	0x1d, 0x4b, 0x97, 0x38, 0x8a, 0x5f, 0x10, 0xdd, 0x98, 0x99, 0x6e, 0xe4, 0x4f, 0xba, 0xf1, 0x46,
-	0xa6, 0x80, 0xda, 0x8d, 0xf1, 0xfe, 0x02, 0x14, 0x3b, 0x29, 0x3d, 0x0a, 0x62, 0x4b, 0xfc, 0xf9,
-	0xbe, 0xef, 0xd7, 0xef, 0xe9, 0x19, 0xdf, 0x54, 0x90, 0x8b, 0x19, 0x0b, 0x58, 0x26, 0xa7, 0xb1,
-	0x0a, 0x38, 0x4b, 0x99, 0x12, 0xca, 0xcf, 0x72, 0x09, 0x92, 0xec, 0x1b, 0xe8, 0x1b, 0xd8, 0x3f,
-	0xe0, 0x92, 0x4b, 0x4d, 0x82, 0xea, 0xcb, 0x88, 0xfa, 0x16, 0x97, 0x92, 0x1f, 0xb1, 0x40, 0xff,
-	0x4d, 0x8a, 0x79, 0x30, 0x2b, 0xf2, 0x08, 0x84, 0x4c, 0x6b, 0x6e, 0xff, 0xc9, 0x41, 0x24, 0x4c,
-	0x41, 0x94, 0x64, 0x46, 0xe0, 0x7e, 0xe9, 0xe0, 0xde, 0xf3, 0x2a, 0xe1, 0x75, 0x3a, 0x97, 0xc4,
-	0xc2, 0x58, 0xcc, 0x58, 0x0a, 0x62, 0x2e, 0x58, 0x4e, 0x91, 0x83, 0xbc, 0x5e, 0xb8, 0x71, 0x42,
-	0xde, 0x63, 0xac, 0x20, 0xca, 0x61, 0x5c, 0xd9, 0xd0, 0x0b, 0x0e, 0xf2, 0x76, 0x07, 0x7d, 0xdf,
-	0x64, 0xf8, 0x4d, 0x86, 0xff, 0xae, 0xc9, 0x18, 0xde, 0x3a, 0x29, 0xed, 0xd6, 0x59, 0x69, 0x5f,
-	0x59, 0x44, 0xc9, 0xd1, 0x63, 0xf7, 0x77, 0xad, 0x7b, 0xfc, 0xdd, 0x46, 0x61, 0x4f, 0x1f, 0x54,
-	0x72, 0x12, 0xe3, 0x9d, 0xe6, 0xea, 0xb4, 0xad, 0x7d, 0x6f, 0x6c, 0xf9, 0x3e, 0xab, 0x05, 0xc3,
-	0x07, 0x95, 0xed, 0xcf, 0xd2, 0x26, 0x4d, 0xc9, 0x5d, 0x99, 0x08, 0x60, 0x49, 0x06, 0x8b, 0xb3,
-	0xd2, 0xbe, 0x6c, 0xc2, 0x1a, 0xe6, 0x7e, 0xad, 0xa2, 0xd6, 0xee, 0xe4, 0x36, 0xde, 0x9f, 0x16,
-	0x79, 0xce, 0x52, 0x18, 0xeb, 0xd1, 0xd2, 0x8e, 0x83, 0xbc, 0x76, 0xb8, 0x57, 0x1f, 0xea, 0x61,
-	0x90, 0xcf, 0x08, 0xd3, 0x73, 0xaa, 0xf1, 0x46, 0xdf, 0x17, 0xff, 0xdb, 0xf7, 0x9d, 0xba, 0x6f,
-	0xdb, 0x5c, 0xe5, 0x5f, 0x4e, 0x66, 0x0a, 0x57, 0x37, 0x93, 0x47, 0xeb, 0x89, 0x1c, 0xe2, 0x6b,
-	0x46, 0x3f, 0x95, 0x45, 0x0a, 0x22, 0xe5, 0xa6, 0x90, 0xcd, 0x68, 0xd7, 0x41, 0xde, 0x4e, 0x78,
-	0xa0, 0xe9, 0xd3, 0x1a, 0x8e, 0x0c, 0x23, 0x4f, 0x70, 0xff, 0x6f, 0x69, 0x31, 0x13, 0x3c, 0x06,
-	0x7a, 0x49, 0xb7, 0x7a, 0x7d, 0x2b, 0xf0, 0x95, 0xc6, 0xee, 0x0b, 0xbc, 0xf7, 0xd2, 0xec, 0xe0,
-	0x08, 0x22, 0x60, 0xe4, 0x11, 0xee, 0x9a, 0xed, 0xa3, 0xc8, 0x69, 0x7b, 0xbb, 0x03, 0xea, 0x9f,
-	0xdb, 0x49, 0x7f, 0xbd, 0x38, 0xc3, 0x4e, 0xd5, 0x70, 0x58, 0xab, 0x87, 0x6f, 0x4e, 0x96, 0x16,
-	0x3a, 0x5d, 0x5a, 0xe8, 0xc7, 0xd2, 0x42, 0xc7, 0x2b, 0xab, 0x75, 0xba, 0xb2, 0x5a, 0xdf, 0x56,
-	0x56, 0xeb, 0xc3, 0x7d, 0x2e, 0x20, 0x2e, 0x26, 0xfe, 0x54, 0x26, 0xc1, 0x48, 0x7b, 0xdd, 0x7b,
-	0x1b, 0x4d, 0x54, 0x50, 0x3f, 0x84, 0x8f, 0x83, 0xc3, 0xe0, 0x53, 0xf3, 0x1c, 0x60, 0x91, 0x31,
-	0x35, 0xe9, 0xea, 0xf1, 0x3e, 0xfc, 0x15, 0x00, 0x00, 0xff, 0xff, 0xe0, 0x55, 0x40, 0xa8, 0x2c,
-	0x03, 0x00, 0x00,
+	// 587 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x53, 0xbf, 0x6f, 0xd3, 0x40,
+	0x14, 0xce, 0xb5, 0xa1, 0x34, 0xd7, 0x86, 0x96, 0x53, 0x29, 0x6e, 0xa0, 0x76, 0x14, 0x96, 0x88,
+	0x1f, 0x36, 0x84, 0x8a, 0x01, 0x26, 0x92, 0xba, 0x34, 0xfc, 0x6a, 0x64, 0xb7, 0x08, 0x58, 0x2c,
+	0xc7, 0xb9, 0x38, 0x27, 0xd5, 0x3e, 0xcb, 0x77, 0x46, 0x64, 0x63, 0x64, 0xec, 0xc8, 0xce, 0x3f,
+	0xd3, 0xb1, 0x23, 0x53, 0x40, 0xcd, 0xc6, 0x58, 0x89, 0x1d, 0xf9, 0xce, 0x0e, 0x49, 0x0b, 0x62,
+	0xb3, 0xdf, 0xf7, 0xbd, 0xef, 0x7b, 0xef, 0xd3, 0x3b, 0x78, 0x83, 0xf1, 0x98, 0xf4, 0xb0, 0x81,
+	0x23, 0xea, 0x0d, 0x98, 0xe1, 0xe3, 0x10, 0x33, 0xc2, 0xf4, 0x28, 0xa6, 0x9c, 0xa2, 0xb2, 0x04,
+	0x75, 0x09, 0x56, 0xd6, 0x7c, 0xea, 0x53, 0x81, 0x18, 0xe9, 0x97, 0x24, 0x55, 0x54, 0x9f, 0x52,
+	0xff, 0x10, 0x1b, 0xe2, 0xaf, 0x9b, 0xf4, 0x8d, 0x5e, 0x12, 0xbb, 0x9c, 0xd0, 0x30, 0xc3, 0xb5,
+	0xf3, 0x38, 0x27, 0x01, 0x66, 0xdc, 0x0d, 0x22, 0x49, 0xa8, 0xfd, 0x2a, 0xc2, 0x92, 0x99, 0x3a,
+	0xb4, 0xc3, 0x3e, 0x45, 0x2a, 0x84, 0xa4, 0x87, 0x43, 0x4e, 0xfa, 0x04, 0xc7, 0x0a, 0xa8, 0x82,
+	0x7a, 0xc9, 0x9a, 0xaa, 0xa0, 0xb7, 0x10, 0x32, 0xee, 0xc6, 0xdc, 0x49, 0x65, 0x94, 0xb9, 0x2a,
+	0xa8, 0x2f, 0x35, 0x2a, 0xba, 0xf4, 0xd0, 0x73, 0x0f, 0x7d, 0x3f, 0xf7, 0x68, 0x6e, 0x1e, 0x8f,
+	0xb4, 0xc2, 0xd9, 0x48, 0xbb, 0x3a, 0x74, 0x83, 0xc3, 0xc7, 0xb5, 0x3f, 0xbd, 0xb5, 0xa3, 0xef,
+	0x1a, 0xb0, 0x4a, 0xa2, 0x90, 0xd2, 0xd1, 0x00, 0x2e, 0xe6, 0xa3, 0x2b, 0xf3, 0x42, 0x77, 0xe3,
+	0x82, 0xee, 0x76, 0x46, 0x68, 0x3e, 0x48, 0x65, 0x7f, 0x8e, 0x34, 0x94, 0xb7, 0xdc, 0xa5, 0x01,
+	0xe1, 0x38, 0x88, 0xf8, 0xf0, 0x6c, 0xa4, 0xad, 0x48, 0xb3, 0x1c, 0xab, 0x7d, 0x49, 0xad, 0x26,
+	0xea, 0xe8, 0x16, 0x2c, 0x7b, 0x49, 0x1c, 0xe3, 0x90, 0x3b, 0x22, 0x5a, 0xa5, 0x58, 0x05, 0xf5,
+	0x79, 0x6b, 0x39, 0x2b, 0x8a, 0x30, 0xd0, 0x27, 0x00, 0x95, 0x19, 0x96, 0x33, 0xb5, 0xf7, 0xa5,
+	0xff, 0xee, 0x7d, 0x27, 0xdb, 0x5b, 0x93, 0xa3, 0xfc, 0x4b, 0x49, 0xa6, 0x70, 0x6d, 0xda, 0xd9,
+	0x9e, 0x24, 0xb2, 0x05, 0xd7, 0x25, 0xdf, 0xa3, 0x49, 0xc8, 0x49, 0xe8, 0xcb, 0x46, 0xdc, 0x53,
+	0x16, 0xaa, 0xa0, 0xbe, 0x68, 0xad, 0x09, 0xb4, 0x95, 0x81, 0xb6, 0xc4, 0xd0, 0x13, 0x58, 0xf9,
+	0x9b, 0xdb, 0x00, 0x13, 0x7f, 0xc0, 0x95, 0xcb, 0x62, 0xd5, 0xeb, 0x17, 0x0c, 0x77, 0x05, 0x8c,
+	0xb6, 0xe1, 0x8a, 0xe7, 0x72, 0x6f, 0xe0, 0x24, 0x91, 0x13, 0xd1, 0x43, 0xe2, 0x0d, 0x95, 0xc5,
+	0x2a, 0xa8, 0x5f, 0x69, 0xdc, 0xd4, 0x67, 0x8e, 0x51, 0x6f, 0xa5, 0xac, 0x83, 0xa8, 0x23, 0x38,
+	0x56, 0xd9, 0x9b, 0xfe, 0x4d, 0x03, 0x0e, 0x08, 0x63, 0xb8, 0x27, 0x27, 0x60, 0x4a, 0x49, 0x06,
+	0x2c, 0x8b, 0xc2, 0x94, 0xd5, 0x76, 0xe0, 0xf2, 0x33, 0x79, 0xee, 0x36, 0x77, 0x39, 0x46, 0x8f,
+	0xe0, 0x42, 0xc6, 0x06, 0xd5, 0xf9, 0xfa, 0x52, 0x43, 0x39, 0xe7, 0x38, 0xb9, 0xd1, 0x66, 0x31,
+	0xcd, 0xd6, 0xca, 0xd8, 0xb7, 0xfb, 0xb0, 0x3c, 0x33, 0x0c, 0xda, 0x84, 0x1b, 0xad, 0xa7, 0xfb,
+	0xad, 0x5d, 0xe7, 0xa0, 0xe3, 0xec, 0xb4, 0x2d, 0xd3, 0x31, 0xdf, 0x98, 0xd6, 0x3b, 0xc7, 0xec,
+	0xec, 0xb5, 0x76, 0x57, 0x0b, 0x48, 0x81, 0x6b, 0x13, 0xd8, 0x7e, 0xd1, 0xee, 0x38, 0xaf, 0xda,
+	0xb6, 0x6d, 0x6e, 0xaf, 0x02, 0xb4, 0x0e, 0xd1, 0x6c, 0xe3, 0xde, 0xeb, 0x96, 0xb9, 0x3a, 0x57,
+	0x29, 0x7e, 0xfe, 0xaa, 0x16, 0x9a, 0xcf, 0x8f, 0x4f, 0x55, 0x70, 0x72, 0xaa, 0x82, 0x1f, 0xa7,
+	0x2a, 0x38, 0x1a, 0xab, 0x85, 0x93, 0xb1, 0x5a, 0xf8, 0x36, 0x56, 0x0b, 0xef, 0xef, 0xfb, 0x84,
+	0x0f, 0x92, 0xae, 0xee, 0xd1, 0xc0, 0xb0, 0xc5, 0xcc, 0xf7, 0x5e, 0xba, 0x5d, 0x66, 0x64, 0x6f,
+	0xfb, 0x43, 0x63, 0xcb, 0xf8, 0x98, 0xbf, 0x70, 0x3e, 0x8c, 0x30, 0xeb, 0x2e, 0x88, 0x8b, 0x79,
+	0xf8, 0x7b, 0x00, 0x13, 0x3c, 0xed, 0xe5, 0xff, 0x03, 0x00, 0x00,
 }
 
 func (m *EpochInfo) Marshal() (dAtA []byte, err error) {
@@ -225,6 +287,16 @@ func (m *EpochInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MissedEpochs != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.MissedEpochs))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.CatchUpPolicy != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.CatchUpPolicy))
+		i--
+		dAtA[i] = 0x40
+	}
 	if m.CurrentEpochStartHeight != 0 {
 		i = encodeVarintGenesis(dAtA, i, uint64(m.CurrentEpochStartHeight))
 		i--
@@ -352,6 +424,12 @@ func (m *EpochInfo) Size() (n int) {
 	if m.CurrentEpochStartHeight != 0 {
 		n += 1 + sovGenesis(uint64(m.CurrentEpochStartHeight))
 	}
+	if m.CatchUpPolicy != 0 {
+		n += 1 + sovGenesis(uint64(m.CatchUpPolicy))
+	}
+	if m.MissedEpochs != 0 {
+		n += 1 + sovGenesis(uint64(m.MissedEpochs))
+	}
 	return n
 }
 
@@ -594,6 +672,44 @@ func (m *EpochInfo) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CatchUpPolicy", wireType)
+			}
+			m.CatchUpPolicy = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CatchUpPolicy |= CatchUpPolicy(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MissedEpochs", wireType)
+			}
+			m.MissedEpochs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MissedEpochs |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -9,6 +9,8 @@ type EpochHooks interface {
 	AfterEpochEnd(ctx sdk.Context, epochInfo EpochInfo)
 	// new epoch is next block of epoch end block
 	BeforeEpochStart(ctx sdk.Context, epochInfo EpochInfo)
+	// name of the module that registered the hooks, used to identify the hook in execution reports
+	GetModuleName() string
 }
 
 var _ EpochHooks = MultiEpochHooks{}
@@ -33,3 +35,7 @@ func (h MultiEpochHooks) BeforeEpochStart(ctx sdk.Context, epochInfo EpochInfo)
 		h[i].BeforeEpochStart(ctx, epochInfo)
 	}
 }
+
+func (h MultiEpochHooks) GetModuleName() string {
+	return ModuleName
+}
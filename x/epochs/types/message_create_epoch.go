@@ -0,0 +1,71 @@
+package types
+
+import (
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/migrations/legacytx"
+)
+
+const TypeMsgCreateEpoch = "create_epoch"
+
+var (
+	_ sdk.Msg            = &MsgCreateEpoch{}
+	_ legacytx.LegacyMsg = &MsgCreateEpoch{}
+)
+
+func NewMsgCreateEpoch(
+	authority string,
+	identifier string,
+	duration time.Duration,
+	startTime time.Time,
+	catchUpPolicy CatchUpPolicy,
+) *MsgCreateEpoch {
+	return &MsgCreateEpoch{
+		Authority:     authority,
+		Identifier:    identifier,
+		Duration:      duration,
+		StartTime:     startTime,
+		CatchUpPolicy: catchUpPolicy,
+	}
+}
+
+func (msg MsgCreateEpoch) Type() string {
+	return TypeMsgCreateEpoch
+}
+
+func (msg MsgCreateEpoch) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgCreateEpoch) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgCreateEpoch) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(msg.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+func (msg *MsgCreateEpoch) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return errorsmod.Wrap(err, "invalid authority address")
+	}
+
+	if err := ValidateEpochIdentifierString(msg.Identifier); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	if msg.Duration <= 0 {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "epoch duration must be positive")
+	}
+
+	if err := ValidateCatchUpPolicy(msg.CatchUpPolicy); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	return nil
+}
@@ -0,0 +1,52 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/migrations/legacytx"
+)
+
+const TypeMsgDeleteEpoch = "delete_epoch"
+
+var (
+	_ sdk.Msg            = &MsgDeleteEpoch{}
+	_ legacytx.LegacyMsg = &MsgDeleteEpoch{}
+)
+
+func NewMsgDeleteEpoch(authority string, identifier string) *MsgDeleteEpoch {
+	return &MsgDeleteEpoch{
+		Authority:  authority,
+		Identifier: identifier,
+	}
+}
+
+func (msg MsgDeleteEpoch) Type() string {
+	return TypeMsgDeleteEpoch
+}
+
+func (msg MsgDeleteEpoch) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgDeleteEpoch) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgDeleteEpoch) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(msg.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+func (msg *MsgDeleteEpoch) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return errorsmod.Wrap(err, "invalid authority address")
+	}
+
+	if err := ValidateEpochIdentifierString(msg.Identifier); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	return nil
+}
@@ -0,0 +1,57 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/migrations/legacytx"
+)
+
+const TypeMsgUpdateEpochCatchUpPolicy = "update_epoch_catch_up_policy"
+
+var (
+	_ sdk.Msg            = &MsgUpdateEpochCatchUpPolicy{}
+	_ legacytx.LegacyMsg = &MsgUpdateEpochCatchUpPolicy{}
+)
+
+func NewMsgUpdateEpochCatchUpPolicy(authority string, identifier string, policy CatchUpPolicy) *MsgUpdateEpochCatchUpPolicy {
+	return &MsgUpdateEpochCatchUpPolicy{
+		Authority:     authority,
+		Identifier:    identifier,
+		CatchUpPolicy: policy,
+	}
+}
+
+func (msg MsgUpdateEpochCatchUpPolicy) Type() string {
+	return TypeMsgUpdateEpochCatchUpPolicy
+}
+
+func (msg MsgUpdateEpochCatchUpPolicy) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgUpdateEpochCatchUpPolicy) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgUpdateEpochCatchUpPolicy) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(msg.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+func (msg *MsgUpdateEpochCatchUpPolicy) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return errorsmod.Wrap(err, "invalid authority address")
+	}
+
+	if err := ValidateEpochIdentifierString(msg.Identifier); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	if err := ValidateCatchUpPolicy(msg.CatchUpPolicy); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	return nil
+}
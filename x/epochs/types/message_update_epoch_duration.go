@@ -0,0 +1,59 @@
+package types
+
+import (
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/migrations/legacytx"
+)
+
+const TypeMsgUpdateEpochDuration = "update_epoch_duration"
+
+var (
+	_ sdk.Msg            = &MsgUpdateEpochDuration{}
+	_ legacytx.LegacyMsg = &MsgUpdateEpochDuration{}
+)
+
+func NewMsgUpdateEpochDuration(authority string, identifier string, duration time.Duration) *MsgUpdateEpochDuration {
+	return &MsgUpdateEpochDuration{
+		Authority:  authority,
+		Identifier: identifier,
+		Duration:   duration,
+	}
+}
+
+func (msg MsgUpdateEpochDuration) Type() string {
+	return TypeMsgUpdateEpochDuration
+}
+
+func (msg MsgUpdateEpochDuration) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgUpdateEpochDuration) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgUpdateEpochDuration) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(msg.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+func (msg *MsgUpdateEpochDuration) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return errorsmod.Wrap(err, "invalid authority address")
+	}
+
+	if err := ValidateEpochIdentifierString(msg.Identifier); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	if msg.Duration <= 0 {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "epoch duration must be positive")
+	}
+
+	return nil
+}
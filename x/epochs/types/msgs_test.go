@@ -0,0 +1,181 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Stride-Labs/stride/v24/app/apptesting"
+	"github.com/Stride-Labs/stride/v24/x/epochs/types"
+)
+
+func TestMsgCreateEpoch(t *testing.T) {
+	apptesting.SetupConfig()
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+
+	tests := []struct {
+		name string
+		msg  *types.MsgCreateEpoch
+		err  string
+	}{
+		{
+			name: "successful message",
+			msg:  types.NewMsgCreateEpoch(authority, "rebalance", time.Hour, time.Time{}, types.CATCH_UP_FIRE_EVERY_EPOCH),
+		},
+		{
+			name: "invalid authority",
+			msg:  types.NewMsgCreateEpoch("invalid", "rebalance", time.Hour, time.Time{}, types.CATCH_UP_FIRE_EVERY_EPOCH),
+			err:  "invalid authority address",
+		},
+		{
+			name: "blank identifier",
+			msg:  types.NewMsgCreateEpoch(authority, " ", time.Hour, time.Time{}, types.CATCH_UP_FIRE_EVERY_EPOCH),
+			err:  "blank epoch identifier",
+		},
+		{
+			name: "zero duration",
+			msg:  types.NewMsgCreateEpoch(authority, "rebalance", 0, time.Time{}, types.CATCH_UP_FIRE_EVERY_EPOCH),
+			err:  "epoch duration must be positive",
+		},
+		{
+			name: "invalid catch up policy",
+			msg:  types.NewMsgCreateEpoch(authority, "rebalance", time.Hour, time.Time{}, types.CatchUpPolicy(99)),
+			err:  "invalid catch up policy",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if test.err == "" {
+				require.NoError(t, test.msg.ValidateBasic(), "test: %v", test.name)
+				require.Equal(t, "create_epoch", test.msg.Type(), "type")
+			} else {
+				require.ErrorContains(t, test.msg.ValidateBasic(), test.err, "test: %v", test.name)
+			}
+		})
+	}
+}
+
+func TestMsgUpdateEpochDuration(t *testing.T) {
+	apptesting.SetupConfig()
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+
+	tests := []struct {
+		name string
+		msg  *types.MsgUpdateEpochDuration
+		err  string
+	}{
+		{
+			name: "successful message",
+			msg:  types.NewMsgUpdateEpochDuration(authority, "mint", time.Hour),
+		},
+		{
+			name: "invalid authority",
+			msg:  types.NewMsgUpdateEpochDuration("invalid", "mint", time.Hour),
+			err:  "invalid authority address",
+		},
+		{
+			name: "blank identifier",
+			msg:  types.NewMsgUpdateEpochDuration(authority, "", time.Hour),
+			err:  "blank epoch identifier",
+		},
+		{
+			name: "negative duration",
+			msg:  types.NewMsgUpdateEpochDuration(authority, "mint", -time.Hour),
+			err:  "epoch duration must be positive",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if test.err == "" {
+				require.NoError(t, test.msg.ValidateBasic(), "test: %v", test.name)
+				require.Equal(t, "update_epoch_duration", test.msg.Type(), "type")
+			} else {
+				require.ErrorContains(t, test.msg.ValidateBasic(), test.err, "test: %v", test.name)
+			}
+		})
+	}
+}
+
+func TestMsgDeleteEpoch(t *testing.T) {
+	apptesting.SetupConfig()
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+
+	tests := []struct {
+		name string
+		msg  *types.MsgDeleteEpoch
+		err  string
+	}{
+		{
+			name: "successful message",
+			msg:  types.NewMsgDeleteEpoch(authority, "rebalance"),
+		},
+		{
+			name: "invalid authority",
+			msg:  types.NewMsgDeleteEpoch("invalid", "rebalance"),
+			err:  "invalid authority address",
+		},
+		{
+			name: "blank identifier",
+			msg:  types.NewMsgDeleteEpoch(authority, ""),
+			err:  "blank epoch identifier",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if test.err == "" {
+				require.NoError(t, test.msg.ValidateBasic(), "test: %v", test.name)
+				require.Equal(t, "delete_epoch", test.msg.Type(), "type")
+			} else {
+				require.ErrorContains(t, test.msg.ValidateBasic(), test.err, "test: %v", test.name)
+			}
+		})
+	}
+}
+
+func TestMsgUpdateEpochCatchUpPolicy(t *testing.T) {
+	apptesting.SetupConfig()
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+
+	tests := []struct {
+		name string
+		msg  *types.MsgUpdateEpochCatchUpPolicy
+		err  string
+	}{
+		{
+			name: "successful message",
+			msg:  types.NewMsgUpdateEpochCatchUpPolicy(authority, "day", types.CATCH_UP_FIRE_ONCE),
+		},
+		{
+			name: "invalid authority",
+			msg:  types.NewMsgUpdateEpochCatchUpPolicy("invalid", "day", types.CATCH_UP_FIRE_ONCE),
+			err:  "invalid authority address",
+		},
+		{
+			name: "blank identifier",
+			msg:  types.NewMsgUpdateEpochCatchUpPolicy(authority, "", types.CATCH_UP_FIRE_ONCE),
+			err:  "blank epoch identifier",
+		},
+		{
+			name: "invalid policy",
+			msg:  types.NewMsgUpdateEpochCatchUpPolicy(authority, "day", types.CatchUpPolicy(99)),
+			err:  "invalid catch up policy",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if test.err == "" {
+				require.NoError(t, test.msg.ValidateBasic(), "test: %v", test.name)
+				require.Equal(t, "update_epoch_catch_up_policy", test.msg.Type(), "type")
+			} else {
+				require.ErrorContains(t, test.msg.ValidateBasic(), test.err, "test: %v", test.name)
+			}
+		})
+	}
+}
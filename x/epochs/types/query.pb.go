@@ -302,6 +302,94 @@ func (m *QueryEpochInfoResponse) GetEpoch() EpochInfo {
 	return EpochInfo{}
 }
 
+type QueryEpochExecutionReportRequest struct {
+	Identifier string `protobuf:"bytes,1,opt,name=identifier,proto3" json:"identifier,omitempty"`
+}
+
+func (m *QueryEpochExecutionReportRequest) Reset()         { *m = QueryEpochExecutionReportRequest{} }
+func (m *QueryEpochExecutionReportRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryEpochExecutionReportRequest) ProtoMessage()    {}
+func (*QueryEpochExecutionReportRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_de81e87fff8f1327, []int{6}
+}
+func (m *QueryEpochExecutionReportRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEpochExecutionReportRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEpochExecutionReportRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryEpochExecutionReportRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEpochExecutionReportRequest.Merge(m, src)
+}
+func (m *QueryEpochExecutionReportRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEpochExecutionReportRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEpochExecutionReportRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEpochExecutionReportRequest proto.InternalMessageInfo
+
+func (m *QueryEpochExecutionReportRequest) GetIdentifier() string {
+	if m != nil {
+		return m.Identifier
+	}
+	return ""
+}
+
+type QueryEpochExecutionReportResponse struct {
+	Report EpochExecutionReport `protobuf:"bytes,1,opt,name=report,proto3" json:"report"`
+}
+
+func (m *QueryEpochExecutionReportResponse) Reset()         { *m = QueryEpochExecutionReportResponse{} }
+func (m *QueryEpochExecutionReportResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryEpochExecutionReportResponse) ProtoMessage()    {}
+func (*QueryEpochExecutionReportResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_de81e87fff8f1327, []int{7}
+}
+func (m *QueryEpochExecutionReportResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEpochExecutionReportResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEpochExecutionReportResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryEpochExecutionReportResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEpochExecutionReportResponse.Merge(m, src)
+}
+func (m *QueryEpochExecutionReportResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEpochExecutionReportResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEpochExecutionReportResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEpochExecutionReportResponse proto.InternalMessageInfo
+
+func (m *QueryEpochExecutionReportResponse) GetReport() EpochExecutionReport {
+	if m != nil {
+		return m.Report
+	}
+	return EpochExecutionReport{}
+}
+
 func init() {
 	proto.RegisterType((*QueryEpochsInfoRequest)(nil), "stride.epochs.QueryEpochsInfoRequest")
 	proto.RegisterType((*QueryEpochsInfoResponse)(nil), "stride.epochs.QueryEpochsInfoResponse")
@@ -309,44 +397,51 @@ func init() {
 	proto.RegisterType((*QueryCurrentEpochResponse)(nil), "stride.epochs.QueryCurrentEpochResponse")
 	proto.RegisterType((*QueryEpochInfoRequest)(nil), "stride.epochs.QueryEpochInfoRequest")
 	proto.RegisterType((*QueryEpochInfoResponse)(nil), "stride.epochs.QueryEpochInfoResponse")
+	proto.RegisterType((*QueryEpochExecutionReportRequest)(nil), "stride.epochs.QueryEpochExecutionReportRequest")
+	proto.RegisterType((*QueryEpochExecutionReportResponse)(nil), "stride.epochs.QueryEpochExecutionReportResponse")
 }
 
 func init() { proto.RegisterFile("stride/epochs/query.proto", fileDescriptor_de81e87fff8f1327) }
 
 var fileDescriptor_de81e87fff8f1327 = []byte{
-	// 508 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x54, 0x4d, 0x6f, 0xd3, 0x30,
-	0x18, 0xae, 0x57, 0x36, 0x69, 0xef, 0xb6, 0x8b, 0xc5, 0x47, 0xdb, 0xa1, 0x30, 0xc2, 0xd6, 0x16,
-	0x04, 0x36, 0x2b, 0x13, 0x48, 0x9c, 0x10, 0x08, 0x10, 0x08, 0x21, 0x08, 0x37, 0x2e, 0x23, 0xc9,
-	0xbc, 0xcc, 0xd2, 0x66, 0x67, 0xb1, 0x3b, 0xb1, 0x0b, 0x07, 0x0e, 0x9c, 0x11, 0xdc, 0xb8, 0xf3,
-	0x5f, 0x76, 0x9c, 0xc4, 0x85, 0x13, 0x42, 0x2d, 0x3f, 0x04, 0xc5, 0xf6, 0xda, 0x04, 0x52, 0xe5,
-	0xd4, 0xca, 0xef, 0xf3, 0xe5, 0xe7, 0xb5, 0x02, 0x6d, 0xa5, 0x33, 0xbe, 0xc3, 0x28, 0x4b, 0x65,
-	0xbc, 0xa7, 0xe8, 0xe1, 0x90, 0x65, 0xc7, 0x24, 0xcd, 0xa4, 0x96, 0x78, 0xc5, 0x8e, 0x88, 0x1d,
-	0x75, 0xce, 0x27, 0x32, 0x91, 0x66, 0x42, 0xf3, 0x7f, 0x16, 0xd4, 0xb9, 0x9c, 0x48, 0x99, 0xec,
-	0x33, 0x1a, 0xa6, 0x9c, 0x86, 0x42, 0x48, 0x1d, 0x6a, 0x2e, 0x85, 0x72, 0xd3, 0x1b, 0xb1, 0x54,
-	0x07, 0x52, 0xd1, 0x28, 0x54, 0xcc, 0x6a, 0xd3, 0xa3, 0xcd, 0x88, 0xe9, 0x70, 0x93, 0xa6, 0x61,
-	0xc2, 0x85, 0x01, 0x3b, 0xec, 0x6a, 0x39, 0x49, 0xc2, 0x04, 0x53, 0xdc, 0x09, 0xf9, 0xef, 0xe0,
-	0xe2, 0xeb, 0x9c, 0xfe, 0xd8, 0x0c, 0x9f, 0x89, 0x5d, 0x19, 0xb0, 0xc3, 0x21, 0x53, 0x1a, 0x3f,
-	0x01, 0x98, 0x4a, 0xb5, 0xd0, 0x1a, 0xea, 0x2f, 0x0d, 0xba, 0xc4, 0xfa, 0x92, 0xdc, 0x97, 0xd8,
-	0x3b, 0x39, 0x5f, 0xf2, 0x2a, 0x4c, 0x98, 0xe3, 0x06, 0x05, 0xa6, 0xff, 0x0d, 0xc1, 0xa5, 0xff,
-	0x2c, 0x54, 0x2a, 0x85, 0x62, 0xf8, 0x2e, 0x2c, 0xd8, 0x54, 0x2d, 0xb4, 0xd6, 0xec, 0x2f, 0x0d,
-	0x5a, 0xa4, 0x54, 0x0d, 0x31, 0x94, 0x9c, 0xf1, 0xf0, 0xdc, 0xc9, 0xaf, 0x2b, 0x8d, 0xc0, 0xa1,
-	0xf1, 0xd3, 0x52, 0xb6, 0x39, 0x93, 0xad, 0x57, 0x9b, 0xcd, 0x9a, 0x96, 0xc2, 0xdd, 0x87, 0x96,
-	0xc9, 0xf6, 0x68, 0x98, 0x65, 0x4c, 0x68, 0xe3, 0x77, 0x56, 0x80, 0x07, 0xc0, 0x77, 0x98, 0xd0,
-	0x7c, 0x97, 0xb3, 0xcc, 0x14, 0xb0, 0x18, 0x14, 0x4e, 0xfc, 0x07, 0xd0, 0xae, 0xe0, 0xba, 0x9b,
-	0x5d, 0x83, 0x95, 0xd8, 0x9e, 0x6f, 0x9b, 0xcc, 0x86, 0xdf, 0x0c, 0x96, 0xe3, 0x02, 0xd8, 0xbf,
-	0x07, 0x17, 0xa6, 0xcd, 0x14, 0xbb, 0xaf, 0xb3, 0x7e, 0x59, 0xdc, 0x5a, 0xa9, 0xd1, 0x2d, 0x98,
-	0x9f, 0xfa, 0xd5, 0x17, 0x6a, 0xc1, 0x83, 0xef, 0x4d, 0x98, 0x37, 0x82, 0xf8, 0x03, 0xc0, 0x04,
-	0xa3, 0xf0, 0xc6, 0x3f, 0xf4, 0xea, 0xa7, 0xd2, 0xe9, 0xd6, 0xc1, 0x6c, 0x38, 0xff, 0xea, 0xc7,
-	0x1f, 0x7f, 0xbe, 0xce, 0xad, 0xe2, 0x36, 0x7d, 0x63, 0xf0, 0xfb, 0x61, 0xa4, 0x68, 0xe9, 0x75,
-	0xe2, 0x2f, 0x08, 0x96, 0x8b, 0x85, 0xe2, 0x5e, 0x95, 0x76, 0xc5, 0xba, 0x3a, 0xfd, 0x7a, 0xa0,
-	0x8b, 0x41, 0x4d, 0x8c, 0xeb, 0xb8, 0x37, 0x33, 0x06, 0x2d, 0xed, 0x0e, 0x7f, 0x42, 0xb0, 0x38,
-	0x69, 0x05, 0xaf, 0xcf, 0xbc, 0x6d, 0xb1, 0x93, 0x8d, 0x1a, 0x94, 0xcb, 0x72, 0xd3, 0x64, 0xe9,
-	0xe2, 0xf5, 0xd9, 0x59, 0xcc, 0xcf, 0x36, 0xcf, 0x97, 0xf6, 0xfc, 0x64, 0xe4, 0xa1, 0xd3, 0x91,
-	0x87, 0x7e, 0x8f, 0x3c, 0xf4, 0x79, 0xec, 0x35, 0x4e, 0xc7, 0x5e, 0xe3, 0xe7, 0xd8, 0x6b, 0xbc,
-	0xbd, 0x9d, 0x70, 0xbd, 0x37, 0x8c, 0x48, 0x2c, 0x0f, 0x9c, 0xd2, 0xad, 0x17, 0x05, 0xa9, 0xa3,
-	0xc1, 0x16, 0x7d, 0x7f, 0x26, 0xa8, 0x8f, 0x53, 0xa6, 0xa2, 0x05, 0xf3, 0x01, 0xb8, 0xf3, 0x37,
-	0x00, 0x00, 0xff, 0xff, 0x78, 0xaa, 0x06, 0xdb, 0xa9, 0x04, 0x00, 0x00,
+	// 592 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x94, 0xcf, 0x6e, 0xd3, 0x40,
+	0x10, 0xc6, 0xe3, 0xfe, 0x93, 0x3a, 0x6d, 0x2f, 0xab, 0x52, 0x92, 0x14, 0x99, 0xd4, 0x4d, 0x93,
+	0x50, 0x15, 0x6f, 0x1b, 0x2a, 0x90, 0x38, 0x41, 0x50, 0x41, 0x20, 0x84, 0xc0, 0xdc, 0xb8, 0x04,
+	0xc7, 0xdd, 0xb8, 0x2b, 0xb5, 0x5e, 0xd7, 0xbb, 0xa9, 0xda, 0x0b, 0x07, 0x0e, 0x9c, 0x11, 0xdc,
+	0x78, 0x07, 0xde, 0xa3, 0xc7, 0x4a, 0x08, 0x89, 0x13, 0x42, 0x09, 0x0f, 0x82, 0xbc, 0xbb, 0x69,
+	0xec, 0xe0, 0xd4, 0x3d, 0x25, 0xf2, 0x7c, 0xdf, 0x7c, 0xbf, 0x9d, 0x1d, 0x1b, 0x4a, 0x5c, 0x44,
+	0x74, 0x9f, 0x60, 0x12, 0x32, 0xef, 0x80, 0xe3, 0xe3, 0x1e, 0x89, 0xce, 0xec, 0x30, 0x62, 0x82,
+	0xa1, 0x25, 0x55, 0xb2, 0x55, 0xa9, 0xbc, 0xec, 0x33, 0x9f, 0xc9, 0x0a, 0x8e, 0xff, 0x29, 0x51,
+	0xf9, 0x96, 0xcf, 0x98, 0x7f, 0x48, 0xb0, 0x1b, 0x52, 0xec, 0x06, 0x01, 0x13, 0xae, 0xa0, 0x2c,
+	0xe0, 0xba, 0xba, 0xe9, 0x31, 0x7e, 0xc4, 0x38, 0xee, 0xb8, 0x9c, 0xa8, 0xde, 0xf8, 0x64, 0xa7,
+	0x43, 0x84, 0xbb, 0x83, 0x43, 0xd7, 0xa7, 0x81, 0x14, 0x6b, 0xed, 0x6a, 0x9a, 0xc4, 0x27, 0x01,
+	0xe1, 0x74, 0xd8, 0xa8, 0x9a, 0x2e, 0x92, 0x53, 0xe2, 0xf5, 0x62, 0x6f, 0x3b, 0x22, 0x21, 0x8b,
+	0x84, 0x52, 0x59, 0xef, 0x61, 0xe5, 0x4d, 0x1c, 0xb2, 0x27, 0x55, 0xcf, 0x83, 0x2e, 0x73, 0xc8,
+	0x71, 0x8f, 0x70, 0x81, 0x9e, 0x02, 0x8c, 0x02, 0x8b, 0x46, 0xc5, 0x68, 0x2c, 0x34, 0x6b, 0xb6,
+	0xa2, 0xb3, 0x63, 0x3a, 0x5b, 0x9d, 0x5c, 0xd3, 0xd9, 0xaf, 0x5d, 0x9f, 0x68, 0xaf, 0x93, 0x70,
+	0x5a, 0xdf, 0x0c, 0xb8, 0xf9, 0x5f, 0x04, 0x0f, 0x59, 0xc0, 0x09, 0xba, 0x0f, 0x73, 0x0a, 0xaf,
+	0x68, 0x54, 0xa6, 0x1b, 0x0b, 0xcd, 0xa2, 0x9d, 0x1a, 0xa0, 0x2d, 0x2d, 0xb1, 0xa3, 0x35, 0x73,
+	0xfe, 0xfb, 0x76, 0xc1, 0xd1, 0x6a, 0xf4, 0x2c, 0xc5, 0x36, 0x25, 0xd9, 0xea, 0xb9, 0x6c, 0x2a,
+	0x34, 0x05, 0xf7, 0x10, 0x8a, 0x92, 0xed, 0x49, 0x2f, 0x8a, 0x48, 0x20, 0x64, 0xde, 0x70, 0x00,
+	0x26, 0x00, 0xdd, 0x27, 0x81, 0xa0, 0x5d, 0x4a, 0x22, 0x39, 0x80, 0x79, 0x27, 0xf1, 0xc4, 0x7a,
+	0x04, 0xa5, 0x0c, 0xaf, 0x3e, 0xd9, 0x3a, 0x2c, 0x79, 0xea, 0x79, 0x5b, 0x32, 0x4b, 0xff, 0xb4,
+	0xb3, 0xe8, 0x25, 0xc4, 0xd6, 0x03, 0xb8, 0x31, 0x9a, 0x4c, 0x72, 0xf6, 0x79, 0xd1, 0xaf, 0x60,
+	0x65, 0xdc, 0xa8, 0x73, 0x77, 0x61, 0x76, 0x94, 0x97, 0x3f, 0x50, 0x25, 0xb6, 0x5a, 0x50, 0x19,
+	0xf5, 0xdb, 0x1b, 0x6e, 0x8a, 0x23, 0x17, 0xe5, 0xba, 0x4c, 0x5d, 0x58, 0xbb, 0xa2, 0x87, 0xc6,
+	0x7b, 0x0c, 0x73, 0x6a, 0xfd, 0x34, 0xdf, 0x7a, 0x16, 0xdf, 0x98, 0x79, 0x78, 0xf7, 0xca, 0xd8,
+	0xfc, 0x39, 0x03, 0xb3, 0x32, 0x08, 0x7d, 0x00, 0xb8, 0x3c, 0x0f, 0x47, 0x1b, 0x63, 0xad, 0xb2,
+	0xd7, 0xba, 0x5c, 0xcb, 0x93, 0x29, 0x52, 0x6b, 0xed, 0xe3, 0x8f, 0xbf, 0x5f, 0xa7, 0x56, 0x51,
+	0x09, 0xbf, 0x95, 0xfa, 0x43, 0xb7, 0xc3, 0x71, 0xea, 0x95, 0x42, 0x5f, 0x0c, 0x58, 0x4c, 0x5e,
+	0x3e, 0xaa, 0x67, 0xf5, 0xce, 0x58, 0xad, 0x72, 0x23, 0x5f, 0xa8, 0x31, 0xb0, 0xc4, 0xb8, 0x83,
+	0xea, 0x13, 0x31, 0x70, 0x6a, 0xcf, 0xd0, 0x27, 0x03, 0xe6, 0x2f, 0xa7, 0x82, 0xaa, 0x13, 0x4f,
+	0x9b, 0x9c, 0xc9, 0x46, 0x8e, 0x4a, 0xb3, 0x6c, 0x49, 0x96, 0x1a, 0xaa, 0x4e, 0x66, 0x91, 0x3f,
+	0x6d, 0x1a, 0x47, 0x7f, 0x37, 0x60, 0x39, 0xeb, 0x3a, 0x11, 0x9e, 0x98, 0x96, 0xbd, 0x79, 0xe5,
+	0xed, 0xeb, 0x1b, 0x34, 0x69, 0x53, 0x92, 0x6e, 0xa1, 0xcd, 0x2b, 0x48, 0xc7, 0xbe, 0x87, 0xad,
+	0x17, 0xe7, 0x7d, 0xd3, 0xb8, 0xe8, 0x9b, 0xc6, 0x9f, 0xbe, 0x69, 0x7c, 0x1e, 0x98, 0x85, 0x8b,
+	0x81, 0x59, 0xf8, 0x35, 0x30, 0x0b, 0xef, 0xb6, 0x7d, 0x2a, 0x0e, 0x7a, 0x1d, 0xdb, 0x63, 0x47,
+	0xba, 0xdf, 0xdd, 0x97, 0x89, 0x86, 0x27, 0xcd, 0x5d, 0x7c, 0x3a, 0x6c, 0x2b, 0xce, 0x42, 0xc2,
+	0x3b, 0x73, 0xf2, 0xe3, 0x7a, 0xef, 0xdf, 0x00, 0x19, 0x94, 0xee, 0x16, 0x2b, 0x06, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -367,6 +462,9 @@ type QueryClient interface {
 	CurrentEpoch(ctx context.Context, in *QueryCurrentEpochRequest, opts ...grpc.CallOption) (*QueryCurrentEpochResponse, error)
 	// CurrentEpoch provide current epoch of specified identifier
 	EpochInfo(ctx context.Context, in *QueryEpochInfoRequest, opts ...grpc.CallOption) (*QueryEpochInfoResponse, error)
+	// Queries the gas, duration and outcome of each hook from the last time the
+	// specified epoch started
+	EpochExecutionReport(ctx context.Context, in *QueryEpochExecutionReportRequest, opts ...grpc.CallOption) (*QueryEpochExecutionReportResponse, error)
 }
 
 type queryClient struct {
@@ -404,6 +502,15 @@ func (c *queryClient) EpochInfo(ctx context.Context, in *QueryEpochInfoRequest,
 	return out, nil
 }
 
+func (c *queryClient) EpochExecutionReport(ctx context.Context, in *QueryEpochExecutionReportRequest, opts ...grpc.CallOption) (*QueryEpochExecutionReportResponse, error) {
+	out := new(QueryEpochExecutionReportResponse)
+	err := c.cc.Invoke(ctx, "/stride.epochs.Query/EpochExecutionReport", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// EpochInfos provide running epochInfos
@@ -412,6 +519,9 @@ type QueryServer interface {
 	CurrentEpoch(context.Context, *QueryCurrentEpochRequest) (*QueryCurrentEpochResponse, error)
 	// CurrentEpoch provide current epoch of specified identifier
 	EpochInfo(context.Context, *QueryEpochInfoRequest) (*QueryEpochInfoResponse, error)
+	// Queries the gas, duration and outcome of each hook from the last time the
+	// specified epoch started
+	EpochExecutionReport(context.Context, *QueryEpochExecutionReportRequest) (*QueryEpochExecutionReportResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -427,6 +537,9 @@ func (*UnimplementedQueryServer) CurrentEpoch(ctx context.Context, req *QueryCur
 func (*UnimplementedQueryServer) EpochInfo(ctx context.Context, req *QueryEpochInfoRequest) (*QueryEpochInfoResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method EpochInfo not implemented")
 }
+func (*UnimplementedQueryServer) EpochExecutionReport(ctx context.Context, req *QueryEpochExecutionReportRequest) (*QueryEpochExecutionReportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EpochExecutionReport not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -486,6 +599,24 @@ func _Query_EpochInfo_Handler(srv interface{}, ctx context.Context, dec func(int
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_EpochExecutionReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEpochExecutionReportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EpochExecutionReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stride.epochs.Query/EpochExecutionReport",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EpochExecutionReport(ctx, req.(*QueryEpochExecutionReportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "stride.epochs.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -502,6 +633,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "EpochInfo",
 			Handler:    _Query_EpochInfo_Handler,
 		},
+		{
+			MethodName: "EpochExecutionReport",
+			Handler:    _Query_EpochExecutionReport_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "stride/epochs/query.proto",
@@ -712,6 +847,69 @@ func (m *QueryEpochInfoResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryEpochExecutionReportRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEpochExecutionReportRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEpochExecutionReportRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Identifier) > 0 {
+		i -= len(m.Identifier)
+		copy(dAtA[i:], m.Identifier)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Identifier)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEpochExecutionReportResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEpochExecutionReportResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEpochExecutionReportResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Report.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -804,6 +1002,30 @@ func (m *QueryEpochInfoResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryEpochExecutionReportRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Identifier)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryEpochExecutionReportResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Report.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1332,6 +1554,171 @@ func (m *QueryEpochInfoResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryEpochExecutionReportRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEpochExecutionReportRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEpochExecutionReportRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Identifier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Identifier = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEpochExecutionReportResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEpochExecutionReportResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEpochExecutionReportResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Report", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Report.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
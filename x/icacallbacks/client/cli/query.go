@@ -27,6 +27,9 @@ func GetQueryCmd(queryRoute string) *cobra.Command {
 	cmd.AddCommand(CmdQueryParams())
 	cmd.AddCommand(CmdListCallbackData())
 	cmd.AddCommand(CmdShowCallbackData())
+	cmd.AddCommand(CmdListOrphanedCallbackData())
+	cmd.AddCommand(CmdListDeadLetters())
+	cmd.AddCommand(CmdShowDeadLetter())
 	// this line is used by starport scaffolding # 1
 
 	return cmd
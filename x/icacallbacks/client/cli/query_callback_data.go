@@ -72,3 +72,36 @@ func CmdShowCallbackData() *cobra.Command {
 
 	return cmd
 }
+
+func CmdListOrphanedCallbackData() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list-orphaned-callback-data",
+		Short: "list all callback-data whose channel was closed without the packet being acknowledged or timed out",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryOrphanedCallbackDataRequest{
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.OrphanedCallbackData(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
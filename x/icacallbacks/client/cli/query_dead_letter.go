@@ -0,0 +1,80 @@
+package cli
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+
+	"github.com/Stride-Labs/stride/v24/x/icacallbacks/types"
+)
+
+const FlagCallbackId = "callback-id"
+
+func CmdListDeadLetters() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list-dead-letters",
+		Short: "list all dead-lettered callbacks, optionally filtered by callback ID",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			callbackId, err := cmd.Flags().GetString(FlagCallbackId)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryDeadLettersRequest{
+				CallbackId: callbackId,
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.DeadLetters(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().String(FlagCallbackId, "", "Only return dead letters for the given callback ID")
+	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdShowDeadLetter() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "show-dead-letter [callback-key]",
+		Short: "shows a dead-lettered callback",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryDeadLetterRequest{
+				CallbackKey: args[0],
+			}
+
+			res, err := queryClient.DeadLetter(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -2,11 +2,15 @@ package cli
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/spf13/cobra"
 
 	"github.com/cosmos/cosmos-sdk/client"
-	// "github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/cosmos/cosmos-sdk/version"
+
 	"github.com/Stride-Labs/stride/v24/x/icacallbacks/types"
 )
 
@@ -20,7 +24,42 @@ func GetTxCmd() *cobra.Command {
 		RunE:                       client.ValidateCmd,
 	}
 
+	cmd.AddCommand(CmdRetryCallback())
 	// this line is used by starport scaffolding # 1
 
 	return cmd
 }
+
+func CmdRetryCallback() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "retry-callback [callback-key]",
+		Short: "Replays the callback of a dead-lettered packet",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Re-invokes the callback of a dead-lettered packet with the original packet and acknowledgement
+
+Example:
+  $ %[1]s tx %[2]s retry-callback icacontroller-cosmoshub-4.DELEGATION.channel-1.5
+`, version.AppName, types.ModuleName),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			callbackKey := args[0]
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRetryCallback(
+				clientCtx.GetFromAddress().String(),
+				callbackKey,
+			)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -23,6 +23,12 @@ func TestGenesis(t *testing.T) {
 				CallbackKey: "1",
 			},
 		},
+		DeadLetters: []types.DeadLetter{
+			{
+				CallbackKey: "2",
+				Attempts:    1,
+			},
+		},
 		// this line is used by starport scaffolding # genesis/test/state
 	}
 
@@ -37,5 +43,6 @@ func TestGenesis(t *testing.T) {
 	require.Equal(t, genesisState.PortId, got.PortId)
 
 	require.ElementsMatch(t, genesisState.CallbackDataList, got.CallbackDataList)
+	require.ElementsMatch(t, genesisState.DeadLetters, got.DeadLetters)
 	// this line is used by starport scaffolding # genesis/test/assert
 }
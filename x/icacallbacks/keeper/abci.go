@@ -0,0 +1,17 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Stride-Labs/stride/v24/x/icacallbacks/types"
+)
+
+// EndBlocker of icacallbacks module
+func (k Keeper) EndBlocker(ctx sdk.Context) {
+	k.RetryDeadLetters(ctx)
+
+	if ctx.BlockHeight()%types.OrphanSweepInterval == 0 {
+		k.SweepOrphanedCallbackData(ctx)
+		k.PruneExhaustedDeadLetters(ctx)
+	}
+}
@@ -16,6 +16,15 @@ func (k Keeper) SetCallbackData(ctx sdk.Context, callbackData types.CallbackData
 	), b)
 }
 
+// AddCallbackData stores new callback data after recording the block height and time,
+// as well as the current state of the packet's channel
+func (k Keeper) AddCallbackData(ctx sdk.Context, callbackData types.CallbackData) {
+	callbackData.CreatedHeight = ctx.BlockHeight()
+	callbackData.CreatedTime = uint64(ctx.BlockTime().UnixNano())
+	callbackData.ChannelState = k.GetChannelState(ctx, callbackData.PortId, callbackData.ChannelId).String()
+	k.SetCallbackData(ctx, callbackData)
+}
+
 // GetCallbackData returns a callbackData from its index
 func (k Keeper) GetCallbackData(
 	ctx sdk.Context,
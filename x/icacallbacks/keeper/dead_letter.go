@@ -0,0 +1,289 @@
+package keeper
+
+import (
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+
+	"github.com/Stride-Labs/stride/v24/utils"
+	"github.com/Stride-Labs/stride/v24/x/icacallbacks/types"
+)
+
+// Returns the prefix store for dead letters that are scheduled for an automatic retry
+func (k Keeper) retryableDeadLetterStore(ctx sdk.Context) prefix.Store {
+	return prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.DeadLetterKeyPrefix))
+}
+
+// Returns the prefix store for dead letters whose automatic retries have been exhausted
+func (k Keeper) exhaustedDeadLetterStore(ctx sdk.Context) prefix.Store {
+	return prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ExhaustedDeadLetterKeyPrefix))
+}
+
+// SetDeadLetter stores a dead letter, indexed by its callback key
+// Dead letters with exhausted retries are stored under a separate prefix so that
+// they are no longer iterated each EndBlock
+func (k Keeper) SetDeadLetter(ctx sdk.Context, deadLetter types.DeadLetter) {
+	key := types.DeadLetterKey(deadLetter.CallbackKey)
+	b := k.cdc.MustMarshal(&deadLetter)
+
+	if deadLetter.RetriesExhausted() {
+		k.retryableDeadLetterStore(ctx).Delete(key)
+		k.exhaustedDeadLetterStore(ctx).Set(key, b)
+	} else {
+		k.exhaustedDeadLetterStore(ctx).Delete(key)
+		k.retryableDeadLetterStore(ctx).Set(key, b)
+	}
+}
+
+// GetDeadLetter returns a dead letter from its callback key, regardless of whether its retries are exhausted
+func (k Keeper) GetDeadLetter(ctx sdk.Context, callbackKey string) (val types.DeadLetter, found bool) {
+	key := types.DeadLetterKey(callbackKey)
+
+	b := k.retryableDeadLetterStore(ctx).Get(key)
+	if b == nil {
+		b = k.exhaustedDeadLetterStore(ctx).Get(key)
+	}
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// RemoveDeadLetter removes a dead letter from the store
+func (k Keeper) RemoveDeadLetter(ctx sdk.Context, callbackKey string) {
+	key := types.DeadLetterKey(callbackKey)
+	k.retryableDeadLetterStore(ctx).Delete(key)
+	k.exhaustedDeadLetterStore(ctx).Delete(key)
+}
+
+// GetAllDeadLetters returns all dead letters, both retryable and exhausted
+func (k Keeper) GetAllDeadLetters(ctx sdk.Context) (list []types.DeadLetter) {
+	return append(k.GetRetryableDeadLetters(ctx), k.GetExhaustedDeadLetters(ctx)...)
+}
+
+// GetRetryableDeadLetters returns all dead letters that are scheduled for an automatic retry
+func (k Keeper) GetRetryableDeadLetters(ctx sdk.Context) (list []types.DeadLetter) {
+	return k.getDeadLetters(k.retryableDeadLetterStore(ctx))
+}
+
+// GetExhaustedDeadLetters returns all dead letters whose automatic retries have been exhausted
+func (k Keeper) GetExhaustedDeadLetters(ctx sdk.Context) (list []types.DeadLetter) {
+	return k.getDeadLetters(k.exhaustedDeadLetterStore(ctx))
+}
+
+// Returns all dead letters in a dead letter prefix store
+func (k Keeper) getDeadLetters(store prefix.Store) (list []types.DeadLetter) {
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.DeadLetter
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// Invokes a callback in a cached context so that any partial state changes are discarded if the callback fails
+func (k Keeper) invokeCallbackIfNoError(
+	ctx sdk.Context,
+	callback types.ICACallback,
+	packet channeltypes.Packet,
+	ackResponse *types.AcknowledgementResponse,
+	callbackArgs []byte,
+) error {
+	return utils.ApplyFuncIfNoError(ctx, func(ctx sdk.Context) error {
+		return callback.CallbackFunc(ctx, packet, ackResponse, callbackArgs)
+	})
+}
+
+// Persists a packet whose callback failed to the dead letter store so that it can be retried,
+// scheduling the first retry according to the callback's retry policy
+func (k Keeper) DeadLetterCallback(
+	ctx sdk.Context,
+	retryPolicy types.RetryPolicy,
+	callbackData types.CallbackData,
+	packet channeltypes.Packet,
+	ackResponse *types.AcknowledgementResponse,
+	callbackErr error,
+) error {
+	attempts := uint64(1)
+	nextRetryHeight := retryPolicy.NextRetryHeight(ctx.BlockHeight(), attempts)
+	if err := k.setNewDeadLetter(ctx, callbackData, packet, ackResponse, attempts, callbackErr.Error(), nextRetryHeight); err != nil {
+		return err
+	}
+
+	k.Logger(ctx).Error(fmt.Sprintf("icacallback %s failed for %s, moved to dead letter store: %s",
+		callbackData.CallbackId, callbackData.CallbackKey, callbackErr.Error()))
+
+	return nil
+}
+
+// Persists a packet whose ack was a failure or timeout to the dead letter store so that it
+// can be inspected, after its callback has already processed the ack
+// Since the callback succeeded, the packet is not scheduled for an automatic retry
+func (k Keeper) DeadLetterFailedPacket(
+	ctx sdk.Context,
+	callbackData types.CallbackData,
+	packet channeltypes.Packet,
+	ackResponse *types.AcknowledgementResponse,
+) error {
+	ackError := ackResponse.Error
+	if ackResponse.Status == types.AckResponseStatus_TIMEOUT {
+		ackError = "packet timed out"
+	}
+
+	if err := k.setNewDeadLetter(ctx, callbackData, packet, ackResponse, 0, ackError, 0); err != nil {
+		return err
+	}
+
+	k.Logger(ctx).Info(fmt.Sprintf("icacallback %s packet %s received a %s ack, moved to dead letter store",
+		callbackData.CallbackId, callbackData.CallbackKey, ackResponse.Status.String()))
+
+	return nil
+}
+
+// Builds and stores a new dead letter, and emits the dead lettered event
+func (k Keeper) setNewDeadLetter(
+	ctx sdk.Context,
+	callbackData types.CallbackData,
+	packet channeltypes.Packet,
+	ackResponse *types.AcknowledgementResponse,
+	attempts uint64,
+	lastError string,
+	nextRetryHeight int64,
+) error {
+	deadLetter, err := types.NewDeadLetter(callbackData, packet, ackResponse)
+	if err != nil {
+		return errorsmod.Wrapf(err, "unable to build dead letter for callback %s", callbackData.CallbackKey)
+	}
+
+	deadLetter.Attempts = attempts
+	deadLetter.LastError = lastError
+	deadLetter.CreatedHeight = ctx.BlockHeight()
+	deadLetter.LastAttemptHeight = ctx.BlockHeight()
+	deadLetter.NextRetryHeight = nextRetryHeight
+	k.SetDeadLetter(ctx, deadLetter)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCallbackDeadLettered,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeKeyCallbackKey, callbackData.CallbackKey),
+			sdk.NewAttribute(types.AttributeKeyCallbackId, callbackData.CallbackId),
+			sdk.NewAttribute(types.AttributeKeyAckStatus, ackResponse.Status.String()),
+			sdk.NewAttribute(types.AttributeKeyError, lastError),
+		),
+	)
+
+	return nil
+}
+
+// Re-invokes the callback of a dead-lettered packet with the original packet and ack response
+// If the callback succeeds, the dead letter is removed; otherwise, the attempt is recorded
+// and the next retry is scheduled according to the callback's retry policy
+func (k Keeper) RetryDeadLetter(ctx sdk.Context, deadLetter types.DeadLetter) error {
+	callbackId := deadLetter.CallbackData.CallbackId
+	callback, found := k.icacallbacks[callbackId]
+	if !found {
+		return errorsmod.Wrapf(types.ErrCallbackIdNotFound, "no registered callback for %s", callbackId)
+	}
+
+	// Callbacks without a retry policy are not safe to replay, since the state they operate on
+	// may have changed since the ack was received
+	// If the retry policy was removed after the packet was dead-lettered, stop any automatic retries
+	if callback.RetryPolicy == nil {
+		if !deadLetter.RetriesExhausted() {
+			deadLetter.NextRetryHeight = 0
+			k.SetDeadLetter(ctx, deadLetter)
+		}
+		return errorsmod.Wrapf(types.ErrCallbackNotRetryable, "callback %s does not have a retry policy", callbackId)
+	}
+
+	packet, err := deadLetter.GetIBCPacket()
+	if err != nil {
+		return errorsmod.Wrapf(err, "unable to unmarshal packet for dead letter %s", deadLetter.CallbackKey)
+	}
+
+	callbackErr := k.invokeCallbackIfNoError(ctx, callback, packet, deadLetter.GetAcknowledgementResponse(), deadLetter.CallbackData.CallbackArgs)
+	if callbackErr == nil {
+		k.RemoveDeadLetter(ctx, deadLetter.CallbackKey)
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeCallbackRetried,
+				sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+				sdk.NewAttribute(types.AttributeKeyCallbackKey, deadLetter.CallbackKey),
+				sdk.NewAttribute(types.AttributeKeyCallbackId, callbackId),
+				sdk.NewAttribute(types.AttributeKeyAttempts, fmt.Sprintf("%d", deadLetter.Attempts+1)),
+			),
+		)
+		return nil
+	}
+
+	deadLetter.Attempts++
+	deadLetter.LastError = callbackErr.Error()
+	deadLetter.LastAttemptHeight = ctx.BlockHeight()
+	deadLetter.NextRetryHeight = callback.RetryPolicy.NextRetryHeight(ctx.BlockHeight(), deadLetter.Attempts)
+	k.SetDeadLetter(ctx, deadLetter)
+
+	if deadLetter.RetriesExhausted() {
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeCallbackRetriesExhausted,
+				sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+				sdk.NewAttribute(types.AttributeKeyCallbackKey, deadLetter.CallbackKey),
+				sdk.NewAttribute(types.AttributeKeyCallbackId, callbackId),
+				sdk.NewAttribute(types.AttributeKeyAttempts, fmt.Sprintf("%d", deadLetter.Attempts)),
+				sdk.NewAttribute(types.AttributeKeyError, callbackErr.Error()),
+			),
+		)
+	}
+
+	return errorsmod.Wrapf(types.ErrCallbackFailed, "retry of %s failed: %s", deadLetter.CallbackKey, callbackErr.Error())
+}
+
+// Retries each dead letter that is scheduled for retry at or before the current block
+// Exhausted dead letters are stored separately and are not iterated
+// Called each EndBlock
+func (k Keeper) RetryDeadLetters(ctx sdk.Context) {
+	for _, deadLetter := range k.GetRetryableDeadLetters(ctx) {
+		if deadLetter.NextRetryHeight > ctx.BlockHeight() {
+			continue
+		}
+
+		if err := k.RetryDeadLetter(ctx, deadLetter); err != nil {
+			k.Logger(ctx).Error(fmt.Sprintf("Unable to retry dead-lettered callback %s (attempt %d): %s",
+				deadLetter.CallbackKey, deadLetter.Attempts+1, err.Error()))
+		}
+	}
+}
+
+// Removes exhausted dead letters that have not been attempted for longer than the expiration
+// Called every OrphanSweepInterval blocks
+func (k Keeper) PruneExhaustedDeadLetters(ctx sdk.Context) {
+	for _, deadLetter := range k.GetExhaustedDeadLetters(ctx) {
+		if ctx.BlockHeight() < deadLetter.LastAttemptHeight+types.ExhaustedDeadLetterExpirationBlocks {
+			continue
+		}
+
+		k.Logger(ctx).Info(fmt.Sprintf("Removing expired dead letter %s", deadLetter.CallbackKey))
+		k.RemoveDeadLetter(ctx, deadLetter.CallbackKey)
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeDeadLetterExpired,
+				sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+				sdk.NewAttribute(types.AttributeKeyCallbackKey, deadLetter.CallbackKey),
+				sdk.NewAttribute(types.AttributeKeyCallbackId, deadLetter.CallbackData.CallbackId),
+			),
+		)
+	}
+}
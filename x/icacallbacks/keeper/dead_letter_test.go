@@ -0,0 +1,351 @@
+package keeper_test
+
+import (
+	"errors"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Stride-Labs/stride/v24/app/apptesting"
+	keepertest "github.com/Stride-Labs/stride/v24/testutil/keeper"
+	"github.com/Stride-Labs/stride/v24/x/icacallbacks/keeper"
+	"github.com/Stride-Labs/stride/v24/x/icacallbacks/types"
+)
+
+const (
+	RetryCallbackId   = "retry-callback"
+	NoRetryCallbackId = "no-retry-callback"
+)
+
+var TestRetryPolicy = types.RetryPolicy{MaxAttempts: 3, BackoffBlocks: 10}
+
+// Mock callback that fails until shouldFail is toggled off
+// Each invocation writes a marker to the store so we can confirm failed attempts are discarded
+type mockCallback struct {
+	shouldFail  bool
+	invocations int
+	keeper      *keeper.Keeper
+}
+
+func (m *mockCallback) callback(ctx sdk.Context, packet channeltypes.Packet, ack *types.AcknowledgementResponse, args []byte) error {
+	m.invocations++
+	m.keeper.SetCallbackData(ctx, types.CallbackData{CallbackKey: "marker"})
+	if m.shouldFail {
+		return errors.New("callback failed")
+	}
+	return nil
+}
+
+type deadLetterTestCase struct {
+	keeper       *keeper.Keeper
+	ctx          sdk.Context
+	mock         *mockCallback
+	packet       channeltypes.Packet
+	callbackData types.CallbackData
+}
+
+func setupDeadLetterTest(t *testing.T, callbackId string) deadLetterTestCase {
+	k, ctx := keepertest.IcacallbacksKeeper(t)
+
+	mock := &mockCallback{shouldFail: true, keeper: k}
+	err := k.SetICACallbacks(types.ModuleCallbacks{
+		{CallbackId: RetryCallbackId, CallbackFunc: mock.callback, RetryPolicy: &TestRetryPolicy},
+		{CallbackId: NoRetryCallbackId, CallbackFunc: mock.callback},
+	})
+	require.NoError(t, err)
+
+	packet := channeltypes.Packet{SourcePort: "port", SourceChannel: "channel-0", Sequence: 1, Data: []byte{1, 2}}
+	callbackData := types.CallbackData{
+		CallbackKey:  types.PacketID(packet.SourcePort, packet.SourceChannel, packet.Sequence),
+		PortId:       packet.SourcePort,
+		ChannelId:    packet.SourceChannel,
+		Sequence:     packet.Sequence,
+		CallbackId:   callbackId,
+		CallbackArgs: []byte{3},
+	}
+	k.SetCallbackData(ctx, callbackData)
+
+	return deadLetterTestCase{keeper: k, ctx: ctx, mock: mock, packet: packet, callbackData: callbackData}
+}
+
+// Invokes the callback with a failed ack, which should move the packet to the dead letter store
+func (tc deadLetterTestCase) deadLetterPacket(t *testing.T) types.DeadLetter {
+	ackResponse := &types.AcknowledgementResponse{Status: types.AckResponseStatus_FAILURE, Error: "ack error"}
+	err := tc.keeper.CallRegisteredICACallback(tc.ctx, tc.packet, ackResponse)
+	require.NoError(t, err, "no error expected when dead-lettering callback")
+
+	deadLetter, found := tc.keeper.GetDeadLetter(tc.ctx, tc.callbackData.CallbackKey)
+	require.True(t, found, "dead letter should have been created")
+	return deadLetter
+}
+
+func TestCallRegisteredICACallback_DeadLettered(t *testing.T) {
+	tc := setupDeadLetterTest(t, RetryCallbackId)
+	deadLetter := tc.deadLetterPacket(t)
+
+	// Confirm the dead letter has the packet, ack and callback data
+	packet, err := deadLetter.GetIBCPacket()
+	require.NoError(t, err)
+	require.Equal(t, tc.packet, packet, "packet")
+	require.Equal(t, tc.callbackData, deadLetter.CallbackData, "callback data")
+	require.Equal(t, types.AckResponseStatus_FAILURE, deadLetter.GetAcknowledgementResponse().Status, "ack status")
+	require.Equal(t, "ack error", deadLetter.GetAcknowledgementResponse().Error, "ack error")
+
+	require.Equal(t, uint64(1), deadLetter.Attempts, "attempts")
+	require.Equal(t, "callback failed", deadLetter.LastError, "last error")
+	require.Equal(t, tc.ctx.BlockHeight(), deadLetter.CreatedHeight, "created height")
+	require.Equal(t, tc.ctx.BlockHeight()+10, deadLetter.NextRetryHeight, "next retry height")
+
+	// Confirm the callback data was removed and the failed callback's state changes were discarded
+	_, found := tc.keeper.GetCallbackData(tc.ctx, tc.callbackData.CallbackKey)
+	require.False(t, found, "callback data should have been removed")
+	_, found = tc.keeper.GetCallbackData(tc.ctx, "marker")
+	require.False(t, found, "failed callback state should be discarded")
+}
+
+func TestCallRegisteredICACallback_NoRetryPolicy(t *testing.T) {
+	tc := setupDeadLetterTest(t, NoRetryCallbackId)
+
+	ackResponse := &types.AcknowledgementResponse{Status: types.AckResponseStatus_TIMEOUT}
+	err := tc.keeper.CallRegisteredICACallback(tc.ctx, tc.packet, ackResponse)
+	require.ErrorContains(t, err, "failed to invoke icacallback")
+
+	require.Empty(t, tc.keeper.GetAllDeadLetters(tc.ctx), "no dead letters")
+	_, found := tc.keeper.GetCallbackData(tc.ctx, tc.callbackData.CallbackKey)
+	require.True(t, found, "callback data should not be removed")
+}
+
+func TestCallRegisteredICACallback_FailedAckRecorded(t *testing.T) {
+	testCases := []struct {
+		name          string
+		callbackId    string
+		status        types.AckResponseStatus
+		expectedError string
+	}{
+		{name: "failure ack without retry policy", callbackId: NoRetryCallbackId, status: types.AckResponseStatus_FAILURE, expectedError: "ack error"},
+		{name: "timeout without retry policy", callbackId: NoRetryCallbackId, status: types.AckResponseStatus_TIMEOUT, expectedError: "packet timed out"},
+		{name: "failure ack with retry policy", callbackId: RetryCallbackId, status: types.AckResponseStatus_FAILURE, expectedError: "ack error"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			dc := setupDeadLetterTest(t, tc.callbackId)
+			dc.mock.shouldFail = false
+
+			// The callback succeeds, but since the packet failed on the host, it should be recorded
+			ackResponse := &types.AcknowledgementResponse{Status: tc.status, Error: "ack error"}
+			err := dc.keeper.CallRegisteredICACallback(dc.ctx, dc.packet, ackResponse)
+			require.NoError(t, err, "no error expected when invoking callback")
+
+			_, found := dc.keeper.GetCallbackData(dc.ctx, "marker")
+			require.True(t, found, "successful callback state should be persisted")
+			_, found = dc.keeper.GetCallbackData(dc.ctx, dc.callbackData.CallbackKey)
+			require.False(t, found, "callback data should have been removed")
+
+			deadLetter, found := dc.keeper.GetDeadLetter(dc.ctx, dc.callbackData.CallbackKey)
+			require.True(t, found, "dead letter should have been created")
+			require.Equal(t, tc.status, deadLetter.GetAcknowledgementResponse().Status, "ack status")
+			require.Equal(t, tc.expectedError, deadLetter.LastError, "last error")
+			require.Zero(t, deadLetter.Attempts, "attempts")
+			require.True(t, deadLetter.RetriesExhausted(), "should not be scheduled for retry")
+
+			// The callback should not be re-invoked automatically
+			dc.keeper.RetryDeadLetters(dc.ctx.WithBlockHeight(dc.ctx.BlockHeight() + 1000))
+			require.Equal(t, 1, dc.mock.invocations, "invocations")
+		})
+	}
+}
+
+func TestCallRegisteredICACallback_SuccessfulAckNotRecorded(t *testing.T) {
+	tc := setupDeadLetterTest(t, NoRetryCallbackId)
+	tc.mock.shouldFail = false
+
+	ackResponse := &types.AcknowledgementResponse{Status: types.AckResponseStatus_SUCCESS}
+	err := tc.keeper.CallRegisteredICACallback(tc.ctx, tc.packet, ackResponse)
+	require.NoError(t, err, "no error expected when invoking callback")
+	require.Empty(t, tc.keeper.GetAllDeadLetters(tc.ctx), "no dead letters")
+}
+
+func TestRetryDeadLetters(t *testing.T) {
+	tc := setupDeadLetterTest(t, RetryCallbackId)
+	deadLetter := tc.deadLetterPacket(t)
+	require.Equal(t, 1, tc.mock.invocations, "invocations after initial failure")
+
+	// Before the backoff has elapsed, the callback should not be retried
+	ctx := tc.ctx.WithBlockHeight(deadLetter.NextRetryHeight - 1)
+	tc.keeper.RetryDeadLetters(ctx)
+	require.Equal(t, 1, tc.mock.invocations, "invocations before backoff")
+
+	// Once the backoff has elapsed, the callback should be retried and the attempt recorded
+	ctx = tc.ctx.WithBlockHeight(deadLetter.NextRetryHeight)
+	tc.keeper.RetryDeadLetters(ctx)
+	require.Equal(t, 2, tc.mock.invocations, "invocations after first retry")
+
+	deadLetter, found := tc.keeper.GetDeadLetter(ctx, tc.callbackData.CallbackKey)
+	require.True(t, found, "dead letter should still exist")
+	require.Equal(t, uint64(2), deadLetter.Attempts, "attempts")
+	require.Equal(t, ctx.BlockHeight(), deadLetter.LastAttemptHeight, "last attempt height")
+	require.Equal(t, ctx.BlockHeight()+20, deadLetter.NextRetryHeight, "next retry height")
+
+	// Once the callback succeeds, the dead letter should be removed and the callback's state persisted
+	tc.mock.shouldFail = false
+	ctx = ctx.WithBlockHeight(deadLetter.NextRetryHeight)
+	tc.keeper.RetryDeadLetters(ctx)
+	require.Equal(t, 3, tc.mock.invocations, "invocations after second retry")
+
+	_, found = tc.keeper.GetDeadLetter(ctx, tc.callbackData.CallbackKey)
+	require.False(t, found, "dead letter should have been removed")
+	_, found = tc.keeper.GetCallbackData(ctx, "marker")
+	require.True(t, found, "successful callback state should be persisted")
+}
+
+func TestRetryDeadLetters_Exhausted(t *testing.T) {
+	tc := setupDeadLetterTest(t, RetryCallbackId)
+	deadLetter := tc.deadLetterPacket(t)
+
+	// Fail the remaining attempts
+	ctx := tc.ctx
+	for i := uint64(1); i < TestRetryPolicy.MaxAttempts; i++ {
+		ctx = ctx.WithBlockHeight(deadLetter.NextRetryHeight)
+		tc.keeper.RetryDeadLetters(ctx)
+		deadLetter, _ = tc.keeper.GetDeadLetter(ctx, tc.callbackData.CallbackKey)
+	}
+
+	require.Equal(t, TestRetryPolicy.MaxAttempts, deadLetter.Attempts, "attempts")
+	require.True(t, deadLetter.RetriesExhausted(), "retries should be exhausted")
+
+	// The dead letter should have been moved out of the retryable store
+	require.Empty(t, tc.keeper.GetRetryableDeadLetters(ctx), "no retryable dead letters")
+	require.Equal(t, []types.DeadLetter{deadLetter}, tc.keeper.GetExhaustedDeadLetters(ctx), "exhausted dead letters")
+
+	// The callback should no longer be retried automatically
+	invocations := tc.mock.invocations
+	tc.keeper.RetryDeadLetters(ctx.WithBlockHeight(ctx.BlockHeight() + 1000))
+	require.Equal(t, invocations, tc.mock.invocations, "no more invocations")
+
+	// An admin replay should still be able to find the exhausted dead letter, and once it
+	// succeeds, the dead letter should be removed
+	tc.mock.shouldFail = false
+	err := tc.keeper.RetryDeadLetter(ctx, deadLetter)
+	require.NoError(t, err, "no error expected when replaying exhausted dead letter")
+
+	_, found := tc.keeper.GetDeadLetter(ctx, tc.callbackData.CallbackKey)
+	require.False(t, found, "dead letter should have been removed")
+	require.Empty(t, tc.keeper.GetAllDeadLetters(ctx), "no dead letters")
+}
+
+func TestMsgRetryCallback(t *testing.T) {
+	tc := setupDeadLetterTest(t, RetryCallbackId)
+	tc.deadLetterPacket(t)
+	msgServer := keeper.NewMsgServerImpl(*tc.keeper)
+	goCtx := sdk.WrapSDKContext(tc.ctx)
+
+	adminAddress, ok := apptesting.GetAdminAddress()
+	require.True(t, ok)
+	nonAdminAddress := apptesting.CreateRandomAccounts(1)[0].String()
+
+	// Non-admin
+	_, err := msgServer.RetryCallback(goCtx, types.NewMsgRetryCallback(nonAdminAddress, tc.callbackData.CallbackKey))
+	require.ErrorContains(t, err, "is not an admin")
+
+	// Unknown callback key
+	_, err = msgServer.RetryCallback(goCtx, types.NewMsgRetryCallback(adminAddress, "fake-key"))
+	require.ErrorIs(t, err, types.ErrDeadLetterNotFound)
+
+	// Callback still failing
+	_, err = msgServer.RetryCallback(goCtx, types.NewMsgRetryCallback(adminAddress, tc.callbackData.CallbackKey))
+	require.ErrorIs(t, err, types.ErrCallbackFailed)
+
+	// Successful retry
+	tc.mock.shouldFail = false
+	_, err = msgServer.RetryCallback(goCtx, types.NewMsgRetryCallback(adminAddress, tc.callbackData.CallbackKey))
+	require.NoError(t, err, "no error expected when retrying callback")
+
+	_, found := tc.keeper.GetDeadLetter(tc.ctx, tc.callbackData.CallbackKey)
+	require.False(t, found, "dead letter should have been removed")
+}
+
+func TestMsgRetryCallback_NotRetryable(t *testing.T) {
+	tc := setupDeadLetterTest(t, NoRetryCallbackId)
+	tc.mock.shouldFail = false
+
+	ackResponse := &types.AcknowledgementResponse{Status: types.AckResponseStatus_FAILURE}
+	err := tc.keeper.CallRegisteredICACallback(tc.ctx, tc.packet, ackResponse)
+	require.NoError(t, err, "no error expected when invoking callback")
+
+	// Callbacks without a retry policy cannot be replayed, since the ack has already been processed
+	adminAddress, ok := apptesting.GetAdminAddress()
+	require.True(t, ok)
+	msgServer := keeper.NewMsgServerImpl(*tc.keeper)
+	_, err = msgServer.RetryCallback(sdk.WrapSDKContext(tc.ctx), types.NewMsgRetryCallback(adminAddress, tc.callbackData.CallbackKey))
+	require.ErrorIs(t, err, types.ErrCallbackNotRetryable)
+	require.Equal(t, 1, tc.mock.invocations, "invocations")
+
+	// If a dead letter is scheduled for a callback that no longer has a retry policy,
+	// it should be moved to the exhausted store
+	deadLetter, found := tc.keeper.GetDeadLetter(tc.ctx, tc.callbackData.CallbackKey)
+	require.True(t, found, "dead letter should still exist")
+	deadLetter.NextRetryHeight = tc.ctx.BlockHeight()
+	tc.keeper.SetDeadLetter(tc.ctx, deadLetter)
+
+	tc.keeper.RetryDeadLetters(tc.ctx)
+	require.Empty(t, tc.keeper.GetRetryableDeadLetters(tc.ctx), "no retryable dead letters")
+	require.Len(t, tc.keeper.GetExhaustedDeadLetters(tc.ctx), 1, "exhausted dead letters")
+}
+
+func TestPruneExhaustedDeadLetters(t *testing.T) {
+	k, ctx := keepertest.IcacallbacksKeeper(t)
+	ctx = ctx.WithBlockHeight(types.ExhaustedDeadLetterExpirationBlocks + 100)
+
+	// key-1 has expired, key-2 has not, and key-3 is still scheduled for retry
+	k.SetDeadLetter(ctx, types.DeadLetter{CallbackKey: "key-1", LastAttemptHeight: 100})
+	k.SetDeadLetter(ctx, types.DeadLetter{CallbackKey: "key-2", LastAttemptHeight: 101})
+	k.SetDeadLetter(ctx, types.DeadLetter{CallbackKey: "key-3", LastAttemptHeight: 1, NextRetryHeight: 1})
+
+	k.PruneExhaustedDeadLetters(ctx)
+
+	_, found := k.GetDeadLetter(ctx, "key-1")
+	require.False(t, found, "key-1 should have been removed")
+	_, found = k.GetDeadLetter(ctx, "key-2")
+	require.True(t, found, "key-2 should not have been removed")
+	_, found = k.GetDeadLetter(ctx, "key-3")
+	require.True(t, found, "key-3 should not have been removed")
+}
+
+func TestDeadLettersQuery(t *testing.T) {
+	k, ctx := keepertest.IcacallbacksKeeper(t)
+	for _, deadLetter := range []types.DeadLetter{
+		{CallbackKey: "key-1", CallbackData: types.CallbackData{CallbackId: "delegate"}},
+		{CallbackKey: "key-2", CallbackData: types.CallbackData{CallbackId: "undelegate"}},
+		{CallbackKey: "key-3", CallbackData: types.CallbackData{CallbackId: "delegate"}, NextRetryHeight: 10},
+	} {
+		k.SetDeadLetter(ctx, deadLetter)
+	}
+
+	getCallbackKeys := func(response *types.QueryDeadLettersResponse) []string {
+		keys := []string{}
+		for _, deadLetter := range response.DeadLetters {
+			keys = append(keys, deadLetter.CallbackKey)
+		}
+		return keys
+	}
+
+	// Exhausted dead letters are listed before retryable dead letters
+	response, err := k.DeadLetters(sdk.WrapSDKContext(ctx), &types.QueryDeadLettersRequest{})
+	require.NoError(t, err)
+	require.Equal(t, []string{"key-1", "key-2", "key-3"}, getCallbackKeys(response))
+
+	response, err = k.DeadLetters(sdk.WrapSDKContext(ctx), &types.QueryDeadLettersRequest{CallbackId: "delegate"})
+	require.NoError(t, err)
+	require.Equal(t, []string{"key-1", "key-3"}, getCallbackKeys(response))
+
+	single, err := k.DeadLetter(sdk.WrapSDKContext(ctx), &types.QueryDeadLetterRequest{CallbackKey: "key-2"})
+	require.NoError(t, err)
+	require.Equal(t, "undelegate", single.DeadLetter.CallbackData.CallbackId)
+
+	single, err = k.DeadLetter(sdk.WrapSDKContext(ctx), &types.QueryDeadLetterRequest{CallbackKey: "key-3"})
+	require.NoError(t, err)
+	require.Equal(t, int64(10), single.DeadLetter.NextRetryHeight)
+}
@@ -55,3 +55,34 @@ func (k Keeper) CallbackData(c context.Context, req *types.QueryGetCallbackDataR
 
 	return &types.QueryGetCallbackDataResponse{CallbackData: val}, nil
 }
+
+func (k Keeper) OrphanedCallbackData(c context.Context, req *types.QueryOrphanedCallbackDataRequest) (*types.QueryOrphanedCallbackDataResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	callbackDatas := []types.CallbackData{}
+	callbackDataStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.CallbackDataKeyPrefix))
+
+	pageRes, err := query.FilteredPaginate(callbackDataStore, req.Pagination, func(key []byte, value []byte, accumulate bool) (bool, error) {
+		var callbackData types.CallbackData
+		if err := k.cdc.Unmarshal(value, &callbackData); err != nil {
+			return false, err
+		}
+
+		if !callbackData.Orphaned {
+			return false, nil
+		}
+
+		if accumulate {
+			callbackDatas = append(callbackDatas, callbackData)
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryOrphanedCallbackDataResponse{CallbackData: callbackDatas, Pagination: pageRes}, nil
+}
@@ -0,0 +1,59 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/Stride-Labs/stride/v24/x/icacallbacks/types"
+)
+
+func (k Keeper) DeadLetter(c context.Context, req *types.QueryDeadLetterRequest) (*types.QueryDeadLetterResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	deadLetter, found := k.GetDeadLetter(ctx, req.CallbackKey)
+	if !found {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+
+	return &types.QueryDeadLetterResponse{DeadLetter: deadLetter}, nil
+}
+
+func (k Keeper) DeadLetters(c context.Context, req *types.QueryDeadLettersRequest) (*types.QueryDeadLettersResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	deadLetters := []types.DeadLetter{}
+	// Both retryable and exhausted dead letters are nested under the root prefix
+	deadLetterStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.DeadLetterRootKeyPrefix))
+
+	pageRes, err := query.FilteredPaginate(deadLetterStore, req.Pagination, func(key []byte, value []byte, accumulate bool) (bool, error) {
+		var deadLetter types.DeadLetter
+		if err := k.cdc.Unmarshal(value, &deadLetter); err != nil {
+			return false, err
+		}
+
+		if req.CallbackId != "" && deadLetter.CallbackData.CallbackId != req.CallbackId {
+			return false, nil
+		}
+
+		if accumulate {
+			deadLetters = append(deadLetters, deadLetter)
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryDeadLettersResponse{DeadLetters: deadLetters, Pagination: pageRes}, nil
+}
@@ -85,11 +85,31 @@ func (k Keeper) CallRegisteredICACallback(ctx sdk.Context, packet channeltypes.P
 		k.Logger(ctx).Info(fmt.Sprintf("No associated callback with callback data %v", callbackData))
 		return nil
 	}
-	if err := callback.CallbackFunc(ctx, packet, ackResponse, callbackData.CallbackArgs); err != nil {
-		return errorsmod.Wrapf(err, "failed to invoke icacallback %s", callbackData.CallbackId)
+
+	// If the callback has no retry policy, any callback error is returned to the caller so that
+	// the ack or timeout fails (e.g. a failed transfer callback must block the ICS-20 refund)
+	if callback.RetryPolicy == nil {
+		if err := callback.CallbackFunc(ctx, packet, ackResponse, callbackData.CallbackArgs); err != nil {
+			return errorsmod.Wrapf(err, "failed to invoke icacallback %s", callbackData.CallbackId)
+		}
+	} else {
+		// Callbacks with a retry policy are safe to replay, so they are executed in a cached context
+		// and, if they fail, the packet is moved to the dead letter store to be retried later
+		callbackErr := k.invokeCallbackIfNoError(ctx, callback, packet, ackResponse, callbackData.CallbackArgs)
+		if callbackErr != nil {
+			k.RemoveCallbackData(ctx, callbackDataKey)
+			return k.DeadLetterCallback(ctx, *callback.RetryPolicy, callbackData, packet, ackResponse, callbackErr)
+		}
 	}
 
 	// remove the callback data
 	k.RemoveCallbackData(ctx, callbackDataKey)
+
+	// Packets that failed or timed out on the host are recorded in the dead letter store so that
+	// they can be inspected, even though the callback processed the ack successfully
+	if ackResponse.Status != types.AckResponseStatus_SUCCESS {
+		return k.DeadLetterFailedPacket(ctx, callbackData, packet, ackResponse)
+	}
+
 	return nil
 }
@@ -1,6 +1,12 @@
 package keeper
 
 import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Stride-Labs/stride/v24/utils"
 	"github.com/Stride-Labs/stride/v24/x/icacallbacks/types"
 )
 
@@ -15,3 +21,24 @@ func NewMsgServerImpl(keeper Keeper) types.MsgServer {
 }
 
 var _ types.MsgServer = msgServer{}
+
+// Replays the callback of a dead-lettered packet
+// This can be used after the automatic retries have been exhausted, or to retry before the next scheduled attempt
+func (k msgServer) RetryCallback(goCtx context.Context, msg *types.MsgRetryCallback) (*types.MsgRetryCallbackResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := utils.ValidateAdminAddress(msg.Creator); err != nil {
+		return nil, err
+	}
+
+	deadLetter, found := k.GetDeadLetter(ctx, msg.CallbackKey)
+	if !found {
+		return nil, errorsmod.Wrapf(types.ErrDeadLetterNotFound, "callback key %s", msg.CallbackKey)
+	}
+
+	if err := k.RetryDeadLetter(ctx, deadLetter); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRetryCallbackResponse{}, nil
+}
@@ -0,0 +1,85 @@
+package keeper
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+
+	"github.com/Stride-Labs/stride/v24/x/icacallbacks/types"
+)
+
+// Returns the current state of a channel, or UNINITIALIZED if the channel does not exist
+func (k Keeper) GetChannelState(ctx sdk.Context, portId, channelId string) channeltypes.State {
+	channel, found := k.IBCKeeper.ChannelKeeper.GetChannel(ctx, portId, channelId)
+	if !found {
+		return channeltypes.UNINITIALIZED
+	}
+	return channel.State
+}
+
+// Checks whether the callback for a packet can no longer be invoked
+// This is the case when the packet's channel is closed (or does not exist) and the packet
+// commitment has been removed, meaning the packet can neither be acknowledged nor timed out
+func (k Keeper) IsCallbackDataOrphaned(ctx sdk.Context, callbackData types.CallbackData) bool {
+	channelState := k.GetChannelState(ctx, callbackData.PortId, callbackData.ChannelId)
+	if channelState != channeltypes.CLOSED && channelState != channeltypes.UNINITIALIZED {
+		return false
+	}
+
+	commitment := k.IBCKeeper.ChannelKeeper.GetPacketCommitment(ctx, callbackData.PortId, callbackData.ChannelId, callbackData.Sequence)
+	return len(commitment) == 0
+}
+
+// Checks each callback data entry and marks it as orphaned if its callback can no longer be invoked
+// Orphaned entries are removed once they've been orphaned for longer than the expiration
+// Called every OrphanSweepInterval blocks
+func (k Keeper) SweepOrphanedCallbackData(ctx sdk.Context) {
+	blockTime := ctx.BlockTime()
+
+	for _, callbackData := range k.GetAllCallbackData(ctx) {
+		// Remove orphaned entries that have expired
+		if callbackData.Orphaned {
+			orphanedTime := time.Unix(0, int64(callbackData.OrphanedTime))
+			if blockTime.Before(orphanedTime.Add(types.OrphanedCallbackDataExpiration)) {
+				continue
+			}
+
+			k.Logger(ctx).Info(fmt.Sprintf("Removing expired orphaned callback data %s", callbackData.CallbackKey))
+			k.RemoveCallbackData(ctx, callbackData.CallbackKey)
+			k.emitCallbackDataEvent(ctx, types.EventTypeCallbackDataExpired, callbackData)
+			continue
+		}
+
+		if !k.IsCallbackDataOrphaned(ctx, callbackData) {
+			continue
+		}
+
+		callbackData.Orphaned = true
+		callbackData.OrphanedTime = uint64(blockTime.UnixNano())
+		callbackData.ChannelState = k.GetChannelState(ctx, callbackData.PortId, callbackData.ChannelId).String()
+		k.SetCallbackData(ctx, callbackData)
+
+		k.Logger(ctx).Info(fmt.Sprintf("Callback data %s marked as orphaned (channel state: %s)",
+			callbackData.CallbackKey, callbackData.ChannelState))
+		k.emitCallbackDataEvent(ctx, types.EventTypeCallbackDataOrphaned, callbackData)
+	}
+}
+
+// Emits an event for an orphaned or expired callback data entry so that
+// the module that submitted the packet can reconcile its state
+func (k Keeper) emitCallbackDataEvent(ctx sdk.Context, eventType string, callbackData types.CallbackData) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			eventType,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeKeyCallbackKey, callbackData.CallbackKey),
+			sdk.NewAttribute(types.AttributeKeyCallbackId, callbackData.CallbackId),
+			sdk.NewAttribute(types.AttributeKeyPortId, callbackData.PortId),
+			sdk.NewAttribute(types.AttributeKeyChannelId, callbackData.ChannelId),
+			sdk.NewAttribute(types.AttributeKeySequence, fmt.Sprintf("%d", callbackData.Sequence)),
+			sdk.NewAttribute(types.AttributeKeyChannelState, callbackData.ChannelState),
+		),
+	)
+}
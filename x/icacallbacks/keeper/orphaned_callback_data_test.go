@@ -0,0 +1,106 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/Stride-Labs/stride/v24/testutil/keeper"
+	"github.com/Stride-Labs/stride/v24/x/icacallbacks/types"
+)
+
+const OrphanTestPortId = "icacontroller-chain.DELEGATION"
+
+func TestAddCallbackData(t *testing.T) {
+	k, ctx := keepertest.IcacallbacksKeeper(t)
+	k.IBCKeeper.ChannelKeeper.SetChannel(ctx, OrphanTestPortId, "channel-0", channeltypes.Channel{State: channeltypes.OPEN})
+
+	k.AddCallbackData(ctx, types.CallbackData{CallbackKey: "key", PortId: OrphanTestPortId, ChannelId: "channel-0"})
+
+	callbackData, found := k.GetCallbackData(ctx, "key")
+	require.True(t, found, "callback data should have been found")
+	require.Equal(t, ctx.BlockHeight(), callbackData.CreatedHeight, "created height")
+	require.Equal(t, uint64(ctx.BlockTime().UnixNano()), callbackData.CreatedTime, "created time")
+	require.Equal(t, channeltypes.OPEN.String(), callbackData.ChannelState, "channel state")
+	require.False(t, callbackData.Orphaned, "orphaned")
+}
+
+func TestSweepOrphanedCallbackData(t *testing.T) {
+	k, ctx := keepertest.IcacallbacksKeeper(t)
+	channelKeeper := k.IBCKeeper.ChannelKeeper
+
+	// channel-0 is open, channel-1 is closed, and channel-2 does not exist
+	channelKeeper.SetChannel(ctx, OrphanTestPortId, "channel-0", channeltypes.Channel{State: channeltypes.OPEN})
+	channelKeeper.SetChannel(ctx, OrphanTestPortId, "channel-1", channeltypes.Channel{State: channeltypes.CLOSED})
+
+	testCases := []struct {
+		callbackKey      string
+		channelId        string
+		hasCommitment    bool
+		expectedOrphaned bool
+	}{
+		{callbackKey: "open-no-commitment", channelId: "channel-0", hasCommitment: false, expectedOrphaned: false},
+		{callbackKey: "closed-with-commitment", channelId: "channel-1", hasCommitment: true, expectedOrphaned: false},
+		{callbackKey: "closed-no-commitment", channelId: "channel-1", hasCommitment: false, expectedOrphaned: true},
+		{callbackKey: "missing-no-commitment", channelId: "channel-2", hasCommitment: false, expectedOrphaned: true},
+	}
+	for i, tc := range testCases {
+		sequence := uint64(i)
+		if tc.hasCommitment {
+			channelKeeper.SetPacketCommitment(ctx, OrphanTestPortId, tc.channelId, sequence, []byte{1})
+		}
+		k.AddCallbackData(ctx, types.CallbackData{
+			CallbackKey: tc.callbackKey,
+			PortId:      OrphanTestPortId,
+			ChannelId:   tc.channelId,
+			Sequence:    sequence,
+			CallbackId:  "delegate",
+		})
+	}
+
+	// Sweep and confirm only the expected entries were marked as orphaned
+	k.SweepOrphanedCallbackData(ctx)
+
+	for _, tc := range testCases {
+		callbackData, found := k.GetCallbackData(ctx, tc.callbackKey)
+		require.True(t, found, "callback data %s should still exist", tc.callbackKey)
+		require.Equal(t, tc.expectedOrphaned, callbackData.Orphaned, "orphaned for %s", tc.callbackKey)
+
+		if tc.expectedOrphaned {
+			require.Equal(t, uint64(ctx.BlockTime().UnixNano()), callbackData.OrphanedTime, "orphaned time for %s", tc.callbackKey)
+		}
+	}
+	missingChannelCallback, _ := k.GetCallbackData(ctx, "missing-no-commitment")
+	require.Equal(t, channeltypes.UNINITIALIZED.String(), missingChannelCallback.ChannelState, "channel state")
+
+	orphanedEvents := 0
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == types.EventTypeCallbackDataOrphaned {
+			orphanedEvents++
+		}
+	}
+	require.Equal(t, 2, orphanedEvents, "number of orphaned events")
+
+	// Query the orphaned callback data
+	response, err := k.OrphanedCallbackData(sdk.WrapSDKContext(ctx), &types.QueryOrphanedCallbackDataRequest{})
+	require.NoError(t, err)
+	require.Len(t, response.CallbackData, 2, "number of orphaned callback data")
+
+	// Sweep again just before the expiration - nothing should be removed
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(types.OrphanedCallbackDataExpiration).Add(-time.Second))
+	k.SweepOrphanedCallbackData(ctx)
+	require.Len(t, k.GetAllCallbackData(ctx), 4, "number of callback data before expiration")
+
+	// Sweep after the expiration - the orphaned entries should be removed
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(time.Second))
+	k.SweepOrphanedCallbackData(ctx)
+
+	remainingKeys := []string{}
+	for _, callbackData := range k.GetAllCallbackData(ctx) {
+		remainingKeys = append(remainingKeys, callbackData.CallbackKey)
+	}
+	require.ElementsMatch(t, []string{"open-no-commitment", "closed-with-commitment"}, remainingKeys, "remaining callback data")
+}
@@ -8,6 +8,19 @@ const (
 	AckResponseStatus_FAILURE
 )
 
+func (s AckResponseStatus) String() string {
+	switch s {
+	case AckResponseStatus_SUCCESS:
+		return "SUCCESS"
+	case AckResponseStatus_TIMEOUT:
+		return "TIMEOUT"
+	case AckResponseStatus_FAILURE:
+		return "FAILURE"
+	default:
+		return "UNKNOWN"
+	}
+}
+
 type AcknowledgementResponse struct {
 	Status       AckResponseStatus
 	MsgResponses [][]byte
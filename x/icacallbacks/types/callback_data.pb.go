@@ -29,6 +29,17 @@ type CallbackData struct {
 	Sequence     uint64 `protobuf:"varint,4,opt,name=sequence,proto3" json:"sequence,omitempty"`
 	CallbackId   string `protobuf:"bytes,5,opt,name=callback_id,json=callbackId,proto3" json:"callback_id,omitempty"`
 	CallbackArgs []byte `protobuf:"bytes,6,opt,name=callback_args,json=callbackArgs,proto3" json:"callback_args,omitempty"`
+	// Block height and time (unix nano) when the callback data was created
+	CreatedHeight int64  `protobuf:"varint,7,opt,name=created_height,json=createdHeight,proto3" json:"created_height,omitempty"`
+	CreatedTime   uint64 `protobuf:"varint,8,opt,name=created_time,json=createdTime,proto3" json:"created_time,omitempty"`
+	// State of the packet's channel, recorded at creation and refreshed
+	// when the callback data is swept
+	ChannelState string `protobuf:"bytes,9,opt,name=channel_state,json=channelState,proto3" json:"channel_state,omitempty"`
+	// Indicates the packet's channel was closed and the packet commitment no
+	// longer exists, meaning the callback will never be invoked
+	Orphaned bool `protobuf:"varint,10,opt,name=orphaned,proto3" json:"orphaned,omitempty"`
+	// Time (unix nano) when the callback data was marked as orphaned
+	OrphanedTime uint64 `protobuf:"varint,11,opt,name=orphaned_time,json=orphanedTime,proto3" json:"orphaned_time,omitempty"`
 }
 
 func (m *CallbackData) Reset()         { *m = CallbackData{} }
@@ -106,6 +117,41 @@ func (m *CallbackData) GetCallbackArgs() []byte {
 	return nil
 }
 
+func (m *CallbackData) GetCreatedHeight() int64 {
+	if m != nil {
+		return m.CreatedHeight
+	}
+	return 0
+}
+
+func (m *CallbackData) GetCreatedTime() uint64 {
+	if m != nil {
+		return m.CreatedTime
+	}
+	return 0
+}
+
+func (m *CallbackData) GetChannelState() string {
+	if m != nil {
+		return m.ChannelState
+	}
+	return ""
+}
+
+func (m *CallbackData) GetOrphaned() bool {
+	if m != nil {
+		return m.Orphaned
+	}
+	return false
+}
+
+func (m *CallbackData) GetOrphanedTime() uint64 {
+	if m != nil {
+		return m.OrphanedTime
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*CallbackData)(nil), "stride.icacallbacks.CallbackData")
 }
@@ -115,24 +161,29 @@ func init() {
 }
 
 var fileDescriptor_19b6f19ce856679b = []byte{
-	// 269 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x2f, 0x2e, 0x29, 0xca,
-	0x4c, 0x49, 0xd5, 0xcf, 0x4c, 0x4e, 0x4c, 0x4e, 0xcc, 0xc9, 0x49, 0x4a, 0x4c, 0xce, 0x2e, 0xd6,
-	0x87, 0xb1, 0xe2, 0x53, 0x12, 0x4b, 0x12, 0xf5, 0x0a, 0x8a, 0xf2, 0x4b, 0xf2, 0x85, 0x84, 0x21,
-	0x0a, 0xf5, 0x90, 0x15, 0x2a, 0x9d, 0x66, 0xe4, 0xe2, 0x71, 0x86, 0xf2, 0x5c, 0x12, 0x4b, 0x12,
-	0x85, 0x14, 0xb9, 0x78, 0xe0, 0x9a, 0xb3, 0x53, 0x2b, 0x25, 0x18, 0x15, 0x18, 0x35, 0x38, 0x83,
-	0xb8, 0x61, 0x62, 0xde, 0xa9, 0x95, 0x42, 0xe2, 0x5c, 0xec, 0x05, 0xf9, 0x45, 0x25, 0xf1, 0x99,
-	0x29, 0x12, 0x4c, 0x60, 0x59, 0x36, 0x10, 0xd7, 0x33, 0x45, 0x48, 0x96, 0x8b, 0x2b, 0x39, 0x23,
-	0x31, 0x2f, 0x2f, 0x35, 0x07, 0x24, 0xc7, 0x0c, 0x96, 0xe3, 0x84, 0x8a, 0x78, 0xa6, 0x08, 0x49,
-	0x71, 0x71, 0x14, 0xa7, 0x16, 0x96, 0xa6, 0xe6, 0x25, 0xa7, 0x4a, 0xb0, 0x28, 0x30, 0x6a, 0xb0,
-	0x04, 0xc1, 0xf9, 0x42, 0xf2, 0x5c, 0x70, 0x2b, 0x40, 0x7a, 0x59, 0xc1, 0x7a, 0xb9, 0x60, 0x42,
-	0x9e, 0x29, 0x42, 0xca, 0x5c, 0xbc, 0x70, 0x05, 0x89, 0x45, 0xe9, 0xc5, 0x12, 0x6c, 0x0a, 0x8c,
-	0x1a, 0x3c, 0x41, 0x70, 0xc7, 0x3a, 0x16, 0xa5, 0x17, 0x3b, 0x05, 0x9c, 0x78, 0x24, 0xc7, 0x78,
-	0xe1, 0x91, 0x1c, 0xe3, 0x83, 0x47, 0x72, 0x8c, 0x13, 0x1e, 0xcb, 0x31, 0x5c, 0x78, 0x2c, 0xc7,
-	0x70, 0xe3, 0xb1, 0x1c, 0x43, 0x94, 0x59, 0x7a, 0x66, 0x49, 0x46, 0x69, 0x92, 0x5e, 0x72, 0x7e,
-	0xae, 0x7e, 0x30, 0x38, 0x1c, 0x74, 0x7d, 0x12, 0x93, 0x8a, 0xf5, 0xa1, 0x81, 0x57, 0x66, 0x64,
-	0xa2, 0x5f, 0x81, 0x1a, 0x84, 0x25, 0x95, 0x05, 0xa9, 0xc5, 0x49, 0x6c, 0xe0, 0xb0, 0x33, 0x06,
-	0x04, 0x00, 0x00, 0xff, 0xff, 0xac, 0x55, 0xc6, 0x80, 0x66, 0x01, 0x00, 0x00,
+	// 352 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x54, 0x91, 0xbf, 0x4e, 0xc3, 0x30,
+	0x10, 0xc6, 0xeb, 0xb6, 0xf4, 0x8f, 0x9b, 0x32, 0x98, 0x01, 0x0b, 0x89, 0x10, 0x40, 0x88, 0x2c,
+	0x34, 0x12, 0x20, 0x76, 0xfe, 0x0c, 0x54, 0x30, 0xa0, 0x94, 0x89, 0xa5, 0x72, 0xe2, 0x53, 0x12,
+	0xb5, 0x4d, 0x42, 0xec, 0x22, 0xfa, 0x16, 0x3c, 0x16, 0x63, 0x47, 0x46, 0xd4, 0x3e, 0x06, 0x0b,
+	0xb2, 0xeb, 0x44, 0xb0, 0xf9, 0x7e, 0xf7, 0x9d, 0xbf, 0x3b, 0x7d, 0xf8, 0x54, 0xc8, 0x22, 0xe1,
+	0xe0, 0x25, 0x21, 0x0b, 0xd9, 0x74, 0x1a, 0xb0, 0x70, 0x22, 0xbc, 0xf2, 0x35, 0xe6, 0x4c, 0xb2,
+	0x41, 0x5e, 0x64, 0x32, 0x23, 0x3b, 0x1b, 0xe1, 0xe0, 0xaf, 0xf0, 0xe8, 0xa7, 0x8e, 0xad, 0x5b,
+	0x53, 0xdd, 0x31, 0xc9, 0xc8, 0x21, 0xb6, 0xaa, 0xe1, 0x09, 0x2c, 0x28, 0x72, 0x90, 0xdb, 0xf5,
+	0x7b, 0x25, 0x7b, 0x80, 0x05, 0xd9, 0xc5, 0xed, 0x3c, 0x2b, 0xe4, 0x38, 0xe1, 0xb4, 0xae, 0xbb,
+	0x2d, 0x55, 0x0e, 0x39, 0xd9, 0xc7, 0x38, 0x8c, 0x59, 0x9a, 0xc2, 0x54, 0xf5, 0x1a, 0xba, 0xd7,
+	0x35, 0x64, 0xc8, 0xc9, 0x1e, 0xee, 0x08, 0x78, 0x9d, 0x43, 0x1a, 0x02, 0x6d, 0x3a, 0xc8, 0x6d,
+	0xfa, 0x55, 0x4d, 0x0e, 0x70, 0x65, 0xa1, 0x66, 0xb7, 0xf4, 0x2c, 0x2e, 0xd1, 0x90, 0x93, 0x63,
+	0xdc, 0xaf, 0x04, 0xac, 0x88, 0x04, 0x6d, 0x39, 0xc8, 0xb5, 0xfc, 0x6a, 0xd9, 0xeb, 0x22, 0x12,
+	0xe4, 0x04, 0x6f, 0x87, 0x05, 0x30, 0x09, 0x7c, 0x1c, 0x43, 0x12, 0xc5, 0x92, 0xb6, 0x1d, 0xe4,
+	0x36, 0xfc, 0xbe, 0xa1, 0xf7, 0x1a, 0xea, 0x1b, 0x8d, 0x4c, 0x26, 0x33, 0xa0, 0x1d, 0xbd, 0x4c,
+	0xcf, 0xb0, 0xe7, 0x64, 0x06, 0xda, 0xce, 0x9c, 0x22, 0x24, 0x93, 0x40, 0xbb, 0x7a, 0x23, 0xcb,
+	0xc0, 0x91, 0x62, 0xea, 0xa0, 0xac, 0xc8, 0x63, 0x96, 0x02, 0xa7, 0xd8, 0x41, 0x6e, 0xc7, 0xaf,
+	0x6a, 0xf5, 0x41, 0xf9, 0xde, 0x98, 0xf4, 0xb4, 0x89, 0x55, 0x42, 0xe5, 0x72, 0xf3, 0xf4, 0xb9,
+	0xb2, 0xd1, 0x72, 0x65, 0xa3, 0xef, 0x95, 0x8d, 0x3e, 0xd6, 0x76, 0x6d, 0xb9, 0xb6, 0x6b, 0x5f,
+	0x6b, 0xbb, 0xf6, 0x72, 0x15, 0x25, 0x32, 0x9e, 0x07, 0x83, 0x30, 0x9b, 0x79, 0x23, 0x9d, 0xdb,
+	0xd9, 0x23, 0x0b, 0x84, 0x67, 0xc2, 0x7e, 0x3b, 0xbf, 0xf4, 0xde, 0xff, 0x47, 0x2e, 0x17, 0x39,
+	0x88, 0xa0, 0xa5, 0xb3, 0xbe, 0xf8, 0x1d, 0x00, 0x9d, 0xd3, 0x71, 0x7c, 0x16, 0x02, 0x00, 0x00,
 }
 
 func (m *CallbackData) Marshal() (dAtA []byte, err error) {
@@ -155,6 +206,38 @@ func (m *CallbackData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.OrphanedTime != 0 {
+		i = encodeVarintCallbackData(dAtA, i, uint64(m.OrphanedTime))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.Orphaned {
+		i--
+		if m.Orphaned {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x50
+	}
+	if len(m.ChannelState) > 0 {
+		i -= len(m.ChannelState)
+		copy(dAtA[i:], m.ChannelState)
+		i = encodeVarintCallbackData(dAtA, i, uint64(len(m.ChannelState)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.CreatedTime != 0 {
+		i = encodeVarintCallbackData(dAtA, i, uint64(m.CreatedTime))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.CreatedHeight != 0 {
+		i = encodeVarintCallbackData(dAtA, i, uint64(m.CreatedHeight))
+		i--
+		dAtA[i] = 0x38
+	}
 	if len(m.CallbackArgs) > 0 {
 		i -= len(m.CallbackArgs)
 		copy(dAtA[i:], m.CallbackArgs)
@@ -238,6 +321,22 @@ func (m *CallbackData) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovCallbackData(uint64(l))
 	}
+	if m.CreatedHeight != 0 {
+		n += 1 + sovCallbackData(uint64(m.CreatedHeight))
+	}
+	if m.CreatedTime != 0 {
+		n += 1 + sovCallbackData(uint64(m.CreatedTime))
+	}
+	l = len(m.ChannelState)
+	if l > 0 {
+		n += 1 + l + sovCallbackData(uint64(l))
+	}
+	if m.Orphaned {
+		n += 2
+	}
+	if m.OrphanedTime != 0 {
+		n += 1 + sovCallbackData(uint64(m.OrphanedTime))
+	}
 	return n
 }
 
@@ -457,6 +556,115 @@ func (m *CallbackData) Unmarshal(dAtA []byte) error {
 				m.CallbackArgs = []byte{}
 			}
 			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedHeight", wireType)
+			}
+			m.CreatedHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCallbackData
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreatedHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedTime", wireType)
+			}
+			m.CreatedTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCallbackData
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreatedTime |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelState", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCallbackData
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCallbackData
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCallbackData
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelState = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Orphaned", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCallbackData
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Orphaned = bool(v != 0)
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrphanedTime", wireType)
+			}
+			m.OrphanedTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCallbackData
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OrphanedTime |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCallbackData(dAtA[iNdEx:])
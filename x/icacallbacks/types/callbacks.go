@@ -8,9 +8,31 @@ import (
 
 type ICACallbackFunction func(sdk.Context, channeltypes.Packet, *AcknowledgementResponse, []byte) error
 
+// Determines how a failed callback is retried
+// The callback is retried every BackoffBlocks * attempts blocks, until it has failed MaxAttempts times
+type RetryPolicy struct {
+	MaxAttempts   uint64
+	BackoffBlocks int64
+}
+
 type ICACallback struct {
 	CallbackId   string
 	CallbackFunc ICACallbackFunction
+	// A retry policy should only be provided if the callback is safe to replay against an old ack
+	// (e.g. it does not move funds or update records that the ack or refund depends on)
+	// If provided, a failed callback will not error the ack or timeout; instead, the packet
+	// will be moved to the dead letter store and retried
+	RetryPolicy *RetryPolicy
 }
 
 type ModuleCallbacks []ICACallback
+
+// Returns the block height at which a callback should next be retried, given the number
+// of failed attempts so far
+// Returns 0 if there are no more automatic retries remaining
+func (p RetryPolicy) NextRetryHeight(currentHeight int64, attempts uint64) int64 {
+	if attempts >= p.MaxAttempts {
+		return 0
+	}
+	return currentHeight + p.BackoffBlocks*int64(attempts)
+}
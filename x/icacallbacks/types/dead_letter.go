@@ -0,0 +1,44 @@
+package types
+
+import (
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+)
+
+// Builds a dead letter from a packet whose callback failed
+func NewDeadLetter(callbackData CallbackData, packet channeltypes.Packet, ackResponse *AcknowledgementResponse) (DeadLetter, error) {
+	packetBz, err := packet.Marshal()
+	if err != nil {
+		return DeadLetter{}, err
+	}
+
+	return DeadLetter{
+		CallbackKey:  callbackData.CallbackKey,
+		CallbackData: callbackData,
+		Packet:       packetBz,
+		AckResponse: DeadLetterAckResponse{
+			Status:       int32(ackResponse.Status),
+			MsgResponses: ackResponse.MsgResponses,
+			Error:        ackResponse.Error,
+		},
+	}, nil
+}
+
+// Deserializes the IBC packet that was stored with the dead letter
+func (d DeadLetter) GetIBCPacket() (packet channeltypes.Packet, err error) {
+	err = packet.Unmarshal(d.Packet)
+	return packet, err
+}
+
+// Rebuilds the acknowledgement response that was originally passed to the callback
+func (d DeadLetter) GetAcknowledgementResponse() *AcknowledgementResponse {
+	return &AcknowledgementResponse{
+		Status:       AckResponseStatus(d.AckResponse.Status),
+		MsgResponses: d.AckResponse.MsgResponses,
+		Error:        d.AckResponse.Error,
+	}
+}
+
+// Checks whether there are automatic retries remaining for the dead letter
+func (d DeadLetter) RetriesExhausted() bool {
+	return d.NextRetryHeight == 0
+}
@@ -0,0 +1,954 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: stride/icacallbacks/dead_letter.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// The acknowledgement response that was passed to the failed callback
+type DeadLetterAckResponse struct {
+	// Corresponds to the AckResponseStatus (0: success, 1: timeout, 2: failure)
+	Status       int32    `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
+	MsgResponses [][]byte `protobuf:"bytes,2,rep,name=msg_responses,json=msgResponses,proto3" json:"msg_responses,omitempty"`
+	Error        string   `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (m *DeadLetterAckResponse) Reset()         { *m = DeadLetterAckResponse{} }
+func (m *DeadLetterAckResponse) String() string { return proto.CompactTextString(m) }
+func (*DeadLetterAckResponse) ProtoMessage()    {}
+func (*DeadLetterAckResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_917f42425cd3974a, []int{0}
+}
+func (m *DeadLetterAckResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DeadLetterAckResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DeadLetterAckResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DeadLetterAckResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeadLetterAckResponse.Merge(m, src)
+}
+func (m *DeadLetterAckResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *DeadLetterAckResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeadLetterAckResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeadLetterAckResponse proto.InternalMessageInfo
+
+func (m *DeadLetterAckResponse) GetStatus() int32 {
+	if m != nil {
+		return m.Status
+	}
+	return 0
+}
+
+func (m *DeadLetterAckResponse) GetMsgResponses() [][]byte {
+	if m != nil {
+		return m.MsgResponses
+	}
+	return nil
+}
+
+func (m *DeadLetterAckResponse) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+// A packet whose callback failed, persisted so that the callback can be
+// retried
+type DeadLetter struct {
+	// Callback key from the original callback data (port.channel.sequence)
+	CallbackKey  string       `protobuf:"bytes,1,opt,name=callback_key,json=callbackKey,proto3" json:"callback_key,omitempty"`
+	CallbackData CallbackData `protobuf:"bytes,2,opt,name=callback_data,json=callbackData,proto3" json:"callback_data"`
+	// Proto-serialized IBC packet
+	Packet      []byte                `protobuf:"bytes,3,opt,name=packet,proto3" json:"packet,omitempty"`
+	AckResponse DeadLetterAckResponse `protobuf:"bytes,4,opt,name=ack_response,json=ackResponse,proto3" json:"ack_response"`
+	// Number of times the callback has been invoked and failed
+	Attempts          uint64 `protobuf:"varint,5,opt,name=attempts,proto3" json:"attempts,omitempty"`
+	LastError         string `protobuf:"bytes,6,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+	CreatedHeight     int64  `protobuf:"varint,7,opt,name=created_height,json=createdHeight,proto3" json:"created_height,omitempty"`
+	LastAttemptHeight int64  `protobuf:"varint,8,opt,name=last_attempt_height,json=lastAttemptHeight,proto3" json:"last_attempt_height,omitempty"`
+	// Block height at which the callback will be automatically retried
+	// (0 if the retries have been exhausted)
+	NextRetryHeight int64 `protobuf:"varint,9,opt,name=next_retry_height,json=nextRetryHeight,proto3" json:"next_retry_height,omitempty"`
+}
+
+func (m *DeadLetter) Reset()         { *m = DeadLetter{} }
+func (m *DeadLetter) String() string { return proto.CompactTextString(m) }
+func (*DeadLetter) ProtoMessage()    {}
+func (*DeadLetter) Descriptor() ([]byte, []int) {
+	return fileDescriptor_917f42425cd3974a, []int{1}
+}
+func (m *DeadLetter) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DeadLetter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DeadLetter.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DeadLetter) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeadLetter.Merge(m, src)
+}
+func (m *DeadLetter) XXX_Size() int {
+	return m.Size()
+}
+func (m *DeadLetter) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeadLetter.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeadLetter proto.InternalMessageInfo
+
+func (m *DeadLetter) GetCallbackKey() string {
+	if m != nil {
+		return m.CallbackKey
+	}
+	return ""
+}
+
+func (m *DeadLetter) GetCallbackData() CallbackData {
+	if m != nil {
+		return m.CallbackData
+	}
+	return CallbackData{}
+}
+
+func (m *DeadLetter) GetPacket() []byte {
+	if m != nil {
+		return m.Packet
+	}
+	return nil
+}
+
+func (m *DeadLetter) GetAckResponse() DeadLetterAckResponse {
+	if m != nil {
+		return m.AckResponse
+	}
+	return DeadLetterAckResponse{}
+}
+
+func (m *DeadLetter) GetAttempts() uint64 {
+	if m != nil {
+		return m.Attempts
+	}
+	return 0
+}
+
+func (m *DeadLetter) GetLastError() string {
+	if m != nil {
+		return m.LastError
+	}
+	return ""
+}
+
+func (m *DeadLetter) GetCreatedHeight() int64 {
+	if m != nil {
+		return m.CreatedHeight
+	}
+	return 0
+}
+
+func (m *DeadLetter) GetLastAttemptHeight() int64 {
+	if m != nil {
+		return m.LastAttemptHeight
+	}
+	return 0
+}
+
+func (m *DeadLetter) GetNextRetryHeight() int64 {
+	if m != nil {
+		return m.NextRetryHeight
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*DeadLetterAckResponse)(nil), "stride.icacallbacks.DeadLetterAckResponse")
+	proto.RegisterType((*DeadLetter)(nil), "stride.icacallbacks.DeadLetter")
+}
+
+func init() {
+	proto.RegisterFile("stride/icacallbacks/dead_letter.proto", fileDescriptor_917f42425cd3974a)
+}
+
+var fileDescriptor_917f42425cd3974a = []byte{
+	// 443 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x92, 0xcf, 0x6e, 0xd3, 0x40,
+	0x10, 0xc6, 0xb3, 0xcd, 0x1f, 0x9a, 0x8d, 0x03, 0xea, 0xb6, 0x20, 0x2b, 0x12, 0xc6, 0x2d, 0xaa,
+	0xb0, 0x2a, 0x61, 0x4b, 0x05, 0x71, 0x6f, 0x29, 0x12, 0x12, 0x39, 0xa0, 0xed, 0x8d, 0x8b, 0x35,
+	0xb1, 0x47, 0x4e, 0x48, 0x52, 0x5b, 0xbb, 0x53, 0xd4, 0xbc, 0x05, 0x8f, 0xc1, 0xa3, 0xf4, 0xd8,
+	0x23, 0x27, 0x84, 0x92, 0x17, 0x41, 0x5e, 0xaf, 0x4b, 0x22, 0xf9, 0xb6, 0xf3, 0xcd, 0x6f, 0xe7,
+	0x9b, 0x19, 0x0d, 0x3f, 0xd5, 0xa4, 0x66, 0x29, 0x46, 0xb3, 0x04, 0x12, 0x58, 0x2c, 0x26, 0x90,
+	0xcc, 0x75, 0x94, 0x22, 0xa4, 0xf1, 0x02, 0x89, 0x50, 0x85, 0x85, 0xca, 0x29, 0x17, 0x87, 0x15,
+	0x16, 0x6e, 0x63, 0xa3, 0xa3, 0x2c, 0xcf, 0x72, 0x93, 0x8f, 0xca, 0x57, 0x85, 0x8e, 0xde, 0x34,
+	0x55, 0xac, 0x5f, 0x71, 0x0a, 0x04, 0x15, 0x78, 0xf2, 0x9d, 0x3f, 0xbf, 0x42, 0x48, 0xc7, 0xc6,
+	0xe7, 0x22, 0x99, 0x4b, 0xd4, 0x45, 0x7e, 0xa3, 0x51, 0xbc, 0xe0, 0x3d, 0x4d, 0x40, 0xb7, 0xda,
+	0x65, 0x3e, 0x0b, 0xba, 0xd2, 0x46, 0xe2, 0x35, 0x1f, 0x2e, 0x75, 0x16, 0x2b, 0xcb, 0x69, 0x77,
+	0xcf, 0x6f, 0x07, 0x8e, 0x74, 0x96, 0x3a, 0xab, 0xff, 0x6a, 0x71, 0xc4, 0xbb, 0xa8, 0x54, 0xae,
+	0xdc, 0xb6, 0xcf, 0x82, 0xbe, 0xac, 0x82, 0x93, 0x5f, 0x6d, 0xce, 0xff, 0x9b, 0x89, 0x63, 0xee,
+	0x3c, 0x76, 0x34, 0xc7, 0x95, 0xf1, 0xe9, 0xcb, 0x41, 0xad, 0x7d, 0xc1, 0x95, 0x18, 0xf3, 0xe1,
+	0x4e, 0xd3, 0xee, 0x9e, 0xcf, 0x82, 0xc1, 0xf9, 0x71, 0xd8, 0xb0, 0x89, 0xf0, 0xa3, 0x7d, 0x5d,
+	0x01, 0xc1, 0x65, 0xe7, 0xfe, 0xcf, 0xab, 0x96, 0x74, 0x92, 0x2d, 0xad, 0x1c, 0xa9, 0x80, 0x64,
+	0x8e, 0x64, 0xda, 0x72, 0xa4, 0x8d, 0xc4, 0x35, 0x77, 0x4a, 0x83, 0x7a, 0x24, 0xb7, 0x63, 0x4c,
+	0xce, 0x1a, 0x4d, 0x1a, 0x97, 0x65, 0xdd, 0x06, 0xb0, 0xb5, 0xbf, 0x11, 0xdf, 0x07, 0x22, 0x5c,
+	0x16, 0xa4, 0xdd, 0xae, 0xcf, 0x82, 0x8e, 0x7c, 0x8c, 0xc5, 0x4b, 0xce, 0x17, 0xa0, 0x29, 0xae,
+	0x76, 0xd4, 0x33, 0x73, 0xf7, 0x4b, 0xe5, 0x53, 0x29, 0x88, 0x53, 0xfe, 0x34, 0x51, 0x08, 0x84,
+	0x69, 0x3c, 0xc5, 0x59, 0x36, 0x25, 0xf7, 0x89, 0xcf, 0x82, 0xb6, 0x1c, 0x5a, 0xf5, 0xb3, 0x11,
+	0x45, 0xc8, 0x0f, 0x4d, 0x15, 0x5b, 0xb6, 0x66, 0xf7, 0x0d, 0x7b, 0x50, 0xa6, 0x2e, 0xaa, 0x8c,
+	0xe5, 0xcf, 0xf8, 0xc1, 0x0d, 0xde, 0x51, 0xac, 0x90, 0xd4, 0xaa, 0xa6, 0xfb, 0x86, 0x7e, 0x56,
+	0x26, 0x64, 0xa9, 0x57, 0xec, 0xe5, 0xd7, 0xfb, 0xb5, 0xc7, 0x1e, 0xd6, 0x1e, 0xfb, 0xbb, 0xf6,
+	0xd8, 0xcf, 0x8d, 0xd7, 0x7a, 0xd8, 0x78, 0xad, 0xdf, 0x1b, 0xaf, 0xf5, 0xed, 0x43, 0x36, 0xa3,
+	0xe9, 0xed, 0x24, 0x4c, 0xf2, 0x65, 0x74, 0x6d, 0x16, 0xf4, 0x76, 0x0c, 0x13, 0x1d, 0xd9, 0x83,
+	0xfb, 0x71, 0xfe, 0x3e, 0xba, 0xdb, 0x3d, 0x3b, 0x5a, 0x15, 0xa8, 0x27, 0x3d, 0x73, 0x6f, 0xef,
+	0xfe, 0x0d, 0x00, 0xba, 0x65, 0xd3, 0xac, 0xec, 0x02, 0x00, 0x00,
+}
+
+func (m *DeadLetterAckResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeadLetterAckResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DeadLetterAckResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintDeadLetter(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.MsgResponses) > 0 {
+		for iNdEx := len(m.MsgResponses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.MsgResponses[iNdEx])
+			copy(dAtA[i:], m.MsgResponses[iNdEx])
+			i = encodeVarintDeadLetter(dAtA, i, uint64(len(m.MsgResponses[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Status != 0 {
+		i = encodeVarintDeadLetter(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DeadLetter) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeadLetter) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DeadLetter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.NextRetryHeight != 0 {
+		i = encodeVarintDeadLetter(dAtA, i, uint64(m.NextRetryHeight))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.LastAttemptHeight != 0 {
+		i = encodeVarintDeadLetter(dAtA, i, uint64(m.LastAttemptHeight))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.CreatedHeight != 0 {
+		i = encodeVarintDeadLetter(dAtA, i, uint64(m.CreatedHeight))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.LastError) > 0 {
+		i -= len(m.LastError)
+		copy(dAtA[i:], m.LastError)
+		i = encodeVarintDeadLetter(dAtA, i, uint64(len(m.LastError)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Attempts != 0 {
+		i = encodeVarintDeadLetter(dAtA, i, uint64(m.Attempts))
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size, err := m.AckResponse.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintDeadLetter(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Packet) > 0 {
+		i -= len(m.Packet)
+		copy(dAtA[i:], m.Packet)
+		i = encodeVarintDeadLetter(dAtA, i, uint64(len(m.Packet)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size, err := m.CallbackData.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintDeadLetter(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.CallbackKey) > 0 {
+		i -= len(m.CallbackKey)
+		copy(dAtA[i:], m.CallbackKey)
+		i = encodeVarintDeadLetter(dAtA, i, uint64(len(m.CallbackKey)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintDeadLetter(dAtA []byte, offset int, v uint64) int {
+	offset -= sovDeadLetter(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *DeadLetterAckResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Status != 0 {
+		n += 1 + sovDeadLetter(uint64(m.Status))
+	}
+	if len(m.MsgResponses) > 0 {
+		for _, b := range m.MsgResponses {
+			l = len(b)
+			n += 1 + l + sovDeadLetter(uint64(l))
+		}
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovDeadLetter(uint64(l))
+	}
+	return n
+}
+
+func (m *DeadLetter) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CallbackKey)
+	if l > 0 {
+		n += 1 + l + sovDeadLetter(uint64(l))
+	}
+	l = m.CallbackData.Size()
+	n += 1 + l + sovDeadLetter(uint64(l))
+	l = len(m.Packet)
+	if l > 0 {
+		n += 1 + l + sovDeadLetter(uint64(l))
+	}
+	l = m.AckResponse.Size()
+	n += 1 + l + sovDeadLetter(uint64(l))
+	if m.Attempts != 0 {
+		n += 1 + sovDeadLetter(uint64(m.Attempts))
+	}
+	l = len(m.LastError)
+	if l > 0 {
+		n += 1 + l + sovDeadLetter(uint64(l))
+	}
+	if m.CreatedHeight != 0 {
+		n += 1 + sovDeadLetter(uint64(m.CreatedHeight))
+	}
+	if m.LastAttemptHeight != 0 {
+		n += 1 + sovDeadLetter(uint64(m.LastAttemptHeight))
+	}
+	if m.NextRetryHeight != 0 {
+		n += 1 + sovDeadLetter(uint64(m.NextRetryHeight))
+	}
+	return n
+}
+
+func sovDeadLetter(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozDeadLetter(x uint64) (n int) {
+	return sovDeadLetter(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *DeadLetterAckResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDeadLetter
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeadLetterAckResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeadLetterAckResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDeadLetter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgResponses", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDeadLetter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthDeadLetter
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDeadLetter
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgResponses = append(m.MsgResponses, make([]byte, postIndex-iNdEx))
+			copy(m.MsgResponses[len(m.MsgResponses)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDeadLetter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDeadLetter
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDeadLetter
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDeadLetter(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDeadLetter
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DeadLetter) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDeadLetter
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeadLetter: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeadLetter: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CallbackKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDeadLetter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDeadLetter
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDeadLetter
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CallbackKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CallbackData", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDeadLetter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDeadLetter
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDeadLetter
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CallbackData.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Packet", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDeadLetter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthDeadLetter
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDeadLetter
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Packet = append(m.Packet[:0], dAtA[iNdEx:postIndex]...)
+			if m.Packet == nil {
+				m.Packet = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AckResponse", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDeadLetter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDeadLetter
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDeadLetter
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AckResponse.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attempts", wireType)
+			}
+			m.Attempts = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDeadLetter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Attempts |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastError", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDeadLetter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDeadLetter
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDeadLetter
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LastError = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedHeight", wireType)
+			}
+			m.CreatedHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDeadLetter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreatedHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastAttemptHeight", wireType)
+			}
+			m.LastAttemptHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDeadLetter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastAttemptHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextRetryHeight", wireType)
+			}
+			m.NextRetryHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDeadLetter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextRetryHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDeadLetter(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDeadLetter
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipDeadLetter(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowDeadLetter
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowDeadLetter
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowDeadLetter
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthDeadLetter
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupDeadLetter
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthDeadLetter
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthDeadLetter        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowDeadLetter          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupDeadLetter = fmt.Errorf("proto: unexpected end of group")
+)
@@ -15,4 +15,6 @@ var (
 	ErrCallbackDataNotFound    = errorsmod.Register(ModuleName, 1505, "icacallback data not found")
 	ErrTxMsgData               = errorsmod.Register(ModuleName, 1506, "txMsgData fetch failed")
 	ErrInvalidAcknowledgement  = errorsmod.Register(ModuleName, 1507, "invalid acknowledgement")
+	ErrDeadLetterNotFound      = errorsmod.Register(ModuleName, 1508, "dead-lettered callback not found")
+	ErrCallbackNotRetryable    = errorsmod.Register(ModuleName, 1509, "icacallback is not safe to retry")
 )
@@ -0,0 +1,26 @@
+package types
+
+// Dead letter events
+const (
+	EventTypeCallbackDeadLettered     = "callback_dead_lettered"
+	EventTypeCallbackRetried          = "callback_retried"
+	EventTypeCallbackRetriesExhausted = "callback_retries_exhausted"
+	EventTypeDeadLetterExpired        = "dead_letter_expired"
+
+	AttributeKeyCallbackKey = "callback_key"
+	AttributeKeyCallbackId  = "callback_id"
+	AttributeKeyAckStatus   = "ack_status"
+	AttributeKeyAttempts    = "attempts"
+	AttributeKeyError       = "error"
+)
+
+// Orphaned callback data events
+const (
+	EventTypeCallbackDataOrphaned = "callback_data_orphaned"
+	EventTypeCallbackDataExpired  = "callback_data_expired"
+
+	AttributeKeyPortId       = "port_id"
+	AttributeKeyChannelId    = "channel_id"
+	AttributeKeySequence     = "sequence"
+	AttributeKeyChannelState = "channel_state"
+)
@@ -14,6 +14,7 @@ func DefaultGenesis() *GenesisState {
 	return &GenesisState{
 		PortId:           PortID,
 		CallbackDataList: []CallbackData{},
+		DeadLetters:      []DeadLetter{},
 		// this line is used by starport scaffolding # genesis/types/default
 		Params: DefaultParams(),
 	}
@@ -35,6 +36,15 @@ func (gs GenesisState) Validate() error {
 		}
 		callbackDataIndexMap[index] = struct{}{}
 	}
+	// Check for duplicated callback keys in the dead letters
+	deadLetterIndexMap := make(map[string]struct{})
+
+	for _, elem := range gs.DeadLetters {
+		if _, ok := deadLetterIndexMap[elem.CallbackKey]; ok {
+			return fmt.Errorf("duplicated callback key for dead letter")
+		}
+		deadLetterIndexMap[elem.CallbackKey] = struct{}{}
+	}
 	// this line is used by starport scaffolding # genesis/types/validate
 
 	return gs.Params.Validate()
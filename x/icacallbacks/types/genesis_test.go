@@ -49,6 +49,21 @@ func TestGenesisState_Validate(t *testing.T) {
 			},
 			valid: false,
 		},
+		{
+			desc: "duplicated dead letter",
+			genState: &types.GenesisState{
+				PortId: types.PortID,
+				DeadLetters: []types.DeadLetter{
+					{
+						CallbackKey: "0",
+					},
+					{
+						CallbackKey: "0",
+					},
+				},
+			},
+			valid: false,
+		},
 		// this line is used by starport scaffolding # types/genesis/testcase
 	} {
 		t.Run(tc.desc, func(t *testing.T) {
@@ -0,0 +1,15 @@
+package types
+
+const (
+	// DeadLetterRootKeyPrefix is the prefix to retrieve all DeadLetters, both retryable and exhausted
+	DeadLetterRootKeyPrefix = "DeadLetter/"
+	// DeadLetterKeyPrefix is the prefix to retrieve all DeadLetters that are scheduled for an automatic retry
+	DeadLetterKeyPrefix = "DeadLetter/value/"
+	// ExhaustedDeadLetterKeyPrefix is the prefix to retrieve all DeadLetters whose automatic retries have been exhausted
+	ExhaustedDeadLetterKeyPrefix = "DeadLetter/exhausted/"
+)
+
+// DeadLetterKey returns the store key to retrieve a DeadLetter from the callback key
+func DeadLetterKey(callbackKey string) []byte {
+	return append([]byte(callbackKey), []byte("/")...)
+}
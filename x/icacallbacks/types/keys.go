@@ -1,6 +1,9 @@
 package types
 
-import fmt "fmt"
+import (
+	"fmt"
+	"time"
+)
 
 const (
 	// ModuleName defines the module name
@@ -35,3 +38,15 @@ func KeyPrefix(p string) []byte {
 func PacketID(portID string, channelID string, sequence uint64) string {
 	return fmt.Sprintf("%s.%s.%d", portID, channelID, sequence)
 }
+
+const (
+	// Number of blocks between each sweep for orphaned callback data
+	OrphanSweepInterval int64 = 100
+
+	// Duration after which orphaned callback data is removed from the store
+	OrphanedCallbackDataExpiration = 7 * 24 * time.Hour
+
+	// Number of blocks after the last attempt at which an exhausted dead letter is removed from the store
+	// (roughly 7 days with 6 second blocks)
+	ExhaustedDeadLetterExpirationBlocks int64 = 100_800
+)
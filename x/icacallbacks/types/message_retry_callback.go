@@ -0,0 +1,54 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/migrations/legacytx"
+)
+
+const TypeMsgRetryCallback = "retry_callback"
+
+var (
+	_ sdk.Msg            = &MsgRetryCallback{}
+	_ legacytx.LegacyMsg = &MsgRetryCallback{}
+)
+
+func NewMsgRetryCallback(creator string, callbackKey string) *MsgRetryCallback {
+	return &MsgRetryCallback{
+		Creator:     creator,
+		CallbackKey: callbackKey,
+	}
+}
+
+func (msg MsgRetryCallback) Type() string {
+	return TypeMsgRetryCallback
+}
+
+func (msg MsgRetryCallback) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgRetryCallback) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgRetryCallback) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// Note: the admin check is done in the msg server since utils depends on this package
+func (msg *MsgRetryCallback) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if msg.CallbackKey == "" {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "callback key must be specified")
+	}
+	return nil
+}
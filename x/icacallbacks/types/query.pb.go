@@ -297,6 +297,294 @@ func (m *QueryAllCallbackDataResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
+type QueryDeadLetterRequest struct {
+	CallbackKey string `protobuf:"bytes,1,opt,name=callback_key,json=callbackKey,proto3" json:"callback_key,omitempty"`
+}
+
+func (m *QueryDeadLetterRequest) Reset()         { *m = QueryDeadLetterRequest{} }
+func (m *QueryDeadLetterRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDeadLetterRequest) ProtoMessage()    {}
+func (*QueryDeadLetterRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5e73b99abb7e91c2, []int{6}
+}
+func (m *QueryDeadLetterRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDeadLetterRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDeadLetterRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDeadLetterRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDeadLetterRequest.Merge(m, src)
+}
+func (m *QueryDeadLetterRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDeadLetterRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDeadLetterRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDeadLetterRequest proto.InternalMessageInfo
+
+func (m *QueryDeadLetterRequest) GetCallbackKey() string {
+	if m != nil {
+		return m.CallbackKey
+	}
+	return ""
+}
+
+type QueryDeadLetterResponse struct {
+	DeadLetter DeadLetter `protobuf:"bytes,1,opt,name=dead_letter,json=deadLetter,proto3" json:"dead_letter"`
+}
+
+func (m *QueryDeadLetterResponse) Reset()         { *m = QueryDeadLetterResponse{} }
+func (m *QueryDeadLetterResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDeadLetterResponse) ProtoMessage()    {}
+func (*QueryDeadLetterResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5e73b99abb7e91c2, []int{7}
+}
+func (m *QueryDeadLetterResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDeadLetterResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDeadLetterResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDeadLetterResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDeadLetterResponse.Merge(m, src)
+}
+func (m *QueryDeadLetterResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDeadLetterResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDeadLetterResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDeadLetterResponse proto.InternalMessageInfo
+
+func (m *QueryDeadLetterResponse) GetDeadLetter() DeadLetter {
+	if m != nil {
+		return m.DeadLetter
+	}
+	return DeadLetter{}
+}
+
+type QueryDeadLettersRequest struct {
+	CallbackId string             `protobuf:"bytes,1,opt,name=callback_id,json=callbackId,proto3" json:"callback_id,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryDeadLettersRequest) Reset()         { *m = QueryDeadLettersRequest{} }
+func (m *QueryDeadLettersRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDeadLettersRequest) ProtoMessage()    {}
+func (*QueryDeadLettersRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5e73b99abb7e91c2, []int{8}
+}
+func (m *QueryDeadLettersRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDeadLettersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDeadLettersRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDeadLettersRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDeadLettersRequest.Merge(m, src)
+}
+func (m *QueryDeadLettersRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDeadLettersRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDeadLettersRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDeadLettersRequest proto.InternalMessageInfo
+
+func (m *QueryDeadLettersRequest) GetCallbackId() string {
+	if m != nil {
+		return m.CallbackId
+	}
+	return ""
+}
+
+func (m *QueryDeadLettersRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryDeadLettersResponse struct {
+	DeadLetters []DeadLetter        `protobuf:"bytes,1,rep,name=dead_letters,json=deadLetters,proto3" json:"dead_letters"`
+	Pagination  *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryDeadLettersResponse) Reset()         { *m = QueryDeadLettersResponse{} }
+func (m *QueryDeadLettersResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDeadLettersResponse) ProtoMessage()    {}
+func (*QueryDeadLettersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5e73b99abb7e91c2, []int{9}
+}
+func (m *QueryDeadLettersResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDeadLettersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDeadLettersResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDeadLettersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDeadLettersResponse.Merge(m, src)
+}
+func (m *QueryDeadLettersResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDeadLettersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDeadLettersResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDeadLettersResponse proto.InternalMessageInfo
+
+func (m *QueryDeadLettersResponse) GetDeadLetters() []DeadLetter {
+	if m != nil {
+		return m.DeadLetters
+	}
+	return nil
+}
+
+func (m *QueryDeadLettersResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryOrphanedCallbackDataRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryOrphanedCallbackDataRequest) Reset()         { *m = QueryOrphanedCallbackDataRequest{} }
+func (m *QueryOrphanedCallbackDataRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryOrphanedCallbackDataRequest) ProtoMessage()    {}
+func (*QueryOrphanedCallbackDataRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5e73b99abb7e91c2, []int{10}
+}
+func (m *QueryOrphanedCallbackDataRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryOrphanedCallbackDataRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryOrphanedCallbackDataRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryOrphanedCallbackDataRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryOrphanedCallbackDataRequest.Merge(m, src)
+}
+func (m *QueryOrphanedCallbackDataRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryOrphanedCallbackDataRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryOrphanedCallbackDataRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryOrphanedCallbackDataRequest proto.InternalMessageInfo
+
+func (m *QueryOrphanedCallbackDataRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryOrphanedCallbackDataResponse struct {
+	CallbackData []CallbackData      `protobuf:"bytes,1,rep,name=callback_data,json=callbackData,proto3" json:"callback_data"`
+	Pagination   *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryOrphanedCallbackDataResponse) Reset()         { *m = QueryOrphanedCallbackDataResponse{} }
+func (m *QueryOrphanedCallbackDataResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryOrphanedCallbackDataResponse) ProtoMessage()    {}
+func (*QueryOrphanedCallbackDataResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5e73b99abb7e91c2, []int{11}
+}
+func (m *QueryOrphanedCallbackDataResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryOrphanedCallbackDataResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryOrphanedCallbackDataResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryOrphanedCallbackDataResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryOrphanedCallbackDataResponse.Merge(m, src)
+}
+func (m *QueryOrphanedCallbackDataResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryOrphanedCallbackDataResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryOrphanedCallbackDataResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryOrphanedCallbackDataResponse proto.InternalMessageInfo
+
+func (m *QueryOrphanedCallbackDataResponse) GetCallbackData() []CallbackData {
+	if m != nil {
+		return m.CallbackData
+	}
+	return nil
+}
+
+func (m *QueryOrphanedCallbackDataResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "stride.icacallbacks.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "stride.icacallbacks.QueryParamsResponse")
@@ -304,45 +592,65 @@ func init() {
 	proto.RegisterType((*QueryGetCallbackDataResponse)(nil), "stride.icacallbacks.QueryGetCallbackDataResponse")
 	proto.RegisterType((*QueryAllCallbackDataRequest)(nil), "stride.icacallbacks.QueryAllCallbackDataRequest")
 	proto.RegisterType((*QueryAllCallbackDataResponse)(nil), "stride.icacallbacks.QueryAllCallbackDataResponse")
+	proto.RegisterType((*QueryDeadLetterRequest)(nil), "stride.icacallbacks.QueryDeadLetterRequest")
+	proto.RegisterType((*QueryDeadLetterResponse)(nil), "stride.icacallbacks.QueryDeadLetterResponse")
+	proto.RegisterType((*QueryDeadLettersRequest)(nil), "stride.icacallbacks.QueryDeadLettersRequest")
+	proto.RegisterType((*QueryDeadLettersResponse)(nil), "stride.icacallbacks.QueryDeadLettersResponse")
+	proto.RegisterType((*QueryOrphanedCallbackDataRequest)(nil), "stride.icacallbacks.QueryOrphanedCallbackDataRequest")
+	proto.RegisterType((*QueryOrphanedCallbackDataResponse)(nil), "stride.icacallbacks.QueryOrphanedCallbackDataResponse")
 }
 
 func init() { proto.RegisterFile("stride/icacallbacks/query.proto", fileDescriptor_5e73b99abb7e91c2) }
 
 var fileDescriptor_5e73b99abb7e91c2 = []byte{
-	// 521 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x94, 0x3f, 0x6f, 0x13, 0x31,
-	0x18, 0xc6, 0xe3, 0x52, 0x22, 0xe1, 0x16, 0x21, 0xb9, 0x1d, 0x50, 0x5a, 0x5d, 0xdb, 0x1b, 0x48,
-	0x41, 0xc2, 0x6e, 0x02, 0xaa, 0xc4, 0x80, 0xa0, 0xe5, 0x4f, 0x07, 0x3a, 0x84, 0xb0, 0xb1, 0xa0,
-	0xf7, 0xae, 0xd6, 0x71, 0xaa, 0x73, 0xbe, 0xc6, 0x4e, 0x45, 0x84, 0x58, 0x10, 0x23, 0x03, 0x12,
-	0xdf, 0x83, 0x8d, 0x85, 0x4f, 0xd0, 0xb1, 0x12, 0x0b, 0x13, 0x42, 0x09, 0x1f, 0x04, 0xc5, 0x76,
-	0xca, 0x9d, 0xea, 0x34, 0x4a, 0x37, 0xcb, 0x7e, 0xde, 0xe7, 0xf9, 0xbd, 0xf7, 0xbe, 0x3a, 0xbc,
-	0xa6, 0x74, 0x37, 0x3d, 0xe0, 0x2c, 0x8d, 0x21, 0x06, 0x21, 0x22, 0x88, 0x0f, 0x15, 0x3b, 0xea,
-	0xf1, 0x6e, 0x9f, 0xe6, 0x5d, 0xa9, 0x25, 0x59, 0xb2, 0x02, 0x5a, 0x14, 0xd4, 0x96, 0x13, 0x99,
-	0x48, 0xf3, 0xce, 0x46, 0x27, 0x2b, 0xad, 0xad, 0x26, 0x52, 0x26, 0x82, 0x33, 0xc8, 0x53, 0x06,
-	0x59, 0x26, 0x35, 0xe8, 0x54, 0x66, 0xca, 0xbd, 0xde, 0x89, 0xa5, 0xea, 0x48, 0xc5, 0x22, 0x50,
-	0xdc, 0x26, 0xb0, 0xe3, 0x46, 0xc4, 0x35, 0x34, 0x58, 0x0e, 0x49, 0x9a, 0x19, 0xb1, 0xd3, 0xae,
-	0xfb, 0xa8, 0x72, 0xe8, 0x42, 0x67, 0xec, 0x56, 0xf7, 0x29, 0xc6, 0xa7, 0x37, 0x07, 0xa0, 0xc1,
-	0x0a, 0xc3, 0x65, 0x4c, 0x5e, 0x8e, 0xc2, 0x5a, 0xa6, 0xba, 0xcd, 0x8f, 0x7a, 0x5c, 0xe9, 0xb0,
-	0x85, 0x97, 0x4a, 0xb7, 0x2a, 0x97, 0x99, 0xe2, 0xe4, 0x01, 0xae, 0xda, 0x94, 0x9b, 0x68, 0x1d,
-	0x6d, 0x2e, 0x34, 0x57, 0xa8, 0xa7, 0x7b, 0x6a, 0x8b, 0x76, 0xe7, 0x4f, 0x7e, 0xaf, 0x55, 0xda,
-	0xae, 0x20, 0x7c, 0x8c, 0x57, 0x8c, 0xe3, 0x1e, 0xd7, 0x4f, 0x9c, 0xf2, 0x29, 0x68, 0x70, 0x81,
-	0x64, 0x03, 0x2f, 0x9e, 0xd1, 0x1d, 0xf2, 0xbe, 0xf1, 0xbf, 0xd6, 0x5e, 0x18, 0xdf, 0xbd, 0xe0,
-	0xfd, 0x50, 0xe0, 0x55, 0xbf, 0x83, 0x83, 0xdb, 0xc7, 0xd7, 0x4b, 0x0d, 0x3a, 0xc6, 0x0d, 0x2f,
-	0x63, 0xd1, 0xc1, 0x91, 0x9e, 0x01, 0x8c, 0xee, 0x42, 0xee, 0x78, 0x77, 0x84, 0xf0, 0xf1, 0x3e,
-	0xc7, 0xf8, 0xff, 0x54, 0x5c, 0xd2, 0x2d, 0x6a, 0x47, 0x48, 0x47, 0x23, 0xa4, 0x76, 0x49, 0xdc,
-	0x08, 0x69, 0x0b, 0x12, 0xee, 0x6a, 0xdb, 0x85, 0xca, 0xf0, 0x3b, 0x72, 0x5d, 0x9d, 0xcb, 0x99,
-	0xdc, 0xd5, 0x95, 0x4b, 0x77, 0x45, 0xf6, 0x4a, 0xd8, 0x73, 0x06, 0xbb, 0x3e, 0x15, 0xdb, 0xa2,
-	0x14, 0xb9, 0x9b, 0x9f, 0xe6, 0xf1, 0x55, 0xc3, 0x4d, 0x3e, 0x23, 0x5c, 0xb5, 0x13, 0x27, 0x75,
-	0x2f, 0xd4, 0xf9, 0xf5, 0xaa, 0x6d, 0x4e, 0x17, 0xda, 0xcc, 0x90, 0x7d, 0xfc, 0xf9, 0xf7, 0xeb,
-	0xdc, 0x6d, 0x52, 0x67, 0xaf, 0x4c, 0xc5, 0xdd, 0x7d, 0x88, 0x14, 0x9b, 0xbc, 0xfe, 0xe4, 0x07,
-	0xc2, 0x8b, 0xc5, 0xcf, 0x40, 0xb6, 0x26, 0x67, 0xf9, 0x77, 0xb1, 0xd6, 0x98, 0xa1, 0xc2, 0x61,
-	0x3e, 0x33, 0x98, 0x8f, 0xc8, 0xc3, 0xa9, 0x98, 0xa5, 0x61, 0xb2, 0xf7, 0xc5, 0xa5, 0xff, 0x40,
-	0xbe, 0x21, 0x7c, 0xa3, 0xe8, 0xbf, 0x23, 0xc4, 0x45, 0xfc, 0xfe, 0xdd, 0xbc, 0x88, 0x7f, 0xc2,
-	0x96, 0x85, 0xdb, 0x86, 0x7f, 0x8b, 0xd0, 0xd9, 0xf8, 0x77, 0x5b, 0x27, 0x83, 0x00, 0x9d, 0x0e,
-	0x02, 0xf4, 0x67, 0x10, 0xa0, 0x2f, 0xc3, 0xa0, 0x72, 0x3a, 0x0c, 0x2a, 0xbf, 0x86, 0x41, 0xe5,
-	0xf5, 0x76, 0x92, 0xea, 0xb7, 0xbd, 0x88, 0xc6, 0xb2, 0xe3, 0xf3, 0x3c, 0x6e, 0xde, 0x67, 0xef,
-	0xca, 0xce, 0xba, 0x9f, 0x73, 0x15, 0x55, 0xcd, 0x6f, 0xe9, 0xde, 0xbf, 0x00, 0x00, 0x00, 0xff,
-	0xff, 0xdd, 0x6d, 0xe5, 0x70, 0x79, 0x05, 0x00, 0x00,
+	// 741 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x96, 0x5d, 0x4f, 0x13, 0x4d,
+	0x14, 0xc7, 0x3b, 0x3c, 0xcf, 0x43, 0x1e, 0x4f, 0x6b, 0x4c, 0x06, 0xa2, 0x64, 0x21, 0x2d, 0x6c,
+	0xa2, 0xc5, 0x17, 0x76, 0x28, 0x0a, 0x86, 0xf8, 0x82, 0x60, 0x05, 0x8d, 0x24, 0xd6, 0x7a, 0xe7,
+	0x4d, 0x33, 0xed, 0x4e, 0x96, 0x95, 0xa5, 0xbb, 0x74, 0x06, 0x62, 0x63, 0xbc, 0xe1, 0xda, 0x0b,
+	0x13, 0x3f, 0x01, 0x1f, 0x00, 0xef, 0xbc, 0xe1, 0x03, 0x18, 0x2e, 0x49, 0xbc, 0xf1, 0xca, 0x18,
+	0xf0, 0x83, 0x18, 0x66, 0xa7, 0xed, 0xae, 0x9d, 0xd2, 0x96, 0x68, 0xe2, 0xdd, 0x66, 0xf6, 0x7f,
+	0xce, 0xff, 0x77, 0xe6, 0x9c, 0x9e, 0x2e, 0x64, 0xb8, 0xa8, 0xb9, 0x36, 0x23, 0x6e, 0x85, 0x56,
+	0xa8, 0xe7, 0x95, 0x69, 0x65, 0x9d, 0x93, 0xcd, 0x2d, 0x56, 0xab, 0x5b, 0x41, 0xcd, 0x17, 0x3e,
+	0x1e, 0x0a, 0x05, 0x56, 0x54, 0x60, 0x0c, 0x3b, 0xbe, 0xe3, 0xcb, 0xf7, 0xe4, 0xe4, 0x29, 0x94,
+	0x1a, 0x63, 0x8e, 0xef, 0x3b, 0x1e, 0x23, 0x34, 0x70, 0x09, 0xad, 0x56, 0x7d, 0x41, 0x85, 0xeb,
+	0x57, 0xb9, 0x7a, 0x7b, 0xad, 0xe2, 0xf3, 0x0d, 0x9f, 0x93, 0x32, 0xe5, 0x2c, 0x74, 0x20, 0xdb,
+	0xb9, 0x32, 0x13, 0x34, 0x47, 0x02, 0xea, 0xb8, 0x55, 0x29, 0x56, 0xda, 0x71, 0x1d, 0x55, 0x40,
+	0x6b, 0x74, 0xa3, 0x91, 0x2d, 0xab, 0x53, 0x34, 0x9e, 0x4a, 0x36, 0x15, 0x54, 0x09, 0x2f, 0xeb,
+	0x84, 0x36, 0xa3, 0x76, 0xc9, 0x63, 0x42, 0xb0, 0x5a, 0x28, 0x33, 0x87, 0x01, 0x3f, 0x3f, 0x61,
+	0x2a, 0x48, 0x93, 0x22, 0xdb, 0xdc, 0x62, 0x5c, 0x98, 0x05, 0x18, 0x8a, 0x9d, 0xf2, 0xc0, 0xaf,
+	0x72, 0x86, 0xe7, 0x61, 0x30, 0x84, 0x19, 0x41, 0xe3, 0x68, 0x32, 0x39, 0x33, 0x6a, 0x69, 0x2e,
+	0xc9, 0x0a, 0x83, 0x96, 0xfe, 0x3d, 0xf8, 0x96, 0x49, 0x14, 0x55, 0x80, 0xf9, 0x00, 0x46, 0x65,
+	0xc6, 0x15, 0x26, 0x1e, 0x2a, 0x65, 0x9e, 0x0a, 0xaa, 0x0c, 0xf1, 0x04, 0xa4, 0x9a, 0x45, 0xac,
+	0xb3, 0xba, 0xcc, 0x7f, 0xae, 0x98, 0x6c, 0x9c, 0x3d, 0x65, 0x75, 0xd3, 0x83, 0x31, 0x7d, 0x06,
+	0x05, 0xb7, 0x0a, 0xe7, 0x63, 0xf7, 0xa0, 0x18, 0x27, 0xb4, 0x8c, 0xd1, 0x0c, 0x8a, 0x34, 0x55,
+	0x89, 0x9c, 0x99, 0x4c, 0xf1, 0x2e, 0x7a, 0x9e, 0x8e, 0x77, 0x19, 0xa0, 0xd5, 0x3c, 0xe5, 0x74,
+	0xc5, 0x0a, 0x3b, 0x6d, 0x9d, 0x74, 0xda, 0x0a, 0x67, 0x49, 0x75, 0xda, 0x2a, 0x50, 0x87, 0xa9,
+	0xd8, 0x62, 0x24, 0xd2, 0xfc, 0x84, 0x60, 0x4c, 0xef, 0xd3, 0xb9, 0xaa, 0x7f, 0xce, 0x5c, 0x15,
+	0x5e, 0x89, 0x61, 0x0f, 0x48, 0xec, 0x6c, 0x57, 0xec, 0x10, 0x25, 0xc6, 0x7d, 0x07, 0x2e, 0x4a,
+	0xec, 0x3c, 0xa3, 0xf6, 0xaa, 0x9c, 0xa7, 0x3e, 0x3a, 0x49, 0xe1, 0x52, 0x5b, 0xb0, 0x2a, 0x77,
+	0x19, 0x92, 0x91, 0x19, 0x55, 0x17, 0x9b, 0xd1, 0x16, 0xdb, 0x8a, 0x56, 0xa5, 0x82, 0xdd, 0x3c,
+	0x31, 0x77, 0x50, 0x9b, 0x47, 0x63, 0xb8, 0x71, 0x06, 0x9a, 0x34, 0x25, 0xd7, 0x56, 0x80, 0xd0,
+	0x38, 0x7a, 0x62, 0xe3, 0x65, 0xcd, 0x2d, 0x9d, 0xa5, 0xb9, 0x7b, 0x08, 0x46, 0xda, 0x21, 0x54,
+	0xa5, 0x8f, 0x21, 0x15, 0xa9, 0x94, 0xab, 0xbe, 0xf6, 0x58, 0x6a, 0xb2, 0x55, 0x2a, 0xff, 0x7d,
+	0x4d, 0x7d, 0x05, 0xe3, 0x12, 0xf7, 0x59, 0x2d, 0x58, 0xa3, 0x55, 0x66, 0xff, 0xc9, 0xc1, 0xdf,
+	0x47, 0x30, 0x71, 0x8a, 0xd9, 0x5f, 0x3d, 0xfd, 0x33, 0xbb, 0xff, 0xc3, 0x7f, 0x12, 0x1e, 0xbf,
+	0x43, 0x30, 0x18, 0xee, 0x3b, 0x9c, 0xd5, 0x42, 0xb5, 0x2f, 0x57, 0x63, 0xb2, 0xbb, 0x30, 0xf4,
+	0x34, 0xc9, 0xce, 0x97, 0x1f, 0x1f, 0x06, 0xae, 0xe2, 0x2c, 0x79, 0x21, 0x23, 0xa6, 0x56, 0x69,
+	0x99, 0x93, 0xce, 0xff, 0x11, 0x78, 0x1f, 0x41, 0x2a, 0x7a, 0x0d, 0x78, 0xba, 0xb3, 0x97, 0x7e,
+	0x13, 0x1b, 0xb9, 0x3e, 0x22, 0x14, 0xe6, 0x23, 0x89, 0xb9, 0x80, 0xef, 0x75, 0xc5, 0x8c, 0x35,
+	0x93, 0xbc, 0x89, 0x2e, 0x8a, 0xb7, 0xf8, 0x23, 0x82, 0x0b, 0xd1, 0xfc, 0x8b, 0x9e, 0x77, 0x1a,
+	0xbf, 0x7e, 0x33, 0x1b, 0xb9, 0x3e, 0x22, 0x14, 0xff, 0x9c, 0xe4, 0x9f, 0xc6, 0x56, 0x7f, 0xfc,
+	0x78, 0x0f, 0x01, 0xb4, 0x7e, 0x9a, 0xf8, 0x7a, 0x67, 0xe7, 0xb6, 0x35, 0x69, 0xdc, 0xe8, 0x4d,
+	0xac, 0x08, 0xf3, 0x92, 0xf0, 0x3e, 0xbe, 0xdb, 0x95, 0x30, 0xba, 0x53, 0x7e, 0xbd, 0xe0, 0x5d,
+	0x04, 0xc9, 0x7c, 0x64, 0x71, 0xf4, 0xc4, 0xd0, 0x1c, 0xdb, 0xa9, 0x1e, 0xd5, 0x0a, 0x79, 0x56,
+	0x22, 0x13, 0x3c, 0xd5, 0x17, 0x32, 0xfe, 0x8c, 0x60, 0x58, 0xb7, 0x12, 0xf0, 0x6c, 0x67, 0xfb,
+	0x53, 0xf6, 0x95, 0x31, 0xd7, 0x6f, 0x98, 0xc2, 0x5f, 0x90, 0xf8, 0xf3, 0xf8, 0x76, 0x57, 0x7c,
+	0x5f, 0xa5, 0x29, 0xc5, 0x86, 0x63, 0xa9, 0x70, 0x70, 0x94, 0x46, 0x87, 0x47, 0x69, 0xf4, 0xfd,
+	0x28, 0x8d, 0xde, 0x1f, 0xa7, 0x13, 0x87, 0xc7, 0xe9, 0xc4, 0xd7, 0xe3, 0x74, 0xe2, 0xe5, 0x9c,
+	0xe3, 0x8a, 0xb5, 0xad, 0xb2, 0x55, 0xf1, 0x37, 0x74, 0xc9, 0xb7, 0x67, 0x6e, 0x91, 0xd7, 0x71,
+	0x0b, 0x51, 0x0f, 0x18, 0x2f, 0x0f, 0xca, 0x2f, 0xb6, 0x9b, 0x3f, 0x07, 0x00, 0xfe, 0xa9, 0x41,
+	0xe8, 0xbb, 0x0a, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -363,6 +671,12 @@ type QueryClient interface {
 	CallbackData(ctx context.Context, in *QueryGetCallbackDataRequest, opts ...grpc.CallOption) (*QueryGetCallbackDataResponse, error)
 	// Queries a list of CallbackData items.
 	CallbackDataAll(ctx context.Context, in *QueryAllCallbackDataRequest, opts ...grpc.CallOption) (*QueryAllCallbackDataResponse, error)
+	// Queries a dead-lettered callback by callback key
+	DeadLetter(ctx context.Context, in *QueryDeadLetterRequest, opts ...grpc.CallOption) (*QueryDeadLetterResponse, error)
+	// Queries all dead-lettered callbacks, optionally filtered by callback ID
+	DeadLetters(ctx context.Context, in *QueryDeadLettersRequest, opts ...grpc.CallOption) (*QueryDeadLettersResponse, error)
+	// Queries all orphaned CallbackData items
+	OrphanedCallbackData(ctx context.Context, in *QueryOrphanedCallbackDataRequest, opts ...grpc.CallOption) (*QueryOrphanedCallbackDataResponse, error)
 }
 
 type queryClient struct {
@@ -400,6 +714,33 @@ func (c *queryClient) CallbackDataAll(ctx context.Context, in *QueryAllCallbackD
 	return out, nil
 }
 
+func (c *queryClient) DeadLetter(ctx context.Context, in *QueryDeadLetterRequest, opts ...grpc.CallOption) (*QueryDeadLetterResponse, error) {
+	out := new(QueryDeadLetterResponse)
+	err := c.cc.Invoke(ctx, "/stride.icacallbacks.Query/DeadLetter", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DeadLetters(ctx context.Context, in *QueryDeadLettersRequest, opts ...grpc.CallOption) (*QueryDeadLettersResponse, error) {
+	out := new(QueryDeadLettersResponse)
+	err := c.cc.Invoke(ctx, "/stride.icacallbacks.Query/DeadLetters", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) OrphanedCallbackData(ctx context.Context, in *QueryOrphanedCallbackDataRequest, opts ...grpc.CallOption) (*QueryOrphanedCallbackDataResponse, error) {
+	out := new(QueryOrphanedCallbackDataResponse)
+	err := c.cc.Invoke(ctx, "/stride.icacallbacks.Query/OrphanedCallbackData", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Parameters queries the parameters of the module.
@@ -408,6 +749,12 @@ type QueryServer interface {
 	CallbackData(context.Context, *QueryGetCallbackDataRequest) (*QueryGetCallbackDataResponse, error)
 	// Queries a list of CallbackData items.
 	CallbackDataAll(context.Context, *QueryAllCallbackDataRequest) (*QueryAllCallbackDataResponse, error)
+	// Queries a dead-lettered callback by callback key
+	DeadLetter(context.Context, *QueryDeadLetterRequest) (*QueryDeadLetterResponse, error)
+	// Queries all dead-lettered callbacks, optionally filtered by callback ID
+	DeadLetters(context.Context, *QueryDeadLettersRequest) (*QueryDeadLettersResponse, error)
+	// Queries all orphaned CallbackData items
+	OrphanedCallbackData(context.Context, *QueryOrphanedCallbackDataRequest) (*QueryOrphanedCallbackDataResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -423,6 +770,15 @@ func (*UnimplementedQueryServer) CallbackData(ctx context.Context, req *QueryGet
 func (*UnimplementedQueryServer) CallbackDataAll(ctx context.Context, req *QueryAllCallbackDataRequest) (*QueryAllCallbackDataResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CallbackDataAll not implemented")
 }
+func (*UnimplementedQueryServer) DeadLetter(ctx context.Context, req *QueryDeadLetterRequest) (*QueryDeadLetterResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeadLetter not implemented")
+}
+func (*UnimplementedQueryServer) DeadLetters(ctx context.Context, req *QueryDeadLettersRequest) (*QueryDeadLettersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeadLetters not implemented")
+}
+func (*UnimplementedQueryServer) OrphanedCallbackData(ctx context.Context, req *QueryOrphanedCallbackDataRequest) (*QueryOrphanedCallbackDataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OrphanedCallbackData not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -482,6 +838,60 @@ func _Query_CallbackDataAll_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_DeadLetter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDeadLetterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DeadLetter(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stride.icacallbacks.Query/DeadLetter",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DeadLetter(ctx, req.(*QueryDeadLetterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DeadLetters_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDeadLettersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DeadLetters(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stride.icacallbacks.Query/DeadLetters",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DeadLetters(ctx, req.(*QueryDeadLettersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_OrphanedCallbackData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryOrphanedCallbackDataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).OrphanedCallbackData(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stride.icacallbacks.Query/OrphanedCallbackData",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).OrphanedCallbackData(ctx, req.(*QueryOrphanedCallbackDataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "stride.icacallbacks.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -498,6 +908,18 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "CallbackDataAll",
 			Handler:    _Query_CallbackDataAll_Handler,
 		},
+		{
+			MethodName: "DeadLetter",
+			Handler:    _Query_DeadLetter_Handler,
+		},
+		{
+			MethodName: "DeadLetters",
+			Handler:    _Query_DeadLetters_Handler,
+		},
+		{
+			MethodName: "OrphanedCallbackData",
+			Handler:    _Query_OrphanedCallbackData_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "stride/icacallbacks/query.proto",
@@ -681,125 +1103,959 @@ func (m *QueryAllCallbackDataResponse) MarshalToSizedBuffer(dAtA []byte) (int, e
 		{
 			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
-				return 0, err
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.CallbackData) > 0 {
+		for iNdEx := len(m.CallbackData) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CallbackData[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDeadLetterRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDeadLetterRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDeadLetterRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CallbackKey) > 0 {
+		i -= len(m.CallbackKey)
+		copy(dAtA[i:], m.CallbackKey)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.CallbackKey)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDeadLetterResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDeadLetterResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDeadLetterResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.DeadLetter.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDeadLettersRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDeadLettersRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDeadLettersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.CallbackId) > 0 {
+		i -= len(m.CallbackId)
+		copy(dAtA[i:], m.CallbackId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.CallbackId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDeadLettersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDeadLettersResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDeadLettersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DeadLetters) > 0 {
+		for iNdEx := len(m.DeadLetters) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DeadLetters[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryOrphanedCallbackDataRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryOrphanedCallbackDataRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOrphanedCallbackDataRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryOrphanedCallbackDataResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryOrphanedCallbackDataResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOrphanedCallbackDataResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.CallbackData) > 0 {
+		for iNdEx := len(m.CallbackData) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CallbackData[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryGetCallbackDataRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CallbackKey)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetCallbackDataResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.CallbackData.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllCallbackDataRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllCallbackDataResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.CallbackData) > 0 {
+		for _, e := range m.CallbackData {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDeadLetterRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CallbackKey)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDeadLetterResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.DeadLetter.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryDeadLettersRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CallbackId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDeadLettersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.DeadLetters) > 0 {
+		for _, e := range m.DeadLetters {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryOrphanedCallbackDataRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryOrphanedCallbackDataResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.CallbackData) > 0 {
+		for _, e := range m.CallbackData {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetCallbackDataRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetCallbackDataRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetCallbackDataRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CallbackKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CallbackKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetCallbackDataResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetCallbackDataResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetCallbackDataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CallbackData", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CallbackData.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllCallbackDataRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllCallbackDataRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllCallbackDataRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllCallbackDataResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllCallbackDataResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllCallbackDataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CallbackData", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CallbackData = append(m.CallbackData, CallbackData{})
+			if err := m.CallbackData[len(m.CallbackData)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.CallbackData) > 0 {
-		for iNdEx := len(m.CallbackData) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.CallbackData[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
 			}
-			i--
-			dAtA[i] = 0xa
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return len(dAtA) - i, nil
-}
-
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
-}
-
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
-}
-
-func (m *QueryGetCallbackDataRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.CallbackKey)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
-}
-
-func (m *QueryGetCallbackDataResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.CallbackData.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
-}
-
-func (m *QueryAllCallbackDataRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
-}
 
-func (m *QueryAllCallbackDataResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.CallbackData) > 0 {
-		for _, e := range m.CallbackData {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryDeadLetterRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -822,12 +2078,44 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDeadLetterRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDeadLetterRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CallbackKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CallbackKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -849,7 +2137,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryDeadLetterResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -872,15 +2160,15 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDeadLetterResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDeadLetterResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DeadLetter", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -907,7 +2195,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.DeadLetter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -932,7 +2220,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetCallbackDataRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryDeadLettersRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -955,15 +2243,15 @@ func (m *QueryGetCallbackDataRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetCallbackDataRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDeadLettersRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetCallbackDataRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDeadLettersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CallbackKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CallbackId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -991,7 +2279,43 @@ func (m *QueryGetCallbackDataRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CallbackKey = string(dAtA[iNdEx:postIndex])
+			m.CallbackId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1014,7 +2338,7 @@ func (m *QueryGetCallbackDataRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetCallbackDataResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryDeadLettersResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1037,15 +2361,15 @@ func (m *QueryGetCallbackDataResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetCallbackDataResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDeadLettersResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetCallbackDataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDeadLettersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CallbackData", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DeadLetters", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1072,7 +2396,44 @@ func (m *QueryGetCallbackDataResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.CallbackData.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.DeadLetters = append(m.DeadLetters, DeadLetter{})
+			if err := m.DeadLetters[len(m.DeadLetters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1097,7 +2458,7 @@ func (m *QueryGetCallbackDataResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllCallbackDataRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryOrphanedCallbackDataRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1120,10 +2481,10 @@ func (m *QueryAllCallbackDataRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllCallbackDataRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryOrphanedCallbackDataRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllCallbackDataRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryOrphanedCallbackDataRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1183,7 +2544,7 @@ func (m *QueryAllCallbackDataRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllCallbackDataResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryOrphanedCallbackDataResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1206,10 +2567,10 @@ func (m *QueryAllCallbackDataResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllCallbackDataResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryOrphanedCallbackDataResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllCallbackDataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryOrphanedCallbackDataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -6,10 +6,17 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
 	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
 	math "math"
+	math_bits "math/bits"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -23,19 +30,124 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// Replays the callback of a dead-lettered packet
+type MsgRetryCallback struct {
+	Creator     string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	CallbackKey string `protobuf:"bytes,2,opt,name=callback_key,json=callbackKey,proto3" json:"callback_key,omitempty"`
+}
+
+func (m *MsgRetryCallback) Reset()         { *m = MsgRetryCallback{} }
+func (m *MsgRetryCallback) String() string { return proto.CompactTextString(m) }
+func (*MsgRetryCallback) ProtoMessage()    {}
+func (*MsgRetryCallback) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c4981fec5f7fee51, []int{0}
+}
+func (m *MsgRetryCallback) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRetryCallback) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRetryCallback.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRetryCallback) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRetryCallback.Merge(m, src)
+}
+func (m *MsgRetryCallback) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRetryCallback) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRetryCallback.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRetryCallback proto.InternalMessageInfo
+
+func (m *MsgRetryCallback) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *MsgRetryCallback) GetCallbackKey() string {
+	if m != nil {
+		return m.CallbackKey
+	}
+	return ""
+}
+
+type MsgRetryCallbackResponse struct {
+}
+
+func (m *MsgRetryCallbackResponse) Reset()         { *m = MsgRetryCallbackResponse{} }
+func (m *MsgRetryCallbackResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRetryCallbackResponse) ProtoMessage()    {}
+func (*MsgRetryCallbackResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c4981fec5f7fee51, []int{1}
+}
+func (m *MsgRetryCallbackResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRetryCallbackResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRetryCallbackResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRetryCallbackResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRetryCallbackResponse.Merge(m, src)
+}
+func (m *MsgRetryCallbackResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRetryCallbackResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRetryCallbackResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRetryCallbackResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*MsgRetryCallback)(nil), "stride.icacallbacks.MsgRetryCallback")
+	proto.RegisterType((*MsgRetryCallbackResponse)(nil), "stride.icacallbacks.MsgRetryCallbackResponse")
+}
+
 func init() { proto.RegisterFile("stride/icacallbacks/tx.proto", fileDescriptor_c4981fec5f7fee51) }
 
 var fileDescriptor_c4981fec5f7fee51 = []byte{
-	// 136 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x29, 0x2e, 0x29, 0xca,
-	0x4c, 0x49, 0xd5, 0xcf, 0x4c, 0x4e, 0x4c, 0x4e, 0xcc, 0xc9, 0x49, 0x4a, 0x4c, 0xce, 0x2e, 0xd6,
-	0x2f, 0xa9, 0xd0, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x86, 0xc8, 0xea, 0x21, 0xcb, 0x1a,
-	0xb1, 0x72, 0x31, 0xfb, 0x16, 0xa7, 0x3b, 0x05, 0x9c, 0x78, 0x24, 0xc7, 0x78, 0xe1, 0x91, 0x1c,
-	0xe3, 0x83, 0x47, 0x72, 0x8c, 0x13, 0x1e, 0xcb, 0x31, 0x5c, 0x78, 0x2c, 0xc7, 0x70, 0xe3, 0xb1,
-	0x1c, 0x43, 0x94, 0x59, 0x7a, 0x66, 0x49, 0x46, 0x69, 0x92, 0x5e, 0x72, 0x7e, 0xae, 0x7e, 0x30,
-	0xd8, 0x00, 0x5d, 0x9f, 0xc4, 0xa4, 0x62, 0x7d, 0xa8, 0x55, 0x65, 0x46, 0x26, 0xfa, 0x15, 0x68,
-	0x16, 0x56, 0x16, 0xa4, 0x16, 0x27, 0xb1, 0x81, 0x2d, 0x35, 0x06, 0x04, 0x00, 0x00, 0xff, 0xff,
-	0x84, 0xaa, 0x9d, 0xd2, 0x94, 0x00, 0x00, 0x00,
+	// 316 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x51, 0xbf, 0x4b, 0xc3, 0x40,
+	0x14, 0xee, 0x29, 0x28, 0x9e, 0x0a, 0x1a, 0x05, 0x63, 0xd0, 0x43, 0x0b, 0x82, 0x14, 0x7a, 0x87,
+	0x55, 0x1c, 0xdc, 0xac, 0xa3, 0x16, 0x24, 0xdd, 0x5c, 0xca, 0xe5, 0x7a, 0xc4, 0xd0, 0xa6, 0x57,
+	0xee, 0x9d, 0xa5, 0x59, 0x1d, 0x9d, 0x5c, 0xfd, 0x2f, 0x3a, 0xf8, 0x47, 0x38, 0x16, 0x27, 0x47,
+	0x49, 0x86, 0xfe, 0x1b, 0xd2, 0xfc, 0x00, 0x1b, 0x1c, 0x5c, 0x12, 0xde, 0xf7, 0x7d, 0xef, 0x7b,
+	0xdf, 0xbd, 0x87, 0x0f, 0xc0, 0xe8, 0xa0, 0x2b, 0x59, 0x20, 0xb8, 0xe0, 0xfd, 0xbe, 0xc7, 0x45,
+	0x0f, 0x98, 0x19, 0xd3, 0xa1, 0x56, 0x46, 0x59, 0x3b, 0x19, 0x4b, 0x7f, 0xb3, 0xce, 0xbe, 0x50,
+	0x10, 0x2a, 0xe8, 0xa4, 0x12, 0x96, 0x15, 0x99, 0xde, 0xd9, 0xcb, 0x2a, 0x16, 0x82, 0xcf, 0x46,
+	0x67, 0xf3, 0x5f, 0x4e, 0x6c, 0xf3, 0x30, 0x18, 0x28, 0x96, 0x7e, 0x33, 0xa8, 0xfa, 0x86, 0xf0,
+	0x56, 0x0b, 0x7c, 0x57, 0x1a, 0x1d, 0xdd, 0xe4, 0xe6, 0x56, 0x03, 0xaf, 0x0a, 0x2d, 0xb9, 0x51,
+	0xda, 0x46, 0x47, 0xe8, 0x74, 0xad, 0x69, 0x7f, 0xbe, 0xd7, 0x77, 0xf3, 0x19, 0xd7, 0xdd, 0xae,
+	0x96, 0x00, 0x6d, 0xa3, 0x83, 0x81, 0xef, 0x16, 0x42, 0xeb, 0x18, 0x6f, 0x14, 0xe1, 0x3a, 0x3d,
+	0x19, 0xd9, 0x4b, 0xf3, 0x46, 0x77, 0xbd, 0xc0, 0x6e, 0x65, 0x74, 0x45, 0x9f, 0x67, 0x93, 0x5a,
+	0xd1, 0xf0, 0x32, 0x9b, 0xd4, 0x0e, 0x17, 0xde, 0x5b, 0x8e, 0x51, 0x75, 0xb0, 0x5d, 0xc6, 0x5c,
+	0x09, 0x43, 0x35, 0x00, 0xd9, 0xe8, 0xe3, 0xe5, 0x16, 0xf8, 0x96, 0xc4, 0x9b, 0x8b, 0xd1, 0x4f,
+	0xe8, 0x1f, 0xcb, 0xa2, 0x65, 0x1b, 0xa7, 0xfe, 0x2f, 0x59, 0x31, 0xad, 0x79, 0xff, 0x11, 0x13,
+	0x34, 0x8d, 0x09, 0xfa, 0x8e, 0x09, 0x7a, 0x4d, 0x48, 0x65, 0x9a, 0x90, 0xca, 0x57, 0x42, 0x2a,
+	0x0f, 0x97, 0x7e, 0x60, 0x1e, 0x9f, 0x3c, 0x2a, 0x54, 0xc8, 0xda, 0xa9, 0x65, 0xfd, 0x8e, 0x7b,
+	0xc0, 0xf2, 0x83, 0x8e, 0x1a, 0x17, 0x6c, 0x5c, 0x3a, 0x6b, 0x34, 0x94, 0xe0, 0xad, 0xa4, 0xeb,
+	0x3f, 0xff, 0x19, 0x00, 0xbe, 0xe0, 0x6d, 0x5c, 0xfa, 0x01, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -50,6 +162,8 @@ const _ = grpc.SupportPackageIsVersion4
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
 type MsgClient interface {
+	// Replays a dead-lettered callback
+	RetryCallback(ctx context.Context, in *MsgRetryCallback, opts ...grpc.CallOption) (*MsgRetryCallbackResponse, error)
 }
 
 type msgClient struct {
@@ -60,22 +174,412 @@ func NewMsgClient(cc grpc1.ClientConn) MsgClient {
 	return &msgClient{cc}
 }
 
+func (c *msgClient) RetryCallback(ctx context.Context, in *MsgRetryCallback, opts ...grpc.CallOption) (*MsgRetryCallbackResponse, error) {
+	out := new(MsgRetryCallbackResponse)
+	err := c.cc.Invoke(ctx, "/stride.icacallbacks.Msg/RetryCallback", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
+	// Replays a dead-lettered callback
+	RetryCallback(context.Context, *MsgRetryCallback) (*MsgRetryCallbackResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
 type UnimplementedMsgServer struct {
 }
 
+func (*UnimplementedMsgServer) RetryCallback(ctx context.Context, req *MsgRetryCallback) (*MsgRetryCallbackResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RetryCallback not implemented")
+}
+
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
 }
 
+func _Msg_RetryCallback_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRetryCallback)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RetryCallback(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stride.icacallbacks.Msg/RetryCallback",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RetryCallback(ctx, req.(*MsgRetryCallback))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "stride.icacallbacks.Msg",
 	HandlerType: (*MsgServer)(nil),
-	Methods:     []grpc.MethodDesc{},
-	Streams:     []grpc.StreamDesc{},
-	Metadata:    "stride/icacallbacks/tx.proto",
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "RetryCallback",
+			Handler:    _Msg_RetryCallback_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "stride/icacallbacks/tx.proto",
+}
+
+func (m *MsgRetryCallback) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRetryCallback) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRetryCallback) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CallbackKey) > 0 {
+		i -= len(m.CallbackKey)
+		copy(dAtA[i:], m.CallbackKey)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.CallbackKey)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
+
+func (m *MsgRetryCallbackResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRetryCallbackResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRetryCallbackResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgRetryCallback) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.CallbackKey)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRetryCallbackResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgRetryCallback) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRetryCallback: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRetryCallback: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CallbackKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CallbackKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRetryCallbackResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRetryCallbackResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRetryCallbackResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipTx(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthTx
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupTx
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthTx
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthTx        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowTx          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupTx = fmt.Errorf("proto: unexpected end of group")
+)
@@ -36,6 +36,7 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdQueryOracle(),
 		GetCmdQueryOracles(),
 		GetCmdQueryMetrics(),
+		GetCmdQueryParams(),
 	)
 
 	return cmd
@@ -187,3 +188,37 @@ Examples:
 
 	return cmd
 }
+
+// GetCmdQueryParams implements a command to query the module parameters
+func GetCmdQueryParams() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "params",
+		Short: "Queries the module parameters",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Queries the module parameters
+Example:
+  $ %s query %s params
+`, version.AppName, types.ModuleName),
+		),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryParamsRequest{}
+			res, err := queryClient.Params(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
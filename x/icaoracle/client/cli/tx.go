@@ -27,6 +27,7 @@ func GetTxCmd() *cobra.Command {
 
 	cmd.AddCommand(
 		CmdAddOracle(),
+		CmdAddIBCOracle(),
 		CmdInstantiateOracle(),
 		CmdRestoreOracleICA(),
 	)
@@ -70,6 +71,42 @@ Example:
 	return cmd
 }
 
+// Adds a new oracle that receives metrics over an existing IBC oracle channel
+func CmdAddIBCOracle() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "add-ibc-oracle [channel-id]",
+		Short: "Adds an oracle that receives metric updates over an IBC channel",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Registers a new oracle that receives metric updates directly over an IBC channel.
+Must provide the ID of an open channel on the %[2]s port.
+
+Example:
+  $ %[1]s tx %[2]s add-ibc-oracle channel-10
+`, version.AppName, types.ModuleName),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			channelId := args[0]
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgAddIBCOracle(
+				clientCtx.GetFromAddress().String(),
+				channelId,
+			)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // Instantiates an oracle cosmwasm contract
 func CmdInstantiateOracle() *cobra.Command {
 	cmd := &cobra.Command{
@@ -0,0 +1,152 @@
+package icaoracle
+
+import (
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v7/modules/core/05-port/types"
+	host "github.com/cosmos/ibc-go/v7/modules/core/24-host"
+	ibcexported "github.com/cosmos/ibc-go/v7/modules/core/exported"
+
+	"github.com/Stride-Labs/stride/v24/x/icaoracle/keeper"
+	"github.com/Stride-Labs/stride/v24/x/icaoracle/types"
+)
+
+var _ porttypes.IBCModule = &IBCModule{}
+
+// IBCModule implements the sending side of an IBC oracle channel
+// Metric packets are sent directly to the counterparty module or contract,
+// and the result is returned in the packet acknowledgement
+// This is separate from the IBCMiddleware which handles the ICA oracle channels
+type IBCModule struct {
+	keeper keeper.Keeper
+}
+
+func NewIBCModule(k keeper.Keeper) IBCModule {
+	return IBCModule{
+		keeper: k,
+	}
+}
+
+// Validates the channel parameters and claims the channel capability
+// The channel must be unordered, but can connect to any counterparty port
+// that implements the receiving side of the oracle
+func (im IBCModule) OnChanOpenInit(
+	ctx sdk.Context,
+	order channeltypes.Order,
+	connectionHops []string,
+	portID string,
+	channelID string,
+	channelCap *capabilitytypes.Capability,
+	counterparty channeltypes.Counterparty,
+	version string,
+) (string, error) {
+	if order != channeltypes.UNORDERED {
+		return "", errorsmod.Wrapf(channeltypes.ErrInvalidChannelOrdering, "expected %s channel, got %s", channeltypes.UNORDERED, order)
+	}
+	if portID != types.PortID {
+		return "", errorsmod.Wrapf(types.ErrInvalidOracleChannel, "invalid port: %s, expected %s", portID, types.PortID)
+	}
+	if version != "" && version != types.Version {
+		return "", errorsmod.Wrapf(types.ErrInvalidOracleChannel, "invalid version: %s, expected %s", version, types.Version)
+	}
+
+	if err := im.keeper.ClaimCapability(ctx, channelCap, host.ChannelCapabilityPath(portID, channelID)); err != nil {
+		return "", err
+	}
+
+	return types.Version, nil
+}
+
+// OnChanOpenTry should not be executed since Stride only acts as the metric sender
+func (im IBCModule) OnChanOpenTry(
+	ctx sdk.Context,
+	order channeltypes.Order,
+	connectionHops []string,
+	portID,
+	channelID string,
+	chanCap *capabilitytypes.Capability,
+	counterparty channeltypes.Counterparty,
+	counterpartyVersion string,
+) (string, error) {
+	return "", errorsmod.Wrap(types.ErrInvalidOracleChannel, "channel handshake must be initiated by the oracle sender")
+}
+
+// Confirms the receiver agreed on the oracle version
+func (im IBCModule) OnChanOpenAck(
+	ctx sdk.Context,
+	portID,
+	channelID string,
+	counterpartyChannelID string,
+	counterpartyVersion string,
+) error {
+	if counterpartyVersion != types.Version {
+		return errorsmod.Wrapf(types.ErrInvalidOracleChannel, "invalid counterparty version: %s, expected %s", counterpartyVersion, types.Version)
+	}
+	return nil
+}
+
+// OnChanOpenConfirm should not be executed since Stride only acts as the metric sender
+func (im IBCModule) OnChanOpenConfirm(
+	ctx sdk.Context,
+	portID,
+	channelID string,
+) error {
+	return errorsmod.Wrap(types.ErrInvalidOracleChannel, "channel handshake must be initiated by the oracle sender")
+}
+
+// Oracle channels cannot be closed by users
+func (im IBCModule) OnChanCloseInit(
+	ctx sdk.Context,
+	portID,
+	channelID string,
+) error {
+	return errorsmod.Wrap(channeltypes.ErrInvalidChannel, "user cannot close channel")
+}
+
+// No custom logic is necessary in OnChanCloseConfirm since metrics will not be posted to
+// an oracle with a closed channel, and will be re-queued once the oracle is moved to a new channel
+func (im IBCModule) OnChanCloseConfirm(
+	ctx sdk.Context,
+	portID,
+	channelID string,
+) error {
+	return nil
+}
+
+// Stride does not receive metrics, so packets should never be received
+func (im IBCModule) OnRecvPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	relayer sdk.AccAddress,
+) ibcexported.Acknowledgement {
+	return channeltypes.NewErrorAcknowledgement(errorsmod.Wrap(types.ErrInvalidOraclePacket, "cannot receive packet on oracle sender"))
+}
+
+// Passes the acknowledgement to the update oracle callback
+func (im IBCModule) OnAcknowledgementPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	acknowledgement []byte,
+	relayer sdk.AccAddress,
+) error {
+	im.keeper.Logger(ctx).Info(fmt.Sprintf("OnAcknowledgementPacket (IBC Oracle) - sequence #%d, channel %s, relayer: %v",
+		packet.Sequence, packet.SourceChannel, relayer))
+
+	return im.keeper.OnOracleAcknowledgementPacket(ctx, packet, acknowledgement)
+}
+
+// Passes the timeout to the update oracle callback
+func (im IBCModule) OnTimeoutPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	relayer sdk.AccAddress,
+) error {
+	im.keeper.Logger(ctx).Info(fmt.Sprintf("OnTimeoutPacket (IBC Oracle) - sequence #%d, channel %s, relayer: %v",
+		packet.Sequence, packet.SourceChannel, relayer))
+
+	return im.keeper.OnOracleTimeoutPacket(ctx, packet)
+}
@@ -1,6 +1,8 @@
 package keeper
 
 import (
+	"fmt"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/Stride-Labs/stride/v24/x/icaoracle/types"
@@ -12,20 +14,31 @@ func (k Keeper) InitGenesis(ctx sdk.Context, genState types.GenesisState) {
 	if err := genState.Validate(); err != nil {
 		panic(err)
 	}
+	k.SetParams(ctx, genState.Params)
 	for _, oracle := range genState.Oracles {
 		k.SetOracle(ctx, oracle)
 	}
 	for _, metric := range genState.Metrics {
 		k.SetMetric(ctx, metric)
 	}
+	for _, metricTypeUpdate := range genState.MetricTypeUpdates {
+		k.SetMetricTypeUpdate(ctx, metricTypeUpdate)
+	}
+
+	// Bind the oracle port so that IBC oracle channels can be opened
+	if err := k.BindPort(ctx); err != nil {
+		panic(fmt.Sprintf("could not claim port capability: %v", err))
+	}
 }
 
 // ExportGenesis returns the capability module's exported genesis.
 func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 	genesis := types.DefaultGenesis()
 
+	genesis.Params = k.GetParams(ctx)
 	genesis.Oracles = k.GetAllOracles(ctx)
 	genesis.Metrics = k.GetAllMetrics(ctx)
+	genesis.MetricTypeUpdates = k.GetAllMetricTypeUpdates(ctx)
 
 	return genesis
 }
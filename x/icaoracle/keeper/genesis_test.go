@@ -15,10 +15,16 @@ func (s *KeeperTestSuite) TestGenesis() {
 		Status:            types.MetricStatus_QUEUED,
 	}
 
+	metricTypeUpdate := types.MetricTypeUpdate{
+		MetricType:     types.MetricType_TVL,
+		LastUpdateTime: int64(1),
+	}
+
 	genesisState := types.GenesisState{
-		Params:  types.Params{},
-		Oracles: []types.Oracle{oracle},
-		Metrics: []types.Metric{metric},
+		Params:            types.NewParams(5, types.DefaultMetricCadences),
+		Oracles:           []types.Oracle{oracle},
+		Metrics:           []types.Metric{metric},
+		MetricTypeUpdates: []types.MetricTypeUpdate{metricTypeUpdate},
 	}
 
 	s.App.ICAOracleKeeper.InitGenesis(s.Ctx, genesisState)
@@ -58,3 +58,9 @@ func (k Keeper) Metrics(c context.Context, req *types.QueryMetricsRequest) (*typ
 
 	return &types.QueryMetricsResponse{Metrics: metrics}, nil
 }
+
+// Query the module parameters
+func (k Keeper) Params(c context.Context, req *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	return &types.QueryParamsResponse{Params: k.GetParams(ctx)}, nil
+}
@@ -90,7 +90,7 @@ func (k Keeper) SubmitICATx(ctx sdk.Context, tx types.ICATx) error {
 		CallbackId:   tx.CallbackId,
 		CallbackArgs: callbackArgsBz,
 	}
-	k.ICACallbacksKeeper.SetCallbackData(ctx, callbackData)
+	k.ICACallbacksKeeper.AddCallbackData(ctx, callbackData)
 
 	return nil
 }
@@ -0,0 +1,141 @@
+package keeper
+
+import (
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
+	proto "github.com/cosmos/gogoproto/proto"
+	clienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+	host "github.com/cosmos/ibc-go/v7/modules/core/24-host"
+
+	"github.com/Stride-Labs/stride/v24/x/icacallbacks"
+	icacallbacktypes "github.com/Stride-Labs/stride/v24/x/icacallbacks/types"
+	"github.com/Stride-Labs/stride/v24/x/icaoracle/types"
+)
+
+// Checks if the oracle port has already been bound
+func (k Keeper) IsBound(ctx sdk.Context) bool {
+	_, ok := k.scopedKeeper.GetCapability(ctx, host.PortPath(types.PortID))
+	return ok
+}
+
+// Binds the oracle port and claims the capability
+// This must be called from InitGenesis, or from an upgrade handler on a live chain,
+// before an IBC oracle channel can be opened
+func (k Keeper) BindPort(ctx sdk.Context) error {
+	if k.IsBound(ctx) {
+		return nil
+	}
+	capability := k.PortKeeper.BindPort(ctx, types.PortID)
+	return k.ClaimCapability(ctx, capability, host.PortPath(types.PortID))
+}
+
+// Claims a capability from the IBC module (e.g. for the port or a channel)
+func (k Keeper) ClaimCapability(ctx sdk.Context, capability *capabilitytypes.Capability, name string) error {
+	return k.scopedKeeper.ClaimCapability(ctx, capability, name)
+}
+
+// Validates that a channel can be used to send metrics to an oracle and returns
+// the channel's connection ID
+func (k Keeper) ValidateIBCOracleChannel(ctx sdk.Context, channelId string) (connectionId string, err error) {
+	channel, found := k.ChannelKeeper.GetChannel(ctx, types.PortID, channelId)
+	if !found {
+		return "", errorsmod.Wrapf(types.ErrInvalidOracleChannel, "channel %s not found on port %s", channelId, types.PortID)
+	}
+	if channel.State != channeltypes.OPEN {
+		return "", errorsmod.Wrapf(types.ErrInvalidOracleChannel, "channel %s is not open", channelId)
+	}
+	if channel.Ordering != channeltypes.UNORDERED {
+		return "", errorsmod.Wrapf(types.ErrInvalidOracleChannel, "channel %s must be unordered", channelId)
+	}
+	if len(channel.ConnectionHops) == 0 {
+		return "", errorsmod.Wrapf(types.ErrInvalidOracleChannel, "channel %s has no connection", channelId)
+	}
+	return channel.ConnectionHops[0], nil
+}
+
+// Sends a single packet over the oracle's IBC channel with each of the metric updates,
+// and stores the callback data so that the metrics are resolved when the packet is acknowledged
+func (k Keeper) SubmitMetricPacket(ctx sdk.Context, oracle types.Oracle, metrics []types.Metric) error {
+	// Validate the channel is setup properly and oracle is active
+	if err := oracle.ValidateIBCSetup(); err != nil {
+		return err
+	}
+	if !oracle.Active {
+		return errorsmod.Wrapf(types.ErrOracleInactive, "oracle (%s) is inactive", oracle.ChainId)
+	}
+	if len(metrics) == 0 {
+		return errorsmod.Wrapf(types.ErrInvalidOraclePacket, "no metrics provided for oracle (%s)", oracle.ChainId)
+	}
+
+	channelCapability, found := k.scopedKeeper.GetCapability(ctx, host.ChannelCapabilityPath(types.PortID, oracle.ChannelId))
+	if !found {
+		return errorsmod.Wrapf(types.ErrInvalidOracleChannel, "capability not found for channel %s", oracle.ChannelId)
+	}
+
+	// Send the metric packet
+	packetData := types.NewOraclePacketData(metrics)
+	timeoutTimestamp := uint64(ctx.BlockTime().UnixNano() + MetricUpdateTimeout.Nanoseconds())
+	sequence, err := k.ICS4Wrapper.SendPacket(
+		ctx,
+		channelCapability,
+		types.PortID,
+		oracle.ChannelId,
+		clienttypes.ZeroHeight(),
+		timeoutTimestamp,
+		packetData.GetBytes(),
+	)
+	if err != nil {
+		return errorsmod.Wrapf(err, "unable to send oracle metric packet")
+	}
+
+	// Store the callback data
+	callbackArgs := types.UpdateOracleCallback{
+		OracleChainId: oracle.ChainId,
+		Metrics:       metrics,
+	}
+	callbackArgsBz, err := proto.Marshal(&callbackArgs)
+	if err != nil {
+		return errorsmod.Wrapf(err, "unable to marshal callback")
+	}
+	k.ICACallbacksKeeper.AddCallbackData(ctx, icacallbacktypes.CallbackData{
+		CallbackKey:  icacallbacktypes.PacketID(types.PortID, oracle.ChannelId, sequence),
+		PortId:       types.PortID,
+		ChannelId:    oracle.ChannelId,
+		Sequence:     sequence,
+		CallbackId:   ICACallbackID_UpdateOracle,
+		CallbackArgs: callbackArgsBz,
+	})
+
+	return nil
+}
+
+// Passes the acknowledgement from an oracle metric packet to the update oracle callback
+func (k Keeper) OnOracleAcknowledgementPacket(ctx sdk.Context, packet channeltypes.Packet, acknowledgement []byte) error {
+	packetDescription := fmt.Sprintf("Sequence %d, from %s %s, to %s %s",
+		packet.Sequence, packet.SourceChannel, packet.SourcePort, packet.DestinationChannel, packet.DestinationPort)
+
+	ackResponse, err := icacallbacks.UnpackAcknowledgementResponse(ctx, k.Logger(ctx), acknowledgement, false)
+	if err != nil {
+		return errorsmod.Wrapf(err, "unable to unpack oracle acknowledgement - %s", packetDescription)
+	}
+
+	if err := k.ICACallbacksKeeper.CallRegisteredICACallback(ctx, packet, ackResponse); err != nil {
+		return errorsmod.Wrapf(err, "unable to call registered callback for oracle OnAckPacket - %s", packetDescription)
+	}
+
+	return nil
+}
+
+// Passes the timeout from an oracle metric packet to the update oracle callback
+func (k Keeper) OnOracleTimeoutPacket(ctx sdk.Context, packet channeltypes.Packet) error {
+	ackResponse := icacallbacktypes.AcknowledgementResponse{Status: icacallbacktypes.AckResponseStatus_TIMEOUT}
+	if err := k.ICACallbacksKeeper.CallRegisteredICACallback(ctx, packet, &ackResponse); err != nil {
+		return errorsmod.Wrapf(err, "unable to call registered callback for oracle OnTimeoutPacket")
+	}
+
+	return nil
+}
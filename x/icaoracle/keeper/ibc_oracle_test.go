@@ -0,0 +1,289 @@
+package keeper_test
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/cosmos/gogoproto/proto"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+	host "github.com/cosmos/ibc-go/v7/modules/core/24-host"
+
+	"github.com/Stride-Labs/stride/v24/app/apptesting"
+	icacallbacktypes "github.com/Stride-Labs/stride/v24/x/icacallbacks/types"
+	"github.com/Stride-Labs/stride/v24/x/icaoracle/types"
+)
+
+const IBCOracleChannelId = "channel-10"
+
+// Mocks out an open IBC oracle channel on the transfer connection
+func (s *KeeperTestSuite) SetupIBCOracleChannel() (connectionId string) {
+	s.CreateTransferChannel(HostChainId)
+	connectionId = s.TransferPath.EndpointA.ConnectionID
+
+	channel := channeltypes.Channel{
+		State:          channeltypes.OPEN,
+		Ordering:       channeltypes.UNORDERED,
+		Counterparty:   channeltypes.NewCounterparty("wasm.oracle", "channel-0"),
+		ConnectionHops: []string{connectionId},
+		Version:        types.Version,
+	}
+	s.App.IBCKeeper.ChannelKeeper.SetChannel(s.Ctx, types.PortID, IBCOracleChannelId, channel)
+	s.App.IBCKeeper.ChannelKeeper.SetNextSequenceSend(s.Ctx, types.PortID, IBCOracleChannelId, 1)
+
+	// The channel capability is created by core IBC and claimed by the module during the handshake
+	capabilityName := host.ChannelCapabilityPath(types.PortID, IBCOracleChannelId)
+	channelCapability, err := s.App.ScopedIBCKeeper.NewCapability(s.Ctx, capabilityName)
+	s.Require().NoError(err, "no error expected when creating channel capability")
+	err = s.App.ICAOracleKeeper.ClaimCapability(s.Ctx, channelCapability, capabilityName)
+	s.Require().NoError(err, "no error expected when claiming channel capability")
+
+	return connectionId
+}
+
+// Mocks out an open IBC oracle channel and adds the oracle
+func (s *KeeperTestSuite) SetupIBCOracle() types.Oracle {
+	connectionId := s.SetupIBCOracleChannel()
+
+	oracle := types.Oracle{
+		ChainId:      HostChainId,
+		ConnectionId: connectionId,
+		ChannelId:    IBCOracleChannelId,
+		PortId:       types.PortID,
+		Active:       true,
+		Transport:    types.OracleTransport_IBC,
+	}
+	s.App.ICAOracleKeeper.SetOracle(s.Ctx, oracle)
+
+	return oracle
+}
+
+func (s *KeeperTestSuite) TestAddIBCOracle_Successful() {
+	connectionId := s.SetupIBCOracleChannel()
+	adminAddress, ok := apptesting.GetAdminAddress()
+	s.Require().True(ok)
+
+	_, err := s.GetMsgServer().AddIBCOracle(s.Ctx, types.NewMsgAddIBCOracle(adminAddress, IBCOracleChannelId))
+	s.Require().NoError(err, "no error expected when adding IBC oracle")
+
+	oracle, found := s.App.ICAOracleKeeper.GetOracle(s.Ctx, HostChainId)
+	s.Require().True(found, "oracle should have been created")
+	s.Require().Equal(types.Oracle{
+		ChainId:      HostChainId,
+		ConnectionId: connectionId,
+		ChannelId:    IBCOracleChannelId,
+		PortId:       types.PortID,
+		Active:       true,
+		Transport:    types.OracleTransport_IBC,
+	}, oracle, "oracle")
+}
+
+func (s *KeeperTestSuite) TestAddIBCOracle_InvalidChannel() {
+	s.SetupIBCOracleChannel()
+	adminAddress, ok := apptesting.GetAdminAddress()
+	s.Require().True(ok)
+
+	// Channel does not exist
+	_, err := s.GetMsgServer().AddIBCOracle(s.Ctx, types.NewMsgAddIBCOracle(adminAddress, "channel-99"))
+	s.Require().ErrorContains(err, "channel channel-99 not found")
+
+	// Channel is not open
+	s.UpdateChannelState(types.PortID, IBCOracleChannelId, channeltypes.CLOSED)
+	_, err = s.GetMsgServer().AddIBCOracle(s.Ctx, types.NewMsgAddIBCOracle(adminAddress, IBCOracleChannelId))
+	s.Require().ErrorContains(err, "is not open")
+}
+
+func (s *KeeperTestSuite) TestAddIBCOracle_OracleExists() {
+	oracle := s.SetupIBCOracle()
+	adminAddress, ok := apptesting.GetAdminAddress()
+	s.Require().True(ok)
+
+	// IBC oracle with an open channel
+	_, err := s.GetMsgServer().AddIBCOracle(s.Ctx, types.NewMsgAddIBCOracle(adminAddress, IBCOracleChannelId))
+	s.Require().ErrorIs(err, types.ErrOracleAlreadyExists)
+
+	// ICA oracle
+	icaOracle := oracle
+	icaOracle.Transport = types.OracleTransport_ICA
+	s.App.ICAOracleKeeper.SetOracle(s.Ctx, icaOracle)
+
+	_, err = s.GetMsgServer().AddIBCOracle(s.Ctx, types.NewMsgAddIBCOracle(adminAddress, IBCOracleChannelId))
+	s.Require().ErrorIs(err, types.ErrOracleTransportMismatch)
+}
+
+func (s *KeeperTestSuite) TestAddIBCOracle_ReplaceClosedChannel() {
+	oracle := s.SetupIBCOracle()
+	adminAddress, ok := apptesting.GetAdminAddress()
+	s.Require().True(ok)
+
+	// Point the oracle at a closed channel, with a metric that was in progress
+	oracle.ChannelId = "channel-9"
+	s.App.ICAOracleKeeper.SetOracle(s.Ctx, oracle)
+	s.App.IBCKeeper.ChannelKeeper.SetChannel(s.Ctx, types.PortID, "channel-9", channeltypes.Channel{State: channeltypes.CLOSED})
+
+	metric := types.Metric{Key: "key1", UpdateTime: 1, DestinationOracle: HostChainId, Status: types.MetricStatus_IN_PROGRESS}
+	s.App.ICAOracleKeeper.SetMetric(s.Ctx, metric)
+
+	// Move the oracle to the new channel
+	_, err := s.GetMsgServer().AddIBCOracle(s.Ctx, types.NewMsgAddIBCOracle(adminAddress, IBCOracleChannelId))
+	s.Require().NoError(err, "no error expected when replacing a closed channel")
+
+	updatedOracle, found := s.App.ICAOracleKeeper.GetOracle(s.Ctx, HostChainId)
+	s.Require().True(found, "oracle should have been found")
+	s.Require().Equal(IBCOracleChannelId, updatedOracle.ChannelId, "oracle channel")
+
+	updatedMetric, found := s.App.ICAOracleKeeper.GetMetric(s.Ctx, metric.GetMetricID())
+	s.Require().True(found, "metric should have been found")
+	s.Require().Equal(types.MetricStatus_QUEUED, updatedMetric.Status, "metric should have been re-queued")
+}
+
+func (s *KeeperTestSuite) TestSubmitMetricPacket_Successful() {
+	oracle := s.SetupIBCOracle()
+	metrics := []types.Metric{
+		{Key: "key1", Value: "value1", MetricType: "type", UpdateTime: 1, BlockHeight: 2, Attributes: "{}", DestinationOracle: HostChainId},
+		{Key: "key2", Value: "value2", MetricType: "type", UpdateTime: 1, BlockHeight: 2, Attributes: "{}", DestinationOracle: HostChainId},
+	}
+
+	err := s.App.ICAOracleKeeper.SubmitMetricUpdates(s.Ctx, oracle, metrics)
+	s.Require().NoError(err, "no error expected when submitting metric packet")
+
+	// Confirm the packet was sent
+	sequence := uint64(1)
+	commitment := s.App.IBCKeeper.ChannelKeeper.GetPacketCommitment(s.Ctx, types.PortID, IBCOracleChannelId, sequence)
+	s.Require().NotEmpty(commitment, "packet commitment should have been stored")
+
+	// Confirm the callback data was stored with each metric
+	callbackKey := icacallbacktypes.PacketID(types.PortID, IBCOracleChannelId, sequence)
+	callbackData, found := s.App.IcacallbacksKeeper.GetCallbackData(s.Ctx, callbackKey)
+	s.Require().True(found, "callback data should have been found")
+
+	var callbackArgs types.UpdateOracleCallback
+	err = proto.Unmarshal(callbackData.CallbackArgs, &callbackArgs)
+	s.Require().NoError(err, "no error expected when unmarshalling callback args")
+	s.Require().Equal(HostChainId, callbackArgs.OracleChainId, "callback oracle")
+	s.Require().Equal(metrics, callbackArgs.Metrics, "callback metrics")
+}
+
+func (s *KeeperTestSuite) TestSubmitMetricPacket_Failure() {
+	oracle := s.SetupIBCOracle()
+	metrics := []types.Metric{{Key: "key1", DestinationOracle: HostChainId}}
+
+	// No metrics
+	err := s.App.ICAOracleKeeper.SubmitMetricUpdates(s.Ctx, oracle, []types.Metric{})
+	s.Require().ErrorContains(err, "no metrics provided")
+
+	// Inactive oracle
+	inactiveOracle := oracle
+	inactiveOracle.Active = false
+	err = s.App.ICAOracleKeeper.SubmitMetricUpdates(s.Ctx, inactiveOracle, metrics)
+	s.Require().ErrorContains(err, "oracle is inactive")
+
+	// Wrong port
+	invalidOracle := oracle
+	invalidOracle.PortId = "port"
+	err = s.App.ICAOracleKeeper.SubmitMetricUpdates(s.Ctx, invalidOracle, metrics)
+	s.Require().ErrorContains(err, "portId must be icaoracle")
+
+	// Missing channel capability
+	invalidOracle = oracle
+	invalidOracle.ChannelId = "channel-99"
+	err = s.App.ICAOracleKeeper.SubmitMetricUpdates(s.Ctx, invalidOracle, metrics)
+	s.Require().ErrorContains(err, "capability not found for channel channel-99")
+}
+
+func (s *KeeperTestSuite) TestPostAllQueuedMetrics_IBCOracle() {
+	s.SetupIBCOracle()
+	for i := 1; i <= 3; i++ {
+		s.App.ICAOracleKeeper.SetMetric(s.Ctx, types.Metric{
+			Key:               fmt.Sprintf("key-%d", i),
+			DestinationOracle: HostChainId,
+			Status:            types.MetricStatus_QUEUED,
+		})
+	}
+
+	s.App.ICAOracleKeeper.PostAllQueuedMetrics(s.Ctx)
+
+	// Confirm a single packet was sent with all metrics
+	callbacks := s.App.IcacallbacksKeeper.GetAllCallbackData(s.Ctx)
+	s.Require().Len(callbacks, 1, "one callback submitted")
+	s.Require().Equal(types.PortID, callbacks[0].PortId, "callback port")
+
+	for _, metric := range s.App.ICAOracleKeeper.GetAllMetrics(s.Ctx) {
+		s.Require().Equal(types.MetricStatus_IN_PROGRESS, metric.Status, "metric %s status", metric.Key)
+	}
+}
+
+func (s *KeeperTestSuite) TestOracleMetricPacketData() {
+	metrics := []types.Metric{{Key: "key1", Value: "value1", MetricType: "type", UpdateTime: 1, BlockHeight: 2, Attributes: "{}"}}
+
+	// Confirm the packet data is JSON that can be parsed by non-Go receivers
+	packetDataBz := types.NewOraclePacketData(metrics).GetBytes()
+
+	var packetData map[string][]map[string]string
+	err := json.Unmarshal(packetDataBz, &packetData)
+	s.Require().NoError(err, "no error expected when parsing packet data")
+	s.Require().Equal([]map[string]string{{
+		"key":          "key1",
+		"value":        "value1",
+		"metric_type":  "type",
+		"update_time":  "1",
+		"block_height": "2",
+		"attributes":   "{}",
+	}}, packetData["metrics"], "packet metrics")
+}
+
+func (s *KeeperTestSuite) setupOracleAckTest() ([]types.Metric, channeltypes.Packet) {
+	oracle := s.SetupIBCOracle()
+	metrics := []types.Metric{
+		{Key: "key1", UpdateTime: 1, DestinationOracle: HostChainId, Status: types.MetricStatus_IN_PROGRESS},
+		{Key: "key2", UpdateTime: 1, DestinationOracle: HostChainId, Status: types.MetricStatus_IN_PROGRESS},
+	}
+	for _, metric := range metrics {
+		s.App.ICAOracleKeeper.SetMetric(s.Ctx, metric)
+	}
+
+	err := s.App.ICAOracleKeeper.SubmitMetricPacket(s.Ctx, oracle, metrics)
+	s.Require().NoError(err, "no error expected when submitting metric packet")
+
+	packet := channeltypes.Packet{Sequence: 1, SourcePort: types.PortID, SourceChannel: IBCOracleChannelId}
+	return metrics, packet
+}
+
+func (s *KeeperTestSuite) TestOnOracleAcknowledgementPacket_Success() {
+	metrics, packet := s.setupOracleAckTest()
+
+	ack := channeltypes.NewResultAcknowledgement([]byte{1}).Acknowledgement()
+	err := s.App.ICAOracleKeeper.OnOracleAcknowledgementPacket(s.Ctx, packet, ack)
+	s.Require().NoError(err, "no error expected when processing ack")
+
+	for _, metric := range metrics {
+		_, found := s.App.ICAOracleKeeper.GetMetric(s.Ctx, metric.GetMetricID())
+		s.Require().False(found, "metric %s should have been removed", metric.Key)
+	}
+}
+
+func (s *KeeperTestSuite) TestOnOracleAcknowledgementPacket_Failure() {
+	metrics, packet := s.setupOracleAckTest()
+
+	ack := channeltypes.NewErrorAcknowledgement(types.ErrInvalidOraclePacket).Acknowledgement()
+	err := s.App.ICAOracleKeeper.OnOracleAcknowledgementPacket(s.Ctx, packet, ack)
+	s.Require().NoError(err, "no error expected when processing ack")
+
+	for _, metric := range metrics {
+		_, found := s.App.ICAOracleKeeper.GetMetric(s.Ctx, metric.GetMetricID())
+		s.Require().False(found, "metric %s should have been removed", metric.Key)
+	}
+}
+
+func (s *KeeperTestSuite) TestOnOracleTimeoutPacket() {
+	metrics, packet := s.setupOracleAckTest()
+
+	err := s.App.ICAOracleKeeper.OnOracleTimeoutPacket(s.Ctx, packet)
+	s.Require().NoError(err, "no error expected when processing timeout")
+
+	// The channel remains open after a timeout, so the metrics should be re-queued
+	for _, metric := range metrics {
+		actualMetric, found := s.App.ICAOracleKeeper.GetMetric(s.Ctx, metric.GetMetricID())
+		s.Require().True(found, "metric %s should not have been removed", metric.Key)
+		s.Require().Equal(types.MetricStatus_QUEUED, actualMetric.Status, "metric %s status", metric.Key)
+	}
+}
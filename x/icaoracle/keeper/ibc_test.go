@@ -176,12 +176,15 @@ func (s *KeeperTestSuite) TestSubmitICATx_Success() {
 	callbackKey := icacallbacktypes.PacketID(icaTx.PortId, icaTx.ChannelId, sequence)
 
 	expectedCallbackData := icacallbacktypes.CallbackData{
-		CallbackKey:  callbackKey,
-		PortId:       icaTx.PortId,
-		ChannelId:    icaTx.ChannelId,
-		Sequence:     sequence,
-		CallbackId:   icaTx.CallbackId,
-		CallbackArgs: callbackBz,
+		CallbackKey:   callbackKey,
+		PortId:        icaTx.PortId,
+		ChannelId:     icaTx.ChannelId,
+		Sequence:      sequence,
+		CallbackId:    icaTx.CallbackId,
+		CallbackArgs:  callbackBz,
+		CreatedHeight: s.Ctx.BlockHeight(),
+		CreatedTime:   uint64(s.Ctx.BlockTime().UnixNano()),
+		ChannelState:  channeltypes.OPEN.String(),
 	}
 	actualCallbackData, found := s.App.IcacallbacksKeeper.GetCallbackData(s.Ctx, callbackKey)
 	s.Require().True(found, "callback data should have been found")
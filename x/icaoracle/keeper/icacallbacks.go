@@ -9,15 +9,21 @@ const (
 	ICACallbackID_UpdateOracle      = "update_oracle"
 )
 
+// Oracle callbacks only push metrics, so they are safe to replay against a stale ack
+// They are retried quickly since metrics are time sensitive
+var OracleCallbackRetryPolicy = icacallbackstypes.RetryPolicy{MaxAttempts: 3, BackoffBlocks: 10}
+
 func (k Keeper) Callbacks() icacallbackstypes.ModuleCallbacks {
 	return []icacallbackstypes.ICACallback{
 		{
 			CallbackId:   ICACallbackID_InstantiateOracle,
 			CallbackFunc: icacallbackstypes.ICACallbackFunction(k.InstantiateOracleCallback),
+			RetryPolicy:  &OracleCallbackRetryPolicy,
 		},
 		{
 			CallbackId:   ICACallbackID_UpdateOracle,
 			CallbackFunc: icacallbackstypes.ICACallbackFunction(k.UpdateOracleCallback),
+			RetryPolicy:  &OracleCallbackRetryPolicy,
 		},
 	}
 }
@@ -14,9 +14,10 @@ import (
 )
 
 // Callback after an update oracle ICA
+// The ICA may include multiple metric updates, all of which are resolved from the single ack
 //
-//	If successful/failure: the metric is removed from the pending store
-//	If timeout: metric is left in pending store so it can be re-submitted
+//	If successful/failure: each metric is removed from the pending store
+//	If timeout: each metric is left in pending store so it can be re-submitted
 func (k Keeper) UpdateOracleCallback(ctx sdk.Context, packet channeltypes.Packet, ackResponse *icacallbackstypes.AcknowledgementResponse, args []byte) error {
 	// Fetch callback args
 	updateOracleCallback := types.UpdateOracleCallback{}
@@ -24,32 +25,54 @@ func (k Keeper) UpdateOracleCallback(ctx sdk.Context, packet channeltypes.Packet
 		return errorsmod.Wrapf(err, "unable to unmarshal update oracle callback")
 	}
 	chainId := updateOracleCallback.OracleChainId
+	metrics := updateOracleCallback.GetCallbackMetrics()
 	k.Logger(ctx).Info(utils.LogICACallbackWithHostZone(chainId, ICACallbackID_UpdateOracle, "Starting update oracle callback"))
 
 	// If the ack timed-out, log the error and exit successfully
-	// The metric should remain in the pending store so that the ICA can be resubmitted when the channel is restored
+	// The metrics should remain in the pending store so that the ICA can be resubmitted when the channel is restored
+	// IBC oracle channels are unordered and remain open after a timeout, so those metrics are re-queued immediately
 	if ackResponse.Status == icacallbackstypes.AckResponseStatus_TIMEOUT {
-		EmitUpdateOracleAckEvent(ctx, updateOracleCallback.Metric, "timeout")
+		for i := range metrics {
+			EmitUpdateOracleAckEvent(ctx, &metrics[i], "timeout")
+		}
 		k.Logger(ctx).Error(utils.LogICACallbackStatusWithHostZone(chainId, ICACallbackID_UpdateOracle, ackResponse.Status, packet))
+
+		if packet.SourcePort == types.PortID {
+			for _, metric := range metrics {
+				if pendingMetric, found := k.GetMetric(ctx, metric.GetMetricID()); found {
+					k.UpdateMetricStatus(ctx, pendingMetric, types.MetricStatus_QUEUED)
+				}
+			}
+		}
 		return nil
 	}
 
 	// if the ack fails, log the response as an error, otherwise log the success as an info log
+	ackStatus := "success"
 	if ackResponse.Status == icacallbackstypes.AckResponseStatus_FAILURE {
-		EmitUpdateOracleAckEvent(ctx, updateOracleCallback.Metric, "failure")
+		ackStatus = "failure"
 		k.Logger(ctx).Error(utils.LogICACallbackStatusWithHostZone(chainId, ICACallbackID_UpdateOracle, ackResponse.Status, packet))
 	} else {
-		EmitUpdateOracleAckEvent(ctx, updateOracleCallback.Metric, "success")
 		k.Logger(ctx).Info(utils.LogICACallbackStatusWithHostZone(chainId, ICACallbackID_UpdateOracle, ackResponse.Status, packet))
 	}
+	for i := range metrics {
+		EmitUpdateOracleAckEvent(ctx, &metrics[i], ackStatus)
+	}
 
-	// Confirm the callback has a valid metric
-	if updateOracleCallback.Metric == nil || updateOracleCallback.Metric.Key == "" {
+	// Confirm the callback has valid metrics
+	if len(metrics) == 0 {
 		return errorsmod.Wrapf(types.ErrInvalidCallback, "metric is missing from callback: %+v", updateOracleCallback)
 	}
+	for _, metric := range metrics {
+		if metric.Key == "" {
+			return errorsmod.Wrapf(types.ErrInvalidCallback, "metric is missing from callback: %+v", updateOracleCallback)
+		}
+	}
 
-	// Remove the metric from the store (aka mark update as complete)
-	k.RemoveMetric(ctx, updateOracleCallback.Metric.GetMetricID())
+	// Remove each metric from the store (aka mark update as complete)
+	for _, metric := range metrics {
+		k.RemoveMetric(ctx, metric.GetMetricID())
+	}
 
 	return nil
 }
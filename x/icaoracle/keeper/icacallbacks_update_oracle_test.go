@@ -8,25 +8,25 @@ import (
 	"github.com/Stride-Labs/stride/v24/x/icaoracle/types"
 )
 
-func (s *KeeperTestSuite) SetupTestUpdateOracleCallback() types.Metric {
-	// Store an IN_PROGRESS metric
-	metric := types.Metric{
-		Key:               "key1",
-		UpdateTime:        1,
-		DestinationOracle: HostChainId,
-		Status:            types.MetricStatus_IN_PROGRESS,
+func (s *KeeperTestSuite) SetupTestUpdateOracleCallback() []types.Metric {
+	// Store IN_PROGRESS metrics
+	metrics := []types.Metric{
+		{Key: "key1", UpdateTime: 1, DestinationOracle: HostChainId, Status: types.MetricStatus_IN_PROGRESS},
+		{Key: "key2", UpdateTime: 1, DestinationOracle: HostChainId, Status: types.MetricStatus_IN_PROGRESS},
+	}
+	for _, metric := range metrics {
+		s.App.ICAOracleKeeper.SetMetric(s.Ctx, metric)
 	}
-	s.App.ICAOracleKeeper.SetMetric(s.Ctx, metric)
 
-	return metric
+	return metrics
 }
 
 func (s *KeeperTestSuite) CallCallbackAndCheckState(ackStatus icacallbacktypes.AckResponseStatus) {
-	metric := s.SetupTestUpdateOracleCallback()
+	metrics := s.SetupTestUpdateOracleCallback()
 
 	// Serialize callback
 	callback := types.UpdateOracleCallback{
-		Metric: &metric,
+		Metrics: metrics,
 	}
 	callbackBz, err := proto.Marshal(&callback)
 	s.Require().NoError(err, "no error expected when marshalling callback data")
@@ -38,10 +38,12 @@ func (s *KeeperTestSuite) CallCallbackAndCheckState(ackStatus icacallbacktypes.A
 	err = s.App.ICAOracleKeeper.UpdateOracleCallback(s.Ctx, channeltypes.Packet{}, &ackResponse, callbackBz)
 	s.Require().NoError(err, "no error expected during callback")
 
-	// Confirm the pending update was removed in the case of success/failure
+	// Confirm each pending update was removed in the case of success/failure
 	expectedFound := ackStatus == icacallbacktypes.AckResponseStatus_TIMEOUT
-	_, actualFound := s.App.ICAOracleKeeper.GetMetric(s.Ctx, metric.GetMetricID())
-	s.Require().Equal(expectedFound, actualFound, "metric found")
+	for _, metric := range metrics {
+		_, actualFound := s.App.ICAOracleKeeper.GetMetric(s.Ctx, metric.GetMetricID())
+		s.Require().Equal(expectedFound, actualFound, "metric %s found", metric.Key)
+	}
 }
 
 func (s *KeeperTestSuite) TestUpdateOracleCallback_AckSuccess() {
@@ -56,6 +58,29 @@ func (s *KeeperTestSuite) TestUpdateOracleCallback_AckFailure() {
 	s.CallCallbackAndCheckState(icacallbacktypes.AckResponseStatus_FAILURE)
 }
 
+func (s *KeeperTestSuite) TestUpdateOracleCallback_LegacySingleMetric() {
+	metric := s.SetupTestUpdateOracleCallback()[0]
+
+	// Serialize callback using the deprecated single metric field
+	callback := types.UpdateOracleCallback{
+		Metric: &metric,
+	}
+	callbackBz, err := proto.Marshal(&callback)
+	s.Require().NoError(err, "no error expected when marshalling callback data")
+
+	// Call update oracle callback
+	ackResponse := icacallbacktypes.AcknowledgementResponse{
+		Status: icacallbacktypes.AckResponseStatus_SUCCESS,
+	}
+	err = s.App.ICAOracleKeeper.UpdateOracleCallback(s.Ctx, channeltypes.Packet{}, &ackResponse, callbackBz)
+	s.Require().NoError(err, "no error expected during callback")
+
+	// Confirm only the included metric was removed
+	_, found := s.App.ICAOracleKeeper.GetMetric(s.Ctx, metric.GetMetricID())
+	s.Require().False(found, "included metric should have been removed")
+	s.Require().Len(s.App.ICAOracleKeeper.GetAllMetrics(s.Ctx), 1, "remaining metrics")
+}
+
 func (s *KeeperTestSuite) TestUpdateOracleCallback_UnmarshalFailure() {
 	dummyPacket := channeltypes.Packet{}
 	dummyAckResponse := icacallbacktypes.AcknowledgementResponse{}
@@ -87,4 +112,15 @@ func (s *KeeperTestSuite) TestUpdateOracleCallback_InvalidCallbackData() {
 	// Callback should fail again
 	err = s.App.ICAOracleKeeper.UpdateOracleCallback(s.Ctx, dummyPacket, &dummyAckResponse, invalidArgs)
 	s.Require().ErrorContains(err, "metric is missing from callback")
+
+	// Finally, create a batched callback where one of the metrics is missing a key
+	callback = types.UpdateOracleCallback{
+		Metrics: []types.Metric{{Key: "key1"}, {Value: "value2"}},
+	}
+	invalidArgs, err = proto.Marshal(&callback)
+	s.Require().NoError(err, "no error expected when marshalling callback data")
+
+	// Callback should fail again
+	err = s.App.ICAOracleKeeper.UpdateOracleCallback(s.Ctx, dummyPacket, &dummyAckResponse, invalidArgs)
+	s.Require().ErrorContains(err, "metric is missing from callback")
 }
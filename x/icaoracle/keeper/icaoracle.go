@@ -10,6 +10,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	proto "github.com/cosmos/gogoproto/proto"
 
+	"github.com/Stride-Labs/stride/v24/utils"
 	"github.com/Stride-Labs/stride/v24/x/icaoracle/types"
 )
 
@@ -30,6 +31,11 @@ func (k Keeper) QueueMetricUpdate(ctx sdk.Context, key, value, metricType, attri
 			continue
 		}
 
+		// Ignore any oracles that have not subscribed to this metric type
+		if !oracle.IsSubscribedTo(metricType) {
+			continue
+		}
+
 		metric.DestinationOracle = oracle.ChainId
 		k.SetMetric(ctx, metric)
 
@@ -37,8 +43,15 @@ func (k Keeper) QueueMetricUpdate(ctx sdk.Context, key, value, metricType, attri
 	}
 }
 
-// Submits an ICA to update the metric in the CW contract
-func (k Keeper) SubmitMetricUpdate(ctx sdk.Context, oracle types.Oracle, metric types.Metric) error {
+// Submits a single ICA to update each of the metrics in the CW contract
+// Each metric is posted with a separate contract execution message within the same tx
+// If the oracle uses the IBC transport, the metrics are instead sent in a single packet
+// over the oracle's IBC channel
+func (k Keeper) SubmitMetricUpdates(ctx sdk.Context, oracle types.Oracle, metrics []types.Metric) error {
+	if oracle.Transport == types.OracleTransport_IBC {
+		return k.SubmitMetricPacket(ctx, oracle, metrics)
+	}
+
 	// Validate ICA is setup properly, contract has been instantiated, and oracle is active
 	if err := oracle.ValidateICASetup(); err != nil {
 		return err
@@ -49,25 +62,30 @@ func (k Keeper) SubmitMetricUpdate(ctx sdk.Context, oracle types.Oracle, metric
 	if !oracle.Active {
 		return errorsmod.Wrapf(types.ErrOracleInactive, "oracle (%s) is inactive", oracle.ChainId)
 	}
-
-	// Build contract message with metric update
-	contractMsg := types.NewMsgExecuteContractPostMetric(metric)
-	contractMsgBz, err := json.Marshal(contractMsg)
-	if err != nil {
-		return errorsmod.Wrapf(err, "unable to marshal execute contract post metric")
+	if len(metrics) == 0 {
+		return errorsmod.Wrapf(types.ErrInvalidICARequest, "no metrics provided for oracle (%s)", oracle.ChainId)
 	}
 
-	// Build ICA message to execute the CW contract
-	msgs := []proto.Message{&wasmtypes.MsgExecuteContract{
-		Sender:   oracle.IcaAddress,
-		Contract: oracle.ContractAddress,
-		Msg:      contractMsgBz,
-	}}
+	// Build an ICA message to execute the CW contract for each metric update
+	msgs := []proto.Message{}
+	for _, metric := range metrics {
+		contractMsg := types.NewMsgExecuteContractPostMetric(metric)
+		contractMsgBz, err := json.Marshal(contractMsg)
+		if err != nil {
+			return errorsmod.Wrapf(err, "unable to marshal execute contract post metric")
+		}
+
+		msgs = append(msgs, &wasmtypes.MsgExecuteContract{
+			Sender:   oracle.IcaAddress,
+			Contract: oracle.ContractAddress,
+			Msg:      contractMsgBz,
+		})
+	}
 
 	// Submit the ICA to execute the contract
 	callbackArgs := types.UpdateOracleCallback{
 		OracleChainId: oracle.ChainId,
-		Metric:        &metric,
+		Metrics:       metrics,
 	}
 	icaTx := types.ICATx{
 		ConnectionId:    oracle.ConnectionId,
@@ -86,35 +104,61 @@ func (k Keeper) SubmitMetricUpdate(ctx sdk.Context, oracle types.Oracle, metric
 	return nil
 }
 
-// For each queued metric, submit an ICA to each oracle, and then flag the metric as IN_PROGRESS
+// Groups all queued metrics by their destination oracle, then, for each oracle, flags the metrics
+// as IN_PROGRESS and submits the updates in batched ICAs (of at most MaxMetricsPerTx metrics each)
 func (k Keeper) PostAllQueuedMetrics(ctx sdk.Context) {
+	maxMetricsPerTx := int(k.GetParams(ctx).MaxMetricsPerTx)
+	if maxMetricsPerTx == 0 {
+		maxMetricsPerTx = int(types.DefaultMaxMetricsPerTx)
+	}
+
+	// Group metrics by oracle, preserving the queue ordering
+	oracleChainIds := []string{}
+	metricsByOracle := map[string][]types.Metric{}
 	for _, metric := range k.GetAllQueuedMetrics(ctx) {
-		k.Logger(ctx).Info(fmt.Sprintf("Submitting oracle metric update - Metric: %s, Oracle: %s", metric.Key, metric.DestinationOracle))
+		if _, ok := metricsByOracle[metric.DestinationOracle]; !ok {
+			oracleChainIds = append(oracleChainIds, metric.DestinationOracle)
+		}
+		metricsByOracle[metric.DestinationOracle] = append(metricsByOracle[metric.DestinationOracle], metric)
+	}
+
+	for _, oracleChainId := range oracleChainIds {
+		metrics := metricsByOracle[oracleChainId]
+		k.Logger(ctx).Info(fmt.Sprintf("Submitting %d oracle metric updates - Oracle: %s", len(metrics), oracleChainId))
 
 		// Ignore any inactive oracles
-		oracle, found := k.GetOracle(ctx, metric.DestinationOracle)
+		oracle, found := k.GetOracle(ctx, oracleChainId)
 		if !found || !oracle.Active {
-			k.Logger(ctx).Info(fmt.Sprintf("Oracle %s is inactive", oracle.ChainId))
+			k.Logger(ctx).Info(fmt.Sprintf("Oracle %s is inactive", oracleChainId))
 			continue
 		}
 
-		// Flag the metric as IN_PROGRESS to prevent resubmissions next block
+		// Flag the metrics as IN_PROGRESS to prevent resubmissions next block
 		// We do this even in the case where the ICA submission fails (from something like a channel closure)
-		// If the channel closes, once it is restored, the metric will get re-queued
-		k.UpdateMetricStatus(ctx, metric, types.MetricStatus_IN_PROGRESS)
+		// If the channel closes, once it is restored, the metrics will get re-queued
+		for _, metric := range metrics {
+			k.UpdateMetricStatus(ctx, metric, types.MetricStatus_IN_PROGRESS)
+		}
 
 		if !k.IsOracleICAChannelOpen(ctx, oracle) {
-			k.Logger(ctx).Error(fmt.Sprintf("Oracle %s has a closed ICA channel (%s)", oracle.ChainId, oracle.ChannelId))
+			k.Logger(ctx).Error(fmt.Sprintf("Oracle %s has a closed channel (%s)", oracle.ChainId, oracle.ChannelId))
 			continue
 		}
 
-		// Submit the ICA for each metric
-		if err := k.SubmitMetricUpdate(ctx, oracle, metric); err != nil {
-			k.Logger(ctx).Error(fmt.Sprintf("Failed to submit a metric update ICA - Metric: %+v, Oracle: %+v, %s", metric, oracle, err.Error()))
-			continue
-		}
+		// Submit an ICA (or IBC packet) for each batch of metrics
+		for start := 0; start < len(metrics); start += maxMetricsPerTx {
+			end := utils.Min(start+maxMetricsPerTx, len(metrics))
+			batch := metrics[start:end]
 
-		k.Logger(ctx).Info(fmt.Sprintf("Submitted metric update ICA - Metric: %s, Oracle: %s, Time: %d", metric.Key, oracle.ChainId, metric.UpdateTime))
-		EmitUpdateOracleEvent(ctx, metric)
+			if err := k.SubmitMetricUpdates(ctx, oracle, batch); err != nil {
+				k.Logger(ctx).Error(fmt.Sprintf("Failed to submit a metric update - Metrics: %+v, Oracle: %+v, %s", batch, oracle, err.Error()))
+				continue
+			}
+
+			for _, metric := range batch {
+				k.Logger(ctx).Info(fmt.Sprintf("Submitted metric update - Metric: %s, Oracle: %s, Time: %d", metric.Key, oracle.ChainId, metric.UpdateTime))
+				EmitUpdateOracleEvent(ctx, metric)
+			}
+		}
 	}
 }
@@ -1,6 +1,8 @@
 package keeper_test
 
 import (
+	"fmt"
+
 	"github.com/cosmos/gogoproto/proto"
 	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
 	ibctesting "github.com/cosmos/ibc-go/v7/testing"
@@ -44,7 +46,7 @@ func (s *KeeperTestSuite) SetupTestSubmitMetricUpdate() SubmitMetricUpdateTestCa
 	callbackId := keeper.ICACallbackID_UpdateOracle
 	callback := types.UpdateOracleCallback{
 		OracleChainId: HostChainId,
-		Metric:        &metric,
+		Metrics:       []types.Metric{metric},
 	}
 	callbackBz, err := proto.Marshal(&callback)
 	s.Require().NoError(err, "no error expected when serializing callback args")
@@ -61,7 +63,7 @@ func (s *KeeperTestSuite) TestSubmitMetricUpdate_Success() {
 	tc := s.SetupTestSubmitMetricUpdate()
 
 	// Call submit metric update (which should trigger an ICA)
-	err := s.App.ICAOracleKeeper.SubmitMetricUpdate(s.Ctx, tc.Oracle, tc.Metric)
+	err := s.App.ICAOracleKeeper.SubmitMetricUpdates(s.Ctx, tc.Oracle, []types.Metric{tc.Metric})
 	s.Require().NoError(err, "no error expected when submitting metric update")
 
 	// Confirm callback data has been stored
@@ -69,12 +71,15 @@ func (s *KeeperTestSuite) TestSubmitMetricUpdate_Success() {
 	callbackKey := icacallbacktypes.PacketID(tc.Oracle.PortId, tc.Oracle.ChannelId, sequence)
 
 	expectedCallbackData := icacallbacktypes.CallbackData{
-		CallbackKey:  callbackKey,
-		PortId:       tc.Oracle.PortId,
-		ChannelId:    tc.Oracle.ChannelId,
-		Sequence:     sequence,
-		CallbackId:   tc.CallbackId,
-		CallbackArgs: tc.CallbackArgs,
+		CallbackKey:   callbackKey,
+		PortId:        tc.Oracle.PortId,
+		ChannelId:     tc.Oracle.ChannelId,
+		Sequence:      sequence,
+		CallbackId:    tc.CallbackId,
+		CallbackArgs:  tc.CallbackArgs,
+		CreatedHeight: s.Ctx.BlockHeight(),
+		CreatedTime:   uint64(s.Ctx.BlockTime().UnixNano()),
+		ChannelState:  channeltypes.OPEN.String(),
 	}
 	actualCallbackData, found := s.App.IcacallbacksKeeper.GetCallbackData(s.Ctx, callbackKey)
 	s.Require().True(found, "callback data should have been found")
@@ -89,7 +94,7 @@ func (s *KeeperTestSuite) TestSubmitMetricUpdate_IcaNotRegistered() {
 	oracle.IcaAddress = ""
 
 	// Submit the metric update which should fail because the ICA is not setup
-	err := s.App.ICAOracleKeeper.SubmitMetricUpdate(s.Ctx, oracle, tc.Metric)
+	err := s.App.ICAOracleKeeper.SubmitMetricUpdates(s.Ctx, oracle, []types.Metric{tc.Metric})
 	s.Require().ErrorContains(err, "ICAAddress is empty: oracle ICA channel has not been registered")
 }
 
@@ -101,7 +106,7 @@ func (s *KeeperTestSuite) TestSubmitMetricUpdate_ContractNotInstantiated() {
 	oracle.ContractAddress = ""
 
 	// Submit the metric update which should fail because the contract is not instantiated
-	err := s.App.ICAOracleKeeper.SubmitMetricUpdate(s.Ctx, oracle, tc.Metric)
+	err := s.App.ICAOracleKeeper.SubmitMetricUpdates(s.Ctx, oracle, []types.Metric{tc.Metric})
 	s.Require().ErrorContains(err, "contract address is empty: oracle not instantiated")
 }
 
@@ -113,7 +118,7 @@ func (s *KeeperTestSuite) TestSubmitMetricUpdate_OracleInactive() {
 	oracle.Active = false
 
 	// Submit the metric update which should fail because the oracle is not active
-	err := s.App.ICAOracleKeeper.SubmitMetricUpdate(s.Ctx, oracle, tc.Metric)
+	err := s.App.ICAOracleKeeper.SubmitMetricUpdates(s.Ctx, oracle, []types.Metric{tc.Metric})
 	s.Require().ErrorContains(err, "oracle is inactive")
 }
 
@@ -124,10 +129,18 @@ func (s *KeeperTestSuite) TestSubmitMetricUpdate_FailedToSubmitICA() {
 	s.UpdateChannelState(tc.Oracle.PortId, tc.Oracle.ChannelId, channeltypes.CLOSED)
 
 	// Submit the metric update which should fail
-	err := s.App.ICAOracleKeeper.SubmitMetricUpdate(s.Ctx, tc.Oracle, tc.Metric)
+	err := s.App.ICAOracleKeeper.SubmitMetricUpdates(s.Ctx, tc.Oracle, []types.Metric{tc.Metric})
 	s.Require().ErrorContains(err, "unable to submit update oracle contract ICA: unable to send ICA tx")
 }
 
+func (s *KeeperTestSuite) TestSubmitMetricUpdate_NoMetrics() {
+	tc := s.SetupTestSubmitMetricUpdate()
+
+	// Submit the metric update without any metrics which should fail
+	err := s.App.ICAOracleKeeper.SubmitMetricUpdates(s.Ctx, tc.Oracle, []types.Metric{})
+	s.Require().ErrorContains(err, "no metrics provided for oracle")
+}
+
 func (s *KeeperTestSuite) TestPostAllQueuedMetrics() {
 	s.SetupTestSubmitMetricUpdate()
 
@@ -157,7 +170,59 @@ func (s *KeeperTestSuite) TestPostAllQueuedMetrics() {
 	// Post all metrics
 	s.App.ICAOracleKeeper.PostAllQueuedMetrics(s.Ctx)
 
-	// Check 3 ICAs were submitted
+	// Check a single ICA was submitted with all 3 metrics
+	callbacks := s.App.IcacallbacksKeeper.GetAllCallbackData(s.Ctx)
+	s.Require().Len(callbacks, 1, "one callback submitted")
+
+	var callbackArgs types.UpdateOracleCallback
+	err := proto.Unmarshal(callbacks[0].CallbackArgs, &callbackArgs)
+	s.Require().NoError(err, "no error expected when unmarshalling callback args")
+	s.Require().Equal(HostChainId, callbackArgs.OracleChainId, "callback oracle")
+	s.Require().Len(callbackArgs.Metrics, 3, "number of metrics in callback")
+	for i, metric := range callbackArgs.Metrics {
+		s.Require().Equal(metrics[i].Key, metric.Key, "metric %d key", i)
+	}
+
+	// Check the sent metrics were flagged as IN_PROGRESS and the others were left alone
+	for _, metric := range metrics[:3] {
+		actualMetric, found := s.App.ICAOracleKeeper.GetMetric(s.Ctx, metric.GetMetricID())
+		s.Require().True(found, "metric %s should have been found", metric.Key)
+		s.Require().Equal(types.MetricStatus_IN_PROGRESS, actualMetric.Status, "metric %s status", metric.Key)
+	}
+	for _, metric := range metrics[4:] {
+		actualMetric, found := s.App.ICAOracleKeeper.GetMetric(s.Ctx, metric.GetMetricID())
+		s.Require().True(found, "metric %s should have been found", metric.Key)
+		s.Require().Equal(types.MetricStatus_QUEUED, actualMetric.Status, "metric %s status", metric.Key)
+	}
+}
+
+func (s *KeeperTestSuite) TestPostAllQueuedMetrics_MaxMetricsPerTx() {
+	s.SetupTestSubmitMetricUpdate()
+	s.App.ICAOracleKeeper.SetParams(s.Ctx, types.NewParams(2, types.DefaultMetricCadences))
+
+	// Queue 5 metrics for the same oracle
+	for i := 1; i <= 5; i++ {
+		s.App.ICAOracleKeeper.SetMetric(s.Ctx, types.Metric{
+			Key:               fmt.Sprintf("key-%d", i),
+			Value:             fmt.Sprintf("value-%d", i),
+			DestinationOracle: HostChainId,
+			Status:            types.MetricStatus_QUEUED,
+		})
+	}
+
+	// Post all metrics
+	s.App.ICAOracleKeeper.PostAllQueuedMetrics(s.Ctx)
+
+	// Check the metrics were split across 3 ICAs (2 + 2 + 1)
 	callbacks := s.App.IcacallbacksKeeper.GetAllCallbackData(s.Ctx)
 	s.Require().Len(callbacks, 3, "three callbacks submitted")
+
+	numMetrics := []int{}
+	for _, callback := range callbacks {
+		var callbackArgs types.UpdateOracleCallback
+		err := proto.Unmarshal(callback.CallbackArgs, &callbackArgs)
+		s.Require().NoError(err, "no error expected when unmarshalling callback args")
+		numMetrics = append(numMetrics, len(callbackArgs.Metrics))
+	}
+	s.Require().ElementsMatch([]int{2, 2, 1}, numMetrics, "metrics per callback")
 }
@@ -7,6 +7,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	capabilitykeeper "github.com/cosmos/cosmos-sdk/x/capability/keeper"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	icacontrollerkeeper "github.com/cosmos/ibc-go/v7/modules/apps/27-interchain-accounts/controller/keeper"
 
@@ -14,17 +15,23 @@ import (
 )
 
 type Keeper struct {
-	cdc        codec.BinaryCodec
-	storeKey   storetypes.StoreKey
-	paramstore paramtypes.Subspace
-	authority  string
+	cdc          codec.BinaryCodec
+	storeKey     storetypes.StoreKey
+	paramstore   paramtypes.Subspace
+	authority    string
+	scopedKeeper capabilitykeeper.ScopedKeeper
 
 	ICS4Wrapper         types.ICS4Wrapper
 	ClientKeeper        types.ClientKeeper
 	ConnectionKeeper    types.ConnectionKeeper
 	ChannelKeeper       types.ChannelKeeper
+	PortKeeper          types.PortKeeper
 	ICAControllerKeeper icacontrollerkeeper.Keeper
 	ICACallbacksKeeper  types.ICACallbacksKeeper
+
+	// Registry of metric producers, keyed by metric type
+	// Since this is a map, it is shared across copies of the keeper
+	metricProducers map[string][]types.MetricProducerFunc
 }
 
 func NewKeeper(
@@ -32,26 +39,32 @@ func NewKeeper(
 	key storetypes.StoreKey,
 	paramstore paramtypes.Subspace,
 	authority string,
+	scopedKeeper capabilitykeeper.ScopedKeeper,
 
 	ics4Wrapper types.ICS4Wrapper,
 	clientKeeper types.ClientKeeper,
 	connectionKeeper types.ConnectionKeeper,
 	channelKeeper types.ChannelKeeper,
+	portKeeper types.PortKeeper,
 	icaControllerKeeper icacontrollerkeeper.Keeper,
 	icaCallbacksKeeper types.ICACallbacksKeeper,
 ) *Keeper {
 	return &Keeper{
-		cdc:        cdc,
-		storeKey:   key,
-		paramstore: paramstore,
-		authority:  authority,
+		cdc:          cdc,
+		storeKey:     key,
+		paramstore:   paramstore,
+		authority:    authority,
+		scopedKeeper: scopedKeeper,
 
 		ICS4Wrapper:         ics4Wrapper,
 		ClientKeeper:        clientKeeper,
 		ConnectionKeeper:    connectionKeeper,
 		ChannelKeeper:       channelKeeper,
+		PortKeeper:          portKeeper,
 		ICAControllerKeeper: icaControllerKeeper,
 		ICACallbacksKeeper:  icaCallbacksKeeper,
+
+		metricProducers: map[string][]types.MetricProducerFunc{},
 	}
 }
 
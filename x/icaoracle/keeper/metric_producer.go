@@ -0,0 +1,126 @@
+package keeper
+
+import (
+	"fmt"
+	"sort"
+
+	errorsmod "cosmossdk.io/errors"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Stride-Labs/stride/v24/utils"
+	"github.com/Stride-Labs/stride/v24/x/icaoracle/types"
+)
+
+// Registers metric producers from other modules
+// Multiple modules can register a producer for the same metric type (e.g. the TVL of
+// stakeibc, staketia and stakedym stTokens), in which case they're run together at
+// the metric type's cadence
+func (k Keeper) RegisterMetricProducers(producers ...types.MetricProducer) error {
+	for _, producer := range producers {
+		if producer.MetricType == "" {
+			return errorsmod.Wrapf(types.ErrInvalidMetricProducer, "metric producer must have a metric type")
+		}
+		if producer.ProduceFunc == nil {
+			return errorsmod.Wrapf(types.ErrInvalidMetricProducer, "metric producer for %s must have a produce function", producer.MetricType)
+		}
+		k.metricProducers[producer.MetricType] = append(k.metricProducers[producer.MetricType], producer.ProduceFunc)
+	}
+	return nil
+}
+
+// Returns the sorted list of metric types that have a registered producer
+func (k Keeper) GetRegisteredMetricTypes() []string {
+	metricTypes := make([]string, 0, len(k.metricProducers))
+	for metricType := range k.metricProducers {
+		metricTypes = append(metricTypes, metricType)
+	}
+	sort.Strings(metricTypes)
+	return metricTypes
+}
+
+// Stores the last time that a metric type was produced
+func (k Keeper) SetMetricTypeUpdate(ctx sdk.Context, metricTypeUpdate types.MetricTypeUpdate) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.LastMetricUpdateKeyPrefix)
+
+	key := types.KeyPrefix(metricTypeUpdate.MetricType)
+	value := k.cdc.MustMarshal(&metricTypeUpdate)
+
+	store.Set(key, value)
+}
+
+// Gets the last time that a metric type was produced
+func (k Keeper) GetMetricTypeUpdate(ctx sdk.Context, metricType string) (metricTypeUpdate types.MetricTypeUpdate, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.LastMetricUpdateKeyPrefix)
+
+	bz := store.Get(types.KeyPrefix(metricType))
+	if len(bz) == 0 {
+		return metricTypeUpdate, false
+	}
+
+	k.cdc.MustUnmarshal(bz, &metricTypeUpdate)
+	return metricTypeUpdate, true
+}
+
+// Returns the last update time of each metric type
+func (k Keeper) GetAllMetricTypeUpdates(ctx sdk.Context) (metricTypeUpdates []types.MetricTypeUpdate) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.LastMetricUpdateKeyPrefix)
+
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		metricTypeUpdate := types.MetricTypeUpdate{}
+		k.cdc.MustUnmarshal(iterator.Value(), &metricTypeUpdate)
+		metricTypeUpdates = append(metricTypeUpdates, metricTypeUpdate)
+	}
+
+	return metricTypeUpdates
+}
+
+// Checks whether the cadence for a metric type has elapsed since it was last produced
+func (k Keeper) IsMetricTypeDue(ctx sdk.Context, metricType string) bool {
+	metricTypeUpdate, found := k.GetMetricTypeUpdate(ctx, metricType)
+	if !found {
+		return true
+	}
+
+	cadence := k.GetParams(ctx).GetMetricCadenceSeconds(metricType)
+	nextUpdateTime := metricTypeUpdate.LastUpdateTime + int64(cadence)
+	return ctx.BlockTime().Unix() >= nextUpdateTime
+}
+
+// Runs each registered metric producer whose cadence has elapsed, and queues the
+// resulting metrics to each subscribed oracle
+// A failed producer is logged and skipped until its next scheduled update, so that
+// it does not block the other producers
+func (k Keeper) ProduceScheduledMetrics(ctx sdk.Context) {
+	for _, metricType := range k.GetRegisteredMetricTypes() {
+		if !k.IsMetricTypeDue(ctx, metricType) {
+			continue
+		}
+
+		k.SetMetricTypeUpdate(ctx, types.MetricTypeUpdate{
+			MetricType:     metricType,
+			LastUpdateTime: ctx.BlockTime().Unix(),
+		})
+
+		// Each producer is run in a cached context so that a failed producer has no side effects
+		// and does not block the other producers of the same metric type
+		for _, produceFunc := range k.metricProducers[metricType] {
+			var metricValues []types.MetricValue
+			err := utils.ApplyFuncIfNoError(ctx, func(ctx sdk.Context) (err error) {
+				metricValues, err = produceFunc(ctx)
+				return err
+			})
+			if err != nil {
+				k.Logger(ctx).Error(fmt.Sprintf("Unable to produce oracle metric %s: %s", metricType, err.Error()))
+				continue
+			}
+
+			for _, metricValue := range metricValues {
+				k.QueueMetricUpdate(ctx, metricValue.Key, metricValue.Value, metricType, metricValue.Attributes)
+			}
+		}
+	}
+}
@@ -0,0 +1,188 @@
+package keeper_test
+
+import (
+	"errors"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Stride-Labs/stride/v24/x/icaoracle/types"
+)
+
+func (s *KeeperTestSuite) TestRegisterMetricProducers() {
+	produceFunc := func(ctx sdk.Context) ([]types.MetricValue, error) { return nil, nil }
+
+	// Register a new metric type
+	err := s.App.ICAOracleKeeper.RegisterMetricProducers(types.MetricProducer{MetricType: "metric-1", ProduceFunc: produceFunc})
+	s.Require().NoError(err, "no error expected when registering a new metric type")
+	s.Require().Contains(s.App.ICAOracleKeeper.GetRegisteredMetricTypes(), "metric-1")
+
+	// Register a second producer for the same metric type (e.g. from a different module)
+	// The metric type should only be listed once
+	err = s.App.ICAOracleKeeper.RegisterMetricProducers(types.MetricProducer{MetricType: "metric-1", ProduceFunc: produceFunc})
+	s.Require().NoError(err, "no error expected when registering a second producer for a metric type")
+
+	numMetric1 := 0
+	for _, metricType := range s.App.ICAOracleKeeper.GetRegisteredMetricTypes() {
+		if metricType == "metric-1" {
+			numMetric1++
+		}
+	}
+	s.Require().Equal(1, numMetric1, "metric-1 should be registered once")
+
+	// Attempt to register a producer without a metric type or function
+	err = s.App.ICAOracleKeeper.RegisterMetricProducers(types.MetricProducer{MetricType: "", ProduceFunc: produceFunc})
+	s.Require().ErrorContains(err, "metric producer must have a metric type")
+
+	err = s.App.ICAOracleKeeper.RegisterMetricProducers(types.MetricProducer{MetricType: "metric-2"})
+	s.Require().ErrorContains(err, "metric producer for metric-2 must have a produce function")
+}
+
+func (s *KeeperTestSuite) TestProduceScheduledMetrics() {
+	oracles := s.CreateTestOracles()
+	startTime := time.Unix(1_000_000, 0)
+	s.Ctx = s.Ctx.WithBlockTime(startTime)
+
+	// Subscribe one oracle to a different metric type and deactivate another
+	oracles[0].MetricSubscriptions = []string{"other-metric"}
+	s.App.ICAOracleKeeper.SetOracle(s.Ctx, oracles[0])
+	oracles[1].Active = false
+	s.App.ICAOracleKeeper.SetOracle(s.Ctx, oracles[1])
+	expectedOracles := []string{oracles[2].ChainId, oracles[3].ChainId, oracles[4].ChainId}
+
+	// Configure a 100 second cadence for the test metric
+	cadence := uint64(100)
+	params := types.DefaultParams()
+	params.MetricCadences = append(params.MetricCadences, types.MetricCadence{MetricType: "test-metric", IntervalSeconds: cadence})
+	s.App.ICAOracleKeeper.SetParams(s.Ctx, params)
+
+	// Register a producer that counts the number of times it was called,
+	// and a producer that always fails after writing to the store
+	producerCalls := 0
+	err := s.App.ICAOracleKeeper.RegisterMetricProducers(
+		types.MetricProducer{
+			MetricType: "test-metric",
+			ProduceFunc: func(ctx sdk.Context) ([]types.MetricValue, error) {
+				producerCalls++
+				return []types.MetricValue{{Key: "key", Value: "value", Attributes: "{}"}}, nil
+			},
+		},
+		types.MetricProducer{
+			MetricType: "failing-metric",
+			ProduceFunc: func(ctx sdk.Context) ([]types.MetricValue, error) {
+				s.App.ICAOracleKeeper.SetOracle(ctx, types.Oracle{ChainId: "failed-oracle"})
+				return nil, errors.New("producer failed")
+			},
+		},
+	)
+	s.Require().NoError(err, "no error expected when registering producers")
+
+	// Helper function to check the metrics that were queued for the test metric type at a given time
+	checkQueuedMetrics := func(updateTime int64, expectedNumMetrics int) {
+		queuedMetrics := s.App.ICAOracleKeeper.GetAllQueuedMetrics(s.Ctx)
+		s.Require().Len(queuedMetrics, expectedNumMetrics, "number of queued metrics")
+
+		queuedOracles := []string{}
+		for _, metric := range queuedMetrics {
+			s.Require().Equal("test-metric", metric.MetricType, "metric type")
+			s.Require().Equal("key", metric.Key, "metric key")
+			if metric.UpdateTime == updateTime {
+				queuedOracles = append(queuedOracles, metric.DestinationOracle)
+			}
+		}
+		s.Require().ElementsMatch(expectedOracles, queuedOracles, "queued oracles")
+	}
+
+	// The first run should produce all metric types
+	s.App.ICAOracleKeeper.ProduceScheduledMetrics(s.Ctx)
+	s.Require().Equal(1, producerCalls, "producer calls after first run")
+	checkQueuedMetrics(startTime.Unix(), 3)
+
+	// The failed producer should have its update time recorded, but have no side effects
+	failedUpdate, found := s.App.ICAOracleKeeper.GetMetricTypeUpdate(s.Ctx, "failing-metric")
+	s.Require().True(found, "failed metric type update should have been stored")
+	s.Require().Equal(startTime.Unix(), failedUpdate.LastUpdateTime, "failed metric type update time")
+
+	_, found = s.App.ICAOracleKeeper.GetOracle(s.Ctx, "failed-oracle")
+	s.Require().False(found, "state from the failed producer should not have been written")
+
+	// Before the cadence has elapsed, the producer should not be called again
+	s.Ctx = s.Ctx.WithBlockTime(startTime.Add(time.Second * time.Duration(cadence-1)))
+	s.App.ICAOracleKeeper.ProduceScheduledMetrics(s.Ctx)
+	s.Require().Equal(1, producerCalls, "producer calls before cadence elapsed")
+	checkQueuedMetrics(startTime.Unix(), 3)
+
+	// Once the cadence has elapsed, the metric should be produced again
+	nextTime := startTime.Add(time.Second * time.Duration(cadence))
+	s.Ctx = s.Ctx.WithBlockTime(nextTime)
+	s.App.ICAOracleKeeper.ProduceScheduledMetrics(s.Ctx)
+	s.Require().Equal(2, producerCalls, "producer calls after cadence elapsed")
+	checkQueuedMetrics(nextTime.Unix(), 6)
+
+	testUpdate, found := s.App.ICAOracleKeeper.GetMetricTypeUpdate(s.Ctx, "test-metric")
+	s.Require().True(found, "test metric type update should have been stored")
+	s.Require().Equal(nextTime.Unix(), testUpdate.LastUpdateTime, "test metric type update time")
+}
+
+func (s *KeeperTestSuite) TestProduceScheduledMetrics_MultipleProducers() {
+	s.CreateTestOracles()
+
+	// Register three producers for the same metric type, where the middle one fails
+	err := s.App.ICAOracleKeeper.RegisterMetricProducers(
+		types.MetricProducer{
+			MetricType: "shared-metric",
+			ProduceFunc: func(ctx sdk.Context) ([]types.MetricValue, error) {
+				return []types.MetricValue{{Key: "sttokenA_shared", Value: "1", Attributes: "{}"}}, nil
+			},
+		},
+		types.MetricProducer{
+			MetricType: "shared-metric",
+			ProduceFunc: func(ctx sdk.Context) ([]types.MetricValue, error) {
+				s.App.ICAOracleKeeper.SetOracle(ctx, types.Oracle{ChainId: "failed-oracle"})
+				return nil, errors.New("producer failed")
+			},
+		},
+		types.MetricProducer{
+			MetricType: "shared-metric",
+			ProduceFunc: func(ctx sdk.Context) ([]types.MetricValue, error) {
+				return []types.MetricValue{{Key: "sttokenB_shared", Value: "2", Attributes: "{}"}}, nil
+			},
+		},
+	)
+	s.Require().NoError(err, "no error expected when registering producers")
+
+	s.App.ICAOracleKeeper.ProduceScheduledMetrics(s.Ctx)
+
+	// The metrics from both successful producers should be queued to each oracle,
+	// and the failed producer should have no side effects
+	queuedKeys := map[string]int{}
+	for _, metric := range s.App.ICAOracleKeeper.GetAllQueuedMetrics(s.Ctx) {
+		s.Require().Equal("shared-metric", metric.MetricType, "metric type")
+		queuedKeys[metric.Key]++
+	}
+	numOracles := len(s.App.ICAOracleKeeper.GetAllOracles(s.Ctx))
+	s.Require().Equal(map[string]int{"sttokenA_shared": numOracles, "sttokenB_shared": numOracles}, queuedKeys, "queued metric keys")
+
+	_, found := s.App.ICAOracleKeeper.GetOracle(s.Ctx, "failed-oracle")
+	s.Require().False(found, "state from the failed producer should not have been written")
+}
+
+func (s *KeeperTestSuite) TestQueueMetricUpdate_Subscriptions() {
+	oracles := s.CreateTestOracles()
+
+	// Subscribe the first oracle to only the TVL metric, and the second to only APR
+	oracles[0].MetricSubscriptions = []string{types.MetricType_TVL}
+	s.App.ICAOracleKeeper.SetOracle(s.Ctx, oracles[0])
+	oracles[1].MetricSubscriptions = []string{types.MetricType_APR}
+	s.App.ICAOracleKeeper.SetOracle(s.Ctx, oracles[1])
+
+	s.App.ICAOracleKeeper.QueueMetricUpdate(s.Ctx, "sttoken_tvl", "100", types.MetricType_TVL, "{}")
+
+	// All oracles except the APR oracle should have received the metric
+	queuedOracles := []string{}
+	for _, metric := range s.App.ICAOracleKeeper.GetAllQueuedMetrics(s.Ctx) {
+		queuedOracles = append(queuedOracles, metric.DestinationOracle)
+	}
+	expectedOracles := []string{oracles[0].ChainId, oracles[2].ChainId, oracles[3].ChainId, oracles[4].ChainId}
+	s.Require().ElementsMatch(expectedOracles, queuedOracles, "queued oracles")
+}
@@ -110,6 +110,69 @@ func (k msgServer) AddOracle(goCtx context.Context, msg *types.MsgAddOracle) (*t
 	return &types.MsgAddOracleResponse{}, nil
 }
 
+// Adds a new oracle that receives metrics over an IBC oracle channel
+// The channel must have already been opened from the icaoracle port to the receiving module or contract
+// If the oracle already exists with a closed IBC channel, the oracle is moved to the new channel
+// and any pending metrics are re-queued
+func (k msgServer) AddIBCOracle(goCtx context.Context, msg *types.MsgAddIBCOracle) (*types.MsgAddIBCOracleResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Confirm the channel is open on the oracle port
+	connectionId, err := k.ValidateIBCOracleChannel(ctx, msg.ChannelId)
+	if err != nil {
+		return nil, err
+	}
+
+	// Get chain id from the channel's connection
+	connectionEnd, found := k.ConnectionKeeper.GetConnection(ctx, connectionId)
+	if !found {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrNotFound, "connection (%s) not found", connectionId)
+	}
+	clientState, found := k.ClientKeeper.GetClientState(ctx, connectionEnd.ClientId)
+	if !found {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrNotFound, "client (%s) not found", connectionEnd.ClientId)
+	}
+	client, ok := clientState.(*ibctmtypes.ClientState)
+	if !ok {
+		return nil, types.ErrClientStateNotTendermint
+	}
+	chainId := client.ChainId
+
+	// If the oracle already exists, it can only be updated if it's an IBC oracle whose channel has closed
+	existingOracle, found := k.GetOracle(ctx, chainId)
+	if found {
+		if existingOracle.Transport != types.OracleTransport_IBC {
+			return nil, errorsmod.Wrapf(types.ErrOracleTransportMismatch, "oracle (%s) already exists with an ICA transport", chainId)
+		}
+		if k.IsOracleICAChannelOpen(ctx, existingOracle) {
+			return nil, errorsmod.Wrapf(types.ErrOracleAlreadyExists, "oracle (%s) already has an open channel (%s)", chainId, existingOracle.ChannelId)
+		}
+	}
+
+	// Create the oracle, marked as active since there is no contract to instantiate
+	oracle := types.Oracle{
+		ChainId:      chainId,
+		ConnectionId: connectionId,
+		ChannelId:    msg.ChannelId,
+		PortId:       types.PortID,
+		Active:       true,
+		Transport:    types.OracleTransport_IBC,
+	}
+	if found {
+		oracle.Active = existingOracle.Active
+	}
+	k.SetOracle(ctx, oracle)
+
+	// Revert all pending metrics for this oracle back to status QUEUED so they're sent on the new channel
+	for _, metric := range k.GetAllMetrics(ctx) {
+		if metric.DestinationOracle == chainId && metric.Status == types.MetricStatus_IN_PROGRESS {
+			k.UpdateMetricStatus(ctx, metric, types.MetricStatus_QUEUED)
+		}
+	}
+
+	return &types.MsgAddIBCOracleResponse{}, nil
+}
+
 // Instantiates the oracle cosmwasm contract
 func (k msgServer) InstantiateOracle(goCtx context.Context, msg *types.MsgInstantiateOracle) (*types.MsgInstantiateOracleResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
@@ -122,6 +185,9 @@ func (k msgServer) InstantiateOracle(goCtx context.Context, msg *types.MsgInstan
 	if oracle.ContractAddress != "" {
 		return nil, types.ErrOracleAlreadyInstantiated
 	}
+	if oracle.Transport != types.OracleTransport_ICA {
+		return nil, errorsmod.Wrapf(types.ErrOracleTransportMismatch, "oracle (%s) does not use an ICA transport", oracle.ChainId)
+	}
 
 	// Confirm the oracle ICA was registered
 	if err := oracle.ValidateICASetup(); err != nil {
@@ -177,6 +243,9 @@ func (k msgServer) RestoreOracleICA(goCtx context.Context, msg *types.MsgRestore
 	if !found {
 		return nil, types.ErrOracleNotFound
 	}
+	if oracle.Transport != types.OracleTransport_ICA {
+		return nil, errorsmod.Wrapf(types.ErrOracleTransportMismatch, "oracle (%s) does not use an ICA transport", oracle.ChainId)
+	}
 	if err := oracle.ValidateICASetup(); err != nil {
 		return nil, errorsmod.Wrapf(err, "the oracle (%s) has never had an registered ICA", oracle.ChainId)
 	}
@@ -265,3 +334,37 @@ func (ms msgServer) RemoveOracle(goCtx context.Context, msg *types.MsgRemoveOrac
 
 	return &types.MsgRemoveOracleResponse{}, nil
 }
+
+// Proposal handler for updating the metric types that an oracle receives
+// An empty list of metric types subscribes the oracle to all metrics
+func (ms msgServer) UpdateOracleSubscriptions(
+	goCtx context.Context,
+	msg *types.MsgUpdateOracleSubscriptions,
+) (*types.MsgUpdateOracleSubscriptionsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if ms.authority != msg.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", ms.authority, msg.Authority)
+	}
+
+	oracle, found := ms.Keeper.GetOracle(ctx, msg.OracleChainId)
+	if !found {
+		return nil, types.ErrOracleNotFound
+	}
+
+	oracle.MetricSubscriptions = msg.MetricTypes
+	ms.Keeper.SetOracle(ctx, oracle)
+
+	return &types.MsgUpdateOracleSubscriptionsResponse{}, nil
+}
+
+// Proposal handler for updating the module parameters
+func (ms msgServer) UpdateParams(goCtx context.Context, msg *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if ms.authority != msg.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", ms.authority, msg.Authority)
+	}
+
+	ms.Keeper.SetParams(ctx, msg.Params)
+
+	return &types.MsgUpdateParamsResponse{}, nil
+}
@@ -0,0 +1,59 @@
+package keeper_test
+
+import (
+	"github.com/Stride-Labs/stride/v24/x/icaoracle/types"
+)
+
+func (s *KeeperTestSuite) TestGovUpdateOracleSubscriptions() {
+	oracles := s.CreateTestOracles()
+	oracleToUpdate := oracles[1]
+
+	// Subscribe the oracle to only TVL and APR
+	metricTypes := []string{types.MetricType_TVL, types.MetricType_APR}
+	_, err := s.GetMsgServer().UpdateOracleSubscriptions(s.Ctx, &types.MsgUpdateOracleSubscriptions{
+		Authority:     s.App.ICAOracleKeeper.GetAuthority(),
+		OracleChainId: oracleToUpdate.ChainId,
+		MetricTypes:   metricTypes,
+	})
+	s.Require().NoError(err, "no error expected when updating subscriptions")
+
+	// Confirm only that oracle was updated
+	for _, oracle := range s.App.ICAOracleKeeper.GetAllOracles(s.Ctx) {
+		if oracle.ChainId == oracleToUpdate.ChainId {
+			s.Require().Equal(metricTypes, oracle.MetricSubscriptions, "oracle %s subscriptions", oracle.ChainId)
+		} else {
+			s.Require().Empty(oracle.MetricSubscriptions, "oracle %s subscriptions", oracle.ChainId)
+		}
+	}
+
+	// Clear the subscriptions so that the oracle receives all metrics again
+	_, err = s.GetMsgServer().UpdateOracleSubscriptions(s.Ctx, &types.MsgUpdateOracleSubscriptions{
+		Authority:     s.App.ICAOracleKeeper.GetAuthority(),
+		OracleChainId: oracleToUpdate.ChainId,
+	})
+	s.Require().NoError(err, "no error expected when clearing subscriptions")
+
+	oracle, found := s.App.ICAOracleKeeper.GetOracle(s.Ctx, oracleToUpdate.ChainId)
+	s.Require().True(found, "oracle should exist")
+	s.Require().Empty(oracle.MetricSubscriptions, "oracle subscriptions after clearing")
+}
+
+func (s *KeeperTestSuite) TestGovUpdateOracleSubscriptions_OracleNotFound() {
+	s.CreateTestOracles()
+
+	_, err := s.GetMsgServer().UpdateOracleSubscriptions(s.Ctx, &types.MsgUpdateOracleSubscriptions{
+		Authority:     s.App.ICAOracleKeeper.GetAuthority(),
+		OracleChainId: "fake_oracle",
+	})
+	s.Require().ErrorContains(err, "oracle not found")
+}
+
+func (s *KeeperTestSuite) TestGovUpdateOracleSubscriptions_InvalidAuthority() {
+	oracles := s.CreateTestOracles()
+
+	_, err := s.GetMsgServer().UpdateOracleSubscriptions(s.Ctx, &types.MsgUpdateOracleSubscriptions{
+		Authority:     "invalid",
+		OracleChainId: oracles[0].ChainId,
+	})
+	s.Require().ErrorContains(err, "invalid authority")
+}
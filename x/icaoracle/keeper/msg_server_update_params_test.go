@@ -0,0 +1,25 @@
+package keeper_test
+
+import (
+	"github.com/Stride-Labs/stride/v24/x/icaoracle/types"
+)
+
+func (s *KeeperTestSuite) TestGovUpdateParams() {
+	// Confirm the default params are returned before any are set
+	response, err := s.App.ICAOracleKeeper.Params(s.Ctx, &types.QueryParamsRequest{})
+	s.Require().NoError(err)
+	s.Require().Equal(types.DefaultParams(), response.Params, "default params")
+
+	// Update the params from the gov authority
+	params := types.NewParams(3, types.DefaultMetricCadences)
+	_, err = s.GetMsgServer().UpdateParams(s.Ctx, types.NewMsgUpdateParams(s.App.ICAOracleKeeper.GetAuthority(), params))
+	s.Require().NoError(err, "no error expected when updating params")
+
+	response, err = s.App.ICAOracleKeeper.Params(s.Ctx, &types.QueryParamsRequest{})
+	s.Require().NoError(err)
+	s.Require().Equal(params, response.Params, "updated params")
+
+	// Attempt to update the params from a non-authority address
+	_, err = s.GetMsgServer().UpdateParams(s.Ctx, types.NewMsgUpdateParams(s.TestAccs[0].String(), types.NewParams(1, types.DefaultMetricCadences)))
+	s.Require().ErrorContains(err, "invalid authority")
+}
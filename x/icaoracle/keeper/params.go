@@ -0,0 +1,26 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Stride-Labs/stride/v24/x/icaoracle/types"
+)
+
+// Writes params to the store
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
+	store := ctx.KVStore(k.storeKey)
+	paramsBz := k.cdc.MustMarshal(&params)
+	store.Set(types.ParamsKey, paramsBz)
+}
+
+// Retrieves the module parameters
+// Falls back to the default params if they have not been set
+func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
+	store := ctx.KVStore(k.storeKey)
+	paramsBz := store.Get(types.ParamsKey)
+	if len(paramsBz) == 0 {
+		return types.DefaultParams()
+	}
+	k.cdc.MustUnmarshal(paramsBz, &params)
+	return params
+}
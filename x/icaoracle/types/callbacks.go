@@ -0,0 +1,12 @@
+package types
+
+// Returns all metrics included in an update oracle ICA
+// Callbacks from before metric updates were batched store a single metric
+// in the deprecated Metric field
+func (c UpdateOracleCallback) GetCallbackMetrics() []Metric {
+	metrics := []Metric{}
+	if c.Metric != nil {
+		metrics = append(metrics, *c.Metric)
+	}
+	return append(metrics, c.Metrics...)
+}
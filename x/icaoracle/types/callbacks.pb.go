@@ -5,6 +5,7 @@ package types
 
 import (
 	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	io "io"
 	math "math"
@@ -69,8 +70,12 @@ func (m *InstantiateOracleCallback) GetOracleChainId() string {
 
 // Callback data for updating a value in the oracle
 type UpdateOracleCallback struct {
-	OracleChainId string  `protobuf:"bytes,1,opt,name=oracle_chain_id,json=oracleChainId,proto3" json:"oracle_chain_id,omitempty"`
-	Metric        *Metric `protobuf:"bytes,2,opt,name=metric,proto3" json:"metric,omitempty"`
+	OracleChainId string `protobuf:"bytes,1,opt,name=oracle_chain_id,json=oracleChainId,proto3" json:"oracle_chain_id,omitempty"`
+	// Deprecated: single metric from before metric updates were batched
+	// Only populated for callbacks that were in-flight during the upgrade
+	Metric *Metric `protobuf:"bytes,2,opt,name=metric,proto3" json:"metric,omitempty"`
+	// All metrics that were included in the ICA tx
+	Metrics []Metric `protobuf:"bytes,3,rep,name=metrics,proto3" json:"metrics"`
 }
 
 func (m *UpdateOracleCallback) Reset()         { *m = UpdateOracleCallback{} }
@@ -120,6 +125,13 @@ func (m *UpdateOracleCallback) GetMetric() *Metric {
 	return nil
 }
 
+func (m *UpdateOracleCallback) GetMetrics() []Metric {
+	if m != nil {
+		return m.Metrics
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*InstantiateOracleCallback)(nil), "stride.icaoracle.InstantiateOracleCallback")
 	proto.RegisterType((*UpdateOracleCallback)(nil), "stride.icaoracle.UpdateOracleCallback")
@@ -128,22 +140,24 @@ func init() {
 func init() { proto.RegisterFile("stride/icaoracle/callbacks.proto", fileDescriptor_7b4c39df2554f0a2) }
 
 var fileDescriptor_7b4c39df2554f0a2 = []byte{
-	// 230 bytes of a gzipped FileDescriptorProto
+	// 263 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x28, 0x2e, 0x29, 0xca,
 	0x4c, 0x49, 0xd5, 0xcf, 0x4c, 0x4e, 0xcc, 0x2f, 0x4a, 0x4c, 0xce, 0x49, 0xd5, 0x4f, 0x4e, 0xcc,
 	0xc9, 0x49, 0x4a, 0x4c, 0xce, 0x2e, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x80, 0xa8,
-	0xd0, 0x83, 0xab, 0x90, 0xc2, 0xd4, 0x03, 0x67, 0x41, 0xf4, 0x28, 0x39, 0x73, 0x49, 0x7a, 0xe6,
-	0x15, 0x97, 0x24, 0xe6, 0x95, 0x64, 0x26, 0x96, 0xa4, 0xfa, 0x83, 0xa5, 0x9c, 0xa1, 0xe6, 0x0a,
-	0xa9, 0x71, 0xf1, 0x43, 0x14, 0xc7, 0x27, 0x67, 0x24, 0x66, 0xe6, 0xc5, 0x67, 0xa6, 0x48, 0x30,
-	0x2a, 0x30, 0x6a, 0x70, 0x06, 0xf1, 0x42, 0x84, 0x9d, 0x41, 0xa2, 0x9e, 0x29, 0x4a, 0x05, 0x5c,
-	0x22, 0xa1, 0x05, 0x29, 0x64, 0xeb, 0x17, 0x32, 0xe0, 0x62, 0xcb, 0x4d, 0x2d, 0x29, 0xca, 0x4c,
-	0x96, 0x60, 0x52, 0x60, 0xd4, 0xe0, 0x36, 0x92, 0xd0, 0x43, 0xf7, 0x89, 0x9e, 0x2f, 0x58, 0x3e,
-	0x08, 0xaa, 0xce, 0xc9, 0xf7, 0xc4, 0x23, 0x39, 0xc6, 0x0b, 0x8f, 0xe4, 0x18, 0x1f, 0x3c, 0x92,
-	0x63, 0x9c, 0xf0, 0x58, 0x8e, 0xe1, 0xc2, 0x63, 0x39, 0x86, 0x1b, 0x8f, 0xe5, 0x18, 0xa2, 0x8c,
-	0xd3, 0x33, 0x4b, 0x32, 0x4a, 0x93, 0xf4, 0x92, 0xf3, 0x73, 0xf5, 0x83, 0xc1, 0xa6, 0xe8, 0xfa,
-	0x24, 0x26, 0x15, 0xeb, 0x43, 0x43, 0xa2, 0xcc, 0xc8, 0x44, 0xbf, 0x02, 0x29, 0x3c, 0x4a, 0x2a,
-	0x0b, 0x52, 0x8b, 0x93, 0xd8, 0xc0, 0x81, 0x61, 0x0c, 0x08, 0x00, 0x00, 0xff, 0xff, 0x59, 0x97,
-	0x5e, 0x23, 0x64, 0x01, 0x00, 0x00,
+	0xd0, 0x83, 0xab, 0x90, 0x12, 0x49, 0xcf, 0x4f, 0xcf, 0x07, 0x4b, 0xea, 0x83, 0x58, 0x10, 0x75,
+	0x52, 0x98, 0x26, 0xc1, 0x59, 0x10, 0x15, 0x4a, 0xce, 0x5c, 0x92, 0x9e, 0x79, 0xc5, 0x25, 0x89,
+	0x79, 0x25, 0x99, 0x89, 0x25, 0xa9, 0xfe, 0x60, 0x29, 0x67, 0xa8, 0x6d, 0x42, 0x6a, 0x5c, 0xfc,
+	0x10, 0xc5, 0xf1, 0xc9, 0x19, 0x89, 0x99, 0x79, 0xf1, 0x99, 0x29, 0x12, 0x8c, 0x0a, 0x8c, 0x1a,
+	0x9c, 0x41, 0xbc, 0x10, 0x61, 0x67, 0x90, 0xa8, 0x67, 0x8a, 0xd2, 0x2a, 0x46, 0x2e, 0x91, 0xd0,
+	0x82, 0x14, 0xb2, 0x0d, 0x10, 0x32, 0xe0, 0x62, 0xcb, 0x4d, 0x2d, 0x29, 0xca, 0x4c, 0x96, 0x60,
+	0x52, 0x60, 0xd4, 0xe0, 0x36, 0x92, 0xd0, 0x43, 0xf7, 0xa0, 0x9e, 0x2f, 0x58, 0x3e, 0x08, 0xaa,
+	0x4e, 0xc8, 0x82, 0x8b, 0x1d, 0xc2, 0x2a, 0x96, 0x60, 0x56, 0x60, 0xc6, 0xa7, 0xc5, 0x89, 0xe5,
+	0xc4, 0x3d, 0x79, 0x86, 0x20, 0x98, 0x72, 0x27, 0xdf, 0x13, 0x8f, 0xe4, 0x18, 0x2f, 0x3c, 0x92,
+	0x63, 0x7c, 0xf0, 0x48, 0x8e, 0x71, 0xc2, 0x63, 0x39, 0x86, 0x0b, 0x8f, 0xe5, 0x18, 0x6e, 0x3c,
+	0x96, 0x63, 0x88, 0x32, 0x4e, 0xcf, 0x2c, 0xc9, 0x28, 0x4d, 0xd2, 0x4b, 0xce, 0xcf, 0xd5, 0x0f,
+	0x06, 0x1b, 0xa6, 0xeb, 0x93, 0x98, 0x54, 0xac, 0x0f, 0x0d, 0xc4, 0x32, 0x23, 0x13, 0xfd, 0x0a,
+	0xa4, 0xa0, 0x2c, 0xa9, 0x2c, 0x48, 0x2d, 0x4e, 0x62, 0x03, 0x87, 0xa3, 0x31, 0x60, 0x00, 0xe0,
+	0xa6, 0xc2, 0x2e, 0xb5, 0x01, 0x00, 0x00,
 }
 
 func (m *InstantiateOracleCallback) Marshal() (dAtA []byte, err error) {
@@ -196,6 +210,20 @@ func (m *UpdateOracleCallback) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Metrics) > 0 {
+		for iNdEx := len(m.Metrics) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Metrics[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintCallbacks(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if m.Metric != nil {
 		{
 			size, err := m.Metric.MarshalToSizedBuffer(dAtA[:i])
@@ -256,6 +284,12 @@ func (m *UpdateOracleCallback) Size() (n int) {
 		l = m.Metric.Size()
 		n += 1 + l + sovCallbacks(uint64(l))
 	}
+	if len(m.Metrics) > 0 {
+		for _, e := range m.Metrics {
+			l = e.Size()
+			n += 1 + l + sovCallbacks(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -444,6 +478,40 @@ func (m *UpdateOracleCallback) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metrics", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCallbacks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCallbacks
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCallbacks
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Metrics = append(m.Metrics, Metric{})
+			if err := m.Metrics[len(m.Metrics)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCallbacks(dAtA[iNdEx:])
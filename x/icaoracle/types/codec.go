@@ -12,19 +12,25 @@ import (
 
 func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	legacy.RegisterAminoMsg(cdc, &MsgAddOracle{}, "icaoracle/AddOracle")
+	legacy.RegisterAminoMsg(cdc, &MsgAddIBCOracle{}, "icaoracle/AddIBCOracle")
 	legacy.RegisterAminoMsg(cdc, &MsgInstantiateOracle{}, "icaoracle/InstantiateOracle")
 	legacy.RegisterAminoMsg(cdc, &MsgRestoreOracleICA{}, "icaoracle/RestoreOracleICA")
 	legacy.RegisterAminoMsg(cdc, &MsgToggleOracle{}, "icaoracle/MsgToggleOracle")
 	legacy.RegisterAminoMsg(cdc, &MsgRemoveOracle{}, "icaoracle/MsgRemoveOracle")
+	legacy.RegisterAminoMsg(cdc, &MsgUpdateOracleSubscriptions{}, "icaoracle/MsgUpdateOracleSubscriptions")
+	legacy.RegisterAminoMsg(cdc, &MsgUpdateParams{}, "icaoracle/MsgUpdateParams")
 }
 
 func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgAddOracle{},
+		&MsgAddIBCOracle{},
 		&MsgInstantiateOracle{},
 		&MsgRestoreOracleICA{},
 		&MsgToggleOracle{},
 		&MsgRemoveOracle{},
+		&MsgUpdateOracleSubscriptions{},
+		&MsgUpdateParams{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
@@ -20,4 +20,9 @@ var (
 	ErrICAAccountDoesNotExist    = errorsmod.Register(ModuleName, 13, "ICA account does not exist")
 	ErrInvalidGenesisState       = errorsmod.Register(ModuleName, 14, "Invalid genesis state")
 	ErrUnableToRestoreICAChannel = errorsmod.Register(ModuleName, 15, "unable to restore oracle ICA channel")
+	ErrInvalidParams             = errorsmod.Register(ModuleName, 16, "invalid params")
+	ErrInvalidOracleChannel      = errorsmod.Register(ModuleName, 17, "invalid oracle channel")
+	ErrInvalidOraclePacket       = errorsmod.Register(ModuleName, 18, "invalid oracle packet")
+	ErrOracleTransportMismatch   = errorsmod.Register(ModuleName, 19, "oracle transport mismatch")
+	ErrInvalidMetricProducer     = errorsmod.Register(ModuleName, 20, "invalid metric producer")
 )
@@ -35,9 +35,15 @@ type ICAControllerKeeper interface {
 	RegisterInterchainAccount(ctx sdk.Context, connectionID, owner, version string) error
 }
 
+// PortKeeper defines the expected IBC port keeper
+type PortKeeper interface {
+	BindPort(ctx sdk.Context, portID string) *capabilitytypes.Capability
+}
+
 // ICACallbacksKeeper defines the expected ICA callback keeper
 type ICACallbacksKeeper interface {
-	SetCallbackData(ctx sdk.Context, callbackData icacallbackstypes.CallbackData)
+	AddCallbackData(ctx sdk.Context, callbackData icacallbackstypes.CallbackData)
+	CallRegisteredICACallback(ctx sdk.Context, packet channeltypes.Packet, ackResponse *icacallbackstypes.AcknowledgementResponse) error
 }
 
 // ICS4Wrapper defines the expected ICS4Wrapper for middleware
@@ -7,9 +7,10 @@ import (
 // DefaultGenesis returns the default Capability genesis state
 func DefaultGenesis() *GenesisState {
 	return &GenesisState{
-		Params:  DefaultParams(),
-		Oracles: []Oracle{},
-		Metrics: []Metric{},
+		Params:            DefaultParams(),
+		Oracles:           []Oracle{},
+		Metrics:           []Metric{},
+		MetricTypeUpdates: []MetricTypeUpdate{},
 	}
 }
 
@@ -17,7 +18,7 @@ func DefaultGenesis() *GenesisState {
 // failure.
 func (gs GenesisState) Validate() error {
 	if err := gs.Params.Validate(); err != nil {
-		return errorsmod.Wrap(err, "invalid genesis state")
+		return errorsmod.Wrap(ErrInvalidGenesisState, err.Error())
 	}
 	for _, oracle := range gs.Oracles {
 		if oracle.ChainId == "" {
@@ -35,6 +36,16 @@ func (gs GenesisState) Validate() error {
 			return errorsmod.Wrap(ErrInvalidGenesisState, "metric has missing destination oracle chain ID")
 		}
 	}
+	metricTypes := map[string]bool{}
+	for _, metricTypeUpdate := range gs.MetricTypeUpdates {
+		if metricTypeUpdate.MetricType == "" {
+			return errorsmod.Wrap(ErrInvalidGenesisState, "metric type update has missing metric type")
+		}
+		if metricTypes[metricTypeUpdate.MetricType] {
+			return errorsmod.Wrapf(ErrInvalidGenesisState, "duplicate metric type update for %s", metricTypeUpdate.MetricType)
+		}
+		metricTypes[metricTypeUpdate.MetricType] = true
+	}
 
 	return nil
 }
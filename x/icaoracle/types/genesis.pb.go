@@ -23,15 +23,74 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// Defines how frequently a registered metric producer should be run
+type MetricCadence struct {
+	MetricType      string `protobuf:"bytes,1,opt,name=metric_type,json=metricType,proto3" json:"metric_type,omitempty"`
+	IntervalSeconds uint64 `protobuf:"varint,2,opt,name=interval_seconds,json=intervalSeconds,proto3" json:"interval_seconds,omitempty"`
+}
+
+func (m *MetricCadence) Reset()         { *m = MetricCadence{} }
+func (m *MetricCadence) String() string { return proto.CompactTextString(m) }
+func (*MetricCadence) ProtoMessage()    {}
+func (*MetricCadence) Descriptor() ([]byte, []int) {
+	return fileDescriptor_89fd81957c6adfb8, []int{0}
+}
+func (m *MetricCadence) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MetricCadence) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MetricCadence.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MetricCadence) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MetricCadence.Merge(m, src)
+}
+func (m *MetricCadence) XXX_Size() int {
+	return m.Size()
+}
+func (m *MetricCadence) XXX_DiscardUnknown() {
+	xxx_messageInfo_MetricCadence.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MetricCadence proto.InternalMessageInfo
+
+func (m *MetricCadence) GetMetricType() string {
+	if m != nil {
+		return m.MetricType
+	}
+	return ""
+}
+
+func (m *MetricCadence) GetIntervalSeconds() uint64 {
+	if m != nil {
+		return m.IntervalSeconds
+	}
+	return 0
+}
+
 // Params defines the icaoracle module parameters.
 type Params struct {
+	// Maximum number of metrics that are batched into a single ICA tx
+	// to a given oracle
+	MaxMetricsPerTx uint64 `protobuf:"varint,1,opt,name=max_metrics_per_tx,json=maxMetricsPerTx,proto3" json:"max_metrics_per_tx,omitempty"`
+	// The update cadence for each metric type with a registered producer
+	// Producers without a cadence use the default interval
+	MetricCadences []MetricCadence `protobuf:"bytes,2,rep,name=metric_cadences,json=metricCadences,proto3" json:"metric_cadences"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
 func (m *Params) String() string { return proto.CompactTextString(m) }
 func (*Params) ProtoMessage()    {}
 func (*Params) Descriptor() ([]byte, []int) {
-	return fileDescriptor_89fd81957c6adfb8, []int{0}
+	return fileDescriptor_89fd81957c6adfb8, []int{1}
 }
 func (m *Params) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -60,18 +119,33 @@ func (m *Params) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Params proto.InternalMessageInfo
 
+func (m *Params) GetMaxMetricsPerTx() uint64 {
+	if m != nil {
+		return m.MaxMetricsPerTx
+	}
+	return 0
+}
+
+func (m *Params) GetMetricCadences() []MetricCadence {
+	if m != nil {
+		return m.MetricCadences
+	}
+	return nil
+}
+
 // GenesisState defines the icaoracle module's genesis state.
 type GenesisState struct {
-	Params  Params   `protobuf:"bytes,1,opt,name=params,proto3" json:"params" yaml:"params"`
-	Oracles []Oracle `protobuf:"bytes,2,rep,name=oracles,proto3" json:"oracles" yaml:"oracles"`
-	Metrics []Metric `protobuf:"bytes,3,rep,name=metrics,proto3" json:"metrics" yaml:"metrics"`
+	Params            Params             `protobuf:"bytes,1,opt,name=params,proto3" json:"params" yaml:"params"`
+	Oracles           []Oracle           `protobuf:"bytes,2,rep,name=oracles,proto3" json:"oracles" yaml:"oracles"`
+	Metrics           []Metric           `protobuf:"bytes,3,rep,name=metrics,proto3" json:"metrics" yaml:"metrics"`
+	MetricTypeUpdates []MetricTypeUpdate `protobuf:"bytes,4,rep,name=metric_type_updates,json=metricTypeUpdates,proto3" json:"metric_type_updates" yaml:"metric_type_updates"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
 func (m *GenesisState) String() string { return proto.CompactTextString(m) }
 func (*GenesisState) ProtoMessage()    {}
 func (*GenesisState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_89fd81957c6adfb8, []int{1}
+	return fileDescriptor_89fd81957c6adfb8, []int{2}
 }
 func (m *GenesisState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -121,7 +195,15 @@ func (m *GenesisState) GetMetrics() []Metric {
 	return nil
 }
 
+func (m *GenesisState) GetMetricTypeUpdates() []MetricTypeUpdate {
+	if m != nil {
+		return m.MetricTypeUpdates
+	}
+	return nil
+}
+
 func init() {
+	proto.RegisterType((*MetricCadence)(nil), "stride.icaoracle.MetricCadence")
 	proto.RegisterType((*Params)(nil), "stride.icaoracle.Params")
 	proto.RegisterType((*GenesisState)(nil), "stride.icaoracle.GenesisState")
 }
@@ -129,25 +211,69 @@ func init() {
 func init() { proto.RegisterFile("stride/icaoracle/genesis.proto", fileDescriptor_89fd81957c6adfb8) }
 
 var fileDescriptor_89fd81957c6adfb8 = []byte{
-	// 274 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x2b, 0x2e, 0x29, 0xca,
-	0x4c, 0x49, 0xd5, 0xcf, 0x4c, 0x4e, 0xcc, 0x2f, 0x4a, 0x4c, 0xce, 0x49, 0xd5, 0x4f, 0x4f, 0xcd,
-	0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x80, 0xc8, 0xeb, 0xc1,
-	0xe5, 0xa5, 0x44, 0xd2, 0xf3, 0xd3, 0xf3, 0xc1, 0x92, 0xfa, 0x20, 0x16, 0x44, 0x9d, 0x94, 0x02,
-	0x86, 0x39, 0x70, 0x16, 0x44, 0x85, 0x12, 0x07, 0x17, 0x5b, 0x40, 0x62, 0x51, 0x62, 0x6e, 0xb1,
-	0xd2, 0x7b, 0x46, 0x2e, 0x1e, 0x77, 0x88, 0x2d, 0xc1, 0x25, 0x89, 0x25, 0xa9, 0x42, 0xee, 0x5c,
-	0x6c, 0x05, 0x60, 0x29, 0x09, 0x46, 0x05, 0x46, 0x0d, 0x6e, 0x23, 0x09, 0x3d, 0x74, 0x5b, 0xf5,
-	0x20, 0x5a, 0x9d, 0x44, 0x4f, 0xdc, 0x93, 0x67, 0xf8, 0x74, 0x4f, 0x9e, 0xb7, 0x32, 0x31, 0x37,
-	0xc7, 0x4a, 0x09, 0xa2, 0x4b, 0x29, 0x08, 0xaa, 0x5d, 0xc8, 0x8b, 0x8b, 0x1d, 0xa2, 0xbe, 0x58,
-	0x82, 0x49, 0x81, 0x19, 0xbb, 0x49, 0xfe, 0x60, 0xca, 0x49, 0x0c, 0x6a, 0x12, 0x1f, 0xc4, 0x24,
-	0xa8, 0x36, 0xa5, 0x20, 0x98, 0x01, 0x20, 0xb3, 0x72, 0x53, 0x4b, 0x8a, 0x32, 0x93, 0x8b, 0x25,
-	0x98, 0x71, 0x99, 0xe5, 0x0b, 0x56, 0x80, 0x6e, 0x16, 0x54, 0x9b, 0x52, 0x10, 0xcc, 0x00, 0x27,
-	0xdf, 0x13, 0x8f, 0xe4, 0x18, 0x2f, 0x3c, 0x92, 0x63, 0x7c, 0xf0, 0x48, 0x8e, 0x71, 0xc2, 0x63,
-	0x39, 0x86, 0x0b, 0x8f, 0xe5, 0x18, 0x6e, 0x3c, 0x96, 0x63, 0x88, 0x32, 0x4e, 0xcf, 0x2c, 0xc9,
-	0x28, 0x4d, 0xd2, 0x4b, 0xce, 0xcf, 0xd5, 0x0f, 0x06, 0x1b, 0xaf, 0xeb, 0x93, 0x98, 0x54, 0xac,
-	0x0f, 0x0d, 0xce, 0x32, 0x23, 0x13, 0xfd, 0x0a, 0xa4, 0x40, 0x2d, 0xa9, 0x2c, 0x48, 0x2d, 0x4e,
-	0x62, 0x03, 0x87, 0xa8, 0x31, 0x20, 0x00, 0x00, 0xff, 0xff, 0xdf, 0xc4, 0x0f, 0xb7, 0xbd, 0x01,
-	0x00, 0x00,
+	// 432 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x92, 0x3f, 0x8f, 0xd3, 0x30,
+	0x18, 0xc6, 0x93, 0xbb, 0xa8, 0x08, 0x97, 0xbb, 0x1e, 0xe6, 0x8f, 0xa2, 0x0e, 0x49, 0xe5, 0xa9,
+	0x08, 0x91, 0x48, 0x3d, 0x26, 0xc6, 0x30, 0x9c, 0x84, 0x38, 0x38, 0xb9, 0xc7, 0x02, 0x43, 0xe4,
+	0x26, 0x56, 0x88, 0x54, 0x27, 0x91, 0xed, 0xab, 0xd2, 0x0f, 0xc0, 0xce, 0xc7, 0xba, 0xf1, 0x46,
+	0xa6, 0x0a, 0xb5, 0x03, 0x3b, 0x9f, 0x00, 0xc5, 0x76, 0xaa, 0xb4, 0xa5, 0x53, 0xa2, 0xf7, 0x7d,
+	0x9f, 0xdf, 0xf3, 0xd8, 0x7e, 0x81, 0x27, 0x24, 0xcf, 0x53, 0x1a, 0xe6, 0x09, 0x29, 0x39, 0x49,
+	0xe6, 0x34, 0xcc, 0x68, 0x41, 0x45, 0x2e, 0x82, 0x8a, 0x97, 0xb2, 0x84, 0x17, 0xba, 0x1f, 0x6c,
+	0xfb, 0xc3, 0xe7, 0x59, 0x99, 0x95, 0xaa, 0x19, 0x36, 0x7f, 0x7a, 0x6e, 0x38, 0x3a, 0xe0, 0x6c,
+	0xff, 0xf4, 0x04, 0xfa, 0x06, 0xce, 0xae, 0xa9, 0xe4, 0x79, 0xf2, 0x9e, 0xa4, 0xb4, 0x48, 0x28,
+	0xf4, 0x41, 0x9f, 0xa9, 0x42, 0x2c, 0x97, 0x15, 0x75, 0xed, 0x91, 0x3d, 0x7e, 0x8c, 0x81, 0x2e,
+	0xdd, 0x2e, 0x2b, 0x0a, 0x5f, 0x81, 0x8b, 0xbc, 0x90, 0x94, 0x2f, 0xc8, 0x3c, 0x16, 0x34, 0x29,
+	0x8b, 0x54, 0xb8, 0x27, 0x23, 0x7b, 0xec, 0xe0, 0x41, 0x5b, 0x9f, 0xea, 0x32, 0xfa, 0x61, 0x83,
+	0xde, 0x0d, 0xe1, 0x84, 0x09, 0xf8, 0x1a, 0x40, 0x46, 0xea, 0x58, 0x73, 0x44, 0x5c, 0x51, 0x1e,
+	0xcb, 0x5a, 0xd1, 0x1d, 0x3c, 0x60, 0xa4, 0xd6, 0x21, 0xc4, 0x0d, 0xe5, 0xb7, 0x35, 0xfc, 0x04,
+	0x06, 0x26, 0x43, 0xa2, 0x53, 0x35, 0x0e, 0xa7, 0xe3, 0xfe, 0xc4, 0x0f, 0xf6, 0x0f, 0x1e, 0xec,
+	0xa4, 0x8f, 0x9c, 0xfb, 0x95, 0x6f, 0xe1, 0x73, 0xd6, 0x2d, 0x0a, 0xf4, 0xe7, 0x04, 0x3c, 0xb9,
+	0xd2, 0x17, 0x38, 0x95, 0x44, 0x52, 0x78, 0x05, 0x7a, 0x95, 0xca, 0xa5, 0x12, 0xf4, 0x27, 0xee,
+	0x21, 0x57, 0xe7, 0x8e, 0x5e, 0x34, 0xc0, 0xbf, 0x2b, 0xff, 0x6c, 0x49, 0xd8, 0xfc, 0x1d, 0xd2,
+	0x2a, 0x84, 0x8d, 0x1c, 0x7e, 0x00, 0x8f, 0xf4, 0x7c, 0x9b, 0xf0, 0x3f, 0xa4, 0xcf, 0xea, 0x13,
+	0xbd, 0x34, 0xa4, 0x73, 0x4d, 0x32, 0x32, 0x84, 0x5b, 0x40, 0xc3, 0x32, 0xd7, 0xe3, 0x9e, 0x1e,
+	0x63, 0xe9, 0xd3, 0xee, 0xb3, 0x8c, 0x0c, 0xe1, 0x16, 0x00, 0x17, 0xe0, 0x59, 0xe7, 0x15, 0xe3,
+	0xbb, 0x2a, 0x25, 0x92, 0x0a, 0xd7, 0x51, 0x5c, 0x74, 0x8c, 0xdb, 0xbc, 0xef, 0x17, 0x35, 0x1a,
+	0x21, 0xe3, 0x30, 0xec, 0x3a, 0xec, 0xc0, 0x10, 0x7e, 0xca, 0xf6, 0x54, 0x22, 0xba, 0xbe, 0x5f,
+	0x7b, 0xf6, 0xc3, 0xda, 0xb3, 0x7f, 0xaf, 0x3d, 0xfb, 0xe7, 0xc6, 0xb3, 0x1e, 0x36, 0x9e, 0xf5,
+	0x6b, 0xe3, 0x59, 0x5f, 0x2f, 0xb3, 0x5c, 0x7e, 0xbf, 0x9b, 0x05, 0x49, 0xc9, 0xc2, 0xa9, 0xb2,
+	0x7f, 0xf3, 0x91, 0xcc, 0x44, 0x68, 0x36, 0x74, 0x31, 0x79, 0x1b, 0xd6, 0x9d, 0x3d, 0x6d, 0x9c,
+	0xc4, 0xac, 0xa7, 0x96, 0xf4, 0xf2, 0xdf, 0x00, 0x1b, 0x6a, 0x03, 0xe9, 0x10, 0x03, 0x00, 0x00,
+}
+
+func (m *MetricCadence) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MetricCadence) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MetricCadence) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.IntervalSeconds != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.IntervalSeconds))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.MetricType) > 0 {
+		i -= len(m.MetricType)
+		copy(dAtA[i:], m.MetricType)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.MetricType)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -170,6 +296,25 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.MetricCadences) > 0 {
+		for iNdEx := len(m.MetricCadences) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MetricCadences[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.MaxMetricsPerTx != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.MaxMetricsPerTx))
+		i--
+		dAtA[i] = 0x8
+	}
 	return len(dAtA) - i, nil
 }
 
@@ -193,6 +338,20 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.MetricTypeUpdates) > 0 {
+		for iNdEx := len(m.MetricTypeUpdates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MetricTypeUpdates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	if len(m.Metrics) > 0 {
 		for iNdEx := len(m.Metrics) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -245,12 +404,37 @@ func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
 	dAtA[offset] = uint8(v)
 	return base
 }
+func (m *MetricCadence) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MetricType)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.IntervalSeconds != 0 {
+		n += 1 + sovGenesis(uint64(m.IntervalSeconds))
+	}
+	return n
+}
+
 func (m *Params) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
+	if m.MaxMetricsPerTx != 0 {
+		n += 1 + sovGenesis(uint64(m.MaxMetricsPerTx))
+	}
+	if len(m.MetricCadences) > 0 {
+		for _, e := range m.MetricCadences {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -274,6 +458,12 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.MetricTypeUpdates) > 0 {
+		for _, e := range m.MetricTypeUpdates {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -283,6 +473,107 @@ func sovGenesis(x uint64) (n int) {
 func sozGenesis(x uint64) (n int) {
 	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
 }
+func (m *MetricCadence) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MetricCadence: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MetricCadence: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MetricType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MetricType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IntervalSeconds", wireType)
+			}
+			m.IntervalSeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.IntervalSeconds |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *Params) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -312,6 +603,59 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxMetricsPerTx", wireType)
+			}
+			m.MaxMetricsPerTx = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxMetricsPerTx |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MetricCadences", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MetricCadences = append(m.MetricCadences, MetricCadence{})
+			if err := m.MetricCadences[len(m.MetricCadences)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -463,6 +807,40 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MetricTypeUpdates", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MetricTypeUpdates = append(m.MetricTypeUpdates, MetricTypeUpdate{})
+			if err := m.MetricTypeUpdates[len(m.MetricTypeUpdates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -29,6 +29,7 @@ func TestValidateGenesis(t *testing.T) {
 		{
 			name: "valid genesis",
 			genesisState: types.GenesisState{
+				Params: types.DefaultParams(),
 				Oracles: []types.Oracle{
 					{ChainId: validChainId},
 				},
@@ -41,6 +42,7 @@ func TestValidateGenesis(t *testing.T) {
 		{
 			name: "invalid oracle",
 			genesisState: types.GenesisState{
+				Params: types.DefaultParams(),
 				Oracles: []types.Oracle{
 					{ChainId: ""},
 				},
@@ -53,6 +55,7 @@ func TestValidateGenesis(t *testing.T) {
 		{
 			name: "invalid metric",
 			genesisState: types.GenesisState{
+				Params: types.DefaultParams(),
 				Oracles: []types.Oracle{
 					{ChainId: validChainId},
 				},
@@ -62,6 +65,59 @@ func TestValidateGenesis(t *testing.T) {
 			},
 			valid: false,
 		},
+		{
+			name: "invalid params",
+			genesisState: types.GenesisState{
+				Params: types.NewParams(0, types.DefaultMetricCadences),
+				Oracles: []types.Oracle{
+					{ChainId: validChainId},
+				},
+				Metrics: []types.Metric{
+					validMetric,
+				},
+			},
+			valid: false,
+		},
+		{
+			name: "duplicate metric cadence",
+			genesisState: types.GenesisState{
+				Params: types.NewParams(1, []types.MetricCadence{
+					{MetricType: types.MetricType_TVL, IntervalSeconds: 10},
+					{MetricType: types.MetricType_TVL, IntervalSeconds: 20},
+				}),
+			},
+			valid: false,
+		},
+		{
+			name: "zero metric cadence",
+			genesisState: types.GenesisState{
+				Params: types.NewParams(1, []types.MetricCadence{
+					{MetricType: types.MetricType_TVL, IntervalSeconds: 0},
+				}),
+			},
+			valid: false,
+		},
+		{
+			name: "duplicate metric type update",
+			genesisState: types.GenesisState{
+				Params: types.DefaultParams(),
+				MetricTypeUpdates: []types.MetricTypeUpdate{
+					{MetricType: types.MetricType_TVL, LastUpdateTime: 1},
+					{MetricType: types.MetricType_TVL, LastUpdateTime: 2},
+				},
+			},
+			valid: false,
+		},
+		{
+			name: "missing metric type in metric type update",
+			genesisState: types.GenesisState{
+				Params: types.DefaultParams(),
+				MetricTypeUpdates: []types.MetricTypeUpdate{
+					{MetricType: "", LastUpdateTime: 1},
+				},
+			},
+			valid: false,
+		},
 	}
 
 	for _, test := range tests {
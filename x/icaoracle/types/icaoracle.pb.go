@@ -23,6 +23,34 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// OracleTransport indicates how metrics are delivered to the oracle
+//   - ICA: an ICA executes the CW oracle contract on the oracle chain
+//   - IBC: metric packets are sent directly over a dedicated IBC channel
+type OracleTransport int32
+
+const (
+	OracleTransport_ICA OracleTransport = 0
+	OracleTransport_IBC OracleTransport = 1
+)
+
+var OracleTransport_name = map[int32]string{
+	0: "ORACLE_TRANSPORT_ICA",
+	1: "ORACLE_TRANSPORT_IBC",
+}
+
+var OracleTransport_value = map[string]int32{
+	"ORACLE_TRANSPORT_ICA": 0,
+	"ORACLE_TRANSPORT_IBC": 1,
+}
+
+func (x OracleTransport) String() string {
+	return proto.EnumName(OracleTransport_name, int32(x))
+}
+
+func (OracleTransport) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_842e38c1f0da9e66, []int{0}
+}
+
 // MetricStatus indicates whether the Metric update ICA has been sent
 type MetricStatus int32
 
@@ -49,18 +77,22 @@ func (x MetricStatus) String() string {
 }
 
 func (MetricStatus) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_842e38c1f0da9e66, []int{0}
+	return fileDescriptor_842e38c1f0da9e66, []int{1}
 }
 
 // Oracle structure stores context about the CW oracle sitting a different chain
 type Oracle struct {
-	ChainId         string `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
-	ConnectionId    string `protobuf:"bytes,2,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty"`
-	ChannelId       string `protobuf:"bytes,3,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
-	PortId          string `protobuf:"bytes,4,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty"`
-	IcaAddress      string `protobuf:"bytes,5,opt,name=ica_address,json=icaAddress,proto3" json:"ica_address,omitempty"`
-	ContractAddress string `protobuf:"bytes,6,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
-	Active          bool   `protobuf:"varint,7,opt,name=active,proto3" json:"active,omitempty"`
+	ChainId         string          `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	ConnectionId    string          `protobuf:"bytes,2,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty"`
+	ChannelId       string          `protobuf:"bytes,3,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	PortId          string          `protobuf:"bytes,4,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty"`
+	IcaAddress      string          `protobuf:"bytes,5,opt,name=ica_address,json=icaAddress,proto3" json:"ica_address,omitempty"`
+	ContractAddress string          `protobuf:"bytes,6,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+	Active          bool            `protobuf:"varint,7,opt,name=active,proto3" json:"active,omitempty"`
+	Transport       OracleTransport `protobuf:"varint,8,opt,name=transport,proto3,enum=stride.icaoracle.OracleTransport" json:"transport,omitempty"`
+	// The metric types that should be sent to this oracle
+	// If empty, the oracle receives every metric type
+	MetricSubscriptions []string `protobuf:"bytes,9,rep,name=metric_subscriptions,json=metricSubscriptions,proto3" json:"metric_subscriptions,omitempty"`
 }
 
 func (m *Oracle) Reset()         { *m = Oracle{} }
@@ -145,6 +177,20 @@ func (m *Oracle) GetActive() bool {
 	return false
 }
 
+func (m *Oracle) GetTransport() OracleTransport {
+	if m != nil {
+		return m.Transport
+	}
+	return OracleTransport_ICA
+}
+
+func (m *Oracle) GetMetricSubscriptions() []string {
+	if m != nil {
+		return m.MetricSubscriptions
+	}
+	return nil
+}
+
 // Metric structure stores a generic metric using a key value structure
 // along with additional context
 type Metric struct {
@@ -292,52 +338,164 @@ func (m *RedemptionRateAttributes) GetSttokenDenom() string {
 	return ""
 }
 
+// Attributes associated with any other stToken metric update
+// (e.g. TVL, APR, pending redemptions, or the halted flag)
+type StTokenMetricAttributes struct {
+	SttokenDenom string `protobuf:"bytes,1,opt,name=sttoken_denom,json=sttokenDenom,proto3" json:"sttoken_denom,omitempty"`
+}
+
+func (m *StTokenMetricAttributes) Reset()         { *m = StTokenMetricAttributes{} }
+func (m *StTokenMetricAttributes) String() string { return proto.CompactTextString(m) }
+func (*StTokenMetricAttributes) ProtoMessage()    {}
+func (*StTokenMetricAttributes) Descriptor() ([]byte, []int) {
+	return fileDescriptor_842e38c1f0da9e66, []int{3}
+}
+func (m *StTokenMetricAttributes) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StTokenMetricAttributes) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StTokenMetricAttributes.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *StTokenMetricAttributes) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StTokenMetricAttributes.Merge(m, src)
+}
+func (m *StTokenMetricAttributes) XXX_Size() int {
+	return m.Size()
+}
+func (m *StTokenMetricAttributes) XXX_DiscardUnknown() {
+	xxx_messageInfo_StTokenMetricAttributes.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StTokenMetricAttributes proto.InternalMessageInfo
+
+func (m *StTokenMetricAttributes) GetSttokenDenom() string {
+	if m != nil {
+		return m.SttokenDenom
+	}
+	return ""
+}
+
+// Tracks the last time that metrics were produced for a given metric type
+// so that producers are only run at the configured cadence
+type MetricTypeUpdate struct {
+	MetricType     string `protobuf:"bytes,1,opt,name=metric_type,json=metricType,proto3" json:"metric_type,omitempty"`
+	LastUpdateTime int64  `protobuf:"varint,2,opt,name=last_update_time,json=lastUpdateTime,proto3" json:"last_update_time,omitempty"`
+}
+
+func (m *MetricTypeUpdate) Reset()         { *m = MetricTypeUpdate{} }
+func (m *MetricTypeUpdate) String() string { return proto.CompactTextString(m) }
+func (*MetricTypeUpdate) ProtoMessage()    {}
+func (*MetricTypeUpdate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_842e38c1f0da9e66, []int{4}
+}
+func (m *MetricTypeUpdate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MetricTypeUpdate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MetricTypeUpdate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MetricTypeUpdate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MetricTypeUpdate.Merge(m, src)
+}
+func (m *MetricTypeUpdate) XXX_Size() int {
+	return m.Size()
+}
+func (m *MetricTypeUpdate) XXX_DiscardUnknown() {
+	xxx_messageInfo_MetricTypeUpdate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MetricTypeUpdate proto.InternalMessageInfo
+
+func (m *MetricTypeUpdate) GetMetricType() string {
+	if m != nil {
+		return m.MetricType
+	}
+	return ""
+}
+
+func (m *MetricTypeUpdate) GetLastUpdateTime() int64 {
+	if m != nil {
+		return m.LastUpdateTime
+	}
+	return 0
+}
+
 func init() {
+	proto.RegisterEnum("stride.icaoracle.OracleTransport", OracleTransport_name, OracleTransport_value)
 	proto.RegisterEnum("stride.icaoracle.MetricStatus", MetricStatus_name, MetricStatus_value)
 	proto.RegisterType((*Oracle)(nil), "stride.icaoracle.Oracle")
 	proto.RegisterType((*Metric)(nil), "stride.icaoracle.Metric")
 	proto.RegisterType((*RedemptionRateAttributes)(nil), "stride.icaoracle.RedemptionRateAttributes")
+	proto.RegisterType((*StTokenMetricAttributes)(nil), "stride.icaoracle.StTokenMetricAttributes")
+	proto.RegisterType((*MetricTypeUpdate)(nil), "stride.icaoracle.MetricTypeUpdate")
 }
 
 func init() { proto.RegisterFile("stride/icaoracle/icaoracle.proto", fileDescriptor_842e38c1f0da9e66) }
 
 var fileDescriptor_842e38c1f0da9e66 = []byte{
-	// 557 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0x93, 0xc1, 0x6e, 0xd3, 0x4c,
-	0x14, 0x85, 0xe3, 0xe6, 0xaf, 0xdb, 0xde, 0xa6, 0x7f, 0xcd, 0x28, 0x82, 0x34, 0x12, 0xc6, 0xa4,
-	0x2c, 0x02, 0x52, 0x1d, 0xa9, 0x45, 0x6c, 0x51, 0x68, 0x0c, 0x58, 0x22, 0x6d, 0xb1, 0x93, 0x0d,
-	0x1b, 0x6b, 0x32, 0x33, 0x4a, 0x46, 0x89, 0x3d, 0x96, 0x3d, 0x89, 0xc8, 0x2b, 0x74, 0xc5, 0x82,
-	0x25, 0x7d, 0x1f, 0x96, 0x5d, 0xb2, 0x44, 0x09, 0x0f, 0x82, 0x3c, 0x76, 0x5a, 0x03, 0xbb, 0xb9,
-	0xdf, 0x39, 0xbe, 0xf2, 0xb9, 0x33, 0x17, 0xac, 0x54, 0x26, 0x9c, 0xb2, 0x0e, 0x27, 0x58, 0x24,
-	0x98, 0xcc, 0x4a, 0x27, 0x3b, 0x4e, 0x84, 0x14, 0xc8, 0xc8, 0x1d, 0xf6, 0x1d, 0x6f, 0xd6, 0xc7,
-	0x62, 0x2c, 0x94, 0xd8, 0xc9, 0x4e, 0xb9, 0xaf, 0xf5, 0x4b, 0x03, 0xfd, 0x52, 0x19, 0xd0, 0x11,
-	0xec, 0x92, 0x09, 0xe6, 0x51, 0xc0, 0x69, 0x43, 0xb3, 0xb4, 0xf6, 0x9e, 0xb7, 0xa3, 0x6a, 0x97,
-	0xa2, 0x63, 0x38, 0x20, 0x22, 0x8a, 0x18, 0x91, 0x5c, 0x28, 0x7d, 0x4b, 0xe9, 0xb5, 0x7b, 0xe8,
-	0x52, 0xf4, 0x18, 0x80, 0x4c, 0x70, 0x14, 0xb1, 0x59, 0xe6, 0xa8, 0x2a, 0xc7, 0x5e, 0x41, 0x5c,
-	0x8a, 0x1e, 0xc1, 0x4e, 0x2c, 0x12, 0x99, 0x69, 0xff, 0x29, 0x4d, 0xcf, 0x4a, 0x97, 0xa2, 0x27,
-	0xb0, 0xcf, 0x09, 0x0e, 0x30, 0xa5, 0x09, 0x4b, 0xd3, 0xc6, 0xb6, 0x12, 0x81, 0x13, 0xdc, 0xcd,
-	0x09, 0x7a, 0x0e, 0x06, 0x11, 0x91, 0x4c, 0x30, 0x91, 0x77, 0x2e, 0x5d, 0xb9, 0x0e, 0x37, 0x7c,
-	0x63, 0x7d, 0x08, 0x3a, 0x26, 0x92, 0x2f, 0x58, 0x63, 0xc7, 0xd2, 0xda, 0xbb, 0x5e, 0x51, 0xb5,
-	0xbe, 0x6d, 0x81, 0xde, 0x67, 0x32, 0xe1, 0x04, 0x19, 0x50, 0x9d, 0xb2, 0x65, 0x91, 0x30, 0x3b,
-	0xa2, 0x3a, 0x6c, 0x2f, 0xf0, 0x6c, 0xce, 0x8a, 0x54, 0x79, 0x91, 0xfd, 0x56, 0xa8, 0xbe, 0x08,
-	0xe4, 0x32, 0x66, 0x45, 0x1e, 0xc8, 0xd1, 0x60, 0x19, 0x2b, 0xc3, 0x3c, 0xa6, 0x58, 0xb2, 0x40,
-	0xf2, 0x90, 0xa9, 0x50, 0x55, 0x0f, 0x72, 0x34, 0xe0, 0x21, 0x43, 0x4f, 0xa1, 0x36, 0x9a, 0x09,
-	0x32, 0x0d, 0x26, 0x8c, 0x8f, 0x27, 0x52, 0x25, 0xab, 0x7a, 0xfb, 0x8a, 0xbd, 0x57, 0x08, 0x99,
-	0x00, 0x58, 0xca, 0x84, 0x8f, 0xe6, 0x92, 0x6d, 0x42, 0x95, 0x08, 0x3a, 0x01, 0x44, 0x59, 0x2a,
-	0x79, 0x84, 0xd5, 0xe4, 0xf3, 0xab, 0x54, 0xd9, 0xf6, 0xbc, 0x07, 0x25, 0xa5, 0xb8, 0xc2, 0x57,
-	0xa0, 0xa7, 0x12, 0xcb, 0x79, 0xda, 0xd8, 0xb5, 0xb4, 0xf6, 0xff, 0xa7, 0xa6, 0xfd, 0xf7, 0x33,
-	0xb0, 0xf3, 0x29, 0xf8, 0xca, 0xe5, 0x15, 0xee, 0xd6, 0x6b, 0x68, 0x78, 0x8c, 0xb2, 0x30, 0xce,
-	0x7a, 0x79, 0x58, 0xb2, 0xee, 0xfd, 0x2f, 0x1c, 0xc3, 0x41, 0x2a, 0xa5, 0x98, 0xb2, 0x28, 0xa0,
-	0x2c, 0x12, 0x61, 0x31, 0xb9, 0x5a, 0x01, 0x7b, 0x19, 0x7b, 0xf1, 0x55, 0x83, 0x5a, 0xb9, 0x33,
-	0xb2, 0xe1, 0xa8, 0xef, 0x0c, 0x3c, 0xf7, 0x3c, 0xf0, 0x07, 0xdd, 0xc1, 0xd0, 0x0f, 0x86, 0x17,
-	0xfe, 0x95, 0x73, 0xee, 0xbe, 0x75, 0x9d, 0x9e, 0x51, 0x69, 0x1e, 0x5e, 0xdf, 0x58, 0xfb, 0x25,
-	0x84, 0x9e, 0x41, 0xfd, 0x4f, 0xff, 0xc7, 0xa1, 0x33, 0x74, 0x7a, 0x86, 0xd6, 0x84, 0xeb, 0x1b,
-	0x4b, 0xcf, 0xab, 0x7f, 0xbb, 0xba, 0x17, 0xc1, 0x95, 0x77, 0xf9, 0xce, 0x73, 0x7c, 0xdf, 0xd8,
-	0xca, 0xbb, 0x96, 0xd0, 0x9b, 0xfe, 0xf7, 0x95, 0xa9, 0xdd, 0xae, 0x4c, 0xed, 0xe7, 0xca, 0xd4,
-	0xbe, 0xac, 0xcd, 0xca, 0xed, 0xda, 0xac, 0xfc, 0x58, 0x9b, 0x95, 0x4f, 0x67, 0x63, 0x2e, 0x27,
-	0xf3, 0x91, 0x4d, 0x44, 0xd8, 0xf1, 0xd5, 0x8c, 0x4e, 0x3e, 0xe0, 0x51, 0xda, 0x29, 0x16, 0x6b,
-	0x71, 0xfa, 0xb2, 0xf3, 0xb9, 0xb4, 0x5e, 0xd9, 0x13, 0x48, 0x47, 0xba, 0xda, 0x99, 0xb3, 0xdf,
-	0x01, 0x00, 0x00, 0xff, 0xff, 0x81, 0x95, 0x95, 0x16, 0x7f, 0x03, 0x00, 0x00,
+	// 689 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x94, 0x41, 0x4f, 0xdb, 0x3c,
+	0x18, 0xc7, 0x9b, 0xf6, 0x25, 0xa5, 0x0f, 0x05, 0xf2, 0x7a, 0xd5, 0x28, 0x95, 0x96, 0x85, 0xb2,
+	0x43, 0x87, 0x44, 0xab, 0xc1, 0xb4, 0xe3, 0x50, 0x29, 0xd9, 0x16, 0x89, 0x52, 0xe6, 0xa4, 0x9a,
+	0x34, 0x69, 0x8a, 0x5c, 0xc7, 0x6a, 0x2d, 0xda, 0xa4, 0x4a, 0x5c, 0x34, 0xbe, 0x02, 0xa7, 0x1d,
+	0x76, 0x1c, 0xdf, 0x67, 0x47, 0x8e, 0x1c, 0x27, 0xf8, 0x22, 0x53, 0x9c, 0xb4, 0x64, 0xb0, 0xcb,
+	0x6e, 0x7e, 0x7e, 0xcf, 0x3f, 0x76, 0xfb, 0x7b, 0x2c, 0x83, 0x11, 0x89, 0x90, 0x7b, 0xac, 0xc5,
+	0x29, 0x09, 0x42, 0x42, 0xc7, 0x99, 0x55, 0x73, 0x1a, 0x06, 0x22, 0x40, 0x5a, 0x92, 0x68, 0x2e,
+	0x78, 0xad, 0x32, 0x0c, 0x86, 0x81, 0x6c, 0xb6, 0xe2, 0x55, 0x92, 0xab, 0xdf, 0xe4, 0x41, 0xed,
+	0xc9, 0x00, 0xda, 0x84, 0x65, 0x3a, 0x22, 0xdc, 0x77, 0xb9, 0x57, 0x55, 0x0c, 0xa5, 0x51, 0xc2,
+	0x45, 0x59, 0x5b, 0x1e, 0xda, 0x86, 0x55, 0x1a, 0xf8, 0x3e, 0xa3, 0x82, 0x07, 0xb2, 0x9f, 0x97,
+	0xfd, 0xf2, 0x3d, 0xb4, 0x3c, 0xf4, 0x0c, 0x80, 0x8e, 0x88, 0xef, 0xb3, 0x71, 0x9c, 0x28, 0xc8,
+	0x44, 0x29, 0x25, 0x96, 0x87, 0x36, 0xa0, 0x38, 0x0d, 0x42, 0x11, 0xf7, 0xfe, 0x93, 0x3d, 0x35,
+	0x2e, 0x2d, 0x0f, 0x3d, 0x87, 0x15, 0x4e, 0x89, 0x4b, 0x3c, 0x2f, 0x64, 0x51, 0x54, 0x5d, 0x92,
+	0x4d, 0xe0, 0x94, 0xb4, 0x13, 0x82, 0x5e, 0x82, 0x46, 0x03, 0x5f, 0x84, 0x84, 0x8a, 0x45, 0x4a,
+	0x95, 0xa9, 0xf5, 0x39, 0x9f, 0x47, 0x9f, 0x82, 0x4a, 0xa8, 0xe0, 0xe7, 0xac, 0x5a, 0x34, 0x94,
+	0xc6, 0x32, 0x4e, 0x2b, 0x74, 0x00, 0x25, 0x11, 0x12, 0x3f, 0x8a, 0x8f, 0xac, 0x2e, 0x1b, 0x4a,
+	0x63, 0x6d, 0x6f, 0xab, 0xf9, 0x50, 0x51, 0x33, 0x11, 0xe1, 0xcc, 0x83, 0xf8, 0xfe, 0x1b, 0xf4,
+	0x0a, 0x2a, 0x13, 0x26, 0x42, 0x4e, 0xdd, 0x68, 0x36, 0x88, 0x68, 0xc8, 0xa7, 0xf1, 0xbf, 0x8e,
+	0xaa, 0x25, 0xa3, 0xd0, 0x28, 0xe1, 0x27, 0x49, 0xcf, 0xce, 0xb6, 0xea, 0x3f, 0xf2, 0xa0, 0x76,
+	0x25, 0x47, 0x1a, 0x14, 0xce, 0xd8, 0x45, 0x6a, 0x35, 0x5e, 0xa2, 0x0a, 0x2c, 0x9d, 0x93, 0xf1,
+	0x8c, 0xa5, 0x26, 0x93, 0x22, 0x56, 0x91, 0x9e, 0x22, 0x2e, 0xa6, 0x2c, 0x75, 0x08, 0x09, 0x72,
+	0x2e, 0xa6, 0x32, 0x30, 0x9b, 0x7a, 0x44, 0x30, 0x57, 0xf0, 0x09, 0x93, 0x22, 0x0b, 0x18, 0x12,
+	0xe4, 0xf0, 0x09, 0x43, 0x5b, 0x50, 0x1e, 0x8c, 0x03, 0x7a, 0xe6, 0x8e, 0x18, 0x1f, 0x8e, 0x84,
+	0xb4, 0x59, 0xc0, 0x2b, 0x92, 0x7d, 0x90, 0x08, 0xe9, 0x00, 0x44, 0x88, 0x90, 0x0f, 0x66, 0x82,
+	0xcd, 0x45, 0x66, 0x08, 0xda, 0x05, 0xe4, 0xb1, 0x48, 0x70, 0x9f, 0xc8, 0x69, 0x27, 0x6e, 0xa4,
+	0xcf, 0x12, 0xfe, 0x3f, 0xd3, 0x49, 0xaf, 0xcd, 0x1b, 0x50, 0x23, 0x41, 0xc4, 0x2c, 0x4a, 0xbd,
+	0xea, 0x8f, 0xbd, 0x26, 0x16, 0x6c, 0x99, 0xc2, 0x69, 0xba, 0x7e, 0x00, 0x55, 0xcc, 0x3c, 0x36,
+	0x91, 0xb6, 0x30, 0x11, 0xac, 0x7d, 0xff, 0x13, 0xb6, 0x61, 0x35, 0x12, 0x22, 0x38, 0x63, 0xbe,
+	0xeb, 0x31, 0x3f, 0x98, 0xa4, 0xe6, 0xca, 0x29, 0x3c, 0x8a, 0x59, 0xfd, 0x2d, 0x6c, 0xd8, 0xc2,
+	0x89, 0xeb, 0x64, 0xff, 0x7f, 0xfd, 0xfe, 0x0b, 0x68, 0xdd, 0x85, 0xd9, 0xbe, 0x54, 0xf8, 0x70,
+	0x00, 0xca, 0xa3, 0x01, 0x34, 0x40, 0x1b, 0x93, 0x48, 0xb8, 0xd9, 0x29, 0xe4, 0xa5, 0xe3, 0xb5,
+	0x98, 0xf7, 0x17, 0x93, 0xd8, 0xf9, 0x04, 0xeb, 0x0f, 0xee, 0x13, 0xda, 0x82, 0x4a, 0x0f, 0xb7,
+	0x3b, 0xc7, 0xa6, 0xeb, 0xe0, 0xf6, 0x89, 0x7d, 0xda, 0xc3, 0x8e, 0x6b, 0x75, 0xda, 0x5a, 0xae,
+	0x56, 0xbc, 0xbc, 0x32, 0x0a, 0x56, 0xa7, 0xfd, 0xf7, 0xc8, 0x61, 0x47, 0x53, 0xd2, 0xc8, 0x61,
+	0x67, 0xe7, 0xbb, 0x02, 0xe5, 0xac, 0x51, 0xd4, 0x84, 0xcd, 0xae, 0xe9, 0x60, 0xab, 0xe3, 0xda,
+	0x4e, 0xdb, 0xe9, 0xdb, 0x6e, 0xff, 0xc4, 0x3e, 0x35, 0x3b, 0xd6, 0x3b, 0xcb, 0x3c, 0xd2, 0x72,
+	0xb5, 0xf5, 0xcb, 0x2b, 0x63, 0x25, 0x83, 0xd0, 0x0b, 0xa8, 0xfc, 0x99, 0xff, 0xd8, 0x37, 0xfb,
+	0xe6, 0x91, 0xa6, 0xd4, 0xe0, 0xf2, 0xca, 0x50, 0x93, 0xea, 0xf1, 0xae, 0xd6, 0x89, 0x7b, 0x8a,
+	0x7b, 0xef, 0xb1, 0x69, 0xdb, 0x5a, 0x3e, 0xd9, 0x35, 0x83, 0x0e, 0xbb, 0x3f, 0x6f, 0x75, 0xe5,
+	0xfa, 0x56, 0x57, 0x7e, 0xdd, 0xea, 0xca, 0xb7, 0x3b, 0x3d, 0x77, 0x7d, 0xa7, 0xe7, 0x6e, 0xee,
+	0xf4, 0xdc, 0xe7, 0xfd, 0x21, 0x17, 0xa3, 0xd9, 0xa0, 0x49, 0x83, 0x49, 0xcb, 0x96, 0x77, 0x63,
+	0xf7, 0x98, 0x0c, 0xa2, 0x56, 0xfa, 0x88, 0x9d, 0xef, 0xbd, 0x6e, 0x7d, 0xcd, 0x3c, 0x65, 0xb1,
+	0xf9, 0x68, 0xa0, 0xca, 0xf7, 0x69, 0xff, 0xf7, 0x00, 0x19, 0x0c, 0x4d, 0xb8, 0xeb, 0x04, 0x00,
+	0x00,
 }
 
 func (m *Oracle) Marshal() (dAtA []byte, err error) {
@@ -360,6 +518,20 @@ func (m *Oracle) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.MetricSubscriptions) > 0 {
+		for iNdEx := len(m.MetricSubscriptions) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.MetricSubscriptions[iNdEx])
+			copy(dAtA[i:], m.MetricSubscriptions[iNdEx])
+			i = encodeVarintIcaoracle(dAtA, i, uint64(len(m.MetricSubscriptions[iNdEx])))
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if m.Transport != 0 {
+		i = encodeVarintIcaoracle(dAtA, i, uint64(m.Transport))
+		i--
+		dAtA[i] = 0x40
+	}
 	if m.Active {
 		i--
 		if m.Active {
@@ -518,6 +690,71 @@ func (m *RedemptionRateAttributes) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
+func (m *StTokenMetricAttributes) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StTokenMetricAttributes) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StTokenMetricAttributes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SttokenDenom) > 0 {
+		i -= len(m.SttokenDenom)
+		copy(dAtA[i:], m.SttokenDenom)
+		i = encodeVarintIcaoracle(dAtA, i, uint64(len(m.SttokenDenom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MetricTypeUpdate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MetricTypeUpdate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MetricTypeUpdate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LastUpdateTime != 0 {
+		i = encodeVarintIcaoracle(dAtA, i, uint64(m.LastUpdateTime))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.MetricType) > 0 {
+		i -= len(m.MetricType)
+		copy(dAtA[i:], m.MetricType)
+		i = encodeVarintIcaoracle(dAtA, i, uint64(len(m.MetricType)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintIcaoracle(dAtA []byte, offset int, v uint64) int {
 	offset -= sovIcaoracle(v)
 	base := offset
@@ -562,6 +799,15 @@ func (m *Oracle) Size() (n int) {
 	if m.Active {
 		n += 2
 	}
+	if m.Transport != 0 {
+		n += 1 + sovIcaoracle(uint64(m.Transport))
+	}
+	if len(m.MetricSubscriptions) > 0 {
+		for _, s := range m.MetricSubscriptions {
+			l = len(s)
+			n += 1 + l + sovIcaoracle(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -616,6 +862,35 @@ func (m *RedemptionRateAttributes) Size() (n int) {
 	return n
 }
 
+func (m *StTokenMetricAttributes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SttokenDenom)
+	if l > 0 {
+		n += 1 + l + sovIcaoracle(uint64(l))
+	}
+	return n
+}
+
+func (m *MetricTypeUpdate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MetricType)
+	if l > 0 {
+		n += 1 + l + sovIcaoracle(uint64(l))
+	}
+	if m.LastUpdateTime != 0 {
+		n += 1 + sovIcaoracle(uint64(m.LastUpdateTime))
+	}
+	return n
+}
+
 func sovIcaoracle(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -863,6 +1138,57 @@ func (m *Oracle) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.Active = bool(v != 0)
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Transport", wireType)
+			}
+			m.Transport = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIcaoracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Transport |= OracleTransport(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MetricSubscriptions", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIcaoracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIcaoracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIcaoracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MetricSubscriptions = append(m.MetricSubscriptions, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipIcaoracle(dAtA[iNdEx:])
@@ -1233,6 +1559,189 @@ func (m *RedemptionRateAttributes) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *StTokenMetricAttributes) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowIcaoracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StTokenMetricAttributes: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StTokenMetricAttributes: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SttokenDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIcaoracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIcaoracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIcaoracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SttokenDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipIcaoracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthIcaoracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MetricTypeUpdate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowIcaoracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MetricTypeUpdate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MetricTypeUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MetricType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIcaoracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIcaoracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIcaoracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MetricType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastUpdateTime", wireType)
+			}
+			m.LastUpdateTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIcaoracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastUpdateTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipIcaoracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthIcaoracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipIcaoracle(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
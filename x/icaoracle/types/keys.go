@@ -11,6 +11,12 @@ const (
 
 	// QuerierRoute defines the module's query routing key
 	QuerierRoute = ModuleName
+
+	// PortID is the port used for IBC oracle channels (as opposed to ICA oracle channels)
+	PortID = ModuleName
+
+	// Version is the channel version used for IBC oracle channels
+	Version = "stride-oracle-1"
 )
 
 // Generates a key byte prefix from a string
@@ -22,4 +28,7 @@ var (
 	OracleKeyPrefix      = KeyPrefix("oracle")
 	MetricKeyPrefix      = KeyPrefix("metric")
 	MetricQueueKeyPrefix = KeyPrefix("queue")
+	ParamsKey            = KeyPrefix("params")
+	// Note: must not be prefixed by "metric", which would overlap with the metric store
+	LastMetricUpdateKeyPrefix = KeyPrefix("last-update")
 )
@@ -0,0 +1,62 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/migrations/legacytx"
+	host "github.com/cosmos/ibc-go/v7/modules/core/24-host"
+
+	"github.com/Stride-Labs/stride/v24/utils"
+)
+
+const TypeMsgAddIBCOracle = "add_ibc_oracle"
+
+var (
+	_ sdk.Msg            = &MsgAddIBCOracle{}
+	_ legacytx.LegacyMsg = &MsgAddIBCOracle{}
+)
+
+func NewMsgAddIBCOracle(creator string, channelId string) *MsgAddIBCOracle {
+	return &MsgAddIBCOracle{
+		Creator:   creator,
+		ChannelId: channelId,
+	}
+}
+
+func (msg MsgAddIBCOracle) Type() string {
+	return TypeMsgAddIBCOracle
+}
+
+func (msg MsgAddIBCOracle) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgAddIBCOracle) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgAddIBCOracle) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgAddIBCOracle) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if err := utils.ValidateAdminAddress(msg.Creator); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+
+	if err := host.ChannelIdentifierValidator(msg.ChannelId); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "invalid channel-id (%s): %s", msg.ChannelId, err.Error())
+	}
+
+	return nil
+}
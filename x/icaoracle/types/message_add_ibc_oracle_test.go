@@ -0,0 +1,70 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/Stride-Labs/stride/v24/app/apptesting"
+	"github.com/Stride-Labs/stride/v24/x/icaoracle/types"
+)
+
+func TestMsgAddIBCOracle(t *testing.T) {
+	apptesting.SetupConfig()
+
+	validNotAdminAddress, invalidAddress := apptesting.GenerateTestAddrs()
+	validAdminAddress, ok := apptesting.GetAdminAddress()
+	require.True(t, ok)
+
+	validChannelId := "channel-10"
+
+	tests := []struct {
+		name string
+		msg  types.MsgAddIBCOracle
+		err  string
+	}{
+		{
+			name: "successful message",
+			msg: types.MsgAddIBCOracle{
+				Creator:   validAdminAddress,
+				ChannelId: validChannelId,
+			},
+		},
+		{
+			name: "invalid creator address",
+			msg: types.MsgAddIBCOracle{
+				Creator:   invalidAddress,
+				ChannelId: validChannelId,
+			},
+			err: "invalid creator address",
+		},
+		{
+			name: "invalid admin address",
+			msg: types.MsgAddIBCOracle{
+				Creator:   validNotAdminAddress,
+				ChannelId: validChannelId,
+			},
+			err: "invalid creator address",
+		},
+		{
+			name: "invalid channel-id",
+			msg: types.MsgAddIBCOracle{
+				Creator:   validAdminAddress,
+				ChannelId: "channel",
+			},
+			err: "invalid channel-id",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if test.err == "" {
+				require.NoError(t, test.msg.ValidateBasic(), "test: %v", test.name)
+				require.Equal(t, test.msg.ChannelId, validChannelId, "channel-id")
+				require.Equal(t, test.msg.Type(), "add_ibc_oracle", "type")
+			} else {
+				require.ErrorContains(t, test.msg.ValidateBasic(), test.err, "test: %v", test.name)
+			}
+		})
+	}
+}
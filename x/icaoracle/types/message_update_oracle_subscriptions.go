@@ -0,0 +1,56 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/migrations/legacytx"
+)
+
+const TypeMsgUpdateOracleSubscriptions = "update_oracle_subscriptions"
+
+var (
+	_ sdk.Msg            = &MsgUpdateOracleSubscriptions{}
+	_ legacytx.LegacyMsg = &MsgUpdateOracleSubscriptions{}
+)
+
+func (msg MsgUpdateOracleSubscriptions) Type() string {
+	return TypeMsgUpdateOracleSubscriptions
+}
+
+func (msg MsgUpdateOracleSubscriptions) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgUpdateOracleSubscriptions) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgUpdateOracleSubscriptions) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(msg.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+func (msg *MsgUpdateOracleSubscriptions) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return errorsmod.Wrap(err, "invalid authority address")
+	}
+
+	if msg.OracleChainId == "" {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "oracle-chain-id is required")
+	}
+
+	metricTypes := map[string]bool{}
+	for _, metricType := range msg.MetricTypes {
+		if metricType == "" {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "metric type cannot be empty")
+		}
+		if metricTypes[metricType] {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "duplicate metric type %s", metricType)
+		}
+		metricTypes[metricType] = true
+	}
+
+	return nil
+}
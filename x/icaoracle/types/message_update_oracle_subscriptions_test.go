@@ -0,0 +1,86 @@
+package types_test
+
+import (
+	"testing"
+
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Stride-Labs/stride/v24/app/apptesting"
+	"github.com/Stride-Labs/stride/v24/x/icaoracle/types"
+)
+
+func TestMsgUpdateOracleSubscriptions(t *testing.T) {
+	apptesting.SetupConfig()
+
+	validAuthority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	validChainId := "chain-1"
+
+	tests := []struct {
+		name string
+		msg  types.MsgUpdateOracleSubscriptions
+		err  string
+	}{
+		{
+			name: "successful message",
+			msg: types.MsgUpdateOracleSubscriptions{
+				Authority:     validAuthority,
+				OracleChainId: validChainId,
+				MetricTypes:   []string{types.MetricType_TVL, types.MetricType_APR},
+			},
+		},
+		{
+			name: "successful message with no subscriptions",
+			msg: types.MsgUpdateOracleSubscriptions{
+				Authority:     validAuthority,
+				OracleChainId: validChainId,
+			},
+		},
+		{
+			name: "invalid authority",
+			msg: types.MsgUpdateOracleSubscriptions{
+				Authority:     "invalid",
+				OracleChainId: validChainId,
+			},
+			err: "invalid authority address",
+		},
+		{
+			name: "empty chain-id",
+			msg: types.MsgUpdateOracleSubscriptions{
+				Authority:     validAuthority,
+				OracleChainId: "",
+			},
+			err: "oracle-chain-id is required",
+		},
+		{
+			name: "empty metric type",
+			msg: types.MsgUpdateOracleSubscriptions{
+				Authority:     validAuthority,
+				OracleChainId: validChainId,
+				MetricTypes:   []string{types.MetricType_TVL, ""},
+			},
+			err: "metric type cannot be empty",
+		},
+		{
+			name: "duplicate metric type",
+			msg: types.MsgUpdateOracleSubscriptions{
+				Authority:     validAuthority,
+				OracleChainId: validChainId,
+				MetricTypes:   []string{types.MetricType_TVL, types.MetricType_TVL},
+			},
+			err: "duplicate metric type tvl",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if test.err == "" {
+				require.NoError(t, test.msg.ValidateBasic(), "test: %v", test.name)
+				require.Equal(t, test.msg.Type(), "update_oracle_subscriptions", "type")
+			} else {
+				require.ErrorContains(t, test.msg.ValidateBasic(), test.err, "test: %v", test.name)
+			}
+		})
+	}
+}
@@ -0,0 +1,59 @@
+package types_test
+
+import (
+	"testing"
+
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Stride-Labs/stride/v24/app/apptesting"
+	"github.com/Stride-Labs/stride/v24/x/icaoracle/types"
+)
+
+func TestMsgUpdateParams(t *testing.T) {
+	apptesting.SetupConfig()
+
+	validAuthority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+
+	tests := []struct {
+		name string
+		msg  types.MsgUpdateParams
+		err  string
+	}{
+		{
+			name: "successful message",
+			msg: types.MsgUpdateParams{
+				Authority: validAuthority,
+				Params:    types.NewParams(5, types.DefaultMetricCadences),
+			},
+		},
+		{
+			name: "invalid authority",
+			msg: types.MsgUpdateParams{
+				Authority: "invalid",
+				Params:    types.NewParams(5, types.DefaultMetricCadences),
+			},
+			err: "invalid authority address",
+		},
+		{
+			name: "zero max metrics per tx",
+			msg: types.MsgUpdateParams{
+				Authority: validAuthority,
+				Params:    types.NewParams(0, types.DefaultMetricCadences),
+			},
+			err: "max metrics per tx must be greater than 0",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if test.err == "" {
+				require.NoError(t, test.msg.ValidateBasic(), "test: %v", test.name)
+				require.Equal(t, test.msg.Type(), "update_params", "type")
+			} else {
+				require.ErrorContains(t, test.msg.ValidateBasic(), test.err, "test: %v", test.name)
+			}
+		})
+	}
+}
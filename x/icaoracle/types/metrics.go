@@ -1,5 +1,9 @@
 package types
 
 var (
-	MetricType_RedemptionRate = "redemption_rate"
+	MetricType_RedemptionRate     = "redemption_rate"
+	MetricType_TVL                = "tvl"
+	MetricType_APR                = "apr"
+	MetricType_PendingRedemptions = "pending_redemptions"
+	MetricType_Halted             = "halted"
 )
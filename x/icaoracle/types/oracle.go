@@ -26,3 +26,30 @@ func (o Oracle) ValidateContractInstantiated() error {
 	}
 	return nil
 }
+
+func (o Oracle) ValidateIBCSetup() error {
+	if o.ConnectionId == "" {
+		return errorsmod.Wrapf(ErrInvalidOracleChannel, "connectionId is empty")
+	}
+	if o.ChannelId == "" {
+		return errorsmod.Wrapf(ErrInvalidOracleChannel, "channelId is empty")
+	}
+	if o.PortId != PortID {
+		return errorsmod.Wrapf(ErrInvalidOracleChannel, "portId must be %s", PortID)
+	}
+	return nil
+}
+
+// Checks if the oracle should receive metrics of the given type
+// An oracle without any subscriptions receives every metric type
+func (o Oracle) IsSubscribedTo(metricType string) bool {
+	if len(o.MetricSubscriptions) == 0 {
+		return true
+	}
+	for _, subscribedType := range o.MetricSubscriptions {
+		if subscribedType == metricType {
+			return true
+		}
+	}
+	return false
+}
@@ -85,3 +85,17 @@ func TestValidateContractInstantiated(t *testing.T) {
 	require.NoError(t, types.Oracle{ContractAddress: "contract"}.ValidateContractInstantiated())
 	require.ErrorContains(t, types.Oracle{}.ValidateContractInstantiated(), "contract address is empty")
 }
+
+func TestIsSubscribedTo(t *testing.T) {
+	// An oracle without subscriptions should receive all metric types
+	oracle := types.Oracle{}
+	require.True(t, oracle.IsSubscribedTo(types.MetricType_RedemptionRate), "no subscriptions - redemption rate")
+	require.True(t, oracle.IsSubscribedTo(types.MetricType_TVL), "no subscriptions - tvl")
+
+	// An oracle with subscriptions should only receive those metric types
+	oracle.MetricSubscriptions = []string{types.MetricType_TVL, types.MetricType_Halted}
+	require.True(t, oracle.IsSubscribedTo(types.MetricType_TVL), "with subscriptions - tvl")
+	require.True(t, oracle.IsSubscribedTo(types.MetricType_Halted), "with subscriptions - halted")
+	require.False(t, oracle.IsSubscribedTo(types.MetricType_RedemptionRate), "with subscriptions - redemption rate")
+	require.False(t, oracle.IsSubscribedTo(types.MetricType_APR), "with subscriptions - apr")
+}
@@ -0,0 +1,27 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Builds the packet data for a batch of metric updates sent over an IBC oracle channel
+func NewOraclePacketData(metrics []Metric) OraclePacketData {
+	metricPackets := []MetricPacketData{}
+	for _, metric := range metrics {
+		metricPackets = append(metricPackets, MetricPacketData{
+			Key:         metric.Key,
+			Value:       metric.Value,
+			MetricType:  metric.MetricType,
+			UpdateTime:  metric.UpdateTime,
+			BlockHeight: metric.BlockHeight,
+			Attributes:  metric.Attributes,
+		})
+	}
+	return OraclePacketData{Metrics: metricPackets}
+}
+
+// Serializes the packet data as sorted JSON so that it can be parsed by
+// non-Go receivers (e.g. an IBC-enabled contract)
+func (p OraclePacketData) GetBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&p))
+}
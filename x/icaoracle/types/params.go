@@ -1,9 +1,26 @@
 package types
 
 import (
+	errorsmod "cosmossdk.io/errors"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 )
 
+const (
+	// Default maximum number of metrics batched into a single ICA tx
+	DefaultMaxMetricsPerTx uint64 = 10
+
+	// Default cadence for a metric producer that does not have a cadence configured
+	DefaultMetricCadenceSeconds uint64 = 60 * 60 * 24 // 1 day
+)
+
+// Default cadences for each of the metric types produced by stakeibc, staketia and stakedym
+var DefaultMetricCadences = []MetricCadence{
+	{MetricType: MetricType_TVL, IntervalSeconds: 60 * 60 * 6},                // 6 hours
+	{MetricType: MetricType_APR, IntervalSeconds: 60 * 60 * 24},               // 1 day
+	{MetricType: MetricType_PendingRedemptions, IntervalSeconds: 60 * 60 * 6}, // 6 hours
+	{MetricType: MetricType_Halted, IntervalSeconds: 60 * 10},                 // 10 minutes
+}
+
 var _ paramtypes.ParamSet = (*Params)(nil)
 
 // ParamKeyTable the param key table for launch module
@@ -12,13 +29,16 @@ func ParamKeyTable() paramtypes.KeyTable {
 }
 
 // NewParams creates a new Params instance
-func NewParams() Params {
-	return Params{}
+func NewParams(maxMetricsPerTx uint64, metricCadences []MetricCadence) Params {
+	return Params{
+		MaxMetricsPerTx: maxMetricsPerTx,
+		MetricCadences:  metricCadences,
+	}
 }
 
 // DefaultParams returns a default set of parameters
 func DefaultParams() Params {
-	return NewParams()
+	return NewParams(DefaultMaxMetricsPerTx, DefaultMetricCadences)
 }
 
 // ParamSetPairs get the params.ParamSet
@@ -28,5 +48,34 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 
 // Validate validates the set of params
 func (p Params) Validate() error {
+	if p.MaxMetricsPerTx == 0 {
+		return errorsmod.Wrapf(ErrInvalidParams, "max metrics per tx must be greater than 0")
+	}
+
+	metricTypes := map[string]bool{}
+	for _, cadence := range p.MetricCadences {
+		if cadence.MetricType == "" {
+			return errorsmod.Wrapf(ErrInvalidParams, "metric cadence has an empty metric type")
+		}
+		if cadence.IntervalSeconds == 0 {
+			return errorsmod.Wrapf(ErrInvalidParams, "metric cadence interval must be greater than 0 for %s", cadence.MetricType)
+		}
+		if metricTypes[cadence.MetricType] {
+			return errorsmod.Wrapf(ErrInvalidParams, "duplicate metric cadence for %s", cadence.MetricType)
+		}
+		metricTypes[cadence.MetricType] = true
+	}
+
 	return nil
 }
+
+// Returns the configured cadence (in seconds) for a metric type,
+// or the default cadence if one is not configured
+func (p Params) GetMetricCadenceSeconds(metricType string) uint64 {
+	for _, cadence := range p.MetricCadences {
+		if cadence.MetricType == metricType {
+			return cadence.IntervalSeconds
+		}
+	}
+	return DefaultMetricCadenceSeconds
+}
@@ -0,0 +1,23 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// A single metric value computed by a metric producer
+// The metric type is determined by the producer that returned the value
+type MetricValue struct {
+	Key        string
+	Value      string
+	Attributes string
+}
+
+// A function that computes the latest values for a metric type
+type MetricProducerFunc func(ctx sdk.Context) ([]MetricValue, error)
+
+// Metric producers are registered with the icaoracle keeper by other modules,
+// and are run at the cadence configured in the params for their metric type
+type MetricProducer struct {
+	MetricType  string
+	ProduceFunc MetricProducerFunc
+}
@@ -385,6 +385,87 @@ func (m *QueryMetricsResponse) GetMetrics() []Metric {
 	return nil
 }
 
+// Query's the module parameters
+type QueryParamsRequest struct {
+}
+
+func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
+func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsRequest) ProtoMessage()    {}
+func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d4d4563f64cd9510, []int{8}
+}
+func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParamsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsRequest.Merge(m, src)
+}
+func (m *QueryParamsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParamsRequest proto.InternalMessageInfo
+
+type QueryParamsResponse struct {
+	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+}
+
+func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
+func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsResponse) ProtoMessage()    {}
+func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d4d4563f64cd9510, []int{9}
+}
+func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsResponse.Merge(m, src)
+}
+func (m *QueryParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParamsResponse proto.InternalMessageInfo
+
+func (m *QueryParamsResponse) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
 func init() {
 	proto.RegisterType((*QueryOracleRequest)(nil), "stride.icaoracle.QueryOracleRequest")
 	proto.RegisterType((*QueryOracleResponse)(nil), "stride.icaoracle.QueryOracleResponse")
@@ -394,45 +475,51 @@ func init() {
 	proto.RegisterType((*QueryActiveOraclesResponse)(nil), "stride.icaoracle.QueryActiveOraclesResponse")
 	proto.RegisterType((*QueryMetricsRequest)(nil), "stride.icaoracle.QueryMetricsRequest")
 	proto.RegisterType((*QueryMetricsResponse)(nil), "stride.icaoracle.QueryMetricsResponse")
+	proto.RegisterType((*QueryParamsRequest)(nil), "stride.icaoracle.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "stride.icaoracle.QueryParamsResponse")
 }
 
 func init() { proto.RegisterFile("stride/icaoracle/query.proto", fileDescriptor_d4d4563f64cd9510) }
 
 var fileDescriptor_d4d4563f64cd9510 = []byte{
-	// 518 bytes of a gzipped FileDescriptorProto
+	// 578 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x54, 0x41, 0x6b, 0x13, 0x41,
-	0x14, 0xce, 0xd4, 0x9a, 0xb4, 0x4f, 0x8a, 0xf2, 0x2c, 0x35, 0x5d, 0xea, 0x1a, 0x16, 0x5b, 0x2b,
-	0xda, 0x1d, 0x4d, 0x04, 0xbd, 0x5a, 0x0f, 0x22, 0x5a, 0xd4, 0x14, 0x3c, 0x88, 0x10, 0x36, 0x9b,
-	0x61, 0x3b, 0x98, 0xee, 0xa4, 0x3b, 0x93, 0xe2, 0x22, 0x5e, 0xbc, 0x78, 0x93, 0x82, 0x3f, 0xc1,
-	0x3f, 0xd3, 0x63, 0xc1, 0x8b, 0x20, 0x88, 0x24, 0xfe, 0x10, 0xe9, 0xcc, 0x24, 0x75, 0xdd, 0xa4,
-	0x89, 0x7a, 0xca, 0xec, 0x7b, 0xdf, 0xfb, 0xbe, 0x6f, 0xde, 0x7c, 0x04, 0x56, 0xa4, 0x4a, 0x78,
-	0x8b, 0x51, 0x1e, 0x06, 0x22, 0x09, 0xc2, 0x36, 0xa3, 0x7b, 0x5d, 0x96, 0xa4, 0x7e, 0x27, 0x11,
-	0x4a, 0xe0, 0x05, 0xd3, 0xf5, 0x87, 0x5d, 0xa7, 0x92, 0xc3, 0x0f, 0x4f, 0x66, 0xc6, 0x59, 0x89,
-	0x84, 0x88, 0xda, 0x8c, 0x06, 0x1d, 0x4e, 0x83, 0x38, 0x16, 0x2a, 0x50, 0x5c, 0xc4, 0xd2, 0x76,
-	0x17, 0x23, 0x11, 0x09, 0x7d, 0xa4, 0xc7, 0x27, 0x53, 0xf5, 0x28, 0xe0, 0xf3, 0x63, 0xd9, 0xa7,
-	0x9a, 0xa8, 0xce, 0xf6, 0xba, 0x4c, 0x2a, 0x5c, 0x86, 0xb9, 0x70, 0x27, 0xe0, 0x71, 0x83, 0xb7,
-	0xca, 0xa4, 0x42, 0xd6, 0xe7, 0xeb, 0x25, 0xfd, 0xfd, 0xa8, 0xe5, 0x3d, 0x84, 0x8b, 0x99, 0x01,
-	0xd9, 0x11, 0xb1, 0x64, 0x78, 0x0b, 0x8a, 0xc6, 0x8b, 0xc6, 0x9f, 0xab, 0x96, 0xfd, 0x3f, 0x2f,
-	0xe0, 0xdb, 0x09, 0x8b, 0xf3, 0xca, 0xb0, 0xa4, 0x89, 0xee, 0xb7, 0xdb, 0xa6, 0x23, 0xad, 0xba,
-	0xb7, 0x0d, 0x97, 0x72, 0x1d, 0x2b, 0x73, 0x0f, 0x4a, 0x66, 0x5c, 0x96, 0x49, 0xe5, 0xcc, 0x69,
-	0x3a, 0x9b, 0xb3, 0x87, 0xdf, 0xaf, 0x14, 0xea, 0x03, 0xb8, 0x57, 0x83, 0x65, 0x43, 0x1a, 0x2a,
-	0xbe, 0xcf, 0xb2, 0x8a, 0xb8, 0x04, 0xc5, 0x40, 0xd7, 0xb5, 0xfb, 0xb9, 0xba, 0xfd, 0xf2, 0x5e,
-	0x80, 0x33, 0x6a, 0xe8, 0xbf, 0xcd, 0xbc, 0xb2, 0x4b, 0xdc, 0x62, 0x2a, 0xe1, 0xe1, 0xd0, 0xc6,
-	0x65, 0x80, 0x5d, 0x5d, 0x69, 0xbc, 0x66, 0xa9, 0x5d, 0xfc, 0xbc, 0xa9, 0x3c, 0x66, 0x29, 0xae,
-	0xc1, 0x79, 0x43, 0xd0, 0x18, 0x3e, 0xce, 0x8c, 0xc6, 0x2c, 0x98, 0xf2, 0x03, 0xfb, 0x44, 0xcf,
-	0x60, 0x31, 0xcb, 0x7e, 0xe2, 0xd7, 0x90, 0x9d, 0xe2, 0xd7, 0xcc, 0x0c, 0xfc, 0x5a, 0x78, 0xf5,
-	0xdb, 0x2c, 0x9c, 0xd5, 0x94, 0xf8, 0x91, 0x40, 0xd1, 0xdc, 0x09, 0xaf, 0xe6, 0xa7, 0xf3, 0x51,
-	0x72, 0x56, 0x27, 0xa0, 0x8c, 0x37, 0xef, 0xee, 0xfb, 0x2f, 0x3f, 0x3f, 0xcd, 0xdc, 0x46, 0x4a,
-	0xb7, 0x35, 0x7c, 0xe3, 0x49, 0xd0, 0x94, 0x34, 0x17, 0x79, 0xfb, 0xf3, 0x76, 0xb0, 0x80, 0x77,
-	0x78, 0x40, 0x00, 0x4e, 0x82, 0x82, 0xeb, 0x63, 0xe4, 0x72, 0x29, 0x73, 0xae, 0x4f, 0x81, 0xb4,
-	0xe6, 0x36, 0xb4, 0xb9, 0x6b, 0xb8, 0x3a, 0x8d, 0x39, 0x89, 0x9f, 0x09, 0x2c, 0x64, 0x12, 0x83,
-	0x37, 0xc6, 0x69, 0x8d, 0x08, 0xa3, 0x73, 0x73, 0x3a, 0xf0, 0xbf, 0x2c, 0x4e, 0xd2, 0x66, 0xda,
-	0x30, 0xd9, 0xc6, 0x0f, 0x04, 0x4a, 0x36, 0x21, 0x38, 0xee, 0x91, 0xb2, 0xf9, 0x74, 0xd6, 0x26,
-	0xc1, 0xfe, 0x6e, 0x5f, 0x36, 0x5d, 0x9b, 0x5b, 0x87, 0x3d, 0x97, 0x1c, 0xf5, 0x5c, 0xf2, 0xa3,
-	0xe7, 0x92, 0x83, 0xbe, 0x5b, 0x38, 0xea, 0xbb, 0x85, 0xaf, 0x7d, 0xb7, 0xf0, 0xb2, 0x16, 0x71,
-	0xb5, 0xd3, 0x6d, 0xfa, 0xa1, 0xd8, 0x1d, 0x45, 0xb5, 0x5f, 0xbd, 0x43, 0xdf, 0xfc, 0x46, 0xa8,
-	0xd2, 0x0e, 0x93, 0xcd, 0xa2, 0xfe, 0x67, 0xab, 0xfd, 0x0a, 0x00, 0x00, 0xff, 0xff, 0xac, 0x1f,
-	0x56, 0xf6, 0x61, 0x05, 0x00, 0x00,
+	0x14, 0xce, 0x54, 0xbb, 0x6d, 0x9f, 0x14, 0x65, 0x1a, 0x6a, 0xba, 0xd4, 0x35, 0x2c, 0x4d, 0xac,
+	0xd8, 0x66, 0x34, 0x11, 0xf5, 0x6a, 0x3d, 0x88, 0x68, 0xb0, 0xa6, 0xe0, 0x41, 0x84, 0xb0, 0xd9,
+	0x0c, 0xdb, 0xc5, 0x64, 0x27, 0xdd, 0xd9, 0x14, 0x83, 0x78, 0xd1, 0x83, 0x37, 0x29, 0xf8, 0x13,
+	0xfc, 0x33, 0x3d, 0x16, 0xbc, 0x78, 0x12, 0x4d, 0xfc, 0x21, 0xd2, 0x99, 0x97, 0xd4, 0x75, 0xb3,
+	0x49, 0xd4, 0x53, 0x67, 0xdf, 0xfb, 0xbe, 0xef, 0x7d, 0x7d, 0xf3, 0x4d, 0x60, 0x5d, 0x46, 0xa1,
+	0xdf, 0xe4, 0xcc, 0x77, 0x1d, 0x11, 0x3a, 0x6e, 0x8b, 0xb3, 0x83, 0x2e, 0x0f, 0x7b, 0xa5, 0x4e,
+	0x28, 0x22, 0x41, 0x2f, 0xe9, 0x6e, 0x69, 0xd4, 0x35, 0xf3, 0x09, 0xfc, 0xe8, 0xa4, 0x39, 0xe6,
+	0xba, 0x27, 0x84, 0xd7, 0xe2, 0xcc, 0xe9, 0xf8, 0xcc, 0x09, 0x02, 0x11, 0x39, 0x91, 0x2f, 0x02,
+	0x89, 0xdd, 0xac, 0x27, 0x3c, 0xa1, 0x8e, 0xec, 0xf4, 0x84, 0x55, 0x2b, 0xa1, 0xea, 0xf1, 0x80,
+	0x4b, 0x1f, 0x59, 0x36, 0x03, 0xfa, 0xec, 0xd4, 0xd6, 0x53, 0xd5, 0xac, 0xf1, 0x83, 0x2e, 0x97,
+	0x11, 0x5d, 0x83, 0x45, 0x77, 0xdf, 0xf1, 0x83, 0xba, 0xdf, 0xcc, 0x91, 0x3c, 0xd9, 0x5c, 0xaa,
+	0x2d, 0xa8, 0xef, 0x47, 0x4d, 0xfb, 0x21, 0xac, 0xc4, 0x08, 0xb2, 0x23, 0x02, 0xc9, 0xe9, 0x4d,
+	0x30, 0xb4, 0xbe, 0xc2, 0x5f, 0x28, 0xe7, 0x4a, 0x7f, 0xfe, 0x83, 0x25, 0x64, 0x20, 0xce, 0xce,
+	0xc1, 0xaa, 0x12, 0xba, 0xdf, 0x6a, 0xe9, 0x8e, 0xc4, 0xe9, 0xf6, 0x1e, 0x5c, 0x4e, 0x74, 0x70,
+	0xcc, 0x3d, 0x58, 0xd0, 0x74, 0x99, 0x23, 0xf9, 0x73, 0x93, 0xe6, 0xec, 0x9c, 0x3f, 0xfe, 0x76,
+	0x35, 0x53, 0x1b, 0xc2, 0xed, 0x0a, 0xac, 0x69, 0x51, 0x37, 0xf2, 0x0f, 0x79, 0x7c, 0x22, 0x5d,
+	0x05, 0xc3, 0x51, 0x75, 0xe5, 0x7e, 0xb1, 0x86, 0x5f, 0xf6, 0x73, 0x30, 0xc7, 0x91, 0xfe, 0xdb,
+	0xcc, 0x4b, 0x5c, 0x62, 0x95, 0x47, 0xa1, 0xef, 0x8e, 0x6c, 0x5c, 0x01, 0x68, 0xab, 0x4a, 0xfd,
+	0x15, 0xef, 0xe1, 0xe2, 0x97, 0x74, 0xe5, 0x31, 0xef, 0xd1, 0x22, 0x5c, 0xd4, 0x02, 0xf5, 0xd1,
+	0xe5, 0xcc, 0x29, 0xcc, 0xb2, 0x2e, 0x3f, 0xc0, 0x2b, 0xda, 0x85, 0x6c, 0x5c, 0xfd, 0xcc, 0xaf,
+	0x16, 0x9b, 0xe0, 0x57, 0x73, 0x86, 0x7e, 0x11, 0x6e, 0x67, 0x31, 0x25, 0xbb, 0x4e, 0xe8, 0xb4,
+	0x47, 0xf7, 0x54, 0x85, 0x95, 0x58, 0x15, 0xc7, 0xdc, 0x01, 0xa3, 0xa3, 0x2a, 0xe9, 0x51, 0xd0,
+	0x0c, 0x9c, 0x82, 0xe8, 0xf2, 0x8f, 0x79, 0x98, 0x57, 0x7a, 0xf4, 0x23, 0x01, 0x43, 0x2f, 0x8e,
+	0x6e, 0x24, 0xc9, 0xc9, 0xbc, 0x9a, 0x85, 0x29, 0x28, 0xed, 0xcc, 0xbe, 0xfb, 0xee, 0xcb, 0xcf,
+	0x4f, 0x73, 0xb7, 0x28, 0x63, 0x7b, 0x0a, 0xbe, 0xfd, 0xc4, 0x69, 0x48, 0x96, 0x78, 0x21, 0xf8,
+	0xe7, 0xcd, 0x70, 0xcb, 0x6f, 0xe9, 0x11, 0x01, 0x38, 0x4b, 0x23, 0xdd, 0x4c, 0x19, 0x97, 0x88,
+	0xb2, 0x79, 0x7d, 0x06, 0x24, 0x9a, 0xdb, 0x56, 0xe6, 0xae, 0xd1, 0xc2, 0x2c, 0xe6, 0x24, 0xfd,
+	0x4c, 0x60, 0x39, 0x16, 0x4b, 0x7a, 0x23, 0x6d, 0xd6, 0x98, 0xc4, 0x9b, 0x5b, 0xb3, 0x81, 0xff,
+	0x65, 0x71, 0x92, 0x35, 0x7a, 0x75, 0xfd, 0x80, 0xe8, 0x07, 0x02, 0x0b, 0x18, 0x43, 0x9a, 0x76,
+	0x49, 0xf1, 0x47, 0x60, 0x16, 0xa7, 0xc1, 0xfe, 0x6e, 0x5f, 0x18, 0x61, 0xfa, 0x9e, 0x80, 0xa1,
+	0x63, 0x97, 0x9a, 0xa9, 0x58, 0xba, 0xcd, 0xc2, 0x14, 0x14, 0xda, 0xd8, 0x52, 0x36, 0x8a, 0x74,
+	0x63, 0xb2, 0x0d, 0x9d, 0xf1, 0x9d, 0xea, 0x71, 0xdf, 0x22, 0x27, 0x7d, 0x8b, 0x7c, 0xef, 0x5b,
+	0xe4, 0x68, 0x60, 0x65, 0x4e, 0x06, 0x56, 0xe6, 0xeb, 0xc0, 0xca, 0xbc, 0xa8, 0x78, 0x7e, 0xb4,
+	0xdf, 0x6d, 0x94, 0x5c, 0xd1, 0x1e, 0xa7, 0x74, 0x58, 0xbe, 0xcd, 0x5e, 0xff, 0xa6, 0x17, 0xf5,
+	0x3a, 0x5c, 0x36, 0x0c, 0xf5, 0x23, 0x5e, 0xf9, 0x35, 0x00, 0xdf, 0xdd, 0xd6, 0x92, 0x6c, 0x06,
+	0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -462,6 +549,8 @@ type QueryClient interface {
 	// - /metrics?metric_key=X
 	// - /metrics?oracle_chain_id=Y
 	Metrics(ctx context.Context, in *QueryMetricsRequest, opts ...grpc.CallOption) (*QueryMetricsResponse, error)
+	// Query the module parameters
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
 }
 
 type queryClient struct {
@@ -508,6 +597,15 @@ func (c *queryClient) Metrics(ctx context.Context, in *QueryMetricsRequest, opts
 	return out, nil
 }
 
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/stride.icaoracle.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Query a specific oracle
@@ -525,6 +623,8 @@ type QueryServer interface {
 	// - /metrics?metric_key=X
 	// - /metrics?oracle_chain_id=Y
 	Metrics(context.Context, *QueryMetricsRequest) (*QueryMetricsResponse, error)
+	// Query the module parameters
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -543,6 +643,9 @@ func (*UnimplementedQueryServer) ActiveOracles(ctx context.Context, req *QueryAc
 func (*UnimplementedQueryServer) Metrics(ctx context.Context, req *QueryMetricsRequest) (*QueryMetricsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Metrics not implemented")
 }
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -620,6 +723,24 @@ func _Query_Metrics_Handler(srv interface{}, ctx context.Context, dec func(inter
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stride.icaoracle.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "stride.icaoracle.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -640,6 +761,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Metrics",
 			Handler:    _Query_Metrics_Handler,
 		},
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "stride/icaoracle/query.proto",
@@ -914,6 +1039,62 @@ func (m *QueryMetricsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -1034,6 +1215,26 @@ func (m *QueryMetricsResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1694,6 +1895,139 @@ func (m *QueryMetricsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -9,6 +9,7 @@ import (
 	_ "github.com/cosmos/cosmos-proto"
 	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
 	grpc "google.golang.org/grpc"
@@ -119,6 +120,96 @@ func (m *MsgAddOracleResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgAddOracleResponse proto.InternalMessageInfo
 
+// Adds a new oracle that receives metrics over an IBC oracle channel
+// The channel must have already been opened from the icaoracle port
+type MsgAddIBCOracle struct {
+	Creator   string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	ChannelId string `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+}
+
+func (m *MsgAddIBCOracle) Reset()         { *m = MsgAddIBCOracle{} }
+func (m *MsgAddIBCOracle) String() string { return proto.CompactTextString(m) }
+func (*MsgAddIBCOracle) ProtoMessage()    {}
+func (*MsgAddIBCOracle) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6e58a377bb8520d3, []int{2}
+}
+func (m *MsgAddIBCOracle) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAddIBCOracle) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAddIBCOracle.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAddIBCOracle) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAddIBCOracle.Merge(m, src)
+}
+func (m *MsgAddIBCOracle) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAddIBCOracle) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAddIBCOracle.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAddIBCOracle proto.InternalMessageInfo
+
+func (m *MsgAddIBCOracle) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *MsgAddIBCOracle) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+type MsgAddIBCOracleResponse struct {
+}
+
+func (m *MsgAddIBCOracleResponse) Reset()         { *m = MsgAddIBCOracleResponse{} }
+func (m *MsgAddIBCOracleResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgAddIBCOracleResponse) ProtoMessage()    {}
+func (*MsgAddIBCOracleResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6e58a377bb8520d3, []int{3}
+}
+func (m *MsgAddIBCOracleResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAddIBCOracleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAddIBCOracleResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAddIBCOracleResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAddIBCOracleResponse.Merge(m, src)
+}
+func (m *MsgAddIBCOracleResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAddIBCOracleResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAddIBCOracleResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAddIBCOracleResponse proto.InternalMessageInfo
+
 // Instantiates the oracle's CW contract
 type MsgInstantiateOracle struct {
 	Creator                 string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
@@ -131,7 +222,7 @@ func (m *MsgInstantiateOracle) Reset()         { *m = MsgInstantiateOracle{} }
 func (m *MsgInstantiateOracle) String() string { return proto.CompactTextString(m) }
 func (*MsgInstantiateOracle) ProtoMessage()    {}
 func (*MsgInstantiateOracle) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6e58a377bb8520d3, []int{2}
+	return fileDescriptor_6e58a377bb8520d3, []int{4}
 }
 func (m *MsgInstantiateOracle) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -195,7 +286,7 @@ func (m *MsgInstantiateOracleResponse) Reset()         { *m = MsgInstantiateOrac
 func (m *MsgInstantiateOracleResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgInstantiateOracleResponse) ProtoMessage()    {}
 func (*MsgInstantiateOracleResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6e58a377bb8520d3, []int{3}
+	return fileDescriptor_6e58a377bb8520d3, []int{5}
 }
 func (m *MsgInstantiateOracleResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -234,7 +325,7 @@ func (m *MsgRestoreOracleICA) Reset()         { *m = MsgRestoreOracleICA{} }
 func (m *MsgRestoreOracleICA) String() string { return proto.CompactTextString(m) }
 func (*MsgRestoreOracleICA) ProtoMessage()    {}
 func (*MsgRestoreOracleICA) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6e58a377bb8520d3, []int{4}
+	return fileDescriptor_6e58a377bb8520d3, []int{6}
 }
 func (m *MsgRestoreOracleICA) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -284,7 +375,7 @@ func (m *MsgRestoreOracleICAResponse) Reset()         { *m = MsgRestoreOracleICA
 func (m *MsgRestoreOracleICAResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgRestoreOracleICAResponse) ProtoMessage()    {}
 func (*MsgRestoreOracleICAResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6e58a377bb8520d3, []int{5}
+	return fileDescriptor_6e58a377bb8520d3, []int{7}
 }
 func (m *MsgRestoreOracleICAResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -326,7 +417,7 @@ func (m *MsgToggleOracle) Reset()         { *m = MsgToggleOracle{} }
 func (m *MsgToggleOracle) String() string { return proto.CompactTextString(m) }
 func (*MsgToggleOracle) ProtoMessage()    {}
 func (*MsgToggleOracle) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6e58a377bb8520d3, []int{6}
+	return fileDescriptor_6e58a377bb8520d3, []int{8}
 }
 func (m *MsgToggleOracle) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -383,7 +474,7 @@ func (m *MsgToggleOracleResponse) Reset()         { *m = MsgToggleOracleResponse
 func (m *MsgToggleOracleResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgToggleOracleResponse) ProtoMessage()    {}
 func (*MsgToggleOracleResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6e58a377bb8520d3, []int{7}
+	return fileDescriptor_6e58a377bb8520d3, []int{9}
 }
 func (m *MsgToggleOracleResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -424,7 +515,7 @@ func (m *MsgRemoveOracle) Reset()         { *m = MsgRemoveOracle{} }
 func (m *MsgRemoveOracle) String() string { return proto.CompactTextString(m) }
 func (*MsgRemoveOracle) ProtoMessage()    {}
 func (*MsgRemoveOracle) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6e58a377bb8520d3, []int{8}
+	return fileDescriptor_6e58a377bb8520d3, []int{10}
 }
 func (m *MsgRemoveOracle) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -474,7 +565,7 @@ func (m *MsgRemoveOracleResponse) Reset()         { *m = MsgRemoveOracleResponse
 func (m *MsgRemoveOracleResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgRemoveOracleResponse) ProtoMessage()    {}
 func (*MsgRemoveOracleResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6e58a377bb8520d3, []int{9}
+	return fileDescriptor_6e58a377bb8520d3, []int{11}
 }
 func (m *MsgRemoveOracleResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -503,9 +594,202 @@ func (m *MsgRemoveOracleResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgRemoveOracleResponse proto.InternalMessageInfo
 
+// Updates the metric types that an oracle is subscribed to
+// An empty list subscribes the oracle to every metric type
+type MsgUpdateOracleSubscriptions struct {
+	// authority is the address that controls the module (defaults to x/gov unless
+	// overwritten).
+	Authority     string   `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	OracleChainId string   `protobuf:"bytes,2,opt,name=oracle_chain_id,json=oracleChainId,proto3" json:"oracle_chain_id,omitempty"`
+	MetricTypes   []string `protobuf:"bytes,3,rep,name=metric_types,json=metricTypes,proto3" json:"metric_types,omitempty"`
+}
+
+func (m *MsgUpdateOracleSubscriptions) Reset()         { *m = MsgUpdateOracleSubscriptions{} }
+func (m *MsgUpdateOracleSubscriptions) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateOracleSubscriptions) ProtoMessage()    {}
+func (*MsgUpdateOracleSubscriptions) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6e58a377bb8520d3, []int{12}
+}
+func (m *MsgUpdateOracleSubscriptions) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateOracleSubscriptions) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateOracleSubscriptions.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateOracleSubscriptions) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateOracleSubscriptions.Merge(m, src)
+}
+func (m *MsgUpdateOracleSubscriptions) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateOracleSubscriptions) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateOracleSubscriptions.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateOracleSubscriptions proto.InternalMessageInfo
+
+func (m *MsgUpdateOracleSubscriptions) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgUpdateOracleSubscriptions) GetOracleChainId() string {
+	if m != nil {
+		return m.OracleChainId
+	}
+	return ""
+}
+
+func (m *MsgUpdateOracleSubscriptions) GetMetricTypes() []string {
+	if m != nil {
+		return m.MetricTypes
+	}
+	return nil
+}
+
+type MsgUpdateOracleSubscriptionsResponse struct {
+}
+
+func (m *MsgUpdateOracleSubscriptionsResponse) Reset()         { *m = MsgUpdateOracleSubscriptionsResponse{} }
+func (m *MsgUpdateOracleSubscriptionsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateOracleSubscriptionsResponse) ProtoMessage()    {}
+func (*MsgUpdateOracleSubscriptionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6e58a377bb8520d3, []int{13}
+}
+func (m *MsgUpdateOracleSubscriptionsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateOracleSubscriptionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateOracleSubscriptionsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateOracleSubscriptionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateOracleSubscriptionsResponse.Merge(m, src)
+}
+func (m *MsgUpdateOracleSubscriptionsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateOracleSubscriptionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateOracleSubscriptionsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateOracleSubscriptionsResponse proto.InternalMessageInfo
+
+// Updates the module parameters
+type MsgUpdateParams struct {
+	// authority is the address that controls the module (defaults to x/gov unless
+	// overwritten).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	Params    Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
+}
+
+func (m *MsgUpdateParams) Reset()         { *m = MsgUpdateParams{} }
+func (m *MsgUpdateParams) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateParams) ProtoMessage()    {}
+func (*MsgUpdateParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6e58a377bb8520d3, []int{14}
+}
+func (m *MsgUpdateParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateParams.Merge(m, src)
+}
+func (m *MsgUpdateParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateParams proto.InternalMessageInfo
+
+func (m *MsgUpdateParams) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgUpdateParams) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+type MsgUpdateParamsResponse struct {
+}
+
+func (m *MsgUpdateParamsResponse) Reset()         { *m = MsgUpdateParamsResponse{} }
+func (m *MsgUpdateParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateParamsResponse) ProtoMessage()    {}
+func (*MsgUpdateParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6e58a377bb8520d3, []int{15}
+}
+func (m *MsgUpdateParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateParamsResponse.Merge(m, src)
+}
+func (m *MsgUpdateParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgAddOracle)(nil), "stride.icaoracle.MsgAddOracle")
 	proto.RegisterType((*MsgAddOracleResponse)(nil), "stride.icaoracle.MsgAddOracleResponse")
+	proto.RegisterType((*MsgAddIBCOracle)(nil), "stride.icaoracle.MsgAddIBCOracle")
+	proto.RegisterType((*MsgAddIBCOracleResponse)(nil), "stride.icaoracle.MsgAddIBCOracleResponse")
 	proto.RegisterType((*MsgInstantiateOracle)(nil), "stride.icaoracle.MsgInstantiateOracle")
 	proto.RegisterType((*MsgInstantiateOracleResponse)(nil), "stride.icaoracle.MsgInstantiateOracleResponse")
 	proto.RegisterType((*MsgRestoreOracleICA)(nil), "stride.icaoracle.MsgRestoreOracleICA")
@@ -514,52 +798,68 @@ func init() {
 	proto.RegisterType((*MsgToggleOracleResponse)(nil), "stride.icaoracle.MsgToggleOracleResponse")
 	proto.RegisterType((*MsgRemoveOracle)(nil), "stride.icaoracle.MsgRemoveOracle")
 	proto.RegisterType((*MsgRemoveOracleResponse)(nil), "stride.icaoracle.MsgRemoveOracleResponse")
+	proto.RegisterType((*MsgUpdateOracleSubscriptions)(nil), "stride.icaoracle.MsgUpdateOracleSubscriptions")
+	proto.RegisterType((*MsgUpdateOracleSubscriptionsResponse)(nil), "stride.icaoracle.MsgUpdateOracleSubscriptionsResponse")
+	proto.RegisterType((*MsgUpdateParams)(nil), "stride.icaoracle.MsgUpdateParams")
+	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "stride.icaoracle.MsgUpdateParamsResponse")
 }
 
 func init() { proto.RegisterFile("stride/icaoracle/tx.proto", fileDescriptor_6e58a377bb8520d3) }
 
 var fileDescriptor_6e58a377bb8520d3 = []byte{
-	// 626 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x95, 0x3d, 0x6f, 0xd3, 0x40,
-	0x18, 0xc7, 0xeb, 0xb6, 0x2a, 0xf4, 0xd4, 0xd2, 0xd6, 0x54, 0x4d, 0x62, 0xc0, 0xb4, 0x46, 0x84,
-	0x50, 0x29, 0x36, 0xa4, 0xbc, 0x29, 0x4c, 0x69, 0xa6, 0x48, 0x44, 0x95, 0x1c, 0x26, 0x84, 0x64,
-	0x5d, 0xce, 0x87, 0x63, 0x91, 0xdc, 0x45, 0x77, 0xd7, 0xa8, 0x5d, 0x19, 0x99, 0x90, 0x98, 0xf8,
-	0x06, 0x88, 0xa9, 0x03, 0x1f, 0x80, 0x91, 0xb1, 0x62, 0x62, 0x44, 0xc9, 0xd0, 0x89, 0xef, 0x80,
-	0xfc, 0x1a, 0xc7, 0x71, 0x69, 0x10, 0x82, 0x25, 0xd1, 0x3d, 0xcf, 0xff, 0xfe, 0xf7, 0xfc, 0xee,
-	0x9e, 0x3b, 0x83, 0x02, 0x17, 0xcc, 0xb5, 0xb1, 0xe1, 0x22, 0x48, 0x19, 0x44, 0x5d, 0x6c, 0x88,
-	0x23, 0xbd, 0xcf, 0xa8, 0xa0, 0xf2, 0x7a, 0x90, 0xd2, 0xe3, 0x94, 0x52, 0x40, 0x94, 0xf7, 0x28,
-	0xb7, 0xfc, 0xbc, 0x11, 0x0c, 0x02, 0xb1, 0x92, 0x0b, 0x46, 0x46, 0x8f, 0x3b, 0xc6, 0xe0, 0xbe,
-	0xf7, 0x17, 0x26, 0x36, 0x60, 0xcf, 0x25, 0xd4, 0xf0, 0x7f, 0x83, 0x90, 0xf6, 0x41, 0x02, 0x2b,
-	0x4d, 0xee, 0xd4, 0x6c, 0xfb, 0xc0, 0xf7, 0x95, 0x2b, 0xe0, 0x12, 0x62, 0x18, 0x0a, 0xca, 0xf2,
-	0xd2, 0xb6, 0x54, 0x5a, 0xde, 0xcf, 0x7f, 0xfb, 0x5c, 0xde, 0x0c, 0xfd, 0x6b, 0xb6, 0xcd, 0x30,
-	0xe7, 0x2d, 0xc1, 0x5c, 0xe2, 0x98, 0x91, 0x50, 0xbe, 0x05, 0x56, 0x11, 0x25, 0x04, 0x23, 0xe1,
-	0x52, 0x62, 0xb9, 0x76, 0x7e, 0xde, 0x9b, 0x69, 0xae, 0x8c, 0x83, 0x0d, 0xbb, 0x7a, 0xef, 0xcd,
-	0xd9, 0xc9, 0x6e, 0x34, 0xe5, 0xed, 0xd9, 0xc9, 0xee, 0xcd, 0x10, 0xf7, 0x28, 0x01, 0x9c, 0x2c,
-	0x45, 0xdb, 0x02, 0x9b, 0xc9, 0xb1, 0x89, 0x79, 0x9f, 0x12, 0x8e, 0xb5, 0xf7, 0xf3, 0x7e, 0xa2,
-	0x41, 0xb8, 0x80, 0x44, 0xb8, 0x50, 0xe0, 0xbf, 0xa8, 0xbd, 0x08, 0xd6, 0x82, 0xb5, 0x2d, 0xd4,
-	0x81, 0x6e, 0xa2, 0xfa, 0xd5, 0x20, 0x5c, 0xf7, 0xa2, 0x0d, 0x5b, 0x2e, 0x81, 0x75, 0x44, 0x89,
-	0x60, 0x10, 0x09, 0x0b, 0x51, 0x1b, 0x7b, 0xc2, 0x85, 0x6d, 0xa9, 0xb4, 0x68, 0x5e, 0x89, 0xe2,
-	0x75, 0x6a, 0xe3, 0x86, 0x2d, 0x3f, 0x05, 0x8a, 0x60, 0x90, 0xf0, 0x57, 0x98, 0x79, 0x9e, 0x84,
-	0xe0, 0xae, 0x45, 0x89, 0x15, 0xd8, 0xe5, 0x17, 0x7d, 0xf3, 0x5c, 0xa4, 0xa8, 0x07, 0x82, 0x03,
-	0x12, 0x20, 0x54, 0x9f, 0xa4, 0x77, 0xe9, 0x4e, 0xf6, 0x2e, 0x4d, 0xc1, 0x6b, 0x2a, 0xb8, 0x9e,
-	0x15, 0x8f, 0x77, 0xed, 0x93, 0x04, 0xae, 0x36, 0xb9, 0x63, 0x62, 0x2e, 0x28, 0x0b, 0x93, 0x8d,
-	0x7a, 0xed, 0x5f, 0x6e, 0x5a, 0xf5, 0x71, 0x9a, 0xa6, 0x98, 0x4d, 0x93, 0x2e, 0x4a, 0xbb, 0x01,
-	0xae, 0x65, 0x84, 0x63, 0x96, 0x2f, 0x12, 0x58, 0x6b, 0x72, 0xe7, 0x39, 0x75, 0x9c, 0x6e, 0x74,
-	0xf8, 0x8f, 0xc0, 0x32, 0x3c, 0x14, 0x1d, 0xca, 0x5c, 0x71, 0x7c, 0x21, 0xc9, 0x58, 0x3a, 0x73,
-	0x03, 0x6c, 0x81, 0x25, 0x88, 0x84, 0x3b, 0xc0, 0xfe, 0xb1, 0x5f, 0x36, 0xc3, 0x51, 0xf5, 0xa1,
-	0xc7, 0x38, 0xf6, 0xf3, 0x28, 0xb5, 0x6c, 0xca, 0x64, 0xb9, 0x5a, 0x01, 0xe4, 0x52, 0xa1, 0x98,
-	0xee, 0x63, 0x40, 0x67, 0xe2, 0x1e, 0x1d, 0xfc, 0x27, 0xba, 0x3f, 0xa0, 0x48, 0x96, 0x15, 0x52,
-	0x24, 0x43, 0x11, 0x45, 0xe5, 0xe7, 0x02, 0x58, 0x68, 0x72, 0x47, 0x6e, 0x81, 0xe5, 0xf1, 0xeb,
-	0xa2, 0xea, 0xe9, 0x87, 0x4c, 0x4f, 0x5e, 0x71, 0xa5, 0xf8, 0xfb, 0x7c, 0x64, 0x2e, 0xbf, 0x06,
-	0x1b, 0xd3, 0xd7, 0x3f, 0x7b, 0xf2, 0x94, 0x4e, 0xd1, 0x67, 0xd3, 0xc5, 0x8b, 0x75, 0xc0, 0xfa,
-	0xd4, 0xad, 0xb9, 0x9d, 0xe9, 0x91, 0x96, 0x29, 0xe5, 0x99, 0x64, 0xf1, 0x4a, 0x2f, 0xc1, 0xca,
-	0x44, 0x4f, 0xef, 0x64, 0x4e, 0x4f, 0x4a, 0x94, 0xbb, 0x17, 0x4a, 0x92, 0xee, 0x13, 0x3d, 0xb5,
-	0x73, 0x4e, 0x71, 0x63, 0xc9, 0x39, 0xee, 0x59, 0xe7, 0xbd, 0xdf, 0xfc, 0x3a, 0x54, 0xa5, 0xd3,
-	0xa1, 0x2a, 0xfd, 0x18, 0xaa, 0xd2, 0xbb, 0x91, 0x3a, 0x77, 0x3a, 0x52, 0xe7, 0xbe, 0x8f, 0xd4,
-	0xb9, 0x17, 0x7b, 0x8e, 0x2b, 0x3a, 0x87, 0x6d, 0x1d, 0xd1, 0x9e, 0xd1, 0xf2, 0xed, 0xca, 0xcf,
-	0x60, 0x9b, 0x1b, 0x61, 0x7f, 0x0d, 0x2a, 0x0f, 0x26, 0x7a, 0x4c, 0x1c, 0xf7, 0x31, 0x6f, 0x2f,
-	0xf9, 0xdf, 0xa7, 0xbd, 0x5f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x45, 0xe4, 0xdc, 0x1c, 0x15, 0x07,
-	0x00, 0x00,
+	// 823 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x56, 0x41, 0x4f, 0x13, 0x41,
+	0x18, 0xed, 0x52, 0x52, 0xed, 0x50, 0x04, 0x2a, 0x81, 0x76, 0x95, 0x85, 0x56, 0xad, 0x2d, 0x09,
+	0x5d, 0x2d, 0x8a, 0xa6, 0x9e, 0x68, 0x4f, 0x4d, 0x6c, 0x30, 0x5b, 0xbc, 0x18, 0x93, 0x66, 0xbb,
+	0x3b, 0x6e, 0x37, 0xd2, 0x9d, 0x66, 0x66, 0x68, 0xe0, 0xaa, 0x37, 0x4f, 0x26, 0x9e, 0xfc, 0x07,
+	0xc6, 0x8b, 0x1c, 0x3c, 0x78, 0xf4, 0xc8, 0x91, 0x78, 0xf2, 0x64, 0x0c, 0xc4, 0xf0, 0x37, 0xcc,
+	0xee, 0xec, 0x6e, 0xa7, 0xed, 0xb6, 0x05, 0x09, 0x5e, 0x80, 0x79, 0xdf, 0xdb, 0xb7, 0xef, 0x7d,
+	0x33, 0xfb, 0x0d, 0x20, 0x49, 0x28, 0x36, 0x75, 0x28, 0x9b, 0x9a, 0x8a, 0xb0, 0xaa, 0xed, 0x40,
+	0x99, 0xee, 0xe5, 0xdb, 0x18, 0x51, 0x14, 0x9f, 0x65, 0xa5, 0xbc, 0x5f, 0x12, 0x93, 0x1a, 0x22,
+	0x2d, 0x44, 0xea, 0x4e, 0x5d, 0x66, 0x0b, 0x46, 0x16, 0x17, 0xd9, 0x4a, 0x6e, 0x11, 0x43, 0xee,
+	0xdc, 0xb7, 0x7f, 0xb9, 0x85, 0x39, 0xb5, 0x65, 0x5a, 0x48, 0x76, 0x7e, 0xba, 0xd0, 0xbc, 0x81,
+	0x0c, 0xc4, 0x34, 0xec, 0xbf, 0x5c, 0x54, 0x1a, 0x70, 0x62, 0x40, 0x0b, 0x12, 0xd3, 0x7d, 0x43,
+	0xfa, 0xa3, 0x00, 0x62, 0x55, 0x62, 0x6c, 0xea, 0xfa, 0x96, 0x53, 0x8e, 0x17, 0xc0, 0x15, 0x0d,
+	0x43, 0x95, 0x22, 0x9c, 0x10, 0x56, 0x84, 0x6c, 0xb4, 0x94, 0xf8, 0xf1, 0x75, 0x6d, 0xde, 0x75,
+	0xb5, 0xa9, 0xeb, 0x18, 0x12, 0x52, 0xa3, 0xd8, 0xb4, 0x0c, 0xc5, 0x23, 0xc6, 0x6f, 0x81, 0x69,
+	0x0d, 0x59, 0x16, 0xd4, 0xa8, 0x89, 0xac, 0xba, 0xa9, 0x27, 0x26, 0xec, 0x27, 0x95, 0x58, 0x17,
+	0xac, 0xe8, 0xc5, 0x7b, 0x6f, 0x4e, 0x0f, 0x56, 0xbd, 0x47, 0xde, 0x9d, 0x1e, 0xac, 0x2e, 0xbb,
+	0xd6, 0xf6, 0x38, 0x73, 0xbc, 0x95, 0xf4, 0x02, 0x98, 0xe7, 0xd7, 0x0a, 0x24, 0x6d, 0x64, 0x11,
+	0x68, 0x7b, 0x9e, 0x61, 0x85, 0x4a, 0xa9, 0x7c, 0x01, 0xdb, 0x4b, 0x00, 0x68, 0x4d, 0xd5, 0xb2,
+	0xe0, 0x4e, 0xd7, 0x73, 0xd4, 0x45, 0x2a, 0x7a, 0x71, 0xbd, 0xdf, 0x70, 0x7a, 0xa8, 0x61, 0xdf,
+	0x47, 0x3a, 0x09, 0x16, 0xfb, 0x20, 0xdf, 0xf6, 0x87, 0x09, 0x27, 0x4f, 0xc5, 0x22, 0x54, 0xb5,
+	0xa8, 0xa9, 0x52, 0x78, 0x01, 0xef, 0x19, 0x30, 0xc3, 0x1c, 0xd4, 0xb5, 0xa6, 0x6a, 0x72, 0x4d,
+	0x9f, 0x66, 0x70, 0xd9, 0x46, 0x2b, 0x7a, 0x3c, 0x0b, 0x66, 0x35, 0x64, 0x51, 0xac, 0x6a, 0xb4,
+	0xae, 0x21, 0x1d, 0xda, 0xc4, 0xf0, 0x8a, 0x90, 0x9d, 0x54, 0xae, 0x79, 0x78, 0x19, 0xe9, 0xb0,
+	0xa2, 0xc7, 0x9f, 0x00, 0x91, 0x62, 0xd5, 0x22, 0xaf, 0x20, 0xae, 0x7b, 0x6d, 0x41, 0x56, 0x9d,
+	0xc9, 0x25, 0x26, 0x1d, 0xf1, 0x45, 0x8f, 0x51, 0x66, 0x84, 0x2d, 0x8b, 0x45, 0x28, 0x3e, 0xee,
+	0xef, 0xd5, 0xdd, 0xe0, 0x5e, 0x0d, 0x84, 0x4f, 0x4b, 0xe0, 0x66, 0x10, 0xee, 0x77, 0xed, 0xb3,
+	0x00, 0xae, 0x57, 0x89, 0xa1, 0x40, 0x42, 0x11, 0x76, 0x8b, 0x95, 0xf2, 0xe6, 0x65, 0x36, 0xad,
+	0xf8, 0xa8, 0x3f, 0x4d, 0x26, 0x38, 0x4d, 0xbf, 0xa9, 0xf4, 0x12, 0xb8, 0x11, 0x00, 0xfb, 0x59,
+	0xbe, 0xb3, 0x83, 0xbb, 0x8d, 0x0c, 0x63, 0xc7, 0xdb, 0xfc, 0x0d, 0x10, 0x55, 0x77, 0x69, 0x13,
+	0x61, 0x93, 0xee, 0x8f, 0x4d, 0xd2, 0xa5, 0x9e, 0xf9, 0x00, 0x2c, 0x80, 0x88, 0xaa, 0x51, 0xb3,
+	0x03, 0x9d, 0x6d, 0xbf, 0xaa, 0xb8, 0xab, 0xe2, 0x43, 0x3b, 0x63, 0x57, 0x6f, 0xc4, 0xf9, 0xe6,
+	0xed, 0xba, 0xe7, 0x9b, 0x87, 0xfc, 0x74, 0x9f, 0x58, 0x3a, 0x05, 0xb6, 0x50, 0xe7, 0x3f, 0xa5,
+	0x3b, 0x47, 0x0a, 0xde, 0x96, 0x9b, 0x82, 0x87, 0xfc, 0x14, 0x7f, 0x04, 0xe7, 0x40, 0x3e, 0x6f,
+	0xeb, 0xfe, 0x59, 0xac, 0xed, 0x36, 0x88, 0x86, 0xcd, 0xb6, 0x3d, 0xc8, 0xc8, 0xa5, 0x6f, 0x58,
+	0x0a, 0xc4, 0x5a, 0x90, 0x62, 0x53, 0xab, 0xd3, 0xfd, 0x36, 0x24, 0x89, 0xf0, 0x4a, 0x38, 0x1b,
+	0x55, 0xa6, 0x18, 0xb6, 0x6d, 0x43, 0xc5, 0xd2, 0x60, 0x6a, 0x39, 0x38, 0xf5, 0xd0, 0x18, 0xe9,
+	0x0c, 0xb8, 0x3d, 0xaa, 0xee, 0xf7, 0xe3, 0x1b, 0xdb, 0x55, 0x46, 0x7c, 0xa6, 0x62, 0xb5, 0xf5,
+	0xef, 0x2d, 0xd8, 0x00, 0x91, 0xb6, 0xa3, 0xe0, 0x24, 0x9f, 0x2a, 0x24, 0xf2, 0xfd, 0x97, 0x61,
+	0x9e, 0xbd, 0xa1, 0x34, 0x79, 0xf8, 0x6b, 0x39, 0xa4, 0xb8, 0xec, 0x73, 0xec, 0x32, 0x6f, 0xd3,
+	0xdd, 0x65, 0x1e, 0xf2, 0x52, 0x15, 0xbe, 0x44, 0x40, 0xb8, 0x4a, 0x8c, 0x78, 0x0d, 0x44, 0xbb,
+	0x57, 0x9f, 0x34, 0x68, 0x87, 0xbf, 0x7f, 0xc4, 0xcc, 0xe8, 0xba, 0x27, 0x1e, 0x7f, 0x09, 0x62,
+	0x3d, 0x77, 0x53, 0x6a, 0xd8, 0x73, 0x3e, 0x45, 0xcc, 0x8d, 0xa5, 0xf8, 0xea, 0xaf, 0xc1, 0xdc,
+	0xe0, 0x15, 0x12, 0x6c, 0x6d, 0x80, 0x27, 0xe6, 0xcf, 0xc6, 0xf3, 0x5f, 0xd6, 0x04, 0xb3, 0x03,
+	0x93, 0xf7, 0x4e, 0xa0, 0x46, 0x3f, 0x4d, 0x5c, 0x3b, 0x13, 0x8d, 0x6f, 0x5a, 0xcf, 0x5c, 0x0c,
+	0x6e, 0x1a, 0x4f, 0x11, 0x73, 0x63, 0x29, 0xbc, 0x7a, 0xcf, 0x5c, 0x4a, 0x0d, 0x31, 0xd7, 0xa5,
+	0x88, 0xb9, 0xb1, 0x14, 0x5f, 0xfd, 0xad, 0x00, 0x92, 0xc3, 0x07, 0x46, 0x70, 0xcf, 0x87, 0xf2,
+	0xc5, 0x8d, 0xf3, 0xf1, 0xf9, 0x8c, 0x3d, 0x5f, 0x69, 0x6a, 0x84, 0x0e, 0xa3, 0x88, 0xb9, 0xb1,
+	0x14, 0x4f, 0xbd, 0x54, 0x3d, 0x3c, 0x96, 0x84, 0xa3, 0x63, 0x49, 0xf8, 0x7d, 0x2c, 0x09, 0xef,
+	0x4f, 0xa4, 0xd0, 0xd1, 0x89, 0x14, 0xfa, 0x79, 0x22, 0x85, 0x5e, 0xac, 0x1b, 0x26, 0x6d, 0xee,
+	0x36, 0xf2, 0x1a, 0x6a, 0xc9, 0x35, 0x47, 0x6e, 0xed, 0xa9, 0xda, 0x20, 0xde, 0x44, 0xea, 0x14,
+	0x1e, 0xf4, 0x7c, 0xa5, 0xce, 0x54, 0x6b, 0x44, 0x9c, 0x7f, 0x3f, 0xd7, 0xff, 0x0e, 0x00, 0xf2,
+	0xc7, 0x78, 0x95, 0x2a, 0x0b, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -576,6 +876,8 @@ const _ = grpc.SupportPackageIsVersion4
 type MsgClient interface {
 	// Adds a new oracle given a provided connection
 	AddOracle(ctx context.Context, in *MsgAddOracle, opts ...grpc.CallOption) (*MsgAddOracleResponse, error)
+	// Adds a new oracle that receives metrics over an IBC oracle channel
+	AddIBCOracle(ctx context.Context, in *MsgAddIBCOracle, opts ...grpc.CallOption) (*MsgAddIBCOracleResponse, error)
 	// Instantiates an Oracle CW contract
 	InstantiateOracle(ctx context.Context, in *MsgInstantiateOracle, opts ...grpc.CallOption) (*MsgInstantiateOracleResponse, error)
 	// Restores the oracle ICA channel after a closure
@@ -584,6 +886,10 @@ type MsgClient interface {
 	ToggleOracle(ctx context.Context, in *MsgToggleOracle, opts ...grpc.CallOption) (*MsgToggleOracleResponse, error)
 	// Removes an oracle completely
 	RemoveOracle(ctx context.Context, in *MsgRemoveOracle, opts ...grpc.CallOption) (*MsgRemoveOracleResponse, error)
+	// Updates the metric types that an oracle is subscribed to
+	UpdateOracleSubscriptions(ctx context.Context, in *MsgUpdateOracleSubscriptions, opts ...grpc.CallOption) (*MsgUpdateOracleSubscriptionsResponse, error)
+	// Updates the module parameters
+	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
 }
 
 type msgClient struct {
@@ -603,6 +909,15 @@ func (c *msgClient) AddOracle(ctx context.Context, in *MsgAddOracle, opts ...grp
 	return out, nil
 }
 
+func (c *msgClient) AddIBCOracle(ctx context.Context, in *MsgAddIBCOracle, opts ...grpc.CallOption) (*MsgAddIBCOracleResponse, error) {
+	out := new(MsgAddIBCOracleResponse)
+	err := c.cc.Invoke(ctx, "/stride.icaoracle.Msg/AddIBCOracle", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) InstantiateOracle(ctx context.Context, in *MsgInstantiateOracle, opts ...grpc.CallOption) (*MsgInstantiateOracleResponse, error) {
 	out := new(MsgInstantiateOracleResponse)
 	err := c.cc.Invoke(ctx, "/stride.icaoracle.Msg/InstantiateOracle", in, out, opts...)
@@ -639,10 +954,30 @@ func (c *msgClient) RemoveOracle(ctx context.Context, in *MsgRemoveOracle, opts
 	return out, nil
 }
 
+func (c *msgClient) UpdateOracleSubscriptions(ctx context.Context, in *MsgUpdateOracleSubscriptions, opts ...grpc.CallOption) (*MsgUpdateOracleSubscriptionsResponse, error) {
+	out := new(MsgUpdateOracleSubscriptionsResponse)
+	err := c.cc.Invoke(ctx, "/stride.icaoracle.Msg/UpdateOracleSubscriptions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
+	out := new(MsgUpdateParamsResponse)
+	err := c.cc.Invoke(ctx, "/stride.icaoracle.Msg/UpdateParams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// Adds a new oracle given a provided connection
 	AddOracle(context.Context, *MsgAddOracle) (*MsgAddOracleResponse, error)
+	// Adds a new oracle that receives metrics over an IBC oracle channel
+	AddIBCOracle(context.Context, *MsgAddIBCOracle) (*MsgAddIBCOracleResponse, error)
 	// Instantiates an Oracle CW contract
 	InstantiateOracle(context.Context, *MsgInstantiateOracle) (*MsgInstantiateOracleResponse, error)
 	// Restores the oracle ICA channel after a closure
@@ -651,6 +986,10 @@ type MsgServer interface {
 	ToggleOracle(context.Context, *MsgToggleOracle) (*MsgToggleOracleResponse, error)
 	// Removes an oracle completely
 	RemoveOracle(context.Context, *MsgRemoveOracle) (*MsgRemoveOracleResponse, error)
+	// Updates the metric types that an oracle is subscribed to
+	UpdateOracleSubscriptions(context.Context, *MsgUpdateOracleSubscriptions) (*MsgUpdateOracleSubscriptionsResponse, error)
+	// Updates the module parameters
+	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -660,6 +999,9 @@ type UnimplementedMsgServer struct {
 func (*UnimplementedMsgServer) AddOracle(ctx context.Context, req *MsgAddOracle) (*MsgAddOracleResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AddOracle not implemented")
 }
+func (*UnimplementedMsgServer) AddIBCOracle(ctx context.Context, req *MsgAddIBCOracle) (*MsgAddIBCOracleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddIBCOracle not implemented")
+}
 func (*UnimplementedMsgServer) InstantiateOracle(ctx context.Context, req *MsgInstantiateOracle) (*MsgInstantiateOracleResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method InstantiateOracle not implemented")
 }
@@ -672,6 +1014,12 @@ func (*UnimplementedMsgServer) ToggleOracle(ctx context.Context, req *MsgToggleO
 func (*UnimplementedMsgServer) RemoveOracle(ctx context.Context, req *MsgRemoveOracle) (*MsgRemoveOracleResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RemoveOracle not implemented")
 }
+func (*UnimplementedMsgServer) UpdateOracleSubscriptions(ctx context.Context, req *MsgUpdateOracleSubscriptions) (*MsgUpdateOracleSubscriptionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateOracleSubscriptions not implemented")
+}
+func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -695,6 +1043,24 @@ func _Msg_AddOracle_Handler(srv interface{}, ctx context.Context, dec func(inter
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_AddIBCOracle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAddIBCOracle)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AddIBCOracle(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stride.icaoracle.Msg/AddIBCOracle",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AddIBCOracle(ctx, req.(*MsgAddIBCOracle))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_InstantiateOracle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgInstantiateOracle)
 	if err := dec(in); err != nil {
@@ -767,6 +1133,42 @@ func _Msg_RemoveOracle_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_UpdateOracleSubscriptions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateOracleSubscriptions)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateOracleSubscriptions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stride.icaoracle.Msg/UpdateOracleSubscriptions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateOracleSubscriptions(ctx, req.(*MsgUpdateOracleSubscriptions))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stride.icaoracle.Msg/UpdateParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "stride.icaoracle.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -775,6 +1177,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "AddOracle",
 			Handler:    _Msg_AddOracle_Handler,
 		},
+		{
+			MethodName: "AddIBCOracle",
+			Handler:    _Msg_AddIBCOracle_Handler,
+		},
 		{
 			MethodName: "InstantiateOracle",
 			Handler:    _Msg_InstantiateOracle_Handler,
@@ -791,6 +1197,14 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "RemoveOracle",
 			Handler:    _Msg_RemoveOracle_Handler,
 		},
+		{
+			MethodName: "UpdateOracleSubscriptions",
+			Handler:    _Msg_UpdateOracleSubscriptions_Handler,
+		},
+		{
+			MethodName: "UpdateParams",
+			Handler:    _Msg_UpdateParams_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "stride/icaoracle/tx.proto",
@@ -856,7 +1270,7 @@ func (m *MsgAddOracleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgInstantiateOracle) Marshal() (dAtA []byte, err error) {
+func (m *MsgAddIBCOracle) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -866,19 +1280,79 @@ func (m *MsgInstantiateOracle) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgInstantiateOracle) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgAddIBCOracle) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgInstantiateOracle) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgAddIBCOracle) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.TransferChannelOnOracle) > 0 {
-		i -= len(m.TransferChannelOnOracle)
-		copy(dAtA[i:], m.TransferChannelOnOracle)
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAddIBCOracleResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddIBCOracleResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddIBCOracleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgInstantiateOracle) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgInstantiateOracle) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgInstantiateOracle) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TransferChannelOnOracle) > 0 {
+		i -= len(m.TransferChannelOnOracle)
+		copy(dAtA[i:], m.TransferChannelOnOracle)
 		i = encodeVarintTx(dAtA, i, uint64(len(m.TransferChannelOnOracle)))
 		i--
 		dAtA[i] = 0x22
@@ -1118,6 +1592,138 @@ func (m *MsgRemoveOracleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgUpdateOracleSubscriptions) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateOracleSubscriptions) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateOracleSubscriptions) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MetricTypes) > 0 {
+		for iNdEx := len(m.MetricTypes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.MetricTypes[iNdEx])
+			copy(dAtA[i:], m.MetricTypes[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.MetricTypes[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.OracleChainId) > 0 {
+		i -= len(m.OracleChainId)
+		copy(dAtA[i:], m.OracleChainId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.OracleChainId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateOracleSubscriptionsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateOracleSubscriptionsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateOracleSubscriptionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -1155,6 +1761,32 @@ func (m *MsgAddOracleResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgAddIBCOracle) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgAddIBCOracleResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func (m *MsgInstantiateOracle) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1231,51 +1863,650 @@ func (m *MsgToggleOracle) Size() (n int) {
 	if m.Active {
 		n += 2
 	}
-	return n
-}
+	return n
+}
+
+func (m *MsgToggleOracleResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRemoveOracle) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.OracleChainId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRemoveOracleResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateOracleSubscriptions) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.OracleChainId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.MetricTypes) > 0 {
+		for _, s := range m.MetricTypes {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgUpdateOracleSubscriptionsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgAddOracle) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddOracle: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddOracle: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConnectionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAddOracleResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddOracleResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddOracleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAddIBCOracle) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddIBCOracle: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddIBCOracle: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAddIBCOracleResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddIBCOracleResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddIBCOracleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgInstantiateOracle) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgInstantiateOracle: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgInstantiateOracle: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OracleChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OracleChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractCodeId", wireType)
+			}
+			m.ContractCodeId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ContractCodeId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TransferChannelOnOracle", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TransferChannelOnOracle = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *MsgToggleOracleResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
+	return nil
 }
-
-func (m *MsgRemoveOracle) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.OracleChainId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgInstantiateOracleResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgInstantiateOracleResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgInstantiateOracleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *MsgRemoveOracleResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *MsgAddOracle) Unmarshal(dAtA []byte) error {
+func (m *MsgRestoreOracleICA) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1298,10 +2529,10 @@ func (m *MsgAddOracle) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAddOracle: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRestoreOracleICA: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAddOracle: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRestoreOracleICA: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1338,7 +2569,7 @@ func (m *MsgAddOracle) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OracleChainId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1366,7 +2597,7 @@ func (m *MsgAddOracle) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ConnectionId = string(dAtA[iNdEx:postIndex])
+			m.OracleChainId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1389,7 +2620,7 @@ func (m *MsgAddOracle) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgAddOracleResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRestoreOracleICAResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1412,10 +2643,10 @@ func (m *MsgAddOracleResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAddOracleResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRestoreOracleICAResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAddOracleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRestoreOracleICAResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1439,7 +2670,7 @@ func (m *MsgAddOracleResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgInstantiateOracle) Unmarshal(dAtA []byte) error {
+func (m *MsgToggleOracle) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1462,15 +2693,15 @@ func (m *MsgInstantiateOracle) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgInstantiateOracle: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgToggleOracle: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgInstantiateOracle: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgToggleOracle: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1498,7 +2729,7 @@ func (m *MsgInstantiateOracle) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
@@ -1534,28 +2765,9 @@ func (m *MsgInstantiateOracle) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContractCodeId", wireType)
-			}
-			m.ContractCodeId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ContractCodeId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TransferChannelOnOracle", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Active", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1565,24 +2777,12 @@ func (m *MsgInstantiateOracle) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.TransferChannelOnOracle = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.Active = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1604,7 +2804,7 @@ func (m *MsgInstantiateOracle) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgInstantiateOracleResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgToggleOracleResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1627,10 +2827,10 @@ func (m *MsgInstantiateOracleResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgInstantiateOracleResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgToggleOracleResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgInstantiateOracleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgToggleOracleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1654,7 +2854,7 @@ func (m *MsgInstantiateOracleResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRestoreOracleICA) Unmarshal(dAtA []byte) error {
+func (m *MsgRemoveOracle) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1677,15 +2877,15 @@ func (m *MsgRestoreOracleICA) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRestoreOracleICA: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRemoveOracle: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRestoreOracleICA: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRemoveOracle: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1713,7 +2913,7 @@ func (m *MsgRestoreOracleICA) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
@@ -1768,7 +2968,7 @@ func (m *MsgRestoreOracleICA) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRestoreOracleICAResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRemoveOracleResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1791,10 +2991,10 @@ func (m *MsgRestoreOracleICAResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRestoreOracleICAResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRemoveOracleResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRestoreOracleICAResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRemoveOracleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1818,7 +3018,7 @@ func (m *MsgRestoreOracleICAResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgToggleOracle) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateOracleSubscriptions) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1841,10 +3041,10 @@ func (m *MsgToggleOracle) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgToggleOracle: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateOracleSubscriptions: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgToggleOracle: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateOracleSubscriptions: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1912,10 +3112,10 @@ func (m *MsgToggleOracle) Unmarshal(dAtA []byte) error {
 			m.OracleChainId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Active", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MetricTypes", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1925,12 +3125,24 @@ func (m *MsgToggleOracle) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Active = bool(v != 0)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MetricTypes = append(m.MetricTypes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1952,7 +3164,7 @@ func (m *MsgToggleOracle) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgToggleOracleResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateOracleSubscriptionsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1975,10 +3187,10 @@ func (m *MsgToggleOracleResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgToggleOracleResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateOracleSubscriptionsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgToggleOracleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateOracleSubscriptionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2002,7 +3214,7 @@ func (m *MsgToggleOracleResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRemoveOracle) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2025,10 +3237,10 @@ func (m *MsgRemoveOracle) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRemoveOracle: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRemoveOracle: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2065,9 +3277,9 @@ func (m *MsgRemoveOracle) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OracleChainId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2077,23 +3289,24 @@ func (m *MsgRemoveOracle) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OracleChainId = string(dAtA[iNdEx:postIndex])
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2116,7 +3329,7 @@ func (m *MsgRemoveOracle) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRemoveOracleResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2139,10 +3352,10 @@ func (m *MsgRemoveOracleResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRemoveOracleResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRemoveOracleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -15,6 +15,11 @@ import (
 	"github.com/Stride-Labs/stride/v24/x/interchainquery/types"
 )
 
+const (
+	FlagCallbackId   = "callback-id"
+	FlagConnectionId = "connection-id"
+)
+
 // GetQueryCmd returns the cli query commands for this module.
 func GetQueryCmd() *cobra.Command {
 	// Group lockup queries under a subcommand
@@ -28,6 +33,10 @@ func GetQueryCmd() *cobra.Command {
 
 	cmd.AddCommand(
 		GetCmdListPendingQueries(),
+		GetCmdListPendingBatchQueries(),
+		GetCmdQueryHistory(),
+		GetCmdQueryParams(),
+		GetCmdQueryRelayerEarnings(),
 	)
 
 	return cmd
@@ -67,3 +76,162 @@ func GetCmdListPendingQueries() *cobra.Command {
 
 	return cmd
 }
+
+// Provides a list of all pending batch queries
+// (batch queries that have been requested but have not received a response)
+func GetCmdListPendingBatchQueries() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list-pending-batch-queries",
+		Short: "Query all pending batch queries",
+		Example: strings.TrimSpace(
+			fmt.Sprintf(`$ %s query interchainquery list-pending-batch-queries`,
+				version.AppName,
+			),
+		),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryServiceClient(clientCtx)
+
+			req := &types.QueryPendingBatchQueriesRequest{}
+
+			res, err := queryClient.PendingBatchQueries(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// Provides the history of recently completed queries, optionally filtered by callback ID or connection ID
+func GetCmdQueryHistory() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "query-history",
+		Short: "Query the history of recently completed queries",
+		Example: strings.TrimSpace(
+			fmt.Sprintf(`$ %s query interchainquery query-history --callback-id delegation --connection-id connection-0`,
+				version.AppName,
+			),
+		),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryServiceClient(clientCtx)
+
+			callbackId, err := cmd.Flags().GetString(FlagCallbackId)
+			if err != nil {
+				return err
+			}
+			connectionId, err := cmd.Flags().GetString(FlagConnectionId)
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryQueryHistoryRequest{
+				CallbackId:   callbackId,
+				ConnectionId: connectionId,
+			}
+			if cmd.Flags().Changed(flags.FlagLimit) || cmd.Flags().Changed(flags.FlagPageKey) ||
+				cmd.Flags().Changed(flags.FlagPage) || cmd.Flags().Changed(flags.FlagReverse) {
+				pageReq, err := client.ReadPageRequest(cmd.Flags())
+				if err != nil {
+					return err
+				}
+				req.Pagination = pageReq
+			}
+
+			res, err := queryClient.QueryHistory(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().String(FlagCallbackId, "", "Only include queries with the given callback ID")
+	cmd.Flags().String(FlagConnectionId, "", "Only include queries on the given connection")
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "query-history")
+
+	return cmd
+}
+
+// Queries the module parameters
+func GetCmdQueryParams() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "params",
+		Short: "Query the module parameters",
+		Example: strings.TrimSpace(
+			fmt.Sprintf(`$ %s query interchainquery params`,
+				version.AppName,
+			),
+		),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryServiceClient(clientCtx)
+
+			res, err := queryClient.Params(context.Background(), &types.QueryParamsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// Queries the total fees a relayer has earned from query responses
+func GetCmdQueryRelayerEarnings() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "relayer-earnings [address]",
+		Short: "Query the total fees a relayer has earned from query responses",
+		Example: strings.TrimSpace(
+			fmt.Sprintf(`$ %s query interchainquery relayer-earnings stride1xxx`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryServiceClient(clientCtx)
+
+			req := &types.QueryRelayerEarningsRequest{
+				Address: args[0],
+			}
+			res, err := queryClient.RelayerEarnings(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
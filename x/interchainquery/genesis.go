@@ -1,6 +1,8 @@
 package interchainquery
 
 import (
+	"fmt"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/Stride-Labs/stride/v24/x/interchainquery/keeper"
@@ -15,11 +17,34 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 		// Initialize empty epoch values via Cosmos SDK
 		k.SetQuery(ctx, query)
 	}
+	k.SetParams(ctx, genState.Params)
+	for _, relayerEarnings := range genState.RelayerEarnings {
+		k.SetRelayerEarnings(ctx, relayerEarnings)
+	}
+	for _, batchQuery := range genState.BatchQueries {
+		k.SetBatchQuery(ctx, batchQuery)
+	}
+	for _, asyncICQChannel := range genState.AsyncIcqChannels {
+		k.SetAsyncICQChannel(ctx, asyncICQChannel)
+	}
+	for _, asyncICQPacket := range genState.AsyncIcqPackets {
+		k.SetAsyncICQPacket(ctx, asyncICQPacket)
+	}
+
+	// Bind the async-icq port so that channels can be opened
+	if err := k.BindPort(ctx); err != nil {
+		panic(fmt.Sprintf("could not claim port capability: %v", err))
+	}
 }
 
 // ExportGenesis returns the capability module's exported genesis.
 func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 	return &types.GenesisState{
-		Queries: k.AllQueries(ctx),
+		Queries:          k.AllQueries(ctx),
+		AsyncIcqChannels: k.GetAllAsyncICQChannels(ctx),
+		AsyncIcqPackets:  k.GetAllAsyncICQPackets(ctx),
+		BatchQueries:     k.AllBatchQueries(ctx),
+		Params:           k.GetParams(ctx),
+		RelayerEarnings:  k.GetAllRelayerEarnings(ctx),
 	}
 }
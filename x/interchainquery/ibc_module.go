@@ -0,0 +1,153 @@
+package interchainquery
+
+import (
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v7/modules/core/05-port/types"
+	host "github.com/cosmos/ibc-go/v7/modules/core/24-host"
+	ibcexported "github.com/cosmos/ibc-go/v7/modules/core/exported"
+
+	"github.com/Stride-Labs/stride/v24/x/interchainquery/keeper"
+	"github.com/Stride-Labs/stride/v24/x/interchainquery/types"
+)
+
+var _ porttypes.IBCModule = &IBCModule{}
+
+// IBCModule implements the controller side of an ICS-31 async-icq channel
+// Queries are sent to the counterparty's icq host module, and the responses
+// are returned in the packet acknowledgement
+type IBCModule struct {
+	keeper keeper.Keeper
+}
+
+func NewIBCModule(k keeper.Keeper) IBCModule {
+	return IBCModule{
+		keeper: k,
+	}
+}
+
+// Validates the channel parameters and claims the channel capability
+// The channel must be unordered and must connect to the counterparty's icq host port
+func (im IBCModule) OnChanOpenInit(
+	ctx sdk.Context,
+	order channeltypes.Order,
+	connectionHops []string,
+	portID string,
+	channelID string,
+	channelCap *capabilitytypes.Capability,
+	counterparty channeltypes.Counterparty,
+	version string,
+) (string, error) {
+	if order != channeltypes.UNORDERED {
+		return "", errorsmod.Wrapf(channeltypes.ErrInvalidChannelOrdering, "expected %s channel, got %s", channeltypes.UNORDERED, order)
+	}
+	if portID != types.PortID {
+		return "", errorsmod.Wrapf(types.ErrInvalidAsyncICQ, "invalid port: %s, expected %s", portID, types.PortID)
+	}
+	if counterparty.PortId != types.HostPortID {
+		return "", errorsmod.Wrapf(types.ErrInvalidAsyncICQ, "invalid counterparty port: %s, expected %s", counterparty.PortId, types.HostPortID)
+	}
+	if version != "" && version != types.Version {
+		return "", errorsmod.Wrapf(types.ErrInvalidAsyncICQ, "invalid version: %s, expected %s", version, types.Version)
+	}
+
+	if err := im.keeper.ClaimCapability(ctx, channelCap, host.ChannelCapabilityPath(portID, channelID)); err != nil {
+		return "", err
+	}
+
+	return types.Version, nil
+}
+
+// OnChanOpenTry should not be executed since Stride only acts as the query controller
+func (im IBCModule) OnChanOpenTry(
+	ctx sdk.Context,
+	order channeltypes.Order,
+	connectionHops []string,
+	portID,
+	channelID string,
+	chanCap *capabilitytypes.Capability,
+	counterparty channeltypes.Counterparty,
+	counterpartyVersion string,
+) (string, error) {
+	return "", errorsmod.Wrap(types.ErrInvalidAsyncICQ, "channel handshake must be initiated by the query controller")
+}
+
+// Confirms the host agreed on the async-icq version
+func (im IBCModule) OnChanOpenAck(
+	ctx sdk.Context,
+	portID,
+	channelID string,
+	counterpartyChannelID string,
+	counterpartyVersion string,
+) error {
+	if counterpartyVersion != types.Version {
+		return errorsmod.Wrapf(types.ErrInvalidAsyncICQ, "invalid counterparty version: %s, expected %s", counterpartyVersion, types.Version)
+	}
+	return nil
+}
+
+// OnChanOpenConfirm should not be executed since Stride only acts as the query controller
+func (im IBCModule) OnChanOpenConfirm(
+	ctx sdk.Context,
+	portID,
+	channelID string,
+) error {
+	return errorsmod.Wrap(types.ErrInvalidAsyncICQ, "channel handshake must be initiated by the query controller")
+}
+
+// Async-icq channels cannot be closed by users
+func (im IBCModule) OnChanCloseInit(
+	ctx sdk.Context,
+	portID,
+	channelID string,
+) error {
+	return errorsmod.Wrap(channeltypes.ErrInvalidChannel, "user cannot close channel")
+}
+
+// No custom logic is necessary in OnChanCloseConfirm since queries will fall back to
+// the relayer once the channel is no longer open
+func (im IBCModule) OnChanCloseConfirm(
+	ctx sdk.Context,
+	portID,
+	channelID string,
+) error {
+	return nil
+}
+
+// Stride does not serve queries, so packets should never be received
+func (im IBCModule) OnRecvPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	relayer sdk.AccAddress,
+) ibcexported.Acknowledgement {
+	return channeltypes.NewErrorAcknowledgement(errorsmod.Wrap(types.ErrInvalidAsyncICQPacket, "cannot receive packet on query controller"))
+}
+
+// Passes the query response from the acknowledgement to the query's callback
+func (im IBCModule) OnAcknowledgementPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	acknowledgement []byte,
+	relayer sdk.AccAddress,
+) error {
+	im.keeper.Logger(ctx).Info(fmt.Sprintf("OnAcknowledgementPacket (AsyncICQ) - sequence #%d, channel %s, relayer: %v",
+		packet.Sequence, packet.SourceChannel, relayer))
+
+	return im.keeper.OnAsyncICQAcknowledgement(ctx, packet, acknowledgement)
+}
+
+// Handles the query according to its timeout policy
+func (im IBCModule) OnTimeoutPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	relayer sdk.AccAddress,
+) error {
+	im.keeper.Logger(ctx).Info(fmt.Sprintf("OnTimeoutPacket (AsyncICQ) - sequence #%d, channel %s, relayer: %v",
+		packet.Sequence, packet.SourceChannel, relayer))
+
+	return im.keeper.OnAsyncICQTimeout(ctx, packet)
+}
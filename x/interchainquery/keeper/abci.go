@@ -8,6 +8,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	"github.com/Stride-Labs/stride/v24/utils"
 	"github.com/Stride-Labs/stride/v24/x/interchainquery/types"
 )
 
@@ -15,12 +16,33 @@ import (
 func (k Keeper) EndBlocker(ctx sdk.Context) {
 	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyEndBlocker)
 
+	// Remove any queries that were never answered, long after their timeout
+	k.PruneStaleQueries(ctx)
+
+	// The pending query counts for telemetry are tallied while iterating the queries
 	events := sdk.Events{}
+	pendingQueries := map[PendingQueryGroup]int{}
 	for _, query := range k.AllQueries(ctx) {
+		pendingQueries[PendingQueryGroup{query.CallbackId, query.ConnectionId}]++
 		if query.RequestSent {
 			continue
 		}
 
+		// If the query is a gRPC query and the connection has an open async-icq channel,
+		// send the query over IBC
+		// Otherwise (or if the send fails), emit the event for the off-chain relayer
+		if channelId, found := k.GetOpenAsyncICQChannelId(ctx, query.ConnectionId); found && query.AsyncICQCompatible() {
+			err := utils.ApplyFuncIfNoError(ctx, func(ctx sdk.Context) error {
+				return k.SendAsyncICQPacket(ctx, query, channelId)
+			})
+			if err == nil {
+				query.RequestSent = true
+				k.SetQuery(ctx, query)
+				continue
+			}
+			k.Logger(ctx).Error(fmt.Sprintf("Failed to send async-icq packet for query %s, falling back to relayer: %s", query.Id, err.Error()))
+		}
+
 		k.Logger(ctx).Info(fmt.Sprintf("Interchainquery event emitted %s", query.Id))
 
 		event := sdk.NewEvent(
@@ -43,7 +65,35 @@ func (k Keeper) EndBlocker(ctx sdk.Context) {
 		k.SetQuery(ctx, query)
 	}
 
+	// Batch queries are routed the same way as single key queries
+	for _, query := range k.AllBatchQueries(ctx) {
+		pendingQueries[PendingQueryGroup{query.CallbackId, query.ConnectionId}]++
+		if query.RequestSent {
+			continue
+		}
+
+		if channelId, found := k.GetOpenAsyncICQChannelId(ctx, query.ConnectionId); found && query.AsyncICQCompatible() {
+			err := utils.ApplyFuncIfNoError(ctx, func(ctx sdk.Context) error {
+				return k.SendAsyncICQBatchPacket(ctx, query, channelId)
+			})
+			if err == nil {
+				query.RequestSent = true
+				k.SetBatchQuery(ctx, query)
+				continue
+			}
+			k.Logger(ctx).Error(fmt.Sprintf("Failed to send async-icq packet for batch query %s, falling back to relayer: %s", query.Id, err.Error()))
+		}
+
+		k.Logger(ctx).Info(fmt.Sprintf("Interchainquery batch event emitted %s", query.Id))
+		events = append(events, k.GetBatchQueryRequestEvent(query))
+
+		query.RequestSent = true
+		k.SetBatchQuery(ctx, query)
+	}
+
 	if len(events) > 0 {
 		ctx.EventManager().EmitEvents(events)
 	}
+
+	k.EmitPendingQueryTelemetry(pendingQueries)
 }
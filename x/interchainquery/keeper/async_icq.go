@@ -0,0 +1,435 @@
+package keeper
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
+	clienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+	host "github.com/cosmos/ibc-go/v7/modules/core/24-host"
+
+	"github.com/Stride-Labs/stride/v24/utils"
+	"github.com/Stride-Labs/stride/v24/x/interchainquery/types"
+)
+
+// Stores the async-icq channel that should be used for queries on a connection
+func (k Keeper) SetAsyncICQChannel(ctx sdk.Context, asyncICQChannel types.AsyncICQChannel) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixAsyncICQChannel)
+	bz := k.cdc.MustMarshal(&asyncICQChannel)
+	store.Set([]byte(asyncICQChannel.ConnectionId), bz)
+}
+
+// Returns the async-icq channel config for a connection
+func (k Keeper) GetAsyncICQChannel(ctx sdk.Context, connectionId string) (asyncICQChannel types.AsyncICQChannel, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixAsyncICQChannel)
+	bz := store.Get([]byte(connectionId))
+	if len(bz) == 0 {
+		return asyncICQChannel, false
+	}
+	k.cdc.MustUnmarshal(bz, &asyncICQChannel)
+	return asyncICQChannel, true
+}
+
+// Removes the async-icq channel config for a connection
+func (k Keeper) RemoveAsyncICQChannel(ctx sdk.Context, connectionId string) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixAsyncICQChannel)
+	store.Delete([]byte(connectionId))
+}
+
+// Returns all async-icq channel configs
+func (k Keeper) GetAllAsyncICQChannels(ctx sdk.Context) []types.AsyncICQChannel {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixAsyncICQChannel)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	asyncICQChannels := []types.AsyncICQChannel{}
+	for ; iterator.Valid(); iterator.Next() {
+		asyncICQChannel := types.AsyncICQChannel{}
+		k.cdc.MustUnmarshal(iterator.Value(), &asyncICQChannel)
+		asyncICQChannels = append(asyncICQChannels, asyncICQChannel)
+	}
+	return asyncICQChannels
+}
+
+// Stores the query ID associated with an in-flight async-icq packet
+func (k Keeper) SetAsyncICQPacket(ctx sdk.Context, asyncICQPacket types.AsyncICQPacket) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixAsyncICQPacket)
+	bz := k.cdc.MustMarshal(&asyncICQPacket)
+	store.Set(types.AsyncICQPacketKey(asyncICQPacket.ChannelId, asyncICQPacket.Sequence), bz)
+}
+
+// Returns the in-flight async-icq packet for a given channel and sequence
+func (k Keeper) GetAsyncICQPacket(ctx sdk.Context, channelId string, sequence uint64) (asyncICQPacket types.AsyncICQPacket, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixAsyncICQPacket)
+	bz := store.Get(types.AsyncICQPacketKey(channelId, sequence))
+	if len(bz) == 0 {
+		return asyncICQPacket, false
+	}
+	k.cdc.MustUnmarshal(bz, &asyncICQPacket)
+	return asyncICQPacket, true
+}
+
+// Removes an in-flight async-icq packet
+func (k Keeper) RemoveAsyncICQPacket(ctx sdk.Context, channelId string, sequence uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixAsyncICQPacket)
+	store.Delete(types.AsyncICQPacketKey(channelId, sequence))
+}
+
+// Returns all in-flight async-icq packets
+func (k Keeper) GetAllAsyncICQPackets(ctx sdk.Context) []types.AsyncICQPacket {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixAsyncICQPacket)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	asyncICQPackets := []types.AsyncICQPacket{}
+	for ; iterator.Valid(); iterator.Next() {
+		asyncICQPacket := types.AsyncICQPacket{}
+		k.cdc.MustUnmarshal(iterator.Value(), &asyncICQPacket)
+		asyncICQPackets = append(asyncICQPackets, asyncICQPacket)
+	}
+	return asyncICQPackets
+}
+
+// Checks if the async-icq port has already been bound
+func (k Keeper) IsBound(ctx sdk.Context) bool {
+	_, ok := k.scopedKeeper.GetCapability(ctx, host.PortPath(types.PortID))
+	return ok
+}
+
+// Binds the async-icq port and claims the capability
+// This must be called from InitGenesis, or from an upgrade handler on a live chain,
+// before an async-icq channel can be opened
+func (k Keeper) BindPort(ctx sdk.Context) error {
+	if k.IsBound(ctx) {
+		return nil
+	}
+	capability := k.IBCKeeper.PortKeeper.BindPort(ctx, types.PortID)
+	return k.ClaimCapability(ctx, capability, host.PortPath(types.PortID))
+}
+
+// Claims a capability from the IBC module (e.g. for the port or a channel)
+func (k Keeper) ClaimCapability(ctx sdk.Context, capability *capabilitytypes.Capability, name string) error {
+	return k.scopedKeeper.ClaimCapability(ctx, capability, name)
+}
+
+// Returns the async-icq channel for a connection if routing is enabled for the connection
+// and the channel is still open. Otherwise, queries on the connection fall back to the relayer
+func (k Keeper) GetOpenAsyncICQChannelId(ctx sdk.Context, connectionId string) (channelId string, found bool) {
+	asyncICQChannel, found := k.GetAsyncICQChannel(ctx, connectionId)
+	if !found {
+		return "", false
+	}
+
+	channel, found := k.IBCKeeper.ChannelKeeper.GetChannel(ctx, types.PortID, asyncICQChannel.ChannelId)
+	if !found || channel.State != channeltypes.OPEN {
+		return "", false
+	}
+	if len(channel.ConnectionHops) == 0 || channel.ConnectionHops[0] != connectionId {
+		return "", false
+	}
+
+	return asyncICQChannel.ChannelId, true
+}
+
+// Validates that a channel can be used to route queries for a connection
+func (k Keeper) ValidateAsyncICQChannel(ctx sdk.Context, connectionId, channelId string) error {
+	channel, found := k.IBCKeeper.ChannelKeeper.GetChannel(ctx, types.PortID, channelId)
+	if !found {
+		return errorsmod.Wrapf(types.ErrInvalidAsyncICQ, "channel %s not found on port %s", channelId, types.PortID)
+	}
+	if channel.State != channeltypes.OPEN {
+		return errorsmod.Wrapf(types.ErrInvalidAsyncICQ, "channel %s is not open", channelId)
+	}
+	if len(channel.ConnectionHops) == 0 || channel.ConnectionHops[0] != connectionId {
+		return errorsmod.Wrapf(types.ErrInvalidAsyncICQ, "channel %s is not on connection %s", channelId, connectionId)
+	}
+	if channel.Counterparty.PortId != types.HostPortID {
+		return errorsmod.Wrapf(types.ErrInvalidAsyncICQ, "channel %s counterparty port must be %s", channelId, types.HostPortID)
+	}
+	return nil
+}
+
+// Sends a query over an async-icq channel and stores the packet sequence so the query
+// can be looked up when the acknowledgement is received
+// The packet times out at the same time as the query
+func (k Keeper) SendAsyncICQPacket(ctx sdk.Context, query types.Query, channelId string) error {
+	packetData, err := types.SerializeAsyncICQPacketData(query)
+	if err != nil {
+		return errorsmod.Wrapf(types.ErrInvalidAsyncICQPacket, "unable to serialize query %s: %s", query.Id, err.Error())
+	}
+	asyncICQPacket := types.AsyncICQPacket{ChannelId: channelId, QueryId: query.Id}
+	return k.sendAsyncICQPacket(ctx, asyncICQPacket, query.ChainId, query.ConnectionId, query.TimeoutTimestamp, packetData)
+}
+
+// Sends a batch query over an async-icq channel, with one request per key in the same packet
+// The packet times out at the same time as the batch query
+func (k Keeper) SendAsyncICQBatchPacket(ctx sdk.Context, query types.BatchQuery, channelId string) error {
+	packetData, err := types.SerializeAsyncICQBatchPacketData(query)
+	if err != nil {
+		return errorsmod.Wrapf(types.ErrInvalidAsyncICQPacket, "unable to serialize batch query %s: %s", query.Id, err.Error())
+	}
+	asyncICQPacket := types.AsyncICQPacket{ChannelId: channelId, QueryId: query.Id, Batch: true}
+	return k.sendAsyncICQPacket(ctx, asyncICQPacket, query.ChainId, query.ConnectionId, query.TimeoutTimestamp, packetData)
+}
+
+// Sends the serialized packet data over an async-icq channel and stores the packet
+// sequence with the associated query ID
+func (k Keeper) sendAsyncICQPacket(
+	ctx sdk.Context,
+	asyncICQPacket types.AsyncICQPacket,
+	chainId string,
+	connectionId string,
+	timeoutTimestamp uint64,
+	packetData []byte,
+) error {
+	channelId := asyncICQPacket.ChannelId
+	channelCapability, found := k.scopedKeeper.GetCapability(ctx, host.ChannelCapabilityPath(types.PortID, channelId))
+	if !found {
+		return errorsmod.Wrapf(types.ErrInvalidAsyncICQ, "capability not found for channel %s", channelId)
+	}
+
+	sequence, err := k.IBCKeeper.ChannelKeeper.SendPacket(
+		ctx,
+		channelCapability,
+		types.PortID,
+		channelId,
+		clienttypes.ZeroHeight(),
+		timeoutTimestamp,
+		packetData,
+	)
+	if err != nil {
+		return err
+	}
+
+	asyncICQPacket.Sequence = sequence
+	k.SetAsyncICQPacket(ctx, asyncICQPacket)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeAsyncICQRequest,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(types.AttributeKeyQueryId, asyncICQPacket.QueryId),
+			sdk.NewAttribute(types.AttributeKeyChainId, chainId),
+			sdk.NewAttribute(types.AttributeKeyConnectionId, connectionId),
+			sdk.NewAttribute(types.AttributeKeyChannelId, channelId),
+			sdk.NewAttribute(types.AttributeKeySequence, strconv.FormatUint(sequence, 10)),
+		),
+	)
+
+	return nil
+}
+
+// Handles the acknowledgement of an async-icq packet
+// Successful responses are passed through the same callback path as a relayed response,
+// while error acknowledgements are treated the same as a timeout
+func (k Keeper) OnAsyncICQAcknowledgement(ctx sdk.Context, packet channeltypes.Packet, acknowledgement []byte) error {
+	asyncICQPacket, found := k.GetAsyncICQPacket(ctx, packet.SourceChannel, packet.Sequence)
+	if !found {
+		k.Logger(ctx).Info("Ignoring async-icq acknowledgement with no associated query")
+		return nil
+	}
+	k.RemoveAsyncICQPacket(ctx, packet.SourceChannel, packet.Sequence)
+
+	var ack channeltypes.Acknowledgement
+	if err := channeltypes.SubModuleCdc.UnmarshalJSON(acknowledgement, &ack); err != nil {
+		return errorsmod.Wrapf(types.ErrInvalidAsyncICQPacket, "cannot unmarshal packet acknowledgement: %s", err.Error())
+	}
+
+	if asyncICQPacket.Batch {
+		return k.onAsyncICQBatchAcknowledgement(ctx, asyncICQPacket.QueryId, ack)
+	}
+
+	query, found := k.GetQuery(ctx, asyncICQPacket.QueryId)
+	if !found {
+		k.Logger(ctx).Info(fmt.Sprintf("Ignoring async-icq acknowledgement for non-existent query %s", asyncICQPacket.QueryId))
+		return nil
+	}
+
+	ackEvent := sdk.NewEvent(
+		types.EventTypeAsyncICQAck,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		sdk.NewAttribute(types.AttributeKeyQueryId, query.Id),
+		sdk.NewAttribute(types.AttributeKeyChainId, query.ChainId),
+		sdk.NewAttribute(types.AttributeKeyAckSuccess, strconv.FormatBool(ack.Success())),
+	)
+
+	// Error acknowledgements are handled according to the query's timeout policy
+	if !ack.Success() {
+		k.Logger(ctx).Error(utils.LogICQCallbackWithHostZone(query.ChainId, query.CallbackId,
+			"Async-icq query failed on host - QueryId: %s, Error: %s", query.Id, ack.GetError()))
+		ctx.EventManager().EmitEvent(ackEvent.AppendAttributes(sdk.NewAttribute(types.AttributeKeyAckError, ack.GetError())))
+
+		k.handleFailedAsyncICQ(ctx, query)
+		return nil
+	}
+	ctx.EventManager().EmitEvent(ackEvent)
+
+	response, err := types.DeserializeAsyncICQAcknowledgement(ack.GetResult())
+	if err != nil {
+		return err
+	}
+
+	msg := types.MsgSubmitQueryResponse{
+		ChainId: query.ChainId,
+		QueryId: query.Id,
+		Result:  response.Value,
+		Height:  response.Height,
+	}
+
+	// Responses delivered over async-icq are not submitted by a relayer, so any relayer fee is refunded
+	k.DeleteQuery(ctx, query.Id)
+	k.refundQueryFeeOrLog(ctx, query)
+	k.applyAsyncICQCallback(ctx, query.Record(types.QueryOutcome_QUERY_CALLBACK_FAILED), query.SubmissionTime(), response.Height,
+		func(ctx sdk.Context) error {
+			return k.HandleQueryResponse(ctx, &msg, query)
+		})
+
+	return nil
+}
+
+// Handles the acknowledgement of an async-icq packet for a batch query
+// The responses are in the same order as the batch's request keys
+func (k Keeper) onAsyncICQBatchAcknowledgement(ctx sdk.Context, queryId string, ack channeltypes.Acknowledgement) error {
+	query, found := k.GetBatchQuery(ctx, queryId)
+	if !found {
+		k.Logger(ctx).Info(fmt.Sprintf("Ignoring async-icq acknowledgement for non-existent batch query %s", queryId))
+		return nil
+	}
+
+	ackEvent := sdk.NewEvent(
+		types.EventTypeAsyncICQAck,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		sdk.NewAttribute(types.AttributeKeyQueryId, query.Id),
+		sdk.NewAttribute(types.AttributeKeyChainId, query.ChainId),
+		sdk.NewAttribute(types.AttributeKeyAckSuccess, strconv.FormatBool(ack.Success())),
+	)
+
+	if !ack.Success() {
+		k.Logger(ctx).Error(utils.LogICQCallbackWithHostZone(query.ChainId, query.CallbackId,
+			"Async-icq batch query failed on host - QueryId: %s, Error: %s", query.Id, ack.GetError()))
+		ctx.EventManager().EmitEvent(ackEvent.AppendAttributes(sdk.NewAttribute(types.AttributeKeyAckError, ack.GetError())))
+
+		k.handleFailedAsyncICQBatch(ctx, query)
+		return nil
+	}
+	ctx.EventManager().EmitEvent(ackEvent)
+
+	responses, err := types.DeserializeAsyncICQBatchAcknowledgement(ack.GetResult(), len(query.RequestKeys))
+	if err != nil {
+		return err
+	}
+
+	results := map[string][]byte{}
+	for i, requestKey := range query.RequestKeys {
+		results[string(requestKey)] = responses[i].Value
+	}
+	responseHeight := responses[0].Height
+
+	// Responses delivered over async-icq are not submitted by a relayer, so any relayer fee is refunded
+	k.DeleteBatchQuery(ctx, query.Id)
+	k.refundBatchQueryFeeOrLog(ctx, query)
+	k.applyAsyncICQCallback(ctx, query.Record(types.QueryOutcome_QUERY_CALLBACK_FAILED), query.SubmissionTime(), responseHeight,
+		func(ctx sdk.Context) error {
+			return k.HandleBatchQueryResponse(ctx, results, responseHeight, query)
+		})
+
+	return nil
+}
+
+// Handles the timeout of an async-icq packet according to the query's timeout policy
+func (k Keeper) OnAsyncICQTimeout(ctx sdk.Context, packet channeltypes.Packet) error {
+	asyncICQPacket, found := k.GetAsyncICQPacket(ctx, packet.SourceChannel, packet.Sequence)
+	if !found {
+		k.Logger(ctx).Info("Ignoring async-icq timeout with no associated query")
+		return nil
+	}
+	k.RemoveAsyncICQPacket(ctx, packet.SourceChannel, packet.Sequence)
+
+	if asyncICQPacket.Batch {
+		query, found := k.GetBatchQuery(ctx, asyncICQPacket.QueryId)
+		if !found {
+			k.Logger(ctx).Info(fmt.Sprintf("Ignoring async-icq timeout for non-existent batch query %s", asyncICQPacket.QueryId))
+			return nil
+		}
+		emitAsyncICQTimeoutEvent(ctx, query.Id, query.ChainId)
+		k.handleFailedAsyncICQBatch(ctx, query)
+		return nil
+	}
+
+	query, found := k.GetQuery(ctx, asyncICQPacket.QueryId)
+	if !found {
+		k.Logger(ctx).Info(fmt.Sprintf("Ignoring async-icq timeout for non-existent query %s", asyncICQPacket.QueryId))
+		return nil
+	}
+	emitAsyncICQTimeoutEvent(ctx, query.Id, query.ChainId)
+	k.handleFailedAsyncICQ(ctx, query)
+
+	return nil
+}
+
+// Emits an event for an async-icq packet that timed out
+func emitAsyncICQTimeoutEvent(ctx sdk.Context, queryId, chainId string) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeAsyncICQTimeout,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(types.AttributeKeyQueryId, queryId),
+			sdk.NewAttribute(types.AttributeKeyChainId, chainId),
+		),
+	)
+}
+
+// Removes a query whose async-icq packet failed (error ack or timeout) and
+// handles it according to the query's timeout policy
+func (k Keeper) handleFailedAsyncICQ(ctx sdk.Context, query types.Query) {
+	k.DeleteQuery(ctx, query.Id)
+	k.refundQueryFeeOrLog(ctx, query)
+	k.applyAsyncICQCallback(ctx, query.Record(types.QueryOutcome_QUERY_CALLBACK_FAILED), query.SubmissionTime(), 0,
+		func(ctx sdk.Context) error {
+			return k.HandleQueryTimeout(ctx, &types.MsgSubmitQueryResponse{ChainId: query.ChainId, QueryId: query.Id}, query)
+		})
+}
+
+// Removes a batch query whose async-icq packet failed (error ack or timeout) and
+// handles it according to the batch query's timeout policy
+func (k Keeper) handleFailedAsyncICQBatch(ctx sdk.Context, query types.BatchQuery) {
+	k.DeleteBatchQuery(ctx, query.Id)
+	k.refundBatchQueryFeeOrLog(ctx, query)
+	k.applyAsyncICQCallback(ctx, query.Record(types.QueryOutcome_QUERY_CALLBACK_FAILED), query.SubmissionTime(), 0,
+		func(ctx sdk.Context) error {
+			k.RecordBatchQueryOutcome(ctx, query, types.QueryOutcome_QUERY_TIMED_OUT, 0, nil)
+			return k.HandleBatchQueryTimeout(ctx, map[string][]byte{}, query)
+		})
+}
+
+// Runs the query's callback (or timeout handler) in a cached context
+// A failed callback should not block the acknowledgement or timeout from being processed,
+// so the error is logged and recorded (with the provided failure record), and the callback's
+// state changes are discarded
+func (k Keeper) applyAsyncICQCallback(
+	ctx sdk.Context,
+	failureRecord types.QueryRecord,
+	submissionTime time.Time,
+	responseHeight int64,
+	callback func(ctx sdk.Context) error,
+) {
+	if err := utils.ApplyFuncIfNoError(ctx, callback); err != nil {
+		k.recordQueryOutcome(ctx, failureRecord, submissionTime, responseHeight, err)
+		k.Logger(ctx).Error(utils.LogICQCallbackWithHostZone(failureRecord.ChainId, failureRecord.CallbackId,
+			"Async-icq callback failed - QueryId: %s, Error: %s", failureRecord.QueryId, err.Error()))
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeAsyncICQCallbackFailed,
+				sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+				sdk.NewAttribute(types.AttributeKeyQueryId, failureRecord.QueryId),
+				sdk.NewAttribute(types.AttributeKeyChainId, failureRecord.ChainId),
+				sdk.NewAttribute(types.AttributeKeyAckError, err.Error()),
+			),
+		)
+	}
+}
@@ -0,0 +1,481 @@
+package keeper_test
+
+import (
+	"errors"
+	"time"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/cosmos/gogoproto/proto"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+	host "github.com/cosmos/ibc-go/v7/modules/core/24-host"
+
+	"github.com/Stride-Labs/stride/v24/utils"
+	"github.com/Stride-Labs/stride/v24/x/interchainquery/types"
+	stakeibctypes "github.com/Stride-Labs/stride/v24/x/stakeibc/types"
+)
+
+const (
+	AsyncICQChannelId = "channel-10"
+
+	BankAllBalancesQueryType = "cosmos.bank.v1beta1.Query/AllBalances"
+	BankBalanceQueryType     = "cosmos.bank.v1beta1.Query/Balance"
+)
+
+type AsyncICQTestCase struct {
+	connectionId string
+	query        types.Query
+}
+
+// Mocks out an open async-icq channel on the transfer connection and stores an unsent query
+func (s *KeeperTestSuite) SetupAsyncICQ() AsyncICQTestCase {
+	s.CreateTransferChannel(HostChainId)
+	connectionId := s.TransferPath.EndpointA.ConnectionID
+
+	channel := channeltypes.Channel{
+		State:          channeltypes.OPEN,
+		Ordering:       channeltypes.UNORDERED,
+		Counterparty:   channeltypes.NewCounterparty(types.HostPortID, "channel-0"),
+		ConnectionHops: []string{connectionId},
+		Version:        types.Version,
+	}
+	s.App.IBCKeeper.ChannelKeeper.SetChannel(s.Ctx, types.PortID, AsyncICQChannelId, channel)
+	s.App.IBCKeeper.ChannelKeeper.SetNextSequenceSend(s.Ctx, types.PortID, AsyncICQChannelId, 1)
+
+	// The channel capability is created by core IBC and claimed by the module during the handshake
+	capabilityName := host.ChannelCapabilityPath(types.PortID, AsyncICQChannelId)
+	channelCapability, err := s.App.ScopedIBCKeeper.NewCapability(s.Ctx, capabilityName)
+	s.Require().NoError(err, "no error expected when creating channel capability")
+	err = s.App.InterchainqueryKeeper.ClaimCapability(s.Ctx, channelCapability, capabilityName)
+	s.Require().NoError(err, "no error expected when claiming channel capability")
+
+	// Only gRPC queries can be served by the async-icq host
+	requestData, err := proto.Marshal(&banktypes.QueryAllBalancesRequest{Address: s.TestAccs[0].String()})
+	s.Require().NoError(err, "no error expected when marshalling request")
+
+	timeoutDuration := time.Minute
+	query := types.Query{
+		Id:               "query-1",
+		CallbackId:       "withdrawalbalance",
+		CallbackModule:   "stakeibc",
+		ChainId:          HostChainId,
+		ConnectionId:     connectionId,
+		QueryType:        BankAllBalancesQueryType,
+		RequestData:      requestData,
+		TimeoutDuration:  timeoutDuration,
+		TimeoutTimestamp: uint64(s.Ctx.BlockTime().Add(timeoutDuration).UnixNano()),
+		TimeoutPolicy:    types.TimeoutPolicy_REJECT_QUERY_RESPONSE,
+	}
+	s.App.InterchainqueryKeeper.SetQuery(s.Ctx, query)
+
+	hostZone := stakeibctypes.HostZone{
+		ChainId:      HostChainId,
+		ConnectionId: connectionId,
+	}
+	s.App.StakeibcKeeper.SetHostZone(s.Ctx, hostZone)
+
+	s.App.InterchainqueryKeeper.SetAsyncICQChannel(s.Ctx, types.AsyncICQChannel{
+		ConnectionId: connectionId,
+		ChannelId:    AsyncICQChannelId,
+	})
+
+	return AsyncICQTestCase{
+		connectionId: connectionId,
+		query:        query,
+	}
+}
+
+// Helper function to build a successful ICS-31 acknowledgement with the given query result
+func (s *KeeperTestSuite) buildAsyncICQAck(result []byte) []byte {
+	cosmosResponse := types.CosmosResponse{
+		Responses: []abci.ResponseQuery{{Value: result, Height: 10}},
+	}
+	cosmosResponseBz, err := proto.Marshal(&cosmosResponse)
+	s.Require().NoError(err)
+
+	packetAckBz, err := s.App.AppCodec().MarshalJSON(&types.InterchainQueryPacketAck{Data: cosmosResponseBz})
+	s.Require().NoError(err)
+
+	return channeltypes.NewResultAcknowledgement(packetAckBz).Acknowledgement()
+}
+
+// Mocks the ICS-31 async-icq host (using this app as the host chain), by executing each
+// request in the packet data through the gRPC query router, the same way the standard host
+// module does, and returns the resulting acknowledgement
+// The host rejects any request that's not in its allowed queries or has no gRPC route
+func (s *KeeperTestSuite) mockAsyncICQHost(packetData []byte, allowedQueries []string) []byte {
+	var interchainQueryPacketData types.InterchainQueryPacketData
+	err := s.App.AppCodec().UnmarshalJSON(packetData, &interchainQueryPacketData)
+	s.Require().NoError(err, "no error expected when unmarshalling packet data")
+
+	var cosmosQuery types.CosmosQuery
+	err = proto.Unmarshal(interchainQueryPacketData.Data, &cosmosQuery)
+	s.Require().NoError(err, "no error expected when unmarshalling cosmos query")
+
+	responses := []abci.ResponseQuery{}
+	for _, request := range cosmosQuery.Requests {
+		if !utils.ContainsString(allowedQueries, request.Path) {
+			return channeltypes.NewErrorAcknowledgement(errors.New("query path not allowed")).Acknowledgement()
+		}
+		route := s.App.GRPCQueryRouter().Route(request.Path)
+		if route == nil {
+			return channeltypes.NewErrorAcknowledgement(errors.New("no route found")).Acknowledgement()
+		}
+		response, err := route(s.Ctx, request)
+		if err != nil {
+			return channeltypes.NewErrorAcknowledgement(err).Acknowledgement()
+		}
+		responses = append(responses, abci.ResponseQuery{Value: response.Value, Height: s.Ctx.BlockHeight()})
+	}
+
+	cosmosResponseBz, err := proto.Marshal(&types.CosmosResponse{Responses: responses})
+	s.Require().NoError(err)
+	packetAckBz, err := s.App.AppCodec().MarshalJSON(&types.InterchainQueryPacketAck{Data: cosmosResponseBz})
+	s.Require().NoError(err)
+
+	return channeltypes.NewResultAcknowledgement(packetAckBz).Acknowledgement()
+}
+
+func (s *KeeperTestSuite) TestAsyncICQ_PortBound() {
+	s.Require().True(s.App.InterchainqueryKeeper.IsBound(s.Ctx), "async-icq port should be bound at genesis")
+}
+
+func (s *KeeperTestSuite) TestAsyncICQ_EndBlockerSendsPacket() {
+	tc := s.SetupAsyncICQ()
+
+	s.App.InterchainqueryKeeper.EndBlocker(s.Ctx)
+
+	// The query should be marked as sent, and the packet should be stored
+	query, found := s.App.InterchainqueryKeeper.GetQuery(s.Ctx, tc.query.Id)
+	s.Require().True(found, "query should still exist")
+	s.Require().True(query.RequestSent, "query should be marked as sent")
+
+	asyncICQPacket, found := s.App.InterchainqueryKeeper.GetAsyncICQPacket(s.Ctx, AsyncICQChannelId, 1)
+	s.Require().True(found, "async-icq packet should be stored")
+	s.Require().Equal(tc.query.Id, asyncICQPacket.QueryId, "async-icq packet query ID")
+
+	commitment := s.App.IBCKeeper.ChannelKeeper.GetPacketCommitment(s.Ctx, types.PortID, AsyncICQChannelId, 1)
+	s.Require().NotEmpty(commitment, "packet commitment should exist")
+
+	// The relayer event should not have been emitted
+	for _, event := range s.Ctx.EventManager().Events() {
+		s.Require().NotEqual("query_request", event.Type, "relayer event should not be emitted")
+	}
+}
+
+func (s *KeeperTestSuite) TestAsyncICQ_EndBlockerFallsBackToRelayer() {
+	tc := s.SetupAsyncICQ()
+
+	// Close the channel - the query should fall back to the relayer
+	channel, _ := s.App.IBCKeeper.ChannelKeeper.GetChannel(s.Ctx, types.PortID, AsyncICQChannelId)
+	channel.State = channeltypes.CLOSED
+	s.App.IBCKeeper.ChannelKeeper.SetChannel(s.Ctx, types.PortID, AsyncICQChannelId, channel)
+
+	s.App.InterchainqueryKeeper.EndBlocker(s.Ctx)
+
+	query, found := s.App.InterchainqueryKeeper.GetQuery(s.Ctx, tc.query.Id)
+	s.Require().True(found, "query should still exist")
+	s.Require().True(query.RequestSent, "query should be marked as sent")
+
+	s.Require().Empty(s.App.InterchainqueryKeeper.GetAllAsyncICQPackets(s.Ctx), "no async-icq packets")
+
+	eventFound := false
+	for _, event := range s.Ctx.EventManager().Events() {
+		if event.Type == "query_request" {
+			eventFound = true
+		}
+	}
+	s.Require().True(eventFound, "relayer event should be emitted")
+}
+
+func (s *KeeperTestSuite) TestAsyncICQ_StoreQueryFallsBackToRelayer() {
+	tc := s.SetupAsyncICQ()
+
+	// Store queries are rejected by the async-icq host, so they should go to the
+	// relayer even though the channel is open
+	_, addr, _ := bech32.DecodeAndConvert(s.TestAccs[0].String())
+	tc.query.QueryType = types.BANK_STORE_QUERY_WITH_PROOF
+	tc.query.RequestData = banktypes.CreateAccountBalancesPrefix(addr)
+	s.App.InterchainqueryKeeper.SetQuery(s.Ctx, tc.query)
+
+	s.App.InterchainqueryKeeper.EndBlocker(s.Ctx)
+
+	query, found := s.App.InterchainqueryKeeper.GetQuery(s.Ctx, tc.query.Id)
+	s.Require().True(found, "query should still exist")
+	s.Require().True(query.RequestSent, "query should be marked as sent")
+	s.Require().Empty(s.App.InterchainqueryKeeper.GetAllAsyncICQPackets(s.Ctx), "no async-icq packets")
+	s.CheckEventTypeEmitted("query_request")
+}
+
+func (s *KeeperTestSuite) TestAsyncICQ_HostAcceptsPacket() {
+	s.SetupAsyncICQ()
+
+	// Register a batch query for the balances of two accounts
+	callbackResults := &[]map[string][]byte{}
+	err := s.App.InterchainqueryKeeper.SetCallbackHandler(BatchTestModule, BatchTestCallbacks{results: callbackResults})
+	s.Require().NoError(err, "no error expected when registering the batch callback handler")
+
+	expectedBalances := []sdk.Coin{sdk.NewInt64Coin("ustrd", 1000), sdk.NewInt64Coin("ustrd", 2000)}
+	requestKeys := [][]byte{}
+	for i, balance := range expectedBalances {
+		s.FundAccount(s.TestAccs[i+1], balance)
+		requestKey, err := proto.Marshal(&banktypes.QueryBalanceRequest{Address: s.TestAccs[i+1].String(), Denom: "ustrd"})
+		s.Require().NoError(err, "no error expected when marshalling request")
+		requestKeys = append(requestKeys, requestKey)
+	}
+
+	batchQuery := types.BatchQuery{
+		Id:               "batch-1",
+		ChainId:          HostChainId,
+		ConnectionId:     s.TransferPath.EndpointA.ConnectionID,
+		QueryType:        BankBalanceQueryType,
+		RequestKeys:      requestKeys,
+		CallbackModule:   BatchTestModule,
+		CallbackId:       BatchTestCallbackId,
+		TimeoutDuration:  time.Minute,
+		TimeoutTimestamp: uint64(s.Ctx.BlockTime().Add(time.Minute).UnixNano()),
+		TimeoutPolicy:    types.TimeoutPolicy_REJECT_QUERY_RESPONSE,
+	}
+	s.Require().True(batchQuery.AsyncICQCompatible(), "gRPC query should be async-icq compatible")
+
+	// The host should route the packet's query path to the bank gRPC query
+	packetData, err := types.SerializeAsyncICQBatchPacketData(batchQuery)
+	s.Require().NoError(err, "no error expected when serializing packet data")
+	ack := s.mockAsyncICQHost(packetData, []string{"/" + BankBalanceQueryType})
+
+	// Process the host's acknowledgement and confirm the callback receives the balance responses
+	s.App.InterchainqueryKeeper.SetBatchQuery(s.Ctx, batchQuery)
+	s.App.InterchainqueryKeeper.SetAsyncICQPacket(s.Ctx, types.AsyncICQPacket{
+		ChannelId: AsyncICQChannelId,
+		Sequence:  1,
+		QueryId:   batchQuery.Id,
+		Batch:     true,
+	})
+	packet := channeltypes.Packet{SourcePort: types.PortID, SourceChannel: AsyncICQChannelId, Sequence: 1}
+	err = s.App.InterchainqueryKeeper.OnAsyncICQAcknowledgement(s.Ctx, packet, ack)
+	s.Require().NoError(err, "no error expected when processing host ack")
+
+	s.Require().Len(*callbackResults, 1, "callback should be invoked once")
+	for i, requestKey := range requestKeys {
+		var response banktypes.QueryBalanceResponse
+		err := proto.Unmarshal((*callbackResults)[0][string(requestKey)], &response)
+		s.Require().NoError(err, "no error expected when unmarshalling balance response %d", i)
+		s.Require().Equal(expectedBalances[i], *response.Balance, "balance response %d", i)
+	}
+
+	// A store query path has no gRPC route, and would be rejected by the host
+	storeQuery := batchQuery
+	storeQuery.QueryType = types.BANK_STORE_QUERY_WITH_PROOF
+	s.Require().False(storeQuery.AsyncICQCompatible(), "store query should not be async-icq compatible")
+
+	packetData, err = types.SerializeAsyncICQBatchPacketData(storeQuery)
+	s.Require().NoError(err, "no error expected when serializing packet data")
+	ack = s.mockAsyncICQHost(packetData, []string{"/" + types.BANK_STORE_QUERY_WITH_PROOF})
+
+	var acknowledgement channeltypes.Acknowledgement
+	err = channeltypes.SubModuleCdc.UnmarshalJSON(ack, &acknowledgement)
+	s.Require().NoError(err, "no error expected when unmarshalling ack")
+	s.Require().False(acknowledgement.Success(), "host should reject store query")
+}
+
+func (s *KeeperTestSuite) TestAsyncICQ_AcknowledgementInvokesCallback() {
+	tc := s.SetupAsyncICQ()
+	s.App.InterchainqueryKeeper.EndBlocker(s.Ctx)
+
+	packet := channeltypes.Packet{SourcePort: types.PortID, SourceChannel: AsyncICQChannelId, Sequence: 1}
+	ack := s.buildAsyncICQAck([]byte("result-example"))
+
+	// The withdrawal balance callback is covered in stakeibc, so we just confirm it's
+	// invoked by catching the error that's thrown at the start of the callback
+	// The error should be surfaced in an event rather than failing the acknowledgement
+	err := s.App.InterchainqueryKeeper.OnAsyncICQAcknowledgement(s.Ctx, packet, ack)
+	s.Require().NoError(err, "no error expected when callback fails")
+
+	callbackError := ""
+	for _, event := range s.Ctx.EventManager().Events() {
+		if event.Type == types.EventTypeAsyncICQCallbackFailed {
+			for _, attribute := range event.Attributes {
+				if attribute.Key == types.AttributeKeyAckError {
+					callbackError = attribute.Value
+				}
+			}
+		}
+	}
+	s.Require().Contains(callbackError, "unable to determine balance from query response")
+
+	_, found := s.App.InterchainqueryKeeper.GetQuery(s.Ctx, tc.query.Id)
+	s.Require().False(found, "query should be removed after failed callback")
+
+	// Process the ack with an empty result, which should be treated as a contentless response
+	s.App.InterchainqueryKeeper.SetAsyncICQPacket(s.Ctx, types.AsyncICQPacket{
+		ChannelId: AsyncICQChannelId,
+		Sequence:  1,
+		QueryId:   tc.query.Id,
+	})
+	err = s.App.InterchainqueryKeeper.OnAsyncICQAcknowledgement(s.Ctx, packet, s.buildAsyncICQAck([]byte{}))
+	s.Require().NoError(err, "no error expected with contentless response")
+
+	_, found = s.App.InterchainqueryKeeper.GetQuery(s.Ctx, tc.query.Id)
+	s.Require().False(found, "query should be removed")
+	_, found = s.App.InterchainqueryKeeper.GetAsyncICQPacket(s.Ctx, AsyncICQChannelId, 1)
+	s.Require().False(found, "async-icq packet should be removed")
+}
+
+func (s *KeeperTestSuite) TestAsyncICQ_ErrorAcknowledgement() {
+	tc := s.SetupAsyncICQ()
+	s.App.InterchainqueryKeeper.EndBlocker(s.Ctx)
+
+	packet := channeltypes.Packet{SourcePort: types.PortID, SourceChannel: AsyncICQChannelId, Sequence: 1}
+	ack := channeltypes.NewErrorAcknowledgement(types.ErrInvalidAsyncICQPacket).Acknowledgement()
+
+	// With the reject policy, the query should be removed without invoking the callback
+	err := s.App.InterchainqueryKeeper.OnAsyncICQAcknowledgement(s.Ctx, packet, ack)
+	s.Require().NoError(err, "no error expected with error ack")
+
+	_, found := s.App.InterchainqueryKeeper.GetQuery(s.Ctx, tc.query.Id)
+	s.Require().False(found, "query should be removed")
+	s.Require().Empty(s.App.InterchainqueryKeeper.GetAllAsyncICQPackets(s.Ctx), "no async-icq packets")
+}
+
+func (s *KeeperTestSuite) TestAsyncICQ_Timeout_RetryQuery() {
+	tc := s.SetupAsyncICQ()
+	tc.query.TimeoutPolicy = types.TimeoutPolicy_RETRY_QUERY_REQUEST
+	s.App.InterchainqueryKeeper.SetQuery(s.Ctx, tc.query)
+	s.App.InterchainqueryKeeper.EndBlocker(s.Ctx)
+
+	packet := channeltypes.Packet{SourcePort: types.PortID, SourceChannel: AsyncICQChannelId, Sequence: 1}
+	err := s.App.InterchainqueryKeeper.OnAsyncICQTimeout(s.Ctx, packet)
+	s.Require().NoError(err, "no error expected during timeout")
+
+	// The original query should be replaced with an unsent retry
+	_, found := s.App.InterchainqueryKeeper.GetQuery(s.Ctx, tc.query.Id)
+	s.Require().False(found, "original query should be removed")
+
+	queries := s.App.InterchainqueryKeeper.AllQueries(s.Ctx)
+	s.Require().Len(queries, 1, "there should be one new query")
+	s.Require().False(queries[0].RequestSent, "retry should not be sent yet")
+	s.Require().Empty(s.App.InterchainqueryKeeper.GetAllAsyncICQPackets(s.Ctx), "no async-icq packets")
+}
+
+// Helper function to build a successful ICS-31 acknowledgement with one response per batch key
+func (s *KeeperTestSuite) buildAsyncICQBatchAck(results ...[]byte) []byte {
+	responses := []abci.ResponseQuery{}
+	for _, result := range results {
+		responses = append(responses, abci.ResponseQuery{Value: result, Height: 10})
+	}
+	cosmosResponseBz, err := proto.Marshal(&types.CosmosResponse{Responses: responses})
+	s.Require().NoError(err)
+
+	packetAckBz, err := s.App.AppCodec().MarshalJSON(&types.InterchainQueryPacketAck{Data: cosmosResponseBz})
+	s.Require().NoError(err)
+
+	return channeltypes.NewResultAcknowledgement(packetAckBz).Acknowledgement()
+}
+
+func (s *KeeperTestSuite) TestAsyncICQ_BatchQuery() {
+	tc := s.SetupAsyncICQ()
+	s.App.InterchainqueryKeeper.DeleteQuery(s.Ctx, tc.query.Id)
+
+	callbackResults := &[]map[string][]byte{}
+	err := s.App.InterchainqueryKeeper.SetCallbackHandler(BatchTestModule, BatchTestCallbacks{results: callbackResults})
+	s.Require().NoError(err, "no error expected when registering the batch callback handler")
+
+	batchQuery := types.BatchQuery{
+		Id:               "batch-1",
+		ChainId:          HostChainId,
+		ConnectionId:     tc.connectionId,
+		QueryType:        BankBalanceQueryType,
+		RequestKeys:      [][]byte{[]byte("key-1"), []byte("key-2")},
+		CallbackModule:   BatchTestModule,
+		CallbackId:       BatchTestCallbackId,
+		TimeoutDuration:  time.Minute,
+		TimeoutTimestamp: uint64(s.Ctx.BlockTime().Add(time.Minute).UnixNano()),
+		TimeoutPolicy:    types.TimeoutPolicy_REJECT_QUERY_RESPONSE,
+	}
+	s.App.InterchainqueryKeeper.SetBatchQuery(s.Ctx, batchQuery)
+
+	// The batch should be sent as a single packet instead of a relayer event
+	s.App.InterchainqueryKeeper.EndBlocker(s.Ctx)
+
+	storedBatch, found := s.App.InterchainqueryKeeper.GetBatchQuery(s.Ctx, batchQuery.Id)
+	s.Require().True(found, "batch query should still exist")
+	s.Require().True(storedBatch.RequestSent, "batch query should be marked as sent")
+	s.CheckEventTypeNotEmitted(types.EventTypeBatchQueryRequest)
+
+	asyncICQPacket, found := s.App.InterchainqueryKeeper.GetAsyncICQPacket(s.Ctx, AsyncICQChannelId, 1)
+	s.Require().True(found, "async-icq packet should be stored")
+	s.Require().Equal(batchQuery.Id, asyncICQPacket.QueryId, "async-icq packet query ID")
+	s.Require().True(asyncICQPacket.Batch, "async-icq packet should be flagged as a batch")
+
+	// An ack with the wrong number of responses should be rejected
+	packet := channeltypes.Packet{SourcePort: types.PortID, SourceChannel: AsyncICQChannelId, Sequence: 1}
+	err = s.App.InterchainqueryKeeper.OnAsyncICQAcknowledgement(s.Ctx, packet, s.buildAsyncICQBatchAck([]byte("value-1")))
+	s.Require().ErrorContains(err, "expected 2 responses")
+
+	// A valid ack should invoke the batch callback with the results indexed by key
+	s.App.InterchainqueryKeeper.SetAsyncICQPacket(s.Ctx, asyncICQPacket)
+	err = s.App.InterchainqueryKeeper.OnAsyncICQAcknowledgement(s.Ctx, packet, s.buildAsyncICQBatchAck([]byte("value-1"), []byte{}))
+	s.Require().NoError(err, "no error expected when processing batch ack")
+
+	s.Require().Len(*callbackResults, 1, "callback should be invoked once")
+	s.Require().Equal(map[string][]byte{
+		"key-1": []byte("value-1"),
+		"key-2": nil,
+	}, (*callbackResults)[0], "callback results")
+
+	_, found = s.App.InterchainqueryKeeper.GetBatchQuery(s.Ctx, batchQuery.Id)
+	s.Require().False(found, "batch query should be removed")
+	s.Require().Empty(s.App.InterchainqueryKeeper.GetAllAsyncICQPackets(s.Ctx), "no async-icq packets")
+}
+
+func (s *KeeperTestSuite) TestAsyncICQ_UnknownPacket() {
+	packet := channeltypes.Packet{SourcePort: types.PortID, SourceChannel: AsyncICQChannelId, Sequence: 1}
+
+	err := s.App.InterchainqueryKeeper.OnAsyncICQAcknowledgement(s.Ctx, packet, s.buildAsyncICQAck([]byte("result")))
+	s.Require().NoError(err, "no error expected for unknown ack")
+
+	err = s.App.InterchainqueryKeeper.OnAsyncICQTimeout(s.Ctx, packet)
+	s.Require().NoError(err, "no error expected for unknown timeout")
+}
+
+func (s *KeeperTestSuite) TestMsgSetAsyncICQChannel() {
+	tc := s.SetupAsyncICQ()
+	s.App.InterchainqueryKeeper.RemoveAsyncICQChannel(s.Ctx, tc.connectionId)
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+
+	// Invalid authority
+	msg := types.NewMsgSetAsyncICQChannel(s.TestAccs[0].String(), tc.connectionId, AsyncICQChannelId)
+	_, err := s.GetMsgServer().SetAsyncICQChannel(sdk.WrapSDKContext(s.Ctx), msg)
+	s.Require().ErrorContains(err, "invalid authority")
+
+	// Channel on the wrong connection
+	msg = types.NewMsgSetAsyncICQChannel(authority, "connection-99", AsyncICQChannelId)
+	_, err = s.GetMsgServer().SetAsyncICQChannel(sdk.WrapSDKContext(s.Ctx), msg)
+	s.Require().ErrorContains(err, "is not on connection")
+
+	// Channel that does not exist
+	msg = types.NewMsgSetAsyncICQChannel(authority, tc.connectionId, "channel-99")
+	_, err = s.GetMsgServer().SetAsyncICQChannel(sdk.WrapSDKContext(s.Ctx), msg)
+	s.Require().ErrorContains(err, "not found")
+
+	// Successful set
+	msg = types.NewMsgSetAsyncICQChannel(authority, tc.connectionId, AsyncICQChannelId)
+	_, err = s.GetMsgServer().SetAsyncICQChannel(sdk.WrapSDKContext(s.Ctx), msg)
+	s.Require().NoError(err, "no error expected when setting channel")
+
+	channelId, found := s.App.InterchainqueryKeeper.GetOpenAsyncICQChannelId(s.Ctx, tc.connectionId)
+	s.Require().True(found, "channel should be found")
+	s.Require().Equal(AsyncICQChannelId, channelId, "channel ID")
+
+	// Removal with an empty channel ID
+	msg = types.NewMsgSetAsyncICQChannel(authority, tc.connectionId, "")
+	_, err = s.GetMsgServer().SetAsyncICQChannel(sdk.WrapSDKContext(s.Ctx), msg)
+	s.Require().NoError(err, "no error expected when removing channel")
+
+	_, found = s.App.InterchainqueryKeeper.GetAsyncICQChannel(s.Ctx, tc.connectionId)
+	s.Require().False(found, "channel should be removed")
+}
@@ -0,0 +1,326 @@
+package keeper
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	"github.com/cometbft/cometbft/crypto"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	clienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
+	connectiontypes "github.com/cosmos/ibc-go/v7/modules/core/03-connection/types"
+
+	"github.com/Stride-Labs/stride/v24/utils"
+	"github.com/Stride-Labs/stride/v24/x/interchainquery/types"
+)
+
+// Generates a batch query ID based on the request information
+// If forceUnique is false, batch queries for the same set of keys will have the same query ID
+// If forceUnique is true, a unique ID will be used for the query
+func (k Keeper) GetBatchQueryId(ctx sdk.Context, query types.BatchQuery, forceUnique bool) string {
+	var queryKey []byte
+	if forceUnique {
+		queryKey = k.GetQueryUID(ctx)
+	} else {
+		queryKey = []byte(query.CallbackModule + query.ConnectionId + query.ChainId + query.QueryType + query.CallbackId)
+		for _, requestKey := range query.RequestKeys {
+			queryKey = append(queryKey, sdk.Uint64ToBigEndian(uint64(len(requestKey)))...)
+			queryKey = append(queryKey, requestKey...)
+		}
+	}
+	return fmt.Sprintf("%x", crypto.Sha256(append([]byte("batch"), queryKey...)))
+}
+
+// ValidateBatchQuery validates that all the required attributes of a batch query are supplied
+// Every key must be unique, and the callback module must have registered a batch callback
+func (k Keeper) ValidateBatchQuery(ctx sdk.Context, query types.BatchQuery) error {
+	if query.ChainId == "" {
+		return errorsmod.Wrapf(types.ErrInvalidICQRequest, "chain-id cannot be empty")
+	}
+	if query.ConnectionId == "" {
+		return errorsmod.Wrapf(types.ErrInvalidICQRequest, "connection-id cannot be empty")
+	}
+	if !strings.HasPrefix(query.ConnectionId, connectiontypes.ConnectionPrefix) {
+		return errorsmod.Wrapf(types.ErrInvalidICQRequest, "invalid connection-id (%s)", query.ConnectionId)
+	}
+	if query.QueryType == "" {
+		return errorsmod.Wrapf(types.ErrInvalidICQRequest, "query type cannot be empty")
+	}
+	if len(query.RequestKeys) == 0 {
+		return errorsmod.Wrapf(types.ErrInvalidBatchQuery, "batch query must include at least one key")
+	}
+	requestKeys := map[string]bool{}
+	for _, requestKey := range query.RequestKeys {
+		if len(requestKey) == 0 {
+			return errorsmod.Wrapf(types.ErrInvalidBatchQuery, "batch query keys cannot be empty")
+		}
+		if requestKeys[string(requestKey)] {
+			return errorsmod.Wrapf(types.ErrInvalidBatchQuery, "duplicate batch query key %x", requestKey)
+		}
+		requestKeys[string(requestKey)] = true
+	}
+	if query.CallbackModule == "" {
+		return errorsmod.Wrapf(types.ErrInvalidICQRequest, "callback module must be specified")
+	}
+	if query.CallbackId == "" {
+		return errorsmod.Wrapf(types.ErrInvalidICQRequest, "callback-id cannot be empty")
+	}
+	if query.TimeoutDuration == time.Duration(0) {
+		return errorsmod.Wrapf(types.ErrInvalidICQRequest, "timeout duration must be set")
+	}
+	batchCallbacks, found := k.getBatchCallbackHandler(query.CallbackModule)
+	if !found {
+		return errorsmod.Wrapf(types.ErrInvalidICQRequest, "no batch callback handler registered for module (%s)", query.CallbackModule)
+	}
+	if !batchCallbacks.HasBatchICQCallback(query.CallbackId) {
+		return errorsmod.Wrapf(types.ErrInvalidICQRequest, "batch callback-id (%s) is not registered for module (%s)",
+			query.CallbackId, query.CallbackModule)
+	}
+
+	return nil
+}
+
+// Submits a query for multiple keys in the same host store, which will be answered
+// at a single remote height and processed by a single callback
+func (k Keeper) SubmitBatchICQRequest(ctx sdk.Context, query types.BatchQuery, forceUnique bool) error {
+	k.Logger(ctx).Info(utils.LogWithHostZone(query.ChainId,
+		"Submitting Batch ICQ Request - module=%s, callbackId=%s, connectionId=%s, queryType=%s, numKeys=%d, timeout_duration=%d",
+		query.CallbackModule, query.CallbackId, query.ConnectionId, query.QueryType, len(query.RequestKeys), query.TimeoutDuration))
+
+	if err := k.ValidateBatchQuery(ctx, query); err != nil {
+		return err
+	}
+
+	// Set the timeout using the block time and timeout duration
+	query.TimeoutTimestamp = uint64(ctx.BlockTime().UnixNano() + query.TimeoutDuration.Nanoseconds())
+
+	// Generate and set the query ID - optionally force it to be unique
+	query.Id = k.GetBatchQueryId(ctx, query, forceUnique)
+	query.RequestSent = false
+
+	// Set the submission height to the latest light client height
+	// In the query response, this will be used to verify that the query wasn't historical
+	connection, found := k.IBCKeeper.ConnectionKeeper.GetConnection(ctx, query.ConnectionId)
+	if !found {
+		return errorsmod.Wrap(connectiontypes.ErrConnectionNotFound, query.ConnectionId)
+	}
+	clientState, found := k.IBCKeeper.ClientKeeper.GetClientState(ctx, connection.ClientId)
+	if !found {
+		return errorsmod.Wrap(clienttypes.ErrClientNotFound, connection.ClientId)
+	}
+	query.SubmissionHeight = clientState.GetLatestHeight().GetRevisionHeight()
+
+	// If the same batch is re-requested, refund the previous batch's relayer fee before
+	// escrowing the fee for the new request
+	if existingQuery, found := k.GetBatchQuery(ctx, query.Id); found {
+		if err := k.RefundBatchQueryFee(ctx, existingQuery); err != nil {
+			return err
+		}
+	}
+	if err := k.EscrowBatchQueryFee(ctx, &query); err != nil {
+		return err
+	}
+
+	// If the same batch is re-requested, it will get replaced in the store with an updated TTL
+	k.SetBatchQuery(ctx, query)
+
+	return nil
+}
+
+// Re-submit a batch ICQ, generally used after a timeout
+func (k Keeper) RetryBatchICQRequest(ctx sdk.Context, query types.BatchQuery) error {
+	k.Logger(ctx).Info(utils.LogWithHostZone(query.ChainId,
+		"Queuing Batch ICQ Retry - Query Type: %s, Query ID: %s", query.CallbackId, query.Id))
+
+	k.DeleteBatchQuery(ctx, query.Id)
+
+	if err := k.SubmitBatchICQRequest(ctx, query, true); err != nil {
+		return errorsmod.Wrap(err, types.ErrFailedToRetryQuery.Error())
+	}
+
+	return nil
+}
+
+// Confirms the response includes exactly one result for each key in the batch, and
+// if the query requires proving, verifies every key's proof against the same consensus state
+// Returns the results indexed by request key
+func (k Keeper) VerifyBatchKeyProofs(
+	ctx sdk.Context,
+	msg *types.MsgSubmitBatchQueryResponse,
+	query types.BatchQuery,
+) (results map[string][]byte, err error) {
+	results = map[string][]byte{}
+	for _, result := range msg.Results {
+		results[string(result.Key)] = result.Value
+	}
+	if len(results) != len(query.RequestKeys) {
+		return nil, errorsmod.Wrapf(types.ErrInvalidBatchQuery,
+			"expected %d results, received %d", len(query.RequestKeys), len(results))
+	}
+	for _, requestKey := range query.RequestKeys {
+		if _, ok := results[string(requestKey)]; !ok {
+			return nil, errorsmod.Wrapf(types.ErrInvalidBatchQuery, "missing result for key %x", requestKey)
+		}
+	}
+
+	// the query does NOT have an associated proof, so no need to verify it.
+	pathParts := strings.Split(query.QueryType, "/")
+	if pathParts[len(pathParts)-1] != "key" {
+		return results, nil
+	}
+
+	// If the query is a "key" proof query, every key must include a proof
+	for _, result := range msg.Results {
+		if result.ProofOps == nil {
+			return nil, errorsmod.Wrapf(types.ErrInvalidICQProof, "Unable to validate proof. No proof submitted for key %x", result.Key)
+		}
+	}
+
+	// Every key is verified against the consensus state at the response height,
+	// guaranteeing the results represent a consistent snapshot of the host's state
+	stateRoot, proofSpecs, err := k.GetProofVerificationState(ctx, query.ConnectionId, query.ChainId, msg.Height, query.SubmissionHeight)
+	if err != nil {
+		return nil, err
+	}
+	for _, result := range msg.Results {
+		if err := VerifyKeyMembership(stateRoot, proofSpecs, pathParts[1], result.Key, result.Value, result.ProofOps); err != nil {
+			return nil, errorsmod.Wrapf(err, "key %x", result.Key)
+		}
+	}
+	k.Logger(ctx).Info(utils.LogICQCallbackWithHostZone(query.ChainId, query.CallbackId,
+		"Batch proofs validated - QueryId %s, NumKeys: %d", query.Id, len(query.RequestKeys)))
+
+	return results, nil
+}
+
+// Handles a batch query timeout based on the timeout policy
+func (k Keeper) HandleBatchQueryTimeout(ctx sdk.Context, results map[string][]byte, query types.BatchQuery) error {
+	k.Logger(ctx).Error(utils.LogICQCallbackWithHostZone(query.ChainId, query.CallbackId,
+		"BATCH QUERY TIMEOUT - QueryId: %s, TTL: %d, BlockTime: %d", query.Id, query.TimeoutTimestamp, ctx.BlockHeader().Time.UnixNano()))
+
+	switch query.TimeoutPolicy {
+	case types.TimeoutPolicy_REJECT_QUERY_RESPONSE:
+		k.Logger(ctx).Info(utils.LogICQCallbackWithHostZone(query.ChainId, query.CallbackId, "Rejecting batch query"))
+		return nil
+
+	case types.TimeoutPolicy_RETRY_QUERY_REQUEST:
+		k.Logger(ctx).Info(utils.LogICQCallbackWithHostZone(query.ChainId, query.CallbackId, "Retrying batch query..."))
+		return k.RetryBatchICQRequest(ctx, query)
+
+	case types.TimeoutPolicy_EXECUTE_QUERY_CALLBACK:
+		k.Logger(ctx).Info(utils.LogICQCallbackWithHostZone(query.ChainId, query.CallbackId, "Executing batch callback..."))
+		return k.InvokeBatchCallback(ctx, results, query)
+
+	default:
+		return fmt.Errorf("Unsupported query timeout policy: %s", query.TimeoutPolicy.String())
+	}
+}
+
+// Processes a verified batch query response by deleting the batch query and
+// invoking the callback (or timeout policy if the query expired)
+func (k Keeper) HandleBatchQueryResponse(
+	ctx sdk.Context,
+	results map[string][]byte,
+	responseHeight int64,
+	query types.BatchQuery,
+) error {
+	// Immediately delete the query so it cannot process again
+	k.DeleteBatchQuery(ctx, query.Id)
+
+	if query.HasTimedOut(ctx.BlockTime()) {
+		k.RecordBatchQueryOutcome(ctx, query, types.QueryOutcome_QUERY_TIMED_OUT, responseHeight, nil)
+		return k.HandleBatchQueryTimeout(ctx, results, query)
+	}
+
+	if err := k.InvokeBatchCallback(ctx, results, query); err != nil {
+		return err
+	}
+	k.RecordBatchQueryOutcome(ctx, query, types.QueryOutcome_QUERY_SUCCEEDED, responseHeight, nil)
+
+	return nil
+}
+
+// Returns the batch callback handler for a module, if the module's callback handler supports batch queries
+func (k Keeper) getBatchCallbackHandler(moduleName string) (types.BatchQueryCallbacks, bool) {
+	moduleCallbackHandler, found := k.callbacks[moduleName]
+	if !found {
+		return nil, false
+	}
+	batchCallbackHandler, ok := moduleCallbackHandler.(types.BatchQueryCallbacks)
+	return batchCallbackHandler, ok
+}
+
+// Calls the batch query's associated callback function with the results for every key
+func (k Keeper) InvokeBatchCallback(ctx sdk.Context, results map[string][]byte, query types.BatchQuery) error {
+	batchCallbackHandler, found := k.getBatchCallbackHandler(query.CallbackModule)
+	if !found || !batchCallbackHandler.HasBatchICQCallback(query.CallbackId) {
+		return types.ErrICQCallbackNotFound
+	}
+
+	if err := batchCallbackHandler.CallBatchICQCallback(ctx, query.CallbackId, results, query); err != nil {
+		k.Logger(ctx).Error(utils.LogICQCallbackWithHostZone(query.ChainId, query.CallbackId,
+			"Error invoking batch ICQ callback, error: %s, %s", err.Error(), query.Description()))
+		return err
+	}
+	return nil
+}
+
+// Builds the event for the off-chain relayer, which includes one request attribute per key
+func (k Keeper) GetBatchQueryRequestEvent(query types.BatchQuery) sdk.Event {
+	event := sdk.NewEvent(
+		types.EventTypeBatchQueryRequest,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		sdk.NewAttribute(types.AttributeKeyQueryId, query.Id),
+		sdk.NewAttribute(types.AttributeKeyChainId, query.ChainId),
+		sdk.NewAttribute(types.AttributeKeyConnectionId, query.ConnectionId),
+		sdk.NewAttribute(types.AttributeKeyType, query.QueryType),
+		sdk.NewAttribute(types.AttributeKeyHeight, "0"),
+	)
+	for _, requestKey := range query.RequestKeys {
+		event = event.AppendAttributes(sdk.NewAttribute(types.AttributeKeyRequest, hex.EncodeToString(requestKey)))
+	}
+	return event
+}
+
+// GetBatchQuery returns a batch query
+func (k Keeper) GetBatchQuery(ctx sdk.Context, id string) (types.BatchQuery, bool) {
+	query := types.BatchQuery{}
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixBatchQuery)
+	bz := store.Get([]byte(id))
+	if len(bz) == 0 {
+		return query, false
+	}
+	k.cdc.MustUnmarshal(bz, &query)
+	return query, true
+}
+
+// SetBatchQuery stores a batch query
+func (k Keeper) SetBatchQuery(ctx sdk.Context, query types.BatchQuery) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixBatchQuery)
+	bz := k.cdc.MustMarshal(&query)
+	store.Set([]byte(query.Id), bz)
+}
+
+// DeleteBatchQuery deletes a batch query
+func (k Keeper) DeleteBatchQuery(ctx sdk.Context, id string) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixBatchQuery)
+	store.Delete([]byte(id))
+}
+
+// AllBatchQueries returns every batch query in the store
+func (k Keeper) AllBatchQueries(ctx sdk.Context) []types.BatchQuery {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixBatchQuery)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	queries := []types.BatchQuery{}
+	for ; iterator.Valid(); iterator.Next() {
+		query := types.BatchQuery{}
+		k.cdc.MustUnmarshal(iterator.Value(), &query)
+		queries = append(queries, query)
+	}
+	return queries
+}
@@ -0,0 +1,302 @@
+package keeper_test
+
+import (
+	"encoding/hex"
+	"errors"
+	"time"
+
+	"github.com/cometbft/cometbft/proto/tendermint/crypto"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Stride-Labs/stride/v24/x/interchainquery/types"
+)
+
+const (
+	BatchTestModule     = "batchtest"
+	BatchTestCallbackId = "batchbalances"
+)
+
+// Mock callback handler that records the results of each batch callback
+type BatchTestCallbacks struct {
+	results     *[]map[string][]byte
+	callbackErr error
+}
+
+func (c BatchTestCallbacks) AddICQCallback(id string, fn interface{}) types.QueryCallbacks { return c }
+func (c BatchTestCallbacks) RegisterICQCallbacks() types.QueryCallbacks                    { return c }
+func (c BatchTestCallbacks) HasICQCallback(id string) bool                                 { return false }
+func (c BatchTestCallbacks) CallICQCallback(ctx sdk.Context, id string, args []byte, query types.Query) error {
+	return nil
+}
+
+func (c BatchTestCallbacks) HasBatchICQCallback(id string) bool { return id == BatchTestCallbackId }
+func (c BatchTestCallbacks) CallBatchICQCallback(ctx sdk.Context, id string, results map[string][]byte, query types.BatchQuery) error {
+	*c.results = append(*c.results, results)
+	return c.callbackErr
+}
+
+type BatchQueryTestCase struct {
+	query           types.BatchQuery
+	validMsg        types.MsgSubmitBatchQueryResponse
+	callbackResults *[]map[string][]byte
+}
+
+func (s *KeeperTestSuite) SetupBatchQuery() BatchQueryTestCase {
+	s.CreateTransferChannel(HostChainId)
+
+	callbackResults := &[]map[string][]byte{}
+	err := s.App.InterchainqueryKeeper.SetCallbackHandler(BatchTestModule, BatchTestCallbacks{results: callbackResults})
+	s.Require().NoError(err, "no error expected when registering the batch callback handler")
+
+	h, err := s.App.StakeibcKeeper.GetLightClientHeight(s.Ctx, s.TransferPath.EndpointA.ConnectionID)
+	s.Require().NoError(err)
+
+	query := types.BatchQuery{
+		ChainId:         HostChainId,
+		ConnectionId:    s.TransferPath.EndpointA.ConnectionID,
+		QueryType:       "store/bank", // intentionally leave off key to skip proof
+		RequestKeys:     [][]byte{[]byte("key-1"), []byte("key-2"), []byte("key-3")},
+		CallbackModule:  BatchTestModule,
+		CallbackId:      BatchTestCallbackId,
+		TimeoutDuration: time.Minute,
+		TimeoutPolicy:   types.TimeoutPolicy_REJECT_QUERY_RESPONSE,
+	}
+	err = s.App.InterchainqueryKeeper.SubmitBatchICQRequest(s.Ctx, query, false)
+	s.Require().NoError(err, "no error expected when submitting batch query")
+
+	queries := s.App.InterchainqueryKeeper.AllBatchQueries(s.Ctx)
+	s.Require().Len(queries, 1, "one batch query should be stored")
+
+	return BatchQueryTestCase{
+		query: queries[0],
+		validMsg: types.MsgSubmitBatchQueryResponse{
+			ChainId: HostChainId,
+			QueryId: queries[0].Id,
+			Results: []types.BatchQueryResult{
+				{Key: []byte("key-1"), Value: []byte("value-1")},
+				{Key: []byte("key-2"), Value: []byte{}},
+				{Key: []byte("key-3"), Value: []byte("value-3")},
+			},
+			Height:      int64(h),
+			FromAddress: s.TestAccs[0].String(),
+		},
+		callbackResults: callbackResults,
+	}
+}
+
+func (s *KeeperTestSuite) TestSubmitBatchICQRequest_Successful() {
+	tc := s.SetupBatchQuery()
+
+	s.Require().NotEmpty(tc.query.Id, "query id")
+	s.Require().False(tc.query.RequestSent, "request sent")
+	s.Require().Equal(uint64(s.Ctx.BlockTime().Add(time.Minute).UnixNano()), tc.query.TimeoutTimestamp, "timeout timestamp")
+	s.Require().NotZero(tc.query.SubmissionHeight, "submission height")
+
+	// Re-submitting the same batch should overwrite the query
+	query := tc.query
+	query.Id = ""
+	err := s.App.InterchainqueryKeeper.SubmitBatchICQRequest(s.Ctx, query, false)
+	s.Require().NoError(err, "no error expected when re-submitting batch query")
+	s.Require().Len(s.App.InterchainqueryKeeper.AllBatchQueries(s.Ctx), 1, "batch query should be overwritten")
+
+	// Forcing a unique ID should store a second query
+	err = s.App.InterchainqueryKeeper.SubmitBatchICQRequest(s.Ctx, query, true)
+	s.Require().NoError(err, "no error expected when submitting unique batch query")
+	s.Require().Len(s.App.InterchainqueryKeeper.AllBatchQueries(s.Ctx), 2, "unique batch query should be added")
+}
+
+func (s *KeeperTestSuite) TestSubmitBatchICQRequest_Invalid() {
+	tc := s.SetupBatchQuery()
+
+	query := tc.query
+	query.RequestKeys = [][]byte{}
+	err := s.App.InterchainqueryKeeper.SubmitBatchICQRequest(s.Ctx, query, false)
+	s.Require().ErrorContains(err, "batch query must include at least one key")
+
+	query = tc.query
+	query.RequestKeys = [][]byte{[]byte("key-1"), []byte("key-1")}
+	err = s.App.InterchainqueryKeeper.SubmitBatchICQRequest(s.Ctx, query, false)
+	s.Require().ErrorContains(err, "duplicate batch query key")
+
+	// The stakedym callback handler does not support batch queries
+	query = tc.query
+	query.CallbackModule = "stakedym"
+	err = s.App.InterchainqueryKeeper.SubmitBatchICQRequest(s.Ctx, query, false)
+	s.Require().ErrorContains(err, "no batch callback handler registered for module (stakedym)")
+
+	query = tc.query
+	query.CallbackId = "fake"
+	err = s.App.InterchainqueryKeeper.SubmitBatchICQRequest(s.Ctx, query, false)
+	s.Require().ErrorContains(err, "batch callback-id (fake) is not registered for module (batchtest)")
+}
+
+func (s *KeeperTestSuite) TestBatchQuery_EndBlockerEmitsRequest() {
+	tc := s.SetupBatchQuery()
+
+	s.App.InterchainqueryKeeper.EndBlocker(s.Ctx)
+
+	query, found := s.App.InterchainqueryKeeper.GetBatchQuery(s.Ctx, tc.query.Id)
+	s.Require().True(found, "batch query should still exist")
+	s.Require().True(query.RequestSent, "request sent")
+
+	pendingResponse, err := s.App.InterchainqueryKeeper.PendingBatchQueries(s.Ctx, &types.QueryPendingBatchQueriesRequest{})
+	s.Require().NoError(err)
+	s.Require().Len(pendingResponse.PendingBatchQueries, 1, "pending batch queries")
+
+	// Confirm a single event was emitted with a request attribute for each key
+	requests := []string{}
+	for _, event := range s.Ctx.EventManager().Events() {
+		if event.Type != types.EventTypeBatchQueryRequest {
+			continue
+		}
+		for _, attribute := range event.Attributes {
+			if attribute.Key == types.AttributeKeyRequest {
+				requests = append(requests, attribute.Value)
+			}
+		}
+	}
+	expectedRequests := []string{}
+	for _, key := range tc.query.RequestKeys {
+		expectedRequests = append(expectedRequests, hex.EncodeToString(key))
+	}
+	s.Require().Equal(expectedRequests, requests, "batch query request attributes")
+
+	// The request should not be re-emitted on the next block
+	s.Ctx = s.Ctx.WithEventManager(sdk.NewEventManager())
+	s.App.InterchainqueryKeeper.EndBlocker(s.Ctx)
+	s.CheckEventTypeNotEmitted(types.EventTypeBatchQueryRequest)
+}
+
+func (s *KeeperTestSuite) TestSubmitBatchQueryResponse_Successful() {
+	tc := s.SetupBatchQuery()
+
+	_, err := s.GetMsgServer().SubmitBatchQueryResponse(s.Ctx, &tc.validMsg)
+	s.Require().NoError(err, "no error expected when submitting batch response")
+
+	// Confirm the callback was invoked once with every result
+	s.Require().Len(*tc.callbackResults, 1, "callback should be invoked once")
+	s.Require().Equal(map[string][]byte{
+		"key-1": []byte("value-1"),
+		"key-2": {},
+		"key-3": []byte("value-3"),
+	}, (*tc.callbackResults)[0], "callback results")
+
+	_, found := s.App.InterchainqueryKeeper.GetBatchQuery(s.Ctx, tc.query.Id)
+	s.Require().False(found, "batch query should be removed")
+	s.CheckEventTypeEmitted(types.EventTypeBatchQueryResponse)
+
+	// A duplicate response should be ignored
+	_, err = s.GetMsgServer().SubmitBatchQueryResponse(s.Ctx, &tc.validMsg)
+	s.Require().NoError(err, "no error expected when submitting duplicate response")
+	s.Require().Len(*tc.callbackResults, 1, "callback should not be invoked again")
+}
+
+func (s *KeeperTestSuite) TestSubmitBatchQueryResponse_MissingKey() {
+	tc := s.SetupBatchQuery()
+
+	msg := tc.validMsg
+	msg.Results = msg.Results[:2]
+	_, err := s.GetMsgServer().SubmitBatchQueryResponse(s.Ctx, &msg)
+	s.Require().ErrorContains(err, "expected 3 results, received 2")
+
+	msg = tc.validMsg
+	msg.Results = []types.BatchQueryResult{msg.Results[0], msg.Results[1], {Key: []byte("key-4")}}
+	_, err = s.GetMsgServer().SubmitBatchQueryResponse(s.Ctx, &msg)
+	s.Require().ErrorContains(err, "missing result for key")
+
+	s.Require().Empty(*tc.callbackResults, "callback should not be invoked")
+}
+
+func (s *KeeperTestSuite) TestSubmitBatchQueryResponse_MissingProof() {
+	tc := s.SetupBatchQuery()
+
+	tc.query.QueryType = types.BANK_STORE_QUERY_WITH_PROOF
+	s.App.InterchainqueryKeeper.SetBatchQuery(s.Ctx, tc.query)
+
+	_, err := s.GetMsgServer().SubmitBatchQueryResponse(s.Ctx, &tc.validMsg)
+	s.Require().ErrorContains(err, "No proof submitted for key")
+}
+
+func (s *KeeperTestSuite) TestSubmitBatchQueryResponse_ProofStale() {
+	tc := s.SetupBatchQuery()
+
+	tc.query.QueryType = types.BANK_STORE_QUERY_WITH_PROOF
+	tc.query.SubmissionHeight = 100
+	s.App.InterchainqueryKeeper.SetBatchQuery(s.Ctx, tc.query)
+
+	tc.validMsg.Height = 16
+	for i := range tc.validMsg.Results {
+		tc.validMsg.Results[i].ProofOps = &crypto.ProofOps{}
+	}
+	_, err := s.GetMsgServer().SubmitBatchQueryResponse(s.Ctx, &tc.validMsg)
+	s.Require().ErrorContains(err, "Query proof height (16) is older than the submission height (100)")
+}
+
+func (s *KeeperTestSuite) TestSubmitBatchQueryResponse_Timeout_RejectQuery() {
+	tc := s.SetupBatchQuery()
+
+	tc.query.TimeoutTimestamp = uint64(s.Ctx.BlockTime().Add(-time.Minute).UnixNano())
+	s.App.InterchainqueryKeeper.SetBatchQuery(s.Ctx, tc.query)
+
+	_, err := s.GetMsgServer().SubmitBatchQueryResponse(s.Ctx, &tc.validMsg)
+	s.Require().NoError(err, "no error expected when rejecting timed out response")
+
+	s.Require().Empty(*tc.callbackResults, "callback should not be invoked")
+	s.Require().Empty(s.App.InterchainqueryKeeper.AllBatchQueries(s.Ctx), "batch query should be removed")
+}
+
+func (s *KeeperTestSuite) TestSubmitBatchQueryResponse_Timeout_RetryQuery() {
+	tc := s.SetupBatchQuery()
+
+	tc.query.TimeoutTimestamp = uint64(s.Ctx.BlockTime().Add(-time.Minute).UnixNano())
+	tc.query.TimeoutPolicy = types.TimeoutPolicy_RETRY_QUERY_REQUEST
+	tc.query.RequestSent = true
+	s.App.InterchainqueryKeeper.SetBatchQuery(s.Ctx, tc.query)
+
+	_, err := s.GetMsgServer().SubmitBatchQueryResponse(s.Ctx, &tc.validMsg)
+	s.Require().NoError(err, "no error expected when retrying timed out response")
+	s.Require().Empty(*tc.callbackResults, "callback should not be invoked")
+
+	// The query should be re-submitted with a new ID and the same keys
+	queries := s.App.InterchainqueryKeeper.AllBatchQueries(s.Ctx)
+	s.Require().Len(queries, 1, "one batch query should be stored")
+	s.Require().NotEqual(tc.query.Id, queries[0].Id, "retried query id")
+	s.Require().Equal(tc.query.RequestKeys, queries[0].RequestKeys, "retried query keys")
+	s.Require().False(queries[0].RequestSent, "retried query request sent")
+}
+
+func (s *KeeperTestSuite) TestSubmitBatchQueryResponse_Timeout_ExecuteCallback() {
+	tc := s.SetupBatchQuery()
+
+	tc.query.TimeoutTimestamp = uint64(s.Ctx.BlockTime().Add(-time.Minute).UnixNano())
+	tc.query.TimeoutPolicy = types.TimeoutPolicy_EXECUTE_QUERY_CALLBACK
+	s.App.InterchainqueryKeeper.SetBatchQuery(s.Ctx, tc.query)
+
+	_, err := s.GetMsgServer().SubmitBatchQueryResponse(s.Ctx, &tc.validMsg)
+	s.Require().NoError(err, "no error expected when executing callback after timeout")
+	s.Require().Len(*tc.callbackResults, 1, "callback should be invoked")
+}
+
+func (s *KeeperTestSuite) TestSubmitBatchQueryResponse_CallbackFailed() {
+	tc := s.SetupBatchQuery()
+
+	// Modules without batch callbacks cannot process the response
+	err := s.App.InterchainqueryKeeper.InvokeBatchCallback(s.Ctx, map[string][]byte{}, types.BatchQuery{
+		CallbackModule: "stakedym",
+		CallbackId:     BatchTestCallbackId,
+	})
+	s.Require().ErrorIs(err, types.ErrICQCallbackNotFound, "stakedym has no batch callbacks")
+
+	// Register a handler whose callback errors
+	failingResults := &[]map[string][]byte{}
+	failingHandler := BatchTestCallbacks{results: failingResults, callbackErr: errors.New("callback failed")}
+	err = s.App.InterchainqueryKeeper.SetCallbackHandler("batchtestfailing", failingHandler)
+	s.Require().NoError(err)
+
+	tc.query.CallbackModule = "batchtestfailing"
+	s.App.InterchainqueryKeeper.SetBatchQuery(s.Ctx, tc.query)
+
+	_, err = s.GetMsgServer().SubmitBatchQueryResponse(s.Ctx, &tc.validMsg)
+	s.Require().ErrorContains(err, "callback failed")
+	s.Require().Len(*failingResults, 1, "failing callback should be invoked")
+}
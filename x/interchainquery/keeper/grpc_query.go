@@ -3,7 +3,11 @@ package keeper
 import (
 	"context"
 
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"github.com/Stride-Labs/stride/v24/x/interchainquery/types"
 )
@@ -23,3 +27,83 @@ func (k Keeper) PendingQueries(c context.Context, req *types.QueryPendingQueries
 
 	return &types.QueryPendingQueriesResponse{PendingQueries: pendingQueries}, nil
 }
+
+// Queries all batch queries that have been requested but have not received a response
+func (k Keeper) PendingBatchQueries(c context.Context, req *types.QueryPendingBatchQueriesRequest) (*types.QueryPendingBatchQueriesResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	pendingBatchQueries := []types.BatchQuery{}
+	for _, query := range k.AllBatchQueries(ctx) {
+		if query.RequestSent {
+			pendingBatchQueries = append(pendingBatchQueries, query)
+		}
+	}
+
+	return &types.QueryPendingBatchQueriesResponse{PendingBatchQueries: pendingBatchQueries}, nil
+}
+
+// Queries the history of completed queries, optionally filtered by callback ID or connection ID
+// Records are returned with the most recent first, unless pagination is specified
+func (k Keeper) QueryHistory(c context.Context, req *types.QueryQueryHistoryRequest) (*types.QueryQueryHistoryResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	pagination := req.Pagination
+	if pagination == nil {
+		pagination = &query.PageRequest{Reverse: true}
+	}
+
+	records := []types.QueryRecord{}
+	recordStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixQueryRecord)
+	pageRes, err := query.FilteredPaginate(recordStore, pagination, func(key []byte, value []byte, accumulate bool) (bool, error) {
+		var record types.QueryRecord
+		if err := k.cdc.Unmarshal(value, &record); err != nil {
+			return false, err
+		}
+
+		if req.CallbackId != "" && record.CallbackId != req.CallbackId {
+			return false, nil
+		}
+		if req.ConnectionId != "" && record.ConnectionId != req.ConnectionId {
+			return false, nil
+		}
+
+		if accumulate {
+			records = append(records, record)
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryQueryHistoryResponse{QueryRecords: records, Pagination: pageRes}, nil
+}
+
+// Queries the module parameters
+func (k Keeper) Params(c context.Context, req *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	return &types.QueryParamsResponse{Params: k.GetParams(ctx)}, nil
+}
+
+// Queries the total fees a relayer has earned from query responses
+func (k Keeper) RelayerEarnings(c context.Context, req *types.QueryRelayerEarningsRequest) (*types.QueryRelayerEarningsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if _, err := sdk.AccAddressFromBech32(req.Address); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid relayer address")
+	}
+
+	return &types.QueryRelayerEarningsResponse{Earnings: k.GetRelayerEarnings(ctx, req.Address)}, nil
+}
+
+// Queries the async-icq channel configured for each connection
+func (k Keeper) AsyncICQChannels(c context.Context, req *types.QueryAsyncICQChannelsRequest) (*types.QueryAsyncICQChannelsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	return &types.QueryAsyncICQChannelsResponse{AsyncIcqChannels: k.GetAllAsyncICQChannels(ctx)}, nil
+}
@@ -9,6 +9,7 @@ import (
 
 	"github.com/cometbft/cometbft/libs/log"
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	capabilitykeeper "github.com/cosmos/cosmos-sdk/x/capability/keeper"
 	clienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
 	connectiontypes "github.com/cosmos/ibc-go/v7/modules/core/03-connection/types"
 	ibckeeper "github.com/cosmos/ibc-go/v7/modules/core/keeper"
@@ -19,22 +20,45 @@ import (
 
 // Keeper of this module maintains collections of registered zones.
 type Keeper struct {
-	cdc       codec.Codec
-	storeKey  storetypes.StoreKey
-	callbacks map[string]types.QueryCallbacks
-	IBCKeeper *ibckeeper.Keeper
+	cdc          codec.Codec
+	storeKey     storetypes.StoreKey
+	callbacks    map[string]types.QueryCallbacks
+	IBCKeeper    *ibckeeper.Keeper
+	scopedKeeper capabilitykeeper.ScopedKeeper
+	bankKeeper   types.BankKeeper
+	authority    string
+
+	// Groups with a non-zero pending queries gauge, used only for telemetry (not consensus state)
+	pendingQueryGroups map[PendingQueryGroup]bool
 }
 
 // NewKeeper returns a new instance of zones Keeper
-func NewKeeper(cdc codec.Codec, storeKey storetypes.StoreKey, ibckeeper *ibckeeper.Keeper) Keeper {
+func NewKeeper(
+	cdc codec.Codec,
+	storeKey storetypes.StoreKey,
+	ibckeeper *ibckeeper.Keeper,
+	scopedKeeper capabilitykeeper.ScopedKeeper,
+	bankKeeper types.BankKeeper,
+	authority string,
+) Keeper {
 	return Keeper{
-		cdc:       cdc,
-		storeKey:  storeKey,
-		callbacks: make(map[string]types.QueryCallbacks),
-		IBCKeeper: ibckeeper,
+		cdc:          cdc,
+		storeKey:     storeKey,
+		callbacks:    make(map[string]types.QueryCallbacks),
+		IBCKeeper:    ibckeeper,
+		scopedKeeper: scopedKeeper,
+		bankKeeper:   bankKeeper,
+		authority:    authority,
+
+		pendingQueryGroups: make(map[PendingQueryGroup]bool),
 	}
 }
 
+// GetAuthority returns the x/interchainquery module's authority.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
 func (k *Keeper) SetCallbackHandler(module string, handler types.QueryCallbacks) error {
 	_, found := k.callbacks[module]
 	if found {
@@ -78,6 +102,17 @@ func (k *Keeper) SubmitICQRequest(ctx sdk.Context, query types.Query, forceUniqu
 	}
 	query.SubmissionHeight = clientState.GetLatestHeight().GetRevisionHeight()
 
+	// If the same query is re-requested, refund the previous query's relayer fee before
+	// escrowing the fee for the new request
+	if existingQuery, found := k.GetQuery(ctx, query.Id); found {
+		if err := k.RefundQueryFee(ctx, existingQuery); err != nil {
+			return err
+		}
+	}
+	if err := k.EscrowQueryFee(ctx, &query); err != nil {
+		return err
+	}
+
 	// Save the query to the store
 	// If the same query is re-requested, it will get replace in the store with an updated TTL
 	//  and the RequestSent bool reset to false
@@ -7,7 +7,9 @@ import (
 	"strings"
 
 	errorsmod "cosmossdk.io/errors"
+	tmcrypto "github.com/cometbft/cometbft/proto/tendermint/crypto"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	clienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
 	commitmenttypes "github.com/cosmos/ibc-go/v7/modules/core/23-commitment/types"
 	"github.com/cosmos/ibc-go/v7/modules/core/exported"
@@ -45,69 +47,104 @@ func (k Keeper) VerifyKeyProof(ctx sdk.Context, msg *types.MsgSubmitQueryRespons
 		return errorsmod.Wrapf(types.ErrInvalidICQProof, "Unable to validate proof. No proof submitted")
 	}
 
-	// Get the client consensus state at the height 1 block above the message height
-	proofHeight, err := cast.ToUint64E(msg.Height)
+	stateRoot, proofSpecs, err := k.GetProofVerificationState(ctx, query.ConnectionId, query.ChainId, msg.Height, query.SubmissionHeight)
 	if err != nil {
 		return err
 	}
-	height := clienttypes.NewHeight(clienttypes.ParseChainID(query.ChainId), proofHeight+1)
+
+	if err := VerifyKeyMembership(stateRoot, proofSpecs, pathParts[1], query.RequestData, msg.Result, msg.ProofOps); err != nil {
+		return err
+	}
+
+	if len(msg.Result) != 0 {
+		k.Logger(ctx).Info(utils.LogICQCallbackWithHostZone(query.ChainId, query.CallbackId, "Inclusion proof validated - QueryId %s", query.Id))
+	} else {
+		k.Logger(ctx).Info(utils.LogICQCallbackWithHostZone(query.ChainId, query.CallbackId, "Non-inclusion proof validated - QueryId %s", query.Id))
+	}
+
+	return nil
+}
+
+// Returns the host's state root and proof specs from the light client on the query's connection,
+// at the block after the proof height (where the app hash of the proof height is committed)
+func (k Keeper) GetProofVerificationState(
+	ctx sdk.Context,
+	connectionId string,
+	chainId string,
+	msgHeight int64,
+	submissionHeight uint64,
+) (stateRoot exported.Root, proofSpecs []*ics23.ProofSpec, err error) {
+	// Get the client consensus state at the height 1 block above the message height
+	proofHeight, err := cast.ToUint64E(msgHeight)
+	if err != nil {
+		return nil, nil, err
+	}
+	height := clienttypes.NewHeight(clienttypes.ParseChainID(chainId), proofHeight+1)
 
 	// Confirm the query proof height occurred after the submission height
-	if proofHeight <= query.SubmissionHeight {
-		return errorsmod.Wrapf(types.ErrInvalidICQProof,
-			"Query proof height (%d) is older than the submission height (%d)", proofHeight, query.SubmissionHeight)
+	if proofHeight <= submissionHeight {
+		return nil, nil, errorsmod.Wrapf(types.ErrInvalidICQProof,
+			"Query proof height (%d) is older than the submission height (%d)", proofHeight, submissionHeight)
 	}
 
 	// Get the client state and consensus state from the connection Id
-	connection, found := k.IBCKeeper.ConnectionKeeper.GetConnection(ctx, query.ConnectionId)
+	connection, found := k.IBCKeeper.ConnectionKeeper.GetConnection(ctx, connectionId)
 	if !found {
-		return errorsmod.Wrapf(types.ErrInvalidICQProof, "ConnectionId %s does not exist", query.ConnectionId)
+		return nil, nil, errorsmod.Wrapf(types.ErrInvalidICQProof, "ConnectionId %s does not exist", connectionId)
 	}
 	consensusState, found := k.IBCKeeper.ClientKeeper.GetClientConsensusState(ctx, connection.ClientId, height)
 	if !found {
-		return errorsmod.Wrapf(types.ErrInvalidICQProof, "Consensus state not found for client %s and height %d", connection.ClientId, height)
+		return nil, nil, errorsmod.Wrapf(types.ErrInvalidICQProof, "Consensus state not found for client %s and height %d", connection.ClientId, height)
 	}
 	clientState, found := k.IBCKeeper.ClientKeeper.GetClientState(ctx, connection.ClientId)
 	if !found {
-		return errorsmod.Wrapf(types.ErrInvalidICQProof, "Unable to fetch client state for client %s", connection.ClientId)
+		return nil, nil, errorsmod.Wrapf(types.ErrInvalidICQProof, "Unable to fetch client state for client %s", connection.ClientId)
 	}
 
 	// Cast the client and consensus state to tendermint type
 	tendermintConsensusState, ok := consensusState.(*tendermint.ConsensusState)
 	if !ok {
-		return errorsmod.Wrapf(types.ErrInvalidICQProof,
+		return nil, nil, errorsmod.Wrapf(types.ErrInvalidICQProof,
 			"Only tendermint consensus state is supported (%s provided)", consensusState.ClientType())
 	}
 	tendermintClientState, ok := clientState.(*tendermint.ClientState)
 	if !ok {
-		return errorsmod.Wrapf(types.ErrInvalidICQProof,
+		return nil, nil, errorsmod.Wrapf(types.ErrInvalidICQProof,
 			"Only tendermint client state is supported (%s provided)", clientState.ClientType())
 	}
-	var stateRoot exported.Root = tendermintConsensusState.Root
-	var clientStateProof []*ics23.ProofSpec = tendermintClientState.ProofSpecs
 
+	return tendermintConsensusState.Root, tendermintClientState.ProofSpecs, nil
+}
+
+// Verifies the inclusion proof of a key in the given host store,
+// or the non-inclusion proof if the value is empty
+func VerifyKeyMembership(
+	stateRoot exported.Root,
+	proofSpecs []*ics23.ProofSpec,
+	storeName string,
+	key []byte,
+	value []byte,
+	proofOps *tmcrypto.ProofOps,
+) error {
 	// Get the merkle path and merkle proof
-	path := commitmenttypes.NewMerklePath([]string{pathParts[1], string(query.RequestData)}...)
-	merkleProof, err := commitmenttypes.ConvertProofs(msg.ProofOps)
+	path := commitmenttypes.NewMerklePath([]string{storeName, string(key)}...)
+	merkleProof, err := commitmenttypes.ConvertProofs(proofOps)
 	if err != nil {
 		return errorsmod.Wrapf(types.ErrInvalidICQProof, "Error converting proofs: %s", err.Error())
 	}
 
 	// If we got a non-nil response, verify inclusion proof
-	if len(msg.Result) != 0 {
-		if err := merkleProof.VerifyMembership(clientStateProof, stateRoot, path, msg.Result); err != nil {
+	if len(value) != 0 {
+		if err := merkleProof.VerifyMembership(proofSpecs, stateRoot, path, value); err != nil {
 			return errorsmod.Wrapf(types.ErrInvalidICQProof, "Unable to verify membership proof: %s", err.Error())
 		}
-		k.Logger(ctx).Info(utils.LogICQCallbackWithHostZone(query.ChainId, query.CallbackId, "Inclusion proof validated - QueryId %s", query.Id))
-
-	} else {
-		// if we got a nil query response, verify non inclusion proof.
-		if err := merkleProof.VerifyNonMembership(clientStateProof, stateRoot, path); err != nil {
-			return errorsmod.Wrapf(types.ErrInvalidICQProof, "Unable to verify non-membership proof: %s", err.Error())
-		}
-		k.Logger(ctx).Info(utils.LogICQCallbackWithHostZone(query.ChainId, query.CallbackId, "Non-inclusion proof validated - QueryId %s", query.Id))
+		return nil
 	}
 
+	// if we got a nil query response, verify non inclusion proof.
+	if err := merkleProof.VerifyNonMembership(proofSpecs, stateRoot, path); err != nil {
+		return errorsmod.Wrapf(types.ErrInvalidICQProof, "Unable to verify non-membership proof: %s", err.Error())
+	}
 	return nil
 }
 
@@ -115,6 +152,7 @@ func (k Keeper) VerifyKeyProof(ctx sdk.Context, msg *types.MsgSubmitQueryRespons
 func (k Keeper) HandleQueryTimeout(ctx sdk.Context, msg *types.MsgSubmitQueryResponse, query types.Query) error {
 	k.Logger(ctx).Error(utils.LogICQCallbackWithHostZone(query.ChainId, query.CallbackId,
 		"QUERY TIMEOUT - QueryId: %s, TTL: %d, BlockTime: %d", query.Id, query.TimeoutTimestamp, ctx.BlockHeader().Time.UnixNano()))
+	k.RecordQueryOutcome(ctx, query, types.QueryOutcome_QUERY_TIMED_OUT, msg.Height, nil)
 
 	switch query.TimeoutPolicy {
 	case types.TimeoutPolicy_REJECT_QUERY_RESPONSE:
@@ -134,6 +172,41 @@ func (k Keeper) HandleQueryTimeout(ctx sdk.Context, msg *types.MsgSubmitQueryRes
 	}
 }
 
+// Processes a verified query response, either from a relayer or an async-icq acknowledgement,
+// by deleting the query and invoking the callback (or timeout policy if the query expired)
+func (k Keeper) HandleQueryResponse(ctx sdk.Context, msg *types.MsgSubmitQueryResponse, query types.Query) error {
+	// Immediately delete the query so it cannot process again
+	k.DeleteQuery(ctx, query.Id)
+
+	// If the query is contentless, end (unless the query opted into a callback on empty results,
+	// in which case the non-membership proof has already been verified)
+	emptyResult := len(msg.Result) == 0
+	if emptyResult && !query.CallbackOnEmptyResult {
+		k.Logger(ctx).Info(utils.LogICQCallbackWithHostZone(query.ChainId, query.CallbackId,
+			"Query response is contentless - QueryId: %s", query.Id))
+		k.RecordQueryOutcome(ctx, query, types.QueryOutcome_QUERY_NO_RESULT, msg.Height, nil)
+		return nil
+	}
+
+	// Check if the query has expired (if the block time is greater than the TTL timestamp, the query has expired)
+	if query.HasTimedOut(ctx.BlockTime()) {
+		return k.HandleQueryTimeout(ctx, msg, query)
+	}
+
+	// Invoke the query callback (if the query has not timed out)
+	if err := k.InvokeCallback(ctx, msg, query); err != nil {
+		return err
+	}
+
+	outcome := types.QueryOutcome_QUERY_SUCCEEDED
+	if emptyResult {
+		outcome = types.QueryOutcome_QUERY_NO_RESULT
+	}
+	k.RecordQueryOutcome(ctx, query, outcome, msg.Height, nil)
+
+	return nil
+}
+
 // call the query's associated callback function
 func (k Keeper) InvokeCallback(ctx sdk.Context, msg *types.MsgSubmitQueryResponse, query types.Query) error {
 	// get all the callback handlers and sort them for determinism
@@ -198,28 +271,97 @@ func (k msgServer) SubmitQueryResponse(goCtx context.Context, msg *types.MsgSubm
 		return nil, err
 	}
 
-	// Immediately delete the query so it cannot process again
-	k.DeleteQuery(ctx, query.Id)
+	// Pay the relayer fee (if the response was proven in time) or return it to the fee pool
+	if err := k.SettleQueryFee(ctx, msg, query); err != nil {
+		return nil, err
+	}
 
-	// If the query is contentless, end
-	if len(msg.Result) == 0 {
-		k.Logger(ctx).Info(utils.LogICQCallbackWithHostZone(query.ChainId, query.CallbackId,
-			"Query response is contentless - QueryId: %s", query.Id))
-		return &types.MsgSubmitQueryResponseResponse{}, nil
+	// Since a failed response reverts the tx, the failure is only tracked in telemetry
+	if err := k.HandleQueryResponse(ctx, msg, query); err != nil {
+		EmitQueryOutcomeTelemetry(query.Record(types.QueryOutcome_QUERY_CALLBACK_FAILED))
+		return nil, err
 	}
 
-	// Check if the query has expired (if the block time is greater than the TTL timestamp, the query has expired)
-	if query.HasTimedOut(ctx.BlockTime()) {
-		if err := k.HandleQueryTimeout(ctx, msg, query); err != nil {
-			return nil, err
-		}
-		return &types.MsgSubmitQueryResponseResponse{}, nil
+	return &types.MsgSubmitQueryResponseResponse{}, nil
+}
+
+// Handle batch ICQ responses by validating every key's proof at the response height,
+// and calling the batch query's callback with the results
+func (k msgServer) SubmitBatchQueryResponse(goCtx context.Context, msg *types.MsgSubmitBatchQueryResponse) (*types.MsgSubmitBatchQueryResponseResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Ignore responses without an associated query (e.g. duplicate responses) so that
+	// one 'bad' message doesn't cause the whole relayer tx to fail
+	query, found := k.GetBatchQuery(ctx, msg.QueryId)
+	if !found {
+		k.Logger(ctx).Info("BATCH ICQ RESPONSE | Ignoring non-existent batch query response")
+		return &types.MsgSubmitBatchQueryResponseResponse{}, nil
 	}
 
-	// Invoke the query callback (if the query has not timed out)
-	if err := k.InvokeCallback(ctx, msg, query); err != nil {
+	defer ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeBatchQueryResponse,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(types.AttributeKeyQueryId, query.Id),
+			sdk.NewAttribute(types.AttributeKeyChainId, query.ChainId),
+		),
+	)
+
+	results, err := k.VerifyBatchKeyProofs(ctx, msg, query)
+	if err != nil {
+		k.Logger(ctx).Error(utils.LogICQCallbackWithHostZone(query.ChainId, query.CallbackId,
+			"BATCH QUERY PROOF VERIFICATION FAILED - QueryId: %s, Error: %s", query.Id, err.Error()))
 		return nil, err
 	}
 
-	return &types.MsgSubmitQueryResponseResponse{}, nil
+	// Pay the relayer fee (if the response was proven in time) or return it to the fee pool
+	if err := k.SettleBatchQueryFee(ctx, msg, query); err != nil {
+		return nil, err
+	}
+
+	// Since a failed response reverts the tx, the failure is only tracked in telemetry
+	if err := k.HandleBatchQueryResponse(ctx, results, msg.Height, query); err != nil {
+		EmitQueryOutcomeTelemetry(query.Record(types.QueryOutcome_QUERY_CALLBACK_FAILED))
+		return nil, err
+	}
+
+	return &types.MsgSubmitBatchQueryResponseResponse{}, nil
+}
+
+// Governance-only message to update the module parameters
+func (k msgServer) UpdateParams(goCtx context.Context, msg *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if k.authority != msg.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority, msg.Authority)
+	}
+
+	k.SetParams(ctx, msg.Params)
+
+	return &types.MsgUpdateParamsResponse{}, nil
+}
+
+// Governance-only message to route queries on a connection over an ICS-31 async-icq channel
+// If the channel ID is empty, the connection falls back to the off-chain ICQ relayer
+func (k msgServer) SetAsyncICQChannel(goCtx context.Context, msg *types.MsgSetAsyncICQChannel) (*types.MsgSetAsyncICQChannelResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if k.authority != msg.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority, msg.Authority)
+	}
+
+	if msg.ChannelId == "" {
+		k.RemoveAsyncICQChannel(ctx, msg.ConnectionId)
+		return &types.MsgSetAsyncICQChannelResponse{}, nil
+	}
+
+	if err := k.ValidateAsyncICQChannel(ctx, msg.ConnectionId, msg.ChannelId); err != nil {
+		return nil, err
+	}
+	k.Keeper.SetAsyncICQChannel(ctx, types.AsyncICQChannel{
+		ConnectionId: msg.ConnectionId,
+		ChannelId:    msg.ChannelId,
+	})
+
+	return &types.MsgSetAsyncICQChannelResponse{}, nil
 }
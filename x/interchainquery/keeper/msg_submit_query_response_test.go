@@ -194,6 +194,36 @@ func (s *KeeperTestSuite) TestMsgSubmitQueryResponse_FindAndInvokeCallback() {
 	s.Require().ErrorContains(err, "unable to determine balance from query response")
 }
 
+func (s *KeeperTestSuite) TestMsgSubmitQueryResponse_EmptyResult() {
+	tc := s.SetupMsgSubmitQueryResponse()
+
+	// Remove the host zone so that the callback would fail if it was invoked
+	s.App.StakeibcKeeper.RemoveHostZone(s.Ctx, HostChainId)
+	s.App.InterchainqueryKeeper.SetQuery(s.Ctx, tc.query)
+
+	// Submit an empty response, the callback should not be invoked and the query should be removed
+	tc.validMsg.Result = []byte{}
+	_, err := s.GetMsgServer().SubmitQueryResponse(tc.goCtx, &tc.validMsg)
+	s.Require().NoError(err)
+
+	_, found := s.App.InterchainqueryKeeper.GetQuery(s.Ctx, tc.query.Id)
+	s.Require().False(found, "query should be removed")
+}
+
+func (s *KeeperTestSuite) TestMsgSubmitQueryResponse_EmptyResult_CallbackOnEmptyResult() {
+	tc := s.SetupMsgSubmitQueryResponse()
+
+	// Remove the host zone so that we can confirm the callback was invoked from the error
+	s.App.StakeibcKeeper.RemoveHostZone(s.Ctx, HostChainId)
+	tc.query.CallbackOnEmptyResult = true
+	s.App.InterchainqueryKeeper.SetQuery(s.Ctx, tc.query)
+
+	// Submit an empty response, since the query opted in, the callback should be invoked
+	tc.validMsg.Result = []byte{}
+	_, err := s.GetMsgServer().SubmitQueryResponse(tc.goCtx, &tc.validMsg)
+	s.Require().ErrorContains(err, "no registered zone for queried chain ID")
+}
+
 // To write this test, we need to write data to Gaia, then get the proof for that data and check it using the LC
 // As a first pass, to verify proof checking, we will use an example from Stride integration testing
 //     //   ...down the line, we may want to write tests here that verify the merkle check using proofs from tendermint's proof_test library, https://github.com/cometbft/cometbft/blob/75d51e18f740c7cbfb7d8b4d49182ee6c7f41982/crypto/merkle/proof_test.go
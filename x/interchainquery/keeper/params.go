@@ -0,0 +1,27 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Stride-Labs/stride/v24/x/interchainquery/types"
+)
+
+// Writes params to the store
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
+	store := ctx.KVStore(k.storeKey)
+	paramsBz := k.cdc.MustMarshal(&params)
+	store.Set(types.KeyParams, paramsBz)
+}
+
+// Retrieves the module parameters
+// The default params are returned if they have not been set yet (e.g. on chains
+// that were running before params were introduced)
+func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
+	store := ctx.KVStore(k.storeKey)
+	paramsBz := store.Get(types.KeyParams)
+	if len(paramsBz) == 0 {
+		return types.DefaultParams()
+	}
+	k.cdc.MustUnmarshal(paramsBz, &params)
+	return params
+}
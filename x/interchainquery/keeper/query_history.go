@@ -0,0 +1,206 @@
+package keeper
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/armon/go-metrics"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Stride-Labs/stride/v24/utils"
+	"github.com/Stride-Labs/stride/v24/x/interchainquery/types"
+)
+
+// Records the outcome of a query that was removed from the store
+// The response height should be zero if the query was never answered
+func (k Keeper) RecordQueryOutcome(
+	ctx sdk.Context,
+	query types.Query,
+	outcome types.QueryOutcome,
+	responseHeight int64,
+	callbackErr error,
+) {
+	k.recordQueryOutcome(ctx, query.Record(outcome), query.SubmissionTime(), responseHeight, callbackErr)
+}
+
+// Records the outcome of a batch query that was removed from the store
+// The response height should be zero if the query was never answered
+func (k Keeper) RecordBatchQueryOutcome(
+	ctx sdk.Context,
+	query types.BatchQuery,
+	outcome types.QueryOutcome,
+	responseHeight int64,
+	callbackErr error,
+) {
+	k.recordQueryOutcome(ctx, query.Record(outcome), query.SubmissionTime(), responseHeight, callbackErr)
+}
+
+// Stores the query record in the bounded history and emits the outcome telemetry
+func (k Keeper) recordQueryOutcome(
+	ctx sdk.Context,
+	record types.QueryRecord,
+	submissionTime time.Time,
+	responseHeight int64,
+	callbackErr error,
+) {
+	record.ResponseHeight = responseHeight
+	record.CompletionBlockHeight = ctx.BlockHeight()
+	if latency := ctx.BlockTime().Sub(submissionTime); latency > 0 {
+		record.Latency = latency
+	}
+	if callbackErr != nil {
+		record.Error = callbackErr.Error()
+	}
+
+	k.AddQueryRecord(ctx, record)
+	EmitQueryOutcomeTelemetry(record)
+}
+
+// Emits a counter for the query's outcome, and if the query was answered,
+// a gauge with the query's latency, both labeled by callback ID and connection
+func EmitQueryOutcomeTelemetry(record types.QueryRecord) {
+	labels := []metrics.Label{
+		telemetry.NewLabel("callback_id", record.CallbackId),
+		telemetry.NewLabel("connection_id", record.ConnectionId),
+	}
+
+	telemetry.IncrCounterWithLabels(
+		[]string{types.ModuleName, "query_outcome"},
+		1,
+		append(labels, telemetry.NewLabel("outcome", record.Outcome.String())),
+	)
+
+	if record.ResponseHeight != 0 {
+		telemetry.SetGaugeWithLabels(
+			[]string{types.ModuleName, "query_latency_seconds"},
+			float32(record.Latency.Seconds()),
+			labels,
+		)
+	}
+}
+
+// Groups pending queries for telemetry
+type PendingQueryGroup struct {
+	CallbackId   string
+	ConnectionId string
+}
+
+// Emits a gauge with the number of pending queries for each callback ID and connection
+// The counts are tallied by the EndBlocker as it iterates the queries
+// Groups that were previously emitted but no longer have pending queries are reset to 0,
+// otherwise the gauge would hold its last value indefinitely
+// Returns the value emitted for each group
+func (k Keeper) EmitPendingQueryTelemetry(pendingQueries map[PendingQueryGroup]int) map[PendingQueryGroup]int {
+	for group := range k.pendingQueryGroups {
+		if _, ok := pendingQueries[group]; !ok {
+			pendingQueries[group] = 0
+		}
+	}
+
+	for group, count := range pendingQueries {
+		telemetry.SetGaugeWithLabels(
+			[]string{types.ModuleName, "pending_queries"},
+			float32(count),
+			[]metrics.Label{
+				telemetry.NewLabel("callback_id", group.CallbackId),
+				telemetry.NewLabel("connection_id", group.ConnectionId),
+			},
+		)
+
+		// Once a group has been reset to 0, it no longer needs to be emitted
+		if count == 0 {
+			delete(k.pendingQueryGroups, group)
+		} else {
+			k.pendingQueryGroups[group] = true
+		}
+	}
+
+	return pendingQueries
+}
+
+// Adds a query record to the history, pruning the oldest record once
+// the history exceeds the max size
+func (k Keeper) AddQueryRecord(ctx sdk.Context, record types.QueryRecord) {
+	store := ctx.KVStore(k.storeKey)
+
+	sequence := uint64(0)
+	if sequenceBz := store.Get(types.KeyQueryRecordSequence); len(sequenceBz) != 0 {
+		sequence = sdk.BigEndianToUint64(sequenceBz)
+	}
+	store.Set(types.KeyQueryRecordSequence, sdk.Uint64ToBigEndian(sequence+1))
+
+	recordStore := prefix.NewStore(store, types.KeyPrefixQueryRecord)
+	recordStore.Set(sdk.Uint64ToBigEndian(sequence), k.cdc.MustMarshal(&record))
+
+	if sequence >= types.MaxQueryHistory {
+		recordStore.Delete(sdk.Uint64ToBigEndian(sequence - types.MaxQueryHistory))
+	}
+}
+
+// Returns all query records in the history, most recent first
+func (k Keeper) AllQueryRecords(ctx sdk.Context) []types.QueryRecord {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixQueryRecord)
+	iterator := store.ReverseIterator(nil, nil)
+	defer iterator.Close()
+
+	records := []types.QueryRecord{}
+	for ; iterator.Valid(); iterator.Next() {
+		record := types.QueryRecord{}
+		k.cdc.MustUnmarshal(iterator.Value(), &record)
+		records = append(records, record)
+	}
+	return records
+}
+
+// Removes queries that were sent long ago but were never answered, which would otherwise
+// accumulate in the store indefinitely (e.g. if the relayer was down)
+// Queries with a retry timeout policy are re-submitted instead
+func (k Keeper) PruneStaleQueries(ctx sdk.Context) {
+	expirationCutoff := ctx.BlockTime().Add(-types.StaleQueryExpiration)
+
+	for _, query := range k.AllQueries(ctx) {
+		if !query.RequestSent || !query.HasTimedOut(expirationCutoff) {
+			continue
+		}
+
+		k.Logger(ctx).Info(utils.LogICQCallbackWithHostZone(query.ChainId, query.CallbackId,
+			"Removing stale query - QueryId: %s, TTL: %d", query.Id, query.TimeoutTimestamp))
+
+		k.DeleteQuery(ctx, query.Id)
+		k.refundQueryFeeOrLog(ctx, query)
+		k.RecordQueryOutcome(ctx, query, types.QueryOutcome_QUERY_EXPIRED, 0, nil)
+
+		if query.TimeoutPolicy == types.TimeoutPolicy_RETRY_QUERY_REQUEST {
+			err := utils.ApplyFuncIfNoError(ctx, func(ctx sdk.Context) error {
+				return k.RetryICQRequest(ctx, query)
+			})
+			if err != nil {
+				k.Logger(ctx).Error(fmt.Sprintf("Failed to retry stale query %s: %s", query.Id, err.Error()))
+			}
+		}
+	}
+
+	for _, query := range k.AllBatchQueries(ctx) {
+		if !query.RequestSent || !query.HasTimedOut(expirationCutoff) {
+			continue
+		}
+
+		k.Logger(ctx).Info(utils.LogICQCallbackWithHostZone(query.ChainId, query.CallbackId,
+			"Removing stale batch query - QueryId: %s, TTL: %d", query.Id, query.TimeoutTimestamp))
+
+		k.DeleteBatchQuery(ctx, query.Id)
+		k.refundBatchQueryFeeOrLog(ctx, query)
+		k.RecordBatchQueryOutcome(ctx, query, types.QueryOutcome_QUERY_EXPIRED, 0, nil)
+
+		if query.TimeoutPolicy == types.TimeoutPolicy_RETRY_QUERY_REQUEST {
+			err := utils.ApplyFuncIfNoError(ctx, func(ctx sdk.Context) error {
+				return k.RetryBatchICQRequest(ctx, query)
+			})
+			if err != nil {
+				k.Logger(ctx).Error(fmt.Sprintf("Failed to retry stale batch query %s: %s", query.Id, err.Error()))
+			}
+		}
+	}
+}
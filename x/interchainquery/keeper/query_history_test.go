@@ -0,0 +1,237 @@
+package keeper_test
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/Stride-Labs/stride/v24/x/interchainquery/keeper"
+	"github.com/Stride-Labs/stride/v24/x/interchainquery/types"
+)
+
+func (s *KeeperTestSuite) TestAddQueryRecord_PrunesOldest() {
+	numRecords := types.MaxQueryHistory + 5
+	for i := 0; i < numRecords; i++ {
+		s.App.InterchainqueryKeeper.AddQueryRecord(s.Ctx, types.QueryRecord{QueryId: fmt.Sprintf("query-%d", i)})
+	}
+
+	records := s.App.InterchainqueryKeeper.AllQueryRecords(s.Ctx)
+	s.Require().Len(records, types.MaxQueryHistory, "number of records")
+	s.Require().Equal(fmt.Sprintf("query-%d", numRecords-1), records[0].QueryId, "most recent record")
+	s.Require().Equal("query-5", records[len(records)-1].QueryId, "oldest record")
+}
+
+func (s *KeeperTestSuite) TestRecordQueryOutcome_ContentlessResponse() {
+	tc := s.SetupMsgSubmitQueryResponse()
+
+	// Submit the query 10 seconds before the current block
+	tc.query.TimeoutTimestamp = uint64(s.Ctx.BlockTime().Add(-10 * time.Second).Add(tc.query.TimeoutDuration).UnixNano())
+	tc.query.SubmissionHeight = 5
+	s.App.InterchainqueryKeeper.SetQuery(s.Ctx, tc.query)
+
+	tc.validMsg.Result = []byte{}
+	_, err := s.GetMsgServer().SubmitQueryResponse(tc.goCtx, &tc.validMsg)
+	s.Require().NoError(err)
+
+	records := s.App.InterchainqueryKeeper.AllQueryRecords(s.Ctx)
+	s.Require().Len(records, 1, "number of records")
+	s.Require().Equal(types.QueryRecord{
+		QueryId:               tc.query.Id,
+		ConnectionId:          tc.query.ConnectionId,
+		ChainId:               tc.query.ChainId,
+		QueryType:             tc.query.QueryType,
+		CallbackModule:        tc.query.CallbackModule,
+		CallbackId:            tc.query.CallbackId,
+		TimeoutPolicy:         tc.query.TimeoutPolicy,
+		Outcome:               types.QueryOutcome_QUERY_NO_RESULT,
+		SubmissionHeight:      5,
+		ResponseHeight:        tc.validMsg.Height,
+		CompletionBlockHeight: s.Ctx.BlockHeight(),
+		Latency:               10 * time.Second,
+	}, records[0], "query record")
+}
+
+func (s *KeeperTestSuite) TestRecordQueryOutcome_Timeout() {
+	tc := s.SetupMsgSubmitQueryResponse()
+
+	tc.query.TimeoutTimestamp = uint64(1)
+	tc.query.TimeoutPolicy = types.TimeoutPolicy_RETRY_QUERY_REQUEST
+	s.App.InterchainqueryKeeper.SetQuery(s.Ctx, tc.query)
+
+	_, err := s.GetMsgServer().SubmitQueryResponse(tc.goCtx, &tc.validMsg)
+	s.Require().NoError(err)
+
+	records := s.App.InterchainqueryKeeper.AllQueryRecords(s.Ctx)
+	s.Require().Len(records, 1, "number of records")
+	s.Require().Equal(types.QueryOutcome_QUERY_TIMED_OUT, records[0].Outcome, "outcome")
+	s.Require().Equal(types.TimeoutPolicy_RETRY_QUERY_REQUEST, records[0].TimeoutPolicy, "timeout policy")
+}
+
+func (s *KeeperTestSuite) TestRecordQueryOutcome_FailedResponseNotRecorded() {
+	tc := s.SetupMsgSubmitQueryResponse()
+	s.App.InterchainqueryKeeper.SetQuery(s.Ctx, tc.query)
+
+	// The withdrawal balance callback will fail to parse the result
+	_, err := s.GetMsgServer().SubmitQueryResponse(tc.goCtx, &tc.validMsg)
+	s.Require().ErrorContains(err, "unable to determine balance from query response")
+
+	s.Require().Empty(s.App.InterchainqueryKeeper.AllQueryRecords(s.Ctx), "no records")
+}
+
+func (s *KeeperTestSuite) TestRecordQueryOutcome_BatchSuccess() {
+	tc := s.SetupBatchQuery()
+
+	_, err := s.GetMsgServer().SubmitBatchQueryResponse(s.Ctx, &tc.validMsg)
+	s.Require().NoError(err)
+
+	records := s.App.InterchainqueryKeeper.AllQueryRecords(s.Ctx)
+	s.Require().Len(records, 1, "number of records")
+	s.Require().Equal(types.QueryOutcome_QUERY_SUCCEEDED, records[0].Outcome, "outcome")
+	s.Require().True(records[0].Batch, "batch")
+	s.Require().Equal(tc.validMsg.Height, records[0].ResponseHeight, "response height")
+}
+
+func (s *KeeperTestSuite) TestPruneStaleQueries() {
+	tc := s.SetupMsgSubmitQueryResponse()
+	blockTime := s.Ctx.BlockTime()
+
+	staleTimeout := uint64(blockTime.Add(-types.StaleQueryExpiration).Add(-time.Second).UnixNano())
+	recentTimeout := uint64(blockTime.Add(-types.StaleQueryExpiration).Add(time.Second).UnixNano())
+
+	// Stale query that was sent - should be removed
+	staleQuery := tc.query
+	staleQuery.Id = "stale"
+	staleQuery.RequestSent = true
+	staleQuery.TimeoutTimestamp = staleTimeout
+	s.App.InterchainqueryKeeper.SetQuery(s.Ctx, staleQuery)
+
+	// Stale query that was never sent - should be kept
+	unsentQuery := staleQuery
+	unsentQuery.Id = "unsent"
+	unsentQuery.RequestSent = false
+	s.App.InterchainqueryKeeper.SetQuery(s.Ctx, unsentQuery)
+
+	// Query that timed out recently - should be kept
+	recentQuery := staleQuery
+	recentQuery.Id = "recent"
+	recentQuery.TimeoutTimestamp = recentTimeout
+	s.App.InterchainqueryKeeper.SetQuery(s.Ctx, recentQuery)
+
+	// Stale query with a retry policy - should be re-submitted
+	retryQuery := staleQuery
+	retryQuery.Id = "retry"
+	retryQuery.TimeoutPolicy = types.TimeoutPolicy_RETRY_QUERY_REQUEST
+	s.App.InterchainqueryKeeper.SetQuery(s.Ctx, retryQuery)
+
+	s.App.InterchainqueryKeeper.PruneStaleQueries(s.Ctx)
+
+	remainingQueries := map[string]types.Query{}
+	for _, query := range s.App.InterchainqueryKeeper.AllQueries(s.Ctx) {
+		remainingQueries[query.Id] = query
+	}
+	s.Require().Len(remainingQueries, 3, "number of remaining queries")
+	s.Require().Contains(remainingQueries, "unsent", "unsent query")
+	s.Require().Contains(remainingQueries, "recent", "recent query")
+	s.Require().NotContains(remainingQueries, "stale", "stale query")
+	s.Require().NotContains(remainingQueries, "retry", "original retry query")
+
+	for id, query := range remainingQueries {
+		if id != "unsent" && id != "recent" {
+			s.Require().False(query.RequestSent, "retried query should not be sent")
+			s.Require().Equal(types.TimeoutPolicy_RETRY_QUERY_REQUEST, query.TimeoutPolicy, "retried query policy")
+		}
+	}
+
+	records := s.App.InterchainqueryKeeper.AllQueryRecords(s.Ctx)
+	s.Require().Len(records, 2, "number of records")
+	for _, record := range records {
+		s.Require().Equal(types.QueryOutcome_QUERY_EXPIRED, record.Outcome, "outcome")
+		s.Require().Zero(record.ResponseHeight, "response height")
+	}
+}
+
+func (s *KeeperTestSuite) TestPruneStaleBatchQueries() {
+	tc := s.SetupBatchQuery()
+
+	tc.query.RequestSent = true
+	tc.query.TimeoutTimestamp = uint64(s.Ctx.BlockTime().Add(-types.StaleQueryExpiration).Add(-time.Second).UnixNano())
+	s.App.InterchainqueryKeeper.SetBatchQuery(s.Ctx, tc.query)
+
+	s.App.InterchainqueryKeeper.EndBlocker(s.Ctx)
+
+	s.Require().Empty(s.App.InterchainqueryKeeper.AllBatchQueries(s.Ctx), "stale batch query should be removed")
+	records := s.App.InterchainqueryKeeper.AllQueryRecords(s.Ctx)
+	s.Require().Len(records, 1, "number of records")
+	s.Require().Equal(types.QueryOutcome_QUERY_EXPIRED, records[0].Outcome, "outcome")
+	s.Require().True(records[0].Batch, "batch")
+}
+
+func (s *KeeperTestSuite) TestEmitPendingQueryTelemetry() {
+	groupA := keeper.PendingQueryGroup{CallbackId: "callback-A", ConnectionId: "connection-0"}
+	groupB := keeper.PendingQueryGroup{CallbackId: "callback-B", ConnectionId: "connection-1"}
+
+	// Both groups have pending queries
+	emitted := s.App.InterchainqueryKeeper.EmitPendingQueryTelemetry(map[keeper.PendingQueryGroup]int{groupA: 2, groupB: 1})
+	s.Require().Equal(map[keeper.PendingQueryGroup]int{groupA: 2, groupB: 1}, emitted, "first emission")
+
+	// Group B no longer has queries, it should be reset to 0
+	emitted = s.App.InterchainqueryKeeper.EmitPendingQueryTelemetry(map[keeper.PendingQueryGroup]int{groupA: 1})
+	s.Require().Equal(map[keeper.PendingQueryGroup]int{groupA: 1, groupB: 0}, emitted, "second emission")
+
+	// Once reset, group B should no longer be emitted
+	emitted = s.App.InterchainqueryKeeper.EmitPendingQueryTelemetry(map[keeper.PendingQueryGroup]int{})
+	s.Require().Equal(map[keeper.PendingQueryGroup]int{groupA: 0}, emitted, "third emission")
+
+	emitted = s.App.InterchainqueryKeeper.EmitPendingQueryTelemetry(map[keeper.PendingQueryGroup]int{})
+	s.Require().Empty(emitted, "fourth emission")
+}
+
+func (s *KeeperTestSuite) TestQueryHistory() {
+	records := []types.QueryRecord{
+		{QueryId: "query-0", CallbackId: "callback-A", ConnectionId: "connection-0"},
+		{QueryId: "query-1", CallbackId: "callback-B", ConnectionId: "connection-0"},
+		{QueryId: "query-2", CallbackId: "callback-A", ConnectionId: "connection-1"},
+		{QueryId: "query-3", CallbackId: "callback-A", ConnectionId: "connection-0"},
+	}
+	for _, record := range records {
+		s.App.InterchainqueryKeeper.AddQueryRecord(s.Ctx, record)
+	}
+
+	getQueryIds := func(response *types.QueryQueryHistoryResponse) []string {
+		queryIds := []string{}
+		for _, record := range response.QueryRecords {
+			queryIds = append(queryIds, record.QueryId)
+		}
+		return queryIds
+	}
+
+	// No filters - most recent first
+	response, err := s.App.InterchainqueryKeeper.QueryHistory(s.Ctx, &types.QueryQueryHistoryRequest{})
+	s.Require().NoError(err)
+	s.Require().Equal([]string{"query-3", "query-2", "query-1", "query-0"}, getQueryIds(response))
+
+	// Filter by callback ID
+	response, err = s.App.InterchainqueryKeeper.QueryHistory(s.Ctx, &types.QueryQueryHistoryRequest{
+		CallbackId: "callback-A",
+	})
+	s.Require().NoError(err)
+	s.Require().Equal([]string{"query-3", "query-2", "query-0"}, getQueryIds(response))
+
+	// Filter by callback ID and connection ID
+	response, err = s.App.InterchainqueryKeeper.QueryHistory(s.Ctx, &types.QueryQueryHistoryRequest{
+		CallbackId:   "callback-A",
+		ConnectionId: "connection-0",
+	})
+	s.Require().NoError(err)
+	s.Require().Equal([]string{"query-3", "query-0"}, getQueryIds(response))
+
+	// With pagination
+	response, err = s.App.InterchainqueryKeeper.QueryHistory(s.Ctx, &types.QueryQueryHistoryRequest{
+		CallbackId: "callback-A",
+		Pagination: &query.PageRequest{Limit: 2, CountTotal: true},
+	})
+	s.Require().NoError(err)
+	s.Require().Equal([]string{"query-0", "query-2"}, getQueryIds(response))
+	s.Require().Equal(uint64(3), response.Pagination.Total, "total")
+}
@@ -0,0 +1,219 @@
+package keeper
+
+import (
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/Stride-Labs/stride/v24/utils"
+	"github.com/Stride-Labs/stride/v24/x/interchainquery/types"
+)
+
+// Escrows the relayer fee for the query's type (if one is configured) from the
+// relayer fee pool into the module account, and attaches it to the query
+// If the fee pool does not have sufficient funds, the query is submitted without a fee
+func (k Keeper) EscrowQueryFee(ctx sdk.Context, query *types.Query) error {
+	query.RelayerFee = nil
+	if !query.RequiresProof() {
+		return nil
+	}
+
+	fee, err := k.escrowRelayerFee(ctx, query.ChainId, query.QueryType, 1)
+	if err != nil {
+		return err
+	}
+	query.RelayerFee = fee
+
+	return nil
+}
+
+// Escrows the relayer fee for a batch query, which is the query type's fee for each key
+// in the batch, since the relayer must prove every key
+// If the fee pool does not have sufficient funds, the batch is submitted without a fee
+func (k Keeper) EscrowBatchQueryFee(ctx sdk.Context, query *types.BatchQuery) error {
+	query.RelayerFee = nil
+	if !query.RequiresProof() {
+		return nil
+	}
+
+	fee, err := k.escrowRelayerFee(ctx, query.ChainId, query.QueryType, len(query.RequestKeys))
+	if err != nil {
+		return err
+	}
+	query.RelayerFee = fee
+
+	return nil
+}
+
+// Returns a query's escrowed relayer fee to the fee pool
+func (k Keeper) RefundQueryFee(ctx sdk.Context, query types.Query) error {
+	return k.refundRelayerFee(ctx, query.Id, query.RelayerFee)
+}
+
+// Returns a batch query's escrowed relayer fee to the fee pool
+func (k Keeper) RefundBatchQueryFee(ctx sdk.Context, query types.BatchQuery) error {
+	return k.refundRelayerFee(ctx, query.Id, query.RelayerFee)
+}
+
+// Pays a query's escrowed relayer fee to the relayer that submitted the response
+func (k Keeper) PayQueryFee(ctx sdk.Context, query types.Query, relayer sdk.AccAddress) error {
+	return k.payRelayerFee(ctx, query.ChainId, query.CallbackId, query.Id, query.RelayerFee, relayer)
+}
+
+// Settles the relayer fee once a query's response has been submitted and verified
+// The fee is paid to the relayer if the response was proven and arrived before the
+// query's timeout. Otherwise, the fee is returned to the fee pool
+func (k Keeper) SettleQueryFee(ctx sdk.Context, msg *types.MsgSubmitQueryResponse, query types.Query) error {
+	if query.RelayerFee.IsZero() {
+		return nil
+	}
+
+	relayer, err := sdk.AccAddressFromBech32(msg.FromAddress)
+	if err != nil || !query.RequiresProof() || query.HasTimedOut(ctx.BlockTime()) {
+		return k.RefundQueryFee(ctx, query)
+	}
+	return k.PayQueryFee(ctx, query, relayer)
+}
+
+// Settles the relayer fee once a batch query's response has been submitted and verified,
+// following the same rules as a single key query
+func (k Keeper) SettleBatchQueryFee(ctx sdk.Context, msg *types.MsgSubmitBatchQueryResponse, query types.BatchQuery) error {
+	if query.RelayerFee.IsZero() {
+		return nil
+	}
+
+	relayer, err := sdk.AccAddressFromBech32(msg.FromAddress)
+	if err != nil || !query.RequiresProof() || query.HasTimedOut(ctx.BlockTime()) {
+		return k.RefundBatchQueryFee(ctx, query)
+	}
+	return k.payRelayerFee(ctx, query.ChainId, query.CallbackId, query.Id, query.RelayerFee, relayer)
+}
+
+// Escrows the relayer fee for a query type (scaled by the number of keys) from the fee pool
+// Returns an empty fee if there's no fee configured or the fee pool has insufficient funds
+func (k Keeper) escrowRelayerFee(ctx sdk.Context, chainId, queryType string, numKeys int) (sdk.Coins, error) {
+	fee := k.GetParams(ctx).GetRelayerFee(queryType).MulInt(sdkmath.NewInt(int64(numKeys)))
+	if fee.IsZero() {
+		return nil, nil
+	}
+
+	feePoolBalance := k.bankKeeper.GetAllBalances(ctx, authtypes.NewModuleAddress(types.RelayerFeePoolName))
+	if !feePoolBalance.IsAllGTE(fee) {
+		k.Logger(ctx).Info(utils.LogWithHostZone(chainId,
+			"Insufficient relayer fee pool balance (%s) to escrow fee (%s) for query type %s",
+			feePoolBalance, fee, queryType))
+		return nil, nil
+	}
+
+	if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.RelayerFeePoolName, types.ModuleName, fee); err != nil {
+		return nil, errorsmod.Wrapf(err, "unable to escrow relayer fee")
+	}
+	return fee, nil
+}
+
+// Returns an escrowed relayer fee to the fee pool
+func (k Keeper) refundRelayerFee(ctx sdk.Context, queryId string, fee sdk.Coins) error {
+	if fee.IsZero() {
+		return nil
+	}
+	if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, types.RelayerFeePoolName, fee); err != nil {
+		return errorsmod.Wrapf(err, "unable to refund relayer fee for query %s", queryId)
+	}
+	return nil
+}
+
+// Pays an escrowed relayer fee to the relayer that submitted the response
+func (k Keeper) payRelayerFee(
+	ctx sdk.Context,
+	chainId string,
+	callbackId string,
+	queryId string,
+	fee sdk.Coins,
+	relayer sdk.AccAddress,
+) error {
+	if fee.IsZero() {
+		return nil
+	}
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, relayer, fee); err != nil {
+		return errorsmod.Wrapf(err, "unable to pay relayer fee for query %s", queryId)
+	}
+	k.AddRelayerEarnings(ctx, relayer.String(), fee)
+
+	k.Logger(ctx).Info(utils.LogICQCallbackWithHostZone(chainId, callbackId,
+		"Paid relayer fee %s to %s - QueryId: %s", fee, relayer, queryId))
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRelayerFeePaid,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(types.AttributeKeyQueryId, queryId),
+			sdk.NewAttribute(types.AttributeKeyChainId, chainId),
+			sdk.NewAttribute(types.AttributeKeyRelayer, relayer.String()),
+			sdk.NewAttribute(types.AttributeKeyFee, fee.String()),
+		),
+	)
+
+	return nil
+}
+
+// Adds to the total fees earned by a relayer
+func (k Keeper) AddRelayerEarnings(ctx sdk.Context, relayer string, fee sdk.Coins) {
+	earnings := k.GetRelayerEarnings(ctx, relayer)
+	k.SetRelayerEarnings(ctx, types.RelayerEarnings{
+		Address:  relayer,
+		Earnings: earnings.Add(fee...),
+	})
+}
+
+// Stores the total fees earned by a relayer
+func (k Keeper) SetRelayerEarnings(ctx sdk.Context, relayerEarnings types.RelayerEarnings) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixRelayerEarnings)
+	store.Set([]byte(relayerEarnings.Address), k.cdc.MustMarshal(&relayerEarnings))
+}
+
+// Returns the total fees earned by a relayer
+func (k Keeper) GetRelayerEarnings(ctx sdk.Context, relayer string) sdk.Coins {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixRelayerEarnings)
+	bz := store.Get([]byte(relayer))
+	if len(bz) == 0 {
+		return sdk.Coins{}
+	}
+
+	var relayerEarnings types.RelayerEarnings
+	k.cdc.MustUnmarshal(bz, &relayerEarnings)
+	return relayerEarnings.Earnings
+}
+
+// Returns the earnings of every relayer that has been paid a fee
+func (k Keeper) GetAllRelayerEarnings(ctx sdk.Context) []types.RelayerEarnings {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixRelayerEarnings)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	allRelayerEarnings := []types.RelayerEarnings{}
+	for ; iterator.Valid(); iterator.Next() {
+		var relayerEarnings types.RelayerEarnings
+		k.cdc.MustUnmarshal(iterator.Value(), &relayerEarnings)
+		allRelayerEarnings = append(allRelayerEarnings, relayerEarnings)
+	}
+	return allRelayerEarnings
+}
+
+// Refunds the relayer fee of a query that's being removed without a relayer response,
+// logging instead of failing since the query removal should not be blocked
+func (k Keeper) refundQueryFeeOrLog(ctx sdk.Context, query types.Query) {
+	if err := k.RefundQueryFee(ctx, query); err != nil {
+		k.Logger(ctx).Error(fmt.Sprintf("Failed to refund relayer fee for query %s: %s", query.Id, err.Error()))
+	}
+}
+
+// Refunds the relayer fee of a batch query that's being removed without a relayer response,
+// logging instead of failing since the query removal should not be blocked
+func (k Keeper) refundBatchQueryFeeOrLog(ctx sdk.Context, query types.BatchQuery) {
+	if err := k.RefundBatchQueryFee(ctx, query); err != nil {
+		k.Logger(ctx).Error(fmt.Sprintf("Failed to refund relayer fee for batch query %s: %s", query.Id, err.Error()))
+	}
+}
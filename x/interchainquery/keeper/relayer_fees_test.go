@@ -0,0 +1,260 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/Stride-Labs/stride/v24/x/interchainquery/types"
+)
+
+const FeeDenom = "ustrd"
+
+var RelayerFee = sdk.NewCoins(sdk.NewCoin(FeeDenom, sdkmath.NewInt(1000)))
+
+// Configures a relayer fee for bank proof queries and funds the fee pool with enough for the given number of fees
+func (s *KeeperTestSuite) SetupRelayerFees(numFees int64) MsgSubmitQueryResponseTestCase {
+	tc := s.SetupMsgSubmitQueryResponse()
+
+	s.App.InterchainqueryKeeper.SetParams(s.Ctx, types.Params{
+		RelayerFees: []types.RelayerFee{{QueryType: types.BANK_STORE_QUERY_WITH_PROOF, Fee: RelayerFee}},
+	})
+	if numFees > 0 {
+		s.FundModuleAccount(types.RelayerFeePoolName, sdk.NewCoin(FeeDenom, RelayerFee.AmountOf(FeeDenom).MulRaw(numFees)))
+	}
+
+	tc.query.QueryType = types.BANK_STORE_QUERY_WITH_PROOF
+	tc.query.Id = ""
+	return tc
+}
+
+func (s *KeeperTestSuite) getFeePoolBalance() sdkmath.Int {
+	return s.App.BankKeeper.GetBalance(s.Ctx, authtypes.NewModuleAddress(types.RelayerFeePoolName), FeeDenom).Amount
+}
+
+func (s *KeeperTestSuite) getFeeEscrowBalance() sdkmath.Int {
+	return s.App.BankKeeper.GetBalance(s.Ctx, authtypes.NewModuleAddress(types.ModuleName), FeeDenom).Amount
+}
+
+func (s *KeeperTestSuite) TestEscrowQueryFee_Successful() {
+	tc := s.SetupRelayerFees(2)
+
+	err := s.App.InterchainqueryKeeper.SubmitICQRequest(s.Ctx, tc.query, false)
+	s.Require().NoError(err, "no error expected when submitting query")
+
+	queries := s.App.InterchainqueryKeeper.AllQueries(s.Ctx)
+	s.Require().Len(queries, 1, "number of queries")
+	s.Require().Equal(RelayerFee, queries[0].RelayerFee, "query relayer fee")
+	s.Require().Equal(int64(1000), s.getFeePoolBalance().Int64(), "fee pool balance")
+	s.Require().Equal(int64(1000), s.getFeeEscrowBalance().Int64(), "escrow balance")
+
+	// Re-submitting the same query should refund the previous fee before escrowing again
+	err = s.App.InterchainqueryKeeper.SubmitICQRequest(s.Ctx, tc.query, false)
+	s.Require().NoError(err, "no error expected when re-submitting query")
+	s.Require().Equal(int64(1000), s.getFeePoolBalance().Int64(), "fee pool balance after re-submission")
+	s.Require().Equal(int64(1000), s.getFeeEscrowBalance().Int64(), "escrow balance after re-submission")
+}
+
+func (s *KeeperTestSuite) TestEscrowQueryFee_InsufficientFeePool() {
+	tc := s.SetupRelayerFees(0)
+
+	err := s.App.InterchainqueryKeeper.SubmitICQRequest(s.Ctx, tc.query, false)
+	s.Require().NoError(err, "no error expected when submitting query")
+
+	queries := s.App.InterchainqueryKeeper.AllQueries(s.Ctx)
+	s.Require().Len(queries, 1, "number of queries")
+	s.Require().True(queries[0].RelayerFee.IsZero(), "query should not have a fee")
+}
+
+func (s *KeeperTestSuite) TestEscrowQueryFee_NoFeeForQueryType() {
+	tc := s.SetupRelayerFees(1)
+
+	tc.query.QueryType = types.STAKING_STORE_QUERY_WITH_PROOF
+	err := s.App.InterchainqueryKeeper.SubmitICQRequest(s.Ctx, tc.query, false)
+	s.Require().NoError(err, "no error expected when submitting query")
+
+	queries := s.App.InterchainqueryKeeper.AllQueries(s.Ctx)
+	s.Require().Len(queries, 1, "number of queries")
+	s.Require().True(queries[0].RelayerFee.IsZero(), "query should not have a fee")
+	s.Require().Equal(int64(1000), s.getFeePoolBalance().Int64(), "fee pool balance")
+}
+
+func (s *KeeperTestSuite) TestSettleQueryFee_PaidToRelayer() {
+	tc := s.SetupRelayerFees(1)
+
+	err := s.App.InterchainqueryKeeper.SubmitICQRequest(s.Ctx, tc.query, false)
+	s.Require().NoError(err)
+	query := s.App.InterchainqueryKeeper.AllQueries(s.Ctx)[0]
+
+	relayer := s.TestAccs[1]
+	tc.validMsg.FromAddress = relayer.String()
+	err = s.App.InterchainqueryKeeper.SettleQueryFee(s.Ctx, &tc.validMsg, query)
+	s.Require().NoError(err, "no error expected when settling fee")
+
+	s.Require().Equal(int64(1000), s.App.BankKeeper.GetBalance(s.Ctx, relayer, FeeDenom).Amount.Int64(), "relayer balance")
+	s.Require().Zero(s.getFeeEscrowBalance().Int64(), "escrow balance")
+	s.CheckEventValueEmitted(types.EventTypeRelayerFeePaid, types.AttributeKeyRelayer, relayer.String())
+
+	// Confirm the earnings are tracked across responses
+	s.App.InterchainqueryKeeper.AddRelayerEarnings(s.Ctx, relayer.String(), RelayerFee)
+	response, err := s.App.InterchainqueryKeeper.RelayerEarnings(s.Ctx, &types.QueryRelayerEarningsRequest{Address: relayer.String()})
+	s.Require().NoError(err)
+	s.Require().Equal(RelayerFee.Add(RelayerFee...), response.Earnings, "relayer earnings")
+}
+
+func (s *KeeperTestSuite) TestSettleQueryFee_TimedOut() {
+	tc := s.SetupRelayerFees(1)
+
+	err := s.App.InterchainqueryKeeper.SubmitICQRequest(s.Ctx, tc.query, false)
+	s.Require().NoError(err)
+	query := s.App.InterchainqueryKeeper.AllQueries(s.Ctx)[0]
+
+	// Settle after the query's timeout - the fee should be returned to the pool
+	s.Ctx = s.Ctx.WithBlockTime(s.Ctx.BlockTime().Add(time.Hour))
+	err = s.App.InterchainqueryKeeper.SettleQueryFee(s.Ctx, &tc.validMsg, query)
+	s.Require().NoError(err, "no error expected when settling fee")
+
+	s.Require().Equal(int64(1000), s.getFeePoolBalance().Int64(), "fee pool balance")
+	s.Require().Zero(s.getFeeEscrowBalance().Int64(), "escrow balance")
+	s.Require().True(s.App.InterchainqueryKeeper.GetRelayerEarnings(s.Ctx, tc.validMsg.FromAddress).IsZero(), "relayer earnings")
+}
+
+func (s *KeeperTestSuite) TestSubmitQueryResponse_InvalidProofKeepsFee() {
+	tc := s.SetupRelayerFees(1)
+
+	err := s.App.InterchainqueryKeeper.SubmitICQRequest(s.Ctx, tc.query, false)
+	s.Require().NoError(err)
+	query := s.App.InterchainqueryKeeper.AllQueries(s.Ctx)[0]
+
+	tc.validMsg.QueryId = query.Id
+	_, err = s.GetMsgServer().SubmitQueryResponse(s.Ctx, &tc.validMsg)
+	s.Require().ErrorIs(err, types.ErrInvalidICQProof)
+	s.Require().Equal(int64(1000), s.getFeeEscrowBalance().Int64(), "escrow balance")
+}
+
+func (s *KeeperTestSuite) TestPruneStaleQueries_RefundsFee() {
+	tc := s.SetupRelayerFees(1)
+
+	err := s.App.InterchainqueryKeeper.SubmitICQRequest(s.Ctx, tc.query, false)
+	s.Require().NoError(err)
+	query := s.App.InterchainqueryKeeper.AllQueries(s.Ctx)[0]
+	query.RequestSent = true
+	s.App.InterchainqueryKeeper.SetQuery(s.Ctx, query)
+
+	s.Ctx = s.Ctx.WithBlockTime(s.Ctx.BlockTime().Add(types.StaleQueryExpiration).Add(time.Hour))
+	s.App.InterchainqueryKeeper.PruneStaleQueries(s.Ctx)
+
+	s.Require().Empty(s.App.InterchainqueryKeeper.AllQueries(s.Ctx), "query should be removed")
+	s.Require().Equal(int64(1000), s.getFeePoolBalance().Int64(), "fee pool balance")
+	s.Require().Zero(s.getFeeEscrowBalance().Int64(), "escrow balance")
+}
+
+func (s *KeeperTestSuite) TestMsgUpdateParams() {
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	params := types.Params{
+		RelayerFees: []types.RelayerFee{{QueryType: types.BANK_STORE_QUERY_WITH_PROOF, Fee: RelayerFee}},
+	}
+
+	// Invalid authority
+	_, err := s.GetMsgServer().UpdateParams(s.Ctx, types.NewMsgUpdateParams(s.TestAccs[0].String(), params))
+	s.Require().ErrorContains(err, "invalid authority")
+
+	// Successful update
+	_, err = s.GetMsgServer().UpdateParams(s.Ctx, types.NewMsgUpdateParams(authority, params))
+	s.Require().NoError(err, "no error expected when updating params")
+
+	response, err := s.App.InterchainqueryKeeper.Params(s.Ctx, &types.QueryParamsRequest{})
+	s.Require().NoError(err)
+	s.Require().Equal(params, response.Params, "params")
+
+	// Fees can only be set for proof queries
+	invalidParams := types.Params{
+		RelayerFees: []types.RelayerFee{{QueryType: "store/bank", Fee: RelayerFee}},
+	}
+	err = types.NewMsgUpdateParams(authority, invalidParams).ValidateBasic()
+	s.Require().ErrorContains(err, "relayer fees can only be set for proof queries")
+
+	// Duplicate query types
+	invalidParams = types.Params{
+		RelayerFees: []types.RelayerFee{
+			{QueryType: types.BANK_STORE_QUERY_WITH_PROOF, Fee: RelayerFee},
+			{QueryType: types.BANK_STORE_QUERY_WITH_PROOF, Fee: RelayerFee},
+		},
+	}
+	err = types.NewMsgUpdateParams(authority, invalidParams).ValidateBasic()
+	s.Require().ErrorContains(err, "duplicate relayer fee")
+}
+
+func (s *KeeperTestSuite) TestEscrowBatchQueryFee_PaidToRelayer() {
+	tc := s.SetupBatchQuery()
+
+	s.App.InterchainqueryKeeper.SetParams(s.Ctx, types.Params{
+		RelayerFees: []types.RelayerFee{{QueryType: types.BANK_STORE_QUERY_WITH_PROOF, Fee: RelayerFee}},
+	})
+	s.FundModuleAccount(types.RelayerFeePoolName, sdk.NewCoin(FeeDenom, sdkmath.NewInt(5000)))
+
+	// The batch fee should be the query type's fee for each of the 3 keys
+	// Replace the setup query with one that requires a proof
+	s.App.InterchainqueryKeeper.DeleteBatchQuery(s.Ctx, tc.query.Id)
+	query := tc.query
+	query.Id = ""
+	query.QueryType = types.BANK_STORE_QUERY_WITH_PROOF
+	err := s.App.InterchainqueryKeeper.SubmitBatchICQRequest(s.Ctx, query, false)
+	s.Require().NoError(err, "no error expected when submitting batch query")
+
+	queries := s.App.InterchainqueryKeeper.AllBatchQueries(s.Ctx)
+	s.Require().Len(queries, 1, "number of batch queries")
+	query = queries[0]
+	expectedFee := sdk.NewCoins(sdk.NewCoin(FeeDenom, sdkmath.NewInt(3000)))
+	s.Require().Equal(expectedFee, query.RelayerFee, "batch query relayer fee")
+	s.Require().Equal(int64(2000), s.getFeePoolBalance().Int64(), "fee pool balance")
+	s.Require().Equal(int64(3000), s.getFeeEscrowBalance().Int64(), "escrow balance")
+
+	// Re-submitting the same batch should refund the previous fee before escrowing again
+	query.Id = ""
+	err = s.App.InterchainqueryKeeper.SubmitBatchICQRequest(s.Ctx, query, false)
+	s.Require().NoError(err, "no error expected when re-submitting batch query")
+	s.Require().Equal(int64(2000), s.getFeePoolBalance().Int64(), "fee pool balance after re-submission")
+	s.Require().Equal(int64(3000), s.getFeeEscrowBalance().Int64(), "escrow balance after re-submission")
+
+	// Settling the proven response should pay the relayer the full batch fee
+	query = s.App.InterchainqueryKeeper.AllBatchQueries(s.Ctx)[0]
+	relayer := s.TestAccs[1]
+	tc.validMsg.FromAddress = relayer.String()
+	err = s.App.InterchainqueryKeeper.SettleBatchQueryFee(s.Ctx, &tc.validMsg, query)
+	s.Require().NoError(err, "no error expected when settling batch fee")
+
+	s.Require().Equal(int64(3000), s.App.BankKeeper.GetBalance(s.Ctx, relayer, FeeDenom).Amount.Int64(), "relayer balance")
+	s.Require().Zero(s.getFeeEscrowBalance().Int64(), "escrow balance")
+}
+
+func (s *KeeperTestSuite) TestPruneStaleQueries_RefundsBatchFee() {
+	tc := s.SetupBatchQuery()
+
+	s.App.InterchainqueryKeeper.SetParams(s.Ctx, types.Params{
+		RelayerFees: []types.RelayerFee{{QueryType: types.BANK_STORE_QUERY_WITH_PROOF, Fee: RelayerFee}},
+	})
+	s.FundModuleAccount(types.RelayerFeePoolName, sdk.NewCoin(FeeDenom, sdkmath.NewInt(3000)))
+
+	// Replace the setup query with one that requires a proof
+	s.App.InterchainqueryKeeper.DeleteBatchQuery(s.Ctx, tc.query.Id)
+	query := tc.query
+	query.Id = ""
+	query.QueryType = types.BANK_STORE_QUERY_WITH_PROOF
+	err := s.App.InterchainqueryKeeper.SubmitBatchICQRequest(s.Ctx, query, false)
+	s.Require().NoError(err)
+	query = s.App.InterchainqueryKeeper.AllBatchQueries(s.Ctx)[0]
+	query.RequestSent = true
+	s.App.InterchainqueryKeeper.SetBatchQuery(s.Ctx, query)
+	s.Require().Zero(s.getFeePoolBalance().Int64(), "fee pool balance after escrow")
+
+	s.Ctx = s.Ctx.WithBlockTime(s.Ctx.BlockTime().Add(types.StaleQueryExpiration).Add(time.Hour))
+	s.App.InterchainqueryKeeper.PruneStaleQueries(s.Ctx)
+
+	s.Require().Empty(s.App.InterchainqueryKeeper.AllBatchQueries(s.Ctx), "batch query should be removed")
+	s.Require().Equal(int64(3000), s.getFeePoolBalance().Int64(), "fee pool balance")
+	s.Require().Zero(s.getFeeEscrowBalance().Int64(), "escrow balance")
+}
@@ -0,0 +1,128 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/gogoproto/proto"
+	host "github.com/cosmos/ibc-go/v7/modules/core/24-host"
+)
+
+// ICS-31 packet data and acknowledgements are JSON encoded with the proto codec
+var packetCdc = codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+
+// Validates the connection ID of an async-icq channel config
+func (c AsyncICQChannel) ValidateConnection() error {
+	if err := host.ConnectionIdentifierValidator(c.ConnectionId); err != nil {
+		return errorsmod.Wrapf(ErrInvalidAsyncICQ, "invalid connection ID (%s)", err)
+	}
+	return nil
+}
+
+// Validates both the connection and channel ID of an async-icq channel config
+func (c AsyncICQChannel) Validate() error {
+	if err := c.ValidateConnection(); err != nil {
+		return err
+	}
+	if err := host.ChannelIdentifierValidator(c.ChannelId); err != nil {
+		return errorsmod.Wrapf(ErrInvalidAsyncICQ, "invalid channel ID (%s)", err)
+	}
+	return nil
+}
+
+// Builds the ABCI query path for an ICQ query type
+// (e.g. "cosmos.bank.v1beta1.Query/Balance" -> "/cosmos.bank.v1beta1.Query/Balance")
+func AsyncICQPath(queryType string) string {
+	return fmt.Sprintf("/%s", strings.TrimPrefix(queryType, "/"))
+}
+
+// Checks whether a query type can be served by an ICS-31 async-icq host
+// The host only routes requests through its gRPC query router, so the query type must be
+// a fully qualified gRPC method (e.g. "cosmos.bank.v1beta1.Query/Balance"). Raw store
+// queries (e.g. "store/bank/key") are rejected by the host, and must be served by the relayer
+// Note: the host must also include the method in its allowed queries
+func IsAsyncICQCompatible(queryType string) bool {
+	pathParts := strings.Split(strings.TrimPrefix(queryType, "/"), "/")
+	if len(pathParts) != 2 {
+		return false
+	}
+	service, method := pathParts[0], pathParts[1]
+	return strings.Contains(service, ".") && method != ""
+}
+
+// Serializes the query as ICS-31 packet data, containing a single ABCI request
+func SerializeAsyncICQPacketData(query Query) ([]byte, error) {
+	return serializeAsyncICQRequests([]abci.RequestQuery{{
+		Path: AsyncICQPath(query.QueryType),
+		Data: query.RequestData,
+	}})
+}
+
+// Serializes the batch query as ICS-31 packet data, containing one ABCI request per key
+// The host executes every request in the same block, so all the keys are read at one height
+func SerializeAsyncICQBatchPacketData(query BatchQuery) ([]byte, error) {
+	requests := []abci.RequestQuery{}
+	for _, requestKey := range query.RequestKeys {
+		requests = append(requests, abci.RequestQuery{
+			Path: AsyncICQPath(query.QueryType),
+			Data: requestKey,
+		})
+	}
+	return serializeAsyncICQRequests(requests)
+}
+
+// Serializes ABCI requests as ICS-31 packet data
+func serializeAsyncICQRequests(requests []abci.RequestQuery) ([]byte, error) {
+	cosmosQuery := CosmosQuery{Requests: requests}
+	cosmosQueryBz, err := proto.Marshal(&cosmosQuery)
+	if err != nil {
+		return nil, err
+	}
+
+	packetData := InterchainQueryPacketData{Data: cosmosQueryBz}
+	packetDataBz, err := packetCdc.MarshalJSON(&packetData)
+	if err != nil {
+		return nil, err
+	}
+	return sdk.MustSortJSON(packetDataBz), nil
+}
+
+// Deserializes the result of a successful ICS-31 acknowledgement, which should
+// contain a single ABCI response since only one request is sent per packet
+func DeserializeAsyncICQAcknowledgement(ackResult []byte) (abci.ResponseQuery, error) {
+	responses, err := DeserializeAsyncICQBatchAcknowledgement(ackResult, 1)
+	if err != nil {
+		return abci.ResponseQuery{}, err
+	}
+	return responses[0], nil
+}
+
+// Deserializes the result of a successful ICS-31 acknowledgement, which should contain
+// one ABCI response for each request in the packet (in the same order as the requests)
+func DeserializeAsyncICQBatchAcknowledgement(ackResult []byte, numRequests int) ([]abci.ResponseQuery, error) {
+	var packetAck InterchainQueryPacketAck
+	if err := packetCdc.UnmarshalJSON(ackResult, &packetAck); err != nil {
+		return nil, errorsmod.Wrapf(ErrInvalidAsyncICQPacket, "unable to unmarshal acknowledgement: %s", err.Error())
+	}
+
+	var cosmosResponse CosmosResponse
+	if err := proto.Unmarshal(packetAck.Data, &cosmosResponse); err != nil {
+		return nil, errorsmod.Wrapf(ErrInvalidAsyncICQPacket, "unable to unmarshal cosmos response: %s", err.Error())
+	}
+	if len(cosmosResponse.Responses) != numRequests {
+		return nil, errorsmod.Wrapf(ErrInvalidAsyncICQPacket,
+			"expected %d responses, %d provided", numRequests, len(cosmosResponse.Responses))
+	}
+
+	for _, response := range cosmosResponse.Responses {
+		if response.Code != 0 {
+			return nil, errorsmod.Wrapf(ErrInvalidAsyncICQPacket, "query failed on host with code %d", response.Code)
+		}
+	}
+	return cosmosResponse.Responses, nil
+}
@@ -10,3 +10,11 @@ type QueryCallbacks interface {
 	CallICQCallback(ctx sdk.Context, id string, args []byte, query Query) error
 	HasICQCallback(id string) bool
 }
+
+// BatchQueryCallbacks can optionally be implemented by a module's QueryCallbacks
+// to handle batch queries. The callback receives the value for every key in the
+// batch, indexed by the raw request key
+type BatchQueryCallbacks interface {
+	CallBatchICQCallback(ctx sdk.Context, id string, results map[string][]byte, query BatchQuery) error
+	HasBatchICQCallback(id string) bool
+}
@@ -15,12 +15,18 @@ var (
 
 func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgSubmitQueryResponse{}, "/stride.interchainquery.MsgSubmitQueryResponse", nil)
+	cdc.RegisterConcrete(&MsgSubmitBatchQueryResponse{}, "interchainquery/MsgSubmitBatchQueryResponse", nil)
+	cdc.RegisterConcrete(&MsgSetAsyncICQChannel{}, "interchainquery/MsgSetAsyncICQChannel", nil)
+	cdc.RegisterConcrete(&MsgUpdateParams{}, "interchainquery/MsgUpdateParams", nil)
 	// this line is used by starport scaffolding # 2
 }
 
 func RegisterInterfaces(registry types.InterfaceRegistry) {
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgSubmitQueryResponse{},
+		&MsgSubmitBatchQueryResponse{},
+		&MsgSetAsyncICQChannel{},
+		&MsgUpdateParams{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
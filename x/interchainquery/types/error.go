@@ -10,4 +10,8 @@ var (
 	ErrInvalidConsensusState = errors.New("invalid consensus state")
 	ErrInvalidICQRequest     = errors.New("invalid interchain query request")
 	ErrFailedToRetryQuery    = errors.New("failed to retry query")
+	ErrInvalidAsyncICQ       = errors.New("invalid async-icq channel")
+	ErrInvalidAsyncICQPacket = errors.New("invalid async-icq packet")
+	ErrInvalidBatchQuery     = errors.New("invalid batch query")
+	ErrInvalidParams         = errors.New("invalid interchainquery params")
 )
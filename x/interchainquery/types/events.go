@@ -8,6 +8,20 @@ const (
 	AttributeKeyParams       = "parameters"
 	AttributeKeyRequest      = "request"
 	AttributeKeyHeight       = "height"
+	AttributeKeyChannelId    = "channel_id"
+	AttributeKeySequence     = "sequence"
+	AttributeKeyAckSuccess   = "success"
+	AttributeKeyAckError     = "error"
+	AttributeKeyRelayer      = "relayer"
+	AttributeKeyFee          = "fee"
+
+	EventTypeAsyncICQRequest        = "async_icq_request"
+	EventTypeAsyncICQAck            = "async_icq_acknowledgement"
+	EventTypeAsyncICQTimeout        = "async_icq_timeout"
+	EventTypeAsyncICQCallbackFailed = "async_icq_callback_failed"
+	EventTypeBatchQueryRequest      = "batch_query_request"
+	EventTypeBatchQueryResponse     = "batch_query_response"
+	EventTypeRelayerFeePaid         = "relayer_fee_paid"
 
 	AttributeValueCategory = ModuleName
 	AttributeValueQuery    = "query"
@@ -1,7 +1,19 @@
 package types
 
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
 func NewGenesisState(queries []Query) *GenesisState {
-	return &GenesisState{Queries: queries}
+	return &GenesisState{
+		Queries:          queries,
+		AsyncIcqChannels: []AsyncICQChannel{},
+		AsyncIcqPackets:  []AsyncICQPacket{},
+		BatchQueries:     []BatchQuery{},
+		Params:           DefaultParams(),
+		RelayerEarnings:  []RelayerEarnings{},
+	}
 }
 
 // DefaultGenesis returns the default Capability genesis state
@@ -13,6 +25,41 @@ func DefaultGenesis() *GenesisState {
 // Validate performs basic genesis state validation returning an error upon any
 // failure.
 func (gs GenesisState) Validate() error {
-	// TODO: validate genesis state.
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+
+	relayers := map[string]bool{}
+	for _, relayerEarnings := range gs.RelayerEarnings {
+		if _, err := sdk.AccAddressFromBech32(relayerEarnings.Address); err != nil {
+			return errorsmod.Wrapf(ErrInvalidParams, "invalid relayer address %s", relayerEarnings.Address)
+		}
+		if relayers[relayerEarnings.Address] {
+			return errorsmod.Wrapf(ErrInvalidParams, "duplicate relayer earnings for %s", relayerEarnings.Address)
+		}
+		relayers[relayerEarnings.Address] = true
+	}
+
+	connectionIds := map[string]bool{}
+	for _, asyncICQChannel := range gs.AsyncIcqChannels {
+		if err := asyncICQChannel.Validate(); err != nil {
+			return err
+		}
+		if connectionIds[asyncICQChannel.ConnectionId] {
+			return errorsmod.Wrapf(ErrInvalidAsyncICQ, "duplicate connection %s", asyncICQChannel.ConnectionId)
+		}
+		connectionIds[asyncICQChannel.ConnectionId] = true
+	}
+
+	batchQueryIds := map[string]bool{}
+	for _, batchQuery := range gs.BatchQueries {
+		if batchQuery.Id == "" {
+			return errorsmod.Wrap(ErrInvalidBatchQuery, "batch query id cannot be empty")
+		}
+		if batchQueryIds[batchQuery.Id] {
+			return errorsmod.Wrapf(ErrInvalidBatchQuery, "duplicate batch query %s", batchQuery.Id)
+		}
+		batchQueryIds[batchQuery.Id] = true
+	}
 	return nil
 }
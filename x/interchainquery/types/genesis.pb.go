@@ -7,6 +7,7 @@ import (
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types1 "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	_ "github.com/cosmos/gogoproto/types"
@@ -71,6 +72,11 @@ type Query struct {
 	TimeoutTimestamp uint64        `protobuf:"varint,9,opt,name=timeout_timestamp,json=timeoutTimestamp,proto3" json:"timeout_timestamp,omitempty"`
 	RequestSent      bool          `protobuf:"varint,11,opt,name=request_sent,json=requestSent,proto3" json:"request_sent,omitempty"`
 	SubmissionHeight uint64        `protobuf:"varint,16,opt,name=submission_height,json=submissionHeight,proto3" json:"submission_height,omitempty"`
+	// Fee escrowed for the relayer that submits the first valid proven response
+	RelayerFee github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,17,rep,name=relayer_fee,json=relayerFee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"relayer_fee"`
+	// Invokes the callback when the response is empty (i.e. the key was proven
+	// to not exist on the host), instead of dropping the response
+	CallbackOnEmptyResult bool `protobuf:"varint,18,opt,name=callback_on_empty_result,json=callbackOnEmptyResult,proto3" json:"callback_on_empty_result,omitempty"`
 }
 
 func (m *Query) Reset()         { *m = Query{} }
@@ -197,6 +203,173 @@ func (m *Query) GetSubmissionHeight() uint64 {
 	return 0
 }
 
+func (m *Query) GetRelayerFee() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.RelayerFee
+	}
+	return nil
+}
+
+func (m *Query) GetCallbackOnEmptyResult() bool {
+	if m != nil {
+		return m.CallbackOnEmptyResult
+	}
+	return false
+}
+
+// A query for multiple keys from the same store on the host, that is
+// answered at a single remote height and processed by a single callback
+type BatchQuery struct {
+	Id           string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ConnectionId string `protobuf:"bytes,2,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty"`
+	ChainId      string `protobuf:"bytes,3,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	// Store query path shared by every key in the batch (e.g. store/bank/key)
+	QueryType        string        `protobuf:"bytes,4,opt,name=query_type,json=queryType,proto3" json:"query_type,omitempty"`
+	RequestKeys      [][]byte      `protobuf:"bytes,5,rep,name=request_keys,json=requestKeys,proto3" json:"request_keys,omitempty"`
+	CallbackModule   string        `protobuf:"bytes,6,opt,name=callback_module,json=callbackModule,proto3" json:"callback_module,omitempty"`
+	CallbackId       string        `protobuf:"bytes,7,opt,name=callback_id,json=callbackId,proto3" json:"callback_id,omitempty"`
+	CallbackData     []byte        `protobuf:"bytes,8,opt,name=callback_data,json=callbackData,proto3" json:"callback_data,omitempty"`
+	TimeoutPolicy    TimeoutPolicy `protobuf:"varint,9,opt,name=timeout_policy,json=timeoutPolicy,proto3,enum=stride.interchainquery.v1.TimeoutPolicy" json:"timeout_policy,omitempty"`
+	TimeoutDuration  time.Duration `protobuf:"bytes,10,opt,name=timeout_duration,json=timeoutDuration,proto3,stdduration" json:"timeout_duration"`
+	TimeoutTimestamp uint64        `protobuf:"varint,11,opt,name=timeout_timestamp,json=timeoutTimestamp,proto3" json:"timeout_timestamp,omitempty"`
+	RequestSent      bool          `protobuf:"varint,12,opt,name=request_sent,json=requestSent,proto3" json:"request_sent,omitempty"`
+	SubmissionHeight uint64        `protobuf:"varint,13,opt,name=submission_height,json=submissionHeight,proto3" json:"submission_height,omitempty"`
+	// Fee escrowed for the relayer that submits the first valid proven response
+	// (the query type's relayer fee for each key in the batch)
+	RelayerFee github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,14,rep,name=relayer_fee,json=relayerFee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"relayer_fee"`
+}
+
+func (m *BatchQuery) Reset()         { *m = BatchQuery{} }
+func (m *BatchQuery) String() string { return proto.CompactTextString(m) }
+func (*BatchQuery) ProtoMessage()    {}
+func (*BatchQuery) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74cd646eb05658fd, []int{1}
+}
+func (m *BatchQuery) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BatchQuery) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BatchQuery.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BatchQuery) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BatchQuery.Merge(m, src)
+}
+func (m *BatchQuery) XXX_Size() int {
+	return m.Size()
+}
+func (m *BatchQuery) XXX_DiscardUnknown() {
+	xxx_messageInfo_BatchQuery.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BatchQuery proto.InternalMessageInfo
+
+func (m *BatchQuery) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *BatchQuery) GetConnectionId() string {
+	if m != nil {
+		return m.ConnectionId
+	}
+	return ""
+}
+
+func (m *BatchQuery) GetChainId() string {
+	if m != nil {
+		return m.ChainId
+	}
+	return ""
+}
+
+func (m *BatchQuery) GetQueryType() string {
+	if m != nil {
+		return m.QueryType
+	}
+	return ""
+}
+
+func (m *BatchQuery) GetRequestKeys() [][]byte {
+	if m != nil {
+		return m.RequestKeys
+	}
+	return nil
+}
+
+func (m *BatchQuery) GetCallbackModule() string {
+	if m != nil {
+		return m.CallbackModule
+	}
+	return ""
+}
+
+func (m *BatchQuery) GetCallbackId() string {
+	if m != nil {
+		return m.CallbackId
+	}
+	return ""
+}
+
+func (m *BatchQuery) GetCallbackData() []byte {
+	if m != nil {
+		return m.CallbackData
+	}
+	return nil
+}
+
+func (m *BatchQuery) GetTimeoutPolicy() TimeoutPolicy {
+	if m != nil {
+		return m.TimeoutPolicy
+	}
+	return TimeoutPolicy_REJECT_QUERY_RESPONSE
+}
+
+func (m *BatchQuery) GetTimeoutDuration() time.Duration {
+	if m != nil {
+		return m.TimeoutDuration
+	}
+	return 0
+}
+
+func (m *BatchQuery) GetTimeoutTimestamp() uint64 {
+	if m != nil {
+		return m.TimeoutTimestamp
+	}
+	return 0
+}
+
+func (m *BatchQuery) GetRequestSent() bool {
+	if m != nil {
+		return m.RequestSent
+	}
+	return false
+}
+
+func (m *BatchQuery) GetSubmissionHeight() uint64 {
+	if m != nil {
+		return m.SubmissionHeight
+	}
+	return 0
+}
+
+func (m *BatchQuery) GetRelayerFee() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.RelayerFee
+	}
+	return nil
+}
+
 type DataPoint struct {
 	Id           string                                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	RemoteHeight github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=remote_height,json=remoteHeight,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"remote_height"`
@@ -208,7 +381,7 @@ func (m *DataPoint) Reset()         { *m = DataPoint{} }
 func (m *DataPoint) String() string { return proto.CompactTextString(m) }
 func (*DataPoint) ProtoMessage()    {}
 func (*DataPoint) Descriptor() ([]byte, []int) {
-	return fileDescriptor_74cd646eb05658fd, []int{1}
+	return fileDescriptor_74cd646eb05658fd, []int{2}
 }
 func (m *DataPoint) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -253,14 +426,19 @@ func (m *DataPoint) GetValue() []byte {
 
 // GenesisState defines the epochs module's genesis state.
 type GenesisState struct {
-	Queries []Query `protobuf:"bytes,1,rep,name=queries,proto3" json:"queries"`
+	Queries          []Query           `protobuf:"bytes,1,rep,name=queries,proto3" json:"queries"`
+	AsyncIcqChannels []AsyncICQChannel `protobuf:"bytes,2,rep,name=async_icq_channels,json=asyncIcqChannels,proto3" json:"async_icq_channels"`
+	AsyncIcqPackets  []AsyncICQPacket  `protobuf:"bytes,3,rep,name=async_icq_packets,json=asyncIcqPackets,proto3" json:"async_icq_packets"`
+	BatchQueries     []BatchQuery      `protobuf:"bytes,4,rep,name=batch_queries,json=batchQueries,proto3" json:"batch_queries"`
+	Params           Params            `protobuf:"bytes,5,opt,name=params,proto3" json:"params"`
+	RelayerEarnings  []RelayerEarnings `protobuf:"bytes,6,rep,name=relayer_earnings,json=relayerEarnings,proto3" json:"relayer_earnings"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
 func (m *GenesisState) String() string { return proto.CompactTextString(m) }
 func (*GenesisState) ProtoMessage()    {}
 func (*GenesisState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_74cd646eb05658fd, []int{2}
+	return fileDescriptor_74cd646eb05658fd, []int{3}
 }
 func (m *GenesisState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -296,9 +474,45 @@ func (m *GenesisState) GetQueries() []Query {
 	return nil
 }
 
+func (m *GenesisState) GetAsyncIcqChannels() []AsyncICQChannel {
+	if m != nil {
+		return m.AsyncIcqChannels
+	}
+	return nil
+}
+
+func (m *GenesisState) GetAsyncIcqPackets() []AsyncICQPacket {
+	if m != nil {
+		return m.AsyncIcqPackets
+	}
+	return nil
+}
+
+func (m *GenesisState) GetBatchQueries() []BatchQuery {
+	if m != nil {
+		return m.BatchQueries
+	}
+	return nil
+}
+
+func (m *GenesisState) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+func (m *GenesisState) GetRelayerEarnings() []RelayerEarnings {
+	if m != nil {
+		return m.RelayerEarnings
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterEnum("stride.interchainquery.v1.TimeoutPolicy", TimeoutPolicy_name, TimeoutPolicy_value)
 	proto.RegisterType((*Query)(nil), "stride.interchainquery.v1.Query")
+	proto.RegisterType((*BatchQuery)(nil), "stride.interchainquery.v1.BatchQuery")
 	proto.RegisterType((*DataPoint)(nil), "stride.interchainquery.v1.DataPoint")
 	proto.RegisterType((*GenesisState)(nil), "stride.interchainquery.v1.GenesisState")
 }
@@ -308,52 +522,71 @@ func init() {
 }
 
 var fileDescriptor_74cd646eb05658fd = []byte{
-	// 718 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0xc1, 0x6e, 0xd3, 0x4a,
-	0x14, 0x8d, 0xd3, 0xf4, 0x35, 0x99, 0x38, 0x69, 0xde, 0xbc, 0xbe, 0xf7, 0x9c, 0x4a, 0x24, 0xa6,
-	0x48, 0xd4, 0x2a, 0xd4, 0x56, 0x03, 0x1b, 0x24, 0x16, 0x34, 0xa9, 0x05, 0x81, 0xd2, 0xa6, 0x4e,
-	0x2a, 0x51, 0x36, 0x96, 0x63, 0x0f, 0xc9, 0xa8, 0xb6, 0x27, 0xf5, 0x8c, 0x23, 0xf2, 0x0d, 0x6c,
-	0x58, 0xf2, 0x05, 0x7c, 0x01, 0x1f, 0xd1, 0x65, 0xc5, 0x0a, 0xb1, 0x28, 0xa8, 0xdd, 0xf1, 0x15,
-	0xc8, 0x63, 0x4f, 0x5b, 0xa8, 0x60, 0xc5, 0xca, 0x9e, 0x7b, 0xce, 0x3d, 0xf7, 0xfa, 0xde, 0xe3,
-	0x01, 0xab, 0x94, 0x45, 0xd8, 0x43, 0x06, 0x0e, 0x19, 0x8a, 0xdc, 0xb1, 0x83, 0xc3, 0xa3, 0x18,
-	0x45, 0x33, 0x63, 0xba, 0x61, 0x8c, 0x50, 0x88, 0x28, 0xa6, 0xfa, 0x24, 0x22, 0x8c, 0xc0, 0x7a,
-	0x4a, 0xd4, 0x7f, 0x22, 0xea, 0xd3, 0x8d, 0xe5, 0xa5, 0x11, 0x19, 0x11, 0xce, 0x32, 0x92, 0xb7,
-	0x34, 0x61, 0xb9, 0x31, 0x22, 0x64, 0xe4, 0x23, 0x83, 0x9f, 0x86, 0xf1, 0x2b, 0xc3, 0x8b, 0x23,
-	0x87, 0x61, 0x12, 0x66, 0x78, 0xdd, 0x25, 0x34, 0x20, 0xd4, 0x4e, 0x13, 0xd3, 0x43, 0x0a, 0xad,
-	0xbc, 0x2f, 0x80, 0xf9, 0xbd, 0x44, 0x1d, 0x56, 0x41, 0x1e, 0x7b, 0x8a, 0xa4, 0x4a, 0x5a, 0xc9,
-	0xca, 0x63, 0x0f, 0xde, 0x02, 0x15, 0x97, 0x84, 0x21, 0x72, 0x13, 0x21, 0x1b, 0x7b, 0x4a, 0x9e,
-	0x43, 0xf2, 0x65, 0xb0, 0xeb, 0xc1, 0x3a, 0x28, 0xf2, 0x06, 0x13, 0x7c, 0x8e, 0xe3, 0x0b, 0xfc,
-	0xdc, 0xf5, 0xe0, 0x0d, 0x00, 0x78, 0xdb, 0x36, 0x9b, 0x4d, 0x90, 0x52, 0xe0, 0x60, 0x89, 0x47,
-	0x06, 0xb3, 0x09, 0x82, 0x37, 0x81, 0x1c, 0xa1, 0xa3, 0x18, 0x51, 0x66, 0x7b, 0x0e, 0x73, 0x94,
-	0x79, 0x55, 0xd2, 0x64, 0xab, 0x9c, 0xc5, 0xb6, 0x1c, 0xe6, 0xc0, 0x55, 0xb0, 0xe8, 0x3a, 0xbe,
-	0x3f, 0x74, 0xdc, 0x43, 0x3b, 0x20, 0x5e, 0xec, 0x23, 0xa5, 0xc2, 0x65, 0xaa, 0x22, 0xfc, 0x9c,
-	0x47, 0x61, 0x13, 0x94, 0x2f, 0x88, 0xd8, 0x53, 0x8a, 0x9c, 0x04, 0x44, 0xa8, 0x9b, 0x7e, 0x8b,
-	0x20, 0xf0, 0x6a, 0x32, 0xaf, 0x26, 0x8b, 0x20, 0x2f, 0xb7, 0x0b, 0xaa, 0x0c, 0x07, 0x88, 0xc4,
-	0xcc, 0x9e, 0x10, 0x1f, 0xbb, 0x33, 0x65, 0x51, 0x95, 0xb4, 0x6a, 0x4b, 0xd3, 0x7f, 0xb9, 0x0f,
-	0x7d, 0x90, 0x26, 0xf4, 0x38, 0xdf, 0xaa, 0xb0, 0xab, 0x47, 0xb8, 0x03, 0x6a, 0x42, 0x50, 0x2c,
-	0x44, 0xa9, 0xaa, 0x92, 0x56, 0x6e, 0xd5, 0xf5, 0x74, 0x63, 0xba, 0xd8, 0x98, 0xbe, 0x95, 0x11,
-	0xda, 0xc5, 0xe3, 0xd3, 0x66, 0xee, 0xdd, 0x97, 0xa6, 0x64, 0x2d, 0x66, 0xc9, 0x02, 0x82, 0x77,
-	0xc0, 0xdf, 0x42, 0x2f, 0x79, 0x52, 0xe6, 0x04, 0x13, 0xa5, 0xa4, 0x4a, 0x5a, 0xc1, 0x12, 0x85,
-	0x06, 0x22, 0x7e, 0x75, 0xbe, 0x14, 0x85, 0x4c, 0x29, 0xab, 0x92, 0x56, 0xbc, 0x98, 0x6f, 0x1f,
-	0x85, 0x2c, 0xd1, 0xa3, 0xf1, 0x30, 0xc0, 0x94, 0x26, 0x1b, 0x1e, 0x23, 0x3c, 0x1a, 0x33, 0xa5,
-	0x96, 0xea, 0x5d, 0x02, 0x4f, 0x78, 0x7c, 0xe5, 0x4d, 0x1e, 0x94, 0x92, 0x31, 0xf5, 0x08, 0x0e,
-	0xd9, 0x35, 0xb3, 0x38, 0xa0, 0x12, 0xa1, 0x80, 0x30, 0x24, 0x64, 0xb8, 0x59, 0xda, 0x0f, 0x93,
-	0x8f, 0xf9, 0x7c, 0xda, 0xbc, 0x3d, 0xc2, 0x6c, 0x1c, 0x0f, 0x75, 0x97, 0x04, 0x99, 0xfd, 0xb2,
-	0xc7, 0x3a, 0xf5, 0x0e, 0x8d, 0xc4, 0x20, 0x54, 0xef, 0x86, 0xec, 0xe3, 0x87, 0x75, 0x90, 0xb9,
-	0xb3, 0x1b, 0x32, 0x4b, 0x4e, 0x25, 0xd3, 0x06, 0xa0, 0x0d, 0x64, 0x9f, 0xb8, 0x8e, 0x2f, 0x2a,
-	0xcc, 0xfd, 0x81, 0x0a, 0x65, 0xae, 0x98, 0x15, 0x58, 0x03, 0xf3, 0x53, 0xc7, 0x8f, 0x53, 0xaf,
-	0xca, 0xed, 0xa5, 0x6f, 0xa7, 0xcd, 0x5a, 0x84, 0x68, 0xec, 0xb3, 0xbb, 0x24, 0xc0, 0x0c, 0x05,
-	0x13, 0x36, 0xb3, 0x52, 0xca, 0x4a, 0x0f, 0xc8, 0x8f, 0xd3, 0x7f, 0xb6, 0xcf, 0x1c, 0x86, 0xe0,
-	0x23, 0xb0, 0x90, 0x78, 0x02, 0x23, 0xaa, 0x48, 0xea, 0x9c, 0x56, 0x6e, 0xa9, 0xbf, 0x31, 0x0d,
-	0xff, 0xdf, 0xda, 0x85, 0xa4, 0x73, 0x4b, 0xa4, 0xad, 0xd9, 0xa0, 0xf2, 0x83, 0x99, 0x60, 0x1d,
-	0xfc, 0x6b, 0x99, 0x4f, 0xcd, 0xce, 0xc0, 0xde, 0xdb, 0x37, 0xad, 0x03, 0xdb, 0x32, 0xfb, 0xbd,
-	0xdd, 0x9d, 0xbe, 0x59, 0xcb, 0xc1, 0xff, 0xc1, 0x3f, 0x96, 0x39, 0xb0, 0x0e, 0x2e, 0x90, 0xbd,
-	0x7d, 0xb3, 0x3f, 0xa8, 0x49, 0x70, 0x19, 0xfc, 0x67, 0xbe, 0x30, 0x3b, 0xfb, 0x03, 0x33, 0x83,
-	0x3a, 0x9b, 0xdb, 0xdb, 0xed, 0xcd, 0xce, 0xb3, 0x5a, 0xbe, 0xdd, 0x3f, 0x3e, 0x6b, 0x48, 0x27,
-	0x67, 0x0d, 0xe9, 0xeb, 0x59, 0x43, 0x7a, 0x7b, 0xde, 0xc8, 0x9d, 0x9c, 0x37, 0x72, 0x9f, 0xce,
-	0x1b, 0xb9, 0x97, 0x0f, 0xae, 0xcc, 0xae, 0xcf, 0xbb, 0x5e, 0xdf, 0x76, 0x86, 0xd4, 0xc8, 0xee,
-	0xab, 0x69, 0xeb, 0xbe, 0xf1, 0xfa, 0xda, 0xad, 0xc5, 0x47, 0x3a, 0xfc, 0x8b, 0x1b, 0xf8, 0xde,
-	0xf7, 0x00, 0x00, 0x00, 0xff, 0xff, 0x71, 0x97, 0x57, 0xbc, 0xdc, 0x04, 0x00, 0x00,
+	// 1024 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x56, 0x4d, 0x6f, 0x1b, 0x45,
+	0x18, 0xce, 0xda, 0x71, 0x3e, 0xc6, 0x6b, 0xc7, 0x19, 0x5a, 0xd8, 0x44, 0xc2, 0x76, 0x83, 0x68,
+	0xdd, 0x40, 0x76, 0x49, 0x40, 0x42, 0x48, 0x48, 0x10, 0xbb, 0x0b, 0x98, 0x86, 0xc6, 0x59, 0x3b,
+	0x12, 0xa5, 0x12, 0xab, 0xd9, 0xdd, 0xa9, 0x3d, 0xca, 0xee, 0x8e, 0xb3, 0x33, 0x1b, 0xe1, 0xdf,
+	0xc0, 0x85, 0x23, 0xfc, 0x05, 0xce, 0xfc, 0x88, 0x5e, 0x90, 0x2a, 0x4e, 0x88, 0x43, 0x8a, 0x92,
+	0x1b, 0xff, 0x01, 0x09, 0xed, 0xec, 0x4c, 0x92, 0x52, 0x92, 0xfa, 0x10, 0xd4, 0x93, 0x3d, 0xef,
+	0xf3, 0x3e, 0xcf, 0x3b, 0xef, 0xcc, 0x33, 0x33, 0x0b, 0xee, 0x30, 0x9e, 0x90, 0x00, 0x5b, 0x24,
+	0xe6, 0x38, 0xf1, 0x47, 0x88, 0xc4, 0x87, 0x29, 0x4e, 0x26, 0xd6, 0xd1, 0xa6, 0x35, 0xc4, 0x31,
+	0x66, 0x84, 0x99, 0xe3, 0x84, 0x72, 0x0a, 0x57, 0xf2, 0x44, 0xf3, 0x5f, 0x89, 0xe6, 0xd1, 0xe6,
+	0xea, 0x8d, 0x21, 0x1d, 0x52, 0x91, 0x65, 0x65, 0xff, 0x72, 0xc2, 0x6a, 0x7d, 0x48, 0xe9, 0x30,
+	0xc4, 0x96, 0x18, 0x79, 0xe9, 0x63, 0x2b, 0x48, 0x13, 0xc4, 0x09, 0x8d, 0x25, 0xbe, 0xe2, 0x53,
+	0x16, 0x51, 0xe6, 0xe6, 0xc4, 0x7c, 0xa0, 0xa8, 0xf9, 0xc8, 0xf2, 0x10, 0xc3, 0xd6, 0xd1, 0xa6,
+	0x87, 0x39, 0xda, 0xb4, 0x7c, 0x4a, 0x14, 0xf5, 0xee, 0xe5, 0x93, 0x46, 0x6c, 0x12, 0xfb, 0x2e,
+	0xf1, 0x0f, 0x65, 0xea, 0xed, 0xcb, 0x53, 0xc7, 0x28, 0x41, 0x91, 0x2c, 0xb9, 0xf6, 0x6b, 0x09,
+	0x94, 0xf6, 0x32, 0x04, 0x56, 0x41, 0x81, 0x04, 0x86, 0xd6, 0xd4, 0x5a, 0x8b, 0x4e, 0x81, 0x04,
+	0xf0, 0x2d, 0x50, 0xf1, 0x69, 0x1c, 0x63, 0x3f, 0x9b, 0xbb, 0x4b, 0x02, 0xa3, 0x20, 0x20, 0xfd,
+	0x3c, 0xd8, 0x0d, 0xe0, 0x0a, 0x58, 0x10, 0xe2, 0x19, 0x5e, 0x14, 0xf8, 0xbc, 0x18, 0x77, 0x03,
+	0xf8, 0x26, 0x00, 0xa2, 0xa4, 0xcb, 0x27, 0x63, 0x6c, 0xcc, 0x0a, 0x70, 0x51, 0x44, 0x06, 0x93,
+	0x31, 0x86, 0xb7, 0x80, 0x9e, 0xe0, 0xc3, 0x14, 0x33, 0xee, 0x06, 0x88, 0x23, 0xa3, 0xd4, 0xd4,
+	0x5a, 0xba, 0x53, 0x96, 0xb1, 0x7b, 0x88, 0x23, 0x78, 0x07, 0x2c, 0xf9, 0x28, 0x0c, 0x3d, 0xe4,
+	0x1f, 0xb8, 0x11, 0x0d, 0xd2, 0x10, 0x1b, 0x15, 0x21, 0x53, 0x55, 0xe1, 0xaf, 0x44, 0x14, 0x36,
+	0x40, 0xf9, 0x2c, 0x91, 0x04, 0xc6, 0x82, 0x48, 0x02, 0x2a, 0xd4, 0xcd, 0x7b, 0x51, 0x09, 0xa2,
+	0x9a, 0x2e, 0xaa, 0xe9, 0x2a, 0x28, 0xca, 0xed, 0x82, 0x2a, 0x27, 0x11, 0xa6, 0x29, 0x77, 0xc7,
+	0x34, 0x24, 0xfe, 0xc4, 0x58, 0x6a, 0x6a, 0xad, 0xea, 0x56, 0xcb, 0xbc, 0xd4, 0x02, 0xe6, 0x20,
+	0x27, 0xf4, 0x44, 0xbe, 0x53, 0xe1, 0x17, 0x87, 0xf0, 0x01, 0xa8, 0x29, 0x41, 0xe5, 0x01, 0xa3,
+	0xda, 0xd4, 0x5a, 0xe5, 0xad, 0x15, 0x33, 0x37, 0x89, 0xa9, 0x4c, 0x62, 0xde, 0x93, 0x09, 0xed,
+	0x85, 0x27, 0xc7, 0x8d, 0x99, 0x1f, 0x9f, 0x35, 0x34, 0x67, 0x49, 0x92, 0x15, 0x04, 0xdf, 0x01,
+	0xcb, 0x4a, 0x2f, 0xfb, 0x65, 0x1c, 0x45, 0x63, 0x63, 0xb1, 0xa9, 0xb5, 0x66, 0x1d, 0x55, 0x68,
+	0xa0, 0xe2, 0x17, 0xd7, 0x97, 0xe1, 0x98, 0x1b, 0xe5, 0xa6, 0xd6, 0x5a, 0x38, 0x5b, 0xdf, 0x3e,
+	0x8e, 0x79, 0xa6, 0xc7, 0x52, 0x2f, 0x22, 0x8c, 0x65, 0x3b, 0x3c, 0xc2, 0x64, 0x38, 0xe2, 0x46,
+	0x2d, 0xd7, 0x3b, 0x07, 0xbe, 0x10, 0x71, 0x18, 0x82, 0x72, 0x82, 0x43, 0x34, 0xc1, 0x89, 0xfb,
+	0x18, 0x63, 0x63, 0xb9, 0x59, 0x14, 0x7d, 0x48, 0xff, 0x66, 0x8e, 0x35, 0xa5, 0x63, 0xcd, 0x0e,
+	0x25, 0x71, 0xfb, 0xbd, 0xac, 0x8f, 0x9f, 0x9f, 0x35, 0x5a, 0x43, 0xc2, 0x47, 0xa9, 0x67, 0xfa,
+	0x34, 0x92, 0x66, 0x97, 0x3f, 0x1b, 0x2c, 0x38, 0xb0, 0x32, 0x6f, 0x30, 0x41, 0x60, 0x0e, 0x90,
+	0xfa, 0x9f, 0x61, 0x0c, 0x3f, 0x04, 0xc6, 0xd9, 0x86, 0xd1, 0xd8, 0xc5, 0xd1, 0x98, 0x4f, 0xdc,
+	0x04, 0xb3, 0x34, 0xe4, 0x06, 0x14, 0x9d, 0xdc, 0x54, 0xf8, 0x6e, 0x6c, 0x67, 0xa8, 0x23, 0xc0,
+	0xb5, 0x9f, 0x4a, 0x00, 0xb4, 0x11, 0xf7, 0x47, 0xaf, 0xda, 0xd4, 0x07, 0x78, 0xc2, 0x8c, 0x52,
+	0xb3, 0x78, 0xc1, 0xd4, 0xf7, 0xf1, 0x84, 0xfd, 0x97, 0xa9, 0xe7, 0xa6, 0x31, 0xf5, 0xfc, 0xcb,
+	0x4d, 0xbd, 0x30, 0x95, 0xa9, 0x17, 0xaf, 0xdf, 0xd4, 0xe0, 0xba, 0x4d, 0x5d, 0x9e, 0xd2, 0xd4,
+	0xfa, 0x94, 0xa6, 0xae, 0x4c, 0x67, 0xea, 0xea, 0xff, 0x6a, 0xea, 0xb5, 0xef, 0x0b, 0x60, 0x31,
+	0xdb, 0x94, 0x1e, 0x25, 0x31, 0x7f, 0xc1, 0x9a, 0x08, 0x54, 0x12, 0x1c, 0x51, 0x8e, 0xd5, 0xa4,
+	0x85, 0x35, 0xdb, 0x1f, 0x67, 0x25, 0xff, 0x38, 0x6e, 0xdc, 0x9e, 0xa2, 0x64, 0x37, 0xe6, 0xbf,
+	0xfd, 0xb2, 0x01, 0xe4, 0xf4, 0xbb, 0x31, 0x77, 0xf4, 0x5c, 0x52, 0xb6, 0xeb, 0x02, 0x3d, 0xa4,
+	0x3e, 0x0a, 0x55, 0x85, 0xe2, 0x35, 0x54, 0x28, 0x0b, 0x45, 0x59, 0x60, 0x1d, 0x94, 0x8e, 0x50,
+	0x98, 0xe6, 0x27, 0x43, 0x6f, 0xdf, 0xf8, 0xeb, 0xb8, 0x51, 0xcb, 0x4f, 0xed, 0xbb, 0x34, 0x22,
+	0x5c, 0x1c, 0x63, 0x27, 0x4f, 0x59, 0xfb, 0xbb, 0x08, 0xf4, 0xcf, 0xf3, 0xa7, 0xb6, 0xcf, 0x11,
+	0xc7, 0xf0, 0x53, 0x30, 0x9f, 0x59, 0x90, 0x60, 0x66, 0x68, 0x62, 0x23, 0x9a, 0x57, 0x78, 0x54,
+	0x1c, 0xef, 0xf6, 0x6c, 0x36, 0x75, 0x47, 0xd1, 0xe0, 0xb7, 0x00, 0x9e, 0xbd, 0x83, 0xae, 0x3f,
+	0x42, 0x71, 0x8c, 0x43, 0x66, 0x14, 0x84, 0xd8, 0xfa, 0x15, 0x62, 0xdb, 0x19, 0xa9, 0xdb, 0xd9,
+	0xeb, 0xe4, 0x14, 0x29, 0x5b, 0x13, 0x5a, 0x5d, 0xff, 0x50, 0x86, 0x19, 0x7c, 0x04, 0x96, 0xcf,
+	0xf5, 0xc7, 0xc8, 0x3f, 0xc0, 0x9c, 0x19, 0x45, 0x21, 0x7f, 0x77, 0x0a, 0xf9, 0x9e, 0x60, 0x48,
+	0xf5, 0x25, 0xa5, 0x9e, 0x47, 0x19, 0xec, 0x81, 0x8a, 0x97, 0x5d, 0x5c, 0xae, 0x5a, 0x84, 0x59,
+	0x21, 0xfc, 0xf6, 0x15, 0xc2, 0xe7, 0x17, 0x9d, 0x14, 0xd5, 0x3d, 0x15, 0xc9, 0x96, 0xe3, 0x13,
+	0x30, 0x97, 0xbf, 0xf5, 0xe2, 0x71, 0x2d, 0x6f, 0xdd, 0xba, 0x42, 0xaa, 0x27, 0x12, 0xa5, 0x8c,
+	0xa4, 0xc1, 0x47, 0xa0, 0xa6, 0x8e, 0x07, 0x46, 0x49, 0x4c, 0xe2, 0x21, 0x33, 0xe6, 0x5e, 0xba,
+	0x9a, 0x4e, 0x4e, 0xb1, 0x25, 0x43, 0xf5, 0x9b, 0x3c, 0x1f, 0x5e, 0x77, 0x41, 0xe5, 0xb9, 0x8b,
+	0x06, 0xae, 0x80, 0x9b, 0x8e, 0xfd, 0xa5, 0xdd, 0x19, 0xb8, 0x7b, 0xfb, 0xb6, 0xf3, 0xd0, 0x75,
+	0xec, 0x7e, 0x6f, 0xf7, 0x41, 0xdf, 0xae, 0xcd, 0xc0, 0x37, 0xc0, 0x6b, 0x8e, 0x3d, 0x70, 0x1e,
+	0x9e, 0x21, 0x7b, 0xfb, 0x76, 0x7f, 0x50, 0xd3, 0xe0, 0x2a, 0x78, 0xdd, 0xfe, 0xda, 0xee, 0xec,
+	0x0f, 0x6c, 0x09, 0x75, 0xb6, 0x77, 0x76, 0xda, 0xdb, 0x9d, 0xfb, 0xb5, 0x42, 0xbb, 0xff, 0xe4,
+	0xa4, 0xae, 0x3d, 0x3d, 0xa9, 0x6b, 0x7f, 0x9e, 0xd4, 0xb5, 0x1f, 0x4e, 0xeb, 0x33, 0x4f, 0x4f,
+	0xeb, 0x33, 0xbf, 0x9f, 0xd6, 0x67, 0xbe, 0xf9, 0xe8, 0x82, 0xd3, 0xfb, 0xa2, 0x8f, 0x8d, 0x1d,
+	0xe4, 0x31, 0x4b, 0x7e, 0x33, 0x1d, 0x6d, 0x7d, 0x60, 0x7d, 0xf7, 0xc2, 0x97, 0x93, 0x38, 0x00,
+	0xde, 0x9c, 0xb8, 0xdc, 0xde, 0xff, 0x67, 0x00, 0x42, 0xd5, 0xe0, 0xb3, 0x40, 0x0a, 0x00, 0x00,
 }
 
 func (m *Query) Marshal() (dAtA []byte, err error) {
@@ -376,6 +609,34 @@ func (m *Query) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.CallbackOnEmptyResult {
+		i--
+		if m.CallbackOnEmptyResult {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x90
+	}
+	if len(m.RelayerFee) > 0 {
+		for iNdEx := len(m.RelayerFee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RelayerFee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x8a
+		}
+	}
 	if m.SubmissionHeight != 0 {
 		i = encodeVarintGenesis(dAtA, i, uint64(m.SubmissionHeight))
 		i--
@@ -470,7 +731,7 @@ func (m *Query) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *DataPoint) Marshal() (dAtA []byte, err error) {
+func (m *BatchQuery) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -480,43 +741,171 @@ func (m *DataPoint) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *DataPoint) MarshalTo(dAtA []byte) (int, error) {
+func (m *BatchQuery) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *DataPoint) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *BatchQuery) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Value) > 0 {
-		i -= len(m.Value)
-		copy(dAtA[i:], m.Value)
-		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Value)))
+	if len(m.RelayerFee) > 0 {
+		for iNdEx := len(m.RelayerFee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RelayerFee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x72
+		}
+	}
+	if m.SubmissionHeight != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.SubmissionHeight))
 		i--
-		dAtA[i] = 0x22
+		dAtA[i] = 0x68
 	}
-	{
-		size := m.LocalHeight.Size()
-		i -= size
-		if _, err := m.LocalHeight.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+	if m.RequestSent {
+		i--
+		if m.RequestSent {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
-		i = encodeVarintGenesis(dAtA, i, uint64(size))
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.TimeoutTimestamp != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.TimeoutTimestamp))
+		i--
+		dAtA[i] = 0x58
 	}
+	n2, err2 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.TimeoutDuration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.TimeoutDuration):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintGenesis(dAtA, i, uint64(n2))
 	i--
-	dAtA[i] = 0x1a
-	{
-		size := m.RemoteHeight.Size()
-		i -= size
-		if _, err := m.RemoteHeight.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+	dAtA[i] = 0x52
+	if m.TimeoutPolicy != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.TimeoutPolicy))
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.CallbackData) > 0 {
+		i -= len(m.CallbackData)
+		copy(dAtA[i:], m.CallbackData)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.CallbackData)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.CallbackId) > 0 {
+		i -= len(m.CallbackId)
+		copy(dAtA[i:], m.CallbackId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.CallbackId)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.CallbackModule) > 0 {
+		i -= len(m.CallbackModule)
+		copy(dAtA[i:], m.CallbackModule)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.CallbackModule)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.RequestKeys) > 0 {
+		for iNdEx := len(m.RequestKeys) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.RequestKeys[iNdEx])
+			copy(dAtA[i:], m.RequestKeys[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.RequestKeys[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
 		}
-		i = encodeVarintGenesis(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0x12
+	if len(m.QueryType) > 0 {
+		i -= len(m.QueryType)
+		copy(dAtA[i:], m.QueryType)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.QueryType)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ConnectionId) > 0 {
+		i -= len(m.ConnectionId)
+		copy(dAtA[i:], m.ConnectionId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ConnectionId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DataPoint) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DataPoint) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DataPoint) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0x22
+	}
+	{
+		size := m.LocalHeight.Size()
+		i -= size
+		if _, err := m.LocalHeight.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.RemoteHeight.Size()
+		i -= size
+		if _, err := m.RemoteHeight.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
 	if len(m.Id) > 0 {
 		i -= len(m.Id)
 		copy(dAtA[i:], m.Id)
@@ -547,6 +936,72 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.RelayerEarnings) > 0 {
+		for iNdEx := len(m.RelayerEarnings) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RelayerEarnings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if len(m.BatchQueries) > 0 {
+		for iNdEx := len(m.BatchQueries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BatchQueries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.AsyncIcqPackets) > 0 {
+		for iNdEx := len(m.AsyncIcqPackets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AsyncIcqPackets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.AsyncIcqChannels) > 0 {
+		for iNdEx := len(m.AsyncIcqChannels) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AsyncIcqChannels[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
 	if len(m.Queries) > 0 {
 		for iNdEx := len(m.Queries) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -627,6 +1082,78 @@ func (m *Query) Size() (n int) {
 	if m.SubmissionHeight != 0 {
 		n += 2 + sovGenesis(uint64(m.SubmissionHeight))
 	}
+	if len(m.RelayerFee) > 0 {
+		for _, e := range m.RelayerFee {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.CallbackOnEmptyResult {
+		n += 3
+	}
+	return n
+}
+
+func (m *BatchQuery) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.ConnectionId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.QueryType)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.RequestKeys) > 0 {
+		for _, b := range m.RequestKeys {
+			l = len(b)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = len(m.CallbackModule)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.CallbackId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.CallbackData)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.TimeoutPolicy != 0 {
+		n += 1 + sovGenesis(uint64(m.TimeoutPolicy))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.TimeoutDuration)
+	n += 1 + l + sovGenesis(uint64(l))
+	if m.TimeoutTimestamp != 0 {
+		n += 1 + sovGenesis(uint64(m.TimeoutTimestamp))
+	}
+	if m.RequestSent {
+		n += 2
+	}
+	if m.SubmissionHeight != 0 {
+		n += 1 + sovGenesis(uint64(m.SubmissionHeight))
+	}
+	if len(m.RelayerFee) > 0 {
+		for _, e := range m.RelayerFee {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -663,6 +1190,32 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.AsyncIcqChannels) > 0 {
+		for _, e := range m.AsyncIcqChannels {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.AsyncIcqPackets) > 0 {
+		for _, e := range m.AsyncIcqPackets {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.BatchQueries) > 0 {
+		for _, e := range m.BatchQueries {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.RelayerEarnings) > 0 {
+		for _, e := range m.RelayerEarnings {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -933,8 +1486,473 @@ func (m *Query) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.RequestSent = bool(v != 0)
-		case 12:
+			m.RequestSent = bool(v != 0)
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CallbackData", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CallbackData = append(m.CallbackData[:0], dAtA[iNdEx:postIndex]...)
+			if m.CallbackData == nil {
+				m.CallbackData = []byte{}
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CallbackModule", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CallbackModule = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutDuration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.TimeoutDuration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutPolicy", wireType)
+			}
+			m.TimeoutPolicy = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TimeoutPolicy |= TimeoutPolicy(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubmissionHeight", wireType)
+			}
+			m.SubmissionHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SubmissionHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RelayerFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RelayerFee = append(m.RelayerFee, types1.Coin{})
+			if err := m.RelayerFee[len(m.RelayerFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 18:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CallbackOnEmptyResult", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.CallbackOnEmptyResult = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BatchQuery) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BatchQuery: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BatchQuery: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConnectionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueryType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.QueryType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestKeys", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RequestKeys = append(m.RequestKeys, make([]byte, postIndex-iNdEx))
+			copy(m.RequestKeys[len(m.RequestKeys)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CallbackModule", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CallbackModule = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CallbackId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CallbackId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field CallbackData", wireType)
 			}
@@ -968,11 +1986,11 @@ func (m *Query) Unmarshal(dAtA []byte) error {
 				m.CallbackData = []byte{}
 			}
 			iNdEx = postIndex
-		case 13:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CallbackModule", wireType)
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutPolicy", wireType)
 			}
-			var stringLen uint64
+			m.TimeoutPolicy = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -982,25 +2000,12 @@ func (m *Query) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.TimeoutPolicy |= TimeoutPolicy(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.CallbackModule = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 14:
+		case 10:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutDuration", wireType)
 			}
@@ -1033,11 +2038,11 @@ func (m *Query) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 15:
+		case 11:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutPolicy", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutTimestamp", wireType)
 			}
-			m.TimeoutPolicy = 0
+			m.TimeoutTimestamp = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1047,12 +2052,32 @@ func (m *Query) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TimeoutPolicy |= TimeoutPolicy(b&0x7F) << shift
+				m.TimeoutTimestamp |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 16:
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestSent", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RequestSent = bool(v != 0)
+		case 13:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field SubmissionHeight", wireType)
 			}
@@ -1071,6 +2096,40 @@ func (m *Query) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RelayerFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RelayerFee = append(m.RelayerFee, types1.Coin{})
+			if err := m.RelayerFee[len(m.RelayerFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -1339,6 +2398,175 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AsyncIcqChannels", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AsyncIcqChannels = append(m.AsyncIcqChannels, AsyncICQChannel{})
+			if err := m.AsyncIcqChannels[len(m.AsyncIcqChannels)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AsyncIcqPackets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AsyncIcqPackets = append(m.AsyncIcqPackets, AsyncICQPacket{})
+			if err := m.AsyncIcqPackets[len(m.AsyncIcqPackets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchQueries", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BatchQueries = append(m.BatchQueries, BatchQuery{})
+			if err := m.BatchQueries[len(m.BatchQueries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RelayerEarnings", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RelayerEarnings = append(m.RelayerEarnings, RelayerEarnings{})
+			if err := m.RelayerEarnings[len(m.RelayerEarnings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -1,6 +1,11 @@
 package types
 
-import fmt "fmt"
+import (
+	fmt "fmt"
+	time "time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
 
 const (
 	// ModuleName defines the module name
@@ -14,13 +19,41 @@ const (
 
 	// QuerierRoute defines the module's query routing key
 	QuerierRoute = ModuleName
+
+	// PortID is the port bound by this module to send ICS-31 async-icq packets
+	PortID = "icqcontroller"
+	// Version defines the ICS-31 async-icq channel version
+	Version = "icq-1"
+	// HostPortID is the port of the async-icq host module on the counterparty chain
+	HostPortID = "icqhost"
+
+	// RelayerFeePoolName is the module account that funds relayer fees for query responses
+	// Fees are escrowed in the module account while the query is pending
+	RelayerFeePoolName = "interchainquery_relayer_fee_pool"
 )
 
 // prefix bytes for the interchainquery persistent store
 const (
-	prefixData         = iota + 1
-	prefixQuery        = iota + 1
-	prefixQueryCounter = iota + 1
+	prefixData            = iota + 1
+	prefixQuery           = iota + 1
+	prefixQueryCounter    = iota + 1
+	prefixAsyncICQChannel = iota + 1
+	prefixAsyncICQPacket  = iota + 1
+	prefixBatchQuery      = iota + 1
+	prefixQueryRecord     = iota + 1
+	prefixQueryRecordSeq  = iota + 1
+	prefixParams          = iota + 1
+	prefixRelayerEarnings = iota + 1
+)
+
+const (
+	// The number of completed query records retained in the store
+	// Once exceeded, the oldest record is pruned each time a new record is added
+	MaxQueryHistory = 1000
+
+	// Queries that were sent but never answered are removed from the store
+	// once this much time has passed since their timeout
+	StaleQueryExpiration = 24 * time.Hour
 )
 
 // keys for proof queries to various stores, note: there's an implicit assumption here that
@@ -47,12 +80,28 @@ var (
 	KeyPrefixData   = []byte{prefixData}
 	KeyPrefixQuery  = []byte{prefixQuery}
 	KeyQueryCounter = []byte{prefixQueryCounter}
+
+	KeyPrefixAsyncICQChannel = []byte{prefixAsyncICQChannel}
+	KeyPrefixAsyncICQPacket  = []byte{prefixAsyncICQPacket}
+
+	KeyPrefixBatchQuery = []byte{prefixBatchQuery}
+
+	KeyPrefixQueryRecord   = []byte{prefixQueryRecord}
+	KeyQueryRecordSequence = []byte{prefixQueryRecordSeq}
+
+	KeyParams                = []byte{prefixParams}
+	KeyPrefixRelayerEarnings = []byte{prefixRelayerEarnings}
 )
 
 func KeyPrefix(p string) []byte {
 	return []byte(p)
 }
 
+// Builds the store key for an in-flight async-icq packet from the channel and sequence
+func AsyncICQPacketKey(channelId string, sequence uint64) []byte {
+	return append([]byte(channelId+"|"), sdk.Uint64ToBigEndian(sequence)...)
+}
+
 func FormatOsmosisMostRecentTWAPKey(poolId uint64, denom1, denom2 string) []byte {
 	// Sort denoms
 	if denom1 > denom2 {
@@ -8,6 +8,8 @@ import (
 	fmt "fmt"
 	crypto "github.com/cometbft/cometbft/proto/tendermint/crypto"
 	_ "github.com/cosmos/cosmos-proto"
+	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -112,9 +114,350 @@ func (m *MsgSubmitQueryResponseResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgSubmitQueryResponseResponse proto.InternalMessageInfo
 
+// The result and proof for a single key in a batch query
+type BatchQueryResult struct {
+	Key      []byte           `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Value    []byte           `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	ProofOps *crypto.ProofOps `protobuf:"bytes,3,opt,name=proof_ops,json=proofOps,proto3" json:"proof_ops,omitempty"`
+}
+
+func (m *BatchQueryResult) Reset()         { *m = BatchQueryResult{} }
+func (m *BatchQueryResult) String() string { return proto.CompactTextString(m) }
+func (*BatchQueryResult) ProtoMessage()    {}
+func (*BatchQueryResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_25adad4f8ed32400, []int{2}
+}
+func (m *BatchQueryResult) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BatchQueryResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BatchQueryResult.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BatchQueryResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BatchQueryResult.Merge(m, src)
+}
+func (m *BatchQueryResult) XXX_Size() int {
+	return m.Size()
+}
+func (m *BatchQueryResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_BatchQueryResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BatchQueryResult proto.InternalMessageInfo
+
+func (m *BatchQueryResult) GetKey() []byte {
+	if m != nil {
+		return m.Key
+	}
+	return nil
+}
+
+func (m *BatchQueryResult) GetValue() []byte {
+	if m != nil {
+		return m.Value
+	}
+	return nil
+}
+
+func (m *BatchQueryResult) GetProofOps() *crypto.ProofOps {
+	if m != nil {
+		return m.ProofOps
+	}
+	return nil
+}
+
+// MsgSubmitBatchQueryResponse represents a message type to fulfil a batch
+// query request. Every key in the batch must be answered at the same height
+type MsgSubmitBatchQueryResponse struct {
+	ChainId     string             `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	QueryId     string             `protobuf:"bytes,2,opt,name=query_id,json=queryId,proto3" json:"query_id,omitempty"`
+	Results     []BatchQueryResult `protobuf:"bytes,3,rep,name=results,proto3" json:"results"`
+	Height      int64              `protobuf:"varint,4,opt,name=height,proto3" json:"height,omitempty"`
+	FromAddress string             `protobuf:"bytes,5,opt,name=from_address,json=fromAddress,proto3" json:"from_address,omitempty"`
+}
+
+func (m *MsgSubmitBatchQueryResponse) Reset()         { *m = MsgSubmitBatchQueryResponse{} }
+func (m *MsgSubmitBatchQueryResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSubmitBatchQueryResponse) ProtoMessage()    {}
+func (*MsgSubmitBatchQueryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_25adad4f8ed32400, []int{3}
+}
+func (m *MsgSubmitBatchQueryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSubmitBatchQueryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSubmitBatchQueryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSubmitBatchQueryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSubmitBatchQueryResponse.Merge(m, src)
+}
+func (m *MsgSubmitBatchQueryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSubmitBatchQueryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSubmitBatchQueryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSubmitBatchQueryResponse proto.InternalMessageInfo
+
+// MsgSubmitBatchQueryResponseResponse defines the MsgSubmitBatchQueryResponse
+// response type.
+type MsgSubmitBatchQueryResponseResponse struct {
+}
+
+func (m *MsgSubmitBatchQueryResponseResponse) Reset()         { *m = MsgSubmitBatchQueryResponseResponse{} }
+func (m *MsgSubmitBatchQueryResponseResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSubmitBatchQueryResponseResponse) ProtoMessage()    {}
+func (*MsgSubmitBatchQueryResponseResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_25adad4f8ed32400, []int{4}
+}
+func (m *MsgSubmitBatchQueryResponseResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSubmitBatchQueryResponseResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSubmitBatchQueryResponseResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSubmitBatchQueryResponseResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSubmitBatchQueryResponseResponse.Merge(m, src)
+}
+func (m *MsgSubmitBatchQueryResponseResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSubmitBatchQueryResponseResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSubmitBatchQueryResponseResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSubmitBatchQueryResponseResponse proto.InternalMessageInfo
+
+// Routes queries for the given connection over an async-icq channel
+// If the channel ID is empty, queries on the connection will fall back to
+// being served by an off-chain ICQ relayer
+type MsgSetAsyncICQChannel struct {
+	// authority is the address that controls the module (defaults to x/gov unless
+	// overwritten).
+	Authority    string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	ConnectionId string `protobuf:"bytes,2,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty"`
+	ChannelId    string `protobuf:"bytes,3,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+}
+
+func (m *MsgSetAsyncICQChannel) Reset()         { *m = MsgSetAsyncICQChannel{} }
+func (m *MsgSetAsyncICQChannel) String() string { return proto.CompactTextString(m) }
+func (*MsgSetAsyncICQChannel) ProtoMessage()    {}
+func (*MsgSetAsyncICQChannel) Descriptor() ([]byte, []int) {
+	return fileDescriptor_25adad4f8ed32400, []int{5}
+}
+func (m *MsgSetAsyncICQChannel) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetAsyncICQChannel) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetAsyncICQChannel.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetAsyncICQChannel) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetAsyncICQChannel.Merge(m, src)
+}
+func (m *MsgSetAsyncICQChannel) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetAsyncICQChannel) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetAsyncICQChannel.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetAsyncICQChannel proto.InternalMessageInfo
+
+func (m *MsgSetAsyncICQChannel) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgSetAsyncICQChannel) GetConnectionId() string {
+	if m != nil {
+		return m.ConnectionId
+	}
+	return ""
+}
+
+func (m *MsgSetAsyncICQChannel) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+type MsgSetAsyncICQChannelResponse struct {
+}
+
+func (m *MsgSetAsyncICQChannelResponse) Reset()         { *m = MsgSetAsyncICQChannelResponse{} }
+func (m *MsgSetAsyncICQChannelResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetAsyncICQChannelResponse) ProtoMessage()    {}
+func (*MsgSetAsyncICQChannelResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_25adad4f8ed32400, []int{6}
+}
+func (m *MsgSetAsyncICQChannelResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetAsyncICQChannelResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetAsyncICQChannelResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetAsyncICQChannelResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetAsyncICQChannelResponse.Merge(m, src)
+}
+func (m *MsgSetAsyncICQChannelResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetAsyncICQChannelResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetAsyncICQChannelResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetAsyncICQChannelResponse proto.InternalMessageInfo
+
+// Updates the module parameters
+type MsgUpdateParams struct {
+	// authority is the address that controls the module (defaults to x/gov unless
+	// overwritten).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	Params    Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
+}
+
+func (m *MsgUpdateParams) Reset()         { *m = MsgUpdateParams{} }
+func (m *MsgUpdateParams) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateParams) ProtoMessage()    {}
+func (*MsgUpdateParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_25adad4f8ed32400, []int{7}
+}
+func (m *MsgUpdateParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateParams.Merge(m, src)
+}
+func (m *MsgUpdateParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateParams proto.InternalMessageInfo
+
+func (m *MsgUpdateParams) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgUpdateParams) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+type MsgUpdateParamsResponse struct {
+}
+
+func (m *MsgUpdateParamsResponse) Reset()         { *m = MsgUpdateParamsResponse{} }
+func (m *MsgUpdateParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateParamsResponse) ProtoMessage()    {}
+func (*MsgUpdateParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_25adad4f8ed32400, []int{8}
+}
+func (m *MsgUpdateParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateParamsResponse.Merge(m, src)
+}
+func (m *MsgUpdateParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgSubmitQueryResponse)(nil), "stride.interchainquery.v1.MsgSubmitQueryResponse")
 	proto.RegisterType((*MsgSubmitQueryResponseResponse)(nil), "stride.interchainquery.v1.MsgSubmitQueryResponseResponse")
+	proto.RegisterType((*BatchQueryResult)(nil), "stride.interchainquery.v1.BatchQueryResult")
+	proto.RegisterType((*MsgSubmitBatchQueryResponse)(nil), "stride.interchainquery.v1.MsgSubmitBatchQueryResponse")
+	proto.RegisterType((*MsgSubmitBatchQueryResponseResponse)(nil), "stride.interchainquery.v1.MsgSubmitBatchQueryResponseResponse")
+	proto.RegisterType((*MsgSetAsyncICQChannel)(nil), "stride.interchainquery.v1.MsgSetAsyncICQChannel")
+	proto.RegisterType((*MsgSetAsyncICQChannelResponse)(nil), "stride.interchainquery.v1.MsgSetAsyncICQChannelResponse")
+	proto.RegisterType((*MsgUpdateParams)(nil), "stride.interchainquery.v1.MsgUpdateParams")
+	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "stride.interchainquery.v1.MsgUpdateParamsResponse")
 }
 
 func init() {
@@ -122,39 +465,62 @@ func init() {
 }
 
 var fileDescriptor_25adad4f8ed32400 = []byte{
-	// 512 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x93, 0x3f, 0x8f, 0xd3, 0x3e,
-	0x18, 0xc7, 0xeb, 0xf6, 0xf7, 0xeb, 0xdd, 0xe5, 0x8a, 0x80, 0x5c, 0x85, 0x72, 0x05, 0x92, 0xca,
-	0x0b, 0x05, 0x71, 0xb6, 0x5a, 0x58, 0xae, 0x4c, 0x74, 0x3b, 0x89, 0xe3, 0x4f, 0xba, 0xb1, 0x54,
-	0x49, 0xe3, 0x73, 0x2d, 0x35, 0x71, 0xb0, 0xdd, 0xea, 0xba, 0x32, 0x31, 0x22, 0xb1, 0x30, 0xf6,
-	0x45, 0x20, 0x31, 0xb2, 0x32, 0x9e, 0x60, 0x61, 0xaa, 0x50, 0xcb, 0x00, 0x6b, 0x5f, 0x01, 0x8a,
-	0x9d, 0x00, 0xba, 0x2b, 0x03, 0x93, 0x9f, 0x3c, 0xdf, 0xcf, 0xf3, 0x2f, 0x7e, 0x6c, 0xb5, 0xa4,
-	0x12, 0x2c, 0x22, 0x98, 0x25, 0x8a, 0x88, 0xe1, 0x28, 0x60, 0xc9, 0x8b, 0x09, 0x11, 0x33, 0x3c,
-	0x6d, 0xe3, 0x98, 0x48, 0x19, 0x50, 0x22, 0x51, 0x2a, 0xb8, 0xe2, 0xf6, 0xbe, 0x21, 0xd1, 0x39,
-	0x12, 0x4d, 0xdb, 0x8d, 0x3a, 0xe5, 0x94, 0x6b, 0x0a, 0x67, 0x96, 0x09, 0x68, 0xec, 0x0f, 0xb9,
-	0x8c, 0xb9, 0x1c, 0x18, 0xc1, 0x7c, 0xe4, 0xd2, 0x0d, 0xca, 0x39, 0x1d, 0x13, 0x1c, 0xa4, 0x0c,
-	0x07, 0x49, 0xc2, 0x55, 0xa0, 0x18, 0x4f, 0x0a, 0xf5, 0xa6, 0x22, 0x49, 0x44, 0x44, 0xcc, 0x12,
-	0x85, 0x87, 0x62, 0x96, 0x2a, 0x8e, 0x53, 0xc1, 0xf9, 0x89, 0x91, 0xe1, 0x8f, 0xb2, 0x75, 0xed,
-	0x58, 0xd2, 0xfe, 0x24, 0x8c, 0x99, 0x7a, 0x96, 0xf5, 0xe0, 0x13, 0x99, 0xf2, 0x44, 0x12, 0x1b,
-	0x59, 0xdb, 0xba, 0xb3, 0x01, 0x8b, 0x1c, 0xd0, 0x04, 0xad, 0x9d, 0xde, 0xde, 0x7a, 0xe1, 0x5d,
-	0x9e, 0x05, 0xf1, 0xb8, 0x0b, 0x0b, 0x05, 0xfa, 0x5b, 0xda, 0x3c, 0x8a, 0x32, 0x5e, 0x0f, 0x91,
-	0xf1, 0xe5, 0xf3, 0x7c, 0xa1, 0x40, 0x7f, 0x4b, 0x9b, 0x47, 0x91, 0x7d, 0xdb, 0xaa, 0x0a, 0x22,
-	0x27, 0x63, 0xe5, 0x54, 0x9a, 0xa0, 0x55, 0xeb, 0x5d, 0x5d, 0x2f, 0xbc, 0x4b, 0x86, 0x36, 0x7e,
-	0xe8, 0xe7, 0x80, 0xfd, 0xd8, 0xda, 0xd1, 0x4d, 0x0f, 0x78, 0x2a, 0x9d, 0xff, 0x9a, 0xa0, 0xb5,
-	0xdb, 0xb9, 0x8e, 0x7e, 0x0f, 0x86, 0xcc, 0x60, 0xe8, 0x69, 0xc6, 0x3c, 0x49, 0x65, 0xaf, 0xbe,
-	0x5e, 0x78, 0x57, 0x4c, 0xaa, 0x5f, 0x71, 0xd0, 0xdf, 0x4e, 0x73, 0x3d, 0x2b, 0x3d, 0x22, 0x8c,
-	0x8e, 0x94, 0xf3, 0x7f, 0x13, 0xb4, 0x2a, 0x7f, 0x96, 0x36, 0x7e, 0xe8, 0xe7, 0x80, 0xfd, 0xc0,
-	0xaa, 0x9d, 0x08, 0x1e, 0x0f, 0x82, 0x28, 0x12, 0x44, 0x4a, 0xa7, 0xaa, 0x27, 0x73, 0x3e, 0xbd,
-	0x3b, 0xa8, 0xe7, 0xb7, 0xf0, 0xd0, 0x28, 0x7d, 0x25, 0x58, 0x42, 0xfd, 0xdd, 0x8c, 0xce, 0x5d,
-	0xdd, 0xda, 0xab, 0xb9, 0x57, 0x7a, 0x3b, 0xf7, 0xc0, 0xf7, 0xb9, 0x57, 0x82, 0x4d, 0xcb, 0xdd,
-	0xfc, 0xab, 0x8b, 0xb3, 0xf3, 0x01, 0x58, 0x95, 0x63, 0x49, 0xed, 0xf7, 0xc0, 0xda, 0xdb, 0x74,
-	0x25, 0x6d, 0xf4, 0xd7, 0xbd, 0x41, 0x9b, 0x53, 0x37, 0x0e, 0xff, 0x39, 0xa4, 0x38, 0x61, 0xe7,
-	0xe5, 0xe7, 0x6f, 0x6f, 0xca, 0x77, 0xbb, 0xe0, 0x0e, 0xbc, 0x75, 0x61, 0xa7, 0xd5, 0x29, 0x9e,
-	0xb6, 0x43, 0xa2, 0x82, 0x36, 0x96, 0x3a, 0x87, 0x76, 0xf7, 0xfa, 0x1f, 0x97, 0x2e, 0x38, 0x5b,
-	0xba, 0xe0, 0xeb, 0xd2, 0x05, 0xaf, 0x57, 0x6e, 0xe9, 0x6c, 0xe5, 0x96, 0xbe, 0xac, 0xdc, 0xd2,
-	0xf3, 0x43, 0xca, 0xd4, 0x68, 0x12, 0xa2, 0x21, 0x8f, 0x71, 0x5f, 0xb7, 0x74, 0xf0, 0x28, 0x08,
-	0x25, 0xce, 0xdf, 0xcc, 0xb4, 0x73, 0x1f, 0x9f, 0x5e, 0xac, 0x32, 0x4b, 0x89, 0x0c, 0xab, 0x7a,
-	0x57, 0xef, 0xfd, 0x0c, 0x00, 0x00, 0xff, 0xff, 0xfb, 0x90, 0x50, 0x23, 0x60, 0x03, 0x00, 0x00,
+	// 871 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x55, 0xcb, 0x6f, 0x1b, 0x45,
+	0x18, 0xf7, 0x64, 0xf3, 0x9c, 0xb8, 0x6a, 0xba, 0x0d, 0xed, 0xda, 0x25, 0x5e, 0xb3, 0x55, 0xc1,
+	0x04, 0xba, 0x8b, 0x17, 0x54, 0xa5, 0x46, 0x02, 0xd5, 0x3d, 0x59, 0x10, 0x68, 0x37, 0xe2, 0xc2,
+	0x25, 0x1a, 0xef, 0x4e, 0xd7, 0x2b, 0xbc, 0x33, 0xcb, 0xce, 0xd8, 0xaa, 0x6f, 0xa8, 0x27, 0xc4,
+	0x09, 0xc1, 0x85, 0x63, 0xfe, 0x84, 0x1e, 0x90, 0xb8, 0x71, 0xa5, 0x17, 0xa4, 0x0a, 0x2e, 0x9c,
+	0x22, 0x94, 0x20, 0x15, 0xae, 0xf9, 0x07, 0xa8, 0x76, 0x66, 0x1f, 0xa9, 0x5f, 0x69, 0x7a, 0xb1,
+	0x67, 0xbf, 0xef, 0xf7, 0xbd, 0x7e, 0xbf, 0x79, 0xc0, 0x06, 0xe3, 0x71, 0xe0, 0x61, 0x2b, 0x20,
+	0x1c, 0xc7, 0x6e, 0x0f, 0x05, 0xe4, 0xeb, 0x01, 0x8e, 0x47, 0xd6, 0xb0, 0x69, 0x85, 0x98, 0x31,
+	0xe4, 0x63, 0x66, 0x46, 0x31, 0xe5, 0x54, 0xad, 0x48, 0xa4, 0x39, 0x86, 0x34, 0x87, 0xcd, 0xea,
+	0xa6, 0x4f, 0x7d, 0x2a, 0x50, 0x56, 0xb2, 0x92, 0x01, 0xd5, 0x8a, 0x4b, 0x59, 0x48, 0xd9, 0xbe,
+	0x74, 0xc8, 0x8f, 0xd4, 0xf5, 0xba, 0x4f, 0xa9, 0xdf, 0xc7, 0x16, 0x8a, 0x02, 0x0b, 0x11, 0x42,
+	0x39, 0xe2, 0x01, 0x25, 0x99, 0x77, 0x8b, 0x63, 0xe2, 0xe1, 0x38, 0x0c, 0x08, 0xb7, 0xdc, 0x78,
+	0x14, 0x71, 0x6a, 0x45, 0x31, 0xa5, 0x0f, 0x52, 0xf7, 0x55, 0x99, 0xca, 0x0a, 0x99, 0x2f, 0xda,
+	0x64, 0x7e, 0xea, 0xb8, 0x84, 0xc2, 0x80, 0x50, 0x4b, 0xfc, 0xa6, 0xa6, 0x37, 0x67, 0x8f, 0x17,
+	0xa1, 0x18, 0x85, 0x69, 0x49, 0xe3, 0xbf, 0x05, 0x78, 0x65, 0x97, 0xf9, 0x7b, 0x83, 0x6e, 0x18,
+	0xf0, 0xfb, 0x09, 0xc4, 0xc1, 0x2c, 0xa2, 0x84, 0x61, 0xd5, 0x84, 0xab, 0x22, 0x70, 0x3f, 0xf0,
+	0x34, 0x50, 0x07, 0x8d, 0xb5, 0xf6, 0xe5, 0x93, 0x43, 0xfd, 0xe2, 0x08, 0x85, 0xfd, 0x96, 0x91,
+	0x79, 0x0c, 0x67, 0x45, 0x2c, 0x3b, 0x5e, 0x82, 0x17, 0x35, 0x12, 0xfc, 0xc2, 0x38, 0x3e, 0xf3,
+	0x18, 0xce, 0x8a, 0x58, 0x76, 0x3c, 0xf5, 0x6d, 0xb8, 0x1c, 0x63, 0x36, 0xe8, 0x73, 0x4d, 0xa9,
+	0x83, 0x46, 0xb9, 0x7d, 0xe9, 0xe4, 0x50, 0xbf, 0x20, 0xd1, 0xd2, 0x6e, 0x38, 0x29, 0x40, 0xfd,
+	0x0c, 0xae, 0x09, 0x22, 0xf6, 0x69, 0xc4, 0xb4, 0xc5, 0x3a, 0x68, 0xac, 0xdb, 0xd7, 0xcc, 0x82,
+	0x2c, 0x53, 0x92, 0x65, 0xde, 0x4b, 0x30, 0x9f, 0x47, 0xac, 0xbd, 0x79, 0x72, 0xa8, 0x6f, 0xc8,
+	0x54, 0x79, 0x9c, 0xe1, 0xac, 0x46, 0xa9, 0x3f, 0x29, 0xdd, 0xc3, 0x81, 0xdf, 0xe3, 0xda, 0x52,
+	0x1d, 0x34, 0x94, 0xd3, 0xa5, 0xa5, 0xdd, 0x70, 0x52, 0x80, 0xfa, 0x21, 0x2c, 0x3f, 0x88, 0x69,
+	0xb8, 0x8f, 0x3c, 0x2f, 0xc6, 0x8c, 0x69, 0xcb, 0x62, 0x32, 0xed, 0x8f, 0x9f, 0x6f, 0x6e, 0xa6,
+	0xca, 0xde, 0x91, 0x9e, 0x3d, 0x1e, 0x07, 0xc4, 0x77, 0xd6, 0x13, 0x74, 0x6a, 0x6a, 0x95, 0xbf,
+	0x3d, 0xd0, 0x4b, 0x3f, 0x1d, 0xe8, 0xe0, 0xdf, 0x03, 0xbd, 0x64, 0xd4, 0x61, 0x6d, 0x3a, 0xd5,
+	0xd9, 0xbf, 0xc1, 0xe1, 0x46, 0x1b, 0x71, 0xb7, 0x97, 0x79, 0x93, 0xd9, 0x37, 0xa0, 0xf2, 0x15,
+	0x1e, 0x09, 0x05, 0xca, 0x4e, 0xb2, 0x54, 0x37, 0xe1, 0xd2, 0x10, 0xf5, 0x07, 0x58, 0xb0, 0x5c,
+	0x76, 0xe4, 0x87, 0xba, 0x73, 0x9a, 0x23, 0xe5, 0x4c, 0x8e, 0x0a, 0x36, 0x8c, 0xff, 0x01, 0xbc,
+	0x96, 0x37, 0xf6, 0x42, 0x7d, 0xb9, 0x11, 0x2a, 0xe3, 0x1b, 0xa1, 0xd0, 0xbc, 0x32, 0xae, 0x79,
+	0x21, 0xef, 0x27, 0x70, 0x45, 0xaa, 0x97, 0x74, 0xa3, 0x34, 0xd6, 0xed, 0x77, 0xcc, 0x99, 0x07,
+	0xc9, 0x1c, 0x9f, 0xba, 0xbd, 0xf8, 0xe4, 0x50, 0x2f, 0x39, 0x59, 0x06, 0xf5, 0x4a, 0x2e, 0x58,
+	0xa2, 0xbe, 0x32, 0x53, 0x9d, 0xa5, 0xf3, 0xa8, 0xb3, 0x9a, 0xa8, 0x23, 0x94, 0xb9, 0x01, 0xaf,
+	0xcf, 0x21, 0x20, 0x97, 0xe7, 0x77, 0x00, 0x5f, 0x4b, 0x70, 0x98, 0xdf, 0x61, 0x23, 0xe2, 0x76,
+	0xee, 0xde, 0xbf, 0xdb, 0x43, 0x84, 0xe0, 0xbe, 0x7a, 0x0b, 0xae, 0xa1, 0x01, 0xef, 0xd1, 0x38,
+	0xe0, 0x23, 0x0d, 0x9c, 0xd1, 0x44, 0x01, 0x55, 0xaf, 0xc3, 0x0b, 0x2e, 0x25, 0x04, 0xbb, 0xc9,
+	0x35, 0x50, 0x90, 0x58, 0x2e, 0x8c, 0x1d, 0x4f, 0xdd, 0x82, 0xd0, 0x95, 0x75, 0x12, 0x84, 0x22,
+	0x10, 0x6b, 0xa9, 0xa5, 0xe3, 0xb5, 0x76, 0x1e, 0x3d, 0x7b, 0xbc, 0x5d, 0xe4, 0xfc, 0xee, 0xd9,
+	0xe3, 0xed, 0x1b, 0xe3, 0xc7, 0x7e, 0x6a, 0xd7, 0x86, 0x0e, 0xb7, 0xa6, 0x3a, 0xf2, 0x81, 0x7f,
+	0x05, 0xf0, 0xe2, 0x2e, 0xf3, 0xbf, 0x88, 0x3c, 0xc4, 0xf1, 0x3d, 0x71, 0x6f, 0xbc, 0xf2, 0xa8,
+	0x1f, 0xc3, 0x65, 0x79, 0xf3, 0x88, 0x19, 0xd7, 0xed, 0x37, 0xe6, 0x6c, 0x07, 0x59, 0x2a, 0xdd,
+	0x04, 0x69, 0x58, 0xcb, 0x9e, 0x9c, 0x53, 0x9f, 0x32, 0xe7, 0xe9, 0x66, 0x8d, 0x0a, 0xbc, 0x3a,
+	0x66, 0xca, 0x66, 0xb3, 0x7f, 0x5b, 0x84, 0xca, 0x2e, 0xf3, 0xd5, 0x5f, 0x00, 0xbc, 0x3c, 0xed,
+	0xfa, 0x6b, 0xce, 0xe9, 0x6f, 0xfa, 0x31, 0xae, 0xde, 0x3e, 0x77, 0x48, 0xce, 0xb4, 0xfd, 0xe8,
+	0xcf, 0x7f, 0x7e, 0x5c, 0x78, 0xb7, 0x05, 0xb6, 0x8d, 0xb7, 0x26, 0x2e, 0x6d, 0xfe, 0xd0, 0x1a,
+	0x36, 0xbb, 0x98, 0xa3, 0xa6, 0xc5, 0x44, 0x0e, 0x61, 0x56, 0x7f, 0x00, 0x50, 0x9b, 0x79, 0x68,
+	0x6f, 0xbd, 0x4c, 0x2f, 0x93, 0x71, 0xd5, 0x8f, 0x5e, 0x2d, 0x2e, 0xaf, 0xfb, 0x0d, 0x80, 0xea,
+	0x94, 0x03, 0xf2, 0xde, 0x19, 0x69, 0x27, 0x22, 0xaa, 0x3b, 0xe7, 0x8d, 0xc8, 0x5b, 0x20, 0xb0,
+	0xfc, 0xc2, 0x8e, 0xdd, 0x9e, 0x9f, 0xe9, 0x34, 0xb6, 0x6a, 0xbf, 0x3c, 0x36, 0xab, 0xd7, 0xde,
+	0x7b, 0x72, 0x54, 0x03, 0x4f, 0x8f, 0x6a, 0xe0, 0xef, 0xa3, 0x1a, 0xf8, 0xfe, 0xb8, 0x56, 0x7a,
+	0x7a, 0x5c, 0x2b, 0xfd, 0x75, 0x5c, 0x2b, 0x7d, 0x79, 0xdb, 0x0f, 0x78, 0x6f, 0xd0, 0x35, 0x5d,
+	0x1a, 0x5a, 0x7b, 0x22, 0xef, 0xcd, 0x4f, 0x51, 0x97, 0x59, 0xe9, 0xe3, 0x3c, 0xb4, 0x3f, 0xb0,
+	0x1e, 0x4e, 0xaa, 0x3d, 0x8a, 0x30, 0xeb, 0x2e, 0x8b, 0xf7, 0xf9, 0xfd, 0xe7, 0x03, 0x00, 0xd4,
+	0xbc, 0xec, 0x34, 0xa8, 0x08, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -171,6 +537,15 @@ const _ = grpc.SupportPackageIsVersion4
 type MsgClient interface {
 	// SubmitQueryResponse defines a method for submit query responses.
 	SubmitQueryResponse(ctx context.Context, in *MsgSubmitQueryResponse, opts ...grpc.CallOption) (*MsgSubmitQueryResponseResponse, error)
+	// SubmitBatchQueryResponse defines a method for submitting the response to
+	// every key in a batch query
+	SubmitBatchQueryResponse(ctx context.Context, in *MsgSubmitBatchQueryResponse, opts ...grpc.CallOption) (*MsgSubmitBatchQueryResponseResponse, error)
+	// Governance-only message to route queries on a connection over an ICS-31
+	// async-icq channel
+	SetAsyncICQChannel(ctx context.Context, in *MsgSetAsyncICQChannel, opts ...grpc.CallOption) (*MsgSetAsyncICQChannelResponse, error)
+	// Governance-only message to update the module parameters (e.g. relayer
+	// fees)
+	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
 }
 
 type msgClient struct {
@@ -190,10 +565,46 @@ func (c *msgClient) SubmitQueryResponse(ctx context.Context, in *MsgSubmitQueryR
 	return out, nil
 }
 
+func (c *msgClient) SubmitBatchQueryResponse(ctx context.Context, in *MsgSubmitBatchQueryResponse, opts ...grpc.CallOption) (*MsgSubmitBatchQueryResponseResponse, error) {
+	out := new(MsgSubmitBatchQueryResponseResponse)
+	err := c.cc.Invoke(ctx, "/stride.interchainquery.v1.Msg/SubmitBatchQueryResponse", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetAsyncICQChannel(ctx context.Context, in *MsgSetAsyncICQChannel, opts ...grpc.CallOption) (*MsgSetAsyncICQChannelResponse, error) {
+	out := new(MsgSetAsyncICQChannelResponse)
+	err := c.cc.Invoke(ctx, "/stride.interchainquery.v1.Msg/SetAsyncICQChannel", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
+	out := new(MsgUpdateParamsResponse)
+	err := c.cc.Invoke(ctx, "/stride.interchainquery.v1.Msg/UpdateParams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// SubmitQueryResponse defines a method for submit query responses.
 	SubmitQueryResponse(context.Context, *MsgSubmitQueryResponse) (*MsgSubmitQueryResponseResponse, error)
+	// SubmitBatchQueryResponse defines a method for submitting the response to
+	// every key in a batch query
+	SubmitBatchQueryResponse(context.Context, *MsgSubmitBatchQueryResponse) (*MsgSubmitBatchQueryResponseResponse, error)
+	// Governance-only message to route queries on a connection over an ICS-31
+	// async-icq channel
+	SetAsyncICQChannel(context.Context, *MsgSetAsyncICQChannel) (*MsgSetAsyncICQChannelResponse, error)
+	// Governance-only message to update the module parameters (e.g. relayer
+	// fees)
+	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -203,6 +614,15 @@ type UnimplementedMsgServer struct {
 func (*UnimplementedMsgServer) SubmitQueryResponse(ctx context.Context, req *MsgSubmitQueryResponse) (*MsgSubmitQueryResponseResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SubmitQueryResponse not implemented")
 }
+func (*UnimplementedMsgServer) SubmitBatchQueryResponse(ctx context.Context, req *MsgSubmitBatchQueryResponse) (*MsgSubmitBatchQueryResponseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitBatchQueryResponse not implemented")
+}
+func (*UnimplementedMsgServer) SetAsyncICQChannel(ctx context.Context, req *MsgSetAsyncICQChannel) (*MsgSetAsyncICQChannelResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetAsyncICQChannel not implemented")
+}
+func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -226,6 +646,60 @@ func _Msg_SubmitQueryResponse_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_SubmitBatchQueryResponse_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSubmitBatchQueryResponse)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SubmitBatchQueryResponse(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stride.interchainquery.v1.Msg/SubmitBatchQueryResponse",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SubmitBatchQueryResponse(ctx, req.(*MsgSubmitBatchQueryResponse))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetAsyncICQChannel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetAsyncICQChannel)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetAsyncICQChannel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stride.interchainquery.v1.Msg/SetAsyncICQChannel",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetAsyncICQChannel(ctx, req.(*MsgSetAsyncICQChannel))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stride.interchainquery.v1.Msg/UpdateParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "stride.interchainquery.v1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -234,6 +708,18 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "SubmitQueryResponse",
 			Handler:    _Msg_SubmitQueryResponse_Handler,
 		},
+		{
+			MethodName: "SubmitBatchQueryResponse",
+			Handler:    _Msg_SubmitBatchQueryResponse_Handler,
+		},
+		{
+			MethodName: "SetAsyncICQChannel",
+			Handler:    _Msg_SetAsyncICQChannel_Handler,
+		},
+		{
+			MethodName: "UpdateParams",
+			Handler:    _Msg_UpdateParams_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "stride/interchainquery/v1/messages.proto",
@@ -330,18 +816,283 @@ func (m *MsgSubmitQueryResponseResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintMessages(dAtA []byte, offset int, v uint64) int {
-	offset -= sovMessages(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *BatchQueryResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MsgSubmitQueryResponse) Size() (n int) {
+
+func (m *BatchQueryResult) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BatchQueryResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ProofOps != nil {
+		{
+			size, err := m.ProofOps.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMessages(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintMessages(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintMessages(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSubmitBatchQueryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSubmitBatchQueryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSubmitBatchQueryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.FromAddress) > 0 {
+		i -= len(m.FromAddress)
+		copy(dAtA[i:], m.FromAddress)
+		i = encodeVarintMessages(dAtA, i, uint64(len(m.FromAddress)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Height != 0 {
+		i = encodeVarintMessages(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Results) > 0 {
+		for iNdEx := len(m.Results) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Results[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMessages(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.QueryId) > 0 {
+		i -= len(m.QueryId)
+		copy(dAtA[i:], m.QueryId)
+		i = encodeVarintMessages(dAtA, i, uint64(len(m.QueryId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintMessages(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSubmitBatchQueryResponseResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSubmitBatchQueryResponseResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSubmitBatchQueryResponseResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetAsyncICQChannel) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetAsyncICQChannel) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetAsyncICQChannel) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintMessages(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ConnectionId) > 0 {
+		i -= len(m.ConnectionId)
+		copy(dAtA[i:], m.ConnectionId)
+		i = encodeVarintMessages(dAtA, i, uint64(len(m.ConnectionId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintMessages(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetAsyncICQChannelResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetAsyncICQChannelResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetAsyncICQChannelResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMessages(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintMessages(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintMessages(dAtA []byte, offset int, v uint64) int {
+	offset -= sovMessages(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgSubmitQueryResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -370,25 +1121,827 @@ func (m *MsgSubmitQueryResponse) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovMessages(uint64(l))
 	}
-	return n
-}
+	return n
+}
+
+func (m *MsgSubmitQueryResponseResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *BatchQueryResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovMessages(uint64(l))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovMessages(uint64(l))
+	}
+	if m.ProofOps != nil {
+		l = m.ProofOps.Size()
+		n += 1 + l + sovMessages(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSubmitBatchQueryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovMessages(uint64(l))
+	}
+	l = len(m.QueryId)
+	if l > 0 {
+		n += 1 + l + sovMessages(uint64(l))
+	}
+	if len(m.Results) > 0 {
+		for _, e := range m.Results {
+			l = e.Size()
+			n += 1 + l + sovMessages(uint64(l))
+		}
+	}
+	if m.Height != 0 {
+		n += 1 + sovMessages(uint64(m.Height))
+	}
+	l = len(m.FromAddress)
+	if l > 0 {
+		n += 1 + l + sovMessages(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSubmitBatchQueryResponseResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetAsyncICQChannel) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovMessages(uint64(l))
+	}
+	l = len(m.ConnectionId)
+	if l > 0 {
+		n += 1 + l + sovMessages(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovMessages(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSetAsyncICQChannelResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovMessages(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovMessages(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovMessages(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozMessages(x uint64) (n int) {
+	return sovMessages(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgSubmitQueryResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMessages
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSubmitQueryResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSubmitQueryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessages
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessages
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueryId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessages
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessages
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.QueryId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMessages
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessages
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Result = append(m.Result[:0], dAtA[iNdEx:postIndex]...)
+			if m.Result == nil {
+				m.Result = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofOps", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessages
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessages
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ProofOps == nil {
+				m.ProofOps = &crypto.ProofOps{}
+			}
+			if err := m.ProofOps.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessages
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessages
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FromAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMessages(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMessages
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSubmitQueryResponseResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMessages
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSubmitQueryResponseResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSubmitQueryResponseResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMessages(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMessages
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BatchQueryResult) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMessages
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BatchQueryResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BatchQueryResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMessages
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessages
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = append(m.Key[:0], dAtA[iNdEx:postIndex]...)
+			if m.Key == nil {
+				m.Key = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMessages
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessages
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = append(m.Value[:0], dAtA[iNdEx:postIndex]...)
+			if m.Value == nil {
+				m.Value = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofOps", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessages
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessages
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ProofOps == nil {
+				m.ProofOps = &crypto.ProofOps{}
+			}
+			if err := m.ProofOps.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMessages(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMessages
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSubmitBatchQueryResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMessages
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSubmitBatchQueryResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSubmitBatchQueryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessages
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessages
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueryId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessages
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessages
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.QueryId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Results", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessages
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessages
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Results = append(m.Results, BatchQueryResult{})
+			if err := m.Results[len(m.Results)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessages
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessages
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FromAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMessages(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMessages
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *MsgSubmitQueryResponseResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
+	return nil
 }
+func (m *MsgSubmitBatchQueryResponseResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMessages
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSubmitBatchQueryResponseResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSubmitBatchQueryResponseResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMessages(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMessages
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovMessages(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozMessages(x uint64) (n int) {
-	return sovMessages(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *MsgSubmitQueryResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetAsyncICQChannel) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -411,15 +1964,15 @@ func (m *MsgSubmitQueryResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSubmitQueryResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetAsyncICQChannel: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSubmitQueryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetAsyncICQChannel: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -447,11 +2000,11 @@ func (m *MsgSubmitQueryResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ChainId = string(dAtA[iNdEx:postIndex])
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field QueryId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -479,13 +2032,13 @@ func (m *MsgSubmitQueryResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.QueryId = string(dAtA[iNdEx:postIndex])
+			m.ConnectionId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMessages
@@ -495,31 +2048,129 @@ func (m *MsgSubmitQueryResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthMessages
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthMessages
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Result = append(m.Result[:0], dAtA[iNdEx:postIndex]...)
-			if m.Result == nil {
-				m.Result = []byte{}
-			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMessages(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMessages
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetAsyncICQChannelResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMessages
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetAsyncICQChannelResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetAsyncICQChannelResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMessages(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMessages
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMessages
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProofOps", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMessages
@@ -529,52 +2180,29 @@ func (m *MsgSubmitQueryResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthMessages
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthMessages
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ProofOps == nil {
-				m.ProofOps = &crypto.ProofOps{}
-			}
-			if err := m.ProofOps.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
-			}
-			m.Height = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMessages
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Height |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 6:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FromAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMessages
@@ -584,23 +2212,24 @@ func (m *MsgSubmitQueryResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthMessages
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthMessages
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FromAddress = string(dAtA[iNdEx:postIndex])
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -623,7 +2252,7 @@ func (m *MsgSubmitQueryResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSubmitQueryResponseResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -646,10 +2275,10 @@ func (m *MsgSubmitQueryResponseResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSubmitQueryResponseResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSubmitQueryResponseResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -44,3 +44,144 @@ func (msg MsgSubmitQueryResponse) GetSigners() []sdk.AccAddress {
 	fromAddress, _ := sdk.AccAddressFromBech32(msg.FromAddress)
 	return []sdk.AccAddress{fromAddress}
 }
+
+// ----------------------------------------------
+//            MsgSubmitBatchQueryResponse
+// ----------------------------------------------
+
+const TypeMsgSubmitBatchQueryResponse = "submit_batch_query_response"
+
+var _ sdk.Msg = &MsgSubmitBatchQueryResponse{}
+
+// Route Implements Msg.
+func (msg MsgSubmitBatchQueryResponse) Route() string { return RouterKey }
+
+// Type Implements Msg.
+func (msg MsgSubmitBatchQueryResponse) Type() string { return TypeMsgSubmitBatchQueryResponse }
+
+// ValidateBasic Implements Msg.
+func (msg MsgSubmitBatchQueryResponse) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.FromAddress); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid fromAddress in ICQ response (%s)", err)
+	}
+	if msg.ChainId == "" {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "chain_id cannot be empty in ICQ response")
+	}
+	if msg.QueryId == "" {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "query_id cannot be empty in ICQ response")
+	}
+	if len(msg.Results) == 0 {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "batch query response must include at least one result")
+	}
+
+	keys := map[string]bool{}
+	for _, result := range msg.Results {
+		if len(result.Key) == 0 {
+			return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "batch query result key cannot be empty")
+		}
+		if keys[string(result.Key)] {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "duplicate batch query result key %x", result.Key)
+		}
+		keys[string(result.Key)] = true
+	}
+
+	return nil
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgSubmitBatchQueryResponse) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners Implements Msg.
+func (msg MsgSubmitBatchQueryResponse) GetSigners() []sdk.AccAddress {
+	fromAddress, _ := sdk.AccAddressFromBech32(msg.FromAddress)
+	return []sdk.AccAddress{fromAddress}
+}
+
+// ----------------------------------------------
+//               MsgSetAsyncICQChannel
+// ----------------------------------------------
+
+const TypeMsgSetAsyncICQChannel = "set_async_icq_channel"
+
+var _ sdk.Msg = &MsgSetAsyncICQChannel{}
+
+func NewMsgSetAsyncICQChannel(authority, connectionId, channelId string) *MsgSetAsyncICQChannel {
+	return &MsgSetAsyncICQChannel{
+		Authority:    authority,
+		ConnectionId: connectionId,
+		ChannelId:    channelId,
+	}
+}
+
+// Route Implements Msg.
+func (msg MsgSetAsyncICQChannel) Route() string { return RouterKey }
+
+// Type Implements Msg.
+func (msg MsgSetAsyncICQChannel) Type() string { return TypeMsgSetAsyncICQChannel }
+
+// ValidateBasic Implements Msg.
+func (msg MsgSetAsyncICQChannel) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid authority address (%s)", err)
+	}
+
+	// An empty channel ID removes the async-icq routing for the connection
+	asyncICQChannel := AsyncICQChannel{ConnectionId: msg.ConnectionId, ChannelId: msg.ChannelId}
+	if msg.ChannelId == "" {
+		return asyncICQChannel.ValidateConnection()
+	}
+	return asyncICQChannel.Validate()
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgSetAsyncICQChannel) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners Implements Msg.
+func (msg MsgSetAsyncICQChannel) GetSigners() []sdk.AccAddress {
+	authority, _ := sdk.AccAddressFromBech32(msg.Authority)
+	return []sdk.AccAddress{authority}
+}
+
+// ----------------------------------------------
+//                MsgUpdateParams
+// ----------------------------------------------
+
+const TypeMsgUpdateParams = "update_params"
+
+var _ sdk.Msg = &MsgUpdateParams{}
+
+func NewMsgUpdateParams(authority string, params Params) *MsgUpdateParams {
+	return &MsgUpdateParams{
+		Authority: authority,
+		Params:    params,
+	}
+}
+
+// Route Implements Msg.
+func (msg MsgUpdateParams) Route() string { return RouterKey }
+
+// Type Implements Msg.
+func (msg MsgUpdateParams) Type() string { return TypeMsgUpdateParams }
+
+// ValidateBasic Implements Msg.
+func (msg MsgUpdateParams) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid authority address (%s)", err)
+	}
+	return msg.Params.Validate()
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgUpdateParams) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners Implements Msg.
+func (msg MsgUpdateParams) GetSigners() []sdk.AccAddress {
+	authority, _ := sdk.AccAddressFromBech32(msg.Authority)
+	return []sdk.AccAddress{authority}
+}
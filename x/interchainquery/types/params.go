@@ -0,0 +1,45 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// By default, no relayer fees are paid
+func DefaultParams() Params {
+	return Params{
+		RelayerFees: []RelayerFee{},
+	}
+}
+
+// Validates that each query type has at most one fee, and that each fee is a proof query
+func (p Params) Validate() error {
+	queryTypes := map[string]bool{}
+	for _, relayerFee := range p.RelayerFees {
+		if relayerFee.QueryType == "" {
+			return errorsmod.Wrap(ErrInvalidParams, "relayer fee query type cannot be empty")
+		}
+		if !(Query{QueryType: relayerFee.QueryType}).RequiresProof() {
+			return errorsmod.Wrapf(ErrInvalidParams, "relayer fees can only be set for proof queries (%s)", relayerFee.QueryType)
+		}
+		if queryTypes[relayerFee.QueryType] {
+			return errorsmod.Wrapf(ErrInvalidParams, "duplicate relayer fee for query type %s", relayerFee.QueryType)
+		}
+		queryTypes[relayerFee.QueryType] = true
+
+		if !relayerFee.Fee.IsValid() {
+			return errorsmod.Wrapf(ErrInvalidParams, "invalid relayer fee for query type %s: %s", relayerFee.QueryType, relayerFee.Fee)
+		}
+	}
+	return nil
+}
+
+// Returns the relayer fee for a query type, or an empty fee if one is not configured
+func (p Params) GetRelayerFee(queryType string) sdk.Coins {
+	for _, relayerFee := range p.RelayerFees {
+		if relayerFee.QueryType == queryType {
+			return relayerFee.Fee
+		}
+	}
+	return sdk.Coins{}
+}
@@ -2,6 +2,7 @@ package types
 
 import (
 	fmt "fmt"
+	"strings"
 	time "time"
 )
 
@@ -11,8 +12,107 @@ func (q Query) HasTimedOut(currentBlockTime time.Time) bool {
 	return q.TimeoutTimestamp < uint64(currentBlockTime.UnixNano())
 }
 
+// Check if the query's response must include a proof (i.e. if it's a "key" store query)
+func (q Query) RequiresProof() bool {
+	return queryTypeRequiresProof(q.QueryType)
+}
+
+// Checks whether the query can be sent over an async-icq channel
+func (q Query) AsyncICQCompatible() bool {
+	return IsAsyncICQCompatible(q.QueryType)
+}
+
+// Returns the block time at which the query was submitted, derived from the timeout
+func (q Query) SubmissionTime() time.Time {
+	return time.Unix(0, int64(q.TimeoutTimestamp)).Add(-q.TimeoutDuration)
+}
+
+// Builds the history record for a query that was removed from the store
+func (q Query) Record(outcome QueryOutcome) QueryRecord {
+	return QueryRecord{
+		QueryId:          q.Id,
+		ConnectionId:     q.ConnectionId,
+		ChainId:          q.ChainId,
+		QueryType:        q.QueryType,
+		CallbackModule:   q.CallbackModule,
+		CallbackId:       q.CallbackId,
+		TimeoutPolicy:    q.TimeoutPolicy,
+		Outcome:          outcome,
+		SubmissionHeight: q.SubmissionHeight,
+	}
+}
+
 // Prints an abbreviated query description for logging purposes
 func (q Query) Description() string {
 	return fmt.Sprintf("QueryId: %s, QueryType: %s, ConnectionId: %s, QueryRequest: %v",
 		q.Id, q.QueryType, q.ConnectionId, q.RequestData)
 }
+
+// Check if a batch query has timed-out by checking whether the block time is after
+// the timeout timestamp
+func (q BatchQuery) HasTimedOut(currentBlockTime time.Time) bool {
+	return q.TimeoutTimestamp < uint64(currentBlockTime.UnixNano())
+}
+
+// Check if the batch query's response must include a proof for each key (i.e. if it's a "key" store query)
+func (q BatchQuery) RequiresProof() bool {
+	return queryTypeRequiresProof(q.QueryType)
+}
+
+// Checks whether the batch query can be sent over an async-icq channel
+func (q BatchQuery) AsyncICQCompatible() bool {
+	return IsAsyncICQCompatible(q.QueryType)
+}
+
+// Returns the single key query for one of the keys in the batch, so that batch callbacks
+// can reuse the callback logic of the equivalent single key query
+func (q BatchQuery) KeyQuery(requestKey []byte, callbackId string) Query {
+	return Query{
+		Id:               q.Id,
+		ConnectionId:     q.ConnectionId,
+		ChainId:          q.ChainId,
+		QueryType:        q.QueryType,
+		RequestData:      requestKey,
+		CallbackModule:   q.CallbackModule,
+		CallbackId:       callbackId,
+		CallbackData:     q.CallbackData,
+		TimeoutPolicy:    q.TimeoutPolicy,
+		TimeoutDuration:  q.TimeoutDuration,
+		TimeoutTimestamp: q.TimeoutTimestamp,
+		RequestSent:      q.RequestSent,
+		SubmissionHeight: q.SubmissionHeight,
+	}
+}
+
+// Returns the block time at which the batch query was submitted, derived from the timeout
+func (q BatchQuery) SubmissionTime() time.Time {
+	return time.Unix(0, int64(q.TimeoutTimestamp)).Add(-q.TimeoutDuration)
+}
+
+// Builds the history record for a batch query that was removed from the store
+func (q BatchQuery) Record(outcome QueryOutcome) QueryRecord {
+	return QueryRecord{
+		QueryId:          q.Id,
+		ConnectionId:     q.ConnectionId,
+		ChainId:          q.ChainId,
+		QueryType:        q.QueryType,
+		CallbackModule:   q.CallbackModule,
+		CallbackId:       q.CallbackId,
+		TimeoutPolicy:    q.TimeoutPolicy,
+		Outcome:          outcome,
+		Batch:            true,
+		SubmissionHeight: q.SubmissionHeight,
+	}
+}
+
+// Prints an abbreviated batch query description for logging purposes
+func (q BatchQuery) Description() string {
+	return fmt.Sprintf("BatchQueryId: %s, QueryType: %s, ConnectionId: %s, NumKeys: %d",
+		q.Id, q.QueryType, q.ConnectionId, len(q.RequestKeys))
+}
+
+// Check if a query type is a "key" store query, which must be answered with a proof
+func queryTypeRequiresProof(queryType string) bool {
+	pathParts := strings.Split(queryType, "/")
+	return pathParts[len(pathParts)-1] == "key"
+}
@@ -6,6 +6,9 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -109,9 +112,462 @@ func (m *QueryPendingQueriesResponse) GetPendingQueries() []Query {
 	return nil
 }
 
+type QueryPendingBatchQueriesRequest struct {
+}
+
+func (m *QueryPendingBatchQueriesRequest) Reset()         { *m = QueryPendingBatchQueriesRequest{} }
+func (m *QueryPendingBatchQueriesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPendingBatchQueriesRequest) ProtoMessage()    {}
+func (*QueryPendingBatchQueriesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b720c147b9144d5b, []int{2}
+}
+func (m *QueryPendingBatchQueriesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPendingBatchQueriesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPendingBatchQueriesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPendingBatchQueriesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPendingBatchQueriesRequest.Merge(m, src)
+}
+func (m *QueryPendingBatchQueriesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPendingBatchQueriesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPendingBatchQueriesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPendingBatchQueriesRequest proto.InternalMessageInfo
+
+type QueryPendingBatchQueriesResponse struct {
+	PendingBatchQueries []BatchQuery `protobuf:"bytes,1,rep,name=pending_batch_queries,json=pendingBatchQueries,proto3" json:"pending_batch_queries"`
+}
+
+func (m *QueryPendingBatchQueriesResponse) Reset()         { *m = QueryPendingBatchQueriesResponse{} }
+func (m *QueryPendingBatchQueriesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPendingBatchQueriesResponse) ProtoMessage()    {}
+func (*QueryPendingBatchQueriesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b720c147b9144d5b, []int{3}
+}
+func (m *QueryPendingBatchQueriesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPendingBatchQueriesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPendingBatchQueriesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPendingBatchQueriesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPendingBatchQueriesResponse.Merge(m, src)
+}
+func (m *QueryPendingBatchQueriesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPendingBatchQueriesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPendingBatchQueriesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPendingBatchQueriesResponse proto.InternalMessageInfo
+
+func (m *QueryPendingBatchQueriesResponse) GetPendingBatchQueries() []BatchQuery {
+	if m != nil {
+		return m.PendingBatchQueries
+	}
+	return nil
+}
+
+// Lists recently completed queries (most recent first by default), optionally
+// filtered by callback ID or connection ID
+type QueryQueryHistoryRequest struct {
+	CallbackId   string             `protobuf:"bytes,1,opt,name=callback_id,json=callbackId,proto3" json:"callback_id,omitempty"`
+	ConnectionId string             `protobuf:"bytes,2,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty"`
+	Pagination   *query.PageRequest `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryQueryHistoryRequest) Reset()         { *m = QueryQueryHistoryRequest{} }
+func (m *QueryQueryHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryQueryHistoryRequest) ProtoMessage()    {}
+func (*QueryQueryHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b720c147b9144d5b, []int{4}
+}
+func (m *QueryQueryHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryQueryHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryQueryHistoryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryQueryHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryQueryHistoryRequest.Merge(m, src)
+}
+func (m *QueryQueryHistoryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryQueryHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryQueryHistoryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryQueryHistoryRequest proto.InternalMessageInfo
+
+func (m *QueryQueryHistoryRequest) GetCallbackId() string {
+	if m != nil {
+		return m.CallbackId
+	}
+	return ""
+}
+
+func (m *QueryQueryHistoryRequest) GetConnectionId() string {
+	if m != nil {
+		return m.ConnectionId
+	}
+	return ""
+}
+
+func (m *QueryQueryHistoryRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryQueryHistoryResponse struct {
+	QueryRecords []QueryRecord       `protobuf:"bytes,1,rep,name=query_records,json=queryRecords,proto3" json:"query_records"`
+	Pagination   *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryQueryHistoryResponse) Reset()         { *m = QueryQueryHistoryResponse{} }
+func (m *QueryQueryHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryQueryHistoryResponse) ProtoMessage()    {}
+func (*QueryQueryHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b720c147b9144d5b, []int{5}
+}
+func (m *QueryQueryHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryQueryHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryQueryHistoryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryQueryHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryQueryHistoryResponse.Merge(m, src)
+}
+func (m *QueryQueryHistoryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryQueryHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryQueryHistoryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryQueryHistoryResponse proto.InternalMessageInfo
+
+func (m *QueryQueryHistoryResponse) GetQueryRecords() []QueryRecord {
+	if m != nil {
+		return m.QueryRecords
+	}
+	return nil
+}
+
+func (m *QueryQueryHistoryResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryParamsRequest struct {
+}
+
+func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
+func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsRequest) ProtoMessage()    {}
+func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b720c147b9144d5b, []int{6}
+}
+func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParamsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsRequest.Merge(m, src)
+}
+func (m *QueryParamsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParamsRequest proto.InternalMessageInfo
+
+type QueryParamsResponse struct {
+	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+}
+
+func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
+func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsResponse) ProtoMessage()    {}
+func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b720c147b9144d5b, []int{7}
+}
+func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsResponse.Merge(m, src)
+}
+func (m *QueryParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParamsResponse proto.InternalMessageInfo
+
+func (m *QueryParamsResponse) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+// Returns the total fees a relayer has earned from query responses
+type QueryRelayerEarningsRequest struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *QueryRelayerEarningsRequest) Reset()         { *m = QueryRelayerEarningsRequest{} }
+func (m *QueryRelayerEarningsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRelayerEarningsRequest) ProtoMessage()    {}
+func (*QueryRelayerEarningsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b720c147b9144d5b, []int{8}
+}
+func (m *QueryRelayerEarningsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRelayerEarningsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRelayerEarningsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRelayerEarningsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRelayerEarningsRequest.Merge(m, src)
+}
+func (m *QueryRelayerEarningsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRelayerEarningsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRelayerEarningsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRelayerEarningsRequest proto.InternalMessageInfo
+
+func (m *QueryRelayerEarningsRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+type QueryRelayerEarningsResponse struct {
+	Earnings github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=earnings,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"earnings"`
+}
+
+func (m *QueryRelayerEarningsResponse) Reset()         { *m = QueryRelayerEarningsResponse{} }
+func (m *QueryRelayerEarningsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRelayerEarningsResponse) ProtoMessage()    {}
+func (*QueryRelayerEarningsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b720c147b9144d5b, []int{9}
+}
+func (m *QueryRelayerEarningsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRelayerEarningsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRelayerEarningsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRelayerEarningsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRelayerEarningsResponse.Merge(m, src)
+}
+func (m *QueryRelayerEarningsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRelayerEarningsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRelayerEarningsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRelayerEarningsResponse proto.InternalMessageInfo
+
+func (m *QueryRelayerEarningsResponse) GetEarnings() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Earnings
+	}
+	return nil
+}
+
+type QueryAsyncICQChannelsRequest struct {
+}
+
+func (m *QueryAsyncICQChannelsRequest) Reset()         { *m = QueryAsyncICQChannelsRequest{} }
+func (m *QueryAsyncICQChannelsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAsyncICQChannelsRequest) ProtoMessage()    {}
+func (*QueryAsyncICQChannelsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b720c147b9144d5b, []int{10}
+}
+func (m *QueryAsyncICQChannelsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAsyncICQChannelsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAsyncICQChannelsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAsyncICQChannelsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAsyncICQChannelsRequest.Merge(m, src)
+}
+func (m *QueryAsyncICQChannelsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAsyncICQChannelsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAsyncICQChannelsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAsyncICQChannelsRequest proto.InternalMessageInfo
+
+type QueryAsyncICQChannelsResponse struct {
+	AsyncIcqChannels []AsyncICQChannel `protobuf:"bytes,1,rep,name=async_icq_channels,json=asyncIcqChannels,proto3" json:"async_icq_channels"`
+}
+
+func (m *QueryAsyncICQChannelsResponse) Reset()         { *m = QueryAsyncICQChannelsResponse{} }
+func (m *QueryAsyncICQChannelsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAsyncICQChannelsResponse) ProtoMessage()    {}
+func (*QueryAsyncICQChannelsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b720c147b9144d5b, []int{11}
+}
+func (m *QueryAsyncICQChannelsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAsyncICQChannelsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAsyncICQChannelsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAsyncICQChannelsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAsyncICQChannelsResponse.Merge(m, src)
+}
+func (m *QueryAsyncICQChannelsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAsyncICQChannelsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAsyncICQChannelsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAsyncICQChannelsResponse proto.InternalMessageInfo
+
+func (m *QueryAsyncICQChannelsResponse) GetAsyncIcqChannels() []AsyncICQChannel {
+	if m != nil {
+		return m.AsyncIcqChannels
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryPendingQueriesRequest)(nil), "stride.interchainquery.v1.QueryPendingQueriesRequest")
 	proto.RegisterType((*QueryPendingQueriesResponse)(nil), "stride.interchainquery.v1.QueryPendingQueriesResponse")
+	proto.RegisterType((*QueryPendingBatchQueriesRequest)(nil), "stride.interchainquery.v1.QueryPendingBatchQueriesRequest")
+	proto.RegisterType((*QueryPendingBatchQueriesResponse)(nil), "stride.interchainquery.v1.QueryPendingBatchQueriesResponse")
+	proto.RegisterType((*QueryQueryHistoryRequest)(nil), "stride.interchainquery.v1.QueryQueryHistoryRequest")
+	proto.RegisterType((*QueryQueryHistoryResponse)(nil), "stride.interchainquery.v1.QueryQueryHistoryResponse")
+	proto.RegisterType((*QueryParamsRequest)(nil), "stride.interchainquery.v1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "stride.interchainquery.v1.QueryParamsResponse")
+	proto.RegisterType((*QueryRelayerEarningsRequest)(nil), "stride.interchainquery.v1.QueryRelayerEarningsRequest")
+	proto.RegisterType((*QueryRelayerEarningsResponse)(nil), "stride.interchainquery.v1.QueryRelayerEarningsResponse")
+	proto.RegisterType((*QueryAsyncICQChannelsRequest)(nil), "stride.interchainquery.v1.QueryAsyncICQChannelsRequest")
+	proto.RegisterType((*QueryAsyncICQChannelsResponse)(nil), "stride.interchainquery.v1.QueryAsyncICQChannelsResponse")
 }
 
 func init() {
@@ -119,27 +575,64 @@ func init() {
 }
 
 var fileDescriptor_b720c147b9144d5b = []byte{
-	// 314 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x2d, 0x2e, 0x29, 0xca,
-	0x4c, 0x49, 0xd5, 0xcf, 0xcc, 0x2b, 0x49, 0x2d, 0x4a, 0xce, 0x48, 0xcc, 0xcc, 0x2b, 0x2c, 0x4d,
-	0x2d, 0xaa, 0xd4, 0x2f, 0x33, 0xd4, 0x07, 0x33, 0xf4, 0x0a, 0x8a, 0xf2, 0x4b, 0xf2, 0x85, 0x24,
-	0x21, 0xca, 0xf4, 0xd0, 0x94, 0xe9, 0x95, 0x19, 0x4a, 0xa9, 0xe3, 0x36, 0x21, 0x3d, 0x35, 0x2f,
-	0xb5, 0x38, 0xb3, 0x18, 0x62, 0x86, 0x94, 0x4c, 0x7a, 0x7e, 0x7e, 0x7a, 0x4e, 0xaa, 0x7e, 0x62,
-	0x41, 0xa6, 0x7e, 0x62, 0x5e, 0x5e, 0x7e, 0x49, 0x62, 0x49, 0x66, 0x7e, 0x1e, 0x4c, 0x56, 0x24,
-	0x3d, 0x3f, 0x3d, 0x1f, 0xcc, 0xd4, 0x07, 0xb1, 0x20, 0xa2, 0x4a, 0x32, 0x5c, 0x52, 0x81, 0x20,
-	0xd3, 0x02, 0x52, 0xf3, 0x52, 0x32, 0xf3, 0xd2, 0x41, 0xec, 0xcc, 0xd4, 0xe2, 0xa0, 0xd4, 0xc2,
-	0xd2, 0xd4, 0xe2, 0x12, 0xa5, 0x3c, 0x2e, 0x69, 0xac, 0xb2, 0xc5, 0x05, 0xf9, 0x79, 0xc5, 0xa9,
-	0x42, 0xfe, 0x5c, 0xfc, 0x05, 0x10, 0x99, 0xf8, 0x42, 0x88, 0x94, 0x04, 0xa3, 0x02, 0xb3, 0x06,
-	0xb7, 0x91, 0x82, 0x1e, 0x4e, 0xef, 0xe8, 0x81, 0x0d, 0x74, 0x62, 0x39, 0x71, 0x4f, 0x9e, 0x21,
-	0x88, 0xaf, 0x00, 0xc5, 0x60, 0xa3, 0xb3, 0x8c, 0x5c, 0x3c, 0x60, 0xf9, 0xe0, 0xd4, 0xa2, 0xb2,
-	0xcc, 0xe4, 0x54, 0xa1, 0x3d, 0x8c, 0x5c, 0x7c, 0xa8, 0x96, 0x0b, 0x99, 0x12, 0x32, 0x1b, 0xab,
-	0x57, 0xa4, 0xcc, 0x48, 0xd5, 0x06, 0xf1, 0xa3, 0x92, 0x75, 0xd3, 0xe5, 0x27, 0x93, 0x99, 0x4c,
-	0x85, 0x8c, 0xf5, 0x83, 0xc1, 0xfa, 0x75, 0x7d, 0x12, 0x93, 0x8a, 0xf5, 0x71, 0x44, 0x09, 0x5a,
-	0x68, 0x38, 0x05, 0x9f, 0x78, 0x24, 0xc7, 0x78, 0xe1, 0x91, 0x1c, 0xe3, 0x83, 0x47, 0x72, 0x8c,
-	0x13, 0x1e, 0xcb, 0x31, 0x5c, 0x78, 0x2c, 0xc7, 0x70, 0xe3, 0xb1, 0x1c, 0x43, 0x94, 0x65, 0x7a,
-	0x66, 0x49, 0x46, 0x69, 0x92, 0x5e, 0x72, 0x7e, 0x2e, 0x36, 0x83, 0xcb, 0x8c, 0x4c, 0xf4, 0x2b,
-	0x30, 0x8c, 0x2f, 0xa9, 0x2c, 0x48, 0x2d, 0x4e, 0x62, 0x03, 0xc7, 0x9c, 0x31, 0x20, 0x00, 0x00,
-	0xff, 0xff, 0x82, 0xbf, 0x49, 0xf2, 0x5a, 0x02, 0x00, 0x00,
+	// 901 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x96, 0xcf, 0x6f, 0xe3, 0x44,
+	0x14, 0xc7, 0x3b, 0x5d, 0x54, 0xd8, 0x69, 0xf7, 0x87, 0xa6, 0x8b, 0x94, 0x9a, 0xe2, 0x66, 0x8d,
+	0xb6, 0x5b, 0x2a, 0x6a, 0xd3, 0x64, 0xb7, 0xbb, 0xa5, 0xd2, 0xc2, 0xa6, 0x62, 0x21, 0x12, 0x12,
+	0xdb, 0x54, 0xe2, 0xc0, 0x81, 0x68, 0x62, 0x8f, 0x9c, 0xd1, 0xa6, 0x33, 0x8e, 0xc7, 0x8d, 0x88,
+	0x10, 0x42, 0x82, 0x03, 0x07, 0x2e, 0x48, 0x1c, 0xf9, 0x07, 0x10, 0x67, 0x24, 0x2e, 0x5c, 0x91,
+	0x56, 0x9c, 0x8a, 0xb8, 0x70, 0x02, 0xd4, 0xf2, 0x87, 0x20, 0xcf, 0x8f, 0x34, 0x4e, 0xed, 0x3a,
+	0xe1, 0xd2, 0x3a, 0x33, 0xdf, 0xf7, 0xe6, 0xf3, 0xde, 0x3c, 0x7f, 0x13, 0x78, 0x47, 0x24, 0x31,
+	0x0d, 0x88, 0x47, 0x59, 0x42, 0x62, 0xbf, 0x8b, 0x29, 0xeb, 0x1f, 0x93, 0x78, 0xe8, 0x0d, 0xb6,
+	0x3d, 0xf9, 0xe0, 0x46, 0x31, 0x4f, 0x38, 0x5a, 0x51, 0x32, 0x77, 0x42, 0xe6, 0x0e, 0xb6, 0xad,
+	0xbb, 0xc5, 0x19, 0x42, 0xc2, 0x88, 0xa0, 0x42, 0xe5, 0xb0, 0x5e, 0x2f, 0x16, 0x62, 0x31, 0x64,
+	0x7e, 0x9b, 0xfa, 0x7d, 0x2d, 0xdd, 0x2a, 0xa1, 0x6a, 0x77, 0xa9, 0x48, 0xb8, 0xa1, 0xb3, 0xd6,
+	0x8b, 0xe5, 0x11, 0x8e, 0xf1, 0x91, 0x21, 0xd8, 0xf4, 0xb9, 0x38, 0xe2, 0xc2, 0xeb, 0x60, 0x41,
+	0x3c, 0x23, 0xe9, 0x90, 0x04, 0xa7, 0xba, 0x90, 0x32, 0x9c, 0x50, 0xce, 0xb4, 0xd6, 0x1e, 0xd7,
+	0x1a, 0x95, 0xcf, 0xa9, 0xd9, 0x5f, 0x0d, 0x39, 0x0f, 0x7b, 0xc4, 0xc3, 0x11, 0xf5, 0x30, 0x63,
+	0x3c, 0x91, 0xc1, 0xe6, 0xa4, 0x5b, 0x21, 0x0f, 0xb9, 0x7c, 0xf4, 0xd2, 0x27, 0xb5, 0xea, 0xac,
+	0x42, 0xeb, 0x20, 0x3d, 0xf5, 0x29, 0x61, 0x01, 0x65, 0x61, 0xfa, 0x4c, 0x89, 0x68, 0x91, 0xfe,
+	0x31, 0x11, 0x89, 0xc3, 0xe0, 0x2b, 0xb9, 0xbb, 0x22, 0xe2, 0x4c, 0x10, 0xf4, 0x21, 0xbc, 0x11,
+	0xa9, 0x9d, 0x76, 0x5f, 0x6d, 0x55, 0x40, 0xf5, 0xca, 0xc6, 0x62, 0xad, 0xea, 0x16, 0x5e, 0x8e,
+	0x2b, 0x13, 0x36, 0x5e, 0x78, 0xfe, 0xd7, 0xda, 0x5c, 0xeb, 0x7a, 0x94, 0x49, 0xec, 0xdc, 0x86,
+	0x6b, 0xe3, 0xe7, 0x35, 0x70, 0xe2, 0x77, 0x27, 0x90, 0xbe, 0x02, 0xb0, 0x5a, 0xac, 0xd1, 0x60,
+	0x6d, 0xf8, 0xb2, 0x01, 0xeb, 0xa4, 0xfb, 0x13, 0x78, 0x77, 0x2e, 0xc1, 0x1b, 0xe5, 0x33, 0x8c,
+	0xcb, 0xd1, 0xc5, 0x83, 0x9c, 0x1f, 0x00, 0xac, 0x48, 0x91, 0xfc, 0xf3, 0xbe, 0xba, 0x7a, 0x8d,
+	0x88, 0xd6, 0xe0, 0xa2, 0x8f, 0x7b, 0xbd, 0x0e, 0xf6, 0x9f, 0xb5, 0x69, 0x50, 0x01, 0x55, 0xb0,
+	0x71, 0xb5, 0x05, 0xcd, 0x52, 0x33, 0x40, 0xaf, 0xc1, 0x6b, 0x3e, 0x67, 0x8c, 0xf8, 0xe9, 0xfd,
+	0xa4, 0x92, 0x79, 0x29, 0x59, 0x3a, 0x5f, 0x6c, 0x06, 0xe8, 0x09, 0x84, 0xe7, 0x13, 0x50, 0xb9,
+	0x52, 0x05, 0x1b, 0x8b, 0xb5, 0x75, 0x57, 0x8d, 0x80, 0x9b, 0x8e, 0x80, 0x6b, 0x98, 0xe5, 0x20,
+	0xb8, 0x4f, 0x71, 0x48, 0x34, 0x41, 0x6b, 0x2c, 0xd2, 0xf9, 0x19, 0xc0, 0x95, 0x1c, 0x54, 0xdd,
+	0xa9, 0x03, 0x78, 0x4d, 0x8d, 0x6f, 0x4c, 0x7c, 0x1e, 0x07, 0xa6, 0x43, 0xeb, 0x65, 0x17, 0xd8,
+	0x92, 0x72, 0xdd, 0xa2, 0xa5, 0xfe, 0xf9, 0x92, 0x40, 0xef, 0x65, 0xc0, 0xe7, 0x25, 0xf8, 0xdd,
+	0x52, 0x70, 0xc5, 0x93, 0x21, 0xbf, 0x05, 0x91, 0xba, 0x69, 0xf9, 0xc2, 0x98, 0x01, 0xf8, 0x08,
+	0x2e, 0x67, 0x56, 0x75, 0x21, 0x6f, 0xc3, 0x05, 0xf5, 0x62, 0xc9, 0x7e, 0x2f, 0xd6, 0x6e, 0x5f,
+	0x52, 0x81, 0x0a, 0xd5, 0xf0, 0x3a, 0xcc, 0x79, 0xa0, 0x67, 0xbd, 0x45, 0x7a, 0x78, 0x48, 0xe2,
+	0x77, 0x71, 0xcc, 0x28, 0x0b, 0xcd, 0xb1, 0xa8, 0x02, 0x5f, 0xc4, 0x41, 0x10, 0x13, 0x21, 0xf4,
+	0x85, 0x9a, 0x8f, 0xce, 0xd7, 0x00, 0xae, 0xe6, 0x47, 0x6a, 0xb4, 0x10, 0xbe, 0x44, 0xf4, 0x9a,
+	0x6e, 0xef, 0x4a, 0xa6, 0x1d, 0xa6, 0x11, 0xfb, 0x9c, 0xb2, 0xc6, 0x9b, 0x29, 0xd4, 0x8f, 0x7f,
+	0xaf, 0x6d, 0x84, 0x34, 0xe9, 0x1e, 0x77, 0x5c, 0x9f, 0x1f, 0x79, 0xfa, 0xbd, 0x57, 0xff, 0xb6,
+	0x44, 0xf0, 0xcc, 0x4b, 0x86, 0x11, 0x11, 0x32, 0x40, 0xb4, 0x46, 0xc9, 0x1d, 0x5b, 0x83, 0x3c,
+	0x4e, 0xbd, 0xab, 0xb9, 0x7f, 0xb0, 0xdf, 0xc5, 0x8c, 0x91, 0xde, 0xa8, 0x75, 0x5f, 0xc0, 0x57,
+	0x0b, 0xf6, 0x35, 0xe9, 0x27, 0x10, 0x8d, 0x7c, 0xaf, 0xed, 0xeb, 0x5d, 0xcd, 0xbc, 0x79, 0x49,
+	0x43, 0x27, 0x12, 0xea, 0xce, 0xde, 0x94, 0xb9, 0x9a, 0x7e, 0xdf, 0x9c, 0x53, 0xfb, 0xe6, 0x2a,
+	0x5c, 0x92, 0x04, 0x87, 0x24, 0x1e, 0x50, 0x9f, 0xa0, 0x5f, 0x00, 0xbc, 0x9e, 0x35, 0x17, 0x74,
+	0xbf, 0x6c, 0xf4, 0x72, 0xad, 0xca, 0xda, 0x99, 0x35, 0x4c, 0x95, 0xec, 0xec, 0x7d, 0xf9, 0xc7,
+	0xbf, 0xdf, 0xcd, 0xdf, 0x47, 0x75, 0xef, 0x50, 0xc6, 0x6f, 0x7d, 0x80, 0x3b, 0xc2, 0x2b, 0x70,
+	0xef, 0x09, 0xb7, 0x43, 0xbf, 0x03, 0xb8, 0x9c, 0xe3, 0x43, 0xe8, 0xad, 0x29, 0x61, 0x72, 0x0c,
+	0xce, 0xda, 0xfb, 0x5f, 0xb1, 0xba, 0x9a, 0xc7, 0xb2, 0x9a, 0x3d, 0xb4, 0x3b, 0x4b, 0x35, 0x19,
+	0x8b, 0x44, 0x3f, 0x01, 0xb8, 0x34, 0x6e, 0x15, 0xa8, 0x5e, 0x06, 0x94, 0xe3, 0x81, 0xd6, 0xbd,
+	0xd9, 0x82, 0x34, 0xfe, 0xae, 0xc4, 0xaf, 0xa3, 0xed, 0x69, 0xf0, 0x33, 0x5f, 0xbb, 0xe8, 0x7b,
+	0x00, 0x17, 0xd4, 0x7b, 0x8d, 0xb6, 0x4a, 0x3b, 0x38, 0x6e, 0x28, 0x96, 0x3b, 0xad, 0x5c, 0x43,
+	0xd6, 0x24, 0xe4, 0x1b, 0x68, 0x73, 0xaa, 0x1e, 0x2b, 0xa4, 0xdf, 0x00, 0xbc, 0x31, 0x61, 0x0f,
+	0x68, 0xa7, 0xdc, 0x63, 0xf3, 0x9c, 0xc8, 0x7a, 0x30, 0x73, 0x9c, 0x06, 0x7f, 0x22, 0xc1, 0xdf,
+	0x41, 0x8f, 0xa6, 0x01, 0x8f, 0x55, 0x92, 0xb6, 0x31, 0x17, 0xef, 0x33, 0xed, 0x77, 0x9f, 0xa3,
+	0x5f, 0x01, 0xbc, 0x39, 0x69, 0x21, 0xa8, 0x94, 0xaa, 0xc0, 0x94, 0xac, 0x87, 0xb3, 0x07, 0xea,
+	0x7a, 0x1e, 0xc9, 0x7a, 0x1e, 0xa2, 0x9d, 0x69, 0xea, 0xb9, 0xe8, 0x6b, 0x8d, 0xc3, 0xe7, 0xa7,
+	0x36, 0x38, 0x39, 0xb5, 0xc1, 0x3f, 0xa7, 0x36, 0xf8, 0xf6, 0xcc, 0x9e, 0x3b, 0x39, 0xb3, 0xe7,
+	0xfe, 0x3c, 0xb3, 0xe7, 0x3e, 0xde, 0x1d, 0x33, 0xdf, 0x9c, 0xdc, 0x83, 0xda, 0x3d, 0xef, 0xd3,
+	0x0b, 0x27, 0x48, 0x4f, 0xee, 0x2c, 0xc8, 0xdf, 0x55, 0xf5, 0xff, 0x06, 0x00, 0x2b, 0x27, 0x60,
+	0x81, 0xc6, 0x0a, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -155,6 +648,11 @@ const _ = grpc.SupportPackageIsVersion4
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
 type QueryServiceClient interface {
 	PendingQueries(ctx context.Context, in *QueryPendingQueriesRequest, opts ...grpc.CallOption) (*QueryPendingQueriesResponse, error)
+	PendingBatchQueries(ctx context.Context, in *QueryPendingBatchQueriesRequest, opts ...grpc.CallOption) (*QueryPendingBatchQueriesResponse, error)
+	QueryHistory(ctx context.Context, in *QueryQueryHistoryRequest, opts ...grpc.CallOption) (*QueryQueryHistoryResponse, error)
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	RelayerEarnings(ctx context.Context, in *QueryRelayerEarningsRequest, opts ...grpc.CallOption) (*QueryRelayerEarningsResponse, error)
+	AsyncICQChannels(ctx context.Context, in *QueryAsyncICQChannelsRequest, opts ...grpc.CallOption) (*QueryAsyncICQChannelsResponse, error)
 }
 
 type queryServiceClient struct {
@@ -174,9 +672,59 @@ func (c *queryServiceClient) PendingQueries(ctx context.Context, in *QueryPendin
 	return out, nil
 }
 
+func (c *queryServiceClient) PendingBatchQueries(ctx context.Context, in *QueryPendingBatchQueriesRequest, opts ...grpc.CallOption) (*QueryPendingBatchQueriesResponse, error) {
+	out := new(QueryPendingBatchQueriesResponse)
+	err := c.cc.Invoke(ctx, "/stride.interchainquery.v1.QueryService/PendingBatchQueries", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryServiceClient) QueryHistory(ctx context.Context, in *QueryQueryHistoryRequest, opts ...grpc.CallOption) (*QueryQueryHistoryResponse, error) {
+	out := new(QueryQueryHistoryResponse)
+	err := c.cc.Invoke(ctx, "/stride.interchainquery.v1.QueryService/QueryHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryServiceClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/stride.interchainquery.v1.QueryService/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryServiceClient) RelayerEarnings(ctx context.Context, in *QueryRelayerEarningsRequest, opts ...grpc.CallOption) (*QueryRelayerEarningsResponse, error) {
+	out := new(QueryRelayerEarningsResponse)
+	err := c.cc.Invoke(ctx, "/stride.interchainquery.v1.QueryService/RelayerEarnings", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryServiceClient) AsyncICQChannels(ctx context.Context, in *QueryAsyncICQChannelsRequest, opts ...grpc.CallOption) (*QueryAsyncICQChannelsResponse, error) {
+	out := new(QueryAsyncICQChannelsResponse)
+	err := c.cc.Invoke(ctx, "/stride.interchainquery.v1.QueryService/AsyncICQChannels", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServiceServer is the server API for QueryService service.
 type QueryServiceServer interface {
 	PendingQueries(context.Context, *QueryPendingQueriesRequest) (*QueryPendingQueriesResponse, error)
+	PendingBatchQueries(context.Context, *QueryPendingBatchQueriesRequest) (*QueryPendingBatchQueriesResponse, error)
+	QueryHistory(context.Context, *QueryQueryHistoryRequest) (*QueryQueryHistoryResponse, error)
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	RelayerEarnings(context.Context, *QueryRelayerEarningsRequest) (*QueryRelayerEarningsResponse, error)
+	AsyncICQChannels(context.Context, *QueryAsyncICQChannelsRequest) (*QueryAsyncICQChannelsResponse, error)
 }
 
 // UnimplementedQueryServiceServer can be embedded to have forward compatible implementations.
@@ -186,6 +734,21 @@ type UnimplementedQueryServiceServer struct {
 func (*UnimplementedQueryServiceServer) PendingQueries(ctx context.Context, req *QueryPendingQueriesRequest) (*QueryPendingQueriesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method PendingQueries not implemented")
 }
+func (*UnimplementedQueryServiceServer) PendingBatchQueries(ctx context.Context, req *QueryPendingBatchQueriesRequest) (*QueryPendingBatchQueriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PendingBatchQueries not implemented")
+}
+func (*UnimplementedQueryServiceServer) QueryHistory(ctx context.Context, req *QueryQueryHistoryRequest) (*QueryQueryHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryHistory not implemented")
+}
+func (*UnimplementedQueryServiceServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServiceServer) RelayerEarnings(ctx context.Context, req *QueryRelayerEarningsRequest) (*QueryRelayerEarningsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RelayerEarnings not implemented")
+}
+func (*UnimplementedQueryServiceServer) AsyncICQChannels(ctx context.Context, req *QueryAsyncICQChannelsRequest) (*QueryAsyncICQChannelsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AsyncICQChannels not implemented")
+}
 
 func RegisterQueryServiceServer(s grpc1.Server, srv QueryServiceServer) {
 	s.RegisterService(&_QueryService_serviceDesc, srv)
@@ -209,6 +772,96 @@ func _QueryService_PendingQueries_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _QueryService_PendingBatchQueries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPendingBatchQueriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServiceServer).PendingBatchQueries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stride.interchainquery.v1.QueryService/PendingBatchQueries",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServiceServer).PendingBatchQueries(ctx, req.(*QueryPendingBatchQueriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueryService_QueryHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryQueryHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServiceServer).QueryHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stride.interchainquery.v1.QueryService/QueryHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServiceServer).QueryHistory(ctx, req.(*QueryQueryHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueryService_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServiceServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stride.interchainquery.v1.QueryService/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServiceServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueryService_RelayerEarnings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRelayerEarningsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServiceServer).RelayerEarnings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stride.interchainquery.v1.QueryService/RelayerEarnings",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServiceServer).RelayerEarnings(ctx, req.(*QueryRelayerEarningsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueryService_AsyncICQChannels_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAsyncICQChannelsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServiceServer).AsyncICQChannels(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stride.interchainquery.v1.QueryService/AsyncICQChannels",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServiceServer).AsyncICQChannels(ctx, req.(*QueryAsyncICQChannelsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _QueryService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "stride.interchainquery.v1.QueryService",
 	HandlerType: (*QueryServiceServer)(nil),
@@ -217,6 +870,26 @@ var _QueryService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "PendingQueries",
 			Handler:    _QueryService_PendingQueries_Handler,
 		},
+		{
+			MethodName: "PendingBatchQueries",
+			Handler:    _QueryService_PendingBatchQueries_Handler,
+		},
+		{
+			MethodName: "QueryHistory",
+			Handler:    _QueryService_QueryHistory_Handler,
+		},
+		{
+			MethodName: "Params",
+			Handler:    _QueryService_Params_Handler,
+		},
+		{
+			MethodName: "RelayerEarnings",
+			Handler:    _QueryService_RelayerEarnings_Handler,
+		},
+		{
+			MethodName: "AsyncICQChannels",
+			Handler:    _QueryService_AsyncICQChannels_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "stride/interchainquery/v1/query.proto",
@@ -229,101 +902,1415 @@ func (m *QueryPendingQueriesRequest) Marshal() (dAtA []byte, err error) {
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryPendingQueriesRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPendingQueriesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPendingQueriesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPendingQueriesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPendingQueriesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPendingQueriesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PendingQueries) > 0 {
+		for iNdEx := len(m.PendingQueries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PendingQueries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPendingBatchQueriesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPendingBatchQueriesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPendingBatchQueriesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPendingBatchQueriesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPendingBatchQueriesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPendingBatchQueriesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PendingBatchQueries) > 0 {
+		for iNdEx := len(m.PendingBatchQueries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PendingBatchQueries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryQueryHistoryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryQueryHistoryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryQueryHistoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ConnectionId) > 0 {
+		i -= len(m.ConnectionId)
+		copy(dAtA[i:], m.ConnectionId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ConnectionId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.CallbackId) > 0 {
+		i -= len(m.CallbackId)
+		copy(dAtA[i:], m.CallbackId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.CallbackId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryQueryHistoryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryQueryHistoryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryQueryHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.QueryRecords) > 0 {
+		for iNdEx := len(m.QueryRecords) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.QueryRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRelayerEarningsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRelayerEarningsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRelayerEarningsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRelayerEarningsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRelayerEarningsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRelayerEarningsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Earnings) > 0 {
+		for iNdEx := len(m.Earnings) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Earnings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAsyncICQChannelsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAsyncICQChannelsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAsyncICQChannelsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAsyncICQChannelsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAsyncICQChannelsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAsyncICQChannelsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AsyncIcqChannels) > 0 {
+		for iNdEx := len(m.AsyncIcqChannels) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AsyncIcqChannels[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryPendingQueriesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryPendingQueriesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.PendingQueries) > 0 {
+		for _, e := range m.PendingQueries {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryPendingBatchQueriesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryPendingBatchQueriesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.PendingBatchQueries) > 0 {
+		for _, e := range m.PendingBatchQueries {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryQueryHistoryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CallbackId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ConnectionId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryQueryHistoryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.QueryRecords) > 0 {
+		for _, e := range m.QueryRecords {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryRelayerEarningsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRelayerEarningsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Earnings) > 0 {
+		for _, e := range m.Earnings {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryAsyncICQChannelsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryAsyncICQChannelsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.AsyncIcqChannels) > 0 {
+		for _, e := range m.AsyncIcqChannels {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryPendingQueriesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPendingQueriesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPendingQueriesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPendingQueriesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPendingQueriesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPendingQueriesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingQueries", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingQueries = append(m.PendingQueries, Query{})
+			if err := m.PendingQueries[len(m.PendingQueries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPendingBatchQueriesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPendingBatchQueriesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPendingBatchQueriesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPendingBatchQueriesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPendingBatchQueriesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPendingBatchQueriesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingBatchQueries", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingBatchQueries = append(m.PendingBatchQueries, BatchQuery{})
+			if err := m.PendingBatchQueries[len(m.PendingBatchQueries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryQueryHistoryRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryQueryHistoryRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryQueryHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CallbackId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CallbackId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConnectionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryQueryHistoryResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryQueryHistoryResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryQueryHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueryRecords", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.QueryRecords = append(m.QueryRecords, QueryRecord{})
+			if err := m.QueryRecords[len(m.QueryRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *QueryPendingQueriesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
-}
-
-func (m *QueryPendingQueriesResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryPendingQueriesResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return nil
 }
-
-func (m *QueryPendingQueriesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.PendingQueries) > 0 {
-		for iNdEx := len(m.PendingQueries) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.PendingQueries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
+func (m *QueryRelayerEarningsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRelayerEarningsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRelayerEarningsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
 			}
-			i--
-			dAtA[i] = 0xa
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return len(dAtA) - i, nil
-}
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *QueryPendingQueriesRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
+	return nil
 }
-
-func (m *QueryPendingQueriesResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.PendingQueries) > 0 {
-		for _, e := range m.PendingQueries {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+func (m *QueryRelayerEarningsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRelayerEarningsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRelayerEarningsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Earnings", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Earnings = append(m.Earnings, types.Coin{})
+			if err := m.Earnings[len(m.Earnings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *QueryPendingQueriesRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAsyncICQChannelsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -346,10 +2333,10 @@ func (m *QueryPendingQueriesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPendingQueriesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAsyncICQChannelsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPendingQueriesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAsyncICQChannelsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -373,7 +2360,7 @@ func (m *QueryPendingQueriesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPendingQueriesResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAsyncICQChannelsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -396,15 +2383,15 @@ func (m *QueryPendingQueriesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPendingQueriesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAsyncICQChannelsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPendingQueriesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAsyncICQChannelsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PendingQueries", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AsyncIcqChannels", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -431,8 +2418,8 @@ func (m *QueryPendingQueriesResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PendingQueries = append(m.PendingQueries, Query{})
-			if err := m.PendingQueries[len(m.PendingQueries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.AsyncIcqChannels = append(m.AsyncIcqChannels, AsyncICQChannel{})
+			if err := m.AsyncIcqChannels[len(m.AsyncIcqChannels)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
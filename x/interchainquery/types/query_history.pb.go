@@ -0,0 +1,971 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: stride/interchainquery/v1/query_history.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	_ "github.com/cosmos/gogoproto/types"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// The final outcome of a query once it has been removed from the store
+type QueryOutcome int32
+
+const (
+	// The response was returned and the callback succeeded
+	QueryOutcome_QUERY_SUCCEEDED QueryOutcome = 0
+	// The response was returned but was empty, so the callback was not invoked
+	QueryOutcome_QUERY_NO_RESULT QueryOutcome = 1
+	// The response was returned after the query's timeout, and the query's
+	// timeout policy was applied
+	QueryOutcome_QUERY_TIMED_OUT QueryOutcome = 2
+	// The response was returned but the callback failed
+	QueryOutcome_QUERY_CALLBACK_FAILED QueryOutcome = 3
+	// The query was never answered and was removed long after its timeout
+	QueryOutcome_QUERY_EXPIRED QueryOutcome = 4
+)
+
+var QueryOutcome_name = map[int32]string{
+	0: "QUERY_SUCCEEDED",
+	1: "QUERY_NO_RESULT",
+	2: "QUERY_TIMED_OUT",
+	3: "QUERY_CALLBACK_FAILED",
+	4: "QUERY_EXPIRED",
+}
+
+var QueryOutcome_value = map[string]int32{
+	"QUERY_SUCCEEDED":       0,
+	"QUERY_NO_RESULT":       1,
+	"QUERY_TIMED_OUT":       2,
+	"QUERY_CALLBACK_FAILED": 3,
+	"QUERY_EXPIRED":         4,
+}
+
+func (x QueryOutcome) String() string {
+	return proto.EnumName(QueryOutcome_name, int32(x))
+}
+
+func (QueryOutcome) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_2d7cdc17c33197e6, []int{0}
+}
+
+// A record of a completed query, used to monitor query lifecycles
+type QueryRecord struct {
+	QueryId        string        `protobuf:"bytes,1,opt,name=query_id,json=queryId,proto3" json:"query_id,omitempty"`
+	ConnectionId   string        `protobuf:"bytes,2,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty"`
+	ChainId        string        `protobuf:"bytes,3,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	QueryType      string        `protobuf:"bytes,4,opt,name=query_type,json=queryType,proto3" json:"query_type,omitempty"`
+	CallbackModule string        `protobuf:"bytes,5,opt,name=callback_module,json=callbackModule,proto3" json:"callback_module,omitempty"`
+	CallbackId     string        `protobuf:"bytes,6,opt,name=callback_id,json=callbackId,proto3" json:"callback_id,omitempty"`
+	TimeoutPolicy  TimeoutPolicy `protobuf:"varint,7,opt,name=timeout_policy,json=timeoutPolicy,proto3,enum=stride.interchainquery.v1.TimeoutPolicy" json:"timeout_policy,omitempty"`
+	Outcome        QueryOutcome  `protobuf:"varint,8,opt,name=outcome,proto3,enum=stride.interchainquery.v1.QueryOutcome" json:"outcome,omitempty"`
+	// Whether the query was a multi-key batch query
+	Batch bool `protobuf:"varint,9,opt,name=batch,proto3" json:"batch,omitempty"`
+	// Light client height of the host at the time the query was submitted
+	SubmissionHeight uint64 `protobuf:"varint,10,opt,name=submission_height,json=submissionHeight,proto3" json:"submission_height,omitempty"`
+	// Host height at which the query was answered (zero if never answered)
+	ResponseHeight int64 `protobuf:"varint,11,opt,name=response_height,json=responseHeight,proto3" json:"response_height,omitempty"`
+	// Stride block height at which the query was completed
+	CompletionBlockHeight int64 `protobuf:"varint,12,opt,name=completion_block_height,json=completionBlockHeight,proto3" json:"completion_block_height,omitempty"`
+	// Time between the query's submission and its completion
+	Latency time.Duration `protobuf:"bytes,13,opt,name=latency,proto3,stdduration" json:"latency"`
+	// Callback error message, if the callback failed
+	Error string `protobuf:"bytes,14,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (m *QueryRecord) Reset()         { *m = QueryRecord{} }
+func (m *QueryRecord) String() string { return proto.CompactTextString(m) }
+func (*QueryRecord) ProtoMessage()    {}
+func (*QueryRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d7cdc17c33197e6, []int{0}
+}
+func (m *QueryRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRecord.Merge(m, src)
+}
+func (m *QueryRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRecord proto.InternalMessageInfo
+
+func (m *QueryRecord) GetQueryId() string {
+	if m != nil {
+		return m.QueryId
+	}
+	return ""
+}
+
+func (m *QueryRecord) GetConnectionId() string {
+	if m != nil {
+		return m.ConnectionId
+	}
+	return ""
+}
+
+func (m *QueryRecord) GetChainId() string {
+	if m != nil {
+		return m.ChainId
+	}
+	return ""
+}
+
+func (m *QueryRecord) GetQueryType() string {
+	if m != nil {
+		return m.QueryType
+	}
+	return ""
+}
+
+func (m *QueryRecord) GetCallbackModule() string {
+	if m != nil {
+		return m.CallbackModule
+	}
+	return ""
+}
+
+func (m *QueryRecord) GetCallbackId() string {
+	if m != nil {
+		return m.CallbackId
+	}
+	return ""
+}
+
+func (m *QueryRecord) GetTimeoutPolicy() TimeoutPolicy {
+	if m != nil {
+		return m.TimeoutPolicy
+	}
+	return TimeoutPolicy_REJECT_QUERY_RESPONSE
+}
+
+func (m *QueryRecord) GetOutcome() QueryOutcome {
+	if m != nil {
+		return m.Outcome
+	}
+	return QueryOutcome_QUERY_SUCCEEDED
+}
+
+func (m *QueryRecord) GetBatch() bool {
+	if m != nil {
+		return m.Batch
+	}
+	return false
+}
+
+func (m *QueryRecord) GetSubmissionHeight() uint64 {
+	if m != nil {
+		return m.SubmissionHeight
+	}
+	return 0
+}
+
+func (m *QueryRecord) GetResponseHeight() int64 {
+	if m != nil {
+		return m.ResponseHeight
+	}
+	return 0
+}
+
+func (m *QueryRecord) GetCompletionBlockHeight() int64 {
+	if m != nil {
+		return m.CompletionBlockHeight
+	}
+	return 0
+}
+
+func (m *QueryRecord) GetLatency() time.Duration {
+	if m != nil {
+		return m.Latency
+	}
+	return 0
+}
+
+func (m *QueryRecord) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterEnum("stride.interchainquery.v1.QueryOutcome", QueryOutcome_name, QueryOutcome_value)
+	proto.RegisterType((*QueryRecord)(nil), "stride.interchainquery.v1.QueryRecord")
+}
+
+func init() {
+	proto.RegisterFile("stride/interchainquery/v1/query_history.proto", fileDescriptor_2d7cdc17c33197e6)
+}
+
+var fileDescriptor_2d7cdc17c33197e6 = []byte{
+	// 600 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x53, 0x4d, 0x6f, 0xd3, 0x3c,
+	0x1c, 0xaf, 0xb7, 0x6e, 0xed, 0xdc, 0xb5, 0xeb, 0xfc, 0x6c, 0x7a, 0xd2, 0x49, 0x64, 0x15, 0x1c,
+	0x16, 0x81, 0x96, 0x68, 0x03, 0x21, 0x71, 0xe0, 0xd0, 0x97, 0x20, 0x22, 0x3a, 0xba, 0xa5, 0xad,
+	0x04, 0x5c, 0xa2, 0xc4, 0x31, 0xa9, 0xb5, 0x34, 0x2e, 0x89, 0x33, 0x11, 0xf1, 0x25, 0x38, 0xf2,
+	0x91, 0x76, 0xdc, 0x91, 0xd3, 0x40, 0xdb, 0x17, 0x41, 0xb1, 0x1b, 0x3a, 0x81, 0xc6, 0xcd, 0xbf,
+	0x37, 0x3b, 0xf9, 0xf9, 0x6f, 0x78, 0x98, 0xf0, 0x98, 0xfa, 0xc4, 0xa0, 0x11, 0x27, 0x31, 0x9e,
+	0xba, 0x34, 0xfa, 0x94, 0x92, 0x38, 0x33, 0x2e, 0x8e, 0x0c, 0xb1, 0x70, 0xa6, 0x34, 0xe1, 0x2c,
+	0xce, 0xf4, 0x79, 0xcc, 0x38, 0x43, 0x2d, 0x69, 0xd7, 0xff, 0xb0, 0xeb, 0x17, 0x47, 0x7b, 0x3b,
+	0x01, 0x0b, 0x98, 0x70, 0x19, 0xf9, 0x4a, 0x06, 0xf6, 0xd4, 0x80, 0xb1, 0x20, 0x24, 0x86, 0x40,
+	0x5e, 0xfa, 0xd1, 0xf0, 0xd3, 0xd8, 0xe5, 0x94, 0x45, 0x0b, 0xfd, 0xe0, 0xfe, 0xf3, 0x03, 0x12,
+	0x91, 0x84, 0x26, 0xd2, 0xf8, 0xf0, 0xba, 0x0c, 0x6b, 0x67, 0xb9, 0x64, 0x13, 0xcc, 0x62, 0x1f,
+	0xb5, 0x60, 0x55, 0x7e, 0x20, 0xf5, 0x15, 0xd0, 0x06, 0xda, 0x86, 0x5d, 0x11, 0xd8, 0xf2, 0xd1,
+	0x23, 0x58, 0xc7, 0x2c, 0x8a, 0x08, 0xce, 0xcf, 0xc9, 0xf5, 0x15, 0xa1, 0x6f, 0x2e, 0x49, 0x4b,
+	0xe4, 0xc5, 0x71, 0xb9, 0xbe, 0x2a, 0xf3, 0x02, 0x5b, 0x3e, 0x7a, 0x00, 0xa1, 0xdc, 0x9a, 0x67,
+	0x73, 0xa2, 0x94, 0x85, 0xb8, 0x21, 0x98, 0x71, 0x36, 0x27, 0xe8, 0x00, 0x6e, 0x61, 0x37, 0x0c,
+	0x3d, 0x17, 0x9f, 0x3b, 0x33, 0xe6, 0xa7, 0x21, 0x51, 0xd6, 0x84, 0xa7, 0x51, 0xd0, 0x27, 0x82,
+	0x45, 0xfb, 0xb0, 0xf6, 0xdb, 0x48, 0x7d, 0x65, 0x5d, 0x98, 0x60, 0x41, 0x59, 0x3e, 0x1a, 0xc2,
+	0x06, 0xa7, 0x33, 0xc2, 0x52, 0xee, 0xcc, 0x59, 0x48, 0x71, 0xa6, 0x54, 0xda, 0x40, 0x6b, 0x1c,
+	0x6b, 0xfa, 0xbd, 0x35, 0xeb, 0x63, 0x19, 0x38, 0x15, 0x7e, 0xbb, 0xce, 0xef, 0x42, 0xd4, 0x81,
+	0x15, 0x96, 0x72, 0xcc, 0x66, 0x44, 0xa9, 0x8a, 0x9d, 0x0e, 0xfe, 0xb1, 0x93, 0x68, 0x73, 0x28,
+	0xed, 0x76, 0x91, 0x43, 0x3b, 0x70, 0xcd, 0x73, 0x39, 0x9e, 0x2a, 0x1b, 0x6d, 0xa0, 0x55, 0x6d,
+	0x09, 0xd0, 0x13, 0xb8, 0x9d, 0xa4, 0xde, 0x8c, 0x26, 0x49, 0x5e, 0xe9, 0x94, 0xd0, 0x60, 0xca,
+	0x15, 0xd8, 0x06, 0x5a, 0xd9, 0x6e, 0x2e, 0x85, 0xd7, 0x82, 0xcf, 0x0b, 0x8a, 0x49, 0x32, 0x67,
+	0x51, 0x42, 0x0a, 0x6b, 0xad, 0x0d, 0xb4, 0x55, 0xbb, 0x51, 0xd0, 0x0b, 0xe3, 0x73, 0xf8, 0x3f,
+	0x66, 0xb3, 0x79, 0x48, 0xc4, 0x45, 0x79, 0x21, 0xc3, 0xe7, 0x45, 0x60, 0x53, 0x04, 0x76, 0x97,
+	0x72, 0x37, 0x57, 0x17, 0xb9, 0x97, 0xb0, 0x12, 0xba, 0x9c, 0x44, 0x38, 0x53, 0xea, 0x6d, 0xa0,
+	0xd5, 0x8e, 0x5b, 0xba, 0x1c, 0x33, 0xbd, 0x18, 0x33, 0xbd, 0xbf, 0x18, 0xb3, 0x6e, 0xf5, 0xf2,
+	0x7a, 0xbf, 0xf4, 0xed, 0xc7, 0x3e, 0xb0, 0x8b, 0x4c, 0xfe, 0x8b, 0x24, 0x8e, 0x59, 0xac, 0x34,
+	0xc4, 0x8d, 0x48, 0xf0, 0xf8, 0x0b, 0xdc, 0xbc, 0xdb, 0x08, 0xfa, 0x0f, 0x6e, 0x9d, 0x4d, 0x4c,
+	0xfb, 0xbd, 0x33, 0x9a, 0xf4, 0x7a, 0xa6, 0xd9, 0x37, 0xfb, 0xcd, 0xd2, 0x92, 0x7c, 0x3b, 0x74,
+	0x6c, 0x73, 0x34, 0x19, 0x8c, 0x9b, 0x60, 0x49, 0x8e, 0xad, 0x13, 0xb3, 0xef, 0x0c, 0x27, 0xe3,
+	0xe6, 0x0a, 0x6a, 0xc1, 0x5d, 0x49, 0xf6, 0x3a, 0x83, 0x41, 0xb7, 0xd3, 0x7b, 0xe3, 0xbc, 0xea,
+	0x58, 0x03, 0xb3, 0xdf, 0x5c, 0x45, 0xdb, 0xb0, 0x2e, 0x25, 0xf3, 0xdd, 0xa9, 0x65, 0x9b, 0xfd,
+	0x66, 0xb9, 0x3b, 0xba, 0xbc, 0x51, 0xc1, 0xd5, 0x8d, 0x0a, 0x7e, 0xde, 0xa8, 0xe0, 0xeb, 0xad,
+	0x5a, 0xba, 0xba, 0x55, 0x4b, 0xdf, 0x6f, 0xd5, 0xd2, 0x87, 0x17, 0x01, 0xe5, 0xd3, 0xd4, 0xd3,
+	0x31, 0x9b, 0x19, 0x23, 0x71, 0x97, 0x87, 0x03, 0xd7, 0x4b, 0x8c, 0xc5, 0xbb, 0xb9, 0x38, 0x7e,
+	0x66, 0x7c, 0xfe, 0xeb, 0xf5, 0xe4, 0x83, 0x9b, 0x78, 0xeb, 0xa2, 0x8d, 0xa7, 0xbf, 0x06, 0x00,
+	0x3d, 0xc6, 0xa5, 0x60, 0xe4, 0x03, 0x00, 0x00,
+}
+
+func (m *QueryRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintQueryHistory(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x72
+	}
+	n1, err1 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.Latency, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Latency):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintQueryHistory(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x6a
+	if m.CompletionBlockHeight != 0 {
+		i = encodeVarintQueryHistory(dAtA, i, uint64(m.CompletionBlockHeight))
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.ResponseHeight != 0 {
+		i = encodeVarintQueryHistory(dAtA, i, uint64(m.ResponseHeight))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.SubmissionHeight != 0 {
+		i = encodeVarintQueryHistory(dAtA, i, uint64(m.SubmissionHeight))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.Batch {
+		i--
+		if m.Batch {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.Outcome != 0 {
+		i = encodeVarintQueryHistory(dAtA, i, uint64(m.Outcome))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.TimeoutPolicy != 0 {
+		i = encodeVarintQueryHistory(dAtA, i, uint64(m.TimeoutPolicy))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.CallbackId) > 0 {
+		i -= len(m.CallbackId)
+		copy(dAtA[i:], m.CallbackId)
+		i = encodeVarintQueryHistory(dAtA, i, uint64(len(m.CallbackId)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.CallbackModule) > 0 {
+		i -= len(m.CallbackModule)
+		copy(dAtA[i:], m.CallbackModule)
+		i = encodeVarintQueryHistory(dAtA, i, uint64(len(m.CallbackModule)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.QueryType) > 0 {
+		i -= len(m.QueryType)
+		copy(dAtA[i:], m.QueryType)
+		i = encodeVarintQueryHistory(dAtA, i, uint64(len(m.QueryType)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintQueryHistory(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ConnectionId) > 0 {
+		i -= len(m.ConnectionId)
+		copy(dAtA[i:], m.ConnectionId)
+		i = encodeVarintQueryHistory(dAtA, i, uint64(len(m.ConnectionId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.QueryId) > 0 {
+		i -= len(m.QueryId)
+		copy(dAtA[i:], m.QueryId)
+		i = encodeVarintQueryHistory(dAtA, i, uint64(len(m.QueryId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQueryHistory(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQueryHistory(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.QueryId)
+	if l > 0 {
+		n += 1 + l + sovQueryHistory(uint64(l))
+	}
+	l = len(m.ConnectionId)
+	if l > 0 {
+		n += 1 + l + sovQueryHistory(uint64(l))
+	}
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovQueryHistory(uint64(l))
+	}
+	l = len(m.QueryType)
+	if l > 0 {
+		n += 1 + l + sovQueryHistory(uint64(l))
+	}
+	l = len(m.CallbackModule)
+	if l > 0 {
+		n += 1 + l + sovQueryHistory(uint64(l))
+	}
+	l = len(m.CallbackId)
+	if l > 0 {
+		n += 1 + l + sovQueryHistory(uint64(l))
+	}
+	if m.TimeoutPolicy != 0 {
+		n += 1 + sovQueryHistory(uint64(m.TimeoutPolicy))
+	}
+	if m.Outcome != 0 {
+		n += 1 + sovQueryHistory(uint64(m.Outcome))
+	}
+	if m.Batch {
+		n += 2
+	}
+	if m.SubmissionHeight != 0 {
+		n += 1 + sovQueryHistory(uint64(m.SubmissionHeight))
+	}
+	if m.ResponseHeight != 0 {
+		n += 1 + sovQueryHistory(uint64(m.ResponseHeight))
+	}
+	if m.CompletionBlockHeight != 0 {
+		n += 1 + sovQueryHistory(uint64(m.CompletionBlockHeight))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Latency)
+	n += 1 + l + sovQueryHistory(uint64(l))
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovQueryHistory(uint64(l))
+	}
+	return n
+}
+
+func sovQueryHistory(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQueryHistory(x uint64) (n int) {
+	return sovQueryHistory(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQueryHistory
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueryId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueryHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueryHistory
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueryHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.QueryId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueryHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueryHistory
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueryHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConnectionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueryHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueryHistory
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueryHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueryType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueryHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueryHistory
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueryHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.QueryType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CallbackModule", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueryHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueryHistory
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueryHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CallbackModule = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CallbackId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueryHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueryHistory
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueryHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CallbackId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutPolicy", wireType)
+			}
+			m.TimeoutPolicy = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueryHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TimeoutPolicy |= TimeoutPolicy(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Outcome", wireType)
+			}
+			m.Outcome = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueryHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Outcome |= QueryOutcome(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Batch", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueryHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Batch = bool(v != 0)
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubmissionHeight", wireType)
+			}
+			m.SubmissionHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueryHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SubmissionHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResponseHeight", wireType)
+			}
+			m.ResponseHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueryHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ResponseHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompletionBlockHeight", wireType)
+			}
+			m.CompletionBlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueryHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CompletionBlockHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Latency", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueryHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQueryHistory
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueryHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.Latency, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueryHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueryHistory
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueryHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQueryHistory(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQueryHistory
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipQueryHistory(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowQueryHistory
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQueryHistory
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQueryHistory
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthQueryHistory
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupQueryHistory
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthQueryHistory
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthQueryHistory        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowQueryHistory          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupQueryHistory = fmt.Errorf("proto: unexpected end of group")
+)
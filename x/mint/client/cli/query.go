@@ -3,6 +3,7 @@ package cli
 import (
 	"context"
 	"fmt"
+	"strconv"
 
 	"github.com/spf13/cobra"
 
@@ -25,6 +26,8 @@ func GetQueryCmd() *cobra.Command {
 	mintingQueryCmd.AddCommand(
 		GetCmdQueryParams(),
 		GetCmdQueryEpochProvisions(),
+		GetCmdQueryProjectedProvisions(),
+		GetCmdQueryRecipientEmissions(),
 	)
 
 	return mintingQueryCmd
@@ -87,3 +90,66 @@ func GetCmdQueryEpochProvisions() *cobra.Command {
 
 	return cmd
 }
+
+// GetCmdQueryProjectedProvisions implements a command to return the projected
+// provisions and total supply over the next epochs.
+func GetCmdQueryProjectedProvisions() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "projected-provisions [num-epochs]",
+		Short: "Query the projected provisions and total supply over the next epochs",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			numEpochs, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			params := &types.QueryProjectedProvisionsRequest{NumEpochs: numEpochs}
+			res, err := queryClient.ProjectedProvisions(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryRecipientEmissions implements a command to return the cumulative
+// emissions to each distribution recipient.
+func GetCmdQueryRecipientEmissions() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "recipient-emissions",
+		Short: "Query the cumulative emissions to each distribution recipient",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryRecipientEmissionsRequest{}
+			res, err := queryClient.RecipientEmissions(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
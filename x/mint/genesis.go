@@ -25,6 +25,10 @@ func InitGenesis(ctx sdk.Context, keeper keeper.Keeper, ak types.AccountKeeper,
 	keeper.SetupNewModuleAccount(ctx, types.CommunityUsageSubmoduleName, types.SubmoduleCommunityNamespaceKey)
 
 	keeper.SetLastReductionEpochNum(ctx, data.ReductionStartedEpoch)
+
+	for _, emissions := range data.RecipientEmissions {
+		keeper.SetRecipientEmissions(ctx, emissions)
+	}
 }
 
 // ExportGenesis returns a GenesisState for a given context and keeper.
@@ -32,5 +36,6 @@ func ExportGenesis(ctx sdk.Context, keeper keeper.Keeper) *types.GenesisState {
 	minter := keeper.GetMinter(ctx)
 	params := keeper.GetParams(ctx)
 	lastReductionEpoch := keeper.GetLastReductionEpochNum(ctx)
-	return types.NewGenesisState(minter, params, lastReductionEpoch)
+	recipientEmissions := keeper.GetAllRecipientEmissions(ctx)
+	return types.NewGenesisState(minter, params, lastReductionEpoch, recipientEmissions)
 }
@@ -4,6 +4,8 @@ import (
 	"context"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"github.com/Stride-Labs/stride/v24/x/mint/types"
 )
@@ -35,3 +37,29 @@ func (q Querier) EpochProvisions(c context.Context, _ *types.QueryEpochProvision
 
 	return &types.QueryEpochProvisionsResponse{EpochProvisions: minter.EpochProvisions}, nil
 }
+
+// ProjectedProvisions returns the projected provisions and total supply over the next epochs.
+func (q Querier) ProjectedProvisions(c context.Context, req *types.QueryProjectedProvisionsRequest) (*types.QueryProjectedProvisionsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if req.NumEpochs == 0 || req.NumEpochs > types.MaxProjectedEpochs {
+		return nil, status.Errorf(codes.InvalidArgument, "number of epochs must be between 1 and %d", types.MaxProjectedEpochs)
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	projections, err := q.Keeper.ProjectEpochProvisions(ctx, req.NumEpochs)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	return &types.QueryProjectedProvisionsResponse{Projections: projections}, nil
+}
+
+// RecipientEmissions returns the cumulative emissions to each recipient.
+func (q Querier) RecipientEmissions(c context.Context, _ *types.QueryRecipientEmissionsRequest) (*types.QueryRecipientEmissionsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	emissions := q.Keeper.GetAllRecipientEmissions(ctx)
+
+	return &types.QueryRecipientEmissionsResponse{Emissions: emissions}, nil
+}
@@ -0,0 +1,105 @@
+package keeper_test
+
+import (
+	"context"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	epochstypes "github.com/Stride-Labs/stride/v24/x/epochs/types"
+	"github.com/Stride-Labs/stride/v24/x/mint/types"
+)
+
+func (s *KeeperTestSuite) TestQueryProjectedProvisions() {
+	params := s.App.MintKeeper.GetParams(s.Ctx)
+	params.MintDenom = "ustrd"
+	params.ReductionPeriodInEpochs = 3
+	params.ReductionFactor = sdk.MustNewDecFromStr("0.5")
+	params.MintingRewardsDistributionStartEpoch = 6
+	s.App.MintKeeper.SetParams(s.Ctx, params)
+
+	s.App.MintKeeper.SetMinter(s.Ctx, types.NewMinter(sdk.NewDec(1000)))
+	s.App.MintKeeper.SetLastReductionEpochNum(s.Ctx, 0)
+	s.App.EpochsKeeper.SetEpochInfo(s.Ctx, epochstypes.EpochInfo{
+		Identifier:   params.EpochIdentifier,
+		CurrentEpoch: 5,
+	})
+
+	// Mint an initial supply
+	s.FundAccount(s.TestAccs[0], sdk.NewInt64Coin("ustrd", 10_000))
+	initialSupply := s.App.BankKeeper.GetSupply(s.Ctx, "ustrd").Amount
+
+	// Epoch 5 is before the distribution start, the reduction period starts at epoch 6,
+	// and the provisions are halved at epoch 9
+	expectedProjections := []struct {
+		epochNumber int64
+		provisions  int64
+		minted      int64
+	}{
+		{epochNumber: 5, provisions: 1000, minted: 0},
+		{epochNumber: 6, provisions: 1000, minted: 1000},
+		{epochNumber: 7, provisions: 1000, minted: 1000},
+		{epochNumber: 8, provisions: 1000, minted: 1000},
+		{epochNumber: 9, provisions: 500, minted: 500},
+		{epochNumber: 10, provisions: 500, minted: 500},
+	}
+
+	resp, err := s.queryClient.ProjectedProvisions(context.Background(), &types.QueryProjectedProvisionsRequest{
+		NumEpochs: uint64(len(expectedProjections)),
+	})
+	s.Require().NoError(err, "no error expected when querying projected provisions")
+	s.Require().Len(resp.Projections, len(expectedProjections), "number of projections")
+
+	expectedSupply := initialSupply
+	for i, expected := range expectedProjections {
+		expectedSupply = expectedSupply.Add(sdkmath.NewInt(expected.minted))
+
+		projection := resp.Projections[i]
+		s.Require().Equal(expected.epochNumber, projection.EpochNumber, "epoch number %d", i)
+		s.Require().Equal(sdk.NewDec(expected.provisions), projection.EpochProvisions, "provisions for epoch %d", expected.epochNumber)
+		s.Require().Equal(expected.minted, projection.Minted.Int64(), "minted for epoch %d", expected.epochNumber)
+		s.Require().Equal(expectedSupply, projection.TotalSupply, "supply for epoch %d", expected.epochNumber)
+	}
+
+	// Confirm the projection did not modify state
+	s.Require().Equal(sdk.NewDec(1000), s.App.MintKeeper.GetMinter(s.Ctx).EpochProvisions, "minter after projection")
+	s.Require().Equal(initialSupply, s.App.BankKeeper.GetSupply(s.Ctx, "ustrd").Amount, "supply after projection")
+
+	// Confirm the number of epochs is bounded
+	_, err = s.queryClient.ProjectedProvisions(context.Background(), &types.QueryProjectedProvisionsRequest{NumEpochs: 0})
+	s.Require().ErrorContains(err, "number of epochs must be between", "zero epochs")
+
+	_, err = s.queryClient.ProjectedProvisions(context.Background(), &types.QueryProjectedProvisionsRequest{
+		NumEpochs: types.MaxProjectedEpochs + 1,
+	})
+	s.Require().ErrorContains(err, "number of epochs must be between", "too many epochs")
+}
+
+func (s *KeeperTestSuite) TestQueryProjectedProvisions_StakingRatioAdaptive() {
+	params := s.App.MintKeeper.GetParams(s.Ctx)
+	params.MintDenom = "ustrd"
+	params.MintingRewardsDistributionStartEpoch = 0
+	params.InflationSchedule = types.InflationSchedule{
+		ScheduleType:       types.SCHEDULE_STAKING_RATIO_ADAPTIVE,
+		TargetStakingRatio: sdk.OneDec(),
+		AdjustmentRate:     sdk.MustNewDecFromStr("0.5"),
+		MinEpochProvisions: sdk.ZeroDec(),
+		MaxEpochProvisions: sdk.NewDec(2000),
+	}
+	s.App.MintKeeper.SetParams(s.Ctx, params)
+
+	s.App.MintKeeper.SetMinter(s.Ctx, types.NewMinter(sdk.NewDec(1000)))
+	s.App.EpochsKeeper.SetEpochInfo(s.Ctx, epochstypes.EpochInfo{
+		Identifier:   params.EpochIdentifier,
+		CurrentEpoch: 1,
+	})
+
+	// The staking ratio is below the target, so the provisions should increase up to the max
+	resp, err := s.queryClient.ProjectedProvisions(context.Background(), &types.QueryProjectedProvisionsRequest{NumEpochs: 3})
+	s.Require().NoError(err, "no error expected when querying projected provisions")
+
+	expectedProvisions := []int64{1500, 2000, 2000}
+	for i, expected := range expectedProvisions {
+		s.Require().Equal(sdk.NewDec(expected), resp.Projections[i].EpochProvisions, "provisions for projection %d", i)
+	}
+}
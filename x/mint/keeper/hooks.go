@@ -25,16 +25,18 @@ func (k Keeper) AfterEpochEnd(ctx sdk.Context, epochInfo epochstypes.EpochInfo)
 		} else if epochNumber == params.MintingRewardsDistributionStartEpoch {
 			k.SetLastReductionEpochNum(ctx, epochNumber)
 		}
-		// fetch stored minter & params
+		// fetch stored minter
 		minter := k.GetMinter(ctx)
-		params := k.GetParams(ctx)
 
-		// Check if we have hit an epoch where we update the inflation parameter.
+		// Update the provisions according to the inflation schedule
 		// Since epochs only update based on BFT time data, it is safe to store the "reductioning period time"
 		// in terms of the number of epochs that have transpired.
-		if epochNumber >= k.GetParams(ctx).ReductionPeriodInEpochs+k.GetLastReductionEpochNum(ctx) {
-			// reduction the reward per reduction period
-			minter.EpochProvisions = minter.NextEpochProvisions(params)
+		// Schedules other than the step reduction adjust the provisions every epoch, which also restarts
+		// the reduction period in case governance switches back to the step reduction
+		stakingRatio := k.GetStakingRatio(ctx, params.MintDenom)
+		provisions, adjusted := minter.ScheduledEpochProvisions(params, epochNumber, k.GetLastReductionEpochNum(ctx), stakingRatio)
+		if adjusted {
+			minter.EpochProvisions = provisions
 			k.SetMinter(ctx, minter)
 			k.SetLastReductionEpochNum(ctx, epochNumber)
 		}
@@ -91,3 +93,7 @@ func (h Hooks) BeforeEpochStart(ctx sdk.Context, epochInfo epochstypes.EpochInfo
 func (h Hooks) AfterEpochEnd(ctx sdk.Context, epochInfo epochstypes.EpochInfo) {
 	h.k.AfterEpochEnd(ctx, epochInfo)
 }
+
+func (h Hooks) GetModuleName() string {
+	return types.ModuleName
+}
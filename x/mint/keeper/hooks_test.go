@@ -0,0 +1,106 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	epochstypes "github.com/Stride-Labs/stride/v24/x/epochs/types"
+	"github.com/Stride-Labs/stride/v24/x/mint/types"
+)
+
+func (s *KeeperTestSuite) TestAfterEpochEnd_InflationSchedules() {
+	startEpoch := int64(10)
+
+	testCases := []struct {
+		name               string
+		schedule           types.InflationSchedule
+		lastReductionEpoch int64
+		epochNumber        int64
+		expectedProvisions sdk.Dec
+		expectedReduction  int64
+	}{
+		{
+			name:               "step reduction - before reduction period",
+			schedule:           types.DefaultInflationSchedule(),
+			lastReductionEpoch: 10,
+			epochNumber:        11,
+			expectedProvisions: sdk.NewDec(1000),
+			expectedReduction:  10,
+		},
+		{
+			name:               "step reduction - after reduction period",
+			schedule:           types.DefaultInflationSchedule(),
+			lastReductionEpoch: 10,
+			epochNumber:        14,
+			expectedProvisions: sdk.NewDec(500),
+			expectedReduction:  14,
+		},
+		{
+			name: "exponential decay",
+			schedule: types.InflationSchedule{
+				ScheduleType: types.SCHEDULE_EXPONENTIAL_DECAY,
+				DecayRate:    sdk.MustNewDecFromStr("0.1"),
+			},
+			lastReductionEpoch: 10,
+			epochNumber:        11,
+			expectedProvisions: sdk.NewDec(900),
+			expectedReduction:  11,
+		},
+		{
+			name: "piecewise",
+			schedule: types.InflationSchedule{
+				ScheduleType: types.SCHEDULE_PIECEWISE,
+				Segments: []types.ScheduleSegment{
+					{StartEpoch: 10, EpochProvisions: sdk.NewDec(800)},
+					{StartEpoch: 20, EpochProvisions: sdk.NewDec(600)},
+				},
+			},
+			lastReductionEpoch: 10,
+			epochNumber:        11,
+			expectedProvisions: sdk.NewDec(800),
+			expectedReduction:  11,
+		},
+		{
+			name:               "before distribution start",
+			schedule:           types.DefaultInflationSchedule(),
+			lastReductionEpoch: 0,
+			epochNumber:        startEpoch - 1,
+			expectedProvisions: sdk.NewDec(1000),
+			expectedReduction:  0,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+
+			params := s.App.MintKeeper.GetParams(s.Ctx)
+			params.MintDenom = "ustrd"
+			params.ReductionPeriodInEpochs = 4
+			params.ReductionFactor = sdk.MustNewDecFromStr("0.5")
+			params.MintingRewardsDistributionStartEpoch = startEpoch
+			params.InflationSchedule = tc.schedule
+			s.App.MintKeeper.SetParams(s.Ctx, params)
+
+			s.App.MintKeeper.SetMinter(s.Ctx, types.NewMinter(sdk.NewDec(1000)))
+			s.App.MintKeeper.SetLastReductionEpochNum(s.Ctx, tc.lastReductionEpoch)
+
+			initialSupply := s.App.BankKeeper.GetSupply(s.Ctx, "ustrd").Amount
+
+			s.App.MintKeeper.AfterEpochEnd(s.Ctx, epochstypes.EpochInfo{
+				Identifier:   params.EpochIdentifier,
+				CurrentEpoch: tc.epochNumber,
+			})
+
+			minter := s.App.MintKeeper.GetMinter(s.Ctx)
+			s.Require().Equal(tc.expectedProvisions, minter.EpochProvisions, "epoch provisions")
+			s.Require().Equal(tc.expectedReduction, s.App.MintKeeper.GetLastReductionEpochNum(s.Ctx), "last reduction epoch")
+
+			expectedMinted := tc.expectedProvisions.TruncateInt()
+			if tc.epochNumber < startEpoch {
+				expectedMinted = sdk.ZeroInt()
+			}
+			finalSupply := s.App.BankKeeper.GetSupply(s.Ctx, "ustrd").Amount
+			s.Require().Equal(expectedMinted.Int64(), finalSupply.Sub(initialSupply).Int64(), "minted")
+		})
+	}
+}
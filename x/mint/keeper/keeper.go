@@ -7,15 +7,18 @@ import (
 	"github.com/spf13/cast"
 
 	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
+	"github.com/Stride-Labs/stride/v24/utils"
 	"github.com/Stride-Labs/stride/v24/x/mint/types"
 
 	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
-	"github.com/cosmos/cosmos-sdk/types/address"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 )
 
@@ -28,6 +31,7 @@ type Keeper struct {
 	bankKeeper       types.BankKeeper
 	distrKeeper      types.DistrKeeper
 	epochKeeper      types.EpochKeeper
+	contractKeeper   types.ContractKeeper
 	hooks            types.MintHooks
 	feeCollectorName string
 }
@@ -36,7 +40,7 @@ type Keeper struct {
 func NewKeeper(
 	cdc codec.BinaryCodec, key storetypes.StoreKey, paramSpace paramtypes.Subspace,
 	ak types.AccountKeeper, bk types.BankKeeper, dk types.DistrKeeper, epochKeeper types.EpochKeeper,
-	feeCollectorName string,
+	contractKeeper types.ContractKeeper, feeCollectorName string,
 ) Keeper {
 	// ensure mint module account is set
 	if addr := ak.GetModuleAddress(types.ModuleName); addr == nil {
@@ -56,6 +60,7 @@ func NewKeeper(
 		bankKeeper:       bk,
 		distrKeeper:      dk,
 		epochKeeper:      epochKeeper,
+		contractKeeper:   contractKeeper,
 		feeCollectorName: feeCollectorName,
 	}
 }
@@ -95,6 +100,50 @@ func (k Keeper) SetLastReductionEpochNum(ctx sdk.Context, epochNum int64) {
 	store.Set(types.LastReductionEpochKey, sdk.Uint64ToBigEndian(cast.ToUint64(epochNum)))
 }
 
+// GetRecipientEmissions returns the cumulative emissions to a recipient.
+func (k Keeper) GetRecipientEmissions(ctx sdk.Context, name string) sdkmath.Int {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.RecipientEmissionsKeyPrefix)
+	b := store.Get([]byte(name))
+	if b == nil {
+		return sdkmath.ZeroInt()
+	}
+
+	var emissions types.RecipientEmissions
+	k.cdc.MustUnmarshal(b, &emissions)
+	return emissions.CumulativeEmissions
+}
+
+// SetRecipientEmissions sets the cumulative emissions to a recipient.
+func (k Keeper) SetRecipientEmissions(ctx sdk.Context, emissions types.RecipientEmissions) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.RecipientEmissionsKeyPrefix)
+	b := k.cdc.MustMarshal(&emissions)
+	store.Set([]byte(emissions.Name), b)
+}
+
+// AddRecipientEmissions increments the cumulative emissions to a recipient.
+func (k Keeper) AddRecipientEmissions(ctx sdk.Context, name string, amount sdkmath.Int) {
+	k.SetRecipientEmissions(ctx, types.RecipientEmissions{
+		Name:                name,
+		CumulativeEmissions: k.GetRecipientEmissions(ctx, name).Add(amount),
+	})
+}
+
+// GetAllRecipientEmissions returns the cumulative emissions for each current and past recipient.
+func (k Keeper) GetAllRecipientEmissions(ctx sdk.Context) []types.RecipientEmissions {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.RecipientEmissionsKeyPrefix)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	allEmissions := []types.RecipientEmissions{}
+	for ; iterator.Valid(); iterator.Next() {
+		var emissions types.RecipientEmissions
+		k.cdc.MustUnmarshal(iterator.Value(), &emissions)
+		allEmissions = append(allEmissions, emissions)
+	}
+
+	return allEmissions
+}
+
 // get the minter.
 func (k Keeper) GetMinter(ctx sdk.Context) (minter types.Minter) {
 	store := ctx.KVStore(k.storeKey)
@@ -127,6 +176,89 @@ func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
 	k.paramSpace.SetParamSet(ctx, &params)
 }
 
+// SetInflationSchedule sets only the inflation schedule parameter.
+func (k Keeper) SetInflationSchedule(ctx sdk.Context, schedule types.InflationSchedule) {
+	k.paramSpace.Set(ctx, types.KeyInflationSchedule, &schedule)
+}
+
+// GetDistributionProportions returns only the deprecated distribution proportions parameter.
+func (k Keeper) GetDistributionProportions(ctx sdk.Context) (proportions types.DistributionProportions) {
+	k.paramSpace.Get(ctx, types.KeyPoolAllocationRatio, &proportions)
+	return proportions
+}
+
+// SetDistributionRecipients sets only the distribution recipients parameter.
+func (k Keeper) SetDistributionRecipients(ctx sdk.Context, recipients []types.MintRecipient) {
+	k.paramSpace.Set(ctx, types.KeyDistributionRecipients, &recipients)
+}
+
+// GetStakingRatio returns the ratio of bonded tokens to the total supply of the mint denom.
+func (k Keeper) GetStakingRatio(ctx sdk.Context, mintDenom string) sdk.Dec {
+	totalSupply := k.bankKeeper.GetSupply(ctx, mintDenom).Amount
+	if totalSupply.IsZero() {
+		return sdk.ZeroDec()
+	}
+
+	bondedPoolAddress := k.accountKeeper.GetModuleAddress(stakingtypes.BondedPoolName)
+	bondedTokens := k.bankKeeper.GetBalance(ctx, bondedPoolAddress, mintDenom).Amount
+
+	return sdk.NewDecFromInt(bondedTokens).Quo(sdk.NewDecFromInt(totalSupply))
+}
+
+// ProjectEpochProvisions simulates the inflation schedule over the next epochs, returning
+// the provisions, minted amount, and total supply at the end of each epoch
+// The staking ratio is assumed to stay at its current value
+func (k Keeper) ProjectEpochProvisions(ctx sdk.Context, numEpochs uint64) ([]types.EpochProjection, error) {
+	params := k.GetParams(ctx)
+	epochInfo, found := k.epochKeeper.GetEpochInfo(ctx, params.EpochIdentifier)
+	if !found {
+		return nil, fmt.Errorf("epoch %s not found", params.EpochIdentifier)
+	}
+
+	minter := k.GetMinter(ctx)
+	lastReductionEpoch := k.GetLastReductionEpochNum(ctx)
+	totalSupply := k.bankKeeper.GetSupply(ctx, params.MintDenom).Amount
+	stakingRatio := k.GetStakingRatio(ctx, params.MintDenom)
+
+	// The mint happens when the current epoch ends, or when the first epoch ends
+	// if the epoch has not started yet
+	firstEpoch := epochInfo.CurrentEpoch
+	if firstEpoch < 1 {
+		firstEpoch = 1
+	}
+
+	projections := []types.EpochProjection{}
+	for i := int64(0); i < cast.ToInt64(numEpochs); i++ {
+		epochNumber := firstEpoch + i
+		minted := sdkmath.ZeroInt()
+
+		// Mirrors AfterEpochEnd
+		if epochNumber >= params.MintingRewardsDistributionStartEpoch {
+			if epochNumber == params.MintingRewardsDistributionStartEpoch {
+				lastReductionEpoch = epochNumber
+			}
+
+			provisions, adjusted := minter.ScheduledEpochProvisions(params, epochNumber, lastReductionEpoch, stakingRatio)
+			if adjusted {
+				minter.EpochProvisions = provisions
+				lastReductionEpoch = epochNumber
+			}
+
+			minted = minter.EpochProvision(params).Amount
+			totalSupply = totalSupply.Add(minted)
+		}
+
+		projections = append(projections, types.EpochProjection{
+			EpochNumber:     epochNumber,
+			EpochProvisions: minter.EpochProvisions,
+			Minted:          minted,
+			TotalSupply:     totalSupply,
+		})
+	}
+
+	return projections, nil
+}
+
 // _____________________________________________________________________
 
 // MintCoins implements an alias call to the underlying supply keeper's
@@ -145,86 +277,125 @@ func (k Keeper) GetProportions(ctx sdk.Context, mintedCoin sdk.Coin, ratio sdk.D
 	return sdk.NewCoin(mintedCoin.Denom, sdk.NewDecFromInt(mintedCoin.Amount).Mul(ratio).TruncateInt())
 }
 
-const (
-	// strategic reserve address F0
-	StrategicReserveAddress = "stride1alnn79kh0xka0r5h4h82uuaqfhpdmph6rvpf5f"
-)
-
-// DistributeMintedCoins implements distribution of minted coins from mint to external modules.
+// DistributeMintedCoins implements distribution of minted coins from mint to each of the weighted recipients.
+// The rounding remainder is sent to the first recipient
 func (k Keeper) DistributeMintedCoin(ctx sdk.Context, mintedCoin sdk.Coin) error {
 	params := k.GetParams(ctx)
-	proportions := params.DistributionProportions
+	recipients := params.DistributionRecipients
+	if len(recipients) == 0 {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "no distribution recipients")
+	}
 
 	k.Logger(ctx).Info(fmt.Sprintf("Distributing minted x/mint rewards: %d coins...", mintedCoin.Amount.Int64()))
 
-	// allocate staking incentives into fee collector account to be moved to on next begin blocker by staking module
-	stakingIncentivesProportions := k.GetProportions(ctx, mintedCoin, proportions.Staking)
-	stakingIncentivesCoins := sdk.NewCoins(stakingIncentivesProportions)
-	k.Logger(ctx).Info(fmt.Sprintf("\t\t\t...staking rewards: %d to %s", stakingIncentivesProportions.Amount.Int64(), k.feeCollectorName))
-
-	// allocate pool allocation ratio to strategic reserve
-	strategicReserveAddress, err := sdk.AccAddressFromBech32(StrategicReserveAddress)
-	if err != nil {
-		return errorsmod.Wrapf(err, "invalid strategic reserve address: %s", StrategicReserveAddress)
-	}
-	strategicReserveProportion := k.GetProportions(ctx, mintedCoin, proportions.StrategicReserve)
-	strategicReserveCoins := sdk.NewCoins(strategicReserveProportion)
-	k.Logger(ctx).Info(fmt.Sprintf("\t\t\t...strategic reserve: %d to %s", strategicReserveProportion.Amount.Int64(), strategicReserveAddress))
-
-	// allocate pool allocation ratio to community growth pool
-	communityPoolGrowthAddress := k.GetSubmoduleAddress(types.CommunityGrowthSubmoduleName, types.SubmoduleCommunityNamespaceKey)
-	communityPoolGrowthProportion := k.GetProportions(ctx, mintedCoin, proportions.CommunityPoolGrowth)
-	communityPoolGrowthCoins := sdk.NewCoins(communityPoolGrowthProportion)
-	k.Logger(ctx).Info(fmt.Sprintf("\t\t\t...community growth: %d to %s", communityPoolGrowthProportion.Amount.Int64(), communityPoolGrowthAddress))
-
-	// allocate pool allocation ratio to security budget pool
-	communityPoolSecurityBudgetAddress := k.GetSubmoduleAddress(types.CommunitySecurityBudgetSubmoduleName, types.SubmoduleCommunityNamespaceKey)
-	communityPoolSecurityBudgetProportion := k.GetProportions(ctx, mintedCoin, proportions.CommunityPoolSecurityBudget)
-	communityPoolSecurityBudgetCoins := sdk.NewCoins(communityPoolSecurityBudgetProportion)
-	k.Logger(ctx).Info(fmt.Sprintf("\t\t\t...community security budget: %d to %s", communityPoolSecurityBudgetProportion.Amount.Int64(), communityPoolSecurityBudgetAddress))
-
-	// remaining tokens to the community growth pool (this should NEVER happen, barring rounding imprecision)
-	remainingCoins := sdk.NewCoins(mintedCoin).
-		Sub(stakingIncentivesCoins...).
-		Sub(strategicReserveCoins...).
-		Sub(communityPoolGrowthCoins...).
-		Sub(communityPoolSecurityBudgetCoins...)
-
-	// check: remaining coins should be less than 5% of minted coins
-	remainingBal := remainingCoins.AmountOf(sdk.DefaultBondDenom)
-	thresh := sdk.NewDec(5).Quo(sdk.NewDec(100))
-	if sdk.NewDecFromInt(remainingBal).Quo(sdk.NewDecFromInt(mintedCoin.Amount)).GT(thresh) {
+	// Determine each recipient's share, with the remainder going to the first recipient
+	shares := make([]sdk.Coin, len(recipients))
+	remaining := mintedCoin.Amount
+	for i := len(recipients) - 1; i > 0; i-- {
+		shares[i] = k.GetProportions(ctx, mintedCoin, recipients[i].Weight)
+		remaining = remaining.Sub(shares[i].Amount)
+	}
+	if remaining.IsNegative() {
 		return errorsmod.Wrapf(sdkerrors.ErrInsufficientFunds,
-			"Failed to divvy up mint module rewards fully -- remaining coins should be LT 5pct of total, instead are %#v/%#v",
-			remainingCoins, remainingBal)
+			"distribution recipient shares exceed minted amount %v", mintedCoin)
 	}
+	shares[0] = sdk.NewCoin(mintedCoin.Denom, remaining)
+
+	for i, recipient := range recipients {
+		if shares[i].IsZero() {
+			continue
+		}
+		if err := k.sendToRecipient(ctx, recipient, recipients[0], shares[i]); err != nil {
+			return errorsmod.Wrapf(err, "unable to distribute minted coins to %s", recipient.Name)
+		}
+	}
+
+	// call a hook after the minting and distribution of new coins
+	// see osmosis' pool incentives hooks.go for an example
+	// k.hooks.AfterDistributeMintedCoin(ctx, mintedCoin)
+
+	return nil
+}
 
-	err = k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, k.feeCollectorName, stakingIncentivesCoins)
+// Sends a recipient's share of the minted coins and records the emissions
+// If a recipient cannot receive the tokens (e.g. the module account does not exist, the address is
+// blocked, or a wasm contract fails to process the sudo message), its share is sent to the fallback
+// recipient instead so that a misconfigured recipient cannot halt minting
+func (k Keeper) sendToRecipient(ctx sdk.Context, recipient, fallback types.MintRecipient, share sdk.Coin) error {
+	err := utils.ApplyFuncIfNoError(ctx, func(ctx sdk.Context) error {
+		return k.sendToRecipientAccount(ctx, recipient, share)
+	})
 	if err != nil {
-		return err
+		// The fallback recipient has nowhere else to send its share
+		if recipient.Name == fallback.Name {
+			return err
+		}
+
+		k.Logger(ctx).Error(fmt.Sprintf("Unable to distribute minted coins to %s (%s), sending to %s instead: %s",
+			recipient.Name, recipient.Address, fallback.Name, err.Error()))
+
+		recipient = fallback
+		if err := k.sendToRecipientAccount(ctx, recipient, share); err != nil {
+			return err
+		}
 	}
-	err = k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, strategicReserveAddress, strategicReserveCoins)
-	if err != nil {
-		return err
+
+	k.Logger(ctx).Info(fmt.Sprintf("\t\t\t...%s: %d to %s", recipient.Name, share.Amount.Int64(), recipient.Address))
+	k.AddRecipientEmissions(ctx, recipient.Name, share.Amount)
+
+	return nil
+}
+
+// Sends minted coins to a recipient based on its recipient type
+func (k Keeper) sendToRecipientAccount(ctx sdk.Context, recipient types.MintRecipient, share sdk.Coin) error {
+	switch recipient.RecipientType {
+	case types.RECIPIENT_MODULE_ACCOUNT:
+		return k.sendToModuleAccount(ctx, recipient.Address, share)
+	case types.RECIPIENT_ADDRESS:
+		return k.sendToAddress(ctx, recipient.Address, share)
+	case types.RECIPIENT_WASM_CONTRACT:
+		return k.sendToContract(ctx, recipient, share)
+	default:
+		return fmt.Errorf("invalid recipient type: %s", recipient.RecipientType.String())
 	}
-	err = k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, communityPoolGrowthAddress, communityPoolGrowthCoins)
-	if err != nil {
-		return err
+}
+
+// Sends minted coins to a module account
+// The bank keeper panics if the recipient module account is not registered, so that is checked first
+// Module accounts are blocked from receiving tokens by address, but can still receive from another module
+func (k Keeper) sendToModuleAccount(ctx sdk.Context, moduleName string, share sdk.Coin) error {
+	if k.accountKeeper.GetModuleAccount(ctx, moduleName) == nil {
+		return errorsmod.Wrapf(sdkerrors.ErrUnknownAddress, "module account %s does not exist", moduleName)
 	}
-	err = k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, communityPoolSecurityBudgetAddress, communityPoolSecurityBudgetCoins)
+	return k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, moduleName, sdk.NewCoins(share))
+}
+
+// Sends minted coins to a bech32 address
+func (k Keeper) sendToAddress(ctx sdk.Context, address string, share sdk.Coin) error {
+	recipientAddress, err := sdk.AccAddressFromBech32(address)
 	if err != nil {
-		return err
+		return errorsmod.Wrapf(err, "invalid recipient address: %s", address)
 	}
-	err = k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, communityPoolGrowthAddress, remainingCoins)
-	if err != nil {
+	if k.bankKeeper.BlockedAddr(recipientAddress) {
+		return errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive funds", address)
+	}
+	return k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, recipientAddress, sdk.NewCoins(share))
+}
+
+// Sends minted coins to a wasm contract and notifies the contract with a sudo message
+func (k Keeper) sendToContract(ctx sdk.Context, recipient types.MintRecipient, share sdk.Coin) error {
+	if err := k.sendToAddress(ctx, recipient.Address, share); err != nil {
 		return err
 	}
 
-	// call a hook after the minting and distribution of new coins
-	// see osmosis' pool incentives hooks.go for an example
-	// k.hooks.AfterDistributeMintedCoin(ctx, mintedCoin)
+	sudoMsg, err := types.NewMintDistributionSudoMsg(share)
+	if err != nil {
+		return errorsmod.Wrapf(err, "unable to build mint distribution sudo message")
+	}
 
-	return nil
+	contractAddress := sdk.MustAccAddressFromBech32(recipient.Address)
+	_, err = k.contractKeeper.Sudo(ctx, contractAddress, sudoMsg)
+	return err
 }
 
 // set up a new module account address
@@ -244,6 +415,5 @@ func (k Keeper) SetupNewModuleAccount(ctx sdk.Context, submoduleName string, sub
 
 // helper: get the address of a submodule
 func (k Keeper) GetSubmoduleAddress(submoduleName string, submoduleNamespace string) sdk.AccAddress {
-	key := append([]byte(submoduleNamespace), []byte(submoduleName)...)
-	return address.Module(types.ModuleName, key)
+	return types.SubmoduleAddress(submoduleName, submoduleNamespace)
 }
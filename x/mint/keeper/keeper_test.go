@@ -0,0 +1,184 @@
+package keeper_test
+
+import (
+	"context"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/Stride-Labs/stride/v24/app/apptesting"
+	"github.com/Stride-Labs/stride/v24/x/mint/types"
+)
+
+type KeeperTestSuite struct {
+	apptesting.AppTestHelper
+	queryClient types.QueryClient
+}
+
+func (s *KeeperTestSuite) SetupTest() {
+	s.Setup()
+	s.queryClient = types.NewQueryClient(s.QueryHelper)
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
+
+func (s *KeeperTestSuite) TestDistributeMintedCoin() {
+	fallbackAddress := s.TestAccs[0]
+	plainAddress := s.TestAccs[1]
+	nonContractAddress := s.TestAccs[2]
+	feeCollectorAddress := s.App.AccountKeeper.GetModuleAddress(authtypes.FeeCollectorName)
+
+	// The "contract" recipient is not a contract, so the sudo call will fail
+	// and its share should be sent to the first recipient instead
+	params := s.App.MintKeeper.GetParams(s.Ctx)
+	params.MintDenom = "ustrd"
+	params.DistributionRecipients = []types.MintRecipient{
+		{
+			Name:          "fallback",
+			RecipientType: types.RECIPIENT_ADDRESS,
+			Address:       fallbackAddress.String(),
+			Weight:        sdk.MustNewDecFromStr("0.1"),
+		},
+		{
+			Name:          "staking",
+			RecipientType: types.RECIPIENT_MODULE_ACCOUNT,
+			Address:       authtypes.FeeCollectorName,
+			Weight:        sdk.MustNewDecFromStr("0.333"),
+		},
+		{
+			Name:          "address",
+			RecipientType: types.RECIPIENT_ADDRESS,
+			Address:       plainAddress.String(),
+			Weight:        sdk.MustNewDecFromStr("0.333"),
+		},
+		{
+			Name:          "contract",
+			RecipientType: types.RECIPIENT_WASM_CONTRACT,
+			Address:       nonContractAddress.String(),
+			Weight:        sdk.MustNewDecFromStr("0.234"),
+		},
+	}
+	s.App.MintKeeper.SetParams(s.Ctx, params)
+
+	initialFeeCollectorBalance := s.App.BankKeeper.GetBalance(s.Ctx, feeCollectorAddress, "ustrd").Amount
+
+	// Distribute twice to confirm the emissions accumulate
+	mintedCoin := sdk.NewInt64Coin("ustrd", 1000)
+	for i := 0; i < 2; i++ {
+		err := s.App.BankKeeper.MintCoins(s.Ctx, types.ModuleName, sdk.NewCoins(mintedCoin))
+		s.Require().NoError(err, "no error expected when minting")
+
+		err = s.App.MintKeeper.DistributeMintedCoin(s.Ctx, mintedCoin)
+		s.Require().NoError(err, "no error expected when distributing minted coins")
+	}
+
+	// Fallback receives 100 + 233 (contract) + 1 (remainder) each distribution
+	expectedBalances := []struct {
+		name    string
+		address sdk.AccAddress
+		amount  int64
+	}{
+		{name: "fallback", address: fallbackAddress, amount: 668},
+		{name: "address", address: plainAddress, amount: 666},
+		{name: "contract", address: nonContractAddress, amount: 0},
+	}
+	for _, expected := range expectedBalances {
+		balance := s.App.BankKeeper.GetBalance(s.Ctx, expected.address, "ustrd").Amount
+		s.Require().Equal(expected.amount, balance.Int64(), "%s balance", expected.name)
+	}
+
+	feeCollectorBalance := s.App.BankKeeper.GetBalance(s.Ctx, feeCollectorAddress, "ustrd").Amount
+	s.Require().Equal(int64(666), feeCollectorBalance.Sub(initialFeeCollectorBalance).Int64(), "fee collector balance")
+
+	mintModuleBalance := s.App.BankKeeper.GetBalance(s.Ctx, s.App.AccountKeeper.GetModuleAddress(types.ModuleName), "ustrd")
+	s.Require().Zero(mintModuleBalance.Amount.Int64(), "mint module balance")
+
+	// Confirm the emissions were recorded for each recipient that received tokens
+	expectedEmissions := map[string]int64{
+		"fallback": 668,
+		"staking":  666,
+		"address":  666,
+	}
+	allEmissions := s.App.MintKeeper.GetAllRecipientEmissions(s.Ctx)
+	s.Require().Len(allEmissions, len(expectedEmissions), "number of recipient emissions")
+	for _, emissions := range allEmissions {
+		s.Require().Equal(expectedEmissions[emissions.Name], emissions.CumulativeEmissions.Int64(), "%s emissions", emissions.Name)
+	}
+
+	// Confirm the query returns the same emissions
+	resp, err := s.queryClient.RecipientEmissions(context.Background(), &types.QueryRecipientEmissionsRequest{})
+	s.Require().NoError(err, "no error expected when querying recipient emissions")
+	s.Require().Equal(allEmissions, resp.Emissions, "queried emissions")
+}
+
+func (s *KeeperTestSuite) TestDistributeMintedCoin_InvalidRecipients() {
+	fallbackAddress := s.TestAccs[0]
+	blockedAddress := s.App.AccountKeeper.GetModuleAddress(distrtypes.ModuleName)
+
+	// Neither the unregistered module account nor the blocked address can receive tokens,
+	// so their shares should be sent to the first recipient instead
+	params := s.App.MintKeeper.GetParams(s.Ctx)
+	params.MintDenom = "ustrd"
+	params.DistributionRecipients = []types.MintRecipient{
+		{
+			Name:          "fallback",
+			RecipientType: types.RECIPIENT_ADDRESS,
+			Address:       fallbackAddress.String(),
+			Weight:        sdk.MustNewDecFromStr("0.5"),
+		},
+		{
+			Name:          "unknown-module",
+			RecipientType: types.RECIPIENT_MODULE_ACCOUNT,
+			Address:       "unknown",
+			Weight:        sdk.MustNewDecFromStr("0.25"),
+		},
+		{
+			Name:          "blocked",
+			RecipientType: types.RECIPIENT_ADDRESS,
+			Address:       blockedAddress.String(),
+			Weight:        sdk.MustNewDecFromStr("0.25"),
+		},
+	}
+	s.App.MintKeeper.SetParams(s.Ctx, params)
+
+	initialBlockedBalance := s.App.BankKeeper.GetBalance(s.Ctx, blockedAddress, "ustrd").Amount
+
+	mintedCoin := sdk.NewInt64Coin("ustrd", 1000)
+	err := s.App.BankKeeper.MintCoins(s.Ctx, types.ModuleName, sdk.NewCoins(mintedCoin))
+	s.Require().NoError(err, "no error expected when minting")
+
+	err = s.App.MintKeeper.DistributeMintedCoin(s.Ctx, mintedCoin)
+	s.Require().NoError(err, "no error expected when distributing minted coins")
+
+	fallbackBalance := s.App.BankKeeper.GetBalance(s.Ctx, fallbackAddress, "ustrd").Amount
+	s.Require().Equal(int64(1000), fallbackBalance.Int64(), "fallback balance")
+
+	blockedBalance := s.App.BankKeeper.GetBalance(s.Ctx, blockedAddress, "ustrd").Amount
+	s.Require().Equal(initialBlockedBalance, blockedBalance, "blocked address balance")
+
+	allEmissions := s.App.MintKeeper.GetAllRecipientEmissions(s.Ctx)
+	s.Require().Len(allEmissions, 1, "number of recipient emissions")
+	s.Require().Equal("fallback", allEmissions[0].Name, "recipient with emissions")
+
+	// If the first recipient is invalid, there's nowhere to send the tokens and it should error
+	params.DistributionRecipients = []types.MintRecipient{
+		{
+			Name:          "unknown-module",
+			RecipientType: types.RECIPIENT_MODULE_ACCOUNT,
+			Address:       "unknown",
+			Weight:        sdk.OneDec(),
+		},
+	}
+	s.App.MintKeeper.SetParams(s.Ctx, params)
+
+	err = s.App.BankKeeper.MintCoins(s.Ctx, types.ModuleName, sdk.NewCoins(mintedCoin))
+	s.Require().NoError(err, "no error expected when minting")
+
+	err = s.App.MintKeeper.DistributeMintedCoin(s.Ctx, mintedCoin)
+	s.Require().ErrorContains(err, "module account unknown does not exist")
+}
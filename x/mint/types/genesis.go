@@ -1,11 +1,17 @@
 package types
 
+import (
+	"errors"
+	"fmt"
+)
+
 // NewGenesisState creates a new GenesisState object.
-func NewGenesisState(minter Minter, params Params, ReductionStartedEpoch int64) *GenesisState {
+func NewGenesisState(minter Minter, params Params, ReductionStartedEpoch int64, recipientEmissions []RecipientEmissions) *GenesisState {
 	return &GenesisState{
 		Minter:                minter,
 		Params:                params,
 		ReductionStartedEpoch: ReductionStartedEpoch,
+		RecipientEmissions:    recipientEmissions,
 	}
 }
 
@@ -25,5 +31,20 @@ func ValidateGenesis(data GenesisState) error {
 		return err
 	}
 
+	names := map[string]bool{}
+	for _, emissions := range data.RecipientEmissions {
+		if emissions.Name == "" {
+			return errors.New("recipient emissions name cannot be blank")
+		}
+		if names[emissions.Name] {
+			return fmt.Errorf("duplicate recipient emissions for %s", emissions.Name)
+		}
+		names[emissions.Name] = true
+
+		if emissions.CumulativeEmissions.IsNil() || emissions.CumulativeEmissions.IsNegative() {
+			return fmt.Errorf("recipient emissions for %s must be non-negative", emissions.Name)
+		}
+	}
+
 	return ValidateMinter(data.Minter)
 }
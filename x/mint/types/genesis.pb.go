@@ -31,6 +31,8 @@ type GenesisState struct {
 	Params Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
 	// current reduction period start epoch
 	ReductionStartedEpoch int64 `protobuf:"varint,3,opt,name=reduction_started_epoch,json=reductionStartedEpoch,proto3" json:"reduction_started_epoch,omitempty" yaml:"reduction_started_epoch"`
+	// cumulative emissions for each recipient
+	RecipientEmissions []RecipientEmissions `protobuf:"bytes,4,rep,name=recipient_emissions,json=recipientEmissions,proto3" json:"recipient_emissions" yaml:"recipient_emissions"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -87,6 +89,13 @@ func (m *GenesisState) GetReductionStartedEpoch() int64 {
 	return 0
 }
 
+func (m *GenesisState) GetRecipientEmissions() []RecipientEmissions {
+	if m != nil {
+		return m.RecipientEmissions
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "stride.mint.v1beta1.GenesisState")
 }
@@ -94,25 +103,28 @@ func init() {
 func init() { proto.RegisterFile("stride/mint/v1beta1/genesis.proto", fileDescriptor_f4521d63f51851f3) }
 
 var fileDescriptor_f4521d63f51851f3 = []byte{
-	// 283 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x2c, 0x2e, 0x29, 0xca,
-	0x4c, 0x49, 0xd5, 0xcf, 0xcd, 0xcc, 0x2b, 0xd1, 0x2f, 0x33, 0x4c, 0x4a, 0x2d, 0x49, 0x34, 0xd4,
-	0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x86,
-	0x28, 0xd1, 0x03, 0x29, 0xd1, 0x83, 0x2a, 0x91, 0x12, 0x49, 0xcf, 0x4f, 0xcf, 0x07, 0xcb, 0xeb,
-	0x83, 0x58, 0x10, 0xa5, 0x52, 0x72, 0xd8, 0x4c, 0x03, 0xeb, 0x03, 0xcb, 0x2b, 0x3d, 0x60, 0xe4,
-	0xe2, 0x71, 0x87, 0x18, 0x1e, 0x5c, 0x92, 0x58, 0x92, 0x2a, 0x64, 0xc9, 0xc5, 0x06, 0x92, 0x4e,
-	0x2d, 0x92, 0x60, 0x54, 0x60, 0xd4, 0xe0, 0x36, 0x92, 0xd6, 0xc3, 0x62, 0x99, 0x9e, 0x2f, 0x58,
-	0x89, 0x13, 0xcb, 0x89, 0x7b, 0xf2, 0x0c, 0x41, 0x50, 0x0d, 0x20, 0xad, 0x05, 0x89, 0x45, 0x89,
-	0xb9, 0xc5, 0x12, 0x4c, 0x78, 0xb4, 0x06, 0x80, 0x95, 0xc0, 0xb4, 0x42, 0x34, 0x08, 0x45, 0x71,
-	0x89, 0x17, 0xa5, 0xa6, 0x94, 0x26, 0x97, 0x64, 0xe6, 0xe7, 0xc5, 0x17, 0x97, 0x24, 0x16, 0x95,
-	0xa4, 0xa6, 0xc4, 0xa7, 0x16, 0xe4, 0x27, 0x67, 0x48, 0x30, 0x2b, 0x30, 0x6a, 0x30, 0x3b, 0x29,
-	0x7d, 0xba, 0x27, 0x2f, 0x57, 0x99, 0x98, 0x9b, 0x63, 0xa5, 0x84, 0x43, 0xa1, 0x52, 0x90, 0x28,
-	0x5c, 0x26, 0x18, 0x22, 0xe1, 0x0a, 0x12, 0x77, 0xf2, 0x38, 0xf1, 0x48, 0x8e, 0xf1, 0xc2, 0x23,
-	0x39, 0xc6, 0x07, 0x8f, 0xe4, 0x18, 0x27, 0x3c, 0x96, 0x63, 0xb8, 0xf0, 0x58, 0x8e, 0xe1, 0xc6,
-	0x63, 0x39, 0x86, 0x28, 0xbd, 0xf4, 0xcc, 0x92, 0x8c, 0xd2, 0x24, 0xbd, 0xe4, 0xfc, 0x5c, 0xfd,
-	0x60, 0xb0, 0x53, 0x75, 0x7d, 0x12, 0x93, 0x8a, 0xf5, 0xa1, 0x61, 0x56, 0x66, 0x64, 0xa2, 0x5f,
-	0x01, 0x09, 0xb9, 0x92, 0xca, 0x82, 0xd4, 0xe2, 0x24, 0x36, 0x70, 0x98, 0x19, 0x03, 0x02, 0x00,
-	0x00, 0xff, 0xff, 0x25, 0x0a, 0xd8, 0xa9, 0xa3, 0x01, 0x00, 0x00,
+	// 331 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x91, 0x31, 0x6b, 0xf2, 0x40,
+	0x1c, 0xc6, 0x13, 0x15, 0x87, 0xf8, 0x4e, 0xf1, 0x2d, 0x0d, 0x16, 0x4e, 0x9b, 0xa5, 0x2e, 0xbd,
+	0x43, 0xdb, 0xa5, 0x1d, 0x05, 0x69, 0x87, 0x16, 0x4a, 0xdc, 0x5c, 0xe4, 0x12, 0xff, 0xc4, 0x83,
+	0x26, 0x17, 0xee, 0x4e, 0xa9, 0xd0, 0x0f, 0xd1, 0x8f, 0xe5, 0xe8, 0xd8, 0x29, 0x14, 0xfd, 0x06,
+	0x7e, 0x82, 0x92, 0x3b, 0xd3, 0xc5, 0xb4, 0x5b, 0xc8, 0xf3, 0xfb, 0xfd, 0xef, 0x81, 0xc7, 0xb9,
+	0x94, 0x4a, 0xb0, 0x39, 0x90, 0x84, 0xa5, 0x8a, 0xac, 0x06, 0x21, 0x28, 0x3a, 0x20, 0x31, 0xa4,
+	0x20, 0x99, 0xc4, 0x99, 0xe0, 0x8a, 0xbb, 0x6d, 0x83, 0xe0, 0x02, 0xc1, 0x47, 0xa4, 0xf3, 0x3f,
+	0xe6, 0x31, 0xd7, 0x39, 0x29, 0xbe, 0x0c, 0xda, 0x41, 0x55, 0xd7, 0xb4, 0xa7, 0x73, 0x3f, 0xaf,
+	0x39, 0xff, 0x1e, 0xcc, 0xf1, 0x89, 0xa2, 0x0a, 0xdc, 0x3b, 0xa7, 0x59, 0xc4, 0x20, 0x3c, 0xbb,
+	0x67, 0xf7, 0x5b, 0xc3, 0x0b, 0x5c, 0xf1, 0x18, 0x7e, 0xd6, 0xc8, 0xa8, 0xb1, 0xc9, 0xbb, 0x56,
+	0x70, 0x14, 0x0a, 0x35, 0xa3, 0x82, 0x26, 0xd2, 0xab, 0xfd, 0xa1, 0xbe, 0x68, 0xa4, 0x54, 0x8d,
+	0xe0, 0x4e, 0x9d, 0x73, 0x01, 0xf3, 0x65, 0xa4, 0x18, 0x4f, 0x67, 0x52, 0x51, 0xa1, 0x60, 0x3e,
+	0x83, 0x8c, 0x47, 0x0b, 0xaf, 0xde, 0xb3, 0xfb, 0xf5, 0x91, 0x7f, 0xc8, 0xbb, 0x68, 0x4d, 0x93,
+	0xd7, 0x7b, 0xff, 0x17, 0xd0, 0x0f, 0xce, 0x7e, 0x92, 0x89, 0x09, 0xc6, 0xc5, 0x7f, 0xf7, 0xdd,
+	0x69, 0x0b, 0x88, 0x58, 0xc6, 0x20, 0x55, 0x33, 0x48, 0x98, 0x94, 0x8c, 0xa7, 0xd2, 0x6b, 0xf4,
+	0xea, 0xfd, 0xd6, 0xf0, 0xaa, 0xb2, 0x63, 0x50, 0xf2, 0xe3, 0x12, 0x1f, 0xf9, 0x45, 0xdf, 0x43,
+	0xde, 0xed, 0x94, 0x25, 0x4e, 0x2e, 0xfa, 0x81, 0x2b, 0x4e, 0xbd, 0xc7, 0xcd, 0x0e, 0xd9, 0xdb,
+	0x1d, 0xb2, 0xbf, 0x76, 0xc8, 0xfe, 0xd8, 0x23, 0x6b, 0xbb, 0x47, 0xd6, 0xe7, 0x1e, 0x59, 0x53,
+	0x1c, 0x33, 0xb5, 0x58, 0x86, 0x38, 0xe2, 0x09, 0x99, 0xe8, 0x12, 0xd7, 0x4f, 0x34, 0x94, 0xe4,
+	0xb8, 0xd8, 0x6a, 0x78, 0x4b, 0xde, 0xcc, 0x6e, 0x6a, 0x9d, 0x81, 0x0c, 0x9b, 0x7a, 0xb1, 0x9b,
+	0xef, 0x01, 0x00, 0xfd, 0xf1, 0x6f, 0xf6, 0x21, 0x02, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -135,6 +147,20 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.RecipientEmissions) > 0 {
+		for iNdEx := len(m.RecipientEmissions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RecipientEmissions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	if m.ReductionStartedEpoch != 0 {
 		i = encodeVarintGenesis(dAtA, i, uint64(m.ReductionStartedEpoch))
 		i--
@@ -187,6 +213,12 @@ func (m *GenesisState) Size() (n int) {
 	if m.ReductionStartedEpoch != 0 {
 		n += 1 + sovGenesis(uint64(m.ReductionStartedEpoch))
 	}
+	if len(m.RecipientEmissions) > 0 {
+		for _, e := range m.RecipientEmissions {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -310,6 +342,40 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecipientEmissions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RecipientEmissions = append(m.RecipientEmissions, RecipientEmissions{})
+			if err := m.RecipientEmissions[len(m.RecipientEmissions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -1,11 +1,19 @@
 package types
 
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
+
 // MinterKey is the key to use for the keeper store.
 var MinterKey = []byte{0x00}
 
 // LastReductionEpochKey is the key to use for the keeper store.
 var LastReductionEpochKey = []byte{0x01}
 
+// RecipientEmissionsKeyPrefix is the prefix for the cumulative emissions of each recipient.
+var RecipientEmissionsKeyPrefix = []byte{0x02}
+
 const (
 	// module name.
 	ModuleName = "mint"
@@ -19,8 +27,12 @@ const (
 	QuerierRoute = StoreKey
 
 	// Query endpoints supported by the minting querier.
-	QueryParameters      = "parameters"
-	QueryEpochProvisions = "epoch_provisions"
+	QueryParameters          = "parameters"
+	QueryEpochProvisions     = "epoch_provisions"
+	QueryProjectedProvisions = "projected_provisions"
+
+	// Max number of epochs that can be projected in a single query
+	MaxProjectedEpochs = 10_000
 
 	// submodule account types
 	CommunityGrowthSubmoduleName         = "growth"
@@ -29,4 +41,19 @@ const (
 
 	// key for creating a new module namespace of type "community"
 	SubmoduleCommunityNamespaceKey = "commmunity"
+
+	// strategic reserve address F0
+	StrategicReserveAddress = "stride1alnn79kh0xka0r5h4h82uuaqfhpdmph6rvpf5f"
+
+	// names of the recipients from the original distribution proportions
+	StakingRecipientName                     = "staking"
+	CommunityPoolGrowthRecipientName         = "community_pool_growth"
+	CommunityPoolSecurityBudgetRecipientName = "community_pool_security_budget"
+	StrategicReserveRecipientName            = "strategic_reserve"
 )
+
+// SubmoduleAddress returns the address of a mint submodule account
+func SubmoduleAddress(submoduleName string, submoduleNamespace string) sdk.AccAddress {
+	key := append([]byte(submoduleNamespace), []byte(submoduleName)...)
+	return address.Module(ModuleName, key)
+}
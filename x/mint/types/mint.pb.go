@@ -4,6 +4,7 @@
 package types
 
 import (
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
@@ -24,6 +25,80 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// RecipientType enum represents how minted tokens are sent to a recipient
+type RecipientType int32
+
+const (
+	// RECIPIENT_MODULE_ACCOUNT sends to the module account with the name in
+	// the recipient's address field
+	RECIPIENT_MODULE_ACCOUNT RecipientType = 0
+	// RECIPIENT_ADDRESS sends to a bech32 address
+	RECIPIENT_ADDRESS RecipientType = 1
+	// RECIPIENT_WASM_CONTRACT sends to a wasm contract and then notifies the
+	// contract with a sudo message
+	RECIPIENT_WASM_CONTRACT RecipientType = 2
+)
+
+var RecipientType_name = map[int32]string{
+	0: "RECIPIENT_MODULE_ACCOUNT",
+	1: "RECIPIENT_ADDRESS",
+	2: "RECIPIENT_WASM_CONTRACT",
+}
+
+var RecipientType_value = map[string]int32{
+	"RECIPIENT_MODULE_ACCOUNT": 0,
+	"RECIPIENT_ADDRESS":        1,
+	"RECIPIENT_WASM_CONTRACT":  2,
+}
+
+func (x RecipientType) String() string {
+	return proto.EnumName(RecipientType_name, int32(x))
+}
+
+func (RecipientType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_5ad5fa4b1fdb702f, []int{0}
+}
+
+// ScheduleType enum represents the curve used to update the epoch provisions
+type ScheduleType int32
+
+const (
+	// SCHEDULE_STEP_REDUCTION multiplies the provisions by the reduction_factor
+	// every reduction_period_in_epochs
+	SCHEDULE_STEP_REDUCTION ScheduleType = 0
+	// SCHEDULE_EXPONENTIAL_DECAY reduces the provisions by the decay_rate every
+	// epoch
+	SCHEDULE_EXPONENTIAL_DECAY ScheduleType = 1
+	// SCHEDULE_STAKING_RATIO_ADAPTIVE increases the provisions by the
+	// adjustment_rate each epoch while the staking ratio is below the target,
+	// and decreases them by the adjustment_rate while it is above the target
+	SCHEDULE_STAKING_RATIO_ADAPTIVE ScheduleType = 2
+	// SCHEDULE_PIECEWISE sets the provisions from a table of epoch ranges
+	SCHEDULE_PIECEWISE ScheduleType = 3
+)
+
+var ScheduleType_name = map[int32]string{
+	0: "SCHEDULE_STEP_REDUCTION",
+	1: "SCHEDULE_EXPONENTIAL_DECAY",
+	2: "SCHEDULE_STAKING_RATIO_ADAPTIVE",
+	3: "SCHEDULE_PIECEWISE",
+}
+
+var ScheduleType_value = map[string]int32{
+	"SCHEDULE_STEP_REDUCTION":         0,
+	"SCHEDULE_EXPONENTIAL_DECAY":      1,
+	"SCHEDULE_STAKING_RATIO_ADAPTIVE": 2,
+	"SCHEDULE_PIECEWISE":              3,
+}
+
+func (x ScheduleType) String() string {
+	return proto.EnumName(ScheduleType_name, int32(x))
+}
+
+func (ScheduleType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_5ad5fa4b1fdb702f, []int{1}
+}
+
 // Minter represents the minting state.
 type Minter struct {
 	// current epoch provisions
@@ -125,9 +200,14 @@ type Params struct {
 	// reduction multiplier to execute on each period
 	ReductionFactor github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=reduction_factor,json=reductionFactor,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"reduction_factor" yaml:"reduction_factor"`
 	// distribution_proportions defines the proportion of the minted denom
+	// Deprecated: replaced by distribution_recipients
 	DistributionProportions DistributionProportions `protobuf:"bytes,6,opt,name=distribution_proportions,json=distributionProportions,proto3" json:"distribution_proportions"`
 	// start epoch to distribute minting rewards
 	MintingRewardsDistributionStartEpoch int64 `protobuf:"varint,7,opt,name=minting_rewards_distribution_start_epoch,json=mintingRewardsDistributionStartEpoch,proto3" json:"minting_rewards_distribution_start_epoch,omitempty" yaml:"minting_rewards_distribution_start_epoch"`
+	// schedule used to update the epoch provisions at the end of each epoch
+	InflationSchedule InflationSchedule `protobuf:"bytes,8,opt,name=inflation_schedule,json=inflationSchedule,proto3" json:"inflation_schedule" yaml:"inflation_schedule"`
+	// weighted recipients of the minted denom
+	DistributionRecipients []MintRecipient `protobuf:"bytes,9,rep,name=distribution_recipients,json=distributionRecipients,proto3" json:"distribution_recipients" yaml:"distribution_recipients"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -197,56 +277,347 @@ func (m *Params) GetMintingRewardsDistributionStartEpoch() int64 {
 	return 0
 }
 
+func (m *Params) GetInflationSchedule() InflationSchedule {
+	if m != nil {
+		return m.InflationSchedule
+	}
+	return InflationSchedule{}
+}
+
+func (m *Params) GetDistributionRecipients() []MintRecipient {
+	if m != nil {
+		return m.DistributionRecipients
+	}
+	return nil
+}
+
+// MintRecipient is a weighted recipient of the minted denom
+type MintRecipient struct {
+	// unique name of the recipient, used to track emissions
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// how the minted tokens are sent to the recipient
+	RecipientType RecipientType `protobuf:"varint,2,opt,name=recipient_type,json=recipientType,proto3,enum=stride.mint.v1beta1.RecipientType" json:"recipient_type,omitempty" yaml:"recipient_type"`
+	// module account name (RECIPIENT_MODULE_ACCOUNT) or bech32 address
+	Address string `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
+	// proportion of the minted tokens sent to the recipient
+	Weight github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=weight,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"weight"`
+}
+
+func (m *MintRecipient) Reset()         { *m = MintRecipient{} }
+func (m *MintRecipient) String() string { return proto.CompactTextString(m) }
+func (*MintRecipient) ProtoMessage()    {}
+func (*MintRecipient) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5ad5fa4b1fdb702f, []int{3}
+}
+func (m *MintRecipient) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MintRecipient) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MintRecipient.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MintRecipient) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MintRecipient.Merge(m, src)
+}
+func (m *MintRecipient) XXX_Size() int {
+	return m.Size()
+}
+func (m *MintRecipient) XXX_DiscardUnknown() {
+	xxx_messageInfo_MintRecipient.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MintRecipient proto.InternalMessageInfo
+
+func (m *MintRecipient) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *MintRecipient) GetRecipientType() RecipientType {
+	if m != nil {
+		return m.RecipientType
+	}
+	return RECIPIENT_MODULE_ACCOUNT
+}
+
+func (m *MintRecipient) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+// RecipientEmissions tracks the total tokens minted to a recipient
+type RecipientEmissions struct {
+	// name of the recipient
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// total amount sent to the recipient
+	CumulativeEmissions cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=cumulative_emissions,json=cumulativeEmissions,proto3,customtype=cosmossdk.io/math.Int" json:"cumulative_emissions" yaml:"cumulative_emissions"`
+}
+
+func (m *RecipientEmissions) Reset()         { *m = RecipientEmissions{} }
+func (m *RecipientEmissions) String() string { return proto.CompactTextString(m) }
+func (*RecipientEmissions) ProtoMessage()    {}
+func (*RecipientEmissions) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5ad5fa4b1fdb702f, []int{4}
+}
+func (m *RecipientEmissions) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RecipientEmissions) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RecipientEmissions.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RecipientEmissions) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RecipientEmissions.Merge(m, src)
+}
+func (m *RecipientEmissions) XXX_Size() int {
+	return m.Size()
+}
+func (m *RecipientEmissions) XXX_DiscardUnknown() {
+	xxx_messageInfo_RecipientEmissions.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RecipientEmissions proto.InternalMessageInfo
+
+func (m *RecipientEmissions) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+// ScheduleSegment sets the provisions for each epoch from the start_epoch
+// until the start of the next segment
+type ScheduleSegment struct {
+	// first epoch of the segment
+	StartEpoch int64 `protobuf:"varint,1,opt,name=start_epoch,json=startEpoch,proto3" json:"start_epoch,omitempty" yaml:"start_epoch"`
+	// provisions for each epoch in the segment
+	EpochProvisions github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=epoch_provisions,json=epochProvisions,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"epoch_provisions" yaml:"epoch_provisions"`
+}
+
+func (m *ScheduleSegment) Reset()         { *m = ScheduleSegment{} }
+func (m *ScheduleSegment) String() string { return proto.CompactTextString(m) }
+func (*ScheduleSegment) ProtoMessage()    {}
+func (*ScheduleSegment) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5ad5fa4b1fdb702f, []int{5}
+}
+func (m *ScheduleSegment) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ScheduleSegment) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ScheduleSegment.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ScheduleSegment) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScheduleSegment.Merge(m, src)
+}
+func (m *ScheduleSegment) XXX_Size() int {
+	return m.Size()
+}
+func (m *ScheduleSegment) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScheduleSegment.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ScheduleSegment proto.InternalMessageInfo
+
+func (m *ScheduleSegment) GetStartEpoch() int64 {
+	if m != nil {
+		return m.StartEpoch
+	}
+	return 0
+}
+
+// InflationSchedule defines how the epoch provisions change over time
+// Only the fields associated with the schedule_type are used
+type InflationSchedule struct {
+	// type of curve used to update the provisions
+	ScheduleType ScheduleType `protobuf:"varint,1,opt,name=schedule_type,json=scheduleType,proto3,enum=stride.mint.v1beta1.ScheduleType" json:"schedule_type,omitempty" yaml:"schedule_type"`
+	// fraction of the provisions removed each epoch
+	// (SCHEDULE_EXPONENTIAL_DECAY)
+	DecayRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=decay_rate,json=decayRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"decay_rate" yaml:"decay_rate"`
+	// target ratio of bonded tokens to total supply
+	// (SCHEDULE_STAKING_RATIO_ADAPTIVE)
+	TargetStakingRatio github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=target_staking_ratio,json=targetStakingRatio,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"target_staking_ratio" yaml:"target_staking_ratio"`
+	// fraction by which the provisions are changed each epoch
+	// (SCHEDULE_STAKING_RATIO_ADAPTIVE)
+	AdjustmentRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=adjustment_rate,json=adjustmentRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"adjustment_rate" yaml:"adjustment_rate"`
+	// lower bound on the provisions (SCHEDULE_STAKING_RATIO_ADAPTIVE)
+	MinEpochProvisions github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=min_epoch_provisions,json=minEpochProvisions,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"min_epoch_provisions" yaml:"min_epoch_provisions"`
+	// upper bound on the provisions (SCHEDULE_STAKING_RATIO_ADAPTIVE)
+	MaxEpochProvisions github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=max_epoch_provisions,json=maxEpochProvisions,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_epoch_provisions" yaml:"max_epoch_provisions"`
+	// provisions table, sorted by start epoch (SCHEDULE_PIECEWISE)
+	Segments []ScheduleSegment `protobuf:"bytes,7,rep,name=segments,proto3" json:"segments"`
+}
+
+func (m *InflationSchedule) Reset()         { *m = InflationSchedule{} }
+func (m *InflationSchedule) String() string { return proto.CompactTextString(m) }
+func (*InflationSchedule) ProtoMessage()    {}
+func (*InflationSchedule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5ad5fa4b1fdb702f, []int{6}
+}
+func (m *InflationSchedule) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *InflationSchedule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_InflationSchedule.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *InflationSchedule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InflationSchedule.Merge(m, src)
+}
+func (m *InflationSchedule) XXX_Size() int {
+	return m.Size()
+}
+func (m *InflationSchedule) XXX_DiscardUnknown() {
+	xxx_messageInfo_InflationSchedule.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InflationSchedule proto.InternalMessageInfo
+
+func (m *InflationSchedule) GetScheduleType() ScheduleType {
+	if m != nil {
+		return m.ScheduleType
+	}
+	return SCHEDULE_STEP_REDUCTION
+}
+
+func (m *InflationSchedule) GetSegments() []ScheduleSegment {
+	if m != nil {
+		return m.Segments
+	}
+	return nil
+}
+
 func init() {
+	proto.RegisterEnum("stride.mint.v1beta1.RecipientType", RecipientType_name, RecipientType_value)
+	proto.RegisterEnum("stride.mint.v1beta1.ScheduleType", ScheduleType_name, ScheduleType_value)
 	proto.RegisterType((*Minter)(nil), "stride.mint.v1beta1.Minter")
 	proto.RegisterType((*DistributionProportions)(nil), "stride.mint.v1beta1.DistributionProportions")
 	proto.RegisterType((*Params)(nil), "stride.mint.v1beta1.Params")
+	proto.RegisterType((*MintRecipient)(nil), "stride.mint.v1beta1.MintRecipient")
+	proto.RegisterType((*RecipientEmissions)(nil), "stride.mint.v1beta1.RecipientEmissions")
+	proto.RegisterType((*ScheduleSegment)(nil), "stride.mint.v1beta1.ScheduleSegment")
+	proto.RegisterType((*InflationSchedule)(nil), "stride.mint.v1beta1.InflationSchedule")
 }
 
 func init() { proto.RegisterFile("stride/mint/v1beta1/mint.proto", fileDescriptor_5ad5fa4b1fdb702f) }
 
 var fileDescriptor_5ad5fa4b1fdb702f = []byte{
-	// 633 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0x4d, 0x6f, 0xd3, 0x40,
-	0x10, 0x8d, 0x69, 0x9b, 0xaa, 0x8b, 0x44, 0x8b, 0x4b, 0x89, 0xd5, 0x0a, 0xbb, 0x58, 0x80, 0x7a,
-	0xa0, 0xb6, 0xda, 0x72, 0xea, 0x09, 0x45, 0xa5, 0xa5, 0x12, 0x48, 0xc1, 0xb9, 0xf5, 0x62, 0xf9,
-	0x63, 0xeb, 0xac, 0x1a, 0xef, 0x5a, 0xbb, 0xeb, 0x94, 0x08, 0x89, 0x3f, 0x50, 0x0e, 0x1c, 0x39,
-	0xf2, 0x73, 0x7a, 0xec, 0x11, 0xf5, 0x10, 0xa1, 0xe4, 0x1f, 0xe4, 0xc2, 0x15, 0xed, 0xae, 0xf3,
-	0x09, 0x91, 0x88, 0xe8, 0x29, 0xde, 0x37, 0xb3, 0xf3, 0x5e, 0xde, 0xce, 0x0c, 0x30, 0x19, 0xa7,
-	0x28, 0x86, 0x6e, 0x8a, 0x30, 0x77, 0x5b, 0x7b, 0x21, 0xe4, 0xc1, 0x9e, 0x3c, 0x38, 0x19, 0x25,
-	0x9c, 0xe8, 0xeb, 0x2a, 0xee, 0x48, 0xa8, 0x88, 0x6f, 0x3e, 0x4a, 0x48, 0x42, 0x64, 0xdc, 0x15,
-	0x5f, 0x2a, 0xd5, 0xfe, 0x0c, 0xca, 0xef, 0x11, 0xe6, 0x90, 0xea, 0x1c, 0xac, 0xc1, 0x8c, 0x44,
-	0x0d, 0x3f, 0xa3, 0xa4, 0x85, 0x18, 0x22, 0x98, 0x19, 0xda, 0xb6, 0xb6, 0xb3, 0x52, 0x3d, 0xbd,
-	0xee, 0x58, 0xa5, 0xdb, 0x8e, 0xf5, 0x22, 0x41, 0xbc, 0x91, 0x87, 0x4e, 0x44, 0x52, 0x37, 0x22,
-	0x2c, 0x25, 0xac, 0xf8, 0xd9, 0x65, 0xf1, 0x85, 0xcb, 0xdb, 0x19, 0x64, 0xce, 0x11, 0x8c, 0xfa,
-	0x1d, 0xab, 0xd2, 0x0e, 0xd2, 0xe6, 0xa1, 0x3d, 0x5d, 0xcf, 0xf6, 0x56, 0x25, 0x54, 0x1b, 0x21,
-	0xbf, 0x16, 0x40, 0xe5, 0x08, 0x09, 0xbd, 0x61, 0xce, 0x11, 0xc1, 0x35, 0x4a, 0x32, 0x42, 0xc5,
-	0x17, 0xd3, 0xcf, 0xc0, 0x32, 0xe3, 0xc1, 0x05, 0xc2, 0x49, 0x21, 0xe4, 0xf5, 0xdc, 0x42, 0x1e,
-	0x28, 0x21, 0x45, 0x19, 0xdb, 0x1b, 0x14, 0xd4, 0x3f, 0x81, 0x8d, 0x88, 0xa4, 0x69, 0x8e, 0x11,
-	0x6f, 0xfb, 0x19, 0x21, 0x4d, 0x3f, 0xa1, 0xe4, 0x92, 0x37, 0x8c, 0x7b, 0x92, 0xe9, 0x64, 0x6e,
-	0xa6, 0x0d, 0xc5, 0x34, 0x59, 0xd4, 0xf6, 0xd6, 0x87, 0x40, 0x8d, 0x90, 0xe6, 0x89, 0xe4, 0xd0,
-	0xbf, 0x68, 0xc0, 0x9c, 0x62, 0x67, 0x30, 0xca, 0xa9, 0x38, 0x85, 0x79, 0x9c, 0x40, 0x6e, 0x2c,
-	0xdc, 0xad, 0x8c, 0xad, 0x09, 0x19, 0xf5, 0x82, 0xac, 0x2a, 0xb9, 0x74, 0x0e, 0x1e, 0x32, 0x4e,
-	0x03, 0x0e, 0x13, 0x14, 0xf9, 0x14, 0x32, 0x48, 0x5b, 0xd0, 0x58, 0xbc, 0x5b, 0x01, 0x6b, 0x43,
-	0x06, 0x4f, 0x11, 0xd8, 0xb7, 0x4b, 0xa0, 0x5c, 0x0b, 0x68, 0x90, 0x32, 0xfd, 0x09, 0x00, 0xa2,
-	0x55, 0xfd, 0x18, 0x62, 0x92, 0xaa, 0xb7, 0xf6, 0x56, 0x04, 0x72, 0x24, 0x00, 0xfd, 0x4a, 0x03,
-	0x46, 0x02, 0x31, 0x64, 0x88, 0xf9, 0x7f, 0xb4, 0xa8, 0x7a, 0xaf, 0x0f, 0x73, 0xeb, 0xb4, 0x94,
-	0xce, 0x59, 0x75, 0x6d, 0xef, 0x71, 0x11, 0x7a, 0x33, 0xd9, 0xb1, 0xfa, 0xf1, 0x60, 0x4e, 0x50,
-	0x0c, 0x31, 0x47, 0xe7, 0x08, 0xd2, 0xe2, 0xb5, 0xb6, 0xa6, 0x3b, 0x7f, 0x94, 0x31, 0xe8, 0xfc,
-	0xd3, 0x21, 0xa2, 0x87, 0x60, 0x93, 0xc2, 0x38, 0x8f, 0x44, 0xaf, 0xfb, 0x19, 0xa4, 0x88, 0xc4,
-	0x3e, 0xc2, 0x4a, 0x08, 0x93, 0xf6, 0x2f, 0x54, 0x9f, 0xf7, 0x3b, 0xd6, 0x53, 0x55, 0x71, 0x76,
-	0xae, 0xed, 0x55, 0x86, 0xc1, 0x9a, 0x8c, 0x9d, 0x62, 0x29, 0x9a, 0x89, 0x99, 0x1e, 0xdd, 0x3b,
-	0x0f, 0x22, 0x4e, 0xa8, 0xb1, 0xf4, 0x7f, 0x33, 0x3d, 0x5d, 0xcf, 0xf6, 0x56, 0x87, 0xd0, 0xb1,
-	0x44, 0xf4, 0x14, 0x18, 0xf1, 0xd8, 0x48, 0x0b, 0x57, 0x07, 0x33, 0x6d, 0x94, 0xb7, 0xb5, 0x9d,
-	0xfb, 0xfb, 0x2f, 0x9d, 0xbf, 0x6c, 0x28, 0x67, 0xc6, 0x1e, 0xa8, 0x2e, 0x0a, 0xad, 0x5e, 0x25,
-	0x9e, 0xb1, 0x26, 0xae, 0x34, 0xb0, 0x23, 0xea, 0x20, 0x9c, 0xf8, 0x14, 0x5e, 0x06, 0x34, 0x66,
-	0xfe, 0x04, 0x3f, 0xe3, 0x01, 0xe5, 0xca, 0x2c, 0x63, 0x59, 0xfa, 0x7a, 0xd0, 0xef, 0x58, 0xae,
-	0xfa, 0x3f, 0xff, 0x7a, 0xd3, 0xf6, 0x9e, 0x15, 0xa9, 0x9e, 0xca, 0x1c, 0x57, 0x5b, 0x17, 0x79,
-	0xd2, 0xf3, 0xc3, 0xc5, 0x6f, 0xdf, 0xad, 0x52, 0xf5, 0xed, 0x75, 0xd7, 0xd4, 0x6e, 0xba, 0xa6,
-	0xf6, 0xb3, 0x6b, 0x6a, 0x5f, 0x7b, 0x66, 0xe9, 0xa6, 0x67, 0x96, 0x7e, 0xf4, 0xcc, 0xd2, 0x99,
-	0x33, 0x66, 0x78, 0x5d, 0x9a, 0xb0, 0xfb, 0x2e, 0x08, 0x99, 0x5b, 0xac, 0xf4, 0xd6, 0xfe, 0x2b,
-	0xf7, 0xa3, 0x5a, 0xec, 0xd2, 0xfc, 0xb0, 0x2c, 0xf7, 0xf4, 0xc1, 0xef, 0x00, 0x00, 0x00, 0xff,
-	0xff, 0xec, 0x7c, 0xad, 0x2c, 0xf4, 0x05, 0x00, 0x00,
+	// 1262 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x57, 0xcd, 0x6f, 0x1b, 0x45,
+	0x14, 0xf7, 0x26, 0xa9, 0xd3, 0x4c, 0x9b, 0xc4, 0x99, 0xe6, 0x63, 0x9b, 0x80, 0xdd, 0x2e, 0xa5,
+	0x8a, 0x2a, 0x6a, 0xab, 0x2d, 0x12, 0x52, 0xc5, 0x01, 0x7f, 0x6c, 0xdb, 0x15, 0x8d, 0x63, 0xc6,
+	0x2e, 0x85, 0x5e, 0x96, 0xb5, 0x77, 0xba, 0x19, 0x9a, 0xdd, 0x31, 0x3b, 0xe3, 0x34, 0x11, 0x12,
+	0x1c, 0xb8, 0x20, 0x81, 0x10, 0x37, 0xe0, 0x86, 0xc4, 0x3f, 0xc2, 0xb1, 0xc7, 0x1e, 0x51, 0x0f,
+	0x16, 0x6a, 0x8f, 0xdc, 0x7c, 0xe1, 0x8a, 0xe6, 0xc3, 0xeb, 0x8f, 0x38, 0x08, 0x2b, 0xe5, 0x64,
+	0xef, 0x9b, 0xf7, 0x7e, 0xef, 0xf7, 0x66, 0x7e, 0xef, 0xcd, 0x2e, 0xc8, 0x32, 0x1e, 0x13, 0x1f,
+	0x17, 0x42, 0x12, 0xf1, 0xc2, 0xc1, 0x8d, 0x26, 0xe6, 0xde, 0x0d, 0xf9, 0x90, 0x6f, 0xc7, 0x94,
+	0x53, 0x78, 0x41, 0xad, 0xe7, 0xa5, 0x49, 0xaf, 0x6f, 0xae, 0x06, 0x34, 0xa0, 0x72, 0xbd, 0x20,
+	0xfe, 0x29, 0x57, 0xeb, 0x2b, 0x90, 0xde, 0x21, 0x11, 0xc7, 0x31, 0xe4, 0x20, 0x83, 0xdb, 0xb4,
+	0xb5, 0xe7, 0xb6, 0x63, 0x7a, 0x40, 0x18, 0xa1, 0x11, 0x33, 0x8d, 0x4b, 0xc6, 0xf6, 0x42, 0xc9,
+	0x79, 0xd6, 0xcd, 0xa5, 0x5e, 0x74, 0x73, 0x57, 0x03, 0xc2, 0xf7, 0x3a, 0xcd, 0x7c, 0x8b, 0x86,
+	0x85, 0x16, 0x65, 0x21, 0x65, 0xfa, 0xe7, 0x3a, 0xf3, 0x9f, 0x14, 0xf8, 0x51, 0x1b, 0xb3, 0x7c,
+	0x05, 0xb7, 0x7a, 0xdd, 0xdc, 0xc6, 0x91, 0x17, 0xee, 0xdf, 0xb6, 0xc6, 0xf1, 0x2c, 0xb4, 0x2c,
+	0x4d, 0xb5, 0x81, 0xe5, 0xef, 0x59, 0xb0, 0x51, 0x21, 0x82, 0x6f, 0xb3, 0xc3, 0x09, 0x8d, 0x6a,
+	0x31, 0x6d, 0xd3, 0x58, 0xfc, 0x63, 0xf0, 0x11, 0x98, 0x67, 0xdc, 0x7b, 0x42, 0xa2, 0x40, 0x13,
+	0xf9, 0x60, 0x6a, 0x22, 0x4b, 0x8a, 0x88, 0x86, 0xb1, 0x50, 0x1f, 0x10, 0x7e, 0x09, 0xd6, 0x5a,
+	0x34, 0x0c, 0x3b, 0x11, 0xe1, 0x47, 0x6e, 0x9b, 0xd2, 0x7d, 0x37, 0x88, 0xe9, 0x53, 0xbe, 0x67,
+	0xce, 0xc8, 0x4c, 0x77, 0xa7, 0xce, 0xb4, 0xa6, 0x32, 0x8d, 0x82, 0x5a, 0xe8, 0x42, 0x62, 0xa8,
+	0x51, 0xba, 0x7f, 0x57, 0xe6, 0x80, 0xdf, 0x1b, 0x20, 0x3b, 0x96, 0x9d, 0xe1, 0x56, 0x27, 0x16,
+	0x4f, 0xcd, 0x8e, 0x1f, 0x60, 0x6e, 0xce, 0xbe, 0x5e, 0x1a, 0x5b, 0x23, 0x34, 0xea, 0x3a, 0x59,
+	0x49, 0xe6, 0x82, 0x1c, 0xac, 0x30, 0x1e, 0x7b, 0x1c, 0x07, 0xa4, 0xe5, 0xc6, 0x98, 0xe1, 0xf8,
+	0x00, 0x9b, 0x73, 0xaf, 0x97, 0x40, 0x26, 0xc9, 0x80, 0x54, 0x02, 0xeb, 0xc5, 0x3c, 0x48, 0xd7,
+	0xbc, 0xd8, 0x0b, 0x19, 0x7c, 0x13, 0x00, 0x21, 0x55, 0xd7, 0xc7, 0x11, 0x0d, 0xd5, 0x59, 0xa3,
+	0x05, 0x61, 0xa9, 0x08, 0x03, 0xfc, 0xce, 0x00, 0x66, 0x80, 0x23, 0xcc, 0x08, 0x73, 0x8f, 0x49,
+	0x54, 0x9d, 0xd7, 0x47, 0x53, 0xf3, 0xcc, 0x29, 0x9e, 0x27, 0xe1, 0x5a, 0x68, 0x5d, 0x2f, 0xd9,
+	0xa3, 0x8a, 0x85, 0x77, 0xfa, 0x7d, 0x42, 0x7c, 0x1c, 0x71, 0xf2, 0x98, 0xe0, 0x58, 0x9f, 0xd6,
+	0xd6, 0xb8, 0xf2, 0x07, 0x1e, 0x7d, 0xe5, 0x3b, 0x89, 0x05, 0x36, 0xc1, 0x66, 0x8c, 0xfd, 0x4e,
+	0x4b, 0x68, 0xdd, 0x6d, 0xe3, 0x98, 0x50, 0xdf, 0x25, 0x91, 0x22, 0xc2, 0xe4, 0xf6, 0xcf, 0x96,
+	0xde, 0xee, 0x75, 0x73, 0x97, 0x15, 0xe2, 0xc9, 0xbe, 0x16, 0xda, 0x48, 0x16, 0x6b, 0x72, 0xcd,
+	0x89, 0x24, 0x69, 0x26, 0x7a, 0x7a, 0x10, 0xf7, 0xd8, 0x6b, 0x71, 0x1a, 0x9b, 0x67, 0x4e, 0xd7,
+	0xd3, 0xe3, 0x78, 0x16, 0x5a, 0x4e, 0x4c, 0x77, 0xa4, 0x05, 0x86, 0xc0, 0xf4, 0x87, 0x5a, 0xda,
+	0x6d, 0x0f, 0x7a, 0xda, 0x4c, 0x5f, 0x32, 0xb6, 0xcf, 0xdd, 0x7c, 0x27, 0x3f, 0x61, 0x42, 0xe5,
+	0x4f, 0x98, 0x03, 0xa5, 0x39, 0xc1, 0x15, 0x6d, 0xf8, 0x93, 0x97, 0x85, 0x3c, 0xb6, 0x05, 0x0e,
+	0x89, 0x02, 0x37, 0xc6, 0x4f, 0xbd, 0xd8, 0x67, 0xee, 0x48, 0x7e, 0xc6, 0xbd, 0x98, 0xab, 0xcd,
+	0x32, 0xe7, 0xe5, 0xbe, 0xde, 0xea, 0x75, 0x73, 0x05, 0x55, 0xcf, 0x7f, 0x8d, 0xb4, 0xd0, 0x15,
+	0xed, 0x8a, 0x94, 0xe7, 0x30, 0xdb, 0xba, 0xf0, 0x93, 0x7b, 0x0e, 0x0f, 0x01, 0x24, 0xd1, 0xe3,
+	0x7d, 0x4f, 0xc5, 0xb7, 0xf6, 0xb0, 0xdf, 0xd9, 0xc7, 0xe6, 0x59, 0x59, 0xf6, 0xd5, 0x89, 0x65,
+	0x3b, 0x7d, 0xf7, 0xba, 0xf6, 0x2e, 0x5d, 0x16, 0x05, 0xf7, 0xba, 0xb9, 0x8b, 0x8a, 0xe2, 0x71,
+	0x3c, 0x0b, 0xad, 0x90, 0xf1, 0x28, 0xf8, 0x8d, 0x01, 0x46, 0xf6, 0xc8, 0x8d, 0x71, 0x8b, 0xb4,
+	0x09, 0x8e, 0x38, 0x33, 0x17, 0x2e, 0xcd, 0x6e, 0x9f, 0xbb, 0x69, 0x4d, 0xcc, 0x2f, 0xe6, 0x3f,
+	0xea, 0xbb, 0x96, 0xae, 0xea, 0xdc, 0x59, 0x95, 0xfb, 0x04, 0x40, 0x0b, 0xad, 0x0f, 0xaf, 0x24,
+	0xe1, 0xec, 0xf6, 0xdc, 0xcf, 0xbf, 0xe6, 0x52, 0xd6, 0x5f, 0x06, 0x58, 0x1c, 0xc1, 0x85, 0x10,
+	0xcc, 0x45, 0x5e, 0x88, 0x75, 0x77, 0xcb, 0xff, 0xd0, 0x07, 0x4b, 0x09, 0xa4, 0x2b, 0xb4, 0x26,
+	0xbb, 0x79, 0xe9, 0x04, 0x9e, 0x09, 0x56, 0xe3, 0xa8, 0x8d, 0x4b, 0x17, 0x07, 0xb3, 0x66, 0x14,
+	0xc3, 0x42, 0x8b, 0xf1, 0xb0, 0x27, 0x34, 0xc1, 0xbc, 0xe7, 0xfb, 0x31, 0x66, 0x4c, 0xf5, 0x29,
+	0xea, 0x3f, 0xc2, 0x3b, 0x20, 0xfd, 0x14, 0x93, 0x60, 0x8f, 0xeb, 0x69, 0x97, 0x9f, 0xae, 0x29,
+	0x90, 0x8e, 0xb6, 0x7e, 0x31, 0x00, 0x4c, 0xd8, 0xd9, 0x21, 0x61, 0x6a, 0x52, 0x4c, 0x2a, 0x99,
+	0x82, 0xd5, 0x56, 0x27, 0xec, 0x88, 0xa3, 0x3b, 0xc0, 0x2e, 0xee, 0xfb, 0xea, 0x31, 0xf6, 0xbe,
+	0x26, 0xb0, 0xa6, 0xd2, 0x31, 0xff, 0x49, 0x9e, 0xd0, 0x42, 0xe8, 0xf1, 0xbd, 0xbc, 0x13, 0xf1,
+	0x5e, 0x37, 0xb7, 0xa5, 0xa7, 0xeb, 0x04, 0x08, 0x71, 0xd7, 0x24, 0xe6, 0x84, 0x84, 0xf5, 0xbb,
+	0x01, 0x96, 0xfb, 0x12, 0xa9, 0xe3, 0x20, 0x14, 0x67, 0xf1, 0x1e, 0x38, 0x37, 0xdc, 0x13, 0x86,
+	0xec, 0x89, 0xf5, 0x5e, 0x37, 0x07, 0x93, 0xeb, 0x72, 0x20, 0x7b, 0xc0, 0x06, 0xe2, 0x9e, 0xf4,
+	0x8e, 0x30, 0xf3, 0xbf, 0xbf, 0x23, 0xfc, 0x94, 0x06, 0x2b, 0xc7, 0x9a, 0x04, 0x7e, 0x06, 0x16,
+	0xfb, 0xed, 0xa0, 0xb4, 0x63, 0x48, 0xed, 0x5c, 0x9e, 0xa8, 0x9d, 0x7e, 0x94, 0x94, 0x8e, 0xd9,
+	0xeb, 0xe6, 0x56, 0x75, 0xa5, 0xc3, 0x08, 0x16, 0x3a, 0xcf, 0x86, 0xfc, 0x60, 0x13, 0x00, 0x1f,
+	0xb7, 0xbc, 0x23, 0x57, 0xdc, 0x5c, 0xba, 0xce, 0xf2, 0xd4, 0x75, 0xae, 0xe8, 0x46, 0x4a, 0x90,
+	0x2c, 0xb4, 0x20, 0x1f, 0x90, 0xc7, 0x31, 0xfc, 0x1a, 0xac, 0x72, 0x2f, 0x0e, 0x30, 0x77, 0xf5,
+	0x9b, 0x89, 0x70, 0x21, 0x54, 0xdf, 0x28, 0x3b, 0x53, 0x67, 0xd3, 0x02, 0x99, 0x84, 0x69, 0x21,
+	0xa8, 0xcc, 0x75, 0x65, 0x45, 0xc2, 0x08, 0xbf, 0x00, 0xcb, 0x9e, 0xff, 0x79, 0x87, 0x71, 0xa1,
+	0x0c, 0x55, 0xa9, 0x6a, 0x86, 0x7b, 0x53, 0xe7, 0x5e, 0x57, 0xb9, 0xc7, 0xe0, 0x2c, 0xb4, 0x34,
+	0xb0, 0xf4, 0x6b, 0x0e, 0xfb, 0xb7, 0xd7, 0xb0, 0x92, 0xce, 0x9c, 0xae, 0xe6, 0x49, 0x98, 0x16,
+	0x82, 0x21, 0x89, 0xc6, 0xaf, 0x70, 0x41, 0xc0, 0x3b, 0x3c, 0x4e, 0x20, 0x7d, 0x4a, 0x02, 0xde,
+	0xe1, 0x44, 0x02, 0xde, 0xe1, 0xf1, 0x77, 0x88, 0xb3, 0x4c, 0xf5, 0x22, 0x33, 0xe7, 0xe5, 0x68,
+	0xbe, 0xf2, 0xaf, 0xb2, 0xd5, 0x8d, 0xab, 0x6f, 0xc2, 0x24, 0xf6, 0x5a, 0x00, 0x16, 0x47, 0xa6,
+	0x22, 0x7c, 0x03, 0x98, 0xc8, 0x2e, 0x3b, 0x35, 0xc7, 0xae, 0x36, 0xdc, 0x9d, 0xdd, 0xca, 0x83,
+	0xfb, 0xb6, 0x5b, 0x2c, 0x97, 0x77, 0x1f, 0x54, 0x1b, 0x99, 0x14, 0x5c, 0x03, 0x2b, 0x83, 0xd5,
+	0x62, 0xa5, 0x82, 0xec, 0x7a, 0x3d, 0x63, 0xc0, 0x2d, 0xb0, 0x31, 0x30, 0x3f, 0x2c, 0xd6, 0x77,
+	0xdc, 0xf2, 0x6e, 0xb5, 0x81, 0x8a, 0xe5, 0x46, 0x66, 0x66, 0x73, 0xee, 0xdb, 0xdf, 0xb2, 0xa9,
+	0x6b, 0x3f, 0x18, 0xe0, 0xfc, 0x70, 0x0f, 0x89, 0x98, 0x7a, 0xf9, 0x9e, 0x2d, 0x13, 0xd4, 0x1b,
+	0x76, 0xcd, 0x45, 0x76, 0xe5, 0x41, 0xb9, 0xe1, 0xec, 0x56, 0x33, 0x29, 0x98, 0x05, 0x9b, 0xc9,
+	0xa2, 0xfd, 0x49, 0x6d, 0xb7, 0x6a, 0x57, 0x1b, 0x4e, 0xf1, 0xbe, 0x5b, 0xb1, 0xcb, 0xc5, 0x4f,
+	0x33, 0x06, 0x7c, 0x0b, 0xe4, 0x86, 0x82, 0x8b, 0x1f, 0x3a, 0xd5, 0xbb, 0x2e, 0x2a, 0x36, 0x9c,
+	0x5d, 0xb7, 0x58, 0x29, 0xd6, 0x1a, 0xce, 0xc7, 0x76, 0x66, 0x06, 0xae, 0x03, 0x98, 0x38, 0xd5,
+	0x1c, 0xbb, 0x6c, 0x3f, 0x74, 0xea, 0x76, 0x66, 0x56, 0x11, 0x2a, 0xdd, 0x7b, 0xf6, 0x32, 0x6b,
+	0x3c, 0x7f, 0x99, 0x35, 0xfe, 0x7c, 0x99, 0x35, 0x7e, 0x7c, 0x95, 0x4d, 0x3d, 0x7f, 0x95, 0x4d,
+	0xfd, 0xf1, 0x2a, 0x9b, 0x7a, 0x94, 0x1f, 0x3a, 0xb6, 0xba, 0xdc, 0xd3, 0xeb, 0xf7, 0xbd, 0x26,
+	0x2b, 0xe8, 0x6f, 0xa6, 0x83, 0x9b, 0xef, 0x16, 0x0e, 0xd5, 0x97, 0x93, 0x3c, 0xc2, 0x66, 0x5a,
+	0x7e, 0x08, 0xdd, 0xfa, 0x67, 0x00, 0x15, 0x40, 0xfb, 0x14, 0x55, 0x0d, 0x00, 0x00,
 }
 
 func (m *Minter) Marshal() (dAtA []byte, err error) {
@@ -365,6 +736,30 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.DistributionRecipients) > 0 {
+		for iNdEx := len(m.DistributionRecipients) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DistributionRecipients[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMint(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	{
+		size, err := m.InflationSchedule.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
 	if m.MintingRewardsDistributionStartEpoch != 0 {
 		i = encodeVarintMint(dAtA, i, uint64(m.MintingRewardsDistributionStartEpoch))
 		i--
@@ -422,62 +817,284 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintMint(dAtA []byte, offset int, v uint64) int {
-	offset -= sovMint(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MintRecipient) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *Minter) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.EpochProvisions.Size()
-	n += 1 + l + sovMint(uint64(l))
-	return n
+
+func (m *MintRecipient) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *DistributionProportions) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MintRecipient) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.Staking.Size()
-	n += 1 + l + sovMint(uint64(l))
-	l = m.CommunityPoolGrowth.Size()
-	n += 1 + l + sovMint(uint64(l))
-	l = m.CommunityPoolSecurityBudget.Size()
-	n += 1 + l + sovMint(uint64(l))
-	l = m.StrategicReserve.Size()
-	n += 1 + l + sovMint(uint64(l))
-	return n
+	{
+		size := m.Weight.Size()
+		i -= size
+		if _, err := m.Weight.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintMint(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.RecipientType != 0 {
+		i = encodeVarintMint(dAtA, i, uint64(m.RecipientType))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintMint(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *Params) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *RecipientEmissions) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *RecipientEmissions) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RecipientEmissions) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.MintDenom)
-	if l > 0 {
-		n += 1 + l + sovMint(uint64(l))
+	{
+		size := m.CumulativeEmissions.Size()
+		i -= size
+		if _, err := m.CumulativeEmissions.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
 	}
-	l = m.GenesisEpochProvisions.Size()
-	n += 1 + l + sovMint(uint64(l))
-	l = len(m.EpochIdentifier)
-	if l > 0 {
-		n += 1 + l + sovMint(uint64(l))
+	i--
+	dAtA[i] = 0x12
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintMint(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
 	}
-	if m.ReductionPeriodInEpochs != 0 {
+	return len(dAtA) - i, nil
+}
+
+func (m *ScheduleSegment) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ScheduleSegment) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ScheduleSegment) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.EpochProvisions.Size()
+		i -= size
+		if _, err := m.EpochProvisions.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.StartEpoch != 0 {
+		i = encodeVarintMint(dAtA, i, uint64(m.StartEpoch))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *InflationSchedule) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *InflationSchedule) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InflationSchedule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Segments) > 0 {
+		for iNdEx := len(m.Segments) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Segments[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMint(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	{
+		size := m.MaxEpochProvisions.Size()
+		i -= size
+		if _, err := m.MaxEpochProvisions.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.MinEpochProvisions.Size()
+		i -= size
+		if _, err := m.MinEpochProvisions.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.AdjustmentRate.Size()
+		i -= size
+		if _, err := m.AdjustmentRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.TargetStakingRatio.Size()
+		i -= size
+		if _, err := m.TargetStakingRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.DecayRate.Size()
+		i -= size
+		if _, err := m.DecayRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.ScheduleType != 0 {
+		i = encodeVarintMint(dAtA, i, uint64(m.ScheduleType))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintMint(dAtA []byte, offset int, v uint64) int {
+	offset -= sovMint(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Minter) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.EpochProvisions.Size()
+	n += 1 + l + sovMint(uint64(l))
+	return n
+}
+
+func (m *DistributionProportions) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Staking.Size()
+	n += 1 + l + sovMint(uint64(l))
+	l = m.CommunityPoolGrowth.Size()
+	n += 1 + l + sovMint(uint64(l))
+	l = m.CommunityPoolSecurityBudget.Size()
+	n += 1 + l + sovMint(uint64(l))
+	l = m.StrategicReserve.Size()
+	n += 1 + l + sovMint(uint64(l))
+	return n
+}
+
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MintDenom)
+	if l > 0 {
+		n += 1 + l + sovMint(uint64(l))
+	}
+	l = m.GenesisEpochProvisions.Size()
+	n += 1 + l + sovMint(uint64(l))
+	l = len(m.EpochIdentifier)
+	if l > 0 {
+		n += 1 + l + sovMint(uint64(l))
+	}
+	if m.ReductionPeriodInEpochs != 0 {
 		n += 1 + sovMint(uint64(m.ReductionPeriodInEpochs))
 	}
 	l = m.ReductionFactor.Size()
@@ -487,16 +1104,693 @@ func (m *Params) Size() (n int) {
 	if m.MintingRewardsDistributionStartEpoch != 0 {
 		n += 1 + sovMint(uint64(m.MintingRewardsDistributionStartEpoch))
 	}
+	l = m.InflationSchedule.Size()
+	n += 1 + l + sovMint(uint64(l))
+	if len(m.DistributionRecipients) > 0 {
+		for _, e := range m.DistributionRecipients {
+			l = e.Size()
+			n += 1 + l + sovMint(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MintRecipient) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovMint(uint64(l))
+	}
+	if m.RecipientType != 0 {
+		n += 1 + sovMint(uint64(m.RecipientType))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovMint(uint64(l))
+	}
+	l = m.Weight.Size()
+	n += 1 + l + sovMint(uint64(l))
+	return n
+}
+
+func (m *RecipientEmissions) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovMint(uint64(l))
+	}
+	l = m.CumulativeEmissions.Size()
+	n += 1 + l + sovMint(uint64(l))
+	return n
+}
+
+func (m *ScheduleSegment) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StartEpoch != 0 {
+		n += 1 + sovMint(uint64(m.StartEpoch))
+	}
+	l = m.EpochProvisions.Size()
+	n += 1 + l + sovMint(uint64(l))
+	return n
+}
+
+func (m *InflationSchedule) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ScheduleType != 0 {
+		n += 1 + sovMint(uint64(m.ScheduleType))
+	}
+	l = m.DecayRate.Size()
+	n += 1 + l + sovMint(uint64(l))
+	l = m.TargetStakingRatio.Size()
+	n += 1 + l + sovMint(uint64(l))
+	l = m.AdjustmentRate.Size()
+	n += 1 + l + sovMint(uint64(l))
+	l = m.MinEpochProvisions.Size()
+	n += 1 + l + sovMint(uint64(l))
+	l = m.MaxEpochProvisions.Size()
+	n += 1 + l + sovMint(uint64(l))
+	if len(m.Segments) > 0 {
+		for _, e := range m.Segments {
+			l = e.Size()
+			n += 1 + l + sovMint(uint64(l))
+		}
+	}
 	return n
 }
 
-func sovMint(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozMint(x uint64) (n int) {
-	return sovMint(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+func sovMint(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozMint(x uint64) (n int) {
+	return sovMint(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Minter) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMint
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Minter: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Minter: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochProvisions", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.EpochProvisions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMint(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMint
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DistributionProportions) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMint
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DistributionProportions: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DistributionProportions: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Staking", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Staking.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommunityPoolGrowth", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CommunityPoolGrowth.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommunityPoolSecurityBudget", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CommunityPoolSecurityBudget.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StrategicReserve", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.StrategicReserve.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMint(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMint
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMint
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MintDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GenesisEpochProvisions", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.GenesisEpochProvisions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochIdentifier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EpochIdentifier = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReductionPeriodInEpochs", wireType)
+			}
+			m.ReductionPeriodInEpochs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReductionPeriodInEpochs |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReductionFactor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ReductionFactor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DistributionProportions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DistributionProportions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintingRewardsDistributionStartEpoch", wireType)
+			}
+			m.MintingRewardsDistributionStartEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MintingRewardsDistributionStartEpoch |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InflationSchedule", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.InflationSchedule.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DistributionRecipients", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DistributionRecipients = append(m.DistributionRecipients, MintRecipient{})
+			if err := m.DistributionRecipients[len(m.DistributionRecipients)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMint(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMint
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *Minter) Unmarshal(dAtA []byte) error {
+func (m *MintRecipient) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -519,15 +1813,15 @@ func (m *Minter) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Minter: wiretype end group for non-group")
+			return fmt.Errorf("proto: MintRecipient: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Minter: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MintRecipient: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EpochProvisions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -555,7 +1849,90 @@ func (m *Minter) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.EpochProvisions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecipientType", wireType)
+			}
+			m.RecipientType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RecipientType |= RecipientType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Weight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -580,7 +1957,7 @@ func (m *Minter) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DistributionProportions) Unmarshal(dAtA []byte) error {
+func (m *RecipientEmissions) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -603,15 +1980,15 @@ func (m *DistributionProportions) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DistributionProportions: wiretype end group for non-group")
+			return fmt.Errorf("proto: RecipientEmissions: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DistributionProportions: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RecipientEmissions: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Staking", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -639,13 +2016,11 @@ func (m *DistributionProportions) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Staking.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CommunityPoolGrowth", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CumulativeEmissions", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -673,15 +2048,65 @@ func (m *DistributionProportions) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.CommunityPoolGrowth.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.CumulativeEmissions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CommunityPoolSecurityBudget", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMint(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			var stringLen uint64
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMint
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ScheduleSegment) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMint
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ScheduleSegment: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ScheduleSegment: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartEpoch", wireType)
+			}
+			m.StartEpoch = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMint
@@ -691,29 +2116,14 @@ func (m *DistributionProportions) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.StartEpoch |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthMint
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthMint
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.CommunityPoolSecurityBudget.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StrategicReserve", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochProvisions", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -741,7 +2151,7 @@ func (m *DistributionProportions) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.StrategicReserve.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.EpochProvisions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -766,7 +2176,7 @@ func (m *DistributionProportions) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Params) Unmarshal(dAtA []byte) error {
+func (m *InflationSchedule) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -789,17 +2199,17 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+			return fmt.Errorf("proto: InflationSchedule: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: InflationSchedule: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MintDenom", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduleType", wireType)
 			}
-			var stringLen uint64
+			m.ScheduleType = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMint
@@ -809,27 +2219,14 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.ScheduleType |= ScheduleType(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthMint
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthMint
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.MintDenom = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GenesisEpochProvisions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DecayRate", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -857,13 +2254,13 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.GenesisEpochProvisions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.DecayRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EpochIdentifier", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetStakingRatio", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -891,13 +2288,15 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.EpochIdentifier = string(dAtA[iNdEx:postIndex])
+			if err := m.TargetStakingRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReductionPeriodInEpochs", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AdjustmentRate", wireType)
 			}
-			m.ReductionPeriodInEpochs = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMint
@@ -907,14 +2306,29 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ReductionPeriodInEpochs |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AdjustmentRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReductionFactor", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MinEpochProvisions", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -942,15 +2356,15 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ReductionFactor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.MinEpochProvisions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DistributionProportions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxEpochProvisions", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMint
@@ -960,30 +2374,31 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthMint
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthMint
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.DistributionProportions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.MaxEpochProvisions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MintingRewardsDistributionStartEpoch", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Segments", wireType)
 			}
-			m.MintingRewardsDistributionStartEpoch = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMint
@@ -993,11 +2408,26 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MintingRewardsDistributionStartEpoch |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Segments = append(m.Segments, ScheduleSegment{})
+			if err := m.Segments[len(m.Segments)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMint(dAtA[iNdEx:])
@@ -32,6 +32,54 @@ func (m Minter) NextEpochProvisions(params Params) sdk.Dec {
 	return m.EpochProvisions.Mul(params.ReductionFactor)
 }
 
+// ScheduledEpochProvisions returns the provisions for the given epoch according to
+// the inflation schedule, as well as whether the provisions were adjusted
+// The step reduction only adjusts the provisions once the reduction period has passed
+// since the last reduction, while all other schedules adjust them every epoch
+// The staking ratio is only used by the staking ratio adaptive schedule
+func (m Minter) ScheduledEpochProvisions(
+	params Params,
+	epochNumber int64,
+	lastReductionEpoch int64,
+	stakingRatio sdk.Dec,
+) (provisions sdk.Dec, adjusted bool) {
+	schedule := params.InflationSchedule
+
+	switch schedule.ScheduleType {
+	case SCHEDULE_EXPONENTIAL_DECAY:
+		return m.EpochProvisions.Mul(sdk.OneDec().Sub(schedule.DecayRate)), true
+
+	case SCHEDULE_STAKING_RATIO_ADAPTIVE:
+		provisions = m.EpochProvisions
+		if stakingRatio.LT(schedule.TargetStakingRatio) {
+			provisions = provisions.Mul(sdk.OneDec().Add(schedule.AdjustmentRate))
+		} else if stakingRatio.GT(schedule.TargetStakingRatio) {
+			provisions = provisions.Mul(sdk.OneDec().Sub(schedule.AdjustmentRate))
+		}
+		provisions = sdk.MaxDec(provisions, schedule.MinEpochProvisions)
+		provisions = sdk.MinDec(provisions, schedule.MaxEpochProvisions)
+		return provisions, true
+
+	case SCHEDULE_PIECEWISE:
+		// Use the last segment that has started, or keep the current provisions
+		// if the first segment has not started yet
+		provisions = m.EpochProvisions
+		for _, segment := range schedule.Segments {
+			if segment.StartEpoch > epochNumber {
+				break
+			}
+			provisions = segment.EpochProvisions
+		}
+		return provisions, true
+
+	default:
+		if epochNumber >= params.ReductionPeriodInEpochs+lastReductionEpoch {
+			return m.NextEpochProvisions(params), true
+		}
+		return m.EpochProvisions, false
+	}
+}
+
 // EpochProvision returns the provisions for a block based on the epoch
 // provisions rate.
 func (m Minter) EpochProvision(params Params) sdk.Coin {
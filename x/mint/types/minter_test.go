@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
 )
 
 // Benchmarking :)
@@ -40,3 +41,125 @@ func BenchmarkNextEpochProvisions(b *testing.B) {
 		minter.NextEpochProvisions(params)
 	}
 }
+
+func TestScheduledEpochProvisions(t *testing.T) {
+	params := DefaultParams()
+	params.ReductionPeriodInEpochs = 10
+	params.ReductionFactor = sdk.MustNewDecFromStr("0.5")
+
+	adaptiveSchedule := InflationSchedule{
+		ScheduleType:       SCHEDULE_STAKING_RATIO_ADAPTIVE,
+		TargetStakingRatio: sdk.MustNewDecFromStr("0.6"),
+		AdjustmentRate:     sdk.MustNewDecFromStr("0.1"),
+		MinEpochProvisions: sdk.NewDec(950),
+		MaxEpochProvisions: sdk.NewDec(1050),
+	}
+
+	testCases := []struct {
+		name               string
+		schedule           InflationSchedule
+		epochNumber        int64
+		stakingRatio       sdk.Dec
+		expectedProvisions sdk.Dec
+		expectedAdjusted   bool
+	}{
+		{
+			name:               "step reduction - within period",
+			schedule:           DefaultInflationSchedule(),
+			epochNumber:        19,
+			expectedProvisions: sdk.NewDec(1000),
+			expectedAdjusted:   false,
+		},
+		{
+			name:               "step reduction - end of period",
+			schedule:           DefaultInflationSchedule(),
+			epochNumber:        20,
+			expectedProvisions: sdk.NewDec(500),
+			expectedAdjusted:   true,
+		},
+		{
+			name: "exponential decay",
+			schedule: InflationSchedule{
+				ScheduleType: SCHEDULE_EXPONENTIAL_DECAY,
+				DecayRate:    sdk.MustNewDecFromStr("0.01"),
+			},
+			epochNumber:        11,
+			expectedProvisions: sdk.NewDec(990),
+			expectedAdjusted:   true,
+		},
+		{
+			name:               "staking ratio adaptive - below target",
+			schedule:           adaptiveSchedule,
+			stakingRatio:       sdk.MustNewDecFromStr("0.5"),
+			expectedProvisions: sdk.NewDec(1050),
+			expectedAdjusted:   true,
+		},
+		{
+			name:               "staking ratio adaptive - above target",
+			schedule:           adaptiveSchedule,
+			stakingRatio:       sdk.MustNewDecFromStr("0.7"),
+			expectedProvisions: sdk.NewDec(950),
+			expectedAdjusted:   true,
+		},
+		{
+			name:               "staking ratio adaptive - at target",
+			schedule:           adaptiveSchedule,
+			stakingRatio:       sdk.MustNewDecFromStr("0.6"),
+			expectedProvisions: sdk.NewDec(1000),
+			expectedAdjusted:   true,
+		},
+		{
+			name: "staking ratio adaptive - clamped",
+			schedule: InflationSchedule{
+				ScheduleType:       SCHEDULE_STAKING_RATIO_ADAPTIVE,
+				TargetStakingRatio: sdk.MustNewDecFromStr("0.6"),
+				AdjustmentRate:     sdk.MustNewDecFromStr("0.5"),
+				MinEpochProvisions: sdk.NewDec(900),
+				MaxEpochProvisions: sdk.NewDec(1200),
+			},
+			stakingRatio:       sdk.MustNewDecFromStr("0.1"),
+			expectedProvisions: sdk.NewDec(1200),
+			expectedAdjusted:   true,
+		},
+		{
+			name: "piecewise - before first segment",
+			schedule: InflationSchedule{
+				ScheduleType: SCHEDULE_PIECEWISE,
+				Segments:     []ScheduleSegment{{StartEpoch: 20, EpochProvisions: sdk.NewDec(300)}},
+			},
+			epochNumber:        19,
+			expectedProvisions: sdk.NewDec(1000),
+			expectedAdjusted:   true,
+		},
+		{
+			name: "piecewise - latest segment",
+			schedule: InflationSchedule{
+				ScheduleType: SCHEDULE_PIECEWISE,
+				Segments: []ScheduleSegment{
+					{StartEpoch: 10, EpochProvisions: sdk.NewDec(300)},
+					{StartEpoch: 20, EpochProvisions: sdk.NewDec(200)},
+					{StartEpoch: 30, EpochProvisions: sdk.NewDec(100)},
+				},
+			},
+			epochNumber:        25,
+			expectedProvisions: sdk.NewDec(200),
+			expectedAdjusted:   true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			params.InflationSchedule = tc.schedule
+			minter := NewMinter(sdk.NewDec(1000))
+
+			stakingRatio := tc.stakingRatio
+			if stakingRatio.IsNil() {
+				stakingRatio = sdk.ZeroDec()
+			}
+
+			provisions, adjusted := minter.ScheduledEpochProvisions(params, tc.epochNumber, 10, stakingRatio)
+			require.Equal(t, tc.expectedProvisions, provisions, "provisions")
+			require.Equal(t, tc.expectedAdjusted, adjusted, "adjusted")
+		})
+	}
+}
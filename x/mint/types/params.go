@@ -10,6 +10,7 @@ import (
 	epochtypes "github.com/Stride-Labs/stride/v24/x/epochs/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 )
 
@@ -23,6 +24,8 @@ var (
 	KeyPoolAllocationRatio                  = []byte("PoolAllocationRatio")
 	KeyDeveloperRewardsReceiver             = []byte("DeveloperRewardsReceiver")
 	KeyMintingRewardsDistributionStartEpoch = []byte("MintingRewardsDistributionStartEpoch")
+	KeyInflationSchedule                    = []byte("InflationSchedule")
+	KeyDistributionRecipients               = []byte("DistributionRecipients")
 )
 
 // ParamTable for minting module.
@@ -48,19 +51,66 @@ func NewParams(
 
 // minting params
 func DefaultParams() Params {
+	distributionProportions := DistributionProportions{
+		Staking:                     sdk.MustNewDecFromStr("0.2764"),
+		CommunityPoolGrowth:         sdk.MustNewDecFromStr("0.1860"),
+		StrategicReserve:            sdk.MustNewDecFromStr("0.4205"),
+		CommunityPoolSecurityBudget: sdk.MustNewDecFromStr("0.1171"),
+	}
+
 	return Params{
-		MintDenom:               sdk.DefaultBondDenom,
-		GenesisEpochProvisions:  sdk.NewDec(2_500_000).Mul(sdk.NewDec(1_000_000)).Quo(sdk.NewDec(24 * 365)), // 2.5MST first year, broken into hours ~= 285ST / hour
-		EpochIdentifier:         "mint",                                                                     // 1 hour
-		ReductionPeriodInEpochs: 24 * 365,                                                                   // 24hrs*365d = 8760
-		ReductionFactor:         sdk.NewDec(1).QuoInt64(2),
-		DistributionProportions: DistributionProportions{
-			Staking:                     sdk.MustNewDecFromStr("0.2764"),
-			CommunityPoolGrowth:         sdk.MustNewDecFromStr("0.1860"),
-			StrategicReserve:            sdk.MustNewDecFromStr("0.4205"),
-			CommunityPoolSecurityBudget: sdk.MustNewDecFromStr("0.1171"),
-		},
+		MintDenom:                            sdk.DefaultBondDenom,
+		GenesisEpochProvisions:               sdk.NewDec(2_500_000).Mul(sdk.NewDec(1_000_000)).Quo(sdk.NewDec(24 * 365)), // 2.5MST first year, broken into hours ~= 285ST / hour
+		EpochIdentifier:                      "mint",                                                                     // 1 hour
+		ReductionPeriodInEpochs:              24 * 365,                                                                   // 24hrs*365d = 8760
+		ReductionFactor:                      sdk.NewDec(1).QuoInt64(2),
+		DistributionProportions:              distributionProportions,
 		MintingRewardsDistributionStartEpoch: 0,
+		InflationSchedule:                    DefaultInflationSchedule(),
+		DistributionRecipients:               RecipientsFromProportions(distributionProportions),
+	}
+}
+
+// Converts the original distribution proportions into weighted recipients
+// The community pool growth is listed first since it receives the rounding remainder
+func RecipientsFromProportions(proportions DistributionProportions) []MintRecipient {
+	return []MintRecipient{
+		{
+			Name:          CommunityPoolGrowthRecipientName,
+			RecipientType: RECIPIENT_ADDRESS,
+			Address:       SubmoduleAddress(CommunityGrowthSubmoduleName, SubmoduleCommunityNamespaceKey).String(),
+			Weight:        proportions.CommunityPoolGrowth,
+		},
+		{
+			Name:          StakingRecipientName,
+			RecipientType: RECIPIENT_MODULE_ACCOUNT,
+			Address:       authtypes.FeeCollectorName,
+			Weight:        proportions.Staking,
+		},
+		{
+			Name:          StrategicReserveRecipientName,
+			RecipientType: RECIPIENT_ADDRESS,
+			Address:       StrategicReserveAddress,
+			Weight:        proportions.StrategicReserve,
+		},
+		{
+			Name:          CommunityPoolSecurityBudgetRecipientName,
+			RecipientType: RECIPIENT_ADDRESS,
+			Address:       SubmoduleAddress(CommunitySecurityBudgetSubmoduleName, SubmoduleCommunityNamespaceKey).String(),
+			Weight:        proportions.CommunityPoolSecurityBudget,
+		},
+	}
+}
+
+// The default schedule reduces the provisions every reduction period
+func DefaultInflationSchedule() InflationSchedule {
+	return InflationSchedule{
+		ScheduleType:       SCHEDULE_STEP_REDUCTION,
+		DecayRate:          sdk.ZeroDec(),
+		TargetStakingRatio: sdk.ZeroDec(),
+		AdjustmentRate:     sdk.ZeroDec(),
+		MinEpochProvisions: sdk.ZeroDec(),
+		MaxEpochProvisions: sdk.ZeroDec(),
 	}
 }
 
@@ -87,6 +137,12 @@ func (p Params) Validate() error {
 	if err := validateMintingRewardsDistributionStartEpoch(p.MintingRewardsDistributionStartEpoch); err != nil {
 		return err
 	}
+	if err := validateInflationSchedule(p.InflationSchedule); err != nil {
+		return err
+	}
+	if err := validateDistributionRecipients(p.DistributionRecipients); err != nil {
+		return err
+	}
 
 	return nil
 }
@@ -107,6 +163,8 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(KeyReductionFactor, &p.ReductionFactor, validateReductionFactor),
 		paramtypes.NewParamSetPair(KeyPoolAllocationRatio, &p.DistributionProportions, validateDistributionProportions),
 		paramtypes.NewParamSetPair(KeyMintingRewardsDistributionStartEpoch, &p.MintingRewardsDistributionStartEpoch, validateMintingRewardsDistributionStartEpoch),
+		paramtypes.NewParamSetPair(KeyInflationSchedule, &p.InflationSchedule, validateInflationSchedule),
+		paramtypes.NewParamSetPair(KeyDistributionRecipients, &p.DistributionRecipients, validateDistributionRecipients),
 	}
 }
 
@@ -212,3 +270,110 @@ func validateMintingRewardsDistributionStartEpoch(i interface{}) error {
 
 	return nil
 }
+
+// Only the fields associated with the schedule type are validated, since the
+// remaining fields are ignored
+func validateInflationSchedule(i interface{}) error {
+	v, ok := i.(InflationSchedule)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	switch v.ScheduleType {
+	case SCHEDULE_STEP_REDUCTION:
+		return nil
+
+	case SCHEDULE_EXPONENTIAL_DECAY:
+		if v.DecayRate.IsNil() || v.DecayRate.IsNegative() || v.DecayRate.GTE(sdk.OneDec()) {
+			return errors.New("decay rate must be between 0 and 1")
+		}
+		return nil
+
+	case SCHEDULE_STAKING_RATIO_ADAPTIVE:
+		if v.TargetStakingRatio.IsNil() || !v.TargetStakingRatio.IsPositive() || v.TargetStakingRatio.GT(sdk.OneDec()) {
+			return errors.New("target staking ratio must be greater than 0 and at most 1")
+		}
+		if v.AdjustmentRate.IsNil() || v.AdjustmentRate.IsNegative() || v.AdjustmentRate.GTE(sdk.OneDec()) {
+			return errors.New("adjustment rate must be between 0 and 1")
+		}
+		if v.MinEpochProvisions.IsNil() || v.MinEpochProvisions.IsNegative() {
+			return errors.New("min epoch provisions must be non-negative")
+		}
+		if v.MaxEpochProvisions.IsNil() || v.MaxEpochProvisions.LT(v.MinEpochProvisions) {
+			return errors.New("max epoch provisions must be greater than or equal to the min epoch provisions")
+		}
+		return nil
+
+	case SCHEDULE_PIECEWISE:
+		if len(v.Segments) == 0 {
+			return errors.New("piecewise schedule must have at least one segment")
+		}
+		for i, segment := range v.Segments {
+			if segment.StartEpoch < 0 {
+				return fmt.Errorf("segment %d start epoch must be non-negative", i)
+			}
+			if i > 0 && segment.StartEpoch <= v.Segments[i-1].StartEpoch {
+				return fmt.Errorf("segment %d start epoch must be greater than the previous segment's", i)
+			}
+			if segment.EpochProvisions.IsNil() || segment.EpochProvisions.IsNegative() {
+				return fmt.Errorf("segment %d epoch provisions must be non-negative", i)
+			}
+		}
+		return nil
+
+	default:
+		return fmt.Errorf("invalid schedule type: %d", v.ScheduleType)
+	}
+}
+
+func validateDistributionRecipients(i interface{}) error {
+	v, ok := i.([]MintRecipient)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if len(v) == 0 {
+		return errors.New("at least one distribution recipient is required")
+	}
+	// The first recipient receives the rounding remainder and the share of any failed
+	// distributions, so it must be able to receive tokens without executing a contract
+	if v[0].RecipientType == RECIPIENT_WASM_CONTRACT {
+		return errors.New("the first distribution recipient cannot be a wasm contract")
+	}
+
+	names := map[string]bool{}
+	totalWeight := sdk.ZeroDec()
+	for _, recipient := range v {
+		if strings.TrimSpace(recipient.Name) == "" {
+			return errors.New("distribution recipient name cannot be blank")
+		}
+		if names[recipient.Name] {
+			return fmt.Errorf("duplicate distribution recipient %s", recipient.Name)
+		}
+		names[recipient.Name] = true
+
+		switch recipient.RecipientType {
+		case RECIPIENT_MODULE_ACCOUNT:
+			if strings.TrimSpace(recipient.Address) == "" {
+				return fmt.Errorf("distribution recipient %s module account name cannot be blank", recipient.Name)
+			}
+		case RECIPIENT_ADDRESS, RECIPIENT_WASM_CONTRACT:
+			if _, err := sdk.AccAddressFromBech32(recipient.Address); err != nil {
+				return fmt.Errorf("distribution recipient %s has an invalid address: %s", recipient.Name, err.Error())
+			}
+		default:
+			return fmt.Errorf("distribution recipient %s has an invalid type: %d", recipient.Name, recipient.RecipientType)
+		}
+
+		if recipient.Weight.IsNil() || !recipient.Weight.IsPositive() {
+			return fmt.Errorf("distribution recipient %s weight must be positive", recipient.Name)
+		}
+		totalWeight = totalWeight.Add(recipient.Weight)
+	}
+
+	if !totalWeight.Equal(sdk.OneDec()) {
+		return fmt.Errorf("total distribution recipient weight should be 1, instead got %s", totalWeight.String())
+	}
+
+	return nil
+}
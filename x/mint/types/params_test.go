@@ -0,0 +1,235 @@
+package types_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Stride-Labs/stride/v24/app/apptesting"
+	"github.com/Stride-Labs/stride/v24/x/mint/types"
+)
+
+func TestValidateInflationSchedule(t *testing.T) {
+	validAdaptive := types.InflationSchedule{
+		ScheduleType:       types.SCHEDULE_STAKING_RATIO_ADAPTIVE,
+		TargetStakingRatio: sdk.MustNewDecFromStr("0.6"),
+		AdjustmentRate:     sdk.MustNewDecFromStr("0.01"),
+		MinEpochProvisions: sdk.NewDec(100),
+		MaxEpochProvisions: sdk.NewDec(200),
+	}
+
+	testCases := []struct {
+		name          string
+		schedule      types.InflationSchedule
+		expectedError string
+	}{
+		{
+			name:     "default step reduction",
+			schedule: types.DefaultInflationSchedule(),
+		},
+		{
+			name:     "step reduction with unset fields",
+			schedule: types.InflationSchedule{ScheduleType: types.SCHEDULE_STEP_REDUCTION},
+		},
+		{
+			name:     "valid exponential decay",
+			schedule: types.InflationSchedule{ScheduleType: types.SCHEDULE_EXPONENTIAL_DECAY, DecayRate: sdk.MustNewDecFromStr("0.001")},
+		},
+		{
+			name:          "exponential decay missing rate",
+			schedule:      types.InflationSchedule{ScheduleType: types.SCHEDULE_EXPONENTIAL_DECAY},
+			expectedError: "decay rate must be between 0 and 1",
+		},
+		{
+			name:          "exponential decay rate of 1",
+			schedule:      types.InflationSchedule{ScheduleType: types.SCHEDULE_EXPONENTIAL_DECAY, DecayRate: sdk.OneDec()},
+			expectedError: "decay rate must be between 0 and 1",
+		},
+		{
+			name:     "valid staking ratio adaptive",
+			schedule: validAdaptive,
+		},
+		{
+			name: "staking ratio adaptive zero target",
+			schedule: func() types.InflationSchedule {
+				s := validAdaptive
+				s.TargetStakingRatio = sdk.ZeroDec()
+				return s
+			}(),
+			expectedError: "target staking ratio must be greater than 0",
+		},
+		{
+			name: "staking ratio adaptive invalid adjustment rate",
+			schedule: func() types.InflationSchedule {
+				s := validAdaptive
+				s.AdjustmentRate = sdk.NewDec(-1)
+				return s
+			}(),
+			expectedError: "adjustment rate must be between 0 and 1",
+		},
+		{
+			name: "staking ratio adaptive max below min",
+			schedule: func() types.InflationSchedule {
+				s := validAdaptive
+				s.MaxEpochProvisions = sdk.NewDec(50)
+				return s
+			}(),
+			expectedError: "max epoch provisions must be greater than or equal to the min",
+		},
+		{
+			name: "valid piecewise",
+			schedule: types.InflationSchedule{
+				ScheduleType: types.SCHEDULE_PIECEWISE,
+				Segments: []types.ScheduleSegment{
+					{StartEpoch: 0, EpochProvisions: sdk.NewDec(100)},
+					{StartEpoch: 10, EpochProvisions: sdk.ZeroDec()},
+				},
+			},
+		},
+		{
+			name:          "piecewise without segments",
+			schedule:      types.InflationSchedule{ScheduleType: types.SCHEDULE_PIECEWISE},
+			expectedError: "piecewise schedule must have at least one segment",
+		},
+		{
+			name: "piecewise unsorted segments",
+			schedule: types.InflationSchedule{
+				ScheduleType: types.SCHEDULE_PIECEWISE,
+				Segments: []types.ScheduleSegment{
+					{StartEpoch: 10, EpochProvisions: sdk.NewDec(100)},
+					{StartEpoch: 10, EpochProvisions: sdk.NewDec(50)},
+				},
+			},
+			expectedError: "segment 1 start epoch must be greater than the previous segment's",
+		},
+		{
+			name: "piecewise negative provisions",
+			schedule: types.InflationSchedule{
+				ScheduleType: types.SCHEDULE_PIECEWISE,
+				Segments:     []types.ScheduleSegment{{StartEpoch: 0, EpochProvisions: sdk.NewDec(-1)}},
+			},
+			expectedError: "segment 0 epoch provisions must be non-negative",
+		},
+		{
+			name:          "invalid schedule type",
+			schedule:      types.InflationSchedule{ScheduleType: 99},
+			expectedError: "invalid schedule type",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			params := types.DefaultParams()
+			params.InflationSchedule = tc.schedule
+
+			err := params.Validate()
+			if tc.expectedError == "" {
+				require.NoError(t, err)
+			} else {
+				require.ErrorContains(t, err, tc.expectedError)
+			}
+		})
+	}
+}
+
+func TestValidateDistributionRecipients(t *testing.T) {
+	apptesting.SetupConfig()
+	validAddress := types.SubmoduleAddress(types.CommunityGrowthSubmoduleName, types.SubmoduleCommunityNamespaceKey).String()
+
+	testCases := []struct {
+		name          string
+		recipients    []types.MintRecipient
+		expectedError string
+	}{
+		{
+			name:       "default recipients",
+			recipients: types.DefaultParams().DistributionRecipients,
+		},
+		{
+			name: "valid contract recipient",
+			recipients: []types.MintRecipient{
+				{Name: "a", RecipientType: types.RECIPIENT_MODULE_ACCOUNT, Address: "fee_collector", Weight: sdk.MustNewDecFromStr("0.5")},
+				{Name: "b", RecipientType: types.RECIPIENT_WASM_CONTRACT, Address: validAddress, Weight: sdk.MustNewDecFromStr("0.5")},
+			},
+		},
+		{
+			name:          "no recipients",
+			recipients:    []types.MintRecipient{},
+			expectedError: "at least one distribution recipient is required",
+		},
+		{
+			name: "first recipient is contract",
+			recipients: []types.MintRecipient{
+				{Name: "a", RecipientType: types.RECIPIENT_WASM_CONTRACT, Address: validAddress, Weight: sdk.OneDec()},
+			},
+			expectedError: "the first distribution recipient cannot be a wasm contract",
+		},
+		{
+			name: "blank name",
+			recipients: []types.MintRecipient{
+				{Name: "", RecipientType: types.RECIPIENT_ADDRESS, Address: validAddress, Weight: sdk.OneDec()},
+			},
+			expectedError: "distribution recipient name cannot be blank",
+		},
+		{
+			name: "duplicate name",
+			recipients: []types.MintRecipient{
+				{Name: "a", RecipientType: types.RECIPIENT_ADDRESS, Address: validAddress, Weight: sdk.MustNewDecFromStr("0.5")},
+				{Name: "a", RecipientType: types.RECIPIENT_ADDRESS, Address: validAddress, Weight: sdk.MustNewDecFromStr("0.5")},
+			},
+			expectedError: "duplicate distribution recipient a",
+		},
+		{
+			name: "blank module account",
+			recipients: []types.MintRecipient{
+				{Name: "a", RecipientType: types.RECIPIENT_MODULE_ACCOUNT, Address: "", Weight: sdk.OneDec()},
+			},
+			expectedError: "module account name cannot be blank",
+		},
+		{
+			name: "invalid address",
+			recipients: []types.MintRecipient{
+				{Name: "a", RecipientType: types.RECIPIENT_ADDRESS, Address: "invalid", Weight: sdk.OneDec()},
+			},
+			expectedError: "distribution recipient a has an invalid address",
+		},
+		{
+			name: "invalid type",
+			recipients: []types.MintRecipient{
+				{Name: "a", RecipientType: 99, Address: validAddress, Weight: sdk.OneDec()},
+			},
+			expectedError: "distribution recipient a has an invalid type",
+		},
+		{
+			name: "zero weight",
+			recipients: []types.MintRecipient{
+				{Name: "a", RecipientType: types.RECIPIENT_ADDRESS, Address: validAddress, Weight: sdk.OneDec()},
+				{Name: "b", RecipientType: types.RECIPIENT_ADDRESS, Address: validAddress, Weight: sdk.ZeroDec()},
+			},
+			expectedError: "distribution recipient b weight must be positive",
+		},
+		{
+			name: "weights do not sum to one",
+			recipients: []types.MintRecipient{
+				{Name: "a", RecipientType: types.RECIPIENT_ADDRESS, Address: validAddress, Weight: sdk.MustNewDecFromStr("0.5")},
+				{Name: "b", RecipientType: types.RECIPIENT_ADDRESS, Address: validAddress, Weight: sdk.MustNewDecFromStr("0.4")},
+			},
+			expectedError: "total distribution recipient weight should be 1",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			params := types.DefaultParams()
+			params.DistributionRecipients = tc.recipients
+
+			err := params.Validate()
+			if tc.expectedError == "" {
+				require.NoError(t, err)
+			} else {
+				require.ErrorContains(t, err, tc.expectedError)
+			}
+		})
+	}
+}
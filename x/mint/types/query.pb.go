@@ -5,6 +5,7 @@ package types
 
 import (
 	context "context"
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
@@ -191,42 +192,296 @@ func (m *QueryEpochProvisionsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_QueryEpochProvisionsResponse proto.InternalMessageInfo
 
+// QueryProjectedProvisionsRequest is the request type for the
+// Query/ProjectedProvisions RPC method.
+type QueryProjectedProvisionsRequest struct {
+	// number of epochs to project
+	NumEpochs uint64 `protobuf:"varint,1,opt,name=num_epochs,json=numEpochs,proto3" json:"num_epochs,omitempty"`
+}
+
+func (m *QueryProjectedProvisionsRequest) Reset()         { *m = QueryProjectedProvisionsRequest{} }
+func (m *QueryProjectedProvisionsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryProjectedProvisionsRequest) ProtoMessage()    {}
+func (*QueryProjectedProvisionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b5a371e09ad2a41a, []int{4}
+}
+func (m *QueryProjectedProvisionsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryProjectedProvisionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProjectedProvisionsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryProjectedProvisionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProjectedProvisionsRequest.Merge(m, src)
+}
+func (m *QueryProjectedProvisionsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProjectedProvisionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProjectedProvisionsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryProjectedProvisionsRequest proto.InternalMessageInfo
+
+func (m *QueryProjectedProvisionsRequest) GetNumEpochs() uint64 {
+	if m != nil {
+		return m.NumEpochs
+	}
+	return 0
+}
+
+// EpochProjection is the projected mint state at the end of an epoch
+type EpochProjection struct {
+	// epoch number
+	EpochNumber int64 `protobuf:"varint,1,opt,name=epoch_number,json=epochNumber,proto3" json:"epoch_number,omitempty"`
+	// provisions for the epoch
+	EpochProvisions github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=epoch_provisions,json=epochProvisions,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"epoch_provisions"`
+	// amount minted at the end of the epoch
+	Minted cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=minted,proto3,customtype=cosmossdk.io/math.Int" json:"minted"`
+	// total supply of the mint denom after the epoch
+	TotalSupply cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=total_supply,json=totalSupply,proto3,customtype=cosmossdk.io/math.Int" json:"total_supply"`
+}
+
+func (m *EpochProjection) Reset()         { *m = EpochProjection{} }
+func (m *EpochProjection) String() string { return proto.CompactTextString(m) }
+func (*EpochProjection) ProtoMessage()    {}
+func (*EpochProjection) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b5a371e09ad2a41a, []int{5}
+}
+func (m *EpochProjection) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EpochProjection) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EpochProjection.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EpochProjection) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EpochProjection.Merge(m, src)
+}
+func (m *EpochProjection) XXX_Size() int {
+	return m.Size()
+}
+func (m *EpochProjection) XXX_DiscardUnknown() {
+	xxx_messageInfo_EpochProjection.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EpochProjection proto.InternalMessageInfo
+
+func (m *EpochProjection) GetEpochNumber() int64 {
+	if m != nil {
+		return m.EpochNumber
+	}
+	return 0
+}
+
+// QueryProjectedProvisionsResponse is the response type for the
+// Query/ProjectedProvisions RPC method.
+type QueryProjectedProvisionsResponse struct {
+	// projections for each of the next epochs, assuming the staking ratio
+	// stays at its current value
+	Projections []EpochProjection `protobuf:"bytes,1,rep,name=projections,proto3" json:"projections"`
+}
+
+func (m *QueryProjectedProvisionsResponse) Reset()         { *m = QueryProjectedProvisionsResponse{} }
+func (m *QueryProjectedProvisionsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryProjectedProvisionsResponse) ProtoMessage()    {}
+func (*QueryProjectedProvisionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b5a371e09ad2a41a, []int{6}
+}
+func (m *QueryProjectedProvisionsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryProjectedProvisionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProjectedProvisionsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryProjectedProvisionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProjectedProvisionsResponse.Merge(m, src)
+}
+func (m *QueryProjectedProvisionsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProjectedProvisionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProjectedProvisionsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryProjectedProvisionsResponse proto.InternalMessageInfo
+
+func (m *QueryProjectedProvisionsResponse) GetProjections() []EpochProjection {
+	if m != nil {
+		return m.Projections
+	}
+	return nil
+}
+
+// QueryRecipientEmissionsRequest is the request type for the
+// Query/RecipientEmissions RPC method.
+type QueryRecipientEmissionsRequest struct {
+}
+
+func (m *QueryRecipientEmissionsRequest) Reset()         { *m = QueryRecipientEmissionsRequest{} }
+func (m *QueryRecipientEmissionsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRecipientEmissionsRequest) ProtoMessage()    {}
+func (*QueryRecipientEmissionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b5a371e09ad2a41a, []int{7}
+}
+func (m *QueryRecipientEmissionsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRecipientEmissionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRecipientEmissionsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRecipientEmissionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRecipientEmissionsRequest.Merge(m, src)
+}
+func (m *QueryRecipientEmissionsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRecipientEmissionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRecipientEmissionsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRecipientEmissionsRequest proto.InternalMessageInfo
+
+// QueryRecipientEmissionsResponse is the response type for the
+// Query/RecipientEmissions RPC method.
+type QueryRecipientEmissionsResponse struct {
+	// cumulative emissions for each current and past recipient
+	Emissions []RecipientEmissions `protobuf:"bytes,1,rep,name=emissions,proto3" json:"emissions"`
+}
+
+func (m *QueryRecipientEmissionsResponse) Reset()         { *m = QueryRecipientEmissionsResponse{} }
+func (m *QueryRecipientEmissionsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRecipientEmissionsResponse) ProtoMessage()    {}
+func (*QueryRecipientEmissionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b5a371e09ad2a41a, []int{8}
+}
+func (m *QueryRecipientEmissionsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRecipientEmissionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRecipientEmissionsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRecipientEmissionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRecipientEmissionsResponse.Merge(m, src)
+}
+func (m *QueryRecipientEmissionsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRecipientEmissionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRecipientEmissionsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRecipientEmissionsResponse proto.InternalMessageInfo
+
+func (m *QueryRecipientEmissionsResponse) GetEmissions() []RecipientEmissions {
+	if m != nil {
+		return m.Emissions
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "stride.mint.v1beta1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "stride.mint.v1beta1.QueryParamsResponse")
 	proto.RegisterType((*QueryEpochProvisionsRequest)(nil), "stride.mint.v1beta1.QueryEpochProvisionsRequest")
 	proto.RegisterType((*QueryEpochProvisionsResponse)(nil), "stride.mint.v1beta1.QueryEpochProvisionsResponse")
+	proto.RegisterType((*QueryProjectedProvisionsRequest)(nil), "stride.mint.v1beta1.QueryProjectedProvisionsRequest")
+	proto.RegisterType((*EpochProjection)(nil), "stride.mint.v1beta1.EpochProjection")
+	proto.RegisterType((*QueryProjectedProvisionsResponse)(nil), "stride.mint.v1beta1.QueryProjectedProvisionsResponse")
+	proto.RegisterType((*QueryRecipientEmissionsRequest)(nil), "stride.mint.v1beta1.QueryRecipientEmissionsRequest")
+	proto.RegisterType((*QueryRecipientEmissionsResponse)(nil), "stride.mint.v1beta1.QueryRecipientEmissionsResponse")
 }
 
 func init() { proto.RegisterFile("stride/mint/v1beta1/query.proto", fileDescriptor_b5a371e09ad2a41a) }
 
 var fileDescriptor_b5a371e09ad2a41a = []byte{
-	// 394 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x52, 0x31, 0x4f, 0xe3, 0x30,
-	0x18, 0x4d, 0xaa, 0xbb, 0x0e, 0xbe, 0x93, 0x7a, 0x72, 0xab, 0x13, 0x4a, 0x8b, 0x5b, 0x65, 0x28,
-	0x5d, 0x6a, 0xd3, 0xc2, 0xc2, 0x5a, 0x81, 0xc4, 0xc0, 0x50, 0xca, 0x04, 0x0b, 0x4a, 0x52, 0x2b,
-	0x8d, 0x20, 0x71, 0x1a, 0xbb, 0x15, 0x59, 0xf9, 0x05, 0x48, 0xec, 0x2c, 0xfc, 0x99, 0x8e, 0x95,
-	0x58, 0x10, 0x43, 0x85, 0x5a, 0x7e, 0x08, 0x8a, 0x63, 0x10, 0x29, 0x41, 0x88, 0x29, 0x91, 0xdf,
-	0xfb, 0xde, 0x7b, 0xdf, 0xb3, 0x41, 0x9d, 0x8b, 0xc8, 0x1b, 0x52, 0xe2, 0x7b, 0x81, 0x20, 0xd3,
-	0x8e, 0x4d, 0x85, 0xd5, 0x21, 0xe3, 0x09, 0x8d, 0x62, 0x1c, 0x46, 0x4c, 0x30, 0x58, 0x4e, 0x09,
-	0x38, 0x21, 0x60, 0x45, 0x30, 0x2a, 0x2e, 0x73, 0x99, 0xc4, 0x49, 0xf2, 0x97, 0x52, 0x8d, 0x9a,
-	0xcb, 0x98, 0x7b, 0x49, 0x89, 0x15, 0x7a, 0xc4, 0x0a, 0x02, 0x26, 0x2c, 0xe1, 0xb1, 0x80, 0x2b,
-	0x14, 0xe5, 0x39, 0x49, 0x55, 0x89, 0x9b, 0x15, 0x00, 0x8f, 0x13, 0xdf, 0xbe, 0x15, 0x59, 0x3e,
-	0x1f, 0xd0, 0xf1, 0x84, 0x72, 0x61, 0xf6, 0x41, 0x39, 0x73, 0xca, 0x43, 0x16, 0x70, 0x0a, 0xf7,
-	0x40, 0x31, 0x94, 0x27, 0x1b, 0x7a, 0x43, 0x6f, 0xfd, 0xe9, 0x56, 0x71, 0x4e, 0x4c, 0x9c, 0x0e,
-	0xf5, 0x7e, 0xcd, 0x16, 0x75, 0x6d, 0xa0, 0x06, 0xcc, 0x4d, 0x50, 0x95, 0x8a, 0x07, 0x21, 0x73,
-	0x46, 0xfd, 0x88, 0x4d, 0x3d, 0x9e, 0xa4, 0x7c, 0x33, 0x8c, 0x41, 0x2d, 0x1f, 0x56, 0xce, 0xa7,
-	0xe0, 0x1f, 0x4d, 0xa0, 0xf3, 0xf0, 0x1d, 0x93, 0x19, 0xfe, 0xf6, 0x70, 0x62, 0xf3, 0xb4, 0xa8,
-	0x37, 0x5d, 0x4f, 0x8c, 0x26, 0x36, 0x76, 0x98, 0x4f, 0x1c, 0xc6, 0x7d, 0xc6, 0xd5, 0xa7, 0xcd,
-	0x87, 0x17, 0x44, 0xc4, 0x21, 0xe5, 0x78, 0x9f, 0x3a, 0x83, 0x12, 0xcd, 0x5a, 0x74, 0xef, 0x0b,
-	0xe0, 0xb7, 0xf4, 0x86, 0x31, 0x28, 0xa6, 0xd9, 0xe1, 0x56, 0xee, 0x62, 0x9f, 0x8b, 0x32, 0x5a,
-	0xdf, 0x13, 0xd3, 0x0d, 0xcc, 0xda, 0xf5, 0xc3, 0xcb, 0x6d, 0xe1, 0x3f, 0xac, 0x64, 0xaf, 0x22,
-	0xad, 0x07, 0xde, 0xe9, 0xa0, 0xb4, 0xb6, 0x3b, 0xdc, 0xfe, 0x5a, 0x3b, 0xbf, 0x45, 0xa3, 0xf3,
-	0x83, 0x09, 0x15, 0xab, 0x29, 0x63, 0x35, 0x20, 0xca, 0xc6, 0x5a, 0x2f, 0xbb, 0x77, 0x38, 0x5b,
-	0x22, 0x7d, 0xbe, 0x44, 0xfa, 0xf3, 0x12, 0xe9, 0x37, 0x2b, 0xa4, 0xcd, 0x57, 0x48, 0x7b, 0x5c,
-	0x21, 0xed, 0x0c, 0x7f, 0x28, 0xfe, 0x44, 0xda, 0xb7, 0x8f, 0x2c, 0x9b, 0x13, 0xf5, 0xf0, 0xa6,
-	0xdd, 0x5d, 0x72, 0x95, 0x8a, 0xcb, 0x4b, 0xb0, 0x8b, 0xf2, 0xe1, 0xed, 0xbc, 0x06, 0x00, 0x00,
-	0xff, 0xff, 0x3f, 0x1a, 0xa4, 0xc7, 0x04, 0x03, 0x00, 0x00,
+	// 664 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x95, 0xcf, 0x6e, 0xd3, 0x40,
+	0x10, 0xc6, 0xe3, 0xfe, 0x89, 0xd4, 0x4d, 0xa5, 0xa2, 0x6d, 0x41, 0x95, 0xdb, 0x3a, 0xa9, 0x41,
+	0x25, 0x1c, 0xea, 0x25, 0x69, 0x2b, 0xc4, 0xad, 0xaa, 0xa8, 0x04, 0xa2, 0x42, 0xc5, 0x3d, 0xc1,
+	0x25, 0x72, 0x9c, 0x55, 0x6a, 0x5a, 0xef, 0x6e, 0xbd, 0xeb, 0x88, 0x08, 0x71, 0xe1, 0xc4, 0x09,
+	0x21, 0x71, 0xe7, 0x01, 0x78, 0x05, 0x5e, 0xa0, 0xc7, 0x4a, 0x5c, 0x10, 0x87, 0x0a, 0x25, 0x3c,
+	0x08, 0xf2, 0x7a, 0x9d, 0xc4, 0x89, 0x0d, 0x44, 0x9c, 0x12, 0xed, 0xcc, 0x37, 0xf3, 0xf3, 0xf8,
+	0x9b, 0x35, 0x28, 0x73, 0x11, 0x78, 0x2d, 0x8c, 0x7c, 0x8f, 0x08, 0xd4, 0xa9, 0x35, 0xb1, 0x70,
+	0x6a, 0xe8, 0x22, 0xc4, 0x41, 0xd7, 0x62, 0x01, 0x15, 0x14, 0x2e, 0xc7, 0x09, 0x56, 0x94, 0x60,
+	0xa9, 0x04, 0x7d, 0xa5, 0x4d, 0xdb, 0x54, 0xc6, 0x51, 0xf4, 0x2f, 0x4e, 0xd5, 0xd7, 0xdb, 0x94,
+	0xb6, 0xcf, 0x31, 0x72, 0x98, 0x87, 0x1c, 0x42, 0xa8, 0x70, 0x84, 0x47, 0x09, 0x57, 0x51, 0x23,
+	0xab, 0x93, 0xac, 0x2a, 0xe3, 0xe6, 0x0a, 0x80, 0xcf, 0xa3, 0xbe, 0xc7, 0x4e, 0xe0, 0xf8, 0xdc,
+	0xc6, 0x17, 0x21, 0xe6, 0xc2, 0x3c, 0x06, 0xcb, 0xa9, 0x53, 0xce, 0x28, 0xe1, 0x18, 0x3e, 0x04,
+	0x45, 0x26, 0x4f, 0x56, 0xb5, 0x8a, 0x56, 0x2d, 0xd5, 0xd7, 0xac, 0x0c, 0x4c, 0x2b, 0x16, 0x1d,
+	0xcc, 0x5d, 0x5e, 0x97, 0x0b, 0xb6, 0x12, 0x98, 0x1b, 0x60, 0x4d, 0x56, 0x3c, 0x64, 0xd4, 0x3d,
+	0x3d, 0x0e, 0x68, 0xc7, 0xe3, 0x11, 0x65, 0xd2, 0xb0, 0x0b, 0xd6, 0xb3, 0xc3, 0xaa, 0xf3, 0x0b,
+	0x70, 0x03, 0x47, 0xa1, 0x06, 0x1b, 0xc4, 0x24, 0xc3, 0xe2, 0x81, 0x15, 0xb5, 0xf9, 0x71, 0x5d,
+	0xde, 0x6a, 0x7b, 0xe2, 0x34, 0x6c, 0x5a, 0x2e, 0xf5, 0x91, 0x4b, 0xb9, 0x4f, 0xb9, 0xfa, 0xd9,
+	0xe6, 0xad, 0x33, 0x24, 0xba, 0x0c, 0x73, 0xeb, 0x11, 0x76, 0xed, 0x25, 0x9c, 0x6e, 0x61, 0xee,
+	0x83, 0x72, 0xfc, 0xac, 0x01, 0x7d, 0x85, 0x5d, 0x81, 0x5b, 0x13, 0x74, 0x70, 0x03, 0x00, 0x12,
+	0xfa, 0x0d, 0xa9, 0x8c, 0xfb, 0xce, 0xd9, 0x0b, 0x24, 0xf4, 0x25, 0x2d, 0x37, 0xdf, 0xcf, 0x80,
+	0xa5, 0x04, 0x3c, 0x2a, 0xe1, 0x51, 0x02, 0x37, 0xc1, 0x62, 0x0c, 0x4c, 0x42, 0xbf, 0x89, 0x03,
+	0x29, 0x9a, 0xb5, 0x4b, 0xf2, 0xec, 0x99, 0x3c, 0xca, 0x7c, 0xa6, 0x99, 0x8a, 0x56, 0x5d, 0xf8,
+	0xef, 0x67, 0x82, 0x7b, 0xa0, 0x18, 0xbd, 0x12, 0xdc, 0x5a, 0x9d, 0x95, 0x05, 0x37, 0x54, 0xc1,
+	0x9b, 0xb1, 0x9c, 0xb7, 0xce, 0x2c, 0x8f, 0x22, 0xdf, 0x11, 0xa7, 0xd6, 0x13, 0x22, 0x6c, 0x95,
+	0x0c, 0xf7, 0xc1, 0xa2, 0xa0, 0xc2, 0x39, 0x6f, 0xf0, 0x90, 0xb1, 0xf3, 0xee, 0xea, 0xdc, 0xbf,
+	0x88, 0x4b, 0x52, 0x72, 0x22, 0x15, 0x26, 0x03, 0x95, 0xfc, 0x61, 0xaa, 0x77, 0x79, 0x04, 0x4a,
+	0x6c, 0x30, 0xa8, 0x68, 0x9c, 0xb3, 0xd5, 0x52, 0xfd, 0x4e, 0xa6, 0x95, 0xc6, 0xa6, 0xaa, 0x3c,
+	0x35, 0x2a, 0x37, 0x2b, 0xc0, 0x90, 0x1d, 0x6d, 0xec, 0x7a, 0xcc, 0xc3, 0x44, 0x1c, 0xfa, 0x1e,
+	0x4f, 0x79, 0x8b, 0x80, 0x72, 0x6e, 0x86, 0x42, 0x7a, 0x0a, 0x16, 0x70, 0x72, 0xa8, 0x80, 0xee,
+	0x66, 0x02, 0x4d, 0xd6, 0x50, 0x4c, 0x43, 0x7d, 0xfd, 0xc3, 0x3c, 0x98, 0x97, 0x0d, 0x61, 0x17,
+	0x14, 0xe3, 0x65, 0x80, 0xd9, 0xd5, 0x26, 0x37, 0x4f, 0xaf, 0xfe, 0x3d, 0x31, 0x66, 0x36, 0xd7,
+	0xdf, 0x7d, 0xfb, 0xf5, 0x69, 0xe6, 0x16, 0x5c, 0x49, 0xef, 0x76, 0xbc, 0x6f, 0xf0, 0xb3, 0x36,
+	0xf4, 0x64, 0xe2, 0x8a, 0xfb, 0xf9, 0xb5, 0xb3, 0xd7, 0x52, 0xaf, 0x4d, 0xa1, 0x50, 0x58, 0x5b,
+	0x12, 0xab, 0x02, 0x8d, 0x34, 0xd6, 0xb8, 0xd3, 0xe1, 0x57, 0x0d, 0x2c, 0x67, 0xb8, 0x04, 0xee,
+	0xfe, 0x61, 0x00, 0xb9, 0x1b, 0xaa, 0xef, 0x4d, 0xa9, 0x52, 0xb0, 0x0f, 0x24, 0x6c, 0x0d, 0xa2,
+	0xb1, 0x19, 0x26, 0x92, 0x11, 0x60, 0xf4, 0x66, 0x78, 0x05, 0xbc, 0x85, 0x5f, 0x34, 0x00, 0x27,
+	0xbd, 0x00, 0x77, 0xf2, 0x31, 0x72, 0xfd, 0xa9, 0xef, 0x4e, 0x27, 0x52, 0xe8, 0xf7, 0x24, 0xfa,
+	0x6d, 0xb8, 0x99, 0x46, 0x0f, 0x12, 0x45, 0x63, 0x60, 0xc8, 0x83, 0xc7, 0x97, 0x3d, 0x43, 0xbb,
+	0xea, 0x19, 0xda, 0xcf, 0x9e, 0xa1, 0x7d, 0xec, 0x1b, 0x85, 0xab, 0xbe, 0x51, 0xf8, 0xde, 0x37,
+	0x0a, 0x2f, 0xad, 0x91, 0x0b, 0xe6, 0x44, 0x42, 0x6c, 0x1f, 0x39, 0x4d, 0x8e, 0xd4, 0x47, 0xa3,
+	0x53, 0xdf, 0x45, 0xaf, 0xe3, 0xfa, 0xf2, 0xb2, 0x69, 0x16, 0xe5, 0x47, 0x63, 0xe7, 0xf7, 0x00,
+	0xc6, 0x62, 0x24, 0x01, 0xc0, 0x06, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -245,6 +500,11 @@ type QueryClient interface {
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
 	// EpochProvisions current minting epoch provisions value.
 	EpochProvisions(ctx context.Context, in *QueryEpochProvisionsRequest, opts ...grpc.CallOption) (*QueryEpochProvisionsResponse, error)
+	// ProjectedProvisions projects the provisions and total supply over the
+	// next epochs, using the current params
+	ProjectedProvisions(ctx context.Context, in *QueryProjectedProvisionsRequest, opts ...grpc.CallOption) (*QueryProjectedProvisionsResponse, error)
+	// RecipientEmissions returns the cumulative emissions to each recipient
+	RecipientEmissions(ctx context.Context, in *QueryRecipientEmissionsRequest, opts ...grpc.CallOption) (*QueryRecipientEmissionsResponse, error)
 }
 
 type queryClient struct {
@@ -273,12 +533,35 @@ func (c *queryClient) EpochProvisions(ctx context.Context, in *QueryEpochProvisi
 	return out, nil
 }
 
+func (c *queryClient) ProjectedProvisions(ctx context.Context, in *QueryProjectedProvisionsRequest, opts ...grpc.CallOption) (*QueryProjectedProvisionsResponse, error) {
+	out := new(QueryProjectedProvisionsResponse)
+	err := c.cc.Invoke(ctx, "/stride.mint.v1beta1.Query/ProjectedProvisions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) RecipientEmissions(ctx context.Context, in *QueryRecipientEmissionsRequest, opts ...grpc.CallOption) (*QueryRecipientEmissionsResponse, error) {
+	out := new(QueryRecipientEmissionsResponse)
+	err := c.cc.Invoke(ctx, "/stride.mint.v1beta1.Query/RecipientEmissions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Params returns the total set of minting parameters.
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
 	// EpochProvisions current minting epoch provisions value.
 	EpochProvisions(context.Context, *QueryEpochProvisionsRequest) (*QueryEpochProvisionsResponse, error)
+	// ProjectedProvisions projects the provisions and total supply over the
+	// next epochs, using the current params
+	ProjectedProvisions(context.Context, *QueryProjectedProvisionsRequest) (*QueryProjectedProvisionsResponse, error)
+	// RecipientEmissions returns the cumulative emissions to each recipient
+	RecipientEmissions(context.Context, *QueryRecipientEmissionsRequest) (*QueryRecipientEmissionsResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -291,6 +574,12 @@ func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsReq
 func (*UnimplementedQueryServer) EpochProvisions(ctx context.Context, req *QueryEpochProvisionsRequest) (*QueryEpochProvisionsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method EpochProvisions not implemented")
 }
+func (*UnimplementedQueryServer) ProjectedProvisions(ctx context.Context, req *QueryProjectedProvisionsRequest) (*QueryProjectedProvisionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProjectedProvisions not implemented")
+}
+func (*UnimplementedQueryServer) RecipientEmissions(ctx context.Context, req *QueryRecipientEmissionsRequest) (*QueryRecipientEmissionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecipientEmissions not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -332,6 +621,42 @@ func _Query_EpochProvisions_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_ProjectedProvisions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryProjectedProvisionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ProjectedProvisions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stride.mint.v1beta1.Query/ProjectedProvisions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ProjectedProvisions(ctx, req.(*QueryProjectedProvisionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RecipientEmissions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRecipientEmissionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RecipientEmissions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stride.mint.v1beta1.Query/RecipientEmissions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RecipientEmissions(ctx, req.(*QueryRecipientEmissionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "stride.mint.v1beta1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -344,6 +669,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "EpochProvisions",
 			Handler:    _Query_EpochProvisions_Handler,
 		},
+		{
+			MethodName: "ProjectedProvisions",
+			Handler:    _Query_ProjectedProvisions_Handler,
+		},
+		{
+			MethodName: "RecipientEmissions",
+			Handler:    _Query_RecipientEmissions_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "stride/mint/v1beta1/query.proto",
@@ -461,60 +794,312 @@ func (m *QueryEpochProvisionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, e
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryProjectedProvisionsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+
+func (m *QueryProjectedProvisionsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryProjectedProvisionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	if m.NumEpochs != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.NumEpochs))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryEpochProvisionsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EpochProjection) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryEpochProvisionsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *EpochProjection) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EpochProjection) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.EpochProvisions.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	{
+		size := m.TotalSupply.Size()
+		i -= size
+		if _, err := m.TotalSupply.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.Minted.Size()
+		i -= size
+		if _, err := m.Minted.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.EpochProvisions.Size()
+		i -= size
+		if _, err := m.EpochProvisions.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.EpochNumber != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.EpochNumber))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
+func (m *QueryProjectedProvisionsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProjectedProvisionsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProjectedProvisionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Projections) > 0 {
+		for iNdEx := len(m.Projections) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Projections[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRecipientEmissionsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRecipientEmissionsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRecipientEmissionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRecipientEmissionsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRecipientEmissionsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRecipientEmissionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Emissions) > 0 {
+		for iNdEx := len(m.Emissions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Emissions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryEpochProvisionsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryEpochProvisionsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.EpochProvisions.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryProjectedProvisionsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.NumEpochs != 0 {
+		n += 1 + sovQuery(uint64(m.NumEpochs))
+	}
+	return n
+}
+
+func (m *EpochProjection) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EpochNumber != 0 {
+		n += 1 + sovQuery(uint64(m.EpochNumber))
+	}
+	l = m.EpochProvisions.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Minted.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.TotalSupply.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryProjectedProvisionsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Projections) > 0 {
+		for _, e := range m.Projections {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryRecipientEmissionsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryRecipientEmissionsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Emissions) > 0 {
+		for _, e := range m.Emissions {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
 func sozQuery(x uint64) (n int) {
 	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
 }
@@ -784,6 +1369,464 @@ func (m *QueryEpochProvisionsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryProjectedProvisionsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryProjectedProvisionsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryProjectedProvisionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumEpochs", wireType)
+			}
+			m.NumEpochs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumEpochs |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EpochProjection) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EpochProjection: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EpochProjection: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochNumber", wireType)
+			}
+			m.EpochNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochNumber |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochProvisions", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.EpochProvisions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Minted", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Minted.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalSupply", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryProjectedProvisionsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryProjectedProvisionsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryProjectedProvisionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Projections", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Projections = append(m.Projections, EpochProjection{})
+			if err := m.Projections[len(m.Projections)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRecipientEmissionsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRecipientEmissionsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRecipientEmissionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRecipientEmissionsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRecipientEmissionsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRecipientEmissionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Emissions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Emissions = append(m.Emissions, RecipientEmissions{})
+			if err := m.Emissions[len(m.Emissions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -0,0 +1,27 @@
+package types
+
+import (
+	"encoding/json"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Sudo message sent to a wasm contract recipient after it receives its share
+// of the minted tokens
+type MintDistributionSudoMsg struct {
+	ReceiveMintDistribution ReceiveMintDistribution `json:"receive_mint_distribution"`
+}
+type ReceiveMintDistribution struct {
+	Denom  string `json:"denom"`
+	Amount string `json:"amount"`
+}
+
+// Serializes the sudo message for a mint distribution
+func NewMintDistributionSudoMsg(coin sdk.Coin) ([]byte, error) {
+	return json.Marshal(MintDistributionSudoMsg{
+		ReceiveMintDistribution: ReceiveMintDistribution{
+			Denom:  coin.Denom,
+			Amount: coin.Amount.String(),
+		},
+	})
+}
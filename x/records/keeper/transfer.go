@@ -49,7 +49,7 @@ func (k Keeper) IBCTransferNativeTokens(ctx sdk.Context, msg *transfertypes.MsgT
 		CallbackArgs: marshalledCallbackArgs,
 	}
 	k.Logger(ctx).Info(utils.LogWithHostZone(depositRecord.HostZoneId, "Storing callback data: %+v", callback))
-	k.ICACallbacksKeeper.SetCallbackData(ctx, callback)
+	k.ICACallbacksKeeper.AddCallbackData(ctx, callback)
 
 	// update the record state to TRANSFER_IN_PROGRESS
 	depositRecord.Status = types.DepositRecord_TRANSFER_IN_PROGRESS
@@ -94,7 +94,7 @@ func (k Keeper) IBCTransferLSMToken(
 		return errorsmod.Wrapf(err, "Unable to marshal transfer callback data for %+v", callbackArgs)
 	}
 
-	k.ICACallbacksKeeper.SetCallbackData(ctx, icacallbackstypes.CallbackData{
+	k.ICACallbacksKeeper.AddCallbackData(ctx, icacallbackstypes.CallbackData{
 		CallbackKey:  icacallbackstypes.PacketID(transferMsg.SourcePort, transferMsg.SourceChannel, msgTransferResponse.Sequence),
 		PortId:       transferMsg.SourcePort,
 		ChannelId:    transferMsg.SourceChannel,
@@ -70,3 +70,28 @@ func (k Keeper) IterateUserRedemptionRecords(ctx sdk.Context,
 		i++
 	}
 }
+
+// IterateUserRedemptionRecordsForHostZone iterates the user redemption records for a host zone
+// Records are keyed by {chain_id}.{epoch}.{receiver}, so only the host zone's records are read from the store
+func (k Keeper) IterateUserRedemptionRecordsForHostZone(ctx sdk.Context, chainId string,
+	fn func(index int64, userRedemptionRecord types.UserRedemptionRecord) (stop bool),
+) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.UserRedemptionRecordKey))
+
+	iterator := sdk.KVStorePrefixIterator(store, types.KeyPrefix(chainId+"."))
+	defer iterator.Close()
+
+	i := int64(0)
+
+	for ; iterator.Valid(); iterator.Next() {
+		userRedRecord := types.UserRedemptionRecord{}
+		k.Cdc.MustUnmarshal(iterator.Value(), &userRedRecord)
+
+		stop := fn(i, userRedRecord)
+
+		if stop {
+			break
+		}
+		i++
+	}
+}
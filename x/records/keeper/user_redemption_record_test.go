@@ -56,3 +56,36 @@ func TestUserRedemptionRecordGetAll(t *testing.T) {
 	actual := keeper.GetAllUserRedemptionRecord(ctx)
 	require.Equal(t, len(items), len(actual))
 }
+
+func TestIterateUserRedemptionRecordsForHostZone(t *testing.T) {
+	keeper, ctx := keepertest.RecordsKeeper(t)
+
+	// Store records for a host zone, as well as for a host zone whose chain ID shares the same prefix
+	for _, chainId := range []string{"chain-1", "chain-10"} {
+		for epoch := uint64(1); epoch <= 3; epoch++ {
+			keeper.SetUserRedemptionRecord(ctx, types.UserRedemptionRecord{
+				Id:                types.UserRedemptionRecordKeyFormatter(chainId, epoch, "receiver"),
+				HostZoneId:        chainId,
+				EpochNumber:       epoch,
+				NativeTokenAmount: sdkmath.ZeroInt(),
+				StTokenAmount:     sdkmath.ZeroInt(),
+			})
+		}
+	}
+
+	// Only the records for the requested host zone should be iterated
+	chainIds := []string{}
+	keeper.IterateUserRedemptionRecordsForHostZone(ctx, "chain-1", func(_ int64, record types.UserRedemptionRecord) bool {
+		chainIds = append(chainIds, record.HostZoneId)
+		return false
+	})
+	require.Equal(t, []string{"chain-1", "chain-1", "chain-1"}, chainIds)
+
+	// Iteration should stop once the callback returns true
+	numIterated := 0
+	keeper.IterateUserRedemptionRecordsForHostZone(ctx, "chain-10", func(index int64, _ types.UserRedemptionRecord) bool {
+		numIterated++
+		return index == 1
+	})
+	require.Equal(t, 2, numIterated)
+}
@@ -39,6 +39,7 @@ func GetQueryCmd() *cobra.Command {
 		CmdQueryRedemptionRecord(),
 		CmdQueryRedemptionRecords(),
 		CmdQuerySlashRecords(),
+		CmdQueryPendingConfirmations(),
 	)
 
 	return cmd
@@ -290,3 +291,37 @@ Examples:
 
 	return cmd
 }
+
+// Queries all operator confirmations awaiting ICQ verification
+func CmdQueryPendingConfirmations() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pending-confirmations",
+		Short: "Queries all operator confirmations awaiting ICQ verification",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Queries all operator confirmations awaiting ICQ verification
+Examples:
+  $ %s query %s pending-confirmations
+`, version.AppName, types.ModuleName),
+		),
+		Args: cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryPendingConfirmationsRequest{}
+			res, err := queryClient.PendingConfirmations(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -47,6 +47,8 @@ func GetTxCmd() *cobra.Command {
 		CmdOverwriteRecord(),
 		CmdRefreshRedemptionRate(),
 		CmdSetOperatorAddress(),
+		CmdSetICQVerification(),
+		CmdClearPendingConfirmation(),
 	)
 
 	return cmd
@@ -537,3 +539,95 @@ $ %[1]s tx %[2]s setup-operator-address stride1265uqtckmd3kt7jek2pv0vrp04j0d74jj
 
 	return cmd
 }
+
+// Enables or disables ICQ verification of operator confirmations
+func CmdSetICQVerification() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-icq-verification [enabled] [connection-id] [validators]",
+		Short: "configures ICQ verification of operator confirmations",
+		Args:  cobra.RangeArgs(1, 3),
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Enables or disables ICQ verification of operator confirmations.
+When enabled, a connection ID and comma-separated list of validators must be provided
+
+Example:
+$ %[1]s tx %[2]s set-icq-verification true connection-0 valoper1xxx,valoper1yyy
+$ %[1]s tx %[2]s set-icq-verification false
+			`, version.AppName, types.ModuleName),
+		),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			enabled, err := strconv.ParseBool(args[0])
+			if err != nil {
+				return err
+			}
+
+			connectionId := ""
+			validators := []string{}
+			if enabled {
+				if len(args) != 3 {
+					return errors.New("connection-id and validators must be provided when enabling ICQ verification")
+				}
+				connectionId = args[1]
+				validators = strings.Split(args[2], ",")
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSetICQVerification(
+				clientCtx.GetFromAddress().String(),
+				enabled,
+				connectionId,
+				validators,
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// Clears a pending confirmation whose verification queries are stuck
+func CmdClearPendingConfirmation() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "clear-pending-confirmation [pending-confirmation-id]",
+		Short: "clears a stuck pending confirmation",
+		Args:  cobra.ExactArgs(1),
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Clears a pending confirmation whose verification queries are stuck,
+so that the operator can re-submit the confirmation
+
+Example:
+$ %[1]s tx %[2]s clear-pending-confirmation 1
+			`, version.AppName, types.ModuleName),
+		),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			pendingConfirmationId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgClearPendingConfirmation(clientCtx.GetFromAddress().String(), pendingConfirmationId)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -94,3 +94,46 @@ func EmitHaltZoneEvent(ctx sdk.Context, hostZone types.HostZone) {
 		),
 	)
 }
+
+// Emits an event indicating an operator confirmation is awaiting ICQ verification
+func EmitConfirmationPendingEvent(ctx sdk.Context, pendingConfirmation types.PendingConfirmation) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeConfirmationPending,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributePendingConfirmationId, strconv.FormatUint(pendingConfirmation.Id, 10)),
+			sdk.NewAttribute(types.AttributeConfirmationType, pendingConfirmation.ConfirmationType.String()),
+			sdk.NewAttribute(types.AttributeRecordId, strconv.FormatUint(pendingConfirmation.RecordId, 10)),
+			sdk.NewAttribute(types.AttributeTxHash, pendingConfirmation.TxHash),
+			sdk.NewAttribute(types.AttributeSender, pendingConfirmation.Sender),
+		),
+	)
+}
+
+// Emits an event indicating the proven host zone state did not match an operator confirmation
+func EmitConfirmationRejectedEvent(ctx sdk.Context, pendingConfirmation types.PendingConfirmation, reason string) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeConfirmationRejected,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributePendingConfirmationId, strconv.FormatUint(pendingConfirmation.Id, 10)),
+			sdk.NewAttribute(types.AttributeConfirmationType, pendingConfirmation.ConfirmationType.String()),
+			sdk.NewAttribute(types.AttributeRecordId, strconv.FormatUint(pendingConfirmation.RecordId, 10)),
+			sdk.NewAttribute(types.AttributeProvenAmount, pendingConfirmation.ProvenAmount.String()),
+			sdk.NewAttribute(types.AttributeKeyError, reason),
+		),
+	)
+}
+
+// Emits an event indicating a pending confirmation was cleared before its queries returned
+func EmitConfirmationClearedEvent(ctx sdk.Context, pendingConfirmation types.PendingConfirmation) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeConfirmationCleared,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributePendingConfirmationId, strconv.FormatUint(pendingConfirmation.Id, 10)),
+			sdk.NewAttribute(types.AttributeConfirmationType, pendingConfirmation.ConfirmationType.String()),
+			sdk.NewAttribute(types.AttributeRecordId, strconv.FormatUint(pendingConfirmation.RecordId, 10)),
+		),
+	)
+}
@@ -0,0 +1,257 @@
+package keeper
+
+import (
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/cosmos/gogoproto/proto"
+
+	"github.com/Stride-Labs/stride/v24/utils"
+	icqtypes "github.com/Stride-Labs/stride/v24/x/interchainquery/types"
+	"github.com/Stride-Labs/stride/v24/x/stakedym/types"
+)
+
+// Timeout for each of the verification queries (they are retried after a timeout)
+var ConfirmationQueryTimeout = time.Hour
+
+// Stores a pending confirmation and submits an ICQ for each validator in the verification config
+// For delegations and adjustments, the validator is queried first (to get the shares to tokens rate),
+// and the delegation query is submitted from the validator callback
+// For undelegations, the unbonding delegation is queried directly
+func (k Keeper) SubmitConfirmationQueries(ctx sdk.Context, hostZone types.HostZone, pendingConfirmation types.PendingConfirmation) error {
+	// Only one confirmation can be in flight for a given record
+	if k.IsConfirmationPending(ctx, pendingConfirmation.ConfirmationType, pendingConfirmation.RecordId) {
+		return errorsmod.Wrapf(types.ErrConfirmationAlreadyPending,
+			"%s already pending for record %d", pendingConfirmation.ConfirmationType.String(), pendingConfirmation.RecordId)
+	}
+
+	verification := hostZone.IcqVerification
+	_, delegatorAddressBz, err := bech32.DecodeAndConvert(hostZone.DelegationAddress)
+	if err != nil {
+		return errorsmod.Wrapf(err, "invalid delegation address %s", hostZone.DelegationAddress)
+	}
+
+	pendingConfirmation.Id = k.IncrementPendingConfirmationId(ctx)
+	pendingConfirmation.OutstandingQueries = uint64(len(verification.Validators))
+	pendingConfirmation.ProvenAmount = sdkmath.ZeroInt()
+	if pendingConfirmation.DelegationOffset.IsNil() {
+		pendingConfirmation.DelegationOffset = sdkmath.ZeroInt()
+	}
+	k.SetPendingConfirmation(ctx, pendingConfirmation)
+
+	callbackData := types.PendingConfirmationQueryCallback{
+		PendingConfirmationId: pendingConfirmation.Id,
+		SharesToTokensRate:    sdk.ZeroDec(),
+	}
+	callbackDataBz, err := proto.Marshal(&callbackData)
+	if err != nil {
+		return errorsmod.Wrapf(err, "unable to marshal pending confirmation callback data")
+	}
+
+	for _, validatorAddress := range verification.Validators {
+		_, validatorAddressBz, err := bech32.DecodeAndConvert(validatorAddress)
+		if err != nil {
+			return errorsmod.Wrapf(err, "invalid validator address %s", validatorAddress)
+		}
+
+		var requestData []byte
+		var callbackId string
+		if pendingConfirmation.ConfirmationType == types.CONFIRM_UNDELEGATION {
+			requestData = stakingtypes.GetUBDKey(delegatorAddressBz, validatorAddressBz)
+			callbackId = ICQCallbackID_ConfirmationUnbonding
+		} else {
+			requestData = stakingtypes.GetValidatorKey(validatorAddressBz)
+			callbackId = ICQCallbackID_ConfirmationValidator
+		}
+
+		query := icqtypes.Query{
+			ChainId:         hostZone.ChainId,
+			ConnectionId:    verification.ConnectionId,
+			QueryType:       icqtypes.STAKING_STORE_QUERY_WITH_PROOF,
+			RequestData:     requestData,
+			CallbackModule:  types.ModuleName,
+			CallbackId:      callbackId,
+			CallbackData:    callbackDataBz,
+			TimeoutDuration: ConfirmationQueryTimeout,
+			TimeoutPolicy:   icqtypes.TimeoutPolicy_RETRY_QUERY_REQUEST,
+			// A missing validator or unbonding delegation is proven with a non-membership
+			// proof and must still count towards the outstanding queries
+			CallbackOnEmptyResult: true,
+		}
+		if err := k.icqKeeper.SubmitICQRequest(ctx, query, true); err != nil {
+			return errorsmod.Wrapf(types.ErrFailedToSubmitICQ, "validator %s: %s", validatorAddress, err.Error())
+		}
+	}
+
+	EmitConfirmationPendingEvent(ctx, pendingConfirmation)
+	return nil
+}
+
+// Submits the delegation query for a validator, passing through the validator's shares to tokens rate
+func (k Keeper) SubmitConfirmationDelegationQuery(
+	ctx sdk.Context,
+	query icqtypes.Query,
+	pendingConfirmationId uint64,
+	validatorAddress string,
+	sharesToTokensRate sdk.Dec,
+) error {
+	hostZone, err := k.GetHostZone(ctx)
+	if err != nil {
+		return err
+	}
+
+	_, delegatorAddressBz, err := bech32.DecodeAndConvert(hostZone.DelegationAddress)
+	if err != nil {
+		return errorsmod.Wrapf(err, "invalid delegation address %s", hostZone.DelegationAddress)
+	}
+	_, validatorAddressBz, err := bech32.DecodeAndConvert(validatorAddress)
+	if err != nil {
+		return errorsmod.Wrapf(err, "invalid validator address %s", validatorAddress)
+	}
+
+	callbackData := types.PendingConfirmationQueryCallback{
+		PendingConfirmationId: pendingConfirmationId,
+		SharesToTokensRate:    sharesToTokensRate,
+	}
+	callbackDataBz, err := proto.Marshal(&callbackData)
+	if err != nil {
+		return errorsmod.Wrapf(err, "unable to marshal pending confirmation callback data")
+	}
+
+	delegationQuery := icqtypes.Query{
+		ChainId:         query.ChainId,
+		ConnectionId:    query.ConnectionId,
+		QueryType:       icqtypes.STAKING_STORE_QUERY_WITH_PROOF,
+		RequestData:     stakingtypes.GetDelegationKey(delegatorAddressBz, validatorAddressBz),
+		CallbackModule:  types.ModuleName,
+		CallbackId:      ICQCallbackID_ConfirmationDelegation,
+		CallbackData:    callbackDataBz,
+		TimeoutDuration: ConfirmationQueryTimeout,
+		TimeoutPolicy:   icqtypes.TimeoutPolicy_RETRY_QUERY_REQUEST,
+		// An empty response (no delegation to the validator) must still be recorded
+		CallbackOnEmptyResult: true,
+	}
+	if err := k.icqKeeper.SubmitICQRequest(ctx, delegationQuery, true); err != nil {
+		return errorsmod.Wrapf(types.ErrFailedToSubmitICQ, "validator %s: %s", validatorAddress, err.Error())
+	}
+
+	return nil
+}
+
+// Adds the proven amount from a query response to the pending confirmation
+// Once all queries have returned, the confirmation is either finalized or rejected
+func (k Keeper) RecordConfirmationQueryResult(ctx sdk.Context, pendingConfirmationId uint64, provenAmount sdkmath.Int) error {
+	pendingConfirmation, found := k.GetPendingConfirmation(ctx, pendingConfirmationId)
+	if !found {
+		return errorsmod.Wrapf(types.ErrPendingConfirmationNotFound, "pending confirmation %d", pendingConfirmationId)
+	}
+
+	pendingConfirmation.ProvenAmount = pendingConfirmation.ProvenAmount.Add(provenAmount)
+	if pendingConfirmation.OutstandingQueries > 0 {
+		pendingConfirmation.OutstandingQueries--
+	}
+
+	if pendingConfirmation.OutstandingQueries > 0 {
+		k.SetPendingConfirmation(ctx, pendingConfirmation)
+		return nil
+	}
+
+	// All queries have returned, so the confirmation is no longer pending
+	// If the proven state does not match, the records are left untouched so the
+	// operator can re-submit the confirmation
+	k.RemovePendingConfirmation(ctx, pendingConfirmation.Id)
+
+	err := utils.ApplyFuncIfNoError(ctx, func(ctx sdk.Context) error {
+		return k.FinalizeVerifiedConfirmation(ctx, pendingConfirmation)
+	})
+	if err != nil {
+		k.Logger(ctx).Error(utils.LogWithHostZone(types.DymensionChainId,
+			"Rejected %s confirmation %d: %s", pendingConfirmation.ConfirmationType.String(), pendingConfirmation.Id, err.Error()))
+		EmitConfirmationRejectedEvent(ctx, pendingConfirmation, err.Error())
+	}
+
+	return nil
+}
+
+// Removes a pending confirmation that's stuck (e.g. if a query was pruned before a response was relayed)
+// so that the operator can re-submit the confirmation
+// Any query responses that return after the confirmation is cleared are ignored
+func (k Keeper) ClearPendingConfirmation(ctx sdk.Context, pendingConfirmationId uint64) error {
+	pendingConfirmation, found := k.GetPendingConfirmation(ctx, pendingConfirmationId)
+	if !found {
+		return errorsmod.Wrapf(types.ErrPendingConfirmationNotFound, "pending confirmation %d", pendingConfirmationId)
+	}
+
+	k.RemovePendingConfirmation(ctx, pendingConfirmationId)
+	EmitConfirmationClearedEvent(ctx, pendingConfirmation)
+
+	return nil
+}
+
+// Checks the proven amount against the internal accounting and, if it matches,
+// finalizes the operator's confirmation
+//   - Delegation: the proven delegations must cover the delegated balance including the new record
+//   - Undelegation: the proven unbondings must cover all in progress unbondings including the new record
+//   - Adjustment: the proven delegations must match the delegated balance after applying the offset
+//
+// The tolerance allows for the truncation of each validator's shares to tokens conversion
+func (k Keeper) FinalizeVerifiedConfirmation(ctx sdk.Context, pendingConfirmation types.PendingConfirmation) error {
+	hostZone, err := k.GetHostZone(ctx)
+	if err != nil {
+		return err
+	}
+	tolerance := sdkmath.NewInt(int64(len(hostZone.IcqVerification.GetValidators())))
+	provenAmount := pendingConfirmation.ProvenAmount
+
+	switch pendingConfirmation.ConfirmationType {
+	case types.CONFIRM_DELEGATION:
+		record, hostZone, err := k.ValidateConfirmDelegation(ctx, pendingConfirmation.RecordId)
+		if err != nil {
+			return err
+		}
+
+		expectedAmount := hostZone.DelegatedBalance.Add(record.NativeAmount)
+		if provenAmount.Add(tolerance).LT(expectedAmount) {
+			return errorsmod.Wrapf(types.ErrInvalidICQVerification,
+				"proven delegations (%v) less than expected delegated balance (%v)", provenAmount, expectedAmount)
+		}
+
+		k.FinalizeConfirmDelegation(ctx, hostZone, record, pendingConfirmation.TxHash, pendingConfirmation.Sender)
+		return nil
+
+	case types.CONFIRM_UNDELEGATION:
+		record, hostZone, err := k.ValidateConfirmUndelegation(ctx, pendingConfirmation.RecordId)
+		if err != nil {
+			return err
+		}
+
+		expectedAmount := record.NativeAmount
+		for _, inProgressRecord := range k.GetAllUnbondingRecordsByStatus(ctx, types.UNBONDING_IN_PROGRESS) {
+			if inProgressRecord.UnbondingCompletionTimeSeconds > uint64(ctx.BlockTime().Unix()) {
+				expectedAmount = expectedAmount.Add(inProgressRecord.NativeAmount)
+			}
+		}
+		if provenAmount.LT(expectedAmount) {
+			return errorsmod.Wrapf(types.ErrInvalidICQVerification,
+				"proven unbondings (%v) less than expected unbonding amount (%v)", provenAmount, expectedAmount)
+		}
+
+		return k.FinalizeConfirmUndelegation(ctx, hostZone, record, pendingConfirmation.TxHash, pendingConfirmation.Sender)
+
+	case types.ADJUST_DELEGATED_BALANCE:
+		expectedAmount := hostZone.DelegatedBalance.Add(pendingConfirmation.DelegationOffset)
+		if provenAmount.Sub(expectedAmount).Abs().GT(tolerance) {
+			return errorsmod.Wrapf(types.ErrInvalidICQVerification,
+				"proven delegations (%v) do not match adjusted delegated balance (%v)", provenAmount, expectedAmount)
+		}
+
+		return k.FinalizeAdjustDelegatedBalance(ctx, hostZone, pendingConfirmation.DelegationOffset, pendingConfirmation.ValidatorAddress)
+
+	default:
+		return errorsmod.Wrapf(types.ErrInvalidICQVerification, "unknown confirmation type %s", pendingConfirmation.ConfirmationType.String())
+	}
+}
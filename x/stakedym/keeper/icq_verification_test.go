@@ -0,0 +1,373 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/cometbft/cometbft/proto/tendermint/crypto"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/cosmos/gogoproto/proto"
+
+	icqkeeper "github.com/Stride-Labs/stride/v24/x/interchainquery/keeper"
+	icqtypes "github.com/Stride-Labs/stride/v24/x/interchainquery/types"
+	"github.com/Stride-Labs/stride/v24/x/stakedym/keeper"
+	"github.com/Stride-Labs/stride/v24/x/stakedym/types"
+)
+
+const (
+	VerificationConnectionId = "connection-0"
+	VerificationClientId     = "07-tendermint-0"
+)
+
+// Helper function to build a valid bech32 address from a seed string
+func (s *KeeperTestSuite) mustBech32(prefix string, seed string) string {
+	address, err := bech32.ConvertAndEncode(prefix, []byte(seed))
+	s.Require().NoError(err, "no error expected when encoding address")
+	return address
+}
+
+// Enables ICQ verification on the existing host zone across two validators
+func (s *KeeperTestSuite) EnableICQVerification() (validators []string) {
+	validators = []string{
+		s.mustBech32("dymvaloper", "validator-one-addr00"),
+		s.mustBech32("dymvaloper", "validator-two-addr00"),
+	}
+
+	hostZone := s.MustGetHostZone()
+	hostZone.ChainId = HostChainId
+	hostZone.DelegationAddress = s.mustBech32("dym", "delegation-address00")
+	hostZone.IcqVerification = &types.ICQVerification{
+		Enabled:      true,
+		ConnectionId: VerificationConnectionId,
+		Validators:   validators,
+	}
+	s.App.StakedymKeeper.SetHostZone(s.Ctx, hostZone)
+
+	s.MockClientAndConnection(HostChainId, VerificationClientId, VerificationConnectionId)
+
+	return validators
+}
+
+// Sets up delegation records and a host zone with ICQ verification enabled
+func (s *KeeperTestSuite) SetupICQVerification() (validators []string) {
+	s.SetupDelegationRecords()
+	return s.EnableICQVerification()
+}
+
+// Helper function to get the submitted ICQs for a given callback
+func (s *KeeperTestSuite) getQueriesByCallbackId(callbackId string) (queries []icqtypes.Query) {
+	for _, query := range s.App.InterchainqueryKeeper.AllQueries(s.Ctx) {
+		if query.CallbackId == callbackId {
+			queries = append(queries, query)
+		}
+	}
+	return queries
+}
+
+// Helper function to submit a query response through the ICQ msg server
+// The proof verification is skipped by dropping the "key" suffix from the query type
+// (the proofs themselves are covered in the interchainquery module)
+func (s *KeeperTestSuite) submitQueryResponse(query icqtypes.Query, result []byte) error {
+	query.QueryType = "store/staking"
+	s.App.InterchainqueryKeeper.SetQuery(s.Ctx, query)
+
+	msgServer := icqkeeper.NewMsgServerImpl(s.App.InterchainqueryKeeper)
+	_, err := msgServer.SubmitQueryResponse(s.Ctx, &icqtypes.MsgSubmitQueryResponse{
+		ChainId:     query.ChainId,
+		QueryId:     query.Id,
+		Result:      result,
+		ProofOps:    &crypto.ProofOps{},
+		Height:      s.Ctx.BlockHeight(),
+		FromAddress: s.TestAccs[0].String(),
+	})
+	return err
+}
+
+// Helper function to get the only pending confirmation
+func (s *KeeperTestSuite) mustGetOnlyPendingConfirmation() types.PendingConfirmation {
+	pendingConfirmations := s.App.StakedymKeeper.GetAllPendingConfirmations(s.Ctx)
+	s.Require().Len(pendingConfirmations, 1, "number of pending confirmations")
+	return pendingConfirmations[0]
+}
+
+func (s *KeeperTestSuite) TestConfirmDelegation_ICQVerification_Successful() {
+	validators := s.SetupICQVerification()
+
+	// Confirm the delegation - it should not be processed until the ICQs return
+	err := s.App.StakedymKeeper.ConfirmDelegation(s.Ctx, 6, ValidTxHashNew, ValidOperator)
+	s.Require().NoError(err, "no error expected when confirming delegation")
+
+	_, found := s.App.StakedymKeeper.GetArchivedDelegationRecord(s.Ctx, 6)
+	s.Require().False(found, "record should not be archived yet")
+	s.Require().Equal(InitialDelegation, s.MustGetHostZone().DelegatedBalance, "delegated balance should not change yet")
+
+	pendingConfirmation := s.mustGetOnlyPendingConfirmation()
+	s.Require().Equal(types.CONFIRM_DELEGATION, pendingConfirmation.ConfirmationType, "confirmation type")
+	s.Require().Equal(uint64(6), pendingConfirmation.RecordId, "record id")
+	s.Require().Equal(uint64(len(validators)), pendingConfirmation.OutstandingQueries, "outstanding queries")
+
+	// A validator query should have been submitted for each validator
+	validatorQueries := s.getQueriesByCallbackId(keeper.ICQCallbackID_ConfirmationValidator)
+	s.Require().Len(validatorQueries, len(validators), "number of validator queries")
+
+	// A second confirmation for the same record should fail while the first is pending
+	err = s.App.StakedymKeeper.ConfirmDelegation(s.Ctx, 6, ValidTxHashNew, ValidOperator)
+	s.Require().ErrorIs(err, types.ErrConfirmationAlreadyPending)
+
+	// Call the validator callbacks, with a shares to tokens rate of 0.5
+	for i, query := range validatorQueries {
+		validator := stakingtypes.Validator{
+			OperatorAddress: validators[i],
+			Tokens:          sdkmath.NewInt(1000),
+			DelegatorShares: sdk.NewDec(2000),
+		}
+		validatorBz := s.App.AppCodec().MustMarshal(&validator)
+
+		err := keeper.ConfirmationValidatorCallback(s.App.StakedymKeeper, s.Ctx, validatorBz, query)
+		s.Require().NoError(err, "no error expected during validator callback %d", i)
+	}
+
+	// A delegation query should have been submitted for each validator
+	delegationQueries := s.getQueriesByCallbackId(keeper.ICQCallbackID_ConfirmationDelegation)
+	s.Require().Len(delegationQueries, len(validators), "number of delegation queries")
+
+	// Split the expected delegated balance across the two validators (represented as shares)
+	expectedBalance := InitialDelegation.Add(sdkmath.NewInt(6000))
+	sharesPerValidator := sdk.NewDecFromInt(expectedBalance) // tokens / 2 validators / 0.5 rate
+	for i, query := range delegationQueries {
+		delegation := stakingtypes.Delegation{Shares: sharesPerValidator}
+		delegationBz := s.App.AppCodec().MustMarshal(&delegation)
+
+		err := keeper.ConfirmationDelegationCallback(s.App.StakedymKeeper, s.Ctx, delegationBz, query)
+		s.Require().NoError(err, "no error expected during delegation callback %d", i)
+	}
+
+	// The confirmation should now be processed
+	s.Require().Empty(s.App.StakedymKeeper.GetAllPendingConfirmations(s.Ctx), "no pending confirmations")
+
+	record, found := s.App.StakedymKeeper.GetArchivedDelegationRecord(s.Ctx, 6)
+	s.Require().True(found, "record should be archived")
+	s.Require().Equal(types.DELEGATION_COMPLETE, record.Status, "record status")
+	s.Require().Equal(ValidTxHashNew, record.TxHash, "record tx hash")
+	s.Require().Equal(expectedBalance, s.MustGetHostZone().DelegatedBalance, "delegated balance")
+}
+
+func (s *KeeperTestSuite) TestConfirmDelegation_ICQVerification_Rejected() {
+	s.SetupICQVerification()
+
+	err := s.App.StakedymKeeper.ConfirmDelegation(s.Ctx, 6, ValidTxHashNew, ValidOperator)
+	s.Require().NoError(err, "no error expected when confirming delegation")
+	pendingConfirmation := s.mustGetOnlyPendingConfirmation()
+
+	// Prove only the initial delegation (i.e. the new delegation never landed)
+	err = s.App.StakedymKeeper.RecordConfirmationQueryResult(s.Ctx, pendingConfirmation.Id, InitialDelegation)
+	s.Require().NoError(err, "no error expected when recording first result")
+	s.Require().Equal(uint64(1), s.mustGetOnlyPendingConfirmation().OutstandingQueries, "outstanding queries after first result")
+
+	err = s.App.StakedymKeeper.RecordConfirmationQueryResult(s.Ctx, pendingConfirmation.Id, sdkmath.ZeroInt())
+	s.Require().NoError(err, "no error expected when recording second result")
+
+	// The pending confirmation should be removed, but the record should be unchanged
+	s.Require().Empty(s.App.StakedymKeeper.GetAllPendingConfirmations(s.Ctx), "no pending confirmations")
+
+	record, found := s.App.StakedymKeeper.GetDelegationRecord(s.Ctx, 6)
+	s.Require().True(found, "record should still be active")
+	s.Require().Equal(types.DELEGATION_QUEUE, record.Status, "record status")
+	s.Require().Empty(record.TxHash, "record tx hash")
+	s.Require().Equal(InitialDelegation, s.MustGetHostZone().DelegatedBalance, "delegated balance")
+
+	// The operator should be able to re-submit the confirmation
+	err = s.App.StakedymKeeper.ConfirmDelegation(s.Ctx, 6, ValidTxHashNew, ValidOperator)
+	s.Require().NoError(err, "no error expected when re-submitting confirmation")
+}
+
+func (s *KeeperTestSuite) TestConfirmUndelegation_ICQVerification() {
+	s.Ctx = s.Ctx.WithBlockTime(time.Unix(1_700_000_000, 0)) // unbonding entries require a valid completion time
+	tc := s.SetupTestConfirmUndelegation(sdkmath.NewInt(100))
+	s.EnableICQVerification()
+	unbondingAmount := tc.amountToUndelegate
+
+	err := s.App.StakedymKeeper.ConfirmUndelegation(s.Ctx, tc.unbondingRecord.Id, ValidTxHashNew, tc.operatorAddress)
+	s.Require().NoError(err, "no error expected when confirming undelegation")
+
+	unbondingQueries := s.getQueriesByCallbackId(keeper.ICQCallbackID_ConfirmationUnbonding)
+	s.Require().Len(unbondingQueries, 2, "number of unbonding queries")
+
+	// The first validator has an entry that's still unbonding and an entry that's already completed
+	// The second validator has no unbondings
+	unbondingDelegation := stakingtypes.UnbondingDelegation{
+		Entries: []stakingtypes.UnbondingDelegationEntry{
+			{CompletionTime: s.Ctx.BlockTime().Add(time.Hour), Balance: unbondingAmount},
+			{CompletionTime: s.Ctx.BlockTime().Add(-time.Hour), Balance: sdkmath.NewInt(1000)},
+		},
+	}
+	unbondingDelegationBz := s.App.AppCodec().MustMarshal(&unbondingDelegation)
+
+	err = keeper.ConfirmationUnbondingCallback(s.App.StakedymKeeper, s.Ctx, unbondingDelegationBz, unbondingQueries[0])
+	s.Require().NoError(err, "no error expected during first unbonding callback")
+	err = keeper.ConfirmationUnbondingCallback(s.App.StakedymKeeper, s.Ctx, []byte{}, unbondingQueries[1])
+	s.Require().NoError(err, "no error expected during second unbonding callback")
+
+	// The record should now be confirmed
+	s.Require().Empty(s.App.StakedymKeeper.GetAllPendingConfirmations(s.Ctx), "no pending confirmations")
+
+	record, found := s.App.StakedymKeeper.GetUnbondingRecord(s.Ctx, tc.unbondingRecord.Id)
+	s.Require().True(found, "record should exist")
+	s.Require().Equal(types.UNBONDING_IN_PROGRESS, record.Status, "record status")
+	s.Require().Equal(ValidTxHashNew, record.UndelegationTxHash, "record tx hash")
+	s.Require().Equal(tc.delegatedBalance.Sub(unbondingAmount), s.MustGetHostZone().DelegatedBalance, "delegated balance")
+}
+
+func (s *KeeperTestSuite) TestConfirmUndelegation_ICQVerification_SubmitQueryResponse() {
+	s.Ctx = s.Ctx.WithBlockTime(time.Unix(1_700_000_000, 0)) // unbonding entries require a valid completion time
+	tc := s.SetupTestConfirmUndelegation(sdkmath.NewInt(100))
+	s.EnableICQVerification()
+
+	err := s.App.StakedymKeeper.ConfirmUndelegation(s.Ctx, tc.unbondingRecord.Id, ValidTxHashNew, tc.operatorAddress)
+	s.Require().NoError(err, "no error expected when confirming undelegation")
+
+	unbondingQueries := s.getQueriesByCallbackId(keeper.ICQCallbackID_ConfirmationUnbonding)
+	s.Require().Len(unbondingQueries, 2, "number of unbonding queries")
+	for _, query := range unbondingQueries {
+		s.Require().True(query.CallbackOnEmptyResult, "query should opt into callbacks on empty results")
+	}
+
+	// The first validator has the full unbonding
+	unbondingDelegation := stakingtypes.UnbondingDelegation{
+		Entries: []stakingtypes.UnbondingDelegationEntry{
+			{CompletionTime: s.Ctx.BlockTime().Add(time.Hour), Balance: tc.amountToUndelegate},
+		},
+	}
+	unbondingDelegationBz := s.App.AppCodec().MustMarshal(&unbondingDelegation)
+
+	err = s.submitQueryResponse(unbondingQueries[0], unbondingDelegationBz)
+	s.Require().NoError(err, "no error expected when submitting first response")
+	s.Require().Equal(uint64(1), s.mustGetOnlyPendingConfirmation().OutstandingQueries, "outstanding queries after first response")
+
+	// The second validator has no unbondings, so the response is empty
+	// It should still be passed to the callback so that the confirmation is processed
+	err = s.submitQueryResponse(unbondingQueries[1], []byte{})
+	s.Require().NoError(err, "no error expected when submitting empty response")
+	s.Require().Empty(s.App.StakedymKeeper.GetAllPendingConfirmations(s.Ctx), "no pending confirmations")
+
+	record, found := s.App.StakedymKeeper.GetUnbondingRecord(s.Ctx, tc.unbondingRecord.Id)
+	s.Require().True(found, "record should exist")
+	s.Require().Equal(types.UNBONDING_IN_PROGRESS, record.Status, "record status")
+	s.Require().Equal(ValidTxHashNew, record.UndelegationTxHash, "record tx hash")
+}
+
+func (s *KeeperTestSuite) TestClearPendingConfirmation() {
+	s.SetupICQVerification()
+
+	safeAddress := s.TestAccs[0].String()
+	hostZone := s.MustGetHostZone()
+	hostZone.SafeAddressOnStride = safeAddress
+	s.App.StakedymKeeper.SetHostZone(s.Ctx, hostZone)
+
+	err := s.App.StakedymKeeper.ConfirmDelegation(s.Ctx, 6, ValidTxHashNew, ValidOperator)
+	s.Require().NoError(err, "no error expected when confirming delegation")
+	pendingConfirmation := s.mustGetOnlyPendingConfirmation()
+	validatorQueries := s.getQueriesByCallbackId(keeper.ICQCallbackID_ConfirmationValidator)
+
+	// Only the SAFE address can clear the confirmation
+	_, err = s.GetMsgServer().ClearPendingConfirmation(s.Ctx, &types.MsgClearPendingConfirmation{
+		Signer:                s.TestAccs[1].String(),
+		PendingConfirmationId: pendingConfirmation.Id,
+	})
+	s.Require().ErrorIs(err, types.ErrInvalidAdmin, "non-safe signer")
+
+	// Attempt to clear a confirmation that does not exist
+	_, err = s.GetMsgServer().ClearPendingConfirmation(s.Ctx, &types.MsgClearPendingConfirmation{
+		Signer:                safeAddress,
+		PendingConfirmationId: pendingConfirmation.Id + 1,
+	})
+	s.Require().ErrorIs(err, types.ErrPendingConfirmationNotFound, "unknown confirmation")
+
+	// Clear the confirmation
+	_, err = s.GetMsgServer().ClearPendingConfirmation(s.Ctx, &types.MsgClearPendingConfirmation{
+		Signer:                safeAddress,
+		PendingConfirmationId: pendingConfirmation.Id,
+	})
+	s.Require().NoError(err, "no error expected when clearing confirmation")
+	s.Require().Empty(s.App.StakedymKeeper.GetAllPendingConfirmations(s.Ctx), "no pending confirmations")
+
+	// A late response for the cleared confirmation should be ignored
+	err = s.submitQueryResponse(validatorQueries[0], []byte{})
+	s.Require().NoError(err, "no error expected when submitting late response")
+	s.Require().Empty(s.getQueriesByCallbackId(keeper.ICQCallbackID_ConfirmationDelegation), "no delegation queries")
+
+	// The operator should be able to re-submit the confirmation
+	err = s.App.StakedymKeeper.ConfirmDelegation(s.Ctx, 6, ValidTxHashNew, ValidOperator)
+	s.Require().NoError(err, "no error expected when re-submitting confirmation")
+}
+
+func (s *KeeperTestSuite) TestAdjustDelegatedBalance_ICQVerification() {
+	validators := s.SetupICQVerification()
+	offset := sdkmath.NewInt(-1000)
+
+	err := s.App.StakedymKeeper.AdjustDelegatedBalance(s.Ctx, offset, validators[0], ValidOperator)
+	s.Require().NoError(err, "no error expected when adjusting delegated balance")
+
+	pendingConfirmation := s.mustGetOnlyPendingConfirmation()
+	s.Require().Equal(types.ADJUST_DELEGATED_BALANCE, pendingConfirmation.ConfirmationType, "confirmation type")
+	s.Require().Equal(offset, pendingConfirmation.DelegationOffset, "delegation offset")
+	s.Require().Empty(s.App.StakedymKeeper.GetAllSlashRecords(s.Ctx), "no slash record yet")
+
+	// Prove the adjusted balance, off by 1 due to truncation
+	expectedBalance := InitialDelegation.Add(offset)
+	err = s.App.StakedymKeeper.RecordConfirmationQueryResult(s.Ctx, pendingConfirmation.Id, expectedBalance.SubRaw(1))
+	s.Require().NoError(err, "no error expected when recording first result")
+	err = s.App.StakedymKeeper.RecordConfirmationQueryResult(s.Ctx, pendingConfirmation.Id, sdkmath.ZeroInt())
+	s.Require().NoError(err, "no error expected when recording second result")
+
+	s.Require().Equal(expectedBalance, s.MustGetHostZone().DelegatedBalance, "delegated balance")
+	s.Require().Len(s.App.StakedymKeeper.GetAllSlashRecords(s.Ctx), 1, "slash record created")
+}
+
+func (s *KeeperTestSuite) TestConfirmUnbondedTokenSweep_ICQVerification() {
+	// Fund the claim address with one token less than record 6 plus the already claimable records (4 and 7)
+	claimableAmount := sdkmath.NewInt(400 + 800)
+	sweepAmount := sdkmath.NewInt(1200)
+	s.SetupTestConfirmUnbondingTokens(claimableAmount.Add(sweepAmount).SubRaw(1).Int64())
+
+	hostZone := s.MustGetHostZone()
+	hostZone.IcqVerification = &types.ICQVerification{Enabled: true}
+	s.App.StakedymKeeper.SetHostZone(s.Ctx, hostZone)
+
+	// The claim address balance must cover the tokens that have not yet been distributed
+	err := s.App.StakedymKeeper.ConfirmUnbondedTokenSweep(s.Ctx, 6, ValidTxHashNew, ValidOperator)
+	s.Require().ErrorIs(err, types.ErrInsufficientFunds)
+
+	// Once the balance is sufficient, the sweep is confirmed immediately, since the claim
+	// address balance is read from local state rather than proven with a query
+	s.FundAccount(s.TestAccs[0], sdk.NewCoin(HostIBCDenom, sdkmath.OneInt()))
+	err = s.App.StakedymKeeper.ConfirmUnbondedTokenSweep(s.Ctx, 6, ValidTxHashNew, ValidOperator)
+	s.Require().NoError(err, "no error expected when confirming sweep")
+
+	s.Require().Empty(s.App.StakedymKeeper.GetAllPendingConfirmations(s.Ctx), "no pending confirmations")
+	s.Require().Empty(s.App.InterchainqueryKeeper.AllQueries(s.Ctx), "no queries submitted")
+
+	record, found := s.App.StakedymKeeper.GetUnbondingRecord(s.Ctx, 6)
+	s.Require().True(found, "record should exist")
+	s.Require().Equal(types.CLAIMABLE, record.Status, "record status")
+}
+
+func (s *KeeperTestSuite) TestRecordConfirmationQueryResult_NotFound() {
+	err := s.App.StakedymKeeper.RecordConfirmationQueryResult(s.Ctx, 1, sdkmath.ZeroInt())
+	s.Require().ErrorIs(err, types.ErrPendingConfirmationNotFound)
+}
+
+func (s *KeeperTestSuite) TestConfirmationCallbacks_InvalidCallbackData() {
+	query := icqtypes.Query{CallbackData: []byte{1, 2, 3}}
+	err := keeper.ConfirmationDelegationCallback(s.App.StakedymKeeper, s.Ctx, []byte{}, query)
+	s.Require().ErrorContains(err, "unable to unmarshal pending confirmation callback data")
+
+	// Valid callback data, but the pending confirmation does not exist
+	callbackDataBz, err := proto.Marshal(&types.PendingConfirmationQueryCallback{PendingConfirmationId: 1, SharesToTokensRate: sdk.OneDec()})
+	s.Require().NoError(err)
+	query = icqtypes.Query{CallbackData: callbackDataBz}
+	err = keeper.ConfirmationDelegationCallback(s.App.StakedymKeeper, s.Ctx, []byte{}, query)
+	s.Require().NoError(err, "response for a cleared confirmation should be ignored")
+}
@@ -0,0 +1,156 @@
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/cosmos/gogoproto/proto"
+
+	"github.com/Stride-Labs/stride/v24/utils"
+	icqtypes "github.com/Stride-Labs/stride/v24/x/interchainquery/types"
+	"github.com/Stride-Labs/stride/v24/x/stakedym/types"
+)
+
+// The callback IDs are prefixed with the module name since the IDs must be unique across modules
+const (
+	ICQCallbackID_ConfirmationValidator  = types.ModuleName + "-confirmation-validator"
+	ICQCallbackID_ConfirmationDelegation = types.ModuleName + "-confirmation-delegation"
+	ICQCallbackID_ConfirmationUnbonding  = types.ModuleName + "-confirmation-unbonding"
+)
+
+// ICQCallbacks wrapper struct for stakedym keeper
+type ICQCallback func(Keeper, sdk.Context, []byte, icqtypes.Query) error
+
+type ICQCallbacks struct {
+	k         Keeper
+	callbacks map[string]ICQCallback
+}
+
+var _ icqtypes.QueryCallbacks = ICQCallbacks{}
+
+func (k Keeper) ICQCallbackHandler() ICQCallbacks {
+	return ICQCallbacks{k, make(map[string]ICQCallback)}
+}
+
+func (c ICQCallbacks) CallICQCallback(ctx sdk.Context, id string, args []byte, query icqtypes.Query) error {
+	return c.callbacks[id](c.k, ctx, args, query)
+}
+
+func (c ICQCallbacks) HasICQCallback(id string) bool {
+	_, found := c.callbacks[id]
+	return found
+}
+
+func (c ICQCallbacks) AddICQCallback(id string, fn interface{}) icqtypes.QueryCallbacks {
+	c.callbacks[id] = fn.(ICQCallback)
+	return c
+}
+
+func (c ICQCallbacks) RegisterICQCallbacks() icqtypes.QueryCallbacks {
+	return c.
+		AddICQCallback(ICQCallbackID_ConfirmationValidator, ICQCallback(ConfirmationValidatorCallback)).
+		AddICQCallback(ICQCallbackID_ConfirmationDelegation, ICQCallback(ConfirmationDelegationCallback)).
+		AddICQCallback(ICQCallbackID_ConfirmationUnbonding, ICQCallback(ConfirmationUnbondingCallback))
+}
+
+// Unmarshals the callback data from a confirmation query
+// If the pending confirmation was cleared while the query was in flight, found is false
+// and the response should be ignored
+func (k Keeper) GetConfirmationQueryCallbackData(
+	ctx sdk.Context,
+	query icqtypes.Query,
+) (callbackData types.PendingConfirmationQueryCallback, found bool, err error) {
+	if err := proto.Unmarshal(query.CallbackData, &callbackData); err != nil {
+		return callbackData, false, errorsmod.Wrapf(err, "unable to unmarshal pending confirmation callback data")
+	}
+
+	if _, found := k.GetPendingConfirmation(ctx, callbackData.PendingConfirmationId); !found {
+		k.Logger(ctx).Info(utils.LogICQCallbackWithHostZone(query.ChainId, query.CallbackId,
+			"Ignoring response for cleared pending confirmation %d, QueryId: %v", callbackData.PendingConfirmationId, query.Id))
+		return callbackData, false, nil
+	}
+
+	return callbackData, true, nil
+}
+
+// Callback for the validator query, used to determine the validator's shares to tokens rate
+// The rate is passed to the delegation query that's submitted next
+// If the validator does not exist, it contributes nothing to the proven amount
+func ConfirmationValidatorCallback(k Keeper, ctx sdk.Context, args []byte, query icqtypes.Query) error {
+	k.Logger(ctx).Info(utils.LogICQCallbackWithHostZone(query.ChainId, ICQCallbackID_ConfirmationValidator,
+		"Starting confirmation validator callback, QueryId: %v", query.Id))
+
+	callbackData, found, err := k.GetConfirmationQueryCallbackData(ctx, query)
+	if err != nil || !found {
+		return err
+	}
+
+	if len(args) == 0 {
+		return k.RecordConfirmationQueryResult(ctx, callbackData.PendingConfirmationId, sdkmath.ZeroInt())
+	}
+
+	var validator stakingtypes.Validator
+	if err := k.cdc.Unmarshal(args, &validator); err != nil {
+		return errorsmod.Wrapf(err, "unable to unmarshal validator query response")
+	}
+
+	sharesToTokensRate := sdk.ZeroDec()
+	if !validator.DelegatorShares.IsZero() {
+		sharesToTokensRate = sdk.NewDecFromInt(validator.Tokens).Quo(validator.DelegatorShares)
+	}
+
+	return k.SubmitConfirmationDelegationQuery(ctx, query, callbackData.PendingConfirmationId, validator.OperatorAddress, sharesToTokensRate)
+}
+
+// Callback for the delegation query, which converts the delegated shares to tokens
+// and adds them to the pending confirmation's proven amount
+// An empty response (proven by a non-membership proof) indicates there is no delegation
+func ConfirmationDelegationCallback(k Keeper, ctx sdk.Context, args []byte, query icqtypes.Query) error {
+	k.Logger(ctx).Info(utils.LogICQCallbackWithHostZone(query.ChainId, ICQCallbackID_ConfirmationDelegation,
+		"Starting confirmation delegation callback, QueryId: %v", query.Id))
+
+	callbackData, found, err := k.GetConfirmationQueryCallbackData(ctx, query)
+	if err != nil || !found {
+		return err
+	}
+
+	delegatedTokens := sdkmath.ZeroInt()
+	if len(args) != 0 {
+		var delegation stakingtypes.Delegation
+		if err := k.cdc.Unmarshal(args, &delegation); err != nil {
+			return errorsmod.Wrapf(err, "unable to unmarshal delegation query response")
+		}
+		delegatedTokens = delegation.Shares.Mul(callbackData.SharesToTokensRate).TruncateInt()
+	}
+
+	return k.RecordConfirmationQueryResult(ctx, callbackData.PendingConfirmationId, delegatedTokens)
+}
+
+// Callback for the unbonding delegation query, which sums the balance of each entry that's
+// still unbonding and adds it to the pending confirmation's proven amount
+// An empty response (proven by a non-membership proof) indicates there are no unbondings
+func ConfirmationUnbondingCallback(k Keeper, ctx sdk.Context, args []byte, query icqtypes.Query) error {
+	k.Logger(ctx).Info(utils.LogICQCallbackWithHostZone(query.ChainId, ICQCallbackID_ConfirmationUnbonding,
+		"Starting confirmation unbonding callback, QueryId: %v", query.Id))
+
+	callbackData, found, err := k.GetConfirmationQueryCallbackData(ctx, query)
+	if err != nil || !found {
+		return err
+	}
+
+	unbondingTokens := sdkmath.ZeroInt()
+	if len(args) != 0 {
+		var unbondingDelegation stakingtypes.UnbondingDelegation
+		if err := k.cdc.Unmarshal(args, &unbondingDelegation); err != nil {
+			return errorsmod.Wrapf(err, "unable to unmarshal unbonding delegation query response")
+		}
+		for _, entry := range unbondingDelegation.Entries {
+			if entry.CompletionTime.After(ctx.BlockTime()) {
+				unbondingTokens = unbondingTokens.Add(entry.Balance)
+			}
+		}
+	}
+
+	return k.RecordConfirmationQueryResult(ctx, callbackData.PendingConfirmationId, unbondingTokens)
+}
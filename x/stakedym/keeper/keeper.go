@@ -19,6 +19,8 @@ type Keeper struct {
 	icaOracleKeeper types.ICAOracleKeeper
 	ratelimitKeeper types.RatelimitKeeper
 	transferKeeper  types.TransferKeeper
+	icqKeeper       types.InterchainQueryKeeper
+	epochsKeeper    types.EpochsKeeper
 }
 
 func NewKeeper(
@@ -29,6 +31,8 @@ func NewKeeper(
 	icaOracleKeeper types.ICAOracleKeeper,
 	ratelimitKeeper types.RatelimitKeeper,
 	transferKeeper types.TransferKeeper,
+	icqKeeper types.InterchainQueryKeeper,
+	epochsKeeper types.EpochsKeeper,
 ) *Keeper {
 	return &Keeper{
 		cdc:             cdc,
@@ -38,6 +42,8 @@ func NewKeeper(
 		icaOracleKeeper: icaOracleKeeper,
 		ratelimitKeeper: ratelimitKeeper,
 		transferKeeper:  transferKeeper,
+		icqKeeper:       icqKeeper,
+		epochsKeeper:    epochsKeeper,
 	}
 }
 
@@ -0,0 +1,142 @@
+package keeper
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Stride-Labs/stride/v24/utils"
+	epochstypes "github.com/Stride-Labs/stride/v24/x/epochs/types"
+	icaoracletypes "github.com/Stride-Labs/stride/v24/x/icaoracle/types"
+	"github.com/Stride-Labs/stride/v24/x/stakedym/types"
+)
+
+const NanosecondsPerYear = int64(time.Hour * 24 * 365)
+
+// Returns the metric producers for each stToken metric that is pushed to the oracles
+// These are registered with the icaoracle keeper in app.go, alongside the stakeibc producers
+func (k Keeper) OracleMetricProducers() []icaoracletypes.MetricProducer {
+	return []icaoracletypes.MetricProducer{
+		{MetricType: icaoracletypes.MetricType_TVL, ProduceFunc: k.ProduceTVLMetrics},
+		{MetricType: icaoracletypes.MetricType_APR, ProduceFunc: k.ProduceAPRMetrics},
+		{MetricType: icaoracletypes.MetricType_PendingRedemptions, ProduceFunc: k.ProducePendingRedemptionMetrics},
+		{MetricType: icaoracletypes.MetricType_Halted, ProduceFunc: k.ProduceHaltedMetrics},
+	}
+}
+
+// Builds the oracle metric for the stToken
+// Metric Key is of format: {stToken}_{metricType}
+func newStTokenMetricValue(hostZone types.HostZone, metricType, value string) (icaoracletypes.MetricValue, error) {
+	stDenom := utils.StAssetDenomFromHostZoneDenom(hostZone.NativeTokenDenom)
+	attributes, err := json.Marshal(icaoracletypes.StTokenMetricAttributes{
+		SttokenDenom: stDenom,
+	})
+	if err != nil {
+		return icaoracletypes.MetricValue{}, err
+	}
+
+	return icaoracletypes.MetricValue{
+		Key:        fmt.Sprintf("%s_%s", stDenom, metricType),
+		Value:      value,
+		Attributes: string(attributes),
+	}, nil
+}
+
+// Produces the TVL of the stToken, denominated in the native token
+// TVL is calculated as the stToken supply multiplied by the redemption rate
+// No metric is produced if the host zone is halted
+func (k Keeper) ProduceTVLMetrics(ctx sdk.Context) (metrics []icaoracletypes.MetricValue, err error) {
+	hostZone, err := k.GetHostZone(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hostZone.Halted {
+		return nil, nil
+	}
+
+	stDenom := utils.StAssetDenomFromHostZoneDenom(hostZone.NativeTokenDenom)
+	stSupply := k.bankKeeper.GetSupply(ctx, stDenom).Amount
+	tvl := sdk.NewDecFromInt(stSupply).Mul(hostZone.RedemptionRate).TruncateInt()
+
+	metric, err := newStTokenMetricValue(hostZone, icaoracletypes.MetricType_TVL, tvl.String())
+	if err != nil {
+		return nil, err
+	}
+	return []icaoracletypes.MetricValue{metric}, nil
+}
+
+// Produces the trailing APR of the stToken, annualized from the change in redemption
+// rate across the most recent redemption rate update (which occurs each day epoch)
+// No metric is produced if the host zone is halted or does not have a previous redemption rate
+func (k Keeper) ProduceAPRMetrics(ctx sdk.Context) (metrics []icaoracletypes.MetricValue, err error) {
+	hostZone, err := k.GetHostZone(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hostZone.Halted || hostZone.LastRedemptionRate.IsNil() || !hostZone.LastRedemptionRate.IsPositive() {
+		return nil, nil
+	}
+
+	dayEpoch, found := k.epochsKeeper.GetEpochInfo(ctx, epochstypes.DAY_EPOCH)
+	if !found {
+		return nil, errorsmod.Wrapf(types.ErrEpochNotFound, "epoch %s not found", epochstypes.DAY_EPOCH)
+	}
+	if dayEpoch.Duration <= 0 {
+		return nil, errorsmod.Wrapf(types.ErrInvalidEpoch, "redemption rate update period must be positive")
+	}
+	periodsPerYear := sdk.NewDec(NanosecondsPerYear).Quo(sdk.NewDec(dayEpoch.Duration.Nanoseconds()))
+
+	periodReturn := hostZone.RedemptionRate.Quo(hostZone.LastRedemptionRate).Sub(sdk.OneDec())
+	apr := periodReturn.Mul(periodsPerYear)
+
+	metric, err := newStTokenMetricValue(hostZone, icaoracletypes.MetricType_APR, apr.String())
+	if err != nil {
+		return nil, err
+	}
+	return []icaoracletypes.MetricValue{metric}, nil
+}
+
+// Produces the amount of stTokens that have been redeemed but are not yet claimable
+// No metric is produced if the host zone is halted
+func (k Keeper) ProducePendingRedemptionMetrics(ctx sdk.Context) (metrics []icaoracletypes.MetricValue, err error) {
+	hostZone, err := k.GetHostZone(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hostZone.Halted {
+		return nil, nil
+	}
+
+	pendingAmount := sdkmath.ZeroInt()
+	for _, unbondingRecord := range k.GetAllActiveUnbondingRecords(ctx) {
+		if unbondingRecord.Status == types.CLAIMABLE || unbondingRecord.Status == types.CLAIMED {
+			continue
+		}
+		pendingAmount = pendingAmount.Add(unbondingRecord.StTokenAmount)
+	}
+
+	metric, err := newStTokenMetricValue(hostZone, icaoracletypes.MetricType_PendingRedemptions, pendingAmount.String())
+	if err != nil {
+		return nil, err
+	}
+	return []icaoracletypes.MetricValue{metric}, nil
+}
+
+// Produces a flag indicating whether the host zone is halted
+func (k Keeper) ProduceHaltedMetrics(ctx sdk.Context) (metrics []icaoracletypes.MetricValue, err error) {
+	hostZone, err := k.GetHostZone(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	metric, err := newStTokenMetricValue(hostZone, icaoracletypes.MetricType_Halted, strconv.FormatBool(hostZone.Halted))
+	if err != nil {
+		return nil, err
+	}
+	return []icaoracletypes.MetricValue{metric}, nil
+}
@@ -0,0 +1,163 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	epochstypes "github.com/Stride-Labs/stride/v24/x/epochs/types"
+	icaoracletypes "github.com/Stride-Labs/stride/v24/x/icaoracle/types"
+	"github.com/Stride-Labs/stride/v24/x/stakedym/keeper"
+	"github.com/Stride-Labs/stride/v24/x/stakedym/types"
+)
+
+const StTokenMetricAttributes = `{"sttoken_denom":"stdenom"}`
+
+// Creates the host zone with a redemption rate that increased from 1.2 to 1.5
+func (s *KeeperTestSuite) SetupOracleMetricHostZone(halted bool) {
+	s.App.StakedymKeeper.SetHostZone(s.Ctx, types.HostZone{
+		NativeTokenDenom:   HostNativeDenom,
+		RedemptionRate:     sdk.MustNewDecFromStr("1.5"),
+		LastRedemptionRate: sdk.MustNewDecFromStr("1.2"),
+		Halted:             halted,
+	})
+}
+
+func (s *KeeperTestSuite) TestOracleMetricProducers() {
+	expectedMetricTypes := []string{
+		icaoracletypes.MetricType_TVL,
+		icaoracletypes.MetricType_APR,
+		icaoracletypes.MetricType_PendingRedemptions,
+		icaoracletypes.MetricType_Halted,
+	}
+
+	// Confirm there's a producer for each metric type, and that they're registered with the oracle
+	registeredTypes := s.App.ICAOracleKeeper.GetRegisteredMetricTypes()
+	for i, producer := range s.App.StakedymKeeper.OracleMetricProducers() {
+		s.Require().Equal(expectedMetricTypes[i], producer.MetricType, "metric type")
+		s.Require().NotNil(producer.ProduceFunc, "produce function for %s", producer.MetricType)
+		s.Require().Contains(registeredTypes, producer.MetricType, "registered metric types")
+	}
+}
+
+func (s *KeeperTestSuite) TestProduceTVLMetrics() {
+	// Without a host zone, the metric cannot be produced
+	_, err := s.App.StakedymKeeper.ProduceTVLMetrics(s.Ctx)
+	s.Require().ErrorContains(err, "No HostZone found")
+
+	s.SetupOracleMetricHostZone(false)
+	s.FundAccount(s.TestAccs[0], sdk.NewInt64Coin(StDenom, 1000))
+
+	metrics, err := s.App.StakedymKeeper.ProduceTVLMetrics(s.Ctx)
+	s.Require().NoError(err, "no error expected when producing tvl metrics")
+
+	// TVL is 1000 * 1.5
+	s.Require().Equal([]icaoracletypes.MetricValue{{
+		Key:        "stdenom_tvl",
+		Value:      "1500",
+		Attributes: StTokenMetricAttributes,
+	}}, metrics)
+
+	// If the host zone is halted, there should be no metric
+	s.SetupOracleMetricHostZone(true)
+	metrics, err = s.App.StakedymKeeper.ProduceTVLMetrics(s.Ctx)
+	s.Require().NoError(err, "no error expected when producing tvl metrics for a halted host zone")
+	s.Require().Empty(metrics, "tvl metrics for a halted host zone")
+}
+
+func (s *KeeperTestSuite) TestProduceAPRMetrics() {
+	s.SetupOracleMetricHostZone(false)
+
+	// Without the day epoch, the APR cannot be calculated
+	s.App.EpochsKeeper.DeleteEpochInfo(s.Ctx, epochstypes.DAY_EPOCH)
+	_, err := s.App.StakedymKeeper.ProduceAPRMetrics(s.Ctx)
+	s.Require().ErrorContains(err, "epoch day not found")
+
+	epochDuration := time.Hour * 24
+	s.App.EpochsKeeper.SetEpochInfo(s.Ctx, epochstypes.EpochInfo{
+		Identifier: epochstypes.DAY_EPOCH,
+		Duration:   epochDuration,
+	})
+
+	metrics, err := s.App.StakedymKeeper.ProduceAPRMetrics(s.Ctx)
+	s.Require().NoError(err, "no error expected when producing apr metrics")
+
+	// The redemption rate increased 25% (1.2 -> 1.5) over one day epoch
+	periodsPerYear := sdk.NewDec(keeper.NanosecondsPerYear).Quo(sdk.NewDec(epochDuration.Nanoseconds()))
+	expectedAPR := sdk.MustNewDecFromStr("0.25").Mul(periodsPerYear)
+
+	s.Require().Equal([]icaoracletypes.MetricValue{{
+		Key:        "stdenom_apr",
+		Value:      expectedAPR.String(),
+		Attributes: StTokenMetricAttributes,
+	}}, metrics)
+
+	// If the host zone doesn't have a previous redemption rate, it should be skipped
+	hostZone := s.MustGetHostZone()
+	hostZone.LastRedemptionRate = sdk.ZeroDec()
+	s.App.StakedymKeeper.SetHostZone(s.Ctx, hostZone)
+
+	metrics, err = s.App.StakedymKeeper.ProduceAPRMetrics(s.Ctx)
+	s.Require().NoError(err, "no error expected when producing apr metrics without a previous rate")
+	s.Require().Empty(metrics, "apr metrics without a previous rate")
+
+	// If the host zone is halted, it should also be skipped
+	s.SetupOracleMetricHostZone(true)
+	metrics, err = s.App.StakedymKeeper.ProduceAPRMetrics(s.Ctx)
+	s.Require().NoError(err, "no error expected when producing apr metrics for a halted host zone")
+	s.Require().Empty(metrics, "apr metrics for a halted host zone")
+}
+
+func (s *KeeperTestSuite) TestProducePendingRedemptionMetrics() {
+	s.SetupOracleMetricHostZone(false)
+
+	unbondingRecords := []types.UnbondingRecord{
+		{Id: 1, Status: types.ACCUMULATING_REDEMPTIONS, StTokenAmount: sdkmath.NewInt(100)},
+		{Id: 2, Status: types.UNBONDING_QUEUE, StTokenAmount: sdkmath.NewInt(200)},
+		{Id: 3, Status: types.UNBONDING_IN_PROGRESS, StTokenAmount: sdkmath.NewInt(300)},
+		{Id: 4, Status: types.UNBONDED, StTokenAmount: sdkmath.NewInt(400)},
+		{Id: 5, Status: types.CLAIMABLE, StTokenAmount: sdkmath.NewInt(500)},
+	}
+	for _, unbondingRecord := range unbondingRecords {
+		s.App.StakedymKeeper.SetUnbondingRecord(s.Ctx, unbondingRecord)
+	}
+	s.App.StakedymKeeper.SetArchivedUnbondingRecord(s.Ctx, types.UnbondingRecord{
+		Id: 6, Status: types.CLAIMED, StTokenAmount: sdkmath.NewInt(600),
+	})
+
+	metrics, err := s.App.StakedymKeeper.ProducePendingRedemptionMetrics(s.Ctx)
+	s.Require().NoError(err, "no error expected when producing pending redemption metrics")
+
+	// The claimable and claimed records should be excluded
+	s.Require().Equal([]icaoracletypes.MetricValue{{
+		Key:        "stdenom_pending_redemptions",
+		Value:      "1000",
+		Attributes: StTokenMetricAttributes,
+	}}, metrics)
+
+	// If the host zone is halted, there should be no metric
+	s.SetupOracleMetricHostZone(true)
+	metrics, err = s.App.StakedymKeeper.ProducePendingRedemptionMetrics(s.Ctx)
+	s.Require().NoError(err, "no error expected when producing pending redemption metrics for a halted host zone")
+	s.Require().Empty(metrics, "pending redemption metrics for a halted host zone")
+}
+
+func (s *KeeperTestSuite) TestProduceHaltedMetrics() {
+	s.SetupOracleMetricHostZone(false)
+
+	metrics, err := s.App.StakedymKeeper.ProduceHaltedMetrics(s.Ctx)
+	s.Require().NoError(err, "no error expected when producing halted metrics")
+	s.Require().Equal([]icaoracletypes.MetricValue{
+		{Key: "stdenom_halted", Value: "false", Attributes: StTokenMetricAttributes},
+	}, metrics)
+
+	// Unlike the other metrics, the halted metric is still produced for a halted host zone
+	s.SetupOracleMetricHostZone(true)
+
+	metrics, err = s.App.StakedymKeeper.ProduceHaltedMetrics(s.Ctx)
+	s.Require().NoError(err, "no error expected when producing halted metrics for a halted host zone")
+	s.Require().Equal([]icaoracletypes.MetricValue{
+		{Key: "stdenom_halted", Value: "true", Attributes: StTokenMetricAttributes},
+	}, metrics)
+}
@@ -24,6 +24,8 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	legacy.RegisterAminoMsg(cdc, &MsgOverwriteUnbondingRecord{}, "stakedym/MsgOverwriteUnbondingRecord")
 	legacy.RegisterAminoMsg(cdc, &MsgOverwriteRedemptionRecord{}, "stakedym/MsgOverwriteRedemptionRecord")
 	legacy.RegisterAminoMsg(cdc, &MsgSetOperatorAddress{}, "stakedym/MsgSetOperatorAddress")
+	legacy.RegisterAminoMsg(cdc, &MsgSetICQVerification{}, "stakedym/MsgSetICQVerification")
+	legacy.RegisterAminoMsg(cdc, &MsgClearPendingConfirmation{}, "stakedym/MsgClearPendingConfirmation")
 
 }
 
@@ -42,6 +44,8 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 		&MsgOverwriteUnbondingRecord{},
 		&MsgOverwriteRedemptionRecord{},
 		&MsgSetOperatorAddress{},
+		&MsgSetICQVerification{},
+		&MsgClearPendingConfirmation{},
 	)
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
 }
@@ -6,6 +6,9 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	transfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
+
+	epochstypes "github.com/Stride-Labs/stride/v24/x/epochs/types"
+	icqtypes "github.com/Stride-Labs/stride/v24/x/interchainquery/types"
 )
 
 // Required AccountKeeper functions
@@ -41,3 +44,13 @@ type RatelimitKeeper interface {
 type ICAOracleKeeper interface {
 	QueueMetricUpdate(ctx sdk.Context, key, value, metricType, attributes string)
 }
+
+// Required InterchainQueryKeeper functions
+type InterchainQueryKeeper interface {
+	SubmitICQRequest(ctx sdk.Context, query icqtypes.Query, forceUnique bool) error
+}
+
+// Required EpochsKeeper functions
+type EpochsKeeper interface {
+	GetEpochInfo(ctx sdk.Context, identifier string) (epochstypes.EpochInfo, bool)
+}
@@ -3,7 +3,9 @@ package types
 import (
 	errorsmod "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
 	transfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
+	host "github.com/cosmos/ibc-go/v7/modules/core/24-host"
 )
 
 // Helper fucntion to validate a host zone is properly initialized
@@ -89,6 +91,41 @@ func (h HostZone) ValidateGenesis() error {
 		return ErrInvalidHostZone.Wrap("unbonding period must be set")
 	}
 
+	// Validate the ICQ verification config if it's enabled
+	if h.IsICQVerificationEnabled() {
+		if err := h.IcqVerification.ValidateBasic(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Returns true if operator confirmations must be verified with an ICQ
+func (h HostZone) IsICQVerificationEnabled() bool {
+	return h.IcqVerification != nil && h.IcqVerification.Enabled
+}
+
+// Validates the connection ID and validator set used for ICQ verification
+func (v ICQVerification) ValidateBasic() error {
+	if err := host.ConnectionIdentifierValidator(v.ConnectionId); err != nil {
+		return errorsmod.Wrapf(ErrInvalidICQVerification, "invalid connection ID (%s)", err)
+	}
+	if len(v.Validators) == 0 {
+		return errorsmod.Wrap(ErrInvalidICQVerification, "at least one validator must be specified")
+	}
+
+	validators := map[string]bool{}
+	for _, validator := range v.Validators {
+		if _, _, err := bech32.DecodeAndConvert(validator); err != nil {
+			return errorsmod.Wrapf(ErrInvalidICQVerification, "invalid validator address %s (%s)", validator, err)
+		}
+		if validators[validator] {
+			return errorsmod.Wrapf(ErrInvalidICQVerification, "duplicate validator %s", validator)
+		}
+		validators[validator] = true
+	}
+
 	return nil
 }
 
@@ -26,6 +26,8 @@ var (
 	SlashRecordsKeyPrefix               = []byte("slash-records")
 	SlashRecordStoreKeyPrefix           = []byte("slash-record-id")
 	TransferInProgressRecordIdKeyPrefix = []byte("transfer-in-progress")
+	PendingConfirmationsKeyPrefix       = []byte("pending-confirmations")
+	PendingConfirmationIdKey            = []byte("pending-confirmation-id")
 
 	ChannelIdBufferFixedLength int = 16
 )
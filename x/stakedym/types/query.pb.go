@@ -636,6 +636,87 @@ func (m *RedemptionRecordResponse) GetUnbondingCompletionTimeSeconds() uint64 {
 	return 0
 }
 
+// Pending Confirmations
+type QueryPendingConfirmationsRequest struct {
+}
+
+func (m *QueryPendingConfirmationsRequest) Reset()         { *m = QueryPendingConfirmationsRequest{} }
+func (m *QueryPendingConfirmationsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPendingConfirmationsRequest) ProtoMessage()    {}
+func (*QueryPendingConfirmationsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_20841970448ef724, []int{13}
+}
+func (m *QueryPendingConfirmationsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPendingConfirmationsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPendingConfirmationsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPendingConfirmationsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPendingConfirmationsRequest.Merge(m, src)
+}
+func (m *QueryPendingConfirmationsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPendingConfirmationsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPendingConfirmationsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPendingConfirmationsRequest proto.InternalMessageInfo
+
+type QueryPendingConfirmationsResponse struct {
+	PendingConfirmations []PendingConfirmation `protobuf:"bytes,1,rep,name=pending_confirmations,json=pendingConfirmations,proto3" json:"pending_confirmations"`
+}
+
+func (m *QueryPendingConfirmationsResponse) Reset()         { *m = QueryPendingConfirmationsResponse{} }
+func (m *QueryPendingConfirmationsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPendingConfirmationsResponse) ProtoMessage()    {}
+func (*QueryPendingConfirmationsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_20841970448ef724, []int{14}
+}
+func (m *QueryPendingConfirmationsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPendingConfirmationsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPendingConfirmationsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPendingConfirmationsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPendingConfirmationsResponse.Merge(m, src)
+}
+func (m *QueryPendingConfirmationsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPendingConfirmationsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPendingConfirmationsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPendingConfirmationsResponse proto.InternalMessageInfo
+
+func (m *QueryPendingConfirmationsResponse) GetPendingConfirmations() []PendingConfirmation {
+	if m != nil {
+		return m.PendingConfirmations
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryHostZoneRequest)(nil), "stride.stakedym.QueryHostZoneRequest")
 	proto.RegisterType((*QueryHostZoneResponse)(nil), "stride.stakedym.QueryHostZoneResponse")
@@ -650,66 +731,73 @@ func init() {
 	proto.RegisterType((*QuerySlashRecordsRequest)(nil), "stride.stakedym.QuerySlashRecordsRequest")
 	proto.RegisterType((*QuerySlashRecordsResponse)(nil), "stride.stakedym.QuerySlashRecordsResponse")
 	proto.RegisterType((*RedemptionRecordResponse)(nil), "stride.stakedym.RedemptionRecordResponse")
+	proto.RegisterType((*QueryPendingConfirmationsRequest)(nil), "stride.stakedym.QueryPendingConfirmationsRequest")
+	proto.RegisterType((*QueryPendingConfirmationsResponse)(nil), "stride.stakedym.QueryPendingConfirmationsResponse")
 }
 
 func init() { proto.RegisterFile("stride/stakedym/query.proto", fileDescriptor_20841970448ef724) }
 
 var fileDescriptor_20841970448ef724 = []byte{
-	// 853 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x56, 0x4d, 0x6f, 0xd3, 0x4a,
-	0x14, 0xcd, 0xa4, 0x7d, 0xef, 0xa5, 0xd3, 0x3e, 0x35, 0x19, 0x0a, 0x72, 0xdd, 0x62, 0x52, 0x4b,
-	0x94, 0xb4, 0xa2, 0x36, 0x0d, 0xa8, 0xac, 0x29, 0x88, 0x52, 0x54, 0x95, 0xe2, 0x40, 0x17, 0xdd,
-	0x44, 0x4e, 0x3c, 0x72, 0x2c, 0x12, 0x4f, 0xea, 0x71, 0xaa, 0x96, 0xaa, 0x1b, 0x36, 0xc0, 0x0e,
-	0x89, 0x15, 0x3f, 0x81, 0x05, 0x0b, 0x16, 0xfc, 0x87, 0xb2, 0xab, 0xc4, 0x06, 0xb1, 0x40, 0xa8,
-	0xe5, 0x87, 0xa0, 0x8c, 0xc7, 0xf9, 0xb0, 0x3d, 0x49, 0xc4, 0xce, 0x99, 0x7b, 0x7d, 0xee, 0x39,
-	0xd7, 0xf7, 0x9e, 0x09, 0x9c, 0xa3, 0xbe, 0xe7, 0x58, 0x58, 0xa7, 0xbe, 0xf9, 0x02, 0x5b, 0x47,
-	0x0d, 0x7d, 0xbf, 0x85, 0xbd, 0x23, 0xad, 0xe9, 0x11, 0x9f, 0xa0, 0xe9, 0x20, 0xa8, 0x85, 0x41,
-	0x59, 0x89, 0x66, 0x87, 0x0f, 0xc1, 0x0b, 0xf2, 0x8c, 0x4d, 0x6c, 0xc2, 0x1e, 0xf5, 0xf6, 0x13,
-	0x3f, 0x9d, 0xb7, 0x09, 0xb1, 0xeb, 0x58, 0x37, 0x9b, 0x8e, 0x6e, 0xba, 0x2e, 0xf1, 0x4d, 0xdf,
-	0x21, 0x2e, 0xe5, 0xd1, 0xe5, 0x2a, 0xa1, 0x0d, 0x42, 0xf5, 0x8a, 0x49, 0x71, 0x50, 0x5d, 0x3f,
-	0x58, 0xad, 0x60, 0xdf, 0x5c, 0xd5, 0x9b, 0xa6, 0xed, 0xb8, 0x2c, 0x39, 0xc8, 0x55, 0xaf, 0xc0,
-	0x99, 0xa7, 0xed, 0x8c, 0x47, 0x84, 0xfa, 0x7b, 0xc4, 0xc5, 0x06, 0xde, 0x6f, 0x61, 0xea, 0xab,
-	0x4f, 0xe0, 0xe5, 0xc8, 0x39, 0x6d, 0x12, 0x97, 0x62, 0xb4, 0x06, 0x27, 0x6a, 0x84, 0xfa, 0xe5,
-	0x97, 0xc4, 0xc5, 0x12, 0xc8, 0x83, 0xc2, 0x64, 0x71, 0x56, 0x8b, 0xa8, 0xd2, 0x3a, 0x6f, 0x65,
-	0x6a, 0xfc, 0x49, 0x7d, 0x0c, 0xaf, 0x32, 0xc0, 0x07, 0xb8, 0x8e, 0x6d, 0xc6, 0xc0, 0xc0, 0x55,
-	0xe2, 0x59, 0x94, 0x57, 0x44, 0x4b, 0x30, 0xeb, 0xb8, 0xd5, 0x7a, 0xcb, 0xc2, 0x65, 0xd3, 0xab,
-	0xd6, 0x9c, 0x03, 0x6c, 0x31, 0xfc, 0x8c, 0x31, 0xcd, 0xcf, 0xef, 0xf1, 0x63, 0xf5, 0x10, 0x2a,
-	0x22, 0x2c, 0xce, 0x72, 0x17, 0x22, 0xab, 0x13, 0x2c, 0x7b, 0x41, 0x54, 0x02, 0xf9, 0xb1, 0xc2,
-	0x64, 0x71, 0x21, 0x46, 0x37, 0x8a, 0xb3, 0x3e, 0x7e, 0xfa, 0xf3, 0x5a, 0xca, 0xc8, 0x59, 0x51,
-	0x7c, 0x75, 0x13, 0xce, 0xb3, 0xca, 0xcf, 0xdd, 0x0a, 0x71, 0x2d, 0xc7, 0xb5, 0xff, 0x5e, 0x84,
-	0xcf, 0x1b, 0x12, 0x87, 0xe2, 0x1a, 0x4a, 0x30, 0xd7, 0x0a, 0x63, 0x11, 0x09, 0xf9, 0x98, 0x84,
-	0x08, 0x0a, 0x57, 0x90, 0x6d, 0x45, 0xc0, 0xd5, 0x1a, 0x17, 0x60, 0x60, 0x0b, 0x37, 0x9a, 0x5d,
-	0x69, 0xa1, 0x00, 0x0d, 0x5e, 0x8a, 0x16, 0x2d, 0x3b, 0x81, 0x86, 0x71, 0x23, 0x17, 0x81, 0xdb,
-	0xb4, 0x90, 0x04, 0xff, 0x33, 0x2d, 0xcb, 0xc3, 0x94, 0x4a, 0xe9, 0x3c, 0x28, 0x4c, 0x18, 0xe1,
-	0x4f, 0xf5, 0x0d, 0xe0, 0x02, 0xe3, 0xa5, 0xb8, 0x40, 0x1b, 0xca, 0x5e, 0x27, 0x16, 0x16, 0xf3,
-	0x78, 0x94, 0xcf, 0xd6, 0x52, 0x4c, 0xa9, 0x08, 0xce, 0x90, 0x3c, 0x41, 0x44, 0xfd, 0x2c, 0xa2,
-	0xd2, 0xf9, 0x6e, 0x3d, 0x32, 0x40, 0x9f, 0x0c, 0x51, 0x43, 0xd2, 0xa2, 0x86, 0x3c, 0x84, 0xb0,
-	0xbb, 0x64, 0xd2, 0x18, 0x13, 0xb1, 0xa8, 0x05, 0x1b, 0xa9, 0xb5, 0x37, 0x52, 0x0b, 0xfc, 0x80,
-	0x6f, 0xa4, 0xb6, 0x63, 0xda, 0xe1, 0xd2, 0x19, 0x3d, 0x6f, 0xaa, 0x3f, 0x00, 0x1f, 0xf2, 0x04,
-	0xce, 0xbc, 0x7f, 0x04, 0xce, 0x89, 0xfb, 0x17, 0x8e, 0xca, 0xe8, 0x0d, 0xe4, 0x33, 0x33, 0x2b,
-	0x6a, 0x23, 0x45, 0x1b, 0x7d, 0xda, 0xd2, 0x4c, 0xdb, 0x8d, 0xa1, 0xda, 0xf8, 0xe7, 0xe9, 0x15,
-	0x27, 0x43, 0x89, 0x69, 0x2b, 0xd5, 0x4d, 0x5a, 0xeb, 0xff, 0x14, 0xaa, 0x05, 0x67, 0x13, 0x62,
-	0x5c, 0xf2, 0x06, 0xfc, 0x9f, 0xb6, 0xcf, 0x23, 0xfb, 0x30, 0x1f, 0x13, 0xd9, 0xf3, 0x36, 0xd7,
-	0x35, 0x45, 0x7b, 0x00, 0xd5, 0x2f, 0x00, 0x4a, 0xc2, 0xc1, 0xdc, 0x86, 0xb9, 0x58, 0x63, 0xf9,
-	0x3c, 0x2e, 0x0c, 0x6f, 0x67, 0x36, 0xda, 0x40, 0xb4, 0x09, 0x17, 0xba, 0x33, 0x54, 0x25, 0x8d,
-	0x66, 0x1d, 0x33, 0x64, 0xdf, 0x69, 0xe0, 0x32, 0xc5, 0x55, 0xe2, 0x5a, 0x94, 0x4f, 0x94, 0xd2,
-	0x49, 0xbc, 0xdf, 0xc9, 0x7b, 0xe6, 0x34, 0x70, 0x29, 0xc8, 0x2a, 0xbe, 0xce, 0xc0, 0x7f, 0x58,
-	0x7b, 0xd0, 0x5b, 0x00, 0x33, 0xa1, 0xcf, 0xa2, 0xeb, 0x31, 0x5a, 0x49, 0xae, 0x2e, 0x2f, 0x0e,
-	0x4b, 0xe3, 0x0b, 0xa3, 0xbd, 0xfa, 0xf6, 0xfb, 0x7d, 0xba, 0x80, 0x16, 0xf5, 0x12, 0xcb, 0x5f,
-	0xd9, 0x32, 0x2b, 0x54, 0x8f, 0x5e, 0x55, 0x9d, 0x7b, 0x00, 0x7d, 0x02, 0x30, 0x17, 0x33, 0x63,
-	0xa4, 0x25, 0x57, 0x13, 0xdd, 0x00, 0xb2, 0x3e, 0x72, 0x3e, 0xa7, 0x79, 0x97, 0xd1, 0x5c, 0x45,
-	0xfa, 0x40, 0x9a, 0xf1, 0x8b, 0x00, 0x7d, 0x04, 0x30, 0x1b, 0xf5, 0x5d, 0xb4, 0x92, 0x5c, 0x5e,
-	0x60, 0xf5, 0xb2, 0x36, 0x6a, 0x3a, 0x27, 0xbb, 0xc6, 0xc8, 0xde, 0x42, 0xda, 0x40, 0xb2, 0x31,
-	0xc7, 0x47, 0x5f, 0x01, 0xcc, 0x46, 0x67, 0x4c, 0xc4, 0x55, 0xe0, 0xea, 0x22, 0xae, 0xa2, 0x05,
-	0x50, 0x77, 0x19, 0xd7, 0x1d, 0xb4, 0x3d, 0x90, 0x6b, 0x6c, 0x47, 0xf4, 0xe3, 0x04, 0xab, 0x3c,
-	0xd1, 0x8f, 0xb9, 0x97, 0x9e, 0xb0, 0x39, 0x89, 0xf9, 0x19, 0x1a, 0x91, 0xdd, 0xb0, 0x39, 0x11,
-	0x1a, 0xe5, 0x88, 0x73, 0x12, 0x93, 0x43, 0xd1, 0x07, 0x00, 0xa7, 0x7a, 0x7d, 0x08, 0x2d, 0x25,
-	0x97, 0x4e, 0xf0, 0x31, 0x79, 0x79, 0x94, 0x54, 0x4e, 0xb0, 0xc8, 0x08, 0xde, 0x44, 0xcb, 0x03,
-	0x09, 0xf6, 0x39, 0xdf, 0xfa, 0xd6, 0xe9, 0xb9, 0x02, 0xce, 0xce, 0x15, 0xf0, 0xeb, 0x5c, 0x01,
-	0xef, 0x2e, 0x94, 0xd4, 0xd9, 0x85, 0x92, 0xfa, 0x7e, 0xa1, 0xa4, 0xf6, 0x8a, 0xb6, 0xe3, 0xd7,
-	0x5a, 0x15, 0xad, 0x4a, 0x1a, 0x49, 0x78, 0x07, 0xc5, 0x3b, 0xfa, 0x61, 0x17, 0xd5, 0x3f, 0x6a,
-	0x62, 0x5a, 0xf9, 0x97, 0xfd, 0x1d, 0xbc, 0xfd, 0x27, 0x00, 0x00, 0xff, 0xff, 0x1d, 0x2f, 0xbe,
-	0xed, 0xbe, 0x0a, 0x00, 0x00,
+	// 930 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x56, 0xcf, 0x6f, 0xdc, 0x44,
+	0x14, 0xce, 0xa4, 0x05, 0x92, 0xd7, 0xa2, 0xee, 0x0e, 0x29, 0xda, 0xb8, 0x89, 0xd9, 0x58, 0x10,
+	0x92, 0x88, 0x7a, 0xc8, 0x52, 0x15, 0x89, 0x1b, 0x05, 0x51, 0x82, 0xaa, 0x12, 0x1c, 0xe8, 0xa1,
+	0x97, 0x95, 0x77, 0x3d, 0x78, 0x2d, 0xd6, 0x1e, 0xd7, 0xe3, 0x8d, 0x1a, 0xaa, 0x5e, 0x10, 0x07,
+	0xb8, 0x21, 0x71, 0xe2, 0x4f, 0xe0, 0xc0, 0x81, 0x03, 0x07, 0xce, 0x5c, 0xca, 0xad, 0x12, 0x17,
+	0xc4, 0x01, 0xa1, 0x84, 0x3f, 0x04, 0x65, 0xfc, 0xbc, 0xd9, 0x1d, 0x7b, 0x36, 0x5b, 0x6e, 0xb3,
+	0xf3, 0xbe, 0x79, 0xef, 0xfb, 0x9e, 0xdf, 0x8f, 0x85, 0x6b, 0x32, 0xcf, 0xa2, 0x80, 0x33, 0x99,
+	0xfb, 0x5f, 0xf0, 0xe0, 0x28, 0x66, 0x0f, 0x46, 0x3c, 0x3b, 0x72, 0xd3, 0x4c, 0xe4, 0x82, 0x5e,
+	0x29, 0x8c, 0x6e, 0x69, 0xb4, 0x6c, 0x1d, 0x5d, 0x1e, 0x8a, 0x07, 0xd6, 0x4a, 0x28, 0x42, 0xa1,
+	0x8e, 0xec, 0xf4, 0x84, 0xb7, 0x6b, 0xa1, 0x10, 0xe1, 0x90, 0x33, 0x3f, 0x8d, 0x98, 0x9f, 0x24,
+	0x22, 0xf7, 0xf3, 0x48, 0x24, 0x12, 0xad, 0x3b, 0x7d, 0x21, 0x63, 0x21, 0x59, 0xcf, 0x97, 0xbc,
+	0x88, 0xce, 0x0e, 0x77, 0x7b, 0x3c, 0xf7, 0x77, 0x59, 0xea, 0x87, 0x51, 0xa2, 0xc0, 0x05, 0xd6,
+	0x79, 0x19, 0x56, 0x3e, 0x39, 0x45, 0x7c, 0x28, 0x64, 0x7e, 0x5f, 0x24, 0xdc, 0xe3, 0x0f, 0x46,
+	0x5c, 0xe6, 0xce, 0xc7, 0x70, 0x55, 0xbb, 0x97, 0xa9, 0x48, 0x24, 0xa7, 0x37, 0x61, 0x79, 0x20,
+	0x64, 0xde, 0xfd, 0x52, 0x24, 0xbc, 0x45, 0xda, 0x64, 0xeb, 0x52, 0x67, 0xd5, 0xd5, 0x54, 0xb9,
+	0xe3, 0x57, 0x4b, 0x03, 0x3c, 0x39, 0x1f, 0xc1, 0xba, 0x72, 0xf8, 0x3e, 0x1f, 0xf2, 0x50, 0x31,
+	0xf0, 0x78, 0x5f, 0x64, 0x81, 0xc4, 0x88, 0x74, 0x1b, 0x1a, 0x51, 0xd2, 0x1f, 0x8e, 0x02, 0xde,
+	0xf5, 0xb3, 0xfe, 0x20, 0x3a, 0xe4, 0x81, 0xf2, 0xbf, 0xe4, 0x5d, 0xc1, 0xfb, 0x77, 0xf1, 0xda,
+	0x79, 0x08, 0xb6, 0xc9, 0x17, 0xb2, 0xbc, 0x07, 0x34, 0x18, 0x1b, 0xbb, 0x59, 0x61, 0x6d, 0x91,
+	0xf6, 0x85, 0xad, 0x4b, 0x9d, 0x8d, 0x0a, 0x5d, 0xdd, 0xcf, 0xad, 0x8b, 0x4f, 0xfe, 0x7e, 0x65,
+	0xc1, 0x6b, 0x06, 0xba, 0x7f, 0x67, 0x0f, 0xd6, 0x54, 0xe4, 0xcf, 0x92, 0x9e, 0x48, 0x82, 0x28,
+	0x09, 0xff, 0xbf, 0x88, 0x1c, 0xd6, 0x0d, 0xae, 0x50, 0xc3, 0x01, 0x34, 0x47, 0xa5, 0x4d, 0x93,
+	0xd0, 0xae, 0x48, 0xd0, 0xbc, 0xa0, 0x82, 0xc6, 0x48, 0x73, 0xee, 0x0c, 0x50, 0x80, 0xc7, 0x03,
+	0x1e, 0xa7, 0x67, 0xd2, 0x4a, 0x01, 0x2e, 0xbc, 0xa4, 0x07, 0xed, 0x46, 0x85, 0x86, 0x8b, 0x5e,
+	0x53, 0x73, 0xb7, 0x17, 0xd0, 0x16, 0xbc, 0xe0, 0x07, 0x41, 0xc6, 0xa5, 0x6c, 0x2d, 0xb6, 0xc9,
+	0xd6, 0xb2, 0x57, 0xfe, 0x74, 0xbe, 0x21, 0xb0, 0x6e, 0x08, 0x85, 0x02, 0x43, 0xb0, 0xb2, 0xb1,
+	0xad, 0x0c, 0x96, 0xa1, 0x15, 0x6b, 0x6b, 0xbb, 0xa2, 0xd4, 0xe4, 0xce, 0x6b, 0x65, 0x06, 0x8b,
+	0xf3, 0xb3, 0x89, 0xca, 0xf8, 0xbb, 0x4d, 0xc8, 0x20, 0x53, 0x32, 0x4c, 0x09, 0x59, 0x34, 0x25,
+	0xe4, 0x03, 0x80, 0xb3, 0x26, 0x6b, 0x5d, 0x50, 0x22, 0x36, 0xdd, 0xa2, 0x23, 0xdd, 0xd3, 0x8e,
+	0x74, 0x8b, 0x79, 0x80, 0x1d, 0xe9, 0xee, 0xfb, 0x61, 0xd9, 0x74, 0xde, 0xc4, 0x4b, 0xe7, 0x2f,
+	0x02, 0xb6, 0x89, 0x33, 0xe6, 0x4f, 0xc0, 0x35, 0x73, 0xfe, 0xca, 0x52, 0x99, 0x3f, 0x81, 0x58,
+	0x33, 0xab, 0xa6, 0x34, 0x4a, 0x7a, 0x7b, 0x4a, 0xdb, 0xa2, 0xd2, 0xf6, 0xfa, 0xb9, 0xda, 0xf0,
+	0xf3, 0x4c, 0x8a, 0xb3, 0xa0, 0xa5, 0xb4, 0x1d, 0x0c, 0x7d, 0x39, 0x98, 0xfe, 0x14, 0x4e, 0x00,
+	0xab, 0x35, 0x36, 0x94, 0x7c, 0x1b, 0x5e, 0x94, 0xa7, 0xf7, 0x5a, 0x3f, 0xac, 0x55, 0x44, 0x4e,
+	0xbc, 0x46, 0x5d, 0x97, 0xe5, 0x84, 0x43, 0xe7, 0x17, 0x02, 0x2d, 0x63, 0x61, 0xde, 0x85, 0x66,
+	0x25, 0xb1, 0x58, 0x8f, 0x1b, 0xe7, 0xa7, 0xb3, 0xa1, 0x27, 0x90, 0xee, 0xc1, 0xc6, 0x59, 0x0d,
+	0xf5, 0x45, 0x9c, 0x0e, 0xb9, 0xf2, 0x9c, 0x47, 0x31, 0xef, 0x4a, 0xde, 0x17, 0x49, 0x20, 0xb1,
+	0xa2, 0xec, 0x31, 0xf0, 0xbd, 0x31, 0xee, 0xd3, 0x28, 0xe6, 0x07, 0x05, 0xca, 0x71, 0xa0, 0xad,
+	0xb2, 0xb3, 0xcf, 0x11, 0x94, 0x7c, 0x1e, 0x65, 0x71, 0x31, 0xfe, 0xcb, 0x0c, 0x7e, 0x4d, 0x60,
+	0x63, 0x06, 0x08, 0x45, 0x76, 0xe1, 0x6a, 0xca, 0x4b, 0x4a, 0x13, 0x00, 0x4c, 0xe9, 0xab, 0x15,
+	0xa1, 0x35, 0xde, 0x30, 0xb5, 0x2b, 0x69, 0x4d, 0xa0, 0xce, 0x6f, 0xcb, 0xf0, 0x9c, 0xa2, 0x41,
+	0xbf, 0x25, 0xb0, 0x54, 0xae, 0x04, 0xfa, 0x5a, 0xc5, 0x71, 0xdd, 0x02, 0xb2, 0x36, 0xcf, 0x83,
+	0x61, 0x6f, 0xbb, 0x5f, 0xfd, 0xf1, 0xef, 0xf7, 0x8b, 0x5b, 0x74, 0x93, 0x1d, 0x28, 0xfc, 0xf5,
+	0x3b, 0x7e, 0x4f, 0x32, 0x7d, 0xab, 0x8e, 0x57, 0x16, 0xfd, 0x89, 0x40, 0xb3, 0xb2, 0x37, 0xa8,
+	0x5b, 0x1f, 0xcd, 0xb4, 0xac, 0x2c, 0x36, 0x37, 0x1e, 0x69, 0xbe, 0xad, 0x68, 0xee, 0x52, 0x36,
+	0x93, 0x66, 0x75, 0x67, 0xd1, 0x1f, 0x09, 0x34, 0xf4, 0x15, 0x41, 0xaf, 0xd7, 0x87, 0x37, 0x6c,
+	0x25, 0xcb, 0x9d, 0x17, 0x8e, 0x64, 0x6f, 0x2a, 0xb2, 0x6f, 0x52, 0x77, 0x26, 0xd9, 0xca, 0x72,
+	0xa2, 0xbf, 0x13, 0x68, 0xe8, 0xed, 0x60, 0xe2, 0x6a, 0x58, 0x40, 0x96, 0x3b, 0x2f, 0x1c, 0xb9,
+	0xde, 0x53, 0x5c, 0xf7, 0xe9, 0xdd, 0x99, 0x5c, 0x2b, 0xed, 0xcc, 0x1e, 0xd5, 0x4c, 0xf5, 0xc7,
+	0xec, 0x11, 0x8e, 0xfd, 0xc7, 0xaa, 0x4e, 0xf4, 0xa0, 0xc6, 0x3a, 0x31, 0xed, 0x15, 0x8b, 0xcd,
+	0x8d, 0x7f, 0xa6, 0x3a, 0xa9, 0xc8, 0x91, 0xf4, 0x07, 0x02, 0x97, 0x27, 0x47, 0x26, 0xdd, 0xae,
+	0x0f, 0x5d, 0x33, 0x72, 0xad, 0x9d, 0x79, 0xa0, 0x48, 0xb0, 0xa3, 0x08, 0xbe, 0x41, 0x77, 0x66,
+	0x12, 0x9c, 0x1a, 0xd2, 0xf4, 0x57, 0x02, 0x2b, 0x75, 0xb3, 0x88, 0xee, 0xd6, 0x07, 0x9e, 0x31,
+	0xdc, 0xac, 0xce, 0xb3, 0x3c, 0x41, 0xce, 0xef, 0x28, 0xce, 0x37, 0x68, 0x67, 0x26, 0xe7, 0xda,
+	0x69, 0x78, 0xeb, 0xce, 0x93, 0x63, 0x9b, 0x3c, 0x3d, 0xb6, 0xc9, 0x3f, 0xc7, 0x36, 0xf9, 0xee,
+	0xc4, 0x5e, 0x78, 0x7a, 0x62, 0x2f, 0xfc, 0x79, 0x62, 0x2f, 0xdc, 0xef, 0x84, 0x51, 0x3e, 0x18,
+	0xf5, 0xdc, 0xbe, 0x88, 0xeb, 0xfc, 0x1e, 0x76, 0x6e, 0xb0, 0x87, 0x67, 0xde, 0xf3, 0xa3, 0x94,
+	0xcb, 0xde, 0xf3, 0xea, 0x5f, 0xf7, 0x5b, 0xff, 0x0d, 0x00, 0xe8, 0x35, 0x30, 0x19, 0x25, 0x0c,
+	0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -746,6 +834,8 @@ type QueryClient interface {
 	RedemptionRecords(ctx context.Context, in *QueryRedemptionRecordsRequest, opts ...grpc.CallOption) (*QueryRedemptionRecordsResponse, error)
 	// Queries slash records
 	SlashRecords(ctx context.Context, in *QuerySlashRecordsRequest, opts ...grpc.CallOption) (*QuerySlashRecordsResponse, error)
+	// Queries the operator confirmations that are awaiting ICQ verification
+	PendingConfirmations(ctx context.Context, in *QueryPendingConfirmationsRequest, opts ...grpc.CallOption) (*QueryPendingConfirmationsResponse, error)
 }
 
 type queryClient struct {
@@ -810,6 +900,15 @@ func (c *queryClient) SlashRecords(ctx context.Context, in *QuerySlashRecordsReq
 	return out, nil
 }
 
+func (c *queryClient) PendingConfirmations(ctx context.Context, in *QueryPendingConfirmationsRequest, opts ...grpc.CallOption) (*QueryPendingConfirmationsResponse, error) {
+	out := new(QueryPendingConfirmationsResponse)
+	err := c.cc.Invoke(ctx, "/stride.stakedym.Query/PendingConfirmations", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Queries the host zone struct
@@ -834,6 +933,8 @@ type QueryServer interface {
 	RedemptionRecords(context.Context, *QueryRedemptionRecordsRequest) (*QueryRedemptionRecordsResponse, error)
 	// Queries slash records
 	SlashRecords(context.Context, *QuerySlashRecordsRequest) (*QuerySlashRecordsResponse, error)
+	// Queries the operator confirmations that are awaiting ICQ verification
+	PendingConfirmations(context.Context, *QueryPendingConfirmationsRequest) (*QueryPendingConfirmationsResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -858,6 +959,9 @@ func (*UnimplementedQueryServer) RedemptionRecords(ctx context.Context, req *Que
 func (*UnimplementedQueryServer) SlashRecords(ctx context.Context, req *QuerySlashRecordsRequest) (*QuerySlashRecordsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SlashRecords not implemented")
 }
+func (*UnimplementedQueryServer) PendingConfirmations(ctx context.Context, req *QueryPendingConfirmationsRequest) (*QueryPendingConfirmationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PendingConfirmations not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -971,6 +1075,24 @@ func _Query_SlashRecords_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_PendingConfirmations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPendingConfirmationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PendingConfirmations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stride.stakedym.Query/PendingConfirmations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PendingConfirmations(ctx, req.(*QueryPendingConfirmationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "stride.stakedym.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -999,6 +1121,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "SlashRecords",
 			Handler:    _Query_SlashRecords_Handler,
 		},
+		{
+			MethodName: "PendingConfirmations",
+			Handler:    _Query_PendingConfirmations_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "stride/stakedym/query.proto",
@@ -1468,6 +1594,66 @@ func (m *RedemptionRecordResponse) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryPendingConfirmationsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPendingConfirmationsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPendingConfirmationsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPendingConfirmationsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPendingConfirmationsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPendingConfirmationsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PendingConfirmations) > 0 {
+		for iNdEx := len(m.PendingConfirmations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PendingConfirmations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -1663,6 +1849,30 @@ func (m *RedemptionRecordResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryPendingConfirmationsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryPendingConfirmationsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.PendingConfirmations) > 0 {
+		for _, e := range m.PendingConfirmations {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -2796,6 +3006,140 @@ func (m *RedemptionRecordResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryPendingConfirmationsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPendingConfirmationsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPendingConfirmationsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPendingConfirmationsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPendingConfirmationsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPendingConfirmationsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingConfirmations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingConfirmations = append(m.PendingConfirmations, PendingConfirmation{})
+			if err := m.PendingConfirmations[len(m.PendingConfirmations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
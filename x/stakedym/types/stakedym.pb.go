@@ -119,6 +119,42 @@ func (UnbondingRecordStatus) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_d78132ac6adfd885, []int{1}
 }
 
+// The type of operator action that's awaiting ICQ verification
+// Note: the unbonded token sweep does not have a confirmation type since the
+// claim address lives on stride, so its balance is checked directly against
+// local state when the sweep is confirmed (there is no host state to prove)
+type PendingConfirmationType int32
+
+const (
+	// CONFIRM_DELEGATION verifies the delegation address's total delegations
+	CONFIRM_DELEGATION PendingConfirmationType = 0
+	// CONFIRM_UNDELEGATION verifies the delegation address's unbonding entries
+	CONFIRM_UNDELEGATION PendingConfirmationType = 1
+	// ADJUST_DELEGATED_BALANCE verifies the delegation address's total
+	// delegations after applying the offset
+	ADJUST_DELEGATED_BALANCE PendingConfirmationType = 2
+)
+
+var PendingConfirmationType_name = map[int32]string{
+	0: "CONFIRM_DELEGATION",
+	1: "CONFIRM_UNDELEGATION",
+	2: "ADJUST_DELEGATED_BALANCE",
+}
+
+var PendingConfirmationType_value = map[string]int32{
+	"CONFIRM_DELEGATION":       0,
+	"CONFIRM_UNDELEGATION":     1,
+	"ADJUST_DELEGATED_BALANCE": 2,
+}
+
+func (x PendingConfirmationType) String() string {
+	return proto.EnumName(PendingConfirmationType_name, int32(x))
+}
+
+func (PendingConfirmationType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_d78132ac6adfd885, []int{2}
+}
+
 type HostZone struct {
 	// Chain ID
 	ChainId string `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
@@ -160,6 +196,9 @@ type HostZone struct {
 	UnbondingPeriodSeconds uint64 `protobuf:"varint,19,opt,name=unbonding_period_seconds,json=unbondingPeriodSeconds,proto3" json:"unbonding_period_seconds,omitempty"`
 	// Indicates whether the host zone has been halted
 	Halted bool `protobuf:"varint,20,opt,name=halted,proto3" json:"halted,omitempty"`
+	// Configuration for verifying operator confirmations with interchain query
+	// proofs (disabled if unset)
+	IcqVerification *ICQVerification `protobuf:"bytes,21,opt,name=icq_verification,json=icqVerification,proto3" json:"icq_verification,omitempty"`
 }
 
 func (m *HostZone) Reset()         { *m = HostZone{} }
@@ -286,6 +325,80 @@ func (m *HostZone) GetHalted() bool {
 	return false
 }
 
+func (m *HostZone) GetIcqVerification() *ICQVerification {
+	if m != nil {
+		return m.IcqVerification
+	}
+	return nil
+}
+
+// When enabled, operator confirmations are not processed immediately, and
+// instead are only finalized once the host zone state has been proven with an
+// interchain query
+type ICQVerification struct {
+	// Indicates whether ICQ verification is required for operator confirmations
+	Enabled bool `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// Connection ID from stride to the host zone, used for the queries
+	ConnectionId string `protobuf:"bytes,2,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty"`
+	// The validators that the delegation address delegates to
+	// The delegations and unbondings are summed across these validators
+	Validators []string `protobuf:"bytes,3,rep,name=validators,proto3" json:"validators,omitempty"`
+}
+
+func (m *ICQVerification) Reset()         { *m = ICQVerification{} }
+func (m *ICQVerification) String() string { return proto.CompactTextString(m) }
+func (*ICQVerification) ProtoMessage()    {}
+func (*ICQVerification) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d78132ac6adfd885, []int{1}
+}
+func (m *ICQVerification) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ICQVerification) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ICQVerification.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ICQVerification) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ICQVerification.Merge(m, src)
+}
+func (m *ICQVerification) XXX_Size() int {
+	return m.Size()
+}
+func (m *ICQVerification) XXX_DiscardUnknown() {
+	xxx_messageInfo_ICQVerification.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ICQVerification proto.InternalMessageInfo
+
+func (m *ICQVerification) GetEnabled() bool {
+	if m != nil {
+		return m.Enabled
+	}
+	return false
+}
+
+func (m *ICQVerification) GetConnectionId() string {
+	if m != nil {
+		return m.ConnectionId
+	}
+	return ""
+}
+
+func (m *ICQVerification) GetValidators() []string {
+	if m != nil {
+		return m.Validators
+	}
+	return nil
+}
+
 // DelegationRecords track the aggregate liquid stakes and delegations
 // for a given epoch
 // Note: There is an important assumption here that tokens in the deposit
@@ -306,7 +419,7 @@ func (m *DelegationRecord) Reset()         { *m = DelegationRecord{} }
 func (m *DelegationRecord) String() string { return proto.CompactTextString(m) }
 func (*DelegationRecord) ProtoMessage()    {}
 func (*DelegationRecord) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d78132ac6adfd885, []int{1}
+	return fileDescriptor_d78132ac6adfd885, []int{2}
 }
 func (m *DelegationRecord) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -378,7 +491,7 @@ func (m *UnbondingRecord) Reset()         { *m = UnbondingRecord{} }
 func (m *UnbondingRecord) String() string { return proto.CompactTextString(m) }
 func (*UnbondingRecord) ProtoMessage()    {}
 func (*UnbondingRecord) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d78132ac6adfd885, []int{2}
+	return fileDescriptor_d78132ac6adfd885, []int{3}
 }
 func (m *UnbondingRecord) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -458,7 +571,7 @@ func (m *RedemptionRecord) Reset()         { *m = RedemptionRecord{} }
 func (m *RedemptionRecord) String() string { return proto.CompactTextString(m) }
 func (*RedemptionRecord) ProtoMessage()    {}
 func (*RedemptionRecord) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d78132ac6adfd885, []int{3}
+	return fileDescriptor_d78132ac6adfd885, []int{4}
 }
 func (m *RedemptionRecord) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -518,7 +631,7 @@ func (m *SlashRecord) Reset()         { *m = SlashRecord{} }
 func (m *SlashRecord) String() string { return proto.CompactTextString(m) }
 func (*SlashRecord) ProtoMessage()    {}
 func (*SlashRecord) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d78132ac6adfd885, []int{4}
+	return fileDescriptor_d78132ac6adfd885, []int{5}
 }
 func (m *SlashRecord) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -568,90 +681,268 @@ func (m *SlashRecord) GetValidatorAddress() string {
 	return ""
 }
 
+// PendingConfirmations track operator actions that are awaiting the results of
+// the verification interchain queries
+type PendingConfirmation struct {
+	// Pending confirmation unique ID
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// The type of action being confirmed
+	ConfirmationType PendingConfirmationType `protobuf:"varint,2,opt,name=confirmation_type,json=confirmationType,proto3,enum=stride.stakedym.PendingConfirmationType" json:"confirmation_type,omitempty"`
+	// The delegation or unbonding record ID (unused for adjustments)
+	RecordId uint64 `protobuf:"varint,3,opt,name=record_id,json=recordId,proto3" json:"record_id,omitempty"`
+	// The tx hash submitted by the operator (unused for adjustments)
+	TxHash string `protobuf:"bytes,4,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`
+	// The address that submitted the confirmation
+	Sender string `protobuf:"bytes,5,opt,name=sender,proto3" json:"sender,omitempty"`
+	// The delegated balance offset (only used for adjustments)
+	DelegationOffset github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,6,opt,name=delegation_offset,json=delegationOffset,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"delegation_offset"`
+	// The validator that was slashed (only used for adjustments)
+	ValidatorAddress string `protobuf:"bytes,7,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// The number of queries that have not yet returned
+	OutstandingQueries uint64 `protobuf:"varint,8,opt,name=outstanding_queries,json=outstandingQueries,proto3" json:"outstanding_queries,omitempty"`
+	// The proven amount summed across each query response
+	ProvenAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,9,opt,name=proven_amount,json=provenAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"proven_amount"`
+}
+
+func (m *PendingConfirmation) Reset()         { *m = PendingConfirmation{} }
+func (m *PendingConfirmation) String() string { return proto.CompactTextString(m) }
+func (*PendingConfirmation) ProtoMessage()    {}
+func (*PendingConfirmation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d78132ac6adfd885, []int{6}
+}
+func (m *PendingConfirmation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PendingConfirmation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PendingConfirmation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PendingConfirmation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingConfirmation.Merge(m, src)
+}
+func (m *PendingConfirmation) XXX_Size() int {
+	return m.Size()
+}
+func (m *PendingConfirmation) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingConfirmation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PendingConfirmation proto.InternalMessageInfo
+
+func (m *PendingConfirmation) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *PendingConfirmation) GetConfirmationType() PendingConfirmationType {
+	if m != nil {
+		return m.ConfirmationType
+	}
+	return CONFIRM_DELEGATION
+}
+
+func (m *PendingConfirmation) GetRecordId() uint64 {
+	if m != nil {
+		return m.RecordId
+	}
+	return 0
+}
+
+func (m *PendingConfirmation) GetTxHash() string {
+	if m != nil {
+		return m.TxHash
+	}
+	return ""
+}
+
+func (m *PendingConfirmation) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *PendingConfirmation) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+func (m *PendingConfirmation) GetOutstandingQueries() uint64 {
+	if m != nil {
+		return m.OutstandingQueries
+	}
+	return 0
+}
+
+// Callback data for the verification interchain queries
+type PendingConfirmationQueryCallback struct {
+	// The ID of the pending confirmation that the query is verifying
+	PendingConfirmationId uint64 `protobuf:"varint,1,opt,name=pending_confirmation_id,json=pendingConfirmationId,proto3" json:"pending_confirmation_id,omitempty"`
+	// The validator's shares to tokens rate (only used for the delegation query)
+	SharesToTokensRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=shares_to_tokens_rate,json=sharesToTokensRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"shares_to_tokens_rate"`
+}
+
+func (m *PendingConfirmationQueryCallback) Reset()         { *m = PendingConfirmationQueryCallback{} }
+func (m *PendingConfirmationQueryCallback) String() string { return proto.CompactTextString(m) }
+func (*PendingConfirmationQueryCallback) ProtoMessage()    {}
+func (*PendingConfirmationQueryCallback) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d78132ac6adfd885, []int{7}
+}
+func (m *PendingConfirmationQueryCallback) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PendingConfirmationQueryCallback) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PendingConfirmationQueryCallback.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PendingConfirmationQueryCallback) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingConfirmationQueryCallback.Merge(m, src)
+}
+func (m *PendingConfirmationQueryCallback) XXX_Size() int {
+	return m.Size()
+}
+func (m *PendingConfirmationQueryCallback) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingConfirmationQueryCallback.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PendingConfirmationQueryCallback proto.InternalMessageInfo
+
+func (m *PendingConfirmationQueryCallback) GetPendingConfirmationId() uint64 {
+	if m != nil {
+		return m.PendingConfirmationId
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterEnum("stride.stakedym.DelegationRecordStatus", DelegationRecordStatus_name, DelegationRecordStatus_value)
 	proto.RegisterEnum("stride.stakedym.UnbondingRecordStatus", UnbondingRecordStatus_name, UnbondingRecordStatus_value)
+	proto.RegisterEnum("stride.stakedym.PendingConfirmationType", PendingConfirmationType_name, PendingConfirmationType_value)
 	proto.RegisterType((*HostZone)(nil), "stride.stakedym.HostZone")
+	proto.RegisterType((*ICQVerification)(nil), "stride.stakedym.ICQVerification")
 	proto.RegisterType((*DelegationRecord)(nil), "stride.stakedym.DelegationRecord")
 	proto.RegisterType((*UnbondingRecord)(nil), "stride.stakedym.UnbondingRecord")
 	proto.RegisterType((*RedemptionRecord)(nil), "stride.stakedym.RedemptionRecord")
 	proto.RegisterType((*SlashRecord)(nil), "stride.stakedym.SlashRecord")
+	proto.RegisterType((*PendingConfirmation)(nil), "stride.stakedym.PendingConfirmation")
+	proto.RegisterType((*PendingConfirmationQueryCallback)(nil), "stride.stakedym.PendingConfirmationQueryCallback")
 }
 
 func init() { proto.RegisterFile("stride/stakedym/stakedym.proto", fileDescriptor_d78132ac6adfd885) }
 
 var fileDescriptor_d78132ac6adfd885 = []byte{
-	// 1120 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x57, 0x4f, 0x6f, 0x1a, 0x47,
-	0x14, 0x67, 0x81, 0xd8, 0xe4, 0xc5, 0xc0, 0x32, 0x10, 0xb2, 0xb6, 0x22, 0x92, 0xfa, 0x90, 0x5a,
-	0x69, 0x8d, 0x2b, 0xa7, 0x87, 0x1e, 0xda, 0x44, 0x18, 0x36, 0xce, 0x4a, 0x18, 0xbb, 0x0b, 0xf4,
-	0x90, 0x1e, 0x56, 0xc3, 0xee, 0x04, 0x56, 0x66, 0x67, 0xd1, 0xce, 0x60, 0x13, 0xa9, 0xf7, 0x56,
-	0xea, 0xa5, 0x97, 0x7e, 0x82, 0x7e, 0x84, 0xe6, 0x43, 0x44, 0xea, 0x25, 0xcd, 0xa9, 0xea, 0x21,
-	0xaa, 0xec, 0x2f, 0x52, 0xed, 0xec, 0x1f, 0x30, 0xd8, 0x42, 0xa9, 0xb8, 0xf4, 0xc4, 0xce, 0xfb,
-	0xbd, 0xf7, 0xfb, 0xcd, 0x7b, 0x6f, 0x66, 0xdf, 0x02, 0x15, 0xc6, 0x3d, 0xdb, 0x22, 0x7b, 0x8c,
-	0xe3, 0x53, 0x62, 0xbd, 0x76, 0xe2, 0x87, 0xea, 0xc8, 0x73, 0xb9, 0x8b, 0xf2, 0x01, 0x5e, 0x8d,
-	0xcc, 0x5b, 0x9b, 0xa6, 0xcb, 0x1c, 0x97, 0x19, 0x02, 0xde, 0x0b, 0x16, 0x81, 0xef, 0x56, 0xa9,
-	0xef, 0xf6, 0xdd, 0xc0, 0xee, 0x3f, 0x05, 0xd6, 0xed, 0x1f, 0x37, 0x20, 0xf3, 0xc2, 0x65, 0xfc,
-	0xa5, 0x4b, 0x09, 0xda, 0x84, 0x8c, 0x39, 0xc0, 0x36, 0x35, 0x6c, 0x4b, 0x91, 0x1e, 0x4a, 0x3b,
-	0xb7, 0xf5, 0x75, 0xb1, 0xd6, 0x2c, 0xf4, 0x39, 0x20, 0x8a, 0xb9, 0x7d, 0x46, 0x0c, 0xee, 0x9e,
-	0x12, 0x6a, 0x58, 0x84, 0xba, 0x8e, 0x92, 0x14, 0x4e, 0x72, 0x80, 0x74, 0x7c, 0xa0, 0xe1, 0xdb,
-	0xd1, 0x13, 0x28, 0x5f, 0xf1, 0xb6, 0x7b, 0x66, 0x18, 0x91, 0x12, 0x11, 0xc5, 0x99, 0x08, 0xad,
-	0x67, 0x06, 0x41, 0x55, 0x28, 0x72, 0x0f, 0x53, 0xf6, 0x8a, 0x78, 0x86, 0x39, 0xc0, 0x94, 0x92,
-	0xa1, 0xbf, 0x91, 0xb4, 0x88, 0x28, 0x44, 0x50, 0x3d, 0x40, 0x34, 0x0b, 0x1d, 0x02, 0xb2, 0xc8,
-	0x90, 0xf4, 0x31, 0xb7, 0x5d, 0x6a, 0x60, 0xcb, 0xf2, 0x08, 0x63, 0xca, 0x2d, 0xdf, 0xfd, 0x40,
-	0x79, 0xff, 0x66, 0xb7, 0x14, 0xa6, 0x5f, 0x0b, 0x90, 0x36, 0xf7, 0x6c, 0xda, 0xd7, 0x0b, 0xd3,
-	0x98, 0x10, 0x40, 0xcf, 0x20, 0xe7, 0x91, 0x73, 0xec, 0x59, 0x31, 0xc9, 0xda, 0x12, 0x92, 0x6c,
-	0xe0, 0x1f, 0x11, 0xd4, 0x20, 0x6f, 0x91, 0x91, 0xcb, 0x6c, 0x1e, 0x33, 0xac, 0x2f, 0x61, 0xc8,
-	0x85, 0x01, 0x11, 0xc5, 0x21, 0x20, 0x8f, 0x58, 0xc4, 0x19, 0x5d, 0x49, 0x26, 0xb3, 0x2c, 0x99,
-	0x69, 0x4c, 0x44, 0xf4, 0x0d, 0x64, 0xcd, 0x21, 0xb6, 0x9d, 0x98, 0xe3, 0xf6, 0x12, 0x8e, 0x0d,
-	0xe1, 0x1e, 0x85, 0x77, 0x61, 0xcb, 0x1d, 0x11, 0x0f, 0x73, 0xd7, 0x8b, 0x18, 0x0c, 0x97, 0x1a,
-	0xc1, 0x39, 0x53, 0x60, 0x09, 0xd7, 0xbd, 0x28, 0x36, 0x34, 0x1f, 0xd3, 0xb6, 0x08, 0x44, 0x47,
-	0x50, 0x66, 0xf8, 0x15, 0xb9, 0x86, 0xf2, 0xce, 0x12, 0xca, 0xa2, 0x1f, 0x37, 0x4f, 0x47, 0xa1,
-	0x34, 0xc4, 0x8c, 0x1b, 0x33, 0x25, 0xf3, 0x30, 0x27, 0xca, 0x86, 0x20, 0xfb, 0xfa, 0xed, 0x87,
-	0x07, 0x89, 0xbf, 0x3f, 0x3c, 0x78, 0xd4, 0xb7, 0xf9, 0x60, 0xdc, 0xab, 0x9a, 0xae, 0x13, 0x5e,
-	0x85, 0xf0, 0x67, 0x97, 0x59, 0xa7, 0x7b, 0xfc, 0xf5, 0x88, 0xb0, 0x6a, 0x83, 0x98, 0xef, 0xdf,
-	0xec, 0x42, 0x28, 0xdd, 0x20, 0xa6, 0x8e, 0x7c, 0x66, 0x3d, 0x26, 0xd6, 0x31, 0x27, 0x88, 0x40,
-	0x7e, 0x5e, 0x2a, 0xbb, 0x02, 0xa9, 0x9c, 0x77, 0x55, 0x66, 0x08, 0x45, 0xc7, 0xa6, 0x0b, 0x59,
-	0xe5, 0x56, 0x20, 0x55, 0x70, 0x6c, 0xaa, 0x2f, 0xaa, 0xe1, 0xc9, 0x82, 0x5a, 0x7e, 0x25, 0x6a,
-	0x78, 0x32, 0xa7, 0x76, 0x0e, 0x9b, 0x7e, 0x6e, 0x36, 0xa5, 0xc4, 0x5b, 0xd0, 0x94, 0x57, 0xa0,
-	0x59, 0x76, 0x6c, 0xaa, 0xf9, 0xec, 0xd7, 0x08, 0xe3, 0xc9, 0x0d, 0xc2, 0x85, 0x95, 0x08, 0xe3,
-	0xc9, 0x75, 0xc2, 0xdf, 0x43, 0xf4, 0xae, 0x21, 0x96, 0xd1, 0xc3, 0x43, 0x4c, 0x4d, 0xa2, 0x20,
-	0x21, 0x58, 0xfd, 0x08, 0x41, 0x8d, 0x72, 0x5d, 0x8e, 0x89, 0x0e, 0x02, 0x1e, 0xf4, 0x15, 0x28,
-	0x63, 0xda, 0x73, 0xa9, 0x65, 0xd3, 0xbe, 0x31, 0x22, 0x9e, 0xed, 0x5a, 0x06, 0x23, 0xa6, 0x4b,
-	0x2d, 0xa6, 0x14, 0x1f, 0x4a, 0x3b, 0x69, 0xbd, 0x1c, 0xe3, 0x27, 0x02, 0x6e, 0x07, 0x28, 0x2a,
-	0xc3, 0xda, 0x00, 0x0f, 0x39, 0xb1, 0x94, 0xd2, 0x43, 0x69, 0x27, 0xa3, 0x87, 0xab, 0xed, 0x3f,
-	0x25, 0x90, 0x1b, 0xf1, 0xbb, 0x51, 0x27, 0xa6, 0xeb, 0x59, 0x28, 0x07, 0xc9, 0x70, 0x16, 0xa4,
-	0xf5, 0xa4, 0x6d, 0xa1, 0x36, 0x64, 0xc3, 0x17, 0x3b, 0x76, 0xdc, 0x31, 0xe5, 0xc1, 0x04, 0xf8,
-	0xe8, 0x7c, 0x36, 0x02, 0x92, 0x9a, 0xe0, 0x40, 0xcf, 0x60, 0x8d, 0x71, 0xcc, 0xc7, 0x4c, 0x4c,
-	0x87, 0xdc, 0xfe, 0xa7, 0xd5, 0xb9, 0xb1, 0x56, 0x9d, 0xdf, 0x57, 0x5b, 0xb8, 0xeb, 0x61, 0x18,
-	0xba, 0x07, 0xeb, 0x7c, 0x62, 0x0c, 0x30, 0x1b, 0x84, 0xd3, 0x62, 0x8d, 0x4f, 0x5e, 0x60, 0x36,
-	0xd8, 0xfe, 0x23, 0x05, 0xf9, 0x6e, 0x54, 0x86, 0x1b, 0x52, 0x7a, 0x1a, 0xab, 0x27, 0x85, 0xfa,
-	0xa3, 0x05, 0xf5, 0x39, 0x86, 0x39, 0xf1, 0xef, 0x20, 0xcf, 0x78, 0x38, 0xe7, 0xc2, 0xa2, 0xa4,
-	0xfe, 0x53, 0x51, 0xb2, 0x8c, 0x8b, 0x81, 0x18, 0x56, 0x65, 0xa1, 0xd4, 0xe9, 0x15, 0x94, 0x5a,
-	0x83, 0x4f, 0xa6, 0xc7, 0xc6, 0x74, 0x9d, 0xd1, 0x90, 0x88, 0xcb, 0xc0, 0x6d, 0x87, 0xc4, 0xe7,
-	0xe7, 0x96, 0xa8, 0x4d, 0x25, 0x76, 0xac, 0xc7, 0x7e, 0x1d, 0xdb, 0x21, 0xd1, 0x39, 0xfa, 0x02,
-	0x4a, 0x63, 0x3a, 0x33, 0x80, 0xa3, 0x0e, 0x88, 0xd9, 0xa9, 0xa3, 0x59, 0xac, 0x23, 0xba, 0x81,
-	0x9e, 0xc2, 0xfd, 0x80, 0x93, 0x58, 0x61, 0xbd, 0xd8, 0x39, 0x21, 0xa3, 0x38, 0x52, 0xcc, 0x4c,
-	0x5d, 0x89, 0x7c, 0x44, 0x31, 0xda, 0xbe, 0x47, 0x10, 0xbf, 0xfd, 0x73, 0x12, 0xe4, 0x99, 0x3b,
-	0x16, 0xb4, 0xb3, 0x0a, 0xc5, 0x69, 0x46, 0x9e, 0xb0, 0x19, 0x71, 0x7f, 0x0b, 0xe3, 0xab, 0xad,
-	0xd3, 0x2c, 0xb4, 0x05, 0x19, 0xff, 0x25, 0x40, 0x1c, 0xe2, 0x85, 0x9f, 0x2f, 0xf1, 0xfa, 0x7f,
-	0xd5, 0xca, 0xed, 0xdf, 0x25, 0xb8, 0xd3, 0x1e, 0x62, 0x36, 0xb8, 0xe1, 0x5c, 0x23, 0x48, 0xfb,
-	0x5d, 0x15, 0x49, 0xa6, 0x75, 0xf1, 0xbc, 0xb8, 0x91, 0xd4, 0x0a, 0xce, 0xd4, 0x67, 0x50, 0x38,
-	0xc3, 0x43, 0xdb, 0x9a, 0xfd, 0x66, 0x08, 0xef, 0xa1, 0x1c, 0x03, 0xe1, 0x04, 0x7f, 0xfc, 0x03,
-	0x94, 0xaf, 0xbf, 0xcc, 0x48, 0x81, 0x52, 0x47, 0xaf, 0xb5, 0xda, 0xcf, 0x55, 0xdd, 0xd0, 0x5a,
-	0xc6, 0x89, 0x7e, 0x7c, 0xa8, 0xab, 0xed, 0xb6, 0x9c, 0x40, 0x45, 0xc8, 0xc7, 0xc8, 0xf3, 0x9a,
-	0xd6, 0x54, 0x1b, 0xb2, 0x84, 0x4a, 0x20, 0x37, 0xd4, 0xa6, 0x7a, 0x58, 0xeb, 0x68, 0xc7, 0x2d,
-	0xe3, 0xdb, 0xae, 0xda, 0x55, 0xe5, 0x24, 0xba, 0x07, 0xc5, 0x19, 0x6b, 0xfd, 0xf8, 0xe8, 0xa4,
-	0xa9, 0x76, 0x54, 0x39, 0xb5, 0x95, 0xfe, 0xe9, 0xb7, 0x4a, 0xe2, 0xf1, 0xaf, 0x12, 0xdc, 0xbd,
-	0xf6, 0x36, 0xa3, 0xfb, 0xa0, 0xd4, 0xea, 0xf5, 0xee, 0x51, 0xb7, 0x59, 0xeb, 0x68, 0xad, 0x43,
-	0x43, 0x57, 0x1b, 0xea, 0xd1, 0x89, 0xcf, 0x12, 0xee, 0xa0, 0xdb, 0x3a, 0x38, 0x6e, 0x35, 0x7c,
-	0x28, 0xd0, 0x92, 0xd0, 0x26, 0xdc, 0x9d, 0x1a, 0x67, 0x77, 0x9c, 0x44, 0x1b, 0x90, 0x09, 0x20,
-	0xb5, 0x21, 0xa7, 0x50, 0x16, 0x6e, 0xd7, 0x9b, 0x35, 0xed, 0xa8, 0x76, 0xd0, 0x54, 0xe5, 0x34,
-	0xba, 0x03, 0xeb, 0x62, 0xa9, 0x36, 0xe4, 0x5b, 0xc1, 0xbe, 0x0e, 0x9a, 0x6f, 0x2f, 0x2a, 0xd2,
-	0xbb, 0x8b, 0x8a, 0xf4, 0xcf, 0x45, 0x45, 0xfa, 0xe5, 0xb2, 0x92, 0x78, 0x77, 0x59, 0x49, 0xfc,
-	0x75, 0x59, 0x49, 0xbc, 0xdc, 0x9f, 0x69, 0x49, 0xf0, 0xf1, 0xb3, 0xdb, 0xc4, 0x3d, 0xb6, 0x17,
-	0xfe, 0x31, 0x38, 0xdb, 0xff, 0x72, 0x6f, 0x32, 0xfd, 0x7b, 0x20, 0x5a, 0xd4, 0x5b, 0x13, 0x9f,
-	0xf6, 0x4f, 0xfe, 0x0d, 0x00, 0x00, 0xff, 0xff, 0xea, 0xde, 0x25, 0x08, 0x3e, 0x0c, 0x00, 0x00,
+	// 1450 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x57, 0xcd, 0x6e, 0xdb, 0xc6,
+	0x16, 0xb6, 0x7e, 0x62, 0xcb, 0xc7, 0x3f, 0xa2, 0x47, 0xfe, 0xa1, 0x7d, 0x03, 0xc5, 0xd7, 0x17,
+	0xc8, 0x35, 0x72, 0xaf, 0xe5, 0x0b, 0xe7, 0xe2, 0xe2, 0x2e, 0xda, 0x04, 0xb2, 0xc4, 0x38, 0x6c,
+	0x65, 0xd9, 0xa1, 0xa4, 0x2c, 0xd2, 0x05, 0x31, 0x22, 0xc7, 0x16, 0x61, 0x71, 0xa8, 0x70, 0x46,
+	0x8e, 0x0d, 0xf4, 0x01, 0x0a, 0x74, 0xd3, 0x4d, 0x9f, 0xa0, 0x8f, 0xd0, 0x3c, 0x44, 0x80, 0x6c,
+	0xd2, 0xa0, 0x8b, 0x22, 0x8b, 0xa0, 0x48, 0x5e, 0xa4, 0xe0, 0x0c, 0x49, 0xd1, 0x92, 0x0c, 0xa3,
+	0x86, 0x36, 0x5d, 0x49, 0x73, 0x7e, 0xbe, 0x6f, 0xe6, 0x9c, 0x33, 0xe7, 0x0c, 0xa1, 0xc8, 0xb8,
+	0xef, 0xd8, 0x64, 0x97, 0x71, 0x7c, 0x46, 0xec, 0x4b, 0x37, 0xfe, 0x53, 0xea, 0xf9, 0x1e, 0xf7,
+	0x50, 0x5e, 0xea, 0x4b, 0x91, 0x78, 0x63, 0xdd, 0xf2, 0x98, 0xeb, 0x31, 0x53, 0xa8, 0x77, 0xe5,
+	0x42, 0xda, 0x6e, 0x2c, 0x9f, 0x7a, 0xa7, 0x9e, 0x94, 0x07, 0xff, 0xa4, 0x74, 0xeb, 0xd7, 0x79,
+	0xc8, 0x3d, 0xf5, 0x18, 0x7f, 0xe1, 0x51, 0x82, 0xd6, 0x21, 0x67, 0x75, 0xb0, 0x43, 0x4d, 0xc7,
+	0x56, 0x53, 0x9b, 0xa9, 0xed, 0x59, 0x63, 0x46, 0xac, 0x75, 0x1b, 0xfd, 0x1b, 0x10, 0xc5, 0xdc,
+	0x39, 0x27, 0x26, 0xf7, 0xce, 0x08, 0x35, 0x6d, 0x42, 0x3d, 0x57, 0x4d, 0x0b, 0x23, 0x45, 0x6a,
+	0x9a, 0x81, 0xa2, 0x1a, 0xc8, 0xd1, 0x43, 0x58, 0xbd, 0x62, 0xed, 0xb4, 0xad, 0xd0, 0x23, 0x23,
+	0x3c, 0x0a, 0x09, 0x0f, 0xbd, 0x6d, 0x49, 0xa7, 0x12, 0x14, 0xb8, 0x8f, 0x29, 0x3b, 0x21, 0xbe,
+	0x69, 0x75, 0x30, 0xa5, 0xa4, 0x1b, 0x6c, 0x24, 0x2b, 0x3c, 0x96, 0x22, 0x55, 0x45, 0x6a, 0x74,
+	0x1b, 0x1d, 0x00, 0xb2, 0x49, 0x97, 0x9c, 0x62, 0xee, 0x78, 0xd4, 0xc4, 0xb6, 0xed, 0x13, 0xc6,
+	0xd4, 0x3b, 0x81, 0xf9, 0xbe, 0xfa, 0xfe, 0xf5, 0xce, 0x72, 0x78, 0xfc, 0xb2, 0xd4, 0x34, 0xb8,
+	0xef, 0xd0, 0x53, 0x63, 0x69, 0xe0, 0x13, 0x2a, 0xd0, 0x63, 0x58, 0xf4, 0xc9, 0x2b, 0xec, 0xdb,
+	0x31, 0xc8, 0xf4, 0x0d, 0x20, 0x0b, 0xd2, 0x3e, 0x02, 0x28, 0x43, 0xde, 0x26, 0x3d, 0x8f, 0x39,
+	0x3c, 0x46, 0x98, 0xb9, 0x01, 0x61, 0x31, 0x74, 0x88, 0x20, 0x0e, 0x00, 0xf9, 0xc4, 0x26, 0x6e,
+	0xef, 0xca, 0x61, 0x72, 0x37, 0x1d, 0x66, 0xe0, 0x13, 0x01, 0x7d, 0x09, 0x0b, 0x56, 0x17, 0x3b,
+	0x6e, 0x8c, 0x31, 0x7b, 0x03, 0xc6, 0xbc, 0x30, 0x8f, 0xdc, 0x5b, 0xb0, 0xe1, 0xf5, 0x88, 0x8f,
+	0xb9, 0xe7, 0x47, 0x08, 0xa6, 0x47, 0x4d, 0x59, 0x67, 0x2a, 0xdc, 0x80, 0xb5, 0x16, 0xf9, 0x86,
+	0xe2, 0x23, 0xda, 0x10, 0x8e, 0xe8, 0x10, 0x56, 0x19, 0x3e, 0x21, 0x63, 0x20, 0xe7, 0x6e, 0x80,
+	0x2c, 0x04, 0x7e, 0xc3, 0x70, 0x14, 0x96, 0xbb, 0x98, 0x71, 0x33, 0x11, 0x32, 0x1f, 0x73, 0xa2,
+	0xce, 0x0b, 0xb0, 0x2f, 0xde, 0x7c, 0xbc, 0x37, 0xf5, 0xe1, 0xe3, 0xbd, 0xfb, 0xa7, 0x0e, 0xef,
+	0xf4, 0xdb, 0x25, 0xcb, 0x73, 0xc3, 0xab, 0x10, 0xfe, 0xec, 0x30, 0xfb, 0x6c, 0x97, 0x5f, 0xf6,
+	0x08, 0x2b, 0x55, 0x89, 0xf5, 0xfe, 0xf5, 0x0e, 0x84, 0xd4, 0x55, 0x62, 0x19, 0x28, 0x40, 0x36,
+	0x62, 0x60, 0x03, 0x73, 0x82, 0x08, 0xe4, 0x87, 0xa9, 0x16, 0x26, 0x40, 0xb5, 0xe8, 0x5f, 0xa5,
+	0xe9, 0x42, 0xc1, 0x75, 0xe8, 0xc8, 0xa9, 0x16, 0x27, 0x40, 0xb5, 0xe4, 0x3a, 0xd4, 0x18, 0x65,
+	0xc3, 0x17, 0x23, 0x6c, 0xf9, 0x89, 0xb0, 0xe1, 0x8b, 0x21, 0xb6, 0x57, 0xb0, 0x1e, 0x9c, 0xcd,
+	0xa1, 0x94, 0xf8, 0x23, 0x9c, 0xca, 0x04, 0x38, 0x57, 0x5d, 0x87, 0xea, 0x01, 0xfa, 0x18, 0x62,
+	0x7c, 0x71, 0x0d, 0xf1, 0xd2, 0x44, 0x88, 0xf1, 0xc5, 0x38, 0xe2, 0x6f, 0x20, 0xea, 0x35, 0xc4,
+	0x36, 0xdb, 0xb8, 0x8b, 0xa9, 0x45, 0x54, 0x24, 0x08, 0x4b, 0x7f, 0x82, 0x50, 0xa7, 0xdc, 0x50,
+	0x62, 0xa0, 0x7d, 0x89, 0x83, 0xfe, 0x0f, 0x6a, 0x9f, 0xb6, 0x3d, 0x6a, 0x3b, 0xf4, 0xd4, 0xec,
+	0x11, 0xdf, 0xf1, 0x6c, 0x93, 0x11, 0xcb, 0xa3, 0x36, 0x53, 0x0b, 0x9b, 0xa9, 0xed, 0xac, 0xb1,
+	0x1a, 0xeb, 0x8f, 0x85, 0xba, 0x21, 0xb5, 0x68, 0x15, 0xa6, 0x3b, 0xb8, 0xcb, 0x89, 0xad, 0x2e,
+	0x6f, 0xa6, 0xb6, 0x73, 0x46, 0xb8, 0x42, 0x5f, 0x83, 0xe2, 0x58, 0x2f, 0xcd, 0x73, 0xe2, 0x3b,
+	0x27, 0x8e, 0x25, 0x1a, 0xa4, 0xba, 0xb2, 0x99, 0xda, 0x9e, 0xdb, 0xdb, 0x2c, 0x0d, 0x8d, 0x99,
+	0x92, 0x5e, 0x79, 0xf6, 0x3c, 0x61, 0x67, 0xe4, 0x1d, 0xeb, 0x65, 0x52, 0xb0, 0xd5, 0x83, 0xfc,
+	0x90, 0x0d, 0x52, 0x61, 0x86, 0x50, 0xdc, 0xee, 0x12, 0x39, 0x5b, 0x72, 0x46, 0xb4, 0x44, 0xff,
+	0x80, 0x05, 0xcb, 0xa3, 0x94, 0x58, 0x22, 0x2f, 0x8e, 0x1d, 0x8e, 0x95, 0xf9, 0x81, 0x50, 0xb7,
+	0x51, 0x11, 0xe0, 0x1c, 0x77, 0x1d, 0x3b, 0xe8, 0x2e, 0x4c, 0xcd, 0x6c, 0x66, 0xb6, 0x67, 0x8d,
+	0x84, 0x64, 0xeb, 0x97, 0x14, 0x28, 0xd5, 0xb8, 0xb5, 0x1b, 0xc4, 0xf2, 0x7c, 0x1b, 0x2d, 0x42,
+	0x3a, 0x1c, 0x65, 0x59, 0x23, 0xed, 0xd8, 0xa8, 0x01, 0x0b, 0xe1, 0x5c, 0xc2, 0xae, 0xd7, 0xa7,
+	0x5c, 0x4d, 0xdf, 0x2a, 0x1d, 0xf3, 0x12, 0xa4, 0x2c, 0x30, 0xd0, 0x63, 0x98, 0x66, 0x1c, 0xf3,
+	0x3e, 0x13, 0xc3, 0x6d, 0x71, 0xef, 0x9f, 0x23, 0xe1, 0x1a, 0xde, 0x57, 0x43, 0x98, 0x1b, 0xa1,
+	0x1b, 0x5a, 0x83, 0x19, 0x7e, 0x61, 0x76, 0x30, 0xeb, 0x84, 0xc3, 0x6e, 0x9a, 0x5f, 0x3c, 0xc5,
+	0xac, 0xb3, 0xf5, 0x36, 0x03, 0xf9, 0x56, 0x94, 0xc5, 0x6b, 0x8e, 0xf4, 0x28, 0x66, 0x4f, 0x0b,
+	0xf6, 0xfb, 0x23, 0xec, 0x43, 0x08, 0x43, 0xe4, 0xcf, 0x21, 0xcf, 0x78, 0x38, 0xa6, 0xc3, 0xa0,
+	0x64, 0x6e, 0x15, 0x94, 0x05, 0xc6, 0xc5, 0x3c, 0x0f, 0xa3, 0x32, 0x12, 0xea, 0xec, 0x04, 0x42,
+	0xad, 0xc3, 0xdf, 0x07, 0x55, 0x6f, 0x79, 0x6e, 0xaf, 0x4b, 0x44, 0xcd, 0x70, 0xc7, 0x25, 0x71,
+	0xf9, 0xdf, 0x11, 0xb1, 0x29, 0xc6, 0x86, 0x95, 0xd8, 0xae, 0xe9, 0xb8, 0x24, 0xba, 0x06, 0xff,
+	0x81, 0xe5, 0x3e, 0x4d, 0xbc, 0x1f, 0xa2, 0x0c, 0x88, 0xd1, 0x6f, 0xa0, 0xa4, 0xae, 0x29, 0xb2,
+	0x81, 0x1e, 0xc1, 0x5d, 0x89, 0x49, 0xec, 0x30, 0x5e, 0xec, 0x15, 0x21, 0xbd, 0xd8, 0x53, 0x8c,
+	0x7c, 0x43, 0x8d, 0x6c, 0x44, 0x30, 0x1a, 0x81, 0x85, 0xf4, 0xdf, 0xfa, 0x3e, 0x0d, 0x4a, 0xa2,
+	0x45, 0xc8, 0x74, 0x96, 0xa0, 0x30, 0x38, 0x91, 0x2f, 0x64, 0x66, 0x9c, 0xdf, 0xa5, 0xfe, 0xd5,
+	0xd4, 0xe9, 0x36, 0xda, 0x80, 0x5c, 0xd0, 0xc3, 0x88, 0x4b, 0xfc, 0xf0, 0x9a, 0xc4, 0xeb, 0xbf,
+	0x54, 0x2a, 0xb7, 0x7e, 0x4e, 0xc1, 0x5c, 0xa3, 0x8b, 0x59, 0xe7, 0x9a, 0xba, 0x46, 0x90, 0x0d,
+	0xb2, 0x2a, 0x0e, 0x99, 0x35, 0xc4, 0xff, 0xd1, 0x8d, 0x64, 0x26, 0x50, 0x53, 0xff, 0x82, 0xa5,
+	0xb8, 0x8d, 0xc4, 0x8f, 0x26, 0x79, 0x0f, 0x95, 0x58, 0x11, 0x3e, 0x40, 0xb6, 0x3e, 0x64, 0xa0,
+	0x70, 0x4c, 0xc2, 0xb2, 0xa2, 0x27, 0x8e, 0xef, 0xca, 0xe6, 0x36, 0xbc, 0xfb, 0x16, 0x2c, 0x59,
+	0x09, 0xbd, 0x19, 0x90, 0x87, 0x17, 0x74, 0x7b, 0xe4, 0x82, 0x8e, 0x01, 0x6c, 0x5e, 0xf6, 0x88,
+	0xa1, 0x58, 0x43, 0x12, 0xf4, 0x37, 0x98, 0x1d, 0xd4, 0x48, 0x46, 0xb0, 0xe5, 0xfc, 0xa8, 0x34,
+	0xae, 0x6b, 0x23, 0x41, 0xc7, 0x67, 0x84, 0xda, 0xc4, 0x97, 0x8f, 0x63, 0x23, 0x5c, 0x25, 0x06,
+	0x54, 0xb0, 0x45, 0xef, 0xe4, 0x84, 0x11, 0xae, 0x4e, 0xdf, 0x2a, 0xa4, 0xca, 0x00, 0xe8, 0x48,
+	0xe0, 0x8c, 0x0f, 0xeb, 0xcc, 0xf8, 0xb0, 0xa2, 0x5d, 0x28, 0x78, 0x7d, 0xce, 0x38, 0x96, 0xf7,
+	0xe0, 0x65, 0x9f, 0xf8, 0x0e, 0x91, 0xcf, 0xdf, 0xac, 0x81, 0x12, 0xaa, 0x67, 0x52, 0x13, 0x54,
+	0x42, 0xcf, 0xf7, 0xce, 0x07, 0x85, 0x3e, 0x7b, 0xbb, 0x4a, 0x90, 0x20, 0x61, 0x49, 0xbe, 0x4d,
+	0xc1, 0xe6, 0x98, 0x5c, 0x04, 0x9c, 0x97, 0x15, 0xdc, 0xed, 0xb6, 0xb1, 0x75, 0x86, 0xfe, 0x07,
+	0x6b, 0x3d, 0x12, 0x35, 0xa0, 0x44, 0x86, 0xe3, 0xf4, 0xaf, 0xf4, 0x46, 0x21, 0x74, 0x1b, 0x79,
+	0xb0, 0xc2, 0x3a, 0xd8, 0x27, 0xcc, 0xe4, 0x9e, 0xbc, 0xa3, 0x4c, 0x3e, 0x41, 0xd2, 0x93, 0x78,
+	0xb3, 0x4a, 0xe8, 0xa6, 0x27, 0xae, 0x2d, 0x0b, 0x9e, 0x1f, 0x0f, 0xbe, 0x85, 0xd5, 0xf1, 0x73,
+	0x07, 0xa9, 0xb0, 0xdc, 0x34, 0xca, 0xf5, 0xc6, 0x13, 0xcd, 0x30, 0xf5, 0xba, 0x79, 0x6c, 0x1c,
+	0x1d, 0x18, 0x5a, 0xa3, 0xa1, 0x4c, 0xa1, 0x02, 0xe4, 0x63, 0xcd, 0x93, 0xb2, 0x5e, 0xd3, 0xaa,
+	0x4a, 0x0a, 0x2d, 0x83, 0x52, 0xd5, 0x6a, 0xda, 0x41, 0xb9, 0xa9, 0x1f, 0xd5, 0xcd, 0x67, 0x2d,
+	0xad, 0xa5, 0x29, 0x69, 0xb4, 0x06, 0x85, 0x84, 0xb4, 0x72, 0x74, 0x78, 0x5c, 0xd3, 0x9a, 0x9a,
+	0x92, 0xd9, 0xc8, 0x7e, 0xf7, 0x53, 0x71, 0xea, 0xc1, 0x8f, 0x29, 0x58, 0x19, 0x3b, 0x78, 0xd0,
+	0x5d, 0x50, 0xcb, 0x95, 0x4a, 0xeb, 0xb0, 0x55, 0x2b, 0x37, 0xf5, 0xfa, 0x81, 0x69, 0x68, 0x55,
+	0xed, 0xf0, 0x38, 0x40, 0x09, 0x77, 0xd0, 0xaa, 0xef, 0x1f, 0xd5, 0xab, 0x81, 0x4a, 0x72, 0xa5,
+	0xd0, 0x3a, 0xac, 0x0c, 0x84, 0xc9, 0x1d, 0xa7, 0xd1, 0x3c, 0xe4, 0xa4, 0x4a, 0xab, 0x2a, 0x19,
+	0xb4, 0x00, 0xb3, 0x95, 0x5a, 0x59, 0x3f, 0x2c, 0xef, 0xd7, 0x34, 0x25, 0x8b, 0xe6, 0x60, 0x46,
+	0x2c, 0xb5, 0xaa, 0x72, 0x27, 0xdc, 0x97, 0x07, 0x6b, 0xd7, 0x5c, 0x37, 0xb4, 0x0a, 0xa8, 0x72,
+	0x54, 0x7f, 0xa2, 0x1b, 0x87, 0xe6, 0xe0, 0x64, 0xca, 0x54, 0x10, 0xae, 0x48, 0xde, 0xaa, 0x27,
+	0x34, 0x29, 0x71, 0x94, 0xea, 0x57, 0xad, 0x46, 0x33, 0x72, 0xd0, 0xaa, 0xe6, 0x7e, 0xb9, 0x56,
+	0xae, 0x57, 0x34, 0x25, 0x2d, 0x09, 0xf7, 0x6b, 0x6f, 0x3e, 0x15, 0x53, 0xef, 0x3e, 0x15, 0x53,
+	0xbf, 0x7f, 0x2a, 0xa6, 0x7e, 0xf8, 0x5c, 0x9c, 0x7a, 0xf7, 0xb9, 0x38, 0xf5, 0xdb, 0xe7, 0xe2,
+	0xd4, 0x8b, 0xbd, 0x44, 0xaa, 0xe5, 0x77, 0xcd, 0x4e, 0x0d, 0xb7, 0xd9, 0x6e, 0xf8, 0xcd, 0x7f,
+	0xbe, 0xf7, 0xdf, 0xdd, 0x8b, 0xc1, 0x97, 0xbf, 0x48, 0x7d, 0x7b, 0x5a, 0x7c, 0xb5, 0x3f, 0xfc,
+	0x63, 0x00, 0xda, 0x6e, 0x15, 0x5e, 0x19, 0x10, 0x00, 0x00,
 }
 
 func (m *HostZone) Marshal() (dAtA []byte, err error) {
@@ -674,6 +965,20 @@ func (m *HostZone) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.IcqVerification != nil {
+		{
+			size, err := m.IcqVerification.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintStakedym(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xaa
+	}
 	if m.Halted {
 		i--
 		if m.Halted {
@@ -849,6 +1154,55 @@ func (m *HostZone) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ICQVerification) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ICQVerification) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ICQVerification) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Validators) > 0 {
+		for iNdEx := len(m.Validators) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Validators[iNdEx])
+			copy(dAtA[i:], m.Validators[iNdEx])
+			i = encodeVarintStakedym(dAtA, i, uint64(len(m.Validators[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.ConnectionId) > 0 {
+		i -= len(m.ConnectionId)
+		copy(dAtA[i:], m.ConnectionId)
+		i = encodeVarintStakedym(dAtA, i, uint64(len(m.ConnectionId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Enabled {
+		i--
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *DelegationRecord) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1076,46 +1430,168 @@ func (m *SlashRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintStakedym(dAtA []byte, offset int, v uint64) int {
-	offset -= sovStakedym(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *PendingConfirmation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *HostZone) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *PendingConfirmation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PendingConfirmation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ChainId)
-	if l > 0 {
-		n += 1 + l + sovStakedym(uint64(l))
+	{
+		size := m.ProvenAmount.Size()
+		i -= size
+		if _, err := m.ProvenAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStakedym(dAtA, i, uint64(size))
 	}
-	l = len(m.NativeTokenDenom)
-	if l > 0 {
-		n += 1 + l + sovStakedym(uint64(l))
+	i--
+	dAtA[i] = 0x4a
+	if m.OutstandingQueries != 0 {
+		i = encodeVarintStakedym(dAtA, i, uint64(m.OutstandingQueries))
+		i--
+		dAtA[i] = 0x40
 	}
-	l = len(m.NativeTokenIbcDenom)
-	if l > 0 {
-		n += 1 + l + sovStakedym(uint64(l))
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintStakedym(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x3a
 	}
-	l = len(m.TransferChannelId)
-	if l > 0 {
-		n += 1 + l + sovStakedym(uint64(l))
+	{
+		size := m.DelegationOffset.Size()
+		i -= size
+		if _, err := m.DelegationOffset.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStakedym(dAtA, i, uint64(size))
 	}
-	l = len(m.DelegationAddress)
-	if l > 0 {
-		n += 1 + l + sovStakedym(uint64(l))
+	i--
+	dAtA[i] = 0x32
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintStakedym(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x2a
 	}
-	l = len(m.RewardAddress)
-	if l > 0 {
-		n += 1 + l + sovStakedym(uint64(l))
+	if len(m.TxHash) > 0 {
+		i -= len(m.TxHash)
+		copy(dAtA[i:], m.TxHash)
+		i = encodeVarintStakedym(dAtA, i, uint64(len(m.TxHash)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.RecordId != 0 {
+		i = encodeVarintStakedym(dAtA, i, uint64(m.RecordId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.ConfirmationType != 0 {
+		i = encodeVarintStakedym(dAtA, i, uint64(m.ConfirmationType))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Id != 0 {
+		i = encodeVarintStakedym(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PendingConfirmationQueryCallback) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PendingConfirmationQueryCallback) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PendingConfirmationQueryCallback) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.SharesToTokensRate.Size()
+		i -= size
+		if _, err := m.SharesToTokensRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStakedym(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.PendingConfirmationId != 0 {
+		i = encodeVarintStakedym(dAtA, i, uint64(m.PendingConfirmationId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintStakedym(dAtA []byte, offset int, v uint64) int {
+	offset -= sovStakedym(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *HostZone) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovStakedym(uint64(l))
+	}
+	l = len(m.NativeTokenDenom)
+	if l > 0 {
+		n += 1 + l + sovStakedym(uint64(l))
+	}
+	l = len(m.NativeTokenIbcDenom)
+	if l > 0 {
+		n += 1 + l + sovStakedym(uint64(l))
+	}
+	l = len(m.TransferChannelId)
+	if l > 0 {
+		n += 1 + l + sovStakedym(uint64(l))
+	}
+	l = len(m.DelegationAddress)
+	if l > 0 {
+		n += 1 + l + sovStakedym(uint64(l))
+	}
+	l = len(m.RewardAddress)
+	if l > 0 {
+		n += 1 + l + sovStakedym(uint64(l))
 	}
 	l = len(m.DepositAddress)
 	if l > 0 {
@@ -1157,6 +1633,32 @@ func (m *HostZone) Size() (n int) {
 	if m.Halted {
 		n += 3
 	}
+	if m.IcqVerification != nil {
+		l = m.IcqVerification.Size()
+		n += 2 + l + sovStakedym(uint64(l))
+	}
+	return n
+}
+
+func (m *ICQVerification) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Enabled {
+		n += 2
+	}
+	l = len(m.ConnectionId)
+	if l > 0 {
+		n += 1 + l + sovStakedym(uint64(l))
+	}
+	if len(m.Validators) > 0 {
+		for _, s := range m.Validators {
+			l = len(s)
+			n += 1 + l + sovStakedym(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1252,6 +1754,57 @@ func (m *SlashRecord) Size() (n int) {
 	return n
 }
 
+func (m *PendingConfirmation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovStakedym(uint64(m.Id))
+	}
+	if m.ConfirmationType != 0 {
+		n += 1 + sovStakedym(uint64(m.ConfirmationType))
+	}
+	if m.RecordId != 0 {
+		n += 1 + sovStakedym(uint64(m.RecordId))
+	}
+	l = len(m.TxHash)
+	if l > 0 {
+		n += 1 + l + sovStakedym(uint64(l))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovStakedym(uint64(l))
+	}
+	l = m.DelegationOffset.Size()
+	n += 1 + l + sovStakedym(uint64(l))
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovStakedym(uint64(l))
+	}
+	if m.OutstandingQueries != 0 {
+		n += 1 + sovStakedym(uint64(m.OutstandingQueries))
+	}
+	l = m.ProvenAmount.Size()
+	n += 1 + l + sovStakedym(uint64(l))
+	return n
+}
+
+func (m *PendingConfirmationQueryCallback) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PendingConfirmationId != 0 {
+		n += 1 + sovStakedym(uint64(m.PendingConfirmationId))
+	}
+	l = m.SharesToTokensRate.Size()
+	n += 1 + l + sovStakedym(uint64(l))
+	return n
+}
+
 func sovStakedym(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1916,6 +2469,42 @@ func (m *HostZone) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.Halted = bool(v != 0)
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IcqVerification", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStakedym
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStakedym
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStakedym
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.IcqVerification == nil {
+				m.IcqVerification = &ICQVerification{}
+			}
+			if err := m.IcqVerification.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipStakedym(dAtA[iNdEx:])
@@ -1937,7 +2526,7 @@ func (m *HostZone) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DelegationRecord) Unmarshal(dAtA []byte) error {
+func (m *ICQVerification) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1960,17 +2549,17 @@ func (m *DelegationRecord) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DelegationRecord: wiretype end group for non-group")
+			return fmt.Errorf("proto: ICQVerification: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DelegationRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ICQVerification: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
 			}
-			m.Id = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStakedym
@@ -1980,14 +2569,15 @@ func (m *DelegationRecord) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Id |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.Enabled = bool(v != 0)
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NativeAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2015,32 +2605,11 @@ func (m *DelegationRecord) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.NativeAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ConnectionId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
-			}
-			m.Status = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowStakedym
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Status |= DelegationRecordStatus(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TxHash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Validators", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2068,7 +2637,7 @@ func (m *DelegationRecord) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TxHash = string(dAtA[iNdEx:postIndex])
+			m.Validators = append(m.Validators, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2091,7 +2660,7 @@ func (m *DelegationRecord) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UnbondingRecord) Unmarshal(dAtA []byte) error {
+func (m *DelegationRecord) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2114,10 +2683,10 @@ func (m *UnbondingRecord) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UnbondingRecord: wiretype end group for non-group")
+			return fmt.Errorf("proto: DelegationRecord: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UnbondingRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DelegationRecord: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2140,27 +2709,8 @@ func (m *UnbondingRecord) Unmarshal(dAtA []byte) error {
 				}
 			}
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
-			}
-			m.Status = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowStakedym
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Status |= UnbondingRecordStatus(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StTokenAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NativeAmount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2188,13 +2738,32 @@ func (m *UnbondingRecord) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.StTokenAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.NativeAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStakedym
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= DelegationRecordStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NativeAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TxHash", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2222,10 +2791,164 @@ func (m *UnbondingRecord) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.NativeAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.TxHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStakedym(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStakedym
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UnbondingRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStakedym
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UnbondingRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UnbondingRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStakedym
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStakedym
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= UnbondingRecordStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StTokenAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStakedym
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStakedym
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStakedym
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.StTokenAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NativeAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStakedym
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStakedym
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStakedym
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.NativeAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 5:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingCompletionTimeSeconds", wireType)
@@ -2653,6 +3376,399 @@ func (m *SlashRecord) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *PendingConfirmation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStakedym
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PendingConfirmation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PendingConfirmation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStakedym
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConfirmationType", wireType)
+			}
+			m.ConfirmationType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStakedym
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ConfirmationType |= PendingConfirmationType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordId", wireType)
+			}
+			m.RecordId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStakedym
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RecordId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStakedym
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStakedym
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStakedym
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStakedym
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStakedym
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStakedym
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegationOffset", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStakedym
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStakedym
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStakedym
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DelegationOffset.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStakedym
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStakedym
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStakedym
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutstandingQueries", wireType)
+			}
+			m.OutstandingQueries = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStakedym
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OutstandingQueries |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProvenAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStakedym
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStakedym
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStakedym
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ProvenAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStakedym(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStakedym
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PendingConfirmationQueryCallback) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStakedym
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PendingConfirmationQueryCallback: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PendingConfirmationQueryCallback: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingConfirmationId", wireType)
+			}
+			m.PendingConfirmationId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStakedym
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PendingConfirmationId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SharesToTokensRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStakedym
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStakedym
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStakedym
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SharesToTokensRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStakedym(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStakedym
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipStakedym(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
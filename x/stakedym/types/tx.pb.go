@@ -1227,6 +1227,200 @@ func (m *MsgSetOperatorAddressResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgSetOperatorAddressResponse proto.InternalMessageInfo
 
+// SetICQVerification
+type MsgSetICQVerification struct {
+	Signer       string   `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	Enabled      bool     `protobuf:"varint,2,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	ConnectionId string   `protobuf:"bytes,3,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty"`
+	Validators   []string `protobuf:"bytes,4,rep,name=validators,proto3" json:"validators,omitempty"`
+}
+
+func (m *MsgSetICQVerification) Reset()         { *m = MsgSetICQVerification{} }
+func (m *MsgSetICQVerification) String() string { return proto.CompactTextString(m) }
+func (*MsgSetICQVerification) ProtoMessage()    {}
+func (*MsgSetICQVerification) Descriptor() ([]byte, []int) {
+	return fileDescriptor_85c52040fb1554a8, []int{26}
+}
+func (m *MsgSetICQVerification) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetICQVerification) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetICQVerification.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetICQVerification) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetICQVerification.Merge(m, src)
+}
+func (m *MsgSetICQVerification) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetICQVerification) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetICQVerification.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetICQVerification proto.InternalMessageInfo
+
+func (m *MsgSetICQVerification) GetSigner() string {
+	if m != nil {
+		return m.Signer
+	}
+	return ""
+}
+
+func (m *MsgSetICQVerification) GetEnabled() bool {
+	if m != nil {
+		return m.Enabled
+	}
+	return false
+}
+
+func (m *MsgSetICQVerification) GetConnectionId() string {
+	if m != nil {
+		return m.ConnectionId
+	}
+	return ""
+}
+
+func (m *MsgSetICQVerification) GetValidators() []string {
+	if m != nil {
+		return m.Validators
+	}
+	return nil
+}
+
+type MsgSetICQVerificationResponse struct {
+}
+
+func (m *MsgSetICQVerificationResponse) Reset()         { *m = MsgSetICQVerificationResponse{} }
+func (m *MsgSetICQVerificationResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetICQVerificationResponse) ProtoMessage()    {}
+func (*MsgSetICQVerificationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_85c52040fb1554a8, []int{27}
+}
+func (m *MsgSetICQVerificationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetICQVerificationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetICQVerificationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetICQVerificationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetICQVerificationResponse.Merge(m, src)
+}
+func (m *MsgSetICQVerificationResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetICQVerificationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetICQVerificationResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetICQVerificationResponse proto.InternalMessageInfo
+
+// ClearPendingConfirmation
+type MsgClearPendingConfirmation struct {
+	Signer                string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	PendingConfirmationId uint64 `protobuf:"varint,2,opt,name=pending_confirmation_id,json=pendingConfirmationId,proto3" json:"pending_confirmation_id,omitempty"`
+}
+
+func (m *MsgClearPendingConfirmation) Reset()         { *m = MsgClearPendingConfirmation{} }
+func (m *MsgClearPendingConfirmation) String() string { return proto.CompactTextString(m) }
+func (*MsgClearPendingConfirmation) ProtoMessage()    {}
+func (*MsgClearPendingConfirmation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_85c52040fb1554a8, []int{28}
+}
+func (m *MsgClearPendingConfirmation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgClearPendingConfirmation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgClearPendingConfirmation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgClearPendingConfirmation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgClearPendingConfirmation.Merge(m, src)
+}
+func (m *MsgClearPendingConfirmation) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgClearPendingConfirmation) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgClearPendingConfirmation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgClearPendingConfirmation proto.InternalMessageInfo
+
+func (m *MsgClearPendingConfirmation) GetSigner() string {
+	if m != nil {
+		return m.Signer
+	}
+	return ""
+}
+
+func (m *MsgClearPendingConfirmation) GetPendingConfirmationId() uint64 {
+	if m != nil {
+		return m.PendingConfirmationId
+	}
+	return 0
+}
+
+type MsgClearPendingConfirmationResponse struct {
+}
+
+func (m *MsgClearPendingConfirmationResponse) Reset()         { *m = MsgClearPendingConfirmationResponse{} }
+func (m *MsgClearPendingConfirmationResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgClearPendingConfirmationResponse) ProtoMessage()    {}
+func (*MsgClearPendingConfirmationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_85c52040fb1554a8, []int{29}
+}
+func (m *MsgClearPendingConfirmationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgClearPendingConfirmationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgClearPendingConfirmationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgClearPendingConfirmationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgClearPendingConfirmationResponse.Merge(m, src)
+}
+func (m *MsgClearPendingConfirmationResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgClearPendingConfirmationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgClearPendingConfirmationResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgClearPendingConfirmationResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterEnum("stride.stakedym.OverwritableRecordType", OverwritableRecordType_name, OverwritableRecordType_value)
 	proto.RegisterType((*MsgLiquidStake)(nil), "stride.stakedym.MsgLiquidStake")
@@ -1255,97 +1449,110 @@ func init() {
 	proto.RegisterType((*MsgOverwriteRedemptionRecordResponse)(nil), "stride.stakedym.MsgOverwriteRedemptionRecordResponse")
 	proto.RegisterType((*MsgSetOperatorAddress)(nil), "stride.stakedym.MsgSetOperatorAddress")
 	proto.RegisterType((*MsgSetOperatorAddressResponse)(nil), "stride.stakedym.MsgSetOperatorAddressResponse")
+	proto.RegisterType((*MsgSetICQVerification)(nil), "stride.stakedym.MsgSetICQVerification")
+	proto.RegisterType((*MsgSetICQVerificationResponse)(nil), "stride.stakedym.MsgSetICQVerificationResponse")
+	proto.RegisterType((*MsgClearPendingConfirmation)(nil), "stride.stakedym.MsgClearPendingConfirmation")
+	proto.RegisterType((*MsgClearPendingConfirmationResponse)(nil), "stride.stakedym.MsgClearPendingConfirmationResponse")
 }
 
 func init() { proto.RegisterFile("stride/stakedym/tx.proto", fileDescriptor_85c52040fb1554a8) }
 
 var fileDescriptor_85c52040fb1554a8 = []byte{
-	// 1356 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x58, 0xcf, 0x8f, 0xd3, 0xc6,
-	0x17, 0x8f, 0x17, 0xc4, 0x8f, 0xc9, 0x17, 0xc8, 0xfa, 0x0b, 0x21, 0x31, 0x25, 0x59, 0x0c, 0x2c,
-	0x10, 0x58, 0xbb, 0x1b, 0xa0, 0x95, 0xd2, 0x5e, 0x36, 0x24, 0x82, 0x55, 0x37, 0x1b, 0x94, 0xdd,
-	0x2d, 0x2a, 0x3d, 0xb8, 0x4e, 0x3c, 0xeb, 0xb8, 0xac, 0x3d, 0xc1, 0x33, 0x09, 0x41, 0x95, 0xaa,
-	0xb6, 0x27, 0xd4, 0x53, 0xa5, 0x9e, 0x7a, 0xe8, 0xa9, 0x52, 0xa5, 0xf6, 0x52, 0x0e, 0xbd, 0xf5,
-	0xd2, 0x23, 0x47, 0xd4, 0x53, 0xd5, 0x03, 0xad, 0xe0, 0x80, 0xfa, 0x0f, 0xb4, 0xd7, 0xca, 0x3f,
-	0x19, 0xdb, 0xe3, 0x24, 0xd0, 0xee, 0x65, 0x13, 0xcf, 0xfb, 0xcc, 0x7b, 0x9f, 0xf7, 0x79, 0xe3,
-	0x37, 0x6f, 0x03, 0x0a, 0x98, 0xd8, 0x86, 0x06, 0x65, 0x4c, 0xd4, 0x3b, 0x50, 0xbb, 0x6f, 0xca,
-	0x64, 0x2c, 0x0d, 0x6c, 0x44, 0x10, 0x7f, 0xc4, 0xb3, 0x48, 0x81, 0x45, 0x38, 0xde, 0x43, 0xd8,
-	0x44, 0x58, 0x36, 0xb1, 0x2e, 0x8f, 0x96, 0x9d, 0x0f, 0x0f, 0x29, 0xcc, 0xab, 0xa6, 0x61, 0x21,
-	0xd9, 0xfd, 0xeb, 0x2f, 0x1d, 0xd5, 0x91, 0x8e, 0xdc, 0xaf, 0xb2, 0xf3, 0xcd, 0x5f, 0x2d, 0xf9,
-	0x1e, 0xba, 0x2a, 0x86, 0xf2, 0x68, 0xb9, 0x0b, 0x89, 0xba, 0x2c, 0xf7, 0x90, 0x61, 0xf9, 0xf6,
-	0xa2, 0x67, 0x57, 0xbc, 0x8d, 0xde, 0x43, 0xb0, 0x35, 0xce, 0x33, 0xf8, 0xe2, 0xd9, 0xc5, 0x6f,
-	0x39, 0x70, 0xb8, 0x85, 0xf5, 0x35, 0xe3, 0xee, 0xd0, 0xd0, 0x36, 0x1c, 0x1b, 0x9f, 0x07, 0xfb,
-	0x5c, 0x90, 0x5d, 0xe0, 0x16, 0xb8, 0xf3, 0x07, 0x3b, 0xfe, 0x13, 0xbf, 0x01, 0x0e, 0x59, 0x2a,
-	0x31, 0x46, 0x50, 0x51, 0x4d, 0x34, 0xb4, 0x48, 0x61, 0xce, 0x31, 0xd7, 0xa5, 0x47, 0x4f, 0xca,
-	0x99, 0xdf, 0x9e, 0x94, 0x17, 0x75, 0x83, 0xf4, 0x87, 0x5d, 0xa9, 0x87, 0x4c, 0x9f, 0x82, 0xff,
-	0xb1, 0x84, 0xb5, 0x3b, 0x32, 0xb9, 0x3f, 0x80, 0x58, 0x5a, 0xb5, 0x48, 0xe7, 0x7f, 0x9e, 0x93,
-	0x15, 0xd7, 0x47, 0xed, 0xdc, 0x67, 0xcf, 0x1f, 0x56, 0xfc, 0x08, 0x9f, 0x3f, 0x7f, 0x58, 0x39,
-	0x1e, 0x12, 0x8d, 0xb2, 0x12, 0x3f, 0xe1, 0x40, 0x3e, 0xba, 0xd4, 0x81, 0x78, 0x80, 0x2c, 0x0c,
-	0xf9, 0x6d, 0x70, 0x00, 0x13, 0x85, 0xa0, 0x3b, 0xd0, 0x72, 0x29, 0x67, 0xab, 0x45, 0xc9, 0x17,
-	0xc1, 0x51, 0x4c, 0xf2, 0x15, 0x93, 0xae, 0x21, 0xc3, 0xaa, 0xbf, 0xee, 0xd0, 0xfd, 0xfe, 0xf7,
-	0xf2, 0xf9, 0x19, 0xe8, 0x3a, 0x1b, 0x70, 0x67, 0x3f, 0x26, 0x9b, 0x8e, 0x6f, 0xf1, 0x07, 0x4f,
-	0xab, 0x0e, 0xd4, 0x20, 0x34, 0x3d, 0xad, 0x04, 0x70, 0xc0, 0x76, 0x1f, 0x43, 0xb5, 0xc2, 0x67,
-	0xfe, 0x5d, 0x70, 0x24, 0xa0, 0xf5, 0xef, 0x14, 0x3b, 0xe4, 0x13, 0xf0, 0x25, 0xbb, 0xe0, 0x48,
-	0x16, 0x86, 0x49, 0x88, 0x46, 0xd1, 0x13, 0x1f, 0x78, 0xa2, 0x51, 0x4b, 0xa1, 0x68, 0x16, 0xf0,
-	0x0b, 0xb1, 0x7b, 0xc2, 0x65, 0xbd, 0x00, 0x9e, 0x78, 0x5f, 0x71, 0xe0, 0x68, 0x0b, 0xeb, 0xd7,
-	0x90, 0xb5, 0x6d, 0xd8, 0x66, 0x03, 0xee, 0x40, 0x5d, 0x25, 0x06, 0xb2, 0x1c, 0x09, 0xd1, 0x00,
-	0xda, 0x2a, 0x41, 0xa1, 0x84, 0xc1, 0x33, 0x7f, 0x02, 0x1c, 0xb4, 0x61, 0x0f, 0xd9, 0x9a, 0x62,
-	0x68, 0xae, 0x78, 0x7b, 0x1d, 0x7d, 0x9d, 0x85, 0x55, 0x8d, 0x3f, 0x0e, 0xf6, 0x93, 0xb1, 0xd2,
-	0x57, 0x71, 0xbf, 0xb0, 0xc7, 0x3b, 0xa8, 0x64, 0x7c, 0x43, 0xc5, 0xfd, 0x9a, 0xec, 0x0a, 0x14,
-	0x38, 0x71, 0x04, 0x3a, 0x49, 0x0b, 0x94, 0xa0, 0x20, 0x96, 0xc0, 0x6b, 0xac, 0xf5, 0x40, 0x2b,
-	0xf1, 0x6b, 0x4f, 0x46, 0x1f, 0xb0, 0x65, 0x69, 0xbb, 0xc9, 0x7e, 0x39, 0xc1, 0xbe, 0xcc, 0x60,
-	0x4f, 0x93, 0x10, 0x17, 0x40, 0x89, 0x6d, 0x09, 0x33, 0xf8, 0x8e, 0xa3, 0x53, 0xdc, 0xb2, 0xba,
-	0xc8, 0xd2, 0xa0, 0xe6, 0x56, 0x66, 0xe3, 0x1e, 0x84, 0x83, 0x5d, 0xc8, 0xe3, 0xcd, 0x44, 0x1e,
-	0x67, 0x99, 0x79, 0xc4, 0xa9, 0x88, 0x8b, 0xe0, 0xcc, 0x24, 0x7b, 0x98, 0xd3, 0xdf, 0x1c, 0x28,
-	0xb6, 0xb0, 0xbe, 0xa2, 0x7d, 0x38, 0xc4, 0xc4, 0xaf, 0x1a, 0xd4, 0xea, 0xea, 0x8e, 0x6a, 0xf5,
-	0xe0, 0xc4, 0x84, 0xde, 0x07, 0xf3, 0x2f, 0x34, 0x52, 0xd0, 0xf6, 0x36, 0x86, 0xaf, 0xfa, 0x6e,
-	0xe6, 0x5e, 0x38, 0x6a, 0xbb, 0x7e, 0xf8, 0x8b, 0x60, 0x7e, 0xa4, 0xee, 0x18, 0x9a, 0x13, 0x49,
-	0x51, 0x35, 0xcd, 0x86, 0x18, 0xfb, 0xd2, 0xe4, 0x42, 0xc3, 0x8a, 0xb7, 0x5e, 0xbb, 0x92, 0x10,
-	0x49, 0xa4, 0x45, 0x62, 0xe7, 0x26, 0x9e, 0x06, 0xa7, 0x52, 0x8d, 0xa1, 0x3c, 0x7f, 0xce, 0x01,
-	0xb1, 0x85, 0xf5, 0xad, 0x81, 0xa6, 0x12, 0xb8, 0x6a, 0x59, 0xd0, 0x76, 0xda, 0x80, 0x39, 0x70,
-	0xcf, 0x85, 0x4a, 0x60, 0x1d, 0x0d, 0x2d, 0x0d, 0xf3, 0x05, 0xb0, 0xbf, 0x67, 0x43, 0x4a, 0xa6,
-	0xe0, 0x91, 0xbf, 0x07, 0x8a, 0xa6, 0x61, 0x29, 0x86, 0xb3, 0x55, 0xb1, 0xc3, 0xbd, 0x8a, 0xad,
-	0x12, 0xe8, 0xab, 0xf5, 0xf6, 0x4b, 0xa8, 0xd5, 0x80, 0xbd, 0x5f, 0x7e, 0x5c, 0x02, 0x7e, 0x7f,
-	0x69, 0xc0, 0x5e, 0x27, 0x6f, 0x1a, 0x16, 0x83, 0x98, 0x1b, 0x58, 0x1d, 0xa7, 0x04, 0xde, 0xf3,
-	0x9f, 0x04, 0x56, 0xc7, 0x8c, 0xc0, 0xde, 0x91, 0x0d, 0xf2, 0x77, 0x8a, 0xb1, 0x48, 0x17, 0xc3,
-	0x53, 0x92, 0x25, 0xa2, 0x78, 0x09, 0x54, 0xa6, 0x4b, 0x1d, 0x56, 0xe6, 0x36, 0x98, 0x77, 0x9b,
-	0x32, 0x1e, 0x9a, 0xf0, 0x06, 0xc2, 0xe4, 0x36, 0xb2, 0x60, 0x7a, 0x1d, 0x6a, 0x17, 0xe3, 0xac,
-	0x84, 0x68, 0xbb, 0xa7, 0xdd, 0x88, 0x27, 0xdc, 0x77, 0x22, 0xba, 0x18, 0x06, 0xee, 0x83, 0x82,
-	0x6b, 0xdc, 0xb6, 0x21, 0xee, 0xc7, 0x44, 0x4f, 0x8f, 0x5f, 0x8d, 0xc7, 0x3f, 0x15, 0x8d, 0xcf,
-	0xf0, 0x26, 0x8a, 0x60, 0x21, 0xcd, 0x16, 0xb2, 0xf9, 0xd9, 0xeb, 0x49, 0xed, 0x11, 0xb4, 0xef,
-	0xd9, 0x06, 0x81, 0x74, 0xe3, 0x75, 0x9a, 0xcb, 0x84, 0xa3, 0xb9, 0x1e, 0x79, 0x81, 0xbd, 0x5e,
-	0xe4, 0x1e, 0xc9, 0x6c, 0xf5, 0x94, 0x14, 0x9b, 0xbf, 0xa4, 0xb8, 0x5f, 0xfa, 0x9d, 0xf5, 0x56,
-	0x6a, 0x6f, 0xc4, 0x53, 0x8c, 0xb4, 0xaa, 0x54, 0x86, 0x7e, 0xab, 0x4a, 0xb5, 0x87, 0xa9, 0xfe,
-	0xc4, 0x81, 0x13, 0x34, 0xd0, 0xeb, 0x6a, 0x86, 0xa5, 0x4f, 0xcd, 0xf4, 0x1d, 0x90, 0x1b, 0x06,
-	0xe0, 0x68, 0xa2, 0x0b, 0x89, 0x44, 0x63, 0x5e, 0x3b, 0x47, 0x86, 0xd1, 0x85, 0xda, 0xd5, 0x78,
-	0x9a, 0x67, 0x98, 0x69, 0xc6, 0xfc, 0x88, 0x67, 0xc1, 0xe9, 0x09, 0xe6, 0xd4, 0x7a, 0x52, 0x65,
-	0x9f, 0xa1, 0x9e, 0xf4, 0x7b, 0x3e, 0xb9, 0x9e, 0x71, 0xbf, 0x9d, 0x9c, 0x1d, 0x5b, 0x99, 0xb5,
-	0x9e, 0x71, 0x4f, 0xf1, 0x7a, 0x26, 0x22, 0x05, 0xa9, 0x7e, 0x04, 0x8e, 0xb5, 0xb0, 0xbe, 0x01,
-	0x49, 0xdb, 0xef, 0xdc, 0x7e, 0x3f, 0x77, 0x67, 0x67, 0x43, 0xb7, 0xa8, 0xd9, 0xd9, 0x7d, 0x8a,
-	0xdc, 0x46, 0x73, 0xd1, 0xdb, 0xa8, 0x26, 0x79, 0x23, 0xb0, 0x0b, 0x74, 0xb8, 0x96, 0x68, 0xae,
-	0xc9, 0x18, 0x62, 0x19, 0x9c, 0x64, 0x1a, 0x02, 0x76, 0x95, 0xbb, 0x20, 0x1f, 0xa4, 0xa0, 0x76,
-	0x77, 0xa0, 0xc7, 0x7d, 0xf3, 0xfe, 0xc0, 0xb9, 0x14, 0xf3, 0x9d, 0xe6, 0xb5, 0x76, 0xa7, 0xa1,
-	0x6c, 0xbe, 0x77, 0xb3, 0xa9, 0x34, 0x9a, 0x6b, 0xcd, 0xeb, 0x2b, 0x9b, 0xab, 0xed, 0xf5, 0x5c,
-	0x86, 0x2f, 0x82, 0x63, 0xb4, 0x6d, 0x6b, 0xbd, 0xde, 0x5e, 0x6f, 0xac, 0xae, 0x5f, 0xcf, 0x71,
-	0xf1, 0x6d, 0x9d, 0x66, 0xa3, 0xd9, 0xba, 0xe9, 0x6e, 0x9b, 0x13, 0xf6, 0x3e, 0xf8, 0xa6, 0x94,
-	0xa9, 0xfe, 0x95, 0x05, 0x7b, 0x5a, 0x58, 0xe7, 0x6f, 0x81, 0x2c, 0xfd, 0xaf, 0x44, 0x39, 0x51,
-	0xbc, 0xe8, 0x08, 0x2f, 0x9c, 0x9b, 0x02, 0x08, 0xc7, 0xd5, 0x5b, 0x20, 0x4b, 0xcf, 0xdd, 0x4c,
-	0xc7, 0x14, 0x80, 0xed, 0x98, 0x35, 0x07, 0x1b, 0x60, 0x3e, 0x39, 0x93, 0x9e, 0x65, 0xed, 0x4e,
-	0xc0, 0x84, 0xa5, 0x99, 0x60, 0x61, 0x28, 0x04, 0xfe, 0xcf, 0x1a, 0x21, 0xcf, 0x4d, 0xf0, 0x42,
-	0x03, 0x05, 0x79, 0x46, 0x60, 0x18, 0xf0, 0x53, 0x0e, 0x14, 0xd3, 0x47, 0xbe, 0xa5, 0x89, 0xee,
-	0xe2, 0x70, 0xe1, 0xea, 0x4b, 0xc1, 0x43, 0x0e, 0x63, 0x90, 0x4f, 0x99, 0xd0, 0x2a, 0x2c, 0x87,
-	0x6c, 0xac, 0x50, 0x9d, 0x1d, 0x1b, 0x46, 0xfe, 0x92, 0x03, 0xe5, 0x69, 0xd3, 0xcf, 0x65, 0x96,
-	0xdf, 0x29, 0x9b, 0x84, 0xb7, 0x5e, 0x61, 0x53, 0xc8, 0xea, 0x03, 0x70, 0x38, 0x76, 0xf3, 0x8b,
-	0xec, 0xa3, 0x4a, 0x63, 0x84, 0xca, 0x74, 0x4c, 0x18, 0x61, 0x08, 0x8e, 0xb1, 0xaf, 0xf8, 0x0b,
-	0x6c, 0x27, 0x0c, 0xa8, 0xb0, 0x3c, 0x33, 0x34, 0x72, 0xd8, 0xd2, 0xef, 0x72, 0xe6, 0x61, 0x4b,
-	0x85, 0xb3, 0x0f, 0xdb, 0xd4, 0x7b, 0x96, 0xff, 0x18, 0x14, 0x52, 0xef, 0xd8, 0x4b, 0x13, 0x5d,
-	0xc6, 0xd0, 0xc2, 0x95, 0x97, 0x41, 0xb3, 0x35, 0x48, 0xdc, 0x7f, 0x93, 0x35, 0x88, 0xc3, 0xa7,
-	0x68, 0x90, 0x76, 0x37, 0xf1, 0x3b, 0x80, 0x67, 0x5c, 0x4c, 0x8b, 0x2c, 0x67, 0x49, 0x9c, 0x20,
-	0xcd, 0x86, 0x0b, 0xa2, 0xd5, 0xd7, 0x1e, 0x3d, 0x2d, 0x71, 0x8f, 0x9f, 0x96, 0xb8, 0x3f, 0x9e,
-	0x96, 0xb8, 0x2f, 0x9e, 0x95, 0x32, 0x8f, 0x9f, 0x95, 0x32, 0xbf, 0x3e, 0x2b, 0x65, 0x6e, 0x57,
-	0xa9, 0xd1, 0x7c, 0xc3, 0xf5, 0xb9, 0xb4, 0xa6, 0x76, 0xb1, 0xec, 0xff, 0x20, 0x35, 0xaa, 0x5e,
-	0x91, 0xc7, 0xd4, 0xcf, 0x67, 0xce, 0xa8, 0xde, 0xdd, 0xe7, 0xfe, 0x28, 0x75, 0xf9, 0x9f, 0x00,
-	0x00, 0x00, 0xff, 0xff, 0x4b, 0x94, 0xd0, 0x5f, 0x5e, 0x13, 0x00, 0x00,
+	// 1503 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x58, 0xcf, 0x6f, 0xd4, 0xc6,
+	0x17, 0x8f, 0x13, 0x44, 0xc2, 0x0b, 0x81, 0xc4, 0x5f, 0x92, 0x6c, 0xcc, 0x97, 0xdd, 0x60, 0x20,
+	0x81, 0x40, 0x76, 0x9b, 0x25, 0xa1, 0xd2, 0xb6, 0x97, 0xfc, 0x12, 0xac, 0x9a, 0x4d, 0xa8, 0x93,
+	0x80, 0x4a, 0x0f, 0x5b, 0xef, 0x7a, 0xb2, 0x71, 0xc9, 0x8e, 0x17, 0x8f, 0x37, 0x2c, 0xaa, 0x54,
+	0xb5, 0x3d, 0xa1, 0x9e, 0x2a, 0xf5, 0xd4, 0x43, 0x4f, 0x48, 0x95, 0xda, 0x4b, 0x39, 0xf4, 0x56,
+	0xa9, 0xea, 0x91, 0x23, 0xea, 0xa9, 0xea, 0x81, 0x56, 0x50, 0x09, 0xf5, 0x2f, 0xe8, 0xb5, 0xb2,
+	0xc7, 0x9e, 0x8c, 0xed, 0xf1, 0xee, 0x86, 0x96, 0x4b, 0xb2, 0x33, 0xef, 0x33, 0xef, 0x7d, 0xde,
+	0xe7, 0xcd, 0x8c, 0x9f, 0x0d, 0x29, 0xe2, 0xd8, 0xa6, 0x81, 0x72, 0xc4, 0xd1, 0xef, 0x22, 0xe3,
+	0x41, 0x3d, 0xe7, 0xb4, 0xb2, 0x0d, 0xdb, 0x72, 0x2c, 0xf9, 0x24, 0xb5, 0x64, 0x03, 0x8b, 0x32,
+	0x5e, 0xb5, 0x48, 0xdd, 0x22, 0xb9, 0x3a, 0xa9, 0xe5, 0xf6, 0xe7, 0xdc, 0x7f, 0x14, 0xa9, 0x8c,
+	0xe8, 0x75, 0x13, 0x5b, 0x39, 0xef, 0xaf, 0x3f, 0x75, 0xaa, 0x66, 0xd5, 0x2c, 0xef, 0x67, 0xce,
+	0xfd, 0xe5, 0xcf, 0xa6, 0x7d, 0x0f, 0x15, 0x9d, 0xa0, 0xdc, 0xfe, 0x5c, 0x05, 0x39, 0xfa, 0x5c,
+	0xae, 0x6a, 0x99, 0xd8, 0xb7, 0x4f, 0x50, 0x7b, 0x99, 0x2e, 0xa4, 0x83, 0x60, 0x69, 0x94, 0x67,
+	0xf0, 0x83, 0xda, 0xd5, 0x6f, 0x24, 0x38, 0x51, 0x22, 0xb5, 0x35, 0xf3, 0x5e, 0xd3, 0x34, 0x36,
+	0x5d, 0x9b, 0x3c, 0x06, 0x47, 0x3d, 0x90, 0x9d, 0x92, 0x26, 0xa5, 0x8b, 0xc7, 0x34, 0x7f, 0x24,
+	0x6f, 0xc2, 0x10, 0xd6, 0x1d, 0x73, 0x1f, 0x95, 0xf5, 0xba, 0xd5, 0xc4, 0x4e, 0xaa, 0xd7, 0x35,
+	0x2f, 0x65, 0x9f, 0x3c, 0xcb, 0xf4, 0xfc, 0xf6, 0x2c, 0x33, 0x55, 0x33, 0x9d, 0xdd, 0x66, 0x25,
+	0x5b, 0xb5, 0xea, 0x3e, 0x05, 0xff, 0xdf, 0x2c, 0x31, 0xee, 0xe6, 0x9c, 0x07, 0x0d, 0x44, 0xb2,
+	0x45, 0xec, 0x68, 0xc7, 0xa9, 0x93, 0x45, 0xcf, 0x47, 0x61, 0xfa, 0xb3, 0x97, 0x8f, 0x67, 0xfc,
+	0x08, 0x9f, 0xbf, 0x7c, 0x3c, 0x33, 0xce, 0x88, 0x86, 0x59, 0xa9, 0x9f, 0x48, 0x30, 0x16, 0x9e,
+	0xd2, 0x10, 0x69, 0x58, 0x98, 0x20, 0x79, 0x07, 0x06, 0x88, 0x53, 0x76, 0xac, 0xbb, 0x08, 0x7b,
+	0x94, 0x07, 0xf3, 0x13, 0x59, 0x5f, 0x04, 0x57, 0xb1, 0xac, 0xaf, 0x58, 0x76, 0xd9, 0x32, 0xf1,
+	0xd2, 0x1b, 0x2e, 0xdd, 0xef, 0x7e, 0xcf, 0x5c, 0xec, 0x82, 0xae, 0xbb, 0x80, 0x68, 0xfd, 0xc4,
+	0xd9, 0x72, 0x7d, 0xab, 0xdf, 0x53, 0xad, 0x34, 0x64, 0x20, 0x54, 0xa7, 0x5a, 0x29, 0x30, 0x60,
+	0x7b, 0x43, 0xa6, 0x16, 0x1b, 0xcb, 0xb7, 0xe0, 0x64, 0x40, 0xeb, 0xdf, 0x29, 0x36, 0xe4, 0x13,
+	0xf0, 0x25, 0xbb, 0xe4, 0x4a, 0xc6, 0xc2, 0xc4, 0x44, 0xe3, 0xe8, 0xa9, 0x0f, 0xa9, 0x68, 0xdc,
+	0x14, 0x13, 0x0d, 0x83, 0x5f, 0x88, 0xd7, 0x27, 0xdc, 0x20, 0x0d, 0x40, 0xc5, 0xfb, 0x4a, 0x82,
+	0x53, 0x25, 0x52, 0x5b, 0xb6, 0xf0, 0x8e, 0x69, 0xd7, 0x57, 0xd0, 0x1e, 0xaa, 0xe9, 0x8e, 0x69,
+	0x61, 0x57, 0x42, 0xab, 0x81, 0x6c, 0xdd, 0xb1, 0x98, 0x84, 0xc1, 0x58, 0x3e, 0x0d, 0xc7, 0x6c,
+	0x54, 0xb5, 0x6c, 0xa3, 0x6c, 0x1a, 0x9e, 0x78, 0x47, 0xb4, 0x01, 0x3a, 0x51, 0x34, 0xe4, 0x71,
+	0xe8, 0x77, 0x5a, 0xe5, 0x5d, 0x9d, 0xec, 0xa6, 0xfa, 0xe8, 0x46, 0x75, 0x5a, 0x37, 0x74, 0xb2,
+	0x5b, 0xc8, 0x79, 0x02, 0x05, 0x4e, 0x5c, 0x81, 0xce, 0xf0, 0x02, 0xc5, 0x28, 0xa8, 0x69, 0xf8,
+	0xbf, 0x68, 0x3e, 0xd0, 0x4a, 0xfd, 0x9a, 0xca, 0xe8, 0x03, 0xb6, 0xb1, 0xf1, 0x3a, 0xd9, 0xcf,
+	0xc5, 0xd8, 0x67, 0x04, 0xec, 0x79, 0x12, 0xea, 0x24, 0xa4, 0xc5, 0x16, 0x96, 0xc1, 0xb7, 0x12,
+	0x9f, 0xe2, 0x36, 0xae, 0x58, 0xd8, 0x40, 0x86, 0x57, 0x99, 0xcd, 0xfb, 0x08, 0x35, 0x5e, 0x43,
+	0x1e, 0x6f, 0xc6, 0xf2, 0xb8, 0x20, 0xcc, 0x23, 0x4a, 0x45, 0x9d, 0x82, 0xf3, 0xed, 0xec, 0x2c,
+	0xa7, 0xbf, 0x25, 0x98, 0x28, 0x91, 0xda, 0xa2, 0xf1, 0x61, 0x93, 0x38, 0x7e, 0xd5, 0x90, 0xb1,
+	0xa4, 0xef, 0xe9, 0xb8, 0x8a, 0xda, 0x26, 0xf4, 0x3e, 0x8c, 0x1c, 0x68, 0x54, 0xb6, 0x76, 0x76,
+	0x08, 0x7a, 0xd5, 0xb3, 0x39, 0x7c, 0xe0, 0x68, 0xc3, 0xf3, 0x23, 0x5f, 0x86, 0x91, 0x7d, 0x7d,
+	0xcf, 0x34, 0xdc, 0x48, 0x65, 0xdd, 0x30, 0x6c, 0x44, 0x88, 0x2f, 0xcd, 0x30, 0x33, 0x2c, 0xd2,
+	0xf9, 0xc2, 0x7c, 0x4c, 0x24, 0x95, 0x17, 0x49, 0x9c, 0x9b, 0x7a, 0x0e, 0xce, 0x26, 0x1a, 0x99,
+	0x3c, 0x7f, 0xf5, 0x82, 0x5a, 0x22, 0xb5, 0xed, 0x86, 0xa1, 0x3b, 0xa8, 0x88, 0x31, 0xb2, 0x35,
+	0x64, 0xa0, 0x7a, 0xc3, 0xdb, 0x17, 0xba, 0x83, 0x96, 0xac, 0x26, 0x36, 0x88, 0x9c, 0x82, 0xfe,
+	0xaa, 0x8d, 0x38, 0x99, 0x82, 0xa1, 0x7c, 0x1f, 0x26, 0xea, 0x26, 0x2e, 0x9b, 0xee, 0xd2, 0xb2,
+	0xcd, 0xd6, 0x96, 0x6d, 0xdd, 0x41, 0xbe, 0x5a, 0x6f, 0x1f, 0x42, 0xad, 0x15, 0x54, 0xfd, 0xe5,
+	0x87, 0x59, 0xa0, 0xf3, 0xee, 0x48, 0x1b, 0xab, 0x9b, 0x58, 0x40, 0xcc, 0x0b, 0xac, 0xb7, 0x12,
+	0x02, 0xf7, 0xfd, 0x27, 0x81, 0xf5, 0x96, 0x20, 0x30, 0xdd, 0xb2, 0x41, 0xfe, 0x6e, 0x31, 0xa6,
+	0xf8, 0x62, 0x50, 0x25, 0x45, 0x22, 0xaa, 0x57, 0x60, 0xa6, 0xb3, 0xd4, 0xac, 0x32, 0x77, 0x60,
+	0xc4, 0xbb, 0x94, 0x49, 0xb3, 0x8e, 0x6e, 0x58, 0xc4, 0xb9, 0x63, 0x61, 0x94, 0x5c, 0x87, 0xc2,
+	0xe5, 0x28, 0x2b, 0x25, 0x7c, 0xdd, 0xf3, 0x6e, 0xd4, 0xd3, 0x30, 0x11, 0x9b, 0x64, 0x81, 0x77,
+	0x21, 0xe5, 0x19, 0x77, 0x6c, 0x44, 0x76, 0x23, 0xa2, 0x27, 0xc7, 0xcf, 0x47, 0xe3, 0x9f, 0x0d,
+	0xc7, 0x17, 0x78, 0x53, 0x55, 0x98, 0x4c, 0xb2, 0x31, 0x36, 0x3f, 0xd3, 0x3b, 0x69, 0x63, 0x1f,
+	0xd9, 0xf7, 0x6d, 0xd3, 0x41, 0xfc, 0xc5, 0xeb, 0x5e, 0x2e, 0x6d, 0xb6, 0xe6, 0x7a, 0xe8, 0x00,
+	0xd3, 0xbb, 0xc8, 0xdb, 0x92, 0x83, 0xf9, 0xb3, 0xd9, 0x48, 0xff, 0x95, 0x8d, 0xfa, 0xe5, 0xcf,
+	0x2c, 0x9d, 0x29, 0x5c, 0x8b, 0xa6, 0x18, 0xba, 0xaa, 0x12, 0x19, 0xfa, 0x57, 0x55, 0xa2, 0x9d,
+	0xa5, 0xfa, 0xa3, 0x04, 0xa7, 0x79, 0x20, 0xbd, 0xd5, 0x4c, 0x5c, 0xeb, 0x98, 0xe9, 0x3b, 0x30,
+	0xdc, 0x0c, 0xc0, 0xe1, 0x44, 0x27, 0x63, 0x89, 0x46, 0xbc, 0x6a, 0x27, 0x9b, 0xe1, 0x89, 0xc2,
+	0x42, 0x34, 0xcd, 0xf3, 0xc2, 0x34, 0x23, 0x7e, 0xd4, 0x0b, 0x70, 0xae, 0x8d, 0x39, 0xb1, 0x9e,
+	0x5c, 0xd9, 0xbb, 0xa8, 0x27, 0x7f, 0xce, 0xdb, 0xd7, 0x33, 0xea, 0x57, 0x1b, 0xb6, 0x23, 0x33,
+	0xdd, 0xd6, 0x33, 0xea, 0x29, 0x5a, 0xcf, 0x58, 0xa4, 0x20, 0xd5, 0x8f, 0x60, 0xb4, 0x44, 0x6a,
+	0x9b, 0xc8, 0xd9, 0xf0, 0x6f, 0x6e, 0xff, 0x3e, 0xf7, 0x7a, 0x67, 0xb3, 0x86, 0xb9, 0xde, 0xd9,
+	0x1b, 0x85, 0x9e, 0x46, 0xbd, 0xe1, 0xa7, 0x51, 0x21, 0x4b, 0x5b, 0x60, 0x0f, 0xe8, 0x72, 0x4d,
+	0xf3, 0x5c, 0xe3, 0x31, 0xd4, 0x0c, 0x9c, 0x11, 0x1a, 0x18, 0xbb, 0x9f, 0xa4, 0x80, 0x5e, 0x71,
+	0xf9, 0xdd, 0x5b, 0xc8, 0x36, 0x77, 0xcc, 0x2a, 0xed, 0x56, 0x92, 0xe8, 0xa5, 0xa0, 0x1f, 0x61,
+	0xbd, 0xb2, 0x87, 0xa8, 0xea, 0x03, 0x5a, 0x30, 0x94, 0xcf, 0xc1, 0x50, 0xd5, 0xc2, 0x18, 0x55,
+	0xbd, 0xca, 0x98, 0x86, 0xff, 0x24, 0x3b, 0x7e, 0x30, 0x59, 0x34, 0xe4, 0x34, 0x00, 0x7b, 0xb2,
+	0x91, 0xd4, 0x91, 0xc9, 0xbe, 0x8b, 0xc7, 0x34, 0x6e, 0xa6, 0x63, 0x86, 0x11, 0x9a, 0x07, 0x19,
+	0x46, 0x0c, 0x2c, 0xc3, 0x47, 0xf4, 0x3c, 0x2d, 0xef, 0x21, 0xdd, 0xbe, 0x89, 0xbc, 0xcd, 0xe8,
+	0xf7, 0x0b, 0xed, 0xf3, 0xbc, 0x06, 0xe3, 0x0d, 0x0a, 0x2f, 0x57, 0x39, 0xfc, 0x41, 0x5f, 0x33,
+	0xda, 0x88, 0x7b, 0x2b, 0x1a, 0xf4, 0x31, 0xcd, 0x25, 0x10, 0x3a, 0x37, 0x49, 0x2c, 0xfc, 0x73,
+	0x93, 0x64, 0x0e, 0x92, 0x99, 0xb9, 0x07, 0x63, 0xc1, 0x8e, 0x73, 0x35, 0xa7, 0x5b, 0x6d, 0xeb,
+	0x41, 0xc3, 0xed, 0x61, 0xc6, 0xb4, 0xd5, 0xe5, 0x0d, 0x6d, 0xa5, 0xbc, 0xf5, 0xde, 0xcd, 0xd5,
+	0xf2, 0xca, 0xea, 0xda, 0xea, 0xf5, 0xc5, 0xad, 0xe2, 0xc6, 0xfa, 0x70, 0x8f, 0x3c, 0x01, 0xa3,
+	0xbc, 0x6d, 0x7b, 0x7d, 0x69, 0x63, 0x7d, 0xa5, 0xb8, 0x7e, 0x7d, 0x58, 0x8a, 0x2e, 0xd3, 0x56,
+	0x57, 0x56, 0x4b, 0x37, 0xbd, 0x65, 0xbd, 0xca, 0x91, 0x87, 0x8f, 0xd2, 0x3d, 0xf9, 0x3f, 0x87,
+	0xa0, 0xaf, 0x44, 0x6a, 0xf2, 0x6d, 0x18, 0xe4, 0xdf, 0xfc, 0x32, 0xb1, 0xb3, 0x16, 0x7e, 0xe3,
+	0x52, 0xa6, 0x3b, 0x00, 0xd8, 0xdb, 0xc5, 0x6d, 0x18, 0xe4, 0x5f, 0x93, 0x84, 0x8e, 0x39, 0x80,
+	0x32, 0xdd, 0x01, 0xc0, 0x1c, 0x9b, 0x30, 0x12, 0x7f, 0x85, 0xb8, 0x20, 0x5a, 0x1d, 0x83, 0x29,
+	0xb3, 0x5d, 0xc1, 0x58, 0x28, 0x0b, 0xfe, 0x27, 0xea, 0xf8, 0xa7, 0xdb, 0x78, 0xe1, 0x81, 0x4a,
+	0xae, 0x4b, 0x20, 0x0b, 0xf8, 0xa9, 0x04, 0x13, 0xc9, 0x1d, 0xfa, 0x6c, 0x5b, 0x77, 0x51, 0xb8,
+	0xb2, 0x70, 0x28, 0x38, 0xe3, 0xd0, 0x82, 0xb1, 0x84, 0x86, 0x7a, 0x46, 0xe4, 0x50, 0x8c, 0x55,
+	0xf2, 0xdd, 0x63, 0x59, 0xe4, 0x2f, 0x25, 0xc8, 0x74, 0x6a, 0x56, 0xaf, 0x8a, 0xfc, 0x76, 0x58,
+	0xa4, 0xbc, 0xf5, 0x0a, 0x8b, 0x18, 0xab, 0x0f, 0xe0, 0x44, 0xa4, 0x51, 0x53, 0xc5, 0x5b, 0x95,
+	0xc7, 0x28, 0x33, 0x9d, 0x31, 0x2c, 0x42, 0x13, 0x46, 0xc5, 0x1d, 0xd9, 0x25, 0xb1, 0x13, 0x01,
+	0x54, 0x99, 0xeb, 0x1a, 0x1a, 0xda, 0x6c, 0xc9, 0xad, 0x97, 0x70, 0xb3, 0x25, 0xc2, 0x95, 0x85,
+	0x43, 0xc1, 0x19, 0x87, 0x8f, 0x21, 0x95, 0xd8, 0x12, 0x5d, 0x69, 0xeb, 0x32, 0x82, 0x56, 0xe6,
+	0x0f, 0x83, 0x16, 0x6b, 0x10, 0x6b, 0x57, 0xda, 0x6b, 0x10, 0x85, 0x2b, 0x0b, 0x87, 0x82, 0x33,
+	0x0e, 0x7b, 0x20, 0x0b, 0xfa, 0x88, 0x29, 0x91, 0xb3, 0x38, 0x4e, 0xc9, 0x76, 0x87, 0x8b, 0x44,
+	0x8b, 0xb6, 0x05, 0x49, 0xd1, 0x22, 0x38, 0x25, 0xdb, 0x1d, 0x8e, 0xaf, 0x6f, 0xe2, 0x23, 0x5a,
+	0x58, 0xdf, 0x24, 0xb4, 0x32, 0x7f, 0x18, 0x74, 0x10, 0x7f, 0x69, 0xed, 0xc9, 0xf3, 0xb4, 0xf4,
+	0xf4, 0x79, 0x5a, 0xfa, 0xe3, 0x79, 0x5a, 0xfa, 0xe2, 0x45, 0xba, 0xe7, 0xe9, 0x8b, 0x74, 0xcf,
+	0xaf, 0x2f, 0xd2, 0x3d, 0x77, 0xf2, 0xdc, 0x7b, 0xe3, 0xa6, 0xe7, 0x79, 0x76, 0x4d, 0xaf, 0x90,
+	0x9c, 0xff, 0xb5, 0x74, 0x3f, 0x3f, 0x9f, 0x6b, 0x71, 0xdf, 0x76, 0xdd, 0xf7, 0xc8, 0xca, 0x51,
+	0xef, 0x8b, 0xe9, 0xd5, 0x7f, 0x06, 0x00, 0x0f, 0x3c, 0x5a, 0x98, 0xfb, 0x15, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1390,6 +1597,10 @@ type MsgClient interface {
 	OverwriteRedemptionRecord(ctx context.Context, in *MsgOverwriteRedemptionRecord, opts ...grpc.CallOption) (*MsgOverwriteRedemptionRecordResponse, error)
 	// Sets the operator address
 	SetOperatorAddress(ctx context.Context, in *MsgSetOperatorAddress, opts ...grpc.CallOption) (*MsgSetOperatorAddressResponse, error)
+	// Sets the configuration for verifying operator confirmations with ICQ
+	SetICQVerification(ctx context.Context, in *MsgSetICQVerification, opts ...grpc.CallOption) (*MsgSetICQVerificationResponse, error)
+	// Removes a pending confirmation whose verification queries are stuck
+	ClearPendingConfirmation(ctx context.Context, in *MsgClearPendingConfirmation, opts ...grpc.CallOption) (*MsgClearPendingConfirmationResponse, error)
 }
 
 type msgClient struct {
@@ -1517,6 +1728,24 @@ func (c *msgClient) SetOperatorAddress(ctx context.Context, in *MsgSetOperatorAd
 	return out, nil
 }
 
+func (c *msgClient) SetICQVerification(ctx context.Context, in *MsgSetICQVerification, opts ...grpc.CallOption) (*MsgSetICQVerificationResponse, error) {
+	out := new(MsgSetICQVerificationResponse)
+	err := c.cc.Invoke(ctx, "/stride.stakedym.Msg/SetICQVerification", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ClearPendingConfirmation(ctx context.Context, in *MsgClearPendingConfirmation, opts ...grpc.CallOption) (*MsgClearPendingConfirmationResponse, error) {
+	out := new(MsgClearPendingConfirmationResponse)
+	err := c.cc.Invoke(ctx, "/stride.stakedym.Msg/ClearPendingConfirmation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// User transaction to liquid stake native tokens into stTokens
@@ -1549,6 +1778,10 @@ type MsgServer interface {
 	OverwriteRedemptionRecord(context.Context, *MsgOverwriteRedemptionRecord) (*MsgOverwriteRedemptionRecordResponse, error)
 	// Sets the operator address
 	SetOperatorAddress(context.Context, *MsgSetOperatorAddress) (*MsgSetOperatorAddressResponse, error)
+	// Sets the configuration for verifying operator confirmations with ICQ
+	SetICQVerification(context.Context, *MsgSetICQVerification) (*MsgSetICQVerificationResponse, error)
+	// Removes a pending confirmation whose verification queries are stuck
+	ClearPendingConfirmation(context.Context, *MsgClearPendingConfirmation) (*MsgClearPendingConfirmationResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -1594,6 +1827,12 @@ func (*UnimplementedMsgServer) OverwriteRedemptionRecord(ctx context.Context, re
 func (*UnimplementedMsgServer) SetOperatorAddress(ctx context.Context, req *MsgSetOperatorAddress) (*MsgSetOperatorAddressResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SetOperatorAddress not implemented")
 }
+func (*UnimplementedMsgServer) SetICQVerification(ctx context.Context, req *MsgSetICQVerification) (*MsgSetICQVerificationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetICQVerification not implemented")
+}
+func (*UnimplementedMsgServer) ClearPendingConfirmation(ctx context.Context, req *MsgClearPendingConfirmation) (*MsgClearPendingConfirmationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClearPendingConfirmation not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -1833,6 +2072,42 @@ func _Msg_SetOperatorAddress_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_SetICQVerification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetICQVerification)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetICQVerification(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stride.stakedym.Msg/SetICQVerification",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetICQVerification(ctx, req.(*MsgSetICQVerification))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ClearPendingConfirmation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgClearPendingConfirmation)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ClearPendingConfirmation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stride.stakedym.Msg/ClearPendingConfirmation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ClearPendingConfirmation(ctx, req.(*MsgClearPendingConfirmation))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "stride.stakedym.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -1889,6 +2164,14 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "SetOperatorAddress",
 			Handler:    _Msg_SetOperatorAddress_Handler,
 		},
+		{
+			MethodName: "SetICQVerification",
+			Handler:    _Msg_SetICQVerification_Handler,
+		},
+		{
+			MethodName: "ClearPendingConfirmation",
+			Handler:    _Msg_ClearPendingConfirmation_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "stride/stakedym/tx.proto",
@@ -2739,76 +3022,213 @@ func (m *MsgSetOperatorAddressResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgSetICQVerification) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MsgLiquidStake) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *MsgSetICQVerification) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetICQVerification) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Staker)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Validators) > 0 {
+		for iNdEx := len(m.Validators) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Validators[iNdEx])
+			copy(dAtA[i:], m.Validators[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Validators[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.ConnectionId) > 0 {
+		i -= len(m.ConnectionId)
+		copy(dAtA[i:], m.ConnectionId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ConnectionId)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = m.NativeAmount.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	if m.Enabled {
+		i--
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgLiquidStakeResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgSetICQVerificationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.StToken.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgRedeemStake) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Redeemer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = m.StTokenAmount.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+func (m *MsgSetICQVerificationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgRedeemStakeResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgSetICQVerificationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.NativeToken.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgConfirmDelegation) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgClearPendingConfirmation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Operator)
+	return dAtA[:n], nil
+}
+
+func (m *MsgClearPendingConfirmation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClearPendingConfirmation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PendingConfirmationId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PendingConfirmationId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgClearPendingConfirmationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClearPendingConfirmationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClearPendingConfirmationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgLiquidStake) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Staker)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.NativeAmount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgLiquidStakeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.StToken.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgRedeemStake) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Redeemer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.StTokenAmount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgRedeemStakeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.NativeToken.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgConfirmDelegation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Operator)
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
@@ -3091,6 +3511,66 @@ func (m *MsgSetOperatorAddressResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgSetICQVerification) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Enabled {
+		n += 2
+	}
+	l = len(m.ConnectionId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Validators) > 0 {
+		for _, s := range m.Validators {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgSetICQVerificationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgClearPendingConfirmation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.PendingConfirmationId != 0 {
+		n += 1 + sovTx(uint64(m.PendingConfirmationId))
+	}
+	return n
+}
+
+func (m *MsgClearPendingConfirmationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -5374,6 +5854,373 @@ func (m *MsgSetOperatorAddressResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgSetICQVerification) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetICQVerification: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetICQVerification: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Enabled = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConnectionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validators", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validators = append(m.Validators, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetICQVerificationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetICQVerificationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetICQVerificationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgClearPendingConfirmation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgClearPendingConfirmation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgClearPendingConfirmation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingConfirmationId", wireType)
+			}
+			m.PendingConfirmationId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PendingConfirmationId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgClearPendingConfirmationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgClearPendingConfirmationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgClearPendingConfirmationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
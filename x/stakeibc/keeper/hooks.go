@@ -11,7 +11,7 @@ import (
 	"github.com/Stride-Labs/stride/v24/x/stakeibc/types"
 )
 
-const StrideEpochsPerDayEpoch = uint64(4)
+const StrideEpochsPerDayEpoch = uint64(epochstypes.StrideEpochsPerDayEpoch)
 
 func (k Keeper) BeforeEpochStart(ctx sdk.Context, epochInfo epochstypes.EpochInfo) {
 	// Update the stakeibc epoch tracker
@@ -21,14 +21,25 @@ func (k Keeper) BeforeEpochStart(ctx sdk.Context, epochInfo epochstypes.EpochInf
 		return
 	}
 
+	// If the epoch jumped ahead after a chain halt (from the skip or fire-once catch up policies),
+	// the interval checks below consider each of the missed epochs so that an interval boundary
+	// that fell in the missed range is not delayed until the following interval
+	missedEpochs := uint64(epochInfo.MissedEpochs)
+
 	// Day Epoch - Process Unbondings
 	if epochInfo.Identifier == epochstypes.DAY_EPOCH {
 		// Initiate unbondings from any hostZone where it's appropriate
-		k.InitiateAllHostZoneUnbondings(ctx, epochNumber)
+		runEpochStep(ctx, "initiate_unbondings", func(ctx sdk.Context) {
+			k.InitiateAllHostZoneUnbondings(ctx, epochNumber, missedEpochs)
+		})
 		// Cleanup any records that are no longer needed
-		k.CleanupEpochUnbondingRecords(ctx, epochNumber)
+		runEpochStep(ctx, "cleanup_unbonding_records", func(ctx sdk.Context) {
+			k.CleanupEpochUnbondingRecords(ctx, epochNumber)
+		})
 		// Create an empty unbonding record for this epoch
-		k.CreateEpochUnbondingRecord(ctx, epochNumber)
+		runEpochStep(ctx, "create_unbonding_record", func(ctx sdk.Context) {
+			k.CreateEpochUnbondingRecord(ctx, epochNumber)
+		})
 	}
 
 	// Stride Epoch - Process Deposits and Delegations
@@ -40,33 +51,47 @@ func (k Keeper) BeforeEpochStart(ctx sdk.Context, epochInfo epochstypes.EpochInf
 		reinvestInterval := k.GetParam(ctx, types.KeyReinvestInterval)
 
 		// Claim accrued staking rewards at the beginning of the epoch
-		k.ClaimAccruedStakingRewards(ctx)
+		runEpochStep(ctx, "claim_staking_rewards", func(ctx sdk.Context) {
+			k.ClaimAccruedStakingRewards(ctx)
+		})
 
 		// Create a new deposit record for each host zone and the grab all deposit records
-		k.CreateDepositRecordsForEpoch(ctx, epochNumber)
+		runEpochStep(ctx, "create_deposit_records", func(ctx sdk.Context) {
+			k.CreateDepositRecordsForEpoch(ctx, epochNumber)
+		})
 		depositRecords := k.RecordsKeeper.GetAllDepositRecord(ctx)
 
 		// TODO: move this to an external function that anyone can call, so that we don't have to call it every epoch
-		k.SetWithdrawalAddress(ctx)
+		runEpochStep(ctx, "set_withdrawal_addresses", func(ctx sdk.Context) {
+			k.SetWithdrawalAddress(ctx)
+		})
 
 		// Update the redemption rate
-		if epochNumber%redemptionRateInterval == 0 {
-			k.UpdateRedemptionRates(ctx, depositRecords)
+		if epochInfo.IsIntervalBoundary(redemptionRateInterval) {
+			runEpochStep(ctx, "update_redemption_rates", func(ctx sdk.Context) {
+				k.UpdateRedemptionRates(ctx, depositRecords)
+			})
 		}
 
 		// Transfer deposited funds from the controller account to the delegation account on the host zone
-		if epochNumber%depositInterval == 0 {
-			k.TransferExistingDepositsToHostZones(ctx, epochNumber, depositRecords)
+		if epochInfo.IsIntervalBoundary(depositInterval) {
+			runEpochStep(ctx, "transfer_deposits", func(ctx sdk.Context) {
+				k.TransferExistingDepositsToHostZones(ctx, epochNumber, depositRecords)
+			})
 		}
 
 		// Delegate tokens from the delegation account
-		if epochNumber%delegationInterval == 0 {
-			k.StakeExistingDepositsOnHostZones(ctx, epochNumber, depositRecords)
+		if epochInfo.IsIntervalBoundary(delegationInterval) {
+			runEpochStep(ctx, "delegate_deposits", func(ctx sdk.Context) {
+				k.StakeExistingDepositsOnHostZones(ctx, epochNumber, depositRecords)
+			})
 		}
 
 		// Reinvest staking rewards
-		if epochNumber%reinvestInterval == 0 { // allow a few blocks from UpdateUndelegatedBal to avoid conflicts
-			k.ReinvestRewards(ctx)
+		if epochInfo.IsIntervalBoundary(reinvestInterval) { // allow a few blocks from UpdateUndelegatedBal to avoid conflicts
+			runEpochStep(ctx, "reinvest_rewards", func(ctx sdk.Context) {
+				k.ReinvestRewards(ctx)
+			})
 		}
 
 		// Rebalance stake according to validator weights
@@ -74,25 +99,45 @@ func (k Keeper) BeforeEpochStart(ctx sdk.Context, epochInfo epochstypes.EpochInf
 		//   overlaps the day epoch, otherwise the unbondings could cause a redelegation to fail
 		// On mainnet, the stride epoch overlaps the day epoch when `epochNumber % 4 == 1`,
 		//   so this will trigger the epoch before the unbonding
-		if epochNumber%StrideEpochsPerDayEpoch == 0 {
-			k.RebalanceAllHostZones(ctx)
+		if epochInfo.IsIntervalBoundary(StrideEpochsPerDayEpoch) {
+			runEpochStep(ctx, "rebalance", func(ctx sdk.Context) {
+				k.RebalanceAllHostZones(ctx)
+			})
 		}
 
 		// Check previous epochs to see if unbondings finished, and sends the relevant tokens
 		// to the redemption account
-		k.SweepUnbondedTokensAllHostZones(ctx)
+		runEpochStep(ctx, "sweep_unbonded_tokens", func(ctx sdk.Context) {
+			k.SweepUnbondedTokensAllHostZones(ctx)
+		})
 
 		// Transfers in and out of tokens for hostZones which have community pools
-		k.ProcessAllCommunityPoolTokens(ctx)
+		runEpochStep(ctx, "community_pool_transfers", func(ctx sdk.Context) {
+			k.ProcessAllCommunityPoolTokens(ctx)
+		})
 
 		// Do transfers for all reward and swapped tokens defined by the trade routes every stride epoch
-		k.TransferAllRewardTokens(ctx)
+		runEpochStep(ctx, "trade_route_transfers", func(ctx sdk.Context) {
+			k.TransferAllRewardTokens(ctx)
+		})
 	}
 	if epochInfo.Identifier == epochstypes.MINT_EPOCH {
-		k.AllocateHostZoneReward(ctx)
+		runEpochStep(ctx, "allocate_host_zone_rewards", func(ctx sdk.Context) {
+			k.AllocateHostZoneReward(ctx)
+		})
 	}
 }
 
+// Runs a step of the epoch hook in an isolated context so that a panic in one step discards
+// only that step's state changes and does not prevent the remaining steps from running
+// Each step is reported separately in the epochs module's execution report
+func runEpochStep(ctx sdk.Context, name string, step func(ctx sdk.Context)) {
+	_ = epochstypes.RunEpochStep(ctx, name, func(ctx sdk.Context) error {
+		step(ctx)
+		return nil
+	})
+}
+
 func (k Keeper) AfterEpochEnd(ctx sdk.Context, epochInfo epochstypes.EpochInfo) {}
 
 // Hooks wrapper struct for incentives keeper
@@ -115,6 +160,10 @@ func (h Hooks) AfterEpochEnd(ctx sdk.Context, epochInfo epochstypes.EpochInfo) {
 	h.k.AfterEpochEnd(ctx, epochInfo)
 }
 
+func (h Hooks) GetModuleName() string {
+	return types.ModuleName
+}
+
 // Set the withdrawal account address for each host zone
 func (k Keeper) SetWithdrawalAddress(ctx sdk.Context) {
 	k.Logger(ctx).Info("Setting Withdrawal Addresses...")
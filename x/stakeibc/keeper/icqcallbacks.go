@@ -15,20 +15,29 @@ const (
 	ICQCallbackID_CommunityPoolIcaBalance = "communitypoolicabalance"
 	ICQCallbackID_WithdrawalRewardBalance = "withdrawalrewardbalance"
 	ICQCallbackID_TradeConvertedBalance   = "tradeconvertedbalance"
+
+	ICQCallbackID_ValidatorBatch = "validatorbatch"
 )
 
 // ICQCallbacks wrapper struct for stakeibc keeper
 type ICQCallback func(Keeper, sdk.Context, []byte, icqtypes.Query) error
 
+// ICQBatchCallback handles the results of a batch query, indexed by request key
+type ICQBatchCallback func(Keeper, sdk.Context, map[string][]byte, icqtypes.BatchQuery) error
+
 type ICQCallbacks struct {
-	k         Keeper
-	callbacks map[string]ICQCallback
+	k              Keeper
+	callbacks      map[string]ICQCallback
+	batchCallbacks map[string]ICQBatchCallback
 }
 
-var _ icqtypes.QueryCallbacks = ICQCallbacks{}
+var (
+	_ icqtypes.QueryCallbacks      = ICQCallbacks{}
+	_ icqtypes.BatchQueryCallbacks = ICQCallbacks{}
+)
 
 func (k Keeper) ICQCallbackHandler() ICQCallbacks {
-	return ICQCallbacks{k, make(map[string]ICQCallback)}
+	return ICQCallbacks{k, make(map[string]ICQCallback), make(map[string]ICQBatchCallback)}
 }
 
 func (c ICQCallbacks) CallICQCallback(ctx sdk.Context, id string, args []byte, query icqtypes.Query) error {
@@ -45,7 +54,23 @@ func (c ICQCallbacks) AddICQCallback(id string, fn interface{}) icqtypes.QueryCa
 	return c
 }
 
+func (c ICQCallbacks) CallBatchICQCallback(ctx sdk.Context, id string, results map[string][]byte, query icqtypes.BatchQuery) error {
+	return c.batchCallbacks[id](c.k, ctx, results, query)
+}
+
+func (c ICQCallbacks) HasBatchICQCallback(id string) bool {
+	_, found := c.batchCallbacks[id]
+	return found
+}
+
+func (c ICQCallbacks) AddBatchICQCallback(id string, fn ICQBatchCallback) ICQCallbacks {
+	c.batchCallbacks[id] = fn
+	return c
+}
+
 func (c ICQCallbacks) RegisterICQCallbacks() icqtypes.QueryCallbacks {
+	c.AddBatchICQCallback(ICQCallbackID_ValidatorBatch, ICQBatchCallback(ValidatorSharesToTokensRateBatchCallback))
+
 	return c.
 		AddICQCallback(ICQCallbackID_WithdrawalHostBalance, ICQCallback(WithdrawalHostBalanceCallback)).
 		AddICQCallback(ICQCallbackID_FeeBalance, ICQCallback(FeeBalanceCallback)).
@@ -81,6 +81,36 @@ func ValidatorSharesToTokensRateCallback(k Keeper, ctx sdk.Context, args []byte,
 	return nil
 }
 
+// ValidatorSharesToTokensRateBatchCallback is a callback handler for batch validator queries
+// Each validator's result is processed with the single validator callback in an isolated context,
+// so that a failure for one validator does not revert the updates for the others
+func ValidatorSharesToTokensRateBatchCallback(k Keeper, ctx sdk.Context, results map[string][]byte, query icqtypes.BatchQuery) error {
+	k.Logger(ctx).Info(utils.LogICQCallbackWithHostZone(query.ChainId, ICQCallbackID_ValidatorBatch,
+		"Starting validator sharesToTokens rate batch callback, QueryId: %vs, NumValidators: %d", query.Id, len(query.RequestKeys)))
+
+	for _, requestKey := range query.RequestKeys {
+		result := results[string(requestKey)]
+
+		// An empty result indicates the validator does not exist on the host
+		if len(result) == 0 {
+			k.Logger(ctx).Info(utils.LogICQCallbackWithHostZone(query.ChainId, ICQCallbackID_ValidatorBatch,
+				"Query response is contentless for validator key %x", requestKey))
+			continue
+		}
+
+		validatorQuery := query.KeyQuery(requestKey, ICQCallbackID_Validator)
+		err := utils.ApplyFuncIfNoError(ctx, func(ctx sdk.Context) error {
+			return ValidatorSharesToTokensRateCallback(k, ctx, result, validatorQuery)
+		})
+		if err != nil {
+			k.Logger(ctx).Error(utils.LogICQCallbackWithHostZone(query.ChainId, ICQCallbackID_ValidatorBatch,
+				"Validator sharesToTokens rate callback failed for validator key %x: %s", requestKey, err.Error()))
+		}
+	}
+
+	return nil
+}
+
 // Determines if the validator was slashed by comparing the validator sharesToTokens rate from the query response
 // with the sharesToTokens rate stored on the validator
 func (k Keeper) CheckIfValidatorWasSlashed(
@@ -524,3 +524,40 @@ func (s *KeeperTestSuite) TestValidatorSharesToTokensRateCallback_DelegationQuer
 	err := keeper.ValidatorSharesToTokensRateCallback(s.App.StakeibcKeeper, s.Ctx, tc.validArgs.callbackArgs, tc.validArgs.query)
 	s.Require().ErrorContains(err, "Failed to submit ICQ validator delegations")
 }
+
+func (s *KeeperTestSuite) TestValidatorSharesToTokensRateBatchCallback() {
+	validatorSlashed := true
+	lsmCallback := false
+	tc := s.SetupValidatorICQCallback(validatorSlashed, lsmCallback)
+
+	// Build the request key for the queried validator, as well as one for a validator
+	// that doesn't exist on the host, and one that will return an invalid response
+	_, validatorAddressBz, err := bech32.DecodeAndConvert(ValAddress)
+	s.Require().NoError(err, "no error expected when decoding validator address")
+	validatorKey := stakingtypes.GetValidatorKey(validatorAddressBz)
+	missingValidatorKey := stakingtypes.GetValidatorKey([]byte("missing"))
+	invalidValidatorKey := stakingtypes.GetValidatorKey([]byte("invalid"))
+
+	batchQuery := icqtypes.BatchQuery{
+		ChainId:          HostChainId,
+		ConnectionId:     ibctesting.FirstConnectionID,
+		QueryType:        icqtypes.STAKING_STORE_QUERY_WITH_PROOF,
+		RequestKeys:      [][]byte{invalidValidatorKey, missingValidatorKey, validatorKey},
+		CallbackModule:   types.ModuleName,
+		CallbackId:       keeper.ICQCallbackID_ValidatorBatch,
+		TimeoutTimestamp: uint64(s.Ctx.BlockTime().Add(time.Minute).UnixNano()),
+	}
+	results := map[string][]byte{
+		string(invalidValidatorKey): []byte("invalid"),
+		string(missingValidatorKey): {},
+		string(validatorKey):        tc.validArgs.callbackArgs,
+	}
+
+	// The invalid and missing validators should not prevent the valid validator from being processed
+	err = keeper.ValidatorSharesToTokensRateBatchCallback(s.App.StakeibcKeeper, s.Ctx, results, batchQuery)
+	s.Require().NoError(err, "validator sharesToTokens rate batch callback error")
+
+	// Confirm the queried validator's sharesToTokens rate was updated and the delegator shares query was submitted
+	s.checkValidatorSharesToTokensRate(tc.sharesToTokensRateIfSlashed)
+	s.checkDelegatorSharesQuerySubmitted(tc)
+}
@@ -213,7 +213,7 @@ func (k Keeper) SubmitTxs(
 			CallbackArgs: callbackArgs,
 		}
 		k.Logger(ctx).Info(utils.LogWithHostZone(chainId, "Storing callback data: %+v", callback))
-		k.ICACallbacksKeeper.SetCallbackData(ctx, callback)
+		k.ICACallbacksKeeper.AddCallbackData(ctx, callback)
 	}
 
 	return sequence, nil
@@ -77,6 +77,49 @@ func (k Keeper) SubmitValidatorSharesToTokensRateICQ(
 	return nil
 }
 
+// Submits a single batch ICQ for the sharesToTokens rates of multiple validators on a host zone,
+// so that every rate is read at the same host height and answered in a single relayer tx
+// Each validator's result is processed with the same logic as the single validator query
+func (k Keeper) QueryValidatorsSharesToTokensRates(ctx sdk.Context, chainId string, validatorAddresses []string) error {
+	k.Logger(ctx).Info(utils.LogWithHostZone(chainId, "Submitting batch ICQ for %d validator sharesToTokens rates", len(validatorAddresses)))
+
+	// Confirm the host zone exists
+	hostZone, found := k.GetHostZone(ctx, chainId)
+	if !found {
+		return errorsmod.Wrapf(types.ErrInvalidHostZone, "Host zone not found (%s)", chainId)
+	}
+
+	// Encode each validator address to form the query request keys
+	requestKeys := [][]byte{}
+	for _, validatorAddress := range validatorAddresses {
+		if !strings.Contains(validatorAddress, hostZone.Bech32Prefix) {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "validator operator address must match the host zone bech32 prefix")
+		}
+		_, validatorAddressBz, err := bech32.DecodeAndConvert(validatorAddress)
+		if err != nil {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "invalid validator operator address, could not decode (%s)", err.Error())
+		}
+		requestKeys = append(requestKeys, stakingtypes.GetValidatorKey(validatorAddressBz))
+	}
+
+	// Considering this query is executed manually, we can be conservative with the timeout
+	query := icqtypes.BatchQuery{
+		ChainId:         hostZone.ChainId,
+		ConnectionId:    hostZone.ConnectionId,
+		QueryType:       icqtypes.STAKING_STORE_QUERY_WITH_PROOF,
+		RequestKeys:     requestKeys,
+		CallbackModule:  types.ModuleName,
+		CallbackId:      ICQCallbackID_ValidatorBatch,
+		TimeoutDuration: time.Hour * 24,
+		TimeoutPolicy:   icqtypes.TimeoutPolicy_REJECT_QUERY_RESPONSE,
+	}
+	if err := k.InterchainQueryKeeper.SubmitBatchICQRequest(ctx, query, true); err != nil {
+		k.Logger(ctx).Error(fmt.Sprintf("Error submitting batch ICQ for validator sharesToTokens rates, error %s", err.Error()))
+		return err
+	}
+	return nil
+}
+
 // Submits an ICQ to get a validator's delegations
 // This is called after the validator's sharesToTokens rate is determined
 // The timeoutDuration parameter represents the length of the timeout (not to be confused with an actual timestamp)
@@ -65,15 +65,17 @@ func (ms msgServer) UpdateHostZoneParams(goCtx context.Context, msg *types.MsgUp
 func (k msgServer) AddValidators(goCtx context.Context, msg *types.MsgAddValidators) (*types.MsgAddValidatorsResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
+	validatorAddresses := []string{}
 	for _, validator := range msg.Validators {
 		if err := k.AddValidatorToHostZone(ctx, msg.HostZone, *validator, false); err != nil {
 			return nil, err
 		}
+		validatorAddresses = append(validatorAddresses, validator.Address)
+	}
 
-		// Query and store the validator's sharesToTokens rate
-		if err := k.QueryValidatorSharesToTokensRate(ctx, msg.HostZone, validator.Address); err != nil {
-			return nil, err
-		}
+	// Query and store the validators' sharesToTokens rates in a single batch query
+	if err := k.QueryValidatorsSharesToTokensRates(ctx, msg.HostZone, validatorAddresses); err != nil {
+		return nil, err
 	}
 
 	// Confirm none of the validator's exceed the weight cap
@@ -182,16 +182,23 @@ func (s *KeeperTestSuite) TestAddValidators_Successful() {
 		s.Require().Equal(*tc.expectedValidators[i], *hostZone.Validators[i], "validators %d", i)
 	}
 
-	// Confirm ICQs were submitted
-	queries := s.App.InterchainqueryKeeper.AllQueries(s.Ctx)
-	s.Require().Len(queries, 3)
-
-	// Map the query responses to the validator names to get the names of the validators that
+	// Confirm a single batch ICQ was submitted for all validators
+	s.Require().Empty(s.App.InterchainqueryKeeper.AllQueries(s.Ctx), "no single queries")
+	batchQueries := s.App.InterchainqueryKeeper.AllBatchQueries(s.Ctx)
+	s.Require().Len(batchQueries, 1)
+
+	batchQuery := batchQueries[0]
+	s.Require().Equal(types.ModuleName, batchQuery.CallbackModule, "callback module")
+	s.Require().Equal(keeper.ICQCallbackID_ValidatorBatch, batchQuery.CallbackId, "callback-id")
+	s.Require().Equal(icqtypes.STAKING_STORE_QUERY_WITH_PROOF, batchQuery.QueryType, "query type")
+	s.Require().Len(batchQuery.RequestKeys, 3)
+
+	// Map the query request keys to the validator names to get the names of the validators that
 	// were queried
 	queriedValidators := []string{}
-	for i, query := range queries {
-		validator, ok := tc.validatorQueryDataToName[string(query.RequestData)]
-		s.Require().True(ok, "query from response %d does not match any expected requests", i)
+	for i, requestKey := range batchQuery.RequestKeys {
+		validator, ok := tc.validatorQueryDataToName[string(requestKey)]
+		s.Require().True(ok, "request key %d does not match any expected requests", i)
 		queriedValidators = append(queriedValidators, validator)
 	}
 
@@ -0,0 +1,142 @@
+package keeper
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	epochstypes "github.com/Stride-Labs/stride/v24/x/epochs/types"
+	icaoracletypes "github.com/Stride-Labs/stride/v24/x/icaoracle/types"
+	recordstypes "github.com/Stride-Labs/stride/v24/x/records/types"
+	"github.com/Stride-Labs/stride/v24/x/stakeibc/types"
+)
+
+const NanosecondsPerYear = int64(time.Hour * 24 * 365)
+
+// Returns the metric producers for each stToken metric that is pushed to the oracles
+// These are registered with the icaoracle keeper in app.go
+func (k Keeper) OracleMetricProducers() []icaoracletypes.MetricProducer {
+	return []icaoracletypes.MetricProducer{
+		{MetricType: icaoracletypes.MetricType_TVL, ProduceFunc: k.ProduceTVLMetrics},
+		{MetricType: icaoracletypes.MetricType_APR, ProduceFunc: k.ProduceAPRMetrics},
+		{MetricType: icaoracletypes.MetricType_PendingRedemptions, ProduceFunc: k.ProducePendingRedemptionMetrics},
+		{MetricType: icaoracletypes.MetricType_Halted, ProduceFunc: k.ProduceHaltedMetrics},
+	}
+}
+
+// Builds the oracle metric for an stToken
+// Metric Key is of format: {stToken}_{metricType}
+func newStTokenMetricValue(hostZone types.HostZone, metricType, value string) (icaoracletypes.MetricValue, error) {
+	stDenom := types.StAssetDenomFromHostZoneDenom(hostZone.HostDenom)
+	attributes, err := json.Marshal(icaoracletypes.StTokenMetricAttributes{
+		SttokenDenom: stDenom,
+	})
+	if err != nil {
+		return icaoracletypes.MetricValue{}, err
+	}
+
+	return icaoracletypes.MetricValue{
+		Key:        fmt.Sprintf("%s_%s", stDenom, metricType),
+		Value:      value,
+		Attributes: string(attributes),
+	}, nil
+}
+
+// Produces the TVL of each stToken, denominated in the native token
+// TVL is calculated as the stToken supply multiplied by the redemption rate
+func (k Keeper) ProduceTVLMetrics(ctx sdk.Context) (metrics []icaoracletypes.MetricValue, err error) {
+	for _, hostZone := range k.GetAllActiveHostZone(ctx) {
+		stDenom := types.StAssetDenomFromHostZoneDenom(hostZone.HostDenom)
+		stSupply := k.bankKeeper.GetSupply(ctx, stDenom).Amount
+		tvl := sdk.NewDecFromInt(stSupply).Mul(hostZone.RedemptionRate).TruncateInt()
+
+		metric, err := newStTokenMetricValue(hostZone, icaoracletypes.MetricType_TVL, tvl.String())
+		if err != nil {
+			return nil, err
+		}
+		metrics = append(metrics, metric)
+	}
+	return metrics, nil
+}
+
+// Produces the trailing APR of each stToken, annualized from the change in redemption
+// rate across the most recent redemption rate update
+// Host zones without a previous redemption rate are skipped
+func (k Keeper) ProduceAPRMetrics(ctx sdk.Context) (metrics []icaoracletypes.MetricValue, err error) {
+	strideEpochTracker, found := k.GetEpochTracker(ctx, epochstypes.STRIDE_EPOCH)
+	if !found {
+		return nil, errorsmod.Wrapf(types.ErrEpochNotFound, "epoch %s not found", epochstypes.STRIDE_EPOCH)
+	}
+
+	// The redemption rate is updated every N stride epochs
+	redemptionRateInterval := k.GetParam(ctx, types.KeyRedemptionRateInterval)
+	updatePeriodNanos := sdkmath.NewIntFromUint64(strideEpochTracker.Duration).Mul(sdkmath.NewIntFromUint64(redemptionRateInterval))
+	if updatePeriodNanos.IsZero() {
+		return nil, errorsmod.Wrapf(types.ErrInvalidEpoch, "redemption rate update period must be positive")
+	}
+	periodsPerYear := sdk.NewDec(NanosecondsPerYear).Quo(sdk.NewDecFromInt(updatePeriodNanos))
+
+	for _, hostZone := range k.GetAllActiveHostZone(ctx) {
+		if hostZone.LastRedemptionRate.IsNil() || !hostZone.LastRedemptionRate.IsPositive() {
+			continue
+		}
+		periodReturn := hostZone.RedemptionRate.Quo(hostZone.LastRedemptionRate).Sub(sdk.OneDec())
+		apr := periodReturn.Mul(periodsPerYear)
+
+		metric, err := newStTokenMetricValue(hostZone, icaoracletypes.MetricType_APR, apr.String())
+		if err != nil {
+			return nil, err
+		}
+		metrics = append(metrics, metric)
+	}
+	return metrics, nil
+}
+
+// Produces the amount of stTokens that have been redeemed but are not yet claimable
+func (k Keeper) ProducePendingRedemptionMetrics(ctx sdk.Context) (metrics []icaoracletypes.MetricValue, err error) {
+	pendingRedemptions := map[string]sdkmath.Int{}
+	for _, epochUnbondingRecord := range k.RecordsKeeper.GetAllEpochUnbondingRecord(ctx) {
+		for _, hostZoneUnbonding := range epochUnbondingRecord.HostZoneUnbondings {
+			if hostZoneUnbonding.Status == recordstypes.HostZoneUnbonding_CLAIMABLE || hostZoneUnbonding.StTokenAmount.IsNil() {
+				continue
+			}
+			total, ok := pendingRedemptions[hostZoneUnbonding.HostZoneId]
+			if !ok {
+				total = sdkmath.ZeroInt()
+			}
+			pendingRedemptions[hostZoneUnbonding.HostZoneId] = total.Add(hostZoneUnbonding.StTokenAmount)
+		}
+	}
+
+	for _, hostZone := range k.GetAllActiveHostZone(ctx) {
+		pendingAmount, ok := pendingRedemptions[hostZone.ChainId]
+		if !ok {
+			pendingAmount = sdkmath.ZeroInt()
+		}
+
+		metric, err := newStTokenMetricValue(hostZone, icaoracletypes.MetricType_PendingRedemptions, pendingAmount.String())
+		if err != nil {
+			return nil, err
+		}
+		metrics = append(metrics, metric)
+	}
+	return metrics, nil
+}
+
+// Produces a flag indicating whether each host zone is halted
+// Unlike the other metrics, this includes halted host zones
+func (k Keeper) ProduceHaltedMetrics(ctx sdk.Context) (metrics []icaoracletypes.MetricValue, err error) {
+	for _, hostZone := range k.GetAllHostZone(ctx) {
+		metric, err := newStTokenMetricValue(hostZone, icaoracletypes.MetricType_Halted, strconv.FormatBool(hostZone.Halted))
+		if err != nil {
+			return nil, err
+		}
+		metrics = append(metrics, metric)
+	}
+	return metrics, nil
+}
@@ -0,0 +1,151 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	epochtypes "github.com/Stride-Labs/stride/v24/x/epochs/types"
+	icaoracletypes "github.com/Stride-Labs/stride/v24/x/icaoracle/types"
+	recordtypes "github.com/Stride-Labs/stride/v24/x/records/types"
+	"github.com/Stride-Labs/stride/v24/x/stakeibc/keeper"
+	"github.com/Stride-Labs/stride/v24/x/stakeibc/types"
+)
+
+// Creates an active host zone (uatom) and a halted host zone (uosmo)
+func (s *KeeperTestSuite) SetupOracleMetricHostZones() {
+	s.App.StakeibcKeeper.SetHostZone(s.Ctx, types.HostZone{
+		ChainId:            "cosmoshub-4",
+		HostDenom:          "uatom",
+		RedemptionRate:     sdk.MustNewDecFromStr("1.5"),
+		LastRedemptionRate: sdk.MustNewDecFromStr("1.2"),
+	})
+	s.App.StakeibcKeeper.SetHostZone(s.Ctx, types.HostZone{
+		ChainId:            "osmosis-1",
+		HostDenom:          "uosmo",
+		RedemptionRate:     sdk.MustNewDecFromStr("1.0"),
+		LastRedemptionRate: sdk.MustNewDecFromStr("1.0"),
+		Halted:             true,
+	})
+}
+
+func (s *KeeperTestSuite) TestOracleMetricProducers() {
+	expectedMetricTypes := []string{
+		icaoracletypes.MetricType_TVL,
+		icaoracletypes.MetricType_APR,
+		icaoracletypes.MetricType_PendingRedemptions,
+		icaoracletypes.MetricType_Halted,
+	}
+
+	// Confirm there's a producer for each metric type, and that they're registered with the oracle
+	registeredTypes := s.App.ICAOracleKeeper.GetRegisteredMetricTypes()
+	for i, producer := range s.App.StakeibcKeeper.OracleMetricProducers() {
+		s.Require().Equal(expectedMetricTypes[i], producer.MetricType, "metric type")
+		s.Require().NotNil(producer.ProduceFunc, "produce function for %s", producer.MetricType)
+		s.Require().Contains(registeredTypes, producer.MetricType, "registered metric types")
+	}
+}
+
+func (s *KeeperTestSuite) TestProduceTVLMetrics() {
+	s.SetupOracleMetricHostZones()
+	s.FundAccount(s.TestAccs[0], sdk.NewInt64Coin("stuatom", 1000))
+
+	metrics, err := s.App.StakeibcKeeper.ProduceTVLMetrics(s.Ctx)
+	s.Require().NoError(err, "no error expected when producing tvl metrics")
+
+	// Only the active host zone should be included - TVL is 1000 * 1.5
+	s.Require().Equal([]icaoracletypes.MetricValue{{
+		Key:        "stuatom_tvl",
+		Value:      "1500",
+		Attributes: `{"sttoken_denom":"stuatom"}`,
+	}}, metrics)
+}
+
+func (s *KeeperTestSuite) TestProduceAPRMetrics() {
+	s.SetupOracleMetricHostZones()
+
+	// Without the stride epoch tracker, the APR cannot be calculated
+	_, err := s.App.StakeibcKeeper.ProduceAPRMetrics(s.Ctx)
+	s.Require().ErrorContains(err, "epoch stride_epoch not found")
+
+	epochDuration := time.Hour * 6
+	s.App.StakeibcKeeper.SetEpochTracker(s.Ctx, types.EpochTracker{
+		EpochIdentifier: epochtypes.STRIDE_EPOCH,
+		Duration:        uint64(epochDuration.Nanoseconds()),
+	})
+
+	metrics, err := s.App.StakeibcKeeper.ProduceAPRMetrics(s.Ctx)
+	s.Require().NoError(err, "no error expected when producing apr metrics")
+
+	// The redemption rate increased 25% (1.2 -> 1.5) over one update period
+	redemptionRateInterval := s.App.StakeibcKeeper.GetParam(s.Ctx, types.KeyRedemptionRateInterval)
+	updatePeriod := sdkmath.NewInt(epochDuration.Nanoseconds()).Mul(sdkmath.NewIntFromUint64(redemptionRateInterval))
+	periodsPerYear := sdk.NewDec(keeper.NanosecondsPerYear).Quo(sdk.NewDecFromInt(updatePeriod))
+	expectedAPR := sdk.MustNewDecFromStr("0.25").Mul(periodsPerYear)
+
+	s.Require().Len(metrics, 1, "number of apr metrics")
+	s.Require().Equal("stuatom_apr", metrics[0].Key, "apr metric key")
+	s.Require().Equal(expectedAPR.String(), metrics[0].Value, "apr metric value")
+
+	// If the host zone doesn't have a previous redemption rate, it should be skipped
+	hostZone := s.MustGetHostZone("cosmoshub-4")
+	hostZone.LastRedemptionRate = sdk.ZeroDec()
+	s.App.StakeibcKeeper.SetHostZone(s.Ctx, hostZone)
+
+	metrics, err = s.App.StakeibcKeeper.ProduceAPRMetrics(s.Ctx)
+	s.Require().NoError(err, "no error expected when producing apr metrics without a previous rate")
+	s.Require().Empty(metrics, "apr metrics without a previous rate")
+}
+
+func (s *KeeperTestSuite) TestProducePendingRedemptionMetrics() {
+	s.SetupOracleMetricHostZones()
+
+	epochUnbondingRecords := []recordtypes.EpochUnbondingRecord{
+		{
+			EpochNumber: 1,
+			HostZoneUnbondings: []*recordtypes.HostZoneUnbonding{
+				{HostZoneId: "cosmoshub-4", StTokenAmount: sdkmath.NewInt(100), Status: recordtypes.HostZoneUnbonding_UNBONDING_QUEUE},
+				{HostZoneId: "osmosis-1", StTokenAmount: sdkmath.NewInt(200), Status: recordtypes.HostZoneUnbonding_UNBONDING_QUEUE},
+			},
+		},
+		{
+			EpochNumber: 2,
+			HostZoneUnbondings: []*recordtypes.HostZoneUnbonding{
+				{HostZoneId: "cosmoshub-4", StTokenAmount: sdkmath.NewInt(50), Status: recordtypes.HostZoneUnbonding_CLAIMABLE},
+			},
+		},
+		{
+			EpochNumber: 3,
+			HostZoneUnbondings: []*recordtypes.HostZoneUnbonding{
+				{HostZoneId: "cosmoshub-4", StTokenAmount: sdkmath.NewInt(25), Status: recordtypes.HostZoneUnbonding_EXIT_TRANSFER_QUEUE},
+			},
+		},
+	}
+	for _, epochUnbondingRecord := range epochUnbondingRecords {
+		s.App.RecordsKeeper.SetEpochUnbondingRecord(s.Ctx, epochUnbondingRecord)
+	}
+
+	metrics, err := s.App.StakeibcKeeper.ProducePendingRedemptionMetrics(s.Ctx)
+	s.Require().NoError(err, "no error expected when producing pending redemption metrics")
+
+	// Only the active host zone should be included, and the claimable record should be excluded
+	s.Require().Equal([]icaoracletypes.MetricValue{{
+		Key:        "stuatom_pending_redemptions",
+		Value:      "125",
+		Attributes: `{"sttoken_denom":"stuatom"}`,
+	}}, metrics)
+}
+
+func (s *KeeperTestSuite) TestProduceHaltedMetrics() {
+	s.SetupOracleMetricHostZones()
+
+	metrics, err := s.App.StakeibcKeeper.ProduceHaltedMetrics(s.Ctx)
+	s.Require().NoError(err, "no error expected when producing halted metrics")
+
+	// Both host zones should be included
+	s.Require().Equal([]icaoracletypes.MetricValue{
+		{Key: "stuatom_halted", Value: "false", Attributes: `{"sttoken_denom":"stuatom"}`},
+		{Key: "stuosmo_halted", Value: "true", Attributes: `{"sttoken_denom":"stuosmo"}`},
+	}, metrics)
+}
@@ -13,6 +13,7 @@ import (
 	"github.com/cosmos/gogoproto/proto"
 
 	"github.com/Stride-Labs/stride/v24/utils"
+	epochstypes "github.com/Stride-Labs/stride/v24/x/epochs/types"
 	recordstypes "github.com/Stride-Labs/stride/v24/x/records/types"
 	"github.com/Stride-Labs/stride/v24/x/stakeibc/types"
 )
@@ -469,14 +470,14 @@ func (k Keeper) UnbondFromHostZone(ctx sdk.Context, hostZone types.HostZone) (er
 
 // this function iterates each host zone, and if it's the right time to
 // initiate an unbonding, it attempts to unbond all outstanding records
-func (k Keeper) InitiateAllHostZoneUnbondings(ctx sdk.Context, dayNumber uint64) {
+func (k Keeper) InitiateAllHostZoneUnbondings(ctx sdk.Context, dayNumber uint64, missedDays uint64) {
 	k.Logger(ctx).Info(fmt.Sprintf("Initiating all host zone unbondings for epoch %d...", dayNumber))
 
 	for _, hostZone := range k.GetAllActiveHostZone(ctx) {
 
 		// Confirm the unbonding is supposed to be triggered this epoch
 		unbondingFrequency := hostZone.GetUnbondingFrequency()
-		if dayNumber%unbondingFrequency != 0 {
+		if !epochstypes.IsEpochIntervalBoundary(dayNumber, missedDays, unbondingFrequency) {
 			k.Logger(ctx).Info(utils.LogWithHostZone(hostZone.ChainId,
 				"Host does not unbond this epoch (Unbonding Period: %d, Unbonding Frequency: %d, Epoch: %d)",
 				hostZone.UnbondingPeriod, unbondingFrequency, dayNumber))
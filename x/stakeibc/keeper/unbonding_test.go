@@ -1347,7 +1347,7 @@ func (s *KeeperTestSuite) SetupInitiateAllHostZoneUnbondings() {
 func (s *KeeperTestSuite) TestInitiateAllHostZoneUnbondings_Successful() {
 	// tests that we can successful initiate a host zone unbonding for GAIA and OSMO
 	s.SetupInitiateAllHostZoneUnbondings()
-	s.App.StakeibcKeeper.InitiateAllHostZoneUnbondings(s.Ctx, 12)
+	s.App.StakeibcKeeper.InitiateAllHostZoneUnbondings(s.Ctx, 12, 0)
 
 	// An event should be emitted for each if they were successful
 	s.CheckEventValueEmitted(types.EventTypeUndelegation, types.AttributeKeyHostZone, HostChainId)
@@ -1357,7 +1357,7 @@ func (s *KeeperTestSuite) TestInitiateAllHostZoneUnbondings_Successful() {
 func (s *KeeperTestSuite) TestInitiateAllHostZoneUnbondings_GaiaSuccessful() {
 	// Tests that if we initiate unbondings a day where only Gaia is supposed to unbond, it succeeds and Osmo is ignored
 	s.SetupInitiateAllHostZoneUnbondings()
-	s.App.StakeibcKeeper.InitiateAllHostZoneUnbondings(s.Ctx, 9)
+	s.App.StakeibcKeeper.InitiateAllHostZoneUnbondings(s.Ctx, 9, 0)
 
 	// An event should only be emitted for Gaia
 	s.CheckEventValueEmitted(types.EventTypeUndelegation, types.AttributeKeyHostZone, HostChainId)
@@ -1367,7 +1367,7 @@ func (s *KeeperTestSuite) TestInitiateAllHostZoneUnbondings_GaiaSuccessful() {
 func (s *KeeperTestSuite) TestInitiateAllHostZoneUnbondings_OsmoSuccessful() {
 	// Tests that if we initiate unbondings a day where only Osmo is supposed to unbond, it succeeds and Gaia is ignored
 	s.SetupInitiateAllHostZoneUnbondings()
-	s.App.StakeibcKeeper.InitiateAllHostZoneUnbondings(s.Ctx, 8)
+	s.App.StakeibcKeeper.InitiateAllHostZoneUnbondings(s.Ctx, 8, 0)
 
 	// An event should only be emitted for Osmo
 	s.CheckEventValueNotEmitted(types.EventTypeUndelegation, types.AttributeKeyHostZone, HostChainId)
@@ -1377,13 +1377,24 @@ func (s *KeeperTestSuite) TestInitiateAllHostZoneUnbondings_OsmoSuccessful() {
 func (s *KeeperTestSuite) TestInitiateAllHostZoneUnbondings_NoneSuccessful() {
 	// Tests that if we initiate unbondings a day where none are supposed to unbond, it works successfully
 	s.SetupInitiateAllHostZoneUnbondings()
-	s.App.StakeibcKeeper.InitiateAllHostZoneUnbondings(s.Ctx, 10)
+	s.App.StakeibcKeeper.InitiateAllHostZoneUnbondings(s.Ctx, 10, 0)
 
 	// No event should be emitted for either host
 	s.CheckEventValueNotEmitted(types.EventTypeUndelegation, types.AttributeKeyHostZone, HostChainId)
 	s.CheckEventValueNotEmitted(types.EventTypeUndelegation, types.AttributeKeyHostZone, OsmoChainId)
 }
 
+func (s *KeeperTestSuite) TestInitiateAllHostZoneUnbondings_MissedEpochs() {
+	// Tests that if the day epoch jumped from 11 to 13 after a chain halt, the unbondings
+	// for the missed day 12 are still initiated
+	s.SetupInitiateAllHostZoneUnbondings()
+	s.App.StakeibcKeeper.InitiateAllHostZoneUnbondings(s.Ctx, 13, 1)
+
+	// An event should be emitted for each host since day 12 is on both cadences
+	s.CheckEventValueEmitted(types.EventTypeUndelegation, types.AttributeKeyHostZone, HostChainId)
+	s.CheckEventValueEmitted(types.EventTypeUndelegation, types.AttributeKeyHostZone, OsmoChainId)
+}
+
 func (s *KeeperTestSuite) TestInitiateAllHostZoneUnbondings_Failed() {
 	// Tests that if Gaia doesn't have enough delegated stake to unbond, it fails
 	// but Osmo does and is successful
@@ -1399,7 +1410,7 @@ func (s *KeeperTestSuite) TestInitiateAllHostZoneUnbondings_Failed() {
 	s.App.StakeibcKeeper.SetHostZone(s.Ctx, hostZone)
 	hostZone, _ = s.App.StakeibcKeeper.GetHostZone(s.Ctx, HostChainId)
 
-	s.App.StakeibcKeeper.InitiateAllHostZoneUnbondings(s.Ctx, 12)
+	s.App.StakeibcKeeper.InitiateAllHostZoneUnbondings(s.Ctx, 12, 0)
 
 	// An event should only be emitted for Osmo
 	s.CheckEventValueNotEmitted(types.EventTypeUndelegation, types.AttributeKeyHostZone, HostChainId)
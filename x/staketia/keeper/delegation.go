@@ -141,30 +141,64 @@ func (k Keeper) PrepareDelegation(ctx sdk.Context, epochNumber uint64, epochDura
 
 // Confirms a delegation has completed on the host zone, increments the internal delegated balance,
 // and archives the record
+// If ICQ verification is enabled, the confirmation is instead queued until the delegations
+// have been proven with an interchain query
 func (k Keeper) ConfirmDelegation(ctx sdk.Context, recordId uint64, txHash string, sender string) (err error) {
+	delegationRecord, hostZone, err := k.ValidateConfirmDelegation(ctx, recordId)
+	if err != nil {
+		return err
+	}
+
+	if hostZone.IsICQVerificationEnabled() {
+		pendingConfirmation := types.PendingConfirmation{
+			ConfirmationType: types.CONFIRM_DELEGATION,
+			RecordId:         recordId,
+			TxHash:           txHash,
+			Sender:           sender,
+		}
+		return k.SubmitConfirmationQueries(ctx, hostZone, pendingConfirmation)
+	}
+
+	k.FinalizeConfirmDelegation(ctx, hostZone, delegationRecord, txHash, sender)
+	return nil
+}
+
+// Validates that a delegation record is ready to be confirmed and returns the record and host zone
+func (k Keeper) ValidateConfirmDelegation(ctx sdk.Context, recordId uint64) (types.DelegationRecord, types.HostZone, error) {
 	// grab unbonding record, verify record is ready to be delegated, and a hash hasn't already been posted
 	delegationRecord, found := k.GetDelegationRecord(ctx, recordId)
 	if !found {
-		return types.ErrDelegationRecordNotFound.Wrapf("delegation record not found for %v", recordId)
+		return delegationRecord, types.HostZone{}, types.ErrDelegationRecordNotFound.Wrapf("delegation record not found for %v", recordId)
 	}
 	if delegationRecord.Status != types.DELEGATION_QUEUE {
-		return types.ErrDelegationRecordInvalidState.Wrapf("delegation record %v is not in the correct state", recordId)
+		return delegationRecord, types.HostZone{}, types.ErrDelegationRecordInvalidState.Wrapf("delegation record %v is not in the correct state", recordId)
 	}
 	if delegationRecord.TxHash != "" {
-		return types.ErrDelegationRecordInvalidState.Wrapf("delegation record %v already has a txHash", recordId)
+		return delegationRecord, types.HostZone{}, types.ErrDelegationRecordInvalidState.Wrapf("delegation record %v already has a txHash", recordId)
 	}
 
 	// note: we're intentionally not checking that the host zone is halted, because we still want to process this tx in that case
 	hostZone, err := k.GetHostZone(ctx)
 	if err != nil {
-		return err
+		return delegationRecord, hostZone, err
 	}
 
 	// verify delegation record is nonzero
 	if !delegationRecord.NativeAmount.IsPositive() {
-		return types.ErrDelegationRecordInvalidState.Wrapf("delegation record %v has non positive delegation", recordId)
+		return delegationRecord, hostZone, types.ErrDelegationRecordInvalidState.Wrapf("delegation record %v has non positive delegation", recordId)
 	}
 
+	return delegationRecord, hostZone, nil
+}
+
+// Archives a confirmed delegation record and increments the delegated balance on the host zone
+func (k Keeper) FinalizeConfirmDelegation(
+	ctx sdk.Context,
+	hostZone types.HostZone,
+	delegationRecord types.DelegationRecord,
+	txHash string,
+	sender string,
+) {
 	// update delegation record to archive it
 	delegationRecord.TxHash = txHash
 	delegationRecord.Status = types.DELEGATION_COMPLETE
@@ -174,7 +208,59 @@ func (k Keeper) ConfirmDelegation(ctx sdk.Context, recordId uint64, txHash strin
 	hostZone.DelegatedBalance = hostZone.DelegatedBalance.Add(delegationRecord.NativeAmount)
 	k.SetHostZone(ctx, hostZone)
 
-	EmitSuccessfulConfirmDelegationEvent(ctx, recordId, delegationRecord.NativeAmount, txHash, sender)
+	EmitSuccessfulConfirmDelegationEvent(ctx, delegationRecord.Id, delegationRecord.NativeAmount, txHash, sender)
+}
+
+// Adjusts the delegated balance after a validator was slashed, and creates a slash record as a log
+// Negative amounts are allowed in case we want to fix our record keeping
+// If ICQ verification is enabled, the adjustment is instead queued until the new delegated
+// balance has been proven with an interchain query
+func (k Keeper) AdjustDelegatedBalance(ctx sdk.Context, delegationOffset sdkmath.Int, validatorAddress string, sender string) error {
+	// Note: we're intentionally not checking the zone is halted
+	hostZone, err := k.GetHostZone(ctx)
+	if err != nil {
+		return err
+	}
+
+	if hostZone.IsICQVerificationEnabled() {
+		pendingConfirmation := types.PendingConfirmation{
+			ConfirmationType: types.ADJUST_DELEGATED_BALANCE,
+			Sender:           sender,
+			DelegationOffset: delegationOffset,
+			ValidatorAddress: validatorAddress,
+		}
+		return k.SubmitConfirmationQueries(ctx, hostZone, pendingConfirmation)
+	}
+
+	return k.FinalizeAdjustDelegatedBalance(ctx, hostZone, delegationOffset, validatorAddress)
+}
+
+// Adds the offset to the delegated balance and writes a slash record
+func (k Keeper) FinalizeAdjustDelegatedBalance(
+	ctx sdk.Context,
+	hostZone types.HostZone,
+	delegationOffset sdkmath.Int,
+	validatorAddress string,
+) error {
+	// add offset to the delegated balance and write to host zone
+	hostZone.DelegatedBalance = hostZone.DelegatedBalance.Add(delegationOffset)
+
+	// safety check that this will not cause the delegated balance to be negative
+	if hostZone.DelegatedBalance.IsNegative() {
+		return types.ErrNegativeNotAllowed.Wrapf("offset would cause the delegated balance to be negative")
+	}
+	k.SetHostZone(ctx, hostZone)
+
+	// create a corresponding slash record
+	latestSlashRecordId := k.IncrementSlashRecordId(ctx)
+	slashRecord := types.SlashRecord{
+		Id:               latestSlashRecordId,
+		Time:             uint64(ctx.BlockTime().Unix()),
+		NativeAmount:     delegationOffset,
+		ValidatorAddress: validatorAddress,
+	}
+	k.SetSlashRecord(ctx, slashRecord)
+
 	return nil
 }
 
@@ -179,3 +179,15 @@ func (k Keeper) SlashRecords(c context.Context, req *types.QuerySlashRecordsRequ
 
 	return &types.QuerySlashRecordsResponse{SlashRecords: slashRecords}, nil
 }
+
+// Queries all operator confirmations that are awaiting ICQ verification
+func (k Keeper) PendingConfirmations(c context.Context, req *types.QueryPendingConfirmationsRequest) (*types.QueryPendingConfirmationsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	pendingConfirmations := k.GetAllPendingConfirmations(ctx)
+
+	return &types.QueryPendingConfirmationsResponse{PendingConfirmations: pendingConfirmations}, nil
+}
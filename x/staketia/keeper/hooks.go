@@ -6,6 +6,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	epochstypes "github.com/Stride-Labs/stride/v24/x/epochs/types"
+	"github.com/Stride-Labs/stride/v24/x/staketia/types"
 )
 
 // This module has the following epochly triggers
@@ -45,7 +46,9 @@ func (k Keeper) BeforeEpochStart(ctx sdk.Context, epochInfo epochstypes.EpochInf
 		// Every few days (depending on the unbonding frequency) prepare undelegations which
 		// freezes the accumulating unbonding record and refreshes the native token amount
 		// TODO [cleanup]: replace with unbonding frequency
-		if epochInfo.CurrentEpoch%4 == 0 {
+		// If the day epoch jumped ahead after a chain halt, this will still trigger if
+		// any of the missed epochs fell on the undelegation cadence
+		if epochInfo.IsIntervalBoundary(4) {
 			if err := k.SafelyPrepareUndelegation(ctx, epochNumber); err != nil {
 				k.Logger(ctx).Error(fmt.Sprintf("Unable to prepare undelegations for epoch %d: %s", epochNumber, err.Error()))
 			}
@@ -86,3 +89,7 @@ func (h Hooks) BeforeEpochStart(ctx sdk.Context, epochInfo epochstypes.EpochInfo
 }
 
 func (h Hooks) AfterEpochEnd(ctx sdk.Context, epochInfo epochstypes.EpochInfo) {}
+
+func (h Hooks) GetModuleName() string {
+	return types.ModuleName
+}
@@ -105,29 +105,9 @@ func (k msgServer) AdjustDelegatedBalance(goCtx context.Context, msg *types.MsgA
 		return nil, err
 	}
 
-	// add offset to the delegated balance and write to host zone
-	// Note: we're intentionally not checking the zone is halted
-	hostZone, err := k.GetHostZone(ctx)
-	if err != nil {
+	if err := k.Keeper.AdjustDelegatedBalance(ctx, msg.DelegationOffset, msg.ValidatorAddress, msg.Operator); err != nil {
 		return nil, err
 	}
-	hostZone.DelegatedBalance = hostZone.DelegatedBalance.Add(msg.DelegationOffset)
-
-	// safety check that this will not cause the delegated balance to be negative
-	if hostZone.DelegatedBalance.IsNegative() {
-		return nil, types.ErrNegativeNotAllowed.Wrapf("offset would cause the delegated balance to be negative")
-	}
-	k.SetHostZone(ctx, hostZone)
-
-	// create a corresponding slash record
-	latestSlashRecordId := k.IncrementSlashRecordId(ctx)
-	slashRecord := types.SlashRecord{
-		Id:               latestSlashRecordId,
-		Time:             uint64(ctx.BlockTime().Unix()),
-		NativeAmount:     msg.DelegationOffset,
-		ValidatorAddress: msg.ValidatorAddress,
-	}
-	k.SetSlashRecord(ctx, slashRecord)
 
 	return &types.MsgAdjustDelegatedBalanceResponse{}, nil
 }
@@ -283,3 +263,47 @@ func (k msgServer) SetOperatorAddress(goCtx context.Context, msg *types.MsgSetOp
 
 	return &types.MsgSetOperatorAddressResponse{}, nil
 }
+
+// SAFE transaction to configure the ICQ verification of operator confirmations
+func (k msgServer) SetICQVerification(goCtx context.Context, msg *types.MsgSetICQVerification) (*types.MsgSetICQVerificationResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// gate this transaction to only the SAFE address
+	if err := k.CheckIsSafeAddress(ctx, msg.Signer); err != nil {
+		return nil, err
+	}
+
+	// Note: we're intentionally not checking the zone is halted
+	zone, err := k.GetHostZone(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	zone.IcqVerification = &types.ICQVerification{
+		Enabled:      msg.Enabled,
+		ConnectionId: msg.ConnectionId,
+		Validators:   msg.Validators,
+	}
+	k.SetHostZone(ctx, zone)
+
+	return &types.MsgSetICQVerificationResponse{}, nil
+}
+
+// SAFE transaction to clear a pending confirmation whose verification queries are stuck
+func (k msgServer) ClearPendingConfirmation(
+	goCtx context.Context,
+	msg *types.MsgClearPendingConfirmation,
+) (*types.MsgClearPendingConfirmationResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// gate this transaction to only the SAFE address
+	if err := k.CheckIsSafeAddress(ctx, msg.Signer); err != nil {
+		return nil, err
+	}
+
+	if err := k.Keeper.ClearPendingConfirmation(ctx, msg.PendingConfirmationId); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgClearPendingConfirmationResponse{}, nil
+}
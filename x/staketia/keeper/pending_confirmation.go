@@ -0,0 +1,85 @@
+package keeper
+
+import (
+	"encoding/binary"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Stride-Labs/stride/v24/x/staketia/types"
+)
+
+// Writes a pending confirmation to the store
+func (k Keeper) SetPendingConfirmation(ctx sdk.Context, pendingConfirmation types.PendingConfirmation) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.PendingConfirmationsKeyPrefix)
+
+	key := types.IntKey(pendingConfirmation.Id)
+	value := k.cdc.MustMarshal(&pendingConfirmation)
+
+	store.Set(key, value)
+}
+
+// Reads a pending confirmation from the store
+func (k Keeper) GetPendingConfirmation(ctx sdk.Context, id uint64) (pendingConfirmation types.PendingConfirmation, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.PendingConfirmationsKeyPrefix)
+
+	pendingConfirmationBz := store.Get(types.IntKey(id))
+	if len(pendingConfirmationBz) == 0 {
+		return pendingConfirmation, false
+	}
+
+	k.cdc.MustUnmarshal(pendingConfirmationBz, &pendingConfirmation)
+	return pendingConfirmation, true
+}
+
+// Removes a pending confirmation from the store
+func (k Keeper) RemovePendingConfirmation(ctx sdk.Context, id uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.PendingConfirmationsKeyPrefix)
+	store.Delete(types.IntKey(id))
+}
+
+// Returns all pending confirmations
+func (k Keeper) GetAllPendingConfirmations(ctx sdk.Context) (pendingConfirmations []types.PendingConfirmation) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.PendingConfirmationsKeyPrefix)
+
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	pendingConfirmations = []types.PendingConfirmation{}
+	for ; iterator.Valid(); iterator.Next() {
+		pendingConfirmation := types.PendingConfirmation{}
+		k.cdc.MustUnmarshal(iterator.Value(), &pendingConfirmation)
+		pendingConfirmations = append(pendingConfirmations, pendingConfirmation)
+	}
+
+	return pendingConfirmations
+}
+
+// Checks if there is already a pending confirmation for the given action and record
+// Adjustments are not associated with a record, so only one adjustment can be pending at a time
+func (k Keeper) IsConfirmationPending(ctx sdk.Context, confirmationType types.PendingConfirmationType, recordId uint64) bool {
+	for _, pendingConfirmation := range k.GetAllPendingConfirmations(ctx) {
+		if pendingConfirmation.ConfirmationType == confirmationType && pendingConfirmation.RecordId == recordId {
+			return true
+		}
+	}
+	return false
+}
+
+// Increments the current pending confirmation ID and returns the new ID
+func (k Keeper) IncrementPendingConfirmationId(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	currentIdBz := store.Get(types.PendingConfirmationIdKey)
+
+	// return 1 if there's nothing in the store yet
+	currentId := uint64(1)
+	if len(currentIdBz) != 0 {
+		currentId = binary.BigEndian.Uint64(currentIdBz)
+	}
+
+	// Increment the ID
+	nextId := currentId + 1
+	store.Set(types.PendingConfirmationIdKey, types.IntKey(nextId))
+
+	return nextId
+}
@@ -153,34 +153,67 @@ func (k Keeper) PrepareUndelegation(ctx sdk.Context, epochNumber uint64) error {
 
 // Confirms that an undelegation has been completed on the host zone
 // Updates the record status to UNBONDING_IN_PROGRESS, decrements the delegated balance and burns stTokens
+// If ICQ verification is enabled, the confirmation is instead queued until the unbondings
+// have been proven with an interchain query
 func (k Keeper) ConfirmUndelegation(ctx sdk.Context, recordId uint64, txHash string, sender string) (err error) {
+	record, hostZone, err := k.ValidateConfirmUndelegation(ctx, recordId)
+	if err != nil {
+		return err
+	}
+
+	if hostZone.IsICQVerificationEnabled() {
+		pendingConfirmation := types.PendingConfirmation{
+			ConfirmationType: types.CONFIRM_UNDELEGATION,
+			RecordId:         recordId,
+			TxHash:           txHash,
+			Sender:           sender,
+		}
+		return k.SubmitConfirmationQueries(ctx, hostZone, pendingConfirmation)
+	}
+
+	return k.FinalizeConfirmUndelegation(ctx, hostZone, record, txHash, sender)
+}
+
+// Validates that an unbonding record is ready to be confirmed and returns the record and host zone
+func (k Keeper) ValidateConfirmUndelegation(ctx sdk.Context, recordId uint64) (types.UnbondingRecord, types.HostZone, error) {
 	// grab unbonding record, verify it's in the right state, and no tx hash has been submitted yet
 	record, found := k.GetUnbondingRecord(ctx, recordId)
 	if !found {
-		return errorsmod.Wrapf(types.ErrUnbondingRecordNotFound, "couldn't find unbonding record with id: %d", recordId)
+		return record, types.HostZone{}, errorsmod.Wrapf(types.ErrUnbondingRecordNotFound, "couldn't find unbonding record with id: %d", recordId)
 	}
 	if record.Status != types.UNBONDING_QUEUE {
-		return errorsmod.Wrapf(types.ErrInvalidUnbondingRecord, "unbonding record with id: %d is not ready to be undelegated", recordId)
+		return record, types.HostZone{}, errorsmod.Wrapf(types.ErrInvalidUnbondingRecord, "unbonding record with id: %d is not ready to be undelegated", recordId)
 	}
 	if record.UndelegationTxHash != "" {
-		return errorsmod.Wrapf(types.ErrInvalidUnbondingRecord, "unbonding record with id: %d already has undelegation tx hash set", recordId)
+		return record, types.HostZone{}, errorsmod.Wrapf(types.ErrInvalidUnbondingRecord, "unbonding record with id: %d already has undelegation tx hash set", recordId)
 	}
 	if record.UnbondedTokenSweepTxHash != "" {
-		return errorsmod.Wrapf(types.ErrInvalidUnbondingRecord, "unbonding record with id: %d already has token sweep tx hash set", recordId)
+		return record, types.HostZone{}, errorsmod.Wrapf(types.ErrInvalidUnbondingRecord, "unbonding record with id: %d already has token sweep tx hash set", recordId)
 	}
 
 	// if there are no tokens to unbond (or negative on the record): throw an error!
 	noTokensUnbondedOrNegative := record.NativeAmount.LTE(sdk.ZeroInt()) || record.StTokenAmount.LTE(sdk.ZeroInt())
 	if noTokensUnbondedOrNegative {
-		return errorsmod.Wrapf(types.ErrInvalidUnbondingRecord, "unbonding record with id: %d has no tokens to unbond (or negative)", recordId)
+		return record, types.HostZone{}, errorsmod.Wrapf(types.ErrInvalidUnbondingRecord, "unbonding record with id: %d has no tokens to unbond (or negative)", recordId)
 	}
 
 	// Note: we're intentionally not checking that the host zone is halted, because we still want to process this tx in that case
 	hostZone, err := k.GetHostZone(ctx)
 	if err != nil {
-		return err
+		return record, hostZone, err
 	}
 
+	return record, hostZone, nil
+}
+
+// Updates the unbonding record to UNBONDING_IN_PROGRESS, decrements the delegated balance and burns stTokens
+func (k Keeper) FinalizeConfirmUndelegation(
+	ctx sdk.Context,
+	hostZone types.HostZone,
+	record types.UnbondingRecord,
+	txHash string,
+	sender string,
+) error {
 	// sanity check: store down the stToken supply and DelegatedBalance for checking against after burn
 	stDenom := utils.StAssetDenomFromHostZoneDenom(hostZone.NativeTokenDenom)
 	stTokenSupplyBefore := k.bankKeeper.GetSupply(ctx, stDenom).Amount
@@ -217,7 +250,7 @@ func (k Keeper) ConfirmUndelegation(ctx sdk.Context, recordId uint64, txHash str
 		return errorsmod.Wrap(err, "ratio of delegation change to burned tokens exceeds redemption rate bounds")
 	}
 
-	EmitSuccessfulConfirmUndelegationEvent(ctx, recordId, record.NativeAmount, txHash, sender)
+	EmitSuccessfulConfirmUndelegationEvent(ctx, record.Id, record.NativeAmount, txHash, sender)
 	return nil
 }
 
@@ -321,8 +354,19 @@ func (k Keeper) ConfirmUnbondedTokenSweep(ctx sdk.Context, recordId uint64, txHa
 	}
 
 	// verify the claim address has the same or more tokens than the record (necessary condition if sweep was successful)
+	// if ICQ verification is enabled, the balance must also cover each record that's already claimable, since
+	// those tokens have not yet been distributed
+	// unlike the delegation and undelegation confirmations, the sweep is not queued as a pending confirmation:
+	// the claim address lives on stride, so its balance is read directly from local state, which is at least as
+	// strong as a proof of the host's state and lets the record be finalized immediately
+	requiredClaimBalance := record.NativeAmount
+	if hostZone.IsICQVerificationEnabled() {
+		for _, claimableRecord := range k.GetAllUnbondingRecordsByStatus(ctx, types.CLAIMABLE) {
+			requiredClaimBalance = requiredClaimBalance.Add(claimableRecord.NativeAmount)
+		}
+	}
 	claimAddressBalance := k.bankKeeper.GetBalance(ctx, claimAddress, hostZone.NativeTokenIbcDenom)
-	if claimAddressBalance.Amount.LT(record.NativeAmount) {
+	if claimAddressBalance.Amount.LT(requiredClaimBalance) {
 		return errorsmod.Wrapf(types.ErrInsufficientFunds, "claim address %s has insufficient funds to confirm sweep unbonded tokens", hostZone.ClaimAddress)
 	}
 
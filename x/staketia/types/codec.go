@@ -24,6 +24,8 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	legacy.RegisterAminoMsg(cdc, &MsgOverwriteUnbondingRecord{}, "staketia/MsgOverwriteUnbondingRecord")
 	legacy.RegisterAminoMsg(cdc, &MsgOverwriteRedemptionRecord{}, "staketia/MsgOverwriteRedemptionRecord")
 	legacy.RegisterAminoMsg(cdc, &MsgSetOperatorAddress{}, "staketia/MsgSetOperatorAddress")
+	legacy.RegisterAminoMsg(cdc, &MsgSetICQVerification{}, "staketia/MsgSetICQVerification")
+	legacy.RegisterAminoMsg(cdc, &MsgClearPendingConfirmation{}, "staketia/MsgClearPendingConfirmation")
 
 }
 
@@ -42,6 +44,8 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 		&MsgOverwriteUnbondingRecord{},
 		&MsgOverwriteRedemptionRecord{},
 		&MsgSetOperatorAddress{},
+		&MsgSetICQVerification{},
+		&MsgClearPendingConfirmation{},
 	)
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
 }
@@ -29,4 +29,10 @@ var (
 	ErrDivisionByZero                    = errorsmod.Register(ModuleName, 1924, "division by zero")
 	ErrInvalidRecordType                 = errorsmod.Register(ModuleName, 1925, "invalid record type")
 	ErrInvalidGenesisRecords             = errorsmod.Register(ModuleName, 1926, "invalid records during genesis")
+	ErrInvalidICQVerification            = errorsmod.Register(ModuleName, 1927, "invalid ICQ verification config")
+	ErrPendingConfirmationNotFound       = errorsmod.Register(ModuleName, 1928, "pending confirmation not found")
+	ErrConfirmationAlreadyPending        = errorsmod.Register(ModuleName, 1929, "confirmation already pending")
+	ErrFailedToSubmitICQ                 = errorsmod.Register(ModuleName, 1930, "failed to submit ICQ")
+	ErrEpochNotFound                     = errorsmod.Register(ModuleName, 1931, "epoch not found")
+	ErrInvalidEpoch                      = errorsmod.Register(ModuleName, 1932, "invalid epoch")
 )
@@ -7,6 +7,9 @@ const (
 	EventTypeConfirmDelegationResponse = "confirm_delegation"
 	EventTypeConfirmUnbondedTokenSweep = "confirm_unbonded_token_sweep"
 	EventTypeConfirmUndelegation       = "confirm_undelegation"
+	EventTypeConfirmationPending       = "confirmation_pending"
+	EventTypeConfirmationRejected      = "confirmation_rejected"
+	EventTypeConfirmationCleared       = "confirmation_cleared"
 
 	AttributeKeyHostZone              = "host_zone"
 	AttributeKeyRedemptionRate        = "redemption_rate"
@@ -22,4 +25,8 @@ const (
 	AttributeTxHash                   = "tx_hash"
 	AttributeSender                   = "sender"
 	EventTypeHostZoneHalt             = "host_zone_halt"
+	AttributePendingConfirmationId    = "pending_confirmation_id"
+	AttributeConfirmationType         = "confirmation_type"
+	AttributeProvenAmount             = "proven_amount"
+	AttributeKeyError                 = "error"
 )
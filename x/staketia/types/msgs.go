@@ -24,6 +24,8 @@ const (
 	TypeMsgOverwriteUnbondingRecord        = "overwrite_unbonding_record"
 	TypeMsgOverwriteRedemptionRecord       = "overwrite_redemption_record"
 	TypeMsgSetOperatorAddress              = "set_operator_address"
+	TypeMsgSetICQVerification              = "set_icq_verification"
+	TypeMsgClearPendingConfirmation        = "clear_pending_confirmation"
 )
 
 var (
@@ -40,6 +42,8 @@ var (
 	_ sdk.Msg = &MsgOverwriteUnbondingRecord{}
 	_ sdk.Msg = &MsgOverwriteRedemptionRecord{}
 	_ sdk.Msg = &MsgSetOperatorAddress{}
+	_ sdk.Msg = &MsgSetICQVerification{}
+	_ sdk.Msg = &MsgClearPendingConfirmation{}
 
 	// Implement legacy interface for ledger support
 	_ legacytx.LegacyMsg = &MsgLiquidStake{}
@@ -55,6 +59,8 @@ var (
 	_ legacytx.LegacyMsg = &MsgOverwriteUnbondingRecord{}
 	_ legacytx.LegacyMsg = &MsgOverwriteRedemptionRecord{}
 	_ legacytx.LegacyMsg = &MsgSetOperatorAddress{}
+	_ legacytx.LegacyMsg = &MsgSetICQVerification{}
+	_ legacytx.LegacyMsg = &MsgClearPendingConfirmation{}
 )
 
 // ----------------------------------------------
@@ -471,6 +477,98 @@ func (msg *MsgSetOperatorAddress) ValidateBasic() error {
 	return nil
 }
 
+// ----------------------------------------------
+//             MsgSetICQVerification
+// ----------------------------------------------
+
+func NewMsgSetICQVerification(signer string, enabled bool, connectionId string, validators []string) *MsgSetICQVerification {
+	return &MsgSetICQVerification{
+		Signer:       signer,
+		Enabled:      enabled,
+		ConnectionId: connectionId,
+		Validators:   validators,
+	}
+}
+
+func (msg MsgSetICQVerification) Type() string {
+	return TypeMsgSetICQVerification
+}
+
+func (msg MsgSetICQVerification) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgSetICQVerification) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{signer}
+}
+
+func (msg *MsgSetICQVerification) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgSetICQVerification) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid signer address (%s)", err)
+	}
+
+	// The connection and validators are only required if verification is being enabled
+	if !msg.Enabled {
+		return nil
+	}
+	verification := ICQVerification{
+		Enabled:      msg.Enabled,
+		ConnectionId: msg.ConnectionId,
+		Validators:   msg.Validators,
+	}
+	return verification.ValidateBasic()
+}
+
+// ----------------------------------------------
+//          MsgClearPendingConfirmation
+// ----------------------------------------------
+
+func NewMsgClearPendingConfirmation(signer string, pendingConfirmationId uint64) *MsgClearPendingConfirmation {
+	return &MsgClearPendingConfirmation{
+		Signer:                signer,
+		PendingConfirmationId: pendingConfirmationId,
+	}
+}
+
+func (msg MsgClearPendingConfirmation) Type() string {
+	return TypeMsgClearPendingConfirmation
+}
+
+func (msg MsgClearPendingConfirmation) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgClearPendingConfirmation) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{signer}
+}
+
+func (msg *MsgClearPendingConfirmation) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgClearPendingConfirmation) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid signer address (%s)", err)
+	}
+	return nil
+}
+
 // ----------------------------------------------
 //       MsgRefreshRedemptionRate
 // ----------------------------------------------
@@ -948,3 +948,148 @@ func TestMsgSetOperatorAddress_ValidateBasic(t *testing.T) {
 		})
 	}
 }
+
+// ----------------------------------------------
+//               MsgSetICQVerification
+// ----------------------------------------------
+
+func TestMsgSetICQVerification_ValidateBasic(t *testing.T) {
+	apptesting.SetupConfig()
+
+	validAddress, invalidAddress := apptesting.GenerateTestAddrs()
+	validValidator := sdk.ValAddress(validAddress).String()
+
+	tests := []struct {
+		name string
+		msg  types.MsgSetICQVerification
+		err  string
+	}{
+		{
+			name: "successful enable",
+			msg: types.MsgSetICQVerification{
+				Signer:       validAddress,
+				Enabled:      true,
+				ConnectionId: "connection-0",
+				Validators:   []string{validValidator},
+			},
+		},
+		{
+			name: "successful disable",
+			msg: types.MsgSetICQVerification{
+				Signer:  validAddress,
+				Enabled: false,
+			},
+		},
+		{
+			name: "invalid signer address",
+			msg: types.MsgSetICQVerification{
+				Signer:  invalidAddress,
+				Enabled: false,
+			},
+			err: "invalid address",
+		},
+		{
+			name: "invalid connection id",
+			msg: types.MsgSetICQVerification{
+				Signer:       validAddress,
+				Enabled:      true,
+				ConnectionId: "channel-0",
+				Validators:   []string{validValidator},
+			},
+			err: "invalid connection ID",
+		},
+		{
+			name: "no validators",
+			msg: types.MsgSetICQVerification{
+				Signer:       validAddress,
+				Enabled:      true,
+				ConnectionId: "connection-0",
+			},
+			err: "at least one validator must be specified",
+		},
+		{
+			name: "invalid validator",
+			msg: types.MsgSetICQVerification{
+				Signer:       validAddress,
+				Enabled:      true,
+				ConnectionId: "connection-0",
+				Validators:   []string{"invalid"},
+			},
+			err: "invalid validator address",
+		},
+		{
+			name: "duplicate validator",
+			msg: types.MsgSetICQVerification{
+				Signer:       validAddress,
+				Enabled:      true,
+				ConnectionId: "connection-0",
+				Validators:   []string{validValidator, validValidator},
+			},
+			err: "duplicate validator",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if test.err == "" {
+				require.NoError(t, test.msg.ValidateBasic(), "test: %v", test.name)
+
+				signers := test.msg.GetSigners()
+				require.Equal(t, len(signers), 1)
+				require.Equal(t, signers[0].String(), validAddress)
+
+				require.Equal(t, test.msg.Type(), "set_icq_verification", "type")
+			} else {
+				require.ErrorContains(t, test.msg.ValidateBasic(), test.err, "test: %v", test.name)
+			}
+		})
+	}
+}
+
+// ----------------------------------------------
+//            MsgClearPendingConfirmation
+// ----------------------------------------------
+
+func TestMsgClearPendingConfirmation_ValidateBasic(t *testing.T) {
+	apptesting.SetupConfig()
+
+	validAddress, invalidAddress := apptesting.GenerateTestAddrs()
+
+	tests := []struct {
+		name string
+		msg  types.MsgClearPendingConfirmation
+		err  string
+	}{
+		{
+			name: "successful message",
+			msg: types.MsgClearPendingConfirmation{
+				Signer:                validAddress,
+				PendingConfirmationId: 1,
+			},
+		},
+		{
+			name: "invalid signer address",
+			msg: types.MsgClearPendingConfirmation{
+				Signer:                invalidAddress,
+				PendingConfirmationId: 1,
+			},
+			err: "invalid address",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if test.err == "" {
+				require.NoError(t, test.msg.ValidateBasic(), "test: %v", test.name)
+
+				signers := test.msg.GetSigners()
+				require.Equal(t, len(signers), 1)
+				require.Equal(t, signers[0].String(), validAddress)
+
+				require.Equal(t, test.msg.Type(), "clear_pending_confirmation", "type")
+			} else {
+				require.ErrorContains(t, test.msg.ValidateBasic(), test.err, "test: %v", test.name)
+			}
+		})
+	}
+}
@@ -636,6 +636,87 @@ func (m *RedemptionRecordResponse) GetUnbondingCompletionTimeSeconds() uint64 {
 	return 0
 }
 
+// Pending Confirmations
+type QueryPendingConfirmationsRequest struct {
+}
+
+func (m *QueryPendingConfirmationsRequest) Reset()         { *m = QueryPendingConfirmationsRequest{} }
+func (m *QueryPendingConfirmationsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPendingConfirmationsRequest) ProtoMessage()    {}
+func (*QueryPendingConfirmationsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_38d30838f2bbb0b1, []int{13}
+}
+func (m *QueryPendingConfirmationsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPendingConfirmationsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPendingConfirmationsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPendingConfirmationsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPendingConfirmationsRequest.Merge(m, src)
+}
+func (m *QueryPendingConfirmationsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPendingConfirmationsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPendingConfirmationsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPendingConfirmationsRequest proto.InternalMessageInfo
+
+type QueryPendingConfirmationsResponse struct {
+	PendingConfirmations []PendingConfirmation `protobuf:"bytes,1,rep,name=pending_confirmations,json=pendingConfirmations,proto3" json:"pending_confirmations"`
+}
+
+func (m *QueryPendingConfirmationsResponse) Reset()         { *m = QueryPendingConfirmationsResponse{} }
+func (m *QueryPendingConfirmationsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPendingConfirmationsResponse) ProtoMessage()    {}
+func (*QueryPendingConfirmationsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_38d30838f2bbb0b1, []int{14}
+}
+func (m *QueryPendingConfirmationsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPendingConfirmationsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPendingConfirmationsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPendingConfirmationsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPendingConfirmationsResponse.Merge(m, src)
+}
+func (m *QueryPendingConfirmationsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPendingConfirmationsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPendingConfirmationsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPendingConfirmationsResponse proto.InternalMessageInfo
+
+func (m *QueryPendingConfirmationsResponse) GetPendingConfirmations() []PendingConfirmation {
+	if m != nil {
+		return m.PendingConfirmations
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryHostZoneRequest)(nil), "stride.staketia.QueryHostZoneRequest")
 	proto.RegisterType((*QueryHostZoneResponse)(nil), "stride.staketia.QueryHostZoneResponse")
@@ -650,66 +731,73 @@ func init() {
 	proto.RegisterType((*QuerySlashRecordsRequest)(nil), "stride.staketia.QuerySlashRecordsRequest")
 	proto.RegisterType((*QuerySlashRecordsResponse)(nil), "stride.staketia.QuerySlashRecordsResponse")
 	proto.RegisterType((*RedemptionRecordResponse)(nil), "stride.staketia.RedemptionRecordResponse")
+	proto.RegisterType((*QueryPendingConfirmationsRequest)(nil), "stride.staketia.QueryPendingConfirmationsRequest")
+	proto.RegisterType((*QueryPendingConfirmationsResponse)(nil), "stride.staketia.QueryPendingConfirmationsResponse")
 }
 
 func init() { proto.RegisterFile("stride/staketia/query.proto", fileDescriptor_38d30838f2bbb0b1) }
 
 var fileDescriptor_38d30838f2bbb0b1 = []byte{
-	// 853 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x56, 0x4d, 0x6f, 0xd3, 0x4a,
-	0x14, 0xcd, 0xa4, 0x7d, 0xef, 0xa5, 0xd3, 0x3e, 0x35, 0x19, 0x0a, 0x72, 0xdd, 0x62, 0x52, 0x4b,
-	0x94, 0xb4, 0xa2, 0x36, 0x0d, 0xa8, 0xac, 0x29, 0x88, 0x52, 0x54, 0x95, 0xe2, 0x40, 0x17, 0xdd,
-	0x44, 0x4e, 0x3c, 0x72, 0x2c, 0x12, 0x4f, 0xea, 0x71, 0xaa, 0x96, 0xaa, 0x1b, 0x36, 0xc0, 0x0e,
-	0x89, 0x15, 0x3f, 0x81, 0x05, 0x0b, 0x16, 0xfc, 0x87, 0xb2, 0xab, 0xc4, 0x06, 0xb1, 0x40, 0xa8,
-	0xe5, 0x87, 0xa0, 0x8c, 0xc7, 0xf9, 0xb0, 0x3d, 0x49, 0xc4, 0xce, 0x99, 0x7b, 0x7d, 0xee, 0x39,
-	0xd7, 0xf7, 0x9e, 0x09, 0x9c, 0xa3, 0xbe, 0xe7, 0x58, 0x58, 0xa7, 0xbe, 0xf9, 0x02, 0xfb, 0x8e,
-	0xa9, 0xef, 0xb7, 0xb0, 0x77, 0xa4, 0x35, 0x3d, 0xe2, 0x13, 0x34, 0x1d, 0x04, 0xb5, 0x30, 0x28,
-	0x2b, 0xd1, 0xec, 0xf0, 0x21, 0x78, 0x41, 0x9e, 0xb1, 0x89, 0x4d, 0xd8, 0xa3, 0xde, 0x7e, 0xe2,
-	0xa7, 0xf3, 0x36, 0x21, 0x76, 0x1d, 0xeb, 0x66, 0xd3, 0xd1, 0x4d, 0xd7, 0x25, 0xbe, 0xe9, 0x3b,
-	0xc4, 0xa5, 0x3c, 0xba, 0x5c, 0x25, 0xb4, 0x41, 0xa8, 0x5e, 0x31, 0x29, 0x0e, 0xaa, 0xeb, 0x07,
-	0xab, 0x15, 0xec, 0x9b, 0xab, 0x7a, 0xd3, 0xb4, 0x1d, 0x97, 0x25, 0x07, 0xb9, 0xea, 0x15, 0x38,
-	0xf3, 0xb4, 0x9d, 0xf1, 0x88, 0x50, 0x7f, 0x8f, 0xb8, 0xd8, 0xc0, 0xfb, 0x2d, 0x4c, 0x7d, 0xf5,
-	0x09, 0xbc, 0x1c, 0x39, 0xa7, 0x4d, 0xe2, 0x52, 0x8c, 0xd6, 0xe0, 0x44, 0x8d, 0x50, 0xbf, 0xfc,
-	0x92, 0xb8, 0x58, 0x02, 0x79, 0x50, 0x98, 0x2c, 0xce, 0x6a, 0x11, 0x55, 0x5a, 0xe7, 0xad, 0x4c,
-	0x8d, 0x3f, 0xa9, 0x8f, 0xe1, 0x55, 0x06, 0xf8, 0x00, 0xd7, 0xb1, 0xcd, 0x18, 0x18, 0xb8, 0x4a,
-	0x3c, 0x8b, 0xf2, 0x8a, 0x68, 0x09, 0x66, 0x1d, 0xb7, 0x5a, 0x6f, 0x59, 0xb8, 0x6c, 0x7a, 0xd5,
-	0x9a, 0x73, 0x80, 0x2d, 0x86, 0x9f, 0x31, 0xa6, 0xf9, 0xf9, 0x3d, 0x7e, 0xac, 0x1e, 0x42, 0x45,
-	0x84, 0xc5, 0x59, 0xee, 0x42, 0x64, 0x75, 0x82, 0x65, 0x2f, 0x88, 0x4a, 0x20, 0x3f, 0x56, 0x98,
-	0x2c, 0x2e, 0xc4, 0xe8, 0x46, 0x71, 0xd6, 0xc7, 0x4f, 0x7f, 0x5e, 0x4b, 0x19, 0x39, 0x2b, 0x8a,
-	0xaf, 0x6e, 0xc2, 0x79, 0x56, 0xf9, 0xb9, 0x5b, 0x21, 0xae, 0xe5, 0xb8, 0xf6, 0xdf, 0x8b, 0xf0,
-	0x79, 0x43, 0xe2, 0x50, 0x5c, 0x43, 0x09, 0xe6, 0x5a, 0x61, 0x2c, 0x22, 0x21, 0x1f, 0x93, 0x10,
-	0x41, 0xe1, 0x0a, 0xb2, 0xad, 0x08, 0xb8, 0x5a, 0xe3, 0x02, 0x0c, 0x6c, 0xe1, 0x46, 0xb3, 0x2b,
-	0x2d, 0x14, 0xa0, 0xc1, 0x4b, 0xd1, 0xa2, 0x65, 0x27, 0xd0, 0x30, 0x6e, 0xe4, 0x22, 0x70, 0x9b,
-	0x16, 0x92, 0xe0, 0x7f, 0xa6, 0x65, 0x79, 0x98, 0x52, 0x29, 0x9d, 0x07, 0x85, 0x09, 0x23, 0xfc,
-	0xa9, 0xbe, 0x01, 0x5c, 0x60, 0xbc, 0x14, 0x17, 0x68, 0x43, 0xd9, 0xeb, 0xc4, 0xc2, 0x62, 0x1e,
-	0x8f, 0xf2, 0xd9, 0x5a, 0x8a, 0x29, 0x15, 0xc1, 0x19, 0x92, 0x27, 0x88, 0xa8, 0x9f, 0x45, 0x54,
-	0x3a, 0xdf, 0xad, 0x47, 0x06, 0xe8, 0x93, 0x21, 0x6a, 0x48, 0x5a, 0xd4, 0x90, 0x87, 0x10, 0x76,
-	0x97, 0x4c, 0x1a, 0x63, 0x22, 0x16, 0xb5, 0x60, 0x23, 0xb5, 0xf6, 0x46, 0x6a, 0x81, 0x1f, 0xf0,
-	0x8d, 0xd4, 0x76, 0x4c, 0x3b, 0x5c, 0x3a, 0xa3, 0xe7, 0x4d, 0xf5, 0x07, 0xe0, 0x43, 0x9e, 0xc0,
-	0x99, 0xf7, 0x8f, 0xc0, 0x39, 0x71, 0xff, 0xc2, 0x51, 0x19, 0xbd, 0x81, 0x7c, 0x66, 0x66, 0x45,
-	0x6d, 0xa4, 0x68, 0xa3, 0x4f, 0x5b, 0x9a, 0x69, 0xbb, 0x31, 0x54, 0x1b, 0xff, 0x3c, 0xbd, 0xe2,
-	0x64, 0x28, 0x31, 0x6d, 0xa5, 0xba, 0x49, 0x6b, 0xfd, 0x9f, 0x42, 0xb5, 0xe0, 0x6c, 0x42, 0x8c,
-	0x4b, 0xde, 0x80, 0xff, 0xd3, 0xf6, 0x79, 0x64, 0x1f, 0xe6, 0x63, 0x22, 0x7b, 0xde, 0xe6, 0xba,
-	0xa6, 0x68, 0x0f, 0xa0, 0xfa, 0x05, 0x40, 0x49, 0x38, 0x98, 0xdb, 0x30, 0x17, 0x6b, 0x2c, 0x9f,
-	0xc7, 0x85, 0xe1, 0xed, 0xcc, 0x46, 0x1b, 0x88, 0x36, 0xe1, 0x42, 0x77, 0x86, 0xaa, 0xa4, 0xd1,
-	0xac, 0x63, 0x86, 0xec, 0x3b, 0x0d, 0x5c, 0xa6, 0xb8, 0x4a, 0x5c, 0x8b, 0xf2, 0x89, 0x52, 0x3a,
-	0x89, 0xf7, 0x3b, 0x79, 0xcf, 0x9c, 0x06, 0x2e, 0x05, 0x59, 0xc5, 0xd7, 0x19, 0xf8, 0x0f, 0x6b,
-	0x0f, 0x7a, 0x0b, 0x60, 0x26, 0xf4, 0x59, 0x74, 0x3d, 0x46, 0x2b, 0xc9, 0xd5, 0xe5, 0xc5, 0x61,
-	0x69, 0x7c, 0x61, 0xb4, 0x57, 0xdf, 0x7e, 0xbf, 0x4f, 0x17, 0xd0, 0xa2, 0x5e, 0x62, 0xf9, 0x2b,
-	0x5b, 0x66, 0x85, 0xea, 0xd1, 0xab, 0xaa, 0x73, 0x0f, 0xa0, 0x4f, 0x00, 0xe6, 0x62, 0x66, 0x8c,
-	0xb4, 0xe4, 0x6a, 0xa2, 0x1b, 0x40, 0xd6, 0x47, 0xce, 0xe7, 0x34, 0xef, 0x32, 0x9a, 0xab, 0x48,
-	0x1f, 0x48, 0x33, 0x7e, 0x11, 0xa0, 0x8f, 0x00, 0x66, 0xa3, 0xbe, 0x8b, 0x56, 0x92, 0xcb, 0x0b,
-	0xac, 0x5e, 0xd6, 0x46, 0x4d, 0xe7, 0x64, 0xd7, 0x18, 0xd9, 0x5b, 0x48, 0x1b, 0x48, 0x36, 0xe6,
-	0xf8, 0xe8, 0x2b, 0x80, 0xd9, 0xe8, 0x8c, 0x89, 0xb8, 0x0a, 0x5c, 0x5d, 0xc4, 0x55, 0xb4, 0x00,
-	0xea, 0x2e, 0xe3, 0xba, 0x83, 0xb6, 0x07, 0x72, 0x8d, 0xed, 0x88, 0x7e, 0x9c, 0x60, 0x95, 0x27,
-	0xfa, 0x31, 0xf7, 0xd2, 0x13, 0x36, 0x27, 0x31, 0x3f, 0x43, 0x23, 0xb2, 0x1b, 0x36, 0x27, 0x42,
-	0xa3, 0x1c, 0x71, 0x4e, 0x62, 0x72, 0x28, 0xfa, 0x00, 0xe0, 0x54, 0xaf, 0x0f, 0xa1, 0xa5, 0xe4,
-	0xd2, 0x09, 0x3e, 0x26, 0x2f, 0x8f, 0x92, 0xca, 0x09, 0x16, 0x19, 0xc1, 0x9b, 0x68, 0x79, 0x20,
-	0xc1, 0x3e, 0xe7, 0x5b, 0xdf, 0x3a, 0x3d, 0x57, 0xc0, 0xd9, 0xb9, 0x02, 0x7e, 0x9d, 0x2b, 0xe0,
-	0xdd, 0x85, 0x92, 0x3a, 0xbb, 0x50, 0x52, 0xdf, 0x2f, 0x94, 0xd4, 0x5e, 0xd1, 0x76, 0xfc, 0x5a,
-	0xab, 0xa2, 0x55, 0x49, 0x23, 0x09, 0xef, 0xa0, 0x78, 0x47, 0x3f, 0xec, 0xa2, 0xfa, 0x47, 0x4d,
-	0x4c, 0x2b, 0xff, 0xb2, 0xbf, 0x83, 0xb7, 0xff, 0x04, 0x00, 0x00, 0xff, 0xff, 0x1f, 0x17, 0xd1,
-	0x78, 0xbe, 0x0a, 0x00, 0x00,
+	// 930 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x56, 0xcf, 0x6f, 0xdc, 0x44,
+	0x14, 0xce, 0xa4, 0x05, 0x92, 0xd7, 0xa2, 0xee, 0x0e, 0x29, 0xda, 0xb8, 0x89, 0xd9, 0x58, 0x10,
+	0x92, 0x88, 0x7a, 0x88, 0xa9, 0x8a, 0xc4, 0x8d, 0x82, 0x28, 0x41, 0x55, 0x09, 0x0e, 0xf4, 0xd0,
+	0xcb, 0xca, 0xbb, 0x1e, 0xbc, 0x16, 0xbb, 0x1e, 0xd7, 0xe3, 0x8d, 0x5a, 0xaa, 0x5e, 0x10, 0x07,
+	0xb8, 0x21, 0x71, 0xe2, 0x4f, 0xe0, 0xc0, 0x81, 0x03, 0x07, 0xce, 0x5c, 0xca, 0xad, 0x12, 0x17,
+	0xc4, 0x01, 0xa1, 0x84, 0x3f, 0x04, 0x65, 0xfc, 0xbc, 0xd9, 0x1d, 0x7b, 0x36, 0x1b, 0x6e, 0xb3,
+	0xf3, 0xbe, 0x79, 0xef, 0xfb, 0x9e, 0xdf, 0x8f, 0x85, 0x6b, 0x32, 0xcf, 0xe2, 0x90, 0x33, 0x99,
+	0x07, 0x5f, 0xf0, 0x3c, 0x0e, 0xd8, 0x83, 0x11, 0xcf, 0x1e, 0xb9, 0x69, 0x26, 0x72, 0x41, 0xaf,
+	0x14, 0x46, 0xb7, 0x34, 0x5a, 0xb6, 0x8e, 0x2e, 0x0f, 0xc5, 0x03, 0x6b, 0x25, 0x12, 0x91, 0x50,
+	0x47, 0x76, 0x72, 0xc2, 0xdb, 0xb5, 0x48, 0x88, 0x68, 0xc0, 0x59, 0x90, 0xc6, 0x2c, 0x48, 0x12,
+	0x91, 0x07, 0x79, 0x2c, 0x12, 0x89, 0xd6, 0x9d, 0x9e, 0x90, 0x43, 0x21, 0x59, 0x37, 0x90, 0xbc,
+	0x88, 0xce, 0x0e, 0x77, 0xbb, 0x3c, 0x0f, 0x76, 0x59, 0x1a, 0x44, 0x71, 0xa2, 0xc0, 0x05, 0xd6,
+	0x79, 0x19, 0x56, 0x3e, 0x39, 0x41, 0x7c, 0x28, 0x64, 0x7e, 0x5f, 0x24, 0xdc, 0xe7, 0x0f, 0x46,
+	0x5c, 0xe6, 0xce, 0xc7, 0x70, 0x55, 0xbb, 0x97, 0xa9, 0x48, 0x24, 0xa7, 0x37, 0x61, 0xb9, 0x2f,
+	0x64, 0xde, 0xf9, 0x52, 0x24, 0xbc, 0x45, 0xda, 0x64, 0xeb, 0x92, 0xb7, 0xea, 0x6a, 0xaa, 0xdc,
+	0xf1, 0xab, 0xa5, 0x3e, 0x9e, 0x9c, 0x8f, 0x60, 0x5d, 0x39, 0x7c, 0x9f, 0x0f, 0x78, 0xa4, 0x18,
+	0xf8, 0xbc, 0x27, 0xb2, 0x50, 0x62, 0x44, 0xba, 0x0d, 0x8d, 0x38, 0xe9, 0x0d, 0x46, 0x21, 0xef,
+	0x04, 0x59, 0xaf, 0x1f, 0x1f, 0xf2, 0x50, 0xf9, 0x5f, 0xf2, 0xaf, 0xe0, 0xfd, 0xbb, 0x78, 0xed,
+	0x3c, 0x04, 0xdb, 0xe4, 0x0b, 0x59, 0xde, 0x03, 0x1a, 0x8e, 0x8d, 0x9d, 0xac, 0xb0, 0xb6, 0x48,
+	0xfb, 0xc2, 0xd6, 0x25, 0x6f, 0xa3, 0x42, 0x57, 0xf7, 0x73, 0xeb, 0xe2, 0xd3, 0xbf, 0x5f, 0x59,
+	0xf0, 0x9b, 0xa1, 0xee, 0xdf, 0xd9, 0x83, 0x35, 0x15, 0xf9, 0xb3, 0xa4, 0x2b, 0x92, 0x30, 0x4e,
+	0xa2, 0xff, 0x2f, 0x22, 0x87, 0x75, 0x83, 0x2b, 0xd4, 0x70, 0x00, 0xcd, 0x51, 0x69, 0xd3, 0x24,
+	0xb4, 0x2b, 0x12, 0x34, 0x2f, 0xa8, 0xa0, 0x31, 0xd2, 0x9c, 0x3b, 0x7d, 0x14, 0xe0, 0xf3, 0x90,
+	0x0f, 0xd3, 0x53, 0x69, 0xa5, 0x00, 0x17, 0x5e, 0xd2, 0x83, 0x76, 0xe2, 0x42, 0xc3, 0x45, 0xbf,
+	0xa9, 0xb9, 0xdb, 0x0b, 0x69, 0x0b, 0x5e, 0x08, 0xc2, 0x30, 0xe3, 0x52, 0xb6, 0x16, 0xdb, 0x64,
+	0x6b, 0xd9, 0x2f, 0x7f, 0x3a, 0xdf, 0x10, 0x58, 0x37, 0x84, 0x42, 0x81, 0x11, 0x58, 0xd9, 0xd8,
+	0x56, 0x06, 0xcb, 0xd0, 0x8a, 0xb5, 0xb5, 0x5d, 0x51, 0x6a, 0x72, 0xe7, 0xb7, 0x32, 0x83, 0xc5,
+	0xf9, 0xd9, 0x44, 0x65, 0xfc, 0xdd, 0x26, 0x64, 0x90, 0x29, 0x19, 0xa6, 0x84, 0x2c, 0x9a, 0x12,
+	0xf2, 0x01, 0xc0, 0x69, 0x93, 0xb5, 0x2e, 0x28, 0x11, 0x9b, 0x6e, 0xd1, 0x91, 0xee, 0x49, 0x47,
+	0xba, 0xc5, 0x3c, 0xc0, 0x8e, 0x74, 0xf7, 0x83, 0xa8, 0x6c, 0x3a, 0x7f, 0xe2, 0xa5, 0xf3, 0x17,
+	0x01, 0xdb, 0xc4, 0x19, 0xf3, 0x27, 0xe0, 0x9a, 0x39, 0x7f, 0x65, 0xa9, 0xcc, 0x9f, 0x40, 0xac,
+	0x99, 0x55, 0x53, 0x1a, 0x25, 0xbd, 0x3d, 0xa5, 0x6d, 0x51, 0x69, 0x7b, 0xfd, 0x4c, 0x6d, 0xf8,
+	0x79, 0x26, 0xc5, 0x59, 0xd0, 0x52, 0xda, 0x0e, 0x06, 0x81, 0xec, 0x4f, 0x7f, 0x0a, 0x27, 0x84,
+	0xd5, 0x1a, 0x1b, 0x4a, 0xbe, 0x0d, 0x2f, 0xca, 0x93, 0x7b, 0xad, 0x1f, 0xd6, 0x2a, 0x22, 0x27,
+	0x5e, 0xa3, 0xae, 0xcb, 0x72, 0xc2, 0xa1, 0xf3, 0x0b, 0x81, 0x96, 0xb1, 0x30, 0xef, 0x42, 0xb3,
+	0x92, 0x58, 0xac, 0xc7, 0x8d, 0xb3, 0xd3, 0xd9, 0xd0, 0x13, 0x48, 0xf7, 0x60, 0xe3, 0xb4, 0x86,
+	0x7a, 0x62, 0x98, 0x0e, 0xb8, 0xf2, 0x9c, 0xc7, 0x43, 0xde, 0x91, 0xbc, 0x27, 0x92, 0x50, 0x62,
+	0x45, 0xd9, 0x63, 0xe0, 0x7b, 0x63, 0xdc, 0xa7, 0xf1, 0x90, 0x1f, 0x14, 0x28, 0xc7, 0x81, 0xb6,
+	0xca, 0xce, 0x3e, 0x47, 0x50, 0xf2, 0x79, 0x9c, 0x0d, 0x8b, 0xf1, 0x5f, 0x66, 0xf0, 0x6b, 0x02,
+	0x1b, 0x33, 0x40, 0x28, 0xb2, 0x03, 0x57, 0x53, 0x5e, 0x52, 0x9a, 0x00, 0x60, 0x4a, 0x5f, 0xad,
+	0x08, 0xad, 0xf1, 0x86, 0xa9, 0x5d, 0x49, 0x6b, 0x02, 0x79, 0xbf, 0x2d, 0xc3, 0x73, 0x8a, 0x06,
+	0xfd, 0x96, 0xc0, 0x52, 0xb9, 0x12, 0xe8, 0x6b, 0x15, 0xc7, 0x75, 0x0b, 0xc8, 0xda, 0x3c, 0x0b,
+	0x86, 0xbd, 0xed, 0x7e, 0xf5, 0xc7, 0xbf, 0xdf, 0x2f, 0x6e, 0xd1, 0x4d, 0x76, 0xa0, 0xf0, 0xd7,
+	0xef, 0x04, 0x5d, 0xc9, 0xf4, 0xad, 0x3a, 0x5e, 0x59, 0xf4, 0x27, 0x02, 0xcd, 0xca, 0xde, 0xa0,
+	0x6e, 0x7d, 0x34, 0xd3, 0xb2, 0xb2, 0xd8, 0xdc, 0x78, 0xa4, 0xf9, 0xb6, 0xa2, 0xb9, 0x4b, 0xd9,
+	0x4c, 0x9a, 0xd5, 0x9d, 0x45, 0x7f, 0x24, 0xd0, 0xd0, 0x57, 0x04, 0xbd, 0x5e, 0x1f, 0xde, 0xb0,
+	0x95, 0x2c, 0x77, 0x5e, 0x38, 0x92, 0xbd, 0xa9, 0xc8, 0xbe, 0x49, 0xdd, 0x99, 0x64, 0x2b, 0xcb,
+	0x89, 0xfe, 0x4e, 0xa0, 0xa1, 0xb7, 0x83, 0x89, 0xab, 0x61, 0x01, 0x59, 0xee, 0xbc, 0x70, 0xe4,
+	0x7a, 0x4f, 0x71, 0xdd, 0xa7, 0x77, 0x67, 0x72, 0xad, 0xb4, 0x33, 0x7b, 0x5c, 0x33, 0xd5, 0x9f,
+	0xb0, 0xc7, 0x38, 0xf6, 0x9f, 0xa8, 0x3a, 0xd1, 0x83, 0x1a, 0xeb, 0xc4, 0xb4, 0x57, 0x2c, 0x36,
+	0x37, 0xfe, 0x5c, 0x75, 0x52, 0x91, 0x23, 0xe9, 0x0f, 0x04, 0x2e, 0x4f, 0x8e, 0x4c, 0xba, 0x5d,
+	0x1f, 0xba, 0x66, 0xe4, 0x5a, 0x3b, 0xf3, 0x40, 0x91, 0xa0, 0xa7, 0x08, 0xbe, 0x41, 0x77, 0x66,
+	0x12, 0x9c, 0x1a, 0xd2, 0xf4, 0x57, 0x02, 0x2b, 0x75, 0xb3, 0x88, 0xee, 0xd6, 0x07, 0x9e, 0x31,
+	0xdc, 0x2c, 0xef, 0x3c, 0x4f, 0x90, 0xf3, 0x3b, 0x8a, 0xf3, 0x0d, 0xea, 0xcd, 0xe4, 0x5c, 0x3b,
+	0x0d, 0x6f, 0xdd, 0x79, 0x7a, 0x64, 0x93, 0x67, 0x47, 0x36, 0xf9, 0xe7, 0xc8, 0x26, 0xdf, 0x1d,
+	0xdb, 0x0b, 0xcf, 0x8e, 0xed, 0x85, 0x3f, 0x8f, 0xed, 0x85, 0xfb, 0x5e, 0x14, 0xe7, 0xfd, 0x51,
+	0xd7, 0xed, 0x89, 0x61, 0x9d, 0xdf, 0x43, 0xef, 0x06, 0x7b, 0x78, 0xea, 0x3d, 0x7f, 0x94, 0x72,
+	0xd9, 0x7d, 0x5e, 0xfd, 0xeb, 0x7e, 0xeb, 0xbf, 0x01, 0x00, 0x72, 0xb9, 0xac, 0x69, 0x25, 0x0c,
+	0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -746,6 +834,8 @@ type QueryClient interface {
 	RedemptionRecords(ctx context.Context, in *QueryRedemptionRecordsRequest, opts ...grpc.CallOption) (*QueryRedemptionRecordsResponse, error)
 	// Queries slash records
 	SlashRecords(ctx context.Context, in *QuerySlashRecordsRequest, opts ...grpc.CallOption) (*QuerySlashRecordsResponse, error)
+	// Queries the operator confirmations that are awaiting ICQ verification
+	PendingConfirmations(ctx context.Context, in *QueryPendingConfirmationsRequest, opts ...grpc.CallOption) (*QueryPendingConfirmationsResponse, error)
 }
 
 type queryClient struct {
@@ -810,6 +900,15 @@ func (c *queryClient) SlashRecords(ctx context.Context, in *QuerySlashRecordsReq
 	return out, nil
 }
 
+func (c *queryClient) PendingConfirmations(ctx context.Context, in *QueryPendingConfirmationsRequest, opts ...grpc.CallOption) (*QueryPendingConfirmationsResponse, error) {
+	out := new(QueryPendingConfirmationsResponse)
+	err := c.cc.Invoke(ctx, "/stride.staketia.Query/PendingConfirmations", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Queries the host zone struct
@@ -834,6 +933,8 @@ type QueryServer interface {
 	RedemptionRecords(context.Context, *QueryRedemptionRecordsRequest) (*QueryRedemptionRecordsResponse, error)
 	// Queries slash records
 	SlashRecords(context.Context, *QuerySlashRecordsRequest) (*QuerySlashRecordsResponse, error)
+	// Queries the operator confirmations that are awaiting ICQ verification
+	PendingConfirmations(context.Context, *QueryPendingConfirmationsRequest) (*QueryPendingConfirmationsResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -858,6 +959,9 @@ func (*UnimplementedQueryServer) RedemptionRecords(ctx context.Context, req *Que
 func (*UnimplementedQueryServer) SlashRecords(ctx context.Context, req *QuerySlashRecordsRequest) (*QuerySlashRecordsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SlashRecords not implemented")
 }
+func (*UnimplementedQueryServer) PendingConfirmations(ctx context.Context, req *QueryPendingConfirmationsRequest) (*QueryPendingConfirmationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PendingConfirmations not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -971,6 +1075,24 @@ func _Query_SlashRecords_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_PendingConfirmations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPendingConfirmationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PendingConfirmations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stride.staketia.Query/PendingConfirmations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PendingConfirmations(ctx, req.(*QueryPendingConfirmationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "stride.staketia.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -999,6 +1121,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "SlashRecords",
 			Handler:    _Query_SlashRecords_Handler,
 		},
+		{
+			MethodName: "PendingConfirmations",
+			Handler:    _Query_PendingConfirmations_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "stride/staketia/query.proto",
@@ -1468,6 +1594,66 @@ func (m *RedemptionRecordResponse) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryPendingConfirmationsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPendingConfirmationsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPendingConfirmationsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPendingConfirmationsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPendingConfirmationsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPendingConfirmationsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PendingConfirmations) > 0 {
+		for iNdEx := len(m.PendingConfirmations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PendingConfirmations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -1663,6 +1849,30 @@ func (m *RedemptionRecordResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryPendingConfirmationsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryPendingConfirmationsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.PendingConfirmations) > 0 {
+		for _, e := range m.PendingConfirmations {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -2796,6 +3006,140 @@ func (m *RedemptionRecordResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryPendingConfirmationsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPendingConfirmationsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPendingConfirmationsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPendingConfirmationsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPendingConfirmationsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPendingConfirmationsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingConfirmations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingConfirmations = append(m.PendingConfirmations, PendingConfirmation{})
+			if err := m.PendingConfirmations[len(m.PendingConfirmations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
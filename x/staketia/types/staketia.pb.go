@@ -119,6 +119,42 @@ func (UnbondingRecordStatus) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_d306d9365b78f7b2, []int{1}
 }
 
+// The type of operator action that's awaiting ICQ verification
+// Note: the unbonded token sweep does not have a confirmation type since the
+// claim address lives on stride, so its balance is checked directly against
+// local state when the sweep is confirmed (there is no host state to prove)
+type PendingConfirmationType int32
+
+const (
+	// CONFIRM_DELEGATION verifies the delegation address's total delegations
+	CONFIRM_DELEGATION PendingConfirmationType = 0
+	// CONFIRM_UNDELEGATION verifies the delegation address's unbonding entries
+	CONFIRM_UNDELEGATION PendingConfirmationType = 1
+	// ADJUST_DELEGATED_BALANCE verifies the delegation address's total
+	// delegations after applying the offset
+	ADJUST_DELEGATED_BALANCE PendingConfirmationType = 2
+)
+
+var PendingConfirmationType_name = map[int32]string{
+	0: "CONFIRM_DELEGATION",
+	1: "CONFIRM_UNDELEGATION",
+	2: "ADJUST_DELEGATED_BALANCE",
+}
+
+var PendingConfirmationType_value = map[string]int32{
+	"CONFIRM_DELEGATION":       0,
+	"CONFIRM_UNDELEGATION":     1,
+	"ADJUST_DELEGATED_BALANCE": 2,
+}
+
+func (x PendingConfirmationType) String() string {
+	return proto.EnumName(PendingConfirmationType_name, int32(x))
+}
+
+func (PendingConfirmationType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_d306d9365b78f7b2, []int{2}
+}
+
 type HostZone struct {
 	// Chain ID
 	ChainId string `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
@@ -160,6 +196,9 @@ type HostZone struct {
 	UnbondingPeriodSeconds uint64 `protobuf:"varint,19,opt,name=unbonding_period_seconds,json=unbondingPeriodSeconds,proto3" json:"unbonding_period_seconds,omitempty"`
 	// Indicates whether the host zone has been halted
 	Halted bool `protobuf:"varint,20,opt,name=halted,proto3" json:"halted,omitempty"`
+	// Configuration for verifying operator confirmations with interchain query
+	// proofs (disabled if unset)
+	IcqVerification *ICQVerification `protobuf:"bytes,21,opt,name=icq_verification,json=icqVerification,proto3" json:"icq_verification,omitempty"`
 }
 
 func (m *HostZone) Reset()         { *m = HostZone{} }
@@ -286,6 +325,80 @@ func (m *HostZone) GetHalted() bool {
 	return false
 }
 
+func (m *HostZone) GetIcqVerification() *ICQVerification {
+	if m != nil {
+		return m.IcqVerification
+	}
+	return nil
+}
+
+// When enabled, operator confirmations are not processed immediately, and
+// instead are only finalized once the host zone state has been proven with an
+// interchain query
+type ICQVerification struct {
+	// Indicates whether ICQ verification is required for operator confirmations
+	Enabled bool `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// Connection ID from stride to the host zone, used for the queries
+	ConnectionId string `protobuf:"bytes,2,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty"`
+	// The validators that the delegation address delegates to
+	// The delegations and unbondings are summed across these validators
+	Validators []string `protobuf:"bytes,3,rep,name=validators,proto3" json:"validators,omitempty"`
+}
+
+func (m *ICQVerification) Reset()         { *m = ICQVerification{} }
+func (m *ICQVerification) String() string { return proto.CompactTextString(m) }
+func (*ICQVerification) ProtoMessage()    {}
+func (*ICQVerification) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d306d9365b78f7b2, []int{1}
+}
+func (m *ICQVerification) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ICQVerification) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ICQVerification.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ICQVerification) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ICQVerification.Merge(m, src)
+}
+func (m *ICQVerification) XXX_Size() int {
+	return m.Size()
+}
+func (m *ICQVerification) XXX_DiscardUnknown() {
+	xxx_messageInfo_ICQVerification.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ICQVerification proto.InternalMessageInfo
+
+func (m *ICQVerification) GetEnabled() bool {
+	if m != nil {
+		return m.Enabled
+	}
+	return false
+}
+
+func (m *ICQVerification) GetConnectionId() string {
+	if m != nil {
+		return m.ConnectionId
+	}
+	return ""
+}
+
+func (m *ICQVerification) GetValidators() []string {
+	if m != nil {
+		return m.Validators
+	}
+	return nil
+}
+
 // DelegationRecords track the aggregate liquid stakes and delegations
 // for a given epoch
 // Note: There is an important assumption here that tokens in the deposit
@@ -306,7 +419,7 @@ func (m *DelegationRecord) Reset()         { *m = DelegationRecord{} }
 func (m *DelegationRecord) String() string { return proto.CompactTextString(m) }
 func (*DelegationRecord) ProtoMessage()    {}
 func (*DelegationRecord) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d306d9365b78f7b2, []int{1}
+	return fileDescriptor_d306d9365b78f7b2, []int{2}
 }
 func (m *DelegationRecord) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -378,7 +491,7 @@ func (m *UnbondingRecord) Reset()         { *m = UnbondingRecord{} }
 func (m *UnbondingRecord) String() string { return proto.CompactTextString(m) }
 func (*UnbondingRecord) ProtoMessage()    {}
 func (*UnbondingRecord) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d306d9365b78f7b2, []int{2}
+	return fileDescriptor_d306d9365b78f7b2, []int{3}
 }
 func (m *UnbondingRecord) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -458,7 +571,7 @@ func (m *RedemptionRecord) Reset()         { *m = RedemptionRecord{} }
 func (m *RedemptionRecord) String() string { return proto.CompactTextString(m) }
 func (*RedemptionRecord) ProtoMessage()    {}
 func (*RedemptionRecord) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d306d9365b78f7b2, []int{3}
+	return fileDescriptor_d306d9365b78f7b2, []int{4}
 }
 func (m *RedemptionRecord) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -518,7 +631,7 @@ func (m *SlashRecord) Reset()         { *m = SlashRecord{} }
 func (m *SlashRecord) String() string { return proto.CompactTextString(m) }
 func (*SlashRecord) ProtoMessage()    {}
 func (*SlashRecord) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d306d9365b78f7b2, []int{4}
+	return fileDescriptor_d306d9365b78f7b2, []int{5}
 }
 func (m *SlashRecord) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -568,91 +681,268 @@ func (m *SlashRecord) GetValidatorAddress() string {
 	return ""
 }
 
+// PendingConfirmations track operator actions that are awaiting the results of
+// the verification interchain queries
+type PendingConfirmation struct {
+	// Pending confirmation unique ID
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// The type of action being confirmed
+	ConfirmationType PendingConfirmationType `protobuf:"varint,2,opt,name=confirmation_type,json=confirmationType,proto3,enum=stride.staketia.PendingConfirmationType" json:"confirmation_type,omitempty"`
+	// The delegation or unbonding record ID (unused for adjustments)
+	RecordId uint64 `protobuf:"varint,3,opt,name=record_id,json=recordId,proto3" json:"record_id,omitempty"`
+	// The tx hash submitted by the operator (unused for adjustments)
+	TxHash string `protobuf:"bytes,4,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`
+	// The address that submitted the confirmation
+	Sender string `protobuf:"bytes,5,opt,name=sender,proto3" json:"sender,omitempty"`
+	// The delegated balance offset (only used for adjustments)
+	DelegationOffset github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,6,opt,name=delegation_offset,json=delegationOffset,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"delegation_offset"`
+	// The validator that was slashed (only used for adjustments)
+	ValidatorAddress string `protobuf:"bytes,7,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// The number of queries that have not yet returned
+	OutstandingQueries uint64 `protobuf:"varint,8,opt,name=outstanding_queries,json=outstandingQueries,proto3" json:"outstanding_queries,omitempty"`
+	// The proven amount summed across each query response
+	ProvenAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,9,opt,name=proven_amount,json=provenAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"proven_amount"`
+}
+
+func (m *PendingConfirmation) Reset()         { *m = PendingConfirmation{} }
+func (m *PendingConfirmation) String() string { return proto.CompactTextString(m) }
+func (*PendingConfirmation) ProtoMessage()    {}
+func (*PendingConfirmation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d306d9365b78f7b2, []int{6}
+}
+func (m *PendingConfirmation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PendingConfirmation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PendingConfirmation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PendingConfirmation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingConfirmation.Merge(m, src)
+}
+func (m *PendingConfirmation) XXX_Size() int {
+	return m.Size()
+}
+func (m *PendingConfirmation) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingConfirmation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PendingConfirmation proto.InternalMessageInfo
+
+func (m *PendingConfirmation) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *PendingConfirmation) GetConfirmationType() PendingConfirmationType {
+	if m != nil {
+		return m.ConfirmationType
+	}
+	return CONFIRM_DELEGATION
+}
+
+func (m *PendingConfirmation) GetRecordId() uint64 {
+	if m != nil {
+		return m.RecordId
+	}
+	return 0
+}
+
+func (m *PendingConfirmation) GetTxHash() string {
+	if m != nil {
+		return m.TxHash
+	}
+	return ""
+}
+
+func (m *PendingConfirmation) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *PendingConfirmation) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+func (m *PendingConfirmation) GetOutstandingQueries() uint64 {
+	if m != nil {
+		return m.OutstandingQueries
+	}
+	return 0
+}
+
+// Callback data for the verification interchain queries
+type PendingConfirmationQueryCallback struct {
+	// The ID of the pending confirmation that the query is verifying
+	PendingConfirmationId uint64 `protobuf:"varint,1,opt,name=pending_confirmation_id,json=pendingConfirmationId,proto3" json:"pending_confirmation_id,omitempty"`
+	// The validator's shares to tokens rate (only used for the delegation query)
+	SharesToTokensRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=shares_to_tokens_rate,json=sharesToTokensRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"shares_to_tokens_rate"`
+}
+
+func (m *PendingConfirmationQueryCallback) Reset()         { *m = PendingConfirmationQueryCallback{} }
+func (m *PendingConfirmationQueryCallback) String() string { return proto.CompactTextString(m) }
+func (*PendingConfirmationQueryCallback) ProtoMessage()    {}
+func (*PendingConfirmationQueryCallback) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d306d9365b78f7b2, []int{7}
+}
+func (m *PendingConfirmationQueryCallback) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PendingConfirmationQueryCallback) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PendingConfirmationQueryCallback.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PendingConfirmationQueryCallback) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingConfirmationQueryCallback.Merge(m, src)
+}
+func (m *PendingConfirmationQueryCallback) XXX_Size() int {
+	return m.Size()
+}
+func (m *PendingConfirmationQueryCallback) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingConfirmationQueryCallback.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PendingConfirmationQueryCallback proto.InternalMessageInfo
+
+func (m *PendingConfirmationQueryCallback) GetPendingConfirmationId() uint64 {
+	if m != nil {
+		return m.PendingConfirmationId
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterEnum("stride.staketia.DelegationRecordStatus", DelegationRecordStatus_name, DelegationRecordStatus_value)
 	proto.RegisterEnum("stride.staketia.UnbondingRecordStatus", UnbondingRecordStatus_name, UnbondingRecordStatus_value)
+	proto.RegisterEnum("stride.staketia.PendingConfirmationType", PendingConfirmationType_name, PendingConfirmationType_value)
 	proto.RegisterType((*HostZone)(nil), "stride.staketia.HostZone")
+	proto.RegisterType((*ICQVerification)(nil), "stride.staketia.ICQVerification")
 	proto.RegisterType((*DelegationRecord)(nil), "stride.staketia.DelegationRecord")
 	proto.RegisterType((*UnbondingRecord)(nil), "stride.staketia.UnbondingRecord")
 	proto.RegisterType((*RedemptionRecord)(nil), "stride.staketia.RedemptionRecord")
 	proto.RegisterType((*SlashRecord)(nil), "stride.staketia.SlashRecord")
+	proto.RegisterType((*PendingConfirmation)(nil), "stride.staketia.PendingConfirmation")
+	proto.RegisterType((*PendingConfirmationQueryCallback)(nil), "stride.staketia.PendingConfirmationQueryCallback")
 }
 
 func init() { proto.RegisterFile("stride/staketia/staketia.proto", fileDescriptor_d306d9365b78f7b2) }
 
 var fileDescriptor_d306d9365b78f7b2 = []byte{
-	// 1121 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x57, 0xcf, 0x6f, 0x1a, 0xc7,
-	0x17, 0x67, 0x81, 0xd8, 0xe4, 0xc5, 0xc0, 0x32, 0x10, 0xb2, 0xb6, 0x22, 0xe2, 0xaf, 0x0f, 0xf9,
-	0x5a, 0x69, 0x8d, 0x2b, 0xa7, 0x87, 0x1e, 0xda, 0x44, 0x18, 0x36, 0xce, 0x4a, 0x18, 0xbb, 0x0b,
-	0xf4, 0x90, 0x1e, 0x56, 0xc3, 0xee, 0x04, 0x56, 0x66, 0x67, 0xd1, 0xce, 0x60, 0x53, 0xa9, 0xf7,
-	0x56, 0xea, 0xa5, 0x97, 0xfe, 0x05, 0xfd, 0x13, 0x9a, 0x3f, 0x22, 0x52, 0x2f, 0x69, 0x4e, 0x55,
-	0x0f, 0x51, 0x65, 0xff, 0x23, 0xd5, 0xce, 0xfe, 0x00, 0x83, 0x2d, 0x94, 0x8a, 0x4b, 0x4f, 0xec,
-	0xbc, 0xcf, 0x7b, 0x9f, 0xcf, 0xbc, 0xf7, 0x66, 0xf6, 0x2d, 0x50, 0x61, 0xdc, 0xb3, 0x2d, 0xb2,
-	0xcf, 0x38, 0x3e, 0x23, 0xdc, 0xc6, 0xf1, 0x43, 0x75, 0xe4, 0xb9, 0xdc, 0x45, 0xf9, 0x00, 0xaf,
-	0x46, 0xe6, 0xad, 0x4d, 0xd3, 0x65, 0x8e, 0xcb, 0x0c, 0x01, 0xef, 0x07, 0x8b, 0xc0, 0x77, 0xab,
-	0xd4, 0x77, 0xfb, 0x6e, 0x60, 0xf7, 0x9f, 0x02, 0xeb, 0xce, 0x0f, 0x1b, 0x90, 0x79, 0xe9, 0x32,
-	0xfe, 0xca, 0xa5, 0x04, 0x6d, 0x42, 0xc6, 0x1c, 0x60, 0x9b, 0x1a, 0xb6, 0xa5, 0x48, 0xdb, 0xd2,
-	0xee, 0x5d, 0x7d, 0x5d, 0xac, 0x35, 0x0b, 0x7d, 0x0a, 0x88, 0x62, 0x6e, 0x9f, 0x13, 0x83, 0xbb,
-	0x67, 0x84, 0x1a, 0x16, 0xa1, 0xae, 0xa3, 0x24, 0x85, 0x93, 0x1c, 0x20, 0x1d, 0x1f, 0x68, 0xf8,
-	0x76, 0xf4, 0x14, 0xca, 0xd7, 0xbc, 0xed, 0x9e, 0x19, 0x46, 0xa4, 0x44, 0x44, 0x71, 0x26, 0x42,
-	0xeb, 0x99, 0x41, 0x50, 0x15, 0x8a, 0xdc, 0xc3, 0x94, 0xbd, 0x26, 0x9e, 0x61, 0x0e, 0x30, 0xa5,
-	0x64, 0xe8, 0x6f, 0x24, 0x2d, 0x22, 0x0a, 0x11, 0x54, 0x0f, 0x10, 0xcd, 0x42, 0x47, 0x80, 0x2c,
-	0x32, 0x24, 0x7d, 0xcc, 0x6d, 0x97, 0x1a, 0xd8, 0xb2, 0x3c, 0xc2, 0x98, 0x72, 0xc7, 0x77, 0x3f,
-	0x54, 0xde, 0xbf, 0xd9, 0x2b, 0x85, 0xe9, 0xd7, 0x02, 0xa4, 0xcd, 0x3d, 0x9b, 0xf6, 0xf5, 0xc2,
-	0x34, 0x26, 0x04, 0xd0, 0x73, 0xc8, 0x79, 0xe4, 0x02, 0x7b, 0x56, 0x4c, 0xb2, 0xb6, 0x84, 0x24,
-	0x1b, 0xf8, 0x47, 0x04, 0x35, 0xc8, 0x5b, 0x64, 0xe4, 0x32, 0x9b, 0xc7, 0x0c, 0xeb, 0x4b, 0x18,
-	0x72, 0x61, 0x40, 0x44, 0x71, 0x04, 0xc8, 0x23, 0x16, 0x71, 0x46, 0xd7, 0x92, 0xc9, 0x2c, 0x4b,
-	0x66, 0x1a, 0x13, 0x11, 0x7d, 0x05, 0x59, 0x73, 0x88, 0x6d, 0x27, 0xe6, 0xb8, 0xbb, 0x84, 0x63,
-	0x43, 0xb8, 0x47, 0xe1, 0x5d, 0xd8, 0x72, 0x47, 0xc4, 0xc3, 0xdc, 0xf5, 0x22, 0x06, 0xc3, 0xa5,
-	0x46, 0x70, 0xce, 0x14, 0x58, 0xc2, 0xf5, 0x20, 0x8a, 0x0d, 0xcd, 0x27, 0xb4, 0x2d, 0x02, 0xd1,
-	0x31, 0x94, 0x19, 0x7e, 0x4d, 0x6e, 0xa0, 0xbc, 0xb7, 0x84, 0xb2, 0xe8, 0xc7, 0xcd, 0xd3, 0x51,
-	0x28, 0x0d, 0x31, 0xe3, 0xc6, 0x4c, 0xc9, 0x3c, 0xcc, 0x89, 0xb2, 0x21, 0xc8, 0xbe, 0x7c, 0xfb,
-	0xe1, 0x51, 0xe2, 0xaf, 0x0f, 0x8f, 0x1e, 0xf7, 0x6d, 0x3e, 0x18, 0xf7, 0xaa, 0xa6, 0xeb, 0x84,
-	0x57, 0x21, 0xfc, 0xd9, 0x63, 0xd6, 0xd9, 0x3e, 0xff, 0x6e, 0x44, 0x58, 0xb5, 0x41, 0xcc, 0xf7,
-	0x6f, 0xf6, 0x20, 0x94, 0x6e, 0x10, 0x53, 0x47, 0x3e, 0xb3, 0x1e, 0x13, 0xeb, 0x98, 0x13, 0x44,
-	0x20, 0x3f, 0x2f, 0x95, 0x5d, 0x81, 0x54, 0xce, 0xbb, 0x2e, 0x33, 0x84, 0xa2, 0x63, 0xd3, 0x85,
-	0xac, 0x72, 0x2b, 0x90, 0x2a, 0x38, 0x36, 0xd5, 0x17, 0xd5, 0xf0, 0x64, 0x41, 0x2d, 0xbf, 0x12,
-	0x35, 0x3c, 0x99, 0x53, 0xbb, 0x80, 0x4d, 0x3f, 0x37, 0x9b, 0x52, 0xe2, 0x2d, 0x68, 0xca, 0x2b,
-	0xd0, 0x2c, 0x3b, 0x36, 0xd5, 0x7c, 0xf6, 0x1b, 0x84, 0xf1, 0xe4, 0x16, 0xe1, 0xc2, 0x4a, 0x84,
-	0xf1, 0xe4, 0x26, 0xe1, 0x6f, 0x21, 0x7a, 0xd7, 0x10, 0xcb, 0xe8, 0xe1, 0x21, 0xa6, 0x26, 0x51,
-	0x90, 0x10, 0xac, 0x7e, 0x84, 0xa0, 0x46, 0xb9, 0x2e, 0xc7, 0x44, 0x87, 0x01, 0x0f, 0xfa, 0x02,
-	0x94, 0x31, 0xed, 0xb9, 0xd4, 0xb2, 0x69, 0xdf, 0x18, 0x11, 0xcf, 0x76, 0x2d, 0x83, 0x11, 0xd3,
-	0xa5, 0x16, 0x53, 0x8a, 0xdb, 0xd2, 0x6e, 0x5a, 0x2f, 0xc7, 0xf8, 0xa9, 0x80, 0xdb, 0x01, 0x8a,
-	0xca, 0xb0, 0x36, 0xc0, 0x43, 0x4e, 0x2c, 0xa5, 0xb4, 0x2d, 0xed, 0x66, 0xf4, 0x70, 0xb5, 0xf3,
-	0x87, 0x04, 0x72, 0x23, 0x7e, 0x37, 0xea, 0xc4, 0x74, 0x3d, 0x0b, 0xe5, 0x20, 0x19, 0xce, 0x82,
-	0xb4, 0x9e, 0xb4, 0x2d, 0xd4, 0x86, 0x6c, 0xf8, 0x62, 0xc7, 0x8e, 0x3b, 0xa6, 0x3c, 0x98, 0x00,
-	0x1f, 0x9d, 0xcf, 0x46, 0x40, 0x52, 0x13, 0x1c, 0xe8, 0x39, 0xac, 0x31, 0x8e, 0xf9, 0x98, 0x89,
-	0xe9, 0x90, 0x3b, 0xf8, 0x7f, 0x75, 0x6e, 0xac, 0x55, 0xe7, 0xf7, 0xd5, 0x16, 0xee, 0x7a, 0x18,
-	0x86, 0x1e, 0xc0, 0x3a, 0x9f, 0x18, 0x03, 0xcc, 0x06, 0xe1, 0xb4, 0x58, 0xe3, 0x93, 0x97, 0x98,
-	0x0d, 0x76, 0x7e, 0x4f, 0x41, 0xbe, 0x1b, 0x95, 0xe1, 0x96, 0x94, 0x9e, 0xc5, 0xea, 0x49, 0xa1,
-	0xfe, 0x78, 0x41, 0x7d, 0x8e, 0x61, 0x4e, 0xfc, 0x1b, 0xc8, 0x33, 0x1e, 0xce, 0xb9, 0xb0, 0x28,
-	0xa9, 0x7f, 0x55, 0x94, 0x2c, 0xe3, 0x62, 0x20, 0x86, 0x55, 0x59, 0x28, 0x75, 0x7a, 0x05, 0xa5,
-	0xd6, 0xe0, 0x7f, 0xd3, 0x63, 0x63, 0xba, 0xce, 0x68, 0x48, 0xc4, 0x65, 0xe0, 0xb6, 0x43, 0xe2,
-	0xf3, 0x73, 0x47, 0xd4, 0xa6, 0x12, 0x3b, 0xd6, 0x63, 0xbf, 0x8e, 0xed, 0x90, 0xe8, 0x1c, 0x7d,
-	0x06, 0xa5, 0x31, 0x9d, 0x19, 0xc0, 0x51, 0x07, 0xc4, 0xec, 0xd4, 0xd1, 0x2c, 0xd6, 0x11, 0xdd,
-	0x40, 0xcf, 0xe0, 0x61, 0xc0, 0x49, 0xac, 0xb0, 0x5e, 0xec, 0x82, 0x90, 0x51, 0x1c, 0x29, 0x66,
-	0xa6, 0xae, 0x44, 0x3e, 0xa2, 0x18, 0x6d, 0xdf, 0x23, 0x88, 0xdf, 0xf9, 0x29, 0x09, 0xf2, 0xcc,
-	0x1d, 0x0b, 0xda, 0x59, 0x85, 0xe2, 0x34, 0x23, 0x4f, 0xd8, 0x8c, 0xb8, 0xbf, 0x85, 0xf1, 0xf5,
-	0xd6, 0x69, 0x16, 0xda, 0x82, 0x8c, 0xff, 0x12, 0x20, 0x0e, 0xf1, 0xc2, 0xcf, 0x97, 0x78, 0xfd,
-	0x9f, 0x6a, 0xe5, 0xce, 0x6f, 0x12, 0xdc, 0x6b, 0x0f, 0x31, 0x1b, 0xdc, 0x72, 0xae, 0x11, 0xa4,
-	0xfd, 0xae, 0x8a, 0x24, 0xd3, 0xba, 0x78, 0x5e, 0xdc, 0x48, 0x6a, 0x05, 0x67, 0xea, 0x13, 0x28,
-	0x9c, 0xe3, 0xa1, 0x6d, 0xcd, 0x7e, 0x33, 0x84, 0xf7, 0x50, 0x8e, 0x81, 0x70, 0x82, 0x3f, 0xf9,
-	0x1e, 0xca, 0x37, 0x5f, 0x66, 0xa4, 0x40, 0xa9, 0xa3, 0xd7, 0x5a, 0xed, 0x17, 0xaa, 0x6e, 0x68,
-	0x2d, 0xe3, 0x54, 0x3f, 0x39, 0xd2, 0xd5, 0x76, 0x5b, 0x4e, 0xa0, 0x22, 0xe4, 0x63, 0xe4, 0x45,
-	0x4d, 0x6b, 0xaa, 0x0d, 0x59, 0x42, 0x25, 0x90, 0x1b, 0x6a, 0x53, 0x3d, 0xaa, 0x75, 0xb4, 0x93,
-	0x96, 0xf1, 0x75, 0x57, 0xed, 0xaa, 0x72, 0x12, 0x3d, 0x80, 0xe2, 0x8c, 0xb5, 0x7e, 0x72, 0x7c,
-	0xda, 0x54, 0x3b, 0xaa, 0x9c, 0xda, 0x4a, 0xff, 0xf8, 0x6b, 0x25, 0xf1, 0xe4, 0x17, 0x09, 0xee,
-	0xdf, 0x78, 0x9b, 0xd1, 0x43, 0x50, 0x6a, 0xf5, 0x7a, 0xf7, 0xb8, 0xdb, 0xac, 0x75, 0xb4, 0xd6,
-	0x91, 0xa1, 0xab, 0x0d, 0xf5, 0xf8, 0xd4, 0x67, 0x09, 0x77, 0xd0, 0x6d, 0x1d, 0x9e, 0xb4, 0x1a,
-	0x3e, 0x14, 0x68, 0x49, 0x68, 0x13, 0xee, 0x4f, 0x8d, 0xb3, 0x3b, 0x4e, 0xa2, 0x0d, 0xc8, 0x04,
-	0x90, 0xda, 0x90, 0x53, 0x28, 0x0b, 0x77, 0xeb, 0xcd, 0x9a, 0x76, 0x5c, 0x3b, 0x6c, 0xaa, 0x72,
-	0x1a, 0xdd, 0x83, 0x75, 0xb1, 0x54, 0x1b, 0xf2, 0x9d, 0x60, 0x5f, 0x87, 0xcd, 0xb7, 0x97, 0x15,
-	0xe9, 0xdd, 0x65, 0x45, 0xfa, 0xfb, 0xb2, 0x22, 0xfd, 0x7c, 0x55, 0x49, 0xbc, 0xbb, 0xaa, 0x24,
-	0xfe, 0xbc, 0xaa, 0x24, 0x5e, 0x1d, 0xcc, 0xb4, 0x24, 0xf8, 0xf8, 0xd9, 0x6b, 0xe2, 0x1e, 0xdb,
-	0x0f, 0xff, 0x18, 0x9c, 0x1f, 0x7c, 0xbe, 0x3f, 0x99, 0xfe, 0x3d, 0x10, 0x2d, 0xea, 0xad, 0x89,
-	0x4f, 0xfb, 0xa7, 0xff, 0x04, 0x00, 0x00, 0xff, 0xff, 0x01, 0x06, 0xc8, 0xce, 0x3e, 0x0c, 0x00,
-	0x00,
+	// 1449 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x57, 0xcd, 0x6e, 0xdb, 0xc6,
+	0x16, 0x36, 0x25, 0xc5, 0x96, 0x8f, 0x7f, 0x44, 0x8f, 0xfc, 0x43, 0xfb, 0x06, 0x8a, 0xaf, 0x2f,
+	0x90, 0x6b, 0xe4, 0x5e, 0xcb, 0x85, 0x53, 0x14, 0x5d, 0xb4, 0x09, 0x64, 0x89, 0x71, 0xd8, 0xca,
+	0xb2, 0x43, 0x49, 0x59, 0xa4, 0x0b, 0x62, 0x44, 0x8e, 0x2d, 0xc2, 0xd2, 0x50, 0xe1, 0x8c, 0x1c,
+	0x07, 0xe8, 0x03, 0x14, 0xe8, 0xa6, 0x9b, 0x3e, 0x41, 0x1f, 0xa1, 0x79, 0x88, 0x00, 0xd9, 0xa4,
+	0x41, 0x17, 0x45, 0x16, 0x41, 0x91, 0xbc, 0x48, 0xc1, 0x19, 0x92, 0xa2, 0x25, 0x19, 0x46, 0x0d,
+	0x6d, 0xba, 0x92, 0xe6, 0xfc, 0x7c, 0xdf, 0xcc, 0x39, 0x67, 0xce, 0x19, 0x42, 0x81, 0x71, 0xdf,
+	0x75, 0xc8, 0x2e, 0xe3, 0xf8, 0x8c, 0x70, 0x17, 0xc7, 0x7f, 0x8a, 0x3d, 0xdf, 0xe3, 0x1e, 0xca,
+	0x49, 0x7d, 0x31, 0x12, 0x6f, 0xac, 0xdb, 0x1e, 0xeb, 0x7a, 0xcc, 0x12, 0xea, 0x5d, 0xb9, 0x90,
+	0xb6, 0x1b, 0xcb, 0xa7, 0xde, 0xa9, 0x27, 0xe5, 0xc1, 0x3f, 0x29, 0xdd, 0xfa, 0x7d, 0x1e, 0xb2,
+	0x8f, 0x3d, 0xc6, 0x9f, 0x79, 0x94, 0xa0, 0x75, 0xc8, 0xda, 0x6d, 0xec, 0x52, 0xcb, 0x75, 0x34,
+	0x65, 0x53, 0xd9, 0x9e, 0x35, 0x67, 0xc4, 0xda, 0x70, 0xd0, 0xff, 0x01, 0x51, 0xcc, 0xdd, 0x73,
+	0x62, 0x71, 0xef, 0x8c, 0x50, 0xcb, 0x21, 0xd4, 0xeb, 0x6a, 0x29, 0x61, 0xa4, 0x4a, 0x4d, 0x23,
+	0x50, 0x54, 0x02, 0x39, 0xba, 0x0f, 0xab, 0x97, 0xac, 0xdd, 0x96, 0x1d, 0x7a, 0xa4, 0x85, 0x47,
+	0x3e, 0xe1, 0x61, 0xb4, 0x6c, 0xe9, 0x54, 0x84, 0x3c, 0xf7, 0x31, 0x65, 0x27, 0xc4, 0xb7, 0xec,
+	0x36, 0xa6, 0x94, 0x74, 0x82, 0x8d, 0x64, 0x84, 0xc7, 0x52, 0xa4, 0x2a, 0x4b, 0x8d, 0xe1, 0xa0,
+	0x03, 0x40, 0x0e, 0xe9, 0x90, 0x53, 0xcc, 0x5d, 0x8f, 0x5a, 0xd8, 0x71, 0x7c, 0xc2, 0x98, 0x76,
+	0x2b, 0x30, 0xdf, 0xd7, 0xde, 0xbd, 0xda, 0x59, 0x0e, 0x8f, 0x5f, 0x92, 0x9a, 0x3a, 0xf7, 0x5d,
+	0x7a, 0x6a, 0x2e, 0x0d, 0x7c, 0x42, 0x05, 0x7a, 0x08, 0x8b, 0x3e, 0x79, 0x81, 0x7d, 0x27, 0x06,
+	0x99, 0xbe, 0x06, 0x64, 0x41, 0xda, 0x47, 0x00, 0x25, 0xc8, 0x39, 0xa4, 0xe7, 0x31, 0x97, 0xc7,
+	0x08, 0x33, 0xd7, 0x20, 0x2c, 0x86, 0x0e, 0x11, 0xc4, 0x01, 0x20, 0x9f, 0x38, 0xa4, 0xdb, 0xbb,
+	0x74, 0x98, 0xec, 0x75, 0x87, 0x19, 0xf8, 0x44, 0x40, 0x5f, 0xc3, 0x82, 0xdd, 0xc1, 0x6e, 0x37,
+	0xc6, 0x98, 0xbd, 0x06, 0x63, 0x5e, 0x98, 0x47, 0xee, 0x4d, 0xd8, 0xf0, 0x7a, 0xc4, 0xc7, 0xdc,
+	0xf3, 0x23, 0x04, 0xcb, 0xa3, 0x96, 0xac, 0x33, 0x0d, 0xae, 0xc1, 0x5a, 0x8b, 0x7c, 0x43, 0xf1,
+	0x11, 0xad, 0x0b, 0x47, 0x74, 0x08, 0xab, 0x0c, 0x9f, 0x90, 0x31, 0x90, 0x73, 0xd7, 0x40, 0xe6,
+	0x03, 0xbf, 0x61, 0x38, 0x0a, 0xcb, 0x1d, 0xcc, 0xb8, 0x95, 0x08, 0x99, 0x8f, 0x39, 0xd1, 0xe6,
+	0x05, 0xd8, 0x57, 0xaf, 0x3f, 0xdc, 0x99, 0x7a, 0xff, 0xe1, 0xce, 0xdd, 0x53, 0x97, 0xb7, 0xfb,
+	0xad, 0xa2, 0xed, 0x75, 0xc3, 0xab, 0x10, 0xfe, 0xec, 0x30, 0xe7, 0x6c, 0x97, 0xbf, 0xec, 0x11,
+	0x56, 0xac, 0x10, 0xfb, 0xdd, 0xab, 0x1d, 0x08, 0xa9, 0x2b, 0xc4, 0x36, 0x51, 0x80, 0x6c, 0xc6,
+	0xc0, 0x26, 0xe6, 0x04, 0x11, 0xc8, 0x0d, 0x53, 0x2d, 0x4c, 0x80, 0x6a, 0xd1, 0xbf, 0x4c, 0xd3,
+	0x81, 0x7c, 0xd7, 0xa5, 0x23, 0xa7, 0x5a, 0x9c, 0x00, 0xd5, 0x52, 0xd7, 0xa5, 0xe6, 0x28, 0x1b,
+	0xbe, 0x18, 0x61, 0xcb, 0x4d, 0x84, 0x0d, 0x5f, 0x0c, 0xb1, 0xbd, 0x80, 0xf5, 0xe0, 0x6c, 0x2e,
+	0xa5, 0xc4, 0x1f, 0xe1, 0x54, 0x27, 0xc0, 0xb9, 0xda, 0x75, 0xa9, 0x11, 0xa0, 0x8f, 0x21, 0xc6,
+	0x17, 0x57, 0x10, 0x2f, 0x4d, 0x84, 0x18, 0x5f, 0x8c, 0x23, 0xfe, 0x0e, 0xa2, 0x5e, 0x43, 0x1c,
+	0xab, 0x85, 0x3b, 0x98, 0xda, 0x44, 0x43, 0x82, 0xb0, 0xf8, 0x37, 0x08, 0x0d, 0xca, 0x4d, 0x35,
+	0x06, 0xda, 0x97, 0x38, 0xe8, 0x4b, 0xd0, 0xfa, 0xb4, 0xe5, 0x51, 0xc7, 0xa5, 0xa7, 0x56, 0x8f,
+	0xf8, 0xae, 0xe7, 0x58, 0x8c, 0xd8, 0x1e, 0x75, 0x98, 0x96, 0xdf, 0x54, 0xb6, 0x33, 0xe6, 0x6a,
+	0xac, 0x3f, 0x16, 0xea, 0xba, 0xd4, 0xa2, 0x55, 0x98, 0x6e, 0xe3, 0x0e, 0x27, 0x8e, 0xb6, 0xbc,
+	0xa9, 0x6c, 0x67, 0xcd, 0x70, 0x85, 0xbe, 0x05, 0xd5, 0xb5, 0x9f, 0x5b, 0xe7, 0xc4, 0x77, 0x4f,
+	0x5c, 0x5b, 0x34, 0x48, 0x6d, 0x65, 0x53, 0xd9, 0x9e, 0xdb, 0xdb, 0x2c, 0x0e, 0x8d, 0x99, 0xa2,
+	0x51, 0x7e, 0xf2, 0x34, 0x61, 0x67, 0xe6, 0x5c, 0xfb, 0x79, 0x52, 0xb0, 0xd5, 0x83, 0xdc, 0x90,
+	0x0d, 0xd2, 0x60, 0x86, 0x50, 0xdc, 0xea, 0x10, 0x39, 0x5b, 0xb2, 0x66, 0xb4, 0x44, 0xff, 0x81,
+	0x05, 0xdb, 0xa3, 0x94, 0xd8, 0x22, 0x2f, 0xae, 0x13, 0x8e, 0x95, 0xf9, 0x81, 0xd0, 0x70, 0x50,
+	0x01, 0xe0, 0x1c, 0x77, 0x5c, 0x27, 0xe8, 0x2e, 0x4c, 0x4b, 0x6f, 0xa6, 0xb7, 0x67, 0xcd, 0x84,
+	0x64, 0xeb, 0x37, 0x05, 0xd4, 0x4a, 0xdc, 0xda, 0x4d, 0x62, 0x7b, 0xbe, 0x83, 0x16, 0x21, 0x15,
+	0x8e, 0xb2, 0x8c, 0x99, 0x72, 0x1d, 0x54, 0x87, 0x85, 0x70, 0x2e, 0xe1, 0xae, 0xd7, 0xa7, 0x5c,
+	0x4b, 0xdd, 0x28, 0x1d, 0xf3, 0x12, 0xa4, 0x24, 0x30, 0xd0, 0x43, 0x98, 0x66, 0x1c, 0xf3, 0x3e,
+	0x13, 0xc3, 0x6d, 0x71, 0xef, 0xbf, 0x23, 0xe1, 0x1a, 0xde, 0x57, 0x5d, 0x98, 0x9b, 0xa1, 0x1b,
+	0x5a, 0x83, 0x19, 0x7e, 0x61, 0xb5, 0x31, 0x6b, 0x87, 0xc3, 0x6e, 0x9a, 0x5f, 0x3c, 0xc6, 0xac,
+	0xbd, 0xf5, 0x26, 0x0d, 0xb9, 0x66, 0x94, 0xc5, 0x2b, 0x8e, 0xf4, 0x20, 0x66, 0x4f, 0x09, 0xf6,
+	0xbb, 0x23, 0xec, 0x43, 0x08, 0x43, 0xe4, 0x4f, 0x21, 0xc7, 0x78, 0x38, 0xa6, 0xc3, 0xa0, 0xa4,
+	0x6f, 0x14, 0x94, 0x05, 0xc6, 0xc5, 0x3c, 0x0f, 0xa3, 0x32, 0x12, 0xea, 0xcc, 0x04, 0x42, 0x6d,
+	0xc0, 0xbf, 0x07, 0x55, 0x6f, 0x7b, 0xdd, 0x5e, 0x87, 0x88, 0x9a, 0xe1, 0x6e, 0x97, 0xc4, 0xe5,
+	0x7f, 0x4b, 0xc4, 0xa6, 0x10, 0x1b, 0x96, 0x63, 0xbb, 0x86, 0xdb, 0x25, 0xd1, 0x35, 0xf8, 0x0c,
+	0x96, 0xfb, 0x34, 0xf1, 0x7e, 0x88, 0x32, 0x20, 0x46, 0xbf, 0x89, 0x92, 0xba, 0x86, 0xc8, 0x06,
+	0x7a, 0x00, 0xb7, 0x25, 0x26, 0x71, 0xc2, 0x78, 0xb1, 0x17, 0x84, 0xf4, 0x62, 0x4f, 0x31, 0xf2,
+	0x4d, 0x2d, 0xb2, 0x11, 0xc1, 0xa8, 0x07, 0x16, 0xd2, 0x7f, 0xeb, 0xc7, 0x14, 0xa8, 0x89, 0x16,
+	0x21, 0xd3, 0x59, 0x84, 0xfc, 0xe0, 0x44, 0xbe, 0x90, 0x59, 0x71, 0x7e, 0x97, 0xfa, 0x97, 0x53,
+	0x67, 0x38, 0x68, 0x03, 0xb2, 0x41, 0x0f, 0x23, 0x5d, 0xe2, 0x87, 0xd7, 0x24, 0x5e, 0xff, 0xa3,
+	0x52, 0xb9, 0xf5, 0xab, 0x02, 0x73, 0xf5, 0x0e, 0x66, 0xed, 0x2b, 0xea, 0x1a, 0x41, 0x26, 0xc8,
+	0xaa, 0x38, 0x64, 0xc6, 0x14, 0xff, 0x47, 0x37, 0x92, 0x9e, 0x40, 0x4d, 0xfd, 0x0f, 0x96, 0xe2,
+	0x36, 0x12, 0x3f, 0x9a, 0xe4, 0x3d, 0x54, 0x63, 0x45, 0xf8, 0x00, 0xd9, 0x7a, 0x9f, 0x86, 0xfc,
+	0x31, 0x09, 0xcb, 0x8a, 0x9e, 0xb8, 0x7e, 0x57, 0x36, 0xb7, 0xe1, 0xdd, 0x37, 0x61, 0xc9, 0x4e,
+	0xe8, 0xad, 0x80, 0x3c, 0xbc, 0xa0, 0xdb, 0x23, 0x17, 0x74, 0x0c, 0x60, 0xe3, 0x65, 0x8f, 0x98,
+	0xaa, 0x3d, 0x24, 0x41, 0xff, 0x82, 0xd9, 0x41, 0x8d, 0xa4, 0x05, 0x5b, 0xd6, 0x8f, 0x4a, 0xe3,
+	0xaa, 0x36, 0x12, 0x74, 0x7c, 0x46, 0xa8, 0x43, 0x7c, 0xf9, 0x38, 0x36, 0xc3, 0x55, 0x62, 0x40,
+	0x05, 0x5b, 0xf4, 0x4e, 0x4e, 0x18, 0xe1, 0xda, 0xf4, 0x8d, 0x42, 0xaa, 0x0e, 0x80, 0x8e, 0x04,
+	0xce, 0xf8, 0xb0, 0xce, 0x8c, 0x0f, 0x2b, 0xda, 0x85, 0xbc, 0xd7, 0xe7, 0x8c, 0x63, 0x79, 0x0f,
+	0x9e, 0xf7, 0x89, 0xef, 0x12, 0xf9, 0xfc, 0xcd, 0x98, 0x28, 0xa1, 0x7a, 0x22, 0x35, 0x41, 0x25,
+	0xf4, 0x7c, 0xef, 0x7c, 0x50, 0xe8, 0xb3, 0x37, 0xab, 0x04, 0x09, 0x12, 0x96, 0xe4, 0x1b, 0x05,
+	0x36, 0xc7, 0xe4, 0x22, 0xe0, 0x7c, 0x59, 0xc6, 0x9d, 0x4e, 0x0b, 0xdb, 0x67, 0xe8, 0x0b, 0x58,
+	0xeb, 0x91, 0xa8, 0x01, 0x25, 0x32, 0x1c, 0xa7, 0x7f, 0xa5, 0x37, 0x0a, 0x61, 0x38, 0xc8, 0x83,
+	0x15, 0xd6, 0xc6, 0x3e, 0x61, 0x16, 0xf7, 0xe4, 0x1d, 0x65, 0xf2, 0x09, 0x92, 0x9a, 0xc4, 0x9b,
+	0x55, 0x42, 0x37, 0x3c, 0x71, 0x6d, 0x59, 0xf0, 0xfc, 0xb8, 0xf7, 0x3d, 0xac, 0x8e, 0x9f, 0x3b,
+	0x48, 0x83, 0xe5, 0x86, 0x59, 0xaa, 0xd5, 0x1f, 0xe9, 0xa6, 0x65, 0xd4, 0xac, 0x63, 0xf3, 0xe8,
+	0xc0, 0xd4, 0xeb, 0x75, 0x75, 0x0a, 0xe5, 0x21, 0x17, 0x6b, 0x1e, 0x95, 0x8c, 0xaa, 0x5e, 0x51,
+	0x15, 0xb4, 0x0c, 0x6a, 0x45, 0xaf, 0xea, 0x07, 0xa5, 0x86, 0x71, 0x54, 0xb3, 0x9e, 0x34, 0xf5,
+	0xa6, 0xae, 0xa6, 0xd0, 0x1a, 0xe4, 0x13, 0xd2, 0xf2, 0xd1, 0xe1, 0x71, 0x55, 0x6f, 0xe8, 0x6a,
+	0x7a, 0x23, 0xf3, 0xc3, 0x2f, 0x85, 0xa9, 0x7b, 0x3f, 0x2b, 0xb0, 0x32, 0x76, 0xf0, 0xa0, 0xdb,
+	0xa0, 0x95, 0xca, 0xe5, 0xe6, 0x61, 0xb3, 0x5a, 0x6a, 0x18, 0xb5, 0x03, 0xcb, 0xd4, 0x2b, 0xfa,
+	0xe1, 0x71, 0x80, 0x12, 0xee, 0xa0, 0x59, 0xdb, 0x3f, 0xaa, 0x55, 0x02, 0x95, 0xe4, 0x52, 0xd0,
+	0x3a, 0xac, 0x0c, 0x84, 0xc9, 0x1d, 0xa7, 0xd0, 0x3c, 0x64, 0xa5, 0x4a, 0xaf, 0xa8, 0x69, 0xb4,
+	0x00, 0xb3, 0xe5, 0x6a, 0xc9, 0x38, 0x2c, 0xed, 0x57, 0x75, 0x35, 0x83, 0xe6, 0x60, 0x46, 0x2c,
+	0xf5, 0x8a, 0x7a, 0x2b, 0xdc, 0x97, 0x07, 0x6b, 0x57, 0x5c, 0x37, 0xb4, 0x0a, 0xa8, 0x7c, 0x54,
+	0x7b, 0x64, 0x98, 0x87, 0xd6, 0xe0, 0x64, 0xea, 0x54, 0x10, 0xae, 0x48, 0xde, 0xac, 0x25, 0x34,
+	0x8a, 0x38, 0x4a, 0xe5, 0x9b, 0x66, 0xbd, 0x11, 0x39, 0xe8, 0x15, 0x6b, 0xbf, 0x54, 0x2d, 0xd5,
+	0xca, 0xba, 0x9a, 0x92, 0x84, 0xfb, 0xd5, 0xd7, 0x1f, 0x0b, 0xca, 0xdb, 0x8f, 0x05, 0xe5, 0xcf,
+	0x8f, 0x05, 0xe5, 0xa7, 0x4f, 0x85, 0xa9, 0xb7, 0x9f, 0x0a, 0x53, 0x7f, 0x7c, 0x2a, 0x4c, 0x3d,
+	0xdb, 0x4b, 0xa4, 0x5a, 0x7e, 0xd7, 0xec, 0x54, 0x71, 0x8b, 0xed, 0x86, 0xdf, 0xfc, 0xe7, 0x7b,
+	0x9f, 0xef, 0x5e, 0x0c, 0xbe, 0xfc, 0x45, 0xea, 0x5b, 0xd3, 0xe2, 0xab, 0xfd, 0xfe, 0x5f, 0x03,
+	0x00, 0x60, 0x91, 0x92, 0x00, 0x19, 0x10, 0x00, 0x00,
 }
 
 func (m *HostZone) Marshal() (dAtA []byte, err error) {
@@ -675,6 +965,20 @@ func (m *HostZone) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.IcqVerification != nil {
+		{
+			size, err := m.IcqVerification.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintStaketia(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xaa
+	}
 	if m.Halted {
 		i--
 		if m.Halted {
@@ -850,6 +1154,55 @@ func (m *HostZone) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ICQVerification) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ICQVerification) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ICQVerification) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Validators) > 0 {
+		for iNdEx := len(m.Validators) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Validators[iNdEx])
+			copy(dAtA[i:], m.Validators[iNdEx])
+			i = encodeVarintStaketia(dAtA, i, uint64(len(m.Validators[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.ConnectionId) > 0 {
+		i -= len(m.ConnectionId)
+		copy(dAtA[i:], m.ConnectionId)
+		i = encodeVarintStaketia(dAtA, i, uint64(len(m.ConnectionId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Enabled {
+		i--
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *DelegationRecord) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1077,46 +1430,168 @@ func (m *SlashRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintStaketia(dAtA []byte, offset int, v uint64) int {
-	offset -= sovStaketia(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *PendingConfirmation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *HostZone) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *PendingConfirmation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PendingConfirmation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ChainId)
-	if l > 0 {
-		n += 1 + l + sovStaketia(uint64(l))
+	{
+		size := m.ProvenAmount.Size()
+		i -= size
+		if _, err := m.ProvenAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStaketia(dAtA, i, uint64(size))
 	}
-	l = len(m.NativeTokenDenom)
-	if l > 0 {
-		n += 1 + l + sovStaketia(uint64(l))
+	i--
+	dAtA[i] = 0x4a
+	if m.OutstandingQueries != 0 {
+		i = encodeVarintStaketia(dAtA, i, uint64(m.OutstandingQueries))
+		i--
+		dAtA[i] = 0x40
 	}
-	l = len(m.NativeTokenIbcDenom)
-	if l > 0 {
-		n += 1 + l + sovStaketia(uint64(l))
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintStaketia(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x3a
 	}
-	l = len(m.TransferChannelId)
-	if l > 0 {
-		n += 1 + l + sovStaketia(uint64(l))
+	{
+		size := m.DelegationOffset.Size()
+		i -= size
+		if _, err := m.DelegationOffset.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStaketia(dAtA, i, uint64(size))
 	}
-	l = len(m.DelegationAddress)
-	if l > 0 {
-		n += 1 + l + sovStaketia(uint64(l))
+	i--
+	dAtA[i] = 0x32
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintStaketia(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x2a
 	}
-	l = len(m.RewardAddress)
-	if l > 0 {
-		n += 1 + l + sovStaketia(uint64(l))
+	if len(m.TxHash) > 0 {
+		i -= len(m.TxHash)
+		copy(dAtA[i:], m.TxHash)
+		i = encodeVarintStaketia(dAtA, i, uint64(len(m.TxHash)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.RecordId != 0 {
+		i = encodeVarintStaketia(dAtA, i, uint64(m.RecordId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.ConfirmationType != 0 {
+		i = encodeVarintStaketia(dAtA, i, uint64(m.ConfirmationType))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Id != 0 {
+		i = encodeVarintStaketia(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PendingConfirmationQueryCallback) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PendingConfirmationQueryCallback) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PendingConfirmationQueryCallback) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.SharesToTokensRate.Size()
+		i -= size
+		if _, err := m.SharesToTokensRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStaketia(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.PendingConfirmationId != 0 {
+		i = encodeVarintStaketia(dAtA, i, uint64(m.PendingConfirmationId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintStaketia(dAtA []byte, offset int, v uint64) int {
+	offset -= sovStaketia(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *HostZone) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovStaketia(uint64(l))
+	}
+	l = len(m.NativeTokenDenom)
+	if l > 0 {
+		n += 1 + l + sovStaketia(uint64(l))
+	}
+	l = len(m.NativeTokenIbcDenom)
+	if l > 0 {
+		n += 1 + l + sovStaketia(uint64(l))
+	}
+	l = len(m.TransferChannelId)
+	if l > 0 {
+		n += 1 + l + sovStaketia(uint64(l))
+	}
+	l = len(m.DelegationAddress)
+	if l > 0 {
+		n += 1 + l + sovStaketia(uint64(l))
+	}
+	l = len(m.RewardAddress)
+	if l > 0 {
+		n += 1 + l + sovStaketia(uint64(l))
 	}
 	l = len(m.DepositAddress)
 	if l > 0 {
@@ -1158,6 +1633,32 @@ func (m *HostZone) Size() (n int) {
 	if m.Halted {
 		n += 3
 	}
+	if m.IcqVerification != nil {
+		l = m.IcqVerification.Size()
+		n += 2 + l + sovStaketia(uint64(l))
+	}
+	return n
+}
+
+func (m *ICQVerification) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Enabled {
+		n += 2
+	}
+	l = len(m.ConnectionId)
+	if l > 0 {
+		n += 1 + l + sovStaketia(uint64(l))
+	}
+	if len(m.Validators) > 0 {
+		for _, s := range m.Validators {
+			l = len(s)
+			n += 1 + l + sovStaketia(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1253,6 +1754,57 @@ func (m *SlashRecord) Size() (n int) {
 	return n
 }
 
+func (m *PendingConfirmation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovStaketia(uint64(m.Id))
+	}
+	if m.ConfirmationType != 0 {
+		n += 1 + sovStaketia(uint64(m.ConfirmationType))
+	}
+	if m.RecordId != 0 {
+		n += 1 + sovStaketia(uint64(m.RecordId))
+	}
+	l = len(m.TxHash)
+	if l > 0 {
+		n += 1 + l + sovStaketia(uint64(l))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovStaketia(uint64(l))
+	}
+	l = m.DelegationOffset.Size()
+	n += 1 + l + sovStaketia(uint64(l))
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovStaketia(uint64(l))
+	}
+	if m.OutstandingQueries != 0 {
+		n += 1 + sovStaketia(uint64(m.OutstandingQueries))
+	}
+	l = m.ProvenAmount.Size()
+	n += 1 + l + sovStaketia(uint64(l))
+	return n
+}
+
+func (m *PendingConfirmationQueryCallback) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PendingConfirmationId != 0 {
+		n += 1 + sovStaketia(uint64(m.PendingConfirmationId))
+	}
+	l = m.SharesToTokensRate.Size()
+	n += 1 + l + sovStaketia(uint64(l))
+	return n
+}
+
 func sovStaketia(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1917,6 +2469,42 @@ func (m *HostZone) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.Halted = bool(v != 0)
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IcqVerification", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaketia
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStaketia
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaketia
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.IcqVerification == nil {
+				m.IcqVerification = &ICQVerification{}
+			}
+			if err := m.IcqVerification.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipStaketia(dAtA[iNdEx:])
@@ -1938,7 +2526,7 @@ func (m *HostZone) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DelegationRecord) Unmarshal(dAtA []byte) error {
+func (m *ICQVerification) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1961,17 +2549,17 @@ func (m *DelegationRecord) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DelegationRecord: wiretype end group for non-group")
+			return fmt.Errorf("proto: ICQVerification: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DelegationRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ICQVerification: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
 			}
-			m.Id = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaketia
@@ -1981,14 +2569,15 @@ func (m *DelegationRecord) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Id |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.Enabled = bool(v != 0)
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NativeAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2016,32 +2605,11 @@ func (m *DelegationRecord) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.NativeAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ConnectionId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
-			}
-			m.Status = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowStaketia
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Status |= DelegationRecordStatus(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TxHash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Validators", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2069,7 +2637,7 @@ func (m *DelegationRecord) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TxHash = string(dAtA[iNdEx:postIndex])
+			m.Validators = append(m.Validators, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2092,7 +2660,7 @@ func (m *DelegationRecord) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UnbondingRecord) Unmarshal(dAtA []byte) error {
+func (m *DelegationRecord) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2115,10 +2683,10 @@ func (m *UnbondingRecord) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UnbondingRecord: wiretype end group for non-group")
+			return fmt.Errorf("proto: DelegationRecord: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UnbondingRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DelegationRecord: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2141,27 +2709,8 @@ func (m *UnbondingRecord) Unmarshal(dAtA []byte) error {
 				}
 			}
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
-			}
-			m.Status = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowStaketia
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Status |= UnbondingRecordStatus(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StTokenAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NativeAmount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2189,13 +2738,32 @@ func (m *UnbondingRecord) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.StTokenAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.NativeAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaketia
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= DelegationRecordStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NativeAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TxHash", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2223,10 +2791,164 @@ func (m *UnbondingRecord) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.NativeAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.TxHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStaketia(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStaketia
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UnbondingRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStaketia
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UnbondingRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UnbondingRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaketia
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaketia
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= UnbondingRecordStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StTokenAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaketia
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaketia
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaketia
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.StTokenAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NativeAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaketia
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaketia
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaketia
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.NativeAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 5:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingCompletionTimeSeconds", wireType)
@@ -2654,6 +3376,399 @@ func (m *SlashRecord) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *PendingConfirmation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStaketia
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PendingConfirmation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PendingConfirmation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaketia
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConfirmationType", wireType)
+			}
+			m.ConfirmationType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaketia
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ConfirmationType |= PendingConfirmationType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordId", wireType)
+			}
+			m.RecordId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaketia
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RecordId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaketia
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaketia
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaketia
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaketia
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaketia
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaketia
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegationOffset", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaketia
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaketia
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaketia
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DelegationOffset.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaketia
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaketia
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaketia
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutstandingQueries", wireType)
+			}
+			m.OutstandingQueries = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaketia
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OutstandingQueries |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProvenAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaketia
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaketia
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaketia
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ProvenAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStaketia(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStaketia
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PendingConfirmationQueryCallback) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStaketia
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PendingConfirmationQueryCallback: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PendingConfirmationQueryCallback: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingConfirmationId", wireType)
+			}
+			m.PendingConfirmationId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaketia
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PendingConfirmationId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SharesToTokensRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaketia
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaketia
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaketia
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SharesToTokensRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStaketia(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStaketia
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipStaketia(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -1227,6 +1227,200 @@ func (m *MsgSetOperatorAddressResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgSetOperatorAddressResponse proto.InternalMessageInfo
 
+// SetICQVerification
+type MsgSetICQVerification struct {
+	Signer       string   `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	Enabled      bool     `protobuf:"varint,2,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	ConnectionId string   `protobuf:"bytes,3,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty"`
+	Validators   []string `protobuf:"bytes,4,rep,name=validators,proto3" json:"validators,omitempty"`
+}
+
+func (m *MsgSetICQVerification) Reset()         { *m = MsgSetICQVerification{} }
+func (m *MsgSetICQVerification) String() string { return proto.CompactTextString(m) }
+func (*MsgSetICQVerification) ProtoMessage()    {}
+func (*MsgSetICQVerification) Descriptor() ([]byte, []int) {
+	return fileDescriptor_98ceebce67c1ff4c, []int{26}
+}
+func (m *MsgSetICQVerification) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetICQVerification) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetICQVerification.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetICQVerification) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetICQVerification.Merge(m, src)
+}
+func (m *MsgSetICQVerification) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetICQVerification) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetICQVerification.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetICQVerification proto.InternalMessageInfo
+
+func (m *MsgSetICQVerification) GetSigner() string {
+	if m != nil {
+		return m.Signer
+	}
+	return ""
+}
+
+func (m *MsgSetICQVerification) GetEnabled() bool {
+	if m != nil {
+		return m.Enabled
+	}
+	return false
+}
+
+func (m *MsgSetICQVerification) GetConnectionId() string {
+	if m != nil {
+		return m.ConnectionId
+	}
+	return ""
+}
+
+func (m *MsgSetICQVerification) GetValidators() []string {
+	if m != nil {
+		return m.Validators
+	}
+	return nil
+}
+
+type MsgSetICQVerificationResponse struct {
+}
+
+func (m *MsgSetICQVerificationResponse) Reset()         { *m = MsgSetICQVerificationResponse{} }
+func (m *MsgSetICQVerificationResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetICQVerificationResponse) ProtoMessage()    {}
+func (*MsgSetICQVerificationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_98ceebce67c1ff4c, []int{27}
+}
+func (m *MsgSetICQVerificationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetICQVerificationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetICQVerificationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetICQVerificationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetICQVerificationResponse.Merge(m, src)
+}
+func (m *MsgSetICQVerificationResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetICQVerificationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetICQVerificationResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetICQVerificationResponse proto.InternalMessageInfo
+
+// ClearPendingConfirmation
+type MsgClearPendingConfirmation struct {
+	Signer                string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	PendingConfirmationId uint64 `protobuf:"varint,2,opt,name=pending_confirmation_id,json=pendingConfirmationId,proto3" json:"pending_confirmation_id,omitempty"`
+}
+
+func (m *MsgClearPendingConfirmation) Reset()         { *m = MsgClearPendingConfirmation{} }
+func (m *MsgClearPendingConfirmation) String() string { return proto.CompactTextString(m) }
+func (*MsgClearPendingConfirmation) ProtoMessage()    {}
+func (*MsgClearPendingConfirmation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_98ceebce67c1ff4c, []int{28}
+}
+func (m *MsgClearPendingConfirmation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgClearPendingConfirmation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgClearPendingConfirmation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgClearPendingConfirmation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgClearPendingConfirmation.Merge(m, src)
+}
+func (m *MsgClearPendingConfirmation) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgClearPendingConfirmation) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgClearPendingConfirmation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgClearPendingConfirmation proto.InternalMessageInfo
+
+func (m *MsgClearPendingConfirmation) GetSigner() string {
+	if m != nil {
+		return m.Signer
+	}
+	return ""
+}
+
+func (m *MsgClearPendingConfirmation) GetPendingConfirmationId() uint64 {
+	if m != nil {
+		return m.PendingConfirmationId
+	}
+	return 0
+}
+
+type MsgClearPendingConfirmationResponse struct {
+}
+
+func (m *MsgClearPendingConfirmationResponse) Reset()         { *m = MsgClearPendingConfirmationResponse{} }
+func (m *MsgClearPendingConfirmationResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgClearPendingConfirmationResponse) ProtoMessage()    {}
+func (*MsgClearPendingConfirmationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_98ceebce67c1ff4c, []int{29}
+}
+func (m *MsgClearPendingConfirmationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgClearPendingConfirmationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgClearPendingConfirmationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgClearPendingConfirmationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgClearPendingConfirmationResponse.Merge(m, src)
+}
+func (m *MsgClearPendingConfirmationResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgClearPendingConfirmationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgClearPendingConfirmationResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgClearPendingConfirmationResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterEnum("stride.staketia.OverwritableRecordType", OverwritableRecordType_name, OverwritableRecordType_value)
 	proto.RegisterType((*MsgLiquidStake)(nil), "stride.staketia.MsgLiquidStake")
@@ -1255,97 +1449,110 @@ func init() {
 	proto.RegisterType((*MsgOverwriteRedemptionRecordResponse)(nil), "stride.staketia.MsgOverwriteRedemptionRecordResponse")
 	proto.RegisterType((*MsgSetOperatorAddress)(nil), "stride.staketia.MsgSetOperatorAddress")
 	proto.RegisterType((*MsgSetOperatorAddressResponse)(nil), "stride.staketia.MsgSetOperatorAddressResponse")
+	proto.RegisterType((*MsgSetICQVerification)(nil), "stride.staketia.MsgSetICQVerification")
+	proto.RegisterType((*MsgSetICQVerificationResponse)(nil), "stride.staketia.MsgSetICQVerificationResponse")
+	proto.RegisterType((*MsgClearPendingConfirmation)(nil), "stride.staketia.MsgClearPendingConfirmation")
+	proto.RegisterType((*MsgClearPendingConfirmationResponse)(nil), "stride.staketia.MsgClearPendingConfirmationResponse")
 }
 
 func init() { proto.RegisterFile("stride/staketia/tx.proto", fileDescriptor_98ceebce67c1ff4c) }
 
 var fileDescriptor_98ceebce67c1ff4c = []byte{
-	// 1356 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x58, 0xcf, 0x6f, 0x1b, 0xc5,
-	0x17, 0xf7, 0xa6, 0x55, 0x7f, 0x8c, 0xbf, 0x6d, 0x9d, 0xfd, 0xb6, 0xae, 0xbd, 0xa5, 0x76, 0xba,
-	0x6d, 0xd3, 0xd6, 0x6d, 0x76, 0x89, 0xdb, 0x82, 0x64, 0xb8, 0xc4, 0xb5, 0xd5, 0x46, 0xc4, 0x71,
-	0xe5, 0x24, 0x54, 0x94, 0xc3, 0xb2, 0xf6, 0x4e, 0xd6, 0x4b, 0xe3, 0x1d, 0x77, 0x67, 0xec, 0xb8,
-	0x42, 0x42, 0xc0, 0xa9, 0xe2, 0x84, 0xc4, 0x89, 0x03, 0x27, 0x24, 0x24, 0xb8, 0xd0, 0x03, 0x37,
-	0x2e, 0x1c, 0x7b, 0xac, 0x38, 0x21, 0x0e, 0x05, 0x25, 0x87, 0x8a, 0x7f, 0x00, 0xae, 0x68, 0x7f,
-	0x76, 0x76, 0x77, 0xd6, 0x76, 0x0a, 0xb9, 0xc4, 0xde, 0x79, 0x9f, 0x79, 0xef, 0xf3, 0x3e, 0x6f,
-	0xf6, 0xcd, 0x8b, 0x41, 0x0e, 0x13, 0xcb, 0xd0, 0xa0, 0x8c, 0x89, 0xfa, 0x00, 0x12, 0x43, 0x95,
-	0xc9, 0x48, 0xea, 0x5b, 0x88, 0x20, 0xfe, 0x84, 0x6b, 0x91, 0x7c, 0x8b, 0x70, 0xba, 0x83, 0x70,
-	0x0f, 0x61, 0xb9, 0x87, 0x75, 0x79, 0xb8, 0x68, 0x7f, 0xb8, 0x48, 0x61, 0x56, 0xed, 0x19, 0x26,
-	0x92, 0x9d, 0xbf, 0xde, 0xd2, 0x49, 0x1d, 0xe9, 0xc8, 0xf9, 0x2a, 0xdb, 0xdf, 0xbc, 0xd5, 0x82,
-	0xe7, 0xa1, 0xad, 0x62, 0x28, 0x0f, 0x17, 0xdb, 0x90, 0xa8, 0x8b, 0x72, 0x07, 0x19, 0xa6, 0x67,
-	0xcf, 0xbb, 0x76, 0xc5, 0xdd, 0xe8, 0x3e, 0xf8, 0x5b, 0xa3, 0x3c, 0xfd, 0x2f, 0xae, 0x5d, 0xfc,
-	0x96, 0x03, 0xc7, 0x1b, 0x58, 0x5f, 0x31, 0x1e, 0x0e, 0x0c, 0x6d, 0xcd, 0xb6, 0xf1, 0x59, 0x70,
-	0xc8, 0x01, 0x59, 0x39, 0x6e, 0x8e, 0xbb, 0x7c, 0xb4, 0xe5, 0x3d, 0xf1, 0x6b, 0xe0, 0x98, 0xa9,
-	0x12, 0x63, 0x08, 0x15, 0xb5, 0x87, 0x06, 0x26, 0xc9, 0xcd, 0xd8, 0xe6, 0xaa, 0xf4, 0xf4, 0x79,
-	0x31, 0xf5, 0xdb, 0xf3, 0xe2, 0xbc, 0x6e, 0x90, 0xee, 0xa0, 0x2d, 0x75, 0x50, 0xcf, 0xa3, 0xe0,
-	0x7d, 0x2c, 0x60, 0xed, 0x81, 0x4c, 0x1e, 0xf5, 0x21, 0x96, 0x96, 0x4d, 0xd2, 0xfa, 0x9f, 0xeb,
-	0x64, 0xc9, 0xf1, 0x51, 0xb9, 0xf4, 0xd9, 0x8b, 0x27, 0x25, 0x2f, 0xc2, 0xe7, 0x2f, 0x9e, 0x94,
-	0x4e, 0x07, 0x44, 0xc3, 0xac, 0xc4, 0x4f, 0x38, 0x90, 0x0d, 0x2f, 0xb5, 0x20, 0xee, 0x23, 0x13,
-	0x43, 0x7e, 0x13, 0x1c, 0xc1, 0x44, 0x21, 0xe8, 0x01, 0x34, 0x1d, 0xca, 0xe9, 0x72, 0x5e, 0xf2,
-	0x44, 0xb0, 0x15, 0x93, 0x3c, 0xc5, 0xa4, 0x5b, 0xc8, 0x30, 0xab, 0xaf, 0xdb, 0x74, 0xbf, 0xff,
-	0xbd, 0x78, 0x79, 0x0a, 0xba, 0xf6, 0x06, 0xdc, 0x3a, 0x8c, 0xc9, 0xba, 0xed, 0x5b, 0xfc, 0xc1,
-	0xd5, 0xaa, 0x05, 0x35, 0x08, 0x7b, 0xae, 0x56, 0x02, 0x38, 0x62, 0x39, 0x8f, 0x81, 0x5a, 0xc1,
-	0x33, 0xff, 0x2e, 0x38, 0xe1, 0xd3, 0xfa, 0x77, 0x8a, 0x1d, 0xf3, 0x08, 0x78, 0x92, 0x5d, 0xb1,
-	0x25, 0x0b, 0xc2, 0xc4, 0x44, 0xa3, 0xe8, 0x89, 0x8f, 0x5d, 0xd1, 0xa8, 0xa5, 0x40, 0x34, 0x13,
-	0x78, 0x85, 0xd8, 0x3f, 0xe1, 0xd2, 0x6e, 0x00, 0x57, 0xbc, 0xaf, 0x38, 0x70, 0xb2, 0x81, 0xf5,
-	0x5b, 0xc8, 0xdc, 0x34, 0xac, 0x5e, 0x0d, 0x6e, 0x41, 0x5d, 0x25, 0x06, 0x32, 0x6d, 0x09, 0x51,
-	0x1f, 0x5a, 0x2a, 0x41, 0x81, 0x84, 0xfe, 0x33, 0x7f, 0x06, 0x1c, 0xb5, 0x60, 0x07, 0x59, 0x9a,
-	0x62, 0x68, 0x8e, 0x78, 0x07, 0x6d, 0x7d, 0xed, 0x85, 0x65, 0x8d, 0x3f, 0x0d, 0x0e, 0x93, 0x91,
-	0xd2, 0x55, 0x71, 0x37, 0x77, 0xc0, 0x3d, 0xa8, 0x64, 0x74, 0x47, 0xc5, 0xdd, 0x8a, 0xec, 0x08,
-	0xe4, 0x3b, 0xb1, 0x05, 0x3a, 0x4b, 0x0b, 0x14, 0xa3, 0x20, 0x16, 0xc0, 0x6b, 0xac, 0x75, 0x5f,
-	0x2b, 0xf1, 0x6b, 0x57, 0x46, 0x0f, 0xb0, 0x61, 0x6a, 0xfb, 0xc9, 0x7e, 0x31, 0xc6, 0xbe, 0xc8,
-	0x60, 0x4f, 0x93, 0x10, 0xe7, 0x40, 0x81, 0x6d, 0x09, 0x32, 0xf8, 0x8e, 0xa3, 0x53, 0xdc, 0x30,
-	0xdb, 0xc8, 0xd4, 0xa0, 0xe6, 0x54, 0x66, 0x6d, 0x1b, 0xc2, 0xfe, 0x3e, 0xe4, 0xf1, 0x66, 0x2c,
-	0x8f, 0x8b, 0xcc, 0x3c, 0xa2, 0x54, 0xc4, 0x79, 0x70, 0x61, 0x9c, 0x3d, 0xc8, 0xe9, 0x6f, 0x0e,
-	0xe4, 0x1b, 0x58, 0x5f, 0xd2, 0x3e, 0x1c, 0x60, 0xe2, 0x55, 0x0d, 0x6a, 0x55, 0x75, 0x4b, 0x35,
-	0x3b, 0x70, 0x6c, 0x42, 0xef, 0x83, 0xd9, 0x97, 0x1a, 0x29, 0x68, 0x73, 0x13, 0xc3, 0x57, 0x7d,
-	0x37, 0x33, 0x2f, 0x1d, 0x35, 0x1d, 0x3f, 0xfc, 0x55, 0x30, 0x3b, 0x54, 0xb7, 0x0c, 0xcd, 0x8e,
-	0xa4, 0xa8, 0x9a, 0x66, 0x41, 0x8c, 0x3d, 0x69, 0x32, 0x81, 0x61, 0xc9, 0x5d, 0xaf, 0xdc, 0x88,
-	0x89, 0x24, 0xd2, 0x22, 0xb1, 0x73, 0x13, 0xcf, 0x83, 0x73, 0x89, 0xc6, 0x40, 0x9e, 0x3f, 0x67,
-	0x80, 0xd8, 0xc0, 0xfa, 0x46, 0x5f, 0x53, 0x09, 0x5c, 0x36, 0x4d, 0x68, 0xd9, 0x6d, 0xa0, 0xd7,
-	0x77, 0xce, 0x85, 0x4a, 0x60, 0x15, 0x0d, 0x4c, 0x0d, 0xf3, 0x39, 0x70, 0xb8, 0x63, 0x41, 0x4a,
-	0x26, 0xff, 0x91, 0xdf, 0x06, 0xf9, 0x9e, 0x61, 0x2a, 0x86, 0xbd, 0x55, 0xb1, 0x82, 0xbd, 0x8a,
-	0xa5, 0x12, 0xe8, 0xa9, 0xf5, 0xf6, 0x1e, 0xd4, 0xaa, 0xc1, 0xce, 0x2f, 0x3f, 0x2e, 0x00, 0xaf,
-	0xbf, 0xd4, 0x60, 0xa7, 0x95, 0xed, 0x19, 0x26, 0x83, 0x98, 0x13, 0x58, 0x1d, 0x25, 0x04, 0x3e,
-	0xf0, 0x9f, 0x04, 0x56, 0x47, 0x8c, 0xc0, 0xee, 0x91, 0xf5, 0xf3, 0xb7, 0x8b, 0x31, 0x4f, 0x17,
-	0xc3, 0x55, 0x92, 0x25, 0xa2, 0x78, 0x0d, 0x94, 0x26, 0x4b, 0x1d, 0x54, 0xe6, 0x3e, 0x98, 0x75,
-	0x9a, 0x32, 0x1e, 0xf4, 0xe0, 0x1d, 0x84, 0xc9, 0x7d, 0x64, 0xc2, 0xe4, 0x3a, 0x54, 0xae, 0x46,
-	0x59, 0x09, 0xe1, 0x76, 0x4f, 0xbb, 0x11, 0xcf, 0x38, 0xef, 0x44, 0x78, 0x31, 0x08, 0xdc, 0x05,
-	0x39, 0xc7, 0xb8, 0x69, 0x41, 0xdc, 0x8d, 0x88, 0x9e, 0x1c, 0xbf, 0x1c, 0x8d, 0x7f, 0x2e, 0x1c,
-	0x9f, 0xe1, 0x4d, 0x14, 0xc1, 0x5c, 0x92, 0x2d, 0x60, 0xf3, 0xb3, 0xdb, 0x93, 0x9a, 0x43, 0x68,
-	0x6d, 0x5b, 0x06, 0x81, 0x74, 0xe3, 0xb5, 0x9b, 0xcb, 0x98, 0xa3, 0xb9, 0x1a, 0x7a, 0x81, 0xdd,
-	0x5e, 0xe4, 0x1c, 0xc9, 0x74, 0xf9, 0x9c, 0x14, 0x99, 0xbf, 0xa4, 0xa8, 0x5f, 0xfa, 0x9d, 0x75,
-	0x57, 0x2a, 0x6f, 0x44, 0x53, 0x0c, 0xb5, 0xaa, 0x44, 0x86, 0x5e, 0xab, 0x4a, 0xb4, 0x07, 0xa9,
-	0xfe, 0xc4, 0x81, 0x33, 0x34, 0xd0, 0xed, 0x6a, 0x86, 0xa9, 0x4f, 0xcc, 0xf4, 0x1d, 0x90, 0x19,
-	0xf8, 0xe0, 0x70, 0xa2, 0x73, 0xb1, 0x44, 0x23, 0x5e, 0x5b, 0x27, 0x06, 0xe1, 0x85, 0xca, 0xcd,
-	0x68, 0x9a, 0x17, 0x98, 0x69, 0x46, 0xfc, 0x88, 0x17, 0xc1, 0xf9, 0x31, 0xe6, 0xc4, 0x7a, 0x52,
-	0x65, 0x9f, 0xa2, 0x9e, 0xf4, 0x7b, 0x3e, 0xbe, 0x9e, 0x51, 0xbf, 0xad, 0x8c, 0x15, 0x59, 0x99,
-	0xb6, 0x9e, 0x51, 0x4f, 0xd1, 0x7a, 0xc6, 0x22, 0xf9, 0xa9, 0x7e, 0x04, 0x4e, 0x35, 0xb0, 0xbe,
-	0x06, 0x49, 0xd3, 0xeb, 0xdc, 0x5e, 0x3f, 0x77, 0x66, 0x67, 0x43, 0x37, 0xa9, 0xd9, 0xd9, 0x79,
-	0x0a, 0xdd, 0x46, 0x33, 0xe1, 0xdb, 0xa8, 0x22, 0xb9, 0x23, 0xb0, 0x03, 0xb4, 0xb9, 0x16, 0x68,
-	0xae, 0xf1, 0x18, 0x62, 0x11, 0x9c, 0x65, 0x1a, 0x7c, 0x76, 0xa5, 0x87, 0x20, 0xeb, 0xa7, 0xa0,
-	0xb6, 0xb7, 0xa0, 0xcb, 0x7d, 0xfd, 0x51, 0xdf, 0xbe, 0x14, 0xb3, 0xad, 0xfa, 0xad, 0x66, 0xab,
-	0xa6, 0xac, 0xbf, 0x77, 0xb7, 0xae, 0xd4, 0xea, 0x2b, 0xf5, 0xdb, 0x4b, 0xeb, 0xcb, 0xcd, 0xd5,
-	0x4c, 0x8a, 0xcf, 0x83, 0x53, 0xb4, 0x6d, 0x63, 0xb5, 0xda, 0x5c, 0xad, 0x2d, 0xaf, 0xde, 0xce,
-	0x70, 0xd1, 0x6d, 0xad, 0x7a, 0xad, 0xde, 0xb8, 0xeb, 0x6c, 0x9b, 0x11, 0x0e, 0x3e, 0xfe, 0xa6,
-	0x90, 0x2a, 0xff, 0x95, 0x06, 0x07, 0x1a, 0x58, 0xe7, 0xef, 0x81, 0x34, 0xfd, 0xaf, 0x44, 0x31,
-	0x56, 0xbc, 0xf0, 0x08, 0x2f, 0x5c, 0x9a, 0x00, 0x08, 0xc6, 0xd5, 0x7b, 0x20, 0x4d, 0xcf, 0xdd,
-	0x4c, 0xc7, 0x14, 0x80, 0xed, 0x98, 0x35, 0x07, 0x1b, 0x60, 0x36, 0x3e, 0x93, 0x5e, 0x64, 0xed,
-	0x8e, 0xc1, 0x84, 0x85, 0xa9, 0x60, 0x41, 0x28, 0x04, 0xfe, 0xcf, 0x1a, 0x21, 0x2f, 0x8d, 0xf1,
-	0x42, 0x03, 0x05, 0x79, 0x4a, 0x60, 0x10, 0xf0, 0x53, 0x0e, 0xe4, 0x93, 0x47, 0xbe, 0x85, 0xb1,
-	0xee, 0xa2, 0x70, 0xe1, 0xe6, 0x9e, 0xe0, 0x01, 0x87, 0x11, 0xc8, 0x26, 0x4c, 0x68, 0x25, 0x96,
-	0x43, 0x36, 0x56, 0x28, 0x4f, 0x8f, 0x0d, 0x22, 0x7f, 0xc9, 0x81, 0xe2, 0xa4, 0xe9, 0xe7, 0x3a,
-	0xcb, 0xef, 0x84, 0x4d, 0xc2, 0x5b, 0xaf, 0xb0, 0x29, 0x60, 0xf5, 0x01, 0x38, 0x1e, 0xb9, 0xf9,
-	0x45, 0xf6, 0x51, 0xa5, 0x31, 0x42, 0x69, 0x32, 0x26, 0x88, 0x30, 0x00, 0xa7, 0xd8, 0x57, 0xfc,
-	0x15, 0xb6, 0x13, 0x06, 0x54, 0x58, 0x9c, 0x1a, 0x1a, 0x3a, 0x6c, 0xc9, 0x77, 0x39, 0xf3, 0xb0,
-	0x25, 0xc2, 0xd9, 0x87, 0x6d, 0xe2, 0x3d, 0xcb, 0x7f, 0x0c, 0x72, 0x89, 0x77, 0xec, 0xb5, 0xb1,
-	0x2e, 0x23, 0x68, 0xe1, 0xc6, 0x5e, 0xd0, 0x6c, 0x0d, 0x62, 0xf7, 0xdf, 0x78, 0x0d, 0xa2, 0xf0,
-	0x09, 0x1a, 0x24, 0xdd, 0x4d, 0xfc, 0x16, 0xe0, 0x19, 0x17, 0xd3, 0x3c, 0xcb, 0x59, 0x1c, 0x27,
-	0x48, 0xd3, 0xe1, 0xfc, 0x68, 0xd5, 0x95, 0xa7, 0x3b, 0x05, 0xee, 0xd9, 0x4e, 0x81, 0xfb, 0x63,
-	0xa7, 0xc0, 0x7d, 0xb1, 0x5b, 0x48, 0x3d, 0xdb, 0x2d, 0xa4, 0x7e, 0xdd, 0x2d, 0xa4, 0xee, 0x97,
-	0xa9, 0xd1, 0x7c, 0xcd, 0xf1, 0xb9, 0xb0, 0xa2, 0xb6, 0xb1, 0xec, 0xfd, 0x20, 0x35, 0x2c, 0xdf,
-	0x90, 0x47, 0xd4, 0xcf, 0x67, 0xf6, 0xa8, 0xde, 0x3e, 0xe4, 0xfc, 0x28, 0x75, 0xfd, 0x9f, 0x00,
-	0x00, 0x00, 0xff, 0xff, 0x1f, 0x41, 0x7f, 0xd7, 0x5e, 0x13, 0x00, 0x00,
+	// 1502 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x58, 0xcf, 0x6f, 0xd4, 0xc6,
+	0x17, 0x8f, 0x13, 0x44, 0xc2, 0x0b, 0x81, 0xc4, 0x5f, 0x92, 0x6c, 0xcc, 0x97, 0xdd, 0x60, 0x20,
+	0x81, 0x40, 0x76, 0x9b, 0x25, 0xa1, 0xd2, 0xb6, 0x97, 0xfc, 0x12, 0xac, 0x9a, 0x4d, 0xa8, 0x93,
+	0x80, 0x4a, 0x0f, 0x5b, 0xef, 0x7a, 0xb2, 0x71, 0xc9, 0x8e, 0x17, 0x8f, 0x37, 0x2c, 0xaa, 0x54,
+	0xb5, 0x3d, 0xa1, 0x9e, 0x2a, 0xf5, 0xd4, 0x43, 0x4f, 0x48, 0x95, 0xda, 0x4b, 0x39, 0xf4, 0x56,
+	0xa9, 0xea, 0x91, 0x23, 0xea, 0xa9, 0xea, 0x81, 0x56, 0x50, 0x09, 0xf5, 0x2f, 0xe8, 0xb5, 0xb2,
+	0xc7, 0x9e, 0x8c, 0xed, 0xf1, 0xee, 0x86, 0x96, 0x4b, 0xb2, 0x33, 0xef, 0x33, 0xef, 0x7d, 0xde,
+	0xe7, 0xcd, 0x8c, 0x9f, 0x0d, 0x29, 0xe2, 0xd8, 0xa6, 0x81, 0x72, 0xc4, 0xd1, 0xef, 0x22, 0xc7,
+	0xd4, 0x73, 0x4e, 0x2b, 0xdb, 0xb0, 0x2d, 0xc7, 0x92, 0x4f, 0x52, 0x4b, 0x36, 0xb0, 0x28, 0xe3,
+	0x55, 0x8b, 0xd4, 0x2d, 0x92, 0xab, 0x93, 0x5a, 0x6e, 0x7f, 0xce, 0xfd, 0x47, 0x91, 0xca, 0x88,
+	0x5e, 0x37, 0xb1, 0x95, 0xf3, 0xfe, 0xfa, 0x53, 0xa7, 0x6a, 0x56, 0xcd, 0xf2, 0x7e, 0xe6, 0xdc,
+	0x5f, 0xfe, 0x6c, 0xda, 0xf7, 0x50, 0xd1, 0x09, 0xca, 0xed, 0xcf, 0x55, 0x90, 0xa3, 0xcf, 0xe5,
+	0xaa, 0x96, 0x89, 0x7d, 0xfb, 0x04, 0xb5, 0x97, 0xe9, 0x42, 0x3a, 0x08, 0x96, 0x46, 0x79, 0x06,
+	0x3f, 0xa8, 0x5d, 0xfd, 0x46, 0x82, 0x13, 0x25, 0x52, 0x5b, 0x33, 0xef, 0x35, 0x4d, 0x63, 0xd3,
+	0xb5, 0xc9, 0x63, 0x70, 0xd4, 0x03, 0xd9, 0x29, 0x69, 0x52, 0xba, 0x78, 0x4c, 0xf3, 0x47, 0xf2,
+	0x26, 0x0c, 0x61, 0xdd, 0x31, 0xf7, 0x51, 0x59, 0xaf, 0x5b, 0x4d, 0xec, 0xa4, 0x7a, 0x5d, 0xf3,
+	0x52, 0xf6, 0xc9, 0xb3, 0x4c, 0xcf, 0x6f, 0xcf, 0x32, 0x53, 0x35, 0xd3, 0xd9, 0x6d, 0x56, 0xb2,
+	0x55, 0xab, 0xee, 0x53, 0xf0, 0xff, 0xcd, 0x12, 0xe3, 0x6e, 0xce, 0x79, 0xd0, 0x40, 0x24, 0x5b,
+	0xc4, 0x8e, 0x76, 0x9c, 0x3a, 0x59, 0xf4, 0x7c, 0x14, 0xa6, 0x3f, 0x7b, 0xf9, 0x78, 0xc6, 0x8f,
+	0xf0, 0xf9, 0xcb, 0xc7, 0x33, 0xe3, 0x8c, 0x68, 0x98, 0x95, 0xfa, 0x89, 0x04, 0x63, 0xe1, 0x29,
+	0x0d, 0x91, 0x86, 0x85, 0x09, 0x92, 0x77, 0x60, 0x80, 0x38, 0x65, 0xc7, 0xba, 0x8b, 0xb0, 0x47,
+	0x79, 0x30, 0x3f, 0x91, 0xf5, 0x45, 0x70, 0x15, 0xcb, 0xfa, 0x8a, 0x65, 0x97, 0x2d, 0x13, 0x2f,
+	0xbd, 0xe1, 0xd2, 0xfd, 0xee, 0xf7, 0xcc, 0xc5, 0x2e, 0xe8, 0xba, 0x0b, 0x88, 0xd6, 0x4f, 0x9c,
+	0x2d, 0xd7, 0xb7, 0xfa, 0x3d, 0xd5, 0x4a, 0x43, 0x06, 0x42, 0x75, 0xaa, 0x95, 0x02, 0x03, 0xb6,
+	0x37, 0x64, 0x6a, 0xb1, 0xb1, 0x7c, 0x0b, 0x4e, 0x06, 0xb4, 0xfe, 0x9d, 0x62, 0x43, 0x3e, 0x01,
+	0x5f, 0xb2, 0x4b, 0xae, 0x64, 0x2c, 0x4c, 0x4c, 0x34, 0x8e, 0x9e, 0xfa, 0x90, 0x8a, 0xc6, 0x4d,
+	0x31, 0xd1, 0x30, 0xf8, 0x85, 0x78, 0x7d, 0xc2, 0x0d, 0xd2, 0x00, 0x54, 0xbc, 0xaf, 0x24, 0x38,
+	0x55, 0x22, 0xb5, 0x65, 0x0b, 0xef, 0x98, 0x76, 0x7d, 0x05, 0xed, 0xa1, 0x9a, 0xee, 0x98, 0x16,
+	0x76, 0x25, 0xb4, 0x1a, 0xc8, 0xd6, 0x1d, 0x8b, 0x49, 0x18, 0x8c, 0xe5, 0xd3, 0x70, 0xcc, 0x46,
+	0x55, 0xcb, 0x36, 0xca, 0xa6, 0xe1, 0x89, 0x77, 0x44, 0x1b, 0xa0, 0x13, 0x45, 0x43, 0x1e, 0x87,
+	0x7e, 0xa7, 0x55, 0xde, 0xd5, 0xc9, 0x6e, 0xaa, 0x8f, 0x6e, 0x54, 0xa7, 0x75, 0x43, 0x27, 0xbb,
+	0x85, 0x9c, 0x27, 0x50, 0xe0, 0xc4, 0x15, 0xe8, 0x0c, 0x2f, 0x50, 0x8c, 0x82, 0x9a, 0x86, 0xff,
+	0x8b, 0xe6, 0x03, 0xad, 0xd4, 0xaf, 0xa9, 0x8c, 0x3e, 0x60, 0x1b, 0x1b, 0xaf, 0x93, 0xfd, 0x5c,
+	0x8c, 0x7d, 0x46, 0xc0, 0x9e, 0x27, 0xa1, 0x4e, 0x42, 0x5a, 0x6c, 0x61, 0x19, 0x7c, 0x2b, 0xf1,
+	0x29, 0x6e, 0xe3, 0x8a, 0x85, 0x0d, 0x64, 0x78, 0x95, 0xd9, 0xbc, 0x8f, 0x50, 0xe3, 0x35, 0xe4,
+	0xf1, 0x66, 0x2c, 0x8f, 0x0b, 0xc2, 0x3c, 0xa2, 0x54, 0xd4, 0x29, 0x38, 0xdf, 0xce, 0xce, 0x72,
+	0xfa, 0x5b, 0x82, 0x89, 0x12, 0xa9, 0x2d, 0x1a, 0x1f, 0x36, 0x89, 0xe3, 0x57, 0x0d, 0x19, 0x4b,
+	0xfa, 0x9e, 0x8e, 0xab, 0xa8, 0x6d, 0x42, 0xef, 0xc3, 0xc8, 0x81, 0x46, 0x65, 0x6b, 0x67, 0x87,
+	0xa0, 0x57, 0x3d, 0x9b, 0xc3, 0x07, 0x8e, 0x36, 0x3c, 0x3f, 0xf2, 0x65, 0x18, 0xd9, 0xd7, 0xf7,
+	0x4c, 0xc3, 0x8d, 0x54, 0xd6, 0x0d, 0xc3, 0x46, 0x84, 0xf8, 0xd2, 0x0c, 0x33, 0xc3, 0x22, 0x9d,
+	0x2f, 0xcc, 0xc7, 0x44, 0x52, 0x79, 0x91, 0xc4, 0xb9, 0xa9, 0xe7, 0xe0, 0x6c, 0xa2, 0x91, 0xc9,
+	0xf3, 0x57, 0x2f, 0xa8, 0x25, 0x52, 0xdb, 0x6e, 0x18, 0xba, 0x83, 0x8a, 0x18, 0x23, 0x5b, 0x43,
+	0x06, 0xaa, 0x37, 0xbc, 0x7d, 0xa1, 0x3b, 0x68, 0xc9, 0x6a, 0x62, 0x83, 0xc8, 0x29, 0xe8, 0xaf,
+	0xda, 0x88, 0x93, 0x29, 0x18, 0xca, 0xf7, 0x61, 0xa2, 0x6e, 0xe2, 0xb2, 0xe9, 0x2e, 0x2d, 0xdb,
+	0x6c, 0x6d, 0xd9, 0xd6, 0x1d, 0xe4, 0xab, 0xf5, 0xf6, 0x21, 0xd4, 0x5a, 0x41, 0xd5, 0x5f, 0x7e,
+	0x98, 0x05, 0x3a, 0xef, 0x8e, 0xb4, 0xb1, 0xba, 0x89, 0x05, 0xc4, 0xbc, 0xc0, 0x7a, 0x2b, 0x21,
+	0x70, 0xdf, 0x7f, 0x12, 0x58, 0x6f, 0x09, 0x02, 0xd3, 0x2d, 0x1b, 0xe4, 0xef, 0x16, 0x63, 0x8a,
+	0x2f, 0x06, 0x55, 0x52, 0x24, 0xa2, 0x7a, 0x05, 0x66, 0x3a, 0x4b, 0xcd, 0x2a, 0x73, 0x07, 0x46,
+	0xbc, 0x4b, 0x99, 0x34, 0xeb, 0xe8, 0x86, 0x45, 0x9c, 0x3b, 0x16, 0x46, 0xc9, 0x75, 0x28, 0x5c,
+	0x8e, 0xb2, 0x52, 0xc2, 0xd7, 0x3d, 0xef, 0x46, 0x3d, 0x0d, 0x13, 0xb1, 0x49, 0x16, 0x78, 0x17,
+	0x52, 0x9e, 0x71, 0xc7, 0x46, 0x64, 0x37, 0x22, 0x7a, 0x72, 0xfc, 0x7c, 0x34, 0xfe, 0xd9, 0x70,
+	0x7c, 0x81, 0x37, 0x55, 0x85, 0xc9, 0x24, 0x1b, 0x63, 0xf3, 0x33, 0xbd, 0x93, 0x36, 0xf6, 0x91,
+	0x7d, 0xdf, 0x36, 0x1d, 0xc4, 0x5f, 0xbc, 0xee, 0xe5, 0xd2, 0x66, 0x6b, 0xae, 0x87, 0x0e, 0x30,
+	0xbd, 0x8b, 0xbc, 0x2d, 0x39, 0x98, 0x3f, 0x9b, 0x8d, 0xf4, 0x5f, 0xd9, 0xa8, 0x5f, 0xfe, 0xcc,
+	0xd2, 0x99, 0xc2, 0xb5, 0x68, 0x8a, 0xa1, 0xab, 0x2a, 0x91, 0xa1, 0x7f, 0x55, 0x25, 0xda, 0x59,
+	0xaa, 0x3f, 0x4a, 0x70, 0x9a, 0x07, 0xd2, 0x5b, 0xcd, 0xc4, 0xb5, 0x8e, 0x99, 0xbe, 0x03, 0xc3,
+	0xcd, 0x00, 0x1c, 0x4e, 0x74, 0x32, 0x96, 0x68, 0xc4, 0xab, 0x76, 0xb2, 0x19, 0x9e, 0x28, 0x2c,
+	0x44, 0xd3, 0x3c, 0x2f, 0x4c, 0x33, 0xe2, 0x47, 0xbd, 0x00, 0xe7, 0xda, 0x98, 0x13, 0xeb, 0xc9,
+	0x95, 0xbd, 0x8b, 0x7a, 0xf2, 0xe7, 0xbc, 0x7d, 0x3d, 0xa3, 0x7e, 0xb5, 0x61, 0x3b, 0x32, 0xd3,
+	0x6d, 0x3d, 0xa3, 0x9e, 0xa2, 0xf5, 0x8c, 0x45, 0x0a, 0x52, 0xfd, 0x08, 0x46, 0x4b, 0xa4, 0xb6,
+	0x89, 0x9c, 0x0d, 0xff, 0xe6, 0xf6, 0xef, 0x73, 0xaf, 0x77, 0x36, 0x6b, 0x98, 0xeb, 0x9d, 0xbd,
+	0x51, 0xe8, 0x69, 0xd4, 0x1b, 0x7e, 0x1a, 0x15, 0xb2, 0xb4, 0x05, 0xf6, 0x80, 0x2e, 0xd7, 0x34,
+	0xcf, 0x35, 0x1e, 0x43, 0xcd, 0xc0, 0x19, 0xa1, 0x81, 0xb1, 0xfb, 0x49, 0x0a, 0xe8, 0x15, 0x97,
+	0xdf, 0xbd, 0x85, 0x6c, 0x73, 0xc7, 0xac, 0xd2, 0x6e, 0x25, 0x89, 0x5e, 0x0a, 0xfa, 0x11, 0xd6,
+	0x2b, 0x7b, 0x88, 0xaa, 0x3e, 0xa0, 0x05, 0x43, 0xf9, 0x1c, 0x0c, 0x55, 0x2d, 0x8c, 0x51, 0xd5,
+	0xab, 0x8c, 0x69, 0xf8, 0x4f, 0xb2, 0xe3, 0x07, 0x93, 0x45, 0x43, 0x4e, 0x03, 0xb0, 0x27, 0x1b,
+	0x49, 0x1d, 0x99, 0xec, 0xbb, 0x78, 0x4c, 0xe3, 0x66, 0x3a, 0x66, 0x18, 0xa1, 0x79, 0x90, 0x61,
+	0xc4, 0xc0, 0x32, 0x7c, 0x44, 0xcf, 0xd3, 0xf2, 0x1e, 0xd2, 0xed, 0x9b, 0xc8, 0xdb, 0x8c, 0x7e,
+	0xbf, 0xd0, 0x3e, 0xcf, 0x6b, 0x30, 0xde, 0xa0, 0xf0, 0x72, 0x95, 0xc3, 0x1f, 0xf4, 0x35, 0xa3,
+	0x8d, 0xb8, 0xb7, 0xa2, 0x41, 0x1f, 0xd3, 0x5c, 0x02, 0xa1, 0x73, 0x93, 0xc4, 0xc2, 0x3f, 0x37,
+	0x49, 0xe6, 0x20, 0x99, 0x99, 0x7b, 0x30, 0x16, 0xec, 0x38, 0x57, 0x73, 0xba, 0xd5, 0xb6, 0x1e,
+	0x34, 0xdc, 0x1e, 0x66, 0x4c, 0x5b, 0x5d, 0xde, 0xd0, 0x56, 0xca, 0x5b, 0xef, 0xdd, 0x5c, 0x2d,
+	0xaf, 0xac, 0xae, 0xad, 0x5e, 0x5f, 0xdc, 0x2a, 0x6e, 0xac, 0x0f, 0xf7, 0xc8, 0x13, 0x30, 0xca,
+	0xdb, 0xb6, 0xd7, 0x97, 0x36, 0xd6, 0x57, 0x8a, 0xeb, 0xd7, 0x87, 0xa5, 0xe8, 0x32, 0x6d, 0x75,
+	0x65, 0xb5, 0x74, 0xd3, 0x5b, 0xd6, 0xab, 0x1c, 0x79, 0xf8, 0x28, 0xdd, 0x93, 0xff, 0x73, 0x08,
+	0xfa, 0x4a, 0xa4, 0x26, 0xdf, 0x86, 0x41, 0xfe, 0xcd, 0x2f, 0x13, 0x3b, 0x6b, 0xe1, 0x37, 0x2e,
+	0x65, 0xba, 0x03, 0x80, 0xbd, 0x5d, 0xdc, 0x86, 0x41, 0xfe, 0x35, 0x49, 0xe8, 0x98, 0x03, 0x28,
+	0xd3, 0x1d, 0x00, 0xcc, 0xb1, 0x09, 0x23, 0xf1, 0x57, 0x88, 0x0b, 0xa2, 0xd5, 0x31, 0x98, 0x32,
+	0xdb, 0x15, 0x8c, 0x85, 0xb2, 0xe0, 0x7f, 0xa2, 0x8e, 0x7f, 0xba, 0x8d, 0x17, 0x1e, 0xa8, 0xe4,
+	0xba, 0x04, 0xb2, 0x80, 0x9f, 0x4a, 0x30, 0x91, 0xdc, 0xa1, 0xcf, 0xb6, 0x75, 0x17, 0x85, 0x2b,
+	0x0b, 0x87, 0x82, 0x33, 0x0e, 0x2d, 0x18, 0x4b, 0x68, 0xa8, 0x67, 0x44, 0x0e, 0xc5, 0x58, 0x25,
+	0xdf, 0x3d, 0x96, 0x45, 0xfe, 0x52, 0x82, 0x4c, 0xa7, 0x66, 0xf5, 0xaa, 0xc8, 0x6f, 0x87, 0x45,
+	0xca, 0x5b, 0xaf, 0xb0, 0x88, 0xb1, 0xfa, 0x00, 0x4e, 0x44, 0x1a, 0x35, 0x55, 0xbc, 0x55, 0x79,
+	0x8c, 0x32, 0xd3, 0x19, 0xc3, 0x22, 0x34, 0x61, 0x54, 0xdc, 0x91, 0x5d, 0x12, 0x3b, 0x11, 0x40,
+	0x95, 0xb9, 0xae, 0xa1, 0xa1, 0xcd, 0x96, 0xdc, 0x7a, 0x09, 0x37, 0x5b, 0x22, 0x5c, 0x59, 0x38,
+	0x14, 0x9c, 0x71, 0xf8, 0x18, 0x52, 0x89, 0x2d, 0xd1, 0x95, 0xb6, 0x2e, 0x23, 0x68, 0x65, 0xfe,
+	0x30, 0x68, 0xb1, 0x06, 0xb1, 0x76, 0xa5, 0xbd, 0x06, 0x51, 0xb8, 0xb2, 0x70, 0x28, 0x38, 0xe3,
+	0xb0, 0x07, 0xb2, 0xa0, 0x8f, 0x98, 0x12, 0x39, 0x8b, 0xe3, 0x94, 0x6c, 0x77, 0xb8, 0x48, 0xb4,
+	0x68, 0x5b, 0x90, 0x14, 0x2d, 0x82, 0x53, 0xb2, 0xdd, 0xe1, 0xf8, 0xfa, 0x26, 0x3e, 0xa2, 0x85,
+	0xf5, 0x4d, 0x42, 0x2b, 0xf3, 0x87, 0x41, 0x07, 0xf1, 0x97, 0xd6, 0x9e, 0x3c, 0x4f, 0x4b, 0x4f,
+	0x9f, 0xa7, 0xa5, 0x3f, 0x9e, 0xa7, 0xa5, 0x2f, 0x5e, 0xa4, 0x7b, 0x9e, 0xbe, 0x48, 0xf7, 0xfc,
+	0xfa, 0x22, 0xdd, 0x73, 0x27, 0xcf, 0xbd, 0x37, 0x6e, 0x7a, 0x9e, 0x67, 0xd7, 0xf4, 0x0a, 0xc9,
+	0xf9, 0x5f, 0x4b, 0xf7, 0xf3, 0xf3, 0xb9, 0x16, 0xf7, 0x6d, 0xd7, 0x7d, 0x8f, 0xac, 0x1c, 0xf5,
+	0xbe, 0x98, 0x5e, 0xfd, 0x67, 0x00, 0x0b, 0x7b, 0xbf, 0x4e, 0xfb, 0x15, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1390,6 +1597,10 @@ type MsgClient interface {
 	OverwriteRedemptionRecord(ctx context.Context, in *MsgOverwriteRedemptionRecord, opts ...grpc.CallOption) (*MsgOverwriteRedemptionRecordResponse, error)
 	// Sets the operator address
 	SetOperatorAddress(ctx context.Context, in *MsgSetOperatorAddress, opts ...grpc.CallOption) (*MsgSetOperatorAddressResponse, error)
+	// Sets the configuration for verifying operator confirmations with ICQ
+	SetICQVerification(ctx context.Context, in *MsgSetICQVerification, opts ...grpc.CallOption) (*MsgSetICQVerificationResponse, error)
+	// Removes a pending confirmation whose verification queries are stuck
+	ClearPendingConfirmation(ctx context.Context, in *MsgClearPendingConfirmation, opts ...grpc.CallOption) (*MsgClearPendingConfirmationResponse, error)
 }
 
 type msgClient struct {
@@ -1517,6 +1728,24 @@ func (c *msgClient) SetOperatorAddress(ctx context.Context, in *MsgSetOperatorAd
 	return out, nil
 }
 
+func (c *msgClient) SetICQVerification(ctx context.Context, in *MsgSetICQVerification, opts ...grpc.CallOption) (*MsgSetICQVerificationResponse, error) {
+	out := new(MsgSetICQVerificationResponse)
+	err := c.cc.Invoke(ctx, "/stride.staketia.Msg/SetICQVerification", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ClearPendingConfirmation(ctx context.Context, in *MsgClearPendingConfirmation, opts ...grpc.CallOption) (*MsgClearPendingConfirmationResponse, error) {
+	out := new(MsgClearPendingConfirmationResponse)
+	err := c.cc.Invoke(ctx, "/stride.staketia.Msg/ClearPendingConfirmation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// User transaction to liquid stake native tokens into stTokens
@@ -1549,6 +1778,10 @@ type MsgServer interface {
 	OverwriteRedemptionRecord(context.Context, *MsgOverwriteRedemptionRecord) (*MsgOverwriteRedemptionRecordResponse, error)
 	// Sets the operator address
 	SetOperatorAddress(context.Context, *MsgSetOperatorAddress) (*MsgSetOperatorAddressResponse, error)
+	// Sets the configuration for verifying operator confirmations with ICQ
+	SetICQVerification(context.Context, *MsgSetICQVerification) (*MsgSetICQVerificationResponse, error)
+	// Removes a pending confirmation whose verification queries are stuck
+	ClearPendingConfirmation(context.Context, *MsgClearPendingConfirmation) (*MsgClearPendingConfirmationResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -1594,6 +1827,12 @@ func (*UnimplementedMsgServer) OverwriteRedemptionRecord(ctx context.Context, re
 func (*UnimplementedMsgServer) SetOperatorAddress(ctx context.Context, req *MsgSetOperatorAddress) (*MsgSetOperatorAddressResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SetOperatorAddress not implemented")
 }
+func (*UnimplementedMsgServer) SetICQVerification(ctx context.Context, req *MsgSetICQVerification) (*MsgSetICQVerificationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetICQVerification not implemented")
+}
+func (*UnimplementedMsgServer) ClearPendingConfirmation(ctx context.Context, req *MsgClearPendingConfirmation) (*MsgClearPendingConfirmationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClearPendingConfirmation not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -1833,6 +2072,42 @@ func _Msg_SetOperatorAddress_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_SetICQVerification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetICQVerification)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetICQVerification(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stride.staketia.Msg/SetICQVerification",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetICQVerification(ctx, req.(*MsgSetICQVerification))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ClearPendingConfirmation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgClearPendingConfirmation)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ClearPendingConfirmation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stride.staketia.Msg/ClearPendingConfirmation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ClearPendingConfirmation(ctx, req.(*MsgClearPendingConfirmation))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "stride.staketia.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -1889,6 +2164,14 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "SetOperatorAddress",
 			Handler:    _Msg_SetOperatorAddress_Handler,
 		},
+		{
+			MethodName: "SetICQVerification",
+			Handler:    _Msg_SetICQVerification_Handler,
+		},
+		{
+			MethodName: "ClearPendingConfirmation",
+			Handler:    _Msg_ClearPendingConfirmation_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "stride/staketia/tx.proto",
@@ -2739,76 +3022,213 @@ func (m *MsgSetOperatorAddressResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgSetICQVerification) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MsgLiquidStake) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *MsgSetICQVerification) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetICQVerification) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Staker)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Validators) > 0 {
+		for iNdEx := len(m.Validators) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Validators[iNdEx])
+			copy(dAtA[i:], m.Validators[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Validators[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.ConnectionId) > 0 {
+		i -= len(m.ConnectionId)
+		copy(dAtA[i:], m.ConnectionId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ConnectionId)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = m.NativeAmount.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	if m.Enabled {
+		i--
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgLiquidStakeResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgSetICQVerificationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.StToken.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgRedeemStake) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Redeemer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = m.StTokenAmount.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+func (m *MsgSetICQVerificationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgRedeemStakeResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgSetICQVerificationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.NativeToken.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgConfirmDelegation) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgClearPendingConfirmation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Operator)
+	return dAtA[:n], nil
+}
+
+func (m *MsgClearPendingConfirmation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClearPendingConfirmation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PendingConfirmationId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PendingConfirmationId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgClearPendingConfirmationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClearPendingConfirmationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClearPendingConfirmationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgLiquidStake) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Staker)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.NativeAmount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgLiquidStakeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.StToken.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgRedeemStake) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Redeemer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.StTokenAmount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgRedeemStakeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.NativeToken.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgConfirmDelegation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Operator)
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
@@ -3091,6 +3511,66 @@ func (m *MsgSetOperatorAddressResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgSetICQVerification) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Enabled {
+		n += 2
+	}
+	l = len(m.ConnectionId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Validators) > 0 {
+		for _, s := range m.Validators {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgSetICQVerificationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgClearPendingConfirmation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.PendingConfirmationId != 0 {
+		n += 1 + sovTx(uint64(m.PendingConfirmationId))
+	}
+	return n
+}
+
+func (m *MsgClearPendingConfirmationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -5374,6 +5854,373 @@ func (m *MsgSetOperatorAddressResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgSetICQVerification) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetICQVerification: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetICQVerification: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Enabled = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConnectionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validators", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validators = append(m.Validators, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetICQVerificationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetICQVerificationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetICQVerificationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgClearPendingConfirmation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgClearPendingConfirmation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgClearPendingConfirmation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingConfirmationId", wireType)
+			}
+			m.PendingConfirmationId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PendingConfirmationId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgClearPendingConfirmationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgClearPendingConfirmationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgClearPendingConfirmationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0